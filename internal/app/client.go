@@ -18,6 +18,7 @@ import (
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/pkg/otel"
+	"pointofsale/pkg/storage"
 	"pointofsale/pkg/upload_image"
 	"syscall"
 	"time"
@@ -174,44 +175,19 @@ func (cm *CacheManager) StartCleanup(ctx context.Context) <-chan struct{} {
 	return done
 }
 
+// cleanup drops expired keys from the namespace indexes. Redis expires the
+// keys themselves, so nothing outside our namespaces is touched.
 func (cm *CacheManager) cleanup(ctx context.Context) {
-	cm.logger.Info("Starting periodic cache cleanup")
-
-	statsBefore, err := cm.cache.GetStats(ctx)
-	if err != nil {
-		cm.logger.Error("Failed to get cache stats before cleanup", zap.Error(err))
-		statsBefore = nil
-	}
-
-	scanned, err := cm.cache.ClearExpired(ctx)
+	pruned, err := cm.cache.PruneNamespaces(ctx)
 	if err != nil {
 		cm.logger.Error("Cache cleanup failed", zap.Error(err))
 		return
 	}
 
-	statsAfter, err := cm.cache.GetStats(ctx)
-	if err != nil {
-		cm.logger.Error("Failed to get cache stats after cleanup", zap.Error(err))
-		statsAfter = nil
-	}
-
-	logFields := []zap.Field{
-		zap.Int64("scanned_keys", scanned),
+	cm.logger.Info("Cache cleanup completed",
+		zap.Int64("pruned_keys", pruned),
 		zap.Int64("ref_count", cm.cache.GetRefCount()),
-	}
-
-	if statsBefore != nil && statsAfter != nil {
-		keysRemoved := statsBefore.TotalKeys - statsAfter.TotalKeys
-		logFields = append(logFields,
-			zap.Int64("keys_before", statsBefore.TotalKeys),
-			zap.Int64("keys_after", statsAfter.TotalKeys),
-			zap.Int64("keys_removed", keysRemoved),
-			zap.String("memory_before", statsBefore.MemoryUsedHuman),
-			zap.String("memory_after", statsAfter.MemoryUsedHuman),
-		)
-	}
-
-	cm.logger.Info("Cache cleanup completed", logFields...)
+	)
 }
 
 func NewClient(cfg *ClientConfig) (*Client, error) {
@@ -265,17 +241,29 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 	echoServer := createEchoServer(cfg)
 
 	mapper := response_api.NewResponseApiMapper()
-	imageUpload := upload_image.NewImageUpload(logger)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	objectStorage, err := newObjectStorage(ctx, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize object storage: %w", err)
+	}
+
+	// Signed links to locally stored images are served here; S3 links point
+	// at the bucket itself.
+	if local, ok := objectStorage.(*storage.LocalStorage); ok {
+		echoServer.GET("/uploads/*", echo.WrapHandler(local.Handler()))
+	}
+
+	imageUpload := upload_image.NewImageUpload(logger, objectStorage, viper.GetDuration("IMAGE_URL_TTL"))
+
 	redisClient, err := initRedisClient(ctx, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Redis: %w", err)
 	}
 
-	cacheStore := cache.NewCacheStore(redisClient, logger, cacheMetrics)
+	cacheStore := cache.NewCacheStoreWithPrefix(redisClient, cacheKeyPrefix("REDIS_KEY_PREFIX_CLIENT"), logger, cacheMetrics)
 
 	tasksCtx, cancelTasks := context.WithCancel(context.Background())
 	cacheManager := NewCacheManager(cacheStore, logger)
@@ -283,6 +271,7 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 	tasksDone := []<-chan struct{}{
 		cacheManager.StartMonitoring(tasksCtx),
 		cacheManager.StartCleanup(tasksCtx),
+		cacheStore.ListenForInvalidations(tasksCtx),
 	}
 
 	handlerDeps := api.Deps{
@@ -496,6 +485,12 @@ func connectToGRPC(addr string, logger logger.LoggerInterface) (*grpc.ClientConn
 			Timeout:             defaultKeepaliveTimeoutClient,
 			PermitWithoutStream: true,
 		}),
+		grpc.WithChainUnaryInterceptor(
+			middlewares.ForwardTokenUnaryClientInterceptor(),
+			middlewares.ForwardIdempotencyKeyUnaryClientInterceptor(),
+			middlewares.ForwardClientInfoUnaryClientInterceptor(),
+		),
+		grpc.WithChainStreamInterceptor(middlewares.ForwardTokenStreamClientInterceptor()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
@@ -524,6 +519,7 @@ func createEchoServer(cfg *ClientConfig) *echo.Echo {
 	e.Use(createCORSMiddleware(cfg.AllowedOrigins))
 	e.Use(middleware.Gzip())
 	e.Use(createSecureMiddleware())
+	e.Use(middlewares.ClientInfo())
 
 	middlewares.WebSecurityConfig(e)
 
@@ -568,6 +564,10 @@ func createCORSMiddleware(allowedOrigins []string) echo.MiddlewareFunc {
 			echo.HeaderAccept,
 			echo.HeaderAuthorization,
 			"X-Request-ID",
+			middlewares.IdempotencyKeyHeader,
+		},
+		ExposeHeaders: []string{
+			middlewares.IdempotentReplayedHeader,
 		},
 		AllowCredentials: true,
 		MaxAge:           86400,
@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"pointofsale/internal/cache"
+	auth_cache "pointofsale/internal/cache/auth"
 	"pointofsale/internal/handler/gapi"
 	"pointofsale/internal/middlewares"
 	"pointofsale/internal/pb"
@@ -21,6 +22,7 @@ import (
 	"pointofsale/pkg/dotenv"
 	"pointofsale/pkg/hash"
 	"pointofsale/pkg/logger"
+	"pointofsale/pkg/notifier"
 	"pointofsale/pkg/observability"
 	"pointofsale/pkg/otel"
 	"pointofsale/pkg/resilience"
@@ -49,6 +51,7 @@ const (
 
 	monitoringInterval     = 30 * time.Second
 	cleanupInterval        = 120 * time.Second
+	lowStockInterval       = 60 * time.Second
 	cacheRefCountThreshold = 500
 
 	shutdownTimeout = 30 * time.Second
@@ -157,18 +160,27 @@ func NewServer(cfg *Config) (*Server, error) {
 		return nil, fmt.Errorf("failed to initialize Redis: %w", err)
 	}
 
-	cacheStore := cache.NewCacheStore(redisClient, logger, cacheMetrics)
+	cacheStore := cache.NewCacheStoreWithPrefix(redisClient, cacheKeyPrefix("REDIS_KEY_PREFIX_SERVER"), logger, cacheMetrics)
+
+	objectStorage, err := newObjectStorage(ctx, logger)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to initialize object storage: %w", err)
+	}
 
 	hasher := hash.NewHashingPassword()
 
-	repositories := repository.NewRepositories(queries)
+	repositories := repository.NewRepositories(dbConn)
 
 	services := service.NewService(service.Deps{
-		Repositories: repositories,
-		Hash:         hasher,
-		Token:        tokenManager,
-		Logger:       logger,
-		Cache:        cacheStore,
+		Repositories:     repositories,
+		Hash:             hasher,
+		Token:            tokenManager,
+		Logger:           logger,
+		Cache:            cacheStore,
+		DefaultRole:      viper.GetString("AUTH_DEFAULT_ROLE"),
+		LowStockNotifier: newLowStockNotifier(logger),
+		Storage:          objectStorage,
 	})
 
 	handlers := gapi.NewHandler(services)
@@ -210,7 +222,12 @@ func (s *Server) Run() error {
 
 	resilienceManager := s.initResilience()
 
-	grpcServer := s.createGRPCServer(resilienceManager)
+	authentication := middlewares.NewAuthInterceptor(s.TokenManager, auth_cache.NewTokenDenylistCache(s.CacheStore), s.Logger, middlewares.DefaultPublicGrpcMethods()...)
+	authorization := s.initAuthorization()
+	tenancy := s.initTenancy()
+	idempotency := middlewares.NewIdempotencyInterceptor(s.Services.Idempotency, s.Logger, middlewares.DefaultIdempotentGrpcMethods()...)
+
+	grpcServer := s.createGRPCServer(resilienceManager, authentication, authorization, tenancy, idempotency)
 
 	s.registerServices(grpcServer)
 
@@ -224,7 +241,9 @@ func (s *Server) Run() error {
 	}
 
 	monitoringDone := spawnMonitoringTask(s.Ctx, s.CacheStore)
-	cleanupDone := spawnCleanupTask(s.Ctx, s.CacheStore)
+	cleanupDone := spawnCleanupTask(s.Ctx, s.CacheStore, s.Services.Idempotency, s.Services.Auth)
+	lowStockDone := spawnLowStockTask(s.Ctx, s.Services.StockAlert, s.Logger)
+	invalidationsDone := s.CacheStore.ListenForInvalidations(s.Ctx)
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
@@ -250,7 +269,7 @@ func (s *Server) Run() error {
 		return err
 	}
 
-	return s.gracefulShutdown(grpcServer, healthServer, monitoringDone, cleanupDone)
+	return s.gracefulShutdown(grpcServer, healthServer, monitoringDone, cleanupDone, lowStockDone, invalidationsDone)
 }
 
 func (s *Server) initResilience() *middlewares.ResilienceInterceptor {
@@ -260,7 +279,35 @@ func (s *Server) initResilience() *middlewares.ResilienceInterceptor {
 	return middlewares.NewResilienceInterceptor(loadMonitor, circuitBreaker, requestLimiter)
 }
 
-func (s *Server) createGRPCServer(resilienceManager *middlewares.ResilienceInterceptor) *grpc.Server {
+func (s *Server) initAuthorization() *middlewares.AuthorizationInterceptor {
+	roles := middlewares.RoleResolverFunc(func(ctx context.Context, userID int) ([]string, error) {
+		userRoles, err := s.Services.Role.FindByUserId(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		names := make([]string, 0, len(userRoles))
+		for _, role := range userRoles {
+			names = append(names, role.RoleName)
+		}
+
+		return names, nil
+	})
+
+	return middlewares.NewAuthorizationInterceptor(middlewares.DefaultGrpcPolicy(), roles, s.Logger)
+}
+
+// initTenancy restricts non-admin callers to the merchants they own or work
+// at as a cashier.
+func (s *Server) initTenancy() *middlewares.TenancyInterceptor {
+	merchants := middlewares.MerchantResolverFunc(func(ctx context.Context, userID int) ([]int, error) {
+		return s.Services.Merchant.FindScopeByUser(ctx, userID)
+	})
+
+	return middlewares.NewTenancyInterceptor(middlewares.DefaultGrpcPolicy(), merchants, s.Logger)
+}
+
+func (s *Server) createGRPCServer(resilienceManager *middlewares.ResilienceInterceptor, authentication *middlewares.AuthInterceptor, authorization *middlewares.AuthorizationInterceptor, tenancy *middlewares.TenancyInterceptor, idempotency *middlewares.IdempotencyInterceptor) *grpc.Server {
 	return grpc.NewServer(
 		grpc.MaxConcurrentStreams(defaultMaxConcurrentConn),
 		grpc.InitialConnWindowSize(defaultWindowSize),
@@ -275,8 +322,18 @@ func (s *Server) createGRPCServer(resilienceManager *middlewares.ResilienceInter
 		}),
 		grpc.ChainUnaryInterceptor(
 			middlewares.PyroscopeUnaryInterceptor(),
+			middlewares.ClientInfoUnaryInterceptor(),
 			middlewares.TimeoutInterceptor(defaultRequestTimeout),
 			resilienceManager.UnaryInterceptor(),
+			authentication.UnaryInterceptor(),
+			authorization.UnaryInterceptor(),
+			tenancy.UnaryInterceptor(),
+			idempotency.UnaryInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			authentication.StreamInterceptor(),
+			authorization.StreamInterceptor(),
+			tenancy.StreamInterceptor(),
 		),
 	)
 }
@@ -291,7 +348,13 @@ func (s *Server) registerServices(grpcServer *grpc.Server) {
 	pb.RegisterOrderServiceServer(grpcServer, s.Handlers.Order)
 	pb.RegisterOrderItemServiceServer(grpcServer, s.Handlers.OrderItem)
 	pb.RegisterProductServiceServer(grpcServer, s.Handlers.Product)
+	pb.RegisterProductVariantServiceServer(grpcServer, s.Handlers.ProductVariant)
 	pb.RegisterTransactionServiceServer(grpcServer, s.Handlers.Transaction)
+	pb.RegisterTaxServiceServer(grpcServer, s.Handlers.Tax)
+	pb.RegisterSupplierServiceServer(grpcServer, s.Handlers.Supplier)
+	pb.RegisterPurchaseOrderServiceServer(grpcServer, s.Handlers.PurchaseOrder)
+	pb.RegisterStocktakeServiceServer(grpcServer, s.Handlers.Stocktake)
+	pb.RegisterCacheServiceServer(grpcServer, s.Handlers.Cache)
 
 	s.Logger.Info("All gRPC services registered successfully")
 }
@@ -299,7 +362,7 @@ func (s *Server) registerServices(grpcServer *grpc.Server) {
 func (s *Server) gracefulShutdown(
 	grpcServer *grpc.Server,
 	healthServer *health.Server,
-	monitoringDone, cleanupDone <-chan struct{},
+	monitoringDone, cleanupDone, lowStockDone, invalidationsDone <-chan struct{},
 ) error {
 	s.Logger.Info("Starting graceful shutdown...")
 
@@ -314,6 +377,8 @@ func (s *Server) gracefulShutdown(
 	go func() {
 		<-monitoringDone
 		<-cleanupDone
+		<-lowStockDone
+		<-invalidationsDone
 		close(tasksDone)
 	}()
 
@@ -431,6 +496,17 @@ func runSeederIfEnabled(ctx context.Context, db *db.Queries, hasher hash.HashPas
 	return nil
 }
 
+// newLowStockNotifier posts low-stock alerts to LOW_STOCK_WEBHOOK_URL when it
+// is set and logs them otherwise.
+func newLowStockNotifier(logger logger.LoggerInterface) notifier.LowStockNotifier {
+	if url := viper.GetString("LOW_STOCK_WEBHOOK_URL"); url != "" {
+		logger.Info("Low stock alerts are sent to webhook")
+		return notifier.NewWebhookNotifier(url, nil)
+	}
+
+	return notifier.NewLogNotifier(logger)
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -438,6 +514,15 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// cacheKeyPrefix returns the key prefix configured under name, falling back
+// to cache.DefaultKeyPrefix.
+func cacheKeyPrefix(name string) string {
+	if prefix := viper.GetString(name); prefix != "" {
+		return prefix
+	}
+	return cache.DefaultKeyPrefix
+}
+
 func spawnMonitoringTask(ctx context.Context, cache *cache.CacheStore) <-chan struct{} {
 	done := make(chan struct{})
 
@@ -490,7 +575,7 @@ func monitorCache(ctx context.Context, cache *cache.CacheStore) {
 	}
 }
 
-func spawnCleanupTask(ctx context.Context, cache *cache.CacheStore) <-chan struct{} {
+func spawnCleanupTask(ctx context.Context, cache *cache.CacheStore, idempotency service.IdempotencyService, authService service.AuthService) <-chan struct{} {
 	done := make(chan struct{})
 
 	go func() {
@@ -510,6 +595,8 @@ func spawnCleanupTask(ctx context.Context, cache *cache.CacheStore) <-chan struc
 				return
 			case <-ticker.C:
 				cleanupCache(ctx, cache)
+				purgeIdempotencyKeys(ctx, idempotency, cache.Logger)
+				purgeExpiredSessions(ctx, authService, cache.Logger)
 			}
 		}
 	}()
@@ -517,42 +604,83 @@ func spawnCleanupTask(ctx context.Context, cache *cache.CacheStore) <-chan struc
 	return done
 }
 
+// cleanupCache drops expired keys from the namespace indexes of the store.
 func cleanupCache(ctx context.Context, cache *cache.CacheStore) {
-	cache.Logger.Info("Starting periodic cache cleanup")
-
-	statsBefore, err := cache.GetStats(ctx)
+	pruned, err := cache.PruneNamespaces(ctx)
 	if err != nil {
-		cache.Logger.Error("Failed to get cache stats before cleanup", zap.Error(err))
-		statsBefore = nil
+		cache.Logger.Error("Cache cleanup failed", zap.Error(err))
+		return
 	}
 
-	scanned, err := cache.ClearExpired(ctx)
+	cache.Logger.Info("Cache cleanup completed",
+		zap.Int64("pruned_keys", pruned),
+		zap.Int64("ref_count", cache.GetRefCount()),
+	)
+}
+
+// purgeIdempotencyKeys drops idempotency keys whose responses are no longer
+// replayed. Failures are logged by the service.
+func purgeIdempotencyKeys(ctx context.Context, idempotency service.IdempotencyService, logger logger.LoggerInterface) {
+	deleted, err := idempotency.PurgeExpired(ctx)
 	if err != nil {
-		cache.Logger.Error("Cache cleanup failed", zap.Error(err))
 		return
 	}
 
-	statsAfter, err := cache.GetStats(ctx)
+	if deleted > 0 {
+		logger.Info("Expired idempotency keys purged", zap.Int64("deleted", deleted))
+	}
+}
+
+// purgeExpiredSessions drops refresh tokens that can no longer be presented,
+// since every rotation leaves the previous token behind. Failures are logged
+// by the service.
+func purgeExpiredSessions(ctx context.Context, authService service.AuthService, logger logger.LoggerInterface) {
+	deleted, err := authService.PurgeExpiredSessions(ctx)
 	if err != nil {
-		cache.Logger.Error("Failed to get cache stats after cleanup", zap.Error(err))
-		statsAfter = nil
+		return
 	}
 
-	logFields := []zap.Field{
-		zap.Int64("scanned_keys", scanned),
-		zap.Int64("ref_count", cache.GetRefCount()),
+	if deleted > 0 {
+		logger.Info("Expired refresh tokens purged", zap.Int64("deleted", deleted))
 	}
+}
 
-	if statsBefore != nil && statsAfter != nil {
-		keysRemoved := statsBefore.TotalKeys - statsAfter.TotalKeys
-		logFields = append(logFields,
-			zap.Int64("keys_before", statsBefore.TotalKeys),
-			zap.Int64("keys_after", statsAfter.TotalKeys),
-			zap.Int64("keys_removed", keysRemoved),
-			zap.String("memory_before", statsBefore.MemoryUsedHuman),
-			zap.String("memory_after", statsAfter.MemoryUsedHuman),
+func spawnLowStockTask(ctx context.Context, stockAlert service.StockAlertService, logger logger.LoggerInterface) <-chan struct{} {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(lowStockInterval)
+		defer ticker.Stop()
+
+		logger.Info("Low stock evaluator started",
+			zap.Duration("interval", lowStockInterval),
 		)
+
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Info("Low stock evaluator stopped")
+				return
+			case <-ticker.C:
+				evaluateLowStock(ctx, stockAlert, logger)
+			}
+		}
+	}()
+
+	return done
+}
+
+// evaluateLowStock sends alerts for products that fell to their reorder
+// point. Failures are logged by the service.
+func evaluateLowStock(ctx context.Context, stockAlert service.StockAlertService, logger logger.LoggerInterface) {
+	alerted, err := stockAlert.EvaluateLowStock(ctx)
+	if err != nil {
+		return
 	}
 
-	cache.Logger.Info("Cache cleanup completed", logFields...)
+	if alerted > 0 {
+		logger.Info("Low stock alerts sent", zap.Int("alerts", alerted))
+	}
 }
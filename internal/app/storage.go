@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/storage"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// newObjectStorage returns the storage uploaded images are kept in, chosen by
+// STORAGE_DRIVER. The client stores uploads and the server removes them when
+// products are deleted, so both must point at the same place: a bucket, or a
+// directory every instance mounts.
+func newObjectStorage(ctx context.Context, logger logger.LoggerInterface) (storage.Storage, error) {
+	switch driver := viper.GetString("STORAGE_DRIVER"); driver {
+	case "s3":
+		store, err := storage.NewS3Storage(storage.S3StorageConfig{
+			Endpoint:  viper.GetString("S3_ENDPOINT"),
+			AccessKey: viper.GetString("S3_ACCESS_KEY"),
+			SecretKey: viper.GetString("S3_SECRET_KEY"),
+			Bucket:    viper.GetString("S3_BUCKET"),
+			Region:    viper.GetString("S3_REGION"),
+			UseSSL:    viper.GetBool("S3_USE_SSL"),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := store.EnsureBucket(ctx); err != nil {
+			return nil, err
+		}
+
+		logger.Info("Images are stored in S3 bucket",
+			zap.String("endpoint", viper.GetString("S3_ENDPOINT")),
+			zap.String("bucket", viper.GetString("S3_BUCKET")),
+		)
+
+		return store, nil
+
+	case "", "local":
+		// Signed image URLs get their own key so that leaking one never
+		// lets anyone forge the other, e.g. access tokens from SECRET_KEY.
+		signingKey := viper.GetString("STORAGE_SIGNING_KEY")
+		if signingKey == "" {
+			return nil, errors.New("STORAGE_SIGNING_KEY is required for the local STORAGE_DRIVER")
+		}
+
+		root := viper.GetString("STORAGE_LOCAL_ROOT")
+
+		logger.Info("Images are stored on the local filesystem", zap.String("root", root))
+
+		return storage.NewLocalStorage(storage.LocalStorageConfig{
+			Root:       root,
+			BaseURL:    viper.GetString("STORAGE_PUBLIC_URL"),
+			SigningKey: signingKey,
+		}), nil
+
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_DRIVER %q", driver)
+	}
+}
@@ -4,17 +4,14 @@ import "pointofsale/internal/cache"
 
 type authMencache struct {
 	IdentityCache
-	LoginCache
 }
 
 type AuthMencache interface {
 	IdentityCache
-	LoginCache
 }
 
 func NewMencache(cacheStore *cache.CacheStore) AuthMencache {
 	return &authMencache{
 		IdentityCache: NewIdentityCache(cacheStore),
-		LoginCache:    NewLoginCache(cacheStore),
 	}
 }
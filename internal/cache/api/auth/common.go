@@ -1,7 +1,14 @@
 package auth_cache
 
+import (
+	"pointofsale/internal/cache"
+	"time"
+)
+
+var ttlDefault = 5 * time.Minute
+
+var entityTag = cache.EntityTag("user")
+
 const (
-	keylogin                = "auth:login:%s"
-	keyIdentityRefreshToken = "auth:refresh_token:%s"
-	keyIdentityUserInfo     = "auth:user_info:%s"
+	keyIdentityUserInfo = "auth:user_info:%s"
 )
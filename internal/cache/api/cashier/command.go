@@ -18,4 +18,9 @@ func (c *cashierCommandCache) DeleteCashierCache(ctx context.Context, id int) {
 	key := fmt.Sprintf(cashierByIdCacheKey, id)
 
 	cache.DeleteFromCache(ctx, c.store, key)
+	c.InvalidateCashierCache(ctx)
+}
+
+func (c *cashierCommandCache) InvalidateCashierCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, c.store, entityTag)
 }
@@ -19,7 +19,7 @@ func NewCashierQueryCache(store *cache.CacheStore) *cashierQueryCache {
 func (s *cashierQueryCache) GetCachedCashiersCache(ctx context.Context, req *requests.FindAllCashiers) (*response.ApiResponsePaginationCashier, bool) {
 	key := fmt.Sprintf(cashierAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashier](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashier](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -34,13 +34,13 @@ func (s *cashierQueryCache) SetCachedCashiersCache(ctx context.Context, req *req
 	}
 
 	key := fmt.Sprintf(cashierAllCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *cashierQueryCache) GetCachedCashier(ctx context.Context, cashierID int) (*response.ApiResponseCashier, bool) {
 	key := fmt.Sprintf(cashierByIdCacheKey, cashierID)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCashier](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashier](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -55,13 +55,13 @@ func (s *cashierQueryCache) SetCachedCashier(ctx context.Context, res *response.
 	}
 
 	key := fmt.Sprintf(cashierByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *cashierQueryCache) GetCachedCashiersActive(ctx context.Context, req *requests.FindAllCashiers) (*response.ApiResponsePaginationCashierDeleteAt, bool) {
 	key := fmt.Sprintf(cashierActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashierDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashierDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -76,13 +76,13 @@ func (s *cashierQueryCache) SetCachedCashiersActive(ctx context.Context, req *re
 	}
 
 	key := fmt.Sprintf(cashierActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *cashierQueryCache) GetCachedCashiersTrashed(ctx context.Context, req *requests.FindAllCashiers) (*response.ApiResponsePaginationCashierDeleteAt, bool) {
 	key := fmt.Sprintf(cashierTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashierDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashierDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -97,13 +97,13 @@ func (s *cashierQueryCache) SetCachedCashiersTrashed(ctx context.Context, req *r
 	}
 
 	key := fmt.Sprintf(cashierTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *cashierQueryCache) GetCachedCashiersByMerchant(ctx context.Context, req *requests.FindAllCashierMerchant) (*response.ApiResponsePaginationCashier, bool) {
 	key := fmt.Sprintf(cashierByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashier](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationCashier](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -118,5 +118,5 @@ func (s *cashierQueryCache) SetCachedCashiersByMerchant(ctx context.Context, req
 	}
 
 	key := fmt.Sprintf(cashierByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
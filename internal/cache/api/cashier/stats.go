@@ -26,7 +26,7 @@ func NewCashierStatsCache(store *cache.CacheStore) *cashierStatsCache {
 
 func (s *cashierStatsCache) GetMonthlyTotalSalesCache(ctx context.Context, req *requests.MonthTotalSales) (*response.ApiResponseCashierMonthlyTotalSales, bool) {
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesCacheKey, req.Month, req.Year)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthlyTotalSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthlyTotalSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -40,12 +40,12 @@ func (s *cashierStatsCache) SetMonthlyTotalSalesCache(ctx context.Context, req *
 	}
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesCacheKey, req.Month, req.Year)
 	// Langsung simpan objek ApiResponse
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsCache) GetYearlyTotalSalesCache(ctx context.Context, year int) (*response.ApiResponseCashierYearlyTotalSales, bool) {
 	key := fmt.Sprintf(cashierStatsYearTotalSalesCacheKey, year)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierYearlyTotalSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierYearlyTotalSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -58,12 +58,12 @@ func (s *cashierStatsCache) SetYearlyTotalSalesCache(ctx context.Context, year i
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearTotalSalesCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsCache) GetMonthlySalesCache(ctx context.Context, year int) (*response.ApiResponseCashierMonthSales, bool) {
 	key := fmt.Sprintf(cashierStatsMonthSalesCacheKey, year)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -76,12 +76,12 @@ func (s *cashierStatsCache) SetMonthlySalesCache(ctx context.Context, year int,
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthSalesCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsCache) GetYearlySalesCache(ctx context.Context, year int) (*response.ApiResponseCashierYearSales, bool) {
 	key := fmt.Sprintf(cashierStatsYearSalesCacheKey, year)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierYearSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierYearSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -93,5 +93,5 @@ func (s *cashierStatsCache) SetYearlySalesCache(ctx context.Context, year int, r
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearSalesCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
@@ -26,7 +26,7 @@ func NewCashierStatsByIdCache(store *cache.CacheStore) *cashierStatsByIdCache {
 
 func (s *cashierStatsByIdCache) GetMonthlyTotalSalesByIdCache(ctx context.Context, req *requests.MonthTotalSalesCashier) (*response.ApiResponseCashierMonthlyTotalSales, bool) {
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByIdCacheKey, req.Month, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthlyTotalSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthlyTotalSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -40,12 +40,12 @@ func (s *cashierStatsByIdCache) SetMonthlyTotalSalesByIdCache(ctx context.Contex
 	}
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByIdCacheKey, req.Month, req.Year, req.CashierID)
 
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsByIdCache) GetYearlyTotalSalesByIdCache(ctx context.Context, req *requests.YearTotalSalesCashier) (*response.ApiResponseCashierYearlyTotalSales, bool) {
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByIdCacheKey, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierYearlyTotalSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierYearlyTotalSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -57,12 +57,12 @@ func (s *cashierStatsByIdCache) SetYearlyTotalSalesByIdCache(ctx context.Context
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByIdCacheKey, req.Year, req.CashierID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsByIdCache) GetMonthlyCashierByIdCache(ctx context.Context, req *requests.MonthCashierId) (*response.ApiResponseCashierMonthSales, bool) {
 	key := fmt.Sprintf(cashierStatsMonthSalesByIdCacheKey, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -74,12 +74,12 @@ func (s *cashierStatsByIdCache) SetMonthlyCashierByIdCache(ctx context.Context,
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthSalesByIdCacheKey, req.Year, req.CashierID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsByIdCache) GetYearlyCashierByIdCache(ctx context.Context, req *requests.YearCashierId) (*response.ApiResponseCashierYearSales, bool) {
 	key := fmt.Sprintf(cashierStatsYearSalesByIdCacheKey, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierYearSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierYearSales](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -91,5 +91,5 @@ func (s *cashierStatsByIdCache) SetYearlyCashierByIdCache(ctx context.Context, r
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearSalesByIdCacheKey, req.Year, req.CashierID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
@@ -26,7 +26,7 @@ func NewCashierStatsByMerchantCache(store *cache.CacheStore) *cashierStatsByMerc
 
 func (s *cashierStatsByMerchantCache) GetMonthlyTotalSalesByMerchantCache(ctx context.Context, req *requests.MonthTotalSalesMerchant) (*response.ApiResponseCashierMonthlyTotalSales, bool) {
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByMerchantCacheKey, req.Month, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthlyTotalSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthlyTotalSales](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -38,12 +38,12 @@ func (s *cashierStatsByMerchantCache) SetMonthlyTotalSalesByMerchantCache(ctx co
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByMerchantCacheKey, req.Month, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *cashierStatsByMerchantCache) GetYearlyTotalSalesByMerchantCache(ctx context.Context, req *requests.YearTotalSalesMerchant) (*response.ApiResponseCashierYearlyTotalSales, bool) {
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierYearlyTotalSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierYearlyTotalSales](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -55,12 +55,12 @@ func (s *cashierStatsByMerchantCache) SetYearlyTotalSalesByMerchantCache(ctx con
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *cashierStatsByMerchantCache) GetMonthlyCashierByMerchantCache(ctx context.Context, req *requests.MonthCashierMerchant) (*response.ApiResponseCashierMonthSales, bool) {
 	key := fmt.Sprintf(cashierStatsMonthSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierMonthSales](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -72,12 +72,12 @@ func (s *cashierStatsByMerchantCache) SetMonthlyCashierByMerchantCache(ctx conte
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *cashierStatsByMerchantCache) GetYearlyCashierByMerchantCache(ctx context.Context, req *requests.YearCashierMerchant) (*response.ApiResponseCashierYearSales, bool) {
 	key := fmt.Sprintf(cashierStatsYearSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[*response.ApiResponseCashierYearSales](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCashierYearSales](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -89,5 +89,5 @@ func (s *cashierStatsByMerchantCache) SetYearlyCashierByMerchantCache(ctx contex
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
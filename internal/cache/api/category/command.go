@@ -17,4 +17,9 @@ func NewCategoryCommandCache(store *cache.CacheStore) *categoryCommandCache {
 func (c *categoryCommandCache) DeleteCachedCategoryCache(ctx context.Context, id int) {
 	key := fmt.Sprintf(categoryByIdCacheKey, id)
 	cache.DeleteFromCache(ctx, c.store, key)
+	c.InvalidateCategoryCache(ctx)
+}
+
+func (c *categoryCommandCache) InvalidateCategoryCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, c.store, entityTag)
 }
@@ -22,6 +22,7 @@ type CategoryQueryCache interface {
 
 type CategoryCommandCache interface {
 	DeleteCachedCategoryCache(ctx context.Context, id int)
+	InvalidateCategoryCache(ctx context.Context)
 }
 
 type CategoryStatsCache interface {
@@ -31,11 +32,11 @@ type CategoryStatsCache interface {
 	GetCachedYearTotalPriceCache(ctx context.Context, year int) (*response.ApiResponseCategoryYearlyTotalPrice, bool)
 	SetCachedYearTotalPriceCache(ctx context.Context, year int, res *response.ApiResponseCategoryYearlyTotalPrice)
 
-	GetCachedMonthPriceCache(ctx context.Context, year int) (*response.ApiResponseCategoryMonthPrice, bool)
-	SetCachedMonthPriceCache(ctx context.Context, year int, res *response.ApiResponseCategoryMonthPrice)
+	GetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice) (*response.ApiResponseCategoryMonthPrice, bool)
+	SetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice, res *response.ApiResponseCategoryMonthPrice)
 
-	GetCachedYearPriceCache(ctx context.Context, year int) (*response.ApiResponseCategoryYearPrice, bool)
-	SetCachedYearPriceCache(ctx context.Context, year int, res *response.ApiResponseCategoryYearPrice)
+	GetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice) (*response.ApiResponseCategoryYearPrice, bool)
+	SetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice, res *response.ApiResponseCategoryYearPrice)
 }
 
 type CategoryStatsByIdCache interface {
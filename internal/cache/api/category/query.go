@@ -18,6 +18,13 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var (
+	entityTag = cache.EntityTag("category")
+
+	// Sales stats are computed from orders and go stale with them.
+	orderTag = cache.EntityTag("order")
+)
+
 type categoryQueryCache struct {
 	store *cache.CacheStore
 }
@@ -29,7 +36,7 @@ func NewCategoryQueryCache(store *cache.CacheStore) *categoryQueryCache {
 func (s *categoryQueryCache) GetCachedCategoriesCache(ctx context.Context, req *requests.FindAllCategory) (*response.ApiResponsePaginationCategory, bool) {
 	key := fmt.Sprintf(categoryAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationCategory](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationCategory](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -44,13 +51,13 @@ func (s *categoryQueryCache) SetCachedCategoriesCache(ctx context.Context, req *
 	}
 
 	key := fmt.Sprintf(categoryAllCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *categoryQueryCache) GetCachedCategoryActiveCache(ctx context.Context, req *requests.FindAllCategory) (*response.ApiResponsePaginationCategoryDeleteAt, bool) {
 	key := fmt.Sprintf(categoryActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationCategoryDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationCategoryDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -65,13 +72,13 @@ func (s *categoryQueryCache) SetCachedCategoryActiveCache(ctx context.Context, r
 	}
 
 	key := fmt.Sprintf(categoryActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *categoryQueryCache) GetCachedCategoryTrashedCache(ctx context.Context, req *requests.FindAllCategory) (*response.ApiResponsePaginationCategoryDeleteAt, bool) {
 	key := fmt.Sprintf(categoryTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationCategoryDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationCategoryDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -86,12 +93,12 @@ func (s *categoryQueryCache) SetCachedCategoryTrashedCache(ctx context.Context,
 	}
 
 	key := fmt.Sprintf(categoryTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *categoryQueryCache) GetCachedCategoryCache(ctx context.Context, id int) (*response.ApiResponseCategory, bool) {
 	key := fmt.Sprintf(categoryByIdCacheKey, id)
-	result, found := cache.GetFromCache[*response.ApiResponseCategory](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategory](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -106,5 +113,5 @@ func (s *categoryQueryCache) SetCachedCategoryCache(ctx context.Context, res *re
 	}
 
 	key := fmt.Sprintf(categoryByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
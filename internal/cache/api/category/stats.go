@@ -12,8 +12,8 @@ const (
 	categoryStatsMonthTotalPriceCacheKey = "category:stats:month:%d:year:%d"
 	categoryStatsYearTotalPriceCacheKey  = "category:stats:year:%d"
 
-	categoryStatsMonthPriceCacheKey = "category:stats:month:%d"
-	categoryStatsYearPriceCacheKey  = "category:stats:year:%d"
+	categoryStatsMonthPriceCacheKey = "category:stats:month:%d:subcategories:%t"
+	categoryStatsYearPriceCacheKey  = "category:stats:year:%d:subcategories:%t"
 )
 
 type categoryStatsCache struct {
@@ -27,7 +27,7 @@ func NewCategoryStatsCache(store *cache.CacheStore) *categoryStatsCache {
 func (s *categoryStatsCache) GetCachedMonthTotalPriceCache(ctx context.Context, req *requests.MonthTotalPrice) (*response.ApiResponseCategoryMonthlyTotalPrice, bool) {
 	key := fmt.Sprintf(categoryStatsMonthTotalPriceCacheKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthlyTotalPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthlyTotalPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -43,12 +43,12 @@ func (s *categoryStatsCache) SetCachedMonthTotalPriceCache(ctx context.Context,
 
 	key := fmt.Sprintf(categoryStatsMonthTotalPriceCacheKey, req.Month, req.Year)
 
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *categoryStatsCache) GetCachedYearTotalPriceCache(ctx context.Context, year int) (*response.ApiResponseCategoryYearlyTotalPrice, bool) {
 	key := fmt.Sprintf(categoryStatsYearTotalPriceCacheKey, year)
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearlyTotalPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearlyTotalPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -63,12 +63,12 @@ func (s *categoryStatsCache) SetCachedYearTotalPriceCache(ctx context.Context, y
 	}
 
 	key := fmt.Sprintf(categoryStatsYearTotalPriceCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
-func (s *categoryStatsCache) GetCachedMonthPriceCache(ctx context.Context, year int) (*response.ApiResponseCategoryMonthPrice, bool) {
-	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, year)
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthPrice](ctx, s.store, key)
+func (s *categoryStatsCache) GetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice) (*response.ApiResponseCategoryMonthPrice, bool) {
+	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, req.Year, req.IncludeSubcategories)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -77,18 +77,18 @@ func (s *categoryStatsCache) GetCachedMonthPriceCache(ctx context.Context, year
 	return result, true
 }
 
-func (s *categoryStatsCache) SetCachedMonthPriceCache(ctx context.Context, year int, res *response.ApiResponseCategoryMonthPrice) {
+func (s *categoryStatsCache) SetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice, res *response.ApiResponseCategoryMonthPrice) {
 	if res == nil {
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
-func (s *categoryStatsCache) GetCachedYearPriceCache(ctx context.Context, year int) (*response.ApiResponseCategoryYearPrice, bool) {
-	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, year)
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearPrice](ctx, s.store, key)
+func (s *categoryStatsCache) GetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice) (*response.ApiResponseCategoryYearPrice, bool) {
+	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, req.Year, req.IncludeSubcategories)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -97,11 +97,11 @@ func (s *categoryStatsCache) GetCachedYearPriceCache(ctx context.Context, year i
 	return result, true
 }
 
-func (s *categoryStatsCache) SetCachedYearPriceCache(ctx context.Context, year int, res *response.ApiResponseCategoryYearPrice) {
+func (s *categoryStatsCache) SetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice, res *response.ApiResponseCategoryYearPrice) {
 	if res == nil {
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
@@ -9,11 +9,11 @@ import (
 )
 
 const (
-	categoryStatsByIdMonthTotalPriceCacheKey = "category:stats:byid:%d:month:%d:year:%d"
-	categoryStatsByIdYearTotalPriceCacheKey  = "category:stats:byid:%d:year:%d"
+	categoryStatsByIdMonthTotalPriceCacheKey = "category:stats:byid:%d:month:%d:year:%d:subcategories:%t"
+	categoryStatsByIdYearTotalPriceCacheKey  = "category:stats:byid:%d:year:%d:subcategories:%t"
 
-	categoryStatsByIdMonthPriceCacheKey = "category:stats:byid:%d:month:%d"
-	categoryStatsByIdYearPriceCacheKey  = "category:stats:byid:%d:year:%d"
+	categoryStatsByIdMonthPriceCacheKey = "category:stats:byid:%d:month:%d:subcategories:%t"
+	categoryStatsByIdYearPriceCacheKey  = "category:stats:byid:%d:year:%d:subcategories:%t"
 )
 
 // ... (definisi cache key dan ttlDefault tetap sama) ...
@@ -27,9 +27,9 @@ func NewCategoryStatsByIdCache(store *cache.CacheStore) *categoryStatsByIdCache
 }
 
 func (s *categoryStatsByIdCache) GetCachedMonthTotalPriceByIdCache(ctx context.Context, req *requests.MonthTotalPriceCategory) (*response.ApiResponseCategoryMonthlyTotalPrice, bool) {
-	key := fmt.Sprintf(categoryStatsByIdMonthTotalPriceCacheKey, req.CategoryID, req.Month, req.Year)
+	key := fmt.Sprintf(categoryStatsByIdMonthTotalPriceCacheKey, req.CategoryID, req.Month, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthlyTotalPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthlyTotalPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -43,14 +43,14 @@ func (s *categoryStatsByIdCache) SetCachedMonthTotalPriceByIdCache(ctx context.C
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByIdMonthTotalPriceCacheKey, req.CategoryID, req.Month, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByIdMonthTotalPriceCacheKey, req.CategoryID, req.Month, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *categoryStatsByIdCache) GetCachedYearTotalPriceByIdCache(ctx context.Context, req *requests.YearTotalPriceCategory) (*response.ApiResponseCategoryYearlyTotalPrice, bool) {
-	key := fmt.Sprintf(categoryStatsByIdYearTotalPriceCacheKey, req.CategoryID, req.Year)
+	key := fmt.Sprintf(categoryStatsByIdYearTotalPriceCacheKey, req.CategoryID, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearlyTotalPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearlyTotalPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -64,14 +64,14 @@ func (s *categoryStatsByIdCache) SetCachedYearTotalPriceByIdCache(ctx context.Co
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByIdYearTotalPriceCacheKey, req.CategoryID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByIdYearTotalPriceCacheKey, req.CategoryID, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *categoryStatsByIdCache) GetCachedMonthPriceByIdCache(ctx context.Context, req *requests.MonthPriceId) (*response.ApiResponseCategoryMonthPrice, bool) {
-	key := fmt.Sprintf(categoryStatsByIdMonthPriceCacheKey, req.CategoryID, req.Year)
+	key := fmt.Sprintf(categoryStatsByIdMonthPriceCacheKey, req.CategoryID, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -85,14 +85,14 @@ func (s *categoryStatsByIdCache) SetCachedMonthPriceByIdCache(ctx context.Contex
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByIdMonthPriceCacheKey, req.CategoryID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByIdMonthPriceCacheKey, req.CategoryID, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *categoryStatsByIdCache) GetCachedYearPriceByIdCache(ctx context.Context, req *requests.YearPriceId) (*response.ApiResponseCategoryYearPrice, bool) {
-	key := fmt.Sprintf(categoryStatsByIdYearPriceCacheKey, req.CategoryID, req.Year)
+	key := fmt.Sprintf(categoryStatsByIdYearPriceCacheKey, req.CategoryID, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearPrice](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -106,6 +106,6 @@ func (s *categoryStatsByIdCache) SetCachedYearPriceByIdCache(ctx context.Context
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByIdYearPriceCacheKey, req.CategoryID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByIdYearPriceCacheKey, req.CategoryID, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag)
 }
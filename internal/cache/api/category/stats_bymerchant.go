@@ -12,8 +12,8 @@ const (
 	categoryStatsByMerchantMonthTotalPriceCacheKey = "category:stats:bymerchant:%d:month:%d:year:%d"
 	categoryStatsByMerchantYearTotalPriceCacheKey  = "category:stats:bymerchant:%d:year:%d"
 
-	categoryStatsByMerchantMonthPriceCacheKey = "category:stats:bymerchant:%d:month:%d"
-	categoryStatsByMerchantYearPriceCacheKey  = "category:stats:bymerchant:%d:year:%d"
+	categoryStatsByMerchantMonthPriceCacheKey = "category:stats:bymerchant:%d:month:%d:subcategories:%t"
+	categoryStatsByMerchantYearPriceCacheKey  = "category:stats:bymerchant:%d:year:%d:subcategories:%t"
 )
 
 type categoryStatsByMerchantCache struct {
@@ -27,7 +27,7 @@ func NewCategoryStatsByMerchantCache(store *cache.CacheStore) *categoryStatsByMe
 func (s *categoryStatsByMerchantCache) GetCachedMonthTotalPriceByMerchantCache(ctx context.Context, req *requests.MonthTotalPriceMerchant) (*response.ApiResponseCategoryMonthlyTotalPrice, bool) {
 	key := fmt.Sprintf(categoryStatsByMerchantMonthTotalPriceCacheKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthlyTotalPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthlyTotalPrice](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -43,13 +43,13 @@ func (s *categoryStatsByMerchantCache) SetCachedMonthTotalPriceByMerchantCache(c
 
 	key := fmt.Sprintf(categoryStatsByMerchantMonthTotalPriceCacheKey, req.MerchantID, req.Month, req.Year)
 
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *categoryStatsByMerchantCache) GetCachedYearTotalPriceByMerchantCache(ctx context.Context, req *requests.YearTotalPriceMerchant) (*response.ApiResponseCategoryYearlyTotalPrice, bool) {
 	key := fmt.Sprintf(categoryStatsByMerchantYearTotalPriceCacheKey, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearlyTotalPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearlyTotalPrice](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -64,13 +64,13 @@ func (s *categoryStatsByMerchantCache) SetCachedYearTotalPriceByMerchantCache(ct
 	}
 
 	key := fmt.Sprintf(categoryStatsByMerchantYearTotalPriceCacheKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *categoryStatsByMerchantCache) GetCachedMonthPriceByMerchantCache(ctx context.Context, req *requests.MonthPriceMerchant) (*response.ApiResponseCategoryMonthPrice, bool) {
-	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year)
+	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryMonthPrice](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -84,14 +84,14 @@ func (s *categoryStatsByMerchantCache) SetCachedMonthPriceByMerchantCache(ctx co
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *categoryStatsByMerchantCache) GetCachedYearPriceByMerchantCache(ctx context.Context, req *requests.YearPriceMerchant) (*response.ApiResponseCategoryYearPrice, bool) {
-	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year)
+	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearPrice](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseCategoryYearPrice](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -105,6 +105,6 @@ func (s *categoryStatsByMerchantCache) SetCachedYearPriceByMerchantCache(ctx con
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
@@ -1,6 +1,9 @@
 package merchant_cache
 
-import "pointofsale/internal/cache"
+import (
+	"pointofsale/internal/cache"
+	"time"
+)
 
 type MerchantMenCache interface {
 	MerchantQueryCache
@@ -12,7 +15,12 @@ type merchantMencache struct {
 	MerchantCommandCache
 }
 
+var localTier = cache.LocalTierOptions{MaxEntries: 500, TTL: time.Minute}
+
 func NewMerchantMencache(store *cache.CacheStore) MerchantMenCache {
+	store.RegisterNamespace("merchant", cache.NamespaceOptions{Tenant: true})
+	store.UseLocalTier("merchant", localTier)
+
 	return &merchantMencache{
 		MerchantQueryCache:   NewMerchantQueryCache(store),
 		MerchantCommandCache: NewMerchantCommandCache(store),
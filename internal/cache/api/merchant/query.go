@@ -19,6 +19,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("merchant")
+
 type merchantQueryCache struct {
 	store *cache.CacheStore
 }
@@ -30,7 +32,7 @@ func NewMerchantQueryCache(store *cache.CacheStore) *merchantQueryCache {
 func (m *merchantQueryCache) GetCachedMerchants(ctx context.Context, req *requests.FindAllMerchants) (*response.ApiResponsePaginationMerchant, bool) {
 	key := fmt.Sprintf(merchantAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationMerchant](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationMerchant](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -46,13 +48,13 @@ func (m *merchantQueryCache) SetCachedMerchants(ctx context.Context, req *reques
 
 	key := fmt.Sprintf(merchantAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchantActive(ctx context.Context, req *requests.FindAllMerchants) (*response.ApiResponsePaginationMerchantDeleteAt, bool) {
 	key := fmt.Sprintf(merchantActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationMerchantDeleteAt](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationMerchantDeleteAt](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -67,13 +69,13 @@ func (m *merchantQueryCache) SetCachedMerchantActive(ctx context.Context, req *r
 	}
 
 	key := fmt.Sprintf(merchantActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchantTrashed(ctx context.Context, req *requests.FindAllMerchants) (*response.ApiResponsePaginationMerchantDeleteAt, bool) {
 	key := fmt.Sprintf(merchantTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationMerchantDeleteAt](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationMerchantDeleteAt](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -88,13 +90,13 @@ func (m *merchantQueryCache) SetCachedMerchantTrashed(ctx context.Context, req *
 	}
 
 	key := fmt.Sprintf(merchantTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchant(ctx context.Context, id int) (*response.ApiResponseMerchant, bool) {
 	key := fmt.Sprintf(merchantByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*response.ApiResponseMerchant](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseMerchant](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -109,13 +111,13 @@ func (m *merchantQueryCache) SetCachedMerchant(ctx context.Context, res *respons
 	}
 
 	key := fmt.Sprintf(merchantByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchantsByUserId(ctx context.Context, id int) (*response.ApiResponsesMerchant, bool) {
 	key := fmt.Sprintf(merchantByUserIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesMerchant](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesMerchant](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -130,5 +132,5 @@ func (m *merchantQueryCache) SetCachedMerchantsByUserId(ctx context.Context, use
 	}
 
 	key := fmt.Sprintf(merchantByUserIdCacheKey, userId)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
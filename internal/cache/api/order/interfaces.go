@@ -53,4 +53,5 @@ type OrderQueryCache interface {
 
 type OrderCommandCache interface {
 	DeleteOrderCache(ctx context.Context, id int)
+	InvalidateOrderCache(ctx context.Context)
 }
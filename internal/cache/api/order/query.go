@@ -10,15 +10,22 @@ import (
 )
 
 const (
-	orderAllCacheKey      = "order:all:page:%d:pageSize:%d:search:%s"
+	orderAllCacheKey      = "order:all:page:%d:pageSize:%d:search:%s:status:%s"
 	orderByIdCacheKey     = "order:id:%d"
-	orderActiveCacheKey   = "order:active:page:%d:pageSize:%d:search:%s"
-	orderTrashedCacheKey  = "order:trashed:page:%d:pageSize:%d:search:%s"
-	orderMerchantCacheKey = "order:merchant:%d:page:%d:pageSize:%d:search:%s"
+	orderActiveCacheKey   = "order:active:page:%d:pageSize:%d:search:%s:status:%s"
+	orderTrashedCacheKey  = "order:trashed:page:%d:pageSize:%d:search:%s:status:%s"
+	orderMerchantCacheKey = "order:merchant:%d:page:%d:pageSize:%d:search:%s:status:%s"
 
 	ttlDefault = 5 * time.Minute
 )
 
+var (
+	entityTag = cache.EntityTag("order")
+
+	// Writes move product stock, so they also make cached products stale.
+	stockTag = cache.EntityTag("product")
+)
+
 type orderQueryCache struct {
 	store *cache.CacheStore
 }
@@ -28,9 +35,9 @@ func NewOrderQueryCache(store *cache.CacheStore) *orderQueryCache {
 }
 
 func (s *orderQueryCache) GetOrderAllCache(ctx context.Context, req *requests.FindAllOrders) (*response.ApiResponsePaginationOrder, bool) {
-	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrder](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrder](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -44,14 +51,14 @@ func (s *orderQueryCache) SetOrderAllCache(ctx context.Context, req *requests.Fi
 		return
 	}
 
-	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search, req.Status)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *orderQueryCache) GetCachedOrderCache(ctx context.Context, orderID int) (*response.ApiResponseOrder, bool) {
 	key := fmt.Sprintf(orderByIdCacheKey, orderID)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrder](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrder](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -66,13 +73,13 @@ func (s *orderQueryCache) SetCachedOrderCache(ctx context.Context, res *response
 	}
 
 	key := fmt.Sprintf(orderByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *orderQueryCache) GetCachedOrderMerchant(ctx context.Context, req *requests.FindAllOrderMerchant) (*response.ApiResponsePaginationOrder, bool) {
-	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrder](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrder](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -86,14 +93,14 @@ func (s *orderQueryCache) SetCachedOrderMerchant(ctx context.Context, req *reque
 		return
 	}
 
-	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.Status)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *orderQueryCache) GetOrderActiveCache(ctx context.Context, req *requests.FindAllOrders) (*response.ApiResponsePaginationOrderDeleteAt, bool) {
-	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -107,14 +114,14 @@ func (s *orderQueryCache) SetOrderActiveCache(ctx context.Context, req *requests
 		return
 	}
 
-	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search, req.Status)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *orderQueryCache) GetOrderTrashedCache(ctx context.Context, req *requests.FindAllOrders) (*response.ApiResponsePaginationOrderDeleteAt, bool) {
-	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -128,6 +135,6 @@ func (s *orderQueryCache) SetOrderTrashedCache(ctx context.Context, req *request
 		return
 	}
 
-	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search, req.Status)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
@@ -27,7 +27,7 @@ func NewOrderStatsCache(store *cache.CacheStore) *orderStatsCache {
 func (s *orderStatsCache) GetMonthlyTotalRevenueCache(ctx context.Context, req *requests.MonthTotalRevenue) (*response.ApiResponseOrderMonthlyTotalRevenue, bool) {
 	key := fmt.Sprintf(monthlyTotalRevenueCacheKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthlyTotalRevenue](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthlyTotalRevenue](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -43,13 +43,13 @@ func (s *orderStatsCache) SetMonthlyTotalRevenueCache(ctx context.Context, req *
 
 	key := fmt.Sprintf(monthlyTotalRevenueCacheKey, req.Month, req.Year)
 	// Langsung simpan objek ApiResponse
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *orderStatsCache) GetYearlyTotalRevenueCache(ctx context.Context, year int) (*response.ApiResponseOrderYearlyTotalRevenue, bool) {
 	key := fmt.Sprintf(yearlyTotalRevenueCacheKey, year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderYearlyTotalRevenue](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderYearlyTotalRevenue](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -64,13 +64,13 @@ func (s *orderStatsCache) SetYearlyTotalRevenueCache(ctx context.Context, year i
 	}
 
 	key := fmt.Sprintf(yearlyTotalRevenueCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *orderStatsCache) GetMonthlyOrderCache(ctx context.Context, year int) (*response.ApiResponseOrderMonthly, bool) {
 	key := fmt.Sprintf(monthlyOrderCacheKey, year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthly](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthly](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -85,13 +85,13 @@ func (s *orderStatsCache) SetMonthlyOrderCache(ctx context.Context, year int, re
 	}
 
 	key := fmt.Sprintf(monthlyOrderCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *orderStatsCache) GetYearlyOrderCache(ctx context.Context, year int) (*response.ApiResponseOrderYearly, bool) {
 	key := fmt.Sprintf(yearlyOrderCacheKey, year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderYearly](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderYearly](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -106,5 +106,5 @@ func (s *orderStatsCache) SetYearlyOrderCache(ctx context.Context, year int, res
 	}
 
 	key := fmt.Sprintf(yearlyOrderCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
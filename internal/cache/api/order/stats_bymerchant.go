@@ -27,7 +27,7 @@ func NewOrderStatsByMerchantCache(store *cache.CacheStore) *orderStatsByMerchant
 func (s *orderStatsByMerchantCache) GetMonthlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.MonthTotalRevenueMerchant) (*response.ApiResponseOrderMonthlyTotalRevenue, bool) {
 	key := fmt.Sprintf(monthlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthlyTotalRevenue](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthlyTotalRevenue](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -42,13 +42,13 @@ func (s *orderStatsByMerchantCache) SetMonthlyTotalRevenueByMerchantCache(ctx co
 	}
 
 	key := fmt.Sprintf(monthlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Month, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *orderStatsByMerchantCache) GetYearlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.YearTotalRevenueMerchant) (*response.ApiResponseOrderYearlyTotalRevenue, bool) {
 	key := fmt.Sprintf(yearlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderYearlyTotalRevenue](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderYearlyTotalRevenue](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -63,13 +63,13 @@ func (s *orderStatsByMerchantCache) SetYearlyTotalRevenueByMerchantCache(ctx con
 	}
 
 	key := fmt.Sprintf(yearlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *orderStatsByMerchantCache) GetMonthlyOrderByMerchantCache(ctx context.Context, req *requests.MonthOrderMerchant) (*response.ApiResponseOrderMonthly, bool) {
 	key := fmt.Sprintf(monthlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthly](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderMonthly](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -84,13 +84,13 @@ func (s *orderStatsByMerchantCache) SetMonthlyOrderByMerchantCache(ctx context.C
 	}
 
 	key := fmt.Sprintf(monthlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *orderStatsByMerchantCache) GetYearlyOrderByMerchantCache(ctx context.Context, req *requests.YearOrderMerchant) (*response.ApiResponseOrderYearly, bool) {
 	key := fmt.Sprintf(yearlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponseOrderYearly](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseOrderYearly](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -105,5 +105,5 @@ func (s *orderStatsByMerchantCache) SetYearlyOrderByMerchantCache(ctx context.Co
 	}
 
 	key := fmt.Sprintf(yearlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
@@ -19,6 +19,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("order")
+
 type orderItemQueryCache struct {
 	store *cache.CacheStore
 }
@@ -30,7 +32,7 @@ func NewOrderItemQueryCache(store *cache.CacheStore) *orderItemQueryCache {
 func (o *orderItemQueryCache) GetCachedOrderItemsAll(ctx context.Context, req *requests.FindAllOrderItems) (*response.ApiResponsePaginationOrderItem, bool) {
 	key := fmt.Sprintf(orderItemAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderItem](ctx, o.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderItem](ctx, o.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -46,13 +48,13 @@ func (o *orderItemQueryCache) SetCachedOrderItemsAll(ctx context.Context, req *r
 
 	key := fmt.Sprintf(orderItemAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	cache.SetToCache(ctx, o.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, res, ttlDefault, entityTag)
 }
 
 func (o *orderItemQueryCache) GetCachedOrderItemActive(ctx context.Context, req *requests.FindAllOrderItems) (*response.ApiResponsePaginationOrderItemDeleteAt, bool) {
 	key := fmt.Sprintf(orderItemActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderItemDeleteAt](ctx, o.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderItemDeleteAt](ctx, o.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -67,12 +69,12 @@ func (o *orderItemQueryCache) SetCachedOrderItemActive(ctx context.Context, req
 	}
 
 	key := fmt.Sprintf(orderItemActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, o.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, res, ttlDefault, entityTag)
 }
 
 func (o *orderItemQueryCache) GetCachedOrderItemTrashed(ctx context.Context, req *requests.FindAllOrderItems) (*response.ApiResponsePaginationOrderItemDeleteAt, bool) {
 	key := fmt.Sprintf(orderItemTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderItemDeleteAt](ctx, o.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationOrderItemDeleteAt](ctx, o.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -87,12 +89,12 @@ func (o *orderItemQueryCache) SetCachedOrderItemTrashed(ctx context.Context, req
 	}
 
 	key := fmt.Sprintf(orderItemTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, o.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, res, ttlDefault, entityTag)
 }
 
 func (o *orderItemQueryCache) GetCachedOrderItems(ctx context.Context, orderID int) (*response.ApiResponsesOrderItem, bool) {
 	key := fmt.Sprintf(orderItemByIdCacheKey, orderID)
-	result, found := cache.GetFromCache[*response.ApiResponsesOrderItem](ctx, o.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesOrderItem](ctx, o.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -107,5 +109,5 @@ func (o *orderItemQueryCache) SetCachedOrderItems(ctx context.Context, res *resp
 	}
 
 	key := fmt.Sprintf(orderItemByIdCacheKey, res.Data[0].OrderID)
-	cache.SetToCache(ctx, o.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, res, ttlDefault, entityTag)
 }
@@ -16,4 +16,9 @@ func NewProductCommandCache(store *cache.CacheStore) *productCommandCache {
 
 func (c *productCommandCache) DeleteCachedProduct(ctx context.Context, productID int) {
 	cache.DeleteFromCache(ctx, c.store, fmt.Sprintf(productByIdCacheKey, productID))
+	c.InvalidateProductCache(ctx)
+}
+
+func (c *productCommandCache) InvalidateProductCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, c.store, entityTag)
 }
@@ -28,4 +28,5 @@ type ProductQueryCache interface {
 
 type ProductCommandCache interface {
 	DeleteCachedProduct(ctx context.Context, productID int)
+	InvalidateProductCache(ctx context.Context)
 }
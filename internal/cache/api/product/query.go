@@ -11,8 +11,8 @@ import (
 
 const (
 	productAllCacheKey      = "product:all:page:%d:pageSize:%d:search:%s"
-	productCategoryCacheKey = "product:category:%s:page:%d:pageSize:%d:search:%s"
-	productMerchantCacheKey = "product:merchant:%d:page:%d:pageSize:%d:search:%s"
+	productCategoryCacheKey = "product:category:%s:page:%d:pageSize:%d:search:%s:minPrice:%d:maxPrice:%d:subcategories:%t"
+	productMerchantCacheKey = "product:merchant:%d:page:%d:pageSize:%d:search:%s:category:%d:minPrice:%d:maxPrice:%d"
 
 	productActiveCacheKey  = "product:active:page:%d:pageSize:%d:search:%s"
 	productTrashedCacheKey = "product:trashed:page:%d:pageSize:%d:search:%s"
@@ -21,6 +21,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("product")
+
 type productQueryCache struct {
 	store *cache.CacheStore
 }
@@ -32,7 +34,7 @@ func NewProductQueryCache(store *cache.CacheStore) *productQueryCache {
 func (p *productQueryCache) GetCachedProducts(ctx context.Context, req *requests.FindAllProducts) (*response.ApiResponsePaginationProduct, bool) {
 	key := fmt.Sprintf(productAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationProduct](ctx, p.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationProduct](ctx, p.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -47,13 +49,13 @@ func (p *productQueryCache) SetCachedProducts(ctx context.Context, req *requests
 
 	key := fmt.Sprintf(productAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	cache.SetToCache(ctx, p.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, res, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProductsByMerchant(ctx context.Context, req *requests.ProductByMerchantRequest) (*response.ApiResponsePaginationProduct, bool) {
-	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.CategoryID, req.MinPrice, req.MaxPrice)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationProduct](ctx, p.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationProduct](ctx, p.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -67,14 +69,14 @@ func (p *productQueryCache) SetCachedProductsByMerchant(ctx context.Context, req
 		return
 	}
 
-	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, p.store, key, res, ttlDefault)
+	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.CategoryID, req.MinPrice, req.MaxPrice)
+	cache.SetToCache(ctx, p.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (p *productQueryCache) GetCachedProductsByCategory(ctx context.Context, req *requests.ProductByCategoryRequest) (*response.ApiResponsePaginationProduct, bool) {
-	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search, req.MinPrice, req.MaxPrice, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationProduct](ctx, p.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationProduct](ctx, p.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -88,14 +90,14 @@ func (p *productQueryCache) SetCachedProductsByCategory(ctx context.Context, req
 		return
 	}
 
-	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, p.store, key, res, ttlDefault)
+	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search, req.MinPrice, req.MaxPrice, req.IncludeSubcategories)
+	cache.SetToCache(ctx, p.store, key, res, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProductActive(ctx context.Context, req *requests.FindAllProducts) (*response.ApiResponsePaginationProductDeleteAt, bool) {
 	key := fmt.Sprintf(productActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationProductDeleteAt](ctx, p.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationProductDeleteAt](ctx, p.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -110,13 +112,13 @@ func (p *productQueryCache) SetCachedProductActive(ctx context.Context, req *req
 	}
 
 	key := fmt.Sprintf(productActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, p.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, res, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProductTrashed(ctx context.Context, req *requests.FindAllProducts) (*response.ApiResponsePaginationProductDeleteAt, bool) {
 	key := fmt.Sprintf(productTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationProductDeleteAt](ctx, p.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationProductDeleteAt](ctx, p.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -131,13 +133,13 @@ func (p *productQueryCache) SetCachedProductTrashed(ctx context.Context, req *re
 	}
 
 	key := fmt.Sprintf(productTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, p.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, res, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProduct(ctx context.Context, productID int) (*response.ApiResponseProduct, bool) {
 	key := fmt.Sprintf(productByIdCacheKey, productID)
 
-	result, found := cache.GetFromCache[*response.ApiResponseProduct](ctx, p.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseProduct](ctx, p.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -152,5 +154,5 @@ func (p *productQueryCache) SetCachedProduct(ctx context.Context, res *response.
 	}
 
 	key := fmt.Sprintf(productByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, p.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, res, ttlDefault, entityTag)
 }
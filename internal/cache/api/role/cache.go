@@ -1,6 +1,9 @@
 package role_cache
 
-import "pointofsale/internal/cache"
+import (
+	"pointofsale/internal/cache"
+	"time"
+)
 
 type RoleMencache interface {
 	RoleQueryCache
@@ -12,7 +15,11 @@ type roleMencache struct {
 	RoleCommandCache
 }
 
+var localTier = cache.LocalTierOptions{MaxEntries: 200, TTL: 2 * time.Minute}
+
 func NewRoleMencache(store *cache.CacheStore) RoleMencache {
+	store.UseLocalTier("role", localTier)
+
 	return &roleMencache{
 		RoleQueryCache:   NewRoleQueryCache(store),
 		RoleCommandCache: NewRoleCommandCache(store),
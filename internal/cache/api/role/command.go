@@ -18,4 +18,9 @@ func (s *roleCommandCache) DeleteCachedRole(ctx context.Context, id int) {
 	key := fmt.Sprintf(roleByIdCacheKey, id)
 
 	cache.DeleteFromCache(ctx, s.store, key)
+	s.InvalidateRoleCache(ctx)
+}
+
+func (s *roleCommandCache) InvalidateRoleCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, s.store, entityTag)
 }
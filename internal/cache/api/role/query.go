@@ -19,6 +19,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("role")
+
 type roleQueryCache struct {
 	store *cache.CacheStore
 }
@@ -34,7 +36,7 @@ func (m *roleQueryCache) SetCachedRoles(ctx context.Context, req *requests.FindA
 
 	key := fmt.Sprintf(roleAllCacheKey, req.Page, req.PageSize, req.Search)
 	// Langsung simpan objek ApiResponse
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleById(ctx context.Context, res *response.ApiResponseRole) {
@@ -44,7 +46,7 @@ func (m *roleQueryCache) SetCachedRoleById(ctx context.Context, res *response.Ap
 
 	// Asumsi: res.Data memiliki field ID untuk membuat kunci cache
 	key := fmt.Sprintf(roleByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleByUserId(ctx context.Context, userId int, res *response.ApiResponsesRole) {
@@ -53,7 +55,7 @@ func (m *roleQueryCache) SetCachedRoleByUserId(ctx context.Context, userId int,
 	}
 
 	key := fmt.Sprintf(roleByUserIdCacheKey, userId)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleActive(ctx context.Context, req *requests.FindAllRoles, res *response.ApiResponsePaginationRoleDeleteAt) {
@@ -62,7 +64,7 @@ func (m *roleQueryCache) SetCachedRoleActive(ctx context.Context, req *requests.
 	}
 
 	key := fmt.Sprintf(roleActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleTrashed(ctx context.Context, req *requests.FindAllRoles, res *response.ApiResponsePaginationRoleDeleteAt) {
@@ -71,13 +73,13 @@ func (m *roleQueryCache) SetCachedRoleTrashed(ctx context.Context, req *requests
 	}
 
 	key := fmt.Sprintf(roleTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, m.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, res, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) GetCachedRoles(ctx context.Context, req *requests.FindAllRoles) (*response.ApiResponsePaginationRole, bool) {
 	key := fmt.Sprintf(roleAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationRole](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationRole](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -89,7 +91,7 @@ func (m *roleQueryCache) GetCachedRoles(ctx context.Context, req *requests.FindA
 func (m *roleQueryCache) GetCachedRoleById(ctx context.Context, id int) (*response.ApiResponseRole, bool) {
 	key := fmt.Sprintf(roleByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*response.ApiResponseRole](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseRole](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -101,7 +103,7 @@ func (m *roleQueryCache) GetCachedRoleById(ctx context.Context, id int) (*respon
 func (m *roleQueryCache) GetCachedRoleByUserId(ctx context.Context, userId int) (*response.ApiResponsesRole, bool) {
 	key := fmt.Sprintf(roleByUserIdCacheKey, userId)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesRole](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesRole](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -113,7 +115,7 @@ func (m *roleQueryCache) GetCachedRoleByUserId(ctx context.Context, userId int)
 func (m *roleQueryCache) GetCachedRoleActive(ctx context.Context, req *requests.FindAllRoles) (*response.ApiResponsePaginationRoleDeleteAt, bool) {
 	key := fmt.Sprintf(roleActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationRoleDeleteAt](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationRoleDeleteAt](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -125,7 +127,7 @@ func (m *roleQueryCache) GetCachedRoleActive(ctx context.Context, req *requests.
 func (m *roleQueryCache) GetCachedRoleTrashed(ctx context.Context, req *requests.FindAllRoles) (*response.ApiResponsePaginationRoleDeleteAt, bool) {
 	key := fmt.Sprintf(roleTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationRoleDeleteAt](ctx, m.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationRoleDeleteAt](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
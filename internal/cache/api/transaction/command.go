@@ -18,4 +18,9 @@ func (t *transactionCommandCache) DeleteTransactionCache(ctx context.Context, tr
 	key := fmt.Sprintf(transactionByIdCacheKey, transactionID)
 
 	cache.DeleteFromCache(ctx, t.store, key)
+	t.InvalidateTransactionCache(ctx)
+}
+
+func (t *transactionCommandCache) InvalidateTransactionCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, t.store, entityTag, stockTag, orderTag)
 }
@@ -23,6 +23,17 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var (
+	entityTag = cache.EntityTag("transaction")
+
+	// Writes move product stock, so they also make cached products stale.
+	stockTag = cache.EntityTag("product")
+
+	// Writes settle, refund or void the order they belong to, so cached
+	// orders are stale as well.
+	orderTag = cache.EntityTag("order")
+)
+
 type transactionQueryCache struct {
 	store *cache.CacheStore
 }
@@ -34,7 +45,7 @@ func NewTransactionQueryCache(store *cache.CacheStore) *transactionQueryCache {
 func (t *transactionQueryCache) GetCachedTransactionsCache(ctx context.Context, req *requests.FindAllTransaction) (*response.ApiResponsePaginationTransaction, bool) {
 	key := fmt.Sprintf(transactionAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransaction](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransaction](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -50,13 +61,13 @@ func (t *transactionQueryCache) SetCachedTransactionsCache(ctx context.Context,
 
 	key := fmt.Sprintf(transactionAllCacheKey, req.Page, req.PageSize, req.Search)
 	// Langsung simpan objek ApiResponse
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionByMerchant(ctx context.Context, req *requests.FindAllTransactionByMerchant) (*response.ApiResponsePaginationTransaction, bool) {
 	key := fmt.Sprintf(transactionByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransaction](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransaction](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -71,13 +82,13 @@ func (t *transactionQueryCache) SetCachedTransactionByMerchant(ctx context.Conte
 	}
 
 	key := fmt.Sprintf(transactionByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionQueryCache) GetCachedTransactionActiveCache(ctx context.Context, req *requests.FindAllTransaction) (*response.ApiResponsePaginationTransactionDeleteAt, bool) {
 	key := fmt.Sprintf(transactionActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransactionDeleteAt](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransactionDeleteAt](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -92,13 +103,13 @@ func (t *transactionQueryCache) SetCachedTransactionActiveCache(ctx context.Cont
 	}
 
 	key := fmt.Sprintf(transactionActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionTrashedCache(ctx context.Context, req *requests.FindAllTransaction) (*response.ApiResponsePaginationTransactionDeleteAt, bool) {
 	key := fmt.Sprintf(transactionTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransactionDeleteAt](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationTransactionDeleteAt](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -113,13 +124,13 @@ func (t *transactionQueryCache) SetCachedTransactionTrashedCache(ctx context.Con
 	}
 
 	key := fmt.Sprintf(transactionTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionCache(ctx context.Context, id int) (*response.ApiResponseTransaction, bool) {
 	key := fmt.Sprintf(transactionByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*response.ApiResponseTransaction](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseTransaction](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -134,13 +145,13 @@ func (t *transactionQueryCache) SetCachedTransactionCache(ctx context.Context, r
 	}
 
 	key := fmt.Sprintf(transactionByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionByOrderId(ctx context.Context, orderID int) (*response.ApiResponseTransaction, bool) {
 	key := fmt.Sprintf(transactionByOrderCacheKey, orderID)
 
-	result, found := cache.GetFromCache[*response.ApiResponseTransaction](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseTransaction](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -155,5 +166,5 @@ func (t *transactionQueryCache) SetCachedTransactionByOrderId(ctx context.Contex
 	}
 
 	key := fmt.Sprintf(transactionByOrderCacheKey, orderID)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
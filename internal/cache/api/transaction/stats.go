@@ -33,7 +33,7 @@ func NewTransactionStatsCache(store *cache.CacheStore) *transactionStatsCache {
 func (t *transactionStatsCache) GetCachedMonthAmountSuccessCached(ctx context.Context, req *requests.MonthAmountTransaction) (*response.ApiResponsesTransactionMonthSuccess, bool) {
 	key := fmt.Sprintf(transactionMonthAmountSuccessKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthSuccess](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthSuccess](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -49,13 +49,13 @@ func (t *transactionStatsCache) SetCachedMonthAmountSuccessCached(ctx context.Co
 
 	key := fmt.Sprintf(transactionMonthAmountSuccessKey, req.Month, req.Year)
 
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionStatsCache) GetCachedYearAmountSuccessCached(ctx context.Context, year int) (*response.ApiResponsesTransactionYearSuccess, bool) {
 	key := fmt.Sprintf(transactionYearAmountSuccessKey, year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearSuccess](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearSuccess](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -70,13 +70,13 @@ func (t *transactionStatsCache) SetCachedYearAmountSuccessCached(ctx context.Con
 	}
 
 	key := fmt.Sprintf(transactionYearAmountSuccessKey, year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionStatsCache) GetCachedMonthAmountFailedCached(ctx context.Context, req *requests.MonthAmountTransaction) (*response.ApiResponsesTransactionMonthFailed, bool) {
 	key := fmt.Sprintf(transactionMonthAmountFailedKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthFailed](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthFailed](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -91,13 +91,13 @@ func (t *transactionStatsCache) SetCachedMonthAmountFailedCached(ctx context.Con
 	}
 
 	key := fmt.Sprintf(transactionMonthAmountFailedKey, req.Month, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionStatsCache) GetCachedYearAmountFailedCached(ctx context.Context, year int) (*response.ApiResponsesTransactionYearFailed, bool) {
 	key := fmt.Sprintf(transactionYearAmountFailedKey, year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearFailed](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearFailed](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -112,13 +112,13 @@ func (t *transactionStatsCache) SetCachedYearAmountFailedCached(ctx context.Cont
 	}
 
 	key := fmt.Sprintf(transactionYearAmountFailedKey, year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionStatsCache) GetCachedMonthMethodSuccessCached(ctx context.Context, req *requests.MonthMethodTransaction) (*response.ApiResponsesTransactionMonthMethod, bool) {
 	key := fmt.Sprintf(transactionMonthMethodSuccessKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -133,13 +133,13 @@ func (t *transactionStatsCache) SetCachedMonthMethodSuccessCached(ctx context.Co
 	}
 
 	key := fmt.Sprintf(transactionMonthMethodSuccessKey, req.Month, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionStatsCache) GetCachedYearMethodSuccessCached(ctx context.Context, year int) (*response.ApiResponsesTransactionYearMethod, bool) {
 	key := fmt.Sprintf(transactionYearMethodSuccessKey, year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -154,13 +154,13 @@ func (t *transactionStatsCache) SetCachedYearMethodSuccessCached(ctx context.Con
 	}
 
 	key := fmt.Sprintf(transactionYearMethodSuccessKey, year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionStatsCache) GetCachedMonthMethodFailedCached(ctx context.Context, req *requests.MonthMethodTransaction) (*response.ApiResponsesTransactionMonthMethod, bool) {
 	key := fmt.Sprintf(transactionMonthMethodFailedKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -175,13 +175,13 @@ func (t *transactionStatsCache) SetCachedMonthMethodFailedCached(ctx context.Con
 	}
 
 	key := fmt.Sprintf(transactionMonthMethodFailedKey, req.Month, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
 
 func (t *transactionStatsCache) GetCachedYearMethodFailedCached(ctx context.Context, year int) (*response.ApiResponsesTransactionYearMethod, bool) {
 	key := fmt.Sprintf(transactionYearMethodFailedKey, year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -196,5 +196,5 @@ func (t *transactionStatsCache) SetCachedYearMethodFailedCached(ctx context.Cont
 	}
 
 	key := fmt.Sprintf(transactionYearMethodFailedKey, year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag)
 }
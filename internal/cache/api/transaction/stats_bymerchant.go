@@ -33,7 +33,7 @@ func NewTransactionStatsByMerchantCache(store *cache.CacheStore) *transactionSta
 func (t *transactionStatsByMerchantCache) GetCachedMonthAmountSuccessByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant) (*response.ApiResponsesTransactionMonthSuccess, bool) {
 	key := fmt.Sprintf(transactonMonthAmountSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthSuccess](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthSuccess](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -49,13 +49,13 @@ func (t *transactionStatsByMerchantCache) SetCachedMonthAmountSuccessByMerchantC
 
 	key := fmt.Sprintf(transactonMonthAmountSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
 	// Langsung simpan objek ApiResponse
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionStatsByMerchantCache) GetCachedMonthAmountFailedByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant) (*response.ApiResponsesTransactionMonthFailed, bool) {
 	key := fmt.Sprintf(transactonMonthAmountFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthFailed](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthFailed](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -70,13 +70,13 @@ func (t *transactionStatsByMerchantCache) SetCachedMonthAmountFailedByMerchantCa
 	}
 
 	key := fmt.Sprintf(transactonMonthAmountFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionStatsByMerchantCache) GetCachedYearAmountFailedByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant) (*response.ApiResponsesTransactionYearFailed, bool) {
 	key := fmt.Sprintf(transactonYearAmountFailedByMerchantKey, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearFailed](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearFailed](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -91,13 +91,13 @@ func (t *transactionStatsByMerchantCache) SetCachedYearAmountFailedByMerchantCac
 	}
 
 	key := fmt.Sprintf(transactonYearAmountFailedByMerchantKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionStatsByMerchantCache) GetCachedYearAmountSuccessByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant) (*response.ApiResponsesTransactionYearSuccess, bool) {
 	key := fmt.Sprintf(transactonYearAmountSuccessByMerchantKey, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearSuccess](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearSuccess](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -112,13 +112,13 @@ func (t *transactionStatsByMerchantCache) SetCachedYearAmountSuccessByMerchantCa
 	}
 
 	key := fmt.Sprintf(transactonYearAmountSuccessByMerchantKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionStatsByMerchantCache) GetCachedMonthMethodSuccessByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant) (*response.ApiResponsesTransactionMonthMethod, bool) {
 	key := fmt.Sprintf(transactonMonthMethodSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -133,13 +133,13 @@ func (t *transactionStatsByMerchantCache) SetCachedMonthMethodSuccessByMerchantC
 	}
 
 	key := fmt.Sprintf(transactonMonthMethodSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionStatsByMerchantCache) GetCachedYearMethodSuccessByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant) (*response.ApiResponsesTransactionYearMethod, bool) {
 	key := fmt.Sprintf(transactonYearMethodSuccessByMerchantKey, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -154,13 +154,13 @@ func (t *transactionStatsByMerchantCache) SetCachedYearMethodSuccessByMerchantCa
 	}
 
 	key := fmt.Sprintf(transactonYearMethodSuccessByMerchantKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionStatsByMerchantCache) GetCachedMonthMethodFailedByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant) (*response.ApiResponsesTransactionMonthMethod, bool) {
 	key := fmt.Sprintf(transactonMonthMethodFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionMonthMethod](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -175,13 +175,13 @@ func (t *transactionStatsByMerchantCache) SetCachedMonthMethodFailedByMerchantCa
 	}
 
 	key := fmt.Sprintf(transactonMonthMethodFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionStatsByMerchantCache) GetCachedYearMethodFailedByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant) (*response.ApiResponsesTransactionYearMethod, bool) {
 	key := fmt.Sprintf(transactonYearMethodFailedByMerchantKey, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsesTransactionYearMethod](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -196,5 +196,5 @@ func (t *transactionStatsByMerchantCache) SetCachedYearMethodFailedByMerchantCac
 	}
 
 	key := fmt.Sprintf(transactonYearMethodFailedByMerchantKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, t.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, res, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
@@ -13,6 +13,8 @@ type userMencache struct {
 }
 
 func NewUserMencache(cacheStore *cache.CacheStore) UserMencache {
+	cacheStore.RegisterNamespace("user", cache.NamespaceOptions{})
+
 	return &userMencache{
 		UserQueryCache:   NewUserQueryCache(cacheStore),
 		UserCommandCache: NewUserCommandCache(cacheStore),
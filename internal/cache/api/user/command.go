@@ -18,4 +18,9 @@ func (u *userCommandCache) DeleteUserCache(ctx context.Context, id int) {
 	key := fmt.Sprintf(userByIdCacheKey, id)
 
 	cache.DeleteFromCache(ctx, u.store, key)
+	u.InvalidateUserCache(ctx)
+}
+
+func (u *userCommandCache) InvalidateUserCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, u.store, entityTag)
 }
@@ -22,4 +22,5 @@ type UserQueryCache interface {
 
 type UserCommandCache interface {
 	DeleteUserCache(ctx context.Context, id int)
+	InvalidateUserCache(ctx context.Context)
 }
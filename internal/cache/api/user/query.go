@@ -18,6 +18,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("user")
+
 type userQueryCache struct {
 	store *cache.CacheStore
 }
@@ -29,7 +31,7 @@ func NewUserQueryCache(store *cache.CacheStore) *userQueryCache {
 func (s *userQueryCache) GetCachedUsersCache(ctx context.Context, req *requests.FindAllUsers) (*response.ApiResponsePaginationUser, bool) {
 	key := fmt.Sprintf(userAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationUser](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationUser](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -45,13 +47,13 @@ func (s *userQueryCache) SetCachedUsersCache(ctx context.Context, req *requests.
 
 	key := fmt.Sprintf(userAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *userQueryCache) GetCachedUserActiveCache(ctx context.Context, req *requests.FindAllUsers) (*response.ApiResponsePaginationUserDeleteAt, bool) {
 	key := fmt.Sprintf(userActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationUserDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationUserDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -66,13 +68,13 @@ func (s *userQueryCache) SetCachedUserActiveCache(ctx context.Context, req *requ
 	}
 
 	key := fmt.Sprintf(userActiveCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *userQueryCache) GetCachedUserTrashedCache(ctx context.Context, req *requests.FindAllUsers) (*response.ApiResponsePaginationUserDeleteAt, bool) {
 	key := fmt.Sprintf(userTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[*response.ApiResponsePaginationUserDeleteAt](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponsePaginationUserDeleteAt](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -87,13 +89,13 @@ func (s *userQueryCache) SetCachedUserTrashedCache(ctx context.Context, req *req
 	}
 
 	key := fmt.Sprintf(userTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
 
 func (s *userQueryCache) GetCachedUserCache(ctx context.Context, id int) (*response.ApiResponseUser, bool) {
 	key := fmt.Sprintf(userByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*response.ApiResponseUser](ctx, s.store, key)
+	result, found := cache.GetFromCache[*response.ApiResponseUser](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -108,5 +110,5 @@ func (s *userQueryCache) SetCachedUserCache(ctx context.Context, res *response.A
 	}
 
 	key := fmt.Sprintf(userByIdCacheKey, res.Data.ID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
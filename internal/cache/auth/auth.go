@@ -4,17 +4,17 @@ import "pointofsale/internal/cache"
 
 type authMencache struct {
 	IdentityCache
-	LoginCache
+	TokenDenylistCache
 }
 
 type AuthMencache interface {
 	IdentityCache
-	LoginCache
+	TokenDenylistCache
 }
 
 func NewMencache(cacheStore *cache.CacheStore) AuthMencache {
 	return &authMencache{
-		IdentityCache: NewidentityCache(cacheStore),
-		LoginCache:    NewLoginCache(cacheStore),
+		IdentityCache:      NewidentityCache(cacheStore),
+		TokenDenylistCache: NewTokenDenylistCache(cacheStore),
 	}
 }
@@ -1,7 +1,10 @@
 package auth_cache
 
+import "pointofsale/internal/cache"
+
 const (
-	keylogin                = "auth:login:%s"
-	keyIdentityRefreshToken = "auth:refresh_token:%s"
-	keyIdentityUserInfo     = "auth:user_info:%s"
+	keyIdentityUserInfo = "auth:user_info:%s"
+	keyTokenDenylist    = "auth:denylist:%s"
 )
+
+var entityTag = cache.EntityTag("user")
@@ -0,0 +1,40 @@
+package auth_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+	"time"
+)
+
+type tokenDenylistCache struct {
+	store *cache.CacheStore
+}
+
+func NewTokenDenylistCache(store *cache.CacheStore) *tokenDenylistCache {
+	// Revoked tokens are recorded nowhere else.
+	store.RegisterNamespace("auth", cache.NamespaceOptions{Durable: true})
+
+	return &tokenDenylistCache{store: store}
+}
+
+// DenyToken keeps the token ID on the denylist for expiration, which should
+// cover the remaining lifetime of the token.
+func (c *tokenDenylistCache) DenyToken(ctx context.Context, tokenID string, expiration time.Duration) {
+	if tokenID == "" || expiration <= 0 {
+		return
+	}
+
+	denied := true
+	key := fmt.Sprintf(keyTokenDenylist, tokenID)
+
+	cache.SetToCache(ctx, c.store, key, &denied, expiration)
+}
+
+func (c *tokenDenylistCache) IsTokenDenied(ctx context.Context, tokenID string) bool {
+	key := fmt.Sprintf(keyTokenDenylist, tokenID)
+
+	denied, found := cache.GetFromCache[bool](ctx, c.store, key)
+
+	return found && denied
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"pointofsale/internal/cache"
 	db "pointofsale/pkg/database/schema"
+	"strconv"
 	"time"
 )
 
@@ -13,30 +14,9 @@ type identityCache struct {
 }
 
 func NewidentityCache(store *cache.CacheStore) *identityCache {
-	return &identityCache{store: store}
-}
-
-func (c *identityCache) SetRefreshToken(ctx context.Context, token string, expiration time.Duration) {
-	key := fmt.Sprintf(keyIdentityRefreshToken, token)
-
-	cache.SetToCache(ctx, c.store, key, &token, expiration)
-}
-
-func (c *identityCache) GetRefreshToken(ctx context.Context, token string) (string, bool) {
-	key := fmt.Sprintf(keyIdentityRefreshToken, token)
+	store.RegisterNamespace("auth", cache.NamespaceOptions{})
 
-	result, found := cache.GetFromCache[string](ctx, c.store, key)
-
-	if !found || result == "" {
-		return "", false
-	}
-
-	return result, true
-}
-
-func (c *identityCache) DeleteRefreshToken(ctx context.Context, token string) {
-	key := fmt.Sprintf(keyIdentityRefreshToken, token)
-	cache.DeleteFromCache(ctx, c.store, key)
+	return &identityCache{store: store}
 }
 
 func (c *identityCache) SetCachedUserInfo(ctx context.Context, user *db.GetUserByIDRow, expiration time.Duration) {
@@ -44,15 +24,15 @@ func (c *identityCache) SetCachedUserInfo(ctx context.Context, user *db.GetUserB
 		return
 	}
 
-	key := fmt.Sprintf(keyIdentityUserInfo, user.UserID)
+	key := fmt.Sprintf(keyIdentityUserInfo, strconv.Itoa(int(user.UserID)))
 
-	cache.SetToCache(ctx, c.store, key, user, expiration)
+	cache.SetToCache(ctx, c.store, key, user, expiration, entityTag)
 }
 
 func (c *identityCache) GetCachedUserInfo(ctx context.Context, userId string) (*db.GetUserByIDRow, bool) {
 	key := fmt.Sprintf(keyIdentityUserInfo, userId)
 
-	return cache.GetFromCache[*db.GetUserByIDRow](ctx, c.store, key)
+	return cache.GetFromCache[*db.GetUserByIDRow](ctx, c.store, key, entityTag)
 }
 
 func (c *identityCache) DeleteCachedUserInfo(ctx context.Context, userId string) {
@@ -60,3 +40,7 @@ func (c *identityCache) DeleteCachedUserInfo(ctx context.Context, userId string)
 
 	cache.DeleteFromCache(ctx, c.store, key)
 }
+
+func (c *identityCache) InvalidateUserCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, c.store, entityTag)
+}
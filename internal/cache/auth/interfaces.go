@@ -2,21 +2,20 @@ package auth_cache
 
 import (
 	"context"
-	"pointofsale/internal/domain/response"
 	db "pointofsale/pkg/database/schema"
 	"time"
 )
 
 type IdentityCache interface {
-	SetRefreshToken(ctx context.Context, token string, expiration time.Duration)
-	GetRefreshToken(ctx context.Context, token string) (string, bool)
-	DeleteRefreshToken(ctx context.Context, token string)
 	SetCachedUserInfo(ctx context.Context, user *db.GetUserByIDRow, expiration time.Duration)
 	GetCachedUserInfo(ctx context.Context, userId string) (*db.GetUserByIDRow, bool)
 	DeleteCachedUserInfo(ctx context.Context, userId string)
+	InvalidateUserCache(ctx context.Context)
 }
 
-type LoginCache interface {
-	SetCachedLogin(ctx context.Context, email string, data *response.TokenResponse, expiration time.Duration)
-	GetCachedLogin(ctx context.Context, email string) (*response.TokenResponse, bool)
+// TokenDenylistCache holds the IDs of access tokens revoked before they
+// expired.
+type TokenDenylistCache interface {
+	DenyToken(ctx context.Context, tokenID string, expiration time.Duration)
+	IsTokenDenied(ctx context.Context, tokenID string) bool
 }
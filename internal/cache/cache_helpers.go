@@ -8,11 +8,13 @@ import (
 	"pointofsale/pkg/observability"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 )
 
 type CacheStats struct {
@@ -26,25 +28,52 @@ type CacheStats struct {
 
 type CacheStore struct {
 	redis           *redis.Client
+	prefix          string
 	Logger          logger.LoggerInterface
 	metrics         observability.CacheMetricsInterface
 	refCount        int64
 	lastCleanupTime time.Time
+	loads           singleflight.Group
+
+	// mu guards the namespace registry and the in-process tier of each
+	// namespace that opted in. localEpoch changes with every invalidation,
+	// so a Redis read that raced one is not copied into the tier.
+	mu         sync.RWMutex
+	namespaces map[string]NamespaceOptions
+	local      map[string]*localTier
+	localEpoch atomic.Int64
 }
 
+// NewCacheStore returns a store whose keys live under DefaultKeyPrefix.
 func NewCacheStore(redis *redis.Client, logger logger.LoggerInterface, metrics observability.CacheMetricsInterface) *CacheStore {
+	return NewCacheStoreWithPrefix(redis, DefaultKeyPrefix, logger, metrics)
+}
+
+// NewCacheStoreWithPrefix returns a store that keeps every key it writes
+// under prefix, so it never reads or deletes data of other applications
+// sharing the Redis database.
+func NewCacheStoreWithPrefix(redis *redis.Client, prefix string, logger logger.LoggerInterface, metrics observability.CacheMetricsInterface) *CacheStore {
 	return &CacheStore{
 		redis:           redis,
+		prefix:          prefix,
 		Logger:          logger,
 		metrics:         metrics,
 		refCount:        0,
 		lastCleanupTime: time.Now(),
+		namespaces:      make(map[string]NamespaceOptions),
+		local:           make(map[string]*localTier),
 	}
 }
 
-func GetFromCache[T any](ctx context.Context, store *CacheStore, key string) (T, bool) {
+// GetFromCache returns the value cached under key. When tags are given the
+// entry only counts as a hit if none of them was invalidated since it was
+// written. Keys in a namespace with a local tier are served from process
+// memory when possible.
+func GetFromCache[T any](ctx context.Context, store *CacheStore, key string, tags ...string) (T, bool) {
 	var zero T
 
+	key = store.partitionKey(ctx, key)
+
 	atomic.AddInt64(&store.refCount, 1)
 	defer atomic.AddInt64(&store.refCount, -1)
 
@@ -53,7 +82,28 @@ func GetFromCache[T any](ctx context.Context, store *CacheStore, key string) (T,
 		store.metrics.RecordCacheOperationLatency(ctx, "get", time.Since(start))
 	}()
 
-	cached, err := store.redis.Get(ctx, key).Result()
+	namespace, tier := store.localTierFor(key)
+	if tier != nil {
+		if cached, ok := tier.get(key); ok {
+			var result T
+			if err := json.Unmarshal(cached, &result); err == nil {
+				store.metrics.RecordLocalCacheHit(ctx, namespace)
+				store.metrics.RecordCacheHit(ctx, key)
+				return result, true
+			}
+		}
+		store.metrics.RecordLocalCacheMiss(ctx, namespace)
+	}
+
+	epoch := store.localEpoch.Load()
+
+	var cached []byte
+	var err error
+	if len(tags) == 0 {
+		cached, err = store.redis.Get(ctx, store.key(key)).Bytes()
+	} else {
+		cached, err = getTagged(ctx, store, key, tags)
+	}
 	if err == redis.Nil {
 		store.metrics.RecordCacheMiss(ctx, key)
 		return zero, false
@@ -69,7 +119,7 @@ func GetFromCache[T any](ctx context.Context, store *CacheStore, key string) (T,
 	}
 
 	var result T
-	if err := json.Unmarshal([]byte(cached), &result); err != nil {
+	if err := json.Unmarshal(cached, &result); err != nil {
 		store.Logger.Error(
 			"Failed to unmarshal cache",
 			zap.Error(err),
@@ -79,11 +129,19 @@ func GetFromCache[T any](ctx context.Context, store *CacheStore, key string) (T,
 		return zero, false
 	}
 
+	if tier != nil && store.localEpoch.Load() == epoch {
+		tier.set(key, cached, tags)
+	}
+
 	store.metrics.RecordCacheHit(ctx, key)
 	return result, true
 }
 
-func SetToCache[T any](ctx context.Context, store *CacheStore, key string, data *T, expiration time.Duration) {
+// SetToCache caches data under key. Tagged entries go stale as soon as one of
+// their tags is passed to InvalidateTags.
+func SetToCache[T any](ctx context.Context, store *CacheStore, key string, data *T, expiration time.Duration, tags ...string) {
+	key = store.partitionKey(ctx, key)
+
 	atomic.AddInt64(&store.refCount, 1)
 	defer atomic.AddInt64(&store.refCount, -1)
 
@@ -100,7 +158,16 @@ func SetToCache[T any](ctx context.Context, store *CacheStore, key string, data
 		return
 	}
 
-	if err := store.redis.Set(ctx, key, jsonData, expiration).Err(); err != nil {
+	if len(tags) == 0 {
+		err = store.set(ctx, key, jsonData, expiration)
+	} else {
+		var generations map[string]int64
+		if generations, err = tagGenerations(ctx, store, tags); err == nil {
+			err = setTagged(ctx, store, key, jsonData, generations, expiration)
+		}
+	}
+
+	if err != nil {
 		store.Logger.Error("Failed to set cache", zap.Error(err), zap.String("cacheKey", key))
 		store.metrics.RecordCacheError(ctx, "set", key, err)
 		store.metrics.RecordCacheSet(ctx, key, false)
@@ -113,6 +180,8 @@ func SetToCache[T any](ctx context.Context, store *CacheStore, key string, data
 }
 
 func DeleteFromCache(ctx context.Context, store *CacheStore, key string) {
+	key = store.partitionKey(ctx, key)
+
 	atomic.AddInt64(&store.refCount, 1)
 	defer atomic.AddInt64(&store.refCount, -1)
 
@@ -121,7 +190,16 @@ func DeleteFromCache(ctx context.Context, store *CacheStore, key string) {
 		store.metrics.RecordCacheOperationLatency(ctx, "delete", time.Since(start))
 	}()
 
-	if err := store.redis.Del(ctx, key).Err(); err != nil {
+	store.invalidateLocal(invalidation{Keys: []string{key}})
+
+	pipe := store.redis.Pipeline()
+	pipe.Del(ctx, store.key(key))
+	if namespace, ok := store.namespaceOf(key); ok {
+		pipe.SRem(ctx, store.indexKey(namespace), store.key(key))
+	}
+	store.publishInvalidation(ctx, pipe, invalidation{Keys: []string{key}})
+
+	if _, err := pipe.Exec(ctx); err != nil {
 		store.Logger.Error("Failed to delete cache", zap.Error(err), zap.String("cacheKey", key))
 		store.metrics.RecordCacheError(ctx, "delete", key, err)
 		store.metrics.RecordCacheDelete(ctx, key, false)
@@ -130,108 +208,23 @@ func DeleteFromCache(ctx context.Context, store *CacheStore, key string) {
 	}
 }
 
-func (store *CacheStore) ClearExpired(ctx context.Context) (int64, error) {
-	atomic.AddInt64(&store.refCount, 1)
-	defer atomic.AddInt64(&store.refCount, -1)
-
-	start := time.Now()
-	defer func() {
-		store.metrics.RecordCacheOperationLatency(ctx, "clear_expired", time.Since(start))
-	}()
-
-	var scanned int64
-	var cursor uint64
-	var expiredCount int64
-
-	for {
-		keys, nextCursor, err := store.redis.Scan(ctx, cursor, "*", 1000).Result()
-		if err != nil {
-			return 0, fmt.Errorf("scan error: %w", err)
-		}
-
-		scanned += int64(len(keys))
-
-		for _, key := range keys {
-			ttl, err := store.redis.TTL(ctx, key).Result()
-			if err != nil {
-				store.Logger.Error("Failed to get TTL", zap.Error(err), zap.String("cacheKey", key))
-				continue
-			}
-
-			if ttl == -1 {
-				continue
-			}
-
-			if ttl <= 0 {
-				if err := store.redis.Del(ctx, key).Err(); err != nil {
-					store.Logger.Error("Failed to delete expired key", zap.Error(err), zap.String("cacheKey", key))
-				} else {
-					expiredCount++
-					store.Logger.Debug("Deleted expired key", zap.String("cacheKey", key))
-				}
-			}
-		}
-
-		cursor = nextCursor
-		if cursor == 0 {
-			break
-		}
-	}
-
-	store.lastCleanupTime = time.Now()
-
-	return scanned, nil
-}
-
-func (store *CacheStore) InvalidateCache(ctx context.Context, pattern string) (int64, error) {
-	atomic.AddInt64(&store.refCount, 1)
-	defer atomic.AddInt64(&store.refCount, -1)
-
-	start := time.Now()
-	defer func() {
-		store.metrics.RecordCacheOperationLatency(ctx, "invalidate", time.Since(start))
-	}()
-
-	var deleted int64
-	var cursor uint64
-
-	for {
-		keys, nextCursor, err := store.redis.Scan(ctx, cursor, pattern, 1000).Result()
-		if err != nil {
-			return 0, fmt.Errorf("scan error: %w", err)
-		}
-
-		if len(keys) > 0 {
-			count, err := store.redis.Del(ctx, keys...).Result()
-			if err != nil {
-				return 0, fmt.Errorf("delete error: %w", err)
-			}
-			deleted += count
-		}
-
-		cursor = nextCursor
-		if cursor == 0 {
-			break
-		}
-	}
-
-	store.Logger.Info("Invalidated cache keys",
-		zap.String("pattern", pattern),
-		zap.Int64("count", deleted))
-
-	return deleted, nil
-}
-
 func (store *CacheStore) GetStats(ctx context.Context) (*CacheStats, error) {
 	atomic.AddInt64(&store.refCount, 1)
 	defer atomic.AddInt64(&store.refCount, -1)
 
-	info, err := store.redis.Info(ctx, "memory", "keyspace").Result()
+	info, err := store.redis.Info(ctx, "memory").Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get redis info: %w", err)
 	}
 
+	// Count our own keys only; the keyspace may be shared.
+	totalKeys, err := store.indexedKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cache keys: %w", err)
+	}
+
 	stats := &CacheStats{
+		TotalKeys:       totalKeys,
 		LastCleanupTime: store.lastCleanupTime,
 	}
 
@@ -245,20 +238,6 @@ func (store *CacheStore) GetStats(ctx context.Context) (*CacheStats, error) {
 					stats.MemoryUsedHuman = formatBytes(memory)
 				}
 			}
-			continue
-		}
-
-		if strings.HasPrefix(line, "db") {
-			parts := strings.SplitN(line, ":", 2)
-			if len(parts) == 2 {
-				for item := range strings.SplitSeq(parts[1], ",") {
-					if strings.HasPrefix(item, "keys=") {
-						if keys, err := strconv.ParseInt(strings.TrimPrefix(item, "keys="), 10, 64); err == nil {
-							stats.TotalKeys += keys
-						}
-					}
-				}
-			}
 		}
 	}
 
@@ -19,6 +19,8 @@ type CashierMencache interface {
 }
 
 func NewCashierMencache(store *cache.CacheStore) CashierMencache {
+	store.RegisterNamespace("cashier", cache.NamespaceOptions{Tenant: true})
+
 	return &cashierMencache{
 		CashierQueryCache:           NewCashierQueryCache(store),
 		CashierCommandCache:         NewCashierCommandCache(store),
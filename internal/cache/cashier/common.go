@@ -1,6 +1,9 @@
 package cashier_cache
 
-import "time"
+import (
+	"pointofsale/internal/cache"
+	"time"
+)
 
 const (
 	cashierAllCacheKey     = "cashier:all:page:%d:pageSize:%d:search:%s"
@@ -12,3 +15,10 @@ const (
 
 	ttlDefault = 5 * time.Minute
 )
+
+var (
+	entityTag = cache.EntityTag("cashier")
+
+	// Sales stats are computed from orders and go stale with them.
+	orderTag = cache.EntityTag("order")
+)
@@ -25,6 +25,7 @@ type CashierQueryCache interface {
 
 type CashierCommandCache interface {
 	DeleteCashierCache(ctx context.Context, id int)
+	InvalidateCashierCache(ctx context.Context)
 }
 
 type CashierStatsCache interface {
@@ -24,7 +24,7 @@ func NewCashierQueryCache(store *cache.CacheStore) *cashierQueryCache {
 func (s *cashierQueryCache) GetCachedCashiersCache(ctx context.Context, req *requests.FindAllCashiers) ([]*db.GetCashiersRow, *int, bool) {
 	key := fmt.Sprintf(cashierAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -45,13 +45,13 @@ func (s *cashierQueryCache) SetCachedCashiersCache(ctx context.Context, req *req
 
 	key := fmt.Sprintf(cashierAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &cashierListCacheResponse[*db.GetCashiersRow]{Data: res, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *cashierQueryCache) GetCachedCashiersByMerchant(ctx context.Context, req *requests.FindAllCashierMerchant) ([]*db.GetCashiersByMerchantRow, *int, bool) {
 	key := fmt.Sprintf(cashierByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersByMerchantRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersByMerchantRow]](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -72,13 +72,13 @@ func (s *cashierQueryCache) SetCachedCashiersByMerchant(ctx context.Context, req
 
 	key := fmt.Sprintf(cashierByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
 	payload := &cashierListCacheResponse[*db.GetCashiersByMerchantRow]{Data: res, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *cashierQueryCache) GetCachedCashiersActive(ctx context.Context, req *requests.FindAllCashiers) ([]*db.GetCashiersActiveRow, *int, bool) {
 	key := fmt.Sprintf(cashierActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersActiveRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersActiveRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -99,13 +99,13 @@ func (s *cashierQueryCache) SetCachedCashiersActive(ctx context.Context, req *re
 
 	key := fmt.Sprintf(cashierActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &cashierListCacheResponse[*db.GetCashiersActiveRow]{Data: res, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *cashierQueryCache) GetCachedCashiersTrashed(ctx context.Context, req *requests.FindAllCashiers) ([]*db.GetCashiersTrashedRow, *int, bool) {
 	key := fmt.Sprintf(cashierTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersTrashedRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[cashierListCacheResponse[*db.GetCashiersTrashedRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -126,13 +126,13 @@ func (s *cashierQueryCache) SetCachedCashiersTrashed(ctx context.Context, req *r
 
 	key := fmt.Sprintf(cashierTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &cashierListCacheResponse[*db.GetCashiersTrashedRow]{Data: res, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *cashierQueryCache) GetCachedCashier(ctx context.Context, cashierID int) (*db.GetCashierByIdRow, bool) {
 	key := fmt.Sprintf(cashierByIdCacheKey, cashierID)
 
-	result, found := cache.GetFromCache[*db.GetCashierByIdRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[*db.GetCashierByIdRow](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -147,5 +147,5 @@ func (s *cashierQueryCache) SetCachedCashier(ctx context.Context, res *db.GetCas
 	}
 
 	key := fmt.Sprintf(cashierByIdCacheKey, res.CashierID)
-	cache.SetToCache(ctx, s.store, key, res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, res, ttlDefault, entityTag)
 }
@@ -26,7 +26,7 @@ func NewCashierStatsCache(store *cache.CacheStore) *cashierStatsCache {
 
 func (s *cashierStatsCache) GetMonthlyTotalSalesCache(ctx context.Context, req *requests.MonthTotalSales) ([]*db.GetMonthlyTotalSalesCashierRow, bool) {
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesCacheKey, req.Month, req.Year)
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalSalesCashierRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalSalesCashierRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -39,12 +39,12 @@ func (s *cashierStatsCache) SetMonthlyTotalSalesCache(ctx context.Context, req *
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesCacheKey, req.Month, req.Year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsCache) GetYearlyTotalSalesCache(ctx context.Context, year int) ([]*db.GetYearlyTotalSalesCashierRow, bool) {
 	key := fmt.Sprintf(cashierStatsYearTotalSalesCacheKey, year)
-	result, found := cache.GetFromCache[[]*db.GetYearlyTotalSalesCashierRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyTotalSalesCashierRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -57,12 +57,12 @@ func (s *cashierStatsCache) SetYearlyTotalSalesCache(ctx context.Context, year i
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearTotalSalesCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsCache) GetMonthlySalesCache(ctx context.Context, year int) ([]*db.GetMonthlyCashierRow, bool) {
 	key := fmt.Sprintf(cashierStatsMonthSalesCacheKey, year)
-	result, found := cache.GetFromCache[[]*db.GetMonthlyCashierRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyCashierRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -75,12 +75,12 @@ func (s *cashierStatsCache) SetMonthlySalesCache(ctx context.Context, year int,
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthSalesCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsCache) GetYearlySalesCache(ctx context.Context, year int) ([]*db.GetYearlyCashierRow, bool) {
 	key := fmt.Sprintf(cashierStatsYearSalesCacheKey, year)
-	result, found := cache.GetFromCache[[]*db.GetYearlyCashierRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyCashierRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -92,5 +92,5 @@ func (s *cashierStatsCache) SetYearlySalesCache(ctx context.Context, year int, r
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearSalesCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
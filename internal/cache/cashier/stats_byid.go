@@ -26,7 +26,7 @@ func NewCashierStatsByIdCache(store *cache.CacheStore) *cashierStatsByIdCache {
 
 func (s *cashierStatsByIdCache) GetMonthlyTotalSalesByIdCache(ctx context.Context, req *requests.MonthTotalSalesCashier) ([]*db.GetMonthlyTotalSalesByIdRow, bool) {
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByIdCacheKey, req.Month, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalSalesByIdRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalSalesByIdRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -38,12 +38,12 @@ func (s *cashierStatsByIdCache) SetMonthlyTotalSalesByIdCache(ctx context.Contex
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByIdCacheKey, req.Month, req.Year, req.CashierID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsByIdCache) GetYearlyTotalSalesByIdCache(ctx context.Context, req *requests.YearTotalSalesCashier) ([]*db.GetYearlyTotalSalesByIdRow, bool) {
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByIdCacheKey, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[[]*db.GetYearlyTotalSalesByIdRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyTotalSalesByIdRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -55,12 +55,12 @@ func (s *cashierStatsByIdCache) SetYearlyTotalSalesByIdCache(ctx context.Context
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByIdCacheKey, req.Year, req.CashierID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsByIdCache) GetMonthlyCashierByIdCache(ctx context.Context, req *requests.MonthCashierId) ([]*db.GetMonthlyCashierByCashierIdRow, bool) {
 	key := fmt.Sprintf(cashierStatsMonthSalesByIdCacheKey, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[[]*db.GetMonthlyCashierByCashierIdRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyCashierByCashierIdRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -72,12 +72,12 @@ func (s *cashierStatsByIdCache) SetMonthlyCashierByIdCache(ctx context.Context,
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthSalesByIdCacheKey, req.Year, req.CashierID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
 
 func (s *cashierStatsByIdCache) GetYearlyCashierByIdCache(ctx context.Context, req *requests.YearCashierId) ([]*db.GetYearlyCashierByCashierIdRow, bool) {
 	key := fmt.Sprintf(cashierStatsYearSalesByIdCacheKey, req.Year, req.CashierID)
-	result, found := cache.GetFromCache[[]*db.GetYearlyCashierByCashierIdRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyCashierByCashierIdRow](ctx, s.store, key, entityTag, orderTag)
 	if !found || result == nil {
 		return nil, false
 	}
@@ -89,5 +89,5 @@ func (s *cashierStatsByIdCache) SetYearlyCashierByIdCache(ctx context.Context, r
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearSalesByIdCacheKey, req.Year, req.CashierID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag)
 }
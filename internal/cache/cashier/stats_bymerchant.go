@@ -26,7 +26,7 @@ func NewCashierStatsByMerchantCache(store *cache.CacheStore) *cashierStatsByMerc
 
 func (s *cashierStatsByMerchantCache) GetMonthlyTotalSalesByMerchantCache(ctx context.Context, req *requests.MonthTotalSalesMerchant) ([]*db.GetMonthlyTotalSalesByMerchantRow, bool) {
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByMerchantCacheKey, req.Month, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalSalesByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalSalesByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -38,12 +38,12 @@ func (s *cashierStatsByMerchantCache) SetMonthlyTotalSalesByMerchantCache(ctx co
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthTotalSalesByMerchantCacheKey, req.Month, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *cashierStatsByMerchantCache) GetYearlyTotalSalesByMerchantCache(ctx context.Context, req *requests.YearTotalSalesMerchant) ([]*db.GetYearlyTotalSalesByMerchantRow, bool) {
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[[]*db.GetYearlyTotalSalesByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyTotalSalesByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -55,12 +55,12 @@ func (s *cashierStatsByMerchantCache) SetYearlyTotalSalesByMerchantCache(ctx con
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearTotalSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *cashierStatsByMerchantCache) GetMonthlyCashierByMerchantCache(ctx context.Context, req *requests.MonthCashierMerchant) ([]*db.GetMonthlyCashierByMerchantRow, bool) {
 	key := fmt.Sprintf(cashierStatsMonthSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[[]*db.GetMonthlyCashierByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyCashierByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -72,12 +72,12 @@ func (s *cashierStatsByMerchantCache) SetMonthlyCashierByMerchantCache(ctx conte
 		return
 	}
 	key := fmt.Sprintf(cashierStatsMonthSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *cashierStatsByMerchantCache) GetYearlyCashierByMerchantCache(ctx context.Context, req *requests.YearCashierMerchant) ([]*db.GetYearlyCashierByMerchantRow, bool) {
 	key := fmt.Sprintf(cashierStatsYearSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	result, found := cache.GetFromCache[[]*db.GetYearlyCashierByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyCashierByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 	if !found || result == nil {
 		return nil, false
 	}
@@ -89,5 +89,5 @@ func (s *cashierStatsByMerchantCache) SetYearlyCashierByMerchantCache(ctx contex
 		return
 	}
 	key := fmt.Sprintf(cashierStatsYearSalesByMerchantCacheKey, req.Year, req.MerchantID)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &res, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
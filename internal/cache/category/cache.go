@@ -1,6 +1,9 @@
 package category_cache
 
-import "pointofsale/internal/cache"
+import (
+	"pointofsale/internal/cache"
+	"time"
+)
 
 type CategoryMencache interface {
 	CategoryQueryCache
@@ -18,7 +21,13 @@ type categoryMencache struct {
 	CategoryStatsByMerchantCache
 }
 
+// Categories change rarely but every product listing reads them.
+var localTier = cache.LocalTierOptions{MaxEntries: 1000, TTL: time.Minute}
+
 func NewCategoryMencache(store *cache.CacheStore) CategoryMencache {
+	store.RegisterNamespace("category", cache.NamespaceOptions{Tenant: true})
+	store.UseLocalTier("category", localTier)
+
 	return &categoryMencache{
 		CategoryQueryCache:           NewCategoryQueryCache(store),
 		CategoryCommandCache:         NewCategoryCommandCache(store),
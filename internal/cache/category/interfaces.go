@@ -22,6 +22,7 @@ type CategoryQueryCache interface {
 
 type CategoryCommandCache interface {
 	DeleteCachedCategoryCache(ctx context.Context, id int)
+	InvalidateCategoryCache(ctx context.Context)
 }
 
 type CategoryStatsCache interface {
@@ -31,11 +32,11 @@ type CategoryStatsCache interface {
 	GetCachedYearTotalPriceCache(ctx context.Context, year int) ([]*db.GetYearlyTotalPriceRow, bool)
 	SetCachedYearTotalPriceCache(ctx context.Context, year int, data []*db.GetYearlyTotalPriceRow)
 
-	GetCachedMonthPriceCache(ctx context.Context, year int) ([]*db.GetMonthlyCategoryRow, bool)
-	SetCachedMonthPriceCache(ctx context.Context, year int, data []*db.GetMonthlyCategoryRow)
+	GetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice) ([]*db.GetMonthlyCategoryRow, bool)
+	SetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice, data []*db.GetMonthlyCategoryRow)
 
-	GetCachedYearPriceCache(ctx context.Context, year int) ([]*db.GetYearlyCategoryRow, bool)
-	SetCachedYearPriceCache(ctx context.Context, year int, data []*db.GetYearlyCategoryRow)
+	GetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice) ([]*db.GetYearlyCategoryRow, bool)
+	SetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice, data []*db.GetYearlyCategoryRow)
 }
 
 type CategoryStatsByIdCache interface {
@@ -18,6 +18,13 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var (
+	entityTag = cache.EntityTag("category")
+
+	// Sales stats are computed from orders and go stale with them.
+	orderTag = cache.EntityTag("order")
+)
+
 type categoryListCacheResponse[T any] struct {
 	Data         []T  `json:"data"`
 	TotalRecords *int `json:"totalRecords"`
@@ -34,7 +41,7 @@ func NewCategoryQueryCache(store *cache.CacheStore) *categoryQueryCache {
 func (s *categoryQueryCache) GetCachedCategoriesCache(ctx context.Context, req *requests.FindAllCategory) ([]*db.GetCategoriesRow, *int, bool) {
 	key := fmt.Sprintf(categoryAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[categoryListCacheResponse[*db.GetCategoriesRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[categoryListCacheResponse[*db.GetCategoriesRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -55,13 +62,13 @@ func (s *categoryQueryCache) SetCachedCategoriesCache(ctx context.Context, req *
 
 	key := fmt.Sprintf(categoryAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &categoryListCacheResponse[*db.GetCategoriesRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *categoryQueryCache) GetCachedCategoryActiveCache(ctx context.Context, req *requests.FindAllCategory) ([]*db.GetCategoriesActiveRow, *int, bool) {
 	key := fmt.Sprintf(categoryActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[categoryListCacheResponse[*db.GetCategoriesActiveRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[categoryListCacheResponse[*db.GetCategoriesActiveRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -82,13 +89,13 @@ func (s *categoryQueryCache) SetCachedCategoryActiveCache(ctx context.Context, r
 
 	key := fmt.Sprintf(categoryActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &categoryListCacheResponse[*db.GetCategoriesActiveRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *categoryQueryCache) GetCachedCategoryTrashedCache(ctx context.Context, req *requests.FindAllCategory) ([]*db.GetCategoriesTrashedRow, *int, bool) {
 	key := fmt.Sprintf(categoryTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[categoryListCacheResponse[*db.GetCategoriesTrashedRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[categoryListCacheResponse[*db.GetCategoriesTrashedRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -109,12 +116,12 @@ func (s *categoryQueryCache) SetCachedCategoryTrashedCache(ctx context.Context,
 
 	key := fmt.Sprintf(categoryTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &categoryListCacheResponse[*db.GetCategoriesTrashedRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *categoryQueryCache) GetCachedCategoryCache(ctx context.Context, id int) (*db.GetCategoryByIDRow, bool) {
 	key := fmt.Sprintf(categoryByIdCacheKey, id)
-	result, found := cache.GetFromCache[*db.GetCategoryByIDRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[*db.GetCategoryByIDRow](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -129,5 +136,5 @@ func (s *categoryQueryCache) SetCachedCategoryCache(ctx context.Context, data *d
 	}
 
 	key := fmt.Sprintf(categoryByIdCacheKey, data.CategoryID)
-	cache.SetToCache(ctx, s.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, data, ttlDefault, entityTag)
 }
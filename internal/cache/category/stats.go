@@ -12,8 +12,8 @@ const (
 	categoryStatsMonthTotalPriceCacheKey = "category:stats:month:%d:year:%d"
 	categoryStatsYearTotalPriceCacheKey  = "category:stats:year:%d"
 
-	categoryStatsMonthPriceCacheKey = "category:stats:month:%d"
-	categoryStatsYearPriceCacheKey  = "category:stats:year:%d"
+	categoryStatsMonthPriceCacheKey = "category:stats:month:%d:subcategories:%t"
+	categoryStatsYearPriceCacheKey  = "category:stats:year:%d:subcategories:%t"
 )
 
 // ... (definisi cache key dan ttlDefault tetap sama) ...
@@ -29,7 +29,7 @@ func NewCategoryStatsCache(store *cache.CacheStore) *categoryStatsCache {
 func (s *categoryStatsCache) GetCachedMonthTotalPriceCache(ctx context.Context, req *requests.MonthTotalPrice) ([]*db.GetMonthlyTotalPriceRow, bool) {
 	key := fmt.Sprintf(categoryStatsMonthTotalPriceCacheKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalPriceRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalPriceRow](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -46,12 +46,12 @@ func (s *categoryStatsCache) SetCachedMonthTotalPriceCache(ctx context.Context,
 
 	key := fmt.Sprintf(categoryStatsMonthTotalPriceCacheKey, req.Month, req.Year)
 	// Perbaikan: Menggunakan 'cache.SetToCache' untuk konsistensi
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag)
 }
 
 func (s *categoryStatsCache) GetCachedYearTotalPriceCache(ctx context.Context, year int) ([]*db.GetYearlyTotalPriceRow, bool) {
 	key := fmt.Sprintf(categoryStatsYearTotalPriceCacheKey, year)
-	result, found := cache.GetFromCache[[]*db.GetYearlyTotalPriceRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyTotalPriceRow](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -66,12 +66,12 @@ func (s *categoryStatsCache) SetCachedYearTotalPriceCache(ctx context.Context, y
 	}
 
 	key := fmt.Sprintf(categoryStatsYearTotalPriceCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag)
 }
 
-func (s *categoryStatsCache) GetCachedMonthPriceCache(ctx context.Context, year int) ([]*db.GetMonthlyCategoryRow, bool) {
-	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, year)
-	result, found := cache.GetFromCache[[]*db.GetMonthlyCategoryRow](ctx, s.store, key)
+func (s *categoryStatsCache) GetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice) ([]*db.GetMonthlyCategoryRow, bool) {
+	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, req.Year, req.IncludeSubcategories)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyCategoryRow](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -80,18 +80,18 @@ func (s *categoryStatsCache) GetCachedMonthPriceCache(ctx context.Context, year
 	return result, true
 }
 
-func (s *categoryStatsCache) SetCachedMonthPriceCache(ctx context.Context, year int, data []*db.GetMonthlyCategoryRow) {
+func (s *categoryStatsCache) SetCachedMonthPriceCache(ctx context.Context, req *requests.MonthPrice, data []*db.GetMonthlyCategoryRow) {
 	if data == nil {
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	key := fmt.Sprintf(categoryStatsMonthPriceCacheKey, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag)
 }
 
-func (s *categoryStatsCache) GetCachedYearPriceCache(ctx context.Context, year int) ([]*db.GetYearlyCategoryRow, bool) {
-	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, year)
-	result, found := cache.GetFromCache[[]*db.GetYearlyCategoryRow](ctx, s.store, key)
+func (s *categoryStatsCache) GetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice) ([]*db.GetYearlyCategoryRow, bool) {
+	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, req.Year, req.IncludeSubcategories)
+	result, found := cache.GetFromCache[[]*db.GetYearlyCategoryRow](ctx, s.store, key, entityTag, orderTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -100,11 +100,11 @@ func (s *categoryStatsCache) GetCachedYearPriceCache(ctx context.Context, year i
 	return result, true
 }
 
-func (s *categoryStatsCache) SetCachedYearPriceCache(ctx context.Context, year int, data []*db.GetYearlyCategoryRow) {
+func (s *categoryStatsCache) SetCachedYearPriceCache(ctx context.Context, req *requests.YearPrice, data []*db.GetYearlyCategoryRow) {
 	if data == nil {
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	key := fmt.Sprintf(categoryStatsYearPriceCacheKey, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag)
 }
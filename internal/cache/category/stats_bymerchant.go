@@ -12,8 +12,8 @@ const (
 	categoryStatsByMerchantMonthTotalPriceCacheKey = "category:stats:bymerchant:%d:month:%d:year:%d"
 	categoryStatsByMerchantYearTotalPriceCacheKey  = "category:stats:bymerchant:%d:year:%d"
 
-	categoryStatsByMerchantMonthPriceCacheKey = "category:stats:bymerchant:%d:month:%d"
-	categoryStatsByMerchantYearPriceCacheKey  = "category:stats:bymerchant:%d:year:%d"
+	categoryStatsByMerchantMonthPriceCacheKey = "category:stats:bymerchant:%d:month:%d:subcategories:%t"
+	categoryStatsByMerchantYearPriceCacheKey  = "category:stats:bymerchant:%d:year:%d:subcategories:%t"
 )
 
 // ... (definisi cache key dan ttlDefault tetap sama) ...
@@ -29,7 +29,7 @@ func NewCategoryStatsByMerchantCache(store *cache.CacheStore) *categoryStatsByMe
 func (s *categoryStatsByMerchantCache) GetCachedMonthTotalPriceByMerchantCache(ctx context.Context, req *requests.MonthTotalPriceMerchant) ([]*db.GetMonthlyTotalPriceByMerchantRow, bool) {
 	key := fmt.Sprintf(categoryStatsByMerchantMonthTotalPriceCacheKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalPriceByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalPriceByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -46,13 +46,13 @@ func (s *categoryStatsByMerchantCache) SetCachedMonthTotalPriceByMerchantCache(c
 
 	key := fmt.Sprintf(categoryStatsByMerchantMonthTotalPriceCacheKey, req.MerchantID, req.Month, req.Year)
 	// Perbaikan: Menggunakan 'cache.SetToCache' untuk konsistensi
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *categoryStatsByMerchantCache) GetCachedYearTotalPriceByMerchantCache(ctx context.Context, req *requests.YearTotalPriceMerchant) ([]*db.GetYearlyTotalPriceByMerchantRow, bool) {
 	key := fmt.Sprintf(categoryStatsByMerchantYearTotalPriceCacheKey, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetYearlyTotalPriceByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyTotalPriceByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -67,13 +67,13 @@ func (s *categoryStatsByMerchantCache) SetCachedYearTotalPriceByMerchantCache(ct
 	}
 
 	key := fmt.Sprintf(categoryStatsByMerchantYearTotalPriceCacheKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *categoryStatsByMerchantCache) GetCachedMonthPriceByMerchantCache(ctx context.Context, req *requests.MonthPriceMerchant) ([]*db.GetMonthlyCategoryByMerchantRow, bool) {
-	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year)
+	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyCategoryByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMonthlyCategoryByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -87,14 +87,14 @@ func (s *categoryStatsByMerchantCache) SetCachedMonthPriceByMerchantCache(ctx co
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByMerchantMonthPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *categoryStatsByMerchantCache) GetCachedYearPriceByMerchantCache(ctx context.Context, req *requests.YearPriceMerchant) ([]*db.GetYearlyCategoryByMerchantRow, bool) {
-	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year)
+	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[[]*db.GetYearlyCategoryByMerchantRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[[]*db.GetYearlyCategoryByMerchantRow](ctx, s.store, key, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result == nil {
 		return nil, false
@@ -108,6 +108,6 @@ func (s *categoryStatsByMerchantCache) SetCachedYearPriceByMerchantCache(ctx con
 		return
 	}
 
-	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, &data, ttlDefault)
+	key := fmt.Sprintf(categoryStatsByMerchantYearPriceCacheKey, req.MerchantID, req.Year, req.IncludeSubcategories)
+	cache.SetToCache(ctx, s.store, key, &data, ttlDefault, entityTag, orderTag, cache.MerchantTag(req.MerchantID))
 }
@@ -0,0 +1,49 @@
+package idempotency_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+	db "pointofsale/pkg/database/schema"
+	"time"
+)
+
+const idempotencyKeyCacheKey = "idempotency:user:%d:method:%s:key:%s"
+
+type idempotencyCache struct {
+	store *cache.CacheStore
+}
+
+func NewIdempotencyCache(store *cache.CacheStore) *idempotencyCache {
+	store.RegisterNamespace("idempotency", cache.NamespaceOptions{})
+
+	return &idempotencyCache{store: store}
+}
+
+func (c *idempotencyCache) GetCachedIdempotencyKey(ctx context.Context, user_id int, method string, key string) (*db.IdempotencyKey, bool) {
+	cacheKey := fmt.Sprintf(idempotencyKeyCacheKey, user_id, method, key)
+
+	result, found := cache.GetFromCache[*db.IdempotencyKey](ctx, c.store, cacheKey)
+
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
+
+// SetCachedIdempotencyKey caches a completed key until it expires. Keys that
+// are still being processed are never cached.
+func (c *idempotencyCache) SetCachedIdempotencyKey(ctx context.Context, data *db.IdempotencyKey) {
+	if data == nil || data.Response == nil {
+		return
+	}
+
+	ttl := time.Until(data.ExpiresAt)
+	if ttl <= 0 {
+		return
+	}
+
+	cacheKey := fmt.Sprintf(idempotencyKeyCacheKey, data.UserID, data.Method, data.IdempotencyKey)
+	cache.SetToCache(ctx, c.store, cacheKey, data, ttl)
+}
@@ -0,0 +1,11 @@
+package idempotency_cache
+
+import (
+	"context"
+	db "pointofsale/pkg/database/schema"
+)
+
+type IdempotencyCache interface {
+	GetCachedIdempotencyKey(ctx context.Context, user_id int, method string, key string) (*db.IdempotencyKey, bool)
+	SetCachedIdempotencyKey(ctx context.Context, data *db.IdempotencyKey)
+}
@@ -0,0 +1,303 @@
+package cache
+
+import (
+	"context"
+	cryptorand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	lockKeyPrefix = "cache:lock:"
+
+	// lockTTL bounds how long a crashed loader can keep other replicas
+	// waiting; loadTimeout bounds a load that was left running by a caller
+	// that went away.
+	lockTTL          = 10 * time.Second
+	lockPollInterval = 50 * time.Millisecond
+	loadTimeout      = 30 * time.Second
+)
+
+// releaseLock deletes the lock only while it still holds our token, so a
+// loader whose lock expired cannot release the lock of the next one.
+var releaseLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// LoadOptions controls how GetOrLoad caches a value.
+type LoadOptions struct {
+	// TTL is how long a loaded value is served as fresh.
+	TTL time.Duration
+
+	// StaleTTL is how long after TTL the value may still be served while a
+	// single caller refreshes it in the background. Zero disables it.
+	StaleTTL time.Duration
+
+	// Jitter adds up to this fraction of TTL at random, so keys loaded
+	// together do not all expire together.
+	Jitter float64
+
+	// NotFound is the error load returns when there is nothing to cache.
+	// With a positive NegativeTTL the miss is cached and replayed as
+	// NotFound until it expires.
+	NotFound    error
+	NegativeTTL time.Duration
+
+	// Tags invalidate the value like the tags of SetToCache.
+	Tags []string
+}
+
+// GetOrLoad returns the value cached under key and calls load when there is
+// none. Concurrent misses for the same key share one load within the process
+// and wait on a Redis lock for the load of another replica, so a hot key that
+// expires hits the database once. Expired values are served for StaleTTL
+// while one caller refreshes them.
+//
+// The cache is best effort: when Redis fails, load is called directly.
+func GetOrLoad[T any](ctx context.Context, store *CacheStore, key string, opts LoadOptions, load func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+
+	key = store.partitionKey(ctx, key)
+
+	start := time.Now()
+	defer func() {
+		store.metrics.RecordCacheOperationLatency(ctx, "get_or_load", time.Since(start))
+	}()
+
+	entry, err := readEntry(ctx, store, key, opts.Tags)
+	switch {
+	case err == nil:
+		value, decodeErr := decodeEntry[T](ctx, store, key, entry, opts)
+		if decodeErr != nil && !errors.Is(decodeErr, errCorruptEntry) {
+			return zero, decodeErr
+		}
+
+		if decodeErr == nil {
+			if time.Now().UnixMilli() < entry.FreshUntil {
+				store.metrics.RecordCacheHit(ctx, key)
+				return value, nil
+			}
+
+			store.metrics.RecordCacheStaleServe(ctx, key)
+			refreshInBackground(ctx, store, key, opts, load)
+
+			return value, nil
+		}
+	case err != redis.Nil:
+		store.Logger.Error("Redis get error", zap.Error(err), zap.String("cacheKey", key))
+		store.metrics.RecordCacheError(ctx, "get", key, err)
+	}
+
+	store.metrics.RecordCacheMiss(ctx, key)
+
+	return loadShared(ctx, store, key, opts, load)
+}
+
+var errCorruptEntry = errors.New("corrupt cache entry")
+
+// decodeEntry returns the value of entry, or opts.NotFound for a cached miss.
+func decodeEntry[T any](ctx context.Context, store *CacheStore, key string, entry *taggedEntry, opts LoadOptions) (T, error) {
+	var value T
+
+	if entry.Negative {
+		if opts.NotFound == nil {
+			return value, errCorruptEntry
+		}
+		return value, opts.NotFound
+	}
+
+	if err := json.Unmarshal(entry.Data, &value); err != nil {
+		store.Logger.Error("Failed to unmarshal cache", zap.Error(err), zap.String("cacheKey", key))
+		store.metrics.RecordCacheError(ctx, "unmarshal", key, err)
+		return value, errCorruptEntry
+	}
+
+	return value, nil
+}
+
+// loadShared runs one load per key within the process. The load outlives a
+// caller that gives up, so the callers sharing it still get its result.
+func loadShared[T any](ctx context.Context, store *CacheStore, key string, opts LoadOptions, load func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+
+	leader := false
+	results := store.loads.DoChan(key, func() (any, error) {
+		leader = true
+
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		return loadLocked(loadCtx, store, key, opts, load, true)
+	})
+
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case res := <-results:
+		if res.Shared && !leader {
+			store.metrics.RecordCacheCoalescedLoad(ctx, key)
+		}
+
+		if res.Err != nil {
+			return zero, res.Err
+		}
+
+		value, _ := res.Val.(T)
+
+		return value, nil
+	}
+}
+
+// refreshInBackground reloads a stale value unless this process or another
+// replica is already doing so.
+func refreshInBackground[T any](ctx context.Context, store *CacheStore, key string, opts LoadOptions, load func(ctx context.Context) (T, error)) {
+	refreshCtx := context.WithoutCancel(ctx)
+
+	// Kept apart from loadShared: a refresh that gives up to another replica
+	// returns nothing, which a caller that missed must not receive.
+	go store.loads.Do("refresh:"+key, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(refreshCtx, loadTimeout)
+		defer cancel()
+
+		return loadLocked(loadCtx, store, key, opts, load, false)
+	})
+}
+
+// loadLocked loads and caches the value while holding the Redis lock of key.
+// When another replica holds the lock, it waits for that replica's value if
+// wait is set and gives up otherwise.
+func loadLocked[T any](ctx context.Context, store *CacheStore, key string, opts LoadOptions, load func(ctx context.Context) (T, error), wait bool) (any, error) {
+	var zero T
+
+	lockKey := store.key(lockKeyPrefix + key)
+	token := newLockToken()
+
+	acquired, err := store.redis.SetNX(ctx, lockKey, token, lockTTL).Result()
+	if err != nil {
+		store.Logger.Error("Failed to acquire cache lock", zap.Error(err), zap.String("cacheKey", key))
+		store.metrics.RecordCacheError(ctx, "lock", key, err)
+	}
+
+	if err == nil && !acquired {
+		if !wait {
+			return zero, nil
+		}
+
+		if value, done, err := waitForLoad[T](ctx, store, key, opts); done {
+			store.metrics.RecordCacheCoalescedLoad(ctx, key)
+			return value, err
+		}
+	}
+
+	if acquired {
+		defer func() {
+			if err := releaseLock.Run(context.WithoutCancel(ctx), store.redis, []string{lockKey}, token).Err(); err != nil {
+				store.Logger.Error("Failed to release cache lock", zap.Error(err), zap.String("cacheKey", key))
+			}
+		}()
+	}
+
+	// Read the generations before loading: a write that lands during the
+	// load bumps them and leaves what we store stale.
+	var generations map[string]int64
+	if len(opts.Tags) > 0 {
+		if generations, err = tagGenerations(ctx, store, opts.Tags); err != nil {
+			store.Logger.Error("Failed to read cache tags", zap.Error(err), zap.String("cacheKey", key))
+			generations = nil
+		}
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		if opts.NotFound != nil && opts.NegativeTTL > 0 && errors.Is(err, opts.NotFound) && (len(opts.Tags) == 0 || generations != nil) {
+			storeLoaded(ctx, store, key, &taggedEntry{Tags: generations, Negative: true}, opts.NegativeTTL, 0)
+		}
+		return zero, err
+	}
+
+	if len(opts.Tags) == 0 || generations != nil {
+		data, err := json.Marshal(value)
+		if err != nil {
+			store.Logger.Error("Failed to marshal cache", zap.Error(err), zap.String("cacheKey", key))
+			store.metrics.RecordCacheError(ctx, "marshal", key, err)
+			return value, nil
+		}
+
+		storeLoaded(ctx, store, key, &taggedEntry{Tags: generations, Data: data}, jittered(opts.TTL, opts.Jitter), opts.StaleTTL)
+	}
+
+	return value, nil
+}
+
+// waitForLoad polls for the value another replica is loading. It reports
+// false when the lock went away or expired without a fresh value.
+func waitForLoad[T any](ctx context.Context, store *CacheStore, key string, opts LoadOptions) (T, bool, error) {
+	var zero T
+
+	deadline := time.Now().Add(lockTTL)
+	ticker := time.NewTicker(lockPollInterval)
+	defer ticker.Stop()
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return zero, true, ctx.Err()
+		case <-ticker.C:
+		}
+
+		entry, err := readEntry(ctx, store, key, opts.Tags)
+		if err == nil && time.Now().UnixMilli() < entry.FreshUntil {
+			value, err := decodeEntry[T](ctx, store, key, entry, opts)
+			if !errors.Is(err, errCorruptEntry) {
+				return value, true, err
+			}
+		}
+		if err != nil && err != redis.Nil {
+			return zero, false, nil
+		}
+
+		held, err := store.redis.Exists(ctx, store.key(lockKeyPrefix+key)).Result()
+		if err != nil || held == 0 {
+			return zero, false, nil
+		}
+	}
+
+	return zero, false, nil
+}
+
+func storeLoaded(ctx context.Context, store *CacheStore, key string, entry *taggedEntry, ttl, staleTTL time.Duration) {
+	entry.FreshUntil = time.Now().Add(ttl).UnixMilli()
+
+	if err := writeEntry(ctx, store, key, entry, ttl+staleTTL); err != nil {
+		store.Logger.Error("Failed to set cache", zap.Error(err), zap.String("cacheKey", key))
+		store.metrics.RecordCacheError(ctx, "set", key, err)
+		store.metrics.RecordCacheSet(ctx, key, false)
+		return
+	}
+
+	store.metrics.RecordCacheSet(ctx, key, true)
+}
+
+func jittered(ttl time.Duration, jitter float64) time.Duration {
+	if jitter <= 0 || ttl <= 0 {
+		return ttl
+	}
+
+	return ttl + time.Duration(rand.Float64()*jitter*float64(ttl))
+}
+
+func newLockToken() string {
+	var b [16]byte
+	_, _ = cryptorand.Read(b[:])
+
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,282 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Writes publish the keys and tags they invalidate on invalidationChannel, so
+// every replica can drop its in-process copies of them.
+const (
+	invalidationChannel = "cache:invalidations"
+	subscribeTimeout    = 5 * time.Second
+)
+
+// LocalTierOptions bounds the in-process tier of one namespace.
+type LocalTierOptions struct {
+	// MaxEntries caps the namespace; the least recently used entry is
+	// evicted first.
+	MaxEntries int
+
+	// TTL bounds how long an entry is served without asking Redis, which
+	// also bounds staleness when an invalidation message is lost.
+	TTL time.Duration
+}
+
+type invalidation struct {
+	Keys       []string `json:"keys,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+type localEntry struct {
+	key       string
+	data      []byte
+	tags      []string
+	expiresAt time.Time
+}
+
+// localTier is an LRU of raw cache payloads for one namespace.
+type localTier struct {
+	mu      sync.Mutex
+	opts    LocalTierOptions
+	entries *list.List
+	index   map[string]*list.Element
+}
+
+func newLocalTier(opts LocalTierOptions) *localTier {
+	return &localTier{
+		opts:    opts,
+		entries: list.New(),
+		index:   make(map[string]*list.Element),
+	}
+}
+
+func (t *localTier) get(key string) ([]byte, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	elem, ok := t.index[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*localEntry)
+	if time.Now().After(entry.expiresAt) {
+		t.remove(elem)
+		return nil, false
+	}
+
+	t.entries.MoveToFront(elem)
+
+	return entry.data, true
+}
+
+func (t *localTier) set(key string, data []byte, tags []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry := &localEntry{
+		key:       key,
+		data:      data,
+		tags:      tags,
+		expiresAt: time.Now().Add(t.opts.TTL),
+	}
+
+	if elem, ok := t.index[key]; ok {
+		elem.Value = entry
+		t.entries.MoveToFront(elem)
+		return
+	}
+
+	t.index[key] = t.entries.PushFront(entry)
+
+	for t.entries.Len() > t.opts.MaxEntries {
+		t.remove(t.entries.Back())
+	}
+}
+
+func (t *localTier) invalidate(keys, tags []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, key := range keys {
+		if elem, ok := t.index[key]; ok {
+			t.remove(elem)
+		}
+	}
+
+	if len(tags) == 0 {
+		return
+	}
+
+	for elem := t.entries.Front(); elem != nil; {
+		next := elem.Next()
+		if hasAnyTag(elem.Value.(*localEntry).tags, tags) {
+			t.remove(elem)
+		}
+		elem = next
+	}
+}
+
+func (t *localTier) len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.entries.Len()
+}
+
+func (t *localTier) clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries.Init()
+	clear(t.index)
+}
+
+func (t *localTier) remove(elem *list.Element) {
+	t.entries.Remove(elem)
+	delete(t.index, elem.Value.(*localEntry).key)
+}
+
+func hasAnyTag(entryTags, tags []string) bool {
+	for _, tag := range tags {
+		for _, entryTag := range entryTags {
+			if entryTag == tag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// UseLocalTier registers namespace and keeps its entries in process as well,
+// in front of Redis. It is meant for data that is read far more often than it
+// changes. Only GetFromCache reads and fills the tier; enabling a namespace
+// again keeps its current tier.
+//
+// Other replicas learn about writes through ListenForInvalidations, which
+// must be running for the tier to stay coherent.
+func (store *CacheStore) UseLocalTier(namespace string, opts LocalTierOptions) {
+	store.RegisterNamespace(namespace, NamespaceOptions{})
+
+	if opts.MaxEntries <= 0 || opts.TTL <= 0 {
+		return
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if _, ok := store.local[namespace]; !ok {
+		store.local[namespace] = newLocalTier(opts)
+	}
+}
+
+// localTierFor returns the tier of the namespace of key, if it has one.
+func (store *CacheStore) localTierFor(key string) (string, *localTier) {
+	namespace, _, found := strings.Cut(key, ":")
+	if !found {
+		return "", nil
+	}
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	return namespace, store.local[namespace]
+}
+
+func (store *CacheStore) invalidateLocal(inv invalidation) {
+	store.localEpoch.Add(1)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for _, namespace := range inv.Namespaces {
+		if tier, ok := store.local[namespace]; ok {
+			tier.clear()
+		}
+	}
+
+	for _, tier := range store.local {
+		tier.invalidate(inv.Keys, inv.Tags)
+	}
+}
+
+func (store *CacheStore) clearLocal() {
+	store.localEpoch.Add(1)
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	for _, tier := range store.local {
+		tier.clear()
+	}
+}
+
+// publishInvalidation queues the invalidation message on pipe, so it reaches
+// other replicas in the same round trip as the write.
+func (store *CacheStore) publishInvalidation(ctx context.Context, pipe redis.Pipeliner, inv invalidation) {
+	payload, err := json.Marshal(inv)
+	if err != nil {
+		return
+	}
+
+	pipe.Publish(ctx, store.key(invalidationChannel), payload)
+}
+
+// ListenForInvalidations applies the invalidations published by every replica
+// to the local tier until ctx is done. The returned channel is closed once it
+// has stopped. It returns once the subscription is confirmed, so writes made
+// afterwards are never missed.
+//
+// Pub/sub does not queue messages for a disconnected subscriber, so the whole
+// tier is dropped whenever the subscription is re-established.
+func (store *CacheStore) ListenForInvalidations(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+
+	pubsub := store.redis.Subscribe(ctx, store.key(invalidationChannel))
+
+	subscribeCtx, cancel := context.WithTimeout(ctx, subscribeTimeout)
+	if _, err := pubsub.Receive(subscribeCtx); err != nil {
+		store.Logger.Error("Failed to subscribe to cache invalidations", zap.Error(err))
+	}
+	cancel()
+
+	go func() {
+		<-ctx.Done()
+		pubsub.Close()
+	}()
+
+	go func() {
+		defer close(done)
+
+		for msg := range pubsub.ChannelWithSubscriptions() {
+			switch msg := msg.(type) {
+			case *redis.Subscription:
+				if msg.Kind == "subscribe" {
+					store.clearLocal()
+				}
+			case *redis.Message:
+				var inv invalidation
+				if err := json.Unmarshal([]byte(msg.Payload), &inv); err != nil {
+					store.Logger.Error("Invalid cache invalidation message", zap.Error(err))
+					store.clearLocal()
+					continue
+				}
+				store.invalidateLocal(inv)
+			}
+		}
+
+		store.Logger.Info("Cache invalidation listener stopped")
+	}()
+
+	return done
+}
@@ -1,6 +1,9 @@
 package merchant_cache
 
-import "pointofsale/internal/cache"
+import (
+	"pointofsale/internal/cache"
+	"time"
+)
 
 type MerchantMenCache interface {
 	MerchantQueryCache
@@ -12,7 +15,13 @@ type merchantMencache struct {
 	MerchantCommandCache
 }
 
+// Merchants are looked up by most requests and edited seldom.
+var localTier = cache.LocalTierOptions{MaxEntries: 1000, TTL: time.Minute}
+
 func NewMerchantMencache(store *cache.CacheStore) MerchantMenCache {
+	store.RegisterNamespace("merchant", cache.NamespaceOptions{Tenant: true})
+	store.UseLocalTier("merchant", localTier)
+
 	return &merchantMencache{
 		MerchantQueryCache:   NewMerchantQueryCache(store),
 		MerchantCommandCache: NewMerchantCommandCache(store),
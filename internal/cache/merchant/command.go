@@ -19,4 +19,9 @@ func (s *merchantCommandCache) DeleteCachedMerchant(ctx context.Context, id int)
 	key := fmt.Sprintf(merchantByIdCacheKey, id)
 
 	cache.DeleteFromCache(ctx, s.store, key)
+	cache.InvalidateTags(ctx, s.store, entityTag, cache.MerchantTag(id))
+}
+
+func (s *merchantCommandCache) InvalidateMerchantCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, s.store, entityTag)
 }
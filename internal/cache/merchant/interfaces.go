@@ -25,4 +25,5 @@ type MerchantQueryCache interface {
 
 type MerchantCommandCache interface {
 	DeleteCachedMerchant(ctx context.Context, id int)
+	InvalidateMerchantCache(ctx context.Context)
 }
@@ -19,6 +19,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("merchant")
+
 type merchantListCacheResponse[T any] struct {
 	Data         []T  `json:"data"`
 	TotalRecords *int `json:"total_records"`
@@ -35,7 +37,7 @@ func NewMerchantQueryCache(store *cache.CacheStore) *merchantQueryCache {
 func (m *merchantQueryCache) GetCachedMerchants(ctx context.Context, req *requests.FindAllMerchants) ([]*db.GetMerchantsRow, *int, bool) {
 	key := fmt.Sprintf(merchantAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[merchantListCacheResponse[*db.GetMerchantsRow]](ctx, m.store, key)
+	result, found := cache.GetFromCache[merchantListCacheResponse[*db.GetMerchantsRow]](ctx, m.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -56,13 +58,13 @@ func (m *merchantQueryCache) SetCachedMerchants(ctx context.Context, req *reques
 
 	key := fmt.Sprintf(merchantAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &merchantListCacheResponse[*db.GetMerchantsRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, m.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchantActive(ctx context.Context, req *requests.FindAllMerchants) ([]*db.GetMerchantsActiveRow, *int, bool) {
 	key := fmt.Sprintf(merchantActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[merchantListCacheResponse[*db.GetMerchantsActiveRow]](ctx, m.store, key)
+	result, found := cache.GetFromCache[merchantListCacheResponse[*db.GetMerchantsActiveRow]](ctx, m.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -83,13 +85,13 @@ func (m *merchantQueryCache) SetCachedMerchantActive(ctx context.Context, req *r
 
 	key := fmt.Sprintf(merchantActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &merchantListCacheResponse[*db.GetMerchantsActiveRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, m.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchantTrashed(ctx context.Context, req *requests.FindAllMerchants) ([]*db.GetMerchantsTrashedRow, *int, bool) {
 	key := fmt.Sprintf(merchantTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[merchantListCacheResponse[*db.GetMerchantsTrashedRow]](ctx, m.store, key)
+	result, found := cache.GetFromCache[merchantListCacheResponse[*db.GetMerchantsTrashedRow]](ctx, m.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -110,13 +112,13 @@ func (m *merchantQueryCache) SetCachedMerchantTrashed(ctx context.Context, req *
 
 	key := fmt.Sprintf(merchantTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &merchantListCacheResponse[*db.GetMerchantsTrashedRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, m.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchant(ctx context.Context, id int) (*db.GetMerchantByIDRow, bool) {
 	key := fmt.Sprintf(merchantByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*db.GetMerchantByIDRow](ctx, m.store, key)
+	result, found := cache.GetFromCache[*db.GetMerchantByIDRow](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -131,13 +133,13 @@ func (m *merchantQueryCache) SetCachedMerchant(ctx context.Context, data *db.Get
 	}
 
 	key := fmt.Sprintf(merchantByIdCacheKey, data.MerchantID)
-	cache.SetToCache(ctx, m.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, data, ttlDefault, entityTag)
 }
 
 func (m *merchantQueryCache) GetCachedMerchantsByUserId(ctx context.Context, id int) ([]*db.GetMerchantByIDRow, bool) {
 	key := fmt.Sprintf(merchantByUserIdCacheKey, id)
 
-	result, found := cache.GetFromCache[[]*db.GetMerchantByIDRow](ctx, m.store, key)
+	result, found := cache.GetFromCache[[]*db.GetMerchantByIDRow](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -152,5 +154,5 @@ func (m *merchantQueryCache) SetCachedMerchantsByUserId(ctx context.Context, use
 	}
 
 	key := fmt.Sprintf(merchantByUserIdCacheKey, userId)
-	cache.SetToCache(ctx, m.store, key, &data, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, &data, ttlDefault, entityTag)
 }
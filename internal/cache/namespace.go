@@ -0,0 +1,344 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pointofsale/pkg/auth"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Every key the store touches lives under its key prefix, so applications can
+// share a Redis database without seeing each other's data. The first segment
+// of a key, e.g. "category" in "category:id:1", is its namespace. Writes to a
+// registered namespace also record the key in the namespace's index set,
+// which is what inspecting, flushing and cleaning up a namespace walk instead
+// of scanning the keyspace.
+const (
+	DefaultKeyPrefix = "pointofsale:"
+
+	indexKeyPrefix = "cache:index:"
+	indexBatchSize = 500
+)
+
+// pruneIndex removes the keys in KEYS[2:] that no longer exist from the index
+// in KEYS[1]. Checking and removing atomically keeps a key that expired and
+// was written again in between indexed.
+var pruneIndex = redis.NewScript(`
+local removed = 0
+for i = 2, #KEYS do
+	if redis.call("EXISTS", KEYS[i]) == 0 then
+		removed = removed + redis.call("SREM", KEYS[1], KEYS[i])
+	end
+end
+return removed
+`)
+
+var (
+	ErrUnknownNamespace = errors.New("unknown cache namespace")
+	ErrDurableNamespace = errors.New("cache namespace cannot be flushed")
+)
+
+// NamespaceOptions describes a namespace registered with RegisterNamespace.
+type NamespaceOptions struct {
+	// Durable namespaces hold state rather than copies of the database,
+	// such as revoked tokens, and cannot be flushed.
+	Durable bool
+
+	// Tenant namespaces hold merchant data, which callers restricted to
+	// some merchants must not share, so each caller reads and writes them
+	// in their own partition (see partitionKey).
+	Tenant bool
+}
+
+type NamespaceStats struct {
+	Namespace    string `json:"namespace"`
+	Keys         int64  `json:"keys"`
+	LocalEntries int    `json:"local_entries"`
+	LocalTier    bool   `json:"local_tier"`
+	Durable      bool   `json:"durable"`
+}
+
+// RegisterNamespace records namespace so its keys are indexed. Keys of
+// namespaces that were never registered are still cached, but can only
+// expire. Packages sharing a namespace may each register it; it is durable
+// or partitioned by tenant if any of them says so.
+func (store *CacheStore) RegisterNamespace(namespace string, opts NamespaceOptions) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	current := store.namespaces[namespace]
+	current.Durable = current.Durable || opts.Durable
+	current.Tenant = current.Tenant || opts.Tenant
+	store.namespaces[namespace] = current
+}
+
+// Namespaces returns the registered namespaces in order.
+func (store *CacheStore) Namespaces() []string {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	names := make([]string, 0, len(store.namespaces))
+	for name := range store.namespaces {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// namespaceOf returns the namespace of key and whether it is registered.
+func (store *CacheStore) namespaceOf(key string) (string, bool) {
+	namespace, _, found := strings.Cut(key, ":")
+	if !found {
+		return "", false
+	}
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	_, ok := store.namespaces[namespace]
+	return namespace, ok
+}
+
+// partitionKey returns the key the caller of ctx stores key under. Keys of
+// tenant namespaces get the caller's merchant scope appended; on the
+// gateway, where the scope is not known yet, the caller's user id. Admins
+// and background jobs share the unpartitioned key. Tags are not
+// partitioned, so invalidating one still reaches every partition.
+func (store *CacheStore) partitionKey(ctx context.Context, key string) string {
+	namespace, _, found := strings.Cut(key, ":")
+	if !found {
+		return key
+	}
+
+	store.mu.RLock()
+	tenant := store.namespaces[namespace].Tenant
+	store.mu.RUnlock()
+
+	if !tenant {
+		return key
+	}
+
+	if scope, ok := auth.MerchantScopeFromContext(ctx); ok {
+		ids := make([]string, len(scope))
+		for i, id := range scope {
+			ids[i] = strconv.Itoa(id)
+		}
+
+		return key + ":scope:" + strings.Join(ids, ",")
+	}
+
+	if userID, ok := auth.UserIDFromContext(ctx); ok && !auth.HasAnyRole(auth.RolesFromContext(ctx), auth.RoleAdmin) {
+		return key + ":user:" + strconv.Itoa(userID)
+	}
+
+	return key
+}
+
+func (store *CacheStore) key(key string) string {
+	return store.prefix + key
+}
+
+func (store *CacheStore) indexKey(namespace string) string {
+	return store.key(indexKeyPrefix + namespace)
+}
+
+// set writes payload under key and indexes it in the same round trip.
+func (store *CacheStore) set(ctx context.Context, key string, payload []byte, expiration time.Duration) error {
+	namespace, ok := store.namespaceOf(key)
+	if !ok {
+		return store.redis.Set(ctx, store.key(key), payload, expiration).Err()
+	}
+
+	pipe := store.redis.TxPipeline()
+	pipe.Set(ctx, store.key(key), payload, expiration)
+	pipe.SAdd(ctx, store.indexKey(namespace), store.key(key))
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// InspectNamespace reports how many keys namespace holds. Keys that expired
+// since the last PruneNamespaces are still counted.
+func (store *CacheStore) InspectNamespace(ctx context.Context, namespace string) (*NamespaceStats, error) {
+	atomic.AddInt64(&store.refCount, 1)
+	defer atomic.AddInt64(&store.refCount, -1)
+
+	store.mu.RLock()
+	opts, registered := store.namespaces[namespace]
+	tier := store.local[namespace]
+	store.mu.RUnlock()
+
+	if !registered {
+		return nil, ErrUnknownNamespace
+	}
+
+	keys, err := store.redis.SCard(ctx, store.indexKey(namespace)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to count keys of namespace %s: %w", namespace, err)
+	}
+
+	stats := &NamespaceStats{
+		Namespace: namespace,
+		Keys:      keys,
+		LocalTier: tier != nil,
+		Durable:   opts.Durable,
+	}
+	if tier != nil {
+		stats.LocalEntries = tier.len()
+	}
+
+	return stats, nil
+}
+
+// FlushNamespace deletes every key of namespace, including the in-process
+// copies held by other replicas, and returns how many were deleted. Keys
+// written while the flush runs may survive it.
+func (store *CacheStore) FlushNamespace(ctx context.Context, namespace string) (int64, error) {
+	atomic.AddInt64(&store.refCount, 1)
+	defer atomic.AddInt64(&store.refCount, -1)
+
+	start := time.Now()
+	defer func() {
+		store.metrics.RecordCacheOperationLatency(ctx, "flush_namespace", time.Since(start))
+	}()
+
+	store.mu.RLock()
+	opts, registered := store.namespaces[namespace]
+	store.mu.RUnlock()
+
+	switch {
+	case !registered:
+		return 0, ErrUnknownNamespace
+	case opts.Durable:
+		return 0, ErrDurableNamespace
+	}
+
+	var deleted int64
+
+	err := store.walkIndex(ctx, namespace, func(keys []string) error {
+		pipe := store.redis.TxPipeline()
+		del := pipe.Del(ctx, keys...)
+		pipe.SRem(ctx, store.indexKey(namespace), toAny(keys)...)
+
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err
+		}
+
+		deleted += del.Val()
+		return nil
+	})
+
+	inv := invalidation{Namespaces: []string{namespace}}
+	store.invalidateLocal(inv)
+
+	pipe := store.redis.Pipeline()
+	store.publishInvalidation(ctx, pipe, inv)
+	if _, pubErr := pipe.Exec(ctx); pubErr != nil {
+		store.Logger.Error("Failed to publish namespace flush", zap.Error(pubErr), zap.String("namespace", namespace))
+	}
+
+	if err != nil {
+		return deleted, fmt.Errorf("failed to flush namespace %s: %w", namespace, err)
+	}
+
+	store.Logger.Info("Flushed cache namespace",
+		zap.String("namespace", namespace),
+		zap.Int64("count", deleted))
+
+	return deleted, nil
+}
+
+// PruneNamespaces drops keys that expired from the index of every registered
+// namespace and returns how many were dropped. Redis expires the keys
+// themselves; this only stops the indexes from growing.
+func (store *CacheStore) PruneNamespaces(ctx context.Context) (int64, error) {
+	atomic.AddInt64(&store.refCount, 1)
+	defer atomic.AddInt64(&store.refCount, -1)
+
+	start := time.Now()
+	defer func() {
+		store.metrics.RecordCacheOperationLatency(ctx, "prune_namespaces", time.Since(start))
+	}()
+
+	var pruned int64
+
+	for _, namespace := range store.Namespaces() {
+		err := store.walkIndex(ctx, namespace, func(keys []string) error {
+			removed, err := pruneIndex.Run(ctx, store.redis, append([]string{store.indexKey(namespace)}, keys...)).Int64()
+			pruned += removed
+			return err
+		})
+		if err != nil {
+			return pruned, fmt.Errorf("failed to prune namespace %s: %w", namespace, err)
+		}
+	}
+
+	store.lastCleanupTime = time.Now()
+
+	return pruned, nil
+}
+
+// walkIndex calls fn with the indexed keys of namespace in batches.
+func (store *CacheStore) walkIndex(ctx context.Context, namespace string, fn func(keys []string) error) error {
+	var cursor uint64
+
+	for {
+		keys, next, err := store.redis.SScan(ctx, store.indexKey(namespace), cursor, "", indexBatchSize).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			if err := fn(keys); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
+// indexedKeys counts the keys of every registered namespace.
+func (store *CacheStore) indexedKeys(ctx context.Context) (int64, error) {
+	namespaces := store.Namespaces()
+	if len(namespaces) == 0 {
+		return 0, nil
+	}
+
+	pipe := store.redis.Pipeline()
+	counts := make([]*redis.IntCmd, len(namespaces))
+	for i, namespace := range namespaces {
+		counts[i] = pipe.SCard(ctx, store.indexKey(namespace))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, count := range counts {
+		total += count.Val()
+	}
+
+	return total, nil
+}
+
+func toAny(keys []string) []any {
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		values[i] = key
+	}
+
+	return values
+}
@@ -17,6 +17,8 @@ type orderMencache struct {
 }
 
 func NewOrderMencache(store *cache.CacheStore) OrderMencache {
+	store.RegisterNamespace("order", cache.NamespaceOptions{Tenant: true})
+
 	return &orderMencache{
 		OrderQueryCache:           NewOrderQueryCache(store),
 		OrderCommandCache:         NewOrderCommandCache(store),
@@ -16,4 +16,9 @@ func NewOrderCommandCache(store *cache.CacheStore) *orderCommandCache {
 
 func (s *orderCommandCache) DeleteOrderCache(ctx context.Context, order_id int) {
 	cache.DeleteFromCache(ctx, s.store, fmt.Sprintf(orderByIdCacheKey, order_id))
+	s.InvalidateOrderCache(ctx)
+}
+
+func (s *orderCommandCache) InvalidateOrderCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, s.store, entityTag, stockTag)
 }
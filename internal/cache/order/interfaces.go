@@ -7,31 +7,23 @@ import (
 )
 
 type OrderStatsCache interface {
-	GetMonthlyTotalRevenueCache(ctx context.Context, req *requests.MonthTotalRevenue) ([]*db.GetMonthlyTotalRevenueRow, bool)
-	SetMonthlyTotalRevenueCache(ctx context.Context, req *requests.MonthTotalRevenue, res []*db.GetMonthlyTotalRevenueRow)
+	GetOrLoadMonthlyTotalRevenue(ctx context.Context, req *requests.MonthTotalRevenue, load func(ctx context.Context) ([]*db.GetMonthlyTotalRevenueRow, error)) ([]*db.GetMonthlyTotalRevenueRow, error)
 
-	GetYearlyTotalRevenueCache(ctx context.Context, year int) ([]*db.GetYearlyTotalRevenueRow, bool)
-	SetYearlyTotalRevenueCache(ctx context.Context, year int, res []*db.GetYearlyTotalRevenueRow)
+	GetOrLoadYearlyTotalRevenue(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyTotalRevenueRow, error)) ([]*db.GetYearlyTotalRevenueRow, error)
 
-	GetMonthlyOrderCache(ctx context.Context, year int) ([]*db.GetMonthlyOrderRow, bool)
-	SetMonthlyOrderCache(ctx context.Context, year int, res []*db.GetMonthlyOrderRow)
+	GetOrLoadMonthlyOrder(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetMonthlyOrderRow, error)) ([]*db.GetMonthlyOrderRow, error)
 
-	GetYearlyOrderCache(ctx context.Context, year int) ([]*db.GetYearlyOrderRow, bool)
-	SetYearlyOrderCache(ctx context.Context, year int, res []*db.GetYearlyOrderRow)
+	GetOrLoadYearlyOrder(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyOrderRow, error)) ([]*db.GetYearlyOrderRow, error)
 }
 
 type OrderStatsByMerchantCache interface {
-	GetMonthlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.MonthTotalRevenueMerchant) ([]*db.GetMonthlyTotalRevenueByMerchantRow, bool)
-	SetMonthlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.MonthTotalRevenueMerchant, res []*db.GetMonthlyTotalRevenueByMerchantRow)
+	GetOrLoadMonthlyTotalRevenueByMerchant(ctx context.Context, req *requests.MonthTotalRevenueMerchant, load func(ctx context.Context) ([]*db.GetMonthlyTotalRevenueByMerchantRow, error)) ([]*db.GetMonthlyTotalRevenueByMerchantRow, error)
 
-	GetYearlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.YearTotalRevenueMerchant) ([]*db.GetYearlyTotalRevenueByMerchantRow, bool)
-	SetYearlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.YearTotalRevenueMerchant, res []*db.GetYearlyTotalRevenueByMerchantRow)
+	GetOrLoadYearlyTotalRevenueByMerchant(ctx context.Context, req *requests.YearTotalRevenueMerchant, load func(ctx context.Context) ([]*db.GetYearlyTotalRevenueByMerchantRow, error)) ([]*db.GetYearlyTotalRevenueByMerchantRow, error)
 
-	GetMonthlyOrderByMerchantCache(ctx context.Context, req *requests.MonthOrderMerchant) ([]*db.GetMonthlyOrderByMerchantRow, bool)
-	SetMonthlyOrderByMerchantCache(ctx context.Context, req *requests.MonthOrderMerchant, res []*db.GetMonthlyOrderByMerchantRow)
+	GetOrLoadMonthlyOrderByMerchant(ctx context.Context, req *requests.MonthOrderMerchant, load func(ctx context.Context) ([]*db.GetMonthlyOrderByMerchantRow, error)) ([]*db.GetMonthlyOrderByMerchantRow, error)
 
-	GetYearlyOrderByMerchantCache(ctx context.Context, req *requests.YearOrderMerchant) ([]*db.GetYearlyOrderByMerchantRow, bool)
-	SetYearlyOrderByMerchantCache(ctx context.Context, req *requests.YearOrderMerchant, res []*db.GetYearlyOrderByMerchantRow)
+	GetOrLoadYearlyOrderByMerchant(ctx context.Context, req *requests.YearOrderMerchant, load func(ctx context.Context) ([]*db.GetYearlyOrderByMerchantRow, error)) ([]*db.GetYearlyOrderByMerchantRow, error)
 }
 
 type OrderQueryCache interface {
@@ -53,4 +45,5 @@ type OrderQueryCache interface {
 
 type OrderCommandCache interface {
 	DeleteOrderCache(ctx context.Context, id int)
+	InvalidateOrderCache(ctx context.Context)
 }
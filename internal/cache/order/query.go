@@ -10,18 +10,25 @@ import (
 )
 
 const (
-	orderAllCacheKey     = "order:all:page:%d:pageSize:%d:search:%s"
+	orderAllCacheKey     = "order:all:page:%d:pageSize:%d:search:%s:status:%s"
 	orderByIdCacheKey    = "order:id:%d"
-	orderActiveCacheKey  = "order:active:page:%d:pageSize:%d:search:%s"
-	orderTrashedCacheKey = "order:trashed:page:%d:pageSize:%d:search:%s"
+	orderActiveCacheKey  = "order:active:page:%d:pageSize:%d:search:%s:status:%s"
+	orderTrashedCacheKey = "order:trashed:page:%d:pageSize:%d:search:%s:status:%s"
 
 	ttlDefault = 5 * time.Minute
 )
 
+var (
+	entityTag = cache.EntityTag("order")
+
+	// Writes move product stock, so they also make cached products stale.
+	stockTag = cache.EntityTag("product")
+)
+
 // ... (definisi cache key dan ttlDefault tetap sama) ...
 
 // Asumsi ada cache key khusus untuk query berdasarkan merchant
-const orderMerchantCacheKey = "order:merchant:%d:page:%d:pageSize:%d:search:%s"
+const orderMerchantCacheKey = "order:merchant:%d:page:%d:pageSize:%d:search:%s:status:%s"
 
 // Menggunakan struct generik untuk membungkus data cache yang berupa list.
 // Ini mengurangi pengulangan kode untuk setiap tipe db yang berbeda.
@@ -39,9 +46,9 @@ func NewOrderQueryCache(store *cache.CacheStore) *orderQueryCache {
 }
 
 func (s *orderQueryCache) GetOrderAllCache(ctx context.Context, req *requests.FindAllOrders) ([]*db.GetOrdersRow, *int, bool) {
-	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -60,15 +67,15 @@ func (s *orderQueryCache) SetOrderAllCache(ctx context.Context, req *requests.Fi
 		data = []*db.GetOrdersRow{}
 	}
 
-	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderAllCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 	payload := &orderListCacheResponse[*db.GetOrdersRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *orderQueryCache) GetCachedOrderCache(ctx context.Context, orderID int) (*db.GetOrderByIDRow, bool) {
 	key := fmt.Sprintf(orderByIdCacheKey, orderID)
 
-	result, found := cache.GetFromCache[*db.GetOrderByIDRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[*db.GetOrderByIDRow](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -83,13 +90,13 @@ func (s *orderQueryCache) SetCachedOrderCache(ctx context.Context, data *db.GetO
 	}
 
 	key := fmt.Sprintf(orderByIdCacheKey, data.OrderID)
-	cache.SetToCache(ctx, s.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, data, ttlDefault, entityTag)
 }
 
 func (s *orderQueryCache) GetCachedOrderMerchant(ctx context.Context, req *requests.FindAllOrderMerchant) ([]*db.GetOrdersByMerchantRow, *int, bool) {
-	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersByMerchantRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersByMerchantRow]](ctx, s.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -108,15 +115,15 @@ func (s *orderQueryCache) SetCachedOrderMerchant(ctx context.Context, req *reque
 		res = []*db.GetOrdersByMerchantRow{}
 	}
 
-	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.Status)
 	payload := &orderListCacheResponse[*db.GetOrdersByMerchantRow]{Data: res, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (s *orderQueryCache) GetOrderActiveCache(ctx context.Context, req *requests.FindAllOrders) ([]*db.GetOrdersActiveRow, *int, bool) {
-	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersActiveRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersActiveRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -135,15 +142,15 @@ func (s *orderQueryCache) SetOrderActiveCache(ctx context.Context, req *requests
 		data = []*db.GetOrdersActiveRow{}
 	}
 
-	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderActiveCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 	payload := &orderListCacheResponse[*db.GetOrdersActiveRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *orderQueryCache) GetOrderTrashedCache(ctx context.Context, req *requests.FindAllOrders) ([]*db.GetOrdersTrashedRow, *int, bool) {
-	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 
-	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersTrashedRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[orderListCacheResponse[*db.GetOrdersTrashedRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -162,7 +169,7 @@ func (s *orderQueryCache) SetOrderTrashedCache(ctx context.Context, req *request
 		data = []*db.GetOrdersTrashedRow{}
 	}
 
-	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(orderTrashedCacheKey, req.Page, req.PageSize, req.Search, req.Status)
 	payload := &orderListCacheResponse[*db.GetOrdersTrashedRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
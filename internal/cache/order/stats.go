@@ -6,6 +6,7 @@ import (
 	"pointofsale/internal/cache"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
+	"time"
 )
 
 const (
@@ -16,6 +17,19 @@ const (
 	yearlyOrderCacheKey  = "order:yearly:order:year:%d"
 )
 
+// Revenue stats are aggregate queries over every order; after they expire
+// the old value is served for statsStaleTTL while one caller recomputes it.
+const statsStaleTTL = 10 * time.Minute
+
+func statsLoadOptions(tags ...string) cache.LoadOptions {
+	return cache.LoadOptions{
+		TTL:      ttlDefault,
+		StaleTTL: statsStaleTTL,
+		Jitter:   0.2,
+		Tags:     tags,
+	}
+}
+
 type orderStatsCache struct {
 	store *cache.CacheStore
 }
@@ -24,89 +38,26 @@ func NewOrderStatsCache(store *cache.CacheStore) *orderStatsCache {
 	return &orderStatsCache{store: store}
 }
 
-func (s *orderStatsCache) GetMonthlyTotalRevenueCache(ctx context.Context, req *requests.MonthTotalRevenue) ([]*db.GetMonthlyTotalRevenueRow, bool) {
+func (s *orderStatsCache) GetOrLoadMonthlyTotalRevenue(ctx context.Context, req *requests.MonthTotalRevenue, load func(ctx context.Context) ([]*db.GetMonthlyTotalRevenueRow, error)) ([]*db.GetMonthlyTotalRevenueRow, error) {
 	key := fmt.Sprintf(monthlyTotalRevenueCacheKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalRevenueRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	// Perbaikan: Mengembalikan 'result' langsung, bukan '*result'
-	return result, true
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (s *orderStatsCache) SetMonthlyTotalRevenueCache(ctx context.Context, req *requests.MonthTotalRevenue, res []*db.GetMonthlyTotalRevenueRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(monthlyTotalRevenueCacheKey, req.Month, req.Year)
-
-	// Perbaikan: Menggunakan 'cache.SetToCache' untuk konsistensi
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
-}
-
-func (s *orderStatsCache) GetYearlyTotalRevenueCache(ctx context.Context, year int) ([]*db.GetYearlyTotalRevenueRow, bool) {
+func (s *orderStatsCache) GetOrLoadYearlyTotalRevenue(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyTotalRevenueRow, error)) ([]*db.GetYearlyTotalRevenueRow, error) {
 	key := fmt.Sprintf(yearlyTotalRevenueCacheKey, year)
 
-	result, found := cache.GetFromCache[[]*db.GetYearlyTotalRevenueRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (s *orderStatsCache) SetYearlyTotalRevenueCache(ctx context.Context, year int, res []*db.GetYearlyTotalRevenueRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(yearlyTotalRevenueCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
-}
-
-func (s *orderStatsCache) GetMonthlyOrderCache(ctx context.Context, year int) ([]*db.GetMonthlyOrderRow, bool) {
+func (s *orderStatsCache) GetOrLoadMonthlyOrder(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetMonthlyOrderRow, error)) ([]*db.GetMonthlyOrderRow, error) {
 	key := fmt.Sprintf(monthlyOrderCacheKey, year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyOrderRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (s *orderStatsCache) SetMonthlyOrderCache(ctx context.Context, year int, res []*db.GetMonthlyOrderRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(monthlyOrderCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (s *orderStatsCache) GetYearlyOrderCache(ctx context.Context, year int) ([]*db.GetYearlyOrderRow, bool) {
+func (s *orderStatsCache) GetOrLoadYearlyOrder(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyOrderRow, error)) ([]*db.GetYearlyOrderRow, error) {
 	key := fmt.Sprintf(yearlyOrderCacheKey, year)
 
-	result, found := cache.GetFromCache[[]*db.GetYearlyOrderRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (s *orderStatsCache) SetYearlyOrderCache(ctx context.Context, year int, res []*db.GetYearlyOrderRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(yearlyOrderCacheKey, year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag), load)
 }
@@ -24,88 +24,26 @@ func NewOrderStatsByMerchantCache(store *cache.CacheStore) *orderStatsByMerchant
 	return &orderStatsByMerchantCache{store: store}
 }
 
-func (s *orderStatsByMerchantCache) GetMonthlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.MonthTotalRevenueMerchant) ([]*db.GetMonthlyTotalRevenueByMerchantRow, bool) {
+func (s *orderStatsByMerchantCache) GetOrLoadMonthlyTotalRevenueByMerchant(ctx context.Context, req *requests.MonthTotalRevenueMerchant, load func(ctx context.Context) ([]*db.GetMonthlyTotalRevenueByMerchantRow, error)) ([]*db.GetMonthlyTotalRevenueByMerchantRow, error) {
 	key := fmt.Sprintf(monthlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTotalRevenueByMerchantRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	// Perbaikan: Mengembalikan 'result' langsung, bukan '*result'
-	return result, true
-}
-
-func (s *orderStatsByMerchantCache) SetMonthlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.MonthTotalRevenueMerchant, res []*db.GetMonthlyTotalRevenueByMerchantRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(monthlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Month, req.Year)
-	// Perbaikan: Menggunakan 'cache.SetToCache' untuk konsistensi
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (s *orderStatsByMerchantCache) GetYearlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.YearTotalRevenueMerchant) ([]*db.GetYearlyTotalRevenueByMerchantRow, bool) {
+func (s *orderStatsByMerchantCache) GetOrLoadYearlyTotalRevenueByMerchant(ctx context.Context, req *requests.YearTotalRevenueMerchant, load func(ctx context.Context) ([]*db.GetYearlyTotalRevenueByMerchantRow, error)) ([]*db.GetYearlyTotalRevenueByMerchantRow, error) {
 	key := fmt.Sprintf(yearlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetYearlyTotalRevenueByMerchantRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (s *orderStatsByMerchantCache) SetYearlyTotalRevenueByMerchantCache(ctx context.Context, req *requests.YearTotalRevenueMerchant, res []*db.GetYearlyTotalRevenueByMerchantRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(yearlyTotalRevenueCacheKeyByMerchant, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (s *orderStatsByMerchantCache) GetMonthlyOrderByMerchantCache(ctx context.Context, req *requests.MonthOrderMerchant) ([]*db.GetMonthlyOrderByMerchantRow, bool) {
+func (s *orderStatsByMerchantCache) GetOrLoadMonthlyOrderByMerchant(ctx context.Context, req *requests.MonthOrderMerchant, load func(ctx context.Context) ([]*db.GetMonthlyOrderByMerchantRow, error)) ([]*db.GetMonthlyOrderByMerchantRow, error) {
 	key := fmt.Sprintf(monthlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyOrderByMerchantRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (s *orderStatsByMerchantCache) SetMonthlyOrderByMerchantCache(ctx context.Context, req *requests.MonthOrderMerchant, res []*db.GetMonthlyOrderByMerchantRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(monthlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (s *orderStatsByMerchantCache) GetYearlyOrderByMerchantCache(ctx context.Context, req *requests.YearOrderMerchant) ([]*db.GetYearlyOrderByMerchantRow, bool) {
+func (s *orderStatsByMerchantCache) GetOrLoadYearlyOrderByMerchant(ctx context.Context, req *requests.YearOrderMerchant, load func(ctx context.Context) ([]*db.GetYearlyOrderByMerchantRow, error)) ([]*db.GetYearlyOrderByMerchantRow, error) {
 	key := fmt.Sprintf(yearlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetYearlyOrderByMerchantRow](ctx, s.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (s *orderStatsByMerchantCache) SetYearlyOrderByMerchantCache(ctx context.Context, req *requests.YearOrderMerchant, res []*db.GetYearlyOrderByMerchantRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(yearlyOrderCacheKeyByMerchant, req.MerchantID, req.Year)
-	cache.SetToCache(ctx, s.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, s.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
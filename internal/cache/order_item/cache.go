@@ -11,6 +11,8 @@ type orderItemCache struct {
 }
 
 func NewOrderItemCache(store *cache.CacheStore) OrderItemCache {
+	store.RegisterNamespace("order_item", cache.NamespaceOptions{Tenant: true})
+
 	return &orderItemCache{
 		OrderItemQueryCache: NewOrderItemQueryCache(store),
 	}
@@ -19,6 +19,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("order")
+
 type orderItemListCacheResponse[T any] struct {
 	Data         []T  `json:"data"`
 	TotalRecords *int `json:"total_records"`
@@ -35,7 +37,7 @@ func NewOrderItemQueryCache(store *cache.CacheStore) *orderItemQueryCache {
 func (o *orderItemQueryCache) GetCachedOrderItemsAll(ctx context.Context, req *requests.FindAllOrderItems) ([]*db.GetOrderItemsRow, *int, bool) {
 	key := fmt.Sprintf(orderItemAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[orderItemListCacheResponse[*db.GetOrderItemsRow]](ctx, o.store, key)
+	result, found := cache.GetFromCache[orderItemListCacheResponse[*db.GetOrderItemsRow]](ctx, o.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -56,13 +58,13 @@ func (o *orderItemQueryCache) SetCachedOrderItemsAll(ctx context.Context, req *r
 
 	key := fmt.Sprintf(orderItemAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &orderItemListCacheResponse[*db.GetOrderItemsRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, o.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, payload, ttlDefault, entityTag)
 }
 
 func (o *orderItemQueryCache) GetCachedOrderItemActive(ctx context.Context, req *requests.FindAllOrderItems) ([]*db.GetOrderItemsActiveRow, *int, bool) {
 	key := fmt.Sprintf(orderItemActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[orderItemListCacheResponse[*db.GetOrderItemsActiveRow]](ctx, o.store, key)
+	result, found := cache.GetFromCache[orderItemListCacheResponse[*db.GetOrderItemsActiveRow]](ctx, o.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -83,12 +85,12 @@ func (o *orderItemQueryCache) SetCachedOrderItemActive(ctx context.Context, req
 
 	key := fmt.Sprintf(orderItemActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &orderItemListCacheResponse[*db.GetOrderItemsActiveRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, o.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, payload, ttlDefault, entityTag)
 }
 
 func (o *orderItemQueryCache) GetCachedOrderItemTrashed(ctx context.Context, req *requests.FindAllOrderItems) ([]*db.GetOrderItemsTrashedRow, *int, bool) {
 	key := fmt.Sprintf(orderItemTrashedCacheKey, req.Page, req.PageSize, req.Search)
-	result, found := cache.GetFromCache[orderItemListCacheResponse[*db.GetOrderItemsTrashedRow]](ctx, o.store, key)
+	result, found := cache.GetFromCache[orderItemListCacheResponse[*db.GetOrderItemsTrashedRow]](ctx, o.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -109,12 +111,12 @@ func (o *orderItemQueryCache) SetCachedOrderItemTrashed(ctx context.Context, req
 
 	key := fmt.Sprintf(orderItemTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &orderItemListCacheResponse[*db.GetOrderItemsTrashedRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, o.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, payload, ttlDefault, entityTag)
 }
 
 func (o *orderItemQueryCache) GetCachedOrderItems(ctx context.Context, orderID int) ([]*db.GetOrderItemsByOrderRow, bool) {
 	key := fmt.Sprintf(orderItemByIdCacheKey, orderID)
-	result, found := cache.GetFromCache[[]*db.GetOrderItemsByOrderRow](ctx, o.store, key)
+	result, found := cache.GetFromCache[[]*db.GetOrderItemsByOrderRow](ctx, o.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -129,5 +131,5 @@ func (o *orderItemQueryCache) SetCachedOrderItems(ctx context.Context, data []*d
 	}
 
 	key := fmt.Sprintf(orderItemByIdCacheKey, data[0].OrderID)
-	cache.SetToCache(ctx, o.store, key, &data, ttlDefault)
+	cache.SetToCache(ctx, o.store, key, &data, ttlDefault, entityTag)
 }
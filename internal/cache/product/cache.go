@@ -13,6 +13,8 @@ type ProductMencache interface {
 }
 
 func NewProductMencache(store *cache.CacheStore) ProductMencache {
+	store.RegisterNamespace("product", cache.NamespaceOptions{Tenant: true})
+
 	return &productMencache{
 		ProductQueryCache:   NewProductQueryCache(store),
 		ProductCommandCache: NewProductCommandCache(store),
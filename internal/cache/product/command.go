@@ -16,4 +16,17 @@ func NewProductCommandCache(store *cache.CacheStore) *productCommandCache {
 
 func (c *productCommandCache) DeleteCachedProduct(ctx context.Context, productID int) {
 	cache.DeleteFromCache(ctx, c.store, fmt.Sprintf(productByIdCacheKey, productID))
+	c.InvalidateProductCache(ctx)
+}
+
+func (c *productCommandCache) DeleteCachedProductBarcode(ctx context.Context, barcode *string) {
+	if barcode == nil {
+		return
+	}
+
+	cache.DeleteFromCache(ctx, c.store, fmt.Sprintf(productBarcodeCacheKey, *barcode))
+}
+
+func (c *productCommandCache) InvalidateProductCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, c.store, entityTag)
 }
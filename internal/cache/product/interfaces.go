@@ -24,8 +24,13 @@ type ProductQueryCache interface {
 
 	GetCachedProduct(ctx context.Context, productID int) (*db.GetProductByIDRow, bool)
 	SetCachedProduct(ctx context.Context, data *db.GetProductByIDRow)
+
+	GetCachedProductByBarcode(ctx context.Context, barcode string) (*db.GetProductByScanRow, bool)
+	SetCachedProductByBarcode(ctx context.Context, barcode string, data *db.GetProductByScanRow)
 }
 
 type ProductCommandCache interface {
 	DeleteCachedProduct(ctx context.Context, productID int)
+	InvalidateProductCache(ctx context.Context)
+	DeleteCachedProductBarcode(ctx context.Context, barcode *string)
 }
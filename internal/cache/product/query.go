@@ -11,16 +11,23 @@ import (
 
 const (
 	productAllCacheKey      = "product:all:page:%d:pageSize:%d:search:%s"
-	productCategoryCacheKey = "product:category:%s:page:%d:pageSize:%d:search:%s"
-	productMerchantCacheKey = "product:merchant:%d:page:%d:pageSize:%d:search:%s"
+	productCategoryCacheKey = "product:category:%s:page:%d:pageSize:%d:search:%s:minPrice:%d:maxPrice:%d:subcategories:%t"
+	productMerchantCacheKey = "product:merchant:%d:page:%d:pageSize:%d:search:%s:category:%d:minPrice:%d:maxPrice:%d"
 
 	productActiveCacheKey  = "product:active:page:%d:pageSize:%d:search:%s"
 	productTrashedCacheKey = "product:trashed:page:%d:pageSize:%d:search:%s"
 	productByIdCacheKey    = "product:id:%d"
+	productBarcodeCacheKey = "product:barcode:%s"
 
 	ttlDefault = 5 * time.Minute
+
+	// ttlBarcode is short because a scanned product carries its stock level,
+	// which moves with every sale.
+	ttlBarcode = 30 * time.Second
 )
 
+var entityTag = cache.EntityTag("product")
+
 type productListCacheResponse[T any] struct {
 	Data         []T  `json:"data"`
 	TotalRecords *int `json:"total_records"`
@@ -37,7 +44,7 @@ func NewProductQueryCache(store *cache.CacheStore) *productQueryCache {
 func (p *productQueryCache) GetCachedProducts(ctx context.Context, req *requests.FindAllProducts) ([]*db.GetProductsRow, *int, bool) {
 	key := fmt.Sprintf(productAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsRow]](ctx, p.store, key)
+	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsRow]](ctx, p.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -57,13 +64,13 @@ func (p *productQueryCache) SetCachedProducts(ctx context.Context, req *requests
 
 	key := fmt.Sprintf(productAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &productListCacheResponse[*db.GetProductsRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, p.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, payload, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProductsByMerchant(ctx context.Context, req *requests.ProductByMerchantRequest) ([]*db.GetProductsByMerchantRow, *int, bool) {
-	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.CategoryID, req.MinPrice, req.MaxPrice)
 
-	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsByMerchantRow]](ctx, p.store, key)
+	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsByMerchantRow]](ctx, p.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -82,15 +89,15 @@ func (p *productQueryCache) SetCachedProductsByMerchant(ctx context.Context, req
 		data = []*db.GetProductsByMerchantRow{}
 	}
 
-	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(productMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search, req.CategoryID, req.MinPrice, req.MaxPrice)
 	payload := &productListCacheResponse[*db.GetProductsByMerchantRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, p.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, payload, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (p *productQueryCache) GetCachedProductsByCategory(ctx context.Context, req *requests.ProductByCategoryRequest) ([]*db.GetProductsByCategoryNameRow, *int, bool) {
-	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search, req.MinPrice, req.MaxPrice, req.IncludeSubcategories)
 
-	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsByCategoryNameRow]](ctx, p.store, key)
+	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsByCategoryNameRow]](ctx, p.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -109,15 +116,15 @@ func (p *productQueryCache) SetCachedProductsByCategory(ctx context.Context, req
 		data = []*db.GetProductsByCategoryNameRow{}
 	}
 
-	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search)
+	key := fmt.Sprintf(productCategoryCacheKey, req.CategoryName, req.Page, req.PageSize, req.Search, req.MinPrice, req.MaxPrice, req.IncludeSubcategories)
 	payload := &productListCacheResponse[*db.GetProductsByCategoryNameRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, p.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, payload, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProductActive(ctx context.Context, req *requests.FindAllProducts) ([]*db.GetProductsActiveRow, *int, bool) {
 	key := fmt.Sprintf(productActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsActiveRow]](ctx, p.store, key)
+	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsActiveRow]](ctx, p.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -138,13 +145,13 @@ func (p *productQueryCache) SetCachedProductActive(ctx context.Context, req *req
 
 	key := fmt.Sprintf(productActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &productListCacheResponse[*db.GetProductsActiveRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, p.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, payload, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProductTrashed(ctx context.Context, req *requests.FindAllProducts) ([]*db.GetProductsTrashedRow, *int, bool) {
 	key := fmt.Sprintf(productTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsTrashedRow]](ctx, p.store, key)
+	result, found := cache.GetFromCache[productListCacheResponse[*db.GetProductsTrashedRow]](ctx, p.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -165,13 +172,13 @@ func (p *productQueryCache) SetCachedProductTrashed(ctx context.Context, req *re
 
 	key := fmt.Sprintf(productTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &productListCacheResponse[*db.GetProductsTrashedRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, p.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, payload, ttlDefault, entityTag)
 }
 
 func (p *productQueryCache) GetCachedProduct(ctx context.Context, productID int) (*db.GetProductByIDRow, bool) {
 	key := fmt.Sprintf(productByIdCacheKey, productID)
 
-	result, found := cache.GetFromCache[*db.GetProductByIDRow](ctx, p.store, key)
+	result, found := cache.GetFromCache[*db.GetProductByIDRow](ctx, p.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -186,5 +193,26 @@ func (p *productQueryCache) SetCachedProduct(ctx context.Context, data *db.GetPr
 	}
 
 	key := fmt.Sprintf(productByIdCacheKey, data.ProductID)
-	cache.SetToCache(ctx, p.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, p.store, key, data, ttlDefault, entityTag)
+}
+
+func (p *productQueryCache) GetCachedProductByBarcode(ctx context.Context, barcode string) (*db.GetProductByScanRow, bool) {
+	key := fmt.Sprintf(productBarcodeCacheKey, barcode)
+
+	result, found := cache.GetFromCache[*db.GetProductByScanRow](ctx, p.store, key, entityTag)
+
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
+
+func (p *productQueryCache) SetCachedProductByBarcode(ctx context.Context, barcode string, data *db.GetProductByScanRow) {
+	if data == nil {
+		return
+	}
+
+	key := fmt.Sprintf(productBarcodeCacheKey, barcode)
+	cache.SetToCache(ctx, p.store, key, data, ttlBarcode, entityTag)
 }
@@ -0,0 +1,22 @@
+package product_variant_cache
+
+import "pointofsale/internal/cache"
+
+type ProductVariantMencache interface {
+	ProductVariantQueryCache
+	ProductVariantCommandCache
+}
+
+type productVariantMencache struct {
+	ProductVariantQueryCache
+	ProductVariantCommandCache
+}
+
+func NewProductVariantMencache(store *cache.CacheStore) ProductVariantMencache {
+	store.RegisterNamespace("product_variant", cache.NamespaceOptions{Tenant: true})
+
+	return &productVariantMencache{
+		ProductVariantQueryCache:   NewProductVariantQueryCache(store),
+		ProductVariantCommandCache: NewProductVariantCommandCache(store),
+	}
+}
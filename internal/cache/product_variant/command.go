@@ -0,0 +1,26 @@
+package product_variant_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+)
+
+type productVariantCommandCache struct {
+	store *cache.CacheStore
+}
+
+func NewProductVariantCommandCache(store *cache.CacheStore) *productVariantCommandCache {
+	return &productVariantCommandCache{store: store}
+}
+
+func (c *productVariantCommandCache) DeleteCachedProductVariant(ctx context.Context, id int) {
+	key := fmt.Sprintf(productVariantByIdCacheKey, id)
+
+	cache.DeleteFromCache(ctx, c.store, key)
+	c.InvalidateProductVariantCache(ctx)
+}
+
+func (c *productVariantCommandCache) InvalidateProductVariantCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, c.store, entityTag)
+}
@@ -0,0 +1,16 @@
+package product_variant_cache
+
+import (
+	"context"
+	db "pointofsale/pkg/database/schema"
+)
+
+type ProductVariantQueryCache interface {
+	SetCachedProductVariant(ctx context.Context, data *db.ProductVariant)
+	GetCachedProductVariant(ctx context.Context, id int) (*db.ProductVariant, bool)
+}
+
+type ProductVariantCommandCache interface {
+	DeleteCachedProductVariant(ctx context.Context, id int)
+	InvalidateProductVariantCache(ctx context.Context)
+}
@@ -0,0 +1,46 @@
+package product_variant_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+	db "pointofsale/pkg/database/schema"
+	"time"
+)
+
+const (
+	productVariantByIdCacheKey = "product_variant:id:%d"
+
+	ttlDefault = 5 * time.Minute
+)
+
+var entityTag = cache.EntityTag("product")
+
+type productVariantQueryCache struct {
+	store *cache.CacheStore
+}
+
+func NewProductVariantQueryCache(store *cache.CacheStore) *productVariantQueryCache {
+	return &productVariantQueryCache{store: store}
+}
+
+func (m *productVariantQueryCache) SetCachedProductVariant(ctx context.Context, data *db.ProductVariant) {
+	if data == nil {
+		return
+	}
+
+	key := fmt.Sprintf(productVariantByIdCacheKey, data.VariantID)
+	cache.SetToCache(ctx, m.store, key, data, ttlDefault, entityTag)
+}
+
+func (m *productVariantQueryCache) GetCachedProductVariant(ctx context.Context, id int) (*db.ProductVariant, bool) {
+	key := fmt.Sprintf(productVariantByIdCacheKey, id)
+
+	result, found := cache.GetFromCache[*db.ProductVariant](ctx, m.store, key, entityTag)
+
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
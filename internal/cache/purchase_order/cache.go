@@ -0,0 +1,22 @@
+package purchase_order_cache
+
+import "pointofsale/internal/cache"
+
+type PurchaseOrderMencache interface {
+	PurchaseOrderQueryCache
+	PurchaseOrderCommandCache
+}
+
+type purchaseOrderMencache struct {
+	PurchaseOrderQueryCache
+	PurchaseOrderCommandCache
+}
+
+func NewPurchaseOrderMencache(store *cache.CacheStore) PurchaseOrderMencache {
+	store.RegisterNamespace("purchase_order", cache.NamespaceOptions{Tenant: true})
+
+	return &purchaseOrderMencache{
+		PurchaseOrderQueryCache:   NewPurchaseOrderQueryCache(store),
+		PurchaseOrderCommandCache: NewPurchaseOrderCommandCache(store),
+	}
+}
@@ -0,0 +1,26 @@
+package purchase_order_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+)
+
+type purchaseOrderCommandCache struct {
+	store *cache.CacheStore
+}
+
+func NewPurchaseOrderCommandCache(store *cache.CacheStore) *purchaseOrderCommandCache {
+	return &purchaseOrderCommandCache{store: store}
+}
+
+func (s *purchaseOrderCommandCache) DeleteCachedPurchaseOrder(ctx context.Context, id int) {
+	key := fmt.Sprintf(purchaseOrderByIdCacheKey, id)
+
+	cache.DeleteFromCache(ctx, s.store, key)
+	s.InvalidatePurchaseOrderCache(ctx)
+}
+
+func (s *purchaseOrderCommandCache) InvalidatePurchaseOrderCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, s.store, entityTag, stockTag)
+}
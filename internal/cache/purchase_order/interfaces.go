@@ -0,0 +1,16 @@
+package purchase_order_cache
+
+import (
+	"context"
+	db "pointofsale/pkg/database/schema"
+)
+
+type PurchaseOrderQueryCache interface {
+	SetCachedPurchaseOrderById(ctx context.Context, data *db.PurchaseOrder)
+	GetCachedPurchaseOrderById(ctx context.Context, id int) (*db.PurchaseOrder, bool)
+}
+
+type PurchaseOrderCommandCache interface {
+	DeleteCachedPurchaseOrder(ctx context.Context, id int)
+	InvalidatePurchaseOrderCache(ctx context.Context)
+}
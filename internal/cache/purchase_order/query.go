@@ -0,0 +1,51 @@
+package purchase_order_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+	db "pointofsale/pkg/database/schema"
+	"time"
+)
+
+const (
+	purchaseOrderByIdCacheKey = "purchase_order:id:%d"
+
+	ttlDefault = 5 * time.Minute
+)
+
+var (
+	entityTag = cache.EntityTag("purchase_order")
+
+	// Writes move product stock, so they also make cached products stale.
+	stockTag = cache.EntityTag("product")
+)
+
+type purchaseOrderQueryCache struct {
+	store *cache.CacheStore
+}
+
+func NewPurchaseOrderQueryCache(store *cache.CacheStore) *purchaseOrderQueryCache {
+	return &purchaseOrderQueryCache{store: store}
+}
+
+func (m *purchaseOrderQueryCache) SetCachedPurchaseOrderById(ctx context.Context, data *db.PurchaseOrder) {
+	if data == nil {
+		return
+	}
+
+	key := fmt.Sprintf(purchaseOrderByIdCacheKey, data.PurchaseOrderID)
+	cache.SetToCache(ctx, m.store, key, data, ttlDefault, entityTag)
+}
+
+func (m *purchaseOrderQueryCache) GetCachedPurchaseOrderById(ctx context.Context, id int) (*db.PurchaseOrder, bool) {
+	key := fmt.Sprintf(purchaseOrderByIdCacheKey, id)
+
+	result, found := cache.GetFromCache[*db.PurchaseOrder](ctx, m.store, key, entityTag)
+
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
@@ -1,6 +1,9 @@
 package role_cache
 
-import "pointofsale/internal/cache"
+import (
+	"pointofsale/internal/cache"
+	"time"
+)
 
 type RoleMencache interface {
 	RoleQueryCache
@@ -12,7 +15,12 @@ type roleMencache struct {
 	RoleCommandCache
 }
 
+// Role lookups back every authorization check.
+var localTier = cache.LocalTierOptions{MaxEntries: 500, TTL: 2 * time.Minute}
+
 func NewRoleMencache(store *cache.CacheStore) RoleMencache {
+	store.UseLocalTier("role", localTier)
+
 	return &roleMencache{
 		RoleQueryCache:   NewRoleQueryCache(store),
 		RoleCommandCache: NewRoleCommandCache(store),
@@ -22,4 +22,5 @@ type RoleQueryCache interface {
 
 type RoleCommandCache interface {
 	DeleteCachedRole(ctx context.Context, id int)
+	InvalidateRoleCache(ctx context.Context)
 }
@@ -19,6 +19,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("role")
+
 type roleListCacheResponse[T any] struct {
 	Data         []T  `json:"data"`
 	TotalRecords *int `json:"total_records"`
@@ -44,7 +46,7 @@ func (m *roleQueryCache) SetCachedRoles(ctx context.Context, req *requests.FindA
 
 	key := fmt.Sprintf(roleAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &roleListCacheResponse[*db.GetRolesRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, m.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleById(ctx context.Context, data *db.GetRoleRow) {
@@ -53,7 +55,7 @@ func (m *roleQueryCache) SetCachedRoleById(ctx context.Context, data *db.GetRole
 	}
 
 	key := fmt.Sprintf(roleByIdCacheKey, data.RoleID)
-	cache.SetToCache(ctx, m.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, data, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleByUserId(ctx context.Context, userId int, data []*db.GetUserRolesRow) {
@@ -62,7 +64,7 @@ func (m *roleQueryCache) SetCachedRoleByUserId(ctx context.Context, userId int,
 	}
 
 	key := fmt.Sprintf(roleByUserIdCacheKey, userId)
-	cache.SetToCache(ctx, m.store, key, &data, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, &data, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleActive(ctx context.Context, req *requests.FindAllRoles, data []*db.GetActiveRolesRow, total *int) {
@@ -77,7 +79,7 @@ func (m *roleQueryCache) SetCachedRoleActive(ctx context.Context, req *requests.
 
 	key := fmt.Sprintf(roleActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &roleListCacheResponse[*db.GetActiveRolesRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, m.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) SetCachedRoleTrashed(ctx context.Context, req *requests.FindAllRoles, data []*db.GetTrashedRolesRow, total *int) {
@@ -92,13 +94,13 @@ func (m *roleQueryCache) SetCachedRoleTrashed(ctx context.Context, req *requests
 
 	key := fmt.Sprintf(roleTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &roleListCacheResponse[*db.GetTrashedRolesRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, m.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag)
 }
 
 func (m *roleQueryCache) GetCachedRoles(ctx context.Context, req *requests.FindAllRoles) ([]*db.GetRolesRow, *int, bool) {
 	key := fmt.Sprintf(roleAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[roleListCacheResponse[*db.GetRolesRow]](ctx, m.store, key)
+	result, found := cache.GetFromCache[roleListCacheResponse[*db.GetRolesRow]](ctx, m.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -110,7 +112,7 @@ func (m *roleQueryCache) GetCachedRoles(ctx context.Context, req *requests.FindA
 func (m *roleQueryCache) GetCachedRoleById(ctx context.Context, id int) (*db.GetRoleRow, bool) {
 	key := fmt.Sprintf(roleByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*db.GetRoleRow](ctx, m.store, key)
+	result, found := cache.GetFromCache[*db.GetRoleRow](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -122,7 +124,7 @@ func (m *roleQueryCache) GetCachedRoleById(ctx context.Context, id int) (*db.Get
 func (m *roleQueryCache) GetCachedRoleByUserId(ctx context.Context, userId int) ([]*db.GetUserRolesRow, bool) {
 	key := fmt.Sprintf(roleByUserIdCacheKey, userId)
 
-	result, found := cache.GetFromCache[[]*db.GetUserRolesRow](ctx, m.store, key)
+	result, found := cache.GetFromCache[[]*db.GetUserRolesRow](ctx, m.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -134,7 +136,7 @@ func (m *roleQueryCache) GetCachedRoleByUserId(ctx context.Context, userId int)
 func (m *roleQueryCache) GetCachedRoleActive(ctx context.Context, req *requests.FindAllRoles) ([]*db.GetActiveRolesRow, *int, bool) {
 	key := fmt.Sprintf(roleActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[roleListCacheResponse[*db.GetActiveRolesRow]](ctx, m.store, key)
+	result, found := cache.GetFromCache[roleListCacheResponse[*db.GetActiveRolesRow]](ctx, m.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -146,7 +148,7 @@ func (m *roleQueryCache) GetCachedRoleActive(ctx context.Context, req *requests.
 func (m *roleQueryCache) GetCachedRoleTrashed(ctx context.Context, req *requests.FindAllRoles) ([]*db.GetTrashedRolesRow, *int, bool) {
 	key := fmt.Sprintf(roleTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[roleListCacheResponse[*db.GetTrashedRolesRow]](ctx, m.store, key)
+	result, found := cache.GetFromCache[roleListCacheResponse[*db.GetTrashedRolesRow]](ctx, m.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
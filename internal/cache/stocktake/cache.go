@@ -0,0 +1,22 @@
+package stocktake_cache
+
+import "pointofsale/internal/cache"
+
+type StocktakeMencache interface {
+	StocktakeQueryCache
+	StocktakeCommandCache
+}
+
+type stocktakeMencache struct {
+	StocktakeQueryCache
+	StocktakeCommandCache
+}
+
+func NewStocktakeMencache(store *cache.CacheStore) StocktakeMencache {
+	store.RegisterNamespace("stocktake", cache.NamespaceOptions{Tenant: true})
+
+	return &stocktakeMencache{
+		StocktakeQueryCache:   NewStocktakeQueryCache(store),
+		StocktakeCommandCache: NewStocktakeCommandCache(store),
+	}
+}
@@ -0,0 +1,26 @@
+package stocktake_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+)
+
+type stocktakeCommandCache struct {
+	store *cache.CacheStore
+}
+
+func NewStocktakeCommandCache(store *cache.CacheStore) *stocktakeCommandCache {
+	return &stocktakeCommandCache{store: store}
+}
+
+func (s *stocktakeCommandCache) DeleteCachedStocktake(ctx context.Context, id int) {
+	key := fmt.Sprintf(stocktakeByIdCacheKey, id)
+
+	cache.DeleteFromCache(ctx, s.store, key)
+	s.InvalidateStocktakeCache(ctx)
+}
+
+func (s *stocktakeCommandCache) InvalidateStocktakeCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, s.store, entityTag, stockTag)
+}
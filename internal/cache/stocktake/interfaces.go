@@ -0,0 +1,16 @@
+package stocktake_cache
+
+import (
+	"context"
+	db "pointofsale/pkg/database/schema"
+)
+
+type StocktakeQueryCache interface {
+	SetCachedStocktakeById(ctx context.Context, data *db.Stocktake)
+	GetCachedStocktakeById(ctx context.Context, id int) (*db.Stocktake, bool)
+}
+
+type StocktakeCommandCache interface {
+	DeleteCachedStocktake(ctx context.Context, id int)
+	InvalidateStocktakeCache(ctx context.Context)
+}
@@ -0,0 +1,51 @@
+package stocktake_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+	db "pointofsale/pkg/database/schema"
+	"time"
+)
+
+const (
+	stocktakeByIdCacheKey = "stocktake:id:%d"
+
+	ttlDefault = 5 * time.Minute
+)
+
+var (
+	entityTag = cache.EntityTag("stocktake")
+
+	// Writes move product stock, so they also make cached products stale.
+	stockTag = cache.EntityTag("product")
+)
+
+type stocktakeQueryCache struct {
+	store *cache.CacheStore
+}
+
+func NewStocktakeQueryCache(store *cache.CacheStore) *stocktakeQueryCache {
+	return &stocktakeQueryCache{store: store}
+}
+
+func (m *stocktakeQueryCache) SetCachedStocktakeById(ctx context.Context, data *db.Stocktake) {
+	if data == nil {
+		return
+	}
+
+	key := fmt.Sprintf(stocktakeByIdCacheKey, data.StocktakeID)
+	cache.SetToCache(ctx, m.store, key, data, ttlDefault, entityTag)
+}
+
+func (m *stocktakeQueryCache) GetCachedStocktakeById(ctx context.Context, id int) (*db.Stocktake, bool) {
+	key := fmt.Sprintf(stocktakeByIdCacheKey, id)
+
+	result, found := cache.GetFromCache[*db.Stocktake](ctx, m.store, key, entityTag)
+
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
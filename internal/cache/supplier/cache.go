@@ -0,0 +1,22 @@
+package supplier_cache
+
+import "pointofsale/internal/cache"
+
+type SupplierMencache interface {
+	SupplierQueryCache
+	SupplierCommandCache
+}
+
+type supplierMencache struct {
+	SupplierQueryCache
+	SupplierCommandCache
+}
+
+func NewSupplierMencache(store *cache.CacheStore) SupplierMencache {
+	store.RegisterNamespace("supplier", cache.NamespaceOptions{Tenant: true})
+
+	return &supplierMencache{
+		SupplierQueryCache:   NewSupplierQueryCache(store),
+		SupplierCommandCache: NewSupplierCommandCache(store),
+	}
+}
@@ -0,0 +1,26 @@
+package supplier_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+)
+
+type supplierCommandCache struct {
+	store *cache.CacheStore
+}
+
+func NewSupplierCommandCache(store *cache.CacheStore) *supplierCommandCache {
+	return &supplierCommandCache{store: store}
+}
+
+func (s *supplierCommandCache) DeleteCachedSupplier(ctx context.Context, id int) {
+	key := fmt.Sprintf(supplierByIdCacheKey, id)
+
+	cache.DeleteFromCache(ctx, s.store, key)
+	s.InvalidateSupplierCache(ctx)
+}
+
+func (s *supplierCommandCache) InvalidateSupplierCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, s.store, entityTag)
+}
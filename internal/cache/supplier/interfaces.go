@@ -0,0 +1,20 @@
+package supplier_cache
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+)
+
+type SupplierQueryCache interface {
+	SetCachedSuppliers(ctx context.Context, req *requests.FindAllSuppliers, data []*db.GetSuppliersRow, total *int)
+	SetCachedSupplierById(ctx context.Context, data *db.Supplier)
+
+	GetCachedSuppliers(ctx context.Context, req *requests.FindAllSuppliers) ([]*db.GetSuppliersRow, *int, bool)
+	GetCachedSupplierById(ctx context.Context, id int) (*db.Supplier, bool)
+}
+
+type SupplierCommandCache interface {
+	DeleteCachedSupplier(ctx context.Context, id int)
+	InvalidateSupplierCache(ctx context.Context)
+}
@@ -0,0 +1,80 @@
+package supplier_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"time"
+)
+
+const (
+	supplierAllCacheKey  = "supplier:all:merchant:%d:page:%d:pageSize:%d:search:%s"
+	supplierByIdCacheKey = "supplier:id:%d"
+
+	ttlDefault = 5 * time.Minute
+)
+
+var entityTag = cache.EntityTag("supplier")
+
+type supplierListCacheResponse struct {
+	Data         []*db.GetSuppliersRow `json:"data"`
+	TotalRecords *int                  `json:"total_records"`
+}
+
+type supplierQueryCache struct {
+	store *cache.CacheStore
+}
+
+func NewSupplierQueryCache(store *cache.CacheStore) *supplierQueryCache {
+	return &supplierQueryCache{store: store}
+}
+
+func (m *supplierQueryCache) SetCachedSuppliers(ctx context.Context, req *requests.FindAllSuppliers, data []*db.GetSuppliersRow, total *int) {
+	if total == nil {
+		zero := 0
+		total = &zero
+	}
+
+	if data == nil {
+		data = []*db.GetSuppliersRow{}
+	}
+
+	key := fmt.Sprintf(supplierAllCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+	payload := &supplierListCacheResponse{Data: data, TotalRecords: total}
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
+}
+
+func (m *supplierQueryCache) SetCachedSupplierById(ctx context.Context, data *db.Supplier) {
+	if data == nil {
+		return
+	}
+
+	key := fmt.Sprintf(supplierByIdCacheKey, data.SupplierID)
+	cache.SetToCache(ctx, m.store, key, data, ttlDefault, entityTag)
+}
+
+func (m *supplierQueryCache) GetCachedSuppliers(ctx context.Context, req *requests.FindAllSuppliers) ([]*db.GetSuppliersRow, *int, bool) {
+	key := fmt.Sprintf(supplierAllCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
+
+	result, found := cache.GetFromCache[supplierListCacheResponse](ctx, m.store, key, entityTag, cache.MerchantTag(req.MerchantID))
+
+	if !found || result.Data == nil {
+		return nil, nil, false
+	}
+
+	return result.Data, result.TotalRecords, true
+}
+
+func (m *supplierQueryCache) GetCachedSupplierById(ctx context.Context, id int) (*db.Supplier, bool) {
+	key := fmt.Sprintf(supplierByIdCacheKey, id)
+
+	result, found := cache.GetFromCache[*db.Supplier](ctx, m.store, key, entityTag)
+
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
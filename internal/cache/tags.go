@@ -0,0 +1,198 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Tagged entries are stored together with the generation each of their tags
+// had when the entry was written. Writes bump the generation of the tags they
+// affect, which makes every entry written before the bump stale at once
+// without having to find and delete the keys.
+//
+// Generations live under tagKeyPrefix without an expiry. A missing generation
+// counts as zero.
+const tagKeyPrefix = "cache:tag:"
+
+type taggedEntry struct {
+	Tags map[string]int64 `json:"tags"`
+	Data json.RawMessage  `json:"data"`
+
+	// Set by GetOrLoad: the entry is served as stale after FreshUntil (unix
+	// milliseconds), and Negative entries remember that nothing was found.
+	FreshUntil int64 `json:"fresh_until,omitempty"`
+	Negative   bool  `json:"negative,omitempty"`
+}
+
+// EntityTag names every cached entry of an entity such as "order". Tags are
+// kept under the store's key prefix, so the service and API caches each have
+// their own generations: a layer has to invalidate its own tags for every
+// write that changes the entity, including writes made through another
+// entity's endpoints.
+func EntityTag(entity string) string {
+	return "entity:" + entity
+}
+
+// MerchantTag names the cached entries scoped to one merchant.
+func MerchantTag(merchantID int) string {
+	return fmt.Sprintf("merchant:%d", merchantID)
+}
+
+func (store *CacheStore) tagKey(tag string) string {
+	return store.key(tagKeyPrefix + tag)
+}
+
+// InvalidateTags makes every entry tagged with any of tags stale.
+func InvalidateTags(ctx context.Context, store *CacheStore, tags ...string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	atomic.AddInt64(&store.refCount, 1)
+	defer atomic.AddInt64(&store.refCount, -1)
+
+	start := time.Now()
+	defer func() {
+		store.metrics.RecordCacheOperationLatency(ctx, "invalidate_tags", time.Since(start))
+	}()
+
+	store.invalidateLocal(invalidation{Tags: tags})
+
+	pipe := store.redis.Pipeline()
+	for _, tag := range tags {
+		pipe.Incr(ctx, store.tagKey(tag))
+	}
+	store.publishInvalidation(ctx, pipe, invalidation{Tags: tags})
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		store.Logger.Error("Failed to invalidate cache tags", zap.Error(err), zap.Strings("tags", tags))
+		store.metrics.RecordCacheError(ctx, "invalidate_tags", store.tagKey(tags[0]), err)
+		return
+	}
+
+	store.Logger.Debug("Invalidated cache tags", zap.Strings("tags", tags))
+}
+
+// tagGenerations returns the current generation of every tag.
+func tagGenerations(ctx context.Context, store *CacheStore, tags []string) (map[string]int64, error) {
+	keys := make([]string, len(tags))
+	for i, tag := range tags {
+		keys[i] = store.tagKey(tag)
+	}
+
+	values, err := store.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseGenerations(tags, values)
+}
+
+func parseGenerations(tags []string, values []any) (map[string]int64, error) {
+	generations := make(map[string]int64, len(tags))
+
+	for i, tag := range tags {
+		raw, ok := values[i].(string)
+		if !ok {
+			generations[tag] = 0
+			continue
+		}
+
+		generation, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid generation for tag %s: %w", tag, err)
+		}
+		generations[tag] = generation
+	}
+
+	return generations, nil
+}
+
+// getTagged reads a tagged entry and returns its payload. Entries written
+// before one of their tags was invalidated are reported as redis.Nil.
+func getTagged(ctx context.Context, store *CacheStore, key string, tags []string) ([]byte, error) {
+	entry, err := readEntry(ctx, store, key, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.Data, nil
+}
+
+// readEntry reads the entry under key in one round trip together with the
+// current generation of its tags.
+func readEntry(ctx context.Context, store *CacheStore, key string, tags []string) (*taggedEntry, error) {
+	keys := make([]string, len(tags))
+	for i, tag := range tags {
+		keys[i] = store.tagKey(tag)
+	}
+
+	pipe := store.redis.Pipeline()
+	entryCmd := pipe.Get(ctx, store.key(key))
+
+	var generationsCmd *redis.SliceCmd
+	if len(keys) > 0 {
+		generationsCmd = pipe.MGet(ctx, keys...)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	cached, err := entryCmd.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var entry taggedEntry
+	if err := json.Unmarshal(cached, &entry); err != nil || entry.Tags == nil {
+		// Written without tags, e.g. before the cache was tagged.
+		return nil, redis.Nil
+	}
+
+	if generationsCmd == nil {
+		return &entry, nil
+	}
+
+	current, err := parseGenerations(tags, generationsCmd.Val())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tags {
+		generation, ok := entry.Tags[tag]
+		if !ok || generation != current[tag] {
+			return nil, redis.Nil
+		}
+	}
+
+	return &entry, nil
+}
+
+// setTagged stores data under key stamped with the given tag generations.
+// Callers that load data from the database should read the generations
+// before loading, so a write that lands in between leaves the entry stale
+// instead of caching the old data under the new generation.
+func setTagged(ctx context.Context, store *CacheStore, key string, data []byte, generations map[string]int64, expiration time.Duration) error {
+	return writeEntry(ctx, store, key, &taggedEntry{Tags: generations, Data: data}, expiration)
+}
+
+func writeEntry(ctx context.Context, store *CacheStore, key string, entry *taggedEntry, expiration time.Duration) error {
+	if entry.Tags == nil {
+		entry.Tags = map[string]int64{}
+	}
+
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return store.set(ctx, key, payload, expiration)
+}
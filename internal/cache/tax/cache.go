@@ -0,0 +1,22 @@
+package tax_cache
+
+import "pointofsale/internal/cache"
+
+type TaxMencache interface {
+	TaxQueryCache
+	TaxCommandCache
+}
+
+type taxMencache struct {
+	TaxQueryCache
+	TaxCommandCache
+}
+
+func NewTaxMencache(store *cache.CacheStore) TaxMencache {
+	store.RegisterNamespace("tax_rate", cache.NamespaceOptions{Tenant: true})
+
+	return &taxMencache{
+		TaxQueryCache:   NewTaxQueryCache(store),
+		TaxCommandCache: NewTaxCommandCache(store),
+	}
+}
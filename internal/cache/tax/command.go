@@ -0,0 +1,26 @@
+package tax_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+)
+
+type taxCommandCache struct {
+	store *cache.CacheStore
+}
+
+func NewTaxCommandCache(store *cache.CacheStore) *taxCommandCache {
+	return &taxCommandCache{store: store}
+}
+
+func (s *taxCommandCache) DeleteCachedTaxRate(ctx context.Context, id int) {
+	key := fmt.Sprintf(taxRateByIdCacheKey, id)
+
+	cache.DeleteFromCache(ctx, s.store, key)
+	s.InvalidateTaxRateCache(ctx)
+}
+
+func (s *taxCommandCache) InvalidateTaxRateCache(ctx context.Context) {
+	cache.InvalidateTags(ctx, s.store, entityTag)
+}
@@ -0,0 +1,20 @@
+package tax_cache
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+)
+
+type TaxQueryCache interface {
+	SetCachedTaxRates(ctx context.Context, req *requests.FindAllTaxRates, data []*db.GetTaxRatesRow, total *int)
+	SetCachedTaxRateById(ctx context.Context, data *db.TaxRate)
+
+	GetCachedTaxRates(ctx context.Context, req *requests.FindAllTaxRates) ([]*db.GetTaxRatesRow, *int, bool)
+	GetCachedTaxRateById(ctx context.Context, id int) (*db.TaxRate, bool)
+}
+
+type TaxCommandCache interface {
+	DeleteCachedTaxRate(ctx context.Context, id int)
+	InvalidateTaxRateCache(ctx context.Context)
+}
@@ -0,0 +1,80 @@
+package tax_cache
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/cache"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"time"
+)
+
+const (
+	taxRateAllCacheKey  = "tax_rate:all:page:%d:pageSize:%d:search:%s"
+	taxRateByIdCacheKey = "tax_rate:id:%d"
+
+	ttlDefault = 5 * time.Minute
+)
+
+var entityTag = cache.EntityTag("tax")
+
+type taxRateListCacheResponse struct {
+	Data         []*db.GetTaxRatesRow `json:"data"`
+	TotalRecords *int                 `json:"total_records"`
+}
+
+type taxQueryCache struct {
+	store *cache.CacheStore
+}
+
+func NewTaxQueryCache(store *cache.CacheStore) *taxQueryCache {
+	return &taxQueryCache{store: store}
+}
+
+func (m *taxQueryCache) SetCachedTaxRates(ctx context.Context, req *requests.FindAllTaxRates, data []*db.GetTaxRatesRow, total *int) {
+	if total == nil {
+		zero := 0
+		total = &zero
+	}
+
+	if data == nil {
+		data = []*db.GetTaxRatesRow{}
+	}
+
+	key := fmt.Sprintf(taxRateAllCacheKey, req.Page, req.PageSize, req.Search)
+	payload := &taxRateListCacheResponse{Data: data, TotalRecords: total}
+	cache.SetToCache(ctx, m.store, key, payload, ttlDefault, entityTag)
+}
+
+func (m *taxQueryCache) SetCachedTaxRateById(ctx context.Context, data *db.TaxRate) {
+	if data == nil {
+		return
+	}
+
+	key := fmt.Sprintf(taxRateByIdCacheKey, data.TaxRateID)
+	cache.SetToCache(ctx, m.store, key, data, ttlDefault, entityTag)
+}
+
+func (m *taxQueryCache) GetCachedTaxRates(ctx context.Context, req *requests.FindAllTaxRates) ([]*db.GetTaxRatesRow, *int, bool) {
+	key := fmt.Sprintf(taxRateAllCacheKey, req.Page, req.PageSize, req.Search)
+
+	result, found := cache.GetFromCache[taxRateListCacheResponse](ctx, m.store, key, entityTag)
+
+	if !found || result.Data == nil {
+		return nil, nil, false
+	}
+
+	return result.Data, result.TotalRecords, true
+}
+
+func (m *taxQueryCache) GetCachedTaxRateById(ctx context.Context, id int) (*db.TaxRate, bool) {
+	key := fmt.Sprintf(taxRateByIdCacheKey, id)
+
+	result, found := cache.GetFromCache[*db.TaxRate](ctx, m.store, key, entityTag)
+
+	if !found || result == nil {
+		return nil, false
+	}
+
+	return result, true
+}
@@ -17,6 +17,8 @@ type transactionMencache struct {
 }
 
 func NewTransactionMencache(cacheStore *cache.CacheStore) TransactionMencache {
+	cacheStore.RegisterNamespace("transaction", cache.NamespaceOptions{Tenant: true})
+
 	return &transactionMencache{
 		TransactionQueryCache:           NewTransactionQueryCache(cacheStore),
 		TransactionCommandCache:         NewTransactionCommandCache(cacheStore),
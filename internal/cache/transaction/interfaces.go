@@ -7,55 +7,39 @@ import (
 )
 
 type TransactionStatsCache interface {
-	GetCachedMonthAmountSuccessCached(ctx context.Context, req *requests.MonthAmountTransaction) ([]*db.GetMonthlyAmountTransactionSuccessRow, bool)
-	SetCachedMonthAmountSuccessCached(ctx context.Context, req *requests.MonthAmountTransaction, res []*db.GetMonthlyAmountTransactionSuccessRow)
+	GetOrLoadMonthAmountSuccess(ctx context.Context, req *requests.MonthAmountTransaction, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionSuccessRow, error)) ([]*db.GetMonthlyAmountTransactionSuccessRow, error)
 
-	GetCachedYearAmountSuccessCached(ctx context.Context, year int) ([]*db.GetYearlyAmountTransactionSuccessRow, bool)
-	SetCachedYearAmountSuccessCached(ctx context.Context, year int, res []*db.GetYearlyAmountTransactionSuccessRow)
+	GetOrLoadYearAmountSuccess(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionSuccessRow, error)) ([]*db.GetYearlyAmountTransactionSuccessRow, error)
 
-	GetCachedMonthAmountFailedCached(ctx context.Context, req *requests.MonthAmountTransaction) ([]*db.GetMonthlyAmountTransactionFailedRow, bool)
-	SetCachedMonthAmountFailedCached(ctx context.Context, req *requests.MonthAmountTransaction, res []*db.GetMonthlyAmountTransactionFailedRow)
+	GetOrLoadMonthAmountFailed(ctx context.Context, req *requests.MonthAmountTransaction, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionFailedRow, error)) ([]*db.GetMonthlyAmountTransactionFailedRow, error)
 
-	GetCachedYearAmountFailedCached(ctx context.Context, year int) ([]*db.GetYearlyAmountTransactionFailedRow, bool)
-	SetCachedYearAmountFailedCached(ctx context.Context, year int, res []*db.GetYearlyAmountTransactionFailedRow)
+	GetOrLoadYearAmountFailed(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionFailedRow, error)) ([]*db.GetYearlyAmountTransactionFailedRow, error)
 
-	GetCachedMonthMethodSuccessCached(ctx context.Context, req *requests.MonthMethodTransaction) ([]*db.GetMonthlyTransactionMethodsSuccessRow, bool)
-	SetCachedMonthMethodSuccessCached(ctx context.Context, req *requests.MonthMethodTransaction, res []*db.GetMonthlyTransactionMethodsSuccessRow)
+	GetOrLoadMonthMethodSuccess(ctx context.Context, req *requests.MonthMethodTransaction, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsSuccessRow, error)) ([]*db.GetMonthlyTransactionMethodsSuccessRow, error)
 
-	GetCachedYearMethodSuccessCached(ctx context.Context, year int) ([]*db.GetYearlyTransactionMethodsSuccessRow, bool)
-	SetCachedYearMethodSuccessCached(ctx context.Context, year int, res []*db.GetYearlyTransactionMethodsSuccessRow)
+	GetOrLoadYearMethodSuccess(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsSuccessRow, error)) ([]*db.GetYearlyTransactionMethodsSuccessRow, error)
 
-	GetCachedMonthMethodFailedCached(ctx context.Context, req *requests.MonthMethodTransaction) ([]*db.GetMonthlyTransactionMethodsFailedRow, bool)
-	SetCachedMonthMethodFailedCached(ctx context.Context, req *requests.MonthMethodTransaction, res []*db.GetMonthlyTransactionMethodsFailedRow)
+	GetOrLoadMonthMethodFailed(ctx context.Context, req *requests.MonthMethodTransaction, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsFailedRow, error)) ([]*db.GetMonthlyTransactionMethodsFailedRow, error)
 
-	GetCachedYearMethodFailedCached(ctx context.Context, year int) ([]*db.GetYearlyTransactionMethodsFailedRow, bool)
-	SetCachedYearMethodFailedCached(ctx context.Context, year int, res []*db.GetYearlyTransactionMethodsFailedRow)
+	GetOrLoadYearMethodFailed(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsFailedRow, error)) ([]*db.GetYearlyTransactionMethodsFailedRow, error)
 }
 
 type TransactionStatsByMerchantCache interface {
-	GetCachedMonthAmountSuccessByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant) ([]*db.GetMonthlyAmountTransactionSuccessByMerchantRow, bool)
-	SetCachedMonthAmountSuccessByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant, res []*db.GetMonthlyAmountTransactionSuccessByMerchantRow)
+	GetOrLoadMonthAmountSuccessByMerchant(ctx context.Context, req *requests.MonthAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionSuccessByMerchantRow, error)) ([]*db.GetMonthlyAmountTransactionSuccessByMerchantRow, error)
 
-	GetCachedYearAmountSuccessByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant) ([]*db.GetYearlyAmountTransactionSuccessByMerchantRow, bool)
-	SetCachedYearAmountSuccessByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant, res []*db.GetYearlyAmountTransactionSuccessByMerchantRow)
+	GetOrLoadYearAmountSuccessByMerchant(ctx context.Context, req *requests.YearAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionSuccessByMerchantRow, error)) ([]*db.GetYearlyAmountTransactionSuccessByMerchantRow, error)
 
-	GetCachedMonthAmountFailedByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant) ([]*db.GetMonthlyAmountTransactionFailedByMerchantRow, bool)
-	SetCachedMonthAmountFailedByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant, res []*db.GetMonthlyAmountTransactionFailedByMerchantRow)
+	GetOrLoadMonthAmountFailedByMerchant(ctx context.Context, req *requests.MonthAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionFailedByMerchantRow, error)) ([]*db.GetMonthlyAmountTransactionFailedByMerchantRow, error)
 
-	GetCachedYearAmountFailedByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant) ([]*db.GetYearlyAmountTransactionFailedByMerchantRow, bool)
-	SetCachedYearAmountFailedByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant, res []*db.GetYearlyAmountTransactionFailedByMerchantRow)
+	GetOrLoadYearAmountFailedByMerchant(ctx context.Context, req *requests.YearAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionFailedByMerchantRow, error)) ([]*db.GetYearlyAmountTransactionFailedByMerchantRow, error)
 
-	GetCachedMonthMethodSuccessByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant) ([]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow, bool)
-	SetCachedMonthMethodSuccessByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant, res []*db.GetMonthlyTransactionMethodsByMerchantSuccessRow)
+	GetOrLoadMonthMethodSuccessByMerchant(ctx context.Context, req *requests.MonthMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow, error)) ([]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow, error)
 
-	GetCachedYearMethodSuccessByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant) ([]*db.GetYearlyTransactionMethodsByMerchantSuccessRow, bool)
-	SetCachedYearMethodSuccessByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant, res []*db.GetYearlyTransactionMethodsByMerchantSuccessRow)
+	GetOrLoadYearMethodSuccessByMerchant(ctx context.Context, req *requests.YearMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsByMerchantSuccessRow, error)) ([]*db.GetYearlyTransactionMethodsByMerchantSuccessRow, error)
 
-	GetCachedMonthMethodFailedByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant) ([]*db.GetMonthlyTransactionMethodsByMerchantFailedRow, bool)
-	SetCachedMonthMethodFailedByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant, res []*db.GetMonthlyTransactionMethodsByMerchantFailedRow)
+	GetOrLoadMonthMethodFailedByMerchant(ctx context.Context, req *requests.MonthMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsByMerchantFailedRow, error)) ([]*db.GetMonthlyTransactionMethodsByMerchantFailedRow, error)
 
-	GetCachedYearMethodFailedByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant) ([]*db.GetYearlyTransactionMethodsByMerchantFailedRow, bool)
-	SetCachedYearMethodFailedByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant, res []*db.GetYearlyTransactionMethodsByMerchantFailedRow)
+	GetOrLoadYearMethodFailedByMerchant(ctx context.Context, req *requests.YearMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsByMerchantFailedRow, error)) ([]*db.GetYearlyTransactionMethodsByMerchantFailedRow, error)
 }
 
 type TransactionQueryCache interface {
@@ -80,4 +64,5 @@ type TransactionQueryCache interface {
 
 type TransactionCommandCache interface {
 	DeleteTransactionCache(ctx context.Context, transactionID int)
+	InvalidateTransactionCache(ctx context.Context)
 }
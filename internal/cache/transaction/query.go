@@ -23,6 +23,17 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var (
+	entityTag = cache.EntityTag("transaction")
+
+	// Writes move product stock, so they also make cached products stale.
+	stockTag = cache.EntityTag("product")
+
+	// Writes settle, refund or void the order they belong to, so cached
+	// orders are stale as well.
+	orderTag = cache.EntityTag("order")
+)
+
 type transactionListCacheResponse[T any] struct {
 	Data         []T  `json:"data"`
 	TotalRecords *int `json:"totalRecords"`
@@ -39,7 +50,7 @@ func NewTransactionQueryCache(store *cache.CacheStore) *transactionQueryCache {
 func (t *transactionQueryCache) GetCachedTransactionsCache(ctx context.Context, req *requests.FindAllTransaction) ([]*db.GetTransactionsRow, *int, bool) {
 	key := fmt.Sprintf(transactionAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionsRow]](ctx, t.store, key)
+	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionsRow]](ctx, t.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -60,13 +71,13 @@ func (t *transactionQueryCache) SetCachedTransactionsCache(ctx context.Context,
 
 	key := fmt.Sprintf(transactionAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &transactionListCacheResponse[*db.GetTransactionsRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, t.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, payload, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionByMerchant(ctx context.Context, req *requests.FindAllTransactionByMerchant) ([]*db.GetTransactionByMerchantRow, *int, bool) {
 	key := fmt.Sprintf(transactionByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionByMerchantRow]](ctx, t.store, key)
+	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionByMerchantRow]](ctx, t.store, key, entityTag, cache.MerchantTag(req.MerchantID))
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -87,13 +98,13 @@ func (t *transactionQueryCache) SetCachedTransactionByMerchant(ctx context.Conte
 
 	key := fmt.Sprintf(transactionByMerchantCacheKey, req.MerchantID, req.Page, req.PageSize, req.Search)
 	payload := &transactionListCacheResponse[*db.GetTransactionByMerchantRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, t.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, payload, ttlDefault, entityTag, cache.MerchantTag(req.MerchantID))
 }
 
 func (t *transactionQueryCache) GetCachedTransactionActiveCache(ctx context.Context, req *requests.FindAllTransaction) ([]*db.GetTransactionsActiveRow, *int, bool) {
 	key := fmt.Sprintf(transactionActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionsActiveRow]](ctx, t.store, key)
+	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionsActiveRow]](ctx, t.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -114,13 +125,13 @@ func (t *transactionQueryCache) SetCachedTransactionActiveCache(ctx context.Cont
 
 	key := fmt.Sprintf(transactionActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &transactionListCacheResponse[*db.GetTransactionsActiveRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, t.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, payload, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionTrashedCache(ctx context.Context, req *requests.FindAllTransaction) ([]*db.GetTransactionsTrashedRow, *int, bool) {
 	key := fmt.Sprintf(transactionTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionsTrashedRow]](ctx, t.store, key)
+	result, found := cache.GetFromCache[transactionListCacheResponse[*db.GetTransactionsTrashedRow]](ctx, t.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -141,13 +152,13 @@ func (t *transactionQueryCache) SetCachedTransactionTrashedCache(ctx context.Con
 
 	key := fmt.Sprintf(transactionTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &transactionListCacheResponse[*db.GetTransactionsTrashedRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, t.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, payload, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionCache(ctx context.Context, id int) (*db.GetTransactionByIDRow, bool) {
 	key := fmt.Sprintf(transactionByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*db.GetTransactionByIDRow](ctx, t.store, key)
+	result, found := cache.GetFromCache[*db.GetTransactionByIDRow](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -162,13 +173,13 @@ func (t *transactionQueryCache) SetCachedTransactionCache(ctx context.Context, d
 	}
 
 	key := fmt.Sprintf(transactionByIdCacheKey, data.TransactionID)
-	cache.SetToCache(ctx, t.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, data, ttlDefault, entityTag)
 }
 
 func (t *transactionQueryCache) GetCachedTransactionByOrderId(ctx context.Context, orderID int) (*db.GetTransactionByOrderIDRow, bool) {
 	key := fmt.Sprintf(transactionByOrderCacheKey, orderID)
 
-	result, found := cache.GetFromCache[*db.GetTransactionByOrderIDRow](ctx, t.store, key)
+	result, found := cache.GetFromCache[*db.GetTransactionByOrderIDRow](ctx, t.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -183,5 +194,5 @@ func (t *transactionQueryCache) SetCachedTransactionByOrderId(ctx context.Contex
 	}
 
 	key := fmt.Sprintf(transactionByOrderCacheKey, orderID)
-	cache.SetToCache(ctx, t.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, t.store, key, data, ttlDefault, entityTag)
 }
@@ -6,6 +6,7 @@ import (
 	"pointofsale/internal/cache"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
+	"time"
 )
 
 const (
@@ -22,6 +23,20 @@ const (
 	transactionYearMethodFailedKey  = "transaction:year:method:failed:year:%d"
 )
 
+// statsStaleTTL is how long an expired stat keeps being served while one
+// caller recomputes it, so the aggregate queries do not run once per request
+// whenever a hot key expires.
+const statsStaleTTL = 10 * time.Minute
+
+func statsLoadOptions(tags ...string) cache.LoadOptions {
+	return cache.LoadOptions{
+		TTL:      ttlDefault,
+		StaleTTL: statsStaleTTL,
+		Jitter:   0.2,
+		Tags:     tags,
+	}
+}
+
 type transactionStatsCache struct {
 	store *cache.CacheStore
 }
@@ -30,180 +45,50 @@ func NewTransactionStatsCache(store *cache.CacheStore) *transactionStatsCache {
 	return &transactionStatsCache{store: store}
 }
 
-func (t *transactionStatsCache) GetCachedMonthAmountSuccessCached(ctx context.Context, req *requests.MonthAmountTransaction) ([]*db.GetMonthlyAmountTransactionSuccessRow, bool) {
+func (t *transactionStatsCache) GetOrLoadMonthAmountSuccess(ctx context.Context, req *requests.MonthAmountTransaction, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionSuccessRow, error)) ([]*db.GetMonthlyAmountTransactionSuccessRow, error) {
 	key := fmt.Sprintf(transactionMonthAmountSuccessKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyAmountTransactionSuccessRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	// Perbaikan: Mengembalikan 'result' langsung, bukan '*result'
-	return result, true
-}
-
-func (t *transactionStatsCache) SetCachedMonthAmountSuccessCached(ctx context.Context, req *requests.MonthAmountTransaction, res []*db.GetMonthlyAmountTransactionSuccessRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(transactionMonthAmountSuccessKey, req.Month, req.Year)
-
-	// Perbaikan: Menggunakan 'cache.SetToCache' untuk konsistensi
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
-}
-
-func (t *transactionStatsCache) GetCachedYearAmountSuccessCached(ctx context.Context, year int) ([]*db.GetYearlyAmountTransactionSuccessRow, bool) {
-	key := fmt.Sprintf(transactionYearAmountSuccessKey, year)
-
-	result, found := cache.GetFromCache[[]*db.GetYearlyAmountTransactionSuccessRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (t *transactionStatsCache) SetCachedYearAmountSuccessCached(ctx context.Context, year int, res []*db.GetYearlyAmountTransactionSuccessRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsCache) GetOrLoadYearAmountSuccess(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionSuccessRow, error)) ([]*db.GetYearlyAmountTransactionSuccessRow, error) {
 	key := fmt.Sprintf(transactionYearAmountSuccessKey, year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (t *transactionStatsCache) GetCachedMonthAmountFailedCached(ctx context.Context, req *requests.MonthAmountTransaction) ([]*db.GetMonthlyAmountTransactionFailedRow, bool) {
+func (t *transactionStatsCache) GetOrLoadMonthAmountFailed(ctx context.Context, req *requests.MonthAmountTransaction, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionFailedRow, error)) ([]*db.GetMonthlyAmountTransactionFailedRow, error) {
 	key := fmt.Sprintf(transactionMonthAmountFailedKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyAmountTransactionFailedRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsCache) SetCachedMonthAmountFailedCached(ctx context.Context, req *requests.MonthAmountTransaction, res []*db.GetMonthlyAmountTransactionFailedRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(transactionMonthAmountFailedKey, req.Month, req.Year)
-
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
-}
-
-func (t *transactionStatsCache) GetCachedYearAmountFailedCached(ctx context.Context, year int) ([]*db.GetYearlyAmountTransactionFailedRow, bool) {
-	key := fmt.Sprintf(transactionYearAmountFailedKey, year)
-
-	result, found := cache.GetFromCache[[]*db.GetYearlyAmountTransactionFailedRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (t *transactionStatsCache) SetCachedYearAmountFailedCached(ctx context.Context, year int, res []*db.GetYearlyAmountTransactionFailedRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsCache) GetOrLoadYearAmountFailed(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionFailedRow, error)) ([]*db.GetYearlyAmountTransactionFailedRow, error) {
 	key := fmt.Sprintf(transactionYearAmountFailedKey, year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (t *transactionStatsCache) GetCachedMonthMethodSuccessCached(ctx context.Context, req *requests.MonthMethodTransaction) ([]*db.GetMonthlyTransactionMethodsSuccessRow, bool) {
+func (t *transactionStatsCache) GetOrLoadMonthMethodSuccess(ctx context.Context, req *requests.MonthMethodTransaction, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsSuccessRow, error)) ([]*db.GetMonthlyTransactionMethodsSuccessRow, error) {
 	key := fmt.Sprintf(transactionMonthMethodSuccessKey, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTransactionMethodsSuccessRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (t *transactionStatsCache) SetCachedMonthMethodSuccessCached(ctx context.Context, req *requests.MonthMethodTransaction, res []*db.GetMonthlyTransactionMethodsSuccessRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(transactionMonthMethodSuccessKey, req.Month, req.Year)
-
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
-}
-
-func (t *transactionStatsCache) GetCachedYearMethodSuccessCached(ctx context.Context, year int) ([]*db.GetYearlyTransactionMethodsSuccessRow, bool) {
-	key := fmt.Sprintf(transactionYearMethodSuccessKey, year)
-
-	result, found := cache.GetFromCache[[]*db.GetYearlyTransactionMethodsSuccessRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsCache) SetCachedYearMethodSuccessCached(ctx context.Context, year int, res []*db.GetYearlyTransactionMethodsSuccessRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsCache) GetOrLoadYearMethodSuccess(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsSuccessRow, error)) ([]*db.GetYearlyTransactionMethodsSuccessRow, error) {
 	key := fmt.Sprintf(transactionYearMethodSuccessKey, year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
-}
-
-func (t *transactionStatsCache) GetCachedMonthMethodFailedCached(ctx context.Context, req *requests.MonthMethodTransaction) ([]*db.GetMonthlyTransactionMethodsFailedRow, bool) {
-	key := fmt.Sprintf(transactionMonthMethodFailedKey, req.Month, req.Year)
-
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTransactionMethodsFailedRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (t *transactionStatsCache) SetCachedMonthMethodFailedCached(ctx context.Context, req *requests.MonthMethodTransaction, res []*db.GetMonthlyTransactionMethodsFailedRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsCache) GetOrLoadMonthMethodFailed(ctx context.Context, req *requests.MonthMethodTransaction, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsFailedRow, error)) ([]*db.GetMonthlyTransactionMethodsFailedRow, error) {
 	key := fmt.Sprintf(transactionMonthMethodFailedKey, req.Month, req.Year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
 
-func (t *transactionStatsCache) GetCachedYearMethodFailedCached(ctx context.Context, year int) ([]*db.GetYearlyTransactionMethodsFailedRow, bool) {
-	key := fmt.Sprintf(transactionYearMethodFailedKey, year)
-
-	result, found := cache.GetFromCache[[]*db.GetYearlyTransactionMethodsFailedRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsCache) SetCachedYearMethodFailedCached(ctx context.Context, year int, res []*db.GetYearlyTransactionMethodsFailedRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsCache) GetOrLoadYearMethodFailed(ctx context.Context, year int, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsFailedRow, error)) ([]*db.GetYearlyTransactionMethodsFailedRow, error) {
 	key := fmt.Sprintf(transactionYearMethodFailedKey, year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag), load)
 }
@@ -30,177 +30,50 @@ func NewTransactionStatsByMerchantCache(store *cache.CacheStore) *transactionSta
 	return &transactionStatsByMerchantCache{store: store}
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedMonthAmountSuccessByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant) ([]*db.GetMonthlyAmountTransactionSuccessByMerchantRow, bool) {
+func (t *transactionStatsByMerchantCache) GetOrLoadMonthAmountSuccessByMerchant(ctx context.Context, req *requests.MonthAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionSuccessByMerchantRow, error)) ([]*db.GetMonthlyAmountTransactionSuccessByMerchantRow, error) {
 	key := fmt.Sprintf(transactonMonthAmountSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyAmountTransactionSuccessByMerchantRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedMonthAmountSuccessByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant, res []*db.GetMonthlyAmountTransactionSuccessByMerchantRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(transactonMonthAmountSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
-
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedMonthAmountFailedByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant) ([]*db.GetMonthlyAmountTransactionFailedByMerchantRow, bool) {
-	key := fmt.Sprintf(transactonMonthAmountFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
-
-	result, found := cache.GetFromCache[[]*db.GetMonthlyAmountTransactionFailedByMerchantRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedMonthAmountFailedByMerchantCached(ctx context.Context, req *requests.MonthAmountTransactionMerchant, res []*db.GetMonthlyAmountTransactionFailedByMerchantRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsByMerchantCache) GetOrLoadMonthAmountFailedByMerchant(ctx context.Context, req *requests.MonthAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionFailedByMerchantRow, error)) ([]*db.GetMonthlyAmountTransactionFailedByMerchantRow, error) {
 	key := fmt.Sprintf(transactonMonthAmountFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedYearAmountFailedByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant) ([]*db.GetYearlyAmountTransactionFailedByMerchantRow, bool) {
+func (t *transactionStatsByMerchantCache) GetOrLoadYearAmountFailedByMerchant(ctx context.Context, req *requests.YearAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionFailedByMerchantRow, error)) ([]*db.GetYearlyAmountTransactionFailedByMerchantRow, error) {
 	key := fmt.Sprintf(transactonYearAmountFailedByMerchantKey, req.MerchantID, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetYearlyAmountTransactionFailedByMerchantRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedYearAmountFailedByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant, res []*db.GetYearlyAmountTransactionFailedByMerchantRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(transactonYearAmountFailedByMerchantKey, req.MerchantID, req.Year)
-
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedYearAmountSuccessByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant) ([]*db.GetYearlyAmountTransactionSuccessByMerchantRow, bool) {
-	key := fmt.Sprintf(transactonYearAmountSuccessByMerchantKey, req.MerchantID, req.Year)
-
-	result, found := cache.GetFromCache[[]*db.GetYearlyAmountTransactionSuccessByMerchantRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedYearAmountSuccessByMerchantCached(ctx context.Context, req *requests.YearAmountTransactionMerchant, res []*db.GetYearlyAmountTransactionSuccessByMerchantRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsByMerchantCache) GetOrLoadYearAmountSuccessByMerchant(ctx context.Context, req *requests.YearAmountTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyAmountTransactionSuccessByMerchantRow, error)) ([]*db.GetYearlyAmountTransactionSuccessByMerchantRow, error) {
 	key := fmt.Sprintf(transactonYearAmountSuccessByMerchantKey, req.MerchantID, req.Year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedMonthMethodSuccessByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant) ([]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow, bool) {
+func (t *transactionStatsByMerchantCache) GetOrLoadMonthMethodSuccessByMerchant(ctx context.Context, req *requests.MonthMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow, error)) ([]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow, error) {
 	key := fmt.Sprintf(transactonMonthMethodSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedMonthMethodSuccessByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant, res []*db.GetMonthlyTransactionMethodsByMerchantSuccessRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(transactonMonthMethodSuccessByMerchantKey, req.MerchantID, req.Month, req.Year)
-
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedYearMethodSuccessByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant) ([]*db.GetYearlyTransactionMethodsByMerchantSuccessRow, bool) {
-	key := fmt.Sprintf(transactonYearMethodSuccessByMerchantKey, req.MerchantID, req.Year)
-
-	result, found := cache.GetFromCache[[]*db.GetYearlyTransactionMethodsByMerchantSuccessRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedYearMethodSuccessByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant, res []*db.GetYearlyTransactionMethodsByMerchantSuccessRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsByMerchantCache) GetOrLoadYearMethodSuccessByMerchant(ctx context.Context, req *requests.YearMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsByMerchantSuccessRow, error)) ([]*db.GetYearlyTransactionMethodsByMerchantSuccessRow, error) {
 	key := fmt.Sprintf(transactonYearMethodSuccessByMerchantKey, req.MerchantID, req.Year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedMonthMethodFailedByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant) ([]*db.GetMonthlyTransactionMethodsByMerchantFailedRow, bool) {
+func (t *transactionStatsByMerchantCache) GetOrLoadMonthMethodFailedByMerchant(ctx context.Context, req *requests.MonthMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsByMerchantFailedRow, error)) ([]*db.GetMonthlyTransactionMethodsByMerchantFailedRow, error) {
 	key := fmt.Sprintf(transactonMonthMethodFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
 
-	result, found := cache.GetFromCache[[]*db.GetMonthlyTransactionMethodsByMerchantFailedRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedMonthMethodFailedByMerchantCached(ctx context.Context, req *requests.MonthMethodTransactionMerchant, res []*db.GetMonthlyTransactionMethodsByMerchantFailedRow) {
-	if res == nil {
-		return
-	}
-
-	key := fmt.Sprintf(transactonMonthMethodFailedByMerchantKey, req.MerchantID, req.Month, req.Year)
-
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
 
-func (t *transactionStatsByMerchantCache) GetCachedYearMethodFailedByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant) ([]*db.GetYearlyTransactionMethodsByMerchantFailedRow, bool) {
-	key := fmt.Sprintf(transactonYearMethodFailedByMerchantKey, req.MerchantID, req.Year)
-
-	result, found := cache.GetFromCache[[]*db.GetYearlyTransactionMethodsByMerchantFailedRow](ctx, t.store, key)
-
-	if !found || result == nil {
-		return nil, false
-	}
-	return result, true
-}
-
-func (t *transactionStatsByMerchantCache) SetCachedYearMethodFailedByMerchantCached(ctx context.Context, req *requests.YearMethodTransactionMerchant, res []*db.GetYearlyTransactionMethodsByMerchantFailedRow) {
-	if res == nil {
-		return
-	}
-
+func (t *transactionStatsByMerchantCache) GetOrLoadYearMethodFailedByMerchant(ctx context.Context, req *requests.YearMethodTransactionMerchant, load func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsByMerchantFailedRow, error)) ([]*db.GetYearlyTransactionMethodsByMerchantFailedRow, error) {
 	key := fmt.Sprintf(transactonYearMethodFailedByMerchantKey, req.MerchantID, req.Year)
 
-	cache.SetToCache(ctx, t.store, key, &res, ttlDefault)
+	return cache.GetOrLoad(ctx, t.store, key, statsLoadOptions(entityTag, cache.MerchantTag(req.MerchantID)), load)
 }
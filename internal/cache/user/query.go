@@ -18,6 +18,8 @@ const (
 	ttlDefault = 5 * time.Minute
 )
 
+var entityTag = cache.EntityTag("user")
+
 type userListCacheResponse[T any] struct {
 	Data         []T  `json:"data"`
 	TotalRecords *int `json:"total_records"`
@@ -34,7 +36,7 @@ func NewUserQueryCache(store *cache.CacheStore) *userQueryCache {
 func (s *userQueryCache) GetCachedUsersCache(ctx context.Context, req *requests.FindAllUsers) ([]*db.GetUsersRow, *int, bool) {
 	key := fmt.Sprintf(userAllCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[userListCacheResponse[*db.GetUsersRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[userListCacheResponse[*db.GetUsersRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -55,13 +57,13 @@ func (s *userQueryCache) SetCachedUsersCache(ctx context.Context, req *requests.
 
 	key := fmt.Sprintf(userAllCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &userListCacheResponse[*db.GetUsersRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *userQueryCache) GetCachedUserActiveCache(ctx context.Context, req *requests.FindAllUsers) ([]*db.GetUsersActiveRow, *int, bool) {
 	key := fmt.Sprintf(userActiveCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[userListCacheResponse[*db.GetUsersActiveRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[userListCacheResponse[*db.GetUsersActiveRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -82,13 +84,13 @@ func (s *userQueryCache) SetCachedUserActiveCache(ctx context.Context, req *requ
 
 	key := fmt.Sprintf(userActiveCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &userListCacheResponse[*db.GetUsersActiveRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *userQueryCache) GetCachedUserTrashedCache(ctx context.Context, req *requests.FindAllUsers) ([]*db.GetUserTrashedRow, *int, bool) {
 	key := fmt.Sprintf(userTrashedCacheKey, req.Page, req.PageSize, req.Search)
 
-	result, found := cache.GetFromCache[userListCacheResponse[*db.GetUserTrashedRow]](ctx, s.store, key)
+	result, found := cache.GetFromCache[userListCacheResponse[*db.GetUserTrashedRow]](ctx, s.store, key, entityTag)
 
 	if !found || result.Data == nil {
 		return nil, nil, false
@@ -109,13 +111,13 @@ func (s *userQueryCache) SetCachedUserTrashedCache(ctx context.Context, req *req
 
 	key := fmt.Sprintf(userTrashedCacheKey, req.Page, req.PageSize, req.Search)
 	payload := &userListCacheResponse[*db.GetUserTrashedRow]{Data: data, TotalRecords: total}
-	cache.SetToCache(ctx, s.store, key, payload, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, payload, ttlDefault, entityTag)
 }
 
 func (s *userQueryCache) GetCachedUserCache(ctx context.Context, id int) (*db.GetUserByIDRow, bool) {
 	key := fmt.Sprintf(userByIdCacheKey, id)
 
-	result, found := cache.GetFromCache[*db.GetUserByIDRow](ctx, s.store, key)
+	result, found := cache.GetFromCache[*db.GetUserByIDRow](ctx, s.store, key, entityTag)
 
 	if !found || result == nil {
 		return nil, false
@@ -130,5 +132,5 @@ func (s *userQueryCache) SetCachedUserCache(ctx context.Context, data *db.GetUse
 	}
 
 	key := fmt.Sprintf(userByIdCacheKey, data.UserID)
-	cache.SetToCache(ctx, s.store, key, data, ttlDefault)
+	cache.SetToCache(ctx, s.store, key, data, ttlDefault, entityTag)
 }
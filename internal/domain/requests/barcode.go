@@ -0,0 +1,19 @@
+package requests
+
+import (
+	"pointofsale/pkg/utils"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// newBarcodeValidator returns a validator that also knows the "barcode" tag,
+// which accepts EAN-8, UPC-A and EAN-13 codes with a correct check digit.
+func newBarcodeValidator() *validator.Validate {
+	validate := validator.New()
+
+	_ = validate.RegisterValidation("barcode", func(fl validator.FieldLevel) bool {
+		return utils.ValidateBarcode(fl.Field().String()) == nil
+	})
+
+	return validate
+}
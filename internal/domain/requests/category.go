@@ -14,14 +14,16 @@ type MonthTotalPrice struct {
 }
 
 type MonthTotalPriceCategory struct {
-	CategoryID int `json:"category_id"`
-	Year       int `json:"year" validate:"required"`
-	Month      int `json:"month" validate:"required"`
+	CategoryID           int  `json:"category_id"`
+	Year                 int  `json:"year" validate:"required"`
+	Month                int  `json:"month" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
 }
 
 type YearTotalPriceCategory struct {
-	CategoryID int `json:"category_id"`
-	Year       int `json:"year" validate:"required"`
+	CategoryID           int  `json:"category_id"`
+	Year                 int  `json:"year" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
 }
 
 type MonthTotalPriceMerchant struct {
@@ -35,24 +37,41 @@ type YearTotalPriceMerchant struct {
 	Year       int `json:"year" validate:"required"`
 }
 
+// MonthPrice and YearPrice ask for the sales of every category. With
+// IncludeSubcategories each category also counts the sales of its
+// descendants.
+type MonthPrice struct {
+	Year                 int  `json:"year" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
+}
+
+type YearPrice struct {
+	Year                 int  `json:"year" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
+}
+
 type MonthPriceMerchant struct {
-	MerchantID int `json:"merchant_id" validate:"required"`
-	Year       int `json:"year" validate:"required"`
+	MerchantID           int  `json:"merchant_id" validate:"required"`
+	Year                 int  `json:"year" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
 }
 
 type YearPriceMerchant struct {
-	MerchantID int `json:"merchant_id" validate:"required"`
-	Year       int `json:"year" validate:"required"`
+	MerchantID           int  `json:"merchant_id" validate:"required"`
+	Year                 int  `json:"year" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
 }
 
 type MonthPriceId struct {
-	CategoryID int `json:"category_id"`
-	Year       int `json:"year" validate:"required"`
+	CategoryID           int  `json:"category_id"`
+	Year                 int  `json:"year" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
 }
 
 type YearPriceId struct {
-	CategoryID int `json:"category_id"`
-	Year       int `json:"year" validate:"required"`
+	CategoryID           int  `json:"category_id"`
+	Year                 int  `json:"year" validate:"required"`
+	IncludeSubcategories bool `json:"include_subcategories"`
 }
 
 type CategoryNameAndId struct {
@@ -64,6 +83,12 @@ type CreateCategoryRequest struct {
 	Name         string  `json:"name" validate:"required"`
 	Description  string  `json:"description" validate:"required"`
 	SlugCategory *string `json:"slug_category"`
+	// ParentID places the category below another one. Leave it nil for a
+	// root category.
+	ParentID *int `json:"parent_id" validate:"omitempty,min=1"`
+	// MerchantID makes the category private to a merchant. Leave it nil for
+	// a global category every merchant can use.
+	MerchantID *int `json:"merchant_id" validate:"omitempty,min=1"`
 }
 
 type UpdateCategoryRequest struct {
@@ -73,6 +98,24 @@ type UpdateCategoryRequest struct {
 	SlugCategory *string `json:"slug_category"`
 }
 
+// MoveCategoryRequest places a category below ParentID, or at the root when
+// ParentID is nil, at Position among its new siblings. A nil Position moves
+// it after the last sibling.
+type MoveCategoryRequest struct {
+	CategoryID int  `json:"category_id" validate:"required,min=1"`
+	ParentID   *int `json:"parent_id" validate:"omitempty,min=1"`
+	Position   *int `json:"position" validate:"omitempty,min=0"`
+}
+
+// ReorderCategoriesRequest lists every sibling below ParentID that belongs
+// to MerchantID, nil meaning root and global respectively, in their new
+// order.
+type ReorderCategoriesRequest struct {
+	ParentID    *int  `json:"parent_id" validate:"omitempty,min=1"`
+	MerchantID  *int  `json:"merchant_id" validate:"omitempty,min=1"`
+	CategoryIDs []int `json:"category_ids" validate:"required,min=1,dive,min=1"`
+}
+
 func (r *CreateCategoryRequest) Validate() error {
 	validate := validator.New()
 	err := validate.Struct(r)
@@ -90,3 +133,21 @@ func (r *UpdateCategoryRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r *MoveCategoryRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *ReorderCategoriesRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
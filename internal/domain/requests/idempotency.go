@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// IdempotencyRequest identifies one attempt of a retried call. RequestHash is
+// the hex SHA-256 of the request payload.
+type IdempotencyRequest struct {
+	UserID      int    `json:"user_id" validate:"required,min=1"`
+	Method      string `json:"method" validate:"required"`
+	Key         string `json:"key" validate:"required,max=255,printascii,excludesall=0x20"`
+	RequestHash string `json:"request_hash" validate:"required,len=64,hexadecimal"`
+}
+
+type ReserveIdempotencyKeyRecordRequest struct {
+	UserID      int       `json:"user_id"`
+	Method      string    `json:"method"`
+	Key         string    `json:"key"`
+	RequestHash string    `json:"request_hash"`
+	LockedUntil time.Time `json:"locked_until"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func (r *IdempotencyRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -29,6 +29,15 @@ type UpdateMerchantRequest struct {
 	Status       string `json:"status" validate:"required"`
 }
 
+// UpdateMerchantBarcodeSettingsRequest sets how barcodes are generated for
+// the products of a merchant. Gs1Prefix is the GS1 company prefix licensed
+// to the merchant; without one, codes come from the GS1 in-store range.
+type UpdateMerchantBarcodeSettingsRequest struct {
+	MerchantID    *int    `json:"merchant_id"`
+	Gs1Prefix     *string `json:"gs1_prefix" validate:"omitempty,numeric,min=6,max=10"`
+	BarcodeFormat string  `json:"barcode_format" validate:"required,oneof=ean13 upca"`
+}
+
 func (r *CreateMerchantRequest) Validate() error {
 	validate := validator.New()
 	err := validate.Struct(r)
@@ -46,3 +55,12 @@ func (r *UpdateMerchantRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r *UpdateMerchantBarcodeSettingsRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
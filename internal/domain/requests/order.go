@@ -4,6 +4,7 @@ import "github.com/go-playground/validator/v10"
 
 type FindAllOrders struct {
 	Search   string `json:"search" validate:"required"`
+	Status   string `json:"status" validate:"omitempty,oneof=draft pending_payment paid cancelled refunded"`
 	Page     int    `json:"page" validate:"min=1"`
 	PageSize int    `json:"page_size" validate:"min=1,max=100"`
 }
@@ -11,6 +12,7 @@ type FindAllOrders struct {
 type FindAllOrderMerchant struct {
 	MerchantID int    `json:"merchant_id" validate:"required"`
 	Search     string `json:"search" validate:"required"`
+	Status     string `json:"status" validate:"omitempty,oneof=draft pending_payment paid cancelled refunded"`
 	Page       int    `json:"page" validate:"min=1"`
 	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
 }
@@ -74,15 +76,26 @@ type UpdateOrderRequest struct {
 	Items   []UpdateOrderItemRequest `json:"items" validate:"required"`
 }
 
+// UpdateOrderStatusRequest moves an order through the statuses a cashier
+// controls. Paid and refunded are only reached through transactions.
+type UpdateOrderStatusRequest struct {
+	OrderID int    `json:"order_id"`
+	Status  string `json:"status" validate:"required,oneof=draft pending_payment cancelled"`
+}
+
+// CreateOrderItemRequest sells a product. Products sold through variants
+// need the VariantID of the variant being sold.
 type CreateOrderItemRequest struct {
-	ProductID int `json:"product_id" validate:"required"`
-	Quantity  int `json:"quantity" validate:"required"`
+	ProductID int  `json:"product_id" validate:"required"`
+	VariantID *int `json:"variant_id" validate:"omitempty,min=1"`
+	Quantity  int  `json:"quantity" validate:"required"`
 }
 
 type UpdateOrderItemRequest struct {
-	OrderItemID int `json:"order_item_id" validate:"required"`
-	ProductID   int `json:"product_id" validate:"required"`
-	Quantity    int `json:"quantity" validate:"required"`
+	OrderItemID int  `json:"order_item_id" validate:"required"`
+	ProductID   int  `json:"product_id" validate:"required"`
+	VariantID   *int `json:"variant_id" validate:"omitempty,min=1"`
+	Quantity    int  `json:"quantity" validate:"required"`
 }
 
 func (r *CreateOrderRequest) Validate() error {
@@ -102,3 +115,12 @@ func (r *UpdateOrderRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r *UpdateOrderStatusRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
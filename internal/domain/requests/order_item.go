@@ -9,18 +9,20 @@ type FindAllOrderItems struct {
 }
 
 type CreateOrderItemRecordRequest struct {
-	OrderID   int `json:"order_id" validate:"required"`
-	ProductID int `json:"product_id" validate:"required"`
-	Quantity  int `json:"quantity" validate:"required"`
-	Price     int `json:"price" validate:"required"`
+	OrderID   int  `json:"order_id" validate:"required"`
+	ProductID int  `json:"product_id" validate:"required"`
+	VariantID *int `json:"variant_id"`
+	Quantity  int  `json:"quantity" validate:"required"`
+	Price     int  `json:"price" validate:"required"`
 }
 
 type UpdateOrderItemRecordRequest struct {
-	OrderItemID int `json:"order_item_id" validate:"required"`
-	OrderID     int `json:"order_id" validate:"required"`
-	ProductID   int `json:"product_id" validate:"required"`
-	Quantity    int `json:"quantity" validate:"required"`
-	Price       int `json:"price" validate:"required"`
+	OrderItemID int  `json:"order_item_id" validate:"required"`
+	OrderID     int  `json:"order_id" validate:"required"`
+	ProductID   int  `json:"product_id" validate:"required"`
+	VariantID   *int `json:"variant_id"`
+	Quantity    int  `json:"quantity" validate:"required"`
+	Price       int  `json:"price" validate:"required"`
 }
 
 func (r *CreateOrderItemRequest) Validate() error {
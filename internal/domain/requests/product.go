@@ -15,6 +15,9 @@ type ProductByCategoryRequest struct {
 	MaxPrice     int    `json:"max_price"`
 	PageSize     int    `json:"page_size" validate:"min=1,max=100"`
 	CategoryName string `json:"category_name" validate:"required"`
+	// IncludeSubcategories also lists the products of every category below
+	// the named one.
+	IncludeSubcategories bool `json:"include_subcategories"`
 }
 
 type ProductByMerchantRequest struct {
@@ -38,7 +41,7 @@ type CreateProductRequest struct {
 	Weight       int     `json:"weight" validate:"required"`
 	SlugProduct  *string `json:"slug_product"`
 	ImageProduct string  `json:"image_product" validate:"required"`
-	Barcode      *string `json:"barcode"`
+	Barcode      *string `json:"barcode" validate:"omitempty,barcode"`
 }
 
 type UpdateProductRequest struct {
@@ -53,7 +56,58 @@ type UpdateProductRequest struct {
 	Weight       int     `json:"weight" validate:"required"`
 	SlugProduct  *string `json:"slug_product"`
 	ImageProduct string  `json:"image_product" validate:"required"`
-	Barcode      *string `json:"barcode"`
+	Barcode      *string `json:"barcode" validate:"omitempty,barcode"`
+}
+
+type FindStockMovementsRequest struct {
+	ProductID int `json:"product_id" validate:"required"`
+	Page      int `json:"page" validate:"min=1"`
+	PageSize  int `json:"page_size" validate:"min=1,max=100"`
+}
+
+type FindLowStockProducts struct {
+	MerchantID int `json:"merchant_id" validate:"required,min=1"`
+	Page       int `json:"page" validate:"min=1"`
+	PageSize   int `json:"page_size" validate:"min=1,max=100"`
+}
+
+// UpdateProductReorderLevelRequest sets when a product counts as low on
+// stock. A ReorderPoint of 0 turns low-stock alerts off for the product.
+type UpdateProductReorderLevelRequest struct {
+	ProductID       *int `json:"product_id"`
+	ReorderPoint    int  `json:"reorder_point" validate:"min=0"`
+	ReorderQuantity int  `json:"reorder_quantity" validate:"min=0"`
+}
+
+// CreateStockMovementRecordRequest changes the stock of a product by
+// QuantityDelta and records why in the stock ledger.
+type CreateStockMovementRecordRequest struct {
+	ProductID     int     `json:"product_id" validate:"required"`
+	VariantID     *int    `json:"variant_id"`
+	Reason        string  `json:"reason" validate:"required"`
+	QuantityDelta int     `json:"quantity_delta" validate:"required"`
+	ReferenceType *string `json:"reference_type"`
+	ReferenceID   *int    `json:"reference_id"`
+	UserID        *int    `json:"user_id"`
+	Note          *string `json:"note"`
+}
+
+// ImportProductsRequest carries a CSV or XLSX sheet of products for one
+// merchant. With DryRun set the rows are only validated and matched.
+type ImportProductsRequest struct {
+	MerchantID int    `json:"merchant_id" validate:"required,min=1"`
+	Format     string `json:"format" validate:"required,oneof=csv xlsx"`
+	DryRun     bool   `json:"dry_run"`
+	File       []byte `json:"-" validate:"required"`
+}
+
+type ExportProductsRequest struct {
+	MerchantID int    `json:"merchant_id" validate:"required,min=1"`
+	Format     string `json:"format" validate:"required,oneof=csv xlsx"`
+	Search     string `json:"search"`
+	CategoryID int    `json:"category_id"`
+	MinPrice   int    `json:"min_price"`
+	MaxPrice   int    `json:"max_price"`
 }
 
 type ProductFormData struct {
@@ -66,10 +120,15 @@ type ProductFormData struct {
 	Brand        string
 	Weight       int
 	ImagePath    string
+	Barcode      *string
+
+	// ImageNew is set when ImagePath was stored by this request rather than
+	// reused from an identical earlier upload.
+	ImageNew bool
 }
 
 func (r *CreateProductRequest) Validate() error {
-	validate := validator.New()
+	validate := newBarcodeValidator()
 	err := validate.Struct(r)
 	if err != nil {
 		return err
@@ -78,6 +137,33 @@ func (r *CreateProductRequest) Validate() error {
 }
 
 func (r *UpdateProductRequest) Validate() error {
+	validate := newBarcodeValidator()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *ImportProductsRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *ExportProductsRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *UpdateProductReorderLevelRequest) Validate() error {
 	validate := validator.New()
 	err := validate.Struct(r)
 	if err != nil {
@@ -0,0 +1,88 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+// SetProductOptionsRequest replaces the options a product varies by. Every
+// existing variant must still be described by the new options.
+type SetProductOptionsRequest struct {
+	ProductID int                    `json:"product_id"`
+	Options   []ProductOptionRequest `json:"options" validate:"max=3,dive"`
+}
+
+type ProductOptionRequest struct {
+	Name   string   `json:"name" validate:"required,max=50"`
+	Values []string `json:"values" validate:"required,min=1,dive,required,max=50"`
+}
+
+// CreateProductVariantRequest adds a variant to a product. Options names
+// one value for every option of the product, and a nil Price inherits the
+// product price.
+type CreateProductVariantRequest struct {
+	ProductID    int               `json:"product_id" validate:"required,min=1"`
+	Sku          string            `json:"sku" validate:"required,max=64"`
+	Barcode      *string           `json:"barcode" validate:"omitempty,barcode"`
+	Options      map[string]string `json:"options" validate:"required,min=1"`
+	Price        *int              `json:"price" validate:"omitempty,min=0"`
+	CountInStock int               `json:"count_in_stock" validate:"min=0"`
+}
+
+// UpdateProductVariantRequest changes the identifiers and price of a
+// variant. A non-nil CountInStock is applied as a stock adjustment.
+type UpdateProductVariantRequest struct {
+	VariantID    *int    `json:"variant_id"`
+	Sku          string  `json:"sku" validate:"required,max=64"`
+	Barcode      *string `json:"barcode" validate:"omitempty,barcode"`
+	Price        *int    `json:"price" validate:"omitempty,min=0"`
+	CountInStock *int    `json:"count_in_stock" validate:"omitempty,min=0"`
+}
+
+type CreateProductVariantRecordRequest struct {
+	ProductID int               `json:"product_id"`
+	Sku       string            `json:"sku"`
+	Barcode   *string           `json:"barcode"`
+	Options   map[string]string `json:"options"`
+	Price     *int              `json:"price"`
+}
+
+type UpdateProductVariantRecordRequest struct {
+	VariantID int     `json:"variant_id"`
+	Sku       string  `json:"sku"`
+	Barcode   *string `json:"barcode"`
+	Price     *int    `json:"price"`
+}
+
+func (r *SetProductOptionsRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CreateProductVariantRequest) Validate() error {
+	validate := newBarcodeValidator()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UpdateProductVariantRequest) Validate() error {
+	validate := newBarcodeValidator()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+type FindAllPurchaseOrders struct {
+	MerchantID int    `json:"merchant_id"`
+	SupplierID int    `json:"supplier_id"`
+	Status     string `json:"status" validate:"omitempty,oneof=draft ordered partially_received received cancelled"`
+	Page       int    `json:"page" validate:"min=1"`
+	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+// CreatePurchaseOrderRequest opens a draft purchase order with its lines.
+type CreatePurchaseOrderRequest struct {
+	MerchantID int                              `json:"merchant_id" validate:"required,min=1"`
+	SupplierID int                              `json:"supplier_id" validate:"required,min=1"`
+	Note       *string                          `json:"note"`
+	Items      []CreatePurchaseOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type CreatePurchaseOrderItemRequest struct {
+	ProductID int `json:"product_id" validate:"required,min=1"`
+	Quantity  int `json:"quantity" validate:"required,min=1"`
+	UnitCost  int `json:"unit_cost" validate:"min=0"`
+}
+
+type CreatePurchaseOrderRecordRequest struct {
+	MerchantID int     `json:"merchant_id"`
+	SupplierID int     `json:"supplier_id"`
+	Note       *string `json:"note"`
+	CreatedBy  *int    `json:"created_by"`
+}
+
+type CreatePurchaseOrderItemRecordRequest struct {
+	PurchaseOrderID int `json:"purchase_order_id"`
+	ProductID       int `json:"product_id"`
+	QuantityOrdered int `json:"quantity_ordered"`
+	UnitCost        int `json:"unit_cost"`
+}
+
+// ReceivePurchaseOrderRequest books goods that arrived against the lines of
+// an ordered purchase order. A line without a unit cost is received at the
+// cost it was ordered at.
+type ReceivePurchaseOrderRequest struct {
+	PurchaseOrderID int                               `json:"purchase_order_id"`
+	Note            *string                           `json:"note"`
+	Items           []ReceivePurchaseOrderItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type ReceivePurchaseOrderItemRequest struct {
+	PurchaseOrderItemID int  `json:"purchase_order_item_id" validate:"required,min=1"`
+	Quantity            int  `json:"quantity" validate:"required,min=1"`
+	UnitCost            *int `json:"unit_cost" validate:"omitempty,min=0"`
+}
+
+type CreatePurchaseOrderReceiptRecordRequest struct {
+	PurchaseOrderItemID int     `json:"purchase_order_item_id"`
+	Quantity            int     `json:"quantity"`
+	UnitCost            int     `json:"unit_cost"`
+	ReceivedBy          *int    `json:"received_by"`
+	Note                *string `json:"note"`
+}
+
+func (r *CreatePurchaseOrderRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReceivePurchaseOrderRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
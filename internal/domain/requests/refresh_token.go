@@ -1,17 +1,25 @@
 package requests
 
-import "github.com/go-playground/validator/v10"
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
 
 type CreateRefreshToken struct {
-	UserId    int    `json:"user_id" validate:"required,min=1"`
-	Token     string `json:"token" validate:"required,min=1"`
-	ExpiresAt string `json:"expires_at" validate:"required,min=1"`
+	UserId           int       `json:"user_id" validate:"required,min=1"`
+	Token            string    `json:"token" validate:"required,min=1"`
+	ExpiresAt        string    `json:"expires_at" validate:"required,min=1"`
+	SessionID        string    `json:"session_id" validate:"required,uuid"`
+	AccessTokenID    string    `json:"access_token_id"`
+	UserAgent        string    `json:"user_agent" validate:"max=255"`
+	IPAddress        string    `json:"ip_address" validate:"max=64"`
+	SessionStartedAt time.Time `json:"session_started_at"`
 }
 
-type UpdateRefreshToken struct {
-	UserId    int    `json:"user_id" validate:"required,min=1"`
-	Token     string `json:"token" validate:"required,min=1"`
-	ExpiresAt string `json:"expires_at" validate:"required,min=1"`
+type LogoutRequest struct {
+	UserID  int    `json:"user_id" validate:"required,min=1"`
+	TokenID string `json:"token_id" validate:"required"`
 }
 
 type RefreshTokenRequest struct {
@@ -30,7 +38,7 @@ func (r *CreateRefreshToken) Validate() error {
 	return nil
 }
 
-func (r *UpdateRefreshToken) Validate() error {
+func (r *LogoutRequest) Validate() error {
 	validate := validator.New()
 
 	err := validate.Struct(r)
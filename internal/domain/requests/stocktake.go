@@ -0,0 +1,77 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+type FindAllStocktakes struct {
+	MerchantID int    `json:"merchant_id"`
+	Status     string `json:"status" validate:"omitempty,oneof=open posted cancelled"`
+	Page       int    `json:"page" validate:"min=1"`
+	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+type FindStocktakeItems struct {
+	StocktakeID int `json:"stocktake_id" validate:"required,min=1"`
+	Page        int `json:"page" validate:"min=1"`
+	PageSize    int `json:"page_size" validate:"min=1,max=100"`
+}
+
+// CreateStocktakeRequest opens a stocktake for a merchant. Without a
+// category every active product of the merchant is counted.
+type CreateStocktakeRequest struct {
+	MerchantID int     `json:"merchant_id" validate:"required,min=1"`
+	CategoryID *int    `json:"category_id" validate:"omitempty,min=1"`
+	Note       *string `json:"note"`
+}
+
+type CreateStocktakeRecordRequest struct {
+	MerchantID int     `json:"merchant_id"`
+	CategoryID *int    `json:"category_id"`
+	Note       *string `json:"note"`
+	CreatedBy  *int    `json:"created_by"`
+}
+
+// SubmitStocktakeCountsRequest records counted quantities against an open
+// stocktake. Each line names its product by ID or by barcode; lines for the
+// same product are added together, and the total replaces any earlier count
+// of that product.
+type SubmitStocktakeCountsRequest struct {
+	StocktakeID int                           `json:"stocktake_id"`
+	Items       []SubmitStocktakeCountRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type SubmitStocktakeCountRequest struct {
+	ProductID *int    `json:"product_id" validate:"required_without=Barcode,omitempty,min=1"`
+	Barcode   *string `json:"barcode" validate:"required_without=ProductID,omitempty,min=1,max=50"`
+	Quantity  int     `json:"quantity" validate:"min=0"`
+}
+
+type UpdateStocktakeCountRecordRequest struct {
+	StocktakeID     int  `json:"stocktake_id"`
+	ProductID       int  `json:"product_id"`
+	CountedQuantity int  `json:"counted_quantity"`
+	CountedBy       *int `json:"counted_by"`
+}
+
+func (r *CreateStocktakeRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *SubmitStocktakeCountsRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+type FindAllSuppliers struct {
+	Search     string `json:"search"`
+	MerchantID int    `json:"merchant_id"`
+	Page       int    `json:"page" validate:"min=1"`
+	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+type CreateSupplierRequest struct {
+	MerchantID  int     `json:"merchant_id" validate:"required,min=1"`
+	Name        string  `json:"name" validate:"required"`
+	ContactName *string `json:"contact_name"`
+	Email       *string `json:"email" validate:"omitempty,email"`
+	Phone       *string `json:"phone" validate:"omitempty,max=50"`
+	Address     *string `json:"address"`
+}
+
+type UpdateSupplierRequest struct {
+	SupplierID  *int    `json:"supplier_id"`
+	Name        string  `json:"name" validate:"required"`
+	ContactName *string `json:"contact_name"`
+	Email       *string `json:"email" validate:"omitempty,email"`
+	Phone       *string `json:"phone" validate:"omitempty,max=50"`
+	Address     *string `json:"address"`
+}
+
+func (r *CreateSupplierRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UpdateSupplierRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
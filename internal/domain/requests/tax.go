@@ -0,0 +1,61 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+type FindAllTaxRates struct {
+	Search   string `json:"search" validate:"required"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+type CreateTaxRateRequest struct {
+	Name        string `json:"name" validate:"required"`
+	MerchantID  *int   `json:"merchant_id" validate:"omitempty,min=1"`
+	CategoryID  *int   `json:"category_id" validate:"omitempty,min=1"`
+	RateBps     int    `json:"rate_bps" validate:"min=0,max=10000"`
+	IsInclusive bool   `json:"is_inclusive"`
+	Rounding    string `json:"rounding" validate:"required,oneof=half_up half_even up down"`
+}
+
+type UpdateTaxRateRequest struct {
+	TaxRateID   *int   `json:"tax_rate_id"`
+	Name        string `json:"name" validate:"required"`
+	MerchantID  *int   `json:"merchant_id" validate:"omitempty,min=1"`
+	CategoryID  *int   `json:"category_id" validate:"omitempty,min=1"`
+	RateBps     int    `json:"rate_bps" validate:"min=0,max=10000"`
+	IsInclusive bool   `json:"is_inclusive"`
+	Rounding    string `json:"rounding" validate:"required,oneof=half_up half_even up down"`
+}
+
+type UpdateOrderItemTaxRequest struct {
+	OrderItemID  int    `json:"order_item_id" validate:"required"`
+	TaxRateID    *int   `json:"tax_rate_id"`
+	TaxRateBps   int    `json:"tax_rate_bps"`
+	TaxInclusive bool   `json:"tax_inclusive"`
+	TaxRounding  string `json:"tax_rounding"`
+	TaxAmount    int    `json:"tax_amount"`
+}
+
+func (r *CreateTaxRateRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UpdateTaxRateRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
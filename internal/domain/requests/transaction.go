@@ -47,25 +47,47 @@ type FindAllTransactionByMerchant struct {
 	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
 }
 
-type CreateTransactionRequest struct {
-	OrderID       int     `json:"order_id" validate:"required"`
-	CashierID     int     `json:"cashier_id" validate:"required"`
-	MerchantID    int     `json:"merchant_id"`
+// TransactionPaymentRequest is one tender line of a transaction: the part of
+// the order paid with a single method.
+type TransactionPaymentRequest struct {
 	PaymentMethod string  `json:"payment_method" validate:"required"`
-	Amount        int     `json:"amount" validate:"required"`
-	ChangeAmount  *int    `json:"change_amount"`
-	PaymentStatus *string `json:"payment_status" `
+	Amount        int     `json:"amount" validate:"required,min=1"`
+	Reference     *string `json:"reference"`
 }
 
-type UpdateTransactionRequest struct {
-	TransactionID *int    `json:"transaction_id"`
-	OrderID       int     `json:"order_id" validate:"required"`
-	CashierID     int     `json:"cashier_id" validate:"required"`
-	MerchantID    int     `json:"merchant_id"`
+type CreateTransactionPaymentRecordRequest struct {
+	TransactionID int     `json:"transaction_id" validate:"required"`
 	PaymentMethod string  `json:"payment_method" validate:"required"`
 	Amount        int     `json:"amount" validate:"required"`
-	ChangeAmount  *int    `json:"change_amount"`
-	PaymentStatus *string `json:"payment_status"`
+	ChangeAmount  int     `json:"change_amount"`
+	Reference     *string `json:"reference"`
+}
+
+type CreateTransactionRequest struct {
+	OrderID        int                         `json:"order_id" validate:"required"`
+	CashierID      int                         `json:"cashier_id" validate:"required"`
+	MerchantID     int                         `json:"merchant_id"`
+	PaymentMethod  string                      `json:"payment_method" validate:"required_without=Payments"`
+	Amount         int                         `json:"amount" validate:"required_without=Payments"`
+	Payments       []TransactionPaymentRequest `json:"payments" validate:"omitempty,dive"`
+	ChangeAmount   *int                        `json:"change_amount"`
+	PaymentStatus  *string                     `json:"payment_status" `
+	SubtotalAmount *int                        `json:"subtotal_amount"`
+	TaxAmount      *int                        `json:"tax_amount"`
+}
+
+type UpdateTransactionRequest struct {
+	TransactionID  *int                        `json:"transaction_id"`
+	OrderID        int                         `json:"order_id" validate:"required"`
+	CashierID      int                         `json:"cashier_id" validate:"required"`
+	MerchantID     int                         `json:"merchant_id"`
+	PaymentMethod  string                      `json:"payment_method" validate:"required_without=Payments"`
+	Amount         int                         `json:"amount" validate:"required_without=Payments"`
+	Payments       []TransactionPaymentRequest `json:"payments" validate:"omitempty,dive"`
+	ChangeAmount   *int                        `json:"change_amount"`
+	PaymentStatus  *string                     `json:"payment_status"`
+	SubtotalAmount *int                        `json:"subtotal_amount"`
+	TaxAmount      *int                        `json:"tax_amount"`
 }
 
 func (r *CreateTransactionRequest) Validate() error {
@@ -85,3 +107,64 @@ func (r *UpdateTransactionRequest) Validate() error {
 	}
 	return nil
 }
+
+type RefundItemRequest struct {
+	OrderItemID int `json:"order_item_id" validate:"required,min=1"`
+	Quantity    int `json:"quantity" validate:"required,min=1"`
+}
+
+// RefundTransactionRequest reverses part of a completed transaction. Without
+// items every unit that has not been refunded yet is refunded.
+type RefundTransactionRequest struct {
+	TransactionID int                 `json:"transaction_id"`
+	ReasonCode    string              `json:"reason_code" validate:"required,oneof=customer_return damaged wrong_item pricing_error duplicate other"`
+	Note          *string             `json:"note"`
+	ApprovedBy    int                 `json:"approved_by"`
+	Restock       bool                `json:"restock"`
+	Items         []RefundItemRequest `json:"items" validate:"omitempty,dive"`
+}
+
+type VoidTransactionRequest struct {
+	TransactionID int     `json:"transaction_id"`
+	ReasonCode    string  `json:"reason_code" validate:"required,oneof=customer_return damaged wrong_item pricing_error duplicate other"`
+	Note          *string `json:"note"`
+	ApprovedBy    int     `json:"approved_by"`
+	Restock       bool    `json:"restock"`
+}
+
+type CreateTransactionRefundRecordRequest struct {
+	TransactionID int     `json:"transaction_id" validate:"required"`
+	Kind          string  `json:"kind" validate:"required,oneof=refund void"`
+	Amount        int     `json:"amount" validate:"required"`
+	TaxAmount     int     `json:"tax_amount"`
+	ReasonCode    string  `json:"reason_code" validate:"required"`
+	Note          *string `json:"note"`
+	ApprovedBy    int     `json:"approved_by" validate:"required"`
+	Restock       bool    `json:"restock"`
+}
+
+type CreateTransactionRefundItemRecordRequest struct {
+	TransactionRefundID int `json:"transaction_refund_id" validate:"required"`
+	OrderItemID         int `json:"order_item_id" validate:"required"`
+	Quantity            int `json:"quantity" validate:"required"`
+	Amount              int `json:"amount"`
+	TaxAmount           int `json:"tax_amount"`
+}
+
+func (r *RefundTransactionRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *VoidTransactionRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -28,3 +28,24 @@ type ApiResponseGetMe struct {
 	Message string        `json:"messsage"`
 	Data    *UserResponse `json:"data"`
 }
+
+type SessionResponse struct {
+	SessionID  string `json:"session_id"`
+	UserAgent  string `json:"user_agent"`
+	IPAddress  string `json:"ip_address"`
+	StartedAt  string `json:"started_at"`
+	LastUsedAt string `json:"last_used_at"`
+	ExpiresAt  string `json:"expires_at"`
+	Current    bool   `json:"current"`
+}
+
+type ApiResponseLogout struct {
+	Status  string `json:"status"`
+	Message string `json:"messsage"`
+}
+
+type ApiResponseSessions struct {
+	Status  string             `json:"status"`
+	Message string             `json:"messsage"`
+	Data    []*SessionResponse `json:"data"`
+}
@@ -0,0 +1,35 @@
+package response
+
+// Cache layers reported by the admin cache endpoints: the gateway caches REST
+// responses, the service caches database reads behind gRPC.
+const (
+	CacheLayerGateway = "gateway"
+	CacheLayerService = "service"
+)
+
+type CacheNamespaceResponse struct {
+	Layer        string `json:"layer"`
+	Namespace    string `json:"namespace"`
+	Keys         int64  `json:"keys"`
+	LocalEntries int    `json:"local_entries"`
+	LocalTier    bool   `json:"local_tier"`
+	Durable      bool   `json:"durable"`
+}
+
+type CacheFlushResponse struct {
+	Layer     string `json:"layer"`
+	Namespace string `json:"namespace"`
+	Deleted   int64  `json:"deleted"`
+}
+
+type ApiResponseCacheNamespaces struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    []*CacheNamespaceResponse `json:"data"`
+}
+
+type ApiResponseCacheFlush struct {
+	Status  string                `json:"status"`
+	Message string                `json:"message"`
+	Data    []*CacheFlushResponse `json:"data"`
+}
@@ -8,6 +8,15 @@ type CategoryResponse struct {
 	ImageCategory string `json:"image_category"`
 	CreatedAt     string `json:"created_at"`
 	UpdatedAt     string `json:"updated_at"`
+	ParentID      *int   `json:"parent_id"`
+	MerchantID    *int   `json:"merchant_id"`
+	Position      int    `json:"position"`
+}
+
+// CategoryTreeNode is a category together with its subcategories.
+type CategoryTreeNode struct {
+	*CategoryResponse
+	Children []*CategoryTreeNode `json:"children"`
 }
 
 type CategoryResponseDeleteAt struct {
@@ -19,6 +28,9 @@ type CategoryResponseDeleteAt struct {
 	CreatedAt     string  `json:"created_at"`
 	UpdatedAt     string  `json:"updated_at"`
 	DeletedAt     *string `json:"deleted_at,omitempty"`
+	ParentID      *int    `json:"parent_id"`
+	MerchantID    *int    `json:"merchant_id"`
+	Position      int     `json:"position"`
 }
 
 type CategoryMonthPriceResponse struct {
@@ -69,6 +81,12 @@ type ApiResponsesCategory struct {
 	Data    []*CategoryResponse `json:"data"`
 }
 
+type ApiResponseCategoryTree struct {
+	Status  string              `json:"status"`
+	Message string              `json:"message"`
+	Data    []*CategoryTreeNode `json:"data"`
+}
+
 type ApiResponseCategoryDelete struct {
 	Status  string `json:"status"`
 	Message string `json:"message"`
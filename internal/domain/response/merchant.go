@@ -27,6 +27,19 @@ type MerchantResponseDeleteAt struct {
 	DeletedAt    string `json:"deleted_at"`
 }
 
+type MerchantBarcodeSettingsResponse struct {
+	MerchantID    int     `json:"merchant_id"`
+	Gs1Prefix     *string `json:"gs1_prefix"`
+	BarcodeFormat string  `json:"barcode_format"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type ApiResponseMerchantBarcodeSettings struct {
+	Status  string                           `json:"status"`
+	Message string                           `json:"message"`
+	Data    *MerchantBarcodeSettingsResponse `json:"data"`
+}
+
 type ApiResponseMerchant struct {
 	Status  string            `json:"status"`
 	Message string            `json:"message"`
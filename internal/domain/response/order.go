@@ -7,6 +7,7 @@ type OrderResponse struct {
 	TotalPrice int    `json:"total_price"`
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at"`
+	Status     string `json:"status"`
 }
 
 type OrderResponseDeleteAt struct {
@@ -17,6 +18,7 @@ type OrderResponseDeleteAt struct {
 	CreatedAt  string  `json:"created_at"`
 	UpdatedAt  string  `json:"updated_at"`
 	DeleteAt   *string `json:"deleted_at"`
+	Status     string  `json:"status"`
 }
 
 type OrderMonthlyResponse struct {
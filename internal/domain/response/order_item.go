@@ -4,6 +4,7 @@ type OrderItemResponse struct {
 	ID        int    `json:"id"`
 	OrderID   int    `json:"order_id"`
 	ProductID int    `json:"product_id"`
+	VariantID *int   `json:"variant_id,omitempty"`
 	Quantity  int    `json:"quantity"`
 	Price     int    `json:"price"`
 	CreatedAt string `json:"created_at"`
@@ -15,6 +15,18 @@ type ProductResponse struct {
 	Barcode      string `json:"barcode"`
 	CreatedAt    string `json:"created_at"`
 	UpdatedAt    string `json:"updated_at"`
+	VariantCount *int   `json:"variant_count,omitempty"`
+	MinPrice     *int   `json:"min_price,omitempty"`
+	MaxPrice     *int   `json:"max_price,omitempty"`
+
+	// ImageVariants holds the downscaled copies of ImageProduct by name,
+	// such as thumb, small and medium.
+	ImageVariants map[string]string `json:"image_variants,omitempty"`
+
+	// ImageURL and ImageVariantURLs are signed, expiring download links for
+	// ImageProduct and ImageVariants.
+	ImageURL         string            `json:"image_url,omitempty"`
+	ImageVariantURLs map[string]string `json:"image_variant_urls,omitempty"`
 }
 
 type ProductResponseDeleteAt struct {
@@ -33,6 +45,82 @@ type ProductResponseDeleteAt struct {
 	CreatedAt    string  `json:"created_at"`
 	UpdatedAt    string  `json:"updated_at"`
 	DeleteAt     *string `json:"deleted_at"`
+	VariantCount *int    `json:"variant_count,omitempty"`
+	MinPrice     *int    `json:"min_price,omitempty"`
+	MaxPrice     *int    `json:"max_price,omitempty"`
+
+	ImageVariants map[string]string `json:"image_variants,omitempty"`
+
+	ImageURL         string            `json:"image_url,omitempty"`
+	ImageVariantURLs map[string]string `json:"image_variant_urls,omitempty"`
+}
+
+type StockMovementResponse struct {
+	ID            int     `json:"id"`
+	ProductID     int     `json:"product_id"`
+	Reason        string  `json:"reason"`
+	QuantityDelta int     `json:"quantity_delta"`
+	BalanceAfter  int     `json:"balance_after"`
+	ReferenceType *string `json:"reference_type"`
+	ReferenceID   *int    `json:"reference_id"`
+	UserID        *int    `json:"user_id"`
+	Note          *string `json:"note"`
+	CreatedAt     string  `json:"created_at"`
+	VariantID     *int    `json:"variant_id"`
+}
+
+type ReorderLevelResponse struct {
+	ID                int     `json:"id"`
+	MerchantID        int     `json:"merchant_id"`
+	CategoryID        int     `json:"category_id"`
+	Name              string  `json:"name"`
+	CountInStock      int     `json:"count_in_stock"`
+	ReorderPoint      int     `json:"reorder_point"`
+	ReorderQuantity   int     `json:"reorder_quantity"`
+	LowStockAlertedAt *string `json:"low_stock_alerted_at"`
+}
+
+// ProductScanResponse is what a till scanner resolves to. When the barcode
+// belongs to a variant, Price and CountInStock are the variant's.
+type ProductScanResponse struct {
+	Product        *ProductResponse  `json:"product"`
+	VariantID      *int              `json:"variant_id,omitempty"`
+	VariantSku     *string           `json:"variant_sku,omitempty"`
+	VariantOptions map[string]string `json:"variant_options,omitempty"`
+	Price          int               `json:"price"`
+	CountInStock   int               `json:"count_in_stock"`
+}
+
+// ProductImportRowResponse reports what an import did, or would do, with
+// one sheet row. Row is the row number as shown by a spreadsheet program.
+type ProductImportRowResponse struct {
+	Row       int      `json:"row"`
+	Action    string   `json:"action"`
+	ProductID *int     `json:"product_id,omitempty"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// ProductImportResponse summarises an import. Applied is false for a dry
+// run and for a sheet that failed validation, in which case nothing changed.
+type ProductImportResponse struct {
+	DryRun  bool                        `json:"dry_run"`
+	Applied bool                        `json:"applied"`
+	Created int                         `json:"created"`
+	Updated int                         `json:"updated"`
+	Failed  int                         `json:"failed"`
+	Rows    []*ProductImportRowResponse `json:"rows"`
+}
+
+type ApiResponseProductImport struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *ProductImportResponse `json:"data"`
+}
+
+type ApiResponseProductScan struct {
+	Status  string               `json:"status"`
+	Message string               `json:"message"`
+	Data    *ProductScanResponse `json:"data"`
 }
 
 type ApiResponseProduct struct {
@@ -76,3 +164,23 @@ type ApiResponsePaginationProduct struct {
 	Data       []*ProductResponse `json:"data"`
 	Pagination PaginationMeta     `json:"pagination"`
 }
+
+type ApiResponsePaginationStockMovement struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*StockMovementResponse `json:"data"`
+	Pagination PaginationMeta           `json:"pagination"`
+}
+
+type ApiResponseReorderLevel struct {
+	Status  string                `json:"status"`
+	Message string                `json:"message"`
+	Data    *ReorderLevelResponse `json:"data"`
+}
+
+type ApiResponsePaginationReorderLevel struct {
+	Status     string                  `json:"status"`
+	Message    string                  `json:"message"`
+	Data       []*ReorderLevelResponse `json:"data"`
+	Pagination PaginationMeta          `json:"pagination"`
+}
@@ -0,0 +1,44 @@
+package response
+
+type ProductOptionResponse struct {
+	ID       int      `json:"id"`
+	Name     string   `json:"name"`
+	Values   []string `json:"values"`
+	Position int      `json:"position"`
+}
+
+type ProductVariantResponse struct {
+	ID           int               `json:"id"`
+	ProductID    int               `json:"product_id"`
+	Sku          string            `json:"sku"`
+	Barcode      *string           `json:"barcode"`
+	Options      map[string]string `json:"options"`
+	Price        *int              `json:"price"`
+	CountInStock int               `json:"count_in_stock"`
+	CreatedAt    string            `json:"created_at"`
+	UpdatedAt    string            `json:"updated_at"`
+}
+
+type ProductVariantsResponse struct {
+	ProductID int                       `json:"product_id"`
+	Options   []*ProductOptionResponse  `json:"options"`
+	Variants  []*ProductVariantResponse `json:"variants"`
+}
+
+type ApiResponseProductOptions struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    []*ProductOptionResponse `json:"data"`
+}
+
+type ApiResponseProductVariant struct {
+	Status  string                  `json:"status"`
+	Message string                  `json:"message"`
+	Data    *ProductVariantResponse `json:"data"`
+}
+
+type ApiResponseProductVariants struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    *ProductVariantsResponse `json:"data"`
+}
@@ -0,0 +1,66 @@
+package response
+
+type PurchaseOrderResponse struct {
+	ID         int     `json:"id"`
+	MerchantID int     `json:"merchant_id"`
+	SupplierID int     `json:"supplier_id"`
+	Status     string  `json:"status"`
+	Note       *string `json:"note"`
+	CreatedBy  *int    `json:"created_by"`
+	OrderedAt  *string `json:"ordered_at"`
+	ReceivedAt *string `json:"received_at"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+type PurchaseOrderItemResponse struct {
+	ID               int    `json:"id"`
+	PurchaseOrderID  int    `json:"purchase_order_id"`
+	ProductID        int    `json:"product_id"`
+	QuantityOrdered  int    `json:"quantity_ordered"`
+	QuantityReceived int    `json:"quantity_received"`
+	UnitCost         int    `json:"unit_cost"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+}
+
+type PurchaseOrderReceiptResponse struct {
+	ID                  int     `json:"id"`
+	PurchaseOrderItemID int     `json:"purchase_order_item_id"`
+	ProductID           int     `json:"product_id"`
+	Quantity            int     `json:"quantity"`
+	UnitCost            int     `json:"unit_cost"`
+	ReceivedBy          *int    `json:"received_by"`
+	Note                *string `json:"note"`
+	CreatedAt           string  `json:"created_at"`
+}
+
+type PurchaseOrderDetailResponse struct {
+	PurchaseOrder *PurchaseOrderResponse       `json:"purchase_order"`
+	Items         []*PurchaseOrderItemResponse `json:"items"`
+}
+
+type ApiResponsePurchaseOrder struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *PurchaseOrderResponse `json:"data"`
+}
+
+type ApiResponsePurchaseOrderDetail struct {
+	Status  string                       `json:"status"`
+	Message string                       `json:"message"`
+	Data    *PurchaseOrderDetailResponse `json:"data"`
+}
+
+type ApiResponsePurchaseOrderReceipts struct {
+	Status  string                          `json:"status"`
+	Message string                          `json:"message"`
+	Data    []*PurchaseOrderReceiptResponse `json:"data"`
+}
+
+type ApiResponsePaginationPurchaseOrder struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*PurchaseOrderResponse `json:"data"`
+	Pagination *PaginationMeta          `json:"pagination"`
+}
@@ -0,0 +1,92 @@
+package response
+
+type StocktakeResponse struct {
+	ID         int     `json:"id"`
+	MerchantID int     `json:"merchant_id"`
+	CategoryID *int    `json:"category_id"`
+	Status     string  `json:"status"`
+	Note       *string `json:"note"`
+	CreatedBy  *int    `json:"created_by"`
+	PostedBy   *int    `json:"posted_by"`
+	PostedAt   *string `json:"posted_at"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
+type StocktakeItemResponse struct {
+	ID               int     `json:"id"`
+	StocktakeID      int     `json:"stocktake_id"`
+	ProductID        int     `json:"product_id"`
+	Name             string  `json:"name"`
+	Barcode          *string `json:"barcode"`
+	CategoryID       int     `json:"category_id"`
+	ExpectedQuantity int     `json:"expected_quantity"`
+	CountedQuantity  *int    `json:"counted_quantity"`
+	UnitPrice        int     `json:"unit_price"`
+	Variance         int     `json:"variance"`
+	VarianceValue    int64   `json:"variance_value"`
+	CountedBy        *int    `json:"counted_by"`
+	CountedAt        *string `json:"counted_at"`
+}
+
+type StocktakeCountResponse struct {
+	ID               int     `json:"id"`
+	StocktakeID      int     `json:"stocktake_id"`
+	ProductID        int     `json:"product_id"`
+	ExpectedQuantity int     `json:"expected_quantity"`
+	CountedQuantity  int     `json:"counted_quantity"`
+	Variance         int     `json:"variance"`
+	CountedAt        *string `json:"counted_at"`
+}
+
+type StocktakeCategoryVarianceResponse struct {
+	CategoryID       int    `json:"category_id"`
+	CategoryName     string `json:"category_name"`
+	ItemCount        int    `json:"item_count"`
+	CountedCount     int    `json:"counted_count"`
+	ExpectedQuantity int    `json:"expected_quantity"`
+	CountedQuantity  int    `json:"counted_quantity"`
+	VarianceQuantity int    `json:"variance_quantity"`
+	VarianceValue    int64  `json:"variance_value"`
+}
+
+type StocktakeVarianceReportResponse struct {
+	StocktakeID      int                                  `json:"stocktake_id"`
+	Categories       []*StocktakeCategoryVarianceResponse `json:"categories"`
+	ExpectedQuantity int                                  `json:"expected_quantity"`
+	CountedQuantity  int                                  `json:"counted_quantity"`
+	VarianceQuantity int                                  `json:"variance_quantity"`
+	VarianceValue    int64                                `json:"variance_value"`
+}
+
+type ApiResponseStocktake struct {
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    *StocktakeResponse `json:"data"`
+}
+
+type ApiResponseStocktakeCounts struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    []*StocktakeCountResponse `json:"data"`
+}
+
+type ApiResponseStocktakeVarianceReport struct {
+	Status  string                           `json:"status"`
+	Message string                           `json:"message"`
+	Data    *StocktakeVarianceReportResponse `json:"data"`
+}
+
+type ApiResponsePaginationStocktake struct {
+	Status     string               `json:"status"`
+	Message    string               `json:"message"`
+	Data       []*StocktakeResponse `json:"data"`
+	Pagination *PaginationMeta      `json:"pagination"`
+}
+
+type ApiResponsePaginationStocktakeItem struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*StocktakeItemResponse `json:"data"`
+	Pagination *PaginationMeta          `json:"pagination"`
+}
@@ -0,0 +1,50 @@
+package response
+
+type SupplierResponse struct {
+	ID          int     `json:"id"`
+	MerchantID  int     `json:"merchant_id"`
+	Name        string  `json:"name"`
+	ContactName *string `json:"contact_name"`
+	Email       *string `json:"email"`
+	Phone       *string `json:"phone"`
+	Address     *string `json:"address"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type SupplierResponseDeleteAt struct {
+	ID          int     `json:"id"`
+	MerchantID  int     `json:"merchant_id"`
+	Name        string  `json:"name"`
+	ContactName *string `json:"contact_name"`
+	Email       *string `json:"email"`
+	Phone       *string `json:"phone"`
+	Address     *string `json:"address"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	DeletedAt   *string `json:"deleted_at"`
+}
+
+type ApiResponseSupplier struct {
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Data    *SupplierResponse `json:"data"`
+}
+
+type ApiResponseSupplierDeleteAt struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    *SupplierResponseDeleteAt `json:"data"`
+}
+
+type ApiResponseSupplierDelete struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type ApiResponsePaginationSupplier struct {
+	Status     string              `json:"status"`
+	Message    string              `json:"message"`
+	Data       []*SupplierResponse `json:"data"`
+	Pagination *PaginationMeta     `json:"pagination"`
+}
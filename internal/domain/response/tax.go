@@ -0,0 +1,68 @@
+package response
+
+type TaxRateResponse struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	MerchantID  *int   `json:"merchant_id"`
+	CategoryID  *int   `json:"category_id"`
+	RateBps     int    `json:"rate_bps"`
+	IsInclusive bool   `json:"is_inclusive"`
+	Rounding    string `json:"rounding"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type TaxRateResponseDeleteAt struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	MerchantID  *int    `json:"merchant_id"`
+	CategoryID  *int    `json:"category_id"`
+	RateBps     int     `json:"rate_bps"`
+	IsInclusive bool    `json:"is_inclusive"`
+	Rounding    string  `json:"rounding"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	DeletedAt   *string `json:"deleted_at"`
+}
+
+type TaxLineResponse struct {
+	OrderItemID  int    `json:"order_item_id"`
+	ProductID    int    `json:"product_id"`
+	Quantity     int    `json:"quantity"`
+	Price        int    `json:"price"`
+	TaxRateID    *int   `json:"tax_rate_id"`
+	TaxRateBps   int    `json:"tax_rate_bps"`
+	TaxInclusive bool   `json:"tax_inclusive"`
+	TaxRounding  string `json:"tax_rounding"`
+	TaxAmount    int    `json:"tax_amount"`
+}
+
+type ApiResponseTaxRate struct {
+	Status  string           `json:"status"`
+	Message string           `json:"message"`
+	Data    *TaxRateResponse `json:"data"`
+}
+
+type ApiResponseTaxRateDeleteAt struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    *TaxRateResponseDeleteAt `json:"data"`
+}
+
+type ApiResponseTaxRateDelete struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type ApiResponsePaginationTaxRate struct {
+	Status     string             `json:"status"`
+	Message    string             `json:"message"`
+	Data       []*TaxRateResponse `json:"data"`
+	Pagination *PaginationMeta    `json:"pagination"`
+}
+
+type ApiResponseTransactionTax struct {
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    []*TaxLineResponse `json:"data"`
+}
@@ -30,6 +30,7 @@ type TransactionMonthlyAmountSuccessResponse struct {
 	Month        string `json:"month"`
 	TotalSuccess int    `json:"total_success"`
 	TotalAmount  int    `json:"total_amount"`
+	TotalTax     int    `json:"total_tax"`
 }
 
 type TransactionMonthlyAmountFailedResponse struct {
@@ -43,6 +44,7 @@ type TransactionYearlyAmountSuccessResponse struct {
 	Year         string `json:"year"`
 	TotalSuccess int    `json:"total_success"`
 	TotalAmount  int    `json:"total_amount"`
+	TotalTax     int    `json:"total_tax"`
 }
 
 type TransactionYearlyAmountFailedResponse struct {
@@ -65,12 +67,53 @@ type TransactionYearlyMethodResponse struct {
 	TotalAmount       int    `json:"total_amount"`
 }
 
+type TransactionPaymentResponse struct {
+	ID            int     `json:"id"`
+	TransactionID int     `json:"transaction_id"`
+	PaymentMethod string  `json:"payment_method"`
+	Amount        int     `json:"amount"`
+	ChangeAmount  int     `json:"change_amount"`
+	Reference     *string `json:"reference"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+type TransactionRefundResponse struct {
+	ID            int     `json:"id"`
+	TransactionID int     `json:"transaction_id"`
+	Kind          string  `json:"kind"`
+	Amount        int     `json:"amount"`
+	TaxAmount     int     `json:"tax_amount"`
+	ReasonCode    string  `json:"reason_code"`
+	Note          *string `json:"note"`
+	ApprovedBy    int     `json:"approved_by"`
+	Restock       bool    `json:"restock"`
+	CreatedAt     string  `json:"created_at"`
+}
+
 type ApiResponseTransaction struct {
 	Status  string               `json:"status"`
 	Message string               `json:"message"`
 	Data    *TransactionResponse `json:"data"`
 }
 
+type ApiResponseTransactionPayments struct {
+	Status  string                        `json:"status"`
+	Message string                        `json:"message"`
+	Data    []*TransactionPaymentResponse `json:"data"`
+}
+
+type ApiResponseTransactionRefund struct {
+	Status  string                     `json:"status"`
+	Message string                     `json:"message"`
+	Data    *TransactionRefundResponse `json:"data"`
+}
+
+type ApiResponseTransactionRefunds struct {
+	Status  string                       `json:"status"`
+	Message string                       `json:"message"`
+	Data    []*TransactionRefundResponse `json:"data"`
+}
+
 type ApiResponseTransactionDeleteAt struct {
 	Status  string                       `json:"status"`
 	Message string                       `json:"message"`
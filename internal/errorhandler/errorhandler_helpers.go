@@ -57,3 +57,14 @@ func HandlerErrorPagination[T any](
 	result, err := HandleError[T](logger, err, method, span, fields...)
 	return result, nil, err
 }
+
+func HandleTxError(
+	logger logger.LoggerInterface,
+	err error,
+	method string,
+	span trace.Span,
+	fields ...zap.Field,
+) error {
+	_, err = HandleError[any](logger, err, method, span, fields...)
+	return err
+}
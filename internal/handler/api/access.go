@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	role_cache "pointofsale/internal/cache/api/role"
+	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/middlewares"
+	"pointofsale/internal/pb"
+)
+
+// newRoleResolver resolves the caller's role names through RoleService and
+// keeps them in the same gateway cache as GET /api/role/user/:user_id.
+func newRoleResolver(client pb.RoleServiceClient, mapping response_api.RoleResponseMapper, cache role_cache.RoleMencache) middlewares.RoleResolver {
+	return middlewares.RoleResolverFunc(func(ctx context.Context, userID int) ([]string, error) {
+		res, found := cache.GetCachedRoleByUserId(ctx, userID)
+		if !found {
+			pbRes, err := client.FindByUserId(ctx, &pb.FindByIdUserRoleRequest{
+				UserId: int32(userID),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			res = mapping.ToApiResponsesRole(pbRes)
+			cache.SetCachedRoleByUserId(ctx, userID, res)
+		}
+
+		roles := make([]string, 0, len(res.Data))
+		for _, role := range res.Data {
+			roles = append(roles, role.Name)
+		}
+
+		return roles, nil
+	})
+}
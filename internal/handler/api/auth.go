@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type authHandleApi struct {
@@ -41,6 +42,9 @@ func NewHandlerAuth(router *echo.Echo, client pb.AuthServiceClient, logger logge
 	routerAuth.POST("/login", apiHandler.Handle("login", authHandler.Login))
 	routerAuth.POST("/refresh-token", apiHandler.Handle("register", authHandler.RefreshToken))
 	routerAuth.GET("/me", apiHandler.Handle("GetMe", authHandler.GetMe))
+	routerAuth.POST("/logout", apiHandler.Handle("logout", authHandler.Logout))
+	routerAuth.POST("/logout-all", apiHandler.Handle("logout-all", authHandler.LogoutAll))
+	routerAuth.GET("/sessions", apiHandler.Handle("sessions", authHandler.ListSessions))
 
 	return authHandler
 }
@@ -94,6 +98,8 @@ func (h *authHandleApi) Register(c echo.Context) error {
 		return err
 	}
 
+	h.cache.InvalidateUserCache(c.Request().Context())
+
 	return c.JSON(http.StatusCreated, h.mapping.ToResponseRegister(res))
 }
 
@@ -120,14 +126,6 @@ func (h *authHandleApi) Login(c echo.Context) error {
 		return errors.NewValidationError(validations)
 	}
 
-	ctx := c.Request().Context()
-
-	cachedResponse, found := h.cache.GetCachedLogin(ctx, body.Email)
-	if found {
-		h.logger.Debug("Returning login response from cache", zap.String("email", body.Email))
-		return c.JSON(http.StatusOK, cachedResponse)
-	}
-
 	res, err := h.client.LoginUser(c.Request().Context(), &pb.LoginRequest{
 		Email:    body.Email,
 		Password: body.Password,
@@ -148,11 +146,7 @@ func (h *authHandleApi) Login(c echo.Context) error {
 		return h.handleGrpcError(err, "Login")
 	}
 
-	mappedResponse := h.mapping.ToResponseLogin(res)
-
-	h.cache.SetCachedLogin(ctx, body.Email, mappedResponse)
-
-	return c.JSON(http.StatusOK, mappedResponse)
+	return c.JSON(http.StatusOK, h.mapping.ToResponseLogin(res))
 }
 
 // RefreshToken godoc
@@ -179,12 +173,6 @@ func (h *authHandleApi) RefreshToken(c echo.Context) error {
 		return errors.NewValidationError(validations)
 	}
 
-	cachedResponse, found := h.cache.GetRefreshToken(c.Request().Context(), body.RefreshToken)
-	if found {
-		h.logger.Debug("Returning refresh token response from cache")
-		return c.JSON(http.StatusOK, cachedResponse)
-	}
-
 	res, err := h.client.RefreshToken(c.Request().Context(), &pb.RefreshTokenRequest{
 		RefreshToken: body.RefreshToken,
 	})
@@ -192,11 +180,7 @@ func (h *authHandleApi) RefreshToken(c echo.Context) error {
 		return h.handleGrpcError(err, "RefreshToken")
 	}
 
-	mappedResponse := h.mapping.ToResponseRefreshToken(res)
-
-	h.cache.SetRefreshToken(c.Request().Context(), body.RefreshToken, mappedResponse)
-
-	return c.JSON(http.StatusOK, mappedResponse)
+	return c.JSON(http.StatusOK, h.mapping.ToResponseRefreshToken(res))
 }
 
 // GetMe godoc
@@ -244,6 +228,66 @@ func (h *authHandleApi) GetMe(c echo.Context) error {
 	return c.JSON(http.StatusOK, newResponse)
 }
 
+// Logout godoc
+// @Summary Log out the current session
+// @Tags Auth
+// @Security Bearer
+// @Description Revokes the session of the access token in the Authorization header, including its refresh token.
+// @Produce json
+// @Success 200 {object} response.ApiResponseLogout "Success"
+// @Failure 401 {object} errors.ApiError "Unauthorized"
+// @Failure 500 {object} errors.ApiError "Internal Server Error"
+// @Router /api/auth/logout [post]
+func (h *authHandleApi) Logout(c echo.Context) error {
+	res, err := h.client.Logout(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		h.logger.Error("Failed to logout", zap.Error(err))
+		return h.handleGrpcError(err, "Logout")
+	}
+
+	return c.JSON(http.StatusOK, h.mapping.ToResponseLogout(res))
+}
+
+// LogoutAll godoc
+// @Summary Log out all sessions
+// @Tags Auth
+// @Security Bearer
+// @Description Revokes every session of the current user on all devices.
+// @Produce json
+// @Success 200 {object} response.ApiResponseLogout "Success"
+// @Failure 401 {object} errors.ApiError "Unauthorized"
+// @Failure 500 {object} errors.ApiError "Internal Server Error"
+// @Router /api/auth/logout-all [post]
+func (h *authHandleApi) LogoutAll(c echo.Context) error {
+	res, err := h.client.LogoutAll(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		h.logger.Error("Failed to logout from all devices", zap.Error(err))
+		return h.handleGrpcError(err, "LogoutAll")
+	}
+
+	return c.JSON(http.StatusOK, h.mapping.ToResponseLogout(res))
+}
+
+// ListSessions godoc
+// @Summary List active sessions
+// @Tags Auth
+// @Security Bearer
+// @Description Lists the active sessions of the current user, marking the one making the request.
+// @Produce json
+// @Success 200 {object} response.ApiResponseSessions "Success"
+// @Failure 401 {object} errors.ApiError "Unauthorized"
+// @Failure 500 {object} errors.ApiError "Internal Server Error"
+// @Router /api/auth/sessions [get]
+func (h *authHandleApi) ListSessions(c echo.Context) error {
+	res, err := h.client.ListSessions(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		h.logger.Error("Failed to list sessions", zap.Error(err))
+		return h.handleGrpcError(err, "ListSessions")
+	}
+
+	return c.JSON(http.StatusOK, h.mapping.ToResponseSessions(res))
+}
+
 func (h *authHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
 	st, ok := status.FromError(err)
 	if !ok {
@@ -0,0 +1,222 @@
+package api
+
+import (
+	stderrors "errors"
+	"net/http"
+	"pointofsale/internal/cache"
+	"pointofsale/internal/domain/response"
+	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// cacheHandleApi serves the admin endpoints of both cache layers: the
+// gateway's own store and, through CacheService, the store of the server.
+type cacheHandleApi struct {
+	client     pb.CacheServiceClient
+	store      *cache.CacheStore
+	logger     logger.LoggerInterface
+	mapping    response_api.CacheResponseMapper
+	apiHandler errors.ApiHandler
+}
+
+func NewHandlerCache(router *echo.Echo, client pb.CacheServiceClient, store *cache.CacheStore, logger logger.LoggerInterface, mapping response_api.CacheResponseMapper, apiHandler errors.ApiHandler) *cacheHandleApi {
+	cacheHandler := &cacheHandleApi{
+		client:     client,
+		store:      store,
+		logger:     logger,
+		mapping:    mapping,
+		apiHandler: apiHandler,
+	}
+
+	routerCache := router.Group("/api/admin/cache")
+
+	routerCache.GET("", apiHandler.Handle("findAllNamespaces", cacheHandler.FindAll))
+	routerCache.GET("/:namespace", apiHandler.Handle("findNamespace", cacheHandler.FindByNamespace))
+	routerCache.DELETE("/:namespace", apiHandler.Handle("flushNamespace", cacheHandler.Flush))
+
+	return cacheHandler
+}
+
+// FindAll godoc.
+// @Summary List cache namespaces
+// @Tags Cache
+// @Security Bearer
+// @Description List the registered namespaces of the gateway and service caches with their key counts.
+// @Produce json
+// @Success 200 {object} response.ApiResponseCacheNamespaces "Cache namespaces"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch cache namespaces"
+// @Router /api/admin/cache [get]
+func (h *cacheHandleApi) FindAll(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	res, err := h.client.FindAllNamespaces(ctx, &emptypb.Empty{})
+	if err != nil {
+		return h.handleGrpcError(err, "FindAllNamespaces")
+	}
+
+	var namespaces []*response.CacheNamespaceResponse
+
+	for _, name := range h.store.Namespaces() {
+		ns, err := h.store.InspectNamespace(ctx, name)
+		if err != nil {
+			return errors.NewInternalError(err).WithMessage("Failed to fetch cache namespaces")
+		}
+		namespaces = append(namespaces, mapGatewayNamespace(ns))
+	}
+
+	namespaces = append(namespaces, h.mapping.ToCacheNamespaceResponses(res)...)
+
+	return c.JSON(http.StatusOK, &response.ApiResponseCacheNamespaces{
+		Status:  "success",
+		Message: "Successfully fetched cache namespaces",
+		Data:    namespaces,
+	})
+}
+
+// FindByNamespace godoc.
+// @Summary Inspect a cache namespace
+// @Tags Cache
+// @Security Bearer
+// @Description Show the key count of a namespace in each cache layer that has it.
+// @Produce json
+// @Param namespace path string true "Namespace, e.g. category"
+// @Success 200 {object} response.ApiResponseCacheNamespaces "Cache namespace"
+// @Failure 404 {object} response.ErrorResponse "Cache namespace not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch cache namespace"
+// @Router /api/admin/cache/{namespace} [get]
+func (h *cacheHandleApi) FindByNamespace(c echo.Context) error {
+	namespace := c.Param("namespace")
+	ctx := c.Request().Context()
+
+	var namespaces []*response.CacheNamespaceResponse
+
+	ns, err := h.store.InspectNamespace(ctx, namespace)
+	switch {
+	case err == nil:
+		namespaces = append(namespaces, mapGatewayNamespace(ns))
+	case !stderrors.Is(err, cache.ErrUnknownNamespace):
+		return errors.NewInternalError(err).WithMessage("Failed to fetch cache namespace")
+	}
+
+	res, err := h.client.FindNamespace(ctx, &pb.FindCacheNamespaceRequest{Namespace: namespace})
+	switch {
+	case err == nil:
+		namespaces = append(namespaces, h.mapping.ToCacheNamespaceResponse(res))
+	case status.Code(err) != codes.NotFound:
+		return h.handleGrpcError(err, "FindNamespace")
+	}
+
+	if len(namespaces) == 0 {
+		return errors.NewNotFoundError("Cache namespace")
+	}
+
+	return c.JSON(http.StatusOK, &response.ApiResponseCacheNamespaces{
+		Status:  "success",
+		Message: "Successfully fetched cache namespace",
+		Data:    namespaces,
+	})
+}
+
+// Flush godoc.
+// @Summary Flush a cache namespace
+// @Tags Cache
+// @Security Bearer
+// @Description Delete every cached entry of a namespace in both cache layers. Namespaces holding state, such as revoked tokens, cannot be flushed.
+// @Produce json
+// @Param namespace path string true "Namespace, e.g. category"
+// @Success 200 {object} response.ApiResponseCacheFlush "Flushed namespace"
+// @Failure 404 {object} response.ErrorResponse "Cache namespace not found"
+// @Failure 422 {object} response.ErrorResponse "Cache namespace cannot be flushed"
+// @Failure 500 {object} response.ErrorResponse "Failed to flush cache namespace"
+// @Router /api/admin/cache/{namespace} [delete]
+func (h *cacheHandleApi) Flush(c echo.Context) error {
+	namespace := c.Param("namespace")
+	ctx := c.Request().Context()
+
+	var flushed []*response.CacheFlushResponse
+
+	// The service goes first, so the gateway is not refilled from entries
+	// the service is about to drop.
+	res, err := h.client.FlushNamespace(ctx, &pb.FindCacheNamespaceRequest{Namespace: namespace})
+	switch {
+	case err == nil:
+		flushed = append(flushed, h.mapping.ToCacheFlushResponse(res))
+	case status.Code(err) != codes.NotFound:
+		return h.handleGrpcError(err, "FlushNamespace")
+	}
+
+	deleted, err := h.store.FlushNamespace(ctx, namespace)
+	switch {
+	case err == nil:
+		flushed = append(flushed, &response.CacheFlushResponse{
+			Layer:     response.CacheLayerGateway,
+			Namespace: namespace,
+			Deleted:   deleted,
+		})
+	case stderrors.Is(err, cache.ErrDurableNamespace):
+		return errors.NewUnprocessableError("Cache namespace cannot be flushed")
+	case !stderrors.Is(err, cache.ErrUnknownNamespace):
+		return errors.NewInternalError(err).WithMessage("Failed to flush cache namespace")
+	}
+
+	if len(flushed) == 0 {
+		return errors.NewNotFoundError("Cache namespace")
+	}
+
+	return c.JSON(http.StatusOK, &response.ApiResponseCacheFlush{
+		Status:  "success",
+		Message: "Successfully flushed cache namespace",
+		Data:    flushed,
+	})
+}
+
+func mapGatewayNamespace(ns *cache.NamespaceStats) *response.CacheNamespaceResponse {
+	return &response.CacheNamespaceResponse{
+		Layer:        response.CacheLayerGateway,
+		Namespace:    ns.Namespace,
+		Keys:         ns.Keys,
+		LocalEntries: ns.LocalEntries,
+		LocalTier:    ns.LocalTier,
+		Durable:      ns.Durable,
+	}
+}
+
+func (h *cacheHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.NewNotFoundError("Cache namespace").WithInternal(err)
+
+	case codes.FailedPrecondition:
+		return errors.NewUnprocessableError(st.Message()).WithInternal(err)
+
+	case codes.InvalidArgument:
+		return errors.NewBadRequestError(st.Message()).WithInternal(err)
+
+	case codes.PermissionDenied:
+		return errors.ErrForbidden.WithInternal(err)
+
+	case codes.Unauthenticated:
+		return errors.ErrUnauthorized.WithInternal(err)
+
+	case codes.Unavailable:
+		return errors.NewServiceUnavailableError("Cache service").WithInternal(err)
+
+	case codes.DeadlineExceeded:
+		return errors.ErrTimeout.WithInternal(err)
+
+	default:
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+}
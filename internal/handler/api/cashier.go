@@ -1201,6 +1201,8 @@ func (h *cashierHandleApi) RestoreAllCashier(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseCashierAll(res)
 
+	h.cache.InvalidateCashierCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -1229,6 +1231,8 @@ func (h *cashierHandleApi) DeleteAllCashierPermanent(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseCashierAll(res)
 
+	h.cache.InvalidateCashierCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
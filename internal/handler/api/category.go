@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type categoryHandleApi struct {
@@ -44,6 +45,7 @@ func NewHandlerCategory(
 	routercategory := router.Group("/api/category")
 
 	routercategory.GET("", categoryHandler.FindAllCategory)
+	routercategory.GET("/tree", categoryHandler.FindTree)
 	routercategory.GET("/:id", categoryHandler.FindById)
 	routercategory.GET("/active", categoryHandler.FindByActive)
 	routercategory.GET("/trashed", categoryHandler.FindByTrashed)
@@ -64,6 +66,8 @@ func NewHandlerCategory(
 
 	routercategory.POST("/create", apiHandler.Handle("create", categoryHandler.Create))
 	routercategory.POST("/update/:id", apiHandler.Handle("update", categoryHandler.Update))
+	routercategory.POST("/move/:id", apiHandler.Handle("move", categoryHandler.MoveCategory))
+	routercategory.POST("/reorder", apiHandler.Handle("reorder", categoryHandler.ReorderCategories))
 
 	routercategory.POST("/trashed/:id", apiHandler.Handle("trashed", categoryHandler.TrashedCategory))
 	routercategory.POST("/restore/:id", apiHandler.Handle("restore", categoryHandler.RestoreCategory))
@@ -131,6 +135,39 @@ func (h *categoryHandleApi) FindAllCategory(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// @Summary Find the category tree
+// @Tags Category
+// @Description Retrieve the active categories nested below their parents. With merchant_id the merchant's own categories are included next to the global ones.
+// @Accept json
+// @Produce json
+// @Param merchant_id query int false "Merchant ID"
+// @Success 200 {object} response.ApiResponseCategoryTree "Category tree"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to retrieve category tree"
+// @Router /api/category/tree [get]
+func (h *categoryHandleApi) FindTree(c echo.Context) error {
+	grpcReq := &pb.FindCategoryTreeRequest{}
+
+	if value := c.QueryParam("merchant_id"); value != "" {
+		merchantID, err := strconv.Atoi(value)
+		if err != nil || merchantID <= 0 {
+			h.logger.Debug("Invalid merchant id parameter", zap.Error(err))
+			return errors.NewBadRequestError("merchant_id must be a valid number")
+		}
+
+		grpcReq.MerchantId = wrapperspb.Int32(int32(merchantID))
+	}
+
+	res, err := h.client.FindTree(c.Request().Context(), grpcReq)
+	if err != nil {
+		h.logger.Error("Failed to fetch category tree", zap.Error(err))
+		return h.handleGrpcError(err, "FindTree")
+	}
+
+	return c.JSON(http.StatusOK, h.mapping.ToApiResponseCategoryTree(res))
+}
+
 // @Security Bearer
 // @Summary Find category by ID
 // @Tags Category
@@ -392,6 +429,7 @@ func (h *categoryHandleApi) FindYearTotalPrice(c echo.Context) error {
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
 // @Param month query int true "Month"
 // @Param category_id query int true "Category ID"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryMonthPrice "Monthly category pricing data"
 // @Failure 400 {object} response.ErrorResponse "Invalid year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -419,12 +457,18 @@ func (h *categoryHandleApi) FindMonthTotalPriceById(c echo.Context) error {
 		return errors.NewBadRequestError("category_id is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
 	req := &requests.MonthTotalPriceCategory{
-		Year:       year,
-		Month:      month,
-		CategoryID: category,
+		Year:                 year,
+		Month:                month,
+		CategoryID:           category,
+		IncludeSubcategories: subcategories,
 	}
 
 	if cached, found := h.cache.GetCachedMonthTotalPriceByIdCache(ctx, req); found {
@@ -432,9 +476,10 @@ func (h *categoryHandleApi) FindMonthTotalPriceById(c echo.Context) error {
 	}
 
 	res, err := h.client.FindMonthlyTotalPricesById(ctx, &pb.FindYearMonthTotalPriceById{
-		Year:       int32(year),
-		Month:      int32(month),
-		CategoryId: int32(category),
+		Year:                 int32(year),
+		Month:                int32(month),
+		CategoryId:           int32(category),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve monthly category price", zap.Error(err))
@@ -457,6 +502,7 @@ func (h *categoryHandleApi) FindMonthTotalPriceById(c echo.Context) error {
 // @Produce json
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
 // @Param category_id query int true "Category ID"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryYearPrice "Yearly category pricing data"
 // @Failure 400 {object} response.ErrorResponse "Invalid year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -477,11 +523,17 @@ func (h *categoryHandleApi) FindYearTotalPriceById(c echo.Context) error {
 		return errors.NewBadRequestError("category_id is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
 	req := &requests.YearTotalPriceCategory{
-		Year:       year,
-		CategoryID: category,
+		Year:                 year,
+		CategoryID:           category,
+		IncludeSubcategories: subcategories,
 	}
 
 	if cached, found := h.cache.GetCachedYearTotalPriceByIdCache(ctx, req); found {
@@ -489,8 +541,9 @@ func (h *categoryHandleApi) FindYearTotalPriceById(c echo.Context) error {
 	}
 
 	res, err := h.client.FindYearlyTotalPricesById(ctx, &pb.FindYearTotalPriceById{
-		Year:       int32(year),
-		CategoryId: int32(category),
+		Year:                 int32(year),
+		CategoryId:           int32(category),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve yearly category price", zap.Error(err))
@@ -634,6 +687,7 @@ func (h *categoryHandleApi) FindYearTotalPriceByMerchant(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryMonthPrice "Monthly category pricing data"
 // @Failure 400 {object} response.ErrorResponse "Invalid year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -647,14 +701,25 @@ func (h *categoryHandleApi) FindMonthPrice(c echo.Context) error {
 		return errors.NewBadRequestError("year is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
-	if cached, found := h.cache.GetCachedMonthPriceCache(ctx, year); found {
+	req := &requests.MonthPrice{
+		Year:                 year,
+		IncludeSubcategories: subcategories,
+	}
+
+	if cached, found := h.cache.GetCachedMonthPriceCache(ctx, req); found {
 		return c.JSON(http.StatusOK, cached)
 	}
 
 	res, err := h.client.FindMonthPrice(ctx, &pb.FindYearCategory{
-		Year: int32(year),
+		Year:                 int32(year),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve monthly category price", zap.Error(err))
@@ -663,7 +728,7 @@ func (h *categoryHandleApi) FindMonthPrice(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseCategoryMonthlyPrice(res)
 
-	h.cache.SetCachedMonthPriceCache(ctx, year, so)
+	h.cache.SetCachedMonthPriceCache(ctx, req, so)
 
 	return c.JSON(http.StatusOK, so)
 }
@@ -676,6 +741,7 @@ func (h *categoryHandleApi) FindMonthPrice(c echo.Context) error {
 // @Accept json
 // @Produce json
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryYearPrice "Yearly category pricing data"
 // @Failure 400 {object} response.ErrorResponse "Invalid year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -689,14 +755,25 @@ func (h *categoryHandleApi) FindYearPrice(c echo.Context) error {
 		return errors.NewBadRequestError("year is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
-	if cached, found := h.cache.GetCachedYearPriceCache(ctx, year); found {
+	req := &requests.YearPrice{
+		Year:                 year,
+		IncludeSubcategories: subcategories,
+	}
+
+	if cached, found := h.cache.GetCachedYearPriceCache(ctx, req); found {
 		return c.JSON(http.StatusOK, cached)
 	}
 
 	res, err := h.client.FindYearPrice(ctx, &pb.FindYearCategory{
-		Year: int32(year),
+		Year:                 int32(year),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve yearly category price", zap.Error(err))
@@ -705,7 +782,7 @@ func (h *categoryHandleApi) FindYearPrice(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseCategoryYearlyPrice(res)
 
-	h.cache.SetCachedYearPriceCache(ctx, year, so)
+	h.cache.SetCachedYearPriceCache(ctx, req, so)
 
 	return c.JSON(http.StatusOK, so)
 }
@@ -719,6 +796,7 @@ func (h *categoryHandleApi) FindYearPrice(c echo.Context) error {
 // @Produce json
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
 // @Param merchant_id query int true "Merchant ID"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryMonthPrice "Monthly category pricing by merchant"
 // @Failure 400 {object} response.ErrorResponse "Invalid merchant ID or year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -740,11 +818,17 @@ func (h *categoryHandleApi) FindMonthPriceByMerchant(c echo.Context) error {
 		return errors.NewBadRequestError("merchant_id is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
 	req := &requests.MonthPriceMerchant{
-		Year:       year,
-		MerchantID: merchant_id,
+		Year:                 year,
+		MerchantID:           merchant_id,
+		IncludeSubcategories: subcategories,
 	}
 
 	if cached, found := h.cache.GetCachedMonthPriceByMerchantCache(ctx, req); found {
@@ -752,8 +836,9 @@ func (h *categoryHandleApi) FindMonthPriceByMerchant(c echo.Context) error {
 	}
 
 	res, err := h.client.FindMonthPriceByMerchant(ctx, &pb.FindYearCategoryByMerchant{
-		Year:       int32(year),
-		MerchantId: int32(merchant_id),
+		Year:                 int32(year),
+		MerchantId:           int32(merchant_id),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve monthly category price", zap.Error(err))
@@ -776,6 +861,7 @@ func (h *categoryHandleApi) FindMonthPriceByMerchant(c echo.Context) error {
 // @Produce json
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
 // @Param merchant_id query int true "Merchant ID"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryYearPrice "Yearly category pricing by merchant"
 // @Failure 400 {object} response.ErrorResponse "Invalid merchant ID or year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -797,11 +883,17 @@ func (h *categoryHandleApi) FindYearPriceByMerchant(c echo.Context) error {
 		return errors.NewBadRequestError("merchant_id is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
 	req := &requests.YearPriceMerchant{
-		Year:       year,
-		MerchantID: merchant_id,
+		Year:                 year,
+		MerchantID:           merchant_id,
+		IncludeSubcategories: subcategories,
 	}
 
 	if cached, found := h.cache.GetCachedYearPriceByMerchantCache(ctx, req); found {
@@ -809,8 +901,9 @@ func (h *categoryHandleApi) FindYearPriceByMerchant(c echo.Context) error {
 	}
 
 	res, err := h.client.FindYearPriceByMerchant(ctx, &pb.FindYearCategoryByMerchant{
-		Year:       int32(year),
-		MerchantId: int32(merchant_id),
+		Year:                 int32(year),
+		MerchantId:           int32(merchant_id),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve yearly category price", zap.Error(err))
@@ -833,6 +926,7 @@ func (h *categoryHandleApi) FindYearPriceByMerchant(c echo.Context) error {
 // @Produce json
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
 // @Param category_id path int true "Category ID"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryMonthPrice "Monthly pricing by category"
 // @Failure 400 {object} response.ErrorResponse "Invalid category ID or year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -854,11 +948,17 @@ func (h *categoryHandleApi) FindMonthPriceById(c echo.Context) error {
 		return errors.NewBadRequestError("category_id is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
 	req := &requests.MonthPriceId{
-		Year:       year,
-		CategoryID: category_id,
+		Year:                 year,
+		CategoryID:           category_id,
+		IncludeSubcategories: subcategories,
 	}
 
 	if cached, found := h.cache.GetCachedMonthPriceByIdCache(ctx, req); found {
@@ -866,8 +966,9 @@ func (h *categoryHandleApi) FindMonthPriceById(c echo.Context) error {
 	}
 
 	res, err := h.client.FindMonthPriceById(ctx, &pb.FindYearCategoryById{
-		Year:       int32(year),
-		CategoryId: int32(category_id),
+		Year:                 int32(year),
+		CategoryId:           int32(category_id),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve monthly category price", zap.Error(err))
@@ -890,6 +991,7 @@ func (h *categoryHandleApi) FindMonthPriceById(c echo.Context) error {
 // @Produce json
 // @Param year query int true "Year in YYYY format (e.g., 2023)"
 // @Param category_id path int true "Category ID"
+// @Param include_subcategories query bool false "Include the subcategories of each category"
 // @Success 200 {object} response.ApiResponseCategoryYearPrice "Yearly pricing by category"
 // @Failure 400 {object} response.ErrorResponse "Invalid category ID or year parameter"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
@@ -911,11 +1013,17 @@ func (h *categoryHandleApi) FindYearPriceById(c echo.Context) error {
 		return errors.NewBadRequestError("category_id is required and must be a valid number")
 	}
 
+	subcategories, err := includeSubcategories(c)
+	if err != nil {
+		return err
+	}
+
 	ctx := c.Request().Context()
 
 	req := &requests.YearPriceId{
-		Year:       year,
-		CategoryID: category_id,
+		Year:                 year,
+		CategoryID:           category_id,
+		IncludeSubcategories: subcategories,
 	}
 
 	if cached, found := h.cache.GetCachedYearPriceByIdCache(ctx, req); found {
@@ -923,8 +1031,9 @@ func (h *categoryHandleApi) FindYearPriceById(c echo.Context) error {
 	}
 
 	res, err := h.client.FindYearPriceById(ctx, &pb.FindYearCategoryById{
-		Year:       int32(year),
-		CategoryId: int32(category_id),
+		Year:                 int32(year),
+		CategoryId:           int32(category_id),
+		IncludeSubcategories: subcategories,
 	})
 	if err != nil {
 		h.logger.Debug("Failed to retrieve yearly category price", zap.Error(err))
@@ -968,6 +1077,8 @@ func (h *categoryHandleApi) Create(c echo.Context) error {
 	grpcReq := &pb.CreateCategoryRequest{
 		Name:        body.Name,
 		Description: body.Description,
+		ParentId:    int32Wrapper(body.ParentID),
+		MerchantId:  int32Wrapper(body.MerchantID),
 	}
 
 	res, err := h.client.Create(ctx, grpcReq)
@@ -1046,6 +1157,119 @@ func (h *categoryHandleApi) Update(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// MoveCategory moves a category, with its subcategories, below another parent.
+// @Summary Move a category
+// @Tags Category
+// @Description Move a category below another parent, or to the root when parent_id is omitted, at the given position among its new siblings. Without a position the category goes last.
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param request body requests.MoveCategoryRequest true "New parent and position"
+// @Success 200 {object} response.ApiResponseCategory "Successfully moved category"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
+// @Failure 404 {object} response.ErrorResponse "Category not found"
+// @Failure 422 {object} response.ErrorResponse "The move would break the tree"
+// @Failure 500 {object} response.ErrorResponse "Failed to move category"
+// @Router /api/category/move/{id} [post]
+func (h *categoryHandleApi) MoveCategory(c echo.Context) error {
+	idInt, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Debug("Invalid id parameter", zap.Error(err))
+		return errors.NewBadRequestError("Invalid category ID")
+	}
+
+	var body requests.MoveCategoryRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format")
+	}
+
+	body.CategoryID = idInt
+
+	if err := body.Validate(); err != nil {
+		h.logger.Debug("Validation failed", zap.Error(err))
+		return errors.NewBadRequestError("Validation failed: " + err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	grpcReq := &pb.MoveCategoryRequest{
+		CategoryId: int32(idInt),
+		ParentId:   int32Wrapper(body.ParentID),
+		Position:   int32Wrapper(body.Position),
+	}
+
+	res, err := h.client.MoveCategory(ctx, grpcReq)
+	if err != nil {
+		h.logger.Error("Category move failed",
+			zap.Error(err),
+			zap.Any("request", grpcReq),
+		)
+
+		return h.handleGrpcError(err, "MoveCategory")
+	}
+
+	so := h.mapping.ToApiResponseCategory(res)
+
+	h.cache.DeleteCachedCategoryCache(ctx, idInt)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// ReorderCategories sets the order of the categories below one parent.
+// @Summary Reorder sibling categories
+// @Tags Category
+// @Description Set the order of the categories below parent_id, or of the root categories, owned by merchant_id or global when it is omitted. category_ids must list each of them once.
+// @Accept json
+// @Produce json
+// @Param request body requests.ReorderCategoriesRequest true "Siblings in their new order"
+// @Success 200 {object} response.ApiResponsesCategory "Successfully reordered categories"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
+// @Failure 422 {object} response.ErrorResponse "category_ids do not match the siblings"
+// @Failure 500 {object} response.ErrorResponse "Failed to reorder categories"
+// @Router /api/category/reorder [post]
+func (h *categoryHandleApi) ReorderCategories(c echo.Context) error {
+	var body requests.ReorderCategoriesRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format")
+	}
+
+	if err := body.Validate(); err != nil {
+		h.logger.Debug("Validation failed", zap.Error(err))
+		return errors.NewBadRequestError("Validation failed: " + err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	grpcReq := &pb.ReorderCategoriesRequest{
+		ParentId:   int32Wrapper(body.ParentID),
+		MerchantId: int32Wrapper(body.MerchantID),
+	}
+
+	for _, id := range body.CategoryIDs {
+		grpcReq.CategoryIds = append(grpcReq.CategoryIds, int32(id))
+	}
+
+	res, err := h.client.ReorderCategories(ctx, grpcReq)
+	if err != nil {
+		h.logger.Error("Category reorder failed",
+			zap.Error(err),
+			zap.Any("request", grpcReq),
+		)
+
+		return h.handleGrpcError(err, "ReorderCategories")
+	}
+
+	for _, id := range body.CategoryIDs {
+		h.cache.DeleteCachedCategoryCache(ctx, id)
+	}
+
+	return c.JSON(http.StatusOK, h.mapping.ToApiResponsesCategory(res))
+}
+
 // @Security Bearer
 // TrashedCategory retrieves a trashed category record by its ID.
 // @Summary Retrieve a trashed category
@@ -1178,6 +1402,8 @@ func (h *categoryHandleApi) RestoreAllCategory(c echo.Context) error {
 
 	h.logger.Debug("Successfully restored all category")
 
+	h.cache.InvalidateCategoryCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -1204,9 +1430,27 @@ func (h *categoryHandleApi) DeleteAllCategoryPermanent(c echo.Context) error {
 	h.logger.Debug("All categories permanently deleted")
 
 	so := h.mapping.ToApiResponseCategoryAll(res)
+	h.cache.InvalidateCategoryCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
+// includeSubcategories reads the optional include_subcategories flag that
+// makes statistics roll up over a category's whole subtree.
+func includeSubcategories(c echo.Context) (bool, error) {
+	value := c.QueryParam("include_subcategories")
+	if value == "" {
+		return false, nil
+	}
+
+	include, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NewBadRequestError("include_subcategories must be true or false")
+	}
+
+	return include, nil
+}
+
 func (h *categoryHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
 	st, ok := status.FromError(err)
 	if !ok {
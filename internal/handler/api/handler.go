@@ -13,6 +13,7 @@ import (
 	transaction_cache "pointofsale/internal/cache/api/transaction"
 	user_cache "pointofsale/internal/cache/api/user"
 	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/middlewares"
 	"pointofsale/internal/pb"
 	"pointofsale/pkg/auth"
 	"pointofsale/pkg/errors"
@@ -59,7 +60,18 @@ func NewHandler(deps Deps) {
 	clientOrderItem := pb.NewOrderItemServiceClient(deps.Conn)
 	clientOrder := pb.NewOrderServiceClient(deps.Conn)
 	clientProduct := pb.NewProductServiceClient(deps.Conn)
+	clientProductVariant := pb.NewProductVariantServiceClient(deps.Conn)
 	clientTransaction := pb.NewTransactionServiceClient(deps.Conn)
+	clientTax := pb.NewTaxServiceClient(deps.Conn)
+	clientSupplier := pb.NewSupplierServiceClient(deps.Conn)
+	clientPurchaseOrder := pb.NewPurchaseOrderServiceClient(deps.Conn)
+	clientStocktake := pb.NewStocktakeServiceClient(deps.Conn)
+	clientCache := pb.NewCacheServiceClient(deps.Conn)
+
+	deps.E.Use(middlewares.RoleAuthorization(
+		middlewares.DefaultRestPolicy(),
+		newRoleResolver(clientRole, deps.Mapping.RoleResponseMapper, role_cache),
+	))
 
 	NewHandlerAuth(deps.E, clientAuth, deps.Logger, deps.Mapping.AuthResponseMapper, apiHandler, auth_cache)
 	NewHandlerRole(deps.E, clientRole, deps.Logger, deps.Mapping.RoleResponseMapper, apiHandler, role_cache)
@@ -70,5 +82,11 @@ func NewHandler(deps Deps) {
 	NewHandlerOrderItem(deps.E, clientOrderItem, deps.Logger, deps.Mapping.OrderItemResponseMapper, apiHandler, order_item_cache)
 	NewHandlerOrder(deps.E, clientOrder, deps.Logger, deps.Mapping.OrderResponseMapper, apiHandler, order_cache)
 	NewHandlerProduct(deps.E, clientProduct, deps.Logger, deps.Mapping.ProductResponseMapper, deps.ImageUpload, apiHandler, product_cache)
+	NewHandlerProductVariant(deps.E, clientProductVariant, deps.Logger, deps.Mapping.ProductVariantResponseMapper, apiHandler, product_cache)
 	NewHandlerTransaction(deps.E, clientTransaction, deps.Logger, deps.Mapping.TransactionResponseMapper, apiHandler, transaction_cache)
+	NewHandlerTax(deps.E, clientTax, deps.Logger, deps.Mapping.TaxResponseMapper, apiHandler)
+	NewHandlerSupplier(deps.E, clientSupplier, deps.Logger, deps.Mapping.SupplierResponseMapper, apiHandler)
+	NewHandlerPurchaseOrder(deps.E, clientPurchaseOrder, deps.Logger, deps.Mapping.PurchaseOrderResponseMapper, apiHandler, product_cache)
+	NewHandlerStocktake(deps.E, clientStocktake, deps.Logger, deps.Mapping.StocktakeResponseMapper, apiHandler, product_cache)
+	NewHandlerCache(deps.E, clientCache, deps.Cache, deps.Logger, deps.Mapping.CacheResponseMapper, apiHandler)
 }
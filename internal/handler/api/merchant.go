@@ -51,6 +51,7 @@ func NewHandlerMerchant(
 
 	routerMerchant.POST("/create", apiHandler.Handle("create", merchantHandler.Create))
 	routerMerchant.POST("/update/:id", apiHandler.Handle("update", merchantHandler.Update))
+	routerMerchant.POST("/barcode-settings/:id", apiHandler.Handle("barcode-settings", merchantHandler.UpdateBarcodeSettings))
 
 	routerMerchant.POST("/trashed/:id", apiHandler.Handle("trashed", merchantHandler.TrashedMerchant))
 	routerMerchant.POST("/restore/:id", apiHandler.Handle("restore", merchantHandler.RestoreMerchant))
@@ -383,6 +384,59 @@ func (h *merchantHandleApi) Update(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// UpdateBarcodeSettings sets how barcodes are generated for the products of a merchant.
+// @Summary Update merchant barcode settings
+// @Tags Merchant
+// @Description Set the GS1 company prefix and barcode format used for new products of a merchant
+// @Accept json
+// @Produce json
+// @Param id path int true "Merchant ID"
+// @Param request body requests.UpdateMerchantBarcodeSettingsRequest true "Barcode settings"
+// @Success 200 {object} response.ApiResponseMerchantBarcodeSettings "Successfully updated merchant barcode settings"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
+// @Failure 500 {object} response.ErrorResponse "Failed to update merchant barcode settings"
+// @Router /api/merchant/barcode-settings/{id} [post]
+func (h *merchantHandleApi) UpdateBarcodeSettings(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		h.logger.Debug("Invalid id parameter", zap.Error(err))
+		return errors.NewBadRequestError("Invalid merchant ID")
+	}
+
+	var body requests.UpdateMerchantBarcodeSettingsRequest
+
+	if err := c.Bind(&body); err != nil {
+		h.logger.Debug("Invalid request format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid request format")
+	}
+
+	body.MerchantID = &id
+
+	if err := body.Validate(); err != nil {
+		h.logger.Debug("Validation failed", zap.Error(err))
+		return errors.NewBadRequestError("Validation failed: " + err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.client.UpdateBarcodeSettings(ctx, &pb.UpdateMerchantBarcodeSettingsRequest{
+		MerchantId:    int32(id),
+		Gs1Prefix:     stringWrapper(body.Gs1Prefix),
+		BarcodeFormat: body.BarcodeFormat,
+	})
+	if err != nil {
+		h.logger.Error("Merchant barcode settings update failed", zap.Error(err))
+		return h.handleGrpcError(err, "UpdateBarcodeSettings")
+	}
+
+	so := h.mapping.ToApiResponseMerchantBarcodeSettings(res)
+
+	h.cache.DeleteCachedMerchant(ctx, id)
+
+	return c.JSON(http.StatusOK, so)
+}
+
 // @Security Bearer
 // TrashedMerchant retrieves a trashed merchant record by its ID.
 // @Summary Retrieve a trashed merchant
@@ -522,6 +576,8 @@ func (h *merchantHandleApi) RestoreAllMerchant(c echo.Context) error {
 
 	h.logger.Debug("Successfully restored all merchant")
 
+	h.cache.InvalidateMerchantCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -550,6 +606,8 @@ func (h *merchantHandleApi) DeleteAllMerchantPermanent(c echo.Context) error {
 
 	h.logger.Debug("Successfully deleted all merchant permanently")
 
+	h.cache.InvalidateMerchantCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -5,6 +5,7 @@ import (
 	order_cache "pointofsale/internal/cache/api/order"
 	"pointofsale/internal/domain/requests"
 	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/middlewares"
 	"pointofsale/internal/pb"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
@@ -58,8 +59,9 @@ func NewHandlerOrder(
 	routerOrder.GET("/merchant/monthly-revenue", orderHandler.FindMonthlyRevenueByMerchant)
 	routerOrder.GET("/merchant/yearly-revenue", orderHandler.FindYearlyRevenueByMerchant)
 
-	routerOrder.POST("/create", apiHandler.Handle("create", orderHandler.Create))
+	routerOrder.POST("/create", apiHandler.Handle("create", orderHandler.Create), middlewares.IdempotencyKey())
 	routerOrder.POST("/update/:id", apiHandler.Handle("update", orderHandler.Update))
+	routerOrder.POST("/status/:id", apiHandler.Handle("update-status", orderHandler.UpdateStatus))
 
 	routerOrder.POST("/trashed/:id", apiHandler.Handle("trashed", orderHandler.TrashedOrder))
 	routerOrder.POST("/restore/:id", apiHandler.Handle("restore", orderHandler.RestoreOrder))
@@ -80,6 +82,7 @@ func NewHandlerOrder(
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Number of items per page" default(10)
 // @Param search query string false "Search query"
+// @Param status query string false "Order status (draft, pending_payment, paid, cancelled, refunded)"
 // @Success 200 {object} response.ApiResponsePaginationOrder "List of orders"
 // @Failure 500 {object} response.ErrorResponse "Failed to retrieve order data"
 // @Router /api/order [get]
@@ -95,6 +98,7 @@ func (h *orderHandleApi) FindAllOrders(c echo.Context) error {
 	}
 
 	search := c.QueryParam("search")
+	status := c.QueryParam("status")
 
 	ctx := c.Request().Context()
 
@@ -102,6 +106,7 @@ func (h *orderHandleApi) FindAllOrders(c echo.Context) error {
 		Page:     page,
 		PageSize: pageSize,
 		Search:   search,
+		Status:   status,
 	}
 
 	if cached, found := h.cache.GetOrderAllCache(ctx, req); found {
@@ -112,6 +117,7 @@ func (h *orderHandleApi) FindAllOrders(c echo.Context) error {
 		Page:     int32(page),
 		PageSize: int32(pageSize),
 		Search:   search,
+		Status:   status,
 	}
 
 	res, err := h.client.FindAll(ctx, grpcReq)
@@ -177,6 +183,7 @@ func (h *orderHandleApi) FindById(c echo.Context) error {
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Number of items per page" default(10)
 // @Param search query string false "Search query"
+// @Param status query string false "Order status (draft, pending_payment, paid, cancelled, refunded)"
 // @Success 200 {object} response.ApiResponsePaginationOrderDeleteAt "List of active orders"
 // @Failure 500 {object} response.ErrorResponse "Failed to retrieve order data"
 // @Router /api/order/active [get]
@@ -192,6 +199,7 @@ func (h *orderHandleApi) FindByActive(c echo.Context) error {
 	}
 
 	search := c.QueryParam("search")
+	status := c.QueryParam("status")
 
 	ctx := c.Request().Context()
 
@@ -199,6 +207,7 @@ func (h *orderHandleApi) FindByActive(c echo.Context) error {
 		Page:     page,
 		PageSize: pageSize,
 		Search:   search,
+		Status:   status,
 	}
 
 	if cached, found := h.cache.GetOrderActiveCache(ctx, req); found {
@@ -209,6 +218,7 @@ func (h *orderHandleApi) FindByActive(c echo.Context) error {
 		Page:     int32(page),
 		PageSize: int32(pageSize),
 		Search:   search,
+		Status:   status,
 	}
 
 	res, err := h.client.FindByActive(ctx, grpcReq)
@@ -233,6 +243,7 @@ func (h *orderHandleApi) FindByActive(c echo.Context) error {
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Number of items per page" default(10)
 // @Param search query string false "Search query"
+// @Param status query string false "Order status (draft, pending_payment, paid, cancelled, refunded)"
 // @Success 200 {object} response.ApiResponsePaginationOrderDeleteAt "List of trashed orders"
 // @Failure 500 {object} response.ErrorResponse "Failed to retrieve order data"
 // @Router /api/order/trashed [get]
@@ -248,6 +259,7 @@ func (h *orderHandleApi) FindByTrashed(c echo.Context) error {
 	}
 
 	search := c.QueryParam("search")
+	status := c.QueryParam("status")
 
 	ctx := c.Request().Context()
 
@@ -255,6 +267,7 @@ func (h *orderHandleApi) FindByTrashed(c echo.Context) error {
 		Page:     page,
 		PageSize: pageSize,
 		Search:   search,
+		Status:   status,
 	}
 
 	if cached, found := h.cache.GetOrderTrashedCache(ctx, req); found {
@@ -265,6 +278,7 @@ func (h *orderHandleApi) FindByTrashed(c echo.Context) error {
 		Page:     int32(page),
 		PageSize: int32(pageSize),
 		Search:   search,
+		Status:   status,
 	}
 
 	res, err := h.client.FindByTrashed(ctx, grpcReq)
@@ -702,6 +716,7 @@ func (h *orderHandleApi) FindYearlyRevenueByMerchant(c echo.Context) error {
 // @Description Create a new order with provided details
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Param request body requests.CreateOrderRequest true "Order details"
 // @Success 200 {object} response.ApiResponseOrder "Successfully created order"
 // @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
@@ -731,6 +746,7 @@ func (h *orderHandleApi) Create(c echo.Context) error {
 		grpcReq.Items = append(grpcReq.Items, &pb.CreateOrderItemRequest{
 			ProductId: int32(item.ProductID),
 			Quantity:  int32(item.Quantity),
+			VariantId: int32Wrapper(item.VariantID),
 		})
 	}
 
@@ -791,6 +807,7 @@ func (h *orderHandleApi) Update(c echo.Context) error {
 			OrderItemId: int32(item.OrderItemID),
 			ProductId:   int32(item.ProductID),
 			Quantity:    int32(item.Quantity),
+			VariantId:   int32Wrapper(item.VariantID),
 		})
 	}
 
@@ -807,6 +824,59 @@ func (h *orderHandleApi) Update(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// @Summary Update order status
+// @Tags Order
+// @Description Move an unpaid order to draft, pending_payment or cancelled. Cancelling returns the reserved stock.
+// @Accept json
+// @Produce json
+// @Param id path int true "Order ID"
+// @Param request body requests.UpdateOrderStatusRequest true "Target status"
+// @Success 200 {object} response.ApiResponseOrder "Successfully updated order status"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
+// @Failure 422 {object} response.ErrorResponse "Order cannot move to the requested status"
+// @Failure 500 {object} response.ErrorResponse "Failed to update order status"
+// @Router /api/order/status/{id} [post]
+func (h *orderHandleApi) UpdateStatus(c echo.Context) error {
+	id := c.Param("id")
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		h.logger.Debug("Invalid id parameter", zap.Error(err))
+		return errors.NewBadRequestError("Invalid order ID")
+	}
+
+	var body requests.UpdateOrderStatusRequest
+
+	if err := c.Bind(&body); err != nil {
+		h.logger.Debug("Invalid request format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid request format")
+	}
+
+	body.OrderID = idInt
+
+	if err := body.Validate(); err != nil {
+		h.logger.Debug("Validation failed", zap.Error(err))
+		return errors.NewBadRequestError("Validation failed: " + err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.client.UpdateStatus(ctx, &pb.UpdateOrderStatusRequest{
+		OrderId: int32(idInt),
+		Status:  body.Status,
+	})
+	if err != nil {
+		h.logger.Debug("Failed to update order status", zap.Error(err))
+		return h.handleGrpcError(err, "UpdateStatus")
+	}
+
+	so := h.mapping.ToApiResponseOrder(res)
+
+	h.cache.DeleteOrderCache(ctx, idInt)
+
+	return c.JSON(http.StatusOK, so)
+}
+
 // @Security Bearer
 // TrashedOrder retrieves a trashed order record by its ID.
 // @Summary Retrieve a trashed order
@@ -944,6 +1014,8 @@ func (h *orderHandleApi) RestoreAllOrder(c echo.Context) error {
 
 	h.logger.Debug("Successfully restored all orders")
 
+	h.cache.InvalidateOrderCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -970,6 +1042,8 @@ func (h *orderHandleApi) DeleteAllOrderPermanent(c echo.Context) error {
 
 	h.logger.Debug("Successfully deleted all orders permanently")
 
+	h.cache.InvalidateOrderCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -984,7 +1058,10 @@ func (h *orderHandleApi) handleGrpcError(err error, operation string) *errors.Ap
 		return errors.NewNotFoundError("Order").WithInternal(err)
 
 	case codes.AlreadyExists:
-		return errors.NewConflictError("Order already exists").WithInternal(err)
+		return errors.NewConflictError(st.Message()).WithInternal(err)
+
+	case codes.FailedPrecondition:
+		return errors.NewUnprocessableError(st.Message()).WithInternal(err)
 
 	case codes.InvalidArgument:
 		return errors.NewBadRequestError(st.Message()).WithInternal(err)
@@ -1,14 +1,22 @@
 package api
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
 	product_cache "pointofsale/internal/cache/api/product"
 	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/domain/response"
 	response_api "pointofsale/internal/mapper"
 	"pointofsale/internal/pb"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
+	"pointofsale/pkg/spreadsheet"
 	"pointofsale/pkg/upload_image"
+	"pointofsale/pkg/utils"
 	"strconv"
 	"strings"
 
@@ -19,6 +27,14 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	// maxProductImportSize bounds the size of an uploaded import file.
+	maxProductImportSize = 20 << 20
+
+	// productFileChunkSize is the size of the pieces an import is streamed in.
+	productFileChunkSize = 64 << 10
+)
+
 type productHandleApi struct {
 	client       pb.ProductServiceClient
 	logger       logger.LoggerInterface
@@ -50,14 +66,20 @@ func NewHandlerProduct(
 
 	routerProduct.GET("", productHandler.FindAllProduct)
 	routerProduct.GET("/:id", productHandler.FindById)
+	routerProduct.GET("/barcode/:barcode", productHandler.FindByBarcode)
 	routerProduct.GET("/merchant/:merchant_id", productHandler.FindByMerchant)
 	routerProduct.GET("/category/:category_name", productHandler.FindByCategory)
+	routerProduct.GET("/stock-movements/:id", productHandler.FindStockMovements)
+	routerProduct.GET("/low-stock/:merchant_id", productHandler.FindLowStock)
+	routerProduct.GET("/export/:merchant_id", productHandler.ExportProducts)
 
 	routerProduct.GET("/active", productHandler.FindByActive)
 	routerProduct.GET("/trashed", productHandler.FindByTrashed)
 
 	routerProduct.POST("/create", apiHandler.Handle("create", productHandler.Create))
 	routerProduct.POST("/update/:id", apiHandler.Handle("update", productHandler.Update))
+	routerProduct.POST("/reorder-level/:id", apiHandler.Handle("reorder-level", productHandler.UpdateReorderLevel))
+	routerProduct.POST("/import", apiHandler.Handle("import", productHandler.ImportProducts))
 
 	routerProduct.POST("/trashed/:id", apiHandler.Handle("trashed", productHandler.TrashedProduct))
 	routerProduct.POST("/restore/:id", apiHandler.Handle("restore", productHandler.RestoreProduct))
@@ -103,6 +125,8 @@ func (h *productHandleApi) FindAllProduct(c echo.Context) error {
 	}
 
 	if cached, found := h.cache.GetCachedProducts(ctx, req); found {
+		h.withImageURLs(ctx, cached.Data...)
+
 		return c.JSON(http.StatusOK, cached)
 	}
 
@@ -123,6 +147,8 @@ func (h *productHandleApi) FindAllProduct(c echo.Context) error {
 
 	h.cache.SetCachedProducts(ctx, req, so)
 
+	h.withImageURLs(ctx, so.Data...)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -187,6 +213,8 @@ func (h *productHandleApi) FindByMerchant(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	if cached, found := h.cache.GetCachedProductsByMerchant(ctx, req); found {
+		h.withImageURLs(ctx, cached.Data...)
+
 		return c.JSON(http.StatusOK, cached)
 	}
 
@@ -216,6 +244,8 @@ func (h *productHandleApi) FindByMerchant(c echo.Context) error {
 
 	h.cache.SetCachedProductsByMerchant(ctx, req, so)
 
+	h.withImageURLs(ctx, so.Data...)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -229,6 +259,7 @@ func (h *productHandleApi) FindByMerchant(c echo.Context) error {
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Number of items per page" default(10)
 // @Param search query string false "Search query"
+// @Param include_subcategories query bool false "Include products of the subcategories"
 // @Success 200 {object} response.ApiResponsePaginationProduct "List of products"
 // @Failure 500 {object} response.ErrorResponse "Failed to retrieve product data"
 // @Router /api/product/category [get]
@@ -250,24 +281,36 @@ func (h *productHandleApi) FindByCategory(c echo.Context) error {
 
 	search := c.QueryParam("search")
 
+	includeSubcategories := false
+	if value := c.QueryParam("include_subcategories"); value != "" {
+		includeSubcategories, err = strconv.ParseBool(value)
+		if err != nil {
+			return errors.NewBadRequestError("include_subcategories must be true or false")
+		}
+	}
+
 	ctx := c.Request().Context()
 
 	req := &requests.ProductByCategoryRequest{
-		CategoryName: categoryName,
-		Page:         page,
-		PageSize:     pageSize,
-		Search:       search,
+		CategoryName:         categoryName,
+		Page:                 page,
+		PageSize:             pageSize,
+		Search:               search,
+		IncludeSubcategories: includeSubcategories,
 	}
 
 	if cached, found := h.cache.GetCachedProductsByCategory(ctx, req); found {
+		h.withImageURLs(ctx, cached.Data...)
+
 		return c.JSON(http.StatusOK, cached)
 	}
 
 	grpcReq := &pb.FindAllProductCategoryRequest{
-		CategoryName: categoryName,
-		Page:         int32(page),
-		PageSize:     int32(pageSize),
-		Search:       search,
+		CategoryName:         categoryName,
+		Page:                 int32(page),
+		PageSize:             int32(pageSize),
+		Search:               search,
+		IncludeSubcategories: includeSubcategories,
 	}
 
 	res, err := h.client.FindByCategory(ctx, grpcReq)
@@ -280,6 +323,8 @@ func (h *productHandleApi) FindByCategory(c echo.Context) error {
 
 	h.cache.SetCachedProductsByCategory(ctx, req, so)
 
+	h.withImageURLs(ctx, so.Data...)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -305,6 +350,8 @@ func (h *productHandleApi) FindById(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	if cached, found := h.cache.GetCachedProduct(ctx, id); found {
+		h.withImageURLs(ctx, cached.Data)
+
 		return c.JSON(http.StatusOK, cached)
 	}
 
@@ -323,6 +370,143 @@ func (h *productHandleApi) FindById(c echo.Context) error {
 
 	h.cache.SetCachedProduct(ctx, so)
 
+	h.withImageURLs(ctx, so.Data)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// @Summary Find product by barcode
+// @Tags Product
+// @Description Resolve a scanned barcode to a product, or to one of its variants
+// @Accept json
+// @Produce json
+// @Param barcode path string true "Scanned barcode"
+// @Success 200 {object} response.ApiResponseProductScan "Scanned product"
+// @Failure 400 {object} response.ErrorResponse "Invalid barcode"
+// @Failure 404 {object} response.ErrorResponse "No product has the barcode"
+// @Failure 500 {object} response.ErrorResponse "Failed to retrieve product data"
+// @Router /api/product/barcode/{barcode} [get]
+func (h *productHandleApi) FindByBarcode(c echo.Context) error {
+	barcode := strings.TrimSpace(c.Param("barcode"))
+
+	if barcode == "" {
+		return errors.NewBadRequestError("Invalid barcode")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.client.FindByBarcode(ctx, &pb.FindByBarcodeRequest{
+		Barcode: barcode,
+	})
+
+	if err != nil {
+		h.logger.Debug("Failed to resolve scanned barcode", zap.Error(err))
+		return h.handleGrpcError(err, "FindByBarcode")
+	}
+
+	so := h.mapping.ToApiResponseProductScan(res)
+
+	if so.Data != nil {
+		h.withImageURLs(ctx, so.Data.Product)
+	}
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// @Summary Find stock movements of a product
+// @Tags Product
+// @Description Retrieve the stock ledger of a product, newest movement first
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} response.ApiResponsePaginationStockMovement "Stock movements of the product"
+// @Failure 400 {object} response.ErrorResponse "Invalid product ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to retrieve stock movements"
+// @Router /api/product/stock-movements/{id} [get]
+func (h *productHandleApi) FindStockMovements(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		h.logger.Debug("Invalid product ID", zap.Error(err))
+		return errors.NewBadRequestError("Invalid product ID")
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.client.FindStockMovements(ctx, &pb.FindStockMovementsRequest{
+		ProductId: int32(id),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	})
+
+	if err != nil {
+		h.logger.Debug("Failed to retrieve stock movements", zap.Error(err))
+		return h.handleGrpcError(err, "FindStockMovements")
+	}
+
+	so := h.mapping.ToApiResponsePaginationStockMovement(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// @Summary Find low stock products of a merchant
+// @Tags Product
+// @Description Retrieve the products of a merchant at or below their reorder point, furthest below first
+// @Accept json
+// @Produce json
+// @Param merchant_id path int true "Merchant ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} response.ApiResponsePaginationReorderLevel "Low stock products of the merchant"
+// @Failure 400 {object} response.ErrorResponse "Invalid merchant ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to retrieve low stock products"
+// @Router /api/product/low-stock/{merchant_id} [get]
+func (h *productHandleApi) FindLowStock(c echo.Context) error {
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+	if err != nil || merchantID <= 0 {
+		h.logger.Debug("Invalid merchant ID", zap.Error(err))
+		return errors.NewBadRequestError("Invalid merchant ID")
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.client.FindLowStock(ctx, &pb.FindLowStockProductRequest{
+		MerchantId: int32(merchantID),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	})
+
+	if err != nil {
+		h.logger.Debug("Failed to retrieve low stock products", zap.Error(err))
+		return h.handleGrpcError(err, "FindLowStock")
+	}
+
+	so := h.mapping.ToApiResponsePaginationReorderLevel(res)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -360,6 +544,8 @@ func (h *productHandleApi) FindByActive(c echo.Context) error {
 	}
 
 	if cached, found := h.cache.GetCachedProductActive(ctx, req); found {
+		h.withDeletedImageURLs(ctx, cached.Data...)
+
 		return c.JSON(http.StatusOK, cached)
 	}
 
@@ -380,6 +566,8 @@ func (h *productHandleApi) FindByActive(c echo.Context) error {
 
 	h.cache.SetCachedProductActive(ctx, req, so)
 
+	h.withDeletedImageURLs(ctx, so.Data...)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -417,6 +605,8 @@ func (h *productHandleApi) FindByTrashed(c echo.Context) error {
 	}
 
 	if cached, found := h.cache.GetCachedProductTrashed(ctx, req); found {
+		h.withDeletedImageURLs(ctx, cached.Data...)
+
 		return c.JSON(http.StatusOK, cached)
 	}
 
@@ -437,6 +627,8 @@ func (h *productHandleApi) FindByTrashed(c echo.Context) error {
 
 	h.cache.SetCachedProductTrashed(ctx, req, so)
 
+	h.withDeletedImageURLs(ctx, so.Data...)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -457,7 +649,7 @@ func (h *productHandleApi) FindByTrashed(c echo.Context) error {
 // @Param rating formData number true "Product rating"
 // @Param slug_product formData string true "Product slug"
 // @Param image formData file true "Product image file"
-// @Param barcode formData string true "Product barcode"
+// @Param barcode formData string false "EAN-8, UPC-A or EAN-13 barcode, generated when omitted on create and kept when omitted on update"
 // @Success 200 {object} response.ApiResponseProduct "Successfully created product"
 // @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
 // @Failure 500 {object} response.ErrorResponse "Failed to create product"
@@ -479,12 +671,16 @@ func (h *productHandleApi) Create(c echo.Context) error {
 		Brand:        formData.Brand,
 		Weight:       int32(formData.Weight),
 		ImageProduct: formData.ImagePath,
+		Barcode:      stringWrapper(formData.Barcode),
 	}
 
 	res, err := h.client.Create(ctx, grpcReq)
 	if err != nil {
 		if formData.ImagePath != "" {
-			h.upload_image.CleanupImageOnFailure(formData.ImagePath)
+			h.upload_image.CleanupImageOnFailure(ctx, &upload_image.UploadedImage{
+				Path: formData.ImagePath,
+				New:  formData.ImageNew,
+			})
 		}
 
 		h.logger.Error("Product creation failed",
@@ -500,6 +696,8 @@ func (h *productHandleApi) Create(c echo.Context) error {
 	// Invalidate cache for related data
 	h.cache.DeleteCachedProduct(ctx, int(res.Data.Id))
 
+	h.withImageURLs(ctx, so.Data)
+
 	return c.JSON(http.StatusCreated, so)
 }
 
@@ -521,7 +719,7 @@ func (h *productHandleApi) Create(c echo.Context) error {
 // @Param rating formData number true "Product rating"
 // @Param slug_product formData string true "Product slug"
 // @Param image formData file true "Product image file"
-// @Param barcode formData string true "Product barcode"
+// @Param barcode formData string false "EAN-8, UPC-A or EAN-13 barcode, generated when omitted on create and kept when omitted on update"
 // @Success 200 {object} response.ApiResponseProduct "Successfully created product"
 // @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
 // @Failure 500 {object} response.ErrorResponse "Failed to create product"
@@ -549,12 +747,16 @@ func (h *productHandleApi) Update(c echo.Context) error {
 		Brand:        formData.Brand,
 		Weight:       int32(formData.Weight),
 		ImageProduct: formData.ImagePath,
+		Barcode:      stringWrapper(formData.Barcode),
 	}
 
 	res, err := h.client.Update(ctx, grpcReq)
 	if err != nil {
 		if formData.ImagePath != "" {
-			h.upload_image.CleanupImageOnFailure(formData.ImagePath)
+			h.upload_image.CleanupImageOnFailure(ctx, &upload_image.UploadedImage{
+				Path: formData.ImagePath,
+				New:  formData.ImageNew,
+			})
 		}
 
 		h.logger.Error("Product update failed",
@@ -570,9 +772,256 @@ func (h *productHandleApi) Update(c echo.Context) error {
 	// Invalidate cache for related data
 	h.cache.DeleteCachedProduct(ctx, productID)
 
+	h.withImageURLs(ctx, so.Data)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// UpdateReorderLevel sets when a product counts as low on stock.
+// @Summary Update the reorder level of a product
+// @Tags Product
+// @Description Set the reorder point and reorder quantity of a product. A reorder point of 0 turns low stock alerts off.
+// @Accept json
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param request body requests.UpdateProductReorderLevelRequest true "Reorder level"
+// @Success 200 {object} response.ApiResponseReorderLevel "Successfully updated reorder level"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
+// @Failure 404 {object} response.ErrorResponse "Product not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to update reorder level"
+// @Router /api/product/reorder-level/{id} [post]
+func (h *productHandleApi) UpdateReorderLevel(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		h.logger.Debug("Invalid product ID", zap.Error(err))
+		return errors.NewBadRequestError("Invalid product ID")
+	}
+
+	var body requests.UpdateProductReorderLevelRequest
+
+	if err := c.Bind(&body); err != nil {
+		h.logger.Debug("Invalid request format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid request format")
+	}
+
+	body.ProductID = &id
+
+	if err := body.Validate(); err != nil {
+		h.logger.Debug("Validation failed", zap.Error(err))
+		return errors.NewBadRequestError("Validation failed: " + err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.client.UpdateReorderLevel(ctx, &pb.UpdateReorderLevelRequest{
+		ProductId:       int32(id),
+		ReorderPoint:    int32(body.ReorderPoint),
+		ReorderQuantity: int32(body.ReorderQuantity),
+	})
+
+	if err != nil {
+		h.logger.Error("Failed to update reorder level", zap.Error(err))
+		return h.handleGrpcError(err, "UpdateReorderLevel")
+	}
+
+	so := h.mapping.ToApiResponseReorderLevel(res)
+
+	h.cache.DeleteCachedProduct(ctx, id)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// ImportProducts creates and updates products from a CSV or XLSX sheet.
+// @Summary Import products from a CSV or XLSX file
+// @Tags Product
+// @Description Import a sheet with the columns name, description, price, count_in_stock, brand, weight, category, barcode, slug_product and image_product. A row updates the product with its barcode or slug and creates a product otherwise. Nothing is written when any row is invalid or dry_run is set; the response reports every row.
+// @Accept multipart/form-data
+// @Produce json
+// @Param merchant_id formData int true "Merchant ID"
+// @Param file formData file true "CSV or XLSX file"
+// @Param dry_run formData bool false "Only validate the rows"
+// @Success 200 {object} response.ApiResponseProductImport "Per-row import report"
+// @Failure 400 {object} response.ErrorResponse "Invalid file or request"
+// @Failure 500 {object} response.ErrorResponse "Failed to import products"
+// @Router /api/product/import [post]
+func (h *productHandleApi) ImportProducts(c echo.Context) error {
+	merchantID, err := strconv.Atoi(c.FormValue("merchant_id"))
+	if err != nil || merchantID <= 0 {
+		return errors.NewBadRequestError("Please provide a valid merchant ID")
+	}
+
+	dryRun := false
+	if value := c.FormValue("dry_run"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			return errors.NewBadRequestError("dry_run must be true or false")
+		}
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		h.logger.Debug("Import file upload error", zap.Error(err))
+		return errors.NewBadRequestError("An import file is required")
+	}
+
+	if header.Size > maxProductImportSize {
+		return errors.NewBadRequestError("Import file is too large")
+	}
+
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(header.Filename)), ".")
+	if format != spreadsheet.FormatCSV && format != spreadsheet.FormatXLSX {
+		return errors.NewBadRequestError("Import file must be a .csv or .xlsx file")
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return errors.NewInternalError(err).WithMessage("Failed to read import file")
+	}
+	defer file.Close()
+
+	ctx := c.Request().Context()
+
+	stream, err := h.client.ImportProducts(ctx)
+	if err != nil {
+		h.logger.Error("Failed to open product import stream", zap.Error(err))
+		return h.handleGrpcError(err, "ImportProducts")
+	}
+
+	// A failed Send only reports io.EOF; the server's error is returned by
+	// CloseAndRecv.
+	err = stream.Send(&pb.ImportProductsChunk{
+		Payload: &pb.ImportProductsChunk_Metadata{
+			Metadata: &pb.ImportProductsMetadata{
+				MerchantId: int32(merchantID),
+				Format:     format,
+				DryRun:     dryRun,
+			},
+		},
+	})
+
+	buf := make([]byte, productFileChunkSize)
+
+	for err == nil {
+		n, readErr := file.Read(buf)
+		if n > 0 {
+			err = stream.Send(&pb.ImportProductsChunk{
+				Payload: &pb.ImportProductsChunk_Data{Data: buf[:n]},
+			})
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return errors.NewInternalError(readErr).WithMessage("Failed to read import file")
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		h.logger.Error("Product import failed",
+			zap.Error(err),
+			zap.Int("merchant_id", merchantID))
+
+		return h.handleGrpcError(err, "ImportProducts")
+	}
+
+	for _, row := range res.Data.Rows {
+		if row.ProductId != nil {
+			h.cache.DeleteCachedProduct(ctx, int(row.ProductId.Value))
+		}
+	}
+
+	so := h.mapping.ToApiResponseProductImport(res)
+
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// ExportProducts downloads the products of a merchant as a CSV or XLSX file.
+// @Summary Export the products of a merchant
+// @Tags Product
+// @Description Download the products of a merchant in the columns the import reads. The filters match the merchant product list.
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param merchant_id path int true "Merchant ID"
+// @Param format query string false "csv or xlsx" default(csv)
+// @Param search query string false "Search query"
+// @Param category_id query int false "Category ID filter"
+// @Param min_price query int false "Minimum price filter"
+// @Param max_price query int false "Maximum price filter"
+// @Success 200 {file} file "Product sheet"
+// @Failure 400 {object} response.ErrorResponse "Invalid request parameters"
+// @Failure 500 {object} response.ErrorResponse "Failed to export products"
+// @Router /api/product/export/{merchant_id} [get]
+func (h *productHandleApi) ExportProducts(c echo.Context) error {
+	merchantID, err := strconv.Atoi(c.Param("merchant_id"))
+	if err != nil || merchantID <= 0 {
+		h.logger.Debug("Invalid merchant ID", zap.Error(err))
+		return errors.NewBadRequestError("Invalid merchant ID")
+	}
+
+	format := strings.ToLower(c.QueryParam("format"))
+	if format == "" {
+		format = spreadsheet.FormatCSV
+	}
+
+	if format != spreadsheet.FormatCSV && format != spreadsheet.FormatXLSX {
+		return errors.NewBadRequestError("format must be csv or xlsx")
+	}
+
+	grpcReq := &pb.ExportProductsRequest{
+		MerchantId: int32(merchantID),
+		Format:     format,
+		Search:     strings.TrimSpace(c.QueryParam("search")),
+	}
+
+	if id, err := strconv.Atoi(c.QueryParam("category_id")); err == nil && id > 0 {
+		grpcReq.CategoryId = int32(id)
+	}
+
+	if price, err := strconv.Atoi(c.QueryParam("min_price")); err == nil && price >= 0 {
+		grpcReq.MinPrice = int32(price)
+	}
+
+	if price, err := strconv.Atoi(c.QueryParam("max_price")); err == nil && price >= 0 {
+		grpcReq.MaxPrice = int32(price)
+	}
+
+	ctx := c.Request().Context()
+
+	stream, err := h.client.ExportProducts(ctx, grpcReq)
+	if err != nil {
+		h.logger.Error("Failed to open product export stream", zap.Error(err))
+		return h.handleGrpcError(err, "ExportProducts")
+	}
+
+	var file bytes.Buffer
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			h.logger.Error("Product export failed",
+				zap.Error(err),
+				zap.Int("merchant_id", merchantID))
+
+			return h.handleGrpcError(err, "ExportProducts")
+		}
+
+		file.Write(chunk.Data)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=products-%d.%s", merchantID, format))
+
+	return c.Blob(http.StatusOK, spreadsheet.ContentType(format), file.Bytes())
+}
+
 // @Security Bearer
 // TrashedProduct retrieves a trashed product record by its ID.
 // @Summary Retrieve a trashed product
@@ -611,6 +1060,8 @@ func (h *productHandleApi) TrashedProduct(c echo.Context) error {
 	// Invalidate cache for related data
 	h.cache.DeleteCachedProduct(ctx, id)
 
+	h.withDeletedImageURLs(ctx, so.Data)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -652,6 +1103,8 @@ func (h *productHandleApi) RestoreProduct(c echo.Context) error {
 	// Invalidate cache for related data
 	h.cache.DeleteCachedProduct(ctx, id)
 
+	h.withDeletedImageURLs(ctx, so.Data)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -720,6 +1173,8 @@ func (h *productHandleApi) RestoreAllProduct(c echo.Context) error {
 
 	h.logger.Debug("Successfully restored all products")
 
+	h.cache.InvalidateProductCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -747,6 +1202,8 @@ func (h *productHandleApi) DeleteAllProductPermanent(c echo.Context) error {
 
 	h.logger.Debug("Successfully deleted all products permanently")
 
+	h.cache.InvalidateProductCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -792,6 +1249,14 @@ func (h *productHandleApi) parseProductForm(c echo.Context, requireImage bool) (
 		return formData, errors.NewBadRequestError("Please provide a valid positive weight")
 	}
 
+	if barcode := strings.TrimSpace(c.FormValue("barcode")); barcode != "" {
+		if err := utils.ValidateBarcode(barcode); err != nil {
+			return formData, errors.NewBadRequestError("Please provide a valid EAN-8, UPC-A or EAN-13 barcode")
+		}
+
+		formData.Barcode = &barcode
+	}
+
 	file, err := c.FormFile("image_product")
 	if err != nil {
 		if requireImage {
@@ -802,16 +1267,40 @@ func (h *productHandleApi) parseProductForm(c echo.Context, requireImage bool) (
 		return formData, nil
 	}
 
-	imagePath, err := h.upload_image.ProcessImageUpload(c, file)
+	image, err := h.upload_image.ProcessImageUpload(c, file)
 	if err != nil {
 		return formData, err
 	}
 
-	formData.ImagePath = imagePath
+	formData.ImagePath = image.Path
+	formData.ImageNew = image.New
 	return formData, nil
 }
 
+// withImageURLs fills in signed download links for product images. Links
+// expire, so they are added after caching and again on every cache hit.
+func (h *productHandleApi) withImageURLs(ctx context.Context, products ...*response.ProductResponse) {
+	for _, product := range products {
+		if product != nil {
+			product.ImageURL, product.ImageVariantURLs = h.upload_image.ImageURLs(ctx, product.ImageProduct)
+		}
+	}
+}
+
+func (h *productHandleApi) withDeletedImageURLs(ctx context.Context, products ...*response.ProductResponseDeleteAt) {
+	for _, product := range products {
+		if product != nil {
+			product.ImageURL, product.ImageVariantURLs = h.upload_image.ImageURLs(ctx, product.ImageProduct)
+		}
+	}
+}
+
 func (h *productHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
+	// Form parsing and image uploads fail with an AppError of their own.
+	if appErr, ok := err.(*errors.AppError); ok {
+		return appErr
+	}
+
 	st, ok := status.FromError(err)
 	if !ok {
 		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
@@ -0,0 +1,402 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	product_cache "pointofsale/internal/cache/api/product"
+	"pointofsale/internal/domain/requests"
+	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/logger"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type productVariantHandleApi struct {
+	productVariant pb.ProductVariantServiceClient
+	logger         logger.LoggerInterface
+	mapping        response_api.ProductVariantResponseMapper
+	apiHandler     errors.ApiHandler
+	cache          product_cache.ProductMencache
+}
+
+func NewHandlerProductVariant(router *echo.Echo, productVariant pb.ProductVariantServiceClient, logger logger.LoggerInterface, mapping response_api.ProductVariantResponseMapper, apiHandler errors.ApiHandler, cache product_cache.ProductMencache) *productVariantHandleApi {
+	productVariantHandler := &productVariantHandleApi{
+		productVariant: productVariant,
+		logger:         logger,
+		mapping:        mapping,
+		apiHandler:     apiHandler,
+		cache:          cache,
+	}
+
+	routerProductVariant := router.Group("/api/product-variant")
+
+	routerProductVariant.GET(
+		"/product/:product_id",
+		apiHandler.Handle("findByProduct", productVariantHandler.FindByProduct),
+	)
+	routerProductVariant.GET(
+		"/:id",
+		apiHandler.Handle("findById", productVariantHandler.FindById),
+	)
+
+	routerProductVariant.POST(
+		"/options/:product_id",
+		apiHandler.Handle("setOptions", productVariantHandler.SetOptions),
+	)
+	routerProductVariant.POST(
+		"/create",
+		apiHandler.Handle("create", productVariantHandler.Create),
+	)
+	routerProductVariant.POST(
+		"/update/:id",
+		apiHandler.Handle("update", productVariantHandler.Update),
+	)
+	routerProductVariant.POST(
+		"/trashed/:id",
+		apiHandler.Handle("trashed", productVariantHandler.Trashed),
+	)
+
+	return productVariantHandler
+}
+
+// FindByProduct godoc.
+// @Summary Get the variants of a product
+// @Tags Product Variant
+// @Security Bearer
+// @Description Retrieve the options of a product and its active variants.
+// @Accept json
+// @Produce json
+// @Param product_id path int true "Product ID"
+// @Success 200 {object} response.ApiResponseProductVariants "Product options and variants"
+// @Failure 400 {object} response.ErrorResponse "Invalid product ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch product variants"
+// @Router /api/product-variant/product/{product_id} [get]
+func (h *productVariantHandleApi) FindByProduct(c echo.Context) error {
+	productID, err := strconv.Atoi(c.Param("product_id"))
+	if err != nil || productID <= 0 {
+		return errors.NewBadRequestError("product_id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.productVariant.FindProductVariants(ctx, &pb.FindProductVariantsRequest{
+		ProductId: int32(productID),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindByProduct")
+	}
+
+	so := h.mapping.ToApiResponseProductVariants(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindById godoc.
+// @Summary Get product variant by ID
+// @Tags Product Variant
+// @Security Bearer
+// @Description Retrieve an active product variant by its ID.
+// @Accept json
+// @Produce json
+// @Param id path int true "Variant ID"
+// @Success 200 {object} response.ApiResponseProductVariant "Product variant data"
+// @Failure 400 {object} response.ErrorResponse "Invalid variant ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch product variant"
+// @Router /api/product-variant/{id} [get]
+func (h *productVariantHandleApi) FindById(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.productVariant.FindByIdProductVariant(ctx, &pb.FindByIdProductVariantRequest{
+		VariantId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindById")
+	}
+
+	so := h.mapping.ToApiResponseProductVariant(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// SetOptions godoc.
+// @Summary Set the options of a product
+// @Tags Product Variant
+// @Security Bearer
+// @Description Replace the options (e.g. size, colour) a product varies by. Existing variants must still fit the new options.
+// @Accept json
+// @Produce json
+// @Param product_id path int true "Product ID"
+// @Param request body requests.SetProductOptionsRequest true "Product options"
+// @Success 200 {object} response.ApiResponseProductOptions "Updated product options"
+// @Failure 400 {object} response.ErrorResponse "Invalid product ID or request body"
+// @Failure 422 {object} response.ErrorResponse "Options no longer describe the existing variants"
+// @Failure 500 {object} response.ErrorResponse "Failed to update product options"
+// @Router /api/product-variant/options/{product_id} [post]
+func (h *productVariantHandleApi) SetOptions(c echo.Context) error {
+	productID, err := strconv.Atoi(c.Param("product_id"))
+	if err != nil || productID <= 0 {
+		return errors.NewBadRequestError("product_id is required")
+	}
+
+	var body requests.SetProductOptionsRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	body.ProductID = productID
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	options := make([]*pb.ProductOptionRequest, 0, len(body.Options))
+	for _, option := range body.Options {
+		options = append(options, &pb.ProductOptionRequest{
+			Name:   option.Name,
+			Values: option.Values,
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.productVariant.SetProductOptions(ctx, &pb.SetProductOptionsRequest{
+		ProductId: int32(productID),
+		Options:   options,
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "SetOptions")
+	}
+
+	so := h.mapping.ToApiResponseProductOptions(res)
+
+	h.cache.InvalidateProductCache(ctx)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Create godoc.
+// @Summary Create a product variant
+// @Tags Product Variant
+// @Security Bearer
+// @Description Add a variant with its own SKU, barcode, price override and opening stock to a product.
+// @Accept json
+// @Produce json
+// @Param request body requests.CreateProductVariantRequest true "Product variant data"
+// @Success 200 {object} response.ApiResponseProductVariant "Created product variant"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 409 {object} response.ErrorResponse "SKU, barcode or option combination already in use"
+// @Failure 422 {object} response.ErrorResponse "Options do not match the product"
+// @Failure 500 {object} response.ErrorResponse "Failed to create product variant"
+// @Router /api/product-variant/create [post]
+func (h *productVariantHandleApi) Create(c echo.Context) error {
+	var body requests.CreateProductVariantRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.CreateProductVariantRequest{
+		ProductId:    int32(body.ProductID),
+		Sku:          body.Sku,
+		Barcode:      stringWrapper(body.Barcode),
+		Options:      body.Options,
+		Price:        int32Wrapper(body.Price),
+		CountInStock: int32(body.CountInStock),
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.productVariant.CreateProductVariant(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Create")
+	}
+
+	so := h.mapping.ToApiResponseProductVariant(res)
+
+	h.cache.InvalidateProductCache(ctx)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Update godoc.
+// @Summary Update a product variant
+// @Tags Product Variant
+// @Security Bearer
+// @Description Update the SKU, barcode and price of a variant. A count_in_stock is recorded as a stock adjustment.
+// @Accept json
+// @Produce json
+// @Param id path int true "Variant ID"
+// @Param request body requests.UpdateProductVariantRequest true "Product variant data"
+// @Success 200 {object} response.ApiResponseProductVariant "Updated product variant"
+// @Failure 400 {object} response.ErrorResponse "Invalid variant ID or request body"
+// @Failure 409 {object} response.ErrorResponse "SKU or barcode already in use"
+// @Failure 500 {object} response.ErrorResponse "Failed to update product variant"
+// @Router /api/product-variant/update/{id} [post]
+func (h *productVariantHandleApi) Update(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	var body requests.UpdateProductVariantRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	body.VariantID = &id
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.UpdateProductVariantRequest{
+		VariantId:    int32(id),
+		Sku:          body.Sku,
+		Barcode:      stringWrapper(body.Barcode),
+		Price:        int32Wrapper(body.Price),
+		CountInStock: int32Wrapper(body.CountInStock),
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.productVariant.UpdateProductVariant(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Update")
+	}
+
+	so := h.mapping.ToApiResponseProductVariant(res)
+
+	h.cache.InvalidateProductCache(ctx)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Trashed godoc.
+// @Summary Remove a product variant
+// @Tags Product Variant
+// @Security Bearer
+// @Description Stop selling a variant. Only variants without stock can be removed.
+// @Accept json
+// @Produce json
+// @Param id path int true "Variant ID"
+// @Success 200 {object} response.ApiResponseProductVariant "Removed product variant"
+// @Failure 400 {object} response.ErrorResponse "Invalid variant ID"
+// @Failure 422 {object} response.ErrorResponse "Variant still holds stock"
+// @Failure 500 {object} response.ErrorResponse "Failed to remove product variant"
+// @Router /api/product-variant/trashed/{id} [post]
+func (h *productVariantHandleApi) Trashed(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.productVariant.DeleteProductVariant(ctx, &pb.FindByIdProductVariantRequest{
+		VariantId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Trashed")
+	}
+
+	so := h.mapping.ToApiResponseProductVariant(res)
+
+	h.cache.InvalidateProductCache(ctx)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+func (h *productVariantHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.NewNotFoundError("Product variant").WithInternal(err)
+
+	case codes.AlreadyExists:
+		return errors.NewConflictError(st.Message()).WithInternal(err)
+
+	case codes.InvalidArgument:
+		return errors.NewBadRequestError(st.Message()).WithInternal(err)
+
+	case codes.FailedPrecondition:
+		return errors.NewUnprocessableError(st.Message()).WithInternal(err)
+
+	case codes.PermissionDenied:
+		return errors.ErrForbidden.WithInternal(err)
+
+	case codes.Unauthenticated:
+		return errors.ErrUnauthorized.WithInternal(err)
+
+	case codes.ResourceExhausted:
+		return errors.ErrTooManyRequests.WithInternal(err)
+
+	case codes.Unavailable:
+		return errors.NewServiceUnavailableError("Product variant service").WithInternal(err)
+
+	case codes.DeadlineExceeded:
+		return errors.ErrTimeout.WithInternal(err)
+
+	default:
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+}
+
+func (h *productVariantHandleApi) parseValidationErrors(err error) []errors.ValidationError {
+	var validationErrs []errors.ValidationError
+
+	if ve, ok := err.(validator.ValidationErrors); ok {
+		for _, fe := range ve {
+			validationErrs = append(validationErrs, errors.ValidationError{
+				Field:   fe.Field(),
+				Message: h.getValidationMessage(fe),
+			})
+		}
+		return validationErrs
+	}
+
+	return []errors.ValidationError{
+		{
+			Field:   "general",
+			Message: err.Error(),
+		},
+	}
+}
+
+func (h *productVariantHandleApi) getValidationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "This field is required"
+	case "min":
+		return fmt.Sprintf("Must be at least %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("Must be at most %s", fe.Param())
+	case "barcode":
+		return "Must be a valid EAN-8, UPC-A or EAN-13 barcode"
+	default:
+		return fmt.Sprintf("Validation failed on '%s' tag", fe.Tag())
+	}
+}
@@ -0,0 +1,457 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	product_cache "pointofsale/internal/cache/api/product"
+	"pointofsale/internal/domain/requests"
+	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/logger"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type purchaseOrderHandleApi struct {
+	purchaseOrder pb.PurchaseOrderServiceClient
+	logger        logger.LoggerInterface
+	mapping       response_api.PurchaseOrderResponseMapper
+	apiHandler    errors.ApiHandler
+	cache         product_cache.ProductMencache
+}
+
+func NewHandlerPurchaseOrder(router *echo.Echo, purchaseOrder pb.PurchaseOrderServiceClient, logger logger.LoggerInterface, mapping response_api.PurchaseOrderResponseMapper, apiHandler errors.ApiHandler, cache product_cache.ProductMencache) *purchaseOrderHandleApi {
+	purchaseOrderHandler := &purchaseOrderHandleApi{
+		purchaseOrder: purchaseOrder,
+		logger:        logger,
+		mapping:       mapping,
+		apiHandler:    apiHandler,
+		cache:         cache,
+	}
+
+	routerPurchaseOrder := router.Group("/api/purchase-order")
+
+	routerPurchaseOrder.GET(
+		"",
+		apiHandler.Handle("findAll", purchaseOrderHandler.FindAll),
+	)
+	routerPurchaseOrder.GET(
+		"/:id",
+		apiHandler.Handle("findById", purchaseOrderHandler.FindById),
+	)
+	routerPurchaseOrder.GET(
+		"/receipts/:id",
+		apiHandler.Handle("findReceipts", purchaseOrderHandler.FindReceipts),
+	)
+
+	routerPurchaseOrder.POST(
+		"/create",
+		apiHandler.Handle("create", purchaseOrderHandler.Create),
+	)
+	routerPurchaseOrder.POST(
+		"/submit/:id",
+		apiHandler.Handle("submit", purchaseOrderHandler.Submit),
+	)
+	routerPurchaseOrder.POST(
+		"/receive/:id",
+		apiHandler.Handle("receive", purchaseOrderHandler.Receive),
+	)
+	routerPurchaseOrder.POST(
+		"/cancel/:id",
+		apiHandler.Handle("cancel", purchaseOrderHandler.Cancel),
+	)
+
+	return purchaseOrderHandler
+}
+
+// FindAll godoc.
+// @Summary Get all purchase orders
+// @Tags PurchaseOrder
+// @Security Bearer
+// @Description Retrieve a paginated list of purchase orders, newest first, filtered by merchant, supplier or status.
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Number of items per page (default: 10)"
+// @Param merchant_id query int false "Merchant ID"
+// @Param supplier_id query int false "Supplier ID"
+// @Param status query string false "Status (draft, ordered, partially_received, received, cancelled)"
+// @Success 200 {object} response.ApiResponsePaginationPurchaseOrder "List of purchase orders"
+// @Failure 400 {object} response.ErrorResponse "Invalid status"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch purchase orders"
+// @Router /api/purchase-order [get]
+func (h *purchaseOrderHandleApi) FindAll(c echo.Context) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+
+	merchantID, err := strconv.Atoi(c.QueryParam("merchant_id"))
+	if err != nil || merchantID < 0 {
+		merchantID = 0
+	}
+
+	supplierID, err := strconv.Atoi(c.QueryParam("supplier_id"))
+	if err != nil || supplierID < 0 {
+		supplierID = 0
+	}
+
+	filter := requests.FindAllPurchaseOrders{
+		Page:       page,
+		PageSize:   pageSize,
+		MerchantID: merchantID,
+		SupplierID: supplierID,
+		Status:     c.QueryParam("status"),
+	}
+
+	if err := validator.New().Struct(&filter); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	ctx := c.Request().Context()
+
+	req := &pb.FindAllPurchaseOrderRequest{
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		MerchantId: int32(merchantID),
+		SupplierId: int32(supplierID),
+		Status:     filter.Status,
+	}
+
+	res, err := h.purchaseOrder.FindAllPurchaseOrder(ctx, req)
+	if err != nil {
+		return h.handleGrpcError(err, "FindAll")
+	}
+
+	so := h.mapping.ToApiResponsePaginationPurchaseOrder(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindById godoc.
+// @Summary Get purchase order by ID
+// @Tags PurchaseOrder
+// @Security Bearer
+// @Description Retrieve a purchase order with its lines.
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} response.ApiResponsePurchaseOrderDetail "Purchase order data"
+// @Failure 400 {object} response.ErrorResponse "Invalid purchase order ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch purchase order"
+// @Router /api/purchase-order/{id} [get]
+func (h *purchaseOrderHandleApi) FindById(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.purchaseOrder.FindByIdPurchaseOrder(ctx, &pb.FindByIdPurchaseOrderRequest{
+		PurchaseOrderId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindById")
+	}
+
+	so := h.mapping.ToApiResponsePurchaseOrderDetail(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindReceipts godoc.
+// @Summary Get the goods received against a purchase order
+// @Tags PurchaseOrder
+// @Security Bearer
+// @Description Retrieve every receiving line booked against a purchase order, oldest first.
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} response.ApiResponsePurchaseOrderReceipts "Receiving lines"
+// @Failure 400 {object} response.ErrorResponse "Invalid purchase order ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch purchase order receipts"
+// @Router /api/purchase-order/receipts/{id} [get]
+func (h *purchaseOrderHandleApi) FindReceipts(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.purchaseOrder.FindPurchaseOrderReceipts(ctx, &pb.FindByIdPurchaseOrderRequest{
+		PurchaseOrderId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindReceipts")
+	}
+
+	so := h.mapping.ToApiResponsePurchaseOrderReceipts(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Create godoc.
+// @Summary Create a purchase order
+// @Tags PurchaseOrder
+// @Security Bearer
+// @Description Open a draft purchase order with a supplier. The supplier and every product must belong to the merchant.
+// @Accept json
+// @Produce json
+// @Param request body requests.CreatePurchaseOrderRequest true "Purchase order data"
+// @Success 200 {object} response.ApiResponsePurchaseOrderDetail "Created purchase order"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 422 {object} response.ErrorResponse "Supplier or product belongs to another merchant"
+// @Failure 500 {object} response.ErrorResponse "Failed to create purchase order"
+// @Router /api/purchase-order/create [post]
+func (h *purchaseOrderHandleApi) Create(c echo.Context) error {
+	var body requests.CreatePurchaseOrderRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.CreatePurchaseOrderRequest{
+		MerchantId: int32(body.MerchantID),
+		SupplierId: int32(body.SupplierID),
+		Note:       stringWrapper(body.Note),
+	}
+
+	for _, item := range body.Items {
+		reqPb.Items = append(reqPb.Items, &pb.CreatePurchaseOrderItemRequest{
+			ProductId: int32(item.ProductID),
+			Quantity:  int32(item.Quantity),
+			UnitCost:  int32(item.UnitCost),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.purchaseOrder.CreatePurchaseOrder(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Create")
+	}
+
+	so := h.mapping.ToApiResponsePurchaseOrderDetail(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Submit godoc.
+// @Summary Place a purchase order
+// @Tags PurchaseOrder
+// @Security Bearer
+// @Description Move a draft purchase order to ordered.
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} response.ApiResponsePurchaseOrder "Placed purchase order"
+// @Failure 400 {object} response.ErrorResponse "Invalid purchase order ID"
+// @Failure 422 {object} response.ErrorResponse "Purchase order is not a draft"
+// @Failure 500 {object} response.ErrorResponse "Failed to place purchase order"
+// @Router /api/purchase-order/submit/{id} [post]
+func (h *purchaseOrderHandleApi) Submit(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.purchaseOrder.SubmitPurchaseOrder(ctx, &pb.FindByIdPurchaseOrderRequest{
+		PurchaseOrderId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Submit")
+	}
+
+	so := h.mapping.ToApiResponsePurchaseOrder(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Receive godoc.
+// @Summary Receive goods against a purchase order
+// @Tags PurchaseOrder
+// @Security Bearer
+// @Description Book delivered units against the lines of an ordered purchase order and add them to stock.
+// @Description A line without unit_cost is received at the cost it was ordered at.
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Param request body requests.ReceivePurchaseOrderRequest true "Receiving lines"
+// @Success 200 {object} response.ApiResponsePurchaseOrderDetail "Purchase order after receiving"
+// @Failure 400 {object} response.ErrorResponse "Invalid purchase order ID or request body"
+// @Failure 422 {object} response.ErrorResponse "Purchase order cannot receive goods or a line is over-received"
+// @Failure 500 {object} response.ErrorResponse "Failed to receive goods"
+// @Router /api/purchase-order/receive/{id} [post]
+func (h *purchaseOrderHandleApi) Receive(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	var body requests.ReceivePurchaseOrderRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	body.PurchaseOrderID = id
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.ReceivePurchaseOrderRequest{
+		PurchaseOrderId: int32(id),
+		Note:            stringWrapper(body.Note),
+	}
+
+	for _, item := range body.Items {
+		reqPb.Items = append(reqPb.Items, &pb.ReceivePurchaseOrderItemRequest{
+			PurchaseOrderItemId: int32(item.PurchaseOrderItemID),
+			Quantity:            int32(item.Quantity),
+			UnitCost:            int32Wrapper(item.UnitCost),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.purchaseOrder.ReceivePurchaseOrder(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Receive")
+	}
+
+	so := h.mapping.ToApiResponsePurchaseOrderDetail(res)
+
+	h.cache.InvalidateProductCache(ctx)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Cancel godoc.
+// @Summary Cancel a purchase order
+// @Tags PurchaseOrder
+// @Security Bearer
+// @Description Cancel a draft or ordered purchase order nothing has been received against.
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} response.ApiResponsePurchaseOrder "Cancelled purchase order"
+// @Failure 400 {object} response.ErrorResponse "Invalid purchase order ID"
+// @Failure 422 {object} response.ErrorResponse "Purchase order can no longer be cancelled"
+// @Failure 500 {object} response.ErrorResponse "Failed to cancel purchase order"
+// @Router /api/purchase-order/cancel/{id} [post]
+func (h *purchaseOrderHandleApi) Cancel(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.purchaseOrder.CancelPurchaseOrder(ctx, &pb.FindByIdPurchaseOrderRequest{
+		PurchaseOrderId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Cancel")
+	}
+
+	so := h.mapping.ToApiResponsePurchaseOrder(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+func (h *purchaseOrderHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.NewNotFoundError("Purchase order").WithInternal(err)
+
+	case codes.AlreadyExists:
+		return errors.NewConflictError(st.Message()).WithInternal(err)
+
+	case codes.FailedPrecondition:
+		return errors.NewUnprocessableError(st.Message()).WithInternal(err)
+
+	case codes.InvalidArgument:
+		return errors.NewBadRequestError(st.Message()).WithInternal(err)
+
+	case codes.PermissionDenied:
+		return errors.ErrForbidden.WithInternal(err)
+
+	case codes.Unauthenticated:
+		return errors.ErrUnauthorized.WithInternal(err)
+
+	case codes.ResourceExhausted:
+		return errors.ErrTooManyRequests.WithInternal(err)
+
+	case codes.Unavailable:
+		return errors.NewServiceUnavailableError("Purchase order service").WithInternal(err)
+
+	case codes.DeadlineExceeded:
+		return errors.ErrTimeout.WithInternal(err)
+
+	default:
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+}
+
+func (h *purchaseOrderHandleApi) parseValidationErrors(err error) []errors.ValidationError {
+	var validationErrs []errors.ValidationError
+
+	if ve, ok := err.(validator.ValidationErrors); ok {
+		for _, fe := range ve {
+			validationErrs = append(validationErrs, errors.ValidationError{
+				Field:   fe.Field(),
+				Message: h.getValidationMessage(fe),
+			})
+		}
+		return validationErrs
+	}
+
+	return []errors.ValidationError{
+		{
+			Field:   "general",
+			Message: err.Error(),
+		},
+	}
+}
+
+func (h *purchaseOrderHandleApi) getValidationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "This field is required"
+	case "min":
+		return fmt.Sprintf("Must be at least %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("Must be at most %s", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("Must be one of: %s", fe.Param())
+	default:
+		return fmt.Sprintf("Validation failed on '%s' tag", fe.Tag())
+	}
+}
@@ -385,6 +385,8 @@ func (h *roleHandleApi) Create(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseRole(res)
 
+	h.cache.InvalidateRoleCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -432,6 +434,8 @@ func (h *roleHandleApi) Update(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseRole(res)
 
+	h.cache.DeleteCachedRole(ctx, roleID)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -466,6 +470,8 @@ func (h *roleHandleApi) Trashed(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseRoleDeleteAt(res)
 
+	h.cache.DeleteCachedRole(ctx, roleID)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -500,6 +506,8 @@ func (h *roleHandleApi) Restore(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseRoleDeleteAt(res)
 
+	h.cache.DeleteCachedRole(ctx, roleID)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -534,6 +542,8 @@ func (h *roleHandleApi) DeletePermanent(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseRoleDelete(res)
 
+	h.cache.DeleteCachedRole(ctx, roleID)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -557,6 +567,8 @@ func (h *roleHandleApi) RestoreAll(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseRoleAll(res)
 
+	h.cache.InvalidateRoleCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -580,6 +592,8 @@ func (h *roleHandleApi) DeleteAllPermanent(c echo.Context) error {
 
 	so := h.mapping.ToApiResponseRoleAll(res)
 
+	h.cache.InvalidateRoleCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
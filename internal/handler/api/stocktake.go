@@ -0,0 +1,492 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	product_cache "pointofsale/internal/cache/api/product"
+	"pointofsale/internal/domain/requests"
+	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/logger"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type stocktakeHandleApi struct {
+	stocktake  pb.StocktakeServiceClient
+	logger     logger.LoggerInterface
+	mapping    response_api.StocktakeResponseMapper
+	apiHandler errors.ApiHandler
+	cache      product_cache.ProductMencache
+}
+
+func NewHandlerStocktake(router *echo.Echo, stocktake pb.StocktakeServiceClient, logger logger.LoggerInterface, mapping response_api.StocktakeResponseMapper, apiHandler errors.ApiHandler, cache product_cache.ProductMencache) *stocktakeHandleApi {
+	stocktakeHandler := &stocktakeHandleApi{
+		stocktake:  stocktake,
+		logger:     logger,
+		mapping:    mapping,
+		apiHandler: apiHandler,
+		cache:      cache,
+	}
+
+	routerStocktake := router.Group("/api/stocktake")
+
+	routerStocktake.GET(
+		"",
+		apiHandler.Handle("findAll", stocktakeHandler.FindAll),
+	)
+	routerStocktake.GET(
+		"/:id",
+		apiHandler.Handle("findById", stocktakeHandler.FindById),
+	)
+	routerStocktake.GET(
+		"/items/:id",
+		apiHandler.Handle("findItems", stocktakeHandler.FindItems),
+	)
+	routerStocktake.GET(
+		"/variance/:id",
+		apiHandler.Handle("findVarianceReport", stocktakeHandler.FindVarianceReport),
+	)
+
+	routerStocktake.POST(
+		"/create",
+		apiHandler.Handle("create", stocktakeHandler.Create),
+	)
+	routerStocktake.POST(
+		"/counts/:id",
+		apiHandler.Handle("submitCounts", stocktakeHandler.SubmitCounts),
+	)
+	routerStocktake.POST(
+		"/post/:id",
+		apiHandler.Handle("post", stocktakeHandler.Post),
+	)
+	routerStocktake.POST(
+		"/cancel/:id",
+		apiHandler.Handle("cancel", stocktakeHandler.Cancel),
+	)
+
+	return stocktakeHandler
+}
+
+// FindAll godoc.
+// @Summary Get all stocktakes
+// @Tags Stocktake
+// @Security Bearer
+// @Description Retrieve a paginated list of stocktakes, newest first, filtered by merchant or status.
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Number of items per page (default: 10)"
+// @Param merchant_id query int false "Merchant ID"
+// @Param status query string false "Status (open, posted, cancelled)"
+// @Success 200 {object} response.ApiResponsePaginationStocktake "List of stocktakes"
+// @Failure 400 {object} response.ErrorResponse "Invalid status"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch stocktakes"
+// @Router /api/stocktake [get]
+func (h *stocktakeHandleApi) FindAll(c echo.Context) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+
+	merchantID, err := strconv.Atoi(c.QueryParam("merchant_id"))
+	if err != nil || merchantID < 0 {
+		merchantID = 0
+	}
+
+	filter := requests.FindAllStocktakes{
+		Page:       page,
+		PageSize:   pageSize,
+		MerchantID: merchantID,
+		Status:     c.QueryParam("status"),
+	}
+
+	if err := validator.New().Struct(&filter); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	ctx := c.Request().Context()
+
+	req := &pb.FindAllStocktakeRequest{
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		MerchantId: int32(merchantID),
+		Status:     filter.Status,
+	}
+
+	res, err := h.stocktake.FindAllStocktake(ctx, req)
+	if err != nil {
+		return h.handleGrpcError(err, "FindAll")
+	}
+
+	so := h.mapping.ToApiResponsePaginationStocktake(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindById godoc.
+// @Summary Get stocktake by ID
+// @Tags Stocktake
+// @Security Bearer
+// @Description Retrieve a stocktake header.
+// @Accept json
+// @Produce json
+// @Param id path int true "Stocktake ID"
+// @Success 200 {object} response.ApiResponseStocktake "Stocktake data"
+// @Failure 400 {object} response.ErrorResponse "Invalid stocktake ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch stocktake"
+// @Router /api/stocktake/{id} [get]
+func (h *stocktakeHandleApi) FindById(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.stocktake.FindByIdStocktake(ctx, &pb.FindByIdStocktakeRequest{
+		StocktakeId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindById")
+	}
+
+	so := h.mapping.ToApiResponseStocktake(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindItems godoc.
+// @Summary Get the lines of a stocktake
+// @Tags Stocktake
+// @Security Bearer
+// @Description Retrieve the counted and expected quantity of every product in a stocktake with its variance, largest shortages first.
+// @Accept json
+// @Produce json
+// @Param id path int true "Stocktake ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Number of items per page (default: 10)"
+// @Success 200 {object} response.ApiResponsePaginationStocktakeItem "Stocktake lines"
+// @Failure 400 {object} response.ErrorResponse "Invalid stocktake ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch stocktake items"
+// @Router /api/stocktake/items/{id} [get]
+func (h *stocktakeHandleApi) FindItems(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.stocktake.FindStocktakeItems(ctx, &pb.FindStocktakeItemsRequest{
+		StocktakeId: int32(id),
+		Page:        int32(page),
+		PageSize:    int32(pageSize),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindItems")
+	}
+
+	so := h.mapping.ToApiResponsePaginationStocktakeItem(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindVarianceReport godoc.
+// @Summary Get the variance report of a stocktake
+// @Tags Stocktake
+// @Security Bearer
+// @Description Summarise the variance of the counted lines of a stocktake by category, in units and in value at the snapshot price.
+// @Accept json
+// @Produce json
+// @Param id path int true "Stocktake ID"
+// @Success 200 {object} response.ApiResponseStocktakeVarianceReport "Variance report"
+// @Failure 400 {object} response.ErrorResponse "Invalid stocktake ID"
+// @Failure 404 {object} response.ErrorResponse "Stocktake not found"
+// @Failure 500 {object} response.ErrorResponse "Failed to build variance report"
+// @Router /api/stocktake/variance/{id} [get]
+func (h *stocktakeHandleApi) FindVarianceReport(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.stocktake.FindStocktakeVarianceReport(ctx, &pb.FindByIdStocktakeRequest{
+		StocktakeId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindVarianceReport")
+	}
+
+	so := h.mapping.ToApiResponseStocktakeVarianceReport(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Create godoc.
+// @Summary Open a stocktake
+// @Tags Stocktake
+// @Security Bearer
+// @Description Open a stocktake for a merchant and snapshot the expected quantity of every active product, optionally limited to one category.
+// @Description A merchant can only have one open stocktake at a time.
+// @Accept json
+// @Produce json
+// @Param request body requests.CreateStocktakeRequest true "Stocktake data"
+// @Success 200 {object} response.ApiResponseStocktake "Opened stocktake"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 409 {object} response.ErrorResponse "Merchant already has an open stocktake"
+// @Failure 500 {object} response.ErrorResponse "Failed to open stocktake"
+// @Router /api/stocktake/create [post]
+func (h *stocktakeHandleApi) Create(c echo.Context) error {
+	var body requests.CreateStocktakeRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.stocktake.CreateStocktake(ctx, &pb.CreateStocktakeRequest{
+		MerchantId: int32(body.MerchantID),
+		CategoryId: int32Wrapper(body.CategoryID),
+		Note:       stringWrapper(body.Note),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Create")
+	}
+
+	so := h.mapping.ToApiResponseStocktake(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// SubmitCounts godoc.
+// @Summary Submit counted quantities
+// @Tags Stocktake
+// @Security Bearer
+// @Description Record counted quantities against an open stocktake. Each line names its product by product_id or barcode.
+// @Description Lines for the same product are added together and replace any earlier count of that product.
+// @Accept json
+// @Produce json
+// @Param id path int true "Stocktake ID"
+// @Param request body requests.SubmitStocktakeCountsRequest true "Counted quantities"
+// @Success 200 {object} response.ApiResponseStocktakeCounts "Recorded counts"
+// @Failure 400 {object} response.ErrorResponse "Invalid stocktake ID or request body"
+// @Failure 422 {object} response.ErrorResponse "Stocktake is not open, or a barcode or product is not part of it"
+// @Failure 500 {object} response.ErrorResponse "Failed to record counts"
+// @Router /api/stocktake/counts/{id} [post]
+func (h *stocktakeHandleApi) SubmitCounts(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	var body requests.SubmitStocktakeCountsRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	body.StocktakeID = id
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.SubmitStocktakeCountsRequest{
+		StocktakeId: int32(id),
+	}
+
+	for _, item := range body.Items {
+		reqPb.Items = append(reqPb.Items, &pb.StocktakeCountRequest{
+			ProductId: int32Wrapper(item.ProductID),
+			Barcode:   stringWrapper(item.Barcode),
+			Quantity:  int32(item.Quantity),
+		})
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.stocktake.SubmitStocktakeCounts(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "SubmitCounts")
+	}
+
+	so := h.mapping.ToApiResponseStocktakeCounts(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Post godoc.
+// @Summary Post a stocktake
+// @Tags Stocktake
+// @Security Bearer
+// @Description Close an open stocktake and adjust the stock of every counted product by its variance. Either every adjustment is posted or none is.
+// @Accept json
+// @Produce json
+// @Param id path int true "Stocktake ID"
+// @Success 200 {object} response.ApiResponseStocktake "Posted stocktake"
+// @Failure 400 {object} response.ErrorResponse "Invalid stocktake ID"
+// @Failure 422 {object} response.ErrorResponse "Stocktake is not open"
+// @Failure 500 {object} response.ErrorResponse "Failed to post stocktake"
+// @Router /api/stocktake/post/{id} [post]
+func (h *stocktakeHandleApi) Post(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.stocktake.PostStocktake(ctx, &pb.FindByIdStocktakeRequest{
+		StocktakeId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Post")
+	}
+
+	so := h.mapping.ToApiResponseStocktake(res)
+
+	h.cache.InvalidateProductCache(ctx)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Cancel godoc.
+// @Summary Cancel a stocktake
+// @Tags Stocktake
+// @Security Bearer
+// @Description Abandon an open stocktake without changing stock.
+// @Accept json
+// @Produce json
+// @Param id path int true "Stocktake ID"
+// @Success 200 {object} response.ApiResponseStocktake "Cancelled stocktake"
+// @Failure 400 {object} response.ErrorResponse "Invalid stocktake ID"
+// @Failure 422 {object} response.ErrorResponse "Stocktake is not open"
+// @Failure 500 {object} response.ErrorResponse "Failed to cancel stocktake"
+// @Router /api/stocktake/cancel/{id} [post]
+func (h *stocktakeHandleApi) Cancel(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.stocktake.CancelStocktake(ctx, &pb.FindByIdStocktakeRequest{
+		StocktakeId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Cancel")
+	}
+
+	so := h.mapping.ToApiResponseStocktake(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+func (h *stocktakeHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.NewNotFoundError("Stocktake").WithInternal(err)
+
+	case codes.AlreadyExists:
+		return errors.NewConflictError(st.Message()).WithInternal(err)
+
+	case codes.FailedPrecondition:
+		return errors.NewUnprocessableError(st.Message()).WithInternal(err)
+
+	case codes.InvalidArgument:
+		return errors.NewBadRequestError(st.Message()).WithInternal(err)
+
+	case codes.PermissionDenied:
+		return errors.ErrForbidden.WithInternal(err)
+
+	case codes.Unauthenticated:
+		return errors.ErrUnauthorized.WithInternal(err)
+
+	case codes.ResourceExhausted:
+		return errors.ErrTooManyRequests.WithInternal(err)
+
+	case codes.Unavailable:
+		return errors.NewServiceUnavailableError("Stocktake service").WithInternal(err)
+
+	case codes.DeadlineExceeded:
+		return errors.ErrTimeout.WithInternal(err)
+
+	default:
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+}
+
+func (h *stocktakeHandleApi) parseValidationErrors(err error) []errors.ValidationError {
+	var validationErrs []errors.ValidationError
+
+	if ve, ok := err.(validator.ValidationErrors); ok {
+		for _, fe := range ve {
+			validationErrs = append(validationErrs, errors.ValidationError{
+				Field:   fe.Field(),
+				Message: h.getValidationMessage(fe),
+			})
+		}
+		return validationErrs
+	}
+
+	return []errors.ValidationError{
+		{
+			Field:   "general",
+			Message: err.Error(),
+		},
+	}
+}
+
+func (h *stocktakeHandleApi) getValidationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "This field is required"
+	case "required_without":
+		return fmt.Sprintf("Required when %s is not set", fe.Param())
+	case "min":
+		return fmt.Sprintf("Must be at least %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("Must be at most %s", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("Must be one of: %s", fe.Param())
+	default:
+		return fmt.Sprintf("Validation failed on '%s' tag", fe.Tag())
+	}
+}
@@ -0,0 +1,428 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"pointofsale/internal/domain/requests"
+	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/logger"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type supplierHandleApi struct {
+	supplier   pb.SupplierServiceClient
+	logger     logger.LoggerInterface
+	mapping    response_api.SupplierResponseMapper
+	apiHandler errors.ApiHandler
+}
+
+func NewHandlerSupplier(router *echo.Echo, supplier pb.SupplierServiceClient, logger logger.LoggerInterface, mapping response_api.SupplierResponseMapper, apiHandler errors.ApiHandler) *supplierHandleApi {
+	supplierHandler := &supplierHandleApi{
+		supplier:   supplier,
+		logger:     logger,
+		mapping:    mapping,
+		apiHandler: apiHandler,
+	}
+
+	routerSupplier := router.Group("/api/supplier")
+
+	routerSupplier.GET(
+		"",
+		apiHandler.Handle("findAll", supplierHandler.FindAll),
+	)
+	routerSupplier.GET(
+		"/:id",
+		apiHandler.Handle("findById", supplierHandler.FindById),
+	)
+
+	routerSupplier.POST(
+		"/create",
+		apiHandler.Handle("create", supplierHandler.Create),
+	)
+	routerSupplier.POST(
+		"/update/:id",
+		apiHandler.Handle("update", supplierHandler.Update),
+	)
+
+	routerSupplier.POST(
+		"/trashed/:id",
+		apiHandler.Handle("trashed", supplierHandler.Trashed),
+	)
+	routerSupplier.POST(
+		"/restore/:id",
+		apiHandler.Handle("restore", supplierHandler.Restore),
+	)
+	routerSupplier.DELETE(
+		"/permanent/:id",
+		apiHandler.Handle("deletePermanent", supplierHandler.DeletePermanent),
+	)
+
+	return supplierHandler
+}
+
+// FindAll godoc.
+// @Summary Get all suppliers
+// @Tags Supplier
+// @Security Bearer
+// @Description Retrieve a paginated list of active suppliers, optionally for one merchant.
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Number of items per page (default: 10)"
+// @Param search query string false "Search keyword"
+// @Param merchant_id query int false "Merchant ID"
+// @Success 200 {object} response.ApiResponsePaginationSupplier "List of suppliers"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch suppliers"
+// @Router /api/supplier [get]
+func (h *supplierHandleApi) FindAll(c echo.Context) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+
+	merchantID, err := strconv.Atoi(c.QueryParam("merchant_id"))
+	if err != nil || merchantID < 0 {
+		merchantID = 0
+	}
+
+	search := c.QueryParam("search")
+
+	ctx := c.Request().Context()
+
+	req := &pb.FindAllSupplierRequest{
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		Search:     search,
+		MerchantId: int32(merchantID),
+	}
+
+	res, err := h.supplier.FindAllSupplier(ctx, req)
+	if err != nil {
+		return h.handleGrpcError(err, "FindAll")
+	}
+
+	so := h.mapping.ToApiResponsePaginationSupplier(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindById godoc.
+// @Summary Get supplier by ID
+// @Tags Supplier
+// @Security Bearer
+// @Description Retrieve a supplier by its ID.
+// @Accept json
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Success 200 {object} response.ApiResponseSupplier "Supplier data"
+// @Failure 400 {object} response.ErrorResponse "Invalid supplier ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch supplier"
+// @Router /api/supplier/{id} [get]
+func (h *supplierHandleApi) FindById(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.supplier.FindByIdSupplier(ctx, &pb.FindByIdSupplierRequest{
+		SupplierId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindById")
+	}
+
+	so := h.mapping.ToApiResponseSupplier(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Create godoc.
+// @Summary Create a supplier
+// @Tags Supplier
+// @Security Bearer
+// @Description Register a supplier a merchant can raise purchase orders against.
+// @Accept json
+// @Produce json
+// @Param request body requests.CreateSupplierRequest true "Supplier data"
+// @Success 200 {object} response.ApiResponseSupplier "Created supplier"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 500 {object} response.ErrorResponse "Failed to create supplier"
+// @Router /api/supplier/create [post]
+func (h *supplierHandleApi) Create(c echo.Context) error {
+	var body requests.CreateSupplierRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.CreateSupplierRequest{
+		MerchantId:  int32(body.MerchantID),
+		Name:        body.Name,
+		ContactName: stringWrapper(body.ContactName),
+		Email:       stringWrapper(body.Email),
+		Phone:       stringWrapper(body.Phone),
+		Address:     stringWrapper(body.Address),
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.supplier.CreateSupplier(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Create")
+	}
+
+	so := h.mapping.ToApiResponseSupplier(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Update godoc.
+// @Summary Update a supplier
+// @Tags Supplier
+// @Security Bearer
+// @Description Update the contact details of a supplier. The owning merchant cannot change.
+// @Accept json
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Param request body requests.UpdateSupplierRequest true "Supplier data"
+// @Success 200 {object} response.ApiResponseSupplier "Updated supplier"
+// @Failure 400 {object} response.ErrorResponse "Invalid supplier ID or request body"
+// @Failure 500 {object} response.ErrorResponse "Failed to update supplier"
+// @Router /api/supplier/update/{id} [post]
+func (h *supplierHandleApi) Update(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	var body requests.UpdateSupplierRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	body.SupplierID = &id
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.UpdateSupplierRequest{
+		SupplierId:  int32(id),
+		Name:        body.Name,
+		ContactName: stringWrapper(body.ContactName),
+		Email:       stringWrapper(body.Email),
+		Phone:       stringWrapper(body.Phone),
+		Address:     stringWrapper(body.Address),
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.supplier.UpdateSupplier(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Update")
+	}
+
+	so := h.mapping.ToApiResponseSupplier(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Trashed godoc.
+// @Summary Soft-delete a supplier
+// @Tags Supplier
+// @Security Bearer
+// @Description Stop raising purchase orders against a supplier while keeping its history.
+// @Accept json
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Success 200 {object} response.ApiResponseSupplierDeleteAt "Soft-deleted supplier"
+// @Failure 400 {object} response.ErrorResponse "Invalid supplier ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to soft-delete supplier"
+// @Router /api/supplier/trashed/{id} [post]
+func (h *supplierHandleApi) Trashed(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.supplier.TrashedSupplier(ctx, &pb.FindByIdSupplierRequest{
+		SupplierId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Trashed")
+	}
+
+	so := h.mapping.ToApiResponseSupplierDeleteAt(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Restore godoc.
+// @Summary Restore a soft-deleted supplier
+// @Tags Supplier
+// @Security Bearer
+// @Description Restore a soft-deleted supplier by its ID.
+// @Accept json
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Success 200 {object} response.ApiResponseSupplierDeleteAt "Restored supplier"
+// @Failure 400 {object} response.ErrorResponse "Invalid supplier ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to restore supplier"
+// @Router /api/supplier/restore/{id} [post]
+func (h *supplierHandleApi) Restore(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.supplier.RestoreSupplier(ctx, &pb.FindByIdSupplierRequest{
+		SupplierId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Restore")
+	}
+
+	so := h.mapping.ToApiResponseSupplierDeleteAt(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// DeletePermanent godoc.
+// @Summary Permanently delete a supplier
+// @Tags Supplier
+// @Security Bearer
+// @Description Permanently delete a trashed supplier that has no purchase orders.
+// @Accept json
+// @Produce json
+// @Param id path int true "Supplier ID"
+// @Success 200 {object} response.ApiResponseSupplierDelete "Deletion result"
+// @Failure 400 {object} response.ErrorResponse "Invalid supplier ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to delete supplier permanently"
+// @Router /api/supplier/permanent/{id} [delete]
+func (h *supplierHandleApi) DeletePermanent(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.supplier.DeleteSupplierPermanent(ctx, &pb.FindByIdSupplierRequest{
+		SupplierId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "DeletePermanent")
+	}
+
+	so := h.mapping.ToApiResponseSupplierDelete(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+func (h *supplierHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.NewNotFoundError("Supplier").WithInternal(err)
+
+	case codes.AlreadyExists:
+		return errors.NewConflictError("Supplier already exists").WithInternal(err)
+
+	case codes.InvalidArgument:
+		return errors.NewBadRequestError(st.Message()).WithInternal(err)
+
+	case codes.PermissionDenied:
+		return errors.ErrForbidden.WithInternal(err)
+
+	case codes.Unauthenticated:
+		return errors.ErrUnauthorized.WithInternal(err)
+
+	case codes.ResourceExhausted:
+		return errors.ErrTooManyRequests.WithInternal(err)
+
+	case codes.Unavailable:
+		return errors.NewServiceUnavailableError("Supplier service").WithInternal(err)
+
+	case codes.DeadlineExceeded:
+		return errors.ErrTimeout.WithInternal(err)
+
+	default:
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+}
+
+func (h *supplierHandleApi) parseValidationErrors(err error) []errors.ValidationError {
+	var validationErrs []errors.ValidationError
+
+	if ve, ok := err.(validator.ValidationErrors); ok {
+		for _, fe := range ve {
+			validationErrs = append(validationErrs, errors.ValidationError{
+				Field:   fe.Field(),
+				Message: h.getValidationMessage(fe),
+			})
+		}
+		return validationErrs
+	}
+
+	return []errors.ValidationError{
+		{
+			Field:   "general",
+			Message: err.Error(),
+		},
+	}
+}
+
+func (h *supplierHandleApi) getValidationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "This field is required"
+	case "min":
+		return fmt.Sprintf("Must be at least %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("Must be at most %s", fe.Param())
+	case "email":
+		return "Must be a valid email address"
+	case "oneof":
+		return fmt.Sprintf("Must be one of: %s", fe.Param())
+	default:
+		return fmt.Sprintf("Validation failed on '%s' tag", fe.Tag())
+	}
+}
+
+func stringWrapper(v *string) *wrapperspb.StringValue {
+	if v == nil {
+		return nil
+	}
+
+	return wrapperspb.String(*v)
+}
@@ -0,0 +1,456 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"pointofsale/internal/domain/requests"
+	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/logger"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type taxHandleApi struct {
+	tax        pb.TaxServiceClient
+	logger     logger.LoggerInterface
+	mapping    response_api.TaxResponseMapper
+	apiHandler errors.ApiHandler
+}
+
+func NewHandlerTax(router *echo.Echo, tax pb.TaxServiceClient, logger logger.LoggerInterface, mapping response_api.TaxResponseMapper, apiHandler errors.ApiHandler) *taxHandleApi {
+	taxHandler := &taxHandleApi{
+		tax:        tax,
+		logger:     logger,
+		mapping:    mapping,
+		apiHandler: apiHandler,
+	}
+
+	routerTax := router.Group("/api/tax-rate")
+
+	routerTax.GET(
+		"",
+		apiHandler.Handle("findAll", taxHandler.FindAll),
+	)
+	routerTax.GET(
+		"/:id",
+		apiHandler.Handle("findById", taxHandler.FindById),
+	)
+	routerTax.GET(
+		"/transaction/:transaction_id",
+		apiHandler.Handle("findTransactionTax", taxHandler.FindTransactionTax),
+	)
+
+	routerTax.POST(
+		"/create",
+		apiHandler.Handle("create", taxHandler.Create),
+	)
+	routerTax.POST(
+		"/update/:id",
+		apiHandler.Handle("update", taxHandler.Update),
+	)
+
+	routerTax.POST(
+		"/trashed/:id",
+		apiHandler.Handle("trashed", taxHandler.Trashed),
+	)
+	routerTax.POST(
+		"/restore/:id",
+		apiHandler.Handle("restore", taxHandler.Restore),
+	)
+	routerTax.DELETE(
+		"/permanent/:id",
+		apiHandler.Handle("deletePermanent", taxHandler.DeletePermanent),
+	)
+
+	return taxHandler
+}
+
+// FindAll godoc.
+// @Summary Get all tax rates
+// @Tags TaxRate
+// @Security Bearer
+// @Description Retrieve a paginated list of active tax rates with optional search.
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Number of items per page (default: 10)"
+// @Param search query string false "Search keyword"
+// @Success 200 {object} response.ApiResponsePaginationTaxRate "List of tax rates"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch tax rates"
+// @Router /api/tax-rate [get]
+func (h *taxHandleApi) FindAll(c echo.Context) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = 10
+	}
+
+	search := c.QueryParam("search")
+
+	ctx := c.Request().Context()
+
+	req := &pb.FindAllTaxRateRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+		Search:   search,
+	}
+
+	res, err := h.tax.FindAllTaxRate(ctx, req)
+	if err != nil {
+		return h.handleGrpcError(err, "FindAll")
+	}
+
+	so := h.mapping.ToApiResponsePaginationTaxRate(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindById godoc.
+// @Summary Get tax rate by ID
+// @Tags TaxRate
+// @Security Bearer
+// @Description Retrieve a tax rate by its ID.
+// @Accept json
+// @Produce json
+// @Param id path int true "Tax rate ID"
+// @Success 200 {object} response.ApiResponseTaxRate "Tax rate data"
+// @Failure 400 {object} response.ErrorResponse "Invalid tax rate ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch tax rate"
+// @Router /api/tax-rate/{id} [get]
+func (h *taxHandleApi) FindById(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.tax.FindByIdTaxRate(ctx, &pb.FindByIdTaxRateRequest{
+		TaxRateId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindById")
+	}
+
+	so := h.mapping.ToApiResponseTaxRate(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// FindTransactionTax godoc.
+// @Summary Get the tax breakdown of a transaction
+// @Tags TaxRate
+// @Security Bearer
+// @Description Retrieve the per-line tax charged on a transaction, as recorded at the time of sale.
+// @Accept json
+// @Produce json
+// @Param transaction_id path int true "Transaction ID"
+// @Success 200 {object} response.ApiResponseTransactionTax "Tax breakdown"
+// @Failure 400 {object} response.ErrorResponse "Invalid transaction ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to fetch tax breakdown"
+// @Router /api/tax-rate/transaction/{transaction_id} [get]
+func (h *taxHandleApi) FindTransactionTax(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("transaction_id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("transaction_id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.tax.FindTransactionTax(ctx, &pb.FindTransactionTaxRequest{
+		TransactionId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "FindTransactionTax")
+	}
+
+	so := h.mapping.ToApiResponseTransactionTax(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Create godoc.
+// @Summary Create a tax rate
+// @Tags TaxRate
+// @Security Bearer
+// @Description Create a tax rate for a merchant, a category, both or neither (global).
+// @Accept json
+// @Produce json
+// @Param request body requests.CreateTaxRateRequest true "Tax rate data"
+// @Success 200 {object} response.ApiResponseTaxRate "Created tax rate"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 500 {object} response.ErrorResponse "Failed to create tax rate"
+// @Router /api/tax-rate/create [post]
+func (h *taxHandleApi) Create(c echo.Context) error {
+	var body requests.CreateTaxRateRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.CreateTaxRateRequest{
+		Name:        body.Name,
+		MerchantId:  int32Wrapper(body.MerchantID),
+		CategoryId:  int32Wrapper(body.CategoryID),
+		RateBps:     int32(body.RateBps),
+		IsInclusive: body.IsInclusive,
+		Rounding:    body.Rounding,
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.tax.CreateTaxRate(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Create")
+	}
+
+	so := h.mapping.ToApiResponseTaxRate(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Update godoc.
+// @Summary Update a tax rate
+// @Tags TaxRate
+// @Security Bearer
+// @Description Update a tax rate. Already priced order lines keep the rate they were charged.
+// @Accept json
+// @Produce json
+// @Param id path int true "Tax rate ID"
+// @Param request body requests.UpdateTaxRateRequest true "Tax rate data"
+// @Success 200 {object} response.ApiResponseTaxRate "Updated tax rate"
+// @Failure 400 {object} response.ErrorResponse "Invalid tax rate ID or request body"
+// @Failure 500 {object} response.ErrorResponse "Failed to update tax rate"
+// @Router /api/tax-rate/update/{id} [post]
+func (h *taxHandleApi) Update(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	var body requests.UpdateTaxRateRequest
+
+	if err := c.Bind(&body); err != nil {
+		return errors.NewBadRequestError("Invalid request format").WithInternal(err)
+	}
+
+	body.TaxRateID = &id
+
+	if err := body.Validate(); err != nil {
+		validations := h.parseValidationErrors(err)
+		return errors.NewValidationError(validations)
+	}
+
+	reqPb := &pb.UpdateTaxRateRequest{
+		TaxRateId:   int32(id),
+		Name:        body.Name,
+		MerchantId:  int32Wrapper(body.MerchantID),
+		CategoryId:  int32Wrapper(body.CategoryID),
+		RateBps:     int32(body.RateBps),
+		IsInclusive: body.IsInclusive,
+		Rounding:    body.Rounding,
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.tax.UpdateTaxRate(ctx, reqPb)
+	if err != nil {
+		return h.handleGrpcError(err, "Update")
+	}
+
+	so := h.mapping.ToApiResponseTaxRate(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Trashed godoc.
+// @Summary Soft-delete a tax rate
+// @Tags TaxRate
+// @Security Bearer
+// @Description Stop applying a tax rate while keeping it for audit.
+// @Accept json
+// @Produce json
+// @Param id path int true "Tax rate ID"
+// @Success 200 {object} response.ApiResponseTaxRateDeleteAt "Soft-deleted tax rate"
+// @Failure 400 {object} response.ErrorResponse "Invalid tax rate ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to soft-delete tax rate"
+// @Router /api/tax-rate/trashed/{id} [post]
+func (h *taxHandleApi) Trashed(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.tax.TrashedTaxRate(ctx, &pb.FindByIdTaxRateRequest{
+		TaxRateId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Trashed")
+	}
+
+	so := h.mapping.ToApiResponseTaxRateDeleteAt(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// Restore godoc.
+// @Summary Restore a soft-deleted tax rate
+// @Tags TaxRate
+// @Security Bearer
+// @Description Restore a soft-deleted tax rate by its ID.
+// @Accept json
+// @Produce json
+// @Param id path int true "Tax rate ID"
+// @Success 200 {object} response.ApiResponseTaxRateDeleteAt "Restored tax rate"
+// @Failure 400 {object} response.ErrorResponse "Invalid tax rate ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to restore tax rate"
+// @Router /api/tax-rate/restore/{id} [post]
+func (h *taxHandleApi) Restore(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.tax.RestoreTaxRate(ctx, &pb.FindByIdTaxRateRequest{
+		TaxRateId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "Restore")
+	}
+
+	so := h.mapping.ToApiResponseTaxRateDeleteAt(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// DeletePermanent godoc.
+// @Summary Permanently delete a tax rate
+// @Tags TaxRate
+// @Security Bearer
+// @Description Permanently delete a trashed tax rate by its ID.
+// @Accept json
+// @Produce json
+// @Param id path int true "Tax rate ID"
+// @Success 200 {object} response.ApiResponseTaxRateDelete "Deletion result"
+// @Failure 400 {object} response.ErrorResponse "Invalid tax rate ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to delete tax rate permanently"
+// @Router /api/tax-rate/permanent/{id} [delete]
+func (h *taxHandleApi) DeletePermanent(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return errors.NewBadRequestError("id is required")
+	}
+
+	ctx := c.Request().Context()
+
+	res, err := h.tax.DeleteTaxRatePermanent(ctx, &pb.FindByIdTaxRateRequest{
+		TaxRateId: int32(id),
+	})
+	if err != nil {
+		return h.handleGrpcError(err, "DeletePermanent")
+	}
+
+	so := h.mapping.ToApiResponseTaxRateDelete(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+func (h *taxHandleApi) handleGrpcError(err error, operation string) *errors.AppError {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.NewNotFoundError("Tax rate").WithInternal(err)
+
+	case codes.AlreadyExists:
+		return errors.NewConflictError("Tax rate already exists").WithInternal(err)
+
+	case codes.InvalidArgument:
+		return errors.NewBadRequestError(st.Message()).WithInternal(err)
+
+	case codes.PermissionDenied:
+		return errors.ErrForbidden.WithInternal(err)
+
+	case codes.Unauthenticated:
+		return errors.ErrUnauthorized.WithInternal(err)
+
+	case codes.ResourceExhausted:
+		return errors.ErrTooManyRequests.WithInternal(err)
+
+	case codes.Unavailable:
+		return errors.NewServiceUnavailableError("Tax service").WithInternal(err)
+
+	case codes.DeadlineExceeded:
+		return errors.ErrTimeout.WithInternal(err)
+
+	default:
+		return errors.NewInternalError(err).WithMessage("Failed to " + operation)
+	}
+}
+
+func (h *taxHandleApi) parseValidationErrors(err error) []errors.ValidationError {
+	var validationErrs []errors.ValidationError
+
+	if ve, ok := err.(validator.ValidationErrors); ok {
+		for _, fe := range ve {
+			validationErrs = append(validationErrs, errors.ValidationError{
+				Field:   fe.Field(),
+				Message: h.getValidationMessage(fe),
+			})
+		}
+		return validationErrs
+	}
+
+	return []errors.ValidationError{
+		{
+			Field:   "general",
+			Message: err.Error(),
+		},
+	}
+}
+
+func (h *taxHandleApi) getValidationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "This field is required"
+	case "min":
+		return fmt.Sprintf("Must be at least %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("Must be at most %s", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("Must be one of: %s", fe.Param())
+	default:
+		return fmt.Sprintf("Validation failed on '%s' tag", fe.Tag())
+	}
+}
+
+func int32Wrapper(v *int) *wrapperspb.Int32Value {
+	if v == nil {
+		return nil
+	}
+
+	return wrapperspb.Int32(int32(*v))
+}
@@ -6,6 +6,7 @@ import (
 	transaction_cache "pointofsale/internal/cache/api/transaction"
 	"pointofsale/internal/domain/requests"
 	response_api "pointofsale/internal/mapper"
+	"pointofsale/internal/middlewares"
 	"pointofsale/internal/pb"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
@@ -17,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type transactionHandleApi struct {
@@ -47,6 +49,8 @@ func NewHandlerTransaction(
 
 	routerTransaction.GET("", transactionHandle.FindAllTransaction)
 	routerTransaction.GET("/:id", transactionHandle.FindById)
+	routerTransaction.GET("/payments/:id", transactionHandle.FindPayments)
+	routerTransaction.GET("/refunds/:id", transactionHandle.FindRefunds)
 	routerTransaction.GET("/merchant/:merchant_id", transactionHandle.FindByMerchant)
 	routerTransaction.GET("/active", transactionHandle.FindByActive)
 	routerTransaction.GET("/trashed", transactionHandle.FindByTrashed)
@@ -73,8 +77,10 @@ func NewHandlerTransaction(
 	routerTransaction.GET("/merchant/monthly-method-failed/:merchant_id", transactionHandle.FindMonthMethodByMerchantFailed)
 	routerTransaction.GET("/merchant/yearly-method-failed/:merchant_id", transactionHandle.FindYearMethodByMerchantFailed)
 
-	routerTransaction.POST("/create", apiHandler.Handle("create", transactionHandle.Create))
+	routerTransaction.POST("/create", apiHandler.Handle("create", transactionHandle.Create), middlewares.IdempotencyKey())
 	routerTransaction.POST("/update/:id", apiHandler.Handle("update", transactionHandle.Update))
+	routerTransaction.POST("/refund/:id", apiHandler.Handle("refund", transactionHandle.Refund))
+	routerTransaction.POST("/void/:id", apiHandler.Handle("void", transactionHandle.Void))
 
 	routerTransaction.POST("/trashed/:id", apiHandler.Handle("trashed", transactionHandle.TrashedTransaction))
 	routerTransaction.POST("/restore/:id", apiHandler.Handle("restore", transactionHandle.RestoreTransaction))
@@ -254,6 +260,80 @@ func (h *transactionHandleApi) FindById(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// @Summary Find transaction payments
+// @Tags Transaction
+// @Description Retrieve the tender lines a transaction was paid with
+// @Accept json
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Success 200 {object} response.ApiResponseTransactionPayments "Transaction payments"
+// @Failure 400 {object} response.ErrorResponse "Invalid transaction ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to retrieve transaction payments"
+// @Router /api/transaction/payments/{id} [get]
+func (h *transactionHandleApi) FindPayments(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		h.logger.Debug("Invalid transaction ID format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid transaction ID")
+	}
+
+	ctx := c.Request().Context()
+
+	grpcReq := &pb.FindByIdTransactionRequest{
+		Id: int32(id),
+	}
+
+	res, err := h.client.FindPayments(ctx, grpcReq)
+
+	if err != nil {
+		h.logger.Error("Failed to fetch transaction payments", zap.Error(err))
+		return h.handleGrpcError(err, "FindPayments")
+	}
+
+	so := h.mapping.ToApiResponseTransactionPayments(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// @Summary Find transaction refunds
+// @Tags Transaction
+// @Description Retrieve the refunds and voids recorded against a transaction
+// @Accept json
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Success 200 {object} response.ApiResponseTransactionRefunds "Transaction refunds"
+// @Failure 400 {object} response.ErrorResponse "Invalid transaction ID"
+// @Failure 500 {object} response.ErrorResponse "Failed to retrieve transaction refunds"
+// @Router /api/transaction/refunds/{id} [get]
+func (h *transactionHandleApi) FindRefunds(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		h.logger.Debug("Invalid transaction ID format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid transaction ID")
+	}
+
+	ctx := c.Request().Context()
+
+	grpcReq := &pb.FindByIdTransactionRequest{
+		Id: int32(id),
+	}
+
+	res, err := h.client.FindRefunds(ctx, grpcReq)
+
+	if err != nil {
+		h.logger.Error("Failed to fetch transaction refunds", zap.Error(err))
+		return h.handleGrpcError(err, "FindRefunds")
+	}
+
+	so := h.mapping.ToApiResponseTransactionRefunds(res)
+
+	return c.JSON(http.StatusOK, so)
+}
+
 // @Security Bearer
 // @Summary Retrieve active transactions
 // @Tags Transaction
@@ -1261,6 +1341,7 @@ func (h *transactionHandleApi) FindYearMethodByMerchantFailed(c echo.Context) er
 // @Description Create a new transaction record
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
 // @Param request body requests.CreateTransactionRequest true "Transaction details"
 // @Success 200 {object} response.ApiResponseTransaction "Successfully created transaction"
 // @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
@@ -1286,6 +1367,7 @@ func (h *transactionHandleApi) Create(c echo.Context) error {
 		CashierId:     int32(body.CashierID),
 		PaymentMethod: body.PaymentMethod,
 		Amount:        int32(body.Amount),
+		Payments:      toPbTransactionPayments(body.Payments),
 	}
 
 	res, err := h.client.Create(ctx, grpcReq)
@@ -1344,6 +1426,7 @@ func (h *transactionHandleApi) Update(c echo.Context) error {
 		CashierId:     int32(body.CashierID),
 		PaymentMethod: body.PaymentMethod,
 		Amount:        int32(body.Amount),
+		Payments:      toPbTransactionPayments(body.Payments),
 	}
 
 	res, err := h.client.Update(ctx, grpcReq)
@@ -1360,6 +1443,131 @@ func (h *transactionHandleApi) Update(c echo.Context) error {
 	return c.JSON(http.StatusOK, so)
 }
 
+// @Security Bearer
+// @Summary Refund a transaction
+// @Tags Transaction
+// @Description Refund some or all items of a completed transaction. Without items everything not yet refunded is returned.
+// @Accept json
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Param request body requests.RefundTransactionRequest true "Refund details"
+// @Success 200 {object} response.ApiResponseTransactionRefund "Successfully refunded transaction"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
+// @Failure 422 {object} response.ErrorResponse "Transaction cannot be refunded"
+// @Failure 500 {object} response.ErrorResponse "Failed to refund transaction"
+// @Router /api/transaction/refund/{id} [post]
+func (h *transactionHandleApi) Refund(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		h.logger.Debug("Invalid transaction ID format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid transaction ID")
+	}
+
+	var body requests.RefundTransactionRequest
+
+	if err := c.Bind(&body); err != nil {
+		h.logger.Debug("Invalid request format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid request format")
+	}
+
+	if err := body.Validate(); err != nil {
+		h.logger.Debug("Validation failed", zap.Error(err))
+		return errors.NewBadRequestError("Validation failed: " + err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	grpcReq := &pb.RefundTransactionRequest{
+		TransactionId: int32(id),
+		ReasonCode:    body.ReasonCode,
+		Restock:       body.Restock,
+	}
+
+	if body.Note != nil {
+		grpcReq.Note = wrapperspb.String(*body.Note)
+	}
+
+	for _, item := range body.Items {
+		grpcReq.Items = append(grpcReq.Items, &pb.RefundItemRequest{
+			OrderItemId: int32(item.OrderItemID),
+			Quantity:    int32(item.Quantity),
+		})
+	}
+
+	res, err := h.client.RefundTransaction(ctx, grpcReq)
+
+	if err != nil {
+		h.logger.Error("transaction refund failed", zap.Error(err))
+		return h.handleGrpcError(err, "Refund")
+	}
+
+	so := h.mapping.ToApiResponseTransactionRefund(res)
+
+	h.cache.DeleteTransactionCache(ctx, id)
+
+	return c.JSON(http.StatusOK, so)
+}
+
+// @Security Bearer
+// @Summary Void a transaction
+// @Tags Transaction
+// @Description Reverse a completed transaction in full
+// @Accept json
+// @Produce json
+// @Param id path int true "Transaction ID"
+// @Param request body requests.VoidTransactionRequest true "Void details"
+// @Success 200 {object} response.ApiResponseTransactionRefund "Successfully voided transaction"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or validation error"
+// @Failure 422 {object} response.ErrorResponse "Transaction cannot be voided"
+// @Failure 500 {object} response.ErrorResponse "Failed to void transaction"
+// @Router /api/transaction/void/{id} [post]
+func (h *transactionHandleApi) Void(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		h.logger.Debug("Invalid transaction ID format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid transaction ID")
+	}
+
+	var body requests.VoidTransactionRequest
+
+	if err := c.Bind(&body); err != nil {
+		h.logger.Debug("Invalid request format", zap.Error(err))
+		return errors.NewBadRequestError("Invalid request format")
+	}
+
+	if err := body.Validate(); err != nil {
+		h.logger.Debug("Validation failed", zap.Error(err))
+		return errors.NewBadRequestError("Validation failed: " + err.Error())
+	}
+
+	ctx := c.Request().Context()
+
+	grpcReq := &pb.VoidTransactionRequest{
+		TransactionId: int32(id),
+		ReasonCode:    body.ReasonCode,
+		Restock:       body.Restock,
+	}
+
+	if body.Note != nil {
+		grpcReq.Note = wrapperspb.String(*body.Note)
+	}
+
+	res, err := h.client.VoidTransaction(ctx, grpcReq)
+
+	if err != nil {
+		h.logger.Error("transaction void failed", zap.Error(err))
+		return h.handleGrpcError(err, "Void")
+	}
+
+	so := h.mapping.ToApiResponseTransactionRefund(res)
+
+	h.cache.DeleteTransactionCache(ctx, id)
+
+	return c.JSON(http.StatusOK, so)
+}
+
 // @Security Bearer
 // TrashedTransaction retrieves a trashed transaction record by its ID.
 // @Summary Retrieve a trashed transaction
@@ -1504,6 +1712,8 @@ func (h *transactionHandleApi) RestoreAllTransaction(c echo.Context) error {
 
 	h.logger.Debug("Successfully restored all transactions")
 
+	h.cache.InvalidateTransactionCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -1530,6 +1740,8 @@ func (h *transactionHandleApi) DeleteAllTransactionPermanent(c echo.Context) err
 
 	h.logger.Debug("Successfully deleted all transactions permanently")
 
+	h.cache.InvalidateTransactionCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -1544,7 +1756,7 @@ func (h *transactionHandleApi) handleGrpcError(err error, operation string) *err
 		return errors.NewNotFoundError("Transaction").WithInternal(err)
 
 	case codes.AlreadyExists:
-		return errors.NewConflictError("Transaction already exists").WithInternal(err)
+		return errors.NewConflictError(st.Message()).WithInternal(err)
 
 	case codes.InvalidArgument:
 		return errors.NewBadRequestError(st.Message()).WithInternal(err)
@@ -1610,3 +1822,22 @@ func (h *transactionHandleApi) getValidationMessage(fe validator.FieldError) str
 		return fmt.Sprintf("Validation failed on '%s' tag", fe.Tag())
 	}
 }
+
+func toPbTransactionPayments(payments []requests.TransactionPaymentRequest) []*pb.TransactionPaymentRequest {
+	var res []*pb.TransactionPaymentRequest
+
+	for _, payment := range payments {
+		req := &pb.TransactionPaymentRequest{
+			PaymentMethod: payment.PaymentMethod,
+			Amount:        int32(payment.Amount),
+		}
+
+		if payment.Reference != nil {
+			req.Reference = *payment.Reference
+		}
+
+		res = append(res, req)
+	}
+
+	return res
+}
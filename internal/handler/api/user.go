@@ -342,6 +342,8 @@ func (h *userHandleApi) Create(c echo.Context) error {
 
 	h.cache.SetCachedUserCache(ctx, so)
 
+	h.cache.InvalidateUserCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -541,6 +543,8 @@ func (h *userHandleApi) RestoreAllUser(c echo.Context) error {
 
 	h.logger.Debug("Successfully restored all user")
 
+	h.cache.InvalidateUserCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -569,6 +573,8 @@ func (h *userHandleApi) DeleteAllUserPermanent(c echo.Context) error {
 
 	h.logger.Debug("Successfully deleted all user permanently")
 
+	h.cache.InvalidateUserCache(ctx)
+
 	return c.JSON(http.StatusOK, so)
 }
 
@@ -5,7 +5,12 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/pb"
 	"pointofsale/internal/service"
+	"pointofsale/pkg/auth"
 	"pointofsale/pkg/errors"
+	refreshtoken_errors "pointofsale/pkg/errors/refresh_token_errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type authHandleGrpc struct {
@@ -111,3 +116,93 @@ func (s *authHandleGrpc) RegisterUser(ctx context.Context, req *pb.RegisterReque
 
 	return pbRes, nil
 }
+
+func (s *authHandleGrpc) Logout(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponseLogout, error) {
+	request, err := logoutRequestFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authService.Logout(ctx, request); err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseLogout{
+		Status:  "success",
+		Message: "Logout successfully",
+	}, nil
+}
+
+func (s *authHandleGrpc) LogoutAll(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponseLogout, error) {
+	request, err := logoutRequestFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authService.LogoutAll(ctx, request); err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseLogout{
+		Status:  "success",
+		Message: "Logout from all devices successfully",
+	}, nil
+}
+
+func (s *authHandleGrpc) ListSessions(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponseSessions, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, refreshtoken_errors.ErrGrpcMissingSession
+	}
+
+	tokenID, _ := auth.TokenIDFromContext(ctx)
+
+	sessions, err := s.authService.FindSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	data := make([]*pb.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		data = append(data, &pb.SessionResponse{
+			SessionId:  session.SessionID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IpAddress,
+			StartedAt:  session.SessionStartedAt.Format(time.RFC3339),
+			LastUsedAt: session.CreatedAt.Time.Format(time.RFC3339),
+			ExpiresAt:  session.Expiration.Format(time.RFC3339),
+			Current:    tokenID != "" && session.AccessTokenID == tokenID,
+		})
+	}
+
+	return &pb.ApiResponseSessions{
+		Status:  "success",
+		Message: "Successfully fetched sessions",
+		Data:    data,
+	}, nil
+}
+
+// logoutRequestFromContext identifies the caller and the access token the
+// interceptor authenticated, which together select the session to end.
+func logoutRequestFromContext(ctx context.Context) (*requests.LogoutRequest, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, refreshtoken_errors.ErrGrpcMissingSession
+	}
+
+	tokenID, ok := auth.TokenIDFromContext(ctx)
+	if !ok {
+		return nil, refreshtoken_errors.ErrGrpcMissingSession
+	}
+
+	request := &requests.LogoutRequest{
+		UserID:  userID,
+		TokenID: tokenID,
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, refreshtoken_errors.ErrGrpcMissingSession
+	}
+
+	return request, nil
+}
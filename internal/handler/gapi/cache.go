@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"context"
+	"pointofsale/internal/cache"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/errors/cache_errors"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type cacheHandleGrpc struct {
+	pb.UnimplementedCacheServiceServer
+	cacheService service.CacheService
+}
+
+func NewCacheHandleGrpc(cache service.CacheService) *cacheHandleGrpc {
+	return &cacheHandleGrpc{
+		cacheService: cache,
+	}
+}
+
+func (s *cacheHandleGrpc) FindAllNamespaces(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponsesCacheNamespace, error) {
+	namespaces, err := s.cacheService.FindAllNamespaces(ctx)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	data := make([]*pb.CacheNamespaceResponse, 0, len(namespaces))
+	for _, ns := range namespaces {
+		data = append(data, toCacheNamespaceResponse(ns))
+	}
+
+	return &pb.ApiResponsesCacheNamespace{
+		Status:  "success",
+		Message: "Successfully fetched cache namespaces",
+		Data:    data,
+	}, nil
+}
+
+func (s *cacheHandleGrpc) FindNamespace(ctx context.Context, req *pb.FindCacheNamespaceRequest) (*pb.ApiResponseCacheNamespace, error) {
+	if req.GetNamespace() == "" {
+		return nil, cache_errors.ErrGrpcCacheInvalidNamespace
+	}
+
+	ns, err := s.cacheService.FindNamespace(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseCacheNamespace{
+		Status:  "success",
+		Message: "Successfully fetched cache namespace",
+		Data:    toCacheNamespaceResponse(ns),
+	}, nil
+}
+
+func (s *cacheHandleGrpc) FlushNamespace(ctx context.Context, req *pb.FindCacheNamespaceRequest) (*pb.ApiResponseCacheFlush, error) {
+	if req.GetNamespace() == "" {
+		return nil, cache_errors.ErrGrpcCacheInvalidNamespace
+	}
+
+	deleted, err := s.cacheService.FlushNamespace(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseCacheFlush{
+		Status:  "success",
+		Message: "Successfully flushed cache namespace",
+		Data: &pb.CacheFlushResponse{
+			Namespace: req.GetNamespace(),
+			Deleted:   deleted,
+		},
+	}, nil
+}
+
+func toCacheNamespaceResponse(ns *cache.NamespaceStats) *pb.CacheNamespaceResponse {
+	return &pb.CacheNamespaceResponse{
+		Namespace:    ns.Namespace,
+		Keys:         ns.Keys,
+		LocalEntries: int32(ns.LocalEntries),
+		LocalTier:    ns.LocalTier,
+		Durable:      ns.Durable,
+	}
+}
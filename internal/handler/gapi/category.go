@@ -67,6 +67,9 @@ func (s *categoryHandleGrpc) FindAll(ctx context.Context, request *pb.FindAllCat
 			SlugCategory: *category.SlugCategory,
 			CreatedAt:    category.CreatedAt.Time.String(),
 			UpdatedAt:    category.UpdatedAt.Time.String(),
+			ParentId:     int32Value(category.ParentID),
+			MerchantId:   int32Value(category.MerchantID),
+			Position:     category.Position,
 		})
 	}
 
@@ -100,10 +103,58 @@ func (s *categoryHandleGrpc) FindById(ctx context.Context, request *pb.FindByIdC
 			SlugCategory: *category.SlugCategory,
 			CreatedAt:    category.CreatedAt.Time.String(),
 			UpdatedAt:    category.UpdatedAt.Time.String(),
+			ParentId:     int32Value(category.ParentID),
+			MerchantId:   int32Value(category.MerchantID),
+			Position:     category.Position,
 		},
 	}, nil
 }
 
+// FindTree nests the categories below their parents. Categories whose
+// parent is not part of the tree, such as one in the trash, are not returned.
+func (s *categoryHandleGrpc) FindTree(ctx context.Context, request *pb.FindCategoryTreeRequest) (*pb.ApiResponseCategoryTree, error) {
+	rows, err := s.categoryService.FindTree(ctx, intPtr(request.GetMerchantId()))
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	nodes := make(map[int32]*pb.CategoryTreeNode, len(rows))
+	var roots []*pb.CategoryTreeNode
+
+	// Rows come parent first, so every parent is in nodes before its children.
+	for _, category := range rows {
+		node := &pb.CategoryTreeNode{
+			Category: &pb.CategoryResponse{
+				Id:           category.CategoryID,
+				Name:         category.Name,
+				Description:  *category.Description,
+				SlugCategory: *category.SlugCategory,
+				CreatedAt:    category.CreatedAt.Time.String(),
+				UpdatedAt:    category.UpdatedAt.Time.String(),
+				ParentId:     int32Value(category.ParentID),
+				MerchantId:   int32Value(category.MerchantID),
+				Position:     category.Position,
+			},
+		}
+		nodes[category.CategoryID] = node
+
+		if category.ParentID == nil {
+			roots = append(roots, node)
+			continue
+		}
+
+		if parent, ok := nodes[*category.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	return &pb.ApiResponseCategoryTree{
+		Status:  "success",
+		Message: "Successfully fetched category tree",
+		Data:    roots,
+	}, nil
+}
+
 func (s *categoryHandleGrpc) FindByActive(ctx context.Context, request *pb.FindAllCategoryRequest) (*pb.ApiResponsePaginationCategoryDeleteAt, error) {
 	page := int(request.GetPage())
 	pageSize := int(request.GetPageSize())
@@ -150,6 +201,9 @@ func (s *categoryHandleGrpc) FindByActive(ctx context.Context, request *pb.FindA
 			SlugCategory: *category.SlugCategory,
 			CreatedAt:    category.CreatedAt.Time.String(),
 			UpdatedAt:    category.UpdatedAt.Time.String(),
+			ParentId:     int32Value(category.ParentID),
+			MerchantId:   int32Value(category.MerchantID),
+			Position:     category.Position,
 			DeletedAt:    &wrapperspb.StringValue{Value: deletedAt},
 		})
 	}
@@ -208,6 +262,9 @@ func (s *categoryHandleGrpc) FindByTrashed(ctx context.Context, request *pb.Find
 			SlugCategory: *category.SlugCategory,
 			CreatedAt:    category.CreatedAt.Time.String(),
 			UpdatedAt:    category.UpdatedAt.Time.String(),
+			ParentId:     int32Value(category.ParentID),
+			MerchantId:   int32Value(category.MerchantID),
+			Position:     category.Position,
 			DeletedAt:    &wrapperspb.StringValue{Value: deletedAt},
 		})
 	}
@@ -303,9 +360,10 @@ func (s *categoryHandleGrpc) FindMonthlyTotalPricesById(ctx context.Context, req
 	}
 
 	reqService := requests.MonthTotalPriceCategory{
-		Year:       year,
-		Month:      month,
-		CategoryID: id,
+		Year:                 year,
+		Month:                month,
+		CategoryID:           id,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
 	}
 
 	prices, err := s.categoryService.FindMonthlyTotalPriceById(ctx, &reqService)
@@ -342,8 +400,9 @@ func (s *categoryHandleGrpc) FindYearlyTotalPricesById(ctx context.Context, req
 	}
 
 	reqService := requests.YearTotalPriceCategory{
-		Year:       year,
-		CategoryID: id,
+		Year:                 year,
+		CategoryID:           id,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
 	}
 
 	prices, err := s.categoryService.FindYearlyTotalPriceById(ctx, &reqService)
@@ -446,7 +505,12 @@ func (s *categoryHandleGrpc) FindMonthPrice(ctx context.Context, req *pb.FindYea
 		return nil, category_errors.ErrGrpcFailedInvalidYear
 	}
 
-	prices, err := s.categoryService.FindMonthPrice(ctx, year)
+	reqService := requests.MonthPrice{
+		Year:                 year,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
+	}
+
+	prices, err := s.categoryService.FindMonthPrice(ctx, &reqService)
 	if err != nil {
 		return nil, errors.ToGrpcError(err)
 	}
@@ -477,7 +541,12 @@ func (s *categoryHandleGrpc) FindYearPrice(ctx context.Context, req *pb.FindYear
 		return nil, category_errors.ErrGrpcFailedInvalidYear
 	}
 
-	prices, err := s.categoryService.FindYearPrice(ctx, year)
+	reqService := requests.YearPrice{
+		Year:                 year,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
+	}
+
+	prices, err := s.categoryService.FindYearPrice(ctx, &reqService)
 	if err != nil {
 		return nil, errors.ToGrpcError(err)
 	}
@@ -515,8 +584,9 @@ func (s *categoryHandleGrpc) FindMonthPriceByMerchant(ctx context.Context, req *
 	}
 
 	reqService := requests.MonthPriceMerchant{
-		Year:       year,
-		MerchantID: id,
+		Year:                 year,
+		MerchantID:           id,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
 	}
 
 	prices, err := s.categoryService.FindMonthPriceByMerchant(ctx, &reqService)
@@ -556,8 +626,9 @@ func (s *categoryHandleGrpc) FindYearPriceByMerchant(ctx context.Context, req *p
 	}
 
 	reqService := requests.YearPriceMerchant{
-		Year:       year,
-		MerchantID: id,
+		Year:                 year,
+		MerchantID:           id,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
 	}
 
 	prices, err := s.categoryService.FindYearPriceByMerchant(ctx, &reqService)
@@ -598,8 +669,9 @@ func (s *categoryHandleGrpc) FindMonthPriceById(ctx context.Context, req *pb.Fin
 	}
 
 	reqService := requests.MonthPriceId{
-		Year:       year,
-		CategoryID: id,
+		Year:                 year,
+		CategoryID:           id,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
 	}
 
 	prices, err := s.categoryService.FindMonthPriceById(ctx, &reqService)
@@ -639,8 +711,9 @@ func (s *categoryHandleGrpc) FindYearPriceById(ctx context.Context, req *pb.Find
 	}
 
 	reqService := requests.YearPriceId{
-		Year:       year,
-		CategoryID: id,
+		Year:                 year,
+		CategoryID:           id,
+		IncludeSubcategories: req.GetIncludeSubcategories(),
 	}
 
 	prices, err := s.categoryService.FindYearPriceById(ctx, &reqService)
@@ -672,6 +745,8 @@ func (s *categoryHandleGrpc) Create(ctx context.Context, request *pb.CreateCateg
 	req := &requests.CreateCategoryRequest{
 		Name:        request.GetName(),
 		Description: request.GetDescription(),
+		ParentID:    intPtr(request.GetParentId()),
+		MerchantID:  intPtr(request.GetMerchantId()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -690,6 +765,9 @@ func (s *categoryHandleGrpc) Create(ctx context.Context, request *pb.CreateCateg
 		SlugCategory: *category.SlugCategory,
 		CreatedAt:    category.CreatedAt.Time.String(),
 		UpdatedAt:    category.UpdatedAt.Time.String(),
+		ParentId:     int32Value(category.ParentID),
+		MerchantId:   int32Value(category.MerchantID),
+		Position:     category.Position,
 	}
 
 	return &pb.ApiResponseCategory{
@@ -728,6 +806,9 @@ func (s *categoryHandleGrpc) Update(ctx context.Context, request *pb.UpdateCateg
 		SlugCategory: *category.SlugCategory,
 		CreatedAt:    category.CreatedAt.Time.String(),
 		UpdatedAt:    category.UpdatedAt.Time.String(),
+		ParentId:     int32Value(category.ParentID),
+		MerchantId:   int32Value(category.MerchantID),
+		Position:     category.Position,
 	}
 
 	return &pb.ApiResponseCategory{
@@ -761,6 +842,9 @@ func (s *categoryHandleGrpc) TrashedCategory(ctx context.Context, request *pb.Fi
 		SlugCategory: *category.SlugCategory,
 		CreatedAt:    category.CreatedAt.Time.String(),
 		UpdatedAt:    category.UpdatedAt.Time.String(),
+		ParentId:     int32Value(category.ParentID),
+		MerchantId:   int32Value(category.MerchantID),
+		Position:     category.Position,
 		DeletedAt:    &wrapperspb.StringValue{Value: deletedAt},
 	}
 
@@ -795,6 +879,9 @@ func (s *categoryHandleGrpc) RestoreCategory(ctx context.Context, request *pb.Fi
 		SlugCategory: *category.SlugCategory,
 		CreatedAt:    category.CreatedAt.Time.String(),
 		UpdatedAt:    category.UpdatedAt.Time.String(),
+		ParentId:     int32Value(category.ParentID),
+		MerchantId:   int32Value(category.MerchantID),
+		Position:     category.Position,
 		DeletedAt:    &wrapperspb.StringValue{Value: deletedAt},
 	}
 
@@ -823,6 +910,82 @@ func (s *categoryHandleGrpc) DeleteCategoryPermanent(ctx context.Context, reques
 	}, nil
 }
 
+func (s *categoryHandleGrpc) MoveCategory(ctx context.Context, request *pb.MoveCategoryRequest) (*pb.ApiResponseCategory, error) {
+	req := &requests.MoveCategoryRequest{
+		CategoryID: int(request.GetCategoryId()),
+		ParentID:   intPtr(request.GetParentId()),
+		Position:   intPtr(request.GetPosition()),
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, category_errors.ErrGrpcValidateMoveCategory
+	}
+
+	category, err := s.categoryService.MoveCategory(ctx, req)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseCategory{
+		Status:  "success",
+		Message: "Successfully moved category",
+		Data: &pb.CategoryResponse{
+			Id:           category.CategoryID,
+			Name:         category.Name,
+			Description:  *category.Description,
+			SlugCategory: *category.SlugCategory,
+			CreatedAt:    category.CreatedAt.Time.String(),
+			UpdatedAt:    category.UpdatedAt.Time.String(),
+			ParentId:     int32Value(category.ParentID),
+			MerchantId:   int32Value(category.MerchantID),
+			Position:     category.Position,
+		},
+	}, nil
+}
+
+func (s *categoryHandleGrpc) ReorderCategories(ctx context.Context, request *pb.ReorderCategoriesRequest) (*pb.ApiResponsesCategory, error) {
+	ids := make([]int, len(request.GetCategoryIds()))
+	for i, id := range request.GetCategoryIds() {
+		ids[i] = int(id)
+	}
+
+	req := &requests.ReorderCategoriesRequest{
+		ParentID:    intPtr(request.GetParentId()),
+		MerchantID:  intPtr(request.GetMerchantId()),
+		CategoryIDs: ids,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, category_errors.ErrGrpcValidateReorderCategories
+	}
+
+	categories, err := s.categoryService.ReorderCategories(ctx, req)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	var categoryResponses []*pb.CategoryResponse
+	for _, category := range categories {
+		categoryResponses = append(categoryResponses, &pb.CategoryResponse{
+			Id:           category.CategoryID,
+			Name:         category.Name,
+			Description:  *category.Description,
+			SlugCategory: *category.SlugCategory,
+			CreatedAt:    category.CreatedAt.Time.String(),
+			UpdatedAt:    category.UpdatedAt.Time.String(),
+			ParentId:     int32Value(category.ParentID),
+			MerchantId:   int32Value(category.MerchantID),
+			Position:     category.Position,
+		})
+	}
+
+	return &pb.ApiResponsesCategory{
+		Status:  "success",
+		Message: "Successfully reordered categories",
+		Data:    categoryResponses,
+	}, nil
+}
+
 func (s *categoryHandleGrpc) RestoreAllCategory(ctx context.Context, _ *emptypb.Empty) (*pb.ApiResponseCategoryAll, error) {
 	_, err := s.categoryService.RestoreAllCategories(ctx)
 	if err != nil {
@@ -5,29 +5,41 @@ import (
 )
 
 type Handler struct {
-	Auth        AuthHandleGrpc
-	Role        RoleHandleGrpc
-	User        UserHandleGrpc
-	Category    CategoryHandleGrpc
-	Cashier     CashierHandleGrpc
-	Merchant    MerchantHandleGrpc
-	OrderItem   OrderItemHandleGrpc
-	Order       OrderHandleGrpc
-	Product     ProductHandleGrpc
-	Transaction TransactionHandleGrpc
+	Auth           AuthHandleGrpc
+	Role           RoleHandleGrpc
+	User           UserHandleGrpc
+	Category       CategoryHandleGrpc
+	Cashier        CashierHandleGrpc
+	Merchant       MerchantHandleGrpc
+	OrderItem      OrderItemHandleGrpc
+	Order          OrderHandleGrpc
+	Product        ProductHandleGrpc
+	ProductVariant ProductVariantHandleGrpc
+	Transaction    TransactionHandleGrpc
+	Tax            TaxHandleGrpc
+	Supplier       SupplierHandleGrpc
+	PurchaseOrder  PurchaseOrderHandleGrpc
+	Stocktake      StocktakeHandleGrpc
+	Cache          CacheHandleGrpc
 }
 
 func NewHandler(service *service.Service) *Handler {
 	return &Handler{
-		Auth:        NewAuthHandleGrpc(service.Auth),
-		Role:        NewRoleHandleGrpc(service.Role),
-		User:        NewUserHandleGrpc(service.User),
-		Category:    NewCategoryHandleGrpc(service.Category),
-		Cashier:     NewCashierHandleGrpc(service.Cashier),
-		Merchant:    NewMerchantHandleGrpc(service.Merchant),
-		OrderItem:   NewOrderItemHandleGrpc(service.OrderItem),
-		Order:       NewOrderHandleGrpc(service.Order),
-		Product:     NewProductHandleGrpc(service.Product),
-		Transaction: NewTransactionHandleGrpc(service.Transaction),
+		Auth:           NewAuthHandleGrpc(service.Auth),
+		Role:           NewRoleHandleGrpc(service.Role),
+		User:           NewUserHandleGrpc(service.User),
+		Category:       NewCategoryHandleGrpc(service.Category),
+		Cashier:        NewCashierHandleGrpc(service.Cashier),
+		Merchant:       NewMerchantHandleGrpc(service.Merchant),
+		OrderItem:      NewOrderItemHandleGrpc(service.OrderItem),
+		Order:          NewOrderHandleGrpc(service.Order),
+		Product:        NewProductHandleGrpc(service.Product),
+		ProductVariant: NewProductVariantHandleGrpc(service.ProductVariant),
+		Transaction:    NewTransactionHandleGrpc(service.Transaction),
+		Tax:            NewTaxHandleGrpc(service.Tax),
+		Supplier:       NewSupplierHandleGrpc(service.Supplier),
+		PurchaseOrder:  NewPurchaseOrderHandleGrpc(service.PurchaseOrder),
+		Stocktake:      NewStocktakeHandleGrpc(service.Stocktake),
+		Cache:          NewCacheHandleGrpc(service.Cache),
 	}
 }
@@ -40,6 +40,30 @@ type ProductHandleGrpc interface {
 	pb.ProductServiceServer
 }
 
+type ProductVariantHandleGrpc interface {
+	pb.ProductVariantServiceServer
+}
+
 type TransactionHandleGrpc interface {
 	pb.TransactionServiceServer
 }
+
+type TaxHandleGrpc interface {
+	pb.TaxServiceServer
+}
+
+type SupplierHandleGrpc interface {
+	pb.SupplierServiceServer
+}
+
+type PurchaseOrderHandleGrpc interface {
+	pb.PurchaseOrderServiceServer
+}
+
+type StocktakeHandleGrpc interface {
+	pb.StocktakeServiceServer
+}
+
+type CacheHandleGrpc interface {
+	pb.CacheServiceServer
+}
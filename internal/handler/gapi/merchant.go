@@ -318,6 +318,40 @@ func (s *merchantHandleGrpc) Update(ctx context.Context, request *pb.UpdateMerch
 	}, nil
 }
 
+func (s *merchantHandleGrpc) UpdateBarcodeSettings(ctx context.Context, request *pb.UpdateMerchantBarcodeSettingsRequest) (*pb.ApiResponseMerchantBarcodeSettings, error) {
+	id := int(request.GetMerchantId())
+
+	if id == 0 {
+		return nil, merchant_errors.ErrGrpcInvalidID
+	}
+
+	req := &requests.UpdateMerchantBarcodeSettingsRequest{
+		MerchantID:    &id,
+		Gs1Prefix:     stringPtr(request.GetGs1Prefix()),
+		BarcodeFormat: request.GetBarcodeFormat(),
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_errors.ErrGrpcValidateBarcodeSettings
+	}
+
+	settings, err := s.merchantService.UpdateBarcodeSettings(ctx, req)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseMerchantBarcodeSettings{
+		Status:  "success",
+		Message: "Successfully updated merchant barcode settings",
+		Data: &pb.MerchantBarcodeSettingsResponse{
+			MerchantId:    settings.MerchantID,
+			Gs1Prefix:     stringValue(settings.Gs1Prefix),
+			BarcodeFormat: settings.BarcodeFormat,
+			UpdatedAt:     settings.UpdatedAt.Time.String(),
+		},
+	}, nil
+}
+
 func (s *merchantHandleGrpc) TrashedMerchant(ctx context.Context, request *pb.FindByIdMerchantRequest) (*pb.ApiResponseMerchantDeleteAt, error) {
 	id := int(request.GetId())
 
@@ -42,6 +42,7 @@ func (s *orderHandleGrpc) FindAll(ctx context.Context, request *pb.FindAllOrderR
 		Page:     page,
 		PageSize: pageSize,
 		Search:   search,
+		Status:   request.GetStatus(),
 	}
 
 	orders, totalRecords, err := s.orderService.FindAllOrders(ctx, &reqService)
@@ -68,6 +69,7 @@ func (s *orderHandleGrpc) FindAll(ctx context.Context, request *pb.FindAllOrderR
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 		})
 	}
 
@@ -97,6 +99,7 @@ func (s *orderHandleGrpc) FindByMerchant(ctx context.Context, request *pb.FindAl
 		PageSize:   pageSize,
 		Search:     search,
 		MerchantID: int(merchant_id),
+		Status:     request.GetStatus(),
 	}
 
 	orders, totalRecords, err := s.orderService.FindByMerchant(ctx, &reqService)
@@ -123,6 +126,7 @@ func (s *orderHandleGrpc) FindByMerchant(ctx context.Context, request *pb.FindAl
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 		})
 	}
 
@@ -157,6 +161,7 @@ func (s *orderHandleGrpc) FindById(ctx context.Context, request *pb.FindByIdOrde
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 		},
 	}, nil
 }
@@ -552,6 +557,7 @@ func (s *orderHandleGrpc) FindByActive(ctx context.Context, request *pb.FindAllO
 		Page:     page,
 		PageSize: pageSize,
 		Search:   search,
+		Status:   request.GetStatus(),
 	}
 
 	orders, totalRecords, err := s.orderService.FindByActive(ctx, &reqService)
@@ -583,6 +589,7 @@ func (s *orderHandleGrpc) FindByActive(ctx context.Context, request *pb.FindAllO
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 			DeletedAt:  &wrapperspb.StringValue{Value: deletedAt},
 		})
 	}
@@ -611,6 +618,7 @@ func (s *orderHandleGrpc) FindByTrashed(ctx context.Context, request *pb.FindAll
 		Page:     page,
 		PageSize: pageSize,
 		Search:   search,
+		Status:   request.GetStatus(),
 	}
 
 	orders, totalRecords, err := s.orderService.FindByTrashed(ctx, &reqService)
@@ -641,6 +649,7 @@ func (s *orderHandleGrpc) FindByTrashed(ctx context.Context, request *pb.FindAll
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 			DeletedAt:  &wrapperspb.StringValue{Value: deletedAt},
 		})
 	}
@@ -662,6 +671,7 @@ func (s *orderHandleGrpc) Create(ctx context.Context, request *pb.CreateOrderReq
 	for _, item := range request.GetItems() {
 		req.Items = append(req.Items, requests.CreateOrderItemRequest{
 			ProductID: int(item.GetProductId()),
+			VariantID: intPtr(item.GetVariantId()),
 			Quantity:  int(item.GetQuantity()),
 		})
 	}
@@ -686,6 +696,7 @@ func (s *orderHandleGrpc) Create(ctx context.Context, request *pb.CreateOrderReq
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 		},
 	}, nil
 }
@@ -705,6 +716,7 @@ func (s *orderHandleGrpc) Update(ctx context.Context, request *pb.UpdateOrderReq
 		req.Items = append(req.Items, requests.UpdateOrderItemRequest{
 			OrderItemID: int(item.GetOrderItemId()),
 			ProductID:   int(item.GetProductId()),
+			VariantID:   intPtr(item.GetVariantId()),
 			Quantity:    int(item.GetQuantity()),
 		})
 	}
@@ -728,6 +740,43 @@ func (s *orderHandleGrpc) Update(ctx context.Context, request *pb.UpdateOrderReq
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
+		},
+	}, nil
+}
+
+func (s *orderHandleGrpc) UpdateStatus(ctx context.Context, request *pb.UpdateOrderStatusRequest) (*pb.ApiResponseOrder, error) {
+	id := int(request.GetOrderId())
+
+	if id == 0 {
+		return nil, order_errors.ErrGrpcFailedInvalidId
+	}
+
+	req := &requests.UpdateOrderStatusRequest{
+		OrderID: id,
+		Status:  request.GetStatus(),
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, order_errors.ErrGrpcValidateUpdateOrderStatus
+	}
+
+	order, err := s.orderService.UpdateOrderStatus(ctx, req)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseOrder{
+		Status:  "success",
+		Message: "Successfully updated order status",
+		Data: &pb.OrderResponse{
+			Id:         int32(order.OrderID),
+			MerchantId: int32(order.MerchantID),
+			CashierId:  int32(order.CashierID),
+			TotalPrice: int32(order.TotalPrice),
+			CreatedAt:  order.CreatedAt.Time.String(),
+			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 		},
 	}, nil
 }
@@ -755,6 +804,7 @@ func (s *orderHandleGrpc) TrashedOrder(ctx context.Context, request *pb.FindById
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 			DeletedAt:  &wrapperspb.StringValue{Value: order.DeletedAt.Time.String()},
 		},
 	}, nil
@@ -783,6 +833,7 @@ func (s *orderHandleGrpc) RestoreOrder(ctx context.Context, request *pb.FindById
 			TotalPrice: int32(order.TotalPrice),
 			CreatedAt:  order.CreatedAt.Time.String(),
 			UpdatedAt:  order.UpdatedAt.Time.String(),
+			Status:     order.Status,
 			DeletedAt:  &wrapperspb.StringValue{Value: order.DeletedAt.Time.String()},
 		},
 	}, nil
@@ -217,6 +217,7 @@ func (s *orderItemHandleGrpc) FindOrderItemByOrder(ctx context.Context, request
 			Price:     int32(item.Price),
 			CreatedAt: item.CreatedAt.Time.String(),
 			UpdatedAt: item.UpdatedAt.Time.String(),
+			VariantId: int32Value(item.VariantID),
 		})
 	}
 
@@ -1,18 +1,32 @@
 package gapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"math"
 	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/domain/response"
 	"pointofsale/internal/pb"
 	"pointofsale/internal/service"
+	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/errors/product_errors"
 
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+const (
+	// maxImportFileSize bounds the import file a stream may upload.
+	maxImportFileSize = 20 << 20
+
+	// productFileChunkSize is the size of the pieces files are streamed in.
+	productFileChunkSize = 64 << 10
+)
+
 type productHandleGrpc struct {
 	pb.UnimplementedProductServiceServer
 	productService service.ProductService
@@ -75,6 +89,9 @@ func (s *productHandleGrpc) FindAll(ctx context.Context, request *pb.FindAllProd
 			Barcode:      *product.Barcode,
 			CreatedAt:    product.CreatedAt.Time.String(),
 			UpdatedAt:    product.UpdatedAt.Time.String(),
+			VariantCount: wrapperspb.Int32(product.VariantCount),
+			MinPrice:     wrapperspb.Int32(product.MinPrice),
+			MaxPrice:     wrapperspb.Int32(product.MaxPrice),
 		})
 	}
 
@@ -149,6 +166,9 @@ func (s *productHandleGrpc) FindByMerchant(ctx context.Context, request *pb.Find
 			Barcode:      *product.Barcode,
 			CreatedAt:    product.CreatedAt.Time.String(),
 			UpdatedAt:    product.UpdatedAt.Time.String(),
+			VariantCount: wrapperspb.Int32(product.VariantCount),
+			MinPrice:     wrapperspb.Int32(product.MinPrice),
+			MaxPrice:     wrapperspb.Int32(product.MaxPrice),
 		})
 	}
 
@@ -176,12 +196,13 @@ func (s *productHandleGrpc) FindByCategory(ctx context.Context, request *pb.Find
 	}
 
 	reqService := requests.ProductByCategoryRequest{
-		Page:         page,
-		PageSize:     pageSize,
-		Search:       search,
-		CategoryName: category_name,
-		MaxPrice:     max_price,
-		MinPrice:     min_price,
+		Page:                 page,
+		PageSize:             pageSize,
+		Search:               search,
+		CategoryName:         category_name,
+		MaxPrice:             max_price,
+		MinPrice:             min_price,
+		IncludeSubcategories: request.GetIncludeSubcategories(),
 	}
 
 	products, totalRecords, err := s.productService.FindByCategory(ctx, &reqService)
@@ -215,6 +236,9 @@ func (s *productHandleGrpc) FindByCategory(ctx context.Context, request *pb.Find
 			Barcode:      *product.Barcode,
 			CreatedAt:    product.CreatedAt.Time.String(),
 			UpdatedAt:    product.UpdatedAt.Time.String(),
+			VariantCount: wrapperspb.Int32(product.VariantCount),
+			MinPrice:     wrapperspb.Int32(product.MinPrice),
+			MaxPrice:     wrapperspb.Int32(product.MaxPrice),
 		})
 	}
 
@@ -256,10 +280,164 @@ func (s *productHandleGrpc) FindById(ctx context.Context, request *pb.FindByIdPr
 			Barcode:      *product.Barcode,
 			CreatedAt:    product.CreatedAt.Time.String(),
 			UpdatedAt:    product.UpdatedAt.Time.String(),
+			VariantCount: wrapperspb.Int32(product.VariantCount),
+			MinPrice:     wrapperspb.Int32(product.MinPrice),
+			MaxPrice:     wrapperspb.Int32(product.MaxPrice),
 		},
 	}, nil
 }
 
+func (s *productHandleGrpc) FindByBarcode(ctx context.Context, request *pb.FindByBarcodeRequest) (*pb.ApiResponseProductScan, error) {
+	barcode := request.GetBarcode()
+
+	if barcode == "" {
+		return nil, product_errors.ErrGrpcInvalidBarcode
+	}
+
+	product, err := s.productService.FindByBarcode(ctx, barcode)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseProductScan{
+		Status:  "success",
+		Message: "Successfully fetched scanned product",
+		Data:    mapProductScanResponse(product),
+	}, nil
+}
+
+func (s *productHandleGrpc) FindStockMovements(ctx context.Context, request *pb.FindStockMovementsRequest) (*pb.ApiResponsePaginationStockMovement, error) {
+	id := int(request.GetProductId())
+	page := int(request.GetPage())
+	pageSize := int(request.GetPageSize())
+
+	if id == 0 {
+		return nil, product_errors.ErrGrpcInvalidID
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	reqService := requests.FindStockMovementsRequest{
+		ProductID: id,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+
+	movements, totalRecords, err := s.productService.FindStockMovements(ctx, &reqService)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+
+	paginationMeta := &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(totalPages),
+		TotalRecords: int32(*totalRecords),
+	}
+
+	var movementResponses []*pb.StockMovementResponse
+	for _, movement := range movements {
+		res := &pb.StockMovementResponse{
+			Id:            movement.StockMovementID,
+			ProductId:     movement.ProductID,
+			Reason:        movement.Reason,
+			QuantityDelta: movement.QuantityDelta,
+			BalanceAfter:  movement.BalanceAfter,
+			CreatedAt:     movement.CreatedAt.Time.String(),
+		}
+
+		if movement.ReferenceType != nil {
+			res.ReferenceType = wrapperspb.String(*movement.ReferenceType)
+		}
+		if movement.ReferenceID != nil {
+			res.ReferenceId = wrapperspb.Int32(*movement.ReferenceID)
+		}
+		if movement.UserID != nil {
+			res.UserId = wrapperspb.Int32(*movement.UserID)
+		}
+		if movement.VariantID != nil {
+			res.VariantId = wrapperspb.Int32(*movement.VariantID)
+		}
+		if movement.Note != nil {
+			res.Note = wrapperspb.String(*movement.Note)
+		}
+
+		movementResponses = append(movementResponses, res)
+	}
+
+	return &pb.ApiResponsePaginationStockMovement{
+		Status:     "success",
+		Message:    "Successfully fetched stock movements",
+		Data:       movementResponses,
+		Pagination: paginationMeta,
+	}, nil
+}
+
+func (s *productHandleGrpc) FindLowStock(ctx context.Context, request *pb.FindLowStockProductRequest) (*pb.ApiResponsePaginationReorderLevel, error) {
+	merchantID := int(request.GetMerchantId())
+	page := int(request.GetPage())
+	pageSize := int(request.GetPageSize())
+
+	if merchantID <= 0 {
+		return nil, product_errors.ErrGrpcInvalidID
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	reqService := requests.FindLowStockProducts{
+		MerchantID: merchantID,
+		Page:       page,
+		PageSize:   pageSize,
+	}
+
+	products, totalRecords, err := s.productService.FindLowStock(ctx, &reqService)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+
+	paginationMeta := &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(totalPages),
+		TotalRecords: int32(*totalRecords),
+	}
+
+	var productResponses []*pb.ReorderLevelResponse
+	for _, product := range products {
+		productResponses = append(productResponses, &pb.ReorderLevelResponse{
+			Id:                product.ProductID,
+			MerchantId:        product.MerchantID,
+			CategoryId:        product.CategoryID,
+			Name:              product.Name,
+			CountInStock:      product.CountInStock,
+			ReorderPoint:      product.ReorderPoint,
+			ReorderQuantity:   product.ReorderQuantity,
+			LowStockAlertedAt: timestampValue(product.LowStockAlertedAt),
+		})
+	}
+
+	return &pb.ApiResponsePaginationReorderLevel{
+		Status:     "success",
+		Message:    "Successfully fetched low stock products",
+		Data:       productResponses,
+		Pagination: paginationMeta,
+	}, nil
+}
+
 func (s *productHandleGrpc) FindByActive(ctx context.Context, request *pb.FindAllProductRequest) (*pb.ApiResponsePaginationProductDeleteAt, error) {
 	page := int(request.GetPage())
 	pageSize := int(request.GetPageSize())
@@ -314,6 +492,9 @@ func (s *productHandleGrpc) FindByActive(ctx context.Context, request *pb.FindAl
 			Barcode:      *product.Barcode,
 			CreatedAt:    product.CreatedAt.Time.String(),
 			UpdatedAt:    product.UpdatedAt.Time.String(),
+			VariantCount: wrapperspb.Int32(product.VariantCount),
+			MinPrice:     wrapperspb.Int32(product.MinPrice),
+			MaxPrice:     wrapperspb.Int32(product.MaxPrice),
 			DeletedAt:    deletedAt,
 		})
 	}
@@ -380,6 +561,9 @@ func (s *productHandleGrpc) FindByTrashed(ctx context.Context, request *pb.FindA
 			Barcode:      *product.Barcode,
 			CreatedAt:    product.CreatedAt.Time.String(),
 			UpdatedAt:    product.UpdatedAt.Time.String(),
+			VariantCount: wrapperspb.Int32(product.VariantCount),
+			MinPrice:     wrapperspb.Int32(product.MinPrice),
+			MaxPrice:     wrapperspb.Int32(product.MaxPrice),
 			DeletedAt:    deletedAt,
 		})
 	}
@@ -403,6 +587,7 @@ func (s *productHandleGrpc) Create(ctx context.Context, request *pb.CreateProduc
 		Brand:        request.GetBrand(),
 		Weight:       int(request.GetWeight()),
 		ImageProduct: request.GetImageProduct(),
+		Barcode:      stringPtr(request.GetBarcode()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -454,6 +639,7 @@ func (s *productHandleGrpc) Update(ctx context.Context, request *pb.UpdateProduc
 		Brand:        request.GetBrand(),
 		Weight:       int(request.GetWeight()),
 		ImageProduct: request.GetImageProduct(),
+		Barcode:      stringPtr(request.GetBarcode()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -487,6 +673,137 @@ func (s *productHandleGrpc) Update(ctx context.Context, request *pb.UpdateProduc
 	}, nil
 }
 
+func (s *productHandleGrpc) UpdateReorderLevel(ctx context.Context, request *pb.UpdateReorderLevelRequest) (*pb.ApiResponseReorderLevel, error) {
+	id := int(request.GetProductId())
+
+	if id == 0 {
+		return nil, product_errors.ErrGrpcInvalidID
+	}
+
+	req := &requests.UpdateProductReorderLevelRequest{
+		ProductID:       &id,
+		ReorderPoint:    int(request.GetReorderPoint()),
+		ReorderQuantity: int(request.GetReorderQuantity()),
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, product_errors.ErrGrpcValidateReorderLevel
+	}
+
+	product, err := s.productService.UpdateReorderLevel(ctx, req)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseReorderLevel{
+		Status:  "success",
+		Message: "Successfully updated product reorder level",
+		Data: &pb.ReorderLevelResponse{
+			Id:                product.ProductID,
+			MerchantId:        product.MerchantID,
+			CategoryId:        product.CategoryID,
+			Name:              product.Name,
+			CountInStock:      product.CountInStock,
+			ReorderPoint:      product.ReorderPoint,
+			ReorderQuantity:   product.ReorderQuantity,
+			LowStockAlertedAt: timestampValue(product.LowStockAlertedAt),
+		},
+	}, nil
+}
+
+// ImportProducts reads an import file sent in chunks after its metadata and
+// replies with the per-row report once the client closes the stream.
+func (s *productHandleGrpc) ImportProducts(stream grpc.ClientStreamingServer[pb.ImportProductsChunk, pb.ApiResponseProductImport]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return product_errors.ErrGrpcImportMetadataFirst
+	}
+
+	var file bytes.Buffer
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if file.Len()+len(chunk.GetData()) > maxImportFileSize {
+			return product_errors.ErrGrpcImportFileTooLarge
+		}
+
+		file.Write(chunk.GetData())
+	}
+
+	req := &requests.ImportProductsRequest{
+		MerchantID: int(metadata.GetMerchantId()),
+		Format:     metadata.GetFormat(),
+		DryRun:     metadata.GetDryRun(),
+		File:       file.Bytes(),
+	}
+
+	if err := req.Validate(); err != nil {
+		return product_errors.ErrGrpcValidateImport
+	}
+
+	result, err := s.productService.ImportProducts(stream.Context(), req)
+	if err != nil {
+		return errors.ToGrpcError(err)
+	}
+
+	message := "Successfully checked product import"
+	if result.Applied {
+		message = "Successfully imported products"
+	}
+
+	return stream.SendAndClose(&pb.ApiResponseProductImport{
+		Status:  "success",
+		Message: message,
+		Data:    mapProductImportResponse(result),
+	})
+}
+
+// ExportProducts streams the export file in chunks small enough for any
+// message size limit.
+func (s *productHandleGrpc) ExportProducts(request *pb.ExportProductsRequest, stream grpc.ServerStreamingServer[pb.ExportProductsChunk]) error {
+	req := &requests.ExportProductsRequest{
+		MerchantID: int(request.GetMerchantId()),
+		Format:     request.GetFormat(),
+		Search:     request.GetSearch(),
+		CategoryID: int(request.GetCategoryId()),
+		MinPrice:   int(request.GetMinPrice()),
+		MaxPrice:   int(request.GetMaxPrice()),
+	}
+
+	if err := req.Validate(); err != nil {
+		return product_errors.ErrGrpcValidateExport
+	}
+
+	file, err := s.productService.ExportProducts(stream.Context(), req)
+	if err != nil {
+		return errors.ToGrpcError(err)
+	}
+
+	for len(file) > 0 {
+		n := min(len(file), productFileChunkSize)
+
+		if err := stream.Send(&pb.ExportProductsChunk{Data: file[:n]}); err != nil {
+			return err
+		}
+
+		file = file[n:]
+	}
+
+	return nil
+}
+
 func (s *productHandleGrpc) TrashedProduct(ctx context.Context, request *pb.FindByIdProductRequest) (*pb.ApiResponseProductDeleteAt, error) {
 	id := int(request.GetId())
 
@@ -608,3 +925,79 @@ func (s *productHandleGrpc) DeleteAllProductPermanent(ctx context.Context, _ *em
 		Message: "Successfully delete all products permanently",
 	}, nil
 }
+
+// mapProductScanResponse reports the price and stock of the variant when the
+// scanned barcode is on a variant, falling back to the product's price for a
+// variant without a price of its own.
+func mapProductScanResponse(product *db.GetProductByScanRow) *pb.ProductScanResponse {
+	res := &pb.ProductScanResponse{
+		Product: &pb.ProductResponse{
+			Id:           product.ProductID,
+			MerchantId:   product.MerchantID,
+			CategoryId:   product.CategoryID,
+			Name:         product.Name,
+			Description:  *product.Description,
+			Price:        product.Price,
+			CountInStock: product.CountInStock,
+			Brand:        *product.Brand,
+			Weight:       *product.Weight,
+			SlugProduct:  *product.SlugProduct,
+			ImageProduct: *product.ImageProduct,
+			CreatedAt:    product.CreatedAt.Time.String(),
+			UpdatedAt:    product.UpdatedAt.Time.String(),
+		},
+		Price:        product.Price,
+		CountInStock: product.CountInStock,
+	}
+
+	if product.Barcode != nil {
+		res.Product.Barcode = *product.Barcode
+	}
+
+	if product.VariantID == nil {
+		return res
+	}
+
+	// Options are stored as a JSON object written by the repository, so a
+	// decode failure would only leave the map empty.
+	options := map[string]string{}
+	_ = json.Unmarshal(product.VariantOptions, &options)
+
+	res.VariantId = int32Value(product.VariantID)
+	res.VariantSku = stringValue(product.VariantSku)
+	res.VariantOptions = options
+	res.CountInStock = *product.VariantCountInStock
+
+	if product.VariantPrice != nil {
+		res.Price = *product.VariantPrice
+	}
+
+	return res
+}
+
+func mapProductImportResponse(result *response.ProductImportResponse) *pb.ProductImportResponse {
+	rows := make([]*pb.ProductImportRowResponse, 0, len(result.Rows))
+
+	for _, row := range result.Rows {
+		var productID *wrapperspb.Int32Value
+		if row.ProductID != nil {
+			productID = wrapperspb.Int32(int32(*row.ProductID))
+		}
+
+		rows = append(rows, &pb.ProductImportRowResponse{
+			Row:       int32(row.Row),
+			Action:    row.Action,
+			ProductId: productID,
+			Errors:    row.Errors,
+		})
+	}
+
+	return &pb.ProductImportResponse{
+		DryRun:  result.DryRun,
+		Applied: result.Applied,
+		Created: int32(result.Created),
+		Updated: int32(result.Updated),
+		Failed:  int32(result.Failed),
+		Rows:    rows,
+	}
+}
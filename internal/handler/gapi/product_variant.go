@@ -0,0 +1,221 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/service"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/errors/product_variant_errors"
+)
+
+type productVariantHandleGrpc struct {
+	pb.UnimplementedProductVariantServiceServer
+	productVariantService service.ProductVariantService
+}
+
+func NewProductVariantHandleGrpc(productVariant service.ProductVariantService) *productVariantHandleGrpc {
+	return &productVariantHandleGrpc{
+		productVariantService: productVariant,
+	}
+}
+
+func (s *productVariantHandleGrpc) FindProductVariants(ctx context.Context, req *pb.FindProductVariantsRequest) (*pb.ApiResponseProductVariants, error) {
+	id := int(req.GetProductId())
+
+	if id == 0 {
+		return nil, product_variant_errors.ErrGrpcProductInvalidId
+	}
+
+	options, err := s.productVariantService.FindOptions(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	variants, err := s.productVariantService.FindByProduct(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	variantResponses := make([]*pb.ProductVariantResponse, 0, len(variants))
+	for _, variant := range variants {
+		variantResponses = append(variantResponses, mapProductVariantResponse(variant))
+	}
+
+	return &pb.ApiResponseProductVariants{
+		Status:  "success",
+		Message: "Successfully fetched product variants",
+		Data: &pb.ProductVariantsResponse{
+			ProductId: int32(id),
+			Options:   mapProductOptionResponses(options),
+			Variants:  variantResponses,
+		},
+	}, nil
+}
+
+func (s *productVariantHandleGrpc) FindByIdProductVariant(ctx context.Context, req *pb.FindByIdProductVariantRequest) (*pb.ApiResponseProductVariant, error) {
+	id := int(req.GetVariantId())
+
+	if id == 0 {
+		return nil, product_variant_errors.ErrGrpcVariantInvalidId
+	}
+
+	variant, err := s.productVariantService.FindById(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseProductVariant{
+		Status:  "success",
+		Message: "Successfully fetched product variant",
+		Data:    mapProductVariantResponse(variant),
+	}, nil
+}
+
+func (s *productVariantHandleGrpc) SetProductOptions(ctx context.Context, req *pb.SetProductOptionsRequest) (*pb.ApiResponseProductOptions, error) {
+	id := int(req.GetProductId())
+
+	if id == 0 {
+		return nil, product_variant_errors.ErrGrpcProductInvalidId
+	}
+
+	options := make([]requests.ProductOptionRequest, 0, len(req.GetOptions()))
+	for _, option := range req.GetOptions() {
+		options = append(options, requests.ProductOptionRequest{
+			Name:   option.GetName(),
+			Values: option.GetValues(),
+		})
+	}
+
+	request := &requests.SetProductOptionsRequest{
+		ProductID: id,
+		Options:   options,
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, product_variant_errors.ErrGrpcValidateSetOptions
+	}
+
+	res, err := s.productVariantService.SetOptions(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseProductOptions{
+		Status:  "success",
+		Message: "Successfully updated product options",
+		Data:    mapProductOptionResponses(res),
+	}, nil
+}
+
+func (s *productVariantHandleGrpc) CreateProductVariant(ctx context.Context, req *pb.CreateProductVariantRequest) (*pb.ApiResponseProductVariant, error) {
+	request := &requests.CreateProductVariantRequest{
+		ProductID:    int(req.GetProductId()),
+		Sku:          req.GetSku(),
+		Barcode:      stringPtr(req.GetBarcode()),
+		Options:      req.GetOptions(),
+		Price:        intPtr(req.GetPrice()),
+		CountInStock: int(req.GetCountInStock()),
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, product_variant_errors.ErrGrpcValidateCreateVariant
+	}
+
+	variant, err := s.productVariantService.CreateVariant(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseProductVariant{
+		Status:  "success",
+		Message: "Successfully created product variant",
+		Data:    mapProductVariantResponse(variant),
+	}, nil
+}
+
+func (s *productVariantHandleGrpc) UpdateProductVariant(ctx context.Context, req *pb.UpdateProductVariantRequest) (*pb.ApiResponseProductVariant, error) {
+	id := int(req.GetVariantId())
+
+	if id == 0 {
+		return nil, product_variant_errors.ErrGrpcVariantInvalidId
+	}
+
+	request := &requests.UpdateProductVariantRequest{
+		VariantID:    &id,
+		Sku:          req.GetSku(),
+		Barcode:      stringPtr(req.GetBarcode()),
+		Price:        intPtr(req.GetPrice()),
+		CountInStock: intPtr(req.GetCountInStock()),
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, product_variant_errors.ErrGrpcValidateUpdateVariant
+	}
+
+	variant, err := s.productVariantService.UpdateVariant(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseProductVariant{
+		Status:  "success",
+		Message: "Successfully updated product variant",
+		Data:    mapProductVariantResponse(variant),
+	}, nil
+}
+
+func (s *productVariantHandleGrpc) DeleteProductVariant(ctx context.Context, req *pb.FindByIdProductVariantRequest) (*pb.ApiResponseProductVariant, error) {
+	id := int(req.GetVariantId())
+
+	if id == 0 {
+		return nil, product_variant_errors.ErrGrpcVariantInvalidId
+	}
+
+	variant, err := s.productVariantService.DeleteVariant(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseProductVariant{
+		Status:  "success",
+		Message: "Successfully deleted product variant",
+		Data:    mapProductVariantResponse(variant),
+	}, nil
+}
+
+func mapProductOptionResponses(options []*db.ProductOption) []*pb.ProductOptionResponse {
+	res := make([]*pb.ProductOptionResponse, 0, len(options))
+
+	for _, option := range options {
+		res = append(res, &pb.ProductOptionResponse{
+			Id:       option.ProductOptionID,
+			Name:     option.Name,
+			Values:   option.OptionValues,
+			Position: option.Position,
+		})
+	}
+
+	return res
+}
+
+func mapProductVariantResponse(variant *db.ProductVariant) *pb.ProductVariantResponse {
+	// Options are stored as a JSON object written by the repository, so a
+	// decode failure would only leave the map empty.
+	options := map[string]string{}
+	_ = json.Unmarshal(variant.Options, &options)
+
+	return &pb.ProductVariantResponse{
+		Id:           variant.VariantID,
+		ProductId:    variant.ProductID,
+		Sku:          variant.Sku,
+		Barcode:      stringValue(variant.Barcode),
+		Options:      options,
+		Price:        int32Value(variant.Price),
+		CountInStock: variant.CountInStock,
+		CreatedAt:    variant.CreatedAt.Time.String(),
+		UpdatedAt:    variant.UpdatedAt.Time.String(),
+	}
+}
@@ -0,0 +1,306 @@
+package gapi
+
+import (
+	"context"
+	"math"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/service"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/errors/purchase_order_errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type purchaseOrderHandleGrpc struct {
+	pb.UnimplementedPurchaseOrderServiceServer
+	purchaseOrderService service.PurchaseOrderService
+}
+
+func NewPurchaseOrderHandleGrpc(purchaseOrder service.PurchaseOrderService) *purchaseOrderHandleGrpc {
+	return &purchaseOrderHandleGrpc{
+		purchaseOrderService: purchaseOrder,
+	}
+}
+
+func (s *purchaseOrderHandleGrpc) FindAllPurchaseOrder(ctx context.Context, req *pb.FindAllPurchaseOrderRequest) (*pb.ApiResponsePaginationPurchaseOrder, error) {
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	reqService := requests.FindAllPurchaseOrders{
+		Page:       page,
+		PageSize:   pageSize,
+		MerchantID: int(req.GetMerchantId()),
+		SupplierID: int(req.GetSupplierId()),
+		Status:     req.GetStatus(),
+	}
+
+	purchaseOrders, totalRecords, err := s.purchaseOrderService.FindAll(ctx, &reqService)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+
+	paginationMeta := &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(totalPages),
+		TotalRecords: int32(*totalRecords),
+	}
+
+	var purchaseOrderResponses []*pb.PurchaseOrderResponse
+	for _, purchaseOrder := range purchaseOrders {
+		purchaseOrderResponses = append(purchaseOrderResponses, mapPurchaseOrderResponse(&db.PurchaseOrder{
+			PurchaseOrderID: purchaseOrder.PurchaseOrderID,
+			MerchantID:      purchaseOrder.MerchantID,
+			SupplierID:      purchaseOrder.SupplierID,
+			Status:          purchaseOrder.Status,
+			Note:            purchaseOrder.Note,
+			CreatedBy:       purchaseOrder.CreatedBy,
+			OrderedAt:       purchaseOrder.OrderedAt,
+			ReceivedAt:      purchaseOrder.ReceivedAt,
+			CreatedAt:       purchaseOrder.CreatedAt,
+			UpdatedAt:       purchaseOrder.UpdatedAt,
+		}))
+	}
+
+	return &pb.ApiResponsePaginationPurchaseOrder{
+		Status:     "success",
+		Message:    "Successfully fetched purchase orders",
+		Data:       purchaseOrderResponses,
+		Pagination: paginationMeta,
+	}, nil
+}
+
+func (s *purchaseOrderHandleGrpc) FindByIdPurchaseOrder(ctx context.Context, req *pb.FindByIdPurchaseOrderRequest) (*pb.ApiResponsePurchaseOrderDetail, error) {
+	id := int(req.GetPurchaseOrderId())
+
+	if id == 0 {
+		return nil, purchase_order_errors.ErrGrpcPurchaseOrderInvalidId
+	}
+
+	purchaseOrder, err := s.purchaseOrderService.FindById(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	detail, err := s.purchaseOrderDetail(ctx, purchaseOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApiResponsePurchaseOrderDetail{
+		Status:  "success",
+		Message: "Successfully fetched purchase order",
+		Data:    detail,
+	}, nil
+}
+
+func (s *purchaseOrderHandleGrpc) FindPurchaseOrderReceipts(ctx context.Context, req *pb.FindByIdPurchaseOrderRequest) (*pb.ApiResponsePurchaseOrderReceipts, error) {
+	id := int(req.GetPurchaseOrderId())
+
+	if id == 0 {
+		return nil, purchase_order_errors.ErrGrpcPurchaseOrderInvalidId
+	}
+
+	receipts, err := s.purchaseOrderService.FindReceipts(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	var receiptResponses []*pb.PurchaseOrderReceiptResponse
+	for _, receipt := range receipts {
+		receiptResponses = append(receiptResponses, &pb.PurchaseOrderReceiptResponse{
+			Id:                  receipt.PurchaseOrderReceiptID,
+			PurchaseOrderItemId: receipt.PurchaseOrderItemID,
+			ProductId:           receipt.ProductID,
+			Quantity:            receipt.Quantity,
+			UnitCost:            receipt.UnitCost,
+			ReceivedBy:          int32Value(receipt.ReceivedBy),
+			Note:                stringValue(receipt.Note),
+			CreatedAt:           receipt.CreatedAt.Time.String(),
+		})
+	}
+
+	return &pb.ApiResponsePurchaseOrderReceipts{
+		Status:  "success",
+		Message: "Successfully fetched purchase order receipts",
+		Data:    receiptResponses,
+	}, nil
+}
+
+func (s *purchaseOrderHandleGrpc) CreatePurchaseOrder(ctx context.Context, req *pb.CreatePurchaseOrderRequest) (*pb.ApiResponsePurchaseOrderDetail, error) {
+	request := &requests.CreatePurchaseOrderRequest{
+		MerchantID: int(req.GetMerchantId()),
+		SupplierID: int(req.GetSupplierId()),
+		Note:       stringPtr(req.GetNote()),
+	}
+
+	for _, item := range req.GetItems() {
+		request.Items = append(request.Items, requests.CreatePurchaseOrderItemRequest{
+			ProductID: int(item.GetProductId()),
+			Quantity:  int(item.GetQuantity()),
+			UnitCost:  int(item.GetUnitCost()),
+		})
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, purchase_order_errors.ErrGrpcValidateCreatePurchaseOrder
+	}
+
+	purchaseOrder, err := s.purchaseOrderService.CreatePurchaseOrder(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	detail, err := s.purchaseOrderDetail(ctx, purchaseOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApiResponsePurchaseOrderDetail{
+		Status:  "success",
+		Message: "Successfully created purchase order",
+		Data:    detail,
+	}, nil
+}
+
+func (s *purchaseOrderHandleGrpc) SubmitPurchaseOrder(ctx context.Context, req *pb.FindByIdPurchaseOrderRequest) (*pb.ApiResponsePurchaseOrder, error) {
+	id := int(req.GetPurchaseOrderId())
+
+	if id == 0 {
+		return nil, purchase_order_errors.ErrGrpcPurchaseOrderInvalidId
+	}
+
+	purchaseOrder, err := s.purchaseOrderService.SubmitPurchaseOrder(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponsePurchaseOrder{
+		Status:  "success",
+		Message: "Successfully submitted purchase order",
+		Data:    mapPurchaseOrderResponse(purchaseOrder),
+	}, nil
+}
+
+func (s *purchaseOrderHandleGrpc) ReceivePurchaseOrder(ctx context.Context, req *pb.ReceivePurchaseOrderRequest) (*pb.ApiResponsePurchaseOrderDetail, error) {
+	id := int(req.GetPurchaseOrderId())
+
+	if id == 0 {
+		return nil, purchase_order_errors.ErrGrpcPurchaseOrderInvalidId
+	}
+
+	request := &requests.ReceivePurchaseOrderRequest{
+		PurchaseOrderID: id,
+		Note:            stringPtr(req.GetNote()),
+	}
+
+	for _, item := range req.GetItems() {
+		request.Items = append(request.Items, requests.ReceivePurchaseOrderItemRequest{
+			PurchaseOrderItemID: int(item.GetPurchaseOrderItemId()),
+			Quantity:            int(item.GetQuantity()),
+			UnitCost:            intPtr(item.GetUnitCost()),
+		})
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, purchase_order_errors.ErrGrpcValidateReceivePurchaseOrder
+	}
+
+	purchaseOrder, err := s.purchaseOrderService.ReceivePurchaseOrder(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	detail, err := s.purchaseOrderDetail(ctx, purchaseOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApiResponsePurchaseOrderDetail{
+		Status:  "success",
+		Message: "Successfully received purchase order goods",
+		Data:    detail,
+	}, nil
+}
+
+func (s *purchaseOrderHandleGrpc) CancelPurchaseOrder(ctx context.Context, req *pb.FindByIdPurchaseOrderRequest) (*pb.ApiResponsePurchaseOrder, error) {
+	id := int(req.GetPurchaseOrderId())
+
+	if id == 0 {
+		return nil, purchase_order_errors.ErrGrpcPurchaseOrderInvalidId
+	}
+
+	purchaseOrder, err := s.purchaseOrderService.CancelPurchaseOrder(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponsePurchaseOrder{
+		Status:  "success",
+		Message: "Successfully cancelled purchase order",
+		Data:    mapPurchaseOrderResponse(purchaseOrder),
+	}, nil
+}
+
+// purchaseOrderDetail loads the lines of a purchase order alongside its
+// header.
+func (s *purchaseOrderHandleGrpc) purchaseOrderDetail(ctx context.Context, purchaseOrder *db.PurchaseOrder) (*pb.PurchaseOrderDetailResponse, error) {
+	items, err := s.purchaseOrderService.FindItems(ctx, int(purchaseOrder.PurchaseOrderID))
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	var itemResponses []*pb.PurchaseOrderItemResponse
+	for _, item := range items {
+		itemResponses = append(itemResponses, &pb.PurchaseOrderItemResponse{
+			Id:               item.PurchaseOrderItemID,
+			PurchaseOrderId:  item.PurchaseOrderID,
+			ProductId:        item.ProductID,
+			QuantityOrdered:  item.QuantityOrdered,
+			QuantityReceived: item.QuantityReceived,
+			UnitCost:         item.UnitCost,
+			CreatedAt:        item.CreatedAt.Time.String(),
+			UpdatedAt:        item.UpdatedAt.Time.String(),
+		})
+	}
+
+	return &pb.PurchaseOrderDetailResponse{
+		PurchaseOrder: mapPurchaseOrderResponse(purchaseOrder),
+		Items:         itemResponses,
+	}, nil
+}
+
+func mapPurchaseOrderResponse(purchaseOrder *db.PurchaseOrder) *pb.PurchaseOrderResponse {
+	return &pb.PurchaseOrderResponse{
+		Id:         purchaseOrder.PurchaseOrderID,
+		MerchantId: purchaseOrder.MerchantID,
+		SupplierId: purchaseOrder.SupplierID,
+		Status:     purchaseOrder.Status,
+		Note:       stringValue(purchaseOrder.Note),
+		CreatedBy:  int32Value(purchaseOrder.CreatedBy),
+		OrderedAt:  timestampValue(purchaseOrder.OrderedAt),
+		ReceivedAt: timestampValue(purchaseOrder.ReceivedAt),
+		CreatedAt:  purchaseOrder.CreatedAt.Time.String(),
+		UpdatedAt:  purchaseOrder.UpdatedAt.Time.String(),
+	}
+}
+
+func timestampValue(v pgtype.Timestamp) *wrapperspb.StringValue {
+	if !v.Valid {
+		return nil
+	}
+
+	return wrapperspb.String(v.Time.String())
+}
@@ -6,6 +6,7 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/pb"
 	"pointofsale/internal/service"
+	"pointofsale/pkg/auth"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/errors/role_errors"
 
@@ -104,6 +105,10 @@ func (s *roleHandleGrpc) FindByUserId(ctx context.Context, req *pb.FindByIdUserR
 		return nil, role_errors.ErrGrpcRoleInvalidId
 	}
 
+	if callerID, ok := auth.UserIDFromContext(ctx); ok && callerID != id && !auth.HasAnyRole(auth.RolesFromContext(ctx), auth.RoleAdmin) {
+		return nil, role_errors.ErrGrpcRoleForbidden
+	}
+
 	roles, err := s.roleService.FindByUserId(ctx, id)
 	if err != nil {
 		return nil, errors.ToGrpcError(err)
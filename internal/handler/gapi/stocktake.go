@@ -0,0 +1,325 @@
+package gapi
+
+import (
+	"context"
+	"math"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/service"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/errors/stocktake_errors"
+)
+
+type stocktakeHandleGrpc struct {
+	pb.UnimplementedStocktakeServiceServer
+	stocktakeService service.StocktakeService
+}
+
+func NewStocktakeHandleGrpc(stocktake service.StocktakeService) *stocktakeHandleGrpc {
+	return &stocktakeHandleGrpc{
+		stocktakeService: stocktake,
+	}
+}
+
+func (s *stocktakeHandleGrpc) FindAllStocktake(ctx context.Context, req *pb.FindAllStocktakeRequest) (*pb.ApiResponsePaginationStocktake, error) {
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	reqService := requests.FindAllStocktakes{
+		Page:       page,
+		PageSize:   pageSize,
+		MerchantID: int(req.GetMerchantId()),
+		Status:     req.GetStatus(),
+	}
+
+	stocktakes, totalRecords, err := s.stocktakeService.FindAll(ctx, &reqService)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+
+	paginationMeta := &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(totalPages),
+		TotalRecords: int32(*totalRecords),
+	}
+
+	var stocktakeResponses []*pb.StocktakeResponse
+	for _, stocktake := range stocktakes {
+		stocktakeResponses = append(stocktakeResponses, mapStocktakeResponse(&db.Stocktake{
+			StocktakeID: stocktake.StocktakeID,
+			MerchantID:  stocktake.MerchantID,
+			CategoryID:  stocktake.CategoryID,
+			Status:      stocktake.Status,
+			Note:        stocktake.Note,
+			CreatedBy:   stocktake.CreatedBy,
+			PostedBy:    stocktake.PostedBy,
+			PostedAt:    stocktake.PostedAt,
+			CreatedAt:   stocktake.CreatedAt,
+			UpdatedAt:   stocktake.UpdatedAt,
+		}))
+	}
+
+	return &pb.ApiResponsePaginationStocktake{
+		Status:     "success",
+		Message:    "Successfully fetched stocktakes",
+		Data:       stocktakeResponses,
+		Pagination: paginationMeta,
+	}, nil
+}
+
+func (s *stocktakeHandleGrpc) FindByIdStocktake(ctx context.Context, req *pb.FindByIdStocktakeRequest) (*pb.ApiResponseStocktake, error) {
+	id := int(req.GetStocktakeId())
+
+	if id == 0 {
+		return nil, stocktake_errors.ErrGrpcStocktakeInvalidId
+	}
+
+	stocktake, err := s.stocktakeService.FindById(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseStocktake{
+		Status:  "success",
+		Message: "Successfully fetched stocktake",
+		Data:    mapStocktakeResponse(stocktake),
+	}, nil
+}
+
+func (s *stocktakeHandleGrpc) FindStocktakeItems(ctx context.Context, req *pb.FindStocktakeItemsRequest) (*pb.ApiResponsePaginationStocktakeItem, error) {
+	id := int(req.GetStocktakeId())
+
+	if id == 0 {
+		return nil, stocktake_errors.ErrGrpcStocktakeInvalidId
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	items, totalRecords, err := s.stocktakeService.FindItems(ctx, &requests.FindStocktakeItems{
+		StocktakeID: id,
+		Page:        page,
+		PageSize:    pageSize,
+	})
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+
+	paginationMeta := &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(totalPages),
+		TotalRecords: int32(*totalRecords),
+	}
+
+	var itemResponses []*pb.StocktakeItemResponse
+	for _, item := range items {
+		itemResponses = append(itemResponses, &pb.StocktakeItemResponse{
+			Id:               item.StocktakeItemID,
+			StocktakeId:      item.StocktakeID,
+			ProductId:        item.ProductID,
+			Name:             item.Name,
+			Barcode:          stringValue(item.Barcode),
+			CategoryId:       item.CategoryID,
+			ExpectedQuantity: item.ExpectedQuantity,
+			CountedQuantity:  int32Value(item.CountedQuantity),
+			UnitPrice:        item.UnitPrice,
+			Variance:         item.Variance,
+			VarianceValue:    item.VarianceValue,
+			CountedBy:        int32Value(item.CountedBy),
+			CountedAt:        timestampValue(item.CountedAt),
+		})
+	}
+
+	return &pb.ApiResponsePaginationStocktakeItem{
+		Status:     "success",
+		Message:    "Successfully fetched stocktake items",
+		Data:       itemResponses,
+		Pagination: paginationMeta,
+	}, nil
+}
+
+func (s *stocktakeHandleGrpc) FindStocktakeVarianceReport(ctx context.Context, req *pb.FindByIdStocktakeRequest) (*pb.ApiResponseStocktakeVarianceReport, error) {
+	id := int(req.GetStocktakeId())
+
+	if id == 0 {
+		return nil, stocktake_errors.ErrGrpcStocktakeInvalidId
+	}
+
+	categories, err := s.stocktakeService.FindVarianceReport(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	report := &pb.StocktakeVarianceReportResponse{
+		StocktakeId: int32(id),
+	}
+
+	for _, category := range categories {
+		report.Categories = append(report.Categories, &pb.StocktakeCategoryVarianceResponse{
+			CategoryId:       category.CategoryID,
+			CategoryName:     category.CategoryName,
+			ItemCount:        category.ItemCount,
+			CountedCount:     category.CountedCount,
+			ExpectedQuantity: category.ExpectedQuantity,
+			CountedQuantity:  category.CountedQuantity,
+			VarianceQuantity: category.VarianceQuantity,
+			VarianceValue:    category.VarianceValue,
+		})
+
+		report.ExpectedQuantity += category.ExpectedQuantity
+		report.CountedQuantity += category.CountedQuantity
+		report.VarianceQuantity += category.VarianceQuantity
+		report.VarianceValue += category.VarianceValue
+	}
+
+	return &pb.ApiResponseStocktakeVarianceReport{
+		Status:  "success",
+		Message: "Successfully built stocktake variance report",
+		Data:    report,
+	}, nil
+}
+
+func (s *stocktakeHandleGrpc) CreateStocktake(ctx context.Context, req *pb.CreateStocktakeRequest) (*pb.ApiResponseStocktake, error) {
+	request := &requests.CreateStocktakeRequest{
+		MerchantID: int(req.GetMerchantId()),
+		CategoryID: intPtr(req.GetCategoryId()),
+		Note:       stringPtr(req.GetNote()),
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, stocktake_errors.ErrGrpcValidateCreateStocktake
+	}
+
+	stocktake, err := s.stocktakeService.CreateStocktake(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseStocktake{
+		Status:  "success",
+		Message: "Successfully opened stocktake",
+		Data:    mapStocktakeResponse(stocktake),
+	}, nil
+}
+
+func (s *stocktakeHandleGrpc) SubmitStocktakeCounts(ctx context.Context, req *pb.SubmitStocktakeCountsRequest) (*pb.ApiResponseStocktakeCounts, error) {
+	id := int(req.GetStocktakeId())
+
+	if id == 0 {
+		return nil, stocktake_errors.ErrGrpcStocktakeInvalidId
+	}
+
+	request := &requests.SubmitStocktakeCountsRequest{
+		StocktakeID: id,
+	}
+
+	for _, item := range req.GetItems() {
+		request.Items = append(request.Items, requests.SubmitStocktakeCountRequest{
+			ProductID: intPtr(item.GetProductId()),
+			Barcode:   stringPtr(item.GetBarcode()),
+			Quantity:  int(item.GetQuantity()),
+		})
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, stocktake_errors.ErrGrpcValidateSubmitCounts
+	}
+
+	items, err := s.stocktakeService.SubmitCounts(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	var countResponses []*pb.StocktakeCountResponse
+	for _, item := range items {
+		countResponses = append(countResponses, &pb.StocktakeCountResponse{
+			Id:               item.StocktakeItemID,
+			StocktakeId:      item.StocktakeID,
+			ProductId:        item.ProductID,
+			ExpectedQuantity: item.ExpectedQuantity,
+			CountedQuantity:  *item.CountedQuantity,
+			Variance:         *item.CountedQuantity - item.ExpectedQuantity,
+			CountedAt:        timestampValue(item.CountedAt),
+		})
+	}
+
+	return &pb.ApiResponseStocktakeCounts{
+		Status:  "success",
+		Message: "Successfully recorded stocktake counts",
+		Data:    countResponses,
+	}, nil
+}
+
+func (s *stocktakeHandleGrpc) PostStocktake(ctx context.Context, req *pb.FindByIdStocktakeRequest) (*pb.ApiResponseStocktake, error) {
+	id := int(req.GetStocktakeId())
+
+	if id == 0 {
+		return nil, stocktake_errors.ErrGrpcStocktakeInvalidId
+	}
+
+	stocktake, err := s.stocktakeService.PostStocktake(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseStocktake{
+		Status:  "success",
+		Message: "Successfully posted stocktake",
+		Data:    mapStocktakeResponse(stocktake),
+	}, nil
+}
+
+func (s *stocktakeHandleGrpc) CancelStocktake(ctx context.Context, req *pb.FindByIdStocktakeRequest) (*pb.ApiResponseStocktake, error) {
+	id := int(req.GetStocktakeId())
+
+	if id == 0 {
+		return nil, stocktake_errors.ErrGrpcStocktakeInvalidId
+	}
+
+	stocktake, err := s.stocktakeService.CancelStocktake(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseStocktake{
+		Status:  "success",
+		Message: "Successfully cancelled stocktake",
+		Data:    mapStocktakeResponse(stocktake),
+	}, nil
+}
+
+func mapStocktakeResponse(stocktake *db.Stocktake) *pb.StocktakeResponse {
+	return &pb.StocktakeResponse{
+		Id:         stocktake.StocktakeID,
+		MerchantId: stocktake.MerchantID,
+		CategoryId: int32Value(stocktake.CategoryID),
+		Status:     stocktake.Status,
+		Note:       stringValue(stocktake.Note),
+		CreatedBy:  int32Value(stocktake.CreatedBy),
+		PostedBy:   int32Value(stocktake.PostedBy),
+		PostedAt:   timestampValue(stocktake.PostedAt),
+		CreatedAt:  stocktake.CreatedAt.Time.String(),
+		UpdatedAt:  stocktake.UpdatedAt.Time.String(),
+	}
+}
@@ -0,0 +1,266 @@
+package gapi
+
+import (
+	"context"
+	"math"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/service"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/errors/supplier_errors"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type supplierHandleGrpc struct {
+	pb.UnimplementedSupplierServiceServer
+	supplierService service.SupplierService
+}
+
+func NewSupplierHandleGrpc(supplier service.SupplierService) *supplierHandleGrpc {
+	return &supplierHandleGrpc{
+		supplierService: supplier,
+	}
+}
+
+func (s *supplierHandleGrpc) FindAllSupplier(ctx context.Context, req *pb.FindAllSupplierRequest) (*pb.ApiResponsePaginationSupplier, error) {
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	search := req.GetSearch()
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	reqService := requests.FindAllSuppliers{
+		Page:       page,
+		PageSize:   pageSize,
+		Search:     search,
+		MerchantID: int(req.GetMerchantId()),
+	}
+
+	suppliers, totalRecords, err := s.supplierService.FindAll(ctx, &reqService)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+
+	paginationMeta := &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(totalPages),
+		TotalRecords: int32(*totalRecords),
+	}
+
+	var supplierResponses []*pb.SupplierResponse
+	for _, supplier := range suppliers {
+		supplierResponses = append(supplierResponses, &pb.SupplierResponse{
+			Id:          supplier.SupplierID,
+			MerchantId:  supplier.MerchantID,
+			Name:        supplier.Name,
+			ContactName: stringValue(supplier.ContactName),
+			Email:       stringValue(supplier.Email),
+			Phone:       stringValue(supplier.Phone),
+			Address:     stringValue(supplier.Address),
+			CreatedAt:   supplier.CreatedAt.Time.String(),
+			UpdatedAt:   supplier.UpdatedAt.Time.String(),
+		})
+	}
+
+	return &pb.ApiResponsePaginationSupplier{
+		Status:     "success",
+		Message:    "Successfully fetched suppliers",
+		Data:       supplierResponses,
+		Pagination: paginationMeta,
+	}, nil
+}
+
+func (s *supplierHandleGrpc) FindByIdSupplier(ctx context.Context, req *pb.FindByIdSupplierRequest) (*pb.ApiResponseSupplier, error) {
+	id := int(req.GetSupplierId())
+
+	if id == 0 {
+		return nil, supplier_errors.ErrGrpcSupplierInvalidId
+	}
+
+	supplier, err := s.supplierService.FindById(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseSupplier{
+		Status:  "success",
+		Message: "Successfully fetched supplier",
+		Data:    mapSupplierResponse(supplier),
+	}, nil
+}
+
+func (s *supplierHandleGrpc) CreateSupplier(ctx context.Context, req *pb.CreateSupplierRequest) (*pb.ApiResponseSupplier, error) {
+	request := &requests.CreateSupplierRequest{
+		MerchantID:  int(req.GetMerchantId()),
+		Name:        req.GetName(),
+		ContactName: stringPtr(req.GetContactName()),
+		Email:       stringPtr(req.GetEmail()),
+		Phone:       stringPtr(req.GetPhone()),
+		Address:     stringPtr(req.GetAddress()),
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, supplier_errors.ErrGrpcValidateCreateSupplier
+	}
+
+	supplier, err := s.supplierService.CreateSupplier(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseSupplier{
+		Status:  "success",
+		Message: "Successfully created supplier",
+		Data:    mapSupplierResponse(supplier),
+	}, nil
+}
+
+func (s *supplierHandleGrpc) UpdateSupplier(ctx context.Context, req *pb.UpdateSupplierRequest) (*pb.ApiResponseSupplier, error) {
+	id := int(req.GetSupplierId())
+
+	if id == 0 {
+		return nil, supplier_errors.ErrGrpcSupplierInvalidId
+	}
+
+	request := &requests.UpdateSupplierRequest{
+		SupplierID:  &id,
+		Name:        req.GetName(),
+		ContactName: stringPtr(req.GetContactName()),
+		Email:       stringPtr(req.GetEmail()),
+		Phone:       stringPtr(req.GetPhone()),
+		Address:     stringPtr(req.GetAddress()),
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, supplier_errors.ErrGrpcValidateUpdateSupplier
+	}
+
+	supplier, err := s.supplierService.UpdateSupplier(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseSupplier{
+		Status:  "success",
+		Message: "Successfully updated supplier",
+		Data:    mapSupplierResponse(supplier),
+	}, nil
+}
+
+func (s *supplierHandleGrpc) TrashedSupplier(ctx context.Context, req *pb.FindByIdSupplierRequest) (*pb.ApiResponseSupplierDeleteAt, error) {
+	id := int(req.GetSupplierId())
+
+	if id == 0 {
+		return nil, supplier_errors.ErrGrpcSupplierInvalidId
+	}
+
+	supplier, err := s.supplierService.TrashedSupplier(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseSupplierDeleteAt{
+		Status:  "success",
+		Message: "Successfully trashed supplier",
+		Data:    mapSupplierResponseDeleteAt(supplier),
+	}, nil
+}
+
+func (s *supplierHandleGrpc) RestoreSupplier(ctx context.Context, req *pb.FindByIdSupplierRequest) (*pb.ApiResponseSupplierDeleteAt, error) {
+	id := int(req.GetSupplierId())
+
+	if id == 0 {
+		return nil, supplier_errors.ErrGrpcSupplierInvalidId
+	}
+
+	supplier, err := s.supplierService.RestoreSupplier(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseSupplierDeleteAt{
+		Status:  "success",
+		Message: "Successfully restored supplier",
+		Data:    mapSupplierResponseDeleteAt(supplier),
+	}, nil
+}
+
+func (s *supplierHandleGrpc) DeleteSupplierPermanent(ctx context.Context, req *pb.FindByIdSupplierRequest) (*pb.ApiResponseSupplierDelete, error) {
+	id := int(req.GetSupplierId())
+
+	if id == 0 {
+		return nil, supplier_errors.ErrGrpcSupplierInvalidId
+	}
+
+	_, err := s.supplierService.DeleteSupplierPermanent(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseSupplierDelete{
+		Status:  "success",
+		Message: "Successfully deleted supplier permanently",
+	}, nil
+}
+
+func mapSupplierResponse(supplier *db.Supplier) *pb.SupplierResponse {
+	return &pb.SupplierResponse{
+		Id:          supplier.SupplierID,
+		MerchantId:  supplier.MerchantID,
+		Name:        supplier.Name,
+		ContactName: stringValue(supplier.ContactName),
+		Email:       stringValue(supplier.Email),
+		Phone:       stringValue(supplier.Phone),
+		Address:     stringValue(supplier.Address),
+		CreatedAt:   supplier.CreatedAt.Time.String(),
+		UpdatedAt:   supplier.UpdatedAt.Time.String(),
+	}
+}
+
+func mapSupplierResponseDeleteAt(supplier *db.Supplier) *pb.SupplierResponseDeleteAt {
+	var deletedAt *wrapperspb.StringValue
+	if supplier.DeletedAt.Valid {
+		deletedAt = wrapperspb.String(supplier.DeletedAt.Time.String())
+	}
+
+	return &pb.SupplierResponseDeleteAt{
+		Id:          supplier.SupplierID,
+		MerchantId:  supplier.MerchantID,
+		Name:        supplier.Name,
+		ContactName: stringValue(supplier.ContactName),
+		Email:       stringValue(supplier.Email),
+		Phone:       stringValue(supplier.Phone),
+		Address:     stringValue(supplier.Address),
+		CreatedAt:   supplier.CreatedAt.Time.String(),
+		UpdatedAt:   supplier.UpdatedAt.Time.String(),
+		DeletedAt:   deletedAt,
+	}
+}
+
+func stringValue(v *string) *wrapperspb.StringValue {
+	if v == nil {
+		return nil
+	}
+
+	return wrapperspb.String(*v)
+}
+
+func stringPtr(v *wrapperspb.StringValue) *string {
+	if v == nil {
+		return nil
+	}
+
+	s := v.GetValue()
+
+	return &s
+}
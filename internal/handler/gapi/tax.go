@@ -0,0 +1,300 @@
+package gapi
+
+import (
+	"context"
+	"math"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/service"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/errors/tax_errors"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type taxHandleGrpc struct {
+	pb.UnimplementedTaxServiceServer
+	taxService service.TaxService
+}
+
+func NewTaxHandleGrpc(tax service.TaxService) *taxHandleGrpc {
+	return &taxHandleGrpc{
+		taxService: tax,
+	}
+}
+
+func (s *taxHandleGrpc) FindAllTaxRate(ctx context.Context, req *pb.FindAllTaxRateRequest) (*pb.ApiResponsePaginationTaxRate, error) {
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	search := req.GetSearch()
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	reqService := requests.FindAllTaxRates{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	rates, totalRecords, err := s.taxService.FindAll(ctx, &reqService)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+
+	paginationMeta := &pb.PaginationMeta{
+		CurrentPage:  int32(page),
+		PageSize:     int32(pageSize),
+		TotalPages:   int32(totalPages),
+		TotalRecords: int32(*totalRecords),
+	}
+
+	var rateResponses []*pb.TaxRateResponse
+	for _, rate := range rates {
+		rateResponses = append(rateResponses, &pb.TaxRateResponse{
+			Id:          rate.TaxRateID,
+			Name:        rate.Name,
+			MerchantId:  int32Value(rate.MerchantID),
+			CategoryId:  int32Value(rate.CategoryID),
+			RateBps:     rate.RateBps,
+			IsInclusive: rate.IsInclusive,
+			Rounding:    rate.Rounding,
+			CreatedAt:   rate.CreatedAt.Time.String(),
+			UpdatedAt:   rate.UpdatedAt.Time.String(),
+		})
+	}
+
+	return &pb.ApiResponsePaginationTaxRate{
+		Status:     "success",
+		Message:    "Successfully fetched tax rates",
+		Data:       rateResponses,
+		Pagination: paginationMeta,
+	}, nil
+}
+
+func (s *taxHandleGrpc) FindByIdTaxRate(ctx context.Context, req *pb.FindByIdTaxRateRequest) (*pb.ApiResponseTaxRate, error) {
+	id := int(req.GetTaxRateId())
+
+	if id == 0 {
+		return nil, tax_errors.ErrGrpcTaxRateInvalidId
+	}
+
+	rate, err := s.taxService.FindById(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTaxRate{
+		Status:  "success",
+		Message: "Successfully fetched tax rate",
+		Data:    mapTaxRateResponse(rate),
+	}, nil
+}
+
+func (s *taxHandleGrpc) FindTransactionTax(ctx context.Context, req *pb.FindTransactionTaxRequest) (*pb.ApiResponseTransactionTax, error) {
+	id := int(req.GetTransactionId())
+
+	if id == 0 {
+		return nil, tax_errors.ErrGrpcTransactionInvalidId
+	}
+
+	lines, err := s.taxService.FindTransactionTax(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	var lineResponses []*pb.TaxLineResponse
+	for _, line := range lines {
+		lineResponses = append(lineResponses, &pb.TaxLineResponse{
+			OrderItemId:  line.OrderItemID,
+			ProductId:    line.ProductID,
+			Quantity:     line.Quantity,
+			Price:        line.Price,
+			TaxRateId:    int32Value(line.TaxRateID),
+			TaxRateBps:   line.TaxRateBps,
+			TaxInclusive: line.TaxInclusive,
+			TaxRounding:  line.TaxRounding,
+			TaxAmount:    line.TaxAmount,
+		})
+	}
+
+	return &pb.ApiResponseTransactionTax{
+		Status:  "success",
+		Message: "Successfully fetched transaction tax breakdown",
+		Data:    lineResponses,
+	}, nil
+}
+
+func (s *taxHandleGrpc) CreateTaxRate(ctx context.Context, req *pb.CreateTaxRateRequest) (*pb.ApiResponseTaxRate, error) {
+	request := &requests.CreateTaxRateRequest{
+		Name:        req.GetName(),
+		MerchantID:  intPtr(req.GetMerchantId()),
+		CategoryID:  intPtr(req.GetCategoryId()),
+		RateBps:     int(req.GetRateBps()),
+		IsInclusive: req.GetIsInclusive(),
+		Rounding:    req.GetRounding(),
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, tax_errors.ErrGrpcValidateCreateTaxRate
+	}
+
+	rate, err := s.taxService.CreateTaxRate(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTaxRate{
+		Status:  "success",
+		Message: "Successfully created tax rate",
+		Data:    mapTaxRateResponse(rate),
+	}, nil
+}
+
+func (s *taxHandleGrpc) UpdateTaxRate(ctx context.Context, req *pb.UpdateTaxRateRequest) (*pb.ApiResponseTaxRate, error) {
+	id := int(req.GetTaxRateId())
+
+	if id == 0 {
+		return nil, tax_errors.ErrGrpcTaxRateInvalidId
+	}
+
+	request := &requests.UpdateTaxRateRequest{
+		TaxRateID:   &id,
+		Name:        req.GetName(),
+		MerchantID:  intPtr(req.GetMerchantId()),
+		CategoryID:  intPtr(req.GetCategoryId()),
+		RateBps:     int(req.GetRateBps()),
+		IsInclusive: req.GetIsInclusive(),
+		Rounding:    req.GetRounding(),
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, tax_errors.ErrGrpcValidateUpdateTaxRate
+	}
+
+	rate, err := s.taxService.UpdateTaxRate(ctx, request)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTaxRate{
+		Status:  "success",
+		Message: "Successfully updated tax rate",
+		Data:    mapTaxRateResponse(rate),
+	}, nil
+}
+
+func (s *taxHandleGrpc) TrashedTaxRate(ctx context.Context, req *pb.FindByIdTaxRateRequest) (*pb.ApiResponseTaxRateDeleteAt, error) {
+	id := int(req.GetTaxRateId())
+
+	if id == 0 {
+		return nil, tax_errors.ErrGrpcTaxRateInvalidId
+	}
+
+	rate, err := s.taxService.TrashedTaxRate(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTaxRateDeleteAt{
+		Status:  "success",
+		Message: "Successfully trashed tax rate",
+		Data:    mapTaxRateResponseDeleteAt(rate),
+	}, nil
+}
+
+func (s *taxHandleGrpc) RestoreTaxRate(ctx context.Context, req *pb.FindByIdTaxRateRequest) (*pb.ApiResponseTaxRateDeleteAt, error) {
+	id := int(req.GetTaxRateId())
+
+	if id == 0 {
+		return nil, tax_errors.ErrGrpcTaxRateInvalidId
+	}
+
+	rate, err := s.taxService.RestoreTaxRate(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTaxRateDeleteAt{
+		Status:  "success",
+		Message: "Successfully restored tax rate",
+		Data:    mapTaxRateResponseDeleteAt(rate),
+	}, nil
+}
+
+func (s *taxHandleGrpc) DeleteTaxRatePermanent(ctx context.Context, req *pb.FindByIdTaxRateRequest) (*pb.ApiResponseTaxRateDelete, error) {
+	id := int(req.GetTaxRateId())
+
+	if id == 0 {
+		return nil, tax_errors.ErrGrpcTaxRateInvalidId
+	}
+
+	_, err := s.taxService.DeleteTaxRatePermanent(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTaxRateDelete{
+		Status:  "success",
+		Message: "Successfully deleted tax rate permanently",
+	}, nil
+}
+
+func mapTaxRateResponse(rate *db.TaxRate) *pb.TaxRateResponse {
+	return &pb.TaxRateResponse{
+		Id:          rate.TaxRateID,
+		Name:        rate.Name,
+		MerchantId:  int32Value(rate.MerchantID),
+		CategoryId:  int32Value(rate.CategoryID),
+		RateBps:     rate.RateBps,
+		IsInclusive: rate.IsInclusive,
+		Rounding:    rate.Rounding,
+		CreatedAt:   rate.CreatedAt.Time.String(),
+		UpdatedAt:   rate.UpdatedAt.Time.String(),
+	}
+}
+
+func mapTaxRateResponseDeleteAt(rate *db.TaxRate) *pb.TaxRateResponseDeleteAt {
+	var deletedAt *wrapperspb.StringValue
+	if rate.DeletedAt.Valid {
+		deletedAt = wrapperspb.String(rate.DeletedAt.Time.String())
+	}
+
+	return &pb.TaxRateResponseDeleteAt{
+		Id:          rate.TaxRateID,
+		Name:        rate.Name,
+		MerchantId:  int32Value(rate.MerchantID),
+		CategoryId:  int32Value(rate.CategoryID),
+		RateBps:     rate.RateBps,
+		IsInclusive: rate.IsInclusive,
+		Rounding:    rate.Rounding,
+		CreatedAt:   rate.CreatedAt.Time.String(),
+		UpdatedAt:   rate.UpdatedAt.Time.String(),
+		DeletedAt:   deletedAt,
+	}
+}
+
+func int32Value(v *int32) *wrapperspb.Int32Value {
+	if v == nil {
+		return nil
+	}
+
+	return wrapperspb.Int32(*v)
+}
+
+func intPtr(v *wrapperspb.Int32Value) *int {
+	if v == nil {
+		return nil
+	}
+
+	n := int(v.GetValue())
+
+	return &n
+}
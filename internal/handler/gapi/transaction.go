@@ -6,6 +6,8 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/pb"
 	"pointofsale/internal/service"
+	"pointofsale/pkg/auth"
+	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/errors/transaction_errors"
 
@@ -171,6 +173,7 @@ func (s *transactionHandleGrpc) FindMonthStatusSuccess(ctx context.Context, requ
 			Month:        amount.Month,
 			TotalSuccess: int32(amount.TotalSuccess),
 			TotalAmount:  int32(amount.TotalAmount),
+			TotalTax:     amount.TotalTax,
 		})
 	}
 
@@ -199,6 +202,7 @@ func (s *transactionHandleGrpc) FindYearStatusSuccess(ctx context.Context, reque
 			Year:         amount.Year,
 			TotalSuccess: int32(amount.TotalSuccess),
 			TotalAmount:  int32(amount.TotalAmount),
+			TotalTax:     amount.TotalTax,
 		})
 	}
 
@@ -311,6 +315,7 @@ func (s *transactionHandleGrpc) FindMonthStatusSuccessByMerchant(ctx context.Con
 			Month:        amount.Month,
 			TotalSuccess: int32(amount.TotalSuccess),
 			TotalAmount:  int32(amount.TotalAmount),
+			TotalTax:     amount.TotalTax,
 		})
 	}
 
@@ -349,6 +354,7 @@ func (s *transactionHandleGrpc) FindYearStatusSuccessByMerchant(ctx context.Cont
 			Year:         amount.Year,
 			TotalSuccess: int32(amount.TotalSuccess),
 			TotalAmount:  int32(amount.TotalAmount),
+			TotalTax:     amount.TotalTax,
 		})
 	}
 
@@ -775,6 +781,67 @@ func (s *transactionHandleGrpc) FindById(ctx context.Context, request *pb.FindBy
 	}, nil
 }
 
+func (s *transactionHandleGrpc) FindPayments(ctx context.Context, request *pb.FindByIdTransactionRequest) (*pb.ApiResponseTransactionPayments, error) {
+	id := int(request.GetId())
+
+	if id == 0 {
+		return nil, transaction_errors.ErrGrpcInvalidID
+	}
+
+	payments, err := s.transactionService.FindPayments(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	var paymentResponses []*pb.TransactionPaymentResponse
+	for _, payment := range payments {
+		var reference string
+		if payment.Reference != nil {
+			reference = *payment.Reference
+		}
+
+		paymentResponses = append(paymentResponses, &pb.TransactionPaymentResponse{
+			Id:            payment.TransactionPaymentID,
+			TransactionId: payment.TransactionID,
+			PaymentMethod: payment.PaymentMethod,
+			Amount:        payment.Amount,
+			ChangeAmount:  payment.ChangeAmount,
+			Reference:     reference,
+			CreatedAt:     payment.CreatedAt.Time.String(),
+		})
+	}
+
+	return &pb.ApiResponseTransactionPayments{
+		Status:  "success",
+		Message: "Successfully fetched transaction payments",
+		Data:    paymentResponses,
+	}, nil
+}
+
+func (s *transactionHandleGrpc) FindRefunds(ctx context.Context, request *pb.FindByIdTransactionRequest) (*pb.ApiResponseTransactionRefunds, error) {
+	id := int(request.GetId())
+
+	if id == 0 {
+		return nil, transaction_errors.ErrGrpcInvalidID
+	}
+
+	refunds, err := s.transactionService.FindRefunds(ctx, id)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	var refundResponses []*pb.TransactionRefundResponse
+	for _, refund := range refunds {
+		refundResponses = append(refundResponses, mapTransactionRefundResponse(refund))
+	}
+
+	return &pb.ApiResponseTransactionRefunds{
+		Status:  "success",
+		Message: "Successfully fetched transaction refunds",
+		Data:    refundResponses,
+	}, nil
+}
+
 func (s *transactionHandleGrpc) FindByActive(ctx context.Context, request *pb.FindAllTransactionRequest) (*pb.ApiResponsePaginationTransactionDeleteAt, error) {
 	page := int(request.GetPage())
 	pageSize := int(request.GetPageSize())
@@ -903,6 +970,7 @@ func (s *transactionHandleGrpc) Create(ctx context.Context, request *pb.CreateTr
 		CashierID:     int(request.GetCashierId()),
 		PaymentMethod: request.GetPaymentMethod(),
 		Amount:        int(request.GetAmount()),
+		Payments:      mapTransactionPaymentRequests(request.GetPayments()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -931,7 +999,7 @@ func (s *transactionHandleGrpc) Create(ctx context.Context, request *pb.CreateTr
 	}, nil
 }
 
-func (s *transactionHandleGrpc) UpdateTransaction(ctx context.Context, request *pb.UpdateTransactionRequest) (*pb.ApiResponseTransaction, error) {
+func (s *transactionHandleGrpc) Update(ctx context.Context, request *pb.UpdateTransactionRequest) (*pb.ApiResponseTransaction, error) {
 	id := int(request.GetTransactionId())
 
 	if id == 0 {
@@ -944,6 +1012,7 @@ func (s *transactionHandleGrpc) UpdateTransaction(ctx context.Context, request *
 		CashierID:     int(request.GetCashierId()),
 		PaymentMethod: request.GetPaymentMethod(),
 		Amount:        int(request.GetAmount()),
+		Payments:      mapTransactionPaymentRequests(request.GetPayments()),
 	}
 
 	if err := req.Validate(); err != nil {
@@ -972,6 +1041,93 @@ func (s *transactionHandleGrpc) UpdateTransaction(ctx context.Context, request *
 	}, nil
 }
 
+func (s *transactionHandleGrpc) RefundTransaction(ctx context.Context, request *pb.RefundTransactionRequest) (*pb.ApiResponseTransactionRefund, error) {
+	id := int(request.GetTransactionId())
+
+	if id == 0 {
+		return nil, transaction_errors.ErrGrpcInvalidID
+	}
+
+	approvedBy, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, transaction_errors.ErrGrpcMissingApprover
+	}
+
+	req := &requests.RefundTransactionRequest{
+		TransactionID: id,
+		ReasonCode:    request.GetReasonCode(),
+		ApprovedBy:    approvedBy,
+		Restock:       request.GetRestock(),
+	}
+
+	if request.GetNote() != nil {
+		note := request.GetNote().GetValue()
+		req.Note = &note
+	}
+
+	for _, item := range request.GetItems() {
+		req.Items = append(req.Items, requests.RefundItemRequest{
+			OrderItemID: int(item.GetOrderItemId()),
+			Quantity:    int(item.GetQuantity()),
+		})
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, transaction_errors.ErrGrpcValidateRefundTransaction
+	}
+
+	refund, err := s.transactionService.RefundTransaction(ctx, req)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTransactionRefund{
+		Status:  "success",
+		Message: "Successfully refunded transaction",
+		Data:    mapTransactionRefundResponse(refund),
+	}, nil
+}
+
+func (s *transactionHandleGrpc) VoidTransaction(ctx context.Context, request *pb.VoidTransactionRequest) (*pb.ApiResponseTransactionRefund, error) {
+	id := int(request.GetTransactionId())
+
+	if id == 0 {
+		return nil, transaction_errors.ErrGrpcInvalidID
+	}
+
+	approvedBy, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, transaction_errors.ErrGrpcMissingApprover
+	}
+
+	req := &requests.VoidTransactionRequest{
+		TransactionID: id,
+		ReasonCode:    request.GetReasonCode(),
+		ApprovedBy:    approvedBy,
+		Restock:       request.GetRestock(),
+	}
+
+	if request.GetNote() != nil {
+		note := request.GetNote().GetValue()
+		req.Note = &note
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, transaction_errors.ErrGrpcValidateVoidTransaction
+	}
+
+	void, err := s.transactionService.VoidTransaction(ctx, req)
+	if err != nil {
+		return nil, errors.ToGrpcError(err)
+	}
+
+	return &pb.ApiResponseTransactionRefund{
+		Status:  "success",
+		Message: "Successfully voided transaction",
+		Data:    mapTransactionRefundResponse(void),
+	}, nil
+}
+
 func (s *transactionHandleGrpc) TrashedTransaction(ctx context.Context, request *pb.FindByIdTransactionRequest) (*pb.ApiResponseTransactionDeleteAt, error) {
 	id := int(request.GetId())
 
@@ -1083,3 +1239,45 @@ func (s *transactionHandleGrpc) DeleteAllTransactionPermanent(ctx context.Contex
 		Message: "Successfully deleted all transactions permanently",
 	}, nil
 }
+
+func mapTransactionPaymentRequests(payments []*pb.TransactionPaymentRequest) []requests.TransactionPaymentRequest {
+	if len(payments) == 0 {
+		return nil
+	}
+
+	res := make([]requests.TransactionPaymentRequest, 0, len(payments))
+	for _, payment := range payments {
+		req := requests.TransactionPaymentRequest{
+			PaymentMethod: payment.GetPaymentMethod(),
+			Amount:        int(payment.GetAmount()),
+		}
+
+		if reference := payment.GetReference(); reference != "" {
+			req.Reference = &reference
+		}
+
+		res = append(res, req)
+	}
+
+	return res
+}
+
+func mapTransactionRefundResponse(refund *db.TransactionRefund) *pb.TransactionRefundResponse {
+	res := &pb.TransactionRefundResponse{
+		Id:            refund.TransactionRefundID,
+		TransactionId: refund.TransactionID,
+		Kind:          refund.Kind,
+		Amount:        refund.Amount,
+		TaxAmount:     refund.TaxAmount,
+		ReasonCode:    refund.ReasonCode,
+		ApprovedBy:    refund.ApprovedBy,
+		Restock:       refund.Restock,
+		CreatedAt:     refund.CreatedAt.Time.String(),
+	}
+
+	if refund.Note != nil {
+		res.Note = wrapperspb.String(*refund.Note)
+	}
+
+	return res
+}
@@ -89,3 +89,31 @@ func (s *authResponseMapper) ToResponseGetMe(res *pb.ApiResponseGetMe) *response
 		},
 	}
 }
+
+func (s *authResponseMapper) ToResponseLogout(res *pb.ApiResponseLogout) *response.ApiResponseLogout {
+	return &response.ApiResponseLogout{
+		Status:  res.Status,
+		Message: res.Message,
+	}
+}
+
+func (s *authResponseMapper) ToResponseSessions(res *pb.ApiResponseSessions) *response.ApiResponseSessions {
+	sessions := make([]*response.SessionResponse, 0, len(res.Data))
+	for _, session := range res.Data {
+		sessions = append(sessions, &response.SessionResponse{
+			SessionID:  session.SessionId,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IpAddress,
+			StartedAt:  session.StartedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.Current,
+		})
+	}
+
+	return &response.ApiResponseSessions{
+		Status:  res.Status,
+		Message: res.Message,
+		Data:    sessions,
+	}
+}
@@ -0,0 +1,46 @@
+package response_api
+
+import (
+	"pointofsale/internal/domain/response"
+	"pointofsale/internal/pb"
+)
+
+type cacheResponseMapper struct {
+}
+
+func NewCacheResponseMapper() *cacheResponseMapper {
+	return &cacheResponseMapper{}
+}
+
+func (s *cacheResponseMapper) ToCacheNamespaceResponses(pbResponse *pb.ApiResponsesCacheNamespace) []*response.CacheNamespaceResponse {
+	namespaces := make([]*response.CacheNamespaceResponse, 0, len(pbResponse.Data))
+
+	for _, ns := range pbResponse.Data {
+		namespaces = append(namespaces, s.mapCacheNamespace(ns))
+	}
+
+	return namespaces
+}
+
+func (s *cacheResponseMapper) ToCacheNamespaceResponse(pbResponse *pb.ApiResponseCacheNamespace) *response.CacheNamespaceResponse {
+	return s.mapCacheNamespace(pbResponse.Data)
+}
+
+func (s *cacheResponseMapper) ToCacheFlushResponse(pbResponse *pb.ApiResponseCacheFlush) *response.CacheFlushResponse {
+	return &response.CacheFlushResponse{
+		Layer:     response.CacheLayerService,
+		Namespace: pbResponse.Data.GetNamespace(),
+		Deleted:   pbResponse.Data.GetDeleted(),
+	}
+}
+
+func (s *cacheResponseMapper) mapCacheNamespace(ns *pb.CacheNamespaceResponse) *response.CacheNamespaceResponse {
+	return &response.CacheNamespaceResponse{
+		Layer:        response.CacheLayerService,
+		Namespace:    ns.GetNamespace(),
+		Keys:         ns.GetKeys(),
+		LocalEntries: int(ns.GetLocalEntries()),
+		LocalTier:    ns.GetLocalTier(),
+		Durable:      ns.GetDurable(),
+	}
+}
@@ -20,9 +20,25 @@ func (c *categoryResponseMapper) ToResponseCategory(category *pb.CategoryRespons
 		ImageCategory: category.ImageCategory,
 		CreatedAt:     category.CreatedAt,
 		UpdatedAt:     category.UpdatedAt,
+		ParentID:      optionalInt(category.ParentId),
+		MerchantID:    optionalInt(category.MerchantId),
+		Position:      int(category.Position),
 	}
 }
 
+func (c *categoryResponseMapper) ToResponsesCategoryTree(nodes []*pb.CategoryTreeNode) []*response.CategoryTreeNode {
+	mappedNodes := make([]*response.CategoryTreeNode, 0, len(nodes))
+
+	for _, node := range nodes {
+		mappedNodes = append(mappedNodes, &response.CategoryTreeNode{
+			CategoryResponse: c.ToResponseCategory(node.Category),
+			Children:         c.ToResponsesCategoryTree(node.Children),
+		})
+	}
+
+	return mappedNodes
+}
+
 func (c *categoryResponseMapper) ToResponsesCategory(categories []*pb.CategoryResponse) []*response.CategoryResponse {
 	var mappedCategories []*response.CategoryResponse
 
@@ -48,6 +64,9 @@ func (c *categoryResponseMapper) ToResponseCategoryDelete(category *pb.CategoryR
 		CreatedAt:     category.CreatedAt,
 		UpdatedAt:     category.UpdatedAt,
 		DeletedAt:     &deletedAt,
+		ParentID:      optionalInt(category.ParentId),
+		MerchantID:    optionalInt(category.MerchantId),
+		Position:      int(category.Position),
 	}
 }
 
@@ -163,6 +182,14 @@ func (c *categoryResponseMapper) ToApiResponsesCategory(pbResponse *pb.ApiRespon
 	}
 }
 
+func (c *categoryResponseMapper) ToApiResponseCategoryTree(pbResponse *pb.ApiResponseCategoryTree) *response.ApiResponseCategoryTree {
+	return &response.ApiResponseCategoryTree{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    c.ToResponsesCategoryTree(pbResponse.Data),
+	}
+}
+
 func (c *categoryResponseMapper) ToApiResponseCategoryDelete(pbResponse *pb.ApiResponseCategoryDelete) *response.ApiResponseCategoryDelete {
 	return &response.ApiResponseCategoryDelete{
 		Status:  pbResponse.Status,
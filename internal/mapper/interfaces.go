@@ -10,6 +10,8 @@ type AuthResponseMapper interface {
 	ToResponseRegister(res *pb.ApiResponseRegister) *response.ApiResponseRegister
 	ToResponseRefreshToken(res *pb.ApiResponseRefreshToken) *response.ApiResponseRefreshToken
 	ToResponseGetMe(res *pb.ApiResponseGetMe) *response.ApiResponseGetMe
+	ToResponseLogout(res *pb.ApiResponseLogout) *response.ApiResponseLogout
+	ToResponseSessions(res *pb.ApiResponseSessions) *response.ApiResponseSessions
 }
 
 type RoleResponseMapper interface {
@@ -45,6 +47,7 @@ type CategoryResponseMapper interface {
 	ToApiResponseCategory(pbResponse *pb.ApiResponseCategory) *response.ApiResponseCategory
 	ToApiResponseCategoryDeleteAt(pbResponse *pb.ApiResponseCategoryDeleteAt) *response.ApiResponseCategoryDeleteAt
 	ToApiResponsesCategory(pbResponse *pb.ApiResponsesCategory) *response.ApiResponsesCategory
+	ToApiResponseCategoryTree(pbResponse *pb.ApiResponseCategoryTree) *response.ApiResponseCategoryTree
 	ToApiResponsePaginationCategoryDeleteAt(pbResponse *pb.ApiResponsePaginationCategoryDeleteAt) *response.ApiResponsePaginationCategoryDeleteAt
 	ToApiResponsePaginationCategory(pbResponse *pb.ApiResponsePaginationCategory) *response.ApiResponsePaginationCategory
 }
@@ -71,6 +74,7 @@ type MerchantResponseMapper interface {
 	ToApiResponseMerchantDelete(pbResponse *pb.ApiResponseMerchantDelete) *response.ApiResponseMerchantDelete
 	ToApiResponseMerchantAll(pbResponse *pb.ApiResponseMerchantAll) *response.ApiResponseMerchantAll
 	ToApiResponseMerchant(pbResponse *pb.ApiResponseMerchant) *response.ApiResponseMerchant
+	ToApiResponseMerchantBarcodeSettings(pbResponse *pb.ApiResponseMerchantBarcodeSettings) *response.ApiResponseMerchantBarcodeSettings
 
 	ToApiResponseMerchantDeleteAt(pbResponse *pb.ApiResponseMerchantDeleteAt) *response.ApiResponseMerchantDeleteAt
 	ToApiResponsesMerchant(pbResponse *pb.ApiResponsesMerchant) *response.ApiResponsesMerchant
@@ -105,12 +109,17 @@ type OrderResponseMapper interface {
 
 type ProductResponseMapper interface {
 	ToApiResponseProduct(pbResponse *pb.ApiResponseProduct) *response.ApiResponseProduct
+	ToApiResponseProductScan(pbResponse *pb.ApiResponseProductScan) *response.ApiResponseProductScan
 	ToApiResponsesProductDeleteAt(pbResponse *pb.ApiResponseProductDeleteAt) *response.ApiResponseProductDeleteAt
 	ToApiResponsesProduct(pbResponse *pb.ApiResponsesProduct) *response.ApiResponsesProduct
 	ToApiResponseProductDelete(pbResponse *pb.ApiResponseProductDelete) *response.ApiResponseProductDelete
 	ToApiResponseProductAll(pbResponse *pb.ApiResponseProductAll) *response.ApiResponseProductAll
 	ToApiResponsePaginationProductDeleteAt(pbResponse *pb.ApiResponsePaginationProductDeleteAt) *response.ApiResponsePaginationProductDeleteAt
 	ToApiResponsePaginationProduct(pbResponse *pb.ApiResponsePaginationProduct) *response.ApiResponsePaginationProduct
+	ToApiResponsePaginationStockMovement(pbResponse *pb.ApiResponsePaginationStockMovement) *response.ApiResponsePaginationStockMovement
+	ToApiResponseReorderLevel(pbResponse *pb.ApiResponseReorderLevel) *response.ApiResponseReorderLevel
+	ToApiResponsePaginationReorderLevel(pbResponse *pb.ApiResponsePaginationReorderLevel) *response.ApiResponsePaginationReorderLevel
+	ToApiResponseProductImport(pbResponse *pb.ApiResponseProductImport) *response.ApiResponseProductImport
 }
 
 type TransactionResponseMapper interface {
@@ -122,6 +131,9 @@ type TransactionResponseMapper interface {
 	ToApiResponseTransactionYearMethod(pbResponse *pb.ApiResponseTransactionYearPaymentmethod) *response.ApiResponsesTransactionYearMethod
 
 	ToApiResponseTransaction(pbResponse *pb.ApiResponseTransaction) *response.ApiResponseTransaction
+	ToApiResponseTransactionPayments(pbResponse *pb.ApiResponseTransactionPayments) *response.ApiResponseTransactionPayments
+	ToApiResponseTransactionRefund(pbResponse *pb.ApiResponseTransactionRefund) *response.ApiResponseTransactionRefund
+	ToApiResponseTransactionRefunds(pbResponse *pb.ApiResponseTransactionRefunds) *response.ApiResponseTransactionRefunds
 	ToApiResponseTransactionDeleteAt(pbResponse *pb.ApiResponseTransactionDeleteAt) *response.ApiResponseTransactionDeleteAt
 	ToApiResponsesTransaction(pbResponse *pb.ApiResponsesTransaction) *response.ApiResponsesTransaction
 	ToApiResponseTransactionDelete(pbResponse *pb.ApiResponseTransactionDelete) *response.ApiResponseTransactionDelete
@@ -129,3 +141,45 @@ type TransactionResponseMapper interface {
 	ToApiResponsePaginationTransactionDeleteAt(pbResponse *pb.ApiResponsePaginationTransactionDeleteAt) *response.ApiResponsePaginationTransactionDeleteAt
 	ToApiResponsePaginationTransaction(pbResponse *pb.ApiResponsePaginationTransaction) *response.ApiResponsePaginationTransaction
 }
+
+type TaxResponseMapper interface {
+	ToApiResponseTaxRate(pbResponse *pb.ApiResponseTaxRate) *response.ApiResponseTaxRate
+	ToApiResponseTaxRateDeleteAt(pbResponse *pb.ApiResponseTaxRateDeleteAt) *response.ApiResponseTaxRateDeleteAt
+	ToApiResponseTaxRateDelete(pbResponse *pb.ApiResponseTaxRateDelete) *response.ApiResponseTaxRateDelete
+	ToApiResponsePaginationTaxRate(pbResponse *pb.ApiResponsePaginationTaxRate) *response.ApiResponsePaginationTaxRate
+	ToApiResponseTransactionTax(pbResponse *pb.ApiResponseTransactionTax) *response.ApiResponseTransactionTax
+}
+
+type SupplierResponseMapper interface {
+	ToApiResponseSupplier(pbResponse *pb.ApiResponseSupplier) *response.ApiResponseSupplier
+	ToApiResponseSupplierDeleteAt(pbResponse *pb.ApiResponseSupplierDeleteAt) *response.ApiResponseSupplierDeleteAt
+	ToApiResponseSupplierDelete(pbResponse *pb.ApiResponseSupplierDelete) *response.ApiResponseSupplierDelete
+	ToApiResponsePaginationSupplier(pbResponse *pb.ApiResponsePaginationSupplier) *response.ApiResponsePaginationSupplier
+}
+
+type PurchaseOrderResponseMapper interface {
+	ToApiResponsePurchaseOrder(pbResponse *pb.ApiResponsePurchaseOrder) *response.ApiResponsePurchaseOrder
+	ToApiResponsePurchaseOrderDetail(pbResponse *pb.ApiResponsePurchaseOrderDetail) *response.ApiResponsePurchaseOrderDetail
+	ToApiResponsePurchaseOrderReceipts(pbResponse *pb.ApiResponsePurchaseOrderReceipts) *response.ApiResponsePurchaseOrderReceipts
+	ToApiResponsePaginationPurchaseOrder(pbResponse *pb.ApiResponsePaginationPurchaseOrder) *response.ApiResponsePaginationPurchaseOrder
+}
+
+type StocktakeResponseMapper interface {
+	ToApiResponseStocktake(pbResponse *pb.ApiResponseStocktake) *response.ApiResponseStocktake
+	ToApiResponseStocktakeCounts(pbResponse *pb.ApiResponseStocktakeCounts) *response.ApiResponseStocktakeCounts
+	ToApiResponseStocktakeVarianceReport(pbResponse *pb.ApiResponseStocktakeVarianceReport) *response.ApiResponseStocktakeVarianceReport
+	ToApiResponsePaginationStocktake(pbResponse *pb.ApiResponsePaginationStocktake) *response.ApiResponsePaginationStocktake
+	ToApiResponsePaginationStocktakeItem(pbResponse *pb.ApiResponsePaginationStocktakeItem) *response.ApiResponsePaginationStocktakeItem
+}
+
+type ProductVariantResponseMapper interface {
+	ToApiResponseProductOptions(pbResponse *pb.ApiResponseProductOptions) *response.ApiResponseProductOptions
+	ToApiResponseProductVariant(pbResponse *pb.ApiResponseProductVariant) *response.ApiResponseProductVariant
+	ToApiResponseProductVariants(pbResponse *pb.ApiResponseProductVariants) *response.ApiResponseProductVariants
+}
+
+type CacheResponseMapper interface {
+	ToCacheNamespaceResponses(pbResponse *pb.ApiResponsesCacheNamespace) []*response.CacheNamespaceResponse
+	ToCacheNamespaceResponse(pbResponse *pb.ApiResponseCacheNamespace) *response.CacheNamespaceResponse
+	ToCacheFlushResponse(pbResponse *pb.ApiResponseCacheFlush) *response.CacheFlushResponse
+}
@@ -1,29 +1,41 @@
 package response_api
 
 type ResponseApiMapper struct {
-	AuthResponseMapper        AuthResponseMapper
-	RoleResponseMapper        RoleResponseMapper
-	UserResponseMapper        UserResponseMapper
-	CategoryResponseMapper    CategoryResponseMapper
-	CashierResponseMapper     CashierResponseMapper
-	MerchantResponseMapper    MerchantResponseMapper
-	OrderItemResponseMapper   OrderItemResponseMapper
-	OrderResponseMapper       OrderResponseMapper
-	ProductResponseMapper     ProductResponseMapper
-	TransactionResponseMapper TransactionResponseMapper
+	AuthResponseMapper           AuthResponseMapper
+	RoleResponseMapper           RoleResponseMapper
+	UserResponseMapper           UserResponseMapper
+	CategoryResponseMapper       CategoryResponseMapper
+	CashierResponseMapper        CashierResponseMapper
+	MerchantResponseMapper       MerchantResponseMapper
+	OrderItemResponseMapper      OrderItemResponseMapper
+	OrderResponseMapper          OrderResponseMapper
+	ProductResponseMapper        ProductResponseMapper
+	ProductVariantResponseMapper ProductVariantResponseMapper
+	TransactionResponseMapper    TransactionResponseMapper
+	TaxResponseMapper            TaxResponseMapper
+	SupplierResponseMapper       SupplierResponseMapper
+	PurchaseOrderResponseMapper  PurchaseOrderResponseMapper
+	StocktakeResponseMapper      StocktakeResponseMapper
+	CacheResponseMapper          CacheResponseMapper
 }
 
 func NewResponseApiMapper() *ResponseApiMapper {
 	return &ResponseApiMapper{
-		AuthResponseMapper:        NewAuthResponseMapper(),
-		UserResponseMapper:        NewUserResponseMapper(),
-		RoleResponseMapper:        NewRoleResponseMapper(),
-		CategoryResponseMapper:    NewCategoryResponseMapper(),
-		CashierResponseMapper:     NewCashierResponseMapper(),
-		MerchantResponseMapper:    NewMerchantResponseMapper(),
-		OrderItemResponseMapper:   NewOrderItemResponseMapper(),
-		OrderResponseMapper:       NewOrderResponseMapper(),
-		ProductResponseMapper:     NewProductResponseMapper(),
-		TransactionResponseMapper: NewTransactionResponseMapper(),
+		AuthResponseMapper:           NewAuthResponseMapper(),
+		UserResponseMapper:           NewUserResponseMapper(),
+		RoleResponseMapper:           NewRoleResponseMapper(),
+		CategoryResponseMapper:       NewCategoryResponseMapper(),
+		CashierResponseMapper:        NewCashierResponseMapper(),
+		MerchantResponseMapper:       NewMerchantResponseMapper(),
+		OrderItemResponseMapper:      NewOrderItemResponseMapper(),
+		OrderResponseMapper:          NewOrderResponseMapper(),
+		ProductResponseMapper:        NewProductResponseMapper(),
+		ProductVariantResponseMapper: NewProductVariantResponseMapper(),
+		TransactionResponseMapper:    NewTransactionResponseMapper(),
+		TaxResponseMapper:            NewTaxResponseMapper(),
+		SupplierResponseMapper:       NewSupplierResponseMapper(),
+		PurchaseOrderResponseMapper:  NewPurchaseOrderResponseMapper(),
+		StocktakeResponseMapper:      NewStocktakeResponseMapper(),
+		CacheResponseMapper:          NewCacheResponseMapper(),
 	}
 }
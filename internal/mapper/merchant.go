@@ -71,6 +71,19 @@ func (m *merchantResponseMapper) ToApiResponseMerchant(pbResponse *pb.ApiRespons
 	}
 }
 
+func (m *merchantResponseMapper) ToApiResponseMerchantBarcodeSettings(pbResponse *pb.ApiResponseMerchantBarcodeSettings) *response.ApiResponseMerchantBarcodeSettings {
+	return &response.ApiResponseMerchantBarcodeSettings{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data: &response.MerchantBarcodeSettingsResponse{
+			MerchantID:    int(pbResponse.Data.MerchantId),
+			Gs1Prefix:     optionalString(pbResponse.Data.Gs1Prefix),
+			BarcodeFormat: pbResponse.Data.BarcodeFormat,
+			UpdatedAt:     pbResponse.Data.UpdatedAt,
+		},
+	}
+}
+
 func (m *merchantResponseMapper) ToApiResponseMerchantDeleteAt(pbResponse *pb.ApiResponseMerchantDeleteAt) *response.ApiResponseMerchantDeleteAt {
 	return &response.ApiResponseMerchantDeleteAt{
 		Status:  pbResponse.Status,
@@ -20,6 +20,7 @@ func (o *orderResponseMapper) ToResponseOrder(order *pb.OrderResponse) *response
 		TotalPrice: int(order.TotalPrice),
 		CreatedAt:  order.CreatedAt,
 		UpdatedAt:  order.UpdatedAt,
+		Status:     order.Status,
 	}
 }
 
@@ -48,6 +49,7 @@ func (o *orderResponseMapper) ToResponseOrderDeleteAt(order *pb.OrderResponseDel
 		CreatedAt:  order.CreatedAt,
 		UpdatedAt:  order.UpdatedAt,
 		DeleteAt:   &deletedAt,
+		Status:     order.Status,
 	}
 }
 
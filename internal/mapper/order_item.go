@@ -17,6 +17,7 @@ func (o *orderItemResponseMapper) ToResponseOrderItem(orderItem *pb.OrderItemRes
 		ID:        int(orderItem.Id),
 		OrderID:   int(orderItem.OrderId),
 		ProductID: int(orderItem.ProductId),
+		VariantID: optionalInt(orderItem.VariantId),
 		Quantity:  int(orderItem.Quantity),
 		Price:     int(orderItem.Price),
 		CreatedAt: orderItem.CreatedAt,
@@ -3,6 +3,7 @@ package response_api
 import (
 	"pointofsale/internal/domain/response"
 	"pointofsale/internal/pb"
+	"pointofsale/pkg/upload_image"
 )
 
 type productResponseMapper struct {
@@ -28,6 +29,11 @@ func (p *productResponseMapper) ToResponseProduct(product *pb.ProductResponse) *
 		Barcode:      product.Barcode,
 		CreatedAt:    product.CreatedAt,
 		UpdatedAt:    product.UpdatedAt,
+		VariantCount: optionalInt(product.VariantCount),
+		MinPrice:     optionalInt(product.MinPrice),
+		MaxPrice:     optionalInt(product.MaxPrice),
+
+		ImageVariants: upload_image.ImageVariantPaths(product.ImageProduct),
 	}
 }
 
@@ -64,6 +70,11 @@ func (p *productResponseMapper) ToResponseProductDeleteAt(product *pb.ProductRes
 		CreatedAt:    product.CreatedAt,
 		UpdatedAt:    product.UpdatedAt,
 		DeleteAt:     &deletedAt,
+		VariantCount: optionalInt(product.VariantCount),
+		MinPrice:     optionalInt(product.MinPrice),
+		MaxPrice:     optionalInt(product.MaxPrice),
+
+		ImageVariants: upload_image.ImageVariantPaths(product.ImageProduct),
 	}
 }
 
@@ -85,6 +96,21 @@ func (p *productResponseMapper) ToApiResponseProduct(pbResponse *pb.ApiResponseP
 	}
 }
 
+func (p *productResponseMapper) ToApiResponseProductScan(pbResponse *pb.ApiResponseProductScan) *response.ApiResponseProductScan {
+	return &response.ApiResponseProductScan{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data: &response.ProductScanResponse{
+			Product:        p.ToResponseProduct(pbResponse.Data.Product),
+			VariantID:      optionalInt(pbResponse.Data.VariantId),
+			VariantSku:     optionalString(pbResponse.Data.VariantSku),
+			VariantOptions: pbResponse.Data.VariantOptions,
+			Price:          int(pbResponse.Data.Price),
+			CountInStock:   int(pbResponse.Data.CountInStock),
+		},
+	}
+}
+
 func (p *productResponseMapper) ToApiResponsesProduct(pbResponse *pb.ApiResponsesProduct) *response.ApiResponsesProduct {
 	return &response.ApiResponsesProduct{
 		Status:  pbResponse.Status,
@@ -132,3 +158,129 @@ func (p *productResponseMapper) ToApiResponsePaginationProduct(pbResponse *pb.Ap
 		Pagination: *mapPaginationMeta(pbResponse.Pagination),
 	}
 }
+
+func (p *productResponseMapper) ToResponseStockMovement(movement *pb.StockMovementResponse) *response.StockMovementResponse {
+	res := &response.StockMovementResponse{
+		ID:            int(movement.Id),
+		ProductID:     int(movement.ProductId),
+		Reason:        movement.Reason,
+		QuantityDelta: int(movement.QuantityDelta),
+		BalanceAfter:  int(movement.BalanceAfter),
+		CreatedAt:     movement.CreatedAt,
+		VariantID:     optionalInt(movement.VariantId),
+	}
+
+	if movement.ReferenceType != nil {
+		res.ReferenceType = &movement.ReferenceType.Value
+	}
+
+	if movement.ReferenceId != nil {
+		referenceID := int(movement.ReferenceId.Value)
+		res.ReferenceID = &referenceID
+	}
+
+	if movement.UserId != nil {
+		userID := int(movement.UserId.Value)
+		res.UserID = &userID
+	}
+
+	if movement.Note != nil {
+		res.Note = &movement.Note.Value
+	}
+
+	return res
+}
+
+func (p *productResponseMapper) ToResponsesStockMovement(movements []*pb.StockMovementResponse) []*response.StockMovementResponse {
+	var mappedMovements []*response.StockMovementResponse
+
+	for _, movement := range movements {
+		mappedMovements = append(mappedMovements, p.ToResponseStockMovement(movement))
+	}
+
+	return mappedMovements
+}
+
+func (p *productResponseMapper) ToApiResponsePaginationStockMovement(pbResponse *pb.ApiResponsePaginationStockMovement) *response.ApiResponsePaginationStockMovement {
+	return &response.ApiResponsePaginationStockMovement{
+		Status:     pbResponse.Status,
+		Message:    pbResponse.Message,
+		Data:       p.ToResponsesStockMovement(pbResponse.Data),
+		Pagination: *mapPaginationMeta(pbResponse.Pagination),
+	}
+}
+
+func (p *productResponseMapper) ToResponseReorderLevel(product *pb.ReorderLevelResponse) *response.ReorderLevelResponse {
+	res := &response.ReorderLevelResponse{
+		ID:              int(product.Id),
+		MerchantID:      int(product.MerchantId),
+		CategoryID:      int(product.CategoryId),
+		Name:            product.Name,
+		CountInStock:    int(product.CountInStock),
+		ReorderPoint:    int(product.ReorderPoint),
+		ReorderQuantity: int(product.ReorderQuantity),
+	}
+
+	if product.LowStockAlertedAt != nil {
+		res.LowStockAlertedAt = &product.LowStockAlertedAt.Value
+	}
+
+	return res
+}
+
+func (p *productResponseMapper) ToResponsesReorderLevel(products []*pb.ReorderLevelResponse) []*response.ReorderLevelResponse {
+	var mappedProducts []*response.ReorderLevelResponse
+
+	for _, product := range products {
+		mappedProducts = append(mappedProducts, p.ToResponseReorderLevel(product))
+	}
+
+	return mappedProducts
+}
+
+func (p *productResponseMapper) ToApiResponseReorderLevel(pbResponse *pb.ApiResponseReorderLevel) *response.ApiResponseReorderLevel {
+	return &response.ApiResponseReorderLevel{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    p.ToResponseReorderLevel(pbResponse.Data),
+	}
+}
+
+func (p *productResponseMapper) ToApiResponsePaginationReorderLevel(pbResponse *pb.ApiResponsePaginationReorderLevel) *response.ApiResponsePaginationReorderLevel {
+	return &response.ApiResponsePaginationReorderLevel{
+		Status:     pbResponse.Status,
+		Message:    pbResponse.Message,
+		Data:       p.ToResponsesReorderLevel(pbResponse.Data),
+		Pagination: *mapPaginationMeta(pbResponse.Pagination),
+	}
+}
+
+func (p *productResponseMapper) ToResponseProductImport(result *pb.ProductImportResponse) *response.ProductImportResponse {
+	rows := make([]*response.ProductImportRowResponse, 0, len(result.Rows))
+
+	for _, row := range result.Rows {
+		rows = append(rows, &response.ProductImportRowResponse{
+			Row:       int(row.Row),
+			Action:    row.Action,
+			ProductID: optionalInt(row.ProductId),
+			Errors:    row.Errors,
+		})
+	}
+
+	return &response.ProductImportResponse{
+		DryRun:  result.DryRun,
+		Applied: result.Applied,
+		Created: int(result.Created),
+		Updated: int(result.Updated),
+		Failed:  int(result.Failed),
+		Rows:    rows,
+	}
+}
+
+func (p *productResponseMapper) ToApiResponseProductImport(pbResponse *pb.ApiResponseProductImport) *response.ApiResponseProductImport {
+	return &response.ApiResponseProductImport{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    p.ToResponseProductImport(pbResponse.Data),
+	}
+}
@@ -0,0 +1,86 @@
+package response_api
+
+import (
+	"pointofsale/internal/domain/response"
+	"pointofsale/internal/pb"
+)
+
+type productVariantResponseMapper struct {
+}
+
+func NewProductVariantResponseMapper() *productVariantResponseMapper {
+	return &productVariantResponseMapper{}
+}
+
+func (s *productVariantResponseMapper) ToApiResponseProductOptions(pbResponse *pb.ApiResponseProductOptions) *response.ApiResponseProductOptions {
+	return &response.ApiResponseProductOptions{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponseProductOptions(pbResponse.Data),
+	}
+}
+
+func (s *productVariantResponseMapper) ToApiResponseProductVariant(pbResponse *pb.ApiResponseProductVariant) *response.ApiResponseProductVariant {
+	return &response.ApiResponseProductVariant{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponseProductVariant(pbResponse.Data),
+	}
+}
+
+func (s *productVariantResponseMapper) ToApiResponseProductVariants(pbResponse *pb.ApiResponseProductVariants) *response.ApiResponseProductVariants {
+	var data *response.ProductVariantsResponse
+
+	if pbResponse.Data != nil {
+		variants := make([]*response.ProductVariantResponse, 0, len(pbResponse.Data.Variants))
+
+		for _, variant := range pbResponse.Data.Variants {
+			variants = append(variants, s.mapResponseProductVariant(variant))
+		}
+
+		data = &response.ProductVariantsResponse{
+			ProductID: int(pbResponse.Data.ProductId),
+			Options:   s.mapResponseProductOptions(pbResponse.Data.Options),
+			Variants:  variants,
+		}
+	}
+
+	return &response.ApiResponseProductVariants{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    data,
+	}
+}
+
+func (s *productVariantResponseMapper) mapResponseProductOptions(options []*pb.ProductOptionResponse) []*response.ProductOptionResponse {
+	res := make([]*response.ProductOptionResponse, 0, len(options))
+
+	for _, option := range options {
+		res = append(res, &response.ProductOptionResponse{
+			ID:       int(option.Id),
+			Name:     option.Name,
+			Values:   option.Values,
+			Position: int(option.Position),
+		})
+	}
+
+	return res
+}
+
+func (s *productVariantResponseMapper) mapResponseProductVariant(variant *pb.ProductVariantResponse) *response.ProductVariantResponse {
+	if variant == nil {
+		return nil
+	}
+
+	return &response.ProductVariantResponse{
+		ID:           int(variant.Id),
+		ProductID:    int(variant.ProductId),
+		Sku:          variant.Sku,
+		Barcode:      optionalString(variant.Barcode),
+		Options:      variant.Options,
+		Price:        optionalInt(variant.Price),
+		CountInStock: int(variant.CountInStock),
+		CreatedAt:    variant.CreatedAt,
+		UpdatedAt:    variant.UpdatedAt,
+	}
+}
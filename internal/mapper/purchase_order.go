@@ -0,0 +1,110 @@
+package response_api
+
+import (
+	"pointofsale/internal/domain/response"
+	"pointofsale/internal/pb"
+)
+
+type purchaseOrderResponseMapper struct {
+}
+
+func NewPurchaseOrderResponseMapper() *purchaseOrderResponseMapper {
+	return &purchaseOrderResponseMapper{}
+}
+
+func (s *purchaseOrderResponseMapper) ToApiResponsePurchaseOrder(pbResponse *pb.ApiResponsePurchaseOrder) *response.ApiResponsePurchaseOrder {
+	return &response.ApiResponsePurchaseOrder{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponsePurchaseOrder(pbResponse.Data),
+	}
+}
+
+func (s *purchaseOrderResponseMapper) ToApiResponsePurchaseOrderDetail(pbResponse *pb.ApiResponsePurchaseOrderDetail) *response.ApiResponsePurchaseOrderDetail {
+	var detail *response.PurchaseOrderDetailResponse
+
+	if pbResponse.Data != nil {
+		var items []*response.PurchaseOrderItemResponse
+
+		for _, item := range pbResponse.Data.Items {
+			items = append(items, &response.PurchaseOrderItemResponse{
+				ID:               int(item.Id),
+				PurchaseOrderID:  int(item.PurchaseOrderId),
+				ProductID:        int(item.ProductId),
+				QuantityOrdered:  int(item.QuantityOrdered),
+				QuantityReceived: int(item.QuantityReceived),
+				UnitCost:         int(item.UnitCost),
+				CreatedAt:        item.CreatedAt,
+				UpdatedAt:        item.UpdatedAt,
+			})
+		}
+
+		detail = &response.PurchaseOrderDetailResponse{
+			PurchaseOrder: s.mapResponsePurchaseOrder(pbResponse.Data.PurchaseOrder),
+			Items:         items,
+		}
+	}
+
+	return &response.ApiResponsePurchaseOrderDetail{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    detail,
+	}
+}
+
+func (s *purchaseOrderResponseMapper) ToApiResponsePurchaseOrderReceipts(pbResponse *pb.ApiResponsePurchaseOrderReceipts) *response.ApiResponsePurchaseOrderReceipts {
+	var receipts []*response.PurchaseOrderReceiptResponse
+
+	for _, receipt := range pbResponse.Data {
+		receipts = append(receipts, &response.PurchaseOrderReceiptResponse{
+			ID:                  int(receipt.Id),
+			PurchaseOrderItemID: int(receipt.PurchaseOrderItemId),
+			ProductID:           int(receipt.ProductId),
+			Quantity:            int(receipt.Quantity),
+			UnitCost:            int(receipt.UnitCost),
+			ReceivedBy:          optionalInt(receipt.ReceivedBy),
+			Note:                optionalString(receipt.Note),
+			CreatedAt:           receipt.CreatedAt,
+		})
+	}
+
+	return &response.ApiResponsePurchaseOrderReceipts{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    receipts,
+	}
+}
+
+func (s *purchaseOrderResponseMapper) ToApiResponsePaginationPurchaseOrder(pbResponse *pb.ApiResponsePaginationPurchaseOrder) *response.ApiResponsePaginationPurchaseOrder {
+	var purchaseOrders []*response.PurchaseOrderResponse
+
+	for _, purchaseOrder := range pbResponse.Data {
+		purchaseOrders = append(purchaseOrders, s.mapResponsePurchaseOrder(purchaseOrder))
+	}
+
+	return &response.ApiResponsePaginationPurchaseOrder{
+		Status:     pbResponse.Status,
+		Message:    pbResponse.Message,
+		Data:       purchaseOrders,
+		Pagination: mapPaginationMeta(pbResponse.Pagination),
+	}
+}
+
+func (s *purchaseOrderResponseMapper) mapResponsePurchaseOrder(purchaseOrder *pb.PurchaseOrderResponse) *response.PurchaseOrderResponse {
+	if purchaseOrder == nil {
+		return nil
+	}
+
+	return &response.PurchaseOrderResponse{
+		ID:         int(purchaseOrder.Id),
+		MerchantID: int(purchaseOrder.MerchantId),
+		SupplierID: int(purchaseOrder.SupplierId),
+		Status:     purchaseOrder.Status,
+		Note:       optionalString(purchaseOrder.Note),
+		CreatedBy:  optionalInt(purchaseOrder.CreatedBy),
+		OrderedAt:  optionalString(purchaseOrder.OrderedAt),
+		ReceivedAt: optionalString(purchaseOrder.ReceivedAt),
+		CreatedAt:  purchaseOrder.CreatedAt,
+		UpdatedAt:  purchaseOrder.UpdatedAt,
+	}
+}
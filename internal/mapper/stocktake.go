@@ -0,0 +1,142 @@
+package response_api
+
+import (
+	"pointofsale/internal/domain/response"
+	"pointofsale/internal/pb"
+)
+
+type stocktakeResponseMapper struct {
+}
+
+func NewStocktakeResponseMapper() *stocktakeResponseMapper {
+	return &stocktakeResponseMapper{}
+}
+
+func (s *stocktakeResponseMapper) ToApiResponseStocktake(pbResponse *pb.ApiResponseStocktake) *response.ApiResponseStocktake {
+	return &response.ApiResponseStocktake{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponseStocktake(pbResponse.Data),
+	}
+}
+
+func (s *stocktakeResponseMapper) ToApiResponseStocktakeCounts(pbResponse *pb.ApiResponseStocktakeCounts) *response.ApiResponseStocktakeCounts {
+	var counts []*response.StocktakeCountResponse
+
+	for _, count := range pbResponse.Data {
+		counts = append(counts, &response.StocktakeCountResponse{
+			ID:               int(count.Id),
+			StocktakeID:      int(count.StocktakeId),
+			ProductID:        int(count.ProductId),
+			ExpectedQuantity: int(count.ExpectedQuantity),
+			CountedQuantity:  int(count.CountedQuantity),
+			Variance:         int(count.Variance),
+			CountedAt:        optionalString(count.CountedAt),
+		})
+	}
+
+	return &response.ApiResponseStocktakeCounts{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    counts,
+	}
+}
+
+func (s *stocktakeResponseMapper) ToApiResponseStocktakeVarianceReport(pbResponse *pb.ApiResponseStocktakeVarianceReport) *response.ApiResponseStocktakeVarianceReport {
+	var report *response.StocktakeVarianceReportResponse
+
+	if pbResponse.Data != nil {
+		var categories []*response.StocktakeCategoryVarianceResponse
+
+		for _, category := range pbResponse.Data.Categories {
+			categories = append(categories, &response.StocktakeCategoryVarianceResponse{
+				CategoryID:       int(category.CategoryId),
+				CategoryName:     category.CategoryName,
+				ItemCount:        int(category.ItemCount),
+				CountedCount:     int(category.CountedCount),
+				ExpectedQuantity: int(category.ExpectedQuantity),
+				CountedQuantity:  int(category.CountedQuantity),
+				VarianceQuantity: int(category.VarianceQuantity),
+				VarianceValue:    category.VarianceValue,
+			})
+		}
+
+		report = &response.StocktakeVarianceReportResponse{
+			StocktakeID:      int(pbResponse.Data.StocktakeId),
+			Categories:       categories,
+			ExpectedQuantity: int(pbResponse.Data.ExpectedQuantity),
+			CountedQuantity:  int(pbResponse.Data.CountedQuantity),
+			VarianceQuantity: int(pbResponse.Data.VarianceQuantity),
+			VarianceValue:    pbResponse.Data.VarianceValue,
+		}
+	}
+
+	return &response.ApiResponseStocktakeVarianceReport{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    report,
+	}
+}
+
+func (s *stocktakeResponseMapper) ToApiResponsePaginationStocktake(pbResponse *pb.ApiResponsePaginationStocktake) *response.ApiResponsePaginationStocktake {
+	var stocktakes []*response.StocktakeResponse
+
+	for _, stocktake := range pbResponse.Data {
+		stocktakes = append(stocktakes, s.mapResponseStocktake(stocktake))
+	}
+
+	return &response.ApiResponsePaginationStocktake{
+		Status:     pbResponse.Status,
+		Message:    pbResponse.Message,
+		Data:       stocktakes,
+		Pagination: mapPaginationMeta(pbResponse.Pagination),
+	}
+}
+
+func (s *stocktakeResponseMapper) ToApiResponsePaginationStocktakeItem(pbResponse *pb.ApiResponsePaginationStocktakeItem) *response.ApiResponsePaginationStocktakeItem {
+	var items []*response.StocktakeItemResponse
+
+	for _, item := range pbResponse.Data {
+		items = append(items, &response.StocktakeItemResponse{
+			ID:               int(item.Id),
+			StocktakeID:      int(item.StocktakeId),
+			ProductID:        int(item.ProductId),
+			Name:             item.Name,
+			Barcode:          optionalString(item.Barcode),
+			CategoryID:       int(item.CategoryId),
+			ExpectedQuantity: int(item.ExpectedQuantity),
+			CountedQuantity:  optionalInt(item.CountedQuantity),
+			UnitPrice:        int(item.UnitPrice),
+			Variance:         int(item.Variance),
+			VarianceValue:    item.VarianceValue,
+			CountedBy:        optionalInt(item.CountedBy),
+			CountedAt:        optionalString(item.CountedAt),
+		})
+	}
+
+	return &response.ApiResponsePaginationStocktakeItem{
+		Status:     pbResponse.Status,
+		Message:    pbResponse.Message,
+		Data:       items,
+		Pagination: mapPaginationMeta(pbResponse.Pagination),
+	}
+}
+
+func (s *stocktakeResponseMapper) mapResponseStocktake(stocktake *pb.StocktakeResponse) *response.StocktakeResponse {
+	if stocktake == nil {
+		return nil
+	}
+
+	return &response.StocktakeResponse{
+		ID:         int(stocktake.Id),
+		MerchantID: int(stocktake.MerchantId),
+		CategoryID: optionalInt(stocktake.CategoryId),
+		Status:     stocktake.Status,
+		Note:       optionalString(stocktake.Note),
+		CreatedBy:  optionalInt(stocktake.CreatedBy),
+		PostedBy:   optionalInt(stocktake.PostedBy),
+		PostedAt:   optionalString(stocktake.PostedAt),
+		CreatedAt:  stocktake.CreatedAt,
+		UpdatedAt:  stocktake.UpdatedAt,
+	}
+}
@@ -0,0 +1,100 @@
+package response_api
+
+import (
+	"pointofsale/internal/domain/response"
+	"pointofsale/internal/pb"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type supplierResponseMapper struct {
+}
+
+func NewSupplierResponseMapper() *supplierResponseMapper {
+	return &supplierResponseMapper{}
+}
+
+func (s *supplierResponseMapper) ToApiResponseSupplier(pbResponse *pb.ApiResponseSupplier) *response.ApiResponseSupplier {
+	return &response.ApiResponseSupplier{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponseSupplier(pbResponse.Data),
+	}
+}
+
+func (s *supplierResponseMapper) ToApiResponseSupplierDeleteAt(pbResponse *pb.ApiResponseSupplierDeleteAt) *response.ApiResponseSupplierDeleteAt {
+	return &response.ApiResponseSupplierDeleteAt{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponseSupplierDeleteAt(pbResponse.Data),
+	}
+}
+
+func (s *supplierResponseMapper) ToApiResponseSupplierDelete(pbResponse *pb.ApiResponseSupplierDelete) *response.ApiResponseSupplierDelete {
+	return &response.ApiResponseSupplierDelete{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+	}
+}
+
+func (s *supplierResponseMapper) ToApiResponsePaginationSupplier(pbResponse *pb.ApiResponsePaginationSupplier) *response.ApiResponsePaginationSupplier {
+	var suppliers []*response.SupplierResponse
+
+	for _, supplier := range pbResponse.Data {
+		suppliers = append(suppliers, s.mapResponseSupplier(supplier))
+	}
+
+	return &response.ApiResponsePaginationSupplier{
+		Status:     pbResponse.Status,
+		Message:    pbResponse.Message,
+		Data:       suppliers,
+		Pagination: mapPaginationMeta(pbResponse.Pagination),
+	}
+}
+
+func (s *supplierResponseMapper) mapResponseSupplier(supplier *pb.SupplierResponse) *response.SupplierResponse {
+	if supplier == nil {
+		return nil
+	}
+
+	return &response.SupplierResponse{
+		ID:          int(supplier.Id),
+		MerchantID:  int(supplier.MerchantId),
+		Name:        supplier.Name,
+		ContactName: optionalString(supplier.ContactName),
+		Email:       optionalString(supplier.Email),
+		Phone:       optionalString(supplier.Phone),
+		Address:     optionalString(supplier.Address),
+		CreatedAt:   supplier.CreatedAt,
+		UpdatedAt:   supplier.UpdatedAt,
+	}
+}
+
+func (s *supplierResponseMapper) mapResponseSupplierDeleteAt(supplier *pb.SupplierResponseDeleteAt) *response.SupplierResponseDeleteAt {
+	if supplier == nil {
+		return nil
+	}
+
+	return &response.SupplierResponseDeleteAt{
+		ID:          int(supplier.Id),
+		MerchantID:  int(supplier.MerchantId),
+		Name:        supplier.Name,
+		ContactName: optionalString(supplier.ContactName),
+		Email:       optionalString(supplier.Email),
+		Phone:       optionalString(supplier.Phone),
+		Address:     optionalString(supplier.Address),
+		CreatedAt:   supplier.CreatedAt,
+		UpdatedAt:   supplier.UpdatedAt,
+		DeletedAt:   optionalString(supplier.DeletedAt),
+	}
+}
+
+func optionalString(v *wrapperspb.StringValue) *string {
+	if v == nil {
+		return nil
+	}
+
+	s := v.Value
+
+	return &s
+}
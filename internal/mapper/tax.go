@@ -0,0 +1,129 @@
+package response_api
+
+import (
+	"pointofsale/internal/domain/response"
+	"pointofsale/internal/pb"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type taxResponseMapper struct {
+}
+
+func NewTaxResponseMapper() *taxResponseMapper {
+	return &taxResponseMapper{}
+}
+
+func (s *taxResponseMapper) ToApiResponseTaxRate(pbResponse *pb.ApiResponseTaxRate) *response.ApiResponseTaxRate {
+	return &response.ApiResponseTaxRate{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponseTaxRate(pbResponse.Data),
+	}
+}
+
+func (s *taxResponseMapper) ToApiResponseTaxRateDeleteAt(pbResponse *pb.ApiResponseTaxRateDeleteAt) *response.ApiResponseTaxRateDeleteAt {
+	return &response.ApiResponseTaxRateDeleteAt{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    s.mapResponseTaxRateDeleteAt(pbResponse.Data),
+	}
+}
+
+func (s *taxResponseMapper) ToApiResponseTaxRateDelete(pbResponse *pb.ApiResponseTaxRateDelete) *response.ApiResponseTaxRateDelete {
+	return &response.ApiResponseTaxRateDelete{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+	}
+}
+
+func (s *taxResponseMapper) ToApiResponsePaginationTaxRate(pbResponse *pb.ApiResponsePaginationTaxRate) *response.ApiResponsePaginationTaxRate {
+	var rates []*response.TaxRateResponse
+
+	for _, rate := range pbResponse.Data {
+		rates = append(rates, s.mapResponseTaxRate(rate))
+	}
+
+	return &response.ApiResponsePaginationTaxRate{
+		Status:     pbResponse.Status,
+		Message:    pbResponse.Message,
+		Data:       rates,
+		Pagination: mapPaginationMeta(pbResponse.Pagination),
+	}
+}
+
+func (s *taxResponseMapper) ToApiResponseTransactionTax(pbResponse *pb.ApiResponseTransactionTax) *response.ApiResponseTransactionTax {
+	var lines []*response.TaxLineResponse
+
+	for _, line := range pbResponse.Data {
+		lines = append(lines, &response.TaxLineResponse{
+			OrderItemID:  int(line.OrderItemId),
+			ProductID:    int(line.ProductId),
+			Quantity:     int(line.Quantity),
+			Price:        int(line.Price),
+			TaxRateID:    optionalInt(line.TaxRateId),
+			TaxRateBps:   int(line.TaxRateBps),
+			TaxInclusive: line.TaxInclusive,
+			TaxRounding:  line.TaxRounding,
+			TaxAmount:    int(line.TaxAmount),
+		})
+	}
+
+	return &response.ApiResponseTransactionTax{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    lines,
+	}
+}
+
+func (s *taxResponseMapper) mapResponseTaxRate(rate *pb.TaxRateResponse) *response.TaxRateResponse {
+	if rate == nil {
+		return nil
+	}
+
+	return &response.TaxRateResponse{
+		ID:          int(rate.Id),
+		Name:        rate.Name,
+		MerchantID:  optionalInt(rate.MerchantId),
+		CategoryID:  optionalInt(rate.CategoryId),
+		RateBps:     int(rate.RateBps),
+		IsInclusive: rate.IsInclusive,
+		Rounding:    rate.Rounding,
+		CreatedAt:   rate.CreatedAt,
+		UpdatedAt:   rate.UpdatedAt,
+	}
+}
+
+func (s *taxResponseMapper) mapResponseTaxRateDeleteAt(rate *pb.TaxRateResponseDeleteAt) *response.TaxRateResponseDeleteAt {
+	if rate == nil {
+		return nil
+	}
+
+	var deletedAt *string
+	if rate.DeletedAt != nil {
+		deletedAt = &rate.DeletedAt.Value
+	}
+
+	return &response.TaxRateResponseDeleteAt{
+		ID:          int(rate.Id),
+		Name:        rate.Name,
+		MerchantID:  optionalInt(rate.MerchantId),
+		CategoryID:  optionalInt(rate.CategoryId),
+		RateBps:     int(rate.RateBps),
+		IsInclusive: rate.IsInclusive,
+		Rounding:    rate.Rounding,
+		CreatedAt:   rate.CreatedAt,
+		UpdatedAt:   rate.UpdatedAt,
+		DeletedAt:   deletedAt,
+	}
+}
+
+func optionalInt(v *wrapperspb.Int32Value) *int {
+	if v == nil {
+		return nil
+	}
+
+	n := int(v.Value)
+
+	return &n
+}
@@ -72,6 +72,7 @@ func (s *transactionResponseMapper) ToTransactionMonthAmountSuccess(row *pb.Tran
 		Month:        row.Month,
 		TotalSuccess: int(row.TotalSuccess),
 		TotalAmount:  int(row.TotalAmount),
+		TotalTax:     int(row.TotalTax),
 	}
 }
 
@@ -90,6 +91,7 @@ func (s *transactionResponseMapper) ToTransactionYearAmountSuccess(row *pb.Trans
 		Year:         row.Year,
 		TotalSuccess: int(row.TotalSuccess),
 		TotalAmount:  int(row.TotalAmount),
+		TotalTax:     int(row.TotalTax),
 	}
 }
 
@@ -186,6 +188,75 @@ func (t *transactionResponseMapper) ToApiResponseTransaction(pbResponse *pb.ApiR
 	}
 }
 
+func (t *transactionResponseMapper) ToApiResponseTransactionPayments(pbResponse *pb.ApiResponseTransactionPayments) *response.ApiResponseTransactionPayments {
+	var payments []*response.TransactionPaymentResponse
+
+	for _, payment := range pbResponse.Data {
+		var reference *string
+		if payment.Reference != "" {
+			reference = &payment.Reference
+		}
+
+		payments = append(payments, &response.TransactionPaymentResponse{
+			ID:            int(payment.Id),
+			TransactionID: int(payment.TransactionId),
+			PaymentMethod: payment.PaymentMethod,
+			Amount:        int(payment.Amount),
+			ChangeAmount:  int(payment.ChangeAmount),
+			Reference:     reference,
+			CreatedAt:     payment.CreatedAt,
+		})
+	}
+
+	return &response.ApiResponseTransactionPayments{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    payments,
+	}
+}
+
+func (t *transactionResponseMapper) ToResponseTransactionRefund(refund *pb.TransactionRefundResponse) *response.TransactionRefundResponse {
+	var note *string
+	if refund.Note != nil {
+		note = &refund.Note.Value
+	}
+
+	return &response.TransactionRefundResponse{
+		ID:            int(refund.Id),
+		TransactionID: int(refund.TransactionId),
+		Kind:          refund.Kind,
+		Amount:        int(refund.Amount),
+		TaxAmount:     int(refund.TaxAmount),
+		ReasonCode:    refund.ReasonCode,
+		Note:          note,
+		ApprovedBy:    int(refund.ApprovedBy),
+		Restock:       refund.Restock,
+		CreatedAt:     refund.CreatedAt,
+	}
+}
+
+func (t *transactionResponseMapper) ToApiResponseTransactionRefund(pbResponse *pb.ApiResponseTransactionRefund) *response.ApiResponseTransactionRefund {
+	return &response.ApiResponseTransactionRefund{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    t.ToResponseTransactionRefund(pbResponse.Data),
+	}
+}
+
+func (t *transactionResponseMapper) ToApiResponseTransactionRefunds(pbResponse *pb.ApiResponseTransactionRefunds) *response.ApiResponseTransactionRefunds {
+	var refunds []*response.TransactionRefundResponse
+
+	for _, refund := range pbResponse.Data {
+		refunds = append(refunds, t.ToResponseTransactionRefund(refund))
+	}
+
+	return &response.ApiResponseTransactionRefunds{
+		Status:  pbResponse.Status,
+		Message: pbResponse.Message,
+		Data:    refunds,
+	}
+}
+
 func (t *transactionResponseMapper) ToApiResponseTransactionDeleteAt(pbResponse *pb.ApiResponseTransactionDeleteAt) *response.ApiResponseTransactionDeleteAt {
 	return &response.ApiResponseTransactionDeleteAt{
 		Status:  pbResponse.Status,
@@ -0,0 +1,209 @@
+package middlewares
+
+import (
+	"context"
+	"pointofsale/pkg/auth"
+	"sort"
+	"strings"
+)
+
+// AnyRole grants a rule to every authenticated caller, including users that
+// have no role assigned yet.
+const AnyRole = "*"
+
+var (
+	adminOnly      = []string{auth.RoleAdmin}
+	managers       = []string{auth.RoleAdmin, auth.RoleManager}
+	staff          = []string{auth.RoleAdmin, auth.RoleManager, auth.RoleCashier}
+	catalogReaders = []string{auth.RoleAdmin, auth.RoleManager, auth.RoleCashier, auth.RoleSupplier}
+	authenticated  = []string{AnyRole}
+)
+
+// RoleResolver returns the role names assigned to a user.
+type RoleResolver interface {
+	ResolveRoles(ctx context.Context, userID int) ([]string, error)
+}
+
+type RoleResolverFunc func(ctx context.Context, userID int) ([]string, error)
+
+func (f RoleResolverFunc) ResolveRoles(ctx context.Context, userID int) ([]string, error) {
+	return f(ctx, userID)
+}
+
+// AccessPolicy maps gRPC full method names or REST "METHOD /route" keys to the
+// roles allowed to call them. A pattern ending in "*" matches by prefix; an
+// exact pattern always wins over a prefix, and longer prefixes win over
+// shorter ones. Keys without a matching rule are denied.
+type AccessPolicy struct {
+	public []string
+	rules  []accessRule
+}
+
+type accessRule struct {
+	pattern string
+	roles   []string
+}
+
+func NewAccessPolicy(public []string, rules map[string][]string) *AccessPolicy {
+	policy := &AccessPolicy{public: public}
+
+	for pattern, roles := range rules {
+		policy.rules = append(policy.rules, accessRule{pattern: pattern, roles: roles})
+	}
+
+	sort.Slice(policy.rules, func(i, j int) bool {
+		wi := strings.HasSuffix(policy.rules[i].pattern, "*")
+		wj := strings.HasSuffix(policy.rules[j].pattern, "*")
+		if wi != wj {
+			return !wi
+		}
+
+		return len(policy.rules[i].pattern) > len(policy.rules[j].pattern)
+	})
+
+	return policy
+}
+
+func (p *AccessPolicy) IsPublic(key string) bool {
+	for _, pattern := range p.public {
+		if matchPattern(pattern, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *AccessPolicy) Allows(key string, roles []string) bool {
+	for _, rule := range p.rules {
+		if !matchPattern(rule.pattern, key) {
+			continue
+		}
+
+		for _, allowed := range rule.roles {
+			if allowed == AnyRole {
+				return true
+			}
+		}
+
+		return auth.HasAnyRole(roles, rule.roles...)
+	}
+
+	return false
+}
+
+func matchPattern(pattern, key string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(key, prefix)
+	}
+
+	return pattern == key
+}
+
+// DefaultGrpcPolicy is the access policy enforced by the gRPC server.
+func DefaultGrpcPolicy() *AccessPolicy {
+	rules := map[string][]string{
+		"/pb.AuthService/GetMe":                      authenticated,
+		"/pb.AuthService/Logout":                     authenticated,
+		"/pb.AuthService/LogoutAll":                  authenticated,
+		"/pb.AuthService/ListSessions":               authenticated,
+		"/pb.UserService/*":                          adminOnly,
+		"/pb.RoleService/*":                          adminOnly,
+		"/pb.RoleService/FindByUserId":               authenticated,
+		"/pb.CacheService/*":                         adminOnly,
+		"/pb.OrderItemService/*":                     staff,
+		"/pb.OrderService/Create":                    staff,
+		"/pb.OrderService/Update":                    staff,
+		"/pb.OrderService/UpdateStatus":              staff,
+		"/pb.TransactionService/Create":              staff,
+		"/pb.TransactionService/Update":              staff,
+		"/pb.TaxService/FindTransactionTax":          staff,
+		"/pb.ProductService/FindStockMovements":      staff,
+		"/pb.ProductService/FindLowStock":            managers,
+		"/pb.StocktakeService/SubmitStocktakeCounts": staff,
+	}
+
+	grpcServiceRules(rules, "MerchantService", staff, managers,
+		"RestoreAllMerchant", "DeleteMerchantPermanent", "DeleteAllMerchantPermanent")
+	grpcServiceRules(rules, "CashierService", staff, managers,
+		"RestoreAllCashier", "DeleteCashierPermanent", "DeleteAllCashierPermanent")
+	grpcServiceRules(rules, "CategoryService", catalogReaders, managers,
+		"RestoreAllCategory", "DeleteCategoryPermanent", "DeleteAllCategoryPermanent")
+	grpcServiceRules(rules, "ProductService", catalogReaders, managers,
+		"RestoreAllProduct", "DeleteProductPermanent", "DeleteAllProductPermanent")
+	grpcServiceRules(rules, "ProductVariantService", catalogReaders, managers)
+	grpcServiceRules(rules, "OrderService", staff, managers,
+		"RestoreAllOrder", "DeleteOrderPermanent", "DeleteAllOrderPermanent")
+	grpcServiceRules(rules, "TransactionService", staff, managers,
+		"RestoreAllTransaction", "DeleteTransactionPermanent", "DeleteAllTransactionPermanent")
+	grpcServiceRules(rules, "TaxService", managers, adminOnly)
+	grpcServiceRules(rules, "SupplierService", staff, managers,
+		"DeleteSupplierPermanent")
+	grpcServiceRules(rules, "PurchaseOrderService", staff, managers)
+	grpcServiceRules(rules, "StocktakeService", staff, managers)
+
+	return NewAccessPolicy(DefaultPublicGrpcMethods(), rules)
+}
+
+// DefaultRestPolicy is the access policy enforced by the Echo gateway. Keys
+// are the request method followed by the matched route, e.g.
+// "POST /api/order/update/:id". Paths in whiteListPaths never reach it.
+func DefaultRestPolicy() *AccessPolicy {
+	rules := map[string][]string{
+		"GET /health":                                   authenticated,
+		"GET /api/auth/me":                              authenticated,
+		"POST /api/auth/refresh-token":                  authenticated,
+		"POST /api/auth/logout":                         authenticated,
+		"POST /api/auth/logout-all":                     authenticated,
+		"GET /api/auth/sessions":                        authenticated,
+		"GET /api/role/user/:user_id":                   authenticated,
+		"GET /api/order-item*":                          staff,
+		"POST /api/order/create":                        staff,
+		"POST /api/order/update/:id":                    staff,
+		"POST /api/order/status/:id":                    staff,
+		"POST /api/transaction/create":                  staff,
+		"POST /api/transaction/update/:id":              staff,
+		"GET /api/tax-rate/transaction/:transaction_id": staff,
+		"GET /api/product/stock-movements/:id":          staff,
+		"GET /api/product/low-stock/:merchant_id":       managers,
+		"GET /api/product/export/:merchant_id":          managers,
+		"POST /api/stocktake/counts/:id":                staff,
+		"GET /api/admin/cache*":                         adminOnly,
+		"DELETE /api/admin/cache*":                      adminOnly,
+	}
+
+	restResourceRules(rules, "/api/user", adminOnly, adminOnly)
+	restResourceRules(rules, "/api/role", adminOnly, adminOnly)
+	restResourceRules(rules, "/api/merchant", staff, managers)
+	restResourceRules(rules, "/api/cashier", staff, managers)
+	restResourceRules(rules, "/api/category", catalogReaders, managers)
+	restResourceRules(rules, "/api/product", catalogReaders, managers)
+	restResourceRules(rules, "/api/product-variant", catalogReaders, managers)
+	restResourceRules(rules, "/api/order", staff, managers)
+	restResourceRules(rules, "/api/transaction", staff, managers)
+	restResourceRules(rules, "/api/tax-rate", managers, adminOnly)
+	restResourceRules(rules, "/api/supplier", staff, managers)
+	restResourceRules(rules, "/api/purchase-order", staff, managers)
+	restResourceRules(rules, "/api/stocktake", staff, managers)
+
+	return NewAccessPolicy(nil, rules)
+}
+
+func grpcServiceRules(rules map[string][]string, service string, readers, writers []string, adminMethods ...string) {
+	prefix := "/pb." + service + "/"
+
+	rules[prefix+"Find*"] = readers
+	rules[prefix+"*"] = writers
+
+	for _, method := range adminMethods {
+		rules[prefix+method] = adminOnly
+	}
+}
+
+func restResourceRules(rules map[string][]string, base string, readers, writers []string) {
+	rules["GET "+base+"*"] = readers
+	rules["POST "+base+"*"] = writers
+	rules["DELETE "+base+"*"] = adminOnly
+	rules["POST "+base+"/restore/all"] = adminOnly
+	rules["POST "+base+"/permanent/all"] = adminOnly
+}
@@ -1,6 +1,8 @@
 package middlewares
 
 import (
+	"pointofsale/pkg/auth"
+	"strconv"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -30,6 +32,9 @@ func WebSecurityConfig(e *echo.Echo) {
 				subject := claims["sub"]
 				c.Set("userID", subject)
 			}
+
+			ctx := auth.WithAccessToken(c.Request().Context(), user.Raw)
+			c.SetRequest(c.Request().WithContext(ctx))
 		},
 		ErrorHandler: func(c echo.Context, err error) error {
 			return echo.ErrUnauthorized
@@ -39,6 +44,39 @@ func WebSecurityConfig(e *echo.Echo) {
 
 }
 
+// RoleAuthorization rejects authenticated requests whose roles are not allowed
+// to call the matched route. It must run after WebSecurityConfig.
+func RoleAuthorization(policy *AccessPolicy, roles RoleResolver) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipAuth(c) {
+				return next(c)
+			}
+
+			subject, _ := c.Get("userID").(string)
+			userID, err := strconv.Atoi(subject)
+			if err != nil {
+				return echo.ErrUnauthorized
+			}
+
+			userRoles, err := roles.ResolveRoles(c.Request().Context(), userID)
+			if err != nil {
+				return echo.ErrInternalServerError
+			}
+
+			if !policy.Allows(c.Request().Method+" "+c.Path(), userRoles) {
+				return echo.ErrForbidden
+			}
+
+			// The gateway caches partition merchant data by caller.
+			ctx := auth.WithRoles(auth.WithUserID(c.Request().Context(), userID), userRoles)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
 func skipAuth(c echo.Context) bool {
 	path := c.Request().URL.Path
 	for _, p := range whiteListPaths {
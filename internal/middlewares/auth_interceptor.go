@@ -0,0 +1,177 @@
+package middlewares
+
+import (
+	"context"
+	"pointofsale/pkg/auth"
+	"pointofsale/pkg/logger"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const authorizationMetadataKey = "authorization"
+
+// DefaultPublicGrpcMethods lists the methods callable without an access token.
+// A trailing "*" matches every method with that prefix.
+func DefaultPublicGrpcMethods() []string {
+	return []string{
+		"/pb.AuthService/RegisterUser",
+		"/pb.AuthService/LoginUser",
+		"/pb.AuthService/RefreshToken",
+		"/grpc.health.v1.Health/*",
+		"/grpc.reflection.*",
+	}
+}
+
+// TokenDenylist reports access tokens that were revoked before they expired.
+type TokenDenylist interface {
+	IsTokenDenied(ctx context.Context, tokenID string) bool
+}
+
+// AuthInterceptor validates the bearer token sent in the "authorization"
+// metadata and stores the authenticated user ID and token ID in the request
+// context. Tokens on the denylist are rejected; a nil denylist disables the
+// check.
+type AuthInterceptor struct {
+	token     auth.TokenManager
+	denylist  TokenDenylist
+	allowlist []string
+	logger    logger.LoggerInterface
+}
+
+func NewAuthInterceptor(token auth.TokenManager, denylist TokenDenylist, logger logger.LoggerInterface, allowlist ...string) *AuthInterceptor {
+	return &AuthInterceptor{
+		token:     token,
+		denylist:  denylist,
+		allowlist: allowlist,
+		logger:    logger,
+	}
+}
+
+func (a *AuthInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *AuthInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if a.isAllowlisted(fullMethod) {
+		return ctx, nil
+	}
+
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+
+	claims, err := a.token.ParseToken(token)
+	if err != nil {
+		a.logger.Debug("Rejected access token",
+			zap.String("method", fullMethod),
+			zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	// Refresh tokens are signed with the same key and must not be accepted
+	// in place of an access token.
+	if claims.Audience != auth.AudienceAccess {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	if claims.ID != "" && a.denylist != nil && a.denylist.IsTokenDenied(ctx, claims.ID) {
+		a.logger.Debug("Rejected revoked access token",
+			zap.String("method", fullMethod),
+			zap.Int("user_id", userID))
+		return nil, status.Error(codes.Unauthenticated, "access token has been revoked")
+	}
+
+	return auth.WithTokenID(auth.WithUserID(ctx, userID), claims.ID), nil
+}
+
+func (a *AuthInterceptor) isAllowlisted(fullMethod string) bool {
+	for _, pattern := range a.allowlist {
+		if matchPattern(pattern, fullMethod) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 {
+		return "", auth.ErrMissingToken
+	}
+
+	token := strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+	if token == "" {
+		return "", auth.ErrMissingToken
+	}
+
+	return token, nil
+}
+
+// ForwardTokenUnaryClientInterceptor copies the access token stored by
+// WebSecurityConfig onto the "authorization" metadata of outgoing calls.
+func ForwardTokenUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(forwardToken(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+func ForwardTokenStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(forwardToken(ctx), desc, cc, method, opts...)
+	}
+}
+
+func forwardToken(ctx context.Context) context.Context {
+	token, ok := auth.AccessTokenFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(authorizationMetadataKey)) > 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, "Bearer "+token)
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,81 @@
+package middlewares
+
+import (
+	"context"
+	"pointofsale/pkg/auth"
+	"pointofsale/pkg/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthorizationInterceptor checks the caller's roles against an AccessPolicy.
+// It relies on AuthInterceptor having stored the user ID in the context, so it
+// must come after it in the interceptor chain.
+type AuthorizationInterceptor struct {
+	policy *AccessPolicy
+	roles  RoleResolver
+	logger logger.LoggerInterface
+}
+
+func NewAuthorizationInterceptor(policy *AccessPolicy, roles RoleResolver, logger logger.LoggerInterface) *AuthorizationInterceptor {
+	return &AuthorizationInterceptor{
+		policy: policy,
+		roles:  roles,
+		logger: logger,
+	}
+}
+
+func (a *AuthorizationInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *AuthorizationInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *AuthorizationInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if a.policy.IsPublic(fullMethod) {
+		return ctx, nil
+	}
+
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+
+	roles, err := a.roles.ResolveRoles(ctx, userID)
+	if err != nil {
+		a.logger.Error("Failed to resolve caller roles",
+			zap.Int("user_id", userID),
+			zap.String("method", fullMethod),
+			zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to resolve caller roles")
+	}
+
+	if !a.policy.Allows(fullMethod, roles) {
+		a.logger.Debug("Permission denied",
+			zap.Int("user_id", userID),
+			zap.String("method", fullMethod),
+			zap.Strings("roles", roles))
+		return nil, status.Error(codes.PermissionDenied, "you do not have permission to call "+fullMethod)
+	}
+
+	return auth.WithRoles(ctx, roles), nil
+}
@@ -0,0 +1,93 @@
+package middlewares
+
+import (
+	"context"
+	"pointofsale/pkg/auth"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	forwardedForMetadataKey       = "x-forwarded-for"
+	forwardedUserAgentMetadataKey = "x-forwarded-user-agent"
+	userAgentMetadataKey          = "user-agent"
+)
+
+// ClientInfo stores the user agent and address of the HTTP caller so that
+// ForwardClientInfoUnaryClientInterceptor can pass them to the gRPC server.
+func ClientInfo() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := auth.WithClientInfo(c.Request().Context(), auth.ClientInfo{
+				UserAgent: c.Request().UserAgent(),
+				IPAddress: c.RealIP(),
+			})
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// ForwardClientInfoUnaryClientInterceptor copies the caller details stored by
+// ClientInfo onto the "x-forwarded-*" metadata of outgoing calls.
+func ForwardClientInfoUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		info := auth.ClientInfoFromContext(ctx)
+
+		if info.IPAddress != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, forwardedForMetadataKey, info.IPAddress)
+		}
+		if info.UserAgent != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, forwardedUserAgentMetadataKey, info.UserAgent)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// ClientInfoUnaryInterceptor stores the caller details in the request context.
+// Details forwarded by the API gateway take precedence over the connection
+// itself. They are only used to label sessions, so they are not verified.
+func ClientInfoUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(auth.WithClientInfo(ctx, clientInfoFromMetadata(ctx)), req)
+	}
+}
+
+func clientInfoFromMetadata(ctx context.Context) auth.ClientInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	info := auth.ClientInfo{
+		UserAgent: firstMetadataValue(md, forwardedUserAgentMetadataKey),
+	}
+
+	// X-Forwarded-For may list every proxy; the first entry is the client.
+	info.IPAddress, _, _ = strings.Cut(firstMetadataValue(md, forwardedForMetadataKey), ",")
+	info.IPAddress = strings.TrimSpace(info.IPAddress)
+
+	if info.UserAgent == "" {
+		info.UserAgent = firstMetadataValue(md, userAgentMetadataKey)
+	}
+
+	if info.IPAddress == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			info.IPAddress = p.Addr.String()
+		}
+	}
+
+	return info
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(values[0])
+}
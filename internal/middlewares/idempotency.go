@@ -0,0 +1,299 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/pkg/auth"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors"
+	"pointofsale/pkg/logger"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// IdempotencyKeyHeader is the HTTP header clients send to make a
+	// request safe to retry.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayedHeader is set on responses replayed from an earlier
+	// request with the same key.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	idempotencyKeyMetadataKey     = "idempotency-key"
+	idempotentReplayedMetadataKey = "idempotent-replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// DefaultIdempotentGrpcMethods lists the methods that honour an idempotency
+// key. A trailing "*" matches every method with that prefix.
+func DefaultIdempotentGrpcMethods() []string {
+	return []string{
+		"/pb.OrderService/Create",
+		"/pb.TransactionService/Create",
+	}
+}
+
+// IdempotencyStore claims idempotency keys and keeps the responses of the
+// requests that completed under them.
+type IdempotencyStore interface {
+	Begin(ctx context.Context, req *requests.IdempotencyRequest) (*db.IdempotencyKey, error)
+	Complete(ctx context.Context, claim *db.IdempotencyKey, response []byte) error
+	Release(ctx context.Context, claim *db.IdempotencyKey) error
+}
+
+// IdempotencyInterceptor runs each keyed request at most once per user and
+// method and replays the original response to retries. It relies on
+// AuthInterceptor having stored the user ID in the context, so it must come
+// after it in the interceptor chain.
+type IdempotencyInterceptor struct {
+	store   IdempotencyStore
+	methods []string
+	logger  logger.LoggerInterface
+}
+
+func NewIdempotencyInterceptor(store IdempotencyStore, logger logger.LoggerInterface, methods ...string) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{
+		store:   store,
+		methods: methods,
+		logger:  logger,
+	}
+}
+
+func (i *IdempotencyInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !i.applies(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKeyFromMetadata(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		if !validIdempotencyKey(key) {
+			return nil, status.Error(codes.InvalidArgument, "invalid idempotency key")
+		}
+
+		userID, ok := auth.UserIDFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestFingerprint(message)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to fingerprint request")
+		}
+
+		claim, err := i.store.Begin(ctx, &requests.IdempotencyRequest{
+			UserID:      userID,
+			Method:      info.FullMethod,
+			Key:         key,
+			RequestHash: hash,
+		})
+		if err != nil {
+			return nil, errors.ToGrpcError(err)
+		}
+
+		if claim.Response != nil {
+			return i.replay(ctx, claim)
+		}
+
+		resp, err := handler(ctx, req)
+
+		// The claim must be settled even when the deadline of the call has
+		// passed, otherwise retries stay blocked until the lock expires.
+		settleCtx := context.WithoutCancel(ctx)
+
+		if err != nil {
+			if releaseErr := i.store.Release(settleCtx, claim); releaseErr != nil {
+				i.logger.Error("Failed to release idempotency key",
+					zap.String("method", info.FullMethod),
+					zap.Error(releaseErr))
+			}
+
+			return nil, err
+		}
+
+		i.complete(settleCtx, claim, resp, info.FullMethod)
+
+		return resp, nil
+	}
+}
+
+func (i *IdempotencyInterceptor) applies(fullMethod string) bool {
+	for _, pattern := range i.methods {
+		if matchPattern(pattern, fullMethod) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (i *IdempotencyInterceptor) replay(ctx context.Context, claim *db.IdempotencyKey) (interface{}, error) {
+	var stored anypb.Any
+	if err := proto.Unmarshal(claim.Response, &stored); err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode stored response")
+	}
+
+	resp, err := stored.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to decode stored response")
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(idempotentReplayedMetadataKey, "true")); err != nil {
+		i.logger.Debug("Failed to mark replayed response", zap.Error(err))
+	}
+
+	return resp, nil
+}
+
+// complete stores the response for later replays. The request already
+// succeeded, so a failure here is logged rather than returned; retries then
+// wait for the claim to expire instead of running twice.
+func (i *IdempotencyInterceptor) complete(ctx context.Context, claim *db.IdempotencyKey, resp interface{}, fullMethod string) {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return
+	}
+
+	stored, err := anypb.New(message)
+	if err != nil {
+		i.logger.Error("Failed to encode idempotent response",
+			zap.String("method", fullMethod),
+			zap.Error(err))
+		return
+	}
+
+	payload, err := proto.Marshal(stored)
+	if err != nil {
+		i.logger.Error("Failed to encode idempotent response",
+			zap.String("method", fullMethod),
+			zap.Error(err))
+		return
+	}
+
+	if err := i.store.Complete(ctx, claim, payload); err != nil {
+		i.logger.Error("Failed to store idempotent response",
+			zap.String("method", fullMethod),
+			zap.Error(err))
+	}
+}
+
+// IdempotencyKey validates the Idempotency-Key header of a route and hands it
+// to ForwardIdempotencyKeyUnaryClientInterceptor. Responses the gRPC server
+// replayed carry the Idempotent-Replayed header.
+func IdempotencyKey() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := strings.TrimSpace(c.Request().Header.Get(IdempotencyKeyHeader))
+			if key == "" {
+				return next(c)
+			}
+
+			if !validIdempotencyKey(key) {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid "+IdempotencyKeyHeader+" header")
+			}
+
+			call := &idempotentCall{key: key}
+			c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), idempotentCallContextKey{}, call)))
+
+			c.Response().Before(func() {
+				if call.replayed {
+					c.Response().Header().Set(IdempotentReplayedHeader, "true")
+				}
+			})
+
+			return next(c)
+		}
+	}
+}
+
+// ForwardIdempotencyKeyUnaryClientInterceptor copies the key accepted by
+// IdempotencyKey onto the "idempotency-key" metadata of outgoing calls.
+func ForwardIdempotencyKeyUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		call, ok := ctx.Value(idempotentCallContextKey{}).(*idempotentCall)
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var header metadata.MD
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKeyMetadataKey, call.key)
+
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+
+		if values := header.Get(idempotentReplayedMetadataKey); len(values) > 0 && values[0] == "true" {
+			call.replayed = true
+		}
+
+		return err
+	}
+}
+
+type idempotentCallContextKey struct{}
+
+// idempotentCall carries a request's idempotency key from the Echo middleware
+// to the gRPC client and reports back whether the server replayed it.
+type idempotentCall struct {
+	key      string
+	replayed bool
+}
+
+func idempotencyKeyFromMetadata(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(idempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(values[0])
+}
+
+// validIdempotencyKey accepts up to 255 visible ASCII characters, which
+// covers UUIDs and the random tokens clients usually send.
+func validIdempotencyKey(key string) bool {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+
+	for i := 0; i < len(key); i++ {
+		if key[i] < '!' || key[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestFingerprint hashes the deterministic encoding of a request so that a
+// reused key can be told apart from a genuine retry.
+func requestFingerprint(message proto.Message) (string, error) {
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+
+	return hex.EncodeToString(sum[:]), nil
+}
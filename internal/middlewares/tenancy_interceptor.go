@@ -0,0 +1,90 @@
+package middlewares
+
+import (
+	"context"
+	"pointofsale/pkg/auth"
+	"pointofsale/pkg/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MerchantResolver returns the merchants a user may act for: the ones they
+// own and the ones they work at as a cashier.
+type MerchantResolver interface {
+	ResolveMerchants(ctx context.Context, userID int) ([]int, error)
+}
+
+type MerchantResolverFunc func(ctx context.Context, userID int) ([]int, error)
+
+func (f MerchantResolverFunc) ResolveMerchants(ctx context.Context, userID int) ([]int, error) {
+	return f(ctx, userID)
+}
+
+// TenancyInterceptor restricts every non-admin caller to their own merchants
+// by storing the merchant scope in the context, which the database applies
+// to every query. It relies on AuthorizationInterceptor having stored the
+// caller's roles, so it must come after it in the interceptor chain.
+type TenancyInterceptor struct {
+	policy    *AccessPolicy
+	merchants MerchantResolver
+	logger    logger.LoggerInterface
+}
+
+func NewTenancyInterceptor(policy *AccessPolicy, merchants MerchantResolver, logger logger.LoggerInterface) *TenancyInterceptor {
+	return &TenancyInterceptor{
+		policy:    policy,
+		merchants: merchants,
+		logger:    logger,
+	}
+}
+
+func (t *TenancyInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := t.scope(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (t *TenancyInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := t.scope(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (t *TenancyInterceptor) scope(ctx context.Context, fullMethod string) (context.Context, error) {
+	if t.policy.IsPublic(fullMethod) {
+		return ctx, nil
+	}
+
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing access token")
+	}
+
+	if auth.HasAnyRole(auth.RolesFromContext(ctx), auth.RoleAdmin) {
+		return ctx, nil
+	}
+
+	merchantIDs, err := t.merchants.ResolveMerchants(ctx, userID)
+	if err != nil {
+		t.logger.Error("Failed to resolve caller merchants",
+			zap.Int("user_id", userID),
+			zap.String("method", fullMethod),
+			zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to resolve caller merchants")
+	}
+
+	return auth.WithMerchantScope(ctx, merchantIDs), nil
+}
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -529,13 +530,217 @@ func (x *ApiResponseGetMe) GetData() *UserResponse {
 	return nil
 }
 
+type SessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionResponse) Reset() {
+	*x = SessionResponse{}
+	mi := &file_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResponse) ProtoMessage() {}
+
+func (x *SessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResponse.ProtoReflect.Descriptor instead.
+func (*SessionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SessionResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *SessionResponse) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ApiResponseLogout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseLogout) Reset() {
+	*x = ApiResponseLogout{}
+	mi := &file_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseLogout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseLogout) ProtoMessage() {}
+
+func (x *ApiResponseLogout) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseLogout.ProtoReflect.Descriptor instead.
+func (*ApiResponseLogout) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ApiResponseLogout) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseLogout) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ApiResponseSessions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*SessionResponse     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseSessions) Reset() {
+	*x = ApiResponseSessions{}
+	mi := &file_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseSessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseSessions) ProtoMessage() {}
+
+func (x *ApiResponseSessions) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseSessions.ProtoReflect.Descriptor instead.
+func (*ApiResponseSessions) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ApiResponseSessions) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseSessions) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponseSessions) GetData() []*SessionResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_auth_proto protoreflect.FileDescriptor
 
 const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"auth.proto\x12\x02pb\x1a\n" +
-	"user.proto\"\xa8\x01\n" +
+	"user.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xa8\x01\n" +
 	"\x0fRegisterRequest\x12\x1c\n" +
 	"\tfirstname\x18\x01 \x01(\tR\tfirstname\x12\x1a\n" +
 	"\blastname\x18\x02 \x01(\tR\blastname\x12\x14\n" +
@@ -567,12 +772,36 @@ const file_auth_proto_rawDesc = "" +
 	"\x10ApiResponseGetMe\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
-	"\x04data\x18\x03 \x01(\v2\x10.pb.UserResponseR\x04data2\xff\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.pb.UserResponseR\x04data\"\xe8\x01\n" +
+	"\x0fSessionResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\tR\tstartedAt\x12 \n" +
+	"\flast_used_at\x18\x05 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"E\n" +
+	"\x11ApiResponseLogout\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"p\n" +
+	"\x13ApiResponseSessions\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x04data\x18\x03 \x03(\v2\x13.pb.SessionResponseR\x04data2\xbb\x03\n" +
 	"\vAuthService\x12>\n" +
 	"\fRegisterUser\x12\x13.pb.RegisterRequest\x1a\x17.pb.ApiResponseRegister\"\x00\x125\n" +
 	"\tLoginUser\x12\x10.pb.LoginRequest\x1a\x14.pb.ApiResponseLogin\"\x00\x12F\n" +
 	"\fRefreshToken\x12\x17.pb.RefreshTokenRequest\x1a\x1b.pb.ApiResponseRefreshToken\"\x00\x121\n" +
-	"\x05GetMe\x12\x10.pb.GetMeRequest\x1a\x14.pb.ApiResponseGetMe\"\x00B\x19Z\x17pointofsale/internal/pbb\x06proto3"
+	"\x05GetMe\x12\x10.pb.GetMeRequest\x1a\x14.pb.ApiResponseGetMe\"\x00\x129\n" +
+	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x15.pb.ApiResponseLogout\"\x00\x12<\n" +
+	"\tLogoutAll\x12\x16.google.protobuf.Empty\x1a\x15.pb.ApiResponseLogout\"\x00\x12A\n" +
+	"\fListSessions\x12\x16.google.protobuf.Empty\x1a\x17.pb.ApiResponseSessions\"\x00B\x19Z\x17pointofsale/internal/pbb\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -586,7 +815,7 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_auth_proto_goTypes = []any{
 	(*RegisterRequest)(nil),         // 0: pb.RegisterRequest
 	(*LoginRequest)(nil),            // 1: pb.LoginRequest
@@ -597,26 +826,37 @@ var file_auth_proto_goTypes = []any{
 	(*ApiResponseRefreshToken)(nil), // 6: pb.ApiResponseRefreshToken
 	(*ApiResponseRegister)(nil),     // 7: pb.ApiResponseRegister
 	(*ApiResponseGetMe)(nil),        // 8: pb.ApiResponseGetMe
-	(*UserResponse)(nil),            // 9: pb.UserResponse
+	(*SessionResponse)(nil),         // 9: pb.SessionResponse
+	(*ApiResponseLogout)(nil),       // 10: pb.ApiResponseLogout
+	(*ApiResponseSessions)(nil),     // 11: pb.ApiResponseSessions
+	(*UserResponse)(nil),            // 12: pb.UserResponse
+	(*emptypb.Empty)(nil),           // 13: google.protobuf.Empty
 }
 var file_auth_proto_depIdxs = []int32{
-	4, // 0: pb.ApiResponseLogin.data:type_name -> pb.TokenResponse
-	4, // 1: pb.ApiResponseRefreshToken.data:type_name -> pb.TokenResponse
-	9, // 2: pb.ApiResponseRegister.data:type_name -> pb.UserResponse
-	9, // 3: pb.ApiResponseGetMe.data:type_name -> pb.UserResponse
-	0, // 4: pb.AuthService.RegisterUser:input_type -> pb.RegisterRequest
-	1, // 5: pb.AuthService.LoginUser:input_type -> pb.LoginRequest
-	2, // 6: pb.AuthService.RefreshToken:input_type -> pb.RefreshTokenRequest
-	3, // 7: pb.AuthService.GetMe:input_type -> pb.GetMeRequest
-	7, // 8: pb.AuthService.RegisterUser:output_type -> pb.ApiResponseRegister
-	5, // 9: pb.AuthService.LoginUser:output_type -> pb.ApiResponseLogin
-	6, // 10: pb.AuthService.RefreshToken:output_type -> pb.ApiResponseRefreshToken
-	8, // 11: pb.AuthService.GetMe:output_type -> pb.ApiResponseGetMe
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4,  // 0: pb.ApiResponseLogin.data:type_name -> pb.TokenResponse
+	4,  // 1: pb.ApiResponseRefreshToken.data:type_name -> pb.TokenResponse
+	12, // 2: pb.ApiResponseRegister.data:type_name -> pb.UserResponse
+	12, // 3: pb.ApiResponseGetMe.data:type_name -> pb.UserResponse
+	9,  // 4: pb.ApiResponseSessions.data:type_name -> pb.SessionResponse
+	0,  // 5: pb.AuthService.RegisterUser:input_type -> pb.RegisterRequest
+	1,  // 6: pb.AuthService.LoginUser:input_type -> pb.LoginRequest
+	2,  // 7: pb.AuthService.RefreshToken:input_type -> pb.RefreshTokenRequest
+	3,  // 8: pb.AuthService.GetMe:input_type -> pb.GetMeRequest
+	13, // 9: pb.AuthService.Logout:input_type -> google.protobuf.Empty
+	13, // 10: pb.AuthService.LogoutAll:input_type -> google.protobuf.Empty
+	13, // 11: pb.AuthService.ListSessions:input_type -> google.protobuf.Empty
+	7,  // 12: pb.AuthService.RegisterUser:output_type -> pb.ApiResponseRegister
+	5,  // 13: pb.AuthService.LoginUser:output_type -> pb.ApiResponseLogin
+	6,  // 14: pb.AuthService.RefreshToken:output_type -> pb.ApiResponseRefreshToken
+	8,  // 15: pb.AuthService.GetMe:output_type -> pb.ApiResponseGetMe
+	10, // 16: pb.AuthService.Logout:output_type -> pb.ApiResponseLogout
+	10, // 17: pb.AuthService.LogoutAll:output_type -> pb.ApiResponseLogout
+	11, // 18: pb.AuthService.ListSessions:output_type -> pb.ApiResponseSessions
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -631,7 +871,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -128,10 +128,11 @@ func (x *FindByIdCategoryRequest) GetId() int32 {
 }
 
 type FindYearCategory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Year                 int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,2,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *FindYearCategory) Reset() {
@@ -171,12 +172,20 @@ func (x *FindYearCategory) GetYear() int32 {
 	return 0
 }
 
+func (x *FindYearCategory) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
 type FindYearCategoryByMerchant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int32                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId           int32                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Year                 int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,3,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *FindYearCategoryByMerchant) Reset() {
@@ -223,12 +232,20 @@ func (x *FindYearCategoryByMerchant) GetYear() int32 {
 	return 0
 }
 
+func (x *FindYearCategoryByMerchant) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
 type FindYearCategoryById struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId           int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Year                 int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,3,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *FindYearCategoryById) Reset() {
@@ -275,6 +292,13 @@ func (x *FindYearCategoryById) GetYear() int32 {
 	return 0
 }
 
+func (x *FindYearCategoryById) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
 type FindYearMonthTotalPrices struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -372,12 +396,13 @@ func (x *FindYearTotalPrices) GetYear() int32 {
 }
 
 type FindYearMonthTotalPriceById struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
-	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Year                 int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month                int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	CategoryId           int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,4,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *FindYearMonthTotalPriceById) Reset() {
@@ -431,12 +456,20 @@ func (x *FindYearMonthTotalPriceById) GetCategoryId() int32 {
 	return 0
 }
 
+func (x *FindYearMonthTotalPriceById) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
 type FindYearTotalPriceById struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Year                 int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	CategoryId           int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,3,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *FindYearTotalPriceById) Reset() {
@@ -483,6 +516,13 @@ func (x *FindYearTotalPriceById) GetCategoryId() int32 {
 	return 0
 }
 
+func (x *FindYearTotalPriceById) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
 type FindYearMonthTotalPriceByMerchant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -599,6 +639,8 @@ type CreateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId      *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	MerchantId    *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -647,6 +689,184 @@ func (x *CreateCategoryRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateCategoryRequest) GetParentId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *CreateCategoryRequest) GetMerchantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MerchantId
+	}
+	return nil
+}
+
+type FindCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindCategoryTreeRequest) Reset() {
+	*x = FindCategoryTreeRequest{}
+	mi := &file_category_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCategoryTreeRequest) ProtoMessage() {}
+
+func (x *FindCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*FindCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FindCategoryTreeRequest) GetMerchantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MerchantId
+	}
+	return nil
+}
+
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ParentId      *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Position      *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_category_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MoveCategoryRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *MoveCategoryRequest) GetParentId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *MoveCategoryRequest) GetPosition() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type ReorderCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	MerchantId    *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryIds   []int32                `protobuf:"varint,3,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCategoriesRequest) Reset() {
+	*x = ReorderCategoriesRequest{}
+	mi := &file_category_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCategoriesRequest) ProtoMessage() {}
+
+func (x *ReorderCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReorderCategoriesRequest) GetParentId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *ReorderCategoriesRequest) GetMerchantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MerchantId
+	}
+	return nil
+}
+
+func (x *ReorderCategoriesRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
 type UpdateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
@@ -658,7 +878,7 @@ type UpdateCategoryRequest struct {
 
 func (x *UpdateCategoryRequest) Reset() {
 	*x = UpdateCategoryRequest{}
-	mi := &file_category_proto_msgTypes[12]
+	mi := &file_category_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -670,7 +890,7 @@ func (x *UpdateCategoryRequest) String() string {
 func (*UpdateCategoryRequest) ProtoMessage() {}
 
 func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[12]
+	mi := &file_category_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +903,7 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{12}
+	return file_category_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateCategoryRequest) GetCategoryId() int32 {
@@ -721,7 +941,7 @@ type CategoryMonthPriceResponse struct {
 
 func (x *CategoryMonthPriceResponse) Reset() {
 	*x = CategoryMonthPriceResponse{}
-	mi := &file_category_proto_msgTypes[13]
+	mi := &file_category_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -733,7 +953,7 @@ func (x *CategoryMonthPriceResponse) String() string {
 func (*CategoryMonthPriceResponse) ProtoMessage() {}
 
 func (x *CategoryMonthPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[13]
+	mi := &file_category_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,7 +966,7 @@ func (x *CategoryMonthPriceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryMonthPriceResponse.ProtoReflect.Descriptor instead.
 func (*CategoryMonthPriceResponse) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{13}
+	return file_category_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CategoryMonthPriceResponse) GetMonth() string {
@@ -806,7 +1026,7 @@ type CategoryYearPriceResponse struct {
 
 func (x *CategoryYearPriceResponse) Reset() {
 	*x = CategoryYearPriceResponse{}
-	mi := &file_category_proto_msgTypes[14]
+	mi := &file_category_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -818,7 +1038,7 @@ func (x *CategoryYearPriceResponse) String() string {
 func (*CategoryYearPriceResponse) ProtoMessage() {}
 
 func (x *CategoryYearPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[14]
+	mi := &file_category_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1051,7 @@ func (x *CategoryYearPriceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryYearPriceResponse.ProtoReflect.Descriptor instead.
 func (*CategoryYearPriceResponse) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{14}
+	return file_category_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CategoryYearPriceResponse) GetYear() string {
@@ -892,13 +1112,16 @@ type CategoryResponse struct {
 	ImageCategory string                 `protobuf:"bytes,5,opt,name=image_category,json=imageCategory,proto3" json:"image_category,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentId      *wrapperspb.Int32Value `protobuf:"bytes,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	MerchantId    *wrapperspb.Int32Value `protobuf:"bytes,9,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Position      int32                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CategoryResponse) Reset() {
 	*x = CategoryResponse{}
-	mi := &file_category_proto_msgTypes[15]
+	mi := &file_category_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -910,7 +1133,7 @@ func (x *CategoryResponse) String() string {
 func (*CategoryResponse) ProtoMessage() {}
 
 func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[15]
+	mi := &file_category_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +1146,7 @@ func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryResponse.ProtoReflect.Descriptor instead.
 func (*CategoryResponse) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{15}
+	return file_category_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CategoryResponse) GetId() int32 {
@@ -975,6 +1198,79 @@ func (x *CategoryResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *CategoryResponse) GetParentId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *CategoryResponse) GetMerchantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MerchantId
+	}
+	return nil
+}
+
+func (x *CategoryResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CategoryTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *CategoryResponse      `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryTreeNode    `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTreeNode) Reset() {
+	*x = CategoryTreeNode{}
+	mi := &file_category_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeNode) ProtoMessage() {}
+
+func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
+func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CategoryTreeNode) GetCategory() *CategoryResponse {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryTreeNode) GetChildren() []*CategoryTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 type CategoryResponseDeleteAt struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -985,13 +1281,16 @@ type CategoryResponseDeleteAt struct {
 	CreatedAt     string                  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	ParentId      *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	MerchantId    *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Position      int32                   `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CategoryResponseDeleteAt) Reset() {
 	*x = CategoryResponseDeleteAt{}
-	mi := &file_category_proto_msgTypes[16]
+	mi := &file_category_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1003,7 +1302,7 @@ func (x *CategoryResponseDeleteAt) String() string {
 func (*CategoryResponseDeleteAt) ProtoMessage() {}
 
 func (x *CategoryResponseDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[16]
+	mi := &file_category_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1315,7 @@ func (x *CategoryResponseDeleteAt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryResponseDeleteAt.ProtoReflect.Descriptor instead.
 func (*CategoryResponseDeleteAt) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{16}
+	return file_category_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CategoryResponseDeleteAt) GetId() int32 {
@@ -1075,6 +1374,27 @@ func (x *CategoryResponseDeleteAt) GetDeletedAt() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *CategoryResponseDeleteAt) GetParentId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *CategoryResponseDeleteAt) GetMerchantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MerchantId
+	}
+	return nil
+}
+
+func (x *CategoryResponseDeleteAt) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type CategoriesMonthlyTotalPriceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -1086,7 +1406,7 @@ type CategoriesMonthlyTotalPriceResponse struct {
 
 func (x *CategoriesMonthlyTotalPriceResponse) Reset() {
 	*x = CategoriesMonthlyTotalPriceResponse{}
-	mi := &file_category_proto_msgTypes[17]
+	mi := &file_category_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1098,7 +1418,7 @@ func (x *CategoriesMonthlyTotalPriceResponse) String() string {
 func (*CategoriesMonthlyTotalPriceResponse) ProtoMessage() {}
 
 func (x *CategoriesMonthlyTotalPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[17]
+	mi := &file_category_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1431,7 @@ func (x *CategoriesMonthlyTotalPriceResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CategoriesMonthlyTotalPriceResponse.ProtoReflect.Descriptor instead.
 func (*CategoriesMonthlyTotalPriceResponse) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{17}
+	return file_category_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CategoriesMonthlyTotalPriceResponse) GetYear() string {
@@ -1145,7 +1465,7 @@ type CategoriesYearlyTotalPriceResponse struct {
 
 func (x *CategoriesYearlyTotalPriceResponse) Reset() {
 	*x = CategoriesYearlyTotalPriceResponse{}
-	mi := &file_category_proto_msgTypes[18]
+	mi := &file_category_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1157,7 +1477,7 @@ func (x *CategoriesYearlyTotalPriceResponse) String() string {
 func (*CategoriesYearlyTotalPriceResponse) ProtoMessage() {}
 
 func (x *CategoriesYearlyTotalPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[18]
+	mi := &file_category_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,7 +1490,7 @@ func (x *CategoriesYearlyTotalPriceResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CategoriesYearlyTotalPriceResponse.ProtoReflect.Descriptor instead.
 func (*CategoriesYearlyTotalPriceResponse) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{18}
+	return file_category_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CategoriesYearlyTotalPriceResponse) GetYear() string {
@@ -1198,7 +1518,7 @@ type ApiResponseCategoryMonthPrice struct {
 
 func (x *ApiResponseCategoryMonthPrice) Reset() {
 	*x = ApiResponseCategoryMonthPrice{}
-	mi := &file_category_proto_msgTypes[19]
+	mi := &file_category_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1210,7 +1530,7 @@ func (x *ApiResponseCategoryMonthPrice) String() string {
 func (*ApiResponseCategoryMonthPrice) ProtoMessage() {}
 
 func (x *ApiResponseCategoryMonthPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[19]
+	mi := &file_category_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,7 +1543,7 @@ func (x *ApiResponseCategoryMonthPrice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseCategoryMonthPrice.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategoryMonthPrice) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{19}
+	return file_category_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ApiResponseCategoryMonthPrice) GetStatus() string {
@@ -1258,7 +1578,7 @@ type ApiResponseCategoryYearPrice struct {
 
 func (x *ApiResponseCategoryYearPrice) Reset() {
 	*x = ApiResponseCategoryYearPrice{}
-	mi := &file_category_proto_msgTypes[20]
+	mi := &file_category_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1270,7 +1590,7 @@ func (x *ApiResponseCategoryYearPrice) String() string {
 func (*ApiResponseCategoryYearPrice) ProtoMessage() {}
 
 func (x *ApiResponseCategoryYearPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[20]
+	mi := &file_category_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,7 +1603,7 @@ func (x *ApiResponseCategoryYearPrice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseCategoryYearPrice.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategoryYearPrice) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{20}
+	return file_category_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ApiResponseCategoryYearPrice) GetStatus() string {
@@ -1318,7 +1638,7 @@ type ApiResponseCategory struct {
 
 func (x *ApiResponseCategory) Reset() {
 	*x = ApiResponseCategory{}
-	mi := &file_category_proto_msgTypes[21]
+	mi := &file_category_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1330,7 +1650,7 @@ func (x *ApiResponseCategory) String() string {
 func (*ApiResponseCategory) ProtoMessage() {}
 
 func (x *ApiResponseCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[21]
+	mi := &file_category_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1663,7 @@ func (x *ApiResponseCategory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseCategory.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategory) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{21}
+	return file_category_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ApiResponseCategory) GetStatus() string {
@@ -1378,7 +1698,7 @@ type ApiResponseCategoryDeleteAt struct {
 
 func (x *ApiResponseCategoryDeleteAt) Reset() {
 	*x = ApiResponseCategoryDeleteAt{}
-	mi := &file_category_proto_msgTypes[22]
+	mi := &file_category_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1390,7 +1710,7 @@ func (x *ApiResponseCategoryDeleteAt) String() string {
 func (*ApiResponseCategoryDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponseCategoryDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[22]
+	mi := &file_category_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,7 +1723,7 @@ func (x *ApiResponseCategoryDeleteAt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseCategoryDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategoryDeleteAt) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{22}
+	return file_category_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ApiResponseCategoryDeleteAt) GetStatus() string {
@@ -1438,7 +1758,7 @@ type ApiResponsesCategory struct {
 
 func (x *ApiResponsesCategory) Reset() {
 	*x = ApiResponsesCategory{}
-	mi := &file_category_proto_msgTypes[23]
+	mi := &file_category_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1450,7 +1770,7 @@ func (x *ApiResponsesCategory) String() string {
 func (*ApiResponsesCategory) ProtoMessage() {}
 
 func (x *ApiResponsesCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[23]
+	mi := &file_category_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +1783,7 @@ func (x *ApiResponsesCategory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsesCategory.ProtoReflect.Descriptor instead.
 func (*ApiResponsesCategory) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{23}
+	return file_category_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ApiResponsesCategory) GetStatus() string {
@@ -1487,6 +1807,66 @@ func (x *ApiResponsesCategory) GetData() []*CategoryResponse {
 	return nil
 }
 
+type ApiResponseCategoryTree struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*CategoryTreeNode    `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseCategoryTree) Reset() {
+	*x = ApiResponseCategoryTree{}
+	mi := &file_category_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseCategoryTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseCategoryTree) ProtoMessage() {}
+
+func (x *ApiResponseCategoryTree) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseCategoryTree.ProtoReflect.Descriptor instead.
+func (*ApiResponseCategoryTree) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApiResponseCategoryTree) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseCategoryTree) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponseCategoryTree) GetData() []*CategoryTreeNode {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type ApiResponseCategoryDelete struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -1497,7 +1877,7 @@ type ApiResponseCategoryDelete struct {
 
 func (x *ApiResponseCategoryDelete) Reset() {
 	*x = ApiResponseCategoryDelete{}
-	mi := &file_category_proto_msgTypes[24]
+	mi := &file_category_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1509,7 +1889,7 @@ func (x *ApiResponseCategoryDelete) String() string {
 func (*ApiResponseCategoryDelete) ProtoMessage() {}
 
 func (x *ApiResponseCategoryDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[24]
+	mi := &file_category_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1902,7 @@ func (x *ApiResponseCategoryDelete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseCategoryDelete.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategoryDelete) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{24}
+	return file_category_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ApiResponseCategoryDelete) GetStatus() string {
@@ -1549,7 +1929,7 @@ type ApiResponseCategoryAll struct {
 
 func (x *ApiResponseCategoryAll) Reset() {
 	*x = ApiResponseCategoryAll{}
-	mi := &file_category_proto_msgTypes[25]
+	mi := &file_category_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1561,7 +1941,7 @@ func (x *ApiResponseCategoryAll) String() string {
 func (*ApiResponseCategoryAll) ProtoMessage() {}
 
 func (x *ApiResponseCategoryAll) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[25]
+	mi := &file_category_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,7 +1954,7 @@ func (x *ApiResponseCategoryAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseCategoryAll.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategoryAll) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{25}
+	return file_category_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ApiResponseCategoryAll) GetStatus() string {
@@ -1603,7 +1983,7 @@ type ApiResponsePaginationCategoryDeleteAt struct {
 
 func (x *ApiResponsePaginationCategoryDeleteAt) Reset() {
 	*x = ApiResponsePaginationCategoryDeleteAt{}
-	mi := &file_category_proto_msgTypes[26]
+	mi := &file_category_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1615,7 +1995,7 @@ func (x *ApiResponsePaginationCategoryDeleteAt) String() string {
 func (*ApiResponsePaginationCategoryDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponsePaginationCategoryDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[26]
+	mi := &file_category_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,7 +2008,7 @@ func (x *ApiResponsePaginationCategoryDeleteAt) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ApiResponsePaginationCategoryDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationCategoryDeleteAt) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{26}
+	return file_category_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ApiResponsePaginationCategoryDeleteAt) GetStatus() string {
@@ -1671,7 +2051,7 @@ type ApiResponsePaginationCategory struct {
 
 func (x *ApiResponsePaginationCategory) Reset() {
 	*x = ApiResponsePaginationCategory{}
-	mi := &file_category_proto_msgTypes[27]
+	mi := &file_category_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1683,7 +2063,7 @@ func (x *ApiResponsePaginationCategory) String() string {
 func (*ApiResponsePaginationCategory) ProtoMessage() {}
 
 func (x *ApiResponsePaginationCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[27]
+	mi := &file_category_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +2076,7 @@ func (x *ApiResponsePaginationCategory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsePaginationCategory.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationCategory) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{27}
+	return file_category_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ApiResponsePaginationCategory) GetStatus() string {
@@ -1738,7 +2118,7 @@ type ApiResponseCategoryMonthlyTotalPrice struct {
 
 func (x *ApiResponseCategoryMonthlyTotalPrice) Reset() {
 	*x = ApiResponseCategoryMonthlyTotalPrice{}
-	mi := &file_category_proto_msgTypes[28]
+	mi := &file_category_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1750,7 +2130,7 @@ func (x *ApiResponseCategoryMonthlyTotalPrice) String() string {
 func (*ApiResponseCategoryMonthlyTotalPrice) ProtoMessage() {}
 
 func (x *ApiResponseCategoryMonthlyTotalPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[28]
+	mi := &file_category_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,7 +2143,7 @@ func (x *ApiResponseCategoryMonthlyTotalPrice) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ApiResponseCategoryMonthlyTotalPrice.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategoryMonthlyTotalPrice) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{28}
+	return file_category_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ApiResponseCategoryMonthlyTotalPrice) GetStatus() string {
@@ -1798,7 +2178,7 @@ type ApiResponseCategoryYearlyTotalPrice struct {
 
 func (x *ApiResponseCategoryYearlyTotalPrice) Reset() {
 	*x = ApiResponseCategoryYearlyTotalPrice{}
-	mi := &file_category_proto_msgTypes[29]
+	mi := &file_category_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1810,7 +2190,7 @@ func (x *ApiResponseCategoryYearlyTotalPrice) String() string {
 func (*ApiResponseCategoryYearlyTotalPrice) ProtoMessage() {}
 
 func (x *ApiResponseCategoryYearlyTotalPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_category_proto_msgTypes[29]
+	mi := &file_category_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1823,7 +2203,7 @@ func (x *ApiResponseCategoryYearlyTotalPrice) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ApiResponseCategoryYearlyTotalPrice.ProtoReflect.Descriptor instead.
 func (*ApiResponseCategoryYearlyTotalPrice) Descriptor() ([]byte, []int) {
-	return file_category_proto_rawDescGZIP(), []int{29}
+	return file_category_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ApiResponseCategoryYearlyTotalPrice) GetStatus() string {
@@ -1857,31 +2237,36 @@ const file_category_proto_rawDesc = "" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\")\n" +
 	"\x17FindByIdCategoryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"&\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"[\n" +
 	"\x10FindYearCategory\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\x05R\x04year\"Q\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x123\n" +
+	"\x15include_subcategories\x18\x02 \x01(\bR\x14includeSubcategories\"\x86\x01\n" +
 	"\x1aFindYearCategoryByMerchant\x12\x1f\n" +
 	"\vmerchant_id\x18\x01 \x01(\x05R\n" +
 	"merchantId\x12\x12\n" +
-	"\x04year\x18\x02 \x01(\x05R\x04year\"K\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x123\n" +
+	"\x15include_subcategories\x18\x03 \x01(\bR\x14includeSubcategories\"\x80\x01\n" +
 	"\x14FindYearCategoryById\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x05R\n" +
 	"categoryId\x12\x12\n" +
-	"\x04year\x18\x02 \x01(\x05R\x04year\"D\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x123\n" +
+	"\x15include_subcategories\x18\x03 \x01(\bR\x14includeSubcategories\"D\n" +
 	"\x18FindYearMonthTotalPrices\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\x05R\x05month\")\n" +
 	"\x13FindYearTotalPrices\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\x05R\x04year\"h\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\"\x9d\x01\n" +
 	"\x1bFindYearMonthTotalPriceById\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\x05R\n" +
-	"categoryId\"M\n" +
+	"categoryId\x123\n" +
+	"\x15include_subcategories\x18\x04 \x01(\bR\x14includeSubcategories\"\x82\x01\n" +
 	"\x16FindYearTotalPriceById\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x05R\n" +
-	"categoryId\"n\n" +
+	"categoryId\x123\n" +
+	"\x15include_subcategories\x18\x03 \x01(\bR\x14includeSubcategories\"n\n" +
 	"!FindYearMonthTotalPriceByMerchant\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x1f\n" +
@@ -1890,10 +2275,26 @@ const file_category_proto_rawDesc = "" +
 	"\x1cFindYearTotalPriceByMerchant\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x05R\n" +
-	"merchantId\"M\n" +
+	"merchantId\"\xc5\x01\n" +
 	"\x15CreateCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"n\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x128\n" +
+	"\tparent_id\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\bparentId\x12<\n" +
+	"\vmerchant_id\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"merchantId\"W\n" +
+	"\x17FindCategoryTreeRequest\x12<\n" +
+	"\vmerchant_id\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"merchantId\"\xa9\x01\n" +
+	"\x13MoveCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x128\n" +
+	"\tparent_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\bparentId\x127\n" +
+	"\bposition\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\bposition\"\xb5\x01\n" +
+	"\x18ReorderCategoriesRequest\x128\n" +
+	"\tparent_id\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\bparentId\x12<\n" +
+	"\vmerchant_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"merchantId\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\x05R\vcategoryIds\"n\n" +
 	"\x15UpdateCategoryRequest\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x05R\n" +
 	"categoryId\x12\x12\n" +
@@ -1919,7 +2320,7 @@ const file_category_proto_rawDesc = "" +
 	"\n" +
 	"items_sold\x18\x05 \x01(\x05R\titemsSold\x12#\n" +
 	"\rtotal_revenue\x18\x06 \x01(\x05R\ftotalRevenue\x120\n" +
-	"\x14unique_products_sold\x18\a \x01(\x05R\x12uniqueProductsSold\"\xe2\x01\n" +
+	"\x14unique_products_sold\x18\a \x01(\x05R\x12uniqueProductsSold\"\xf6\x02\n" +
 	"\x10CategoryResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1929,7 +2330,15 @@ const file_category_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xa7\x02\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x128\n" +
+	"\tparent_id\x18\b \x01(\v2\x1b.google.protobuf.Int32ValueR\bparentId\x12<\n" +
+	"\vmerchant_id\x18\t \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"merchantId\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\"v\n" +
+	"\x10CategoryTreeNode\x120\n" +
+	"\bcategory\x18\x01 \x01(\v2\x14.pb.CategoryResponseR\bcategory\x120\n" +
+	"\bchildren\x18\x02 \x03(\v2\x14.pb.CategoryTreeNodeR\bchildren\"\xbb\x03\n" +
 	"\x18CategoryResponseDeleteAt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1941,7 +2350,12 @@ const file_category_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\a \x01(\tR\tupdatedAt\x12;\n" +
 	"\n" +
-	"deleted_at\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\"t\n" +
+	"deleted_at\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\x128\n" +
+	"\tparent_id\x18\t \x01(\v2\x1b.google.protobuf.Int32ValueR\bparentId\x12<\n" +
+	"\vmerchant_id\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"merchantId\x12\x1a\n" +
+	"\bposition\x18\v \x01(\x05R\bposition\"t\n" +
 	"#CategoriesMonthlyTotalPriceResponse\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\tR\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\tR\x05month\x12#\n" +
@@ -1968,7 +2382,11 @@ const file_category_proto_rawDesc = "" +
 	"\x14ApiResponsesCategory\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x04data\x18\x03 \x03(\v2\x14.pb.CategoryResponseR\x04data\"M\n" +
+	"\x04data\x18\x03 \x03(\v2\x14.pb.CategoryResponseR\x04data\"u\n" +
+	"\x17ApiResponseCategoryTree\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x04data\x18\x03 \x03(\v2\x14.pb.CategoryTreeNodeR\x04data\"M\n" +
 	"\x19ApiResponseCategoryDelete\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"J\n" +
@@ -1996,7 +2414,7 @@ const file_category_proto_rawDesc = "" +
 	"#ApiResponseCategoryYearlyTotalPrice\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
-	"\x04data\x18\x03 \x03(\v2&.pb.CategoriesYearlyTotalPriceResponseR\x04data2\xd0\x0f\n" +
+	"\x04data\x18\x03 \x03(\v2&.pb.CategoriesYearlyTotalPriceResponseR\x04data2\xa5\x11\n" +
 	"\x0fCategoryService\x12b\n" +
 	"\x16FindMonthlyTotalPrices\x12\x1c.pb.FindYearMonthTotalPrices\x1a(.pb.ApiResponseCategoryMonthlyTotalPrice\"\x00\x12[\n" +
 	"\x15FindYearlyTotalPrices\x12\x17.pb.FindYearTotalPrices\x1a'.pb.ApiResponseCategoryYearlyTotalPrice\"\x00\x12i\n" +
@@ -2013,12 +2431,15 @@ const file_category_proto_rawDesc = "" +
 	"\fFindByActive\x12\x1a.pb.FindAllCategoryRequest\x1a).pb.ApiResponsePaginationCategoryDeleteAt\"\x00\x12X\n" +
 	"\rFindByTrashed\x12\x1a.pb.FindAllCategoryRequest\x1a).pb.ApiResponsePaginationCategoryDeleteAt\"\x00\x12H\n" +
 	"\aFindAll\x12\x1a.pb.FindAllCategoryRequest\x1a!.pb.ApiResponsePaginationCategory\x12@\n" +
-	"\bFindById\x12\x1b.pb.FindByIdCategoryRequest\x1a\x17.pb.ApiResponseCategory\x12<\n" +
+	"\bFindById\x12\x1b.pb.FindByIdCategoryRequest\x1a\x17.pb.ApiResponseCategory\x12D\n" +
+	"\bFindTree\x12\x1b.pb.FindCategoryTreeRequest\x1a\x1b.pb.ApiResponseCategoryTree\x12<\n" +
 	"\x06Create\x12\x19.pb.CreateCategoryRequest\x1a\x17.pb.ApiResponseCategory\x12<\n" +
 	"\x06Update\x12\x19.pb.UpdateCategoryRequest\x1a\x17.pb.ApiResponseCategory\x12O\n" +
 	"\x0fTrashedCategory\x12\x1b.pb.FindByIdCategoryRequest\x1a\x1f.pb.ApiResponseCategoryDeleteAt\x12O\n" +
 	"\x0fRestoreCategory\x12\x1b.pb.FindByIdCategoryRequest\x1a\x1f.pb.ApiResponseCategoryDeleteAt\x12U\n" +
-	"\x17DeleteCategoryPermanent\x12\x1b.pb.FindByIdCategoryRequest\x1a\x1d.pb.ApiResponseCategoryDelete\x12J\n" +
+	"\x17DeleteCategoryPermanent\x12\x1b.pb.FindByIdCategoryRequest\x1a\x1d.pb.ApiResponseCategoryDelete\x12@\n" +
+	"\fMoveCategory\x12\x17.pb.MoveCategoryRequest\x1a\x17.pb.ApiResponseCategory\x12K\n" +
+	"\x11ReorderCategories\x12\x1c.pb.ReorderCategoriesRequest\x1a\x18.pb.ApiResponsesCategory\x12J\n" +
 	"\x12RestoreAllCategory\x12\x16.google.protobuf.Empty\x1a\x1a.pb.ApiResponseCategoryAll\"\x00\x12R\n" +
 	"\x1aDeleteAllCategoryPermanent\x12\x16.google.protobuf.Empty\x1a\x1a.pb.ApiResponseCategoryAll\"\x00B\x19Z\x17pointofsale/internal/pbb\x06proto3"
 
@@ -2034,7 +2455,7 @@ func file_category_proto_rawDescGZIP() []byte {
 	return file_category_proto_rawDescData
 }
 
-var file_category_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_category_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_category_proto_goTypes = []any{
 	(*FindAllCategoryRequest)(nil),                // 0: pb.FindAllCategoryRequest
 	(*FindByIdCategoryRequest)(nil),               // 1: pb.FindByIdCategoryRequest
@@ -2048,92 +2469,118 @@ var file_category_proto_goTypes = []any{
 	(*FindYearMonthTotalPriceByMerchant)(nil),     // 9: pb.FindYearMonthTotalPriceByMerchant
 	(*FindYearTotalPriceByMerchant)(nil),          // 10: pb.FindYearTotalPriceByMerchant
 	(*CreateCategoryRequest)(nil),                 // 11: pb.CreateCategoryRequest
-	(*UpdateCategoryRequest)(nil),                 // 12: pb.UpdateCategoryRequest
-	(*CategoryMonthPriceResponse)(nil),            // 13: pb.CategoryMonthPriceResponse
-	(*CategoryYearPriceResponse)(nil),             // 14: pb.CategoryYearPriceResponse
-	(*CategoryResponse)(nil),                      // 15: pb.CategoryResponse
-	(*CategoryResponseDeleteAt)(nil),              // 16: pb.CategoryResponseDeleteAt
-	(*CategoriesMonthlyTotalPriceResponse)(nil),   // 17: pb.CategoriesMonthlyTotalPriceResponse
-	(*CategoriesYearlyTotalPriceResponse)(nil),    // 18: pb.CategoriesYearlyTotalPriceResponse
-	(*ApiResponseCategoryMonthPrice)(nil),         // 19: pb.ApiResponseCategoryMonthPrice
-	(*ApiResponseCategoryYearPrice)(nil),          // 20: pb.ApiResponseCategoryYearPrice
-	(*ApiResponseCategory)(nil),                   // 21: pb.ApiResponseCategory
-	(*ApiResponseCategoryDeleteAt)(nil),           // 22: pb.ApiResponseCategoryDeleteAt
-	(*ApiResponsesCategory)(nil),                  // 23: pb.ApiResponsesCategory
-	(*ApiResponseCategoryDelete)(nil),             // 24: pb.ApiResponseCategoryDelete
-	(*ApiResponseCategoryAll)(nil),                // 25: pb.ApiResponseCategoryAll
-	(*ApiResponsePaginationCategoryDeleteAt)(nil), // 26: pb.ApiResponsePaginationCategoryDeleteAt
-	(*ApiResponsePaginationCategory)(nil),         // 27: pb.ApiResponsePaginationCategory
-	(*ApiResponseCategoryMonthlyTotalPrice)(nil),  // 28: pb.ApiResponseCategoryMonthlyTotalPrice
-	(*ApiResponseCategoryYearlyTotalPrice)(nil),   // 29: pb.ApiResponseCategoryYearlyTotalPrice
-	(*wrapperspb.StringValue)(nil),                // 30: google.protobuf.StringValue
-	(*PaginationMeta)(nil),                        // 31: pb.PaginationMeta
-	(*emptypb.Empty)(nil),                         // 32: google.protobuf.Empty
+	(*FindCategoryTreeRequest)(nil),               // 12: pb.FindCategoryTreeRequest
+	(*MoveCategoryRequest)(nil),                   // 13: pb.MoveCategoryRequest
+	(*ReorderCategoriesRequest)(nil),              // 14: pb.ReorderCategoriesRequest
+	(*UpdateCategoryRequest)(nil),                 // 15: pb.UpdateCategoryRequest
+	(*CategoryMonthPriceResponse)(nil),            // 16: pb.CategoryMonthPriceResponse
+	(*CategoryYearPriceResponse)(nil),             // 17: pb.CategoryYearPriceResponse
+	(*CategoryResponse)(nil),                      // 18: pb.CategoryResponse
+	(*CategoryTreeNode)(nil),                      // 19: pb.CategoryTreeNode
+	(*CategoryResponseDeleteAt)(nil),              // 20: pb.CategoryResponseDeleteAt
+	(*CategoriesMonthlyTotalPriceResponse)(nil),   // 21: pb.CategoriesMonthlyTotalPriceResponse
+	(*CategoriesYearlyTotalPriceResponse)(nil),    // 22: pb.CategoriesYearlyTotalPriceResponse
+	(*ApiResponseCategoryMonthPrice)(nil),         // 23: pb.ApiResponseCategoryMonthPrice
+	(*ApiResponseCategoryYearPrice)(nil),          // 24: pb.ApiResponseCategoryYearPrice
+	(*ApiResponseCategory)(nil),                   // 25: pb.ApiResponseCategory
+	(*ApiResponseCategoryDeleteAt)(nil),           // 26: pb.ApiResponseCategoryDeleteAt
+	(*ApiResponsesCategory)(nil),                  // 27: pb.ApiResponsesCategory
+	(*ApiResponseCategoryTree)(nil),               // 28: pb.ApiResponseCategoryTree
+	(*ApiResponseCategoryDelete)(nil),             // 29: pb.ApiResponseCategoryDelete
+	(*ApiResponseCategoryAll)(nil),                // 30: pb.ApiResponseCategoryAll
+	(*ApiResponsePaginationCategoryDeleteAt)(nil), // 31: pb.ApiResponsePaginationCategoryDeleteAt
+	(*ApiResponsePaginationCategory)(nil),         // 32: pb.ApiResponsePaginationCategory
+	(*ApiResponseCategoryMonthlyTotalPrice)(nil),  // 33: pb.ApiResponseCategoryMonthlyTotalPrice
+	(*ApiResponseCategoryYearlyTotalPrice)(nil),   // 34: pb.ApiResponseCategoryYearlyTotalPrice
+	(*wrapperspb.Int32Value)(nil),                 // 35: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil),                // 36: google.protobuf.StringValue
+	(*PaginationMeta)(nil),                        // 37: pb.PaginationMeta
+	(*emptypb.Empty)(nil),                         // 38: google.protobuf.Empty
 }
 var file_category_proto_depIdxs = []int32{
-	30, // 0: pb.CategoryResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
-	13, // 1: pb.ApiResponseCategoryMonthPrice.data:type_name -> pb.CategoryMonthPriceResponse
-	14, // 2: pb.ApiResponseCategoryYearPrice.data:type_name -> pb.CategoryYearPriceResponse
-	15, // 3: pb.ApiResponseCategory.data:type_name -> pb.CategoryResponse
-	16, // 4: pb.ApiResponseCategoryDeleteAt.data:type_name -> pb.CategoryResponseDeleteAt
-	15, // 5: pb.ApiResponsesCategory.data:type_name -> pb.CategoryResponse
-	16, // 6: pb.ApiResponsePaginationCategoryDeleteAt.data:type_name -> pb.CategoryResponseDeleteAt
-	31, // 7: pb.ApiResponsePaginationCategoryDeleteAt.pagination:type_name -> pb.PaginationMeta
-	15, // 8: pb.ApiResponsePaginationCategory.data:type_name -> pb.CategoryResponse
-	31, // 9: pb.ApiResponsePaginationCategory.pagination:type_name -> pb.PaginationMeta
-	17, // 10: pb.ApiResponseCategoryMonthlyTotalPrice.data:type_name -> pb.CategoriesMonthlyTotalPriceResponse
-	18, // 11: pb.ApiResponseCategoryYearlyTotalPrice.data:type_name -> pb.CategoriesYearlyTotalPriceResponse
-	5,  // 12: pb.CategoryService.FindMonthlyTotalPrices:input_type -> pb.FindYearMonthTotalPrices
-	6,  // 13: pb.CategoryService.FindYearlyTotalPrices:input_type -> pb.FindYearTotalPrices
-	7,  // 14: pb.CategoryService.FindMonthlyTotalPricesById:input_type -> pb.FindYearMonthTotalPriceById
-	8,  // 15: pb.CategoryService.FindYearlyTotalPricesById:input_type -> pb.FindYearTotalPriceById
-	9,  // 16: pb.CategoryService.FindMonthlyTotalPricesByMerchant:input_type -> pb.FindYearMonthTotalPriceByMerchant
-	10, // 17: pb.CategoryService.FindYearlyTotalPricesByMerchant:input_type -> pb.FindYearTotalPriceByMerchant
-	2,  // 18: pb.CategoryService.FindMonthPrice:input_type -> pb.FindYearCategory
-	2,  // 19: pb.CategoryService.FindYearPrice:input_type -> pb.FindYearCategory
-	3,  // 20: pb.CategoryService.FindMonthPriceByMerchant:input_type -> pb.FindYearCategoryByMerchant
-	3,  // 21: pb.CategoryService.FindYearPriceByMerchant:input_type -> pb.FindYearCategoryByMerchant
-	4,  // 22: pb.CategoryService.FindMonthPriceById:input_type -> pb.FindYearCategoryById
-	4,  // 23: pb.CategoryService.FindYearPriceById:input_type -> pb.FindYearCategoryById
-	0,  // 24: pb.CategoryService.FindByActive:input_type -> pb.FindAllCategoryRequest
-	0,  // 25: pb.CategoryService.FindByTrashed:input_type -> pb.FindAllCategoryRequest
-	0,  // 26: pb.CategoryService.FindAll:input_type -> pb.FindAllCategoryRequest
-	1,  // 27: pb.CategoryService.FindById:input_type -> pb.FindByIdCategoryRequest
-	11, // 28: pb.CategoryService.Create:input_type -> pb.CreateCategoryRequest
-	12, // 29: pb.CategoryService.Update:input_type -> pb.UpdateCategoryRequest
-	1,  // 30: pb.CategoryService.TrashedCategory:input_type -> pb.FindByIdCategoryRequest
-	1,  // 31: pb.CategoryService.RestoreCategory:input_type -> pb.FindByIdCategoryRequest
-	1,  // 32: pb.CategoryService.DeleteCategoryPermanent:input_type -> pb.FindByIdCategoryRequest
-	32, // 33: pb.CategoryService.RestoreAllCategory:input_type -> google.protobuf.Empty
-	32, // 34: pb.CategoryService.DeleteAllCategoryPermanent:input_type -> google.protobuf.Empty
-	28, // 35: pb.CategoryService.FindMonthlyTotalPrices:output_type -> pb.ApiResponseCategoryMonthlyTotalPrice
-	29, // 36: pb.CategoryService.FindYearlyTotalPrices:output_type -> pb.ApiResponseCategoryYearlyTotalPrice
-	28, // 37: pb.CategoryService.FindMonthlyTotalPricesById:output_type -> pb.ApiResponseCategoryMonthlyTotalPrice
-	29, // 38: pb.CategoryService.FindYearlyTotalPricesById:output_type -> pb.ApiResponseCategoryYearlyTotalPrice
-	28, // 39: pb.CategoryService.FindMonthlyTotalPricesByMerchant:output_type -> pb.ApiResponseCategoryMonthlyTotalPrice
-	29, // 40: pb.CategoryService.FindYearlyTotalPricesByMerchant:output_type -> pb.ApiResponseCategoryYearlyTotalPrice
-	19, // 41: pb.CategoryService.FindMonthPrice:output_type -> pb.ApiResponseCategoryMonthPrice
-	20, // 42: pb.CategoryService.FindYearPrice:output_type -> pb.ApiResponseCategoryYearPrice
-	19, // 43: pb.CategoryService.FindMonthPriceByMerchant:output_type -> pb.ApiResponseCategoryMonthPrice
-	20, // 44: pb.CategoryService.FindYearPriceByMerchant:output_type -> pb.ApiResponseCategoryYearPrice
-	19, // 45: pb.CategoryService.FindMonthPriceById:output_type -> pb.ApiResponseCategoryMonthPrice
-	20, // 46: pb.CategoryService.FindYearPriceById:output_type -> pb.ApiResponseCategoryYearPrice
-	26, // 47: pb.CategoryService.FindByActive:output_type -> pb.ApiResponsePaginationCategoryDeleteAt
-	26, // 48: pb.CategoryService.FindByTrashed:output_type -> pb.ApiResponsePaginationCategoryDeleteAt
-	27, // 49: pb.CategoryService.FindAll:output_type -> pb.ApiResponsePaginationCategory
-	21, // 50: pb.CategoryService.FindById:output_type -> pb.ApiResponseCategory
-	21, // 51: pb.CategoryService.Create:output_type -> pb.ApiResponseCategory
-	21, // 52: pb.CategoryService.Update:output_type -> pb.ApiResponseCategory
-	22, // 53: pb.CategoryService.TrashedCategory:output_type -> pb.ApiResponseCategoryDeleteAt
-	22, // 54: pb.CategoryService.RestoreCategory:output_type -> pb.ApiResponseCategoryDeleteAt
-	24, // 55: pb.CategoryService.DeleteCategoryPermanent:output_type -> pb.ApiResponseCategoryDelete
-	25, // 56: pb.CategoryService.RestoreAllCategory:output_type -> pb.ApiResponseCategoryAll
-	25, // 57: pb.CategoryService.DeleteAllCategoryPermanent:output_type -> pb.ApiResponseCategoryAll
-	35, // [35:58] is the sub-list for method output_type
-	12, // [12:35] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	35, // 0: pb.CreateCategoryRequest.parent_id:type_name -> google.protobuf.Int32Value
+	35, // 1: pb.CreateCategoryRequest.merchant_id:type_name -> google.protobuf.Int32Value
+	35, // 2: pb.FindCategoryTreeRequest.merchant_id:type_name -> google.protobuf.Int32Value
+	35, // 3: pb.MoveCategoryRequest.parent_id:type_name -> google.protobuf.Int32Value
+	35, // 4: pb.MoveCategoryRequest.position:type_name -> google.protobuf.Int32Value
+	35, // 5: pb.ReorderCategoriesRequest.parent_id:type_name -> google.protobuf.Int32Value
+	35, // 6: pb.ReorderCategoriesRequest.merchant_id:type_name -> google.protobuf.Int32Value
+	35, // 7: pb.CategoryResponse.parent_id:type_name -> google.protobuf.Int32Value
+	35, // 8: pb.CategoryResponse.merchant_id:type_name -> google.protobuf.Int32Value
+	18, // 9: pb.CategoryTreeNode.category:type_name -> pb.CategoryResponse
+	19, // 10: pb.CategoryTreeNode.children:type_name -> pb.CategoryTreeNode
+	36, // 11: pb.CategoryResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
+	35, // 12: pb.CategoryResponseDeleteAt.parent_id:type_name -> google.protobuf.Int32Value
+	35, // 13: pb.CategoryResponseDeleteAt.merchant_id:type_name -> google.protobuf.Int32Value
+	16, // 14: pb.ApiResponseCategoryMonthPrice.data:type_name -> pb.CategoryMonthPriceResponse
+	17, // 15: pb.ApiResponseCategoryYearPrice.data:type_name -> pb.CategoryYearPriceResponse
+	18, // 16: pb.ApiResponseCategory.data:type_name -> pb.CategoryResponse
+	20, // 17: pb.ApiResponseCategoryDeleteAt.data:type_name -> pb.CategoryResponseDeleteAt
+	18, // 18: pb.ApiResponsesCategory.data:type_name -> pb.CategoryResponse
+	19, // 19: pb.ApiResponseCategoryTree.data:type_name -> pb.CategoryTreeNode
+	20, // 20: pb.ApiResponsePaginationCategoryDeleteAt.data:type_name -> pb.CategoryResponseDeleteAt
+	37, // 21: pb.ApiResponsePaginationCategoryDeleteAt.pagination:type_name -> pb.PaginationMeta
+	18, // 22: pb.ApiResponsePaginationCategory.data:type_name -> pb.CategoryResponse
+	37, // 23: pb.ApiResponsePaginationCategory.pagination:type_name -> pb.PaginationMeta
+	21, // 24: pb.ApiResponseCategoryMonthlyTotalPrice.data:type_name -> pb.CategoriesMonthlyTotalPriceResponse
+	22, // 25: pb.ApiResponseCategoryYearlyTotalPrice.data:type_name -> pb.CategoriesYearlyTotalPriceResponse
+	5,  // 26: pb.CategoryService.FindMonthlyTotalPrices:input_type -> pb.FindYearMonthTotalPrices
+	6,  // 27: pb.CategoryService.FindYearlyTotalPrices:input_type -> pb.FindYearTotalPrices
+	7,  // 28: pb.CategoryService.FindMonthlyTotalPricesById:input_type -> pb.FindYearMonthTotalPriceById
+	8,  // 29: pb.CategoryService.FindYearlyTotalPricesById:input_type -> pb.FindYearTotalPriceById
+	9,  // 30: pb.CategoryService.FindMonthlyTotalPricesByMerchant:input_type -> pb.FindYearMonthTotalPriceByMerchant
+	10, // 31: pb.CategoryService.FindYearlyTotalPricesByMerchant:input_type -> pb.FindYearTotalPriceByMerchant
+	2,  // 32: pb.CategoryService.FindMonthPrice:input_type -> pb.FindYearCategory
+	2,  // 33: pb.CategoryService.FindYearPrice:input_type -> pb.FindYearCategory
+	3,  // 34: pb.CategoryService.FindMonthPriceByMerchant:input_type -> pb.FindYearCategoryByMerchant
+	3,  // 35: pb.CategoryService.FindYearPriceByMerchant:input_type -> pb.FindYearCategoryByMerchant
+	4,  // 36: pb.CategoryService.FindMonthPriceById:input_type -> pb.FindYearCategoryById
+	4,  // 37: pb.CategoryService.FindYearPriceById:input_type -> pb.FindYearCategoryById
+	0,  // 38: pb.CategoryService.FindByActive:input_type -> pb.FindAllCategoryRequest
+	0,  // 39: pb.CategoryService.FindByTrashed:input_type -> pb.FindAllCategoryRequest
+	0,  // 40: pb.CategoryService.FindAll:input_type -> pb.FindAllCategoryRequest
+	1,  // 41: pb.CategoryService.FindById:input_type -> pb.FindByIdCategoryRequest
+	12, // 42: pb.CategoryService.FindTree:input_type -> pb.FindCategoryTreeRequest
+	11, // 43: pb.CategoryService.Create:input_type -> pb.CreateCategoryRequest
+	15, // 44: pb.CategoryService.Update:input_type -> pb.UpdateCategoryRequest
+	1,  // 45: pb.CategoryService.TrashedCategory:input_type -> pb.FindByIdCategoryRequest
+	1,  // 46: pb.CategoryService.RestoreCategory:input_type -> pb.FindByIdCategoryRequest
+	1,  // 47: pb.CategoryService.DeleteCategoryPermanent:input_type -> pb.FindByIdCategoryRequest
+	13, // 48: pb.CategoryService.MoveCategory:input_type -> pb.MoveCategoryRequest
+	14, // 49: pb.CategoryService.ReorderCategories:input_type -> pb.ReorderCategoriesRequest
+	38, // 50: pb.CategoryService.RestoreAllCategory:input_type -> google.protobuf.Empty
+	38, // 51: pb.CategoryService.DeleteAllCategoryPermanent:input_type -> google.protobuf.Empty
+	33, // 52: pb.CategoryService.FindMonthlyTotalPrices:output_type -> pb.ApiResponseCategoryMonthlyTotalPrice
+	34, // 53: pb.CategoryService.FindYearlyTotalPrices:output_type -> pb.ApiResponseCategoryYearlyTotalPrice
+	33, // 54: pb.CategoryService.FindMonthlyTotalPricesById:output_type -> pb.ApiResponseCategoryMonthlyTotalPrice
+	34, // 55: pb.CategoryService.FindYearlyTotalPricesById:output_type -> pb.ApiResponseCategoryYearlyTotalPrice
+	33, // 56: pb.CategoryService.FindMonthlyTotalPricesByMerchant:output_type -> pb.ApiResponseCategoryMonthlyTotalPrice
+	34, // 57: pb.CategoryService.FindYearlyTotalPricesByMerchant:output_type -> pb.ApiResponseCategoryYearlyTotalPrice
+	23, // 58: pb.CategoryService.FindMonthPrice:output_type -> pb.ApiResponseCategoryMonthPrice
+	24, // 59: pb.CategoryService.FindYearPrice:output_type -> pb.ApiResponseCategoryYearPrice
+	23, // 60: pb.CategoryService.FindMonthPriceByMerchant:output_type -> pb.ApiResponseCategoryMonthPrice
+	24, // 61: pb.CategoryService.FindYearPriceByMerchant:output_type -> pb.ApiResponseCategoryYearPrice
+	23, // 62: pb.CategoryService.FindMonthPriceById:output_type -> pb.ApiResponseCategoryMonthPrice
+	24, // 63: pb.CategoryService.FindYearPriceById:output_type -> pb.ApiResponseCategoryYearPrice
+	31, // 64: pb.CategoryService.FindByActive:output_type -> pb.ApiResponsePaginationCategoryDeleteAt
+	31, // 65: pb.CategoryService.FindByTrashed:output_type -> pb.ApiResponsePaginationCategoryDeleteAt
+	32, // 66: pb.CategoryService.FindAll:output_type -> pb.ApiResponsePaginationCategory
+	25, // 67: pb.CategoryService.FindById:output_type -> pb.ApiResponseCategory
+	28, // 68: pb.CategoryService.FindTree:output_type -> pb.ApiResponseCategoryTree
+	25, // 69: pb.CategoryService.Create:output_type -> pb.ApiResponseCategory
+	25, // 70: pb.CategoryService.Update:output_type -> pb.ApiResponseCategory
+	26, // 71: pb.CategoryService.TrashedCategory:output_type -> pb.ApiResponseCategoryDeleteAt
+	26, // 72: pb.CategoryService.RestoreCategory:output_type -> pb.ApiResponseCategoryDeleteAt
+	29, // 73: pb.CategoryService.DeleteCategoryPermanent:output_type -> pb.ApiResponseCategoryDelete
+	25, // 74: pb.CategoryService.MoveCategory:output_type -> pb.ApiResponseCategory
+	27, // 75: pb.CategoryService.ReorderCategories:output_type -> pb.ApiResponsesCategory
+	30, // 76: pb.CategoryService.RestoreAllCategory:output_type -> pb.ApiResponseCategoryAll
+	30, // 77: pb.CategoryService.DeleteAllCategoryPermanent:output_type -> pb.ApiResponseCategoryAll
+	52, // [52:78] is the sub-list for method output_type
+	26, // [26:52] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_category_proto_init() }
@@ -2148,7 +2595,7 @@ func file_category_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_category_proto_rawDesc), len(file_category_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
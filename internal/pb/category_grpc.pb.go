@@ -36,11 +36,14 @@ const (
 	CategoryService_FindByTrashed_FullMethodName                    = "/pb.CategoryService/FindByTrashed"
 	CategoryService_FindAll_FullMethodName                          = "/pb.CategoryService/FindAll"
 	CategoryService_FindById_FullMethodName                         = "/pb.CategoryService/FindById"
+	CategoryService_FindTree_FullMethodName                         = "/pb.CategoryService/FindTree"
 	CategoryService_Create_FullMethodName                           = "/pb.CategoryService/Create"
 	CategoryService_Update_FullMethodName                           = "/pb.CategoryService/Update"
 	CategoryService_TrashedCategory_FullMethodName                  = "/pb.CategoryService/TrashedCategory"
 	CategoryService_RestoreCategory_FullMethodName                  = "/pb.CategoryService/RestoreCategory"
 	CategoryService_DeleteCategoryPermanent_FullMethodName          = "/pb.CategoryService/DeleteCategoryPermanent"
+	CategoryService_MoveCategory_FullMethodName                     = "/pb.CategoryService/MoveCategory"
+	CategoryService_ReorderCategories_FullMethodName                = "/pb.CategoryService/ReorderCategories"
 	CategoryService_RestoreAllCategory_FullMethodName               = "/pb.CategoryService/RestoreAllCategory"
 	CategoryService_DeleteAllCategoryPermanent_FullMethodName       = "/pb.CategoryService/DeleteAllCategoryPermanent"
 )
@@ -65,11 +68,14 @@ type CategoryServiceClient interface {
 	FindByTrashed(ctx context.Context, in *FindAllCategoryRequest, opts ...grpc.CallOption) (*ApiResponsePaginationCategoryDeleteAt, error)
 	FindAll(ctx context.Context, in *FindAllCategoryRequest, opts ...grpc.CallOption) (*ApiResponsePaginationCategory, error)
 	FindById(ctx context.Context, in *FindByIdCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategory, error)
+	FindTree(ctx context.Context, in *FindCategoryTreeRequest, opts ...grpc.CallOption) (*ApiResponseCategoryTree, error)
 	Create(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategory, error)
 	Update(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategory, error)
 	TrashedCategory(ctx context.Context, in *FindByIdCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategoryDeleteAt, error)
 	RestoreCategory(ctx context.Context, in *FindByIdCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategoryDeleteAt, error)
 	DeleteCategoryPermanent(ctx context.Context, in *FindByIdCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategoryDelete, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategory, error)
+	ReorderCategories(ctx context.Context, in *ReorderCategoriesRequest, opts ...grpc.CallOption) (*ApiResponsesCategory, error)
 	RestoreAllCategory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponseCategoryAll, error)
 	DeleteAllCategoryPermanent(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponseCategoryAll, error)
 }
@@ -242,6 +248,16 @@ func (c *categoryServiceClient) FindById(ctx context.Context, in *FindByIdCatego
 	return out, nil
 }
 
+func (c *categoryServiceClient) FindTree(ctx context.Context, in *FindCategoryTreeRequest, opts ...grpc.CallOption) (*ApiResponseCategoryTree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseCategoryTree)
+	err := c.cc.Invoke(ctx, CategoryService_FindTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *categoryServiceClient) Create(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategory, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponseCategory)
@@ -292,6 +308,26 @@ func (c *categoryServiceClient) DeleteCategoryPermanent(ctx context.Context, in
 	return out, nil
 }
 
+func (c *categoryServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*ApiResponseCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseCategory)
+	err := c.cc.Invoke(ctx, CategoryService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) ReorderCategories(ctx context.Context, in *ReorderCategoriesRequest, opts ...grpc.CallOption) (*ApiResponsesCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponsesCategory)
+	err := c.cc.Invoke(ctx, CategoryService_ReorderCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *categoryServiceClient) RestoreAllCategory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiResponseCategoryAll, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponseCategoryAll)
@@ -332,11 +368,14 @@ type CategoryServiceServer interface {
 	FindByTrashed(context.Context, *FindAllCategoryRequest) (*ApiResponsePaginationCategoryDeleteAt, error)
 	FindAll(context.Context, *FindAllCategoryRequest) (*ApiResponsePaginationCategory, error)
 	FindById(context.Context, *FindByIdCategoryRequest) (*ApiResponseCategory, error)
+	FindTree(context.Context, *FindCategoryTreeRequest) (*ApiResponseCategoryTree, error)
 	Create(context.Context, *CreateCategoryRequest) (*ApiResponseCategory, error)
 	Update(context.Context, *UpdateCategoryRequest) (*ApiResponseCategory, error)
 	TrashedCategory(context.Context, *FindByIdCategoryRequest) (*ApiResponseCategoryDeleteAt, error)
 	RestoreCategory(context.Context, *FindByIdCategoryRequest) (*ApiResponseCategoryDeleteAt, error)
 	DeleteCategoryPermanent(context.Context, *FindByIdCategoryRequest) (*ApiResponseCategoryDelete, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*ApiResponseCategory, error)
+	ReorderCategories(context.Context, *ReorderCategoriesRequest) (*ApiResponsesCategory, error)
 	RestoreAllCategory(context.Context, *emptypb.Empty) (*ApiResponseCategoryAll, error)
 	DeleteAllCategoryPermanent(context.Context, *emptypb.Empty) (*ApiResponseCategoryAll, error)
 	mustEmbedUnimplementedCategoryServiceServer()
@@ -397,6 +436,9 @@ func (UnimplementedCategoryServiceServer) FindAll(context.Context, *FindAllCateg
 func (UnimplementedCategoryServiceServer) FindById(context.Context, *FindByIdCategoryRequest) (*ApiResponseCategory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindById not implemented")
 }
+func (UnimplementedCategoryServiceServer) FindTree(context.Context, *FindCategoryTreeRequest) (*ApiResponseCategoryTree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTree not implemented")
+}
 func (UnimplementedCategoryServiceServer) Create(context.Context, *CreateCategoryRequest) (*ApiResponseCategory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
@@ -412,6 +454,12 @@ func (UnimplementedCategoryServiceServer) RestoreCategory(context.Context, *Find
 func (UnimplementedCategoryServiceServer) DeleteCategoryPermanent(context.Context, *FindByIdCategoryRequest) (*ApiResponseCategoryDelete, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryPermanent not implemented")
 }
+func (UnimplementedCategoryServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*ApiResponseCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) ReorderCategories(context.Context, *ReorderCategoriesRequest) (*ApiResponsesCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCategories not implemented")
+}
 func (UnimplementedCategoryServiceServer) RestoreAllCategory(context.Context, *emptypb.Empty) (*ApiResponseCategoryAll, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestoreAllCategory not implemented")
 }
@@ -727,6 +775,24 @@ func _CategoryService_FindById_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoryService_FindTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).FindTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_FindTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).FindTree(ctx, req.(*FindCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CategoryService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCategoryRequest)
 	if err := dec(in); err != nil {
@@ -817,6 +883,42 @@ func _CategoryService_DeleteCategoryPermanent_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoryService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_ReorderCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).ReorderCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_ReorderCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).ReorderCategories(ctx, req.(*ReorderCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CategoryService_RestoreAllCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -924,6 +1026,10 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindById",
 			Handler:    _CategoryService_FindById_Handler,
 		},
+		{
+			MethodName: "FindTree",
+			Handler:    _CategoryService_FindTree_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _CategoryService_Create_Handler,
@@ -944,6 +1050,14 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategoryPermanent",
 			Handler:    _CategoryService_DeleteCategoryPermanent_Handler,
 		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _CategoryService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "ReorderCategories",
+			Handler:    _CategoryService_ReorderCategories_Handler,
+		},
 		{
 			MethodName: "RestoreAllCategory",
 			Handler:    _CategoryService_RestoreAllCategory_Handler,
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -318,6 +319,66 @@ func (x *UpdateMerchantRequest) GetStatus() string {
 	return ""
 }
 
+type UpdateMerchantBarcodeSettingsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	MerchantId    int32                   `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Gs1Prefix     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=gs1_prefix,json=gs1Prefix,proto3" json:"gs1_prefix,omitempty"`
+	BarcodeFormat string                  `protobuf:"bytes,3,opt,name=barcode_format,json=barcodeFormat,proto3" json:"barcode_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMerchantBarcodeSettingsRequest) Reset() {
+	*x = UpdateMerchantBarcodeSettingsRequest{}
+	mi := &file_merchant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMerchantBarcodeSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMerchantBarcodeSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateMerchantBarcodeSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMerchantBarcodeSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMerchantBarcodeSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateMerchantBarcodeSettingsRequest) GetMerchantId() int32 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *UpdateMerchantBarcodeSettingsRequest) GetGs1Prefix() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gs1Prefix
+	}
+	return nil
+}
+
+func (x *UpdateMerchantBarcodeSettingsRequest) GetBarcodeFormat() string {
+	if x != nil {
+		return x.BarcodeFormat
+	}
+	return ""
+}
+
 type MerchantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -336,7 +397,7 @@ type MerchantResponse struct {
 
 func (x *MerchantResponse) Reset() {
 	*x = MerchantResponse{}
-	mi := &file_merchant_proto_msgTypes[4]
+	mi := &file_merchant_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -348,7 +409,7 @@ func (x *MerchantResponse) String() string {
 func (*MerchantResponse) ProtoMessage() {}
 
 func (x *MerchantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[4]
+	mi := &file_merchant_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +422,7 @@ func (x *MerchantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerchantResponse.ProtoReflect.Descriptor instead.
 func (*MerchantResponse) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{4}
+	return file_merchant_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MerchantResponse) GetId() int32 {
@@ -453,7 +514,7 @@ type MerchantResponseDeleteAt struct {
 
 func (x *MerchantResponseDeleteAt) Reset() {
 	*x = MerchantResponseDeleteAt{}
-	mi := &file_merchant_proto_msgTypes[5]
+	mi := &file_merchant_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +526,7 @@ func (x *MerchantResponseDeleteAt) String() string {
 func (*MerchantResponseDeleteAt) ProtoMessage() {}
 
 func (x *MerchantResponseDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[5]
+	mi := &file_merchant_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +539,7 @@ func (x *MerchantResponseDeleteAt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerchantResponseDeleteAt.ProtoReflect.Descriptor instead.
 func (*MerchantResponseDeleteAt) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{5}
+	return file_merchant_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MerchantResponseDeleteAt) GetId() int32 {
@@ -569,7 +630,7 @@ type ApiResponseMerchant struct {
 
 func (x *ApiResponseMerchant) Reset() {
 	*x = ApiResponseMerchant{}
-	mi := &file_merchant_proto_msgTypes[6]
+	mi := &file_merchant_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +642,7 @@ func (x *ApiResponseMerchant) String() string {
 func (*ApiResponseMerchant) ProtoMessage() {}
 
 func (x *ApiResponseMerchant) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[6]
+	mi := &file_merchant_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +655,7 @@ func (x *ApiResponseMerchant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseMerchant.ProtoReflect.Descriptor instead.
 func (*ApiResponseMerchant) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{6}
+	return file_merchant_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ApiResponseMerchant) GetStatus() string {
@@ -618,6 +679,134 @@ func (x *ApiResponseMerchant) GetData() *MerchantResponse {
 	return nil
 }
 
+type MerchantBarcodeSettingsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	MerchantId    int32                   `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Gs1Prefix     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=gs1_prefix,json=gs1Prefix,proto3" json:"gs1_prefix,omitempty"`
+	BarcodeFormat string                  `protobuf:"bytes,3,opt,name=barcode_format,json=barcodeFormat,proto3" json:"barcode_format,omitempty"`
+	UpdatedAt     string                  `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantBarcodeSettingsResponse) Reset() {
+	*x = MerchantBarcodeSettingsResponse{}
+	mi := &file_merchant_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantBarcodeSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantBarcodeSettingsResponse) ProtoMessage() {}
+
+func (x *MerchantBarcodeSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantBarcodeSettingsResponse.ProtoReflect.Descriptor instead.
+func (*MerchantBarcodeSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MerchantBarcodeSettingsResponse) GetMerchantId() int32 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MerchantBarcodeSettingsResponse) GetGs1Prefix() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Gs1Prefix
+	}
+	return nil
+}
+
+func (x *MerchantBarcodeSettingsResponse) GetBarcodeFormat() string {
+	if x != nil {
+		return x.BarcodeFormat
+	}
+	return ""
+}
+
+func (x *MerchantBarcodeSettingsResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ApiResponseMerchantBarcodeSettings struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Status        string                           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *MerchantBarcodeSettingsResponse `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseMerchantBarcodeSettings) Reset() {
+	*x = ApiResponseMerchantBarcodeSettings{}
+	mi := &file_merchant_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseMerchantBarcodeSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseMerchantBarcodeSettings) ProtoMessage() {}
+
+func (x *ApiResponseMerchantBarcodeSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseMerchantBarcodeSettings.ProtoReflect.Descriptor instead.
+func (*ApiResponseMerchantBarcodeSettings) Descriptor() ([]byte, []int) {
+	return file_merchant_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApiResponseMerchantBarcodeSettings) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseMerchantBarcodeSettings) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponseMerchantBarcodeSettings) GetData() *MerchantBarcodeSettingsResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type ApiResponseMerchantDeleteAt struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Status        string                    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -629,7 +818,7 @@ type ApiResponseMerchantDeleteAt struct {
 
 func (x *ApiResponseMerchantDeleteAt) Reset() {
 	*x = ApiResponseMerchantDeleteAt{}
-	mi := &file_merchant_proto_msgTypes[7]
+	mi := &file_merchant_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +830,7 @@ func (x *ApiResponseMerchantDeleteAt) String() string {
 func (*ApiResponseMerchantDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponseMerchantDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[7]
+	mi := &file_merchant_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +843,7 @@ func (x *ApiResponseMerchantDeleteAt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseMerchantDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponseMerchantDeleteAt) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{7}
+	return file_merchant_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ApiResponseMerchantDeleteAt) GetStatus() string {
@@ -689,7 +878,7 @@ type ApiResponsesMerchant struct {
 
 func (x *ApiResponsesMerchant) Reset() {
 	*x = ApiResponsesMerchant{}
-	mi := &file_merchant_proto_msgTypes[8]
+	mi := &file_merchant_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -701,7 +890,7 @@ func (x *ApiResponsesMerchant) String() string {
 func (*ApiResponsesMerchant) ProtoMessage() {}
 
 func (x *ApiResponsesMerchant) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[8]
+	mi := &file_merchant_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +903,7 @@ func (x *ApiResponsesMerchant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsesMerchant.ProtoReflect.Descriptor instead.
 func (*ApiResponsesMerchant) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{8}
+	return file_merchant_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ApiResponsesMerchant) GetStatus() string {
@@ -748,7 +937,7 @@ type ApiResponseMerchantDelete struct {
 
 func (x *ApiResponseMerchantDelete) Reset() {
 	*x = ApiResponseMerchantDelete{}
-	mi := &file_merchant_proto_msgTypes[9]
+	mi := &file_merchant_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -760,7 +949,7 @@ func (x *ApiResponseMerchantDelete) String() string {
 func (*ApiResponseMerchantDelete) ProtoMessage() {}
 
 func (x *ApiResponseMerchantDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[9]
+	mi := &file_merchant_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +962,7 @@ func (x *ApiResponseMerchantDelete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseMerchantDelete.ProtoReflect.Descriptor instead.
 func (*ApiResponseMerchantDelete) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{9}
+	return file_merchant_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ApiResponseMerchantDelete) GetStatus() string {
@@ -800,7 +989,7 @@ type ApiResponseMerchantAll struct {
 
 func (x *ApiResponseMerchantAll) Reset() {
 	*x = ApiResponseMerchantAll{}
-	mi := &file_merchant_proto_msgTypes[10]
+	mi := &file_merchant_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -812,7 +1001,7 @@ func (x *ApiResponseMerchantAll) String() string {
 func (*ApiResponseMerchantAll) ProtoMessage() {}
 
 func (x *ApiResponseMerchantAll) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[10]
+	mi := &file_merchant_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +1014,7 @@ func (x *ApiResponseMerchantAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseMerchantAll.ProtoReflect.Descriptor instead.
 func (*ApiResponseMerchantAll) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{10}
+	return file_merchant_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ApiResponseMerchantAll) GetStatus() string {
@@ -854,7 +1043,7 @@ type ApiResponsePaginationMerchantDeleteAt struct {
 
 func (x *ApiResponsePaginationMerchantDeleteAt) Reset() {
 	*x = ApiResponsePaginationMerchantDeleteAt{}
-	mi := &file_merchant_proto_msgTypes[11]
+	mi := &file_merchant_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -866,7 +1055,7 @@ func (x *ApiResponsePaginationMerchantDeleteAt) String() string {
 func (*ApiResponsePaginationMerchantDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponsePaginationMerchantDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[11]
+	mi := &file_merchant_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1068,7 @@ func (x *ApiResponsePaginationMerchantDeleteAt) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ApiResponsePaginationMerchantDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationMerchantDeleteAt) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{11}
+	return file_merchant_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ApiResponsePaginationMerchantDeleteAt) GetStatus() string {
@@ -922,7 +1111,7 @@ type ApiResponsePaginationMerchant struct {
 
 func (x *ApiResponsePaginationMerchant) Reset() {
 	*x = ApiResponsePaginationMerchant{}
-	mi := &file_merchant_proto_msgTypes[12]
+	mi := &file_merchant_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -934,7 +1123,7 @@ func (x *ApiResponsePaginationMerchant) String() string {
 func (*ApiResponsePaginationMerchant) ProtoMessage() {}
 
 func (x *ApiResponsePaginationMerchant) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_proto_msgTypes[12]
+	mi := &file_merchant_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1136,7 @@ func (x *ApiResponsePaginationMerchant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsePaginationMerchant.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationMerchant) Descriptor() ([]byte, []int) {
-	return file_merchant_proto_rawDescGZIP(), []int{12}
+	return file_merchant_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ApiResponsePaginationMerchant) GetStatus() string {
@@ -982,7 +1171,7 @@ var File_merchant_proto protoreflect.FileDescriptor
 
 const file_merchant_proto_rawDesc = "" +
 	"\n" +
-	"\x0emerchant.proto\x12\x02pb\x1a\tapi.proto\x1a\x1bgoogle/protobuf/empty.proto\"a\n" +
+	"\x0emerchant.proto\x12\x02pb\x1a\tapi.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\"a\n" +
 	"\x16FindAllMerchantRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
@@ -1006,7 +1195,13 @@ const file_merchant_proto_rawDesc = "" +
 	"\aaddress\x18\x05 \x01(\tR\aaddress\x12#\n" +
 	"\rcontact_email\x18\x06 \x01(\tR\fcontactEmail\x12#\n" +
 	"\rcontact_phone\x18\a \x01(\tR\fcontactPhone\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06status\"\xab\x02\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"\xab\x01\n" +
+	"$UpdateMerchantBarcodeSettingsRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x05R\n" +
+	"merchantId\x12;\n" +
+	"\n" +
+	"gs1_prefix\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\tgs1Prefix\x12%\n" +
+	"\x0ebarcode_format\x18\x03 \x01(\tR\rbarcodeFormat\"\xab\x02\n" +
 	"\x10MerchantResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
@@ -1040,7 +1235,19 @@ const file_merchant_proto_rawDesc = "" +
 	"\x13ApiResponseMerchant\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\x04data\x18\x03 \x01(\v2\x14.pb.MerchantResponseR\x04data\"\x81\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x14.pb.MerchantResponseR\x04data\"\xc5\x01\n" +
+	"\x1fMerchantBarcodeSettingsResponse\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x05R\n" +
+	"merchantId\x12;\n" +
+	"\n" +
+	"gs1_prefix\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\tgs1Prefix\x12%\n" +
+	"\x0ebarcode_format\x18\x03 \x01(\tR\rbarcodeFormat\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\x8f\x01\n" +
+	"\"ApiResponseMerchantBarcodeSettings\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x04data\x18\x03 \x01(\v2#.pb.MerchantBarcodeSettingsResponseR\x04data\"\x81\x01\n" +
 	"\x1bApiResponseMerchantDeleteAt\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
@@ -1068,14 +1275,15 @@ const file_merchant_proto_rawDesc = "" +
 	"\x04data\x18\x03 \x03(\v2\x14.pb.MerchantResponseR\x04data\x122\n" +
 	"\n" +
 	"pagination\x18\x04 \x01(\v2\x12.pb.PaginationMetaR\n" +
-	"pagination2\xe5\x06\n" +
+	"pagination2\xd0\a\n" +
 	"\x0fMerchantService\x12H\n" +
 	"\aFindAll\x12\x1a.pb.FindAllMerchantRequest\x1a!.pb.ApiResponsePaginationMerchant\x12@\n" +
 	"\bFindById\x12\x1b.pb.FindByIdMerchantRequest\x1a\x17.pb.ApiResponseMerchant\x12W\n" +
 	"\fFindByActive\x12\x1a.pb.FindAllMerchantRequest\x1a).pb.ApiResponsePaginationMerchantDeleteAt\"\x00\x12X\n" +
 	"\rFindByTrashed\x12\x1a.pb.FindAllMerchantRequest\x1a).pb.ApiResponsePaginationMerchantDeleteAt\"\x00\x12<\n" +
 	"\x06Create\x12\x19.pb.CreateMerchantRequest\x1a\x17.pb.ApiResponseMerchant\x12<\n" +
-	"\x06Update\x12\x19.pb.UpdateMerchantRequest\x1a\x17.pb.ApiResponseMerchant\x12O\n" +
+	"\x06Update\x12\x19.pb.UpdateMerchantRequest\x1a\x17.pb.ApiResponseMerchant\x12i\n" +
+	"\x15UpdateBarcodeSettings\x12(.pb.UpdateMerchantBarcodeSettingsRequest\x1a&.pb.ApiResponseMerchantBarcodeSettings\x12O\n" +
 	"\x0fTrashedMerchant\x12\x1b.pb.FindByIdMerchantRequest\x1a\x1f.pb.ApiResponseMerchantDeleteAt\x12O\n" +
 	"\x0fRestoreMerchant\x12\x1b.pb.FindByIdMerchantRequest\x1a\x1f.pb.ApiResponseMerchantDeleteAt\x12U\n" +
 	"\x17DeleteMerchantPermanent\x12\x1b.pb.FindByIdMerchantRequest\x1a\x1d.pb.ApiResponseMerchantDelete\x12J\n" +
@@ -1094,59 +1302,68 @@ func file_merchant_proto_rawDescGZIP() []byte {
 	return file_merchant_proto_rawDescData
 }
 
-var file_merchant_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_merchant_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_merchant_proto_goTypes = []any{
 	(*FindAllMerchantRequest)(nil),                // 0: pb.FindAllMerchantRequest
 	(*FindByIdMerchantRequest)(nil),               // 1: pb.FindByIdMerchantRequest
 	(*CreateMerchantRequest)(nil),                 // 2: pb.CreateMerchantRequest
 	(*UpdateMerchantRequest)(nil),                 // 3: pb.UpdateMerchantRequest
-	(*MerchantResponse)(nil),                      // 4: pb.MerchantResponse
-	(*MerchantResponseDeleteAt)(nil),              // 5: pb.MerchantResponseDeleteAt
-	(*ApiResponseMerchant)(nil),                   // 6: pb.ApiResponseMerchant
-	(*ApiResponseMerchantDeleteAt)(nil),           // 7: pb.ApiResponseMerchantDeleteAt
-	(*ApiResponsesMerchant)(nil),                  // 8: pb.ApiResponsesMerchant
-	(*ApiResponseMerchantDelete)(nil),             // 9: pb.ApiResponseMerchantDelete
-	(*ApiResponseMerchantAll)(nil),                // 10: pb.ApiResponseMerchantAll
-	(*ApiResponsePaginationMerchantDeleteAt)(nil), // 11: pb.ApiResponsePaginationMerchantDeleteAt
-	(*ApiResponsePaginationMerchant)(nil),         // 12: pb.ApiResponsePaginationMerchant
-	(*PaginationMeta)(nil),                        // 13: pb.PaginationMeta
-	(*emptypb.Empty)(nil),                         // 14: google.protobuf.Empty
+	(*UpdateMerchantBarcodeSettingsRequest)(nil),  // 4: pb.UpdateMerchantBarcodeSettingsRequest
+	(*MerchantResponse)(nil),                      // 5: pb.MerchantResponse
+	(*MerchantResponseDeleteAt)(nil),              // 6: pb.MerchantResponseDeleteAt
+	(*ApiResponseMerchant)(nil),                   // 7: pb.ApiResponseMerchant
+	(*MerchantBarcodeSettingsResponse)(nil),       // 8: pb.MerchantBarcodeSettingsResponse
+	(*ApiResponseMerchantBarcodeSettings)(nil),    // 9: pb.ApiResponseMerchantBarcodeSettings
+	(*ApiResponseMerchantDeleteAt)(nil),           // 10: pb.ApiResponseMerchantDeleteAt
+	(*ApiResponsesMerchant)(nil),                  // 11: pb.ApiResponsesMerchant
+	(*ApiResponseMerchantDelete)(nil),             // 12: pb.ApiResponseMerchantDelete
+	(*ApiResponseMerchantAll)(nil),                // 13: pb.ApiResponseMerchantAll
+	(*ApiResponsePaginationMerchantDeleteAt)(nil), // 14: pb.ApiResponsePaginationMerchantDeleteAt
+	(*ApiResponsePaginationMerchant)(nil),         // 15: pb.ApiResponsePaginationMerchant
+	(*wrapperspb.StringValue)(nil),                // 16: google.protobuf.StringValue
+	(*PaginationMeta)(nil),                        // 17: pb.PaginationMeta
+	(*emptypb.Empty)(nil),                         // 18: google.protobuf.Empty
 }
 var file_merchant_proto_depIdxs = []int32{
-	4,  // 0: pb.ApiResponseMerchant.data:type_name -> pb.MerchantResponse
-	5,  // 1: pb.ApiResponseMerchantDeleteAt.data:type_name -> pb.MerchantResponseDeleteAt
-	4,  // 2: pb.ApiResponsesMerchant.data:type_name -> pb.MerchantResponse
-	5,  // 3: pb.ApiResponsePaginationMerchantDeleteAt.data:type_name -> pb.MerchantResponseDeleteAt
-	13, // 4: pb.ApiResponsePaginationMerchantDeleteAt.pagination:type_name -> pb.PaginationMeta
-	4,  // 5: pb.ApiResponsePaginationMerchant.data:type_name -> pb.MerchantResponse
-	13, // 6: pb.ApiResponsePaginationMerchant.pagination:type_name -> pb.PaginationMeta
-	0,  // 7: pb.MerchantService.FindAll:input_type -> pb.FindAllMerchantRequest
-	1,  // 8: pb.MerchantService.FindById:input_type -> pb.FindByIdMerchantRequest
-	0,  // 9: pb.MerchantService.FindByActive:input_type -> pb.FindAllMerchantRequest
-	0,  // 10: pb.MerchantService.FindByTrashed:input_type -> pb.FindAllMerchantRequest
-	2,  // 11: pb.MerchantService.Create:input_type -> pb.CreateMerchantRequest
-	3,  // 12: pb.MerchantService.Update:input_type -> pb.UpdateMerchantRequest
-	1,  // 13: pb.MerchantService.TrashedMerchant:input_type -> pb.FindByIdMerchantRequest
-	1,  // 14: pb.MerchantService.RestoreMerchant:input_type -> pb.FindByIdMerchantRequest
-	1,  // 15: pb.MerchantService.DeleteMerchantPermanent:input_type -> pb.FindByIdMerchantRequest
-	14, // 16: pb.MerchantService.RestoreAllMerchant:input_type -> google.protobuf.Empty
-	14, // 17: pb.MerchantService.DeleteAllMerchantPermanent:input_type -> google.protobuf.Empty
-	12, // 18: pb.MerchantService.FindAll:output_type -> pb.ApiResponsePaginationMerchant
-	6,  // 19: pb.MerchantService.FindById:output_type -> pb.ApiResponseMerchant
-	11, // 20: pb.MerchantService.FindByActive:output_type -> pb.ApiResponsePaginationMerchantDeleteAt
-	11, // 21: pb.MerchantService.FindByTrashed:output_type -> pb.ApiResponsePaginationMerchantDeleteAt
-	6,  // 22: pb.MerchantService.Create:output_type -> pb.ApiResponseMerchant
-	6,  // 23: pb.MerchantService.Update:output_type -> pb.ApiResponseMerchant
-	7,  // 24: pb.MerchantService.TrashedMerchant:output_type -> pb.ApiResponseMerchantDeleteAt
-	7,  // 25: pb.MerchantService.RestoreMerchant:output_type -> pb.ApiResponseMerchantDeleteAt
-	9,  // 26: pb.MerchantService.DeleteMerchantPermanent:output_type -> pb.ApiResponseMerchantDelete
-	10, // 27: pb.MerchantService.RestoreAllMerchant:output_type -> pb.ApiResponseMerchantAll
-	10, // 28: pb.MerchantService.DeleteAllMerchantPermanent:output_type -> pb.ApiResponseMerchantAll
-	18, // [18:29] is the sub-list for method output_type
-	7,  // [7:18] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	16, // 0: pb.UpdateMerchantBarcodeSettingsRequest.gs1_prefix:type_name -> google.protobuf.StringValue
+	5,  // 1: pb.ApiResponseMerchant.data:type_name -> pb.MerchantResponse
+	16, // 2: pb.MerchantBarcodeSettingsResponse.gs1_prefix:type_name -> google.protobuf.StringValue
+	8,  // 3: pb.ApiResponseMerchantBarcodeSettings.data:type_name -> pb.MerchantBarcodeSettingsResponse
+	6,  // 4: pb.ApiResponseMerchantDeleteAt.data:type_name -> pb.MerchantResponseDeleteAt
+	5,  // 5: pb.ApiResponsesMerchant.data:type_name -> pb.MerchantResponse
+	6,  // 6: pb.ApiResponsePaginationMerchantDeleteAt.data:type_name -> pb.MerchantResponseDeleteAt
+	17, // 7: pb.ApiResponsePaginationMerchantDeleteAt.pagination:type_name -> pb.PaginationMeta
+	5,  // 8: pb.ApiResponsePaginationMerchant.data:type_name -> pb.MerchantResponse
+	17, // 9: pb.ApiResponsePaginationMerchant.pagination:type_name -> pb.PaginationMeta
+	0,  // 10: pb.MerchantService.FindAll:input_type -> pb.FindAllMerchantRequest
+	1,  // 11: pb.MerchantService.FindById:input_type -> pb.FindByIdMerchantRequest
+	0,  // 12: pb.MerchantService.FindByActive:input_type -> pb.FindAllMerchantRequest
+	0,  // 13: pb.MerchantService.FindByTrashed:input_type -> pb.FindAllMerchantRequest
+	2,  // 14: pb.MerchantService.Create:input_type -> pb.CreateMerchantRequest
+	3,  // 15: pb.MerchantService.Update:input_type -> pb.UpdateMerchantRequest
+	4,  // 16: pb.MerchantService.UpdateBarcodeSettings:input_type -> pb.UpdateMerchantBarcodeSettingsRequest
+	1,  // 17: pb.MerchantService.TrashedMerchant:input_type -> pb.FindByIdMerchantRequest
+	1,  // 18: pb.MerchantService.RestoreMerchant:input_type -> pb.FindByIdMerchantRequest
+	1,  // 19: pb.MerchantService.DeleteMerchantPermanent:input_type -> pb.FindByIdMerchantRequest
+	18, // 20: pb.MerchantService.RestoreAllMerchant:input_type -> google.protobuf.Empty
+	18, // 21: pb.MerchantService.DeleteAllMerchantPermanent:input_type -> google.protobuf.Empty
+	15, // 22: pb.MerchantService.FindAll:output_type -> pb.ApiResponsePaginationMerchant
+	7,  // 23: pb.MerchantService.FindById:output_type -> pb.ApiResponseMerchant
+	14, // 24: pb.MerchantService.FindByActive:output_type -> pb.ApiResponsePaginationMerchantDeleteAt
+	14, // 25: pb.MerchantService.FindByTrashed:output_type -> pb.ApiResponsePaginationMerchantDeleteAt
+	7,  // 26: pb.MerchantService.Create:output_type -> pb.ApiResponseMerchant
+	7,  // 27: pb.MerchantService.Update:output_type -> pb.ApiResponseMerchant
+	9,  // 28: pb.MerchantService.UpdateBarcodeSettings:output_type -> pb.ApiResponseMerchantBarcodeSettings
+	10, // 29: pb.MerchantService.TrashedMerchant:output_type -> pb.ApiResponseMerchantDeleteAt
+	10, // 30: pb.MerchantService.RestoreMerchant:output_type -> pb.ApiResponseMerchantDeleteAt
+	12, // 31: pb.MerchantService.DeleteMerchantPermanent:output_type -> pb.ApiResponseMerchantDelete
+	13, // 32: pb.MerchantService.RestoreAllMerchant:output_type -> pb.ApiResponseMerchantAll
+	13, // 33: pb.MerchantService.DeleteAllMerchantPermanent:output_type -> pb.ApiResponseMerchantAll
+	22, // [22:34] is the sub-list for method output_type
+	10, // [10:22] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_merchant_proto_init() }
@@ -1161,7 +1378,7 @@ func file_merchant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_merchant_proto_rawDesc), len(file_merchant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,7 @@ const (
 	MerchantService_FindByTrashed_FullMethodName              = "/pb.MerchantService/FindByTrashed"
 	MerchantService_Create_FullMethodName                     = "/pb.MerchantService/Create"
 	MerchantService_Update_FullMethodName                     = "/pb.MerchantService/Update"
+	MerchantService_UpdateBarcodeSettings_FullMethodName      = "/pb.MerchantService/UpdateBarcodeSettings"
 	MerchantService_TrashedMerchant_FullMethodName            = "/pb.MerchantService/TrashedMerchant"
 	MerchantService_RestoreMerchant_FullMethodName            = "/pb.MerchantService/RestoreMerchant"
 	MerchantService_DeleteMerchantPermanent_FullMethodName    = "/pb.MerchantService/DeleteMerchantPermanent"
@@ -43,6 +44,7 @@ type MerchantServiceClient interface {
 	FindByTrashed(ctx context.Context, in *FindAllMerchantRequest, opts ...grpc.CallOption) (*ApiResponsePaginationMerchantDeleteAt, error)
 	Create(ctx context.Context, in *CreateMerchantRequest, opts ...grpc.CallOption) (*ApiResponseMerchant, error)
 	Update(ctx context.Context, in *UpdateMerchantRequest, opts ...grpc.CallOption) (*ApiResponseMerchant, error)
+	UpdateBarcodeSettings(ctx context.Context, in *UpdateMerchantBarcodeSettingsRequest, opts ...grpc.CallOption) (*ApiResponseMerchantBarcodeSettings, error)
 	TrashedMerchant(ctx context.Context, in *FindByIdMerchantRequest, opts ...grpc.CallOption) (*ApiResponseMerchantDeleteAt, error)
 	RestoreMerchant(ctx context.Context, in *FindByIdMerchantRequest, opts ...grpc.CallOption) (*ApiResponseMerchantDeleteAt, error)
 	DeleteMerchantPermanent(ctx context.Context, in *FindByIdMerchantRequest, opts ...grpc.CallOption) (*ApiResponseMerchantDelete, error)
@@ -118,6 +120,16 @@ func (c *merchantServiceClient) Update(ctx context.Context, in *UpdateMerchantRe
 	return out, nil
 }
 
+func (c *merchantServiceClient) UpdateBarcodeSettings(ctx context.Context, in *UpdateMerchantBarcodeSettingsRequest, opts ...grpc.CallOption) (*ApiResponseMerchantBarcodeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseMerchantBarcodeSettings)
+	err := c.cc.Invoke(ctx, MerchantService_UpdateBarcodeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *merchantServiceClient) TrashedMerchant(ctx context.Context, in *FindByIdMerchantRequest, opts ...grpc.CallOption) (*ApiResponseMerchantDeleteAt, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponseMerchantDeleteAt)
@@ -178,6 +190,7 @@ type MerchantServiceServer interface {
 	FindByTrashed(context.Context, *FindAllMerchantRequest) (*ApiResponsePaginationMerchantDeleteAt, error)
 	Create(context.Context, *CreateMerchantRequest) (*ApiResponseMerchant, error)
 	Update(context.Context, *UpdateMerchantRequest) (*ApiResponseMerchant, error)
+	UpdateBarcodeSettings(context.Context, *UpdateMerchantBarcodeSettingsRequest) (*ApiResponseMerchantBarcodeSettings, error)
 	TrashedMerchant(context.Context, *FindByIdMerchantRequest) (*ApiResponseMerchantDeleteAt, error)
 	RestoreMerchant(context.Context, *FindByIdMerchantRequest) (*ApiResponseMerchantDeleteAt, error)
 	DeleteMerchantPermanent(context.Context, *FindByIdMerchantRequest) (*ApiResponseMerchantDelete, error)
@@ -211,6 +224,9 @@ func (UnimplementedMerchantServiceServer) Create(context.Context, *CreateMerchan
 func (UnimplementedMerchantServiceServer) Update(context.Context, *UpdateMerchantRequest) (*ApiResponseMerchant, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedMerchantServiceServer) UpdateBarcodeSettings(context.Context, *UpdateMerchantBarcodeSettingsRequest) (*ApiResponseMerchantBarcodeSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBarcodeSettings not implemented")
+}
 func (UnimplementedMerchantServiceServer) TrashedMerchant(context.Context, *FindByIdMerchantRequest) (*ApiResponseMerchantDeleteAt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrashedMerchant not implemented")
 }
@@ -355,6 +371,24 @@ func _MerchantService_Update_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MerchantService_UpdateBarcodeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMerchantBarcodeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).UpdateBarcodeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_UpdateBarcodeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).UpdateBarcodeSettings(ctx, req.(*UpdateMerchantBarcodeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MerchantService_TrashedMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindByIdMerchantRequest)
 	if err := dec(in); err != nil {
@@ -476,6 +510,10 @@ var MerchantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _MerchantService_Update_Handler,
 		},
+		{
+			MethodName: "UpdateBarcodeSettings",
+			Handler:    _MerchantService_UpdateBarcodeSettings_Handler,
+		},
 		{
 			MethodName: "TrashedMerchant",
 			Handler:    _MerchantService_TrashedMerchant_Handler,
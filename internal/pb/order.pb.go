@@ -28,6 +28,7 @@ type FindAllOrderRequest struct {
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,12 +84,20 @@ func (x *FindAllOrderRequest) GetSearch() string {
 	return ""
 }
 
+func (x *FindAllOrderRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type FindAllOrderMerchantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
 	MerchantId    int32                  `protobuf:"varint,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -151,6 +160,13 @@ func (x *FindAllOrderMerchantRequest) GetMerchantId() int32 {
 	return 0
 }
 
+func (x *FindAllOrderMerchantRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type FindByIdOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -727,6 +743,7 @@ type CreateOrderItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId     *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -775,18 +792,78 @@ func (x *CreateOrderItemRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *CreateOrderItemRequest) GetVariantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.VariantId
+	}
+	return nil
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateOrderStatusRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type UpdateOrderItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderItemId   int32                  `protobuf:"varint,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
 	ProductId     int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId     *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateOrderItemRequest) Reset() {
 	*x = UpdateOrderItemRequest{}
-	mi := &file_order_proto_msgTypes[14]
+	mi := &file_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +875,7 @@ func (x *UpdateOrderItemRequest) String() string {
 func (*UpdateOrderItemRequest) ProtoMessage() {}
 
 func (x *UpdateOrderItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[14]
+	mi := &file_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +888,7 @@ func (x *UpdateOrderItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderItemRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{14}
+	return file_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateOrderItemRequest) GetOrderItemId() int32 {
@@ -835,6 +912,13 @@ func (x *UpdateOrderItemRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *UpdateOrderItemRequest) GetVariantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.VariantId
+	}
+	return nil
+}
+
 type OrderMonthlyResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Month          string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
@@ -847,7 +931,7 @@ type OrderMonthlyResponse struct {
 
 func (x *OrderMonthlyResponse) Reset() {
 	*x = OrderMonthlyResponse{}
-	mi := &file_order_proto_msgTypes[15]
+	mi := &file_order_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +943,7 @@ func (x *OrderMonthlyResponse) String() string {
 func (*OrderMonthlyResponse) ProtoMessage() {}
 
 func (x *OrderMonthlyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[15]
+	mi := &file_order_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +956,7 @@ func (x *OrderMonthlyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderMonthlyResponse.ProtoReflect.Descriptor instead.
 func (*OrderMonthlyResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{15}
+	return file_order_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *OrderMonthlyResponse) GetMonth() string {
@@ -917,7 +1001,7 @@ type OrderYearlyResponse struct {
 
 func (x *OrderYearlyResponse) Reset() {
 	*x = OrderYearlyResponse{}
-	mi := &file_order_proto_msgTypes[16]
+	mi := &file_order_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -929,7 +1013,7 @@ func (x *OrderYearlyResponse) String() string {
 func (*OrderYearlyResponse) ProtoMessage() {}
 
 func (x *OrderYearlyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[16]
+	mi := &file_order_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,7 +1026,7 @@ func (x *OrderYearlyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderYearlyResponse.ProtoReflect.Descriptor instead.
 func (*OrderYearlyResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{16}
+	return file_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *OrderYearlyResponse) GetYear() string {
@@ -995,13 +1079,14 @@ type OrderResponse struct {
 	TotalPrice    int32                  `protobuf:"varint,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderResponse) Reset() {
 	*x = OrderResponse{}
-	mi := &file_order_proto_msgTypes[17]
+	mi := &file_order_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1013,7 +1098,7 @@ func (x *OrderResponse) String() string {
 func (*OrderResponse) ProtoMessage() {}
 
 func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[17]
+	mi := &file_order_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,7 +1111,7 @@ func (x *OrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
 func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{17}
+	return file_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *OrderResponse) GetId() int32 {
@@ -1071,6 +1156,13 @@ func (x *OrderResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *OrderResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type OrderResponseDeleteAt struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1080,13 +1172,14 @@ type OrderResponseDeleteAt struct {
 	CreatedAt     string                  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Status        string                  `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderResponseDeleteAt) Reset() {
 	*x = OrderResponseDeleteAt{}
-	mi := &file_order_proto_msgTypes[18]
+	mi := &file_order_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1098,7 +1191,7 @@ func (x *OrderResponseDeleteAt) String() string {
 func (*OrderResponseDeleteAt) ProtoMessage() {}
 
 func (x *OrderResponseDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[18]
+	mi := &file_order_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1204,7 @@ func (x *OrderResponseDeleteAt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderResponseDeleteAt.ProtoReflect.Descriptor instead.
 func (*OrderResponseDeleteAt) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{18}
+	return file_order_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *OrderResponseDeleteAt) GetId() int32 {
@@ -1163,6 +1256,13 @@ func (x *OrderResponseDeleteAt) GetDeletedAt() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *OrderResponseDeleteAt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type OrderMonthlyTotalRevenueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -1174,7 +1274,7 @@ type OrderMonthlyTotalRevenueResponse struct {
 
 func (x *OrderMonthlyTotalRevenueResponse) Reset() {
 	*x = OrderMonthlyTotalRevenueResponse{}
-	mi := &file_order_proto_msgTypes[19]
+	mi := &file_order_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1186,7 +1286,7 @@ func (x *OrderMonthlyTotalRevenueResponse) String() string {
 func (*OrderMonthlyTotalRevenueResponse) ProtoMessage() {}
 
 func (x *OrderMonthlyTotalRevenueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[19]
+	mi := &file_order_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +1299,7 @@ func (x *OrderMonthlyTotalRevenueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderMonthlyTotalRevenueResponse.ProtoReflect.Descriptor instead.
 func (*OrderMonthlyTotalRevenueResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{19}
+	return file_order_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *OrderMonthlyTotalRevenueResponse) GetYear() string {
@@ -1233,7 +1333,7 @@ type OrderYearlyTotalRevenueResponse struct {
 
 func (x *OrderYearlyTotalRevenueResponse) Reset() {
 	*x = OrderYearlyTotalRevenueResponse{}
-	mi := &file_order_proto_msgTypes[20]
+	mi := &file_order_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1245,7 +1345,7 @@ func (x *OrderYearlyTotalRevenueResponse) String() string {
 func (*OrderYearlyTotalRevenueResponse) ProtoMessage() {}
 
 func (x *OrderYearlyTotalRevenueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[20]
+	mi := &file_order_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1358,7 @@ func (x *OrderYearlyTotalRevenueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderYearlyTotalRevenueResponse.ProtoReflect.Descriptor instead.
 func (*OrderYearlyTotalRevenueResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{20}
+	return file_order_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *OrderYearlyTotalRevenueResponse) GetYear() string {
@@ -1286,7 +1386,7 @@ type ApiResponseOrderMonthly struct {
 
 func (x *ApiResponseOrderMonthly) Reset() {
 	*x = ApiResponseOrderMonthly{}
-	mi := &file_order_proto_msgTypes[21]
+	mi := &file_order_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1398,7 @@ func (x *ApiResponseOrderMonthly) String() string {
 func (*ApiResponseOrderMonthly) ProtoMessage() {}
 
 func (x *ApiResponseOrderMonthly) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[21]
+	mi := &file_order_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1411,7 @@ func (x *ApiResponseOrderMonthly) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseOrderMonthly.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrderMonthly) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{21}
+	return file_order_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ApiResponseOrderMonthly) GetStatus() string {
@@ -1346,7 +1446,7 @@ type ApiResponseOrderYearly struct {
 
 func (x *ApiResponseOrderYearly) Reset() {
 	*x = ApiResponseOrderYearly{}
-	mi := &file_order_proto_msgTypes[22]
+	mi := &file_order_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +1458,7 @@ func (x *ApiResponseOrderYearly) String() string {
 func (*ApiResponseOrderYearly) ProtoMessage() {}
 
 func (x *ApiResponseOrderYearly) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[22]
+	mi := &file_order_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +1471,7 @@ func (x *ApiResponseOrderYearly) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseOrderYearly.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrderYearly) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{22}
+	return file_order_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ApiResponseOrderYearly) GetStatus() string {
@@ -1406,7 +1506,7 @@ type ApiResponseOrder struct {
 
 func (x *ApiResponseOrder) Reset() {
 	*x = ApiResponseOrder{}
-	mi := &file_order_proto_msgTypes[23]
+	mi := &file_order_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1418,7 +1518,7 @@ func (x *ApiResponseOrder) String() string {
 func (*ApiResponseOrder) ProtoMessage() {}
 
 func (x *ApiResponseOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[23]
+	mi := &file_order_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1531,7 @@ func (x *ApiResponseOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseOrder.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrder) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{23}
+	return file_order_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ApiResponseOrder) GetStatus() string {
@@ -1466,7 +1566,7 @@ type ApiResponseOrderDeleteAt struct {
 
 func (x *ApiResponseOrderDeleteAt) Reset() {
 	*x = ApiResponseOrderDeleteAt{}
-	mi := &file_order_proto_msgTypes[24]
+	mi := &file_order_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1478,7 +1578,7 @@ func (x *ApiResponseOrderDeleteAt) String() string {
 func (*ApiResponseOrderDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponseOrderDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[24]
+	mi := &file_order_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +1591,7 @@ func (x *ApiResponseOrderDeleteAt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseOrderDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrderDeleteAt) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{24}
+	return file_order_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ApiResponseOrderDeleteAt) GetStatus() string {
@@ -1526,7 +1626,7 @@ type ApiResponsesOrder struct {
 
 func (x *ApiResponsesOrder) Reset() {
 	*x = ApiResponsesOrder{}
-	mi := &file_order_proto_msgTypes[25]
+	mi := &file_order_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1538,7 +1638,7 @@ func (x *ApiResponsesOrder) String() string {
 func (*ApiResponsesOrder) ProtoMessage() {}
 
 func (x *ApiResponsesOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[25]
+	mi := &file_order_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +1651,7 @@ func (x *ApiResponsesOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsesOrder.ProtoReflect.Descriptor instead.
 func (*ApiResponsesOrder) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{25}
+	return file_order_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ApiResponsesOrder) GetStatus() string {
@@ -1585,7 +1685,7 @@ type ApiResponseOrderDelete struct {
 
 func (x *ApiResponseOrderDelete) Reset() {
 	*x = ApiResponseOrderDelete{}
-	mi := &file_order_proto_msgTypes[26]
+	mi := &file_order_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1597,7 +1697,7 @@ func (x *ApiResponseOrderDelete) String() string {
 func (*ApiResponseOrderDelete) ProtoMessage() {}
 
 func (x *ApiResponseOrderDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[26]
+	mi := &file_order_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1610,7 +1710,7 @@ func (x *ApiResponseOrderDelete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseOrderDelete.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrderDelete) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{26}
+	return file_order_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ApiResponseOrderDelete) GetStatus() string {
@@ -1637,7 +1737,7 @@ type ApiResponseOrderAll struct {
 
 func (x *ApiResponseOrderAll) Reset() {
 	*x = ApiResponseOrderAll{}
-	mi := &file_order_proto_msgTypes[27]
+	mi := &file_order_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1649,7 +1749,7 @@ func (x *ApiResponseOrderAll) String() string {
 func (*ApiResponseOrderAll) ProtoMessage() {}
 
 func (x *ApiResponseOrderAll) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[27]
+	mi := &file_order_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,7 +1762,7 @@ func (x *ApiResponseOrderAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseOrderAll.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrderAll) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{27}
+	return file_order_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ApiResponseOrderAll) GetStatus() string {
@@ -1691,7 +1791,7 @@ type ApiResponsePaginationOrderDeleteAt struct {
 
 func (x *ApiResponsePaginationOrderDeleteAt) Reset() {
 	*x = ApiResponsePaginationOrderDeleteAt{}
-	mi := &file_order_proto_msgTypes[28]
+	mi := &file_order_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1703,7 +1803,7 @@ func (x *ApiResponsePaginationOrderDeleteAt) String() string {
 func (*ApiResponsePaginationOrderDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponsePaginationOrderDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[28]
+	mi := &file_order_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +1816,7 @@ func (x *ApiResponsePaginationOrderDeleteAt) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ApiResponsePaginationOrderDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationOrderDeleteAt) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{28}
+	return file_order_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ApiResponsePaginationOrderDeleteAt) GetStatus() string {
@@ -1759,7 +1859,7 @@ type ApiResponsePaginationOrder struct {
 
 func (x *ApiResponsePaginationOrder) Reset() {
 	*x = ApiResponsePaginationOrder{}
-	mi := &file_order_proto_msgTypes[29]
+	mi := &file_order_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1771,7 +1871,7 @@ func (x *ApiResponsePaginationOrder) String() string {
 func (*ApiResponsePaginationOrder) ProtoMessage() {}
 
 func (x *ApiResponsePaginationOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[29]
+	mi := &file_order_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,7 +1884,7 @@ func (x *ApiResponsePaginationOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsePaginationOrder.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationOrder) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{29}
+	return file_order_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ApiResponsePaginationOrder) GetStatus() string {
@@ -1826,7 +1926,7 @@ type ApiResponseOrderMonthlyTotalRevenue struct {
 
 func (x *ApiResponseOrderMonthlyTotalRevenue) Reset() {
 	*x = ApiResponseOrderMonthlyTotalRevenue{}
-	mi := &file_order_proto_msgTypes[30]
+	mi := &file_order_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1838,7 +1938,7 @@ func (x *ApiResponseOrderMonthlyTotalRevenue) String() string {
 func (*ApiResponseOrderMonthlyTotalRevenue) ProtoMessage() {}
 
 func (x *ApiResponseOrderMonthlyTotalRevenue) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[30]
+	mi := &file_order_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,7 +1951,7 @@ func (x *ApiResponseOrderMonthlyTotalRevenue) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ApiResponseOrderMonthlyTotalRevenue.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrderMonthlyTotalRevenue) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{30}
+	return file_order_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ApiResponseOrderMonthlyTotalRevenue) GetStatus() string {
@@ -1886,7 +1986,7 @@ type ApiResponseOrderYearlyTotalRevenue struct {
 
 func (x *ApiResponseOrderYearlyTotalRevenue) Reset() {
 	*x = ApiResponseOrderYearlyTotalRevenue{}
-	mi := &file_order_proto_msgTypes[31]
+	mi := &file_order_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1898,7 +1998,7 @@ func (x *ApiResponseOrderYearlyTotalRevenue) String() string {
 func (*ApiResponseOrderYearlyTotalRevenue) ProtoMessage() {}
 
 func (x *ApiResponseOrderYearlyTotalRevenue) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[31]
+	mi := &file_order_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,7 +2011,7 @@ func (x *ApiResponseOrderYearlyTotalRevenue) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ApiResponseOrderYearlyTotalRevenue.ProtoReflect.Descriptor instead.
 func (*ApiResponseOrderYearlyTotalRevenue) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{31}
+	return file_order_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ApiResponseOrderYearlyTotalRevenue) GetStatus() string {
@@ -1939,17 +2039,19 @@ var File_order_proto protoreflect.FileDescriptor
 
 const file_order_proto_rawDesc = "" +
 	"\n" +
-	"\vorder.proto\x12\x02pb\x1a\tapi.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\"^\n" +
+	"\vorder.proto\x12\x02pb\x1a\tapi.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1bgoogle/protobuf/empty.proto\"v\n" +
 	"\x13FindAllOrderRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
-	"\x06search\x18\x03 \x01(\tR\x06search\"\x87\x01\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\x9f\x01\n" +
 	"\x1bFindAllOrderMerchantRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\x12\x1f\n" +
 	"\vmerchant_id\x18\x04 \x01(\x05R\n" +
-	"merchantId\"&\n" +
+	"merchantId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"&\n" +
 	"\x14FindByIdOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"#\n" +
 	"\rFindYearOrder\x12\x12\n" +
@@ -1987,16 +2089,23 @@ const file_order_proto_rawDesc = "" +
 	"\x05items\x18\x04 \x03(\v2\x1a.pb.CreateOrderItemRequestR\x05items\"a\n" +
 	"\x12UpdateOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x05R\aorderId\x120\n" +
-	"\x05items\x18\x03 \x03(\v2\x1a.pb.UpdateOrderItemRequestR\x05items\"S\n" +
+	"\x05items\x18\x03 \x03(\v2\x1a.pb.UpdateOrderItemRequestR\x05items\"\x8f\x01\n" +
 	"\x16CreateOrderItemRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"w\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12:\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\tvariantId\"M\n" +
+	"\x18UpdateOrderStatusRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xb3\x01\n" +
 	"\x16UpdateOrderItemRequest\x12\"\n" +
 	"\rorder_item_id\x18\x01 \x01(\x05R\vorderItemId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x9c\x01\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12:\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\tvariantId\"\x9c\x01\n" +
 	"\x14OrderMonthlyResponse\x12\x14\n" +
 	"\x05month\x18\x01 \x01(\tR\x05month\x12\x1f\n" +
 	"\vorder_count\x18\x02 \x01(\x05R\n" +
@@ -2010,7 +2119,7 @@ const file_order_proto_rawDesc = "" +
 	"\rtotal_revenue\x18\x03 \x01(\x05R\ftotalRevenue\x12(\n" +
 	"\x10total_items_sold\x18\x04 \x01(\x05R\x0etotalItemsSold\x12'\n" +
 	"\x0factive_cashiers\x18\x05 \x01(\x05R\x0eactiveCashiers\x120\n" +
-	"\x14unique_products_sold\x18\x06 \x01(\x05R\x12uniqueProductsSold\"\xbe\x01\n" +
+	"\x14unique_products_sold\x18\x06 \x01(\x05R\x12uniqueProductsSold\"\xd6\x01\n" +
 	"\rOrderResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x05R\n" +
@@ -2022,7 +2131,8 @@ const file_order_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\x83\x02\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"\x9b\x02\n" +
 	"\x15OrderResponseDeleteAt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x05R\n" +
@@ -2036,7 +2146,8 @@ const file_order_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12;\n" +
 	"\n" +
-	"deleted_at\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\"q\n" +
+	"deleted_at\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"q\n" +
 	" OrderMonthlyTotalRevenueResponse\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\tR\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\tR\x05month\x12#\n" +
@@ -2091,7 +2202,7 @@ const file_order_proto_rawDesc = "" +
 	"\"ApiResponseOrderYearlyTotalRevenue\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
-	"\x04data\x18\x03 \x03(\v2#.pb.OrderYearlyTotalRevenueResponseR\x04data2\xa7\x0e\n" +
+	"\x04data\x18\x03 \x03(\v2#.pb.OrderYearlyTotalRevenueResponseR\x04data2\xeb\x0e\n" +
 	"\fOrderService\x12c\n" +
 	"\x17FindMonthlyTotalRevenue\x12\x1d.pb.FindYearMonthTotalRevenue\x1a'.pb.ApiResponseOrderMonthlyTotalRevenue\"\x00\x12\\\n" +
 	"\x16FindYearlyTotalRevenue\x12\x18.pb.FindYearTotalRevenue\x1a&.pb.ApiResponseOrderYearlyTotalRevenue\"\x00\x12k\n" +
@@ -2109,7 +2220,8 @@ const file_order_proto_rawDesc = "" +
 	"\fFindByActive\x12\x17.pb.FindAllOrderRequest\x1a&.pb.ApiResponsePaginationOrderDeleteAt\"\x00\x12R\n" +
 	"\rFindByTrashed\x12\x17.pb.FindAllOrderRequest\x1a&.pb.ApiResponsePaginationOrderDeleteAt\"\x00\x126\n" +
 	"\x06Create\x12\x16.pb.CreateOrderRequest\x1a\x14.pb.ApiResponseOrder\x126\n" +
-	"\x06Update\x12\x16.pb.UpdateOrderRequest\x1a\x14.pb.ApiResponseOrder\x12F\n" +
+	"\x06Update\x12\x16.pb.UpdateOrderRequest\x1a\x14.pb.ApiResponseOrder\x12B\n" +
+	"\fUpdateStatus\x12\x1c.pb.UpdateOrderStatusRequest\x1a\x14.pb.ApiResponseOrder\x12F\n" +
 	"\fTrashedOrder\x12\x18.pb.FindByIdOrderRequest\x1a\x1c.pb.ApiResponseOrderDeleteAt\x12F\n" +
 	"\fRestoreOrder\x12\x18.pb.FindByIdOrderRequest\x1a\x1c.pb.ApiResponseOrderDeleteAt\x12L\n" +
 	"\x14DeleteOrderPermanent\x12\x18.pb.FindByIdOrderRequest\x1a\x1a.pb.ApiResponseOrderDelete\x12D\n" +
@@ -2128,7 +2240,7 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_order_proto_goTypes = []any{
 	(*FindAllOrderRequest)(nil),                 // 0: pb.FindAllOrderRequest
 	(*FindAllOrderMerchantRequest)(nil),         // 1: pb.FindAllOrderMerchantRequest
@@ -2144,92 +2256,98 @@ var file_order_proto_goTypes = []any{
 	(*CreateOrderRequest)(nil),                  // 11: pb.CreateOrderRequest
 	(*UpdateOrderRequest)(nil),                  // 12: pb.UpdateOrderRequest
 	(*CreateOrderItemRequest)(nil),              // 13: pb.CreateOrderItemRequest
-	(*UpdateOrderItemRequest)(nil),              // 14: pb.UpdateOrderItemRequest
-	(*OrderMonthlyResponse)(nil),                // 15: pb.OrderMonthlyResponse
-	(*OrderYearlyResponse)(nil),                 // 16: pb.OrderYearlyResponse
-	(*OrderResponse)(nil),                       // 17: pb.OrderResponse
-	(*OrderResponseDeleteAt)(nil),               // 18: pb.OrderResponseDeleteAt
-	(*OrderMonthlyTotalRevenueResponse)(nil),    // 19: pb.OrderMonthlyTotalRevenueResponse
-	(*OrderYearlyTotalRevenueResponse)(nil),     // 20: pb.OrderYearlyTotalRevenueResponse
-	(*ApiResponseOrderMonthly)(nil),             // 21: pb.ApiResponseOrderMonthly
-	(*ApiResponseOrderYearly)(nil),              // 22: pb.ApiResponseOrderYearly
-	(*ApiResponseOrder)(nil),                    // 23: pb.ApiResponseOrder
-	(*ApiResponseOrderDeleteAt)(nil),            // 24: pb.ApiResponseOrderDeleteAt
-	(*ApiResponsesOrder)(nil),                   // 25: pb.ApiResponsesOrder
-	(*ApiResponseOrderDelete)(nil),              // 26: pb.ApiResponseOrderDelete
-	(*ApiResponseOrderAll)(nil),                 // 27: pb.ApiResponseOrderAll
-	(*ApiResponsePaginationOrderDeleteAt)(nil),  // 28: pb.ApiResponsePaginationOrderDeleteAt
-	(*ApiResponsePaginationOrder)(nil),          // 29: pb.ApiResponsePaginationOrder
-	(*ApiResponseOrderMonthlyTotalRevenue)(nil), // 30: pb.ApiResponseOrderMonthlyTotalRevenue
-	(*ApiResponseOrderYearlyTotalRevenue)(nil),  // 31: pb.ApiResponseOrderYearlyTotalRevenue
-	(*wrapperspb.StringValue)(nil),              // 32: google.protobuf.StringValue
-	(*PaginationMeta)(nil),                      // 33: pb.PaginationMeta
-	(*emptypb.Empty)(nil),                       // 34: google.protobuf.Empty
+	(*UpdateOrderStatusRequest)(nil),            // 14: pb.UpdateOrderStatusRequest
+	(*UpdateOrderItemRequest)(nil),              // 15: pb.UpdateOrderItemRequest
+	(*OrderMonthlyResponse)(nil),                // 16: pb.OrderMonthlyResponse
+	(*OrderYearlyResponse)(nil),                 // 17: pb.OrderYearlyResponse
+	(*OrderResponse)(nil),                       // 18: pb.OrderResponse
+	(*OrderResponseDeleteAt)(nil),               // 19: pb.OrderResponseDeleteAt
+	(*OrderMonthlyTotalRevenueResponse)(nil),    // 20: pb.OrderMonthlyTotalRevenueResponse
+	(*OrderYearlyTotalRevenueResponse)(nil),     // 21: pb.OrderYearlyTotalRevenueResponse
+	(*ApiResponseOrderMonthly)(nil),             // 22: pb.ApiResponseOrderMonthly
+	(*ApiResponseOrderYearly)(nil),              // 23: pb.ApiResponseOrderYearly
+	(*ApiResponseOrder)(nil),                    // 24: pb.ApiResponseOrder
+	(*ApiResponseOrderDeleteAt)(nil),            // 25: pb.ApiResponseOrderDeleteAt
+	(*ApiResponsesOrder)(nil),                   // 26: pb.ApiResponsesOrder
+	(*ApiResponseOrderDelete)(nil),              // 27: pb.ApiResponseOrderDelete
+	(*ApiResponseOrderAll)(nil),                 // 28: pb.ApiResponseOrderAll
+	(*ApiResponsePaginationOrderDeleteAt)(nil),  // 29: pb.ApiResponsePaginationOrderDeleteAt
+	(*ApiResponsePaginationOrder)(nil),          // 30: pb.ApiResponsePaginationOrder
+	(*ApiResponseOrderMonthlyTotalRevenue)(nil), // 31: pb.ApiResponseOrderMonthlyTotalRevenue
+	(*ApiResponseOrderYearlyTotalRevenue)(nil),  // 32: pb.ApiResponseOrderYearlyTotalRevenue
+	(*wrapperspb.Int32Value)(nil),               // 33: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil),              // 34: google.protobuf.StringValue
+	(*PaginationMeta)(nil),                      // 35: pb.PaginationMeta
+	(*emptypb.Empty)(nil),                       // 36: google.protobuf.Empty
 }
 var file_order_proto_depIdxs = []int32{
 	13, // 0: pb.CreateOrderRequest.items:type_name -> pb.CreateOrderItemRequest
-	14, // 1: pb.UpdateOrderRequest.items:type_name -> pb.UpdateOrderItemRequest
-	32, // 2: pb.OrderResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
-	15, // 3: pb.ApiResponseOrderMonthly.data:type_name -> pb.OrderMonthlyResponse
-	16, // 4: pb.ApiResponseOrderYearly.data:type_name -> pb.OrderYearlyResponse
-	17, // 5: pb.ApiResponseOrder.data:type_name -> pb.OrderResponse
-	18, // 6: pb.ApiResponseOrderDeleteAt.data:type_name -> pb.OrderResponseDeleteAt
-	17, // 7: pb.ApiResponsesOrder.data:type_name -> pb.OrderResponse
-	18, // 8: pb.ApiResponsePaginationOrderDeleteAt.data:type_name -> pb.OrderResponseDeleteAt
-	33, // 9: pb.ApiResponsePaginationOrderDeleteAt.pagination:type_name -> pb.PaginationMeta
-	17, // 10: pb.ApiResponsePaginationOrder.data:type_name -> pb.OrderResponse
-	33, // 11: pb.ApiResponsePaginationOrder.pagination:type_name -> pb.PaginationMeta
-	19, // 12: pb.ApiResponseOrderMonthlyTotalRevenue.data:type_name -> pb.OrderMonthlyTotalRevenueResponse
-	20, // 13: pb.ApiResponseOrderYearlyTotalRevenue.data:type_name -> pb.OrderYearlyTotalRevenueResponse
-	5,  // 14: pb.OrderService.FindMonthlyTotalRevenue:input_type -> pb.FindYearMonthTotalRevenue
-	6,  // 15: pb.OrderService.FindYearlyTotalRevenue:input_type -> pb.FindYearTotalRevenue
-	7,  // 16: pb.OrderService.FindMonthlyTotalRevenueById:input_type -> pb.FindYearMonthTotalRevenueById
-	8,  // 17: pb.OrderService.FindYearlyTotalRevenueById:input_type -> pb.FindYearTotalRevenueById
-	9,  // 18: pb.OrderService.FindMonthlyTotalRevenueByMerchant:input_type -> pb.FindYearMonthTotalRevenueByMerchant
-	10, // 19: pb.OrderService.FindYearlyTotalRevenueByMerchant:input_type -> pb.FindYearTotalRevenueByMerchant
-	0,  // 20: pb.OrderService.FindAll:input_type -> pb.FindAllOrderRequest
-	1,  // 21: pb.OrderService.FindByMerchant:input_type -> pb.FindAllOrderMerchantRequest
-	2,  // 22: pb.OrderService.FindById:input_type -> pb.FindByIdOrderRequest
-	3,  // 23: pb.OrderService.FindMonthlyRevenue:input_type -> pb.FindYearOrder
-	3,  // 24: pb.OrderService.FindYearlyRevenue:input_type -> pb.FindYearOrder
-	4,  // 25: pb.OrderService.FindMonthlyRevenueByMerchant:input_type -> pb.FindYearOrderByMerchant
-	4,  // 26: pb.OrderService.FindYearlyRevenueByMerchant:input_type -> pb.FindYearOrderByMerchant
-	0,  // 27: pb.OrderService.FindByActive:input_type -> pb.FindAllOrderRequest
-	0,  // 28: pb.OrderService.FindByTrashed:input_type -> pb.FindAllOrderRequest
-	11, // 29: pb.OrderService.Create:input_type -> pb.CreateOrderRequest
-	12, // 30: pb.OrderService.Update:input_type -> pb.UpdateOrderRequest
-	2,  // 31: pb.OrderService.TrashedOrder:input_type -> pb.FindByIdOrderRequest
-	2,  // 32: pb.OrderService.RestoreOrder:input_type -> pb.FindByIdOrderRequest
-	2,  // 33: pb.OrderService.DeleteOrderPermanent:input_type -> pb.FindByIdOrderRequest
-	34, // 34: pb.OrderService.RestoreAllOrder:input_type -> google.protobuf.Empty
-	34, // 35: pb.OrderService.DeleteAllOrderPermanent:input_type -> google.protobuf.Empty
-	30, // 36: pb.OrderService.FindMonthlyTotalRevenue:output_type -> pb.ApiResponseOrderMonthlyTotalRevenue
-	31, // 37: pb.OrderService.FindYearlyTotalRevenue:output_type -> pb.ApiResponseOrderYearlyTotalRevenue
-	30, // 38: pb.OrderService.FindMonthlyTotalRevenueById:output_type -> pb.ApiResponseOrderMonthlyTotalRevenue
-	31, // 39: pb.OrderService.FindYearlyTotalRevenueById:output_type -> pb.ApiResponseOrderYearlyTotalRevenue
-	30, // 40: pb.OrderService.FindMonthlyTotalRevenueByMerchant:output_type -> pb.ApiResponseOrderMonthlyTotalRevenue
-	31, // 41: pb.OrderService.FindYearlyTotalRevenueByMerchant:output_type -> pb.ApiResponseOrderYearlyTotalRevenue
-	29, // 42: pb.OrderService.FindAll:output_type -> pb.ApiResponsePaginationOrder
-	29, // 43: pb.OrderService.FindByMerchant:output_type -> pb.ApiResponsePaginationOrder
-	23, // 44: pb.OrderService.FindById:output_type -> pb.ApiResponseOrder
-	21, // 45: pb.OrderService.FindMonthlyRevenue:output_type -> pb.ApiResponseOrderMonthly
-	22, // 46: pb.OrderService.FindYearlyRevenue:output_type -> pb.ApiResponseOrderYearly
-	21, // 47: pb.OrderService.FindMonthlyRevenueByMerchant:output_type -> pb.ApiResponseOrderMonthly
-	22, // 48: pb.OrderService.FindYearlyRevenueByMerchant:output_type -> pb.ApiResponseOrderYearly
-	28, // 49: pb.OrderService.FindByActive:output_type -> pb.ApiResponsePaginationOrderDeleteAt
-	28, // 50: pb.OrderService.FindByTrashed:output_type -> pb.ApiResponsePaginationOrderDeleteAt
-	23, // 51: pb.OrderService.Create:output_type -> pb.ApiResponseOrder
-	23, // 52: pb.OrderService.Update:output_type -> pb.ApiResponseOrder
-	24, // 53: pb.OrderService.TrashedOrder:output_type -> pb.ApiResponseOrderDeleteAt
-	24, // 54: pb.OrderService.RestoreOrder:output_type -> pb.ApiResponseOrderDeleteAt
-	26, // 55: pb.OrderService.DeleteOrderPermanent:output_type -> pb.ApiResponseOrderDelete
-	27, // 56: pb.OrderService.RestoreAllOrder:output_type -> pb.ApiResponseOrderAll
-	27, // 57: pb.OrderService.DeleteAllOrderPermanent:output_type -> pb.ApiResponseOrderAll
-	36, // [36:58] is the sub-list for method output_type
-	14, // [14:36] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	15, // 1: pb.UpdateOrderRequest.items:type_name -> pb.UpdateOrderItemRequest
+	33, // 2: pb.CreateOrderItemRequest.variant_id:type_name -> google.protobuf.Int32Value
+	33, // 3: pb.UpdateOrderItemRequest.variant_id:type_name -> google.protobuf.Int32Value
+	34, // 4: pb.OrderResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
+	16, // 5: pb.ApiResponseOrderMonthly.data:type_name -> pb.OrderMonthlyResponse
+	17, // 6: pb.ApiResponseOrderYearly.data:type_name -> pb.OrderYearlyResponse
+	18, // 7: pb.ApiResponseOrder.data:type_name -> pb.OrderResponse
+	19, // 8: pb.ApiResponseOrderDeleteAt.data:type_name -> pb.OrderResponseDeleteAt
+	18, // 9: pb.ApiResponsesOrder.data:type_name -> pb.OrderResponse
+	19, // 10: pb.ApiResponsePaginationOrderDeleteAt.data:type_name -> pb.OrderResponseDeleteAt
+	35, // 11: pb.ApiResponsePaginationOrderDeleteAt.pagination:type_name -> pb.PaginationMeta
+	18, // 12: pb.ApiResponsePaginationOrder.data:type_name -> pb.OrderResponse
+	35, // 13: pb.ApiResponsePaginationOrder.pagination:type_name -> pb.PaginationMeta
+	20, // 14: pb.ApiResponseOrderMonthlyTotalRevenue.data:type_name -> pb.OrderMonthlyTotalRevenueResponse
+	21, // 15: pb.ApiResponseOrderYearlyTotalRevenue.data:type_name -> pb.OrderYearlyTotalRevenueResponse
+	5,  // 16: pb.OrderService.FindMonthlyTotalRevenue:input_type -> pb.FindYearMonthTotalRevenue
+	6,  // 17: pb.OrderService.FindYearlyTotalRevenue:input_type -> pb.FindYearTotalRevenue
+	7,  // 18: pb.OrderService.FindMonthlyTotalRevenueById:input_type -> pb.FindYearMonthTotalRevenueById
+	8,  // 19: pb.OrderService.FindYearlyTotalRevenueById:input_type -> pb.FindYearTotalRevenueById
+	9,  // 20: pb.OrderService.FindMonthlyTotalRevenueByMerchant:input_type -> pb.FindYearMonthTotalRevenueByMerchant
+	10, // 21: pb.OrderService.FindYearlyTotalRevenueByMerchant:input_type -> pb.FindYearTotalRevenueByMerchant
+	0,  // 22: pb.OrderService.FindAll:input_type -> pb.FindAllOrderRequest
+	1,  // 23: pb.OrderService.FindByMerchant:input_type -> pb.FindAllOrderMerchantRequest
+	2,  // 24: pb.OrderService.FindById:input_type -> pb.FindByIdOrderRequest
+	3,  // 25: pb.OrderService.FindMonthlyRevenue:input_type -> pb.FindYearOrder
+	3,  // 26: pb.OrderService.FindYearlyRevenue:input_type -> pb.FindYearOrder
+	4,  // 27: pb.OrderService.FindMonthlyRevenueByMerchant:input_type -> pb.FindYearOrderByMerchant
+	4,  // 28: pb.OrderService.FindYearlyRevenueByMerchant:input_type -> pb.FindYearOrderByMerchant
+	0,  // 29: pb.OrderService.FindByActive:input_type -> pb.FindAllOrderRequest
+	0,  // 30: pb.OrderService.FindByTrashed:input_type -> pb.FindAllOrderRequest
+	11, // 31: pb.OrderService.Create:input_type -> pb.CreateOrderRequest
+	12, // 32: pb.OrderService.Update:input_type -> pb.UpdateOrderRequest
+	14, // 33: pb.OrderService.UpdateStatus:input_type -> pb.UpdateOrderStatusRequest
+	2,  // 34: pb.OrderService.TrashedOrder:input_type -> pb.FindByIdOrderRequest
+	2,  // 35: pb.OrderService.RestoreOrder:input_type -> pb.FindByIdOrderRequest
+	2,  // 36: pb.OrderService.DeleteOrderPermanent:input_type -> pb.FindByIdOrderRequest
+	36, // 37: pb.OrderService.RestoreAllOrder:input_type -> google.protobuf.Empty
+	36, // 38: pb.OrderService.DeleteAllOrderPermanent:input_type -> google.protobuf.Empty
+	31, // 39: pb.OrderService.FindMonthlyTotalRevenue:output_type -> pb.ApiResponseOrderMonthlyTotalRevenue
+	32, // 40: pb.OrderService.FindYearlyTotalRevenue:output_type -> pb.ApiResponseOrderYearlyTotalRevenue
+	31, // 41: pb.OrderService.FindMonthlyTotalRevenueById:output_type -> pb.ApiResponseOrderMonthlyTotalRevenue
+	32, // 42: pb.OrderService.FindYearlyTotalRevenueById:output_type -> pb.ApiResponseOrderYearlyTotalRevenue
+	31, // 43: pb.OrderService.FindMonthlyTotalRevenueByMerchant:output_type -> pb.ApiResponseOrderMonthlyTotalRevenue
+	32, // 44: pb.OrderService.FindYearlyTotalRevenueByMerchant:output_type -> pb.ApiResponseOrderYearlyTotalRevenue
+	30, // 45: pb.OrderService.FindAll:output_type -> pb.ApiResponsePaginationOrder
+	30, // 46: pb.OrderService.FindByMerchant:output_type -> pb.ApiResponsePaginationOrder
+	24, // 47: pb.OrderService.FindById:output_type -> pb.ApiResponseOrder
+	22, // 48: pb.OrderService.FindMonthlyRevenue:output_type -> pb.ApiResponseOrderMonthly
+	23, // 49: pb.OrderService.FindYearlyRevenue:output_type -> pb.ApiResponseOrderYearly
+	22, // 50: pb.OrderService.FindMonthlyRevenueByMerchant:output_type -> pb.ApiResponseOrderMonthly
+	23, // 51: pb.OrderService.FindYearlyRevenueByMerchant:output_type -> pb.ApiResponseOrderYearly
+	29, // 52: pb.OrderService.FindByActive:output_type -> pb.ApiResponsePaginationOrderDeleteAt
+	29, // 53: pb.OrderService.FindByTrashed:output_type -> pb.ApiResponsePaginationOrderDeleteAt
+	24, // 54: pb.OrderService.Create:output_type -> pb.ApiResponseOrder
+	24, // 55: pb.OrderService.Update:output_type -> pb.ApiResponseOrder
+	24, // 56: pb.OrderService.UpdateStatus:output_type -> pb.ApiResponseOrder
+	25, // 57: pb.OrderService.TrashedOrder:output_type -> pb.ApiResponseOrderDeleteAt
+	25, // 58: pb.OrderService.RestoreOrder:output_type -> pb.ApiResponseOrderDeleteAt
+	27, // 59: pb.OrderService.DeleteOrderPermanent:output_type -> pb.ApiResponseOrderDelete
+	28, // 60: pb.OrderService.RestoreAllOrder:output_type -> pb.ApiResponseOrderAll
+	28, // 61: pb.OrderService.DeleteAllOrderPermanent:output_type -> pb.ApiResponseOrderAll
+	39, // [39:62] is the sub-list for method output_type
+	16, // [16:39] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -2244,7 +2362,7 @@ func file_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -37,6 +37,7 @@ const (
 	OrderService_FindByTrashed_FullMethodName                     = "/pb.OrderService/FindByTrashed"
 	OrderService_Create_FullMethodName                            = "/pb.OrderService/Create"
 	OrderService_Update_FullMethodName                            = "/pb.OrderService/Update"
+	OrderService_UpdateStatus_FullMethodName                      = "/pb.OrderService/UpdateStatus"
 	OrderService_TrashedOrder_FullMethodName                      = "/pb.OrderService/TrashedOrder"
 	OrderService_RestoreOrder_FullMethodName                      = "/pb.OrderService/RestoreOrder"
 	OrderService_DeleteOrderPermanent_FullMethodName              = "/pb.OrderService/DeleteOrderPermanent"
@@ -65,6 +66,7 @@ type OrderServiceClient interface {
 	FindByTrashed(ctx context.Context, in *FindAllOrderRequest, opts ...grpc.CallOption) (*ApiResponsePaginationOrderDeleteAt, error)
 	Create(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*ApiResponseOrder, error)
 	Update(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*ApiResponseOrder, error)
+	UpdateStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*ApiResponseOrder, error)
 	TrashedOrder(ctx context.Context, in *FindByIdOrderRequest, opts ...grpc.CallOption) (*ApiResponseOrderDeleteAt, error)
 	RestoreOrder(ctx context.Context, in *FindByIdOrderRequest, opts ...grpc.CallOption) (*ApiResponseOrderDeleteAt, error)
 	DeleteOrderPermanent(ctx context.Context, in *FindByIdOrderRequest, opts ...grpc.CallOption) (*ApiResponseOrderDelete, error)
@@ -250,6 +252,16 @@ func (c *orderServiceClient) Update(ctx context.Context, in *UpdateOrderRequest,
 	return out, nil
 }
 
+func (c *orderServiceClient) UpdateStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*ApiResponseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseOrder)
+	err := c.cc.Invoke(ctx, OrderService_UpdateStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) TrashedOrder(ctx context.Context, in *FindByIdOrderRequest, opts ...grpc.CallOption) (*ApiResponseOrderDeleteAt, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponseOrderDeleteAt)
@@ -321,6 +333,7 @@ type OrderServiceServer interface {
 	FindByTrashed(context.Context, *FindAllOrderRequest) (*ApiResponsePaginationOrderDeleteAt, error)
 	Create(context.Context, *CreateOrderRequest) (*ApiResponseOrder, error)
 	Update(context.Context, *UpdateOrderRequest) (*ApiResponseOrder, error)
+	UpdateStatus(context.Context, *UpdateOrderStatusRequest) (*ApiResponseOrder, error)
 	TrashedOrder(context.Context, *FindByIdOrderRequest) (*ApiResponseOrderDeleteAt, error)
 	RestoreOrder(context.Context, *FindByIdOrderRequest) (*ApiResponseOrderDeleteAt, error)
 	DeleteOrderPermanent(context.Context, *FindByIdOrderRequest) (*ApiResponseOrderDelete, error)
@@ -387,6 +400,9 @@ func (UnimplementedOrderServiceServer) Create(context.Context, *CreateOrderReque
 func (UnimplementedOrderServiceServer) Update(context.Context, *UpdateOrderRequest) (*ApiResponseOrder, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedOrderServiceServer) UpdateStatus(context.Context, *UpdateOrderStatusRequest) (*ApiResponseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
+}
 func (UnimplementedOrderServiceServer) TrashedOrder(context.Context, *FindByIdOrderRequest) (*ApiResponseOrderDeleteAt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrashedOrder not implemented")
 }
@@ -729,6 +745,24 @@ func _OrderService_Update_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_UpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdateStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateStatus(ctx, req.(*UpdateOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_TrashedOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindByIdOrderRequest)
 	if err := dec(in); err != nil {
@@ -894,6 +928,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _OrderService_Update_Handler,
 		},
+		{
+			MethodName: "UpdateStatus",
+			Handler:    _OrderService_UpdateStatus_Handler,
+		},
 		{
 			MethodName: "TrashedOrder",
 			Handler:    _OrderService_TrashedOrder_Handler,
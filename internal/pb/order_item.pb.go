@@ -135,6 +135,7 @@ type OrderItemResponse struct {
 	Price         int32                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	VariantId     *wrapperspb.Int32Value `protobuf:"bytes,8,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -218,6 +219,13 @@ func (x *OrderItemResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *OrderItemResponse) GetVariantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.VariantId
+	}
+	return nil
+}
+
 type OrderItemResponseDeleteAt struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -688,7 +696,7 @@ const file_order_item_proto_rawDesc = "" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\"*\n" +
 	"\x18FindByIdOrderItemRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xcd\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x89\x02\n" +
 	"\x11OrderItemResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x05R\aorderId\x12\x1d\n" +
@@ -699,7 +707,9 @@ const file_order_item_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"\x92\x02\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12:\n" +
+	"\n" +
+	"variant_id\x18\b \x01(\v2\x1b.google.protobuf.Int32ValueR\tvariantId\"\x92\x02\n" +
 	"\x19OrderItemResponseDeleteAt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x05R\aorderId\x12\x1d\n" +
@@ -771,30 +781,32 @@ var file_order_item_proto_goTypes = []any{
 	(*ApiResponseOrderItemAll)(nil),                // 7: pb.ApiResponseOrderItemAll
 	(*ApiResponsePaginationOrderItemDeleteAt)(nil), // 8: pb.ApiResponsePaginationOrderItemDeleteAt
 	(*ApiResponsePaginationOrderItem)(nil),         // 9: pb.ApiResponsePaginationOrderItem
-	(*wrapperspb.StringValue)(nil),                 // 10: google.protobuf.StringValue
-	(*PaginationMeta)(nil),                         // 11: pb.PaginationMeta
+	(*wrapperspb.Int32Value)(nil),                  // 10: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil),                 // 11: google.protobuf.StringValue
+	(*PaginationMeta)(nil),                         // 12: pb.PaginationMeta
 }
 var file_order_item_proto_depIdxs = []int32{
-	10, // 0: pb.OrderItemResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
-	2,  // 1: pb.ApiResponseOrderItem.data:type_name -> pb.OrderItemResponse
-	2,  // 2: pb.ApiResponsesOrderItem.data:type_name -> pb.OrderItemResponse
-	3,  // 3: pb.ApiResponsePaginationOrderItemDeleteAt.data:type_name -> pb.OrderItemResponseDeleteAt
-	11, // 4: pb.ApiResponsePaginationOrderItemDeleteAt.pagination:type_name -> pb.PaginationMeta
-	2,  // 5: pb.ApiResponsePaginationOrderItem.data:type_name -> pb.OrderItemResponse
-	11, // 6: pb.ApiResponsePaginationOrderItem.pagination:type_name -> pb.PaginationMeta
-	0,  // 7: pb.OrderItemService.FindAll:input_type -> pb.FindAllOrderItemRequest
-	0,  // 8: pb.OrderItemService.FindByActive:input_type -> pb.FindAllOrderItemRequest
-	0,  // 9: pb.OrderItemService.FindByTrashed:input_type -> pb.FindAllOrderItemRequest
-	1,  // 10: pb.OrderItemService.FindOrderItemByOrder:input_type -> pb.FindByIdOrderItemRequest
-	9,  // 11: pb.OrderItemService.FindAll:output_type -> pb.ApiResponsePaginationOrderItem
-	8,  // 12: pb.OrderItemService.FindByActive:output_type -> pb.ApiResponsePaginationOrderItemDeleteAt
-	8,  // 13: pb.OrderItemService.FindByTrashed:output_type -> pb.ApiResponsePaginationOrderItemDeleteAt
-	5,  // 14: pb.OrderItemService.FindOrderItemByOrder:output_type -> pb.ApiResponsesOrderItem
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	10, // 0: pb.OrderItemResponse.variant_id:type_name -> google.protobuf.Int32Value
+	11, // 1: pb.OrderItemResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
+	2,  // 2: pb.ApiResponseOrderItem.data:type_name -> pb.OrderItemResponse
+	2,  // 3: pb.ApiResponsesOrderItem.data:type_name -> pb.OrderItemResponse
+	3,  // 4: pb.ApiResponsePaginationOrderItemDeleteAt.data:type_name -> pb.OrderItemResponseDeleteAt
+	12, // 5: pb.ApiResponsePaginationOrderItemDeleteAt.pagination:type_name -> pb.PaginationMeta
+	2,  // 6: pb.ApiResponsePaginationOrderItem.data:type_name -> pb.OrderItemResponse
+	12, // 7: pb.ApiResponsePaginationOrderItem.pagination:type_name -> pb.PaginationMeta
+	0,  // 8: pb.OrderItemService.FindAll:input_type -> pb.FindAllOrderItemRequest
+	0,  // 9: pb.OrderItemService.FindByActive:input_type -> pb.FindAllOrderItemRequest
+	0,  // 10: pb.OrderItemService.FindByTrashed:input_type -> pb.FindAllOrderItemRequest
+	1,  // 11: pb.OrderItemService.FindOrderItemByOrder:input_type -> pb.FindByIdOrderItemRequest
+	9,  // 12: pb.OrderItemService.FindAll:output_type -> pb.ApiResponsePaginationOrderItem
+	8,  // 13: pb.OrderItemService.FindByActive:output_type -> pb.ApiResponsePaginationOrderItemDeleteAt
+	8,  // 14: pb.OrderItemService.FindByTrashed:output_type -> pb.ApiResponsePaginationOrderItemDeleteAt
+	5,  // 15: pb.OrderItemService.FindOrderItemByOrder:output_type -> pb.ApiResponsesOrderItem
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_order_item_proto_init() }
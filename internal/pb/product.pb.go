@@ -176,15 +176,16 @@ func (x *FindAllProductMerchantRequest) GetPageSize() int32 {
 }
 
 type FindAllProductCategoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryName  string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Search        string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
-	Minprice      int32                  `protobuf:"varint,5,opt,name=minprice,proto3" json:"minprice,omitempty"`
-	Maxprice      int32                  `protobuf:"varint,6,opt,name=maxprice,proto3" json:"maxprice,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CategoryName         string                 `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Page                 int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Search               string                 `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	Minprice             int32                  `protobuf:"varint,5,opt,name=minprice,proto3" json:"minprice,omitempty"`
+	Maxprice             int32                  `protobuf:"varint,6,opt,name=maxprice,proto3" json:"maxprice,omitempty"`
+	IncludeSubcategories bool                   `protobuf:"varint,7,opt,name=include_subcategories,json=includeSubcategories,proto3" json:"include_subcategories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *FindAllProductCategoryRequest) Reset() {
@@ -259,6 +260,13 @@ func (x *FindAllProductCategoryRequest) GetMaxprice() int32 {
 	return 0
 }
 
+func (x *FindAllProductCategoryRequest) GetIncludeSubcategories() bool {
+	if x != nil {
+		return x.IncludeSubcategories
+	}
+	return false
+}
+
 type FindByIdProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -303,24 +311,205 @@ func (x *FindByIdProductRequest) GetId() int32 {
 	return 0
 }
 
-type CreateProductRequest struct {
+type FindStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindStockMovementsRequest) Reset() {
+	*x = FindStockMovementsRequest{}
+	mi := &file_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindStockMovementsRequest) ProtoMessage() {}
+
+func (x *FindStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*FindStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindStockMovementsRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *FindStockMovementsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *FindStockMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type FindLowStockProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int32                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int32                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	CountInStock  int32                  `protobuf:"varint,6,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
-	Brand         string                 `protobuf:"bytes,7,opt,name=brand,proto3" json:"brand,omitempty"`
-	Weight        int32                  `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
-	ImageProduct  string                 `protobuf:"bytes,9,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindLowStockProductRequest) Reset() {
+	*x = FindLowStockProductRequest{}
+	mi := &file_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindLowStockProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindLowStockProductRequest) ProtoMessage() {}
+
+func (x *FindLowStockProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindLowStockProductRequest.ProtoReflect.Descriptor instead.
+func (*FindLowStockProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindLowStockProductRequest) GetMerchantId() int32 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *FindLowStockProductRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *FindLowStockProductRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UpdateReorderLevelRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ReorderPoint    int32                  `protobuf:"varint,2,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity int32                  `protobuf:"varint,3,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateReorderLevelRequest) Reset() {
+	*x = UpdateReorderLevelRequest{}
+	mi := &file_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReorderLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReorderLevelRequest) ProtoMessage() {}
+
+func (x *UpdateReorderLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReorderLevelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReorderLevelRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateReorderLevelRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateReorderLevelRequest) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *UpdateReorderLevelRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+type CreateProductRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	MerchantId    int32                   `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int32                   `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int32                   `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	CountInStock  int32                   `protobuf:"varint,6,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
+	Brand         string                  `protobuf:"bytes,7,opt,name=brand,proto3" json:"brand,omitempty"`
+	Weight        int32                   `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	ImageProduct  string                  `protobuf:"bytes,9,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
+	Barcode       *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=barcode,proto3" json:"barcode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +521,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +534,7 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{4}
+	return file_product_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateProductRequest) GetMerchantId() int32 {
@@ -411,25 +600,33 @@ func (x *CreateProductRequest) GetImageProduct() string {
 	return ""
 }
 
+func (x *CreateProductRequest) GetBarcode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Barcode
+	}
+	return nil
+}
+
 type UpdateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	MerchantId    int32                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int32                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	CountInStock  int32                  `protobuf:"varint,7,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
-	Brand         string                 `protobuf:"bytes,8,opt,name=brand,proto3" json:"brand,omitempty"`
-	Weight        int32                  `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
-	ImageProduct  string                 `protobuf:"bytes,10,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ProductId     int32                   `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MerchantId    int32                   `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int32                   `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                  `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int32                   `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	CountInStock  int32                   `protobuf:"varint,7,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
+	Brand         string                  `protobuf:"bytes,8,opt,name=brand,proto3" json:"brand,omitempty"`
+	Weight        int32                   `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	ImageProduct  string                  `protobuf:"bytes,10,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
+	Barcode       *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=barcode,proto3" json:"barcode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +638,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[5]
+	mi := &file_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +651,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{5}
+	return file_product_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateProductRequest) GetProductId() int32 {
@@ -527,42 +724,35 @@ func (x *UpdateProductRequest) GetImageProduct() string {
 	return ""
 }
 
-type ProductResponse struct {
+func (x *UpdateProductRequest) GetBarcode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Barcode
+	}
+	return nil
+}
+
+type FindByBarcodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MerchantId    int32                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int32                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	CountInStock  int32                  `protobuf:"varint,7,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
-	Brand         string                 `protobuf:"bytes,8,opt,name=brand,proto3" json:"brand,omitempty"`
-	Weight        int32                  `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
-	Rating        float32                `protobuf:"fixed32,10,opt,name=rating,proto3" json:"rating,omitempty"`
-	SlugProduct   string                 `protobuf:"bytes,11,opt,name=slug_product,json=slugProduct,proto3" json:"slug_product,omitempty"`
-	ImageProduct  string                 `protobuf:"bytes,12,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
-	Barcode       string                 `protobuf:"bytes,13,opt,name=barcode,proto3" json:"barcode,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Barcode       string                 `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductResponse) Reset() {
-	*x = ProductResponse{}
-	mi := &file_product_proto_msgTypes[6]
+func (x *FindByBarcodeRequest) Reset() {
+	*x = FindByBarcodeRequest{}
+	mi := &file_product_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductResponse) String() string {
+func (x *FindByBarcodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductResponse) ProtoMessage() {}
+func (*FindByBarcodeRequest) ProtoMessage() {}
 
-func (x *ProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[6]
+func (x *FindByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,153 +763,268 @@ func (x *ProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
-func (*ProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use FindByBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*FindByBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ProductResponse) GetId() int32 {
+func (x *FindByBarcodeRequest) GetBarcode() string {
 	if x != nil {
-		return x.Id
+		return x.Barcode
 	}
-	return 0
+	return ""
 }
 
-func (x *ProductResponse) GetMerchantId() int32 {
-	if x != nil {
-		return x.MerchantId
-	}
-	return 0
+type ImportProductsMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int32                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductResponse) GetCategoryId() int32 {
-	if x != nil {
-		return x.CategoryId
-	}
-	return 0
+func (x *ImportProductsMetadata) Reset() {
+	*x = ImportProductsMetadata{}
+	mi := &file_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ImportProductsMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProductResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
+func (*ImportProductsMetadata) ProtoMessage() {}
 
-func (x *ProductResponse) GetPrice() int32 {
+func (x *ImportProductsMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[10]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProductResponse) GetCountInStock() int32 {
+// Deprecated: Use ImportProductsMetadata.ProtoReflect.Descriptor instead.
+func (*ImportProductsMetadata) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportProductsMetadata) GetMerchantId() int32 {
 	if x != nil {
-		return x.CountInStock
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *ProductResponse) GetBrand() string {
+func (x *ImportProductsMetadata) GetFormat() string {
 	if x != nil {
-		return x.Brand
+		return x.Format
 	}
 	return ""
 }
 
-func (x *ProductResponse) GetWeight() int32 {
+func (x *ImportProductsMetadata) GetDryRun() bool {
 	if x != nil {
-		return x.Weight
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *ProductResponse) GetRating() float32 {
+// ImportProductsChunk is one message of an import upload. The first message
+// carries the metadata, every following one a piece of the file.
+type ImportProductsChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportProductsChunk_Metadata
+	//	*ImportProductsChunk_Data
+	Payload       isImportProductsChunk_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsChunk) Reset() {
+	*x = ImportProductsChunk{}
+	mi := &file_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsChunk) ProtoMessage() {}
+
+func (x *ImportProductsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[11]
 	if x != nil {
-		return x.Rating
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsChunk.ProtoReflect.Descriptor instead.
+func (*ImportProductsChunk) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportProductsChunk) GetPayload() isImportProductsChunk_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportProductsChunk) GetMetadata() *ImportProductsMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsChunk_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *ImportProductsChunk) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportProductsChunk_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isImportProductsChunk_Payload interface {
+	isImportProductsChunk_Payload()
+}
+
+type ImportProductsChunk_Metadata struct {
+	Metadata *ImportProductsMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type ImportProductsChunk_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ImportProductsChunk_Metadata) isImportProductsChunk_Payload() {}
+
+func (*ImportProductsChunk_Data) isImportProductsChunk_Payload() {}
+
+type ExportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int32                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	MinPrice      int32                  `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      int32                  `protobuf:"varint,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportProductsRequest) GetMerchantId() int32 {
+	if x != nil {
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *ProductResponse) GetSlugProduct() string {
+func (x *ExportProductsRequest) GetFormat() string {
 	if x != nil {
-		return x.SlugProduct
+		return x.Format
 	}
 	return ""
 }
 
-func (x *ProductResponse) GetImageProduct() string {
+func (x *ExportProductsRequest) GetSearch() string {
 	if x != nil {
-		return x.ImageProduct
+		return x.Search
 	}
 	return ""
 }
 
-func (x *ProductResponse) GetBarcode() string {
+func (x *ExportProductsRequest) GetCategoryId() int32 {
 	if x != nil {
-		return x.Barcode
+		return x.CategoryId
 	}
-	return ""
+	return 0
 }
 
-func (x *ProductResponse) GetCreatedAt() string {
+func (x *ExportProductsRequest) GetMinPrice() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.MinPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *ProductResponse) GetUpdatedAt() string {
+func (x *ExportProductsRequest) GetMaxPrice() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.MaxPrice
 	}
-	return ""
+	return 0
 }
 
-type ProductResponseDeleteAt struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MerchantId    int32                   `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	CategoryId    int32                   `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name          string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                  `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int32                   `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	CountInStock  int32                   `protobuf:"varint,7,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
-	Brand         string                  `protobuf:"bytes,8,opt,name=brand,proto3" json:"brand,omitempty"`
-	Weight        int32                   `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
-	Rating        float32                 `protobuf:"fixed32,10,opt,name=rating,proto3" json:"rating,omitempty"`
-	SlugProduct   string                  `protobuf:"bytes,11,opt,name=slug_product,json=slugProduct,proto3" json:"slug_product,omitempty"`
-	ImageProduct  string                  `protobuf:"bytes,12,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
-	Barcode       string                  `protobuf:"bytes,13,opt,name=barcode,proto3" json:"barcode,omitempty"`
-	CreatedAt     string                  `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                  `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+type ExportProductsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductResponseDeleteAt) Reset() {
-	*x = ProductResponseDeleteAt{}
-	mi := &file_product_proto_msgTypes[7]
+func (x *ExportProductsChunk) Reset() {
+	*x = ExportProductsChunk{}
+	mi := &file_product_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductResponseDeleteAt) String() string {
+func (x *ExportProductsChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductResponseDeleteAt) ProtoMessage() {}
+func (*ExportProductsChunk) ProtoMessage() {}
 
-func (x *ProductResponseDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[7]
+func (x *ExportProductsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,147 +1035,1177 @@ func (x *ProductResponseDeleteAt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductResponseDeleteAt.ProtoReflect.Descriptor instead.
-func (*ProductResponseDeleteAt) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExportProductsChunk.ProtoReflect.Descriptor instead.
+func (*ExportProductsChunk) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ProductResponseDeleteAt) GetId() int32 {
+func (x *ExportProductsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int32                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int32                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	CountInStock  int32                  `protobuf:"varint,7,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
+	Brand         string                 `protobuf:"bytes,8,opt,name=brand,proto3" json:"brand,omitempty"`
+	Weight        int32                  `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	Rating        float32                `protobuf:"fixed32,10,opt,name=rating,proto3" json:"rating,omitempty"`
+	SlugProduct   string                 `protobuf:"bytes,11,opt,name=slug_product,json=slugProduct,proto3" json:"slug_product,omitempty"`
+	ImageProduct  string                 `protobuf:"bytes,12,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
+	Barcode       string                 `protobuf:"bytes,13,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	VariantCount  *wrapperspb.Int32Value `protobuf:"bytes,16,opt,name=variant_count,json=variantCount,proto3" json:"variant_count,omitempty"`
+	MinPrice      *wrapperspb.Int32Value `protobuf:"bytes,17,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      *wrapperspb.Int32Value `protobuf:"bytes,18,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductResponse) Reset() {
+	*x = ProductResponse{}
+	mi := &file_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductResponse) ProtoMessage() {}
+
+func (x *ProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
+func (*ProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProductResponse) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ProductResponseDeleteAt) GetMerchantId() int32 {
+func (x *ProductResponse) GetMerchantId() int32 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *ProductResponseDeleteAt) GetCategoryId() int32 {
+func (x *ProductResponse) GetCategoryId() int32 {
 	if x != nil {
 		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *ProductResponseDeleteAt) GetName() string {
+func (x *ProductResponse) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetDescription() string {
+func (x *ProductResponse) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetPrice() int32 {
+func (x *ProductResponse) GetPrice() int32 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *ProductResponseDeleteAt) GetCountInStock() int32 {
+func (x *ProductResponse) GetCountInStock() int32 {
 	if x != nil {
 		return x.CountInStock
 	}
 	return 0
 }
 
-func (x *ProductResponseDeleteAt) GetBrand() string {
+func (x *ProductResponse) GetBrand() string {
 	if x != nil {
 		return x.Brand
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetWeight() int32 {
+func (x *ProductResponse) GetWeight() int32 {
 	if x != nil {
 		return x.Weight
 	}
 	return 0
 }
 
-func (x *ProductResponseDeleteAt) GetRating() float32 {
+func (x *ProductResponse) GetRating() float32 {
 	if x != nil {
 		return x.Rating
 	}
 	return 0
 }
 
-func (x *ProductResponseDeleteAt) GetSlugProduct() string {
+func (x *ProductResponse) GetSlugProduct() string {
 	if x != nil {
 		return x.SlugProduct
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetImageProduct() string {
+func (x *ProductResponse) GetImageProduct() string {
 	if x != nil {
 		return x.ImageProduct
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetBarcode() string {
+func (x *ProductResponse) GetBarcode() string {
 	if x != nil {
 		return x.Barcode
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetCreatedAt() string {
+func (x *ProductResponse) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetUpdatedAt() string {
+func (x *ProductResponse) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *ProductResponseDeleteAt) GetDeletedAt() *wrapperspb.StringValue {
+func (x *ProductResponse) GetVariantCount() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.DeletedAt
+		return x.VariantCount
 	}
 	return nil
 }
 
-type ApiResponseProduct struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *ProductResponse       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+func (x *ProductResponse) GetMinPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *ProductResponse) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+type ProductResponseDeleteAt struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int32                   `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int32                   `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                  `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Price         int32                   `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	CountInStock  int32                   `protobuf:"varint,7,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
+	Brand         string                  `protobuf:"bytes,8,opt,name=brand,proto3" json:"brand,omitempty"`
+	Weight        int32                   `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	Rating        float32                 `protobuf:"fixed32,10,opt,name=rating,proto3" json:"rating,omitempty"`
+	SlugProduct   string                  `protobuf:"bytes,11,opt,name=slug_product,json=slugProduct,proto3" json:"slug_product,omitempty"`
+	ImageProduct  string                  `protobuf:"bytes,12,opt,name=image_product,json=imageProduct,proto3" json:"image_product,omitempty"`
+	Barcode       string                  `protobuf:"bytes,13,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                  `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	VariantCount  *wrapperspb.Int32Value  `protobuf:"bytes,17,opt,name=variant_count,json=variantCount,proto3" json:"variant_count,omitempty"`
+	MinPrice      *wrapperspb.Int32Value  `protobuf:"bytes,18,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      *wrapperspb.Int32Value  `protobuf:"bytes,19,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductResponseDeleteAt) Reset() {
+	*x = ProductResponseDeleteAt{}
+	mi := &file_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductResponseDeleteAt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductResponseDeleteAt) ProtoMessage() {}
+
+func (x *ProductResponseDeleteAt) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductResponseDeleteAt.ProtoReflect.Descriptor instead.
+func (*ProductResponseDeleteAt) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProductResponseDeleteAt) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductResponseDeleteAt) GetMerchantId() int32 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ProductResponseDeleteAt) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ProductResponseDeleteAt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductResponseDeleteAt) GetCountInStock() int32 {
+	if x != nil {
+		return x.CountInStock
+	}
+	return 0
+}
+
+func (x *ProductResponseDeleteAt) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProductResponseDeleteAt) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ProductResponseDeleteAt) GetSlugProduct() string {
+	if x != nil {
+		return x.SlugProduct
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetImageProduct() string {
+	if x != nil {
+		return x.ImageProduct
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ProductResponseDeleteAt) GetDeletedAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ProductResponseDeleteAt) GetVariantCount() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.VariantCount
+	}
+	return nil
+}
+
+func (x *ProductResponseDeleteAt) GetMinPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *ProductResponseDeleteAt) GetMaxPrice() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+// ProductScanResponse is what a till scanner resolves to. When the barcode
+// belongs to a variant, price and count_in_stock are the variant's.
+type ProductScanResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Product        *ProductResponse        `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	VariantId      *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantSku     *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	VariantOptions map[string]string       `protobuf:"bytes,4,rep,name=variant_options,json=variantOptions,proto3" json:"variant_options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price          int32                   `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	CountInStock   int32                   `protobuf:"varint,6,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProductScanResponse) Reset() {
+	*x = ProductScanResponse{}
+	mi := &file_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductScanResponse) ProtoMessage() {}
+
+func (x *ProductScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductScanResponse.ProtoReflect.Descriptor instead.
+func (*ProductScanResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProductScanResponse) GetProduct() *ProductResponse {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductScanResponse) GetVariantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.VariantId
+	}
+	return nil
+}
+
+func (x *ProductScanResponse) GetVariantSku() *wrapperspb.StringValue {
+	if x != nil {
+		return x.VariantSku
+	}
+	return nil
+}
+
+func (x *ProductScanResponse) GetVariantOptions() map[string]string {
+	if x != nil {
+		return x.VariantOptions
+	}
+	return nil
+}
+
+func (x *ProductScanResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductScanResponse) GetCountInStock() int32 {
+	if x != nil {
+		return x.CountInStock
+	}
+	return 0
+}
+
+type StockMovementResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int32                   `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason        string                  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	QuantityDelta int32                   `protobuf:"varint,4,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"`
+	BalanceAfter  int32                   `protobuf:"varint,5,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	ReferenceType *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
+	ReferenceId   *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	UserId        *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VariantId     *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovementResponse) Reset() {
+	*x = StockMovementResponse{}
+	mi := &file_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovementResponse) ProtoMessage() {}
+
+func (x *StockMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovementResponse.ProtoReflect.Descriptor instead.
+func (*StockMovementResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StockMovementResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockMovementResponse) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockMovementResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovementResponse) GetQuantityDelta() int32 {
+	if x != nil {
+		return x.QuantityDelta
+	}
+	return 0
+}
+
+func (x *StockMovementResponse) GetBalanceAfter() int32 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *StockMovementResponse) GetReferenceType() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return nil
+}
+
+func (x *StockMovementResponse) GetReferenceId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return nil
+}
+
+func (x *StockMovementResponse) GetUserId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *StockMovementResponse) GetNote() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *StockMovementResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockMovementResponse) GetVariantId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.VariantId
+	}
+	return nil
+}
+
+type ApiResponseProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ProductResponse       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseProduct) Reset() {
+	*x = ApiResponseProduct{}
+	mi := &file_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseProduct) ProtoMessage() {}
+
+func (x *ApiResponseProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseProduct.ProtoReflect.Descriptor instead.
+func (*ApiResponseProduct) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ApiResponseProduct) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseProduct) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponseProduct) GetData() *ProductResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ApiResponseProductScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ProductScanResponse   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseProductScan) Reset() {
+	*x = ApiResponseProductScan{}
+	mi := &file_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseProductScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseProductScan) ProtoMessage() {}
+
+func (x *ApiResponseProductScan) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseProductScan.ProtoReflect.Descriptor instead.
+func (*ApiResponseProductScan) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApiResponseProductScan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseProductScan) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponseProductScan) GetData() *ProductScanResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProductImportRowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ProductId     *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImportRowResponse) Reset() {
+	*x = ProductImportRowResponse{}
+	mi := &file_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImportRowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImportRowResponse) ProtoMessage() {}
+
+func (x *ProductImportRowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImportRowResponse.ProtoReflect.Descriptor instead.
+func (*ProductImportRowResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProductImportRowResponse) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ProductImportRowResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ProductImportRowResponse) GetProductId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ProductId
+	}
+	return nil
+}
+
+func (x *ProductImportRowResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ProductImportResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	DryRun        bool                        `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Applied       bool                        `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	Created       int32                       `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                       `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                       `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Rows          []*ProductImportRowResponse `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImportResponse) Reset() {
+	*x = ProductImportResponse{}
+	mi := &file_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImportResponse) ProtoMessage() {}
+
+func (x *ProductImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImportResponse.ProtoReflect.Descriptor instead.
+func (*ProductImportResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProductImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ProductImportResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ProductImportResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ProductImportResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ProductImportResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ProductImportResponse) GetRows() []*ProductImportRowResponse {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ApiResponseProductImport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ProductImportResponse `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseProductImport) Reset() {
+	*x = ApiResponseProductImport{}
+	mi := &file_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseProductImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseProductImport) ProtoMessage() {}
+
+func (x *ApiResponseProductImport) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseProductImport.ProtoReflect.Descriptor instead.
+func (*ApiResponseProductImport) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ApiResponseProductImport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseProductImport) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponseProductImport) GetData() *ProductImportResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ApiResponseProductDeleteAt struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        string                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ProductResponseDeleteAt `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseProductDeleteAt) Reset() {
+	*x = ApiResponseProductDeleteAt{}
+	mi := &file_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseProductDeleteAt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseProductDeleteAt) ProtoMessage() {}
+
+func (x *ApiResponseProductDeleteAt) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseProductDeleteAt.ProtoReflect.Descriptor instead.
+func (*ApiResponseProductDeleteAt) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApiResponseProductDeleteAt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseProductDeleteAt) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponseProductDeleteAt) GetData() *ProductResponseDeleteAt {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ApiResponsesProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*ProductResponse     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponsesProduct) Reset() {
+	*x = ApiResponsesProduct{}
+	mi := &file_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponsesProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponsesProduct) ProtoMessage() {}
+
+func (x *ApiResponsesProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponsesProduct.ProtoReflect.Descriptor instead.
+func (*ApiResponsesProduct) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ApiResponsesProduct) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponsesProduct) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApiResponsesProduct) GetData() []*ProductResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ApiResponseProductDelete struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseProductDelete) Reset() {
+	*x = ApiResponseProductDelete{}
+	mi := &file_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseProductDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseProductDelete) ProtoMessage() {}
+
+func (x *ApiResponseProductDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseProductDelete.ProtoReflect.Descriptor instead.
+func (*ApiResponseProductDelete) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApiResponseProductDelete) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseProductDelete) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ApiResponseProductAll struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseProductAll) Reset() {
+	*x = ApiResponseProductAll{}
+	mi := &file_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseProductAll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseProductAll) ProtoMessage() {}
+
+func (x *ApiResponseProductAll) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseProductAll.ProtoReflect.Descriptor instead.
+func (*ApiResponseProductAll) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApiResponseProductAll) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApiResponseProductAll) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ApiResponsePaginationProductDeleteAt struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Status        string                     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*ProductResponseDeleteAt `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta            `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApiResponseProduct) Reset() {
-	*x = ApiResponseProduct{}
-	mi := &file_product_proto_msgTypes[8]
+func (x *ApiResponsePaginationProductDeleteAt) Reset() {
+	*x = ApiResponsePaginationProductDeleteAt{}
+	mi := &file_product_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApiResponseProduct) String() string {
+func (x *ApiResponsePaginationProductDeleteAt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiResponseProduct) ProtoMessage() {}
+func (*ApiResponsePaginationProductDeleteAt) ProtoMessage() {}
 
-func (x *ApiResponseProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[8]
+func (x *ApiResponsePaginationProductDeleteAt) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,56 +2216,64 @@ func (x *ApiResponseProduct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiResponseProduct.ProtoReflect.Descriptor instead.
-func (*ApiResponseProduct) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ApiResponsePaginationProductDeleteAt.ProtoReflect.Descriptor instead.
+func (*ApiResponsePaginationProductDeleteAt) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ApiResponseProduct) GetStatus() string {
+func (x *ApiResponsePaginationProductDeleteAt) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *ApiResponseProduct) GetMessage() string {
+func (x *ApiResponsePaginationProductDeleteAt) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ApiResponseProduct) GetData() *ProductResponse {
+func (x *ApiResponsePaginationProductDeleteAt) GetData() []*ProductResponseDeleteAt {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ApiResponseProductDeleteAt struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Status        string                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *ProductResponseDeleteAt `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+func (x *ApiResponsePaginationProductDeleteAt) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ApiResponsePaginationProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*ProductResponse     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApiResponseProductDeleteAt) Reset() {
-	*x = ApiResponseProductDeleteAt{}
-	mi := &file_product_proto_msgTypes[9]
+func (x *ApiResponsePaginationProduct) Reset() {
+	*x = ApiResponsePaginationProduct{}
+	mi := &file_product_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApiResponseProductDeleteAt) String() string {
+func (x *ApiResponsePaginationProduct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiResponseProductDeleteAt) ProtoMessage() {}
+func (*ApiResponsePaginationProduct) ProtoMessage() {}
 
-func (x *ApiResponseProductDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[9]
+func (x *ApiResponsePaginationProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,56 +2284,64 @@ func (x *ApiResponseProductDeleteAt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiResponseProductDeleteAt.ProtoReflect.Descriptor instead.
-func (*ApiResponseProductDeleteAt) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ApiResponsePaginationProduct.ProtoReflect.Descriptor instead.
+func (*ApiResponsePaginationProduct) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ApiResponseProductDeleteAt) GetStatus() string {
+func (x *ApiResponsePaginationProduct) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *ApiResponseProductDeleteAt) GetMessage() string {
+func (x *ApiResponsePaginationProduct) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ApiResponseProductDeleteAt) GetData() *ProductResponseDeleteAt {
+func (x *ApiResponsePaginationProduct) GetData() []*ProductResponse {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ApiResponsesProduct struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          []*ProductResponse     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+func (x *ApiResponsePaginationProduct) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ApiResponsePaginationStockMovement struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        string                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*StockMovementResponse `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta          `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApiResponsesProduct) Reset() {
-	*x = ApiResponsesProduct{}
-	mi := &file_product_proto_msgTypes[10]
+func (x *ApiResponsePaginationStockMovement) Reset() {
+	*x = ApiResponsePaginationStockMovement{}
+	mi := &file_product_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApiResponsesProduct) String() string {
+func (x *ApiResponsePaginationStockMovement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiResponsesProduct) ProtoMessage() {}
+func (*ApiResponsePaginationStockMovement) ProtoMessage() {}
 
-func (x *ApiResponsesProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[10]
+func (x *ApiResponsePaginationStockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,55 +2352,68 @@ func (x *ApiResponsesProduct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiResponsesProduct.ProtoReflect.Descriptor instead.
-func (*ApiResponsesProduct) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ApiResponsePaginationStockMovement.ProtoReflect.Descriptor instead.
+func (*ApiResponsePaginationStockMovement) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ApiResponsesProduct) GetStatus() string {
+func (x *ApiResponsePaginationStockMovement) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *ApiResponsesProduct) GetMessage() string {
+func (x *ApiResponsePaginationStockMovement) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ApiResponsesProduct) GetData() []*ProductResponse {
+func (x *ApiResponsePaginationStockMovement) GetData() []*StockMovementResponse {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ApiResponseProductDelete struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ApiResponsePaginationStockMovement) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-func (x *ApiResponseProductDelete) Reset() {
-	*x = ApiResponseProductDelete{}
-	mi := &file_product_proto_msgTypes[11]
+type ReorderLevelResponse struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId        int32                   `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId        int32                   `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name              string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CountInStock      int32                   `protobuf:"varint,5,opt,name=count_in_stock,json=countInStock,proto3" json:"count_in_stock,omitempty"`
+	ReorderPoint      int32                   `protobuf:"varint,6,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity   int32                   `protobuf:"varint,7,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	LowStockAlertedAt *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=low_stock_alerted_at,json=lowStockAlertedAt,proto3" json:"low_stock_alerted_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReorderLevelResponse) Reset() {
+	*x = ReorderLevelResponse{}
+	mi := &file_product_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApiResponseProductDelete) String() string {
+func (x *ReorderLevelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiResponseProductDelete) ProtoMessage() {}
+func (*ReorderLevelResponse) ProtoMessage() {}
 
-func (x *ApiResponseProductDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[11]
+func (x *ReorderLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,102 +2424,91 @@ func (x *ApiResponseProductDelete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiResponseProductDelete.ProtoReflect.Descriptor instead.
-func (*ApiResponseProductDelete) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReorderLevelResponse.ProtoReflect.Descriptor instead.
+func (*ReorderLevelResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ApiResponseProductDelete) GetStatus() string {
+func (x *ReorderLevelResponse) GetId() int32 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ApiResponseProductDelete) GetMessage() string {
+func (x *ReorderLevelResponse) GetMerchantId() int32 {
 	if x != nil {
-		return x.Message
+		return x.MerchantId
 	}
-	return ""
-}
-
-type ApiResponseProductAll struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *ApiResponseProductAll) Reset() {
-	*x = ApiResponseProductAll{}
-	mi := &file_product_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReorderLevelResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
 }
 
-func (x *ApiResponseProductAll) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReorderLevelResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*ApiResponseProductAll) ProtoMessage() {}
-
-func (x *ApiResponseProductAll) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[12]
+func (x *ReorderLevelResponse) GetCountInStock() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CountInStock
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ApiResponseProductAll.ProtoReflect.Descriptor instead.
-func (*ApiResponseProductAll) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{12}
+func (x *ReorderLevelResponse) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
 }
 
-func (x *ApiResponseProductAll) GetStatus() string {
+func (x *ReorderLevelResponse) GetReorderQuantity() int32 {
 	if x != nil {
-		return x.Status
+		return x.ReorderQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *ApiResponseProductAll) GetMessage() string {
+func (x *ReorderLevelResponse) GetLowStockAlertedAt() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Message
+		return x.LowStockAlertedAt
 	}
-	return ""
+	return nil
 }
 
-type ApiResponsePaginationProductDeleteAt struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Status        string                     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          []*ProductResponseDeleteAt `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	Pagination    *PaginationMeta            `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type ApiResponseReorderLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ReorderLevelResponse  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApiResponsePaginationProductDeleteAt) Reset() {
-	*x = ApiResponsePaginationProductDeleteAt{}
-	mi := &file_product_proto_msgTypes[13]
+func (x *ApiResponseReorderLevel) Reset() {
+	*x = ApiResponseReorderLevel{}
+	mi := &file_product_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApiResponsePaginationProductDeleteAt) String() string {
+func (x *ApiResponseReorderLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiResponsePaginationProductDeleteAt) ProtoMessage() {}
+func (*ApiResponseReorderLevel) ProtoMessage() {}
 
-func (x *ApiResponsePaginationProductDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[13]
+func (x *ApiResponseReorderLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,64 +2519,57 @@ func (x *ApiResponsePaginationProductDeleteAt) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiResponsePaginationProductDeleteAt.ProtoReflect.Descriptor instead.
-func (*ApiResponsePaginationProductDeleteAt) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ApiResponseReorderLevel.ProtoReflect.Descriptor instead.
+func (*ApiResponseReorderLevel) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ApiResponsePaginationProductDeleteAt) GetStatus() string {
+func (x *ApiResponseReorderLevel) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *ApiResponsePaginationProductDeleteAt) GetMessage() string {
+func (x *ApiResponseReorderLevel) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ApiResponsePaginationProductDeleteAt) GetData() []*ProductResponseDeleteAt {
+func (x *ApiResponseReorderLevel) GetData() *ReorderLevelResponse {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ApiResponsePaginationProductDeleteAt) GetPagination() *PaginationMeta {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-type ApiResponsePaginationProduct struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          []*ProductResponse     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
-	Pagination    *PaginationMeta        `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type ApiResponsePaginationReorderLevel struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Status        string                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*ReorderLevelResponse `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination    *PaginationMeta         `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApiResponsePaginationProduct) Reset() {
-	*x = ApiResponsePaginationProduct{}
-	mi := &file_product_proto_msgTypes[14]
+func (x *ApiResponsePaginationReorderLevel) Reset() {
+	*x = ApiResponsePaginationReorderLevel{}
+	mi := &file_product_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApiResponsePaginationProduct) String() string {
+func (x *ApiResponsePaginationReorderLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiResponsePaginationProduct) ProtoMessage() {}
+func (*ApiResponsePaginationReorderLevel) ProtoMessage() {}
 
-func (x *ApiResponsePaginationProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[14]
+func (x *ApiResponsePaginationReorderLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,33 +2580,33 @@ func (x *ApiResponsePaginationProduct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiResponsePaginationProduct.ProtoReflect.Descriptor instead.
-func (*ApiResponsePaginationProduct) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ApiResponsePaginationReorderLevel.ProtoReflect.Descriptor instead.
+func (*ApiResponsePaginationReorderLevel) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ApiResponsePaginationProduct) GetStatus() string {
+func (x *ApiResponsePaginationReorderLevel) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *ApiResponsePaginationProduct) GetMessage() string {
+func (x *ApiResponsePaginationReorderLevel) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ApiResponsePaginationProduct) GetData() []*ProductResponse {
+func (x *ApiResponsePaginationReorderLevel) GetData() []*ReorderLevelResponse {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *ApiResponsePaginationProduct) GetPagination() *PaginationMeta {
+func (x *ApiResponsePaginationReorderLevel) GetPagination() *PaginationMeta {
 	if x != nil {
 		return x.Pagination
 	}
@@ -1285,16 +2631,32 @@ const file_product_proto_rawDesc = "" +
 	"\tmin_price\x18\x04 \x01(\x05R\bminPrice\x12\x1b\n" +
 	"\tmax_price\x18\x05 \x01(\x05R\bmaxPrice\x12\x12\n" +
 	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\xc5\x01\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\xfa\x01\n" +
 	"\x1dFindAllProductCategoryRequest\x12#\n" +
 	"\rcategory_name\x18\x01 \x01(\tR\fcategoryName\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
 	"\x06search\x18\x04 \x01(\tR\x06search\x12\x1a\n" +
 	"\bminprice\x18\x05 \x01(\x05R\bminprice\x12\x1a\n" +
-	"\bmaxprice\x18\x06 \x01(\x05R\bmaxprice\"(\n" +
+	"\bmaxprice\x18\x06 \x01(\x05R\bmaxprice\x123\n" +
+	"\x15include_subcategories\x18\a \x01(\bR\x14includeSubcategories\"(\n" +
 	"\x16FindByIdProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x9d\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"k\n" +
+	"\x19FindStockMovementsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"n\n" +
+	"\x1aFindLowStockProductRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x05R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8a\x01\n" +
+	"\x19UpdateReorderLevelRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12#\n" +
+	"\rreorder_point\x18\x02 \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\x03 \x01(\x05R\x0freorderQuantity\"\xd5\x02\n" +
 	"\x14CreateProductRequest\x12\x1f\n" +
 	"\vmerchant_id\x18\x01 \x01(\x05R\n" +
 	"merchantId\x12\x1f\n" +
@@ -1306,7 +2668,9 @@ const file_product_proto_rawDesc = "" +
 	"\x0ecount_in_stock\x18\x06 \x01(\x05R\fcountInStock\x12\x14\n" +
 	"\x05brand\x18\a \x01(\tR\x05brand\x12\x16\n" +
 	"\x06weight\x18\b \x01(\x05R\x06weight\x12#\n" +
-	"\rimage_product\x18\t \x01(\tR\fimageProduct\"\xbc\x02\n" +
+	"\rimage_product\x18\t \x01(\tR\fimageProduct\x126\n" +
+	"\abarcode\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\abarcode\"\xf4\x02\n" +
 	"\x14UpdateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1f\n" +
@@ -1321,7 +2685,30 @@ const file_product_proto_rawDesc = "" +
 	"\x05brand\x18\b \x01(\tR\x05brand\x12\x16\n" +
 	"\x06weight\x18\t \x01(\x05R\x06weight\x12#\n" +
 	"\rimage_product\x18\n" +
-	" \x01(\tR\fimageProduct\"\xbb\x03\n" +
+	" \x01(\tR\fimageProduct\x126\n" +
+	"\abarcode\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\abarcode\"0\n" +
+	"\x14FindByBarcodeRequest\x12\x18\n" +
+	"\abarcode\x18\x01 \x01(\tR\abarcode\"j\n" +
+	"\x16ImportProductsMetadata\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x05R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"p\n" +
+	"\x13ImportProductsChunk\x128\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1a.pb.ImportProductsMetadataH\x00R\bmetadata\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
+	"\apayload\"\xc3\x01\n" +
+	"\x15ExportProductsRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x05R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x05R\n" +
+	"categoryId\x12\x1b\n" +
+	"\tmin_price\x18\x05 \x01(\x05R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x06 \x01(\x05R\bmaxPrice\")\n" +
+	"\x13ExportProductsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xf1\x04\n" +
 	"\x0fProductResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x05R\n" +
@@ -1342,7 +2729,10 @@ const file_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAt\"\x80\x04\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12@\n" +
+	"\rvariant_count\x18\x10 \x01(\v2\x1b.google.protobuf.Int32ValueR\fvariantCount\x128\n" +
+	"\tmin_price\x18\x11 \x01(\v2\x1b.google.protobuf.Int32ValueR\bminPrice\x128\n" +
+	"\tmax_price\x18\x12 \x01(\v2\x1b.google.protobuf.Int32ValueR\bmaxPrice\"\xb6\x05\n" +
 	"\x17ProductResponseDeleteAt\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x05R\n" +
@@ -1365,11 +2755,63 @@ const file_product_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12;\n" +
 	"\n" +
-	"deleted_at\x18\x10 \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\"o\n" +
+	"deleted_at\x18\x10 \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\x12@\n" +
+	"\rvariant_count\x18\x11 \x01(\v2\x1b.google.protobuf.Int32ValueR\fvariantCount\x128\n" +
+	"\tmin_price\x18\x12 \x01(\v2\x1b.google.protobuf.Int32ValueR\bminPrice\x128\n" +
+	"\tmax_price\x18\x13 \x01(\v2\x1b.google.protobuf.Int32ValueR\bmaxPrice\"\x94\x03\n" +
+	"\x13ProductScanResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.pb.ProductResponseR\aproduct\x12:\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\tvariantId\x12=\n" +
+	"\vvariant_sku\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"variantSku\x12T\n" +
+	"\x0fvariant_options\x18\x04 \x03(\v2+.pb.ProductScanResponse.VariantOptionsEntryR\x0evariantOptions\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x05R\x05price\x12$\n" +
+	"\x0ecount_in_stock\x18\x06 \x01(\x05R\fcountInStock\x1aA\n" +
+	"\x13VariantOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf2\x03\n" +
+	"\x15StockMovementResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12%\n" +
+	"\x0equantity_delta\x18\x04 \x01(\x05R\rquantityDelta\x12#\n" +
+	"\rbalance_after\x18\x05 \x01(\x05R\fbalanceAfter\x12C\n" +
+	"\x0ereference_type\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\rreferenceType\x12>\n" +
+	"\freference_id\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\vreferenceId\x124\n" +
+	"\auser_id\x18\b \x01(\v2\x1b.google.protobuf.Int32ValueR\x06userId\x120\n" +
+	"\x04note\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12:\n" +
+	"\n" +
+	"variant_id\x18\v \x01(\v2\x1b.google.protobuf.Int32ValueR\tvariantId\"o\n" +
 	"\x12ApiResponseProduct\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
-	"\x04data\x18\x03 \x01(\v2\x13.pb.ProductResponseR\x04data\"\x7f\n" +
+	"\x04data\x18\x03 \x01(\v2\x13.pb.ProductResponseR\x04data\"w\n" +
+	"\x16ApiResponseProductScan\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.pb.ProductScanResponseR\x04data\"\x98\x01\n" +
+	"\x18ProductImportRowResponse\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12:\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\tproductId\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xc8\x01\n" +
+	"\x15ProductImportResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x120\n" +
+	"\x04rows\x18\x06 \x03(\v2\x1c.pb.ProductImportRowResponseR\x04rows\"{\n" +
+	"\x18ApiResponseProductImport\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.pb.ProductImportResponseR\x04data\"\x7f\n" +
 	"\x1aApiResponseProductDeleteAt\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
@@ -1397,16 +2839,51 @@ const file_product_proto_rawDesc = "" +
 	"\x04data\x18\x03 \x03(\v2\x13.pb.ProductResponseR\x04data\x122\n" +
 	"\n" +
 	"pagination\x18\x04 \x01(\v2\x12.pb.PaginationMetaR\n" +
-	"pagination2\xf9\a\n" +
+	"pagination\"\xb9\x01\n" +
+	"\"ApiResponsePaginationStockMovement\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x03(\v2\x19.pb.StockMovementResponseR\x04data\x122\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x12.pb.PaginationMetaR\n" +
+	"pagination\"\xc1\x02\n" +
+	"\x14ReorderLevelResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x05R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12$\n" +
+	"\x0ecount_in_stock\x18\x05 \x01(\x05R\fcountInStock\x12#\n" +
+	"\rreorder_point\x18\x06 \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\a \x01(\x05R\x0freorderQuantity\x12M\n" +
+	"\x14low_stock_alerted_at\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\x11lowStockAlertedAt\"y\n" +
+	"\x17ApiResponseReorderLevel\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.pb.ReorderLevelResponseR\x04data\"\xb7\x01\n" +
+	"!ApiResponsePaginationReorderLevel\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x03(\v2\x18.pb.ReorderLevelResponseR\x04data\x122\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x12.pb.PaginationMetaR\n" +
+	"pagination2\xd9\v\n" +
 	"\x0eProductService\x12F\n" +
 	"\aFindAll\x12\x19.pb.FindAllProductRequest\x1a .pb.ApiResponsePaginationProduct\x12U\n" +
 	"\x0eFindByMerchant\x12!.pb.FindAllProductMerchantRequest\x1a .pb.ApiResponsePaginationProduct\x12U\n" +
 	"\x0eFindByCategory\x12!.pb.FindAllProductCategoryRequest\x1a .pb.ApiResponsePaginationProduct\x12>\n" +
-	"\bFindById\x12\x1a.pb.FindByIdProductRequest\x1a\x16.pb.ApiResponseProduct\x12U\n" +
+	"\bFindById\x12\x1a.pb.FindByIdProductRequest\x1a\x16.pb.ApiResponseProduct\x12E\n" +
+	"\rFindByBarcode\x12\x18.pb.FindByBarcodeRequest\x1a\x1a.pb.ApiResponseProductScan\x12[\n" +
+	"\x12FindStockMovements\x12\x1d.pb.FindStockMovementsRequest\x1a&.pb.ApiResponsePaginationStockMovement\x12U\n" +
+	"\fFindLowStock\x12\x1e.pb.FindLowStockProductRequest\x1a%.pb.ApiResponsePaginationReorderLevel\x12U\n" +
 	"\fFindByActive\x12\x19.pb.FindAllProductRequest\x1a(.pb.ApiResponsePaginationProductDeleteAt\"\x00\x12V\n" +
 	"\rFindByTrashed\x12\x19.pb.FindAllProductRequest\x1a(.pb.ApiResponsePaginationProductDeleteAt\"\x00\x12:\n" +
 	"\x06Create\x12\x18.pb.CreateProductRequest\x1a\x16.pb.ApiResponseProduct\x12:\n" +
-	"\x06Update\x12\x18.pb.UpdateProductRequest\x1a\x16.pb.ApiResponseProduct\x12L\n" +
+	"\x06Update\x12\x18.pb.UpdateProductRequest\x1a\x16.pb.ApiResponseProduct\x12P\n" +
+	"\x12UpdateReorderLevel\x12\x1d.pb.UpdateReorderLevelRequest\x1a\x1b.pb.ApiResponseReorderLevel\x12I\n" +
+	"\x0eImportProducts\x12\x17.pb.ImportProductsChunk\x1a\x1c.pb.ApiResponseProductImport(\x01\x12F\n" +
+	"\x0eExportProducts\x12\x19.pb.ExportProductsRequest\x1a\x17.pb.ExportProductsChunk0\x01\x12L\n" +
 	"\x0eTrashedProduct\x12\x1a.pb.FindByIdProductRequest\x1a\x1e.pb.ApiResponseProductDeleteAt\x12L\n" +
 	"\x0eRestoreProduct\x12\x1a.pb.FindByIdProductRequest\x1a\x1e.pb.ApiResponseProductDeleteAt\x12R\n" +
 	"\x16DeleteProductPermanent\x12\x1a.pb.FindByIdProductRequest\x1a\x1c.pb.ApiResponseProductDelete\x12H\n" +
@@ -1425,67 +2902,127 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_product_proto_goTypes = []any{
 	(*FindAllProductRequest)(nil),                // 0: pb.FindAllProductRequest
 	(*FindAllProductMerchantRequest)(nil),        // 1: pb.FindAllProductMerchantRequest
 	(*FindAllProductCategoryRequest)(nil),        // 2: pb.FindAllProductCategoryRequest
 	(*FindByIdProductRequest)(nil),               // 3: pb.FindByIdProductRequest
-	(*CreateProductRequest)(nil),                 // 4: pb.CreateProductRequest
-	(*UpdateProductRequest)(nil),                 // 5: pb.UpdateProductRequest
-	(*ProductResponse)(nil),                      // 6: pb.ProductResponse
-	(*ProductResponseDeleteAt)(nil),              // 7: pb.ProductResponseDeleteAt
-	(*ApiResponseProduct)(nil),                   // 8: pb.ApiResponseProduct
-	(*ApiResponseProductDeleteAt)(nil),           // 9: pb.ApiResponseProductDeleteAt
-	(*ApiResponsesProduct)(nil),                  // 10: pb.ApiResponsesProduct
-	(*ApiResponseProductDelete)(nil),             // 11: pb.ApiResponseProductDelete
-	(*ApiResponseProductAll)(nil),                // 12: pb.ApiResponseProductAll
-	(*ApiResponsePaginationProductDeleteAt)(nil), // 13: pb.ApiResponsePaginationProductDeleteAt
-	(*ApiResponsePaginationProduct)(nil),         // 14: pb.ApiResponsePaginationProduct
-	(*wrapperspb.StringValue)(nil),               // 15: google.protobuf.StringValue
-	(*PaginationMeta)(nil),                       // 16: pb.PaginationMeta
-	(*emptypb.Empty)(nil),                        // 17: google.protobuf.Empty
+	(*FindStockMovementsRequest)(nil),            // 4: pb.FindStockMovementsRequest
+	(*FindLowStockProductRequest)(nil),           // 5: pb.FindLowStockProductRequest
+	(*UpdateReorderLevelRequest)(nil),            // 6: pb.UpdateReorderLevelRequest
+	(*CreateProductRequest)(nil),                 // 7: pb.CreateProductRequest
+	(*UpdateProductRequest)(nil),                 // 8: pb.UpdateProductRequest
+	(*FindByBarcodeRequest)(nil),                 // 9: pb.FindByBarcodeRequest
+	(*ImportProductsMetadata)(nil),               // 10: pb.ImportProductsMetadata
+	(*ImportProductsChunk)(nil),                  // 11: pb.ImportProductsChunk
+	(*ExportProductsRequest)(nil),                // 12: pb.ExportProductsRequest
+	(*ExportProductsChunk)(nil),                  // 13: pb.ExportProductsChunk
+	(*ProductResponse)(nil),                      // 14: pb.ProductResponse
+	(*ProductResponseDeleteAt)(nil),              // 15: pb.ProductResponseDeleteAt
+	(*ProductScanResponse)(nil),                  // 16: pb.ProductScanResponse
+	(*StockMovementResponse)(nil),                // 17: pb.StockMovementResponse
+	(*ApiResponseProduct)(nil),                   // 18: pb.ApiResponseProduct
+	(*ApiResponseProductScan)(nil),               // 19: pb.ApiResponseProductScan
+	(*ProductImportRowResponse)(nil),             // 20: pb.ProductImportRowResponse
+	(*ProductImportResponse)(nil),                // 21: pb.ProductImportResponse
+	(*ApiResponseProductImport)(nil),             // 22: pb.ApiResponseProductImport
+	(*ApiResponseProductDeleteAt)(nil),           // 23: pb.ApiResponseProductDeleteAt
+	(*ApiResponsesProduct)(nil),                  // 24: pb.ApiResponsesProduct
+	(*ApiResponseProductDelete)(nil),             // 25: pb.ApiResponseProductDelete
+	(*ApiResponseProductAll)(nil),                // 26: pb.ApiResponseProductAll
+	(*ApiResponsePaginationProductDeleteAt)(nil), // 27: pb.ApiResponsePaginationProductDeleteAt
+	(*ApiResponsePaginationProduct)(nil),         // 28: pb.ApiResponsePaginationProduct
+	(*ApiResponsePaginationStockMovement)(nil),   // 29: pb.ApiResponsePaginationStockMovement
+	(*ReorderLevelResponse)(nil),                 // 30: pb.ReorderLevelResponse
+	(*ApiResponseReorderLevel)(nil),              // 31: pb.ApiResponseReorderLevel
+	(*ApiResponsePaginationReorderLevel)(nil),    // 32: pb.ApiResponsePaginationReorderLevel
+	nil,                            // 33: pb.ProductScanResponse.VariantOptionsEntry
+	(*wrapperspb.StringValue)(nil), // 34: google.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),  // 35: google.protobuf.Int32Value
+	(*PaginationMeta)(nil),         // 36: pb.PaginationMeta
+	(*emptypb.Empty)(nil),          // 37: google.protobuf.Empty
 }
 var file_product_proto_depIdxs = []int32{
-	15, // 0: pb.ProductResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
-	6,  // 1: pb.ApiResponseProduct.data:type_name -> pb.ProductResponse
-	7,  // 2: pb.ApiResponseProductDeleteAt.data:type_name -> pb.ProductResponseDeleteAt
-	6,  // 3: pb.ApiResponsesProduct.data:type_name -> pb.ProductResponse
-	7,  // 4: pb.ApiResponsePaginationProductDeleteAt.data:type_name -> pb.ProductResponseDeleteAt
-	16, // 5: pb.ApiResponsePaginationProductDeleteAt.pagination:type_name -> pb.PaginationMeta
-	6,  // 6: pb.ApiResponsePaginationProduct.data:type_name -> pb.ProductResponse
-	16, // 7: pb.ApiResponsePaginationProduct.pagination:type_name -> pb.PaginationMeta
-	0,  // 8: pb.ProductService.FindAll:input_type -> pb.FindAllProductRequest
-	1,  // 9: pb.ProductService.FindByMerchant:input_type -> pb.FindAllProductMerchantRequest
-	2,  // 10: pb.ProductService.FindByCategory:input_type -> pb.FindAllProductCategoryRequest
-	3,  // 11: pb.ProductService.FindById:input_type -> pb.FindByIdProductRequest
-	0,  // 12: pb.ProductService.FindByActive:input_type -> pb.FindAllProductRequest
-	0,  // 13: pb.ProductService.FindByTrashed:input_type -> pb.FindAllProductRequest
-	4,  // 14: pb.ProductService.Create:input_type -> pb.CreateProductRequest
-	5,  // 15: pb.ProductService.Update:input_type -> pb.UpdateProductRequest
-	3,  // 16: pb.ProductService.TrashedProduct:input_type -> pb.FindByIdProductRequest
-	3,  // 17: pb.ProductService.RestoreProduct:input_type -> pb.FindByIdProductRequest
-	3,  // 18: pb.ProductService.DeleteProductPermanent:input_type -> pb.FindByIdProductRequest
-	17, // 19: pb.ProductService.RestoreAllProduct:input_type -> google.protobuf.Empty
-	17, // 20: pb.ProductService.DeleteAllProductPermanent:input_type -> google.protobuf.Empty
-	14, // 21: pb.ProductService.FindAll:output_type -> pb.ApiResponsePaginationProduct
-	14, // 22: pb.ProductService.FindByMerchant:output_type -> pb.ApiResponsePaginationProduct
-	14, // 23: pb.ProductService.FindByCategory:output_type -> pb.ApiResponsePaginationProduct
-	8,  // 24: pb.ProductService.FindById:output_type -> pb.ApiResponseProduct
-	13, // 25: pb.ProductService.FindByActive:output_type -> pb.ApiResponsePaginationProductDeleteAt
-	13, // 26: pb.ProductService.FindByTrashed:output_type -> pb.ApiResponsePaginationProductDeleteAt
-	8,  // 27: pb.ProductService.Create:output_type -> pb.ApiResponseProduct
-	8,  // 28: pb.ProductService.Update:output_type -> pb.ApiResponseProduct
-	9,  // 29: pb.ProductService.TrashedProduct:output_type -> pb.ApiResponseProductDeleteAt
-	9,  // 30: pb.ProductService.RestoreProduct:output_type -> pb.ApiResponseProductDeleteAt
-	11, // 31: pb.ProductService.DeleteProductPermanent:output_type -> pb.ApiResponseProductDelete
-	12, // 32: pb.ProductService.RestoreAllProduct:output_type -> pb.ApiResponseProductAll
-	12, // 33: pb.ProductService.DeleteAllProductPermanent:output_type -> pb.ApiResponseProductAll
-	21, // [21:34] is the sub-list for method output_type
-	8,  // [8:21] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	34, // 0: pb.CreateProductRequest.barcode:type_name -> google.protobuf.StringValue
+	34, // 1: pb.UpdateProductRequest.barcode:type_name -> google.protobuf.StringValue
+	10, // 2: pb.ImportProductsChunk.metadata:type_name -> pb.ImportProductsMetadata
+	35, // 3: pb.ProductResponse.variant_count:type_name -> google.protobuf.Int32Value
+	35, // 4: pb.ProductResponse.min_price:type_name -> google.protobuf.Int32Value
+	35, // 5: pb.ProductResponse.max_price:type_name -> google.protobuf.Int32Value
+	34, // 6: pb.ProductResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
+	35, // 7: pb.ProductResponseDeleteAt.variant_count:type_name -> google.protobuf.Int32Value
+	35, // 8: pb.ProductResponseDeleteAt.min_price:type_name -> google.protobuf.Int32Value
+	35, // 9: pb.ProductResponseDeleteAt.max_price:type_name -> google.protobuf.Int32Value
+	14, // 10: pb.ProductScanResponse.product:type_name -> pb.ProductResponse
+	35, // 11: pb.ProductScanResponse.variant_id:type_name -> google.protobuf.Int32Value
+	34, // 12: pb.ProductScanResponse.variant_sku:type_name -> google.protobuf.StringValue
+	33, // 13: pb.ProductScanResponse.variant_options:type_name -> pb.ProductScanResponse.VariantOptionsEntry
+	34, // 14: pb.StockMovementResponse.reference_type:type_name -> google.protobuf.StringValue
+	35, // 15: pb.StockMovementResponse.reference_id:type_name -> google.protobuf.Int32Value
+	35, // 16: pb.StockMovementResponse.user_id:type_name -> google.protobuf.Int32Value
+	34, // 17: pb.StockMovementResponse.note:type_name -> google.protobuf.StringValue
+	35, // 18: pb.StockMovementResponse.variant_id:type_name -> google.protobuf.Int32Value
+	14, // 19: pb.ApiResponseProduct.data:type_name -> pb.ProductResponse
+	16, // 20: pb.ApiResponseProductScan.data:type_name -> pb.ProductScanResponse
+	35, // 21: pb.ProductImportRowResponse.product_id:type_name -> google.protobuf.Int32Value
+	20, // 22: pb.ProductImportResponse.rows:type_name -> pb.ProductImportRowResponse
+	21, // 23: pb.ApiResponseProductImport.data:type_name -> pb.ProductImportResponse
+	15, // 24: pb.ApiResponseProductDeleteAt.data:type_name -> pb.ProductResponseDeleteAt
+	14, // 25: pb.ApiResponsesProduct.data:type_name -> pb.ProductResponse
+	15, // 26: pb.ApiResponsePaginationProductDeleteAt.data:type_name -> pb.ProductResponseDeleteAt
+	36, // 27: pb.ApiResponsePaginationProductDeleteAt.pagination:type_name -> pb.PaginationMeta
+	14, // 28: pb.ApiResponsePaginationProduct.data:type_name -> pb.ProductResponse
+	36, // 29: pb.ApiResponsePaginationProduct.pagination:type_name -> pb.PaginationMeta
+	17, // 30: pb.ApiResponsePaginationStockMovement.data:type_name -> pb.StockMovementResponse
+	36, // 31: pb.ApiResponsePaginationStockMovement.pagination:type_name -> pb.PaginationMeta
+	34, // 32: pb.ReorderLevelResponse.low_stock_alerted_at:type_name -> google.protobuf.StringValue
+	30, // 33: pb.ApiResponseReorderLevel.data:type_name -> pb.ReorderLevelResponse
+	30, // 34: pb.ApiResponsePaginationReorderLevel.data:type_name -> pb.ReorderLevelResponse
+	36, // 35: pb.ApiResponsePaginationReorderLevel.pagination:type_name -> pb.PaginationMeta
+	0,  // 36: pb.ProductService.FindAll:input_type -> pb.FindAllProductRequest
+	1,  // 37: pb.ProductService.FindByMerchant:input_type -> pb.FindAllProductMerchantRequest
+	2,  // 38: pb.ProductService.FindByCategory:input_type -> pb.FindAllProductCategoryRequest
+	3,  // 39: pb.ProductService.FindById:input_type -> pb.FindByIdProductRequest
+	9,  // 40: pb.ProductService.FindByBarcode:input_type -> pb.FindByBarcodeRequest
+	4,  // 41: pb.ProductService.FindStockMovements:input_type -> pb.FindStockMovementsRequest
+	5,  // 42: pb.ProductService.FindLowStock:input_type -> pb.FindLowStockProductRequest
+	0,  // 43: pb.ProductService.FindByActive:input_type -> pb.FindAllProductRequest
+	0,  // 44: pb.ProductService.FindByTrashed:input_type -> pb.FindAllProductRequest
+	7,  // 45: pb.ProductService.Create:input_type -> pb.CreateProductRequest
+	8,  // 46: pb.ProductService.Update:input_type -> pb.UpdateProductRequest
+	6,  // 47: pb.ProductService.UpdateReorderLevel:input_type -> pb.UpdateReorderLevelRequest
+	11, // 48: pb.ProductService.ImportProducts:input_type -> pb.ImportProductsChunk
+	12, // 49: pb.ProductService.ExportProducts:input_type -> pb.ExportProductsRequest
+	3,  // 50: pb.ProductService.TrashedProduct:input_type -> pb.FindByIdProductRequest
+	3,  // 51: pb.ProductService.RestoreProduct:input_type -> pb.FindByIdProductRequest
+	3,  // 52: pb.ProductService.DeleteProductPermanent:input_type -> pb.FindByIdProductRequest
+	37, // 53: pb.ProductService.RestoreAllProduct:input_type -> google.protobuf.Empty
+	37, // 54: pb.ProductService.DeleteAllProductPermanent:input_type -> google.protobuf.Empty
+	28, // 55: pb.ProductService.FindAll:output_type -> pb.ApiResponsePaginationProduct
+	28, // 56: pb.ProductService.FindByMerchant:output_type -> pb.ApiResponsePaginationProduct
+	28, // 57: pb.ProductService.FindByCategory:output_type -> pb.ApiResponsePaginationProduct
+	18, // 58: pb.ProductService.FindById:output_type -> pb.ApiResponseProduct
+	19, // 59: pb.ProductService.FindByBarcode:output_type -> pb.ApiResponseProductScan
+	29, // 60: pb.ProductService.FindStockMovements:output_type -> pb.ApiResponsePaginationStockMovement
+	32, // 61: pb.ProductService.FindLowStock:output_type -> pb.ApiResponsePaginationReorderLevel
+	27, // 62: pb.ProductService.FindByActive:output_type -> pb.ApiResponsePaginationProductDeleteAt
+	27, // 63: pb.ProductService.FindByTrashed:output_type -> pb.ApiResponsePaginationProductDeleteAt
+	18, // 64: pb.ProductService.Create:output_type -> pb.ApiResponseProduct
+	18, // 65: pb.ProductService.Update:output_type -> pb.ApiResponseProduct
+	31, // 66: pb.ProductService.UpdateReorderLevel:output_type -> pb.ApiResponseReorderLevel
+	22, // 67: pb.ProductService.ImportProducts:output_type -> pb.ApiResponseProductImport
+	13, // 68: pb.ProductService.ExportProducts:output_type -> pb.ExportProductsChunk
+	23, // 69: pb.ProductService.TrashedProduct:output_type -> pb.ApiResponseProductDeleteAt
+	23, // 70: pb.ProductService.RestoreProduct:output_type -> pb.ApiResponseProductDeleteAt
+	25, // 71: pb.ProductService.DeleteProductPermanent:output_type -> pb.ApiResponseProductDelete
+	26, // 72: pb.ProductService.RestoreAllProduct:output_type -> pb.ApiResponseProductAll
+	26, // 73: pb.ProductService.DeleteAllProductPermanent:output_type -> pb.ApiResponseProductAll
+	55, // [55:74] is the sub-list for method output_type
+	36, // [36:55] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -1494,13 +3031,17 @@ func file_product_proto_init() {
 		return
 	}
 	file_api_proto_init()
+	file_product_proto_msgTypes[11].OneofWrappers = []any{
+		(*ImportProductsChunk_Metadata)(nil),
+		(*ImportProductsChunk_Data)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,10 +24,16 @@ const (
 	ProductService_FindByMerchant_FullMethodName            = "/pb.ProductService/FindByMerchant"
 	ProductService_FindByCategory_FullMethodName            = "/pb.ProductService/FindByCategory"
 	ProductService_FindById_FullMethodName                  = "/pb.ProductService/FindById"
+	ProductService_FindByBarcode_FullMethodName             = "/pb.ProductService/FindByBarcode"
+	ProductService_FindStockMovements_FullMethodName        = "/pb.ProductService/FindStockMovements"
+	ProductService_FindLowStock_FullMethodName              = "/pb.ProductService/FindLowStock"
 	ProductService_FindByActive_FullMethodName              = "/pb.ProductService/FindByActive"
 	ProductService_FindByTrashed_FullMethodName             = "/pb.ProductService/FindByTrashed"
 	ProductService_Create_FullMethodName                    = "/pb.ProductService/Create"
 	ProductService_Update_FullMethodName                    = "/pb.ProductService/Update"
+	ProductService_UpdateReorderLevel_FullMethodName        = "/pb.ProductService/UpdateReorderLevel"
+	ProductService_ImportProducts_FullMethodName            = "/pb.ProductService/ImportProducts"
+	ProductService_ExportProducts_FullMethodName            = "/pb.ProductService/ExportProducts"
 	ProductService_TrashedProduct_FullMethodName            = "/pb.ProductService/TrashedProduct"
 	ProductService_RestoreProduct_FullMethodName            = "/pb.ProductService/RestoreProduct"
 	ProductService_DeleteProductPermanent_FullMethodName    = "/pb.ProductService/DeleteProductPermanent"
@@ -43,10 +49,16 @@ type ProductServiceClient interface {
 	FindByMerchant(ctx context.Context, in *FindAllProductMerchantRequest, opts ...grpc.CallOption) (*ApiResponsePaginationProduct, error)
 	FindByCategory(ctx context.Context, in *FindAllProductCategoryRequest, opts ...grpc.CallOption) (*ApiResponsePaginationProduct, error)
 	FindById(ctx context.Context, in *FindByIdProductRequest, opts ...grpc.CallOption) (*ApiResponseProduct, error)
+	FindByBarcode(ctx context.Context, in *FindByBarcodeRequest, opts ...grpc.CallOption) (*ApiResponseProductScan, error)
+	FindStockMovements(ctx context.Context, in *FindStockMovementsRequest, opts ...grpc.CallOption) (*ApiResponsePaginationStockMovement, error)
+	FindLowStock(ctx context.Context, in *FindLowStockProductRequest, opts ...grpc.CallOption) (*ApiResponsePaginationReorderLevel, error)
 	FindByActive(ctx context.Context, in *FindAllProductRequest, opts ...grpc.CallOption) (*ApiResponsePaginationProductDeleteAt, error)
 	FindByTrashed(ctx context.Context, in *FindAllProductRequest, opts ...grpc.CallOption) (*ApiResponsePaginationProductDeleteAt, error)
 	Create(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*ApiResponseProduct, error)
 	Update(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ApiResponseProduct, error)
+	UpdateReorderLevel(ctx context.Context, in *UpdateReorderLevelRequest, opts ...grpc.CallOption) (*ApiResponseReorderLevel, error)
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsChunk, ApiResponseProductImport], error)
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error)
 	TrashedProduct(ctx context.Context, in *FindByIdProductRequest, opts ...grpc.CallOption) (*ApiResponseProductDeleteAt, error)
 	RestoreProduct(ctx context.Context, in *FindByIdProductRequest, opts ...grpc.CallOption) (*ApiResponseProductDeleteAt, error)
 	DeleteProductPermanent(ctx context.Context, in *FindByIdProductRequest, opts ...grpc.CallOption) (*ApiResponseProductDelete, error)
@@ -102,6 +114,36 @@ func (c *productServiceClient) FindById(ctx context.Context, in *FindByIdProduct
 	return out, nil
 }
 
+func (c *productServiceClient) FindByBarcode(ctx context.Context, in *FindByBarcodeRequest, opts ...grpc.CallOption) (*ApiResponseProductScan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseProductScan)
+	err := c.cc.Invoke(ctx, ProductService_FindByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) FindStockMovements(ctx context.Context, in *FindStockMovementsRequest, opts ...grpc.CallOption) (*ApiResponsePaginationStockMovement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponsePaginationStockMovement)
+	err := c.cc.Invoke(ctx, ProductService_FindStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) FindLowStock(ctx context.Context, in *FindLowStockProductRequest, opts ...grpc.CallOption) (*ApiResponsePaginationReorderLevel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponsePaginationReorderLevel)
+	err := c.cc.Invoke(ctx, ProductService_FindLowStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) FindByActive(ctx context.Context, in *FindAllProductRequest, opts ...grpc.CallOption) (*ApiResponsePaginationProductDeleteAt, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponsePaginationProductDeleteAt)
@@ -142,6 +184,48 @@ func (c *productServiceClient) Update(ctx context.Context, in *UpdateProductRequ
 	return out, nil
 }
 
+func (c *productServiceClient) UpdateReorderLevel(ctx context.Context, in *UpdateReorderLevelRequest, opts ...grpc.CallOption) (*ApiResponseReorderLevel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseReorderLevel)
+	err := c.cc.Invoke(ctx, ProductService_UpdateReorderLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsChunk, ApiResponseProductImport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_ImportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportProductsChunk, ApiResponseProductImport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductsClient = grpc.ClientStreamingClient[ImportProductsChunk, ApiResponseProductImport]
+
+func (c *productServiceClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[1], ProductService_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductsRequest, ExportProductsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsClient = grpc.ServerStreamingClient[ExportProductsChunk]
+
 func (c *productServiceClient) TrashedProduct(ctx context.Context, in *FindByIdProductRequest, opts ...grpc.CallOption) (*ApiResponseProductDeleteAt, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponseProductDeleteAt)
@@ -200,10 +284,16 @@ type ProductServiceServer interface {
 	FindByMerchant(context.Context, *FindAllProductMerchantRequest) (*ApiResponsePaginationProduct, error)
 	FindByCategory(context.Context, *FindAllProductCategoryRequest) (*ApiResponsePaginationProduct, error)
 	FindById(context.Context, *FindByIdProductRequest) (*ApiResponseProduct, error)
+	FindByBarcode(context.Context, *FindByBarcodeRequest) (*ApiResponseProductScan, error)
+	FindStockMovements(context.Context, *FindStockMovementsRequest) (*ApiResponsePaginationStockMovement, error)
+	FindLowStock(context.Context, *FindLowStockProductRequest) (*ApiResponsePaginationReorderLevel, error)
 	FindByActive(context.Context, *FindAllProductRequest) (*ApiResponsePaginationProductDeleteAt, error)
 	FindByTrashed(context.Context, *FindAllProductRequest) (*ApiResponsePaginationProductDeleteAt, error)
 	Create(context.Context, *CreateProductRequest) (*ApiResponseProduct, error)
 	Update(context.Context, *UpdateProductRequest) (*ApiResponseProduct, error)
+	UpdateReorderLevel(context.Context, *UpdateReorderLevelRequest) (*ApiResponseReorderLevel, error)
+	ImportProducts(grpc.ClientStreamingServer[ImportProductsChunk, ApiResponseProductImport]) error
+	ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error
 	TrashedProduct(context.Context, *FindByIdProductRequest) (*ApiResponseProductDeleteAt, error)
 	RestoreProduct(context.Context, *FindByIdProductRequest) (*ApiResponseProductDeleteAt, error)
 	DeleteProductPermanent(context.Context, *FindByIdProductRequest) (*ApiResponseProductDelete, error)
@@ -231,6 +321,15 @@ func (UnimplementedProductServiceServer) FindByCategory(context.Context, *FindAl
 func (UnimplementedProductServiceServer) FindById(context.Context, *FindByIdProductRequest) (*ApiResponseProduct, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindById not implemented")
 }
+func (UnimplementedProductServiceServer) FindByBarcode(context.Context, *FindByBarcodeRequest) (*ApiResponseProductScan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByBarcode not implemented")
+}
+func (UnimplementedProductServiceServer) FindStockMovements(context.Context, *FindStockMovementsRequest) (*ApiResponsePaginationStockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindStockMovements not implemented")
+}
+func (UnimplementedProductServiceServer) FindLowStock(context.Context, *FindLowStockProductRequest) (*ApiResponsePaginationReorderLevel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindLowStock not implemented")
+}
 func (UnimplementedProductServiceServer) FindByActive(context.Context, *FindAllProductRequest) (*ApiResponsePaginationProductDeleteAt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindByActive not implemented")
 }
@@ -243,6 +342,15 @@ func (UnimplementedProductServiceServer) Create(context.Context, *CreateProductR
 func (UnimplementedProductServiceServer) Update(context.Context, *UpdateProductRequest) (*ApiResponseProduct, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedProductServiceServer) UpdateReorderLevel(context.Context, *UpdateReorderLevelRequest) (*ApiResponseReorderLevel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReorderLevel not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProducts(grpc.ClientStreamingServer[ImportProductsChunk, ApiResponseProductImport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
+}
 func (UnimplementedProductServiceServer) TrashedProduct(context.Context, *FindByIdProductRequest) (*ApiResponseProductDeleteAt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrashedProduct not implemented")
 }
@@ -351,6 +459,60 @@ func _ProductService_FindById_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_FindByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).FindByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_FindByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).FindByBarcode(ctx, req.(*FindByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_FindStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).FindStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_FindStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).FindStockMovements(ctx, req.(*FindStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_FindLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindLowStockProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).FindLowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_FindLowStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).FindLowStock(ctx, req.(*FindLowStockProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_FindByActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindAllProductRequest)
 	if err := dec(in); err != nil {
@@ -423,6 +585,42 @@ func _ProductService_Update_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_UpdateReorderLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReorderLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateReorderLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateReorderLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateReorderLevel(ctx, req.(*UpdateReorderLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).ImportProducts(&grpc.GenericServerStream[ImportProductsChunk, ApiResponseProductImport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductsServer = grpc.ClientStreamingServer[ImportProductsChunk, ApiResponseProductImport]
+
+func _ProductService_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).ExportProducts(m, &grpc.GenericServerStream[ExportProductsRequest, ExportProductsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsServer = grpc.ServerStreamingServer[ExportProductsChunk]
+
 func _ProductService_TrashedProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindByIdProductRequest)
 	if err := dec(in); err != nil {
@@ -536,6 +734,18 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindById",
 			Handler:    _ProductService_FindById_Handler,
 		},
+		{
+			MethodName: "FindByBarcode",
+			Handler:    _ProductService_FindByBarcode_Handler,
+		},
+		{
+			MethodName: "FindStockMovements",
+			Handler:    _ProductService_FindStockMovements_Handler,
+		},
+		{
+			MethodName: "FindLowStock",
+			Handler:    _ProductService_FindLowStock_Handler,
+		},
 		{
 			MethodName: "FindByActive",
 			Handler:    _ProductService_FindByActive_Handler,
@@ -552,6 +762,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _ProductService_Update_Handler,
 		},
+		{
+			MethodName: "UpdateReorderLevel",
+			Handler:    _ProductService_UpdateReorderLevel_Handler,
+		},
 		{
 			MethodName: "TrashedProduct",
 			Handler:    _ProductService_TrashedProduct_Handler,
@@ -573,6 +787,17 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ProductService_DeleteAllProductPermanent_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _ProductService_ImportProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _ProductService_ExportProducts_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "product.proto",
 }
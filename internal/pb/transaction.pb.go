@@ -619,19 +619,80 @@ func (x *FindByIdTransactionRequest) GetId() int32 {
 	return 0
 }
 
-type CreateTransactionRequest struct {
+type TransactionPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CashierId     int32                  `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,1,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionPaymentRequest) Reset() {
+	*x = TransactionPaymentRequest{}
+	mi := &file_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionPaymentRequest) ProtoMessage() {}
+
+func (x *TransactionPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionPaymentRequest.ProtoReflect.Descriptor instead.
+func (*TransactionPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransactionPaymentRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *TransactionPaymentRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionPaymentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type CreateTransactionRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	OrderId       int32                        `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CashierId     int32                        `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
+	PaymentMethod string                       `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount        int32                        `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payments      []*TransactionPaymentRequest `protobuf:"bytes,5,rep,name=payments,proto3" json:"payments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTransactionRequest) Reset() {
 	*x = CreateTransactionRequest{}
-	mi := &file_transaction_proto_msgTypes[11]
+	mi := &file_transaction_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -643,7 +704,7 @@ func (x *CreateTransactionRequest) String() string {
 func (*CreateTransactionRequest) ProtoMessage() {}
 
 func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[11]
+	mi := &file_transaction_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +717,7 @@ func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{11}
+	return file_transaction_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CreateTransactionRequest) GetOrderId() int32 {
@@ -687,21 +748,29 @@ func (x *CreateTransactionRequest) GetAmount() int32 {
 	return 0
 }
 
+func (x *CreateTransactionRequest) GetPayments() []*TransactionPaymentRequest {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
 type UpdateTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId int32                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	OrderId       int32                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CashierId     int32                  `protobuf:"varint,3,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	Amount        int32                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	PaymentStatus string                 `protobuf:"bytes,6,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	TransactionId int32                        `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	OrderId       int32                        `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CashierId     int32                        `protobuf:"varint,3,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
+	PaymentMethod string                       `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount        int32                        `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentStatus string                       `protobuf:"bytes,6,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
+	Payments      []*TransactionPaymentRequest `protobuf:"bytes,7,rep,name=payments,proto3" json:"payments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateTransactionRequest) Reset() {
 	*x = UpdateTransactionRequest{}
-	mi := &file_transaction_proto_msgTypes[12]
+	mi := &file_transaction_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +782,7 @@ func (x *UpdateTransactionRequest) String() string {
 func (*UpdateTransactionRequest) ProtoMessage() {}
 
 func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[12]
+	mi := &file_transaction_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +795,7 @@ func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{12}
+	return file_transaction_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateTransactionRequest) GetTransactionId() int32 {
@@ -771,19 +840,223 @@ func (x *UpdateTransactionRequest) GetPaymentStatus() string {
 	return ""
 }
 
+func (x *UpdateTransactionRequest) GetPayments() []*TransactionPaymentRequest {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type RefundItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderItemId   int32                  `protobuf:"varint,1,opt,name=order_item_id,json=orderItemId,proto3" json:"order_item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundItemRequest) Reset() {
+	*x = RefundItemRequest{}
+	mi := &file_transaction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundItemRequest) ProtoMessage() {}
+
+func (x *RefundItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundItemRequest.ProtoReflect.Descriptor instead.
+func (*RefundItemRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RefundItemRequest) GetOrderItemId() int32 {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return 0
+}
+
+func (x *RefundItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RefundTransactionRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TransactionId int32                   `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReasonCode    string                  `protobuf:"bytes,2,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Note          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Restock       bool                    `protobuf:"varint,4,opt,name=restock,proto3" json:"restock,omitempty"`
+	Items         []*RefundItemRequest    `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundTransactionRequest) Reset() {
+	*x = RefundTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransactionRequest) ProtoMessage() {}
+
+func (x *RefundTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RefundTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RefundTransactionRequest) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *RefundTransactionRequest) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *RefundTransactionRequest) GetNote() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *RefundTransactionRequest) GetRestock() bool {
+	if x != nil {
+		return x.Restock
+	}
+	return false
+}
+
+func (x *RefundTransactionRequest) GetItems() []*RefundItemRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type VoidTransactionRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TransactionId int32                   `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReasonCode    string                  `protobuf:"bytes,2,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Note          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Restock       bool                    `protobuf:"varint,4,opt,name=restock,proto3" json:"restock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidTransactionRequest) Reset() {
+	*x = VoidTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidTransactionRequest) ProtoMessage() {}
+
+func (x *VoidTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidTransactionRequest.ProtoReflect.Descriptor instead.
+func (*VoidTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VoidTransactionRequest) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *VoidTransactionRequest) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *VoidTransactionRequest) GetNote() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *VoidTransactionRequest) GetRestock() bool {
+	if x != nil {
+		return x.Restock
+	}
+	return false
+}
+
 type TransactionMonthlyAmountSuccess struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
 	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
 	TotalSuccess  int32                  `protobuf:"varint,3,opt,name=total_success,json=totalSuccess,proto3" json:"total_success,omitempty"`
 	TotalAmount   int32                  `protobuf:"varint,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalTax      int32                  `protobuf:"varint,5,opt,name=total_tax,json=totalTax,proto3" json:"total_tax,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TransactionMonthlyAmountSuccess) Reset() {
 	*x = TransactionMonthlyAmountSuccess{}
-	mi := &file_transaction_proto_msgTypes[13]
+	mi := &file_transaction_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1068,7 @@ func (x *TransactionMonthlyAmountSuccess) String() string {
 func (*TransactionMonthlyAmountSuccess) ProtoMessage() {}
 
 func (x *TransactionMonthlyAmountSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[13]
+	mi := &file_transaction_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1081,7 @@ func (x *TransactionMonthlyAmountSuccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionMonthlyAmountSuccess.ProtoReflect.Descriptor instead.
 func (*TransactionMonthlyAmountSuccess) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{13}
+	return file_transaction_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TransactionMonthlyAmountSuccess) GetYear() string {
@@ -839,6 +1112,13 @@ func (x *TransactionMonthlyAmountSuccess) GetTotalAmount() int32 {
 	return 0
 }
 
+func (x *TransactionMonthlyAmountSuccess) GetTotalTax() int32 {
+	if x != nil {
+		return x.TotalTax
+	}
+	return 0
+}
+
 type TransactionMonthlyAmountFailed struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -851,7 +1131,7 @@ type TransactionMonthlyAmountFailed struct {
 
 func (x *TransactionMonthlyAmountFailed) Reset() {
 	*x = TransactionMonthlyAmountFailed{}
-	mi := &file_transaction_proto_msgTypes[14]
+	mi := &file_transaction_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -863,7 +1143,7 @@ func (x *TransactionMonthlyAmountFailed) String() string {
 func (*TransactionMonthlyAmountFailed) ProtoMessage() {}
 
 func (x *TransactionMonthlyAmountFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[14]
+	mi := &file_transaction_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +1156,7 @@ func (x *TransactionMonthlyAmountFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionMonthlyAmountFailed.ProtoReflect.Descriptor instead.
 func (*TransactionMonthlyAmountFailed) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{14}
+	return file_transaction_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TransactionMonthlyAmountFailed) GetYear() string {
@@ -912,13 +1192,14 @@ type TransactionYearlyAmountSuccess struct {
 	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
 	TotalSuccess  int32                  `protobuf:"varint,2,opt,name=total_success,json=totalSuccess,proto3" json:"total_success,omitempty"`
 	TotalAmount   int32                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalTax      int32                  `protobuf:"varint,4,opt,name=total_tax,json=totalTax,proto3" json:"total_tax,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TransactionYearlyAmountSuccess) Reset() {
 	*x = TransactionYearlyAmountSuccess{}
-	mi := &file_transaction_proto_msgTypes[15]
+	mi := &file_transaction_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -930,7 +1211,7 @@ func (x *TransactionYearlyAmountSuccess) String() string {
 func (*TransactionYearlyAmountSuccess) ProtoMessage() {}
 
 func (x *TransactionYearlyAmountSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[15]
+	mi := &file_transaction_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1224,7 @@ func (x *TransactionYearlyAmountSuccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionYearlyAmountSuccess.ProtoReflect.Descriptor instead.
 func (*TransactionYearlyAmountSuccess) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{15}
+	return file_transaction_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TransactionYearlyAmountSuccess) GetYear() string {
@@ -967,6 +1248,13 @@ func (x *TransactionYearlyAmountSuccess) GetTotalAmount() int32 {
 	return 0
 }
 
+func (x *TransactionYearlyAmountSuccess) GetTotalTax() int32 {
+	if x != nil {
+		return x.TotalTax
+	}
+	return 0
+}
+
 type TransactionYearlyAmountFailed struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Year          string                 `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty"`
@@ -978,7 +1266,7 @@ type TransactionYearlyAmountFailed struct {
 
 func (x *TransactionYearlyAmountFailed) Reset() {
 	*x = TransactionYearlyAmountFailed{}
-	mi := &file_transaction_proto_msgTypes[16]
+	mi := &file_transaction_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1278,7 @@ func (x *TransactionYearlyAmountFailed) String() string {
 func (*TransactionYearlyAmountFailed) ProtoMessage() {}
 
 func (x *TransactionYearlyAmountFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[16]
+	mi := &file_transaction_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1291,7 @@ func (x *TransactionYearlyAmountFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionYearlyAmountFailed.ProtoReflect.Descriptor instead.
 func (*TransactionYearlyAmountFailed) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{16}
+	return file_transaction_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TransactionYearlyAmountFailed) GetYear() string {
@@ -1039,7 +1327,7 @@ type TransactionMonthlyMethod struct {
 
 func (x *TransactionMonthlyMethod) Reset() {
 	*x = TransactionMonthlyMethod{}
-	mi := &file_transaction_proto_msgTypes[17]
+	mi := &file_transaction_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1051,7 +1339,7 @@ func (x *TransactionMonthlyMethod) String() string {
 func (*TransactionMonthlyMethod) ProtoMessage() {}
 
 func (x *TransactionMonthlyMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[17]
+	mi := &file_transaction_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,7 +1352,7 @@ func (x *TransactionMonthlyMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionMonthlyMethod.ProtoReflect.Descriptor instead.
 func (*TransactionMonthlyMethod) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{17}
+	return file_transaction_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TransactionMonthlyMethod) GetMonth() string {
@@ -1107,7 +1395,7 @@ type TransactionYearlyMethod struct {
 
 func (x *TransactionYearlyMethod) Reset() {
 	*x = TransactionYearlyMethod{}
-	mi := &file_transaction_proto_msgTypes[18]
+	mi := &file_transaction_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1119,7 +1407,7 @@ func (x *TransactionYearlyMethod) String() string {
 func (*TransactionYearlyMethod) ProtoMessage() {}
 
 func (x *TransactionYearlyMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[18]
+	mi := &file_transaction_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +1420,7 @@ func (x *TransactionYearlyMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionYearlyMethod.ProtoReflect.Descriptor instead.
 func (*TransactionYearlyMethod) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{18}
+	return file_transaction_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TransactionYearlyMethod) GetYear() string {
@@ -1178,21 +1466,338 @@ type TransactionResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionResponse) Reset() {
-	*x = TransactionResponse{}
-	mi := &file_transaction_proto_msgTypes[19]
+func (x *TransactionResponse) Reset() {
+	*x = TransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResponse) ProtoMessage() {}
+
+func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TransactionResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetMerchantId() int32 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *TransactionResponse) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetChangeAmount() int32 {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *TransactionResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TransactionResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type TransactionResponseDeleteAt struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int32                   `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MerchantId    int32                   `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	PaymentMethod string                  `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount        int32                   `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	ChangeAmount  int32                   `protobuf:"varint,6,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	PaymentStatus string                  `protobuf:"bytes,7,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                  `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionResponseDeleteAt) Reset() {
+	*x = TransactionResponseDeleteAt{}
+	mi := &file_transaction_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionResponseDeleteAt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResponseDeleteAt) ProtoMessage() {}
+
+func (x *TransactionResponseDeleteAt) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResponseDeleteAt.ProtoReflect.Descriptor instead.
+func (*TransactionResponseDeleteAt) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransactionResponseDeleteAt) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionResponseDeleteAt) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *TransactionResponseDeleteAt) GetMerchantId() int32 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *TransactionResponseDeleteAt) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *TransactionResponseDeleteAt) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionResponseDeleteAt) GetChangeAmount() int32 {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return 0
+}
+
+func (x *TransactionResponseDeleteAt) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *TransactionResponseDeleteAt) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TransactionResponseDeleteAt) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *TransactionResponseDeleteAt) GetDeletedAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type TransactionPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int32                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Amount        int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ChangeAmount  int32                  `protobuf:"varint,5,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionPaymentResponse) Reset() {
+	*x = TransactionPaymentResponse{}
+	mi := &file_transaction_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionPaymentResponse) ProtoMessage() {}
+
+func (x *TransactionPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionPaymentResponse.ProtoReflect.Descriptor instead.
+func (*TransactionPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TransactionPaymentResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionPaymentResponse) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionPaymentResponse) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *TransactionPaymentResponse) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionPaymentResponse) GetChangeAmount() int32 {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return 0
+}
+
+func (x *TransactionPaymentResponse) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *TransactionPaymentResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type TransactionRefundResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int32                   `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Kind          string                  `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Amount        int32                   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TaxAmount     int32                   `protobuf:"varint,5,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	ReasonCode    string                  `protobuf:"bytes,6,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Note          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	ApprovedBy    int32                   `protobuf:"varint,8,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	Restock       bool                    `protobuf:"varint,9,opt,name=restock,proto3" json:"restock,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRefundResponse) Reset() {
+	*x = TransactionRefundResponse{}
+	mi := &file_transaction_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionResponse) String() string {
+func (x *TransactionRefundResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionResponse) ProtoMessage() {}
+func (*TransactionRefundResponse) ProtoMessage() {}
 
-func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[19]
+func (x *TransactionRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,105 +1808,105 @@ func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
-func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use TransactionRefundResponse.ProtoReflect.Descriptor instead.
+func (*TransactionRefundResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TransactionResponse) GetId() int32 {
+func (x *TransactionRefundResponse) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *TransactionResponse) GetOrderId() int32 {
-	if x != nil {
-		return x.OrderId
-	}
-	return 0
-}
-
-func (x *TransactionResponse) GetMerchantId() int32 {
+func (x *TransactionRefundResponse) GetTransactionId() int32 {
 	if x != nil {
-		return x.MerchantId
+		return x.TransactionId
 	}
 	return 0
 }
 
-func (x *TransactionResponse) GetPaymentMethod() string {
+func (x *TransactionRefundResponse) GetKind() string {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *TransactionResponse) GetAmount() int32 {
+func (x *TransactionRefundResponse) GetAmount() int32 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *TransactionResponse) GetChangeAmount() int32 {
+func (x *TransactionRefundResponse) GetTaxAmount() int32 {
 	if x != nil {
-		return x.ChangeAmount
+		return x.TaxAmount
 	}
 	return 0
 }
 
-func (x *TransactionResponse) GetPaymentStatus() string {
+func (x *TransactionRefundResponse) GetReasonCode() string {
 	if x != nil {
-		return x.PaymentStatus
+		return x.ReasonCode
 	}
 	return ""
 }
 
-func (x *TransactionResponse) GetCreatedAt() string {
+func (x *TransactionRefundResponse) GetNote() *wrapperspb.StringValue {
 	if x != nil {
-		return x.CreatedAt
+		return x.Note
 	}
-	return ""
+	return nil
 }
 
-func (x *TransactionResponse) GetUpdatedAt() string {
+func (x *TransactionRefundResponse) GetApprovedBy() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *TransactionRefundResponse) GetRestock() bool {
+	if x != nil {
+		return x.Restock
+	}
+	return false
+}
+
+func (x *TransactionRefundResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type TransactionResponseDeleteAt struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrderId       int32                   `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	MerchantId    int32                   `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	PaymentMethod string                  `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	Amount        int32                   `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	ChangeAmount  int32                   `protobuf:"varint,6,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
-	PaymentStatus string                  `protobuf:"bytes,7,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
-	CreatedAt     string                  `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                  `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeletedAt     *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+type ApiResponseTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TransactionResponse   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionResponseDeleteAt) Reset() {
-	*x = TransactionResponseDeleteAt{}
-	mi := &file_transaction_proto_msgTypes[20]
+func (x *ApiResponseTransaction) Reset() {
+	*x = ApiResponseTransaction{}
+	mi := &file_transaction_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionResponseDeleteAt) String() string {
+func (x *ApiResponseTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionResponseDeleteAt) ProtoMessage() {}
+func (*ApiResponseTransaction) ProtoMessage() {}
 
-func (x *TransactionResponseDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[20]
+func (x *ApiResponseTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,105 +1917,176 @@ func (x *TransactionResponseDeleteAt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionResponseDeleteAt.ProtoReflect.Descriptor instead.
-func (*TransactionResponseDeleteAt) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ApiResponseTransaction.ProtoReflect.Descriptor instead.
+func (*ApiResponseTransaction) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TransactionResponseDeleteAt) GetId() int32 {
+func (x *ApiResponseTransaction) GetStatus() string {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionResponseDeleteAt) GetOrderId() int32 {
+func (x *ApiResponseTransaction) GetMessage() string {
 	if x != nil {
-		return x.OrderId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionResponseDeleteAt) GetMerchantId() int32 {
+func (x *ApiResponseTransaction) GetData() *TransactionResponse {
 	if x != nil {
-		return x.MerchantId
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *TransactionResponseDeleteAt) GetPaymentMethod() string {
+type ApiResponseTransactionPayments struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Status        string                        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*TransactionPaymentResponse `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseTransactionPayments) Reset() {
+	*x = ApiResponseTransactionPayments{}
+	mi := &file_transaction_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseTransactionPayments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseTransactionPayments) ProtoMessage() {}
+
+func (x *ApiResponseTransactionPayments) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[28]
 	if x != nil {
-		return x.PaymentMethod
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiResponseTransactionPayments.ProtoReflect.Descriptor instead.
+func (*ApiResponseTransactionPayments) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApiResponseTransactionPayments) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *TransactionResponseDeleteAt) GetAmount() int32 {
+func (x *ApiResponseTransactionPayments) GetMessage() string {
 	if x != nil {
-		return x.Amount
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *TransactionResponseDeleteAt) GetChangeAmount() int32 {
+func (x *ApiResponseTransactionPayments) GetData() []*TransactionPaymentResponse {
 	if x != nil {
-		return x.ChangeAmount
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *TransactionResponseDeleteAt) GetPaymentStatus() string {
+type ApiResponseTransactionRefund struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Status        string                     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TransactionRefundResponse `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiResponseTransactionRefund) Reset() {
+	*x = ApiResponseTransactionRefund{}
+	mi := &file_transaction_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiResponseTransactionRefund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiResponseTransactionRefund) ProtoMessage() {}
+
+func (x *ApiResponseTransactionRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[29]
 	if x != nil {
-		return x.PaymentStatus
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TransactionResponseDeleteAt) GetCreatedAt() string {
+// Deprecated: Use ApiResponseTransactionRefund.ProtoReflect.Descriptor instead.
+func (*ApiResponseTransactionRefund) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApiResponseTransactionRefund) GetStatus() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Status
 	}
 	return ""
 }
 
-func (x *TransactionResponseDeleteAt) GetUpdatedAt() string {
+func (x *ApiResponseTransactionRefund) GetMessage() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Message
 	}
 	return ""
 }
 
-func (x *TransactionResponseDeleteAt) GetDeletedAt() *wrapperspb.StringValue {
+func (x *ApiResponseTransactionRefund) GetData() *TransactionRefundResponse {
 	if x != nil {
-		return x.DeletedAt
+		return x.Data
 	}
 	return nil
 }
 
-type ApiResponseTransaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *TransactionResponse   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+type ApiResponseTransactionRefunds struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Status        string                       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*TransactionRefundResponse `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApiResponseTransaction) Reset() {
-	*x = ApiResponseTransaction{}
-	mi := &file_transaction_proto_msgTypes[21]
+func (x *ApiResponseTransactionRefunds) Reset() {
+	*x = ApiResponseTransactionRefunds{}
+	mi := &file_transaction_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApiResponseTransaction) String() string {
+func (x *ApiResponseTransactionRefunds) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApiResponseTransaction) ProtoMessage() {}
+func (*ApiResponseTransactionRefunds) ProtoMessage() {}
 
-func (x *ApiResponseTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[21]
+func (x *ApiResponseTransactionRefunds) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,26 +2097,26 @@ func (x *ApiResponseTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApiResponseTransaction.ProtoReflect.Descriptor instead.
-func (*ApiResponseTransaction) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ApiResponseTransactionRefunds.ProtoReflect.Descriptor instead.
+func (*ApiResponseTransactionRefunds) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ApiResponseTransaction) GetStatus() string {
+func (x *ApiResponseTransactionRefunds) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *ApiResponseTransaction) GetMessage() string {
+func (x *ApiResponseTransactionRefunds) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ApiResponseTransaction) GetData() *TransactionResponse {
+func (x *ApiResponseTransactionRefunds) GetData() []*TransactionRefundResponse {
 	if x != nil {
 		return x.Data
 	}
@@ -1458,7 +2134,7 @@ type ApiResponseTransactionDeleteAt struct {
 
 func (x *ApiResponseTransactionDeleteAt) Reset() {
 	*x = ApiResponseTransactionDeleteAt{}
-	mi := &file_transaction_proto_msgTypes[22]
+	mi := &file_transaction_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1470,7 +2146,7 @@ func (x *ApiResponseTransactionDeleteAt) String() string {
 func (*ApiResponseTransactionDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponseTransactionDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[22]
+	mi := &file_transaction_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,7 +2159,7 @@ func (x *ApiResponseTransactionDeleteAt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseTransactionDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionDeleteAt) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{22}
+	return file_transaction_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ApiResponseTransactionDeleteAt) GetStatus() string {
@@ -1518,7 +2194,7 @@ type ApiResponseTransactionMonthAmountSuccess struct {
 
 func (x *ApiResponseTransactionMonthAmountSuccess) Reset() {
 	*x = ApiResponseTransactionMonthAmountSuccess{}
-	mi := &file_transaction_proto_msgTypes[23]
+	mi := &file_transaction_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1530,7 +2206,7 @@ func (x *ApiResponseTransactionMonthAmountSuccess) String() string {
 func (*ApiResponseTransactionMonthAmountSuccess) ProtoMessage() {}
 
 func (x *ApiResponseTransactionMonthAmountSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[23]
+	mi := &file_transaction_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,7 +2219,7 @@ func (x *ApiResponseTransactionMonthAmountSuccess) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ApiResponseTransactionMonthAmountSuccess.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionMonthAmountSuccess) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{23}
+	return file_transaction_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ApiResponseTransactionMonthAmountSuccess) GetStatus() string {
@@ -1578,7 +2254,7 @@ type ApiResponseTransactionYearAmountSuccess struct {
 
 func (x *ApiResponseTransactionYearAmountSuccess) Reset() {
 	*x = ApiResponseTransactionYearAmountSuccess{}
-	mi := &file_transaction_proto_msgTypes[24]
+	mi := &file_transaction_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1590,7 +2266,7 @@ func (x *ApiResponseTransactionYearAmountSuccess) String() string {
 func (*ApiResponseTransactionYearAmountSuccess) ProtoMessage() {}
 
 func (x *ApiResponseTransactionYearAmountSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[24]
+	mi := &file_transaction_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,7 +2279,7 @@ func (x *ApiResponseTransactionYearAmountSuccess) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ApiResponseTransactionYearAmountSuccess.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionYearAmountSuccess) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{24}
+	return file_transaction_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ApiResponseTransactionYearAmountSuccess) GetStatus() string {
@@ -1638,7 +2314,7 @@ type ApiResponseTransactionMonthAmountFailed struct {
 
 func (x *ApiResponseTransactionMonthAmountFailed) Reset() {
 	*x = ApiResponseTransactionMonthAmountFailed{}
-	mi := &file_transaction_proto_msgTypes[25]
+	mi := &file_transaction_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1650,7 +2326,7 @@ func (x *ApiResponseTransactionMonthAmountFailed) String() string {
 func (*ApiResponseTransactionMonthAmountFailed) ProtoMessage() {}
 
 func (x *ApiResponseTransactionMonthAmountFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[25]
+	mi := &file_transaction_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +2339,7 @@ func (x *ApiResponseTransactionMonthAmountFailed) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ApiResponseTransactionMonthAmountFailed.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionMonthAmountFailed) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{25}
+	return file_transaction_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ApiResponseTransactionMonthAmountFailed) GetStatus() string {
@@ -1698,7 +2374,7 @@ type ApiResponseTransactionYearAmountFailed struct {
 
 func (x *ApiResponseTransactionYearAmountFailed) Reset() {
 	*x = ApiResponseTransactionYearAmountFailed{}
-	mi := &file_transaction_proto_msgTypes[26]
+	mi := &file_transaction_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1710,7 +2386,7 @@ func (x *ApiResponseTransactionYearAmountFailed) String() string {
 func (*ApiResponseTransactionYearAmountFailed) ProtoMessage() {}
 
 func (x *ApiResponseTransactionYearAmountFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[26]
+	mi := &file_transaction_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1723,7 +2399,7 @@ func (x *ApiResponseTransactionYearAmountFailed) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ApiResponseTransactionYearAmountFailed.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionYearAmountFailed) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{26}
+	return file_transaction_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ApiResponseTransactionYearAmountFailed) GetStatus() string {
@@ -1758,7 +2434,7 @@ type ApiResponseTransactionMonthPaymentMethod struct {
 
 func (x *ApiResponseTransactionMonthPaymentMethod) Reset() {
 	*x = ApiResponseTransactionMonthPaymentMethod{}
-	mi := &file_transaction_proto_msgTypes[27]
+	mi := &file_transaction_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1770,7 +2446,7 @@ func (x *ApiResponseTransactionMonthPaymentMethod) String() string {
 func (*ApiResponseTransactionMonthPaymentMethod) ProtoMessage() {}
 
 func (x *ApiResponseTransactionMonthPaymentMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[27]
+	mi := &file_transaction_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +2459,7 @@ func (x *ApiResponseTransactionMonthPaymentMethod) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ApiResponseTransactionMonthPaymentMethod.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionMonthPaymentMethod) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{27}
+	return file_transaction_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ApiResponseTransactionMonthPaymentMethod) GetStatus() string {
@@ -1818,7 +2494,7 @@ type ApiResponseTransactionYearPaymentmethod struct {
 
 func (x *ApiResponseTransactionYearPaymentmethod) Reset() {
 	*x = ApiResponseTransactionYearPaymentmethod{}
-	mi := &file_transaction_proto_msgTypes[28]
+	mi := &file_transaction_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1830,7 +2506,7 @@ func (x *ApiResponseTransactionYearPaymentmethod) String() string {
 func (*ApiResponseTransactionYearPaymentmethod) ProtoMessage() {}
 
 func (x *ApiResponseTransactionYearPaymentmethod) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[28]
+	mi := &file_transaction_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,7 +2519,7 @@ func (x *ApiResponseTransactionYearPaymentmethod) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ApiResponseTransactionYearPaymentmethod.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionYearPaymentmethod) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{28}
+	return file_transaction_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ApiResponseTransactionYearPaymentmethod) GetStatus() string {
@@ -1878,7 +2554,7 @@ type ApiResponsesTransaction struct {
 
 func (x *ApiResponsesTransaction) Reset() {
 	*x = ApiResponsesTransaction{}
-	mi := &file_transaction_proto_msgTypes[29]
+	mi := &file_transaction_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1890,7 +2566,7 @@ func (x *ApiResponsesTransaction) String() string {
 func (*ApiResponsesTransaction) ProtoMessage() {}
 
 func (x *ApiResponsesTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[29]
+	mi := &file_transaction_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2579,7 @@ func (x *ApiResponsesTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsesTransaction.ProtoReflect.Descriptor instead.
 func (*ApiResponsesTransaction) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{29}
+	return file_transaction_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ApiResponsesTransaction) GetStatus() string {
@@ -1937,7 +2613,7 @@ type ApiResponseTransactionDelete struct {
 
 func (x *ApiResponseTransactionDelete) Reset() {
 	*x = ApiResponseTransactionDelete{}
-	mi := &file_transaction_proto_msgTypes[30]
+	mi := &file_transaction_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1949,7 +2625,7 @@ func (x *ApiResponseTransactionDelete) String() string {
 func (*ApiResponseTransactionDelete) ProtoMessage() {}
 
 func (x *ApiResponseTransactionDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[30]
+	mi := &file_transaction_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1962,7 +2638,7 @@ func (x *ApiResponseTransactionDelete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseTransactionDelete.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionDelete) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{30}
+	return file_transaction_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ApiResponseTransactionDelete) GetStatus() string {
@@ -1989,7 +2665,7 @@ type ApiResponseTransactionAll struct {
 
 func (x *ApiResponseTransactionAll) Reset() {
 	*x = ApiResponseTransactionAll{}
-	mi := &file_transaction_proto_msgTypes[31]
+	mi := &file_transaction_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2001,7 +2677,7 @@ func (x *ApiResponseTransactionAll) String() string {
 func (*ApiResponseTransactionAll) ProtoMessage() {}
 
 func (x *ApiResponseTransactionAll) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[31]
+	mi := &file_transaction_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,7 +2690,7 @@ func (x *ApiResponseTransactionAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponseTransactionAll.ProtoReflect.Descriptor instead.
 func (*ApiResponseTransactionAll) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{31}
+	return file_transaction_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ApiResponseTransactionAll) GetStatus() string {
@@ -2043,7 +2719,7 @@ type ApiResponsePaginationTransactionDeleteAt struct {
 
 func (x *ApiResponsePaginationTransactionDeleteAt) Reset() {
 	*x = ApiResponsePaginationTransactionDeleteAt{}
-	mi := &file_transaction_proto_msgTypes[32]
+	mi := &file_transaction_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2055,7 +2731,7 @@ func (x *ApiResponsePaginationTransactionDeleteAt) String() string {
 func (*ApiResponsePaginationTransactionDeleteAt) ProtoMessage() {}
 
 func (x *ApiResponsePaginationTransactionDeleteAt) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[32]
+	mi := &file_transaction_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2068,7 +2744,7 @@ func (x *ApiResponsePaginationTransactionDeleteAt) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ApiResponsePaginationTransactionDeleteAt.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationTransactionDeleteAt) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{32}
+	return file_transaction_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ApiResponsePaginationTransactionDeleteAt) GetStatus() string {
@@ -2111,7 +2787,7 @@ type ApiResponsePaginationTransaction struct {
 
 func (x *ApiResponsePaginationTransaction) Reset() {
 	*x = ApiResponsePaginationTransaction{}
-	mi := &file_transaction_proto_msgTypes[33]
+	mi := &file_transaction_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2123,7 +2799,7 @@ func (x *ApiResponsePaginationTransaction) String() string {
 func (*ApiResponsePaginationTransaction) ProtoMessage() {}
 
 func (x *ApiResponsePaginationTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[33]
+	mi := &file_transaction_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2136,7 +2812,7 @@ func (x *ApiResponsePaginationTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiResponsePaginationTransaction.ProtoReflect.Descriptor instead.
 func (*ApiResponsePaginationTransaction) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{33}
+	return file_transaction_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ApiResponsePaginationTransaction) GetStatus() string {
@@ -2212,13 +2888,18 @@ const file_transaction_proto_rawDesc = "" +
 	"merchantId\x12\x12\n" +
 	"\x04year\x18\x02 \x01(\x05R\x04year\",\n" +
 	"\x1aFindByIdTransactionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x93\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"x\n" +
+	"\x19TransactionPaymentRequest\x12%\n" +
+	"\x0epayment_method\x18\x01 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\"\xce\x01\n" +
 	"\x18CreateTransactionRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x1d\n" +
 	"\n" +
 	"cashier_id\x18\x02 \x01(\x05R\tcashierId\x12%\n" +
 	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x05R\x06amount\"\xe1\x01\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x129\n" +
+	"\bpayments\x18\x05 \x03(\v2\x1d.pb.TransactionPaymentRequestR\bpayments\"\x9c\x02\n" +
 	"\x18UpdateTransactionRequest\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x05R\aorderId\x12\x1d\n" +
@@ -2226,21 +2907,40 @@ const file_transaction_proto_rawDesc = "" +
 	"cashier_id\x18\x03 \x01(\x05R\tcashierId\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12\x16\n" +
 	"\x06amount\x18\x05 \x01(\x05R\x06amount\x12%\n" +
-	"\x0epayment_status\x18\x06 \x01(\tR\rpaymentStatus\"\x93\x01\n" +
+	"\x0epayment_status\x18\x06 \x01(\tR\rpaymentStatus\x129\n" +
+	"\bpayments\x18\a \x03(\v2\x1d.pb.TransactionPaymentRequestR\bpayments\"S\n" +
+	"\x11RefundItemRequest\x12\"\n" +
+	"\rorder_item_id\x18\x01 \x01(\x05R\vorderItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xdb\x01\n" +
+	"\x18RefundTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\x12\x1f\n" +
+	"\vreason_code\x18\x02 \x01(\tR\n" +
+	"reasonCode\x120\n" +
+	"\x04note\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04note\x12\x18\n" +
+	"\arestock\x18\x04 \x01(\bR\arestock\x12+\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.pb.RefundItemRequestR\x05items\"\xac\x01\n" +
+	"\x16VoidTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x05R\rtransactionId\x12\x1f\n" +
+	"\vreason_code\x18\x02 \x01(\tR\n" +
+	"reasonCode\x120\n" +
+	"\x04note\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04note\x12\x18\n" +
+	"\arestock\x18\x04 \x01(\bR\arestock\"\xb0\x01\n" +
 	"\x1fTransactionMonthlyAmountSuccess\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\tR\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\tR\x05month\x12#\n" +
 	"\rtotal_success\x18\x03 \x01(\x05R\ftotalSuccess\x12!\n" +
-	"\ftotal_amount\x18\x04 \x01(\x05R\vtotalAmount\"\x90\x01\n" +
+	"\ftotal_amount\x18\x04 \x01(\x05R\vtotalAmount\x12\x1b\n" +
+	"\ttotal_tax\x18\x05 \x01(\x05R\btotalTax\"\x90\x01\n" +
 	"\x1eTransactionMonthlyAmountFailed\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\tR\x04year\x12\x14\n" +
 	"\x05month\x18\x02 \x01(\tR\x05month\x12!\n" +
 	"\ftotal_failed\x18\x03 \x01(\x05R\vtotalFailed\x12!\n" +
-	"\ftotal_amount\x18\x04 \x01(\x05R\vtotalAmount\"|\n" +
+	"\ftotal_amount\x18\x04 \x01(\x05R\vtotalAmount\"\x99\x01\n" +
 	"\x1eTransactionYearlyAmountSuccess\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\tR\x04year\x12#\n" +
 	"\rtotal_success\x18\x02 \x01(\x05R\ftotalSuccess\x12!\n" +
-	"\ftotal_amount\x18\x03 \x01(\x05R\vtotalAmount\"y\n" +
+	"\ftotal_amount\x18\x03 \x01(\x05R\vtotalAmount\x12\x1b\n" +
+	"\ttotal_tax\x18\x04 \x01(\x05R\btotalTax\"y\n" +
 	"\x1dTransactionYearlyAmountFailed\x12\x12\n" +
 	"\x04year\x18\x01 \x01(\tR\x04year\x12!\n" +
 	"\ftotal_failed\x18\x02 \x01(\x05R\vtotalFailed\x12!\n" +
@@ -2283,11 +2983,48 @@ const file_transaction_proto_rawDesc = "" +
 	"updated_at\x18\t \x01(\tR\tupdatedAt\x12;\n" +
 	"\n" +
 	"deleted_at\x18\n" +
-	" \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\"w\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\tdeletedAt\"\xf4\x01\n" +
+	"\x1aTransactionPaymentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x05R\rtransactionId\x12%\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x12#\n" +
+	"\rchange_amount\x18\x05 \x01(\x05R\fchangeAmount\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xca\x02\n" +
+	"\x19TransactionRefundResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x05R\rtransactionId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\x05 \x01(\x05R\ttaxAmount\x12\x1f\n" +
+	"\vreason_code\x18\x06 \x01(\tR\n" +
+	"reasonCode\x120\n" +
+	"\x04note\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x04note\x12\x1f\n" +
+	"\vapproved_by\x18\b \x01(\x05R\n" +
+	"approvedBy\x12\x18\n" +
+	"\arestock\x18\t \x01(\bR\arestock\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"w\n" +
 	"\x16ApiResponseTransaction\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
-	"\x04data\x18\x03 \x01(\v2\x17.pb.TransactionResponseR\x04data\"\x87\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.pb.TransactionResponseR\x04data\"\x86\x01\n" +
+	"\x1eApiResponseTransactionPayments\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x04data\x18\x03 \x03(\v2\x1e.pb.TransactionPaymentResponseR\x04data\"\x83\x01\n" +
+	"\x1cApiResponseTransactionRefund\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.pb.TransactionRefundResponseR\x04data\"\x84\x01\n" +
+	"\x1dApiResponseTransactionRefunds\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x04data\x18\x03 \x03(\v2\x1d.pb.TransactionRefundResponseR\x04data\"\x87\x01\n" +
 	"\x1eApiResponseTransactionDeleteAt\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
@@ -2339,11 +3076,13 @@ const file_transaction_proto_rawDesc = "" +
 	"\x04data\x18\x03 \x03(\v2\x17.pb.TransactionResponseR\x04data\x122\n" +
 	"\n" +
 	"pagination\x18\x04 \x01(\v2\x12.pb.PaginationMetaR\n" +
-	"pagination2\xfe\x15\n" +
+	"pagination2\xca\x18\n" +
 	"\x12TransactionService\x12N\n" +
 	"\aFindAll\x12\x1d.pb.FindAllTransactionRequest\x1a$.pb.ApiResponsePaginationTransaction\x12]\n" +
 	"\x0eFindByMerchant\x12%.pb.FindAllTransactionMerchantRequest\x1a$.pb.ApiResponsePaginationTransaction\x12F\n" +
-	"\bFindById\x12\x1e.pb.FindByIdTransactionRequest\x1a\x1a.pb.ApiResponseTransaction\x12h\n" +
+	"\bFindById\x12\x1e.pb.FindByIdTransactionRequest\x1a\x1a.pb.ApiResponseTransaction\x12R\n" +
+	"\fFindPayments\x12\x1e.pb.FindByIdTransactionRequest\x1a\".pb.ApiResponseTransactionPayments\x12P\n" +
+	"\vFindRefunds\x12\x1e.pb.FindByIdTransactionRequest\x1a!.pb.ApiResponseTransactionRefunds\x12h\n" +
 	"\x16FindMonthStatusSuccess\x12 .pb.FindMonthlyTransactionStatus\x1a,.pb.ApiResponseTransactionMonthAmountSuccess\x12e\n" +
 	"\x15FindYearStatusSuccess\x12\x1f.pb.FindYearlyTransactionStatus\x1a+.pb.ApiResponseTransactionYearAmountSuccess\x12f\n" +
 	"\x15FindMonthStatusFailed\x12 .pb.FindMonthlyTransactionStatus\x1a+.pb.ApiResponseTransactionMonthAmountFailed\x12c\n" +
@@ -2363,7 +3102,9 @@ const file_transaction_proto_rawDesc = "" +
 	"\fFindByActive\x12\x1d.pb.FindAllTransactionRequest\x1a,.pb.ApiResponsePaginationTransactionDeleteAt\"\x00\x12^\n" +
 	"\rFindByTrashed\x12\x1d.pb.FindAllTransactionRequest\x1a,.pb.ApiResponsePaginationTransactionDeleteAt\"\x00\x12B\n" +
 	"\x06Create\x12\x1c.pb.CreateTransactionRequest\x1a\x1a.pb.ApiResponseTransaction\x12B\n" +
-	"\x06Update\x12\x1c.pb.UpdateTransactionRequest\x1a\x1a.pb.ApiResponseTransaction\x12X\n" +
+	"\x06Update\x12\x1c.pb.UpdateTransactionRequest\x1a\x1a.pb.ApiResponseTransaction\x12S\n" +
+	"\x11RefundTransaction\x12\x1c.pb.RefundTransactionRequest\x1a .pb.ApiResponseTransactionRefund\x12O\n" +
+	"\x0fVoidTransaction\x12\x1a.pb.VoidTransactionRequest\x1a .pb.ApiResponseTransactionRefund\x12X\n" +
 	"\x12TrashedTransaction\x12\x1e.pb.FindByIdTransactionRequest\x1a\".pb.ApiResponseTransactionDeleteAt\x12X\n" +
 	"\x12RestoreTransaction\x12\x1e.pb.FindByIdTransactionRequest\x1a\".pb.ApiResponseTransactionDeleteAt\x12^\n" +
 	"\x1aDeleteTransactionPermanent\x12\x1e.pb.FindByIdTransactionRequest\x1a .pb.ApiResponseTransactionDelete\x12P\n" +
@@ -2382,7 +3123,7 @@ func file_transaction_proto_rawDescGZIP() []byte {
 	return file_transaction_proto_rawDescData
 }
 
-var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_transaction_proto_goTypes = []any{
 	(*FindAllTransactionRequest)(nil),                // 0: pb.FindAllTransactionRequest
 	(*FindAllTransactionMerchantRequest)(nil),        // 1: pb.FindAllTransactionMerchantRequest
@@ -2395,109 +3136,135 @@ var file_transaction_proto_goTypes = []any{
 	(*MonthTransactionMethodByMerchant)(nil),         // 8: pb.MonthTransactionMethodByMerchant
 	(*YearTransactionMethodByMerchant)(nil),          // 9: pb.YearTransactionMethodByMerchant
 	(*FindByIdTransactionRequest)(nil),               // 10: pb.FindByIdTransactionRequest
-	(*CreateTransactionRequest)(nil),                 // 11: pb.CreateTransactionRequest
-	(*UpdateTransactionRequest)(nil),                 // 12: pb.UpdateTransactionRequest
-	(*TransactionMonthlyAmountSuccess)(nil),          // 13: pb.TransactionMonthlyAmountSuccess
-	(*TransactionMonthlyAmountFailed)(nil),           // 14: pb.TransactionMonthlyAmountFailed
-	(*TransactionYearlyAmountSuccess)(nil),           // 15: pb.TransactionYearlyAmountSuccess
-	(*TransactionYearlyAmountFailed)(nil),            // 16: pb.TransactionYearlyAmountFailed
-	(*TransactionMonthlyMethod)(nil),                 // 17: pb.TransactionMonthlyMethod
-	(*TransactionYearlyMethod)(nil),                  // 18: pb.TransactionYearlyMethod
-	(*TransactionResponse)(nil),                      // 19: pb.TransactionResponse
-	(*TransactionResponseDeleteAt)(nil),              // 20: pb.TransactionResponseDeleteAt
-	(*ApiResponseTransaction)(nil),                   // 21: pb.ApiResponseTransaction
-	(*ApiResponseTransactionDeleteAt)(nil),           // 22: pb.ApiResponseTransactionDeleteAt
-	(*ApiResponseTransactionMonthAmountSuccess)(nil), // 23: pb.ApiResponseTransactionMonthAmountSuccess
-	(*ApiResponseTransactionYearAmountSuccess)(nil),  // 24: pb.ApiResponseTransactionYearAmountSuccess
-	(*ApiResponseTransactionMonthAmountFailed)(nil),  // 25: pb.ApiResponseTransactionMonthAmountFailed
-	(*ApiResponseTransactionYearAmountFailed)(nil),   // 26: pb.ApiResponseTransactionYearAmountFailed
-	(*ApiResponseTransactionMonthPaymentMethod)(nil), // 27: pb.ApiResponseTransactionMonthPaymentMethod
-	(*ApiResponseTransactionYearPaymentmethod)(nil),  // 28: pb.ApiResponseTransactionYearPaymentmethod
-	(*ApiResponsesTransaction)(nil),                  // 29: pb.ApiResponsesTransaction
-	(*ApiResponseTransactionDelete)(nil),             // 30: pb.ApiResponseTransactionDelete
-	(*ApiResponseTransactionAll)(nil),                // 31: pb.ApiResponseTransactionAll
-	(*ApiResponsePaginationTransactionDeleteAt)(nil), // 32: pb.ApiResponsePaginationTransactionDeleteAt
-	(*ApiResponsePaginationTransaction)(nil),         // 33: pb.ApiResponsePaginationTransaction
-	(*wrapperspb.StringValue)(nil),                   // 34: google.protobuf.StringValue
-	(*PaginationMeta)(nil),                           // 35: pb.PaginationMeta
-	(*emptypb.Empty)(nil),                            // 36: google.protobuf.Empty
+	(*TransactionPaymentRequest)(nil),                // 11: pb.TransactionPaymentRequest
+	(*CreateTransactionRequest)(nil),                 // 12: pb.CreateTransactionRequest
+	(*UpdateTransactionRequest)(nil),                 // 13: pb.UpdateTransactionRequest
+	(*RefundItemRequest)(nil),                        // 14: pb.RefundItemRequest
+	(*RefundTransactionRequest)(nil),                 // 15: pb.RefundTransactionRequest
+	(*VoidTransactionRequest)(nil),                   // 16: pb.VoidTransactionRequest
+	(*TransactionMonthlyAmountSuccess)(nil),          // 17: pb.TransactionMonthlyAmountSuccess
+	(*TransactionMonthlyAmountFailed)(nil),           // 18: pb.TransactionMonthlyAmountFailed
+	(*TransactionYearlyAmountSuccess)(nil),           // 19: pb.TransactionYearlyAmountSuccess
+	(*TransactionYearlyAmountFailed)(nil),            // 20: pb.TransactionYearlyAmountFailed
+	(*TransactionMonthlyMethod)(nil),                 // 21: pb.TransactionMonthlyMethod
+	(*TransactionYearlyMethod)(nil),                  // 22: pb.TransactionYearlyMethod
+	(*TransactionResponse)(nil),                      // 23: pb.TransactionResponse
+	(*TransactionResponseDeleteAt)(nil),              // 24: pb.TransactionResponseDeleteAt
+	(*TransactionPaymentResponse)(nil),               // 25: pb.TransactionPaymentResponse
+	(*TransactionRefundResponse)(nil),                // 26: pb.TransactionRefundResponse
+	(*ApiResponseTransaction)(nil),                   // 27: pb.ApiResponseTransaction
+	(*ApiResponseTransactionPayments)(nil),           // 28: pb.ApiResponseTransactionPayments
+	(*ApiResponseTransactionRefund)(nil),             // 29: pb.ApiResponseTransactionRefund
+	(*ApiResponseTransactionRefunds)(nil),            // 30: pb.ApiResponseTransactionRefunds
+	(*ApiResponseTransactionDeleteAt)(nil),           // 31: pb.ApiResponseTransactionDeleteAt
+	(*ApiResponseTransactionMonthAmountSuccess)(nil), // 32: pb.ApiResponseTransactionMonthAmountSuccess
+	(*ApiResponseTransactionYearAmountSuccess)(nil),  // 33: pb.ApiResponseTransactionYearAmountSuccess
+	(*ApiResponseTransactionMonthAmountFailed)(nil),  // 34: pb.ApiResponseTransactionMonthAmountFailed
+	(*ApiResponseTransactionYearAmountFailed)(nil),   // 35: pb.ApiResponseTransactionYearAmountFailed
+	(*ApiResponseTransactionMonthPaymentMethod)(nil), // 36: pb.ApiResponseTransactionMonthPaymentMethod
+	(*ApiResponseTransactionYearPaymentmethod)(nil),  // 37: pb.ApiResponseTransactionYearPaymentmethod
+	(*ApiResponsesTransaction)(nil),                  // 38: pb.ApiResponsesTransaction
+	(*ApiResponseTransactionDelete)(nil),             // 39: pb.ApiResponseTransactionDelete
+	(*ApiResponseTransactionAll)(nil),                // 40: pb.ApiResponseTransactionAll
+	(*ApiResponsePaginationTransactionDeleteAt)(nil), // 41: pb.ApiResponsePaginationTransactionDeleteAt
+	(*ApiResponsePaginationTransaction)(nil),         // 42: pb.ApiResponsePaginationTransaction
+	(*wrapperspb.StringValue)(nil),                   // 43: google.protobuf.StringValue
+	(*PaginationMeta)(nil),                           // 44: pb.PaginationMeta
+	(*emptypb.Empty)(nil),                            // 45: google.protobuf.Empty
 }
 var file_transaction_proto_depIdxs = []int32{
-	34, // 0: pb.TransactionResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
-	19, // 1: pb.ApiResponseTransaction.data:type_name -> pb.TransactionResponse
-	20, // 2: pb.ApiResponseTransactionDeleteAt.data:type_name -> pb.TransactionResponseDeleteAt
-	13, // 3: pb.ApiResponseTransactionMonthAmountSuccess.data:type_name -> pb.TransactionMonthlyAmountSuccess
-	15, // 4: pb.ApiResponseTransactionYearAmountSuccess.data:type_name -> pb.TransactionYearlyAmountSuccess
-	14, // 5: pb.ApiResponseTransactionMonthAmountFailed.data:type_name -> pb.TransactionMonthlyAmountFailed
-	16, // 6: pb.ApiResponseTransactionYearAmountFailed.data:type_name -> pb.TransactionYearlyAmountFailed
-	17, // 7: pb.ApiResponseTransactionMonthPaymentMethod.data:type_name -> pb.TransactionMonthlyMethod
-	18, // 8: pb.ApiResponseTransactionYearPaymentmethod.data:type_name -> pb.TransactionYearlyMethod
-	19, // 9: pb.ApiResponsesTransaction.data:type_name -> pb.TransactionResponse
-	20, // 10: pb.ApiResponsePaginationTransactionDeleteAt.data:type_name -> pb.TransactionResponseDeleteAt
-	35, // 11: pb.ApiResponsePaginationTransactionDeleteAt.pagination:type_name -> pb.PaginationMeta
-	19, // 12: pb.ApiResponsePaginationTransaction.data:type_name -> pb.TransactionResponse
-	35, // 13: pb.ApiResponsePaginationTransaction.pagination:type_name -> pb.PaginationMeta
-	0,  // 14: pb.TransactionService.FindAll:input_type -> pb.FindAllTransactionRequest
-	1,  // 15: pb.TransactionService.FindByMerchant:input_type -> pb.FindAllTransactionMerchantRequest
-	10, // 16: pb.TransactionService.FindById:input_type -> pb.FindByIdTransactionRequest
-	2,  // 17: pb.TransactionService.FindMonthStatusSuccess:input_type -> pb.FindMonthlyTransactionStatus
-	3,  // 18: pb.TransactionService.FindYearStatusSuccess:input_type -> pb.FindYearlyTransactionStatus
-	2,  // 19: pb.TransactionService.FindMonthStatusFailed:input_type -> pb.FindMonthlyTransactionStatus
-	3,  // 20: pb.TransactionService.FindYearStatusFailed:input_type -> pb.FindYearlyTransactionStatus
-	4,  // 21: pb.TransactionService.FindMonthStatusSuccessByMerchant:input_type -> pb.FindMonthlyTransactionStatusByMerchant
-	5,  // 22: pb.TransactionService.FindYearStatusSuccessByMerchant:input_type -> pb.FindYearlyTransactionStatusByMerchant
-	4,  // 23: pb.TransactionService.FindMonthStatusFailedByMerchant:input_type -> pb.FindMonthlyTransactionStatusByMerchant
-	5,  // 24: pb.TransactionService.FindYearStatusFailedByMerchant:input_type -> pb.FindYearlyTransactionStatusByMerchant
-	7,  // 25: pb.TransactionService.FindMonthMethodSuccess:input_type -> pb.MonthTransactionMethod
-	6,  // 26: pb.TransactionService.FindYearMethodSuccess:input_type -> pb.YearTransactionMethod
-	8,  // 27: pb.TransactionService.FindMonthMethodByMerchantSuccess:input_type -> pb.MonthTransactionMethodByMerchant
-	9,  // 28: pb.TransactionService.FindYearMethodByMerchantSuccess:input_type -> pb.YearTransactionMethodByMerchant
-	7,  // 29: pb.TransactionService.FindMonthMethodFailed:input_type -> pb.MonthTransactionMethod
-	6,  // 30: pb.TransactionService.FindYearMethodFailed:input_type -> pb.YearTransactionMethod
-	8,  // 31: pb.TransactionService.FindMonthMethodByMerchantFailed:input_type -> pb.MonthTransactionMethodByMerchant
-	9,  // 32: pb.TransactionService.FindYearMethodByMerchantFailed:input_type -> pb.YearTransactionMethodByMerchant
-	0,  // 33: pb.TransactionService.FindByActive:input_type -> pb.FindAllTransactionRequest
-	0,  // 34: pb.TransactionService.FindByTrashed:input_type -> pb.FindAllTransactionRequest
-	11, // 35: pb.TransactionService.Create:input_type -> pb.CreateTransactionRequest
-	12, // 36: pb.TransactionService.Update:input_type -> pb.UpdateTransactionRequest
-	10, // 37: pb.TransactionService.TrashedTransaction:input_type -> pb.FindByIdTransactionRequest
-	10, // 38: pb.TransactionService.RestoreTransaction:input_type -> pb.FindByIdTransactionRequest
-	10, // 39: pb.TransactionService.DeleteTransactionPermanent:input_type -> pb.FindByIdTransactionRequest
-	36, // 40: pb.TransactionService.RestoreAllTransaction:input_type -> google.protobuf.Empty
-	36, // 41: pb.TransactionService.DeleteAllTransactionPermanent:input_type -> google.protobuf.Empty
-	33, // 42: pb.TransactionService.FindAll:output_type -> pb.ApiResponsePaginationTransaction
-	33, // 43: pb.TransactionService.FindByMerchant:output_type -> pb.ApiResponsePaginationTransaction
-	21, // 44: pb.TransactionService.FindById:output_type -> pb.ApiResponseTransaction
-	23, // 45: pb.TransactionService.FindMonthStatusSuccess:output_type -> pb.ApiResponseTransactionMonthAmountSuccess
-	24, // 46: pb.TransactionService.FindYearStatusSuccess:output_type -> pb.ApiResponseTransactionYearAmountSuccess
-	25, // 47: pb.TransactionService.FindMonthStatusFailed:output_type -> pb.ApiResponseTransactionMonthAmountFailed
-	26, // 48: pb.TransactionService.FindYearStatusFailed:output_type -> pb.ApiResponseTransactionYearAmountFailed
-	23, // 49: pb.TransactionService.FindMonthStatusSuccessByMerchant:output_type -> pb.ApiResponseTransactionMonthAmountSuccess
-	24, // 50: pb.TransactionService.FindYearStatusSuccessByMerchant:output_type -> pb.ApiResponseTransactionYearAmountSuccess
-	25, // 51: pb.TransactionService.FindMonthStatusFailedByMerchant:output_type -> pb.ApiResponseTransactionMonthAmountFailed
-	26, // 52: pb.TransactionService.FindYearStatusFailedByMerchant:output_type -> pb.ApiResponseTransactionYearAmountFailed
-	27, // 53: pb.TransactionService.FindMonthMethodSuccess:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
-	28, // 54: pb.TransactionService.FindYearMethodSuccess:output_type -> pb.ApiResponseTransactionYearPaymentmethod
-	27, // 55: pb.TransactionService.FindMonthMethodByMerchantSuccess:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
-	28, // 56: pb.TransactionService.FindYearMethodByMerchantSuccess:output_type -> pb.ApiResponseTransactionYearPaymentmethod
-	27, // 57: pb.TransactionService.FindMonthMethodFailed:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
-	28, // 58: pb.TransactionService.FindYearMethodFailed:output_type -> pb.ApiResponseTransactionYearPaymentmethod
-	27, // 59: pb.TransactionService.FindMonthMethodByMerchantFailed:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
-	28, // 60: pb.TransactionService.FindYearMethodByMerchantFailed:output_type -> pb.ApiResponseTransactionYearPaymentmethod
-	32, // 61: pb.TransactionService.FindByActive:output_type -> pb.ApiResponsePaginationTransactionDeleteAt
-	32, // 62: pb.TransactionService.FindByTrashed:output_type -> pb.ApiResponsePaginationTransactionDeleteAt
-	21, // 63: pb.TransactionService.Create:output_type -> pb.ApiResponseTransaction
-	21, // 64: pb.TransactionService.Update:output_type -> pb.ApiResponseTransaction
-	22, // 65: pb.TransactionService.TrashedTransaction:output_type -> pb.ApiResponseTransactionDeleteAt
-	22, // 66: pb.TransactionService.RestoreTransaction:output_type -> pb.ApiResponseTransactionDeleteAt
-	30, // 67: pb.TransactionService.DeleteTransactionPermanent:output_type -> pb.ApiResponseTransactionDelete
-	31, // 68: pb.TransactionService.RestoreAllTransaction:output_type -> pb.ApiResponseTransactionAll
-	31, // 69: pb.TransactionService.DeleteAllTransactionPermanent:output_type -> pb.ApiResponseTransactionAll
-	42, // [42:70] is the sub-list for method output_type
-	14, // [14:42] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	11, // 0: pb.CreateTransactionRequest.payments:type_name -> pb.TransactionPaymentRequest
+	11, // 1: pb.UpdateTransactionRequest.payments:type_name -> pb.TransactionPaymentRequest
+	43, // 2: pb.RefundTransactionRequest.note:type_name -> google.protobuf.StringValue
+	14, // 3: pb.RefundTransactionRequest.items:type_name -> pb.RefundItemRequest
+	43, // 4: pb.VoidTransactionRequest.note:type_name -> google.protobuf.StringValue
+	43, // 5: pb.TransactionResponseDeleteAt.deleted_at:type_name -> google.protobuf.StringValue
+	43, // 6: pb.TransactionRefundResponse.note:type_name -> google.protobuf.StringValue
+	23, // 7: pb.ApiResponseTransaction.data:type_name -> pb.TransactionResponse
+	25, // 8: pb.ApiResponseTransactionPayments.data:type_name -> pb.TransactionPaymentResponse
+	26, // 9: pb.ApiResponseTransactionRefund.data:type_name -> pb.TransactionRefundResponse
+	26, // 10: pb.ApiResponseTransactionRefunds.data:type_name -> pb.TransactionRefundResponse
+	24, // 11: pb.ApiResponseTransactionDeleteAt.data:type_name -> pb.TransactionResponseDeleteAt
+	17, // 12: pb.ApiResponseTransactionMonthAmountSuccess.data:type_name -> pb.TransactionMonthlyAmountSuccess
+	19, // 13: pb.ApiResponseTransactionYearAmountSuccess.data:type_name -> pb.TransactionYearlyAmountSuccess
+	18, // 14: pb.ApiResponseTransactionMonthAmountFailed.data:type_name -> pb.TransactionMonthlyAmountFailed
+	20, // 15: pb.ApiResponseTransactionYearAmountFailed.data:type_name -> pb.TransactionYearlyAmountFailed
+	21, // 16: pb.ApiResponseTransactionMonthPaymentMethod.data:type_name -> pb.TransactionMonthlyMethod
+	22, // 17: pb.ApiResponseTransactionYearPaymentmethod.data:type_name -> pb.TransactionYearlyMethod
+	23, // 18: pb.ApiResponsesTransaction.data:type_name -> pb.TransactionResponse
+	24, // 19: pb.ApiResponsePaginationTransactionDeleteAt.data:type_name -> pb.TransactionResponseDeleteAt
+	44, // 20: pb.ApiResponsePaginationTransactionDeleteAt.pagination:type_name -> pb.PaginationMeta
+	23, // 21: pb.ApiResponsePaginationTransaction.data:type_name -> pb.TransactionResponse
+	44, // 22: pb.ApiResponsePaginationTransaction.pagination:type_name -> pb.PaginationMeta
+	0,  // 23: pb.TransactionService.FindAll:input_type -> pb.FindAllTransactionRequest
+	1,  // 24: pb.TransactionService.FindByMerchant:input_type -> pb.FindAllTransactionMerchantRequest
+	10, // 25: pb.TransactionService.FindById:input_type -> pb.FindByIdTransactionRequest
+	10, // 26: pb.TransactionService.FindPayments:input_type -> pb.FindByIdTransactionRequest
+	10, // 27: pb.TransactionService.FindRefunds:input_type -> pb.FindByIdTransactionRequest
+	2,  // 28: pb.TransactionService.FindMonthStatusSuccess:input_type -> pb.FindMonthlyTransactionStatus
+	3,  // 29: pb.TransactionService.FindYearStatusSuccess:input_type -> pb.FindYearlyTransactionStatus
+	2,  // 30: pb.TransactionService.FindMonthStatusFailed:input_type -> pb.FindMonthlyTransactionStatus
+	3,  // 31: pb.TransactionService.FindYearStatusFailed:input_type -> pb.FindYearlyTransactionStatus
+	4,  // 32: pb.TransactionService.FindMonthStatusSuccessByMerchant:input_type -> pb.FindMonthlyTransactionStatusByMerchant
+	5,  // 33: pb.TransactionService.FindYearStatusSuccessByMerchant:input_type -> pb.FindYearlyTransactionStatusByMerchant
+	4,  // 34: pb.TransactionService.FindMonthStatusFailedByMerchant:input_type -> pb.FindMonthlyTransactionStatusByMerchant
+	5,  // 35: pb.TransactionService.FindYearStatusFailedByMerchant:input_type -> pb.FindYearlyTransactionStatusByMerchant
+	7,  // 36: pb.TransactionService.FindMonthMethodSuccess:input_type -> pb.MonthTransactionMethod
+	6,  // 37: pb.TransactionService.FindYearMethodSuccess:input_type -> pb.YearTransactionMethod
+	8,  // 38: pb.TransactionService.FindMonthMethodByMerchantSuccess:input_type -> pb.MonthTransactionMethodByMerchant
+	9,  // 39: pb.TransactionService.FindYearMethodByMerchantSuccess:input_type -> pb.YearTransactionMethodByMerchant
+	7,  // 40: pb.TransactionService.FindMonthMethodFailed:input_type -> pb.MonthTransactionMethod
+	6,  // 41: pb.TransactionService.FindYearMethodFailed:input_type -> pb.YearTransactionMethod
+	8,  // 42: pb.TransactionService.FindMonthMethodByMerchantFailed:input_type -> pb.MonthTransactionMethodByMerchant
+	9,  // 43: pb.TransactionService.FindYearMethodByMerchantFailed:input_type -> pb.YearTransactionMethodByMerchant
+	0,  // 44: pb.TransactionService.FindByActive:input_type -> pb.FindAllTransactionRequest
+	0,  // 45: pb.TransactionService.FindByTrashed:input_type -> pb.FindAllTransactionRequest
+	12, // 46: pb.TransactionService.Create:input_type -> pb.CreateTransactionRequest
+	13, // 47: pb.TransactionService.Update:input_type -> pb.UpdateTransactionRequest
+	15, // 48: pb.TransactionService.RefundTransaction:input_type -> pb.RefundTransactionRequest
+	16, // 49: pb.TransactionService.VoidTransaction:input_type -> pb.VoidTransactionRequest
+	10, // 50: pb.TransactionService.TrashedTransaction:input_type -> pb.FindByIdTransactionRequest
+	10, // 51: pb.TransactionService.RestoreTransaction:input_type -> pb.FindByIdTransactionRequest
+	10, // 52: pb.TransactionService.DeleteTransactionPermanent:input_type -> pb.FindByIdTransactionRequest
+	45, // 53: pb.TransactionService.RestoreAllTransaction:input_type -> google.protobuf.Empty
+	45, // 54: pb.TransactionService.DeleteAllTransactionPermanent:input_type -> google.protobuf.Empty
+	42, // 55: pb.TransactionService.FindAll:output_type -> pb.ApiResponsePaginationTransaction
+	42, // 56: pb.TransactionService.FindByMerchant:output_type -> pb.ApiResponsePaginationTransaction
+	27, // 57: pb.TransactionService.FindById:output_type -> pb.ApiResponseTransaction
+	28, // 58: pb.TransactionService.FindPayments:output_type -> pb.ApiResponseTransactionPayments
+	30, // 59: pb.TransactionService.FindRefunds:output_type -> pb.ApiResponseTransactionRefunds
+	32, // 60: pb.TransactionService.FindMonthStatusSuccess:output_type -> pb.ApiResponseTransactionMonthAmountSuccess
+	33, // 61: pb.TransactionService.FindYearStatusSuccess:output_type -> pb.ApiResponseTransactionYearAmountSuccess
+	34, // 62: pb.TransactionService.FindMonthStatusFailed:output_type -> pb.ApiResponseTransactionMonthAmountFailed
+	35, // 63: pb.TransactionService.FindYearStatusFailed:output_type -> pb.ApiResponseTransactionYearAmountFailed
+	32, // 64: pb.TransactionService.FindMonthStatusSuccessByMerchant:output_type -> pb.ApiResponseTransactionMonthAmountSuccess
+	33, // 65: pb.TransactionService.FindYearStatusSuccessByMerchant:output_type -> pb.ApiResponseTransactionYearAmountSuccess
+	34, // 66: pb.TransactionService.FindMonthStatusFailedByMerchant:output_type -> pb.ApiResponseTransactionMonthAmountFailed
+	35, // 67: pb.TransactionService.FindYearStatusFailedByMerchant:output_type -> pb.ApiResponseTransactionYearAmountFailed
+	36, // 68: pb.TransactionService.FindMonthMethodSuccess:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
+	37, // 69: pb.TransactionService.FindYearMethodSuccess:output_type -> pb.ApiResponseTransactionYearPaymentmethod
+	36, // 70: pb.TransactionService.FindMonthMethodByMerchantSuccess:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
+	37, // 71: pb.TransactionService.FindYearMethodByMerchantSuccess:output_type -> pb.ApiResponseTransactionYearPaymentmethod
+	36, // 72: pb.TransactionService.FindMonthMethodFailed:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
+	37, // 73: pb.TransactionService.FindYearMethodFailed:output_type -> pb.ApiResponseTransactionYearPaymentmethod
+	36, // 74: pb.TransactionService.FindMonthMethodByMerchantFailed:output_type -> pb.ApiResponseTransactionMonthPaymentMethod
+	37, // 75: pb.TransactionService.FindYearMethodByMerchantFailed:output_type -> pb.ApiResponseTransactionYearPaymentmethod
+	41, // 76: pb.TransactionService.FindByActive:output_type -> pb.ApiResponsePaginationTransactionDeleteAt
+	41, // 77: pb.TransactionService.FindByTrashed:output_type -> pb.ApiResponsePaginationTransactionDeleteAt
+	27, // 78: pb.TransactionService.Create:output_type -> pb.ApiResponseTransaction
+	27, // 79: pb.TransactionService.Update:output_type -> pb.ApiResponseTransaction
+	29, // 80: pb.TransactionService.RefundTransaction:output_type -> pb.ApiResponseTransactionRefund
+	29, // 81: pb.TransactionService.VoidTransaction:output_type -> pb.ApiResponseTransactionRefund
+	31, // 82: pb.TransactionService.TrashedTransaction:output_type -> pb.ApiResponseTransactionDeleteAt
+	31, // 83: pb.TransactionService.RestoreTransaction:output_type -> pb.ApiResponseTransactionDeleteAt
+	39, // 84: pb.TransactionService.DeleteTransactionPermanent:output_type -> pb.ApiResponseTransactionDelete
+	40, // 85: pb.TransactionService.RestoreAllTransaction:output_type -> pb.ApiResponseTransactionAll
+	40, // 86: pb.TransactionService.DeleteAllTransactionPermanent:output_type -> pb.ApiResponseTransactionAll
+	55, // [55:87] is the sub-list for method output_type
+	23, // [23:55] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_transaction_proto_init() }
@@ -2512,7 +3279,7 @@ func file_transaction_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transaction_proto_rawDesc), len(file_transaction_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
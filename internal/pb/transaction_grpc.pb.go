@@ -23,6 +23,8 @@ const (
 	TransactionService_FindAll_FullMethodName                          = "/pb.TransactionService/FindAll"
 	TransactionService_FindByMerchant_FullMethodName                   = "/pb.TransactionService/FindByMerchant"
 	TransactionService_FindById_FullMethodName                         = "/pb.TransactionService/FindById"
+	TransactionService_FindPayments_FullMethodName                     = "/pb.TransactionService/FindPayments"
+	TransactionService_FindRefunds_FullMethodName                      = "/pb.TransactionService/FindRefunds"
 	TransactionService_FindMonthStatusSuccess_FullMethodName           = "/pb.TransactionService/FindMonthStatusSuccess"
 	TransactionService_FindYearStatusSuccess_FullMethodName            = "/pb.TransactionService/FindYearStatusSuccess"
 	TransactionService_FindMonthStatusFailed_FullMethodName            = "/pb.TransactionService/FindMonthStatusFailed"
@@ -43,6 +45,8 @@ const (
 	TransactionService_FindByTrashed_FullMethodName                    = "/pb.TransactionService/FindByTrashed"
 	TransactionService_Create_FullMethodName                           = "/pb.TransactionService/Create"
 	TransactionService_Update_FullMethodName                           = "/pb.TransactionService/Update"
+	TransactionService_RefundTransaction_FullMethodName                = "/pb.TransactionService/RefundTransaction"
+	TransactionService_VoidTransaction_FullMethodName                  = "/pb.TransactionService/VoidTransaction"
 	TransactionService_TrashedTransaction_FullMethodName               = "/pb.TransactionService/TrashedTransaction"
 	TransactionService_RestoreTransaction_FullMethodName               = "/pb.TransactionService/RestoreTransaction"
 	TransactionService_DeleteTransactionPermanent_FullMethodName       = "/pb.TransactionService/DeleteTransactionPermanent"
@@ -57,6 +61,8 @@ type TransactionServiceClient interface {
 	FindAll(ctx context.Context, in *FindAllTransactionRequest, opts ...grpc.CallOption) (*ApiResponsePaginationTransaction, error)
 	FindByMerchant(ctx context.Context, in *FindAllTransactionMerchantRequest, opts ...grpc.CallOption) (*ApiResponsePaginationTransaction, error)
 	FindById(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransaction, error)
+	FindPayments(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionPayments, error)
+	FindRefunds(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionRefunds, error)
 	FindMonthStatusSuccess(ctx context.Context, in *FindMonthlyTransactionStatus, opts ...grpc.CallOption) (*ApiResponseTransactionMonthAmountSuccess, error)
 	FindYearStatusSuccess(ctx context.Context, in *FindYearlyTransactionStatus, opts ...grpc.CallOption) (*ApiResponseTransactionYearAmountSuccess, error)
 	FindMonthStatusFailed(ctx context.Context, in *FindMonthlyTransactionStatus, opts ...grpc.CallOption) (*ApiResponseTransactionMonthAmountFailed, error)
@@ -77,6 +83,8 @@ type TransactionServiceClient interface {
 	FindByTrashed(ctx context.Context, in *FindAllTransactionRequest, opts ...grpc.CallOption) (*ApiResponsePaginationTransactionDeleteAt, error)
 	Create(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransaction, error)
 	Update(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransaction, error)
+	RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionRefund, error)
+	VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionRefund, error)
 	TrashedTransaction(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionDeleteAt, error)
 	RestoreTransaction(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionDeleteAt, error)
 	DeleteTransactionPermanent(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionDelete, error)
@@ -122,6 +130,26 @@ func (c *transactionServiceClient) FindById(ctx context.Context, in *FindByIdTra
 	return out, nil
 }
 
+func (c *transactionServiceClient) FindPayments(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionPayments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseTransactionPayments)
+	err := c.cc.Invoke(ctx, TransactionService_FindPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) FindRefunds(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionRefunds, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseTransactionRefunds)
+	err := c.cc.Invoke(ctx, TransactionService_FindRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) FindMonthStatusSuccess(ctx context.Context, in *FindMonthlyTransactionStatus, opts ...grpc.CallOption) (*ApiResponseTransactionMonthAmountSuccess, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponseTransactionMonthAmountSuccess)
@@ -322,6 +350,26 @@ func (c *transactionServiceClient) Update(ctx context.Context, in *UpdateTransac
 	return out, nil
 }
 
+func (c *transactionServiceClient) RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionRefund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseTransactionRefund)
+	err := c.cc.Invoke(ctx, TransactionService_RefundTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionRefund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiResponseTransactionRefund)
+	err := c.cc.Invoke(ctx, TransactionService_VoidTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) TrashedTransaction(ctx context.Context, in *FindByIdTransactionRequest, opts ...grpc.CallOption) (*ApiResponseTransactionDeleteAt, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApiResponseTransactionDeleteAt)
@@ -379,6 +427,8 @@ type TransactionServiceServer interface {
 	FindAll(context.Context, *FindAllTransactionRequest) (*ApiResponsePaginationTransaction, error)
 	FindByMerchant(context.Context, *FindAllTransactionMerchantRequest) (*ApiResponsePaginationTransaction, error)
 	FindById(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransaction, error)
+	FindPayments(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionPayments, error)
+	FindRefunds(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionRefunds, error)
 	FindMonthStatusSuccess(context.Context, *FindMonthlyTransactionStatus) (*ApiResponseTransactionMonthAmountSuccess, error)
 	FindYearStatusSuccess(context.Context, *FindYearlyTransactionStatus) (*ApiResponseTransactionYearAmountSuccess, error)
 	FindMonthStatusFailed(context.Context, *FindMonthlyTransactionStatus) (*ApiResponseTransactionMonthAmountFailed, error)
@@ -399,6 +449,8 @@ type TransactionServiceServer interface {
 	FindByTrashed(context.Context, *FindAllTransactionRequest) (*ApiResponsePaginationTransactionDeleteAt, error)
 	Create(context.Context, *CreateTransactionRequest) (*ApiResponseTransaction, error)
 	Update(context.Context, *UpdateTransactionRequest) (*ApiResponseTransaction, error)
+	RefundTransaction(context.Context, *RefundTransactionRequest) (*ApiResponseTransactionRefund, error)
+	VoidTransaction(context.Context, *VoidTransactionRequest) (*ApiResponseTransactionRefund, error)
 	TrashedTransaction(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionDeleteAt, error)
 	RestoreTransaction(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionDeleteAt, error)
 	DeleteTransactionPermanent(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionDelete, error)
@@ -423,6 +475,12 @@ func (UnimplementedTransactionServiceServer) FindByMerchant(context.Context, *Fi
 func (UnimplementedTransactionServiceServer) FindById(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransaction, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindById not implemented")
 }
+func (UnimplementedTransactionServiceServer) FindPayments(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionPayments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPayments not implemented")
+}
+func (UnimplementedTransactionServiceServer) FindRefunds(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionRefunds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRefunds not implemented")
+}
 func (UnimplementedTransactionServiceServer) FindMonthStatusSuccess(context.Context, *FindMonthlyTransactionStatus) (*ApiResponseTransactionMonthAmountSuccess, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindMonthStatusSuccess not implemented")
 }
@@ -483,6 +541,12 @@ func (UnimplementedTransactionServiceServer) Create(context.Context, *CreateTran
 func (UnimplementedTransactionServiceServer) Update(context.Context, *UpdateTransactionRequest) (*ApiResponseTransaction, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedTransactionServiceServer) RefundTransaction(context.Context, *RefundTransactionRequest) (*ApiResponseTransactionRefund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) VoidTransaction(context.Context, *VoidTransactionRequest) (*ApiResponseTransactionRefund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidTransaction not implemented")
+}
 func (UnimplementedTransactionServiceServer) TrashedTransaction(context.Context, *FindByIdTransactionRequest) (*ApiResponseTransactionDeleteAt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrashedTransaction not implemented")
 }
@@ -573,6 +637,42 @@ func _TransactionService_FindById_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_FindPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIdTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).FindPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_FindPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).FindPayments(ctx, req.(*FindByIdTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_FindRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIdTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).FindRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_FindRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).FindRefunds(ctx, req.(*FindByIdTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_FindMonthStatusSuccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindMonthlyTransactionStatus)
 	if err := dec(in); err != nil {
@@ -933,6 +1033,42 @@ func _TransactionService_Update_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_RefundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).RefundTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_RefundTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).RefundTransaction(ctx, req.(*RefundTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_VoidTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).VoidTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_VoidTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).VoidTransaction(ctx, req.(*VoidTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_TrashedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindByIdTransactionRequest)
 	if err := dec(in); err != nil {
@@ -1042,6 +1178,14 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindById",
 			Handler:    _TransactionService_FindById_Handler,
 		},
+		{
+			MethodName: "FindPayments",
+			Handler:    _TransactionService_FindPayments_Handler,
+		},
+		{
+			MethodName: "FindRefunds",
+			Handler:    _TransactionService_FindRefunds_Handler,
+		},
 		{
 			MethodName: "FindMonthStatusSuccess",
 			Handler:    _TransactionService_FindMonthStatusSuccess_Handler,
@@ -1122,6 +1266,14 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _TransactionService_Update_Handler,
 		},
+		{
+			MethodName: "RefundTransaction",
+			Handler:    _TransactionService_RefundTransaction_Handler,
+		},
+		{
+			MethodName: "VoidTransaction",
+			Handler:    _TransactionService_VoidTransaction_Handler,
+		},
 		{
 			MethodName: "TrashedTransaction",
 			Handler:    _TransactionService_TrashedTransaction_Handler,
@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors/cashier_errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -97,6 +99,10 @@ func (r *cashierRepository) FindById(ctx context.Context, cashier_id int) (*db.G
 	res, err := r.db.GetCashierById(ctx, int32(cashier_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, cashier_errors.ErrCashierNotFound
+		}
+
 		return nil, cashier_errors.ErrFindCashierById
 	}
 
@@ -376,6 +382,10 @@ func (r *cashierRepository) UpdateCashier(ctx context.Context, request *requests
 	res, err := r.db.UpdateCashier(ctx, req)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, cashier_errors.ErrCashierNotFound
+		}
+
 		return nil, cashier_errors.ErrUpdateCashier
 	}
 
@@ -386,6 +396,10 @@ func (r *cashierRepository) TrashedCashier(ctx context.Context, cashier_id int)
 	res, err := r.db.TrashCashier(ctx, int32(cashier_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, cashier_errors.ErrCashierNotFound
+		}
+
 		return nil, cashier_errors.ErrTrashedCashier
 	}
 
@@ -396,6 +410,10 @@ func (r *cashierRepository) RestoreCashier(ctx context.Context, cashier_id int)
 	res, err := r.db.RestoreCashier(ctx, int32(cashier_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, cashier_errors.ErrCashierNotFound
+		}
+
 		return nil, cashier_errors.ErrRestoreCashier
 	}
 
@@ -403,12 +421,16 @@ func (r *cashierRepository) RestoreCashier(ctx context.Context, cashier_id int)
 }
 
 func (r *cashierRepository) DeleteCashierPermanent(ctx context.Context, cashier_id int) (bool, error) {
-	err := r.db.DeleteCashierPermanently(ctx, int32(cashier_id))
+	deleted, err := r.db.DeleteCashierPermanently(ctx, int32(cashier_id))
 
 	if err != nil {
 		return false, cashier_errors.ErrDeleteCashierPermanent
 	}
 
+	if deleted == 0 {
+		return false, cashier_errors.ErrCashierNotFound
+	}
+
 	return true, nil
 }
 
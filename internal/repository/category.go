@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors/category_errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -58,6 +60,28 @@ func (r *categoryRepository) FindByName(ctx context.Context, name string) (*db.G
 	return res, nil
 }
 
+// FindByNameOrSlug resolves a category written by name or by slug among the
+// global categories and those of the merchant. It fails with
+// ErrCategoryNotFound when no active category matches.
+func (r *categoryRepository) FindByNameOrSlug(ctx context.Context, value string, merchantID int) (*db.GetCategoryByNameOrSlugRow, error) {
+	merchant := int32(merchantID)
+
+	res, err := r.db.GetCategoryByNameOrSlug(ctx, db.GetCategoryByNameOrSlugParams{
+		Name:       value,
+		MerchantID: &merchant,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, category_errors.ErrCategoryNotFound
+		}
+
+		return nil, category_errors.ErrFindByNameOrSlug
+	}
+
+	return res, nil
+}
+
 func (r *categoryRepository) FindByNameAndId(ctx context.Context, req *requests.CategoryNameAndId) (*db.GetCategoryByNameAndIdRow, error) {
 	res, err := r.db.GetCategoryByNameAndId(ctx, db.GetCategoryByNameAndIdParams{
 		Name:       req.Name,
@@ -189,6 +213,7 @@ func (r *categoryRepository) GetMonthlyTotalPriceById(ctx context.Context, req *
 		CreatedAt_2: prevStart,
 		CreatedAt_3: prevEnd,
 		CategoryID:  int32(req.CategoryID),
+		Column6:     req.IncludeSubcategories,
 	})
 
 	if err != nil {
@@ -202,6 +227,7 @@ func (r *categoryRepository) GetYearlyTotalPricesById(ctx context.Context, req *
 	res, err := r.db.GetYearlyTotalPriceById(ctx, db.GetYearlyTotalPriceByIdParams{
 		Column1:    int32(req.Year),
 		CategoryID: int32(req.CategoryID),
+		Column3:    req.IncludeSubcategories,
 	})
 
 	if err != nil {
@@ -265,10 +291,13 @@ func (r *categoryRepository) GetYearlyTotalPricesByMerchant(ctx context.Context,
 	return res, nil
 }
 
-func (r *categoryRepository) GetMonthPrice(ctx context.Context, year int) ([]*db.GetMonthlyCategoryRow, error) {
-	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+func (r *categoryRepository) GetMonthPrice(ctx context.Context, req *requests.MonthPrice) ([]*db.GetMonthlyCategoryRow, error) {
+	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	res, err := r.db.GetMonthlyCategory(ctx, yearStart)
+	res, err := r.db.GetMonthlyCategory(ctx, db.GetMonthlyCategoryParams{
+		Column1: yearStart,
+		Column2: req.IncludeSubcategories,
+	})
 
 	if err != nil {
 		return nil, category_errors.ErrGetMonthPrice
@@ -277,10 +306,13 @@ func (r *categoryRepository) GetMonthPrice(ctx context.Context, year int) ([]*db
 	return res, nil
 }
 
-func (r *categoryRepository) GetYearPrice(ctx context.Context, year int) ([]*db.GetYearlyCategoryRow, error) {
-	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+func (r *categoryRepository) GetYearPrice(ctx context.Context, req *requests.YearPrice) ([]*db.GetYearlyCategoryRow, error) {
+	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	res, err := r.db.GetYearlyCategory(ctx, yearStart)
+	res, err := r.db.GetYearlyCategory(ctx, db.GetYearlyCategoryParams{
+		Column1: yearStart,
+		Column2: req.IncludeSubcategories,
+	})
 
 	if err != nil {
 		return nil, category_errors.ErrGetYearPrice
@@ -295,6 +327,7 @@ func (r *categoryRepository) GetMonthPriceByMerchant(ctx context.Context, req *r
 	res, err := r.db.GetMonthlyCategoryByMerchant(ctx, db.GetMonthlyCategoryByMerchantParams{
 		Column1:    yearStart,
 		MerchantID: int32(req.MerchantID),
+		Column3:    req.IncludeSubcategories,
 	})
 	if err != nil {
 		return nil, category_errors.ErrGetMonthPriceByMerchant
@@ -309,6 +342,7 @@ func (r *categoryRepository) GetYearPriceByMerchant(ctx context.Context, req *re
 	res, err := r.db.GetYearlyCategoryByMerchant(ctx, db.GetYearlyCategoryByMerchantParams{
 		Column1:    yearStart,
 		MerchantID: int32(req.MerchantID),
+		Column3:    req.IncludeSubcategories,
 	})
 
 	if err != nil {
@@ -324,6 +358,7 @@ func (r *categoryRepository) GetMonthPriceById(ctx context.Context, req *request
 	res, err := r.db.GetMonthlyCategoryById(ctx, db.GetMonthlyCategoryByIdParams{
 		Column1:    yearStart,
 		CategoryID: int32(req.CategoryID),
+		Column3:    req.IncludeSubcategories,
 	})
 	if err != nil {
 		return nil, category_errors.ErrGetMonthPriceById
@@ -338,6 +373,7 @@ func (r *categoryRepository) GetYearPriceById(ctx context.Context, req *requests
 	res, err := r.db.GetYearlyCategoryById(ctx, db.GetYearlyCategoryByIdParams{
 		Column1:    yearStart,
 		CategoryID: int32(req.CategoryID),
+		Column3:    req.IncludeSubcategories,
 	})
 
 	if err != nil {
@@ -352,6 +388,8 @@ func (r *categoryRepository) CreateCategory(ctx context.Context, request *reques
 		Name:         request.Name,
 		Description:  &request.Description,
 		SlugCategory: request.SlugCategory,
+		ParentID:     toInt32Ptr(request.ParentID),
+		MerchantID:   toInt32Ptr(request.MerchantID),
 	}
 
 	category, err := r.db.CreateCategory(ctx, req)
@@ -380,6 +418,109 @@ func (r *categoryRepository) UpdateCategory(ctx context.Context, request *reques
 	return res, nil
 }
 
+// FindTree returns the active categories visible to a merchant, global ones
+// only when merchantID is nil, each one after its parent.
+func (r *categoryRepository) FindTree(ctx context.Context, merchantID *int) ([]*db.GetCategoryTreeRow, error) {
+	res, err := r.db.GetCategoryTree(ctx, toInt32Ptr(merchantID))
+	if err != nil {
+		return nil, category_errors.ErrFindTree
+	}
+
+	return res, nil
+}
+
+// FindSiblings returns the active categories below parentID that belong to
+// merchantID, in position order. nil stands for the root and for global
+// categories respectively.
+func (r *categoryRepository) FindSiblings(ctx context.Context, parentID *int, merchantID *int) ([]*db.Category, error) {
+	res, err := r.db.GetCategorySiblings(ctx, db.GetCategorySiblingsParams{
+		ParentID:   toInt32Ptr(parentID),
+		MerchantID: toInt32Ptr(merchantID),
+	})
+	if err != nil {
+		return nil, category_errors.ErrFindSiblings
+	}
+
+	return res, nil
+}
+
+// FindSubtreeIds returns the ID of a category and of all its descendants,
+// trashed ones included.
+func (r *categoryRepository) FindSubtreeIds(ctx context.Context, category_id int) ([]int, error) {
+	res, err := r.db.GetCategorySubtreeIds(ctx, int32(category_id))
+	if err != nil {
+		return nil, category_errors.ErrFindSubtree
+	}
+
+	ids := make([]int, len(res))
+	for i, id := range res {
+		ids[i] = int(id)
+	}
+
+	return ids, nil
+}
+
+func (r *categoryRepository) CountActiveChildren(ctx context.Context, category_id int) (int, error) {
+	count, err := r.db.CountActiveChildCategories(ctx, int32(category_id))
+	if err != nil {
+		return 0, category_errors.ErrCountChildren
+	}
+
+	return int(count), nil
+}
+
+// CountSlugConflicts counts the categories a category owned by merchantID
+// could not share slug with. categoryID, when set, is left out so a category
+// does not clash with itself.
+func (r *categoryRepository) CountSlugConflicts(ctx context.Context, slug string, categoryID *int, merchantID *int) (int, error) {
+	count, err := r.db.CountCategorySlugConflicts(ctx, db.CountCategorySlugConflictsParams{
+		SlugCategory: &slug,
+		CategoryID:   toInt32Ptr(categoryID),
+		MerchantID:   toInt32Ptr(merchantID),
+	})
+	if err != nil {
+		return 0, category_errors.ErrCountSlugConflicts
+	}
+
+	return int(count), nil
+}
+
+func (r *categoryRepository) UpdateParent(ctx context.Context, category_id int, parentID *int) (*db.Category, error) {
+	res, err := r.db.UpdateCategoryParent(ctx, db.UpdateCategoryParentParams{
+		CategoryID: int32(category_id),
+		ParentID:   toInt32Ptr(parentID),
+	})
+	if err != nil {
+		return nil, category_errors.ErrMoveCategory
+	}
+
+	return res, nil
+}
+
+// LockTree makes other transactions that change the tree wait until the
+// current one ends. It must run inside a transaction.
+func (r *categoryRepository) LockTree(ctx context.Context) error {
+	if err := r.db.LockCategoryTree(ctx); err != nil {
+		return category_errors.ErrLockTree
+	}
+
+	return nil
+}
+
+// Reorder gives the categories the positions of their IDs in the list.
+func (r *categoryRepository) Reorder(ctx context.Context, categoryIDs []int) error {
+	ids := make([]int32, len(categoryIDs))
+	for i, id := range categoryIDs {
+		ids[i] = int32(id)
+	}
+
+	if err := r.db.ReorderCategories(ctx, ids); err != nil {
+		return category_errors.ErrReorderCategories
+	}
+
+	return nil
+}
+
 func (r *categoryRepository) TrashedCategory(ctx context.Context, category_id int) (*db.Category, error) {
 	res, err := r.db.TrashCategory(ctx, int32(category_id))
 	if err != nil {
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/idempotency_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type idempotencyKeyRepository struct {
+	db *db.Queries
+}
+
+func NewIdempotencyKeyRepository(db *db.Queries) *idempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// Reserve claims the key for the caller. It returns ErrIdempotencyKeyHeld when
+// a live claim or a completed response already exists for it.
+func (r *idempotencyKeyRepository) Reserve(ctx context.Context, req *requests.ReserveIdempotencyKeyRecordRequest) (*db.IdempotencyKey, error) {
+	res, err := r.db.ReserveIdempotencyKey(ctx, db.ReserveIdempotencyKeyParams{
+		UserID:         int32(req.UserID),
+		Method:         req.Method,
+		IdempotencyKey: req.Key,
+		RequestHash:    req.RequestHash,
+		LockedUntil:    req.LockedUntil,
+		ExpiresAt:      req.ExpiresAt,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, idempotency_errors.ErrIdempotencyKeyHeld
+		}
+
+		return nil, idempotency_errors.ErrReserveIdempotencyKey
+	}
+
+	return res, nil
+}
+
+func (r *idempotencyKeyRepository) FindByKey(ctx context.Context, user_id int, method string, key string) (*db.IdempotencyKey, error) {
+	res, err := r.db.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{
+		UserID:         int32(user_id),
+		Method:         method,
+		IdempotencyKey: key,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, idempotency_errors.ErrIdempotencyKeyNotFound
+		}
+
+		return nil, idempotency_errors.ErrFindIdempotencyKey
+	}
+
+	return res, nil
+}
+
+func (r *idempotencyKeyRepository) Complete(ctx context.Context, idempotency_key_id int, response []byte) (*db.IdempotencyKey, error) {
+	res, err := r.db.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+		IdempotencyKeyID: int32(idempotency_key_id),
+		Response:         response,
+	})
+
+	if err != nil {
+		return nil, idempotency_errors.ErrCompleteIdempotencyKey
+	}
+
+	return res, nil
+}
+
+func (r *idempotencyKeyRepository) Release(ctx context.Context, idempotency_key_id int) error {
+	err := r.db.ReleaseIdempotencyKey(ctx, int32(idempotency_key_id))
+
+	if err != nil {
+		return idempotency_errors.ErrReleaseIdempotencyKey
+	}
+
+	return nil
+}
+
+func (r *idempotencyKeyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	res, err := r.db.DeleteExpiredIdempotencyKeys(ctx)
+
+	if err != nil {
+		return 0, idempotency_errors.ErrDeleteExpiredIdempotencyKeys
+	}
+
+	return res, nil
+}
@@ -43,10 +43,15 @@ type RoleRepository interface {
 type RefreshTokenRepository interface {
 	FindByToken(ctx context.Context, token string) (*db.RefreshToken, error)
 	FindByUserId(ctx context.Context, user_id int) (*db.RefreshToken, error)
+	FindByAccessTokenId(ctx context.Context, user_id int, access_token_id string) (*db.RefreshToken, error)
+	FindActiveByUserId(ctx context.Context, user_id int) ([]*db.RefreshToken, error)
 	CreateRefreshToken(ctx context.Context, req *requests.CreateRefreshToken) (*db.RefreshToken, error)
-	UpdateRefreshToken(ctx context.Context, req *requests.UpdateRefreshToken) (*db.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, refresh_token_id int) (*db.RefreshToken, error)
+	RevokeSession(ctx context.Context, user_id int, session_id string) ([]*db.RefreshToken, error)
+	RevokeAllByUserId(ctx context.Context, user_id int) ([]*db.RefreshToken, error)
 	DeleteRefreshToken(ctx context.Context, token string) error
 	DeleteRefreshTokenByUserId(ctx context.Context, user_id int) error
+	DeleteExpired(ctx context.Context) (int64, error)
 }
 
 type UserRoleRepository interface {
@@ -58,6 +63,7 @@ type CategoryRepository interface {
 	FindAllCategory(ctx context.Context, req *requests.FindAllCategory) ([]*db.GetCategoriesRow, error)
 	FindById(ctx context.Context, category_id int) (*db.GetCategoryByIDRow, error)
 	FindByName(ctx context.Context, name string) (*db.GetCategoryByNameRow, error)
+	FindByNameOrSlug(ctx context.Context, value string, merchantID int) (*db.GetCategoryByNameOrSlugRow, error)
 	FindByNameAndId(ctx context.Context, req *requests.CategoryNameAndId) (*db.GetCategoryByNameAndIdRow, error)
 	FindByActive(ctx context.Context, req *requests.FindAllCategory) ([]*db.GetCategoriesActiveRow, error)
 	FindByTrashed(ctx context.Context, req *requests.FindAllCategory) ([]*db.GetCategoriesTrashedRow, error)
@@ -69,8 +75,8 @@ type CategoryRepository interface {
 	GetMonthlyTotalPriceByMerchant(ctx context.Context, req *requests.MonthTotalPriceMerchant) ([]*db.GetMonthlyTotalPriceByMerchantRow, error)
 	GetYearlyTotalPricesByMerchant(ctx context.Context, req *requests.YearTotalPriceMerchant) ([]*db.GetYearlyTotalPriceByMerchantRow, error)
 
-	GetMonthPrice(ctx context.Context, year int) ([]*db.GetMonthlyCategoryRow, error)
-	GetYearPrice(ctx context.Context, year int) ([]*db.GetYearlyCategoryRow, error)
+	GetMonthPrice(ctx context.Context, req *requests.MonthPrice) ([]*db.GetMonthlyCategoryRow, error)
+	GetYearPrice(ctx context.Context, req *requests.YearPrice) ([]*db.GetYearlyCategoryRow, error)
 	GetMonthPriceByMerchant(ctx context.Context, req *requests.MonthPriceMerchant) ([]*db.GetMonthlyCategoryByMerchantRow, error)
 	GetYearPriceByMerchant(ctx context.Context, req *requests.YearPriceMerchant) ([]*db.GetYearlyCategoryByMerchantRow, error)
 	GetMonthPriceById(ctx context.Context, req *requests.MonthPriceId) ([]*db.GetMonthlyCategoryByIdRow, error)
@@ -78,6 +84,16 @@ type CategoryRepository interface {
 
 	CreateCategory(ctx context.Context, request *requests.CreateCategoryRequest) (*db.CreateCategoryRow, error)
 	UpdateCategory(ctx context.Context, request *requests.UpdateCategoryRequest) (*db.UpdateCategoryRow, error)
+
+	FindTree(ctx context.Context, merchantID *int) ([]*db.GetCategoryTreeRow, error)
+	FindSiblings(ctx context.Context, parentID *int, merchantID *int) ([]*db.Category, error)
+	FindSubtreeIds(ctx context.Context, category_id int) ([]int, error)
+	CountActiveChildren(ctx context.Context, category_id int) (int, error)
+	CountSlugConflicts(ctx context.Context, slug string, categoryID *int, merchantID *int) (int, error)
+	UpdateParent(ctx context.Context, category_id int, parentID *int) (*db.Category, error)
+	LockTree(ctx context.Context) error
+	Reorder(ctx context.Context, categoryIDs []int) error
+
 	TrashedCategory(ctx context.Context, category_id int) (*db.Category, error)
 	RestoreCategory(ctx context.Context, category_id int) (*db.Category, error)
 	DeleteCategoryPermanently(ctx context.Context, category_id int) (bool, error)
@@ -120,9 +136,11 @@ type MerchantRepository interface {
 	FindByActive(ctx context.Context, req *requests.FindAllMerchants) ([]*db.GetMerchantsActiveRow, error)
 	FindByTrashed(ctx context.Context, req *requests.FindAllMerchants) ([]*db.GetMerchantsTrashedRow, error)
 	FindById(ctx context.Context, user_id int) (*db.GetMerchantByIDRow, error)
+	FindScopeByUser(ctx context.Context, user_id int) ([]int, error)
 
 	CreateMerchant(ctx context.Context, request *requests.CreateMerchantRequest) (*db.CreateMerchantRow, error)
 	UpdateMerchant(ctx context.Context, request *requests.UpdateMerchantRequest) (*db.UpdateMerchantRow, error)
+	UpdateBarcodeSettings(ctx context.Context, request *requests.UpdateMerchantBarcodeSettingsRequest) (*db.UpdateMerchantBarcodeSettingsRow, error)
 	TrashedMerchant(ctx context.Context, merchant_id int) (*db.Merchant, error)
 	RestoreMerchant(ctx context.Context, merchant_id int) (*db.Merchant, error)
 	DeleteMerchantPermanent(ctx context.Context, merchant_id int) (bool, error)
@@ -152,6 +170,7 @@ type OrderRepository interface {
 
 	CreateOrder(ctx context.Context, request *requests.CreateOrderRecordRequest) (*db.CreateOrderRow, error)
 	UpdateOrder(ctx context.Context, request *requests.UpdateOrderRecordRequest) (*db.UpdateOrderRow, error)
+	UpdateOrderStatus(ctx context.Context, order_id int, from string, to string) (*db.Order, error)
 
 	TrashedOrder(ctx context.Context, order_id int) (*db.Order, error)
 	RestoreOrder(ctx context.Context, order_id int) (*db.Order, error)
@@ -166,11 +185,13 @@ type OrderItemRepository interface {
 	FindByTrashed(ctx context.Context, req *requests.FindAllOrderItems) ([]*db.GetOrderItemsTrashedRow, error)
 	FindOrderItemByOrder(ctx context.Context, order_id int) ([]*db.GetOrderItemsByOrderRow, error)
 	FindOrderItemByOrderTrashed(ctx context.Context, order_id int) ([]*db.OrderItem, error)
+	FindOrderItemTaxLines(ctx context.Context, order_id int) ([]*db.GetOrderItemTaxLinesRow, error)
 
 	CalculateTotalPrice(ctx context.Context, order_id int) (*int32, error)
 
 	CreateOrderItem(ctx context.Context, req *requests.CreateOrderItemRecordRequest) (*db.CreateOrderItemRow, error)
 	UpdateOrderItem(ctx context.Context, req *requests.UpdateOrderItemRecordRequest) (*db.UpdateOrderItemRow, error)
+	UpdateOrderItemTax(ctx context.Context, req *requests.UpdateOrderItemTaxRequest) error
 	TrashedOrderItem(ctx context.Context, order_id int) (*db.OrderItem, error)
 	RestoreOrderItem(ctx context.Context, order_id int) (*db.OrderItem, error)
 	DeleteOrderItemPermanent(ctx context.Context, order_id int) (bool, error)
@@ -185,17 +206,45 @@ type ProductRepository interface {
 	FindByMerchant(ctx context.Context, req *requests.ProductByMerchantRequest) ([]*db.GetProductsByMerchantRow, error)
 	FindByCategory(ctx context.Context, req *requests.ProductByCategoryRequest) ([]*db.GetProductsByCategoryNameRow, error)
 	FindById(ctx context.Context, product_id int) (*db.GetProductByIDRow, error)
+	FindByBarcode(ctx context.Context, barcode string) (*db.GetProductByBarcodeRow, error)
+	FindByScan(ctx context.Context, barcode string) (*db.GetProductByScanRow, error)
+	FindForImport(ctx context.Context, barcode *string, slug *string) ([]*db.GetProductsForImportRow, error)
 	FindByIdTrashed(ctx context.Context, id int) (*db.GetProductByIdTrashedRow, error)
 
 	CreateProduct(ctx context.Context, request *requests.CreateProductRequest) (*db.CreateProductRow, error)
 	UpdateProduct(ctx context.Context, request *requests.UpdateProductRequest) (*db.UpdateProductRow, error)
-	UpdateProductCountStock(ctx context.Context, product_id int, stock int) (*db.UpdateProductCountStockRow, error)
+
+	FindLowStock(ctx context.Context, req *requests.FindLowStockProducts) ([]*db.GetLowStockProductsRow, error)
+	UpdateReorderLevel(ctx context.Context, request *requests.UpdateProductReorderLevelRequest) (*db.UpdateProductReorderLevelRow, error)
+	ClaimLowStockAlerts(ctx context.Context, limit int) ([]*db.ClaimLowStockAlertsRow, error)
+	ReleaseLowStockAlerts(ctx context.Context, product_ids []int) error
+	ResetLowStockAlerts(ctx context.Context) (int64, error)
 
 	TrashedProduct(ctx context.Context, product_id int) (*db.Product, error)
 	RestoreProduct(ctx context.Context, product_id int) (*db.Product, error)
 	DeleteProductPermanent(ctx context.Context, product_id int) (bool, error)
 	RestoreAllProducts(ctx context.Context) (bool, error)
 	DeleteAllProductPermanent(ctx context.Context) (bool, error)
+
+	CountByImage(ctx context.Context, image string) (int, error)
+	FindTrashedImages(ctx context.Context) ([]string, error)
+}
+
+type ProductVariantRepository interface {
+	FindOptions(ctx context.Context, product_id int) ([]*db.ProductOption, error)
+	ReplaceOptions(ctx context.Context, product_id int, options []requests.ProductOptionRequest) ([]*db.ProductOption, error)
+	FindByProduct(ctx context.Context, product_id int) ([]*db.ProductVariant, error)
+	FindById(ctx context.Context, variant_id int) (*db.ProductVariant, error)
+	CountByProduct(ctx context.Context, product_id int) (int, error)
+	CreateVariant(ctx context.Context, req *requests.CreateProductVariantRecordRequest) (*db.ProductVariant, error)
+	UpdateVariant(ctx context.Context, req *requests.UpdateProductVariantRecordRequest) (*db.ProductVariant, error)
+	TrashVariant(ctx context.Context, variant_id int) (*db.ProductVariant, error)
+}
+
+type StockMovementRepository interface {
+	FindByProduct(ctx context.Context, req *requests.FindStockMovementsRequest) ([]*db.GetStockMovementsByProductRow, error)
+	CreateOpeningBalance(ctx context.Context, product_id int, user_id *int) (*db.StockMovement, error)
+	RecordMovement(ctx context.Context, request *requests.CreateStockMovementRecordRequest) (*db.StockMovement, error)
 }
 
 type TransactionRepository interface {
@@ -204,6 +253,7 @@ type TransactionRepository interface {
 	FindByTrashed(ctx context.Context, req *requests.FindAllTransaction) ([]*db.GetTransactionsTrashedRow, error)
 	FindByMerchant(ctx context.Context, req *requests.FindAllTransactionByMerchant) ([]*db.GetTransactionByMerchantRow, error)
 	FindById(ctx context.Context, transaction_id int) (*db.GetTransactionByIDRow, error)
+	FindByIdForUpdate(ctx context.Context, transaction_id int) (*db.GetTransactionForUpdateRow, error)
 	FindByOrderId(ctx context.Context, order_id int) (*db.GetTransactionByOrderIDRow, error)
 
 	GetMonthlyAmountSuccess(ctx context.Context, req *requests.MonthAmountTransaction) ([]*db.GetMonthlyAmountTransactionSuccessRow, error)
@@ -226,9 +276,74 @@ type TransactionRepository interface {
 
 	CreateTransaction(ctx context.Context, request *requests.CreateTransactionRequest) (*db.CreateTransactionRow, error)
 	UpdateTransaction(ctx context.Context, request *requests.UpdateTransactionRequest) (*db.UpdateTransactionRow, error)
+	FindPayments(ctx context.Context, transaction_id int) ([]*db.TransactionPayment, error)
+	CreatePayment(ctx context.Context, request *requests.CreateTransactionPaymentRecordRequest) (*db.TransactionPayment, error)
+	DeletePayments(ctx context.Context, transaction_id int) error
+	UpdateTransactionStatus(ctx context.Context, transaction_id int, status string) (*db.Transaction, error)
 	TrashTransaction(ctx context.Context, transaction_id int) (*db.Transaction, error)
 	RestoreTransaction(ctx context.Context, transaction_id int) (*db.Transaction, error)
 	DeleteTransactionPermanently(ctx context.Context, transaction_id int) (bool, error)
 	RestoreAllTransactions(ctx context.Context) (bool, error)
 	DeleteAllTransactionPermanent(ctx context.Context) (bool, error)
 }
+
+type TransactionRefundRepository interface {
+	FindByTransaction(ctx context.Context, transaction_id int) ([]*db.TransactionRefund, error)
+	FindRefundedQuantities(ctx context.Context, transaction_id int) (map[int]int, error)
+	CreateRefund(ctx context.Context, request *requests.CreateTransactionRefundRecordRequest) (*db.TransactionRefund, error)
+	CreateRefundItem(ctx context.Context, request *requests.CreateTransactionRefundItemRecordRequest) (*db.TransactionRefundItem, error)
+}
+
+type TaxRateRepository interface {
+	FindAllTaxRates(ctx context.Context, req *requests.FindAllTaxRates) ([]*db.GetTaxRatesRow, error)
+	FindById(ctx context.Context, tax_rate_id int) (*db.TaxRate, error)
+	FindApplicable(ctx context.Context, merchant_id int, category_id int) (*db.TaxRate, error)
+	CreateTaxRate(ctx context.Context, req *requests.CreateTaxRateRequest) (*db.TaxRate, error)
+	UpdateTaxRate(ctx context.Context, req *requests.UpdateTaxRateRequest) (*db.TaxRate, error)
+	TrashedTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error)
+	RestoreTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error)
+	DeleteTaxRatePermanent(ctx context.Context, tax_rate_id int) (bool, error)
+}
+
+type IdempotencyKeyRepository interface {
+	Reserve(ctx context.Context, req *requests.ReserveIdempotencyKeyRecordRequest) (*db.IdempotencyKey, error)
+	FindByKey(ctx context.Context, user_id int, method string, key string) (*db.IdempotencyKey, error)
+	Complete(ctx context.Context, idempotency_key_id int, response []byte) (*db.IdempotencyKey, error)
+	Release(ctx context.Context, idempotency_key_id int) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type SupplierRepository interface {
+	FindAllSuppliers(ctx context.Context, req *requests.FindAllSuppliers) ([]*db.GetSuppliersRow, error)
+	FindById(ctx context.Context, supplier_id int) (*db.Supplier, error)
+	CreateSupplier(ctx context.Context, req *requests.CreateSupplierRequest) (*db.Supplier, error)
+	UpdateSupplier(ctx context.Context, req *requests.UpdateSupplierRequest) (*db.Supplier, error)
+	TrashedSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error)
+	RestoreSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error)
+	DeleteSupplierPermanent(ctx context.Context, supplier_id int) (bool, error)
+}
+
+type PurchaseOrderRepository interface {
+	FindAllPurchaseOrders(ctx context.Context, req *requests.FindAllPurchaseOrders) ([]*db.GetPurchaseOrdersRow, error)
+	FindById(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error)
+	FindItems(ctx context.Context, purchase_order_id int) ([]*db.PurchaseOrderItem, error)
+	FindReceipts(ctx context.Context, purchase_order_id int) ([]*db.GetPurchaseOrderReceiptsRow, error)
+	CreatePurchaseOrder(ctx context.Context, req *requests.CreatePurchaseOrderRecordRequest) (*db.PurchaseOrder, error)
+	CreatePurchaseOrderItem(ctx context.Context, req *requests.CreatePurchaseOrderItemRecordRequest) (*db.PurchaseOrderItem, error)
+	UpdatePurchaseOrderStatus(ctx context.Context, purchase_order_id int, from string, to string) (*db.PurchaseOrder, error)
+	ReceiveItem(ctx context.Context, purchase_order_id int, purchase_order_item_id int, quantity int) (*db.PurchaseOrderItem, error)
+	CreateReceipt(ctx context.Context, req *requests.CreatePurchaseOrderReceiptRecordRequest) (*db.PurchaseOrderReceipt, error)
+}
+
+type StocktakeRepository interface {
+	FindAllStocktakes(ctx context.Context, req *requests.FindAllStocktakes) ([]*db.GetStocktakesRow, error)
+	FindById(ctx context.Context, stocktake_id int) (*db.Stocktake, error)
+	LockForCount(ctx context.Context, stocktake_id int) (*db.Stocktake, error)
+	FindItems(ctx context.Context, req *requests.FindStocktakeItems) ([]*db.GetStocktakeItemsRow, error)
+	FindCountedItems(ctx context.Context, stocktake_id int) ([]*db.StocktakeItem, error)
+	FindVarianceByCategory(ctx context.Context, stocktake_id int) ([]*db.GetStocktakeVarianceByCategoryRow, error)
+	CreateStocktake(ctx context.Context, req *requests.CreateStocktakeRecordRequest) (*db.Stocktake, error)
+	CreateItems(ctx context.Context, stocktake_id int) (int64, error)
+	UpdateCount(ctx context.Context, req *requests.UpdateStocktakeCountRecordRequest) (*db.StocktakeItem, error)
+	UpdateStocktakeStatus(ctx context.Context, stocktake_id int, from string, to string, posted_by *int) (*db.Stocktake, error)
+}
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors/merchant_errors"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type merchantRepository struct {
@@ -75,12 +78,33 @@ func (r *merchantRepository) FindById(ctx context.Context, user_id int) (*db.Get
 	res, err := r.db.GetMerchantByID(ctx, int32(user_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, merchant_errors.ErrMerchantNotFound
+		}
+
 		return nil, merchant_errors.ErrFindById
 	}
 
 	return res, nil
 }
 
+// FindScopeByUser returns the merchants user_id owns or works at as a
+// cashier. It must be called without a merchant scope.
+func (r *merchantRepository) FindScopeByUser(ctx context.Context, user_id int) ([]int, error) {
+	res, err := r.db.GetMerchantScopeByUser(ctx, int32(user_id))
+
+	if err != nil {
+		return nil, merchant_errors.ErrFindScopeByUser
+	}
+
+	merchantIDs := make([]int, len(res))
+	for i, id := range res {
+		merchantIDs[i] = int(id)
+	}
+
+	return merchantIDs, nil
+}
+
 func (r *merchantRepository) CreateMerchant(ctx context.Context, request *requests.CreateMerchantRequest) (*db.CreateMerchantRow, error) {
 	req := db.CreateMerchantParams{
 		UserID:       int32(request.UserID),
@@ -115,16 +139,42 @@ func (r *merchantRepository) UpdateMerchant(ctx context.Context, request *reques
 	res, err := r.db.UpdateMerchant(ctx, req)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, merchant_errors.ErrMerchantNotFound
+		}
+
 		return nil, merchant_errors.ErrUpdateMerchant
 	}
 
 	return res, nil
 }
 
+func (r *merchantRepository) UpdateBarcodeSettings(ctx context.Context, request *requests.UpdateMerchantBarcodeSettingsRequest) (*db.UpdateMerchantBarcodeSettingsRow, error) {
+	res, err := r.db.UpdateMerchantBarcodeSettings(ctx, db.UpdateMerchantBarcodeSettingsParams{
+		MerchantID:    int32(*request.MerchantID),
+		Gs1Prefix:     request.Gs1Prefix,
+		BarcodeFormat: request.BarcodeFormat,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, merchant_errors.ErrMerchantNotFound
+		}
+
+		return nil, merchant_errors.ErrUpdateMerchantBarcodeSettings
+	}
+
+	return res, nil
+}
+
 func (r *merchantRepository) TrashedMerchant(ctx context.Context, merchant_id int) (*db.Merchant, error) {
 	res, err := r.db.TrashMerchant(ctx, int32(merchant_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, merchant_errors.ErrMerchantNotFound
+		}
+
 		return nil, merchant_errors.ErrTrashedMerchant
 	}
 
@@ -135,6 +185,10 @@ func (r *merchantRepository) RestoreMerchant(ctx context.Context, merchant_id in
 	res, err := r.db.RestoreMerchant(ctx, int32(merchant_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, merchant_errors.ErrMerchantNotFound
+		}
+
 		return nil, merchant_errors.ErrRestoreMerchant
 	}
 
@@ -142,12 +196,16 @@ func (r *merchantRepository) RestoreMerchant(ctx context.Context, merchant_id in
 }
 
 func (r *merchantRepository) DeleteMerchantPermanent(ctx context.Context, merchant_id int) (bool, error) {
-	err := r.db.DeleteMerchantPermanently(ctx, int32(merchant_id))
+	deleted, err := r.db.DeleteMerchantPermanently(ctx, int32(merchant_id))
 
 	if err != nil {
 		return false, merchant_errors.ErrDeleteMerchantPermanent
 	}
 
+	if deleted == 0 {
+		return false, merchant_errors.ErrMerchantNotFound
+	}
+
 	return true, nil
 }
 
@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors/order_errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -27,6 +29,7 @@ func (r *orderRepository) FindAllOrders(ctx context.Context, req *requests.FindA
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
 		Offset:  int32(offset),
+		Column4: req.Status,
 	}
 
 	res, err := r.db.GetOrders(ctx, reqDb)
@@ -45,6 +48,7 @@ func (r *orderRepository) FindByActive(ctx context.Context, req *requests.FindAl
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
 		Offset:  int32(offset),
+		Column4: req.Status,
 	}
 
 	res, err := r.db.GetOrdersActive(ctx, reqDb)
@@ -63,6 +67,7 @@ func (r *orderRepository) FindByTrashed(ctx context.Context, req *requests.FindA
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
 		Offset:  int32(offset),
+		Column4: req.Status,
 	}
 
 	res, err := r.db.GetOrdersTrashed(ctx, reqDb)
@@ -81,6 +86,8 @@ func (r *orderRepository) FindByMerchant(ctx context.Context, req *requests.Find
 		Column1: req.Search,
 		Limit:   int32(req.PageSize),
 		Offset:  int32(offset),
+		Column4: int32(req.MerchantID),
+		Column5: req.Status,
 	}
 
 	res, err := r.db.GetOrdersByMerchant(ctx, reqDb)
@@ -305,6 +312,10 @@ func (r *orderRepository) FindById(ctx context.Context, order_id int) (*db.GetOr
 	res, err := r.db.GetOrderByID(ctx, int32(order_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order_errors.ErrOrderNotFound
+		}
+
 		return nil, order_errors.ErrFindById
 	}
 
@@ -315,6 +326,10 @@ func (r *orderRepository) FindByIdTrashed(ctx context.Context, user_id int) (*db
 	res, err := r.db.GetOrderByIDTrashed(ctx, int32(user_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order_errors.ErrOrderNotFound
+		}
+
 		return nil, order_errors.ErrFindById
 	}
 
@@ -346,16 +361,44 @@ func (r *orderRepository) UpdateOrder(ctx context.Context, request *requests.Upd
 	res, err := r.db.UpdateOrder(ctx, req)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order_errors.ErrOrderNotFound
+		}
+
 		return nil, order_errors.ErrUpdateOrder
 	}
 
 	return res, nil
 }
 
+// UpdateOrderStatus moves an order from one status to another. It fails with
+// ErrOrderStatusChanged when the order is no longer in the from status.
+func (r *orderRepository) UpdateOrderStatus(ctx context.Context, order_id int, from string, to string) (*db.Order, error) {
+	res, err := r.db.UpdateOrderStatus(ctx, db.UpdateOrderStatusParams{
+		OrderID:  int32(order_id),
+		Status:   from,
+		Status_2: to,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order_errors.ErrOrderStatusChanged
+		}
+
+		return nil, order_errors.ErrUpdateOrderStatus
+	}
+
+	return res, nil
+}
+
 func (r *orderRepository) TrashedOrder(ctx context.Context, order_id int) (*db.Order, error) {
 	res, err := r.db.TrashedOrder(ctx, int32(order_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order_errors.ErrOrderNotFound
+		}
+
 		return nil, order_errors.ErrTrashedOrder
 	}
 
@@ -366,6 +409,10 @@ func (r *orderRepository) RestoreOrder(ctx context.Context, order_id int) (*db.O
 	res, err := r.db.RestoreOrder(ctx, int32(order_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, order_errors.ErrOrderNotFound
+		}
+
 		return nil, order_errors.ErrRestoreOrder
 	}
 
@@ -373,12 +420,16 @@ func (r *orderRepository) RestoreOrder(ctx context.Context, order_id int) (*db.O
 }
 
 func (r *orderRepository) DeleteOrderPermanent(ctx context.Context, order_id int) (bool, error) {
-	err := r.db.DeleteOrderPermanently(ctx, int32(order_id))
+	deleted, err := r.db.DeleteOrderPermanently(ctx, int32(order_id))
 
 	if err != nil {
 		return false, order_errors.ErrDeleteOrderPermanent
 	}
 
+	if deleted == 0 {
+		return false, order_errors.ErrOrderNotFound
+	}
+
 	return true, nil
 }
 
@@ -91,6 +91,16 @@ func (r *orderItemRepository) FindOrderItemByOrderTrashed(ctx context.Context, o
 	return res, nil
 }
 
+func (r *orderItemRepository) FindOrderItemTaxLines(ctx context.Context, order_id int) ([]*db.GetOrderItemTaxLinesRow, error) {
+	res, err := r.db.GetOrderItemTaxLines(ctx, int32(order_id))
+
+	if err != nil {
+		return nil, orderitem_errors.ErrFindOrderItemTaxLines
+	}
+
+	return res, nil
+}
+
 func (r *orderItemRepository) CalculateTotalPrice(ctx context.Context, order_id int) (*int32, error) {
 	res, err := r.db.CalculateTotalPrice(ctx, int32(order_id))
 
@@ -108,6 +118,7 @@ func (r *orderItemRepository) CreateOrderItem(ctx context.Context, req *requests
 		ProductID: int32(req.ProductID),
 		Quantity:  int32(req.Quantity),
 		Price:     int32(req.Price),
+		VariantID: toInt32Ptr(req.VariantID),
 	})
 
 	if err != nil {
@@ -122,6 +133,8 @@ func (r *orderItemRepository) UpdateOrderItem(ctx context.Context, req *requests
 		OrderItemID: int32(req.OrderItemID),
 		Quantity:    int32(req.Quantity),
 		Price:       int32(req.Price),
+		ProductID:   int32(req.ProductID),
+		VariantID:   toInt32Ptr(req.VariantID),
 	})
 
 	if err != nil {
@@ -131,6 +144,23 @@ func (r *orderItemRepository) UpdateOrderItem(ctx context.Context, req *requests
 	return res, nil
 }
 
+func (r *orderItemRepository) UpdateOrderItemTax(ctx context.Context, req *requests.UpdateOrderItemTaxRequest) error {
+	err := r.db.UpdateOrderItemTax(ctx, db.UpdateOrderItemTaxParams{
+		OrderItemID:  int32(req.OrderItemID),
+		TaxRateID:    toInt32Ptr(req.TaxRateID),
+		TaxRateBps:   int32(req.TaxRateBps),
+		TaxInclusive: req.TaxInclusive,
+		TaxRounding:  req.TaxRounding,
+		TaxAmount:    int32(req.TaxAmount),
+	})
+
+	if err != nil {
+		return orderitem_errors.ErrUpdateOrderItemTax
+	}
+
+	return nil
+}
+
 func (r *orderItemRepository) TrashedOrderItem(ctx context.Context, order_id int) (*db.OrderItem, error) {
 	res, err := r.db.TrashOrderItem(ctx, int32(order_id))
 
@@ -2,11 +2,22 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors/product_errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// checkViolation is the SQLSTATE raised when a CHECK constraint such as
+// chk_products_count_in_stock_non_negative rejects a row.
+const checkViolation = "23514"
+
+// productBarcodeConstraint is the UNIQUE constraint on products.barcode.
+const productBarcodeConstraint = "products_barcode_key"
+
 type productRepository struct {
 	db *db.Queries
 }
@@ -103,6 +114,7 @@ func (r *productRepository) FindByCategory(ctx context.Context, req *requests.Pr
 		Column4: int32(req.MaxPrice),
 		Limit:   int32(req.PageSize),
 		Offset:  int32(offset),
+		Column7: req.IncludeSubcategories,
 	}
 
 	res, err := r.db.GetProductsByCategoryName(ctx, reqDb)
@@ -118,16 +130,72 @@ func (r *productRepository) FindById(ctx context.Context, product_id int) (*db.G
 	res, err := r.db.GetProductByID(ctx, int32(product_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrProductNotFound
+		}
+
 		return nil, product_errors.ErrFindById
 	}
 
 	return res, nil
 }
 
+// FindByBarcode resolves a scanned barcode to an active product. It fails
+// with ErrBarcodeNotFound when no active product carries the barcode.
+func (r *productRepository) FindByBarcode(ctx context.Context, barcode string) (*db.GetProductByBarcodeRow, error) {
+	res, err := r.db.GetProductByBarcode(ctx, &barcode)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrBarcodeNotFound
+		}
+
+		return nil, product_errors.ErrFindByBarcode
+	}
+
+	return res, nil
+}
+
+// FindByScan resolves a scanned barcode to an active product, or to one of
+// its variants when the barcode belongs to a variant. It fails with
+// ErrBarcodeNotFound when nothing active carries the barcode.
+func (r *productRepository) FindByScan(ctx context.Context, barcode string) (*db.GetProductByScanRow, error) {
+	res, err := r.db.GetProductByScan(ctx, &barcode)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrBarcodeNotFound
+		}
+
+		return nil, product_errors.ErrFindByBarcode
+	}
+
+	return res, nil
+}
+
+// FindForImport returns every product, trashed ones included, that carries
+// the barcode or the slug of an imported row.
+func (r *productRepository) FindForImport(ctx context.Context, barcode *string, slug *string) ([]*db.GetProductsForImportRow, error) {
+	res, err := r.db.GetProductsForImport(ctx, db.GetProductsForImportParams{
+		Barcode:     barcode,
+		SlugProduct: slug,
+	})
+
+	if err != nil {
+		return nil, product_errors.ErrFindForImport
+	}
+
+	return res, nil
+}
+
 func (r *productRepository) FindByIdTrashed(ctx context.Context, id int) (*db.GetProductByIdTrashedRow, error) {
 	res, err := r.db.GetProductByIdTrashed(ctx, int32(id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrProductNotFound
+		}
+
 		return nil, product_errors.ErrFindById
 	}
 
@@ -154,6 +222,10 @@ func (r *productRepository) CreateProduct(ctx context.Context, request *requests
 	product, err := r.db.CreateProduct(ctx, req)
 
 	if err != nil {
+		if isBarcodeViolation(err) {
+			return nil, product_errors.ErrDuplicateBarcode
+		}
+
 		return nil, product_errors.ErrCreateProduct
 	}
 
@@ -169,7 +241,6 @@ func (r *productRepository) UpdateProduct(ctx context.Context, request *requests
 		Name:         request.Name,
 		Description:  &request.Description,
 		Price:        int32(request.Price),
-		CountInStock: int32(request.CountInStock),
 		Brand:        &request.Brand,
 		Weight:       &weight,
 		ImageProduct: &request.ImageProduct,
@@ -179,20 +250,90 @@ func (r *productRepository) UpdateProduct(ctx context.Context, request *requests
 	res, err := r.db.UpdateProduct(ctx, req)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrProductNotFound
+		}
+
+		if isBarcodeViolation(err) {
+			return nil, product_errors.ErrDuplicateBarcode
+		}
+
 		return nil, product_errors.ErrUpdateProduct
 	}
 
 	return res, nil
 }
 
-func (r *productRepository) UpdateProductCountStock(ctx context.Context, product_id int, stock int) (*db.UpdateProductCountStockRow, error) {
-	res, err := r.db.UpdateProductCountStock(ctx, db.UpdateProductCountStockParams{
-		ProductID:    int32(product_id),
-		CountInStock: int32(stock),
+// FindLowStock lists the products of a merchant that are at or below their
+// reorder point.
+func (r *productRepository) FindLowStock(ctx context.Context, req *requests.FindLowStockProducts) ([]*db.GetLowStockProductsRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	res, err := r.db.GetLowStockProducts(ctx, db.GetLowStockProductsParams{
+		MerchantID: int32(req.MerchantID),
+		Limit:      int32(req.PageSize),
+		Offset:     int32(offset),
 	})
 
 	if err != nil {
-		return nil, product_errors.ErrUpdateProductCountStock
+		return nil, product_errors.ErrFindLowStock
+	}
+
+	return res, nil
+}
+
+func (r *productRepository) UpdateReorderLevel(ctx context.Context, request *requests.UpdateProductReorderLevelRequest) (*db.UpdateProductReorderLevelRow, error) {
+	res, err := r.db.UpdateProductReorderLevel(ctx, db.UpdateProductReorderLevelParams{
+		ProductID:       int32(*request.ProductID),
+		ReorderPoint:    int32(request.ReorderPoint),
+		ReorderQuantity: int32(request.ReorderQuantity),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrFindById
+		}
+
+		return nil, product_errors.ErrUpdateReorderLevel
+	}
+
+	return res, nil
+}
+
+// ClaimLowStockAlerts marks up to limit products that newly fell to their
+// reorder point as alerted and returns them.
+func (r *productRepository) ClaimLowStockAlerts(ctx context.Context, limit int) ([]*db.ClaimLowStockAlertsRow, error) {
+	res, err := r.db.ClaimLowStockAlerts(ctx, int32(limit))
+
+	if err != nil {
+		return nil, product_errors.ErrClaimLowStockAlerts
+	}
+
+	return res, nil
+}
+
+// ReleaseLowStockAlerts unmarks products whose alert was not delivered so
+// they are claimed again.
+func (r *productRepository) ReleaseLowStockAlerts(ctx context.Context, product_ids []int) error {
+	ids := make([]int32, 0, len(product_ids))
+	for _, id := range product_ids {
+		ids = append(ids, int32(id))
+	}
+
+	if err := r.db.ReleaseLowStockAlerts(ctx, ids); err != nil {
+		return product_errors.ErrReleaseLowStockAlerts
+	}
+
+	return nil
+}
+
+// ResetLowStockAlerts unmarks restocked products and returns how many there
+// were.
+func (r *productRepository) ResetLowStockAlerts(ctx context.Context) (int64, error) {
+	res, err := r.db.ResetLowStockAlerts(ctx)
+
+	if err != nil {
+		return 0, product_errors.ErrResetLowStockAlerts
 	}
 
 	return res, nil
@@ -202,6 +343,10 @@ func (r *productRepository) TrashedProduct(ctx context.Context, product_id int)
 	res, err := r.db.TrashProduct(ctx, int32(product_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrProductNotFound
+		}
+
 		return nil, product_errors.ErrTrashedProduct
 	}
 
@@ -212,6 +357,10 @@ func (r *productRepository) RestoreProduct(ctx context.Context, product_id int)
 	res, err := r.db.RestoreProduct(ctx, int32(product_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_errors.ErrProductNotFound
+		}
+
 		return nil, product_errors.ErrRestoreProduct
 	}
 
@@ -245,3 +394,46 @@ func (r *productRepository) DeleteAllProductPermanent(ctx context.Context) (bool
 	}
 	return true, nil
 }
+
+// CountByImage counts the products, trashed ones included, whose image is
+// stored under image.
+func (r *productRepository) CountByImage(ctx context.Context, image string) (int, error) {
+	count, err := r.db.CountProductsByImage(ctx, image)
+
+	if err != nil {
+		return 0, product_errors.ErrCountByImage
+	}
+
+	return int(count), nil
+}
+
+func (r *productRepository) FindTrashedImages(ctx context.Context) ([]string, error) {
+	res, err := r.db.GetTrashedProductImages(ctx)
+
+	if err != nil {
+		return nil, product_errors.ErrFindTrashedImages
+	}
+
+	images := make([]string, 0, len(res))
+	for _, image := range res {
+		if image != nil && *image != "" {
+			images = append(images, *image)
+		}
+	}
+
+	return images, nil
+}
+
+func isCheckViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == checkViolation
+}
+
+// isBarcodeViolation reports whether err is another product already
+// holding the barcode, as opposed to a clash on the slug.
+func isBarcodeViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == productBarcodeConstraint
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/product_variant_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type productVariantRepository struct {
+	db *db.Queries
+}
+
+func NewProductVariantRepository(db *db.Queries) *productVariantRepository {
+	return &productVariantRepository{
+		db: db,
+	}
+}
+
+func (r *productVariantRepository) FindOptions(ctx context.Context, product_id int) ([]*db.ProductOption, error) {
+	res, err := r.db.GetProductOptions(ctx, int32(product_id))
+
+	if err != nil {
+		return nil, product_variant_errors.ErrFindProductOptions
+	}
+
+	return res, nil
+}
+
+// ReplaceOptions swaps the options of a product for the given ones, keeping
+// their order. It should run inside a transaction so a failure leaves the
+// previous options in place.
+func (r *productVariantRepository) ReplaceOptions(ctx context.Context, product_id int, options []requests.ProductOptionRequest) ([]*db.ProductOption, error) {
+	if err := r.db.DeleteProductOptions(ctx, int32(product_id)); err != nil {
+		return nil, product_variant_errors.ErrReplaceProductOptions
+	}
+
+	res := make([]*db.ProductOption, 0, len(options))
+
+	for i, option := range options {
+		created, err := r.db.CreateProductOption(ctx, db.CreateProductOptionParams{
+			ProductID:    int32(product_id),
+			Name:         option.Name,
+			OptionValues: option.Values,
+			Position:     int32(i),
+		})
+
+		if err != nil {
+			if isUniqueViolation(err) {
+				return nil, product_variant_errors.ErrDuplicateOptionName
+			}
+
+			return nil, product_variant_errors.ErrReplaceProductOptions
+		}
+
+		res = append(res, created)
+	}
+
+	return res, nil
+}
+
+func (r *productVariantRepository) FindByProduct(ctx context.Context, product_id int) ([]*db.ProductVariant, error) {
+	res, err := r.db.GetProductVariants(ctx, int32(product_id))
+
+	if err != nil {
+		return nil, product_variant_errors.ErrFindProductVariants
+	}
+
+	return res, nil
+}
+
+func (r *productVariantRepository) FindById(ctx context.Context, variant_id int) (*db.ProductVariant, error) {
+	res, err := r.db.GetProductVariant(ctx, int32(variant_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_variant_errors.ErrVariantNotFound
+		}
+
+		return nil, product_variant_errors.ErrFindVariantById
+	}
+
+	return res, nil
+}
+
+func (r *productVariantRepository) CountByProduct(ctx context.Context, product_id int) (int, error) {
+	res, err := r.db.CountProductVariants(ctx, int32(product_id))
+
+	if err != nil {
+		return 0, product_variant_errors.ErrCountProductVariants
+	}
+
+	return int(res), nil
+}
+
+func (r *productVariantRepository) CreateVariant(ctx context.Context, req *requests.CreateProductVariantRecordRequest) (*db.ProductVariant, error) {
+	options, err := json.Marshal(req.Options)
+
+	if err != nil {
+		return nil, product_variant_errors.ErrCreateProductVariant
+	}
+
+	res, err := r.db.CreateProductVariant(ctx, db.CreateProductVariantParams{
+		ProductID: int32(req.ProductID),
+		Sku:       req.Sku,
+		Barcode:   req.Barcode,
+		Options:   options,
+		Price:     toInt32Ptr(req.Price),
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, product_variant_errors.ErrDuplicateVariant
+		}
+
+		return nil, product_variant_errors.ErrCreateProductVariant
+	}
+
+	return res, nil
+}
+
+func (r *productVariantRepository) UpdateVariant(ctx context.Context, req *requests.UpdateProductVariantRecordRequest) (*db.ProductVariant, error) {
+	res, err := r.db.UpdateProductVariant(ctx, db.UpdateProductVariantParams{
+		VariantID: int32(req.VariantID),
+		Sku:       req.Sku,
+		Barcode:   req.Barcode,
+		Price:     toInt32Ptr(req.Price),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, product_variant_errors.ErrVariantNotFound
+		}
+
+		if isUniqueViolation(err) {
+			return nil, product_variant_errors.ErrDuplicateVariant
+		}
+
+		return nil, product_variant_errors.ErrUpdateProductVariant
+	}
+
+	return res, nil
+}
+
+// TrashVariant soft-deletes a variant. Variants that still hold stock are
+// kept and ErrVariantHasStock is returned.
+func (r *productVariantRepository) TrashVariant(ctx context.Context, variant_id int) (*db.ProductVariant, error) {
+	res, err := r.db.TrashProductVariant(ctx, int32(variant_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if _, findErr := r.FindById(ctx, variant_id); findErr != nil {
+				return nil, findErr
+			}
+
+			return nil, product_variant_errors.ErrVariantHasStock
+		}
+
+		return nil, product_variant_errors.ErrTrashProductVariant
+	}
+
+	return res, nil
+}
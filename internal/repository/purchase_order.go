@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/purchase_order_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type purchaseOrderRepository struct {
+	db *db.Queries
+}
+
+func NewPurchaseOrderRepository(db *db.Queries) *purchaseOrderRepository {
+	return &purchaseOrderRepository{
+		db: db,
+	}
+}
+
+func (r *purchaseOrderRepository) FindAllPurchaseOrders(ctx context.Context, req *requests.FindAllPurchaseOrders) ([]*db.GetPurchaseOrdersRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetPurchaseOrdersParams{
+		Column1: int32(req.MerchantID),
+		Column2: int32(req.SupplierID),
+		Column3: req.Status,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	res, err := r.db.GetPurchaseOrders(ctx, reqDb)
+
+	if err != nil {
+		return nil, purchase_order_errors.ErrFindAllPurchaseOrders
+	}
+
+	return res, nil
+}
+
+func (r *purchaseOrderRepository) FindById(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error) {
+	res, err := r.db.GetPurchaseOrder(ctx, int32(purchase_order_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, purchase_order_errors.ErrPurchaseOrderNotFound
+		}
+
+		return nil, purchase_order_errors.ErrFindPurchaseOrderById
+	}
+
+	return res, nil
+}
+
+func (r *purchaseOrderRepository) FindItems(ctx context.Context, purchase_order_id int) ([]*db.PurchaseOrderItem, error) {
+	res, err := r.db.GetPurchaseOrderItems(ctx, int32(purchase_order_id))
+
+	if err != nil {
+		return nil, purchase_order_errors.ErrFindPurchaseOrderItems
+	}
+
+	return res, nil
+}
+
+func (r *purchaseOrderRepository) FindReceipts(ctx context.Context, purchase_order_id int) ([]*db.GetPurchaseOrderReceiptsRow, error) {
+	res, err := r.db.GetPurchaseOrderReceipts(ctx, int32(purchase_order_id))
+
+	if err != nil {
+		return nil, purchase_order_errors.ErrFindPurchaseOrderReceipts
+	}
+
+	return res, nil
+}
+
+func (r *purchaseOrderRepository) CreatePurchaseOrder(ctx context.Context, req *requests.CreatePurchaseOrderRecordRequest) (*db.PurchaseOrder, error) {
+	res, err := r.db.CreatePurchaseOrder(ctx, db.CreatePurchaseOrderParams{
+		MerchantID: int32(req.MerchantID),
+		SupplierID: int32(req.SupplierID),
+		Note:       req.Note,
+		CreatedBy:  toInt32Ptr(req.CreatedBy),
+	})
+
+	if err != nil {
+		return nil, purchase_order_errors.ErrCreatePurchaseOrder
+	}
+
+	return res, nil
+}
+
+func (r *purchaseOrderRepository) CreatePurchaseOrderItem(ctx context.Context, req *requests.CreatePurchaseOrderItemRecordRequest) (*db.PurchaseOrderItem, error) {
+	res, err := r.db.CreatePurchaseOrderItem(ctx, db.CreatePurchaseOrderItemParams{
+		PurchaseOrderID: int32(req.PurchaseOrderID),
+		ProductID:       int32(req.ProductID),
+		QuantityOrdered: int32(req.QuantityOrdered),
+		UnitCost:        int32(req.UnitCost),
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, purchase_order_errors.ErrDuplicatePurchaseOrderItem
+		}
+
+		return nil, purchase_order_errors.ErrCreatePurchaseOrderItem
+	}
+
+	return res, nil
+}
+
+// UpdatePurchaseOrderStatus moves a purchase order from one status to
+// another. It fails with ErrPurchaseOrderStatusChanged when the purchase
+// order is no longer in the from status.
+func (r *purchaseOrderRepository) UpdatePurchaseOrderStatus(ctx context.Context, purchase_order_id int, from string, to string) (*db.PurchaseOrder, error) {
+	res, err := r.db.UpdatePurchaseOrderStatus(ctx, db.UpdatePurchaseOrderStatusParams{
+		PurchaseOrderID: int32(purchase_order_id),
+		Status:          from,
+		Status_2:        to,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, purchase_order_errors.ErrPurchaseOrderStatusChanged
+		}
+
+		return nil, purchase_order_errors.ErrUpdatePurchaseOrderStatus
+	}
+
+	return res, nil
+}
+
+// ReceiveItem adds quantity to the units received on a line of the
+// purchase order. It fails with ErrOverReceived when the line does not
+// belong to the purchase order or would end up over-received.
+func (r *purchaseOrderRepository) ReceiveItem(ctx context.Context, purchase_order_id int, purchase_order_item_id int, quantity int) (*db.PurchaseOrderItem, error) {
+	res, err := r.db.ReceivePurchaseOrderItem(ctx, db.ReceivePurchaseOrderItemParams{
+		PurchaseOrderItemID: int32(purchase_order_item_id),
+		PurchaseOrderID:     int32(purchase_order_id),
+		QuantityReceived:    int32(quantity),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || isCheckViolation(err) {
+			return nil, purchase_order_errors.ErrOverReceived
+		}
+
+		return nil, purchase_order_errors.ErrReceivePurchaseOrderItem
+	}
+
+	return res, nil
+}
+
+func (r *purchaseOrderRepository) CreateReceipt(ctx context.Context, req *requests.CreatePurchaseOrderReceiptRecordRequest) (*db.PurchaseOrderReceipt, error) {
+	res, err := r.db.CreatePurchaseOrderReceipt(ctx, db.CreatePurchaseOrderReceiptParams{
+		PurchaseOrderItemID: int32(req.PurchaseOrderItemID),
+		Quantity:            int32(req.Quantity),
+		UnitCost:            int32(req.UnitCost),
+		ReceivedBy:          toInt32Ptr(req.ReceivedBy),
+		Note:                req.Note,
+	})
+
+	if err != nil {
+		return nil, purchase_order_errors.ErrCreatePurchaseOrderReceipt
+	}
+
+	return res, nil
+}
@@ -2,10 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
 	refreshtoken_errors "pointofsale/pkg/errors/refresh_token_errors"
 	"time"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type refreshTokenRepository struct {
@@ -38,40 +41,53 @@ func (r *refreshTokenRepository) FindByUserId(ctx context.Context, user_id int)
 	return res, nil
 }
 
-func (r *refreshTokenRepository) CreateRefreshToken(ctx context.Context, req *requests.CreateRefreshToken) (*db.RefreshToken, error) {
-	layout := "2006-01-02 15:04:05"
-	expirationTime, err := time.Parse(layout, req.ExpiresAt)
+func (r *refreshTokenRepository) FindByAccessTokenId(ctx context.Context, user_id int, access_token_id string) (*db.RefreshToken, error) {
+	res, err := r.db.FindRefreshTokenByAccessTokenId(ctx, db.FindRefreshTokenByAccessTokenIdParams{
+		UserID:        int32(user_id),
+		AccessTokenID: access_token_id,
+	})
+
 	if err != nil {
-		return nil, refreshtoken_errors.ErrParseDate
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, refreshtoken_errors.ErrTokenNotFound
+		}
+
+		return nil, refreshtoken_errors.ErrFindByAccessTokenID
 	}
 
-	res, err := r.db.CreateRefreshToken(ctx, db.CreateRefreshTokenParams{
-		UserID:     int32(req.UserId),
-		Token:      req.Token,
-		Expiration: expirationTime,
-	})
+	return res, nil
+}
+
+func (r *refreshTokenRepository) FindActiveByUserId(ctx context.Context, user_id int) ([]*db.RefreshToken, error) {
+	res, err := r.db.FindActiveRefreshTokensByUserId(ctx, int32(user_id))
 
 	if err != nil {
-		return nil, refreshtoken_errors.ErrCreateRefreshToken
+		return nil, refreshtoken_errors.ErrFindActiveByUserID
 	}
 
 	return res, nil
 }
 
-func (r *refreshTokenRepository) UpdateRefreshToken(ctx context.Context, req *requests.UpdateRefreshToken) (*db.RefreshToken, error) {
+func (r *refreshTokenRepository) CreateRefreshToken(ctx context.Context, req *requests.CreateRefreshToken) (*db.RefreshToken, error) {
 	layout := "2006-01-02 15:04:05"
 	expirationTime, err := time.Parse(layout, req.ExpiresAt)
 	if err != nil {
 		return nil, refreshtoken_errors.ErrParseDate
 	}
 
-	res, err := r.db.UpdateRefreshTokenByUserId(ctx, db.UpdateRefreshTokenByUserIdParams{
-		UserID:     int32(req.UserId),
-		Token:      req.Token,
-		Expiration: expirationTime,
+	res, err := r.db.CreateRefreshToken(ctx, db.CreateRefreshTokenParams{
+		UserID:           int32(req.UserId),
+		Token:            req.Token,
+		Expiration:       expirationTime,
+		SessionID:        req.SessionID,
+		AccessTokenID:    req.AccessTokenID,
+		UserAgent:        req.UserAgent,
+		IpAddress:        req.IPAddress,
+		SessionStartedAt: req.SessionStartedAt,
 	})
+
 	if err != nil {
-		return nil, refreshtoken_errors.ErrUpdateRefreshToken
+		return nil, refreshtoken_errors.ErrCreateRefreshToken
 	}
 
 	return res, nil
@@ -96,3 +112,52 @@ func (r *refreshTokenRepository) DeleteRefreshTokenByUserId(ctx context.Context,
 
 	return nil
 }
+
+// RevokeRefreshToken marks a token as used. It returns ErrTokenRevoked when the
+// token was already revoked, which means it is being reused.
+func (r *refreshTokenRepository) RevokeRefreshToken(ctx context.Context, refresh_token_id int) (*db.RefreshToken, error) {
+	res, err := r.db.RevokeRefreshToken(ctx, int32(refresh_token_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, refreshtoken_errors.ErrTokenRevoked
+		}
+
+		return nil, refreshtoken_errors.ErrRevokeRefreshToken
+	}
+
+	return res, nil
+}
+
+func (r *refreshTokenRepository) RevokeSession(ctx context.Context, user_id int, session_id string) ([]*db.RefreshToken, error) {
+	res, err := r.db.RevokeRefreshTokenSession(ctx, db.RevokeRefreshTokenSessionParams{
+		UserID:    int32(user_id),
+		SessionID: session_id,
+	})
+
+	if err != nil {
+		return nil, refreshtoken_errors.ErrRevokeSession
+	}
+
+	return res, nil
+}
+
+func (r *refreshTokenRepository) RevokeAllByUserId(ctx context.Context, user_id int) ([]*db.RefreshToken, error) {
+	res, err := r.db.RevokeRefreshTokensByUserId(ctx, int32(user_id))
+
+	if err != nil {
+		return nil, refreshtoken_errors.ErrRevokeByUserID
+	}
+
+	return res, nil
+}
+
+func (r *refreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	res, err := r.db.DeleteExpiredRefreshTokens(ctx)
+
+	if err != nil {
+		return 0, refreshtoken_errors.ErrDeleteExpiredRefreshTokens
+	}
+
+	return res, nil
+}
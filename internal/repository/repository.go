@@ -2,34 +2,62 @@ package repository
 
 import (
 	db "pointofsale/pkg/database/schema"
+
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Repositories struct {
-	User         UserRepository
-	Role         RoleRepository
-	UserRole     UserRoleRepository
-	Category     CategoryRepository
-	RefreshToken RefreshTokenRepository
-	Cashier      CashierRepository
-	Product      ProductRepository
-	Merchant     MerchantRepository
-	OrderItem    OrderItemRepository
-	Order        OrderRepository
-	Transaction  TransactionRepository
+	User              UserRepository
+	Role              RoleRepository
+	UserRole          UserRoleRepository
+	Category          CategoryRepository
+	RefreshToken      RefreshTokenRepository
+	Cashier           CashierRepository
+	Product           ProductRepository
+	ProductVariant    ProductVariantRepository
+	StockMovement     StockMovementRepository
+	Merchant          MerchantRepository
+	OrderItem         OrderItemRepository
+	Order             OrderRepository
+	Transaction       TransactionRepository
+	TransactionRefund TransactionRefundRepository
+	TaxRate           TaxRateRepository
+	IdempotencyKey    IdempotencyKeyRepository
+	Supplier          SupplierRepository
+	PurchaseOrder     PurchaseOrderRepository
+	Stocktake         StocktakeRepository
+	UnitOfWork        UnitOfWork
+}
+
+func NewRepositories(pool *pgxpool.Pool) *Repositories {
+	queries := db.New(pool)
+
+	repos := newRepositories(queries)
+	repos.UnitOfWork = NewUnitOfWork(pool, queries)
+
+	return repos
 }
 
-func NewRepositories(db *db.Queries) *Repositories {
+func newRepositories(db *db.Queries) *Repositories {
 	return &Repositories{
-		User:         NewUserRepository(db),
-		Role:         NewRoleRepository(db),
-		UserRole:     NewUserRoleRepository(db),
-		Category:     NewCategoryRepository(db),
-		RefreshToken: NewRefreshTokenRepository(db),
-		Cashier:      NewCashierRepository(db),
-		Product:      NewProductRepository(db),
-		Merchant:     NewMerchantRepository(db),
-		OrderItem:    NewOrderItemRepository(db),
-		Order:        NewOrderRepository(db),
-		Transaction:  NewTransactionRepository(db),
+		User:              NewUserRepository(db),
+		Role:              NewRoleRepository(db),
+		UserRole:          NewUserRoleRepository(db),
+		Category:          NewCategoryRepository(db),
+		RefreshToken:      NewRefreshTokenRepository(db),
+		Cashier:           NewCashierRepository(db),
+		Product:           NewProductRepository(db),
+		ProductVariant:    NewProductVariantRepository(db),
+		StockMovement:     NewStockMovementRepository(db),
+		Merchant:          NewMerchantRepository(db),
+		OrderItem:         NewOrderItemRepository(db),
+		Order:             NewOrderRepository(db),
+		Transaction:       NewTransactionRepository(db),
+		TransactionRefund: NewTransactionRefundRepository(db),
+		TaxRate:           NewTaxRateRepository(db),
+		IdempotencyKey:    NewIdempotencyKeyRepository(db),
+		Supplier:          NewSupplierRepository(db),
+		PurchaseOrder:     NewPurchaseOrderRepository(db),
+		Stocktake:         NewStocktakeRepository(db),
 	}
 }
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/product_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type stockMovementRepository struct {
+	db *db.Queries
+}
+
+func NewStockMovementRepository(db *db.Queries) *stockMovementRepository {
+	return &stockMovementRepository{
+		db: db,
+	}
+}
+
+func (r *stockMovementRepository) FindByProduct(ctx context.Context, req *requests.FindStockMovementsRequest) ([]*db.GetStockMovementsByProductRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	res, err := r.db.GetStockMovementsByProduct(ctx, db.GetStockMovementsByProductParams{
+		ProductID: int32(req.ProductID),
+		Limit:     int32(req.PageSize),
+		Offset:    int32(offset),
+	})
+
+	if err != nil {
+		return nil, product_errors.ErrFindStockMovements
+	}
+
+	return res, nil
+}
+
+// CreateOpeningBalance records the stock a product currently holds as the
+// first entry of its ledger.
+func (r *stockMovementRepository) CreateOpeningBalance(ctx context.Context, product_id int, user_id *int) (*db.StockMovement, error) {
+	res, err := r.db.CreateOpeningStockMovement(ctx, db.CreateOpeningStockMovementParams{
+		ProductID: int32(product_id),
+		UserID:    toInt32Ptr(user_id),
+	})
+
+	if err != nil {
+		return nil, product_errors.ErrCreateStockMovement
+	}
+
+	return res, nil
+}
+
+// RecordMovement applies the delta to count_in_stock and appends it to the
+// ledger in one statement. A movement that names a variant changes the stock
+// of the variant and of its product together. A movement that would leave
+// the product or variant with negative stock fails with ErrInsufficientStock.
+func (r *stockMovementRepository) RecordMovement(ctx context.Context, request *requests.CreateStockMovementRecordRequest) (*db.StockMovement, error) {
+	res, err := r.db.RecordStockMovement(ctx, db.RecordStockMovementParams{
+		ProductID:     int32(request.ProductID),
+		QuantityDelta: int32(request.QuantityDelta),
+		Reason:        request.Reason,
+		ReferenceType: request.ReferenceType,
+		ReferenceID:   toInt32Ptr(request.ReferenceID),
+		UserID:        toInt32Ptr(request.UserID),
+		Note:          request.Note,
+		VariantID:     toInt32Ptr(request.VariantID),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) && request.QuantityDelta >= 0 {
+			return nil, product_errors.ErrFindById
+		}
+
+		if errors.Is(err, pgx.ErrNoRows) || isCheckViolation(err) {
+			return nil, product_errors.ErrInsufficientStock
+		}
+
+		return nil, product_errors.ErrCreateStockMovement
+	}
+
+	return res, nil
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/stocktake_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type stocktakeRepository struct {
+	db *db.Queries
+}
+
+func NewStocktakeRepository(db *db.Queries) *stocktakeRepository {
+	return &stocktakeRepository{
+		db: db,
+	}
+}
+
+func (r *stocktakeRepository) FindAllStocktakes(ctx context.Context, req *requests.FindAllStocktakes) ([]*db.GetStocktakesRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetStocktakesParams{
+		Column1: int32(req.MerchantID),
+		Column2: req.Status,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	res, err := r.db.GetStocktakes(ctx, reqDb)
+
+	if err != nil {
+		return nil, stocktake_errors.ErrFindAllStocktakes
+	}
+
+	return res, nil
+}
+
+func (r *stocktakeRepository) FindById(ctx context.Context, stocktake_id int) (*db.Stocktake, error) {
+	res, err := r.db.GetStocktake(ctx, int32(stocktake_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, stocktake_errors.ErrStocktakeNotFound
+		}
+
+		return nil, stocktake_errors.ErrFindStocktakeById
+	}
+
+	return res, nil
+}
+
+// LockForCount loads a stocktake and holds a share lock on it until the
+// surrounding transaction ends, so it cannot be posted or cancelled while
+// counts are being recorded against it.
+func (r *stocktakeRepository) LockForCount(ctx context.Context, stocktake_id int) (*db.Stocktake, error) {
+	res, err := r.db.LockStocktakeForCount(ctx, int32(stocktake_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, stocktake_errors.ErrStocktakeNotFound
+		}
+
+		return nil, stocktake_errors.ErrFindStocktakeById
+	}
+
+	return res, nil
+}
+
+func (r *stocktakeRepository) FindItems(ctx context.Context, req *requests.FindStocktakeItems) ([]*db.GetStocktakeItemsRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	res, err := r.db.GetStocktakeItems(ctx, db.GetStocktakeItemsParams{
+		StocktakeID: int32(req.StocktakeID),
+		Limit:       int32(req.PageSize),
+		Offset:      int32(offset),
+	})
+
+	if err != nil {
+		return nil, stocktake_errors.ErrFindStocktakeItems
+	}
+
+	return res, nil
+}
+
+func (r *stocktakeRepository) FindCountedItems(ctx context.Context, stocktake_id int) ([]*db.StocktakeItem, error) {
+	res, err := r.db.GetCountedStocktakeItems(ctx, int32(stocktake_id))
+
+	if err != nil {
+		return nil, stocktake_errors.ErrFindCountedItems
+	}
+
+	return res, nil
+}
+
+func (r *stocktakeRepository) FindVarianceByCategory(ctx context.Context, stocktake_id int) ([]*db.GetStocktakeVarianceByCategoryRow, error) {
+	res, err := r.db.GetStocktakeVarianceByCategory(ctx, int32(stocktake_id))
+
+	if err != nil {
+		return nil, stocktake_errors.ErrFindVarianceByCategory
+	}
+
+	return res, nil
+}
+
+// CreateStocktake opens a stocktake. It fails with ErrStocktakeAlreadyOpen
+// when the merchant already has an open stocktake.
+func (r *stocktakeRepository) CreateStocktake(ctx context.Context, req *requests.CreateStocktakeRecordRequest) (*db.Stocktake, error) {
+	res, err := r.db.CreateStocktake(ctx, db.CreateStocktakeParams{
+		MerchantID: int32(req.MerchantID),
+		CategoryID: toInt32Ptr(req.CategoryID),
+		Note:       req.Note,
+		CreatedBy:  toInt32Ptr(req.CreatedBy),
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, stocktake_errors.ErrStocktakeAlreadyOpen
+		}
+
+		return nil, stocktake_errors.ErrCreateStocktake
+	}
+
+	return res, nil
+}
+
+// CreateItems snapshots the expected quantity and price of every product
+// the stocktake counts and returns how many products it covers.
+func (r *stocktakeRepository) CreateItems(ctx context.Context, stocktake_id int) (int64, error) {
+	res, err := r.db.CreateStocktakeItems(ctx, int32(stocktake_id))
+
+	if err != nil {
+		return 0, stocktake_errors.ErrCreateStocktakeItems
+	}
+
+	return res, nil
+}
+
+// UpdateCount records the counted quantity of a product. It fails with
+// ErrProductNotInStocktake when the product is not part of the stocktake.
+func (r *stocktakeRepository) UpdateCount(ctx context.Context, req *requests.UpdateStocktakeCountRecordRequest) (*db.StocktakeItem, error) {
+	counted := int32(req.CountedQuantity)
+
+	res, err := r.db.UpdateStocktakeItemCount(ctx, db.UpdateStocktakeItemCountParams{
+		StocktakeID:     int32(req.StocktakeID),
+		ProductID:       int32(req.ProductID),
+		CountedQuantity: &counted,
+		CountedBy:       toInt32Ptr(req.CountedBy),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, stocktake_errors.ErrProductNotInStocktake
+		}
+
+		return nil, stocktake_errors.ErrUpdateStocktakeCount
+	}
+
+	return res, nil
+}
+
+// UpdateStocktakeStatus moves a stocktake from one status to another. It
+// fails with ErrStocktakeStatusChanged when the stocktake is no longer in
+// the from status.
+func (r *stocktakeRepository) UpdateStocktakeStatus(ctx context.Context, stocktake_id int, from string, to string, posted_by *int) (*db.Stocktake, error) {
+	res, err := r.db.UpdateStocktakeStatus(ctx, db.UpdateStocktakeStatusParams{
+		StocktakeID: int32(stocktake_id),
+		Status:      from,
+		Status_2:    to,
+		PostedBy:    toInt32Ptr(posted_by),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, stocktake_errors.ErrStocktakeStatusChanged
+		}
+
+		return nil, stocktake_errors.ErrUpdateStocktakeStatus
+	}
+
+	return res, nil
+}
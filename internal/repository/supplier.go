@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/supplier_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type supplierRepository struct {
+	db *db.Queries
+}
+
+func NewSupplierRepository(db *db.Queries) *supplierRepository {
+	return &supplierRepository{
+		db: db,
+	}
+}
+
+func (r *supplierRepository) FindAllSuppliers(ctx context.Context, req *requests.FindAllSuppliers) ([]*db.GetSuppliersRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetSuppliersParams{
+		Column1: req.Search,
+		Column2: int32(req.MerchantID),
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	res, err := r.db.GetSuppliers(ctx, reqDb)
+
+	if err != nil {
+		return nil, supplier_errors.ErrFindAllSuppliers
+	}
+
+	return res, nil
+}
+
+func (r *supplierRepository) FindById(ctx context.Context, supplier_id int) (*db.Supplier, error) {
+	res, err := r.db.GetSupplier(ctx, int32(supplier_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, supplier_errors.ErrSupplierNotFound
+		}
+
+		return nil, supplier_errors.ErrFindSupplierById
+	}
+
+	return res, nil
+}
+
+func (r *supplierRepository) CreateSupplier(ctx context.Context, req *requests.CreateSupplierRequest) (*db.Supplier, error) {
+	res, err := r.db.CreateSupplier(ctx, db.CreateSupplierParams{
+		MerchantID:  int32(req.MerchantID),
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+	})
+
+	if err != nil {
+		return nil, supplier_errors.ErrCreateSupplier
+	}
+
+	return res, nil
+}
+
+func (r *supplierRepository) UpdateSupplier(ctx context.Context, req *requests.UpdateSupplierRequest) (*db.Supplier, error) {
+	res, err := r.db.UpdateSupplier(ctx, db.UpdateSupplierParams{
+		SupplierID:  int32(*req.SupplierID),
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, supplier_errors.ErrSupplierNotFound
+		}
+
+		return nil, supplier_errors.ErrUpdateSupplier
+	}
+
+	return res, nil
+}
+
+func (r *supplierRepository) TrashedSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error) {
+	res, err := r.db.TrashSupplier(ctx, int32(supplier_id))
+
+	if err != nil {
+		return nil, supplier_errors.ErrTrashedSupplier
+	}
+
+	return res, nil
+}
+
+func (r *supplierRepository) RestoreSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error) {
+	res, err := r.db.RestoreSupplier(ctx, int32(supplier_id))
+
+	if err != nil {
+		return nil, supplier_errors.ErrRestoreSupplier
+	}
+
+	return res, nil
+}
+
+func (r *supplierRepository) DeleteSupplierPermanent(ctx context.Context, supplier_id int) (bool, error) {
+	err := r.db.DeleteSupplierPermanently(ctx, int32(supplier_id))
+
+	if err != nil {
+		return false, supplier_errors.ErrDeleteSupplierPermanent
+	}
+
+	return true, nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/tax_errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation is the SQLSTATE raised when idx_tax_rates_scope already
+// holds an active rate for the same merchant and category.
+const uniqueViolation = "23505"
+
+type taxRateRepository struct {
+	db *db.Queries
+}
+
+func NewTaxRateRepository(db *db.Queries) *taxRateRepository {
+	return &taxRateRepository{
+		db: db,
+	}
+}
+
+func (r *taxRateRepository) FindAllTaxRates(ctx context.Context, req *requests.FindAllTaxRates) ([]*db.GetTaxRatesRow, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetTaxRatesParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	res, err := r.db.GetTaxRates(ctx, reqDb)
+
+	if err != nil {
+		return nil, tax_errors.ErrFindAllTaxRates
+	}
+
+	return res, nil
+}
+
+func (r *taxRateRepository) FindById(ctx context.Context, tax_rate_id int) (*db.TaxRate, error) {
+	res, err := r.db.GetTaxRate(ctx, int32(tax_rate_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, tax_errors.ErrTaxRateNotFound
+		}
+
+		return nil, tax_errors.ErrFindTaxRateById
+	}
+
+	return res, nil
+}
+
+func (r *taxRateRepository) FindApplicable(ctx context.Context, merchant_id int, category_id int) (*db.TaxRate, error) {
+	res, err := r.db.GetApplicableTaxRate(ctx, db.GetApplicableTaxRateParams{
+		Column1: int32(merchant_id),
+		Column2: int32(category_id),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, tax_errors.ErrTaxRateNotFound
+		}
+
+		return nil, tax_errors.ErrFindApplicable
+	}
+
+	return res, nil
+}
+
+func (r *taxRateRepository) CreateTaxRate(ctx context.Context, req *requests.CreateTaxRateRequest) (*db.TaxRate, error) {
+	res, err := r.db.CreateTaxRate(ctx, db.CreateTaxRateParams{
+		Name:        req.Name,
+		MerchantID:  toInt32Ptr(req.MerchantID),
+		CategoryID:  toInt32Ptr(req.CategoryID),
+		RateBps:     int32(req.RateBps),
+		IsInclusive: req.IsInclusive,
+		Rounding:    req.Rounding,
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, tax_errors.ErrTaxRateConflict
+		}
+
+		return nil, tax_errors.ErrCreateTaxRate
+	}
+
+	return res, nil
+}
+
+func (r *taxRateRepository) UpdateTaxRate(ctx context.Context, req *requests.UpdateTaxRateRequest) (*db.TaxRate, error) {
+	res, err := r.db.UpdateTaxRate(ctx, db.UpdateTaxRateParams{
+		TaxRateID:   int32(*req.TaxRateID),
+		Name:        req.Name,
+		MerchantID:  toInt32Ptr(req.MerchantID),
+		CategoryID:  toInt32Ptr(req.CategoryID),
+		RateBps:     int32(req.RateBps),
+		IsInclusive: req.IsInclusive,
+		Rounding:    req.Rounding,
+	})
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, tax_errors.ErrTaxRateConflict
+		}
+
+		return nil, tax_errors.ErrUpdateTaxRate
+	}
+
+	return res, nil
+}
+
+func (r *taxRateRepository) TrashedTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error) {
+	res, err := r.db.TrashTaxRate(ctx, int32(tax_rate_id))
+
+	if err != nil {
+		return nil, tax_errors.ErrTrashedTaxRate
+	}
+
+	return res, nil
+}
+
+func (r *taxRateRepository) RestoreTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error) {
+	res, err := r.db.RestoreTaxRate(ctx, int32(tax_rate_id))
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, tax_errors.ErrTaxRateConflict
+		}
+
+		return nil, tax_errors.ErrRestoreTaxRate
+	}
+
+	return res, nil
+}
+
+func (r *taxRateRepository) DeleteTaxRatePermanent(ctx context.Context, tax_rate_id int) (bool, error) {
+	err := r.db.DeleteTaxRatePermanently(ctx, int32(tax_rate_id))
+
+	if err != nil {
+		return false, tax_errors.ErrDeleteTaxRatePermanent
+	}
+
+	return true, nil
+}
+
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	n := int32(*v)
+
+	return &n
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
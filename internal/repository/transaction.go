@@ -2,10 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"pointofsale/internal/domain/requests"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors/transaction_errors"
 	"time"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type transactionRepository struct {
@@ -369,6 +372,24 @@ func (r *transactionRepository) FindById(ctx context.Context, transaction_id int
 	res, err := r.db.GetTransactionByID(ctx, int32(transaction_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotFound
+		}
+
+		return nil, transaction_errors.ErrFindById
+	}
+
+	return res, nil
+}
+
+func (r *transactionRepository) FindByIdForUpdate(ctx context.Context, transaction_id int) (*db.GetTransactionForUpdateRow, error) {
+	res, err := r.db.GetTransactionForUpdate(ctx, int32(transaction_id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotFound
+		}
+
 		return nil, transaction_errors.ErrFindById
 	}
 
@@ -396,13 +417,25 @@ func (r *transactionRepository) CreateTransaction(ctx context.Context, request *
 		paymentStatus = *request.PaymentStatus
 	}
 
+	subtotalAmount := int32(request.Amount)
+	if request.SubtotalAmount != nil {
+		subtotalAmount = int32(*request.SubtotalAmount)
+	}
+
+	var taxAmount int32
+	if request.TaxAmount != nil {
+		taxAmount = int32(*request.TaxAmount)
+	}
+
 	req := db.CreateTransactionParams{
-		OrderID:       int32(request.OrderID),
-		MerchantID:    int32(request.MerchantID),
-		PaymentMethod: request.PaymentMethod,
-		Amount:        int32(request.Amount),
-		ChangeAmount:  &changeAmount,
-		PaymentStatus: paymentStatus,
+		OrderID:        int32(request.OrderID),
+		MerchantID:     int32(request.MerchantID),
+		PaymentMethod:  request.PaymentMethod,
+		Amount:         int32(request.Amount),
+		ChangeAmount:   &changeAmount,
+		PaymentStatus:  paymentStatus,
+		SubtotalAmount: subtotalAmount,
+		TaxAmount:      taxAmount,
 	}
 
 	transaction, err := r.db.CreateTransaction(ctx, req)
@@ -425,29 +458,100 @@ func (r *transactionRepository) UpdateTransaction(ctx context.Context, request *
 		paymentStatus = *request.PaymentStatus
 	}
 
+	subtotalAmount := int32(request.Amount)
+	if request.SubtotalAmount != nil {
+		subtotalAmount = int32(*request.SubtotalAmount)
+	}
+
+	var taxAmount int32
+	if request.TaxAmount != nil {
+		taxAmount = int32(*request.TaxAmount)
+	}
+
 	req := db.UpdateTransactionParams{
-		TransactionID: int32(*request.TransactionID),
-		MerchantID:    int32(request.MerchantID),
-		PaymentMethod: request.PaymentMethod,
-		Amount:        int32(request.Amount),
-		ChangeAmount:  &changeAmount,
-		OrderID:       int32(request.OrderID),
-		PaymentStatus: paymentStatus,
+		TransactionID:  int32(*request.TransactionID),
+		MerchantID:     int32(request.MerchantID),
+		PaymentMethod:  request.PaymentMethod,
+		Amount:         int32(request.Amount),
+		ChangeAmount:   &changeAmount,
+		OrderID:        int32(request.OrderID),
+		PaymentStatus:  paymentStatus,
+		SubtotalAmount: subtotalAmount,
+		TaxAmount:      taxAmount,
 	}
 
 	res, err := r.db.UpdateTransaction(ctx, req)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotFound
+		}
+
 		return nil, transaction_errors.ErrUpdateTransaction
 	}
 
 	return res, nil
 }
 
+func (r *transactionRepository) FindPayments(ctx context.Context, transaction_id int) ([]*db.TransactionPayment, error) {
+	res, err := r.db.GetTransactionPayments(ctx, int32(transaction_id))
+
+	if err != nil {
+		return nil, transaction_errors.ErrFindTransactionPayments
+	}
+
+	return res, nil
+}
+
+func (r *transactionRepository) CreatePayment(ctx context.Context, request *requests.CreateTransactionPaymentRecordRequest) (*db.TransactionPayment, error) {
+	req := db.CreateTransactionPaymentParams{
+		TransactionID: int32(request.TransactionID),
+		PaymentMethod: request.PaymentMethod,
+		Amount:        int32(request.Amount),
+		ChangeAmount:  int32(request.ChangeAmount),
+		Reference:     request.Reference,
+	}
+
+	payment, err := r.db.CreateTransactionPayment(ctx, req)
+
+	if err != nil {
+		return nil, transaction_errors.ErrCreateTransactionPayment
+	}
+
+	return payment, nil
+}
+
+func (r *transactionRepository) DeletePayments(ctx context.Context, transaction_id int) error {
+	err := r.db.DeleteTransactionPayments(ctx, int32(transaction_id))
+
+	if err != nil {
+		return transaction_errors.ErrDeleteTransactionPayments
+	}
+
+	return nil
+}
+
+func (r *transactionRepository) UpdateTransactionStatus(ctx context.Context, transaction_id int, status string) (*db.Transaction, error) {
+	res, err := r.db.UpdateTransactionStatus(ctx, db.UpdateTransactionStatusParams{
+		TransactionID: int32(transaction_id),
+		PaymentStatus: status,
+	})
+
+	if err != nil {
+		return nil, transaction_errors.ErrUpdateTransactionStatus
+	}
+
+	return res, nil
+}
+
 func (r *transactionRepository) TrashTransaction(ctx context.Context, transaction_id int) (*db.Transaction, error) {
 	res, err := r.db.TrashTransaction(ctx, int32(transaction_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotFound
+		}
+
 		return nil, transaction_errors.ErrTrashTransaction
 	}
 
@@ -458,6 +562,10 @@ func (r *transactionRepository) RestoreTransaction(ctx context.Context, transact
 	res, err := r.db.RestoreTransaction(ctx, int32(transaction_id))
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotFound
+		}
+
 		return nil, transaction_errors.ErrRestoreTransaction
 	}
 
@@ -465,12 +573,16 @@ func (r *transactionRepository) RestoreTransaction(ctx context.Context, transact
 }
 
 func (r *transactionRepository) DeleteTransactionPermanently(ctx context.Context, transaction_id int) (bool, error) {
-	err := r.db.DeleteTransactionPermanently(ctx, int32(transaction_id))
+	deleted, err := r.db.DeleteTransactionPermanently(ctx, int32(transaction_id))
 
 	if err != nil {
 		return false, transaction_errors.ErrDeleteTransactionPermanently
 	}
 
+	if deleted == 0 {
+		return false, transaction_errors.ErrTransactionNotFound
+	}
+
 	return true, nil
 }
 
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/transaction_errors"
+)
+
+type transactionRefundRepository struct {
+	db *db.Queries
+}
+
+func NewTransactionRefundRepository(db *db.Queries) *transactionRefundRepository {
+	return &transactionRefundRepository{
+		db: db,
+	}
+}
+
+func (r *transactionRefundRepository) FindByTransaction(ctx context.Context, transaction_id int) ([]*db.TransactionRefund, error) {
+	res, err := r.db.GetTransactionRefunds(ctx, int32(transaction_id))
+
+	if err != nil {
+		return nil, transaction_errors.ErrFindTransactionRefunds
+	}
+
+	return res, nil
+}
+
+// FindRefundedQuantities returns the units already reversed per order item.
+func (r *transactionRefundRepository) FindRefundedQuantities(ctx context.Context, transaction_id int) (map[int]int, error) {
+	rows, err := r.db.GetRefundedQuantities(ctx, int32(transaction_id))
+
+	if err != nil {
+		return nil, transaction_errors.ErrFindRefundedQuantities
+	}
+
+	quantities := make(map[int]int, len(rows))
+	for _, row := range rows {
+		quantities[int(row.OrderItemID)] = int(row.Quantity)
+	}
+
+	return quantities, nil
+}
+
+func (r *transactionRefundRepository) CreateRefund(ctx context.Context, request *requests.CreateTransactionRefundRecordRequest) (*db.TransactionRefund, error) {
+	req := db.CreateTransactionRefundParams{
+		TransactionID: int32(request.TransactionID),
+		Kind:          request.Kind,
+		Amount:        int32(request.Amount),
+		TaxAmount:     int32(request.TaxAmount),
+		ReasonCode:    request.ReasonCode,
+		Note:          request.Note,
+		ApprovedBy:    int32(request.ApprovedBy),
+		Restock:       request.Restock,
+	}
+
+	res, err := r.db.CreateTransactionRefund(ctx, req)
+
+	if err != nil {
+		return nil, transaction_errors.ErrCreateTransactionRefund
+	}
+
+	return res, nil
+}
+
+func (r *transactionRefundRepository) CreateRefundItem(ctx context.Context, request *requests.CreateTransactionRefundItemRecordRequest) (*db.TransactionRefundItem, error) {
+	req := db.CreateTransactionRefundItemParams{
+		TransactionRefundID: int32(request.TransactionRefundID),
+		OrderItemID:         int32(request.OrderItemID),
+		Quantity:            int32(request.Quantity),
+		Amount:              int32(request.Amount),
+		TaxAmount:           int32(request.TaxAmount),
+	}
+
+	res, err := r.db.CreateTransactionRefundItem(ctx, req)
+
+	if err != nil {
+		return nil, transaction_errors.ErrCreateTransactionRefundItem
+	}
+
+	return res, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	db "pointofsale/pkg/database/schema"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// UnitOfWork runs a group of repository calls inside a single database
+// transaction. The callback receives repositories bound to that transaction;
+// returning an error (or panicking) rolls every write back.
+type UnitOfWork interface {
+	WithinTransaction(ctx context.Context, fn func(repos *Repositories) error) error
+}
+
+type unitOfWork struct {
+	pool    *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewUnitOfWork(pool *pgxpool.Pool, queries *db.Queries) *unitOfWork {
+	return &unitOfWork{
+		pool:    pool,
+		queries: queries,
+	}
+}
+
+func (u *unitOfWork) WithinTransaction(ctx context.Context, fn func(repos *Repositories) error) error {
+	return pgx.BeginFunc(ctx, u.pool, func(tx pgx.Tx) error {
+		repos := newRepositories(u.queries.WithTx(tx))
+		repos.UnitOfWork = &txUnitOfWork{repos: repos}
+
+		return fn(repos)
+	})
+}
+
+// txUnitOfWork is handed to callbacks that are already running inside a
+// transaction, so nested calls join the outer transaction instead of
+// opening a new one.
+type txUnitOfWork struct {
+	repos *Repositories
+}
+
+func (u *txUnitOfWork) WithinTransaction(ctx context.Context, fn func(repos *Repositories) error) error {
+	return fn(u.repos)
+}
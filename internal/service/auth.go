@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	auth_cache "pointofsale/internal/cache/auth"
 	"pointofsale/internal/domain/requests"
@@ -21,7 +20,9 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.uber.org/zap"
 )
@@ -36,6 +37,8 @@ type authService struct {
 	logger        logger.LoggerInterface
 	observability observability.TraceLoggerObservability
 	cache         auth_cache.AuthMencache
+	unitOfWork    repository.UnitOfWork
+	defaultRole   string
 }
 
 type AuthServiceDeps struct {
@@ -48,9 +51,18 @@ type AuthServiceDeps struct {
 	Logger           logger.LoggerInterface
 	Observability    observability.TraceLoggerObservability
 	Cache            auth_cache.AuthMencache
+	UnitOfWork       repository.UnitOfWork
+	// DefaultRole is assigned to self-registered users. It falls back to
+	// auth.RoleUser, the least privileged role, when empty.
+	DefaultRole string
 }
 
 func NewAuthService(deps AuthServiceDeps) *authService {
+	defaultRole := deps.DefaultRole
+	if defaultRole == "" {
+		defaultRole = auth.RoleUser
+	}
+
 	return &authService{
 		auth:          deps.UserRepo,
 		refreshToken:  deps.RefreshTokenRepo,
@@ -61,6 +73,8 @@ func NewAuthService(deps AuthServiceDeps) *authService {
 		logger:        deps.Logger,
 		observability: deps.Observability,
 		cache:         deps.Cache,
+		unitOfWork:    deps.UnitOfWork,
+		defaultRole:   defaultRole,
 	}
 }
 
@@ -103,8 +117,7 @@ func (s *authService) Register(ctx context.Context, request *requests.CreateUser
 	}
 	request.Password = passwordHash
 
-	const defaultRoleName = "ROLE_ADMIN"
-	role, err := s.role.FindByName(ctx, defaultRoleName)
+	role, err := s.role.FindByName(ctx, s.defaultRole)
 	if err != nil || role == nil {
 		status = "error"
 		return errorhandler.HandleError[*db.CreateUserRow](
@@ -112,7 +125,7 @@ func (s *authService) Register(ctx context.Context, request *requests.CreateUser
 			role_errors.ErrRoleNotFoundRes,
 			method,
 			span,
-			zap.String("role", defaultRoleName),
+			zap.String("role", s.defaultRole),
 		)
 	}
 
@@ -145,6 +158,7 @@ func (s *authService) Register(ctx context.Context, request *requests.CreateUser
 	}
 
 	s.cache.DeleteCachedUserInfo(ctx, strconv.Itoa(int(newUser.UserID)))
+	s.cache.InvalidateUserCache(ctx)
 
 	logSuccess("User registered successfully",
 		zap.Int("user_id", int(newUser.UserID)),
@@ -167,11 +181,6 @@ func (s *authService) Login(ctx context.Context, request *requests.AuthRequest)
 		zap.String("email", request.Email),
 	)
 
-	if cachedResponse, found := s.cache.GetCachedLogin(ctx, request.Email); found {
-		logSuccess("Successfully retrieved login data from cache", zap.String("email", request.Email))
-		return cachedResponse, nil
-	}
-
 	res, err := s.auth.FindByEmailWithPassword(ctx, request.Email)
 	if err != nil {
 		status = "error"
@@ -196,34 +205,25 @@ func (s *authService) Login(ctx context.Context, request *requests.AuthRequest)
 		)
 	}
 
-	token, err := s.createAccessToken(int(res.UserID))
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*response.TokenResponse](
-			s.logger,
-			refreshtoken_errors.ErrFailedCreateAccess,
-			method,
-			span,
-			zap.Int("user_id", int(res.UserID)),
-		)
-	}
+	client := auth.ClientInfoFromContext(ctx)
 
-	refreshToken, err := s.createRefreshToken(ctx, int(res.UserID))
+	tokenResponse, err := s.issueTokens(ctx, s.refreshToken, int(res.UserID), &requests.CreateRefreshToken{
+		SessionID:        uuid.NewString(),
+		UserAgent:        truncate(client.UserAgent, 255),
+		IPAddress:        truncate(client.IPAddress, 64),
+		SessionStartedAt: time.Now(),
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[*response.TokenResponse](
 			s.logger,
-			refreshtoken_errors.ErrFailedCreateRefresh,
+			err,
 			method,
 			span,
 			zap.Int("user_id", int(res.UserID)),
 		)
 	}
 
-	tokenResponse := &response.TokenResponse{AccessToken: token, RefreshToken: refreshToken}
-
-	s.cache.SetCachedLogin(ctx, request.Email, tokenResponse, 24*time.Hour)
-
 	logSuccess("User logged in successfully", zap.String("email", request.Email))
 
 	return tokenResponse, nil
@@ -232,15 +232,15 @@ func (s *authService) Login(ctx context.Context, request *requests.AuthRequest)
 func (s *authService) RefreshToken(ctx context.Context, token string) (*response.TokenResponse, error) {
 	const method = "RefreshToken"
 
-	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method, attribute.String("token", token))
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method, attribute.String("token", maskToken(token)))
 
 	defer func() {
 		end(status)
 	}()
 
-	s.logger.Debug("Refreshing token", zap.String("token", token))
+	s.logger.Debug("Refreshing token", zap.String("token", maskToken(token)))
 
-	userIdStr, err := s.token.ValidateToken(token)
+	claims, err := s.token.ParseToken(token)
 	if err != nil {
 		status = "error"
 		if errors.Is(err, auth.ErrTokenExpired) {
@@ -250,7 +250,7 @@ func (s *authService) RefreshToken(ctx context.Context, token string) (*response
 					refreshtoken_errors.ErrFailedDeleteRefreshToken,
 					method,
 					span,
-					zap.String("token", token),
+					zap.String("token", maskToken(token)),
 				)
 			}
 			return errorhandler.HandleError[*response.TokenResponse](
@@ -258,7 +258,7 @@ func (s *authService) RefreshToken(ctx context.Context, token string) (*response
 				refreshtoken_errors.ErrFailedExpire,
 				method,
 				span,
-				zap.String("token", token),
+				zap.String("token", maskToken(token)),
 			)
 		}
 		return errorhandler.HandleError[*response.TokenResponse](
@@ -266,88 +266,241 @@ func (s *authService) RefreshToken(ctx context.Context, token string) (*response
 			refreshtoken_errors.ErrRefreshTokenNotFound,
 			method,
 			span,
-			zap.String("token", token),
+			zap.String("token", maskToken(token)),
 		)
 	}
 
-	userId, err := strconv.Atoi(userIdStr)
-	if err != nil {
+	current, err := s.refreshToken.FindByToken(ctx, token)
+	if err != nil || claims.Audience != auth.AudienceRefresh || strconv.Itoa(int(current.UserID)) != claims.Subject {
 		status = "error"
 		return errorhandler.HandleError[*response.TokenResponse](
 			s.logger,
-			user_errors.ErrUserNotFoundRes,
+			refreshtoken_errors.ErrRefreshTokenNotFound,
 			method,
 			span,
-			zap.String("user_id_str", userIdStr),
+			zap.String("token", maskToken(token)),
 		)
 	}
 
-	accessToken, err := s.createAccessToken(userId)
+	userId := int(current.UserID)
+
+	if current.RevokedAt.Valid {
+		status = "error"
+		return s.revokeReusedSession(ctx, method, span, current)
+	}
+
+	var tokenResponse *response.TokenResponse
+
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		if _, err := repos.RefreshToken.RevokeRefreshToken(ctx, int(current.RefreshTokenID)); err != nil {
+			if errors.Is(err, refreshtoken_errors.ErrTokenRevoked) {
+				return err
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				refreshtoken_errors.ErrFailedRotateRefreshToken,
+				method,
+				span,
+				zap.Int("user_id", userId),
+				zap.String("session_id", current.SessionID),
+			)
+		}
+
+		res, err := s.issueTokens(ctx, repos.RefreshToken, userId, &requests.CreateRefreshToken{
+			SessionID:        current.SessionID,
+			UserAgent:        current.UserAgent,
+			IPAddress:        current.IpAddress,
+			SessionStartedAt: current.SessionStartedAt,
+		})
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				err,
+				method,
+				span,
+				zap.Int("user_id", userId),
+				zap.String("session_id", current.SessionID),
+			)
+		}
+
+		tokenResponse = res
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+
+		// Another request rotated the same token first, so this one is a
+		// replay of a token that is no longer valid.
+		if errors.Is(err, refreshtoken_errors.ErrTokenRevoked) {
+			return s.revokeReusedSession(ctx, method, span, current)
+		}
+
+		return nil, err
+	}
+
+	s.cache.DenyToken(ctx, current.AccessTokenID, auth.AccessTokenTTL)
+
+	s.cache.DeleteCachedUserInfo(ctx, claims.Subject)
+
+	logSuccess("Refresh token rotated successfully",
+		zap.Int("user_id", userId),
+		zap.String("session_id", current.SessionID),
+	)
+
+	return tokenResponse, nil
+}
+
+func (s *authService) Logout(ctx context.Context, req *requests.LogoutRequest) (bool, error) {
+	const method = "Logout"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method, attribute.Int("user_id", req.UserID))
+
+	defer func() {
+		end(status)
+	}()
+
+	// The access token is denied first so that the caller is logged out
+	// even when its session cannot be found.
+	s.cache.DenyToken(ctx, req.TokenID, auth.AccessTokenTTL)
+
+	current, err := s.refreshToken.FindByAccessTokenId(ctx, req.UserID, req.TokenID)
 	if err != nil {
+		if errors.Is(err, refreshtoken_errors.ErrTokenNotFound) {
+			logSuccess("Access token revoked without a session", zap.Int("user_id", req.UserID))
+			return true, nil
+		}
+
 		status = "error"
-		return errorhandler.HandleError[*response.TokenResponse](
+		return errorhandler.HandleError[bool](
 			s.logger,
-			refreshtoken_errors.ErrFailedCreateAccess,
+			refreshtoken_errors.ErrFailedFindByToken,
 			method,
 			span,
-			zap.Int("user_id", userId),
+			zap.Int("user_id", req.UserID),
 		)
 	}
 
-	refreshToken, err := s.createRefreshToken(ctx, userId)
+	revoked, err := s.refreshToken.RevokeSession(ctx, req.UserID, current.SessionID)
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*response.TokenResponse](
+		return errorhandler.HandleError[bool](
 			s.logger,
-			refreshtoken_errors.ErrFailedCreateRefresh,
+			refreshtoken_errors.ErrFailedRevokeSession,
 			method,
 			span,
-			zap.Int("user_id", userId),
+			zap.Int("user_id", req.UserID),
+			zap.String("session_id", current.SessionID),
 		)
 	}
 
-	expiryTime := time.Now().Add(24 * time.Hour)
-	updateRequest := &requests.UpdateRefreshToken{
-		UserId:    userId,
-		Token:     refreshToken,
-		ExpiresAt: expiryTime.Format("2006-01-02 15:04:05"),
-	}
+	s.denyAccessTokens(ctx, revoked)
+
+	logSuccess("Session logged out successfully",
+		zap.Int("user_id", req.UserID),
+		zap.String("session_id", current.SessionID),
+	)
+
+	return true, nil
+}
+
+func (s *authService) LogoutAll(ctx context.Context, req *requests.LogoutRequest) (bool, error) {
+	const method = "LogoutAll"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method, attribute.Int("user_id", req.UserID))
+
+	defer func() {
+		end(status)
+	}()
 
-	if _, err = s.refreshToken.UpdateRefreshToken(ctx, updateRequest); err != nil {
+	s.cache.DenyToken(ctx, req.TokenID, auth.AccessTokenTTL)
+
+	revoked, err := s.refreshToken.RevokeAllByUserId(ctx, req.UserID)
+	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*response.TokenResponse](
+		return errorhandler.HandleError[bool](
 			s.logger,
-			refreshtoken_errors.ErrFailedUpdateRefreshToken,
+			refreshtoken_errors.ErrFailedRevokeSession,
 			method,
 			span,
-			zap.Int("user_id", userId),
+			zap.Int("user_id", req.UserID),
 		)
 	}
 
-	tokenResponse := &response.TokenResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+	s.denyAccessTokens(ctx, revoked)
+
+	logSuccess("All sessions logged out successfully",
+		zap.Int("user_id", req.UserID),
+		zap.Int("revoked", len(revoked)),
+	)
+
+	return true, nil
+}
+
+func (s *authService) FindSessions(ctx context.Context, user_id int) ([]*db.RefreshToken, error) {
+	const method = "FindSessions"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method, attribute.Int("user_id", user_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	sessions, err := s.refreshToken.FindActiveByUserId(ctx, user_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.RefreshToken](
+			s.logger,
+			refreshtoken_errors.ErrFailedFindSessions,
+			method,
+			span,
+			zap.Int("user_id", user_id),
+		)
 	}
 
-	s.cache.SetRefreshToken(ctx, refreshToken, 24*time.Hour)
+	logSuccess("Successfully fetched sessions",
+		zap.Int("user_id", user_id),
+		zap.Int("count", len(sessions)),
+	)
+
+	return sessions, nil
+}
 
-	s.cache.DeleteCachedUserInfo(ctx, userIdStr)
+func (s *authService) PurgeExpiredSessions(ctx context.Context) (int64, error) {
+	const method = "PurgeExpiredSessions"
 
-	logSuccess("Refresh token refreshed successfully")
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method)
 
-	return tokenResponse, nil
+	defer func() {
+		end(status)
+	}()
+
+	deleted, err := s.refreshToken.DeleteExpired(ctx)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[int64](
+			s.logger,
+			refreshtoken_errors.ErrFailedPurgeRefreshTokens,
+			method,
+			span)
+	}
+
+	logSuccess("Purged expired refresh tokens", zap.Int64("deleted", deleted))
+
+	return deleted, nil
 }
 
 func (s *authService) GetMe(ctx context.Context, token string) (*db.GetUserByIDRow, error) {
 	const method = "GetMe"
 
-	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method, attribute.String("token", token))
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method, attribute.String("token", maskToken(token)))
 
 	defer func() {
 		end(status)
 	}()
 
-	s.logger.Debug("Fetching user details", zap.String("token", token))
+	s.logger.Debug("Fetching user details", zap.String("token", maskToken(token)))
 
 	userIdStr, err := s.token.ValidateToken(token)
 	if err != nil {
@@ -357,7 +510,7 @@ func (s *authService) GetMe(ctx context.Context, token string) (*db.GetUserByIDR
 			refreshtoken_errors.ErrFailedInValidToken,
 			method,
 			span,
-			zap.String("token", token),
+			zap.String("token", maskToken(token)),
 		)
 	}
 
@@ -397,33 +550,59 @@ func (s *authService) GetMe(ctx context.Context, token string) (*db.GetUserByIDR
 	return user, nil
 }
 
-func (s *authService) createAccessToken(id int) (string, error) {
+// issueTokens signs a new token pair for the session and stores the refresh
+// token together with the jti of the access token issued alongside it.
+func (s *authService) issueTokens(ctx context.Context, refreshTokens repository.RefreshTokenRepository, userId int, session *requests.CreateRefreshToken) (*response.TokenResponse, error) {
+	accessToken, accessTokenID, err := s.createAccessToken(userId)
+	if err != nil {
+		return nil, refreshtoken_errors.ErrFailedCreateAccess
+	}
+
+	refreshToken, err := s.createRefreshToken(ctx, refreshTokens, userId, accessTokenID, session)
+	if err != nil {
+		return nil, refreshtoken_errors.ErrFailedCreateRefresh
+	}
+
+	return &response.TokenResponse{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// createAccessToken returns the signed token and its jti, which is read back
+// from the token so that the session can revoke it later.
+func (s *authService) createAccessToken(id int) (string, string, error) {
 	s.logger.Debug("Creating access token",
 		zap.Int("userID", id),
 	)
 
-	res, err := s.token.GenerateToken(id, "access")
-
+	res, err := s.token.GenerateToken(id, auth.AudienceAccess)
 	if err != nil {
 		s.logger.Error("Failed to create access token",
 			zap.Int("userID", id),
 			zap.Error(err))
-		return "", err
+		return "", "", err
+	}
+
+	claims, err := s.token.ParseToken(res)
+	if err != nil {
+		s.logger.Error("Failed to read access token claims",
+			zap.Int("userID", id),
+			zap.Error(err))
+		return "", "", err
 	}
 
 	s.logger.Debug("Access token created successfully",
 		zap.Int("userID", id),
 	)
 
-	return res, nil
+	return res, claims.ID, nil
 }
 
-func (s *authService) createRefreshToken(ctx context.Context, id int) (string, error) {
+func (s *authService) createRefreshToken(ctx context.Context, refreshTokens repository.RefreshTokenRepository, id int, accessTokenID string, session *requests.CreateRefreshToken) (string, error) {
 	s.logger.Debug("Creating refresh token",
 		zap.Int("userID", id),
+		zap.String("session_id", session.SessionID),
 	)
 
-	res, err := s.token.GenerateToken(id, "refresh")
+	res, err := s.token.GenerateToken(id, auth.AudienceRefresh)
 
 	if err != nil {
 		s.logger.Error("Failed to create refresh token",
@@ -433,12 +612,16 @@ func (s *authService) createRefreshToken(ctx context.Context, id int) (string, e
 		return "", err
 	}
 
-	if err := s.refreshToken.DeleteRefreshTokenByUserId(ctx, id); err != nil && !errors.Is(err, sql.ErrNoRows) {
-		s.logger.Error("Failed to delete existing refresh token", zap.Error(err))
-		return "", err
-	}
-
-	_, err = s.refreshToken.CreateRefreshToken(ctx, &requests.CreateRefreshToken{Token: res, UserId: id, ExpiresAt: time.Now().Add(24 * time.Hour).Format("2006-01-02 15:04:05")})
+	_, err = refreshTokens.CreateRefreshToken(ctx, &requests.CreateRefreshToken{
+		UserId:           id,
+		Token:            res,
+		ExpiresAt:        time.Now().Add(auth.RefreshTokenTTL).Format("2006-01-02 15:04:05"),
+		SessionID:        session.SessionID,
+		AccessTokenID:    accessTokenID,
+		UserAgent:        session.UserAgent,
+		IPAddress:        session.IPAddress,
+		SessionStartedAt: session.SessionStartedAt,
+	})
 	if err != nil {
 		s.logger.Error("Failed to create refresh token", zap.Error(err))
 
@@ -452,6 +635,50 @@ func (s *authService) createRefreshToken(ctx context.Context, id int) (string, e
 	return res, nil
 }
 
+// revokeReusedSession handles a refresh token presented after it was already
+// rotated. Either the client or an attacker holds a stolen copy, so every
+// token of the session is revoked and both parties have to log in again.
+func (s *authService) revokeReusedSession(ctx context.Context, method string, span trace.Span, reused *db.RefreshToken) (*response.TokenResponse, error) {
+	revoked, err := s.refreshToken.RevokeSession(ctx, int(reused.UserID), reused.SessionID)
+	if err != nil {
+		return errorhandler.HandleError[*response.TokenResponse](
+			s.logger,
+			refreshtoken_errors.ErrFailedRevokeSession,
+			method,
+			span,
+			zap.Int("user_id", int(reused.UserID)),
+			zap.String("session_id", reused.SessionID),
+		)
+	}
+
+	s.denyAccessTokens(ctx, revoked)
+
+	return errorhandler.HandleError[*response.TokenResponse](
+		s.logger,
+		refreshtoken_errors.ErrRefreshTokenReused,
+		method,
+		span,
+		zap.Int("user_id", int(reused.UserID)),
+		zap.String("session_id", reused.SessionID),
+	)
+}
+
+// denyAccessTokens puts the access tokens issued with the given refresh
+// tokens on the denylist for the longest time they can still be valid.
+func (s *authService) denyAccessTokens(ctx context.Context, tokens []*db.RefreshToken) {
+	for _, token := range tokens {
+		s.cache.DenyToken(ctx, token.AccessTokenID, auth.AccessTokenTTL)
+	}
+}
+
+func truncate(value string, max int) string {
+	if len(value) <= max {
+		return value
+	}
+
+	return value[:max]
+}
+
 func maskToken(token string) string {
 	if len(token) < 8 {
 		return "******"
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/cache"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/pkg/errors/cache_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// cacheService lets administrators inspect and flush the namespaces of the
+// server's cache store.
+type cacheService struct {
+	store         *cache.CacheStore
+	logger        logger.LoggerInterface
+	observability observability.TraceLoggerObservability
+}
+
+type CacheServiceDeps struct {
+	Store         *cache.CacheStore
+	Logger        logger.LoggerInterface
+	Observability observability.TraceLoggerObservability
+}
+
+func NewCacheService(deps CacheServiceDeps) *cacheService {
+	return &cacheService{
+		store:         deps.Store,
+		logger:        deps.Logger,
+		observability: deps.Observability,
+	}
+}
+
+func (s *cacheService) FindAllNamespaces(ctx context.Context) ([]*cache.NamespaceStats, error) {
+	const method = "FindAllCacheNamespaces"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method)
+
+	defer func() {
+		end(status)
+	}()
+
+	namespaces := s.store.Namespaces()
+	stats := make([]*cache.NamespaceStats, 0, len(namespaces))
+
+	for _, namespace := range namespaces {
+		ns, err := s.store.InspectNamespace(ctx, namespace)
+		if err != nil {
+			status = "error"
+			return errorhandler.HandleError[[]*cache.NamespaceStats](
+				s.logger,
+				cache_errors.ErrFailedFindNamespaces,
+				method,
+				span,
+				zap.String("namespace", namespace),
+				zap.NamedError("cause", err),
+			)
+		}
+		stats = append(stats, ns)
+	}
+
+	logSuccess("Successfully fetched cache namespaces", zap.Int("count", len(stats)))
+
+	return stats, nil
+}
+
+func (s *cacheService) FindNamespace(ctx context.Context, namespace string) (*cache.NamespaceStats, error) {
+	const method = "FindCacheNamespace"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.String("namespace", namespace))
+
+	defer func() {
+		end(status)
+	}()
+
+	stats, err := s.store.InspectNamespace(ctx, namespace)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*cache.NamespaceStats](
+			s.logger,
+			namespaceError(err, cache_errors.ErrFailedFindNamespace),
+			method,
+			span,
+			zap.String("namespace", namespace),
+			zap.NamedError("cause", err),
+		)
+	}
+
+	logSuccess("Successfully fetched cache namespace", zap.String("namespace", namespace))
+
+	return stats, nil
+}
+
+func (s *cacheService) FlushNamespace(ctx context.Context, namespace string) (int64, error) {
+	const method = "FlushCacheNamespace"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.String("namespace", namespace))
+
+	defer func() {
+		end(status)
+	}()
+
+	deleted, err := s.store.FlushNamespace(ctx, namespace)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[int64](
+			s.logger,
+			namespaceError(err, cache_errors.ErrFailedFlushNamespace),
+			method,
+			span,
+			zap.String("namespace", namespace),
+			zap.Int64("deleted", deleted),
+			zap.NamedError("cause", err),
+		)
+	}
+
+	logSuccess("Successfully flushed cache namespace",
+		zap.String("namespace", namespace),
+		zap.Int64("deleted", deleted))
+
+	return deleted, nil
+}
+
+// namespaceError maps the errors of the cache store to responses, using
+// fallback for failures of Redis.
+func namespaceError(err error, fallback error) error {
+	switch {
+	case errors.Is(err, cache.ErrUnknownNamespace):
+		return cache_errors.ErrCacheNamespaceNotFoundRes
+	case errors.Is(err, cache.ErrDurableNamespace):
+		return cache_errors.ErrCacheNamespaceDurable
+	default:
+		return fallback
+	}
+}
@@ -131,7 +131,7 @@ func (s *cashierService) FindById(ctx context.Context, cashierID int) (*db.GetCa
 		status = "error"
 		return errorhandler.HandleError[*db.GetCashierByIdRow](
 			s.logger,
-			cashier_errors.ErrFailedFindCashierById,
+			cashierError(err, cashier_errors.ErrFailedFindCashierById),
 			method,
 			span,
 			zap.Int("cashier_id", cashierID))
@@ -822,7 +822,7 @@ func (s *cashierService) CreateCashier(ctx context.Context, req *requests.Create
 		status = "error"
 		return errorhandler.HandleError[*db.CreateCashierRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
 			span,
 			zap.Int("merchant_id", req.MerchantID))
@@ -875,7 +875,7 @@ func (s *cashierService) UpdateCashier(ctx context.Context, req *requests.Update
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateCashierRow](
 			s.logger,
-			cashier_errors.ErrFailedUpdateCashier,
+			cashierError(err, cashier_errors.ErrFailedUpdateCashier),
 			method,
 			span,
 			zap.Int("cashier_id", *req.CashierID))
@@ -904,7 +904,7 @@ func (s *cashierService) TrashedCashier(ctx context.Context, cashierID int) (*db
 		status = "error"
 		return errorhandler.HandleError[*db.Cashier](
 			s.logger,
-			cashier_errors.ErrFailedTrashedCashier,
+			cashierError(err, cashier_errors.ErrFailedTrashedCashier),
 			method,
 			span,
 			zap.Int("cashier_id", cashierID))
@@ -933,7 +933,7 @@ func (s *cashierService) RestoreCashier(ctx context.Context, cashierID int) (*db
 		status = "error"
 		return errorhandler.HandleError[*db.Cashier](
 			s.logger,
-			cashier_errors.ErrFailedRestoreCashier,
+			cashierError(err, cashier_errors.ErrFailedRestoreCashier),
 			method,
 			span,
 			zap.Int("cashier_id", cashierID))
@@ -962,7 +962,7 @@ func (s *cashierService) DeleteCashierPermanent(ctx context.Context, cashierID i
 		status = "error"
 		return errorhandler.HandleError[bool](
 			s.logger,
-			cashier_errors.ErrFailedDeleteCashierPermanent,
+			cashierError(err, cashier_errors.ErrFailedDeleteCashierPermanent),
 			method,
 			span,
 			zap.Int("cashier_id", cashierID))
@@ -995,7 +995,7 @@ func (s *cashierService) RestoreAllCashier(ctx context.Context) (bool, error) {
 			span)
 	}
 
-	s.logger.Debug("All cashier caches should be invalidated after restore all operation")
+	s.cache.InvalidateCashierCache(ctx)
 
 	logSuccess("Successfully restored all trashed cashiers")
 
@@ -1021,7 +1021,7 @@ func (s *cashierService) DeleteAllCashierPermanent(ctx context.Context) (bool, e
 			span)
 	}
 
-	s.logger.Debug("All cashier caches should be invalidated after delete all operation")
+	s.cache.InvalidateCashierCache(ctx)
 
 	logSuccess("Successfully permanently deleted all trashed cashiers")
 
@@ -6,19 +6,24 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/errorhandler"
 	"pointofsale/internal/repository"
+	"pointofsale/pkg/auth"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors/category_errors"
+	"pointofsale/pkg/errors/merchant_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/pkg/utils"
+	"slices"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.uber.org/zap"
 )
 
 type categoryService struct {
 	categoryRepository repository.CategoryRepository
+	unitOfWork         repository.UnitOfWork
 	logger             logger.LoggerInterface
 	observability      observability.TraceLoggerObservability
 	cache              category_cache.CategoryMencache
@@ -26,6 +31,7 @@ type categoryService struct {
 
 type CategoryServiceDeps struct {
 	CategoryRepo  repository.CategoryRepository
+	UnitOfWork    repository.UnitOfWork
 	Logger        logger.LoggerInterface
 	Observability observability.TraceLoggerObservability
 	Cache         category_cache.CategoryMencache
@@ -34,6 +40,7 @@ type CategoryServiceDeps struct {
 func NewCategoryService(deps CategoryServiceDeps) *categoryService {
 	return &categoryService{
 		categoryRepository: deps.CategoryRepo,
+		unitOfWork:         deps.UnitOfWork,
 		logger:             deps.Logger,
 		observability:      deps.Observability,
 		cache:              deps.Cache,
@@ -508,23 +515,24 @@ func (s *categoryService) FindYearlyTotalPriceByMerchant(ctx context.Context, re
 	return res, nil
 }
 
-func (s *categoryService) FindMonthPrice(ctx context.Context, year int) ([]*db.GetMonthlyCategoryRow, error) {
+func (s *categoryService) FindMonthPrice(ctx context.Context, req *requests.MonthPrice) ([]*db.GetMonthlyCategoryRow, error) {
 	const method = "FindMonthPrice"
 
 	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
-		attribute.Int("year", year))
+		attribute.Int("year", req.Year),
+		attribute.Bool("include_subcategories", req.IncludeSubcategories))
 
 	defer func() {
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthPriceCache(ctx, year); found {
+	if data, found := s.cache.GetCachedMonthPriceCache(ctx, req); found {
 		logSuccess("Successfully retrieved month price from cache",
-			zap.Int("year", year))
+			zap.Int("year", req.Year))
 		return data, nil
 	}
 
-	res, err := s.categoryRepository.GetMonthPrice(ctx, year)
+	res, err := s.categoryRepository.GetMonthPrice(ctx, req)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyCategoryRow](
@@ -532,35 +540,36 @@ func (s *categoryService) FindMonthPrice(ctx context.Context, year int) ([]*db.G
 			category_errors.ErrFailedFindMonthPrice,
 			method,
 			span,
-			zap.Int("year", year))
+			zap.Int("year", req.Year))
 	}
 
-	s.cache.SetCachedMonthPriceCache(ctx, year, res)
+	s.cache.SetCachedMonthPriceCache(ctx, req, res)
 
 	logSuccess("Successfully fetched month price",
-		zap.Int("year", year),
+		zap.Int("year", req.Year),
 		zap.Int("count", len(res)))
 
 	return res, nil
 }
 
-func (s *categoryService) FindYearPrice(ctx context.Context, year int) ([]*db.GetYearlyCategoryRow, error) {
+func (s *categoryService) FindYearPrice(ctx context.Context, req *requests.YearPrice) ([]*db.GetYearlyCategoryRow, error) {
 	const method = "FindYearPrice"
 
 	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
-		attribute.Int("year", year))
+		attribute.Int("year", req.Year),
+		attribute.Bool("include_subcategories", req.IncludeSubcategories))
 
 	defer func() {
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearPriceCache(ctx, year); found {
+	if data, found := s.cache.GetCachedYearPriceCache(ctx, req); found {
 		logSuccess("Successfully retrieved year price from cache",
-			zap.Int("year", year))
+			zap.Int("year", req.Year))
 		return data, nil
 	}
 
-	res, err := s.categoryRepository.GetYearPrice(ctx, year)
+	res, err := s.categoryRepository.GetYearPrice(ctx, req)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyCategoryRow](
@@ -568,13 +577,13 @@ func (s *categoryService) FindYearPrice(ctx context.Context, year int) ([]*db.Ge
 			category_errors.ErrFailedFindYearPrice,
 			method,
 			span,
-			zap.Int("year", year))
+			zap.Int("year", req.Year))
 	}
 
-	s.cache.SetCachedYearPriceCache(ctx, year, res)
+	s.cache.SetCachedYearPriceCache(ctx, req, res)
 
 	logSuccess("Successfully fetched year price",
-		zap.Int("year", year),
+		zap.Int("year", req.Year),
 		zap.Int("count", len(res)))
 
 	return res, nil
@@ -740,6 +749,9 @@ func (s *categoryService) FindYearPriceById(ctx context.Context, req *requests.Y
 	return res, nil
 }
 
+// CreateCategory adds a category below req.ParentID, after its existing
+// siblings. A merchant category may sit below a global category or one of
+// the same merchant; a global category only below another global one.
 func (s *categoryService) CreateCategory(ctx context.Context, req *requests.CreateCategoryRequest) (*db.CreateCategoryRow, error) {
 	const method = "CreateCategory"
 
@@ -750,9 +762,26 @@ func (s *categoryService) CreateCategory(ctx context.Context, req *requests.Crea
 		end(status)
 	}()
 
+	if err := s.checkMerchantAccess(ctx, method, span, req.MerchantID); err != nil {
+		status = "error"
+		return nil, err
+	}
+
 	slug := utils.GenerateSlug(req.Name)
 	req.SlugCategory = &slug
 
+	if req.ParentID != nil {
+		if err := s.checkParent(ctx, s.categoryRepository, method, span, *req.ParentID, req.MerchantID); err != nil {
+			status = "error"
+			return nil, err
+		}
+	}
+
+	if err := s.checkSlugFree(ctx, method, span, slug, nil, req.MerchantID); err != nil {
+		status = "error"
+		return nil, err
+	}
+
 	category, err := s.categoryRepository.CreateCategory(ctx, req)
 	if err != nil {
 		status = "error"
@@ -787,6 +816,23 @@ func (s *categoryService) UpdateCategory(ctx context.Context, req *requests.Upda
 	slug := utils.GenerateSlug(req.Name)
 	req.SlugCategory = &slug
 
+	current, err := s.categoryRepository.FindById(ctx, *req.CategoryID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.UpdateCategoryRow](
+			s.logger,
+			category_errors.ErrCategoryNotFoundRes,
+			method,
+			span,
+			zap.Int("category_id", *req.CategoryID),
+			zap.Error(err))
+	}
+
+	if err := s.checkSlugFree(ctx, method, span, slug, req.CategoryID, toIntPtr(current.MerchantID)); err != nil {
+		status = "error"
+		return nil, err
+	}
+
 	category, err := s.categoryRepository.UpdateCategory(ctx, req)
 	if err != nil {
 		status = "error"
@@ -807,6 +853,286 @@ func (s *categoryService) UpdateCategory(ctx context.Context, req *requests.Upda
 	return category, nil
 }
 
+// FindTree returns the active categories visible to a merchant, its own and
+// the global ones, or only the global ones when merchantID is nil. Every
+// category comes after its parent and siblings follow their position.
+func (s *categoryService) FindTree(ctx context.Context, merchantID *int) ([]*db.GetCategoryTreeRow, error) {
+	const method = "FindCategoryTree"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method)
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.categoryRepository.FindTree(ctx, merchantID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.GetCategoryTreeRow](
+			s.logger,
+			category_errors.ErrFailedFindCategoryTree,
+			method,
+			span,
+			zap.Any("merchant_id", merchantID),
+			zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched category tree",
+		zap.Any("merchant_id", merchantID),
+		zap.Int("count", len(res)))
+
+	return res, nil
+}
+
+// MoveCategory places a category below another parent, or at the root, at
+// the requested position among its new siblings. The category takes its
+// whole subtree along, so the new parent must not be inside that subtree.
+// The category keeps its owner, which limits the parents it can have the
+// same way CreateCategory does.
+func (s *categoryService) MoveCategory(ctx context.Context, req *requests.MoveCategoryRequest) (*db.Category, error) {
+	const method = "MoveCategory"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("category_id", req.CategoryID))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res *db.Category
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		if err := repos.Category.LockTree(ctx); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedMoveCategory,
+				method,
+				span,
+				zap.Error(err))
+		}
+
+		category, err := repos.Category.FindById(ctx, req.CategoryID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrCategoryNotFoundRes,
+				method,
+				span,
+				zap.Int("category_id", req.CategoryID),
+				zap.Error(err))
+		}
+
+		merchantID := toIntPtr(category.MerchantID)
+		oldParentID := toIntPtr(category.ParentID)
+
+		if req.ParentID != nil {
+			if err := s.checkParent(ctx, repos.Category, method, span, *req.ParentID, merchantID); err != nil {
+				return err
+			}
+
+			subtree, err := repos.Category.FindSubtreeIds(ctx, req.CategoryID)
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					category_errors.ErrFailedMoveCategory,
+					method,
+					span,
+					zap.Int("category_id", req.CategoryID),
+					zap.Error(err))
+			}
+
+			if slices.Contains(subtree, *req.ParentID) {
+				return errorhandler.HandleTxError(
+					s.logger,
+					category_errors.ErrFailedCategoryCycle,
+					method,
+					span,
+					zap.Int("category_id", req.CategoryID),
+					zap.Int("parent_id", *req.ParentID))
+			}
+		}
+
+		res, err = repos.Category.UpdateParent(ctx, req.CategoryID, req.ParentID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedMoveCategory,
+				method,
+				span,
+				zap.Int("category_id", req.CategoryID),
+				zap.Error(err))
+		}
+
+		siblings, err := repos.Category.FindSiblings(ctx, req.ParentID, merchantID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedMoveCategory,
+				method,
+				span,
+				zap.Int("category_id", req.CategoryID),
+				zap.Error(err))
+		}
+
+		ids := make([]int, 0, len(siblings))
+		for _, sibling := range siblings {
+			if int(sibling.CategoryID) != req.CategoryID {
+				ids = append(ids, int(sibling.CategoryID))
+			}
+		}
+
+		position := len(ids)
+		if req.Position != nil && *req.Position < position {
+			position = *req.Position
+		}
+
+		ids = slices.Insert(ids, position, req.CategoryID)
+
+		if err := repos.Category.Reorder(ctx, ids); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedMoveCategory,
+				method,
+				span,
+				zap.Int("category_id", req.CategoryID),
+				zap.Error(err))
+		}
+
+		res.Position = int32(position)
+
+		if sameParent(oldParentID, req.ParentID) {
+			return nil
+		}
+
+		// Close the gap the category left among its old siblings.
+		previous, err := repos.Category.FindSiblings(ctx, oldParentID, merchantID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedMoveCategory,
+				method,
+				span,
+				zap.Int("category_id", req.CategoryID),
+				zap.Error(err))
+		}
+
+		if err := repos.Category.Reorder(ctx, categoryIDs(previous)); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedMoveCategory,
+				method,
+				span,
+				zap.Int("category_id", req.CategoryID),
+				zap.Error(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteCachedCategoryCache(ctx, req.CategoryID)
+
+	logSuccess("Successfully moved category",
+		zap.Int("category_id", req.CategoryID),
+		zap.Any("parent_id", req.ParentID),
+		zap.Int32("position", res.Position))
+
+	return res, nil
+}
+
+// ReorderCategories sets the order of the categories below a parent that
+// share an owner. The request has to list each of them exactly once.
+func (s *categoryService) ReorderCategories(ctx context.Context, req *requests.ReorderCategoriesRequest) ([]*db.Category, error) {
+	const method = "ReorderCategories"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("count", len(req.CategoryIDs)))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res []*db.Category
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		if err := repos.Category.LockTree(ctx); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedReorderCategories,
+				method,
+				span,
+				zap.Error(err))
+		}
+
+		siblings, err := repos.Category.FindSiblings(ctx, req.ParentID, req.MerchantID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedReorderCategories,
+				method,
+				span,
+				zap.Any("parent_id", req.ParentID),
+				zap.Any("merchant_id", req.MerchantID),
+				zap.Error(err))
+		}
+
+		current := categoryIDs(siblings)
+		wanted := slices.Clone(req.CategoryIDs)
+		slices.Sort(current)
+		slices.Sort(wanted)
+
+		if !slices.Equal(current, wanted) {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedInvalidReorder,
+				method,
+				span,
+				zap.Ints("siblings", current),
+				zap.Ints("category_ids", req.CategoryIDs))
+		}
+
+		if err := repos.Category.Reorder(ctx, req.CategoryIDs); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedReorderCategories,
+				method,
+				span,
+				zap.Ints("category_ids", req.CategoryIDs),
+				zap.Error(err))
+		}
+
+		res, err = repos.Category.FindSiblings(ctx, req.ParentID, req.MerchantID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedReorderCategories,
+				method,
+				span,
+				zap.Error(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	for _, category := range res {
+		s.cache.DeleteCachedCategoryCache(ctx, int(category.CategoryID))
+	}
+
+	logSuccess("Successfully reordered categories",
+		zap.Ints("category_ids", req.CategoryIDs))
+
+	return res, nil
+}
+
+// TrashedCategory moves a category to the trash. Its active subcategories
+// have to be moved or trashed first.
 func (s *categoryService) TrashedCategory(ctx context.Context, category_id int) (*db.Category, error) {
 	const method = "TrashedCategory"
 
@@ -817,6 +1143,29 @@ func (s *categoryService) TrashedCategory(ctx context.Context, category_id int)
 		end(status)
 	}()
 
+	children, err := s.categoryRepository.CountActiveChildren(ctx, category_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Category](
+			s.logger,
+			category_errors.ErrFailedCountSubcategories,
+			method,
+			span,
+			zap.Int("category_id", category_id),
+			zap.Error(err))
+	}
+
+	if children > 0 {
+		status = "error"
+		return errorhandler.HandleError[*db.Category](
+			s.logger,
+			category_errors.ErrFailedCategoryHasChildren,
+			method,
+			span,
+			zap.Int("category_id", category_id),
+			zap.Int("children", children))
+	}
+
 	category, err := s.categoryRepository.TrashedCategory(ctx, category_id)
 	if err != nil {
 		status = "error"
@@ -836,6 +1185,8 @@ func (s *categoryService) TrashedCategory(ctx context.Context, category_id int)
 	return category, nil
 }
 
+// RestoreCategory takes a category out of the trash. Its parent has to be
+// restored first.
 func (s *categoryService) RestoreCategory(ctx context.Context, categoryID int) (*db.Category, error) {
 	const method = "RestoreCategory"
 
@@ -846,15 +1197,43 @@ func (s *categoryService) RestoreCategory(ctx context.Context, categoryID int) (
 		end(status)
 	}()
 
-	category, err := s.categoryRepository.RestoreCategory(ctx, categoryID)
+	var category *db.Category
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		var err error
+
+		category, err = repos.Category.RestoreCategory(ctx, categoryID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedRestoreCategory,
+				method,
+				span,
+				zap.Int("category_id", categoryID),
+				zap.Error(err))
+		}
+
+		if category.ParentID == nil {
+			return nil
+		}
+
+		// A restored category must not hang below one that is still in the
+		// trash.
+		if _, err := repos.Category.FindById(ctx, int(*category.ParentID)); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedParentNotFound,
+				method,
+				span,
+				zap.Int("category_id", categoryID),
+				zap.Int32("parent_id", *category.ParentID))
+		}
+
+		return nil
+	})
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*db.Category](
-			s.logger,
-			category_errors.ErrFailedRestoreCategory,
-			method,
-			span,
-			zap.Int("category_id", categoryID))
+		return nil, err
 	}
 
 	s.cache.DeleteCachedCategoryCache(ctx, categoryID)
@@ -913,7 +1292,7 @@ func (s *categoryService) RestoreAllCategories(ctx context.Context) (bool, error
 			span)
 	}
 
-	s.logger.Debug("All category caches should be invalidated after restore all operation")
+	s.cache.InvalidateCategoryCache(ctx)
 
 	logSuccess("Successfully restored all trashed categories")
 
@@ -939,9 +1318,109 @@ func (s *categoryService) DeleteAllPermanentCategories(ctx context.Context) (boo
 			span)
 	}
 
-	s.logger.Debug("All category caches should be invalidated after delete all operation")
+	s.cache.InvalidateCategoryCache(ctx)
 
 	logSuccess("Successfully permanently deleted all trashed categories")
 
 	return success, nil
 }
+
+// checkParent verifies that parentID is an active category that a category
+// owned by merchantID, nil for global, may sit below.
+func (s *categoryService) checkParent(ctx context.Context, repo repository.CategoryRepository, method string, span trace.Span, parentID int, merchantID *int) error {
+	parent, err := repo.FindById(ctx, parentID)
+	if err != nil {
+		return errorhandler.HandleTxError(
+			s.logger,
+			category_errors.ErrFailedParentNotFound,
+			method,
+			span,
+			zap.Int("parent_id", parentID),
+			zap.Error(err))
+	}
+
+	if parent.MerchantID != nil && (merchantID == nil || int(*parent.MerchantID) != *merchantID) {
+		return errorhandler.HandleTxError(
+			s.logger,
+			category_errors.ErrFailedParentScope,
+			method,
+			span,
+			zap.Int("parent_id", parentID),
+			zap.Any("merchant_id", merchantID))
+	}
+
+	return nil
+}
+
+// checkMerchantAccess rejects a category of a merchant the caller may not
+// act for. Global categories are shared by every merchant, so only callers
+// without a merchant scope may create them.
+func (s *categoryService) checkMerchantAccess(ctx context.Context, method string, span trace.Span, merchantID *int) error {
+	if merchantID == nil {
+		if _, restricted := auth.MerchantScopeFromContext(ctx); restricted {
+			return errorhandler.HandleTxError(
+				s.logger,
+				category_errors.ErrFailedGlobalCategoryForbidden,
+				method,
+				span)
+		}
+
+		return nil
+	}
+
+	if !auth.CanActForMerchant(ctx, *merchantID) {
+		return errorhandler.HandleTxError(
+			s.logger,
+			merchant_errors.ErrFailedMerchantNotFound,
+			method,
+			span,
+			zap.Int("merchant_id", *merchantID))
+	}
+
+	return nil
+}
+
+// checkSlugFree rejects a slug that another category in the same scope
+// already uses. A merchant category must not share its slug with a global
+// category either, so slugs stay unambiguous in the merchant's tree.
+func (s *categoryService) checkSlugFree(ctx context.Context, method string, span trace.Span, slug string, categoryID *int, merchantID *int) error {
+	conflicts, err := s.categoryRepository.CountSlugConflicts(ctx, slug, categoryID, merchantID)
+	if err != nil {
+		return errorhandler.HandleTxError(
+			s.logger,
+			category_errors.ErrFailedCheckCategorySlug,
+			method,
+			span,
+			zap.String("slug", slug),
+			zap.Error(err))
+	}
+
+	if conflicts > 0 {
+		return errorhandler.HandleTxError(
+			s.logger,
+			category_errors.ErrFailedSlugConflict,
+			method,
+			span,
+			zap.String("slug", slug),
+			zap.Any("merchant_id", merchantID))
+	}
+
+	return nil
+}
+
+func categoryIDs(categories []*db.Category) []int {
+	ids := make([]int, len(categories))
+	for i, category := range categories {
+		ids[i] = int(category.CategoryID)
+	}
+
+	return ids
+}
+
+func sameParent(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
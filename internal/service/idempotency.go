@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	idempotency_cache "pointofsale/internal/cache/idempotency"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/idempotency_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	// idempotencyLockTTL bounds how long a claimed key blocks retries when
+	// the request holding it never finishes.
+	idempotencyLockTTL = time.Minute
+
+	// idempotencyRetention is how long a completed response is replayed.
+	idempotencyRetention = 24 * time.Hour
+)
+
+type idempotencyService struct {
+	idempotencyKeyRepository repository.IdempotencyKeyRepository
+	logger                   logger.LoggerInterface
+	observability            observability.TraceLoggerObservability
+	cache                    idempotency_cache.IdempotencyCache
+}
+
+type IdempotencyServiceDeps struct {
+	IdempotencyKeyRepo repository.IdempotencyKeyRepository
+	Logger             logger.LoggerInterface
+	Observability      observability.TraceLoggerObservability
+	Cache              idempotency_cache.IdempotencyCache
+}
+
+func NewIdempotencyService(deps IdempotencyServiceDeps) *idempotencyService {
+	return &idempotencyService{
+		idempotencyKeyRepository: deps.IdempotencyKeyRepo,
+		logger:                   deps.Logger,
+		observability:            deps.Observability,
+		cache:                    deps.Cache,
+	}
+}
+
+// Begin claims the key of an incoming request. A returned key with a nil
+// Response belongs to the caller, who must Complete or Release it; otherwise
+// it holds the response to replay. A key reused with another payload, or
+// still held by a concurrent request, is rejected with a conflict.
+func (s *idempotencyService) Begin(ctx context.Context, req *requests.IdempotencyRequest) (*db.IdempotencyKey, error) {
+	const method = "BeginIdempotentRequest"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("user_id", req.UserID),
+		attribute.String("method", req.Method))
+
+	defer func() {
+		end(status)
+	}()
+
+	if err := req.Validate(); err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.IdempotencyKey](
+			s.logger,
+			idempotency_errors.ErrInvalidIdempotencyKey,
+			method,
+			span,
+			zap.Int("user_id", req.UserID),
+			zap.String("method", req.Method))
+	}
+
+	if cached, found := s.cache.GetCachedIdempotencyKey(ctx, req.UserID, req.Method, req.Key); found {
+		if cached.RequestHash != req.RequestHash {
+			status = "error"
+			return errorhandler.HandleError[*db.IdempotencyKey](
+				s.logger,
+				idempotency_errors.ErrIdempotencyKeyReused,
+				method,
+				span,
+				zap.Int("user_id", req.UserID),
+				zap.String("method", req.Method))
+		}
+
+		logSuccess("Replaying idempotent response from cache",
+			zap.Int("idempotency_key_id", int(cached.IdempotencyKeyID)))
+
+		return cached, nil
+	}
+
+	now := time.Now().UTC()
+
+	claim, err := s.idempotencyKeyRepository.Reserve(ctx, &requests.ReserveIdempotencyKeyRecordRequest{
+		UserID:      req.UserID,
+		Method:      req.Method,
+		Key:         req.Key,
+		RequestHash: req.RequestHash,
+		LockedUntil: now.Add(idempotencyLockTTL),
+		ExpiresAt:   now.Add(idempotencyRetention),
+	})
+	if err == nil {
+		logSuccess("Reserved idempotency key",
+			zap.Int("idempotency_key_id", int(claim.IdempotencyKeyID)))
+
+		return claim, nil
+	}
+
+	if !errors.Is(err, idempotency_errors.ErrIdempotencyKeyHeld) {
+		status = "error"
+		return errorhandler.HandleError[*db.IdempotencyKey](
+			s.logger,
+			idempotency_errors.ErrFailedReserveIdempotencyKey,
+			method,
+			span,
+			zap.Int("user_id", req.UserID),
+			zap.String("method", req.Method))
+	}
+
+	existing, err := s.idempotencyKeyRepository.FindByKey(ctx, req.UserID, req.Method, req.Key)
+	if err != nil {
+		// The holder released the key between both queries; the client
+		// may simply retry.
+		status = "error"
+		return errorhandler.HandleError[*db.IdempotencyKey](
+			s.logger,
+			idempotency_errors.ErrIdempotencyKeyInProgress,
+			method,
+			span,
+			zap.Int("user_id", req.UserID),
+			zap.String("method", req.Method))
+	}
+
+	if existing.RequestHash != req.RequestHash {
+		status = "error"
+		return errorhandler.HandleError[*db.IdempotencyKey](
+			s.logger,
+			idempotency_errors.ErrIdempotencyKeyReused,
+			method,
+			span,
+			zap.Int("user_id", req.UserID),
+			zap.String("method", req.Method))
+	}
+
+	if existing.Response == nil {
+		status = "error"
+		return errorhandler.HandleError[*db.IdempotencyKey](
+			s.logger,
+			idempotency_errors.ErrIdempotencyKeyInProgress,
+			method,
+			span,
+			zap.Int("user_id", req.UserID),
+			zap.String("method", req.Method))
+	}
+
+	s.cache.SetCachedIdempotencyKey(ctx, existing)
+
+	logSuccess("Replaying idempotent response",
+		zap.Int("idempotency_key_id", int(existing.IdempotencyKeyID)))
+
+	return existing, nil
+}
+
+// Complete stores the response of the request that claimed the key.
+func (s *idempotencyService) Complete(ctx context.Context, claim *db.IdempotencyKey, response []byte) error {
+	const method = "CompleteIdempotentRequest"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("idempotency_key_id", int(claim.IdempotencyKeyID)))
+
+	defer func() {
+		end(status)
+	}()
+
+	completed, err := s.idempotencyKeyRepository.Complete(ctx, int(claim.IdempotencyKeyID), response)
+	if err != nil {
+		status = "error"
+		_, err = errorhandler.HandleError[*db.IdempotencyKey](
+			s.logger,
+			idempotency_errors.ErrFailedCompleteIdempotencyKey,
+			method,
+			span,
+			zap.Int("idempotency_key_id", int(claim.IdempotencyKeyID)))
+		return err
+	}
+
+	s.cache.SetCachedIdempotencyKey(ctx, completed)
+
+	logSuccess("Stored idempotent response",
+		zap.Int("idempotency_key_id", int(claim.IdempotencyKeyID)))
+
+	return nil
+}
+
+// Release drops the claim of a request that failed so it can be retried.
+func (s *idempotencyService) Release(ctx context.Context, claim *db.IdempotencyKey) error {
+	const method = "ReleaseIdempotentRequest"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("idempotency_key_id", int(claim.IdempotencyKeyID)))
+
+	defer func() {
+		end(status)
+	}()
+
+	if err := s.idempotencyKeyRepository.Release(ctx, int(claim.IdempotencyKeyID)); err != nil {
+		status = "error"
+		_, err = errorhandler.HandleError[*db.IdempotencyKey](
+			s.logger,
+			idempotency_errors.ErrFailedReleaseIdempotencyKey,
+			method,
+			span,
+			zap.Int("idempotency_key_id", int(claim.IdempotencyKeyID)))
+		return err
+	}
+
+	logSuccess("Released idempotency key",
+		zap.Int("idempotency_key_id", int(claim.IdempotencyKeyID)))
+
+	return nil
+}
+
+// PurgeExpired deletes keys whose responses are no longer replayed.
+func (s *idempotencyService) PurgeExpired(ctx context.Context) (int64, error) {
+	const method = "PurgeExpiredIdempotencyKeys"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method)
+
+	defer func() {
+		end(status)
+	}()
+
+	deleted, err := s.idempotencyKeyRepository.DeleteExpired(ctx)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[int64](
+			s.logger,
+			idempotency_errors.ErrFailedPurgeIdempotencyKeys,
+			method,
+			span)
+	}
+
+	logSuccess("Purged expired idempotency keys", zap.Int64("deleted", deleted))
+
+	return deleted, nil
+}
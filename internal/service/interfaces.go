@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"pointofsale/internal/cache"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/domain/response"
 	db "pointofsale/pkg/database/schema"
@@ -13,6 +14,10 @@ type AuthService interface {
 	Login(ctx context.Context, request *requests.AuthRequest) (*response.TokenResponse, error)
 	RefreshToken(ctx context.Context, token string) (*response.TokenResponse, error)
 	GetMe(ctx context.Context, token string) (*db.GetUserByIDRow, error)
+	Logout(ctx context.Context, req *requests.LogoutRequest) (bool, error)
+	LogoutAll(ctx context.Context, req *requests.LogoutRequest) (bool, error)
+	FindSessions(ctx context.Context, user_id int) ([]*db.RefreshToken, error)
+	PurgeExpiredSessions(ctx context.Context) (int64, error)
 }
 
 type RoleService interface {
@@ -54,8 +59,8 @@ type CategoryService interface {
 
 	FindMonthlyTotalPrice(ctx context.Context, req *requests.MonthTotalPrice) ([]*db.GetMonthlyTotalPriceRow, error)
 	FindYearlyTotalPrice(ctx context.Context, year int) ([]*db.GetYearlyTotalPriceRow, error)
-	FindMonthPrice(ctx context.Context, year int) ([]*db.GetMonthlyCategoryRow, error)
-	FindYearPrice(ctx context.Context, year int) ([]*db.GetYearlyCategoryRow, error)
+	FindMonthPrice(ctx context.Context, req *requests.MonthPrice) ([]*db.GetMonthlyCategoryRow, error)
+	FindYearPrice(ctx context.Context, req *requests.YearPrice) ([]*db.GetYearlyCategoryRow, error)
 
 	FindMonthlyTotalPriceById(ctx context.Context, req *requests.MonthTotalPriceCategory) ([]*db.GetMonthlyTotalPriceByIdRow, error)
 	FindYearlyTotalPriceById(ctx context.Context, req *requests.YearTotalPriceCategory) ([]*db.GetYearlyTotalPriceByIdRow, error)
@@ -69,6 +74,11 @@ type CategoryService interface {
 
 	CreateCategory(ctx context.Context, req *requests.CreateCategoryRequest) (*db.CreateCategoryRow, error)
 	UpdateCategory(ctx context.Context, req *requests.UpdateCategoryRequest) (*db.UpdateCategoryRow, error)
+
+	FindTree(ctx context.Context, merchantID *int) ([]*db.GetCategoryTreeRow, error)
+	MoveCategory(ctx context.Context, req *requests.MoveCategoryRequest) (*db.Category, error)
+	ReorderCategories(ctx context.Context, req *requests.ReorderCategoriesRequest) ([]*db.Category, error)
+
 	TrashedCategory(ctx context.Context, category_id int) (*db.Category, error)
 	RestoreCategory(ctx context.Context, categoryID int) (*db.Category, error)
 	DeleteCategoryPermanently(ctx context.Context, categoryID int) (bool, error)
@@ -111,9 +121,11 @@ type MerchantService interface {
 	FindByActive(ctx context.Context, req *requests.FindAllMerchants) ([]*db.GetMerchantsActiveRow, *int, error)
 	FindByTrashed(ctx context.Context, req *requests.FindAllMerchants) ([]*db.GetMerchantsTrashedRow, *int, error)
 	FindById(ctx context.Context, user_id int) (*db.GetMerchantByIDRow, error)
+	FindScopeByUser(ctx context.Context, user_id int) ([]int, error)
 
 	CreateMerchant(ctx context.Context, request *requests.CreateMerchantRequest) (*db.CreateMerchantRow, error)
 	UpdateMerchant(ctx context.Context, request *requests.UpdateMerchantRequest) (*db.UpdateMerchantRow, error)
+	UpdateBarcodeSettings(ctx context.Context, request *requests.UpdateMerchantBarcodeSettingsRequest) (*db.UpdateMerchantBarcodeSettingsRow, error)
 	TrashedMerchant(ctx context.Context, merchant_id int) (*db.Merchant, error)
 	RestoreMerchant(ctx context.Context, merchant_id int) (*db.Merchant, error)
 	DeleteMerchantPermanent(ctx context.Context, merchant_id int) (bool, error)
@@ -149,6 +161,7 @@ type OrderService interface {
 
 	CreateOrder(ctx context.Context, request *requests.CreateOrderRequest) (*db.UpdateOrderRow, error)
 	UpdateOrder(ctx context.Context, request *requests.UpdateOrderRequest) (*db.UpdateOrderRow, error)
+	UpdateOrderStatus(ctx context.Context, req *requests.UpdateOrderStatusRequest) (*db.GetOrderByIDRow, error)
 
 	TrashedOrder(ctx context.Context, order_id int) (*db.Order, error)
 	RestoreOrder(ctx context.Context, order_id int) (*db.Order, error)
@@ -164,9 +177,15 @@ type ProductService interface {
 	FindByMerchant(ctx context.Context, req *requests.ProductByMerchantRequest) ([]*db.GetProductsByMerchantRow, *int, error)
 	FindByCategory(ctx context.Context, req *requests.ProductByCategoryRequest) ([]*db.GetProductsByCategoryNameRow, *int, error)
 	FindById(ctx context.Context, product_id int) (*db.GetProductByIDRow, error)
+	FindByBarcode(ctx context.Context, barcode string) (*db.GetProductByScanRow, error)
+	FindStockMovements(ctx context.Context, req *requests.FindStockMovementsRequest) ([]*db.GetStockMovementsByProductRow, *int, error)
+	FindLowStock(ctx context.Context, req *requests.FindLowStockProducts) ([]*db.GetLowStockProductsRow, *int, error)
 
 	CreateProduct(ctx context.Context, request *requests.CreateProductRequest) (*db.CreateProductRow, error)
 	UpdateProduct(ctx context.Context, request *requests.UpdateProductRequest) (*db.UpdateProductRow, error)
+	UpdateReorderLevel(ctx context.Context, request *requests.UpdateProductReorderLevelRequest) (*db.UpdateProductReorderLevelRow, error)
+	ImportProducts(ctx context.Context, req *requests.ImportProductsRequest) (*response.ProductImportResponse, error)
+	ExportProducts(ctx context.Context, req *requests.ExportProductsRequest) ([]byte, error)
 
 	TrashedProduct(ctx context.Context, product_id int) (*db.Product, error)
 	RestoreProduct(ctx context.Context, product_id int) (*db.Product, error)
@@ -181,6 +200,8 @@ type TransactionService interface {
 	FindByActive(ctx context.Context, req *requests.FindAllTransaction) ([]*db.GetTransactionsActiveRow, *int, error)
 	FindByTrashed(ctx context.Context, req *requests.FindAllTransaction) ([]*db.GetTransactionsTrashedRow, *int, error)
 	FindById(ctx context.Context, transactionID int) (*db.GetTransactionByIDRow, error)
+	FindPayments(ctx context.Context, transactionID int) ([]*db.TransactionPayment, error)
+	FindRefunds(ctx context.Context, transactionID int) ([]*db.TransactionRefund, error)
 	FindByOrderId(ctx context.Context, orderID int) (*db.GetTransactionByOrderIDRow, error)
 
 	FindMonthlyAmountSuccess(ctx context.Context, req *requests.MonthAmountTransaction) ([]*db.GetMonthlyAmountTransactionSuccessRow, error)
@@ -203,9 +224,81 @@ type TransactionService interface {
 
 	CreateTransaction(ctx context.Context, req *requests.CreateTransactionRequest) (*db.CreateTransactionRow, error)
 	UpdateTransaction(ctx context.Context, req *requests.UpdateTransactionRequest) (*db.UpdateTransactionRow, error)
+	RefundTransaction(ctx context.Context, req *requests.RefundTransactionRequest) (*db.TransactionRefund, error)
+	VoidTransaction(ctx context.Context, req *requests.VoidTransactionRequest) (*db.TransactionRefund, error)
 	TrashedTransaction(ctx context.Context, transaction_id int) (*db.Transaction, error)
 	RestoreTransaction(ctx context.Context, transaction_id int) (*db.Transaction, error)
 	DeleteTransactionPermanently(ctx context.Context, transactionID int) (bool, error)
 	RestoreAllTransactions(ctx context.Context) (bool, error)
 	DeleteAllTransactionPermanent(ctx context.Context) (bool, error)
 }
+
+type TaxService interface {
+	FindAll(ctx context.Context, req *requests.FindAllTaxRates) ([]*db.GetTaxRatesRow, *int, error)
+	FindById(ctx context.Context, tax_rate_id int) (*db.TaxRate, error)
+	FindTransactionTax(ctx context.Context, transaction_id int) ([]*db.GetOrderItemTaxLinesRow, error)
+	CreateTaxRate(ctx context.Context, req *requests.CreateTaxRateRequest) (*db.TaxRate, error)
+	UpdateTaxRate(ctx context.Context, req *requests.UpdateTaxRateRequest) (*db.TaxRate, error)
+	TrashedTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error)
+	RestoreTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error)
+	DeleteTaxRatePermanent(ctx context.Context, tax_rate_id int) (bool, error)
+}
+
+type IdempotencyService interface {
+	Begin(ctx context.Context, req *requests.IdempotencyRequest) (*db.IdempotencyKey, error)
+	Complete(ctx context.Context, claim *db.IdempotencyKey, response []byte) error
+	Release(ctx context.Context, claim *db.IdempotencyKey) error
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+type CacheService interface {
+	FindAllNamespaces(ctx context.Context) ([]*cache.NamespaceStats, error)
+	FindNamespace(ctx context.Context, namespace string) (*cache.NamespaceStats, error)
+	FlushNamespace(ctx context.Context, namespace string) (int64, error)
+}
+
+type StockAlertService interface {
+	EvaluateLowStock(ctx context.Context) (int, error)
+}
+
+type SupplierService interface {
+	FindAll(ctx context.Context, req *requests.FindAllSuppliers) ([]*db.GetSuppliersRow, *int, error)
+	FindById(ctx context.Context, supplier_id int) (*db.Supplier, error)
+	CreateSupplier(ctx context.Context, req *requests.CreateSupplierRequest) (*db.Supplier, error)
+	UpdateSupplier(ctx context.Context, req *requests.UpdateSupplierRequest) (*db.Supplier, error)
+	TrashedSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error)
+	RestoreSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error)
+	DeleteSupplierPermanent(ctx context.Context, supplier_id int) (bool, error)
+}
+
+type PurchaseOrderService interface {
+	FindAll(ctx context.Context, req *requests.FindAllPurchaseOrders) ([]*db.GetPurchaseOrdersRow, *int, error)
+	FindById(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error)
+	FindItems(ctx context.Context, purchase_order_id int) ([]*db.PurchaseOrderItem, error)
+	FindReceipts(ctx context.Context, purchase_order_id int) ([]*db.GetPurchaseOrderReceiptsRow, error)
+	CreatePurchaseOrder(ctx context.Context, req *requests.CreatePurchaseOrderRequest) (*db.PurchaseOrder, error)
+	SubmitPurchaseOrder(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error)
+	ReceivePurchaseOrder(ctx context.Context, req *requests.ReceivePurchaseOrderRequest) (*db.PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error)
+}
+
+type ProductVariantService interface {
+	FindOptions(ctx context.Context, product_id int) ([]*db.ProductOption, error)
+	FindByProduct(ctx context.Context, product_id int) ([]*db.ProductVariant, error)
+	FindById(ctx context.Context, variant_id int) (*db.ProductVariant, error)
+	SetOptions(ctx context.Context, req *requests.SetProductOptionsRequest) ([]*db.ProductOption, error)
+	CreateVariant(ctx context.Context, req *requests.CreateProductVariantRequest) (*db.ProductVariant, error)
+	UpdateVariant(ctx context.Context, req *requests.UpdateProductVariantRequest) (*db.ProductVariant, error)
+	DeleteVariant(ctx context.Context, variant_id int) (*db.ProductVariant, error)
+}
+
+type StocktakeService interface {
+	FindAll(ctx context.Context, req *requests.FindAllStocktakes) ([]*db.GetStocktakesRow, *int, error)
+	FindById(ctx context.Context, stocktake_id int) (*db.Stocktake, error)
+	FindItems(ctx context.Context, req *requests.FindStocktakeItems) ([]*db.GetStocktakeItemsRow, *int, error)
+	FindVarianceReport(ctx context.Context, stocktake_id int) ([]*db.GetStocktakeVarianceByCategoryRow, error)
+	CreateStocktake(ctx context.Context, req *requests.CreateStocktakeRequest) (*db.Stocktake, error)
+	SubmitCounts(ctx context.Context, req *requests.SubmitStocktakeCountsRequest) ([]*db.StocktakeItem, error)
+	PostStocktake(ctx context.Context, stocktake_id int) (*db.Stocktake, error)
+	CancelStocktake(ctx context.Context, stocktake_id int) (*db.Stocktake, error)
+}
@@ -10,6 +10,7 @@ import (
 	"pointofsale/pkg/errors/merchant_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
+	"pointofsale/pkg/utils"
 
 	"go.opentelemetry.io/otel/attribute"
 
@@ -249,7 +250,7 @@ func (s *merchantService) FindById(ctx context.Context, merchantID int) (*db.Get
 		status = "error"
 		return errorhandler.HandleError[*db.GetMerchantByIDRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
 			span,
 			zap.Int("merchant_id", merchantID))
@@ -263,6 +264,36 @@ func (s *merchantService) FindById(ctx context.Context, merchantID int) (*db.Get
 	return merchant, nil
 }
 
+// FindScopeByUser returns the merchants userID may act for. It is not cached,
+// so removing a cashier takes effect on their next request.
+func (s *merchantService) FindScopeByUser(ctx context.Context, userID int) ([]int, error) {
+	const method = "FindScopeByUser"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("user_id", userID))
+
+	defer func() {
+		end(status)
+	}()
+
+	merchantIDs, err := s.merchantRepository.FindScopeByUser(ctx, userID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]int](
+			s.logger,
+			merchant_errors.ErrFailedFindScopeByUser,
+			method,
+			span,
+			zap.Int("user_id", userID))
+	}
+
+	logSuccess("Successfully resolved merchants of user",
+		zap.Int("user_id", userID),
+		zap.Int("merchants", len(merchantIDs)))
+
+	return merchantIDs, nil
+}
+
 func (s *merchantService) CreateMerchant(ctx context.Context, req *requests.CreateMerchantRequest) (*db.CreateMerchantRow, error) {
 	const method = "CreateMerchant"
 
@@ -310,7 +341,7 @@ func (s *merchantService) UpdateMerchant(ctx context.Context, req *requests.Upda
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateMerchantRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
 			span,
 			zap.Int("merchant_id", *req.MerchantID))
@@ -321,7 +352,7 @@ func (s *merchantService) UpdateMerchant(ctx context.Context, req *requests.Upda
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateMerchantRow](
 			s.logger,
-			merchant_errors.ErrFailedUpdateMerchant,
+			merchantError(err, merchant_errors.ErrFailedUpdateMerchant),
 			method,
 			span,
 			zap.Any("request", req))
@@ -336,6 +367,67 @@ func (s *merchantService) UpdateMerchant(ctx context.Context, req *requests.Upda
 	return merchant, nil
 }
 
+func (s *merchantService) UpdateBarcodeSettings(ctx context.Context, req *requests.UpdateMerchantBarcodeSettingsRequest) (*db.UpdateMerchantBarcodeSettingsRow, error) {
+	const method = "UpdateBarcodeSettings"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("merchant_id", *req.MerchantID),
+		attribute.String("barcode_format", req.BarcodeFormat))
+
+	defer func() {
+		end(status)
+	}()
+
+	prefix := ""
+	if req.Gs1Prefix != nil {
+		prefix = *req.Gs1Prefix
+	}
+
+	// Generating a throwaway code is the cheapest way to tell whether the
+	// prefix fits the format, e.g. UPC-A needs a prefix starting with 0.
+	if _, err := utils.GenerateBarcode(req.BarcodeFormat, prefix); err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.UpdateMerchantBarcodeSettingsRow](
+			s.logger,
+			merchant_errors.ErrFailedInvalidBarcodePrefix,
+			method,
+			span,
+			zap.Int("merchant_id", *req.MerchantID),
+			zap.String("gs1_prefix", prefix),
+			zap.String("barcode_format", req.BarcodeFormat))
+	}
+
+	_, err := s.merchantRepository.FindById(ctx, *req.MerchantID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.UpdateMerchantBarcodeSettingsRow](
+			s.logger,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
+			method,
+			span,
+			zap.Int("merchant_id", *req.MerchantID))
+	}
+
+	settings, err := s.merchantRepository.UpdateBarcodeSettings(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.UpdateMerchantBarcodeSettingsRow](
+			s.logger,
+			merchantError(err, merchant_errors.ErrFailedUpdateBarcodeSettings),
+			method,
+			span,
+			zap.Any("request", req))
+	}
+
+	s.Cache.DeleteCachedMerchant(ctx, int(settings.MerchantID))
+
+	logSuccess("Successfully updated merchant barcode settings",
+		zap.Int("merchant_id", int(settings.MerchantID)),
+		zap.String("barcode_format", settings.BarcodeFormat))
+
+	return settings, nil
+}
+
 func (s *merchantService) TrashedMerchant(ctx context.Context, merchantID int) (*db.Merchant, error) {
 	const method = "TrashedMerchant"
 
@@ -351,7 +443,7 @@ func (s *merchantService) TrashedMerchant(ctx context.Context, merchantID int) (
 		status = "error"
 		return errorhandler.HandleError[*db.Merchant](
 			s.logger,
-			merchant_errors.ErrFailedTrashMerchant,
+			merchantError(err, merchant_errors.ErrFailedTrashMerchant),
 			method,
 			span,
 			zap.Int("merchant_id", merchantID))
@@ -380,7 +472,7 @@ func (s *merchantService) RestoreMerchant(ctx context.Context, merchantID int) (
 		status = "error"
 		return errorhandler.HandleError[*db.Merchant](
 			s.logger,
-			merchant_errors.ErrFailedRestoreMerchant,
+			merchantError(err, merchant_errors.ErrFailedRestoreMerchant),
 			method,
 			span,
 			zap.Int("merchant_id", merchantID))
@@ -409,7 +501,7 @@ func (s *merchantService) DeleteMerchantPermanent(ctx context.Context, merchantI
 		status = "error"
 		return errorhandler.HandleError[bool](
 			s.logger,
-			merchant_errors.ErrFailedDeleteMerchantPermanent,
+			merchantError(err, merchant_errors.ErrFailedDeleteMerchantPermanent),
 			method,
 			span,
 			zap.Int("merchant_id", merchantID))
@@ -442,7 +534,7 @@ func (s *merchantService) RestoreAllMerchant(ctx context.Context) (bool, error)
 			span)
 	}
 
-	s.logger.Debug("All merchant caches should be invalidated after restore all operation")
+	s.Cache.InvalidateMerchantCache(ctx)
 
 	logSuccess("Successfully restored all trashed merchants")
 
@@ -468,7 +560,7 @@ func (s *merchantService) DeleteAllMerchantPermanent(ctx context.Context) (bool,
 			span)
 	}
 
-	s.logger.Debug("All merchant caches should be invalidated after delete all operation")
+	s.Cache.InvalidateMerchantCache(ctx)
 
 	logSuccess("Successfully permanently deleted all merchants")
 
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	order_cache "pointofsale/internal/cache/order"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/errorhandler"
@@ -12,10 +13,12 @@ import (
 	"pointofsale/pkg/errors/order_errors"
 	orderitem_errors "pointofsale/pkg/errors/order_item_errors"
 	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/product_variant_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.uber.org/zap"
 )
@@ -26,6 +29,7 @@ type orderService struct {
 	productRepository   repository.ProductRepository
 	cashierRepository   repository.CashierRepository
 	merchantRepository  repository.MerchantRepository
+	unitOfWork          repository.UnitOfWork
 	logger              logger.LoggerInterface
 	observability       observability.TraceLoggerObservability
 	cache               order_cache.OrderMencache
@@ -37,6 +41,7 @@ type OrderServiceDeps struct {
 	ProductRepo   repository.ProductRepository
 	CashierRepo   repository.CashierRepository
 	MerchantRepo  repository.MerchantRepository
+	UnitOfWork    repository.UnitOfWork
 	Logger        logger.LoggerInterface
 	Observability observability.TraceLoggerObservability
 	Cache         order_cache.OrderMencache
@@ -49,6 +54,7 @@ func NewOrderService(deps OrderServiceDeps) *orderService {
 		productRepository:   deps.ProductRepo,
 		cashierRepository:   deps.CashierRepo,
 		merchantRepository:  deps.MerchantRepo,
+		unitOfWork:          deps.UnitOfWork,
 		logger:              deps.Logger,
 		observability:       deps.Observability,
 		cache:               deps.Cache,
@@ -139,7 +145,7 @@ func (s *orderService) FindById(ctx context.Context, order_id int) (*db.GetOrder
 		status = "error"
 		return errorhandler.HandleError[*db.GetOrderByIDRow](
 			s.logger,
-			order_errors.ErrFailedFindOrderById,
+			orderError(err, order_errors.ErrFailedFindOrderById),
 			method,
 			span,
 			zap.Int("order_id", order_id))
@@ -353,14 +359,9 @@ func (s *orderService) FindMonthlyTotalRevenue(ctx context.Context, req *request
 		end(status)
 	}()
 
-	if data, found := s.cache.GetMonthlyTotalRevenueCache(ctx, req); found {
-		logSuccess("Successfully retrieved monthly total revenue from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetMonthlyTotalRevenue(ctx, req)
+	res, err := s.cache.GetOrLoadMonthlyTotalRevenue(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyTotalRevenueRow, error) {
+		return s.orderRepository.GetMonthlyTotalRevenue(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyTotalRevenueRow](
@@ -372,8 +373,6 @@ func (s *orderService) FindMonthlyTotalRevenue(ctx context.Context, req *request
 			zap.Int("month", req.Month))
 	}
 
-	s.cache.SetMonthlyTotalRevenueCache(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly total revenue",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -392,13 +391,9 @@ func (s *orderService) FindYearlyTotalRevenue(ctx context.Context, year int) ([]
 		end(status)
 	}()
 
-	if data, found := s.cache.GetYearlyTotalRevenueCache(ctx, year); found {
-		logSuccess("Successfully retrieved yearly total revenue from cache",
-			zap.Int("year", year))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetYearlyTotalRevenue(ctx, year)
+	res, err := s.cache.GetOrLoadYearlyTotalRevenue(ctx, year, func(ctx context.Context) ([]*db.GetYearlyTotalRevenueRow, error) {
+		return s.orderRepository.GetYearlyTotalRevenue(ctx, year)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyTotalRevenueRow](
@@ -409,8 +404,6 @@ func (s *orderService) FindYearlyTotalRevenue(ctx context.Context, year int) ([]
 			zap.Int("year", year))
 	}
 
-	s.cache.SetYearlyTotalRevenueCache(ctx, year, res)
-
 	logSuccess("Successfully fetched yearly total revenue",
 		zap.Int("year", year),
 		zap.Int("count", len(res)))
@@ -495,15 +488,9 @@ func (s *orderService) FindMonthlyTotalRevenueByMerchant(ctx context.Context, re
 		end(status)
 	}()
 
-	if data, found := s.cache.GetMonthlyTotalRevenueByMerchantCache(ctx, req); found {
-		logSuccess("Successfully retrieved monthly total revenue by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month),
-			zap.Int("merchant_id", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetMonthlyTotalRevenueByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadMonthlyTotalRevenueByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyTotalRevenueByMerchantRow, error) {
+		return s.orderRepository.GetMonthlyTotalRevenueByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyTotalRevenueByMerchantRow](
@@ -516,8 +503,6 @@ func (s *orderService) FindMonthlyTotalRevenueByMerchant(ctx context.Context, re
 			zap.Int("merchant_id", req.MerchantID))
 	}
 
-	s.cache.SetMonthlyTotalRevenueByMerchantCache(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly total revenue by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -538,14 +523,9 @@ func (s *orderService) FindYearlyTotalRevenueByMerchant(ctx context.Context, req
 		end(status)
 	}()
 
-	if data, found := s.cache.GetYearlyTotalRevenueByMerchantCache(ctx, req); found {
-		logSuccess("Successfully retrieved yearly total revenue by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchant_id", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetYearlyTotalRevenueByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadYearlyTotalRevenueByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetYearlyTotalRevenueByMerchantRow, error) {
+		return s.orderRepository.GetYearlyTotalRevenueByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyTotalRevenueByMerchantRow](
@@ -557,8 +537,6 @@ func (s *orderService) FindYearlyTotalRevenueByMerchant(ctx context.Context, req
 			zap.Int("merchant_id", req.MerchantID))
 	}
 
-	s.cache.SetYearlyTotalRevenueByMerchantCache(ctx, req, res)
-
 	logSuccess("Successfully fetched yearly total revenue by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchant_id", req.MerchantID),
@@ -577,13 +555,9 @@ func (s *orderService) FindMonthlyOrder(ctx context.Context, year int) ([]*db.Ge
 		end(status)
 	}()
 
-	if data, found := s.cache.GetMonthlyOrderCache(ctx, year); found {
-		logSuccess("Successfully retrieved monthly order from cache",
-			zap.Int("year", year))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetMonthlyOrder(ctx, year)
+	res, err := s.cache.GetOrLoadMonthlyOrder(ctx, year, func(ctx context.Context) ([]*db.GetMonthlyOrderRow, error) {
+		return s.orderRepository.GetMonthlyOrder(ctx, year)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyOrderRow](
@@ -594,8 +568,6 @@ func (s *orderService) FindMonthlyOrder(ctx context.Context, year int) ([]*db.Ge
 			zap.Int("year", year))
 	}
 
-	s.cache.SetMonthlyOrderCache(ctx, year, res)
-
 	logSuccess("Successfully fetched monthly order",
 		zap.Int("year", year),
 		zap.Int("count", len(res)))
@@ -613,13 +585,9 @@ func (s *orderService) FindYearlyOrder(ctx context.Context, year int) ([]*db.Get
 		end(status)
 	}()
 
-	if data, found := s.cache.GetYearlyOrderCache(ctx, year); found {
-		logSuccess("Successfully retrieved yearly order from cache",
-			zap.Int("year", year))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetYearlyOrder(ctx, year)
+	res, err := s.cache.GetOrLoadYearlyOrder(ctx, year, func(ctx context.Context) ([]*db.GetYearlyOrderRow, error) {
+		return s.orderRepository.GetYearlyOrder(ctx, year)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyOrderRow](
@@ -630,8 +598,6 @@ func (s *orderService) FindYearlyOrder(ctx context.Context, year int) ([]*db.Get
 			zap.Int("year", year))
 	}
 
-	s.cache.SetYearlyOrderCache(ctx, year, res)
-
 	logSuccess("Successfully fetched yearly order",
 		zap.Int("year", year),
 		zap.Int("count", len(res)))
@@ -650,14 +616,9 @@ func (s *orderService) FindMonthlyOrderByMerchant(ctx context.Context, req *requ
 		end(status)
 	}()
 
-	if data, found := s.cache.GetMonthlyOrderByMerchantCache(ctx, req); found {
-		logSuccess("Successfully retrieved monthly order by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchant_id", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetMonthlyOrderByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadMonthlyOrderByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyOrderByMerchantRow, error) {
+		return s.orderRepository.GetMonthlyOrderByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyOrderByMerchantRow](
@@ -669,8 +630,6 @@ func (s *orderService) FindMonthlyOrderByMerchant(ctx context.Context, req *requ
 			zap.Int("merchant_id", req.MerchantID))
 	}
 
-	s.cache.SetMonthlyOrderByMerchantCache(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly order by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchant_id", req.MerchantID),
@@ -690,14 +649,9 @@ func (s *orderService) FindYearlyOrderByMerchant(ctx context.Context, req *reque
 		end(status)
 	}()
 
-	if data, found := s.cache.GetYearlyOrderByMerchantCache(ctx, req); found {
-		logSuccess("Successfully retrieved yearly order by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchant_id", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.orderRepository.GetYearlyOrderByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadYearlyOrderByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetYearlyOrderByMerchantRow, error) {
+		return s.orderRepository.GetYearlyOrderByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyOrderByMerchantRow](
@@ -709,8 +663,6 @@ func (s *orderService) FindYearlyOrderByMerchant(ctx context.Context, req *reque
 			zap.Int("merchant_id", req.MerchantID))
 	}
 
-	s.cache.SetYearlyOrderByMerchantCache(ctx, req, res)
-
 	logSuccess("Successfully fetched yearly order by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchant_id", req.MerchantID),
@@ -735,7 +687,7 @@ func (s *orderService) CreateOrder(ctx context.Context, req *requests.CreateOrde
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateOrderRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
 			span,
 			zap.Int("merchant_id", req.MerchantID))
@@ -746,107 +698,91 @@ func (s *orderService) CreateOrder(ctx context.Context, req *requests.CreateOrde
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateOrderRow](
 			s.logger,
-			cashier_errors.ErrFailedFindCashierById,
+			cashierError(err, cashier_errors.ErrFailedFindCashierById),
 			method,
 			span,
 			zap.Int("cashier_id", req.CashierID))
 	}
 
-	order, err := s.orderRepository.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
-		MerchantID: req.MerchantID,
-		CashierID:  req.CashierID,
-	})
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.UpdateOrderRow](
-			s.logger,
-			order_errors.ErrFailedCreateOrder,
-			method,
-			span,
-			zap.Int("order_id", int(order.OrderID)))
-	}
+	var res *db.UpdateOrderRow
 
-	for _, item := range req.Items {
-		product, err := s.productRepository.FindById(ctx, item.ProductID)
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		order, err := repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+			MerchantID: req.MerchantID,
+			CashierID:  req.CashierID,
+		})
 		if err != nil {
-			status = "error"
-			return errorhandler.HandleError[*db.UpdateOrderRow](
+			return errorhandler.HandleTxError(
 				s.logger,
-				product_errors.ErrFailedFindProductById,
+				order_errors.ErrFailedCreateOrder,
 				method,
 				span,
-				zap.Int("product_id", item.ProductID))
+				zap.Int("merchant_id", req.MerchantID),
+				zap.Int("cashier_id", req.CashierID))
 		}
 
-		if product.CountInStock < int32(item.Quantity) {
-			status = "error"
-			return errorhandler.HandleError[*db.UpdateOrderRow](
-				s.logger,
-				order_errors.ErrFailedInvalidCountInStock,
-				method,
-				span,
-				zap.Int("product_id", item.ProductID),
-				zap.Int("requested", item.Quantity),
-				zap.Int("available", int(product.CountInStock)))
+		for _, item := range req.Items {
+			price, err := s.linePrice(ctx, repos, method, span, item.ProductID, item.VariantID)
+			if err != nil {
+				return err
+			}
+
+			if err := s.reserveStock(ctx, repos, method, span, int(order.OrderID), stockReasonSale, item.ProductID, item.VariantID, item.Quantity); err != nil {
+				return err
+			}
+
+			_, err = repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+				OrderID:   int(order.OrderID),
+				ProductID: item.ProductID,
+				VariantID: item.VariantID,
+				Quantity:  item.Quantity,
+				Price:     price,
+			})
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					orderitem_errors.ErrFailedCreateOrderItem,
+					method,
+					span,
+					zap.Int("order_id", int(order.OrderID)),
+					zap.Int("product_id", item.ProductID))
+			}
 		}
 
-		_, err = s.orderItemRepository.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
-			OrderID:   int(order.OrderID),
-			ProductID: item.ProductID,
-			Quantity:  item.Quantity,
-			Price:     int(product.Price),
-		})
+		totalPrice, err := repos.OrderItem.CalculateTotalPrice(ctx, int(order.OrderID))
 		if err != nil {
-			status = "error"
-			return errorhandler.HandleError[*db.UpdateOrderRow](
+			return errorhandler.HandleTxError(
 				s.logger,
-				orderitem_errors.ErrFailedCreateOrderItem,
+				orderitem_errors.ErrFailedCalculateTotal,
 				method,
 				span,
-				zap.Int("order_id", int(order.OrderID)),
-				zap.Int("product_id", item.ProductID))
+				zap.Int("order_id", int(order.OrderID)))
 		}
 
-		product.CountInStock -= int32(item.Quantity)
-		_, err = s.productRepository.UpdateProductCountStock(ctx, int(product.ProductID), int(product.CountInStock))
+		res, err = repos.Order.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
+			OrderID:    int(order.OrderID),
+			TotalPrice: int(*totalPrice),
+		})
 		if err != nil {
-			status = "error"
-			return errorhandler.HandleError[*db.UpdateOrderRow](
+			return errorhandler.HandleTxError(
 				s.logger,
-				product_errors.ErrFailedUpdateProduct,
+				orderError(err, order_errors.ErrFailedUpdateOrder),
 				method,
 				span,
-				zap.Int("product_id", int(product.ProductID)))
+				zap.Int("order_id", int(order.OrderID)))
 		}
-	}
 
-	totalPrice, err := s.orderItemRepository.CalculateTotalPrice(ctx, int(order.OrderID))
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.UpdateOrderRow](
-			s.logger,
-			orderitem_errors.ErrFailedCalculateTotal,
-			method,
-			span,
-			zap.Int("order_id", int(order.OrderID)))
-	}
-
-	res, err := s.orderRepository.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
-		OrderID:    int(order.OrderID),
-		TotalPrice: int(*totalPrice),
+		return nil
 	})
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*db.UpdateOrderRow](
-			s.logger,
-			order_errors.ErrFailedUpdateOrder,
-			method,
-			span,
-			zap.Int("order_id", int(order.OrderID)))
+		return nil, err
 	}
 
+	s.cache.InvalidateOrderCache(ctx)
+
 	logSuccess("Successfully created order",
-		zap.Int("order_id", int(order.OrderID)))
+		zap.Int("order_id", int(res.OrderID)))
 
 	return res, nil
 }
@@ -861,67 +797,100 @@ func (s *orderService) UpdateOrder(ctx context.Context, req *requests.UpdateOrde
 		end(status)
 	}()
 
-	_, err := s.orderRepository.FindById(ctx, *req.OrderID)
+	order, err := s.orderRepository.FindById(ctx, *req.OrderID)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateOrderRow](
 			s.logger,
-			order_errors.ErrFailedFindOrderById,
+			orderError(err, order_errors.ErrFailedFindOrderById),
 			method,
 			span,
 			zap.Int("order_id", *req.OrderID))
 	}
 
-	for _, item := range req.Items {
-		product, err := s.productRepository.FindById(ctx, item.ProductID)
+	if !isOrderEditable(order.Status) {
+		status = "error"
+		return errorhandler.HandleError[*db.UpdateOrderRow](
+			s.logger,
+			order_errors.ErrFailedOrderNotEditable,
+			method,
+			span,
+			zap.Int("order_id", *req.OrderID),
+			zap.String("status", order.Status))
+	}
+
+	var res *db.UpdateOrderRow
+
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		existingItems, err := repos.OrderItem.FindOrderItemByOrder(ctx, *req.OrderID)
 		if err != nil {
-			status = "error"
-			return errorhandler.HandleError[*db.UpdateOrderRow](
+			return errorhandler.HandleTxError(
 				s.logger,
-				product_errors.ErrFailedFindProductById,
+				orderitem_errors.ErrFailedOrderItemNotFound,
 				method,
 				span,
-				zap.Int("product_id", item.ProductID))
+				zap.Int("order_id", *req.OrderID))
 		}
 
-		if item.OrderItemID > 0 {
-			_, err := s.orderItemRepository.UpdateOrderItem(ctx, &requests.UpdateOrderItemRecordRequest{
-				OrderItemID: item.OrderItemID,
-				ProductID:   item.ProductID,
-				Quantity:    item.Quantity,
-				Price:       int(product.Price),
-			})
+		existingByID := make(map[int]*db.GetOrderItemsByOrderRow, len(existingItems))
+		for _, existing := range existingItems {
+			existingByID[int(existing.OrderItemID)] = existing
+		}
+
+		for _, item := range req.Items {
+			price, err := s.linePrice(ctx, repos, method, span, item.ProductID, item.VariantID)
 			if err != nil {
-				status = "error"
-				return errorhandler.HandleError[*db.UpdateOrderRow](
-					s.logger,
-					orderitem_errors.ErrFailedUpdateOrderItem,
-					method,
-					span,
-					zap.Int("order_item_id", item.OrderItemID))
+				return err
 			}
-		} else {
-			if product.CountInStock < int32(item.Quantity) {
-				status = "error"
-				return errorhandler.HandleError[*db.UpdateOrderRow](
-					s.logger,
-					order_errors.ErrFailedInvalidCountInStock,
-					method,
-					span,
-					zap.Int("product_id", item.ProductID),
-					zap.Int("requested", item.Quantity),
-					zap.Int("available", int(product.CountInStock)))
+
+			if item.OrderItemID > 0 {
+				existing, ok := existingByID[item.OrderItemID]
+				if !ok {
+					return errorhandler.HandleTxError(
+						s.logger,
+						orderitem_errors.ErrFailedOrderItemNotFound,
+						method,
+						span,
+						zap.Int("order_id", *req.OrderID),
+						zap.Int("order_item_id", item.OrderItemID))
+				}
+
+				if err := s.adjustStock(ctx, repos, method, span, *req.OrderID, existing, item); err != nil {
+					return err
+				}
+
+				_, err := repos.OrderItem.UpdateOrderItem(ctx, &requests.UpdateOrderItemRecordRequest{
+					OrderItemID: item.OrderItemID,
+					ProductID:   item.ProductID,
+					VariantID:   item.VariantID,
+					Quantity:    item.Quantity,
+					Price:       price,
+				})
+				if err != nil {
+					return errorhandler.HandleTxError(
+						s.logger,
+						orderitem_errors.ErrFailedUpdateOrderItem,
+						method,
+						span,
+						zap.Int("order_item_id", item.OrderItemID))
+				}
+
+				continue
+			}
+
+			if err := s.reserveStock(ctx, repos, method, span, *req.OrderID, stockReasonOrderEdit, item.ProductID, item.VariantID, item.Quantity); err != nil {
+				return err
 			}
 
-			_, err := s.orderItemRepository.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+			_, err = repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
 				OrderID:   *req.OrderID,
 				ProductID: item.ProductID,
+				VariantID: item.VariantID,
 				Quantity:  item.Quantity,
-				Price:     int(product.Price),
+				Price:     price,
 			})
 			if err != nil {
-				status = "error"
-				return errorhandler.HandleError[*db.UpdateOrderRow](
+				return errorhandler.HandleTxError(
 					s.logger,
 					orderitem_errors.ErrFailedCreateOrderItem,
 					method,
@@ -929,50 +898,122 @@ func (s *orderService) UpdateOrder(ctx context.Context, req *requests.UpdateOrde
 					zap.Int("order_id", *req.OrderID),
 					zap.Int("product_id", item.ProductID))
 			}
+		}
 
-			product.CountInStock -= int32(item.Quantity)
-			_, err = s.productRepository.UpdateProductCountStock(ctx, int(product.ProductID), int(product.CountInStock))
-			if err != nil {
-				status = "error"
-				return errorhandler.HandleError[*db.UpdateOrderRow](
-					s.logger,
-					product_errors.ErrFailedUpdateProduct,
-					method,
-					span,
-					zap.Int("product_id", int(product.ProductID)))
-			}
+		totalPrice, err := repos.OrderItem.CalculateTotalPrice(ctx, *req.OrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderitem_errors.ErrFailedCalculateTotal,
+				method,
+				span,
+				zap.Int("order_id", *req.OrderID))
 		}
+
+		res, err = repos.Order.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
+			OrderID:    *req.OrderID,
+			TotalPrice: int(*totalPrice),
+		})
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderError(err, order_errors.ErrFailedUpdateOrder),
+				method,
+				span,
+				zap.Int("order_id", *req.OrderID))
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
 	}
 
-	totalPrice, err := s.orderItemRepository.CalculateTotalPrice(ctx, *req.OrderID)
+	s.cache.DeleteOrderCache(ctx, *req.OrderID)
+
+	logSuccess("Successfully updated order",
+		zap.Int("order_id", *req.OrderID))
+
+	return res, nil
+}
+
+// UpdateOrderStatus moves an order that has not been paid yet to another
+// status. Cancelling an order returns its reserved stock.
+func (s *orderService) UpdateOrderStatus(ctx context.Context, req *requests.UpdateOrderStatusRequest) (*db.GetOrderByIDRow, error) {
+	const method = "UpdateOrderStatus"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("order_id", req.OrderID),
+		attribute.String("status", req.Status))
+
+	defer func() {
+		end(status)
+	}()
+
+	order, err := s.orderRepository.FindById(ctx, req.OrderID)
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*db.UpdateOrderRow](
+		return errorhandler.HandleError[*db.GetOrderByIDRow](
 			s.logger,
-			orderitem_errors.ErrFailedCalculateTotal,
+			orderError(err, order_errors.ErrFailedFindOrderById),
 			method,
 			span,
-			zap.Int("order_id", *req.OrderID))
+			zap.Int("order_id", req.OrderID))
 	}
 
-	res, err := s.orderRepository.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
-		OrderID:    *req.OrderID,
-		TotalPrice: int(*totalPrice),
-	})
-	if err != nil {
+	if !isOrderEditable(order.Status) {
 		status = "error"
-		return errorhandler.HandleError[*db.UpdateOrderRow](
+		return errorhandler.HandleError[*db.GetOrderByIDRow](
 			s.logger,
-			order_errors.ErrFailedUpdateOrder,
+			order_errors.ErrFailedInvalidOrderTransition,
 			method,
 			span,
-			zap.Int("order_id", *req.OrderID))
+			zap.Int("order_id", req.OrderID),
+			zap.String("from", order.Status),
+			zap.String("to", req.Status))
 	}
 
-	logSuccess("Successfully updated order",
-		zap.Int("order_id", *req.OrderID))
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		order, err = transitionOrder(ctx, repos, s.logger, method, span, req.OrderID, req.Status, order_errors.ErrFailedInvalidOrderTransition)
+		if err != nil {
+			return err
+		}
 
-	return res, nil
+		if req.Status != orderStatusCancelled {
+			return nil
+		}
+
+		orderItems, err := repos.OrderItem.FindOrderItemByOrder(ctx, req.OrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderitem_errors.ErrFailedFindOrderItemByOrder,
+				method,
+				span,
+				zap.Int("order_id", req.OrderID))
+		}
+
+		for _, item := range orderItems {
+			if err := s.releaseStock(ctx, repos, method, span, req.OrderID, stockReasonOrderCancel, int(item.ProductID), toIntPtr(item.VariantID), int(item.Quantity)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteOrderCache(ctx, req.OrderID)
+
+	logSuccess("Successfully updated order status",
+		zap.Int("order_id", req.OrderID),
+		zap.String("status", order.Status))
+
+	return order, nil
 }
 
 func (s *orderService) TrashedOrder(ctx context.Context, order_id int) (*db.Order, error) {
@@ -990,51 +1031,60 @@ func (s *orderService) TrashedOrder(ctx context.Context, order_id int) (*db.Orde
 		status = "error"
 		return errorhandler.HandleError[*db.Order](
 			s.logger,
-			order_errors.ErrFailedFindOrderById,
+			orderError(err, order_errors.ErrFailedFindOrderById),
 			method,
 			span,
 			zap.Int("order_id", order_id),
 		)
 	}
 
-	orderItems, err := s.orderItemRepository.FindOrderItemByOrder(ctx, order_id)
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.Order](
-			s.logger,
-			order_errors.ErrFailedTrashOrder,
-			method,
-			span,
-			zap.Int("order_id", order_id),
-		)
-	}
+	var trashedOrder *db.Order
 
-	for _, item := range orderItems {
-		_, err := s.orderItemRepository.TrashedOrderItem(ctx, int(item.OrderItemID))
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		orderItems, err := repos.OrderItem.FindOrderItemByOrder(ctx, order_id)
 		if err != nil {
-			status = "error"
-			return errorhandler.HandleError[*db.Order](
+			return errorhandler.HandleTxError(
 				s.logger,
-				orderitem_errors.ErrFailedTrashedOrderItem,
+				order_errors.ErrFailedTrashOrder,
 				method,
 				span,
-				zap.Int("order_item_id", int(item.OrderItemID)),
+				zap.Int("order_id", order_id),
 			)
 		}
-	}
 
-	trashedOrder, err := s.orderRepository.TrashedOrder(ctx, order_id)
+		for _, item := range orderItems {
+			_, err := repos.OrderItem.TrashedOrderItem(ctx, int(item.OrderItemID))
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					orderitem_errors.ErrFailedTrashedOrderItem,
+					method,
+					span,
+					zap.Int("order_item_id", int(item.OrderItemID)),
+				)
+			}
+		}
+
+		trashedOrder, err = repos.Order.TrashedOrder(ctx, order_id)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderError(err, order_errors.ErrFailedTrashOrder),
+				method,
+				span,
+				zap.Int("order_id", order_id),
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*db.Order](
-			s.logger,
-			order_errors.ErrFailedTrashOrder,
-			method,
-			span,
-			zap.Int("order_id", order_id),
-		)
+		return nil, err
 	}
 
+	s.cache.DeleteOrderCache(ctx, order_id)
+
 	logSuccess("Order moved to trash successfully",
 		zap.Int("order_id", order_id),
 		zap.String("deleted_at", trashedOrder.DeletedAt.Time.String()))
@@ -1052,39 +1102,46 @@ func (s *orderService) RestoreOrder(ctx context.Context, order_id int) (*db.Orde
 		end(status)
 	}()
 
-	orderItems, err := s.orderItemRepository.FindOrderItemByOrderTrashed(ctx, order_id)
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.Order](
-			s.logger,
-			orderitem_errors.ErrFailedFindOrderItemByOrder,
-			method,
-			span,
-			zap.Int("order_id", order_id))
-	}
+	var order *db.Order
 
-	for _, item := range orderItems {
-		_, err := s.orderItemRepository.RestoreOrderItem(ctx, int(item.OrderItemID))
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		orderItems, err := repos.OrderItem.FindOrderItemByOrderTrashed(ctx, order_id)
 		if err != nil {
-			status = "error"
-			return errorhandler.HandleError[*db.Order](
+			return errorhandler.HandleTxError(
 				s.logger,
-				orderitem_errors.ErrFailedRestoreOrderItem,
+				orderitem_errors.ErrFailedFindOrderItemByOrder,
 				method,
 				span,
-				zap.Int("order_item_id", int(item.OrderItemID)))
+				zap.Int("order_id", order_id))
+		}
+
+		for _, item := range orderItems {
+			_, err := repos.OrderItem.RestoreOrderItem(ctx, int(item.OrderItemID))
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					orderitem_errors.ErrFailedRestoreOrderItem,
+					method,
+					span,
+					zap.Int("order_item_id", int(item.OrderItemID)))
+			}
 		}
-	}
 
-	order, err := s.orderRepository.RestoreOrder(ctx, order_id)
+		order, err = repos.Order.RestoreOrder(ctx, order_id)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderError(err, order_errors.ErrFailedRestoreOrder),
+				method,
+				span,
+				zap.Int("order_id", order_id))
+		}
+
+		return nil
+	})
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*db.Order](
-			s.logger,
-			order_errors.ErrFailedRestoreOrder,
-			method,
-			span,
-			zap.Int("order_id", order_id))
+		return nil, err
 	}
 
 	s.cache.DeleteOrderCache(ctx, order_id)
@@ -1105,39 +1162,46 @@ func (s *orderService) DeleteOrderPermanent(ctx context.Context, order_id int) (
 		end(status)
 	}()
 
-	orderItems, err := s.orderItemRepository.FindOrderItemByOrder(ctx, order_id)
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[bool](
-			s.logger,
-			orderitem_errors.ErrFailedFindOrderItemByOrder,
-			method,
-			span,
-			zap.Int("order_id", order_id))
-	}
+	var success bool
 
-	for _, item := range orderItems {
-		_, err := s.orderItemRepository.DeleteOrderItemPermanent(ctx, int(item.OrderItemID))
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		orderItems, err := repos.OrderItem.FindOrderItemByOrder(ctx, order_id)
 		if err != nil {
-			status = "error"
-			return errorhandler.HandleError[bool](
+			return errorhandler.HandleTxError(
 				s.logger,
-				orderitem_errors.ErrFailedDeleteOrderItem,
+				orderitem_errors.ErrFailedFindOrderItemByOrder,
 				method,
 				span,
-				zap.Int("order_item_id", int(item.OrderItemID)))
+				zap.Int("order_id", order_id))
+		}
+
+		for _, item := range orderItems {
+			_, err := repos.OrderItem.DeleteOrderItemPermanent(ctx, int(item.OrderItemID))
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					orderitem_errors.ErrFailedDeleteOrderItem,
+					method,
+					span,
+					zap.Int("order_item_id", int(item.OrderItemID)))
+			}
 		}
-	}
 
-	success, err := s.orderRepository.DeleteOrderPermanent(ctx, order_id)
+		success, err = repos.Order.DeleteOrderPermanent(ctx, order_id)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderError(err, order_errors.ErrFailedDeleteOrderPermanent),
+				method,
+				span,
+				zap.Int("order_id", order_id))
+		}
+
+		return nil
+	})
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[bool](
-			s.logger,
-			order_errors.ErrFailedDeleteOrderPermanent,
-			method,
-			span,
-			zap.Int("order_id", order_id))
+		return false, err
 	}
 
 	s.cache.DeleteOrderCache(ctx, order_id)
@@ -1157,27 +1221,35 @@ func (s *orderService) RestoreAllOrder(ctx context.Context) (bool, error) {
 		end(status)
 	}()
 
-	successItems, err := s.orderItemRepository.RestoreAllOrderItem(ctx)
-	if err != nil || !successItems {
-		status = "error"
-		return errorhandler.HandleError[bool](
-			s.logger,
-			orderitem_errors.ErrFailedRestoreAllOrderItem,
-			method,
-			span)
-	}
+	var success bool
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		successItems, err := repos.OrderItem.RestoreAllOrderItem(ctx)
+		if err != nil || !successItems {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderitem_errors.ErrFailedRestoreAllOrderItem,
+				method,
+				span)
+		}
 
-	success, err := s.orderRepository.RestoreAllOrder(ctx)
-	if err != nil || !success {
+		success, err = repos.Order.RestoreAllOrder(ctx)
+		if err != nil || !success {
+			return errorhandler.HandleTxError(
+				s.logger,
+				order_errors.ErrFailedRestoreAllOrder,
+				method,
+				span)
+		}
+
+		return nil
+	})
+	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[bool](
-			s.logger,
-			order_errors.ErrFailedRestoreAllOrder,
-			method,
-			span)
+		return false, err
 	}
 
-	s.logger.Debug("All order caches should be invalidated after restore all operation")
+	s.cache.InvalidateOrderCache(ctx)
 
 	logSuccess("Successfully restored all trashed orders")
 
@@ -1193,29 +1265,161 @@ func (s *orderService) DeleteAllOrderPermanent(ctx context.Context) (bool, error
 		end(status)
 	}()
 
-	successItems, err := s.orderItemRepository.DeleteAllOrderPermanent(ctx)
-	if err != nil || !successItems {
+	var success bool
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		successItems, err := repos.OrderItem.DeleteAllOrderPermanent(ctx)
+		if err != nil || !successItems {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderitem_errors.ErrFailedDeleteAllOrderItem,
+				method,
+				span)
+		}
+
+		success, err = repos.Order.DeleteAllOrderPermanent(ctx)
+		if err != nil || !success {
+			return errorhandler.HandleTxError(
+				s.logger,
+				order_errors.ErrFailedDeleteAllOrderPermanent,
+				method,
+				span)
+		}
+
+		return nil
+	})
+	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[bool](
+		return false, err
+	}
+
+	s.cache.InvalidateOrderCache(ctx)
+
+	logSuccess("Successfully permanently deleted all trashed orders")
+
+	return success, nil
+}
+
+// linePrice resolves the unit price of an order line. A product sold through
+// variants must name one of its own variants, whose price override wins
+// over the product price.
+func (s *orderService) linePrice(ctx context.Context, repos *repository.Repositories, method string, span trace.Span, productID int, variantID *int) (int, error) {
+	product, err := repos.Product.FindById(ctx, productID)
+	if err != nil {
+		return 0, errorhandler.HandleTxError(
 			s.logger,
-			orderitem_errors.ErrFailedDeleteAllOrderItem,
+			productError(err, product_errors.ErrFailedFindProductById),
 			method,
-			span)
+			span,
+			zap.Int("product_id", productID))
 	}
 
-	success, err := s.orderRepository.DeleteAllOrderPermanent(ctx)
-	if err != nil || !success {
-		status = "error"
-		return errorhandler.HandleError[bool](
+	if variantID == nil {
+		count, err := repos.ProductVariant.CountByProduct(ctx, productID)
+		if err != nil {
+			return 0, errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedCountVariants,
+				method,
+				span,
+				zap.Int("product_id", productID))
+		}
+
+		if count > 0 {
+			return 0, errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedVariantRequired,
+				method,
+				span,
+				zap.Int("product_id", productID))
+		}
+
+		return int(product.Price), nil
+	}
+
+	variant, err := repos.ProductVariant.FindById(ctx, *variantID)
+	if err != nil {
+		failure := product_variant_errors.ErrFailedFindVariant
+		if errors.Is(err, product_variant_errors.ErrVariantNotFound) {
+			failure = product_variant_errors.ErrVariantNotFoundRes
+		}
+
+		return 0, errorhandler.HandleTxError(
 			s.logger,
-			order_errors.ErrFailedDeleteAllOrderPermanent,
+			failure,
 			method,
-			span)
+			span,
+			zap.Int("variant_id", *variantID))
 	}
 
-	s.logger.Debug("All order caches should be invalidated after delete all operation")
+	if int(variant.ProductID) != productID {
+		return 0, errorhandler.HandleTxError(
+			s.logger,
+			product_variant_errors.ErrFailedVariantMismatch,
+			method,
+			span,
+			zap.Int("product_id", productID),
+			zap.Int("variant_id", *variantID))
+	}
 
-	logSuccess("Successfully permanently deleted all trashed orders")
+	if variant.Price != nil {
+		return int(*variant.Price), nil
+	}
 
-	return success, nil
+	return int(product.Price), nil
+}
+
+func (s *orderService) reserveStock(ctx context.Context, repos *repository.Repositories, method string, span trace.Span, orderID int, reason string, productID int, variantID *int, quantity int) error {
+	return s.moveOrderStock(ctx, repos, method, span, orderID, reason, productID, variantID, -quantity)
+}
+
+func (s *orderService) releaseStock(ctx context.Context, repos *repository.Repositories, method string, span trace.Span, orderID int, reason string, productID int, variantID *int, quantity int) error {
+	return s.moveOrderStock(ctx, repos, method, span, orderID, reason, productID, variantID, quantity)
+}
+
+func (s *orderService) moveOrderStock(ctx context.Context, repos *repository.Repositories, method string, span trace.Span, orderID int, reason string, productID int, variantID *int, delta int) error {
+	reference := stockReferenceOrder
+
+	_, err := recordStockMovement(ctx, repos, s.logger, method, span, product_errors.ErrFailedUpdateProduct, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		VariantID:     variantID,
+		Reason:        reason,
+		QuantityDelta: delta,
+		ReferenceType: &reference,
+		ReferenceID:   &orderID,
+	})
+
+	return err
+}
+
+func (s *orderService) adjustStock(ctx context.Context, repos *repository.Repositories, method string, span trace.Span, orderID int, existing *db.GetOrderItemsByOrderRow, item requests.UpdateOrderItemRequest) error {
+	existingVariantID := toIntPtr(existing.VariantID)
+
+	if int(existing.ProductID) != item.ProductID || !sameID(existingVariantID, item.VariantID) {
+		if err := s.releaseStock(ctx, repos, method, span, orderID, stockReasonOrderEdit, int(existing.ProductID), existingVariantID, int(existing.Quantity)); err != nil {
+			return err
+		}
+
+		return s.reserveStock(ctx, repos, method, span, orderID, stockReasonOrderEdit, item.ProductID, item.VariantID, item.Quantity)
+	}
+
+	delta := item.Quantity - int(existing.Quantity)
+
+	switch {
+	case delta > 0:
+		return s.reserveStock(ctx, repos, method, span, orderID, stockReasonOrderEdit, item.ProductID, item.VariantID, delta)
+	case delta < 0:
+		return s.releaseStock(ctx, repos, method, span, orderID, stockReasonOrderEdit, item.ProductID, item.VariantID, -delta)
+	}
+
+	return nil
+}
+
+// sameID reports whether two optional IDs refer to the same record.
+func sameID(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
 }
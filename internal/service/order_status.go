@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/order_errors"
+	"pointofsale/pkg/logger"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	orderStatusDraft          = "draft"
+	orderStatusPendingPayment = "pending_payment"
+	orderStatusPaid           = "paid"
+	orderStatusCancelled      = "cancelled"
+	orderStatusRefunded       = "refunded"
+)
+
+// orderTransitions lists the statuses an order may move to from each status.
+// Cancelled and refunded orders are final.
+var orderTransitions = map[string][]string{
+	orderStatusDraft:          {orderStatusPendingPayment, orderStatusPaid, orderStatusCancelled},
+	orderStatusPendingPayment: {orderStatusDraft, orderStatusPaid, orderStatusCancelled},
+	orderStatusPaid:           {orderStatusRefunded, orderStatusCancelled},
+}
+
+func canTransitionOrder(from, to string) bool {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOrderPayable reports whether a transaction may still be recorded against
+// an order.
+func isOrderPayable(status string) bool {
+	return status == orderStatusDraft || status == orderStatusPendingPayment
+}
+
+// isOrderEditable reports whether the items of an order may still change.
+func isOrderEditable(status string) bool {
+	return status == orderStatusDraft || status == orderStatusPendingPayment
+}
+
+// transitionOrder moves an order to the given status inside a unit of work.
+// Moving an order to the status it already has is a no-op, except for paid:
+// an order is paid once, so a second settlement is rejected rather than
+// recorded. rejected is returned when the state machine does not allow the
+// transition.
+func transitionOrder(
+	ctx context.Context,
+	repos *repository.Repositories,
+	log logger.LoggerInterface,
+	method string,
+	span trace.Span,
+	orderID int,
+	to string,
+	rejected error,
+) (*db.GetOrderByIDRow, error) {
+	order, err := repos.Order.FindById(ctx, orderID)
+	if err != nil {
+		return nil, errorhandler.HandleTxError(
+			log,
+			orderError(err, order_errors.ErrFailedFindOrderById),
+			method,
+			span,
+			zap.Int("order_id", orderID),
+			zap.Error(err))
+	}
+
+	if order.Status == to && to != orderStatusPaid {
+		return order, nil
+	}
+
+	if !canTransitionOrder(order.Status, to) {
+		return nil, errorhandler.HandleTxError(
+			log,
+			rejected,
+			method,
+			span,
+			zap.Int("order_id", orderID),
+			zap.String("from", order.Status),
+			zap.String("to", to))
+	}
+
+	if _, err := repos.Order.UpdateOrderStatus(ctx, orderID, order.Status, to); err != nil {
+		failure := order_errors.ErrFailedUpdateOrderStatus
+		if errors.Is(err, order_errors.ErrOrderStatusChanged) {
+			failure = order_errors.ErrFailedOrderStatusConflict
+		}
+
+		return nil, errorhandler.HandleTxError(
+			log,
+			failure,
+			method,
+			span,
+			zap.Int("order_id", orderID),
+			zap.String("from", order.Status),
+			zap.String("to", to),
+			zap.Error(err))
+	}
+
+	order.Status = to
+
+	return order, nil
+}
@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"os"
+	"errors"
 	product_cache "pointofsale/internal/cache/product"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/errorhandler"
@@ -11,41 +11,61 @@ import (
 	"pointofsale/pkg/errors/category_errors"
 	"pointofsale/pkg/errors/merchant_errors"
 	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/product_variant_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
+	"pointofsale/pkg/storage"
+	"pointofsale/pkg/upload_image"
 	"pointofsale/pkg/utils"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"go.uber.org/zap"
 )
 
+// maxBarcodeAttempts bounds how often CreateProduct draws a new barcode when
+// the generated one is already taken.
+const maxBarcodeAttempts = 5
+
 type productService struct {
-	categoryRepository repository.CategoryRepository
-	merchantRepository repository.MerchantRepository
-	productRepository  repository.ProductRepository
-	logger             logger.LoggerInterface
-	observability      observability.TraceLoggerObservability
-	cache              product_cache.ProductMencache
+	categoryRepository      repository.CategoryRepository
+	merchantRepository      repository.MerchantRepository
+	productRepository       repository.ProductRepository
+	stockMovementRepository repository.StockMovementRepository
+	unitOfWork              repository.UnitOfWork
+	logger                  logger.LoggerInterface
+	observability           observability.TraceLoggerObservability
+	cache                   product_cache.ProductMencache
+	storage                 storage.Storage
 }
 
 type ProductServiceDeps struct {
-	CategoryRepo  repository.CategoryRepository
-	MerchantRepo  repository.MerchantRepository
-	ProductRepo   repository.ProductRepository
-	Logger        logger.LoggerInterface
-	Observability observability.TraceLoggerObservability
-	Cache         product_cache.ProductMencache
+	CategoryRepo      repository.CategoryRepository
+	MerchantRepo      repository.MerchantRepository
+	ProductRepo       repository.ProductRepository
+	StockMovementRepo repository.StockMovementRepository
+	UnitOfWork        repository.UnitOfWork
+	Logger            logger.LoggerInterface
+	Observability     observability.TraceLoggerObservability
+	Cache             product_cache.ProductMencache
+
+	// Storage holds product images. Images of deleted products are kept when
+	// it is nil.
+	Storage storage.Storage
 }
 
 func NewProductService(deps ProductServiceDeps) *productService {
 	return &productService{
-		categoryRepository: deps.CategoryRepo,
-		merchantRepository: deps.MerchantRepo,
-		productRepository:  deps.ProductRepo,
-		logger:             deps.Logger,
-		observability:      deps.Observability,
-		cache:              deps.Cache,
+		categoryRepository:      deps.CategoryRepo,
+		merchantRepository:      deps.MerchantRepo,
+		productRepository:       deps.ProductRepo,
+		stockMovementRepository: deps.StockMovementRepo,
+		unitOfWork:              deps.UnitOfWork,
+		logger:                  deps.Logger,
+		observability:           deps.Observability,
+		cache:                   deps.Cache,
+		storage:                 deps.Storage,
 	}
 }
 
@@ -291,7 +311,7 @@ func (s *productService) FindById(ctx context.Context, productID int) (*db.GetPr
 		status = "error"
 		return errorhandler.HandleError[*db.GetProductByIDRow](
 			s.logger,
-			product_errors.ErrFailedFindProductById,
+			productError(err, product_errors.ErrFailedFindProductById),
 			method,
 			span,
 			zap.Int("product_id", productID))
@@ -305,6 +325,152 @@ func (s *productService) FindById(ctx context.Context, productID int) (*db.GetPr
 	return product, nil
 }
 
+// FindByBarcode resolves what a till scanner read to a product, or to one of
+// its variants when the barcode is on a variant. Scans are served from the
+// cache for a short while, since the same items are rung up again and again.
+func (s *productService) FindByBarcode(ctx context.Context, barcode string) (*db.GetProductByScanRow, error) {
+	const method = "FindByBarcode"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.String("barcode", barcode))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.cache.GetCachedProductByBarcode(ctx, barcode); found {
+		logSuccess("Successfully retrieved scanned product from cache",
+			zap.String("barcode", barcode))
+		return data, nil
+	}
+
+	product, err := s.productRepository.FindByScan(ctx, barcode)
+	if err != nil {
+		status = "error"
+
+		failure := product_errors.ErrFailedFindProductByBarcode
+		if errors.Is(err, product_errors.ErrBarcodeNotFound) {
+			failure = product_errors.ErrFailedBarcodeNotFound
+		}
+
+		return errorhandler.HandleError[*db.GetProductByScanRow](
+			s.logger,
+			failure,
+			method,
+			span,
+			zap.String("barcode", barcode),
+			zap.Error(err))
+	}
+
+	s.cache.SetCachedProductByBarcode(ctx, barcode, product)
+
+	logSuccess("Successfully fetched scanned product",
+		zap.String("barcode", barcode),
+		zap.Int("product_id", int(product.ProductID)))
+
+	return product, nil
+}
+
+// FindStockMovements pages through the stock ledger of a product, newest
+// movement first.
+func (s *productService) FindStockMovements(ctx context.Context, req *requests.FindStockMovementsRequest) ([]*db.GetStockMovementsByProductRow, *int, error) {
+	const method = "FindStockMovements"
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("product_id", req.ProductID),
+		attribute.Int("page", req.Page),
+		attribute.Int("pageSize", req.PageSize))
+
+	defer func() {
+		end(status)
+	}()
+
+	movements, err := s.stockMovementRepository.FindByProduct(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandlerErrorPagination[[]*db.GetStockMovementsByProductRow](
+			s.logger,
+			product_errors.ErrFailedFindStockMovements,
+			method,
+			span,
+			zap.Int("product_id", req.ProductID),
+			zap.Int("page", req.Page),
+			zap.Int("pageSize", req.PageSize))
+	}
+
+	var totalCount int
+
+	if len(movements) > 0 {
+		totalCount = int(movements[0].TotalCount)
+	}
+
+	logSuccess("Successfully fetched stock movements",
+		zap.Int("product_id", req.ProductID),
+		zap.Int("totalRecords", totalCount),
+		zap.Int("page", req.Page),
+		zap.Int("pageSize", req.PageSize))
+
+	return movements, &totalCount, nil
+}
+
+// FindLowStock lists the products of a merchant that are at or below their
+// reorder point, furthest below first.
+func (s *productService) FindLowStock(ctx context.Context, req *requests.FindLowStockProducts) ([]*db.GetLowStockProductsRow, *int, error) {
+	const method = "FindLowStock"
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("merchant_id", req.MerchantID),
+		attribute.Int("page", req.Page),
+		attribute.Int("pageSize", req.PageSize))
+
+	defer func() {
+		end(status)
+	}()
+
+	products, err := s.productRepository.FindLowStock(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandlerErrorPagination[[]*db.GetLowStockProductsRow](
+			s.logger,
+			product_errors.ErrFailedFindLowStock,
+			method,
+			span,
+			zap.Int("merchant_id", req.MerchantID),
+			zap.Int("page", req.Page),
+			zap.Int("pageSize", req.PageSize))
+	}
+
+	var totalCount int
+
+	if len(products) > 0 {
+		totalCount = int(products[0].TotalCount)
+	}
+
+	logSuccess("Successfully fetched low stock products",
+		zap.Int("merchant_id", req.MerchantID),
+		zap.Int("totalRecords", totalCount),
+		zap.Int("page", req.Page),
+		zap.Int("pageSize", req.PageSize))
+
+	return products, &totalCount, nil
+}
+
 func (s *productService) FindByActive(ctx context.Context, req *requests.FindAllProducts) ([]*db.GetProductsActiveRow, *int, error) {
 	const method = "FindByActive"
 
@@ -443,7 +609,7 @@ func (s *productService) CreateProduct(ctx context.Context, req *requests.Create
 		end(status)
 	}()
 
-	_, err := s.categoryRepository.FindById(ctx, req.CategoryID)
+	category, err := s.categoryRepository.FindById(ctx, req.CategoryID)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[*db.CreateProductRow](
@@ -454,33 +620,76 @@ func (s *productService) CreateProduct(ctx context.Context, req *requests.Create
 			zap.Int("categoryID", req.CategoryID))
 	}
 
-	_, err = s.merchantRepository.FindById(ctx, req.MerchantID)
-	if err != nil {
+	// Another merchant's own categories are not offered to this one.
+	if category.MerchantID != nil && int(*category.MerchantID) != req.MerchantID {
 		status = "error"
 		return errorhandler.HandleError[*db.CreateProductRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			category_errors.ErrFailedCategoryNotAvailable,
 			method,
 			span,
+			zap.Int("categoryID", req.CategoryID),
 			zap.Int("merchantID", req.MerchantID))
 	}
 
-	barcode := utils.GenerateBarcode(req.Name)
-	slug := utils.GenerateSlug(req.Name)
-	req.Barcode = &barcode
-	req.SlugProduct = &slug
-
-	product, err := s.productRepository.CreateProduct(ctx, req)
+	merchant, err := s.merchantRepository.FindById(ctx, req.MerchantID)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[*db.CreateProductRow](
 			s.logger,
-			product_errors.ErrFailedCreateProduct,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
-			span)
+			span,
+			zap.Int("merchantID", req.MerchantID))
+	}
+
+	// An import may carry the slug a product already has elsewhere.
+	if req.SlugProduct == nil {
+		slug := utils.GenerateSlug(req.Name)
+		req.SlugProduct = &slug
+	}
+
+	generated := req.Barcode == nil
+
+	prefix := ""
+	if merchant.Gs1Prefix != nil {
+		prefix = *merchant.Gs1Prefix
+	}
+
+	var product *db.CreateProductRow
+
+	for attempt := 1; ; attempt++ {
+		if generated {
+			barcode, err := utils.GenerateBarcode(merchant.BarcodeFormat, prefix)
+			if err != nil {
+				status = "error"
+				return errorhandler.HandleError[*db.CreateProductRow](
+					s.logger,
+					product_errors.ErrFailedGenerateBarcode,
+					method,
+					span,
+					zap.Int("merchantID", req.MerchantID),
+					zap.Error(err))
+			}
+
+			req.Barcode = &barcode
+		}
+
+		err = s.createProduct(ctx, method, span, req, &product)
+
+		// A generated barcode that turns out to be taken is simply drawn
+		// again; a barcode the caller chose is reported back as a conflict.
+		if !generated || attempt == maxBarcodeAttempts || !errors.Is(err, product_errors.ErrFailedBarcodeInUse) {
+			break
+		}
+	}
+	if err != nil {
+		status = "error"
+		return nil, err
 	}
 
 	s.cache.DeleteCachedProduct(ctx, int(product.ProductID))
+	s.cache.DeleteCachedProductBarcode(ctx, product.Barcode)
 
 	logSuccess("Successfully created product",
 		zap.Int("productID", int(product.ProductID)),
@@ -489,6 +698,44 @@ func (s *productService) CreateProduct(ctx context.Context, req *requests.Create
 	return product, nil
 }
 
+// createProduct stores the product together with its opening stock balance.
+func (s *productService) createProduct(ctx context.Context, method string, span trace.Span, req *requests.CreateProductRequest, product **db.CreateProductRow) error {
+	return s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		if err := s.ensureBarcodeFree(ctx, repos, method, span, req.Barcode, 0); err != nil {
+			return err
+		}
+
+		created, err := repos.Product.CreateProduct(ctx, req)
+		if err != nil {
+			failure := product_errors.ErrFailedCreateProduct
+			if errors.Is(err, product_errors.ErrDuplicateBarcode) {
+				failure = product_errors.ErrFailedBarcodeInUse
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Error(err))
+		}
+
+		_, err = repos.StockMovement.CreateOpeningBalance(ctx, int(created.ProductID), actingUserID(ctx))
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_errors.ErrFailedCreateProduct,
+				method,
+				span,
+				zap.Int("productID", int(created.ProductID)))
+		}
+
+		*product = created
+
+		return nil
+	})
+}
+
 func (s *productService) UpdateProduct(ctx context.Context, req *requests.UpdateProductRequest) (*db.UpdateProductRow, error) {
 	const method = "UpdateProduct"
 
@@ -501,7 +748,7 @@ func (s *productService) UpdateProduct(ctx context.Context, req *requests.Update
 		end(status)
 	}()
 
-	_, err := s.categoryRepository.FindById(ctx, req.CategoryID)
+	category, err := s.categoryRepository.FindById(ctx, req.CategoryID)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateProductRow](
@@ -512,33 +759,122 @@ func (s *productService) UpdateProduct(ctx context.Context, req *requests.Update
 			zap.Int("categoryID", req.CategoryID))
 	}
 
+	// Another merchant's own categories are not offered to this one.
+	if category.MerchantID != nil && int(*category.MerchantID) != req.MerchantID {
+		status = "error"
+		return errorhandler.HandleError[*db.UpdateProductRow](
+			s.logger,
+			category_errors.ErrFailedCategoryNotAvailable,
+			method,
+			span,
+			zap.Int("categoryID", req.CategoryID),
+			zap.Int("merchantID", req.MerchantID))
+	}
+
 	_, err = s.merchantRepository.FindById(ctx, req.MerchantID)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateProductRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
 			span,
 			zap.Int("merchantID", req.MerchantID))
 	}
 
-	barcode := utils.GenerateBarcode(req.Name)
-	slug := utils.GenerateSlug(req.Name)
-	req.Barcode = &barcode
-	req.SlugProduct = &slug
-
-	product, err := s.productRepository.UpdateProduct(ctx, req)
+	current, err := s.productRepository.FindById(ctx, *req.ProductID)
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateProductRow](
 			s.logger,
-			product_errors.ErrFailedUpdateProduct,
+			product_errors.ErrFailedProductNotFound,
 			method,
-			span)
+			span,
+			zap.Int("productID", *req.ProductID))
+	}
+
+	// Labels are already printed with the barcode, so it only changes when
+	// the caller asks for it.
+	if req.Barcode == nil {
+		req.Barcode = current.Barcode
+	}
+
+	if req.SlugProduct == nil {
+		slug := utils.GenerateSlug(req.Name)
+		req.SlugProduct = &slug
+	}
+
+	var product *db.UpdateProductRow
+
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		if err := s.ensureBarcodeFree(ctx, repos, method, span, req.Barcode, *req.ProductID); err != nil {
+			return err
+		}
+
+		product, err = repos.Product.UpdateProduct(ctx, req)
+		if err != nil {
+			failure := productError(err, product_errors.ErrFailedUpdateProduct)
+			if errors.Is(err, product_errors.ErrDuplicateBarcode) {
+				failure = product_errors.ErrFailedBarcodeInUse
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Error(err))
+		}
+
+		// The update holds the product row, so the difference to the requested
+		// count cannot race with a sale.
+		delta := req.CountInStock - int(product.CountInStock)
+		if delta == 0 {
+			return nil
+		}
+
+		variants, err := repos.ProductVariant.CountByProduct(ctx, int(product.ProductID))
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedCountVariants,
+				method,
+				span,
+				zap.Int("productID", int(product.ProductID)))
+		}
+
+		// The stock of a product with variants is the sum of theirs and is
+		// adjusted through the variants.
+		if variants > 0 {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedStockManagedByVariants,
+				method,
+				span,
+				zap.Int("productID", int(product.ProductID)))
+		}
+
+		movement, err := recordStockMovement(ctx, repos, s.logger, method, span, product_errors.ErrFailedUpdateProduct, &requests.CreateStockMovementRecordRequest{
+			ProductID:     int(product.ProductID),
+			Reason:        stockReasonAdjustment,
+			QuantityDelta: delta,
+		})
+		if err != nil {
+			return err
+		}
+
+		product.CountInStock = movement.BalanceAfter
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
 	}
 
 	s.cache.DeleteCachedProduct(ctx, int(product.ProductID))
+	s.cache.DeleteCachedProductBarcode(ctx, current.Barcode)
+	s.cache.DeleteCachedProductBarcode(ctx, product.Barcode)
 
 	logSuccess("Successfully updated product",
 		zap.Int("productID", int(product.ProductID)),
@@ -547,6 +883,46 @@ func (s *productService) UpdateProduct(ctx context.Context, req *requests.Update
 	return product, nil
 }
 
+func (s *productService) UpdateReorderLevel(ctx context.Context, request *requests.UpdateProductReorderLevelRequest) (*db.UpdateProductReorderLevelRow, error) {
+	const method = "UpdateReorderLevel"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("product_id", *request.ProductID),
+		attribute.Int("reorder_point", request.ReorderPoint),
+		attribute.Int("reorder_quantity", request.ReorderQuantity))
+
+	defer func() {
+		end(status)
+	}()
+
+	product, err := s.productRepository.UpdateReorderLevel(ctx, request)
+	if err != nil {
+		status = "error"
+
+		failure := product_errors.ErrFailedUpdateReorderLevel
+		if errors.Is(err, product_errors.ErrFindById) {
+			failure = product_errors.ErrFailedProductNotFound
+		}
+
+		return errorhandler.HandleError[*db.UpdateProductReorderLevelRow](
+			s.logger,
+			failure,
+			method,
+			span,
+			zap.Int("product_id", *request.ProductID),
+			zap.Error(err))
+	}
+
+	s.cache.DeleteCachedProduct(ctx, *request.ProductID)
+
+	logSuccess("Successfully updated product reorder level",
+		zap.Int("product_id", *request.ProductID),
+		zap.Int("reorder_point", request.ReorderPoint),
+		zap.Int("reorder_quantity", request.ReorderQuantity))
+
+	return product, nil
+}
+
 func (s *productService) TrashedProduct(ctx context.Context, product_id int) (*db.Product, error) {
 	const method = "TrashedProduct"
 
@@ -562,13 +938,14 @@ func (s *productService) TrashedProduct(ctx context.Context, product_id int) (*d
 		status = "error"
 		return errorhandler.HandleError[*db.Product](
 			s.logger,
-			product_errors.ErrFailedTrashProduct,
+			productError(err, product_errors.ErrFailedTrashProduct),
 			method,
 			span,
 			zap.Int("product_id", product_id))
 	}
 
 	s.cache.DeleteCachedProduct(ctx, product_id)
+	s.cache.DeleteCachedProductBarcode(ctx, product.Barcode)
 
 	logSuccess("Successfully trashed product",
 		zap.Int("product_id", product_id))
@@ -591,7 +968,7 @@ func (s *productService) RestoreProduct(ctx context.Context, product_id int) (*d
 		status = "error"
 		return errorhandler.HandleError[*db.Product](
 			s.logger,
-			product_errors.ErrFailedRestoreProduct,
+			productError(err, product_errors.ErrFailedRestoreProduct),
 			method,
 			span,
 			zap.Int("product_id", product_id))
@@ -626,27 +1003,6 @@ func (s *productService) DeleteProductPermanent(ctx context.Context, product_id
 			zap.Int("product_id", product_id))
 	}
 
-	if res.ImageProduct == nil {
-		err := os.Remove(*res.ImageProduct)
-		if err != nil {
-			if os.IsNotExist(err) {
-				s.logger.Debug("Product image file not found, continuing with product deletion",
-					zap.String("image_path", *res.ImageProduct))
-			} else {
-				status = "error"
-				return errorhandler.HandleError[bool](
-					s.logger,
-					product_errors.ErrFailedDeleteImageProduct,
-					method,
-					span,
-					zap.String("image_path", *res.ImageProduct))
-			}
-		} else {
-			s.logger.Debug("Successfully deleted product image",
-				zap.String("image_path", *res.ImageProduct))
-		}
-	}
-
 	success, err := s.productRepository.DeleteProductPermanent(ctx, product_id)
 	if err != nil {
 		status = "error"
@@ -670,6 +1026,10 @@ func (s *productService) DeleteProductPermanent(ctx context.Context, product_id
 
 	s.cache.DeleteCachedProduct(ctx, product_id)
 
+	if res.ImageProduct != nil && *res.ImageProduct != "" {
+		s.removeUnusedImage(ctx, *res.ImageProduct)
+	}
+
 	logSuccess("Successfully permanently deleted product",
 		zap.Int("product_id", product_id))
 
@@ -695,7 +1055,7 @@ func (s *productService) RestoreAllProducts(ctx context.Context) (bool, error) {
 			span)
 	}
 
-	s.logger.Debug("All product caches should be invalidated after restore all operation")
+	s.cache.InvalidateProductCache(ctx)
 
 	logSuccess("Successfully restored all trashed products",
 		zap.Bool("success", success))
@@ -712,6 +1072,19 @@ func (s *productService) DeleteAllProductPermanent(ctx context.Context) (bool, e
 		end(status)
 	}()
 
+	// Images are collected first, since the purge removes the rows that
+	// point at them.
+	var images []string
+	if s.storage != nil {
+		found, err := s.productRepository.FindTrashedImages(ctx)
+		if err != nil {
+			s.logger.Error("Failed to find images of trashed products, keeping them",
+				zap.Error(err))
+		}
+
+		images = found
+	}
+
 	success, err := s.productRepository.DeleteAllProductPermanent(ctx)
 	if err != nil {
 		status = "error"
@@ -722,10 +1095,84 @@ func (s *productService) DeleteAllProductPermanent(ctx context.Context) (bool, e
 			span)
 	}
 
-	s.logger.Debug("All product caches should be invalidated after delete all operation")
+	for _, image := range images {
+		s.removeUnusedImage(ctx, image)
+	}
+
+	s.cache.InvalidateProductCache(ctx)
 
 	logSuccess("Successfully permanently deleted all trashed products",
 		zap.Bool("success", success))
 
 	return success, nil
 }
+
+// removeUnusedImage deletes an image and its variants from storage once no
+// product refers to it any more. Identical uploads share an image, so the
+// image of a deleted product may still be in use. Failures are logged only:
+// the product is already gone and a leftover file does no harm.
+func (s *productService) removeUnusedImage(ctx context.Context, image string) {
+	if s.storage == nil {
+		return
+	}
+
+	count, err := s.productRepository.CountByImage(ctx, image)
+	if err != nil {
+		s.logger.Error("Failed to check image references, keeping image",
+			zap.String("image_path", image),
+			zap.Error(err))
+		return
+	}
+
+	if count > 0 {
+		s.logger.Debug("Product image still in use, keeping it",
+			zap.String("image_path", image),
+			zap.Int("products", count))
+		return
+	}
+
+	if err := upload_image.RemoveImage(ctx, s.storage, image); err != nil {
+		s.logger.Error("Failed to delete product image",
+			zap.String("image_path", image),
+			zap.Error(err))
+		return
+	}
+
+	s.logger.Debug("Successfully deleted product image",
+		zap.String("image_path", image))
+}
+
+// ensureBarcodeFree rejects a barcode that another product or any variant
+// already carries, so a scan always resolves to a single item. productID is
+// the product being updated, or 0 when the product is new.
+func (s *productService) ensureBarcodeFree(ctx context.Context, repos *repository.Repositories, method string, span trace.Span, barcode *string, productID int) error {
+	if barcode == nil {
+		return nil
+	}
+
+	found, err := repos.Product.FindByScan(ctx, *barcode)
+	if errors.Is(err, product_errors.ErrBarcodeNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return errorhandler.HandleTxError(
+			s.logger,
+			product_errors.ErrFailedFindProductByBarcode,
+			method,
+			span,
+			zap.String("barcode", *barcode),
+			zap.Error(err))
+	}
+
+	if int(found.ProductID) == productID && found.VariantID == nil {
+		return nil
+	}
+
+	return errorhandler.HandleTxError(
+		s.logger,
+		product_errors.ErrFailedBarcodeInUse,
+		method,
+		span,
+		zap.String("barcode", *barcode))
+}
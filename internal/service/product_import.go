@@ -0,0 +1,496 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/domain/response"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/pkg/errors/category_errors"
+	"pointofsale/pkg/errors/merchant_errors"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/spreadsheet"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxImportRows bounds the product rows of a single import.
+	maxImportRows = 5000
+
+	// exportPageSize is how many products an export reads per query.
+	exportPageSize = 100
+)
+
+const (
+	importActionCreate = "create"
+	importActionUpdate = "update"
+)
+
+// Columns of an import or export sheet, in the order exports write them.
+var productSheetColumns = []string{
+	"name",
+	"description",
+	"price",
+	"count_in_stock",
+	"brand",
+	"weight",
+	"category",
+	"barcode",
+	"slug_product",
+	"image_product",
+}
+
+var requiredImportColumns = []string{"name", "price", "category"}
+
+// importFieldColumns names the sheet column behind each validated field of
+// CreateProductRequest.
+var importFieldColumns = map[string]string{
+	"Name":         "name",
+	"Description":  "description",
+	"Price":        "price",
+	"CountInStock": "count_in_stock",
+	"Brand":        "brand",
+	"Weight":       "weight",
+	"ImageProduct": "image_product",
+	"Barcode":      "barcode",
+}
+
+// importRow is a sheet row on its way into the catalogue.
+type importRow struct {
+	values    map[string]string
+	request   *requests.CreateProductRequest
+	productID int
+	report    *response.ProductImportRowResponse
+}
+
+// ImportProducts validates every row of a CSV or XLSX sheet and, unless the
+// request is a dry run or any row is invalid, creates or updates the
+// products. A row updates the product that has its barcode or slug and
+// creates a new one otherwise. Each row is written through CreateProduct or
+// UpdateProduct, so a failure while applying leaves earlier rows in place
+// and is reported on the row.
+func (s *productService) ImportProducts(ctx context.Context, req *requests.ImportProductsRequest) (*response.ProductImportResponse, error) {
+	const method = "ImportProducts"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("merchant_id", req.MerchantID),
+		attribute.String("format", req.Format),
+		attribute.Bool("dry_run", req.DryRun))
+
+	defer func() {
+		end(status)
+	}()
+
+	records, err := spreadsheet.Read(req.Format, bytes.NewReader(req.File))
+	if err != nil {
+		failure := product_errors.ErrFailedReadImportFile
+
+		var rowErr *spreadsheet.RowError
+		if errors.As(err, &rowErr) {
+			failure = product_errors.ErrFailedReadImportFile.WithMessage(
+				fmt.Sprintf("Failed to read import file, row %d is malformed: %v", rowErr.Row, rowErr.Err))
+		}
+
+		status = "error"
+		return errorhandler.HandleError[*response.ProductImportResponse](
+			s.logger,
+			failure,
+			method,
+			span,
+			zap.String("format", req.Format),
+			zap.Error(err))
+	}
+
+	rows, failure := parseImportSheet(records)
+	if failure != nil {
+		status = "error"
+		return errorhandler.HandleError[*response.ProductImportResponse](
+			s.logger,
+			failure,
+			method,
+			span,
+			zap.Int("merchant_id", req.MerchantID))
+	}
+
+	_, err = s.merchantRepository.FindById(ctx, req.MerchantID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*response.ProductImportResponse](
+			s.logger,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
+			method,
+			span,
+			zap.Int("merchant_id", req.MerchantID))
+	}
+
+	result := &response.ProductImportResponse{
+		DryRun: req.DryRun,
+		Rows:   make([]*response.ProductImportRowResponse, 0, len(rows)),
+	}
+
+	categories := make(map[string]int)
+	barcodes := make(map[string]int)
+	slugs := make(map[string]int)
+
+	for _, row := range rows {
+		if err := s.checkImportRow(ctx, req.MerchantID, row, categories); err != nil {
+			status = "error"
+			return errorhandler.HandleError[*response.ProductImportResponse](
+				s.logger,
+				product_errors.ErrFailedImportProducts,
+				method,
+				span,
+				zap.Int("merchant_id", req.MerchantID),
+				zap.Int("row", row.report.Row),
+				zap.Error(err))
+		}
+
+		if barcode := row.values["barcode"]; barcode != "" {
+			if first, ok := barcodes[barcode]; ok {
+				row.fail(fmt.Sprintf("barcode is already used by row %d", first))
+			} else {
+				barcodes[barcode] = row.report.Row
+			}
+		}
+
+		if slug := row.values["slug_product"]; slug != "" {
+			if first, ok := slugs[slug]; ok {
+				row.fail(fmt.Sprintf("slug_product is already used by row %d", first))
+			} else {
+				slugs[slug] = row.report.Row
+			}
+		}
+
+		if len(row.report.Errors) > 0 {
+			result.Failed++
+		}
+
+		result.Rows = append(result.Rows, row.report)
+	}
+
+	if req.DryRun || result.Failed > 0 {
+		logSuccess("Successfully checked product import",
+			zap.Int("merchant_id", req.MerchantID),
+			zap.Int("rows", len(rows)),
+			zap.Int("failed", result.Failed))
+
+		return result, nil
+	}
+
+	result.Applied = true
+
+	for _, row := range rows {
+		if row.productID == 0 {
+			product, err := s.CreateProduct(ctx, row.request)
+			if err != nil {
+				row.fail(err.Error())
+				result.Failed++
+				continue
+			}
+
+			id := int(product.ProductID)
+			row.report.ProductID = &id
+			result.Created++
+			continue
+		}
+
+		_, err := s.UpdateProduct(ctx, &requests.UpdateProductRequest{
+			ProductID:    &row.productID,
+			MerchantID:   row.request.MerchantID,
+			CategoryID:   row.request.CategoryID,
+			Name:         row.request.Name,
+			Description:  row.request.Description,
+			Price:        row.request.Price,
+			CountInStock: row.request.CountInStock,
+			Brand:        row.request.Brand,
+			Weight:       row.request.Weight,
+			SlugProduct:  row.request.SlugProduct,
+			ImageProduct: row.request.ImageProduct,
+			Barcode:      row.request.Barcode,
+		})
+		if err != nil {
+			row.fail(err.Error())
+			result.Failed++
+			continue
+		}
+
+		result.Updated++
+	}
+
+	s.cache.InvalidateProductCache(ctx)
+
+	logSuccess("Successfully imported products",
+		zap.Int("merchant_id", req.MerchantID),
+		zap.Int("created", result.Created),
+		zap.Int("updated", result.Updated),
+		zap.Int("failed", result.Failed))
+
+	return result, nil
+}
+
+// ExportProducts writes the merchant's products in the columns ImportProducts
+// reads, so an edited export can be imported again.
+func (s *productService) ExportProducts(ctx context.Context, req *requests.ExportProductsRequest) ([]byte, error) {
+	const method = "ExportProducts"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("merchant_id", req.MerchantID),
+		attribute.String("format", req.Format))
+
+	defer func() {
+		end(status)
+	}()
+
+	records := [][]string{productSheetColumns}
+
+	for page := 1; ; page++ {
+		products, err := s.productRepository.FindByMerchant(ctx, &requests.ProductByMerchantRequest{
+			MerchantID: req.MerchantID,
+			Search:     req.Search,
+			CategoryID: req.CategoryID,
+			MinPrice:   req.MinPrice,
+			MaxPrice:   req.MaxPrice,
+			Page:       page,
+			PageSize:   exportPageSize,
+		})
+		if err != nil {
+			status = "error"
+			return errorhandler.HandleError[[]byte](
+				s.logger,
+				product_errors.ErrFailedExportProducts,
+				method,
+				span,
+				zap.Int("merchant_id", req.MerchantID),
+				zap.Int("page", page))
+		}
+
+		for _, product := range products {
+			records = append(records, []string{
+				product.Name,
+				stringOrEmpty(product.Description),
+				strconv.Itoa(int(product.Price)),
+				strconv.Itoa(int(product.CountInStock)),
+				stringOrEmpty(product.Brand),
+				intOrEmpty(product.Weight),
+				product.CategoryName,
+				stringOrEmpty(product.Barcode),
+				stringOrEmpty(product.SlugProduct),
+				stringOrEmpty(product.ImageProduct),
+			})
+		}
+
+		if len(products) < exportPageSize {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if err := spreadsheet.Write(req.Format, &buf, records); err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]byte](
+			s.logger,
+			product_errors.ErrFailedExportProducts,
+			method,
+			span,
+			zap.Int("merchant_id", req.MerchantID),
+			zap.Error(err))
+	}
+
+	logSuccess("Successfully exported products",
+		zap.Int("merchant_id", req.MerchantID),
+		zap.Int("products", len(records)-1))
+
+	return buf.Bytes(), nil
+}
+
+// checkImportRow turns the row into a CreateProductRequest and decides
+// whether it creates or updates a product. Problems with the row are
+// recorded on its report; the returned error means the lookup itself failed.
+func (s *productService) checkImportRow(ctx context.Context, merchantID int, row *importRow, categories map[string]int) error {
+	req := &requests.CreateProductRequest{
+		MerchantID:   merchantID,
+		Name:         row.values["name"],
+		Description:  row.values["description"],
+		Brand:        row.values["brand"],
+		ImageProduct: row.values["image_product"],
+	}
+	row.request = req
+
+	// Columns already reported are left out of the validator's messages.
+	reported := make(map[string]bool)
+
+	for _, field := range []struct {
+		column string
+		target *int
+	}{
+		{"price", &req.Price},
+		{"count_in_stock", &req.CountInStock},
+		{"weight", &req.Weight},
+	} {
+		column, target := field.column, field.target
+		value := row.values[column]
+		if value == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			row.fail(fmt.Sprintf("%s must be a whole number", column))
+			reported[column] = true
+			continue
+		}
+
+		*target = n
+	}
+
+	if barcode := row.values["barcode"]; barcode != "" {
+		req.Barcode = &barcode
+	}
+
+	if slug := row.values["slug_product"]; slug != "" {
+		req.SlugProduct = &slug
+	}
+
+	if category := row.values["category"]; category == "" {
+		row.fail("category is required")
+	} else if id, ok := categories[category]; ok {
+		req.CategoryID = id
+	} else {
+		found, err := s.categoryRepository.FindByNameOrSlug(ctx, category, merchantID)
+		switch {
+		case errors.Is(err, category_errors.ErrCategoryNotFound):
+			row.fail(fmt.Sprintf("category %q does not exist", category))
+		case err != nil:
+			return err
+		default:
+			req.CategoryID = int(found.CategoryID)
+			categories[category] = req.CategoryID
+		}
+	}
+
+	var fieldErrors validator.ValidationErrors
+	if err := req.Validate(); errors.As(err, &fieldErrors) {
+		for _, fe := range fieldErrors {
+			column, ok := importFieldColumns[fe.Field()]
+			if !ok || reported[column] {
+				continue
+			}
+
+			switch fe.Tag() {
+			case "required":
+				row.fail(fmt.Sprintf("%s is required", column))
+			case "barcode":
+				row.fail(fmt.Sprintf("%s must be a valid EAN-8, UPC-A or EAN-13 code", column))
+			default:
+				row.fail(fmt.Sprintf("%s is invalid", column))
+			}
+		}
+	}
+
+	if req.Barcode == nil && req.SlugProduct == nil {
+		return nil
+	}
+
+	matches, err := s.productRepository.FindForImport(ctx, req.Barcode, req.SlugProduct)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(matches) == 0:
+		return nil
+	case len(matches) > 1:
+		row.fail("barcode and slug_product belong to different products")
+		return nil
+	}
+
+	match := matches[0]
+
+	switch {
+	case int(match.MerchantID) != merchantID:
+		row.fail("barcode or slug_product is used by another merchant's product")
+	case match.DeletedAt.Valid:
+		row.fail(fmt.Sprintf("product %d with this barcode or slug_product is trashed", match.ProductID))
+	case match.VariantCount > 0 && int(match.CountInStock) != req.CountInStock:
+		row.fail("count_in_stock of a product with variants is changed through its variants")
+	}
+
+	if len(row.report.Errors) > 0 {
+		return nil
+	}
+
+	// A row without a slug keeps the one the product has.
+	if req.SlugProduct == nil {
+		req.SlugProduct = match.SlugProduct
+	}
+
+	row.productID = int(match.ProductID)
+	row.report.Action = importActionUpdate
+	row.report.ProductID = &row.productID
+
+	return nil
+}
+
+func (r *importRow) fail(message string) {
+	r.report.Errors = append(r.report.Errors, message)
+}
+
+// parseImportSheet maps every non-blank row below the header to its column
+// values. Header names are matched case-insensitively and unknown columns
+// are ignored.
+func parseImportSheet(records [][]string) ([]*importRow, error) {
+	sheetRows, err := spreadsheet.MapRows(records, productSheetColumns, requiredImportColumns)
+	if err != nil {
+		var missing *spreadsheet.MissingColumnsError
+		if errors.As(err, &missing) {
+			return nil, product_errors.ErrFailedImportMissingColumns
+		}
+
+		return nil, product_errors.ErrFailedImportEmpty
+	}
+
+	if len(sheetRows) == 0 {
+		return nil, product_errors.ErrFailedImportEmpty
+	}
+
+	if len(sheetRows) > maxImportRows {
+		return nil, product_errors.ErrFailedImportTooManyRows
+	}
+
+	rows := make([]*importRow, 0, len(sheetRows))
+
+	for _, sheetRow := range sheetRows {
+		rows = append(rows, &importRow{
+			values: sheetRow.Values,
+			report: &response.ProductImportRowResponse{
+				Row:    sheetRow.Number,
+				Action: importActionCreate,
+			},
+		})
+	}
+
+	return rows, nil
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func intOrEmpty(value *int32) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.Itoa(int(*value))
+}
@@ -0,0 +1,611 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	product_cache "pointofsale/internal/cache/product"
+	product_variant_cache "pointofsale/internal/cache/product_variant"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/product_variant_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type productVariantService struct {
+	productVariantRepository repository.ProductVariantRepository
+	productRepository        repository.ProductRepository
+	unitOfWork               repository.UnitOfWork
+	logger                   logger.LoggerInterface
+	observability            observability.TraceLoggerObservability
+	cache                    product_variant_cache.ProductVariantMencache
+	productCache             product_cache.ProductCommandCache
+}
+
+type ProductVariantServiceDeps struct {
+	ProductVariantRepo repository.ProductVariantRepository
+	ProductRepo        repository.ProductRepository
+	UnitOfWork         repository.UnitOfWork
+	Logger             logger.LoggerInterface
+	Observability      observability.TraceLoggerObservability
+	Cache              product_variant_cache.ProductVariantMencache
+	// ProductCache is cleared for the parent product whenever a variant
+	// changes, since the product stock and price range derive from them.
+	ProductCache product_cache.ProductCommandCache
+}
+
+func NewProductVariantService(deps ProductVariantServiceDeps) *productVariantService {
+	return &productVariantService{
+		productVariantRepository: deps.ProductVariantRepo,
+		productRepository:        deps.ProductRepo,
+		unitOfWork:               deps.UnitOfWork,
+		logger:                   deps.Logger,
+		observability:            deps.Observability,
+		cache:                    deps.Cache,
+		productCache:             deps.ProductCache,
+	}
+}
+
+func (s *productVariantService) FindOptions(ctx context.Context, product_id int) ([]*db.ProductOption, error) {
+	const method = "FindProductOptions"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("productID", product_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.productVariantRepository.FindOptions(ctx, product_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.ProductOption](
+			s.logger,
+			product_variant_errors.ErrFailedFindOptions,
+			method,
+			span,
+
+			zap.Int("product_id", product_id),
+			zap.Error(err),
+		)
+	}
+
+	logSuccess("Successfully fetched product options",
+		zap.Int("productID", product_id),
+		zap.Int("options", len(res)))
+
+	return res, nil
+}
+
+func (s *productVariantService) FindByProduct(ctx context.Context, product_id int) ([]*db.ProductVariant, error) {
+	const method = "FindProductVariants"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("productID", product_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.productRepository.FindById(ctx, product_id); err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.ProductVariant](
+			s.logger,
+			product_errors.ErrFailedProductNotFound,
+			method,
+			span,
+
+			zap.Int("product_id", product_id),
+			zap.Error(err),
+		)
+	}
+
+	res, err := s.productVariantRepository.FindByProduct(ctx, product_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.ProductVariant](
+			s.logger,
+			product_variant_errors.ErrFailedFindVariants,
+			method,
+			span,
+
+			zap.Int("product_id", product_id),
+			zap.Error(err),
+		)
+	}
+
+	logSuccess("Successfully fetched product variants",
+		zap.Int("productID", product_id),
+		zap.Int("variants", len(res)))
+
+	return res, nil
+}
+
+func (s *productVariantService) FindById(ctx context.Context, variant_id int) (*db.ProductVariant, error) {
+	const method = "FindByIdProductVariant"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("variantID", variant_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.cache.GetCachedProductVariant(ctx, variant_id); found {
+		logSuccess("Successfully retrieved product variant from cache", zap.Int("variantID", variant_id))
+
+		return data, nil
+	}
+
+	res, err := s.productVariantRepository.FindById(ctx, variant_id)
+	if err != nil {
+		status = "error"
+
+		failure := product_variant_errors.ErrFailedFindVariant
+		if errors.Is(err, product_variant_errors.ErrVariantNotFound) {
+			failure = product_variant_errors.ErrVariantNotFoundRes
+		}
+
+		return errorhandler.HandleError[*db.ProductVariant](
+			s.logger,
+			failure,
+			method,
+			span,
+
+			zap.Int("variant_id", variant_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.SetCachedProductVariant(ctx, res)
+
+	logSuccess("Successfully fetched product variant", zap.Int("variantID", variant_id))
+
+	return res, nil
+}
+
+// SetOptions replaces the options a product varies by. Options that existing
+// variants rely on cannot be dropped, so a product with variants can only
+// gain option values.
+func (s *productVariantService) SetOptions(ctx context.Context, req *requests.SetProductOptionsRequest) ([]*db.ProductOption, error) {
+	const method = "SetProductOptions"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("productID", req.ProductID),
+		attribute.Int("options", len(req.Options)))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res []*db.ProductOption
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		if _, err := repos.Product.FindById(ctx, req.ProductID); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_errors.ErrFailedProductNotFound,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Error(err))
+		}
+
+		variants, err := repos.ProductVariant.FindByProduct(ctx, req.ProductID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedFindVariants,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Error(err))
+		}
+
+		for _, variant := range variants {
+			if !variantOptionsFit(req.Options, variant.Options) {
+				return errorhandler.HandleTxError(
+					s.logger,
+					product_variant_errors.ErrFailedOptionsInUse,
+					method,
+					span,
+					zap.Int("product_id", req.ProductID),
+					zap.Int("variant_id", int(variant.VariantID)))
+			}
+		}
+
+		res, err = repos.ProductVariant.ReplaceOptions(ctx, req.ProductID, req.Options)
+		if err != nil {
+			failure := product_variant_errors.ErrFailedSetOptions
+			if errors.Is(err, product_variant_errors.ErrDuplicateOptionName) {
+				failure = product_variant_errors.ErrFailedDuplicateOption
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Error(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.InvalidateProductVariantCache(ctx)
+
+	logSuccess("Successfully set product options",
+		zap.Int("productID", req.ProductID),
+		zap.Int("options", len(res)))
+
+	return res, nil
+}
+
+// CreateVariant adds a variant to a product. The variant must name one
+// declared value for every option of the product. Once a product has
+// variants its stock is the sum of theirs, so the first variant can only be
+// added while the product itself holds no stock.
+func (s *productVariantService) CreateVariant(ctx context.Context, req *requests.CreateProductVariantRequest) (*db.ProductVariant, error) {
+	const method = "CreateProductVariant"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("productID", req.ProductID),
+		attribute.String("sku", req.Sku))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res *db.ProductVariant
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		product, err := repos.Product.FindById(ctx, req.ProductID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_errors.ErrFailedProductNotFound,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Error(err))
+		}
+
+		options, err := repos.ProductVariant.FindOptions(ctx, req.ProductID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedFindOptions,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Error(err))
+		}
+
+		if len(options) == 0 {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedNoOptions,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID))
+		}
+
+		if !declaredOptionsMatch(options, req.Options) {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedInvalidOptions,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Any("options", req.Options))
+		}
+
+		count, err := repos.ProductVariant.CountByProduct(ctx, req.ProductID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedCountVariants,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Error(err))
+		}
+
+		if count == 0 && product.CountInStock != 0 {
+			return errorhandler.HandleTxError(
+				s.logger,
+				product_variant_errors.ErrFailedProductHasStock,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.Int32("count_in_stock", product.CountInStock))
+		}
+
+		if err := s.ensureBarcodeFree(ctx, repos, method, span, req.Barcode); err != nil {
+			return err
+		}
+
+		res, err = repos.ProductVariant.CreateVariant(ctx, &requests.CreateProductVariantRecordRequest{
+			ProductID: req.ProductID,
+			Sku:       req.Sku,
+			Barcode:   req.Barcode,
+			Options:   req.Options,
+			Price:     req.Price,
+		})
+		if err != nil {
+			failure := product_variant_errors.ErrFailedCreateVariant
+			if errors.Is(err, product_variant_errors.ErrDuplicateVariant) {
+				failure = product_variant_errors.ErrFailedDuplicateVariant
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Int("product_id", req.ProductID),
+				zap.String("sku", req.Sku),
+				zap.Error(err))
+		}
+
+		if req.CountInStock == 0 {
+			return nil
+		}
+
+		variantID := int(res.VariantID)
+
+		movement, err := recordStockMovement(ctx, repos, s.logger, method, span, product_variant_errors.ErrFailedRecordMovement, &requests.CreateStockMovementRecordRequest{
+			ProductID:     req.ProductID,
+			VariantID:     &variantID,
+			Reason:        stockReasonAdjustment,
+			QuantityDelta: req.CountInStock,
+		})
+		if err != nil {
+			return err
+		}
+
+		res.CountInStock = movement.BalanceAfter
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.productCache.DeleteCachedProduct(ctx, req.ProductID)
+	s.productCache.DeleteCachedProductBarcode(ctx, res.Barcode)
+
+	logSuccess("Successfully created product variant",
+		zap.Int("productID", req.ProductID),
+		zap.Int("variantID", int(res.VariantID)))
+
+	return res, nil
+}
+
+// UpdateVariant changes the SKU, barcode and price override of a variant.
+// A requested stock count is applied as an adjustment on the ledger.
+func (s *productVariantService) UpdateVariant(ctx context.Context, req *requests.UpdateProductVariantRequest) (*db.ProductVariant, error) {
+	const method = "UpdateProductVariant"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("variantID", *req.VariantID),
+		attribute.String("sku", req.Sku))
+
+	defer func() {
+		end(status)
+	}()
+
+	var (
+		res      *db.ProductVariant
+		previous *string
+	)
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		current, err := repos.ProductVariant.FindById(ctx, *req.VariantID)
+		if err != nil {
+			failure := product_variant_errors.ErrFailedFindVariant
+			if errors.Is(err, product_variant_errors.ErrVariantNotFound) {
+				failure = product_variant_errors.ErrVariantNotFoundRes
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Int("variant_id", *req.VariantID),
+				zap.Error(err))
+		}
+
+		previous = current.Barcode
+
+		if req.Barcode != nil && (current.Barcode == nil || *current.Barcode != *req.Barcode) {
+			if err := s.ensureBarcodeFree(ctx, repos, method, span, req.Barcode); err != nil {
+				return err
+			}
+		}
+
+		res, err = repos.ProductVariant.UpdateVariant(ctx, &requests.UpdateProductVariantRecordRequest{
+			VariantID: *req.VariantID,
+			Sku:       req.Sku,
+			Barcode:   req.Barcode,
+			Price:     req.Price,
+		})
+		if err != nil {
+			failure := product_variant_errors.ErrFailedUpdateVariant
+			switch {
+			case errors.Is(err, product_variant_errors.ErrVariantNotFound):
+				failure = product_variant_errors.ErrVariantNotFoundRes
+			case errors.Is(err, product_variant_errors.ErrDuplicateVariant):
+				failure = product_variant_errors.ErrFailedDuplicateVariant
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Int("variant_id", *req.VariantID),
+				zap.Error(err))
+		}
+
+		if req.CountInStock == nil {
+			return nil
+		}
+
+		// The update holds the variant row, so the difference to the
+		// requested count cannot race with a sale.
+		delta := *req.CountInStock - int(res.CountInStock)
+		if delta == 0 {
+			return nil
+		}
+
+		movement, err := recordStockMovement(ctx, repos, s.logger, method, span, product_variant_errors.ErrFailedRecordMovement, &requests.CreateStockMovementRecordRequest{
+			ProductID:     int(res.ProductID),
+			VariantID:     req.VariantID,
+			Reason:        stockReasonAdjustment,
+			QuantityDelta: delta,
+		})
+		if err != nil {
+			return err
+		}
+
+		res.CountInStock = movement.BalanceAfter
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteCachedProductVariant(ctx, int(res.VariantID))
+	s.productCache.DeleteCachedProduct(ctx, int(res.ProductID))
+	s.productCache.DeleteCachedProductBarcode(ctx, previous)
+	s.productCache.DeleteCachedProductBarcode(ctx, res.Barcode)
+
+	logSuccess("Successfully updated product variant",
+		zap.Int("variantID", int(res.VariantID)),
+		zap.String("sku", res.Sku))
+
+	return res, nil
+}
+
+// DeleteVariant removes a variant that has no stock left. Order lines that
+// sold the variant keep referring to it.
+func (s *productVariantService) DeleteVariant(ctx context.Context, variant_id int) (*db.ProductVariant, error) {
+	const method = "DeleteProductVariant"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("variantID", variant_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.productVariantRepository.TrashVariant(ctx, variant_id)
+	if err != nil {
+		status = "error"
+
+		failure := product_variant_errors.ErrFailedDeleteVariant
+		switch {
+		case errors.Is(err, product_variant_errors.ErrVariantNotFound):
+			failure = product_variant_errors.ErrVariantNotFoundRes
+		case errors.Is(err, product_variant_errors.ErrVariantHasStock):
+			failure = product_variant_errors.ErrFailedVariantHasStock
+		}
+
+		return errorhandler.HandleError[*db.ProductVariant](
+			s.logger,
+			failure,
+			method,
+			span,
+
+			zap.Int("variant_id", variant_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedProductVariant(ctx, variant_id)
+	s.productCache.DeleteCachedProduct(ctx, int(res.ProductID))
+	s.productCache.DeleteCachedProductBarcode(ctx, res.Barcode)
+
+	logSuccess("Successfully deleted product variant",
+		zap.Int("variantID", variant_id),
+		zap.Int("productID", int(res.ProductID)))
+
+	return res, nil
+}
+
+// ensureBarcodeFree rejects a variant barcode that a product already uses,
+// so a scanned barcode always resolves to a single item.
+func (s *productVariantService) ensureBarcodeFree(ctx context.Context, repos *repository.Repositories, method string, span trace.Span, barcode *string) error {
+	if barcode == nil {
+		return nil
+	}
+
+	if _, err := repos.Product.FindByBarcode(ctx, *barcode); err == nil {
+		return errorhandler.HandleTxError(
+			s.logger,
+			product_variant_errors.ErrFailedBarcodeInUse,
+			method,
+			span,
+			zap.String("barcode", *barcode))
+	}
+
+	return nil
+}
+
+// declaredOptionsMatch reports whether values names exactly one declared
+// value for every option of the product.
+func declaredOptionsMatch(options []*db.ProductOption, values map[string]string) bool {
+	if len(values) != len(options) {
+		return false
+	}
+
+	for _, option := range options {
+		value, ok := values[option.Name]
+		if !ok || !slices.Contains(option.OptionValues, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// variantOptionsFit reports whether a stored variant is still described by
+// the requested options.
+func variantOptionsFit(options []requests.ProductOptionRequest, stored []byte) bool {
+	var values map[string]string
+	if err := json.Unmarshal(stored, &values); err != nil {
+		return false
+	}
+
+	if len(values) != len(options) {
+		return false
+	}
+
+	for _, option := range options {
+		value, ok := values[option.Name]
+		if !ok || !slices.Contains(option.Values, value) {
+			return false
+		}
+	}
+
+	return true
+}
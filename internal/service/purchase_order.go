@@ -0,0 +1,527 @@
+package service
+
+import (
+	"context"
+	"errors"
+	purchase_order_cache "pointofsale/internal/cache/purchase_order"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/product_variant_errors"
+	"pointofsale/pkg/errors/purchase_order_errors"
+	"pointofsale/pkg/errors/supplier_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type purchaseOrderService struct {
+	purchaseOrderRepository repository.PurchaseOrderRepository
+	supplierRepository      repository.SupplierRepository
+	unitOfWork              repository.UnitOfWork
+	logger                  logger.LoggerInterface
+	observability           observability.TraceLoggerObservability
+	cache                   purchase_order_cache.PurchaseOrderMencache
+}
+
+type PurchaseOrderServiceDeps struct {
+	PurchaseOrderRepo repository.PurchaseOrderRepository
+	SupplierRepo      repository.SupplierRepository
+	UnitOfWork        repository.UnitOfWork
+	Logger            logger.LoggerInterface
+	Observability     observability.TraceLoggerObservability
+	Cache             purchase_order_cache.PurchaseOrderMencache
+}
+
+func NewPurchaseOrderService(deps PurchaseOrderServiceDeps) *purchaseOrderService {
+	return &purchaseOrderService{
+		purchaseOrderRepository: deps.PurchaseOrderRepo,
+		supplierRepository:      deps.SupplierRepo,
+		unitOfWork:              deps.UnitOfWork,
+		logger:                  deps.Logger,
+		observability:           deps.Observability,
+		cache:                   deps.Cache,
+	}
+}
+
+func (s *purchaseOrderService) FindAll(ctx context.Context, req *requests.FindAllPurchaseOrders) ([]*db.GetPurchaseOrdersRow, *int, error) {
+	const method = "FindAllPurchaseOrders"
+
+	page := req.Page
+	pageSize := req.PageSize
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("page", page),
+		attribute.Int("pageSize", pageSize),
+		attribute.Int("merchantID", req.MerchantID),
+		attribute.Int("supplierID", req.SupplierID),
+		attribute.String("status", req.Status))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.purchaseOrderRepository.FindAllPurchaseOrders(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandlerErrorPagination[[]*db.GetPurchaseOrdersRow](
+			s.logger,
+			purchase_order_errors.ErrFailedFindAll,
+			method,
+			span,
+
+			zap.Int("page", req.Page),
+			zap.Int("page_size", req.PageSize),
+			zap.Int("merchant_id", req.MerchantID),
+			zap.Int("supplier_id", req.SupplierID),
+			zap.String("status", req.Status),
+		)
+	}
+
+	var totalCount int
+
+	if len(res) > 0 {
+		totalCount = int(res[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	logSuccess("Successfully fetched purchase orders",
+		zap.Int("totalRecords", totalCount),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return res, &totalCount, nil
+}
+
+func (s *purchaseOrderService) FindById(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error) {
+	const method = "FindByIdPurchaseOrder"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("purchaseOrderID", purchase_order_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.cache.GetCachedPurchaseOrderById(ctx, purchase_order_id); found {
+		logSuccess("Successfully retrieved purchase order from cache", zap.Int("purchaseOrderID", purchase_order_id))
+
+		return data, nil
+	}
+
+	res, err := s.purchaseOrderRepository.FindById(ctx, purchase_order_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.PurchaseOrder](
+			s.logger,
+			purchase_order_errors.ErrPurchaseOrderNotFoundRes,
+			method,
+			span,
+
+			zap.Int("purchase_order_id", purchase_order_id),
+		)
+	}
+
+	s.cache.SetCachedPurchaseOrderById(ctx, res)
+
+	logSuccess("Successfully fetched purchase order", zap.Int("purchaseOrderID", purchase_order_id))
+
+	return res, nil
+}
+
+func (s *purchaseOrderService) FindItems(ctx context.Context, purchase_order_id int) ([]*db.PurchaseOrderItem, error) {
+	const method = "FindPurchaseOrderItems"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("purchaseOrderID", purchase_order_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.purchaseOrderRepository.FindItems(ctx, purchase_order_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.PurchaseOrderItem](
+			s.logger,
+			purchase_order_errors.ErrFailedFindItems,
+			method,
+			span,
+
+			zap.Int("purchase_order_id", purchase_order_id),
+			zap.Error(err),
+		)
+	}
+
+	logSuccess("Successfully fetched purchase order items",
+		zap.Int("purchaseOrderID", purchase_order_id),
+		zap.Int("items", len(res)))
+
+	return res, nil
+}
+
+func (s *purchaseOrderService) FindReceipts(ctx context.Context, purchase_order_id int) ([]*db.GetPurchaseOrderReceiptsRow, error) {
+	const method = "FindPurchaseOrderReceipts"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("purchaseOrderID", purchase_order_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.purchaseOrderRepository.FindReceipts(ctx, purchase_order_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.GetPurchaseOrderReceiptsRow](
+			s.logger,
+			purchase_order_errors.ErrFailedFindReceipts,
+			method,
+			span,
+
+			zap.Int("purchase_order_id", purchase_order_id),
+			zap.Error(err),
+		)
+	}
+
+	logSuccess("Successfully fetched purchase order receipts",
+		zap.Int("purchaseOrderID", purchase_order_id),
+		zap.Int("receipts", len(res)))
+
+	return res, nil
+}
+
+// CreatePurchaseOrder opens a draft purchase order with its lines. The
+// supplier and every product must belong to the ordering merchant.
+func (s *purchaseOrderService) CreatePurchaseOrder(ctx context.Context, req *requests.CreatePurchaseOrderRequest) (*db.PurchaseOrder, error) {
+	const method = "CreatePurchaseOrder"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("merchantID", req.MerchantID),
+		attribute.Int("supplierID", req.SupplierID),
+		attribute.Int("items", len(req.Items)))
+
+	defer func() {
+		end(status)
+	}()
+
+	supplier, err := s.supplierRepository.FindById(ctx, req.SupplierID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.PurchaseOrder](
+			s.logger,
+			supplier_errors.ErrSupplierNotFoundRes,
+			method,
+			span,
+
+			zap.Int("supplier_id", req.SupplierID),
+			zap.Error(err),
+		)
+	}
+
+	if int(supplier.MerchantID) != req.MerchantID {
+		status = "error"
+		return errorhandler.HandleError[*db.PurchaseOrder](
+			s.logger,
+			purchase_order_errors.ErrFailedSupplierMismatch,
+			method,
+			span,
+
+			zap.Int("supplier_id", req.SupplierID),
+			zap.Int("merchant_id", req.MerchantID),
+		)
+	}
+
+	var res *db.PurchaseOrder
+
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		var err error
+
+		res, err = repos.PurchaseOrder.CreatePurchaseOrder(ctx, &requests.CreatePurchaseOrderRecordRequest{
+			MerchantID: req.MerchantID,
+			SupplierID: req.SupplierID,
+			Note:       req.Note,
+			CreatedBy:  actingUserID(ctx),
+		})
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				purchase_order_errors.ErrFailedCreatePurchaseOrder,
+				method,
+				span,
+				zap.Int("merchant_id", req.MerchantID),
+				zap.Error(err))
+		}
+
+		for _, item := range req.Items {
+			product, err := repos.Product.FindById(ctx, item.ProductID)
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					productError(err, product_errors.ErrFailedFindProductById),
+					method,
+					span,
+					zap.Int("product_id", item.ProductID),
+					zap.Error(err))
+			}
+
+			if int(product.MerchantID) != req.MerchantID {
+				return errorhandler.HandleTxError(
+					s.logger,
+					purchase_order_errors.ErrFailedProductMismatch,
+					method,
+					span,
+					zap.Int("product_id", item.ProductID),
+					zap.Int("merchant_id", req.MerchantID))
+			}
+
+			// Goods received are booked on the product, which only works for
+			// products whose stock is not split across variants.
+			variants, err := repos.ProductVariant.CountByProduct(ctx, item.ProductID)
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					product_variant_errors.ErrFailedCountVariants,
+					method,
+					span,
+					zap.Int("product_id", item.ProductID),
+					zap.Error(err))
+			}
+
+			if variants > 0 {
+				return errorhandler.HandleTxError(
+					s.logger,
+					product_variant_errors.ErrFailedStockManagedByVariants,
+					method,
+					span,
+					zap.Int("product_id", item.ProductID))
+			}
+
+			_, err = repos.PurchaseOrder.CreatePurchaseOrderItem(ctx, &requests.CreatePurchaseOrderItemRecordRequest{
+				PurchaseOrderID: int(res.PurchaseOrderID),
+				ProductID:       item.ProductID,
+				QuantityOrdered: item.Quantity,
+				UnitCost:        item.UnitCost,
+			})
+			if err != nil {
+				failure := purchase_order_errors.ErrFailedCreateItem
+				if errors.Is(err, purchase_order_errors.ErrDuplicatePurchaseOrderItem) {
+					failure = purchase_order_errors.ErrFailedDuplicateProduct
+				}
+
+				return errorhandler.HandleTxError(
+					s.logger,
+					failure,
+					method,
+					span,
+					zap.Int("purchase_order_id", int(res.PurchaseOrderID)),
+					zap.Int("product_id", item.ProductID),
+					zap.Error(err))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.InvalidatePurchaseOrderCache(ctx)
+
+	logSuccess("Successfully created purchase order",
+		zap.Int("purchaseOrderID", int(res.PurchaseOrderID)),
+		zap.Int("items", len(req.Items)))
+
+	return res, nil
+}
+
+// SubmitPurchaseOrder places a draft purchase order with its supplier.
+func (s *purchaseOrderService) SubmitPurchaseOrder(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error) {
+	return s.updateStatus(ctx, "SubmitPurchaseOrder", purchase_order_id, purchaseOrderStatusOrdered)
+}
+
+// CancelPurchaseOrder cancels a purchase order nothing has been received
+// against yet.
+func (s *purchaseOrderService) CancelPurchaseOrder(ctx context.Context, purchase_order_id int) (*db.PurchaseOrder, error) {
+	return s.updateStatus(ctx, "CancelPurchaseOrder", purchase_order_id, purchaseOrderStatusCancelled)
+}
+
+func (s *purchaseOrderService) updateStatus(ctx context.Context, method string, purchase_order_id int, to string) (*db.PurchaseOrder, error) {
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("purchaseOrderID", purchase_order_id),
+		attribute.String("status", to))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res *db.PurchaseOrder
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		var err error
+
+		res, err = transitionPurchaseOrder(ctx, repos, s.logger, method, span, purchase_order_id, to)
+
+		return err
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteCachedPurchaseOrder(ctx, purchase_order_id)
+
+	logSuccess("Successfully updated purchase order status",
+		zap.Int("purchaseOrderID", purchase_order_id),
+		zap.String("status", res.Status))
+
+	return res, nil
+}
+
+// ReceivePurchaseOrder books goods that arrived against an ordered purchase
+// order. Each line records a receipt, adds the units to stock through the
+// stock ledger and counts towards the quantity ordered; receiving more than
+// is outstanding fails the whole delivery. The purchase order becomes
+// received once every line is complete and partially received otherwise.
+func (s *purchaseOrderService) ReceivePurchaseOrder(ctx context.Context, req *requests.ReceivePurchaseOrderRequest) (*db.PurchaseOrder, error) {
+	const method = "ReceivePurchaseOrder"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("purchaseOrderID", req.PurchaseOrderID),
+		attribute.Int("lines", len(req.Items)))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res *db.PurchaseOrder
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		purchaseOrder, err := repos.PurchaseOrder.FindById(ctx, req.PurchaseOrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				purchase_order_errors.ErrPurchaseOrderNotFoundRes,
+				method,
+				span,
+				zap.Int("purchase_order_id", req.PurchaseOrderID),
+				zap.Error(err))
+		}
+
+		if !isPurchaseOrderReceivable(purchaseOrder.Status) {
+			return errorhandler.HandleTxError(
+				s.logger,
+				purchase_order_errors.ErrFailedInvalidTransition,
+				method,
+				span,
+				zap.Int("purchase_order_id", req.PurchaseOrderID),
+				zap.String("status", purchaseOrder.Status))
+		}
+
+		receivedBy := actingUserID(ctx)
+		reference := stockReferencePurchaseOrder
+
+		for _, line := range req.Items {
+			item, err := repos.PurchaseOrder.ReceiveItem(ctx, req.PurchaseOrderID, line.PurchaseOrderItemID, line.Quantity)
+			if err != nil {
+				failure := purchase_order_errors.ErrFailedReceiveItem
+				if errors.Is(err, purchase_order_errors.ErrOverReceived) {
+					failure = purchase_order_errors.ErrFailedOverReceive
+				}
+
+				return errorhandler.HandleTxError(
+					s.logger,
+					failure,
+					method,
+					span,
+					zap.Int("purchase_order_id", req.PurchaseOrderID),
+					zap.Int("purchase_order_item_id", line.PurchaseOrderItemID),
+					zap.Int("quantity", line.Quantity),
+					zap.Error(err))
+			}
+
+			unitCost := int(item.UnitCost)
+			if line.UnitCost != nil {
+				unitCost = *line.UnitCost
+			}
+
+			_, err = repos.PurchaseOrder.CreateReceipt(ctx, &requests.CreatePurchaseOrderReceiptRecordRequest{
+				PurchaseOrderItemID: line.PurchaseOrderItemID,
+				Quantity:            line.Quantity,
+				UnitCost:            unitCost,
+				ReceivedBy:          receivedBy,
+				Note:                req.Note,
+			})
+			if err != nil {
+				return errorhandler.HandleTxError(
+					s.logger,
+					purchase_order_errors.ErrFailedRecordReceipt,
+					method,
+					span,
+					zap.Int("purchase_order_item_id", line.PurchaseOrderItemID),
+					zap.Error(err))
+			}
+
+			_, err = recordStockMovement(ctx, repos, s.logger, method, span, purchase_order_errors.ErrFailedReceiveStock, &requests.CreateStockMovementRecordRequest{
+				ProductID:     int(item.ProductID),
+				Reason:        stockReasonGoodsReceived,
+				QuantityDelta: line.Quantity,
+				ReferenceType: &reference,
+				ReferenceID:   &req.PurchaseOrderID,
+				UserID:        receivedBy,
+				Note:          req.Note,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		items, err := repos.PurchaseOrder.FindItems(ctx, req.PurchaseOrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				purchase_order_errors.ErrFailedFindItems,
+				method,
+				span,
+				zap.Int("purchase_order_id", req.PurchaseOrderID),
+				zap.Error(err))
+		}
+
+		to := purchaseOrderStatusReceived
+		for _, item := range items {
+			if item.QuantityReceived < item.QuantityOrdered {
+				to = purchaseOrderStatusPartiallyReceived
+				break
+			}
+		}
+
+		res, err = transitionPurchaseOrder(ctx, repos, s.logger, method, span, req.PurchaseOrderID, to)
+
+		return err
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteCachedPurchaseOrder(ctx, req.PurchaseOrderID)
+
+	logSuccess("Successfully received purchase order goods",
+		zap.Int("purchaseOrderID", req.PurchaseOrderID),
+		zap.String("status", res.Status))
+
+	return res, nil
+}
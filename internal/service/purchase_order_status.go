@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/purchase_order_errors"
+	"pointofsale/pkg/logger"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	purchaseOrderStatusDraft             = "draft"
+	purchaseOrderStatusOrdered           = "ordered"
+	purchaseOrderStatusPartiallyReceived = "partially_received"
+	purchaseOrderStatusReceived          = "received"
+	purchaseOrderStatusCancelled         = "cancelled"
+)
+
+// purchaseOrderTransitions lists the statuses a purchase order may move to
+// from each status. Once goods have been received the order can no longer be
+// cancelled; received and cancelled orders are final.
+var purchaseOrderTransitions = map[string][]string{
+	purchaseOrderStatusDraft:             {purchaseOrderStatusOrdered, purchaseOrderStatusCancelled},
+	purchaseOrderStatusOrdered:           {purchaseOrderStatusPartiallyReceived, purchaseOrderStatusReceived, purchaseOrderStatusCancelled},
+	purchaseOrderStatusPartiallyReceived: {purchaseOrderStatusReceived},
+}
+
+func canTransitionPurchaseOrder(from, to string) bool {
+	for _, next := range purchaseOrderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPurchaseOrderReceivable reports whether goods may be received against a
+// purchase order.
+func isPurchaseOrderReceivable(status string) bool {
+	return status == purchaseOrderStatusOrdered || status == purchaseOrderStatusPartiallyReceived
+}
+
+// transitionPurchaseOrder moves a purchase order to the given status inside
+// a unit of work. Moving a purchase order to the status it already has is a
+// no-op.
+func transitionPurchaseOrder(
+	ctx context.Context,
+	repos *repository.Repositories,
+	log logger.LoggerInterface,
+	method string,
+	span trace.Span,
+	purchaseOrderID int,
+	to string,
+) (*db.PurchaseOrder, error) {
+	purchaseOrder, err := repos.PurchaseOrder.FindById(ctx, purchaseOrderID)
+	if err != nil {
+		return nil, errorhandler.HandleTxError(
+			log,
+			purchase_order_errors.ErrPurchaseOrderNotFoundRes,
+			method,
+			span,
+			zap.Int("purchase_order_id", purchaseOrderID),
+			zap.Error(err))
+	}
+
+	if purchaseOrder.Status == to {
+		return purchaseOrder, nil
+	}
+
+	if !canTransitionPurchaseOrder(purchaseOrder.Status, to) {
+		return nil, errorhandler.HandleTxError(
+			log,
+			purchase_order_errors.ErrFailedInvalidTransition,
+			method,
+			span,
+			zap.Int("purchase_order_id", purchaseOrderID),
+			zap.String("from", purchaseOrder.Status),
+			zap.String("to", to))
+	}
+
+	res, err := repos.PurchaseOrder.UpdatePurchaseOrderStatus(ctx, purchaseOrderID, purchaseOrder.Status, to)
+	if err != nil {
+		failure := purchase_order_errors.ErrFailedUpdateStatus
+		if errors.Is(err, purchase_order_errors.ErrPurchaseOrderStatusChanged) {
+			failure = purchase_order_errors.ErrFailedStatusConflict
+		}
+
+		return nil, errorhandler.HandleTxError(
+			log,
+			failure,
+			method,
+			span,
+			zap.Int("purchase_order_id", purchaseOrderID),
+			zap.String("from", purchaseOrder.Status),
+			zap.String("to", to),
+			zap.Error(err))
+	}
+
+	return res, nil
+}
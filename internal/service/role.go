@@ -312,6 +312,8 @@ func (s *roleService) CreateRole(ctx context.Context, request *requests.CreateRo
 		)
 	}
 
+	s.cache.InvalidateRoleCache(ctx)
+
 	logSuccess("CreateRole process completed",
 		zap.String("roleName", request.Name),
 		zap.Int("roleID", int(role.RoleID)),
@@ -349,6 +351,8 @@ func (s *roleService) UpdateRole(ctx context.Context, request *requests.UpdateRo
 		)
 	}
 
+	s.cache.DeleteCachedRole(ctx, *request.ID)
+
 	logSuccess("UpdateRole process completed",
 		zap.Int("roleID", *request.ID),
 		zap.String("newRoleName", request.Name),
@@ -383,6 +387,8 @@ func (s *roleService) TrashedRole(ctx context.Context, id int) (*db.Role, error)
 		)
 	}
 
+	s.cache.DeleteCachedRole(ctx, id)
+
 	logSuccess("TrashedRole process completed",
 		zap.Int("roleID", id),
 	)
@@ -416,6 +422,8 @@ func (s *roleService) RestoreRole(ctx context.Context, id int) (*db.Role, error)
 		)
 	}
 
+	s.cache.DeleteCachedRole(ctx, id)
+
 	logSuccess("RestoreRole process completed",
 		zap.Int("roleID", id),
 	)
@@ -449,6 +457,8 @@ func (s *roleService) DeleteRolePermanent(ctx context.Context, id int) (bool, er
 		)
 	}
 
+	s.cache.DeleteCachedRole(ctx, id)
+
 	logSuccess("DeleteRolePermanent process completed",
 		zap.Int("roleID", id),
 	)
@@ -478,6 +488,8 @@ func (s *roleService) RestoreAllRole(ctx context.Context) (bool, error) {
 		)
 	}
 
+	s.cache.InvalidateRoleCache(ctx)
+
 	logSuccess("Successfully restored all roles")
 	return true, nil
 }
@@ -504,6 +516,8 @@ func (s *roleService) DeleteAllRolePermanent(ctx context.Context) (bool, error)
 		)
 	}
 
+	s.cache.InvalidateRoleCache(ctx)
+
 	logSuccess("Successfully deleted all roles permanently")
 	return true, nil
 }
@@ -5,31 +5,47 @@ import (
 	auth_cache "pointofsale/internal/cache/auth"
 	cashier_cache "pointofsale/internal/cache/cashier"
 	category_cache "pointofsale/internal/cache/category"
+	idempotency_cache "pointofsale/internal/cache/idempotency"
 	merchant_cache "pointofsale/internal/cache/merchant"
 	order_cache "pointofsale/internal/cache/order"
 	orderitem_cache "pointofsale/internal/cache/order_item"
 	product_cache "pointofsale/internal/cache/product"
+	product_variant_cache "pointofsale/internal/cache/product_variant"
+	purchase_order_cache "pointofsale/internal/cache/purchase_order"
 	role_cache "pointofsale/internal/cache/role"
+	stocktake_cache "pointofsale/internal/cache/stocktake"
+	supplier_cache "pointofsale/internal/cache/supplier"
+	tax_cache "pointofsale/internal/cache/tax"
 	transaction_cache "pointofsale/internal/cache/transaction"
 	user_cache "pointofsale/internal/cache/user"
 	"pointofsale/internal/repository"
 	"pointofsale/pkg/auth"
 	"pointofsale/pkg/hash"
 	"pointofsale/pkg/logger"
+	"pointofsale/pkg/notifier"
 	"pointofsale/pkg/observability"
+	"pointofsale/pkg/storage"
 )
 
 type Service struct {
-	Auth        AuthService
-	User        UserService
-	Role        RoleService
-	Cashier     CashierService
-	Category    CategoryService
-	Merchant    MerchantService
-	OrderItem   OrderItemService
-	Order       OrderService
-	Product     ProductService
-	Transaction TransactionService
+	Auth           AuthService
+	User           UserService
+	Role           RoleService
+	Cashier        CashierService
+	Category       CategoryService
+	Merchant       MerchantService
+	OrderItem      OrderItemService
+	Order          OrderService
+	Product        ProductService
+	ProductVariant ProductVariantService
+	Transaction    TransactionService
+	Tax            TaxService
+	Idempotency    IdempotencyService
+	Cache          CacheService
+	Supplier       SupplierService
+	PurchaseOrder  PurchaseOrderService
+	StockAlert     StockAlertService
+	Stocktake      StocktakeService
 }
 
 type Deps struct {
@@ -38,11 +54,23 @@ type Deps struct {
 	Hash         hash.HashPassword
 	Logger       logger.LoggerInterface
 	Cache        *cache.CacheStore
+	DefaultRole  string
+	// LowStockNotifier receives low-stock alerts. Alerts are logged when it
+	// is nil.
+	LowStockNotifier notifier.LowStockNotifier
+	// Storage holds uploaded images. Images of deleted products are kept
+	// when it is nil.
+	Storage storage.Storage
 }
 
 func NewService(deps Deps) *Service {
 	observability, _ := observability.NewObservability("grpc-server", deps.Logger)
 
+	lowStockNotifier := deps.LowStockNotifier
+	if lowStockNotifier == nil {
+		lowStockNotifier = notifier.NewLogNotifier(deps.Logger)
+	}
+
 	auth_cache := auth_cache.NewMencache(deps.Cache)
 	role_cache := role_cache.NewRoleMencache(deps.Cache)
 	user_cache := user_cache.NewUserMencache(deps.Cache)
@@ -52,7 +80,13 @@ func NewService(deps Deps) *Service {
 	order_cache := order_cache.NewOrderMencache(deps.Cache)
 	order_item_cache := orderitem_cache.NewOrderItemCache(deps.Cache)
 	product_cache := product_cache.NewProductMencache(deps.Cache)
+	product_variant_cache := product_variant_cache.NewProductVariantMencache(deps.Cache)
 	transaction_cache := transaction_cache.NewTransactionMencache(deps.Cache)
+	tax_cache := tax_cache.NewTaxMencache(deps.Cache)
+	idempotency_cache := idempotency_cache.NewIdempotencyCache(deps.Cache)
+	supplier_cache := supplier_cache.NewSupplierMencache(deps.Cache)
+	purchase_order_cache := purchase_order_cache.NewPurchaseOrderMencache(deps.Cache)
+	stocktake_cache := stocktake_cache.NewStocktakeMencache(deps.Cache)
 
 	return &Service{
 		Auth: NewAuthService(AuthServiceDeps{
@@ -65,6 +99,8 @@ func NewService(deps Deps) *Service {
 			Logger:           deps.Logger,
 			Observability:    observability,
 			Cache:            auth_cache,
+			UnitOfWork:       deps.Repositories.UnitOfWork,
+			DefaultRole:      deps.DefaultRole,
 		}),
 
 		User: NewUserService(UserServiceDeps{
@@ -92,6 +128,7 @@ func NewService(deps Deps) *Service {
 		}),
 		Category: NewCategoryService(CategoryServiceDeps{
 			CategoryRepo:  deps.Repositories.Category,
+			UnitOfWork:    deps.Repositories.UnitOfWork,
 			Logger:        deps.Logger,
 			Observability: observability,
 			Cache:         category_cache,
@@ -117,29 +154,101 @@ func NewService(deps Deps) *Service {
 			ProductRepo:   deps.Repositories.Product,
 			CashierRepo:   deps.Repositories.Cashier,
 			MerchantRepo:  deps.Repositories.Merchant,
+			UnitOfWork:    deps.Repositories.UnitOfWork,
 			Logger:        deps.Logger,
 			Observability: observability,
 			Cache:         order_cache,
 		}),
 
 		Product: NewProductService(ProductServiceDeps{
-			CategoryRepo:  deps.Repositories.Category,
-			MerchantRepo:  deps.Repositories.Merchant,
-			ProductRepo:   deps.Repositories.Product,
-			Logger:        deps.Logger,
-			Observability: observability,
-			Cache:         product_cache,
+			CategoryRepo:      deps.Repositories.Category,
+			MerchantRepo:      deps.Repositories.Merchant,
+			ProductRepo:       deps.Repositories.Product,
+			StockMovementRepo: deps.Repositories.StockMovement,
+			UnitOfWork:        deps.Repositories.UnitOfWork,
+			Logger:            deps.Logger,
+			Observability:     observability,
+			Cache:             product_cache,
+			Storage:           deps.Storage,
+		}),
+
+		ProductVariant: NewProductVariantService(ProductVariantServiceDeps{
+			ProductVariantRepo: deps.Repositories.ProductVariant,
+			ProductRepo:        deps.Repositories.Product,
+			UnitOfWork:         deps.Repositories.UnitOfWork,
+			Logger:             deps.Logger,
+			Observability:      observability,
+			Cache:              product_variant_cache,
+			ProductCache:       product_cache,
 		}),
 
 		Transaction: NewTransactionService(TransactionServiceDeps{
-			CashierRepo:     deps.Repositories.Cashier,
-			MerchantRepo:    deps.Repositories.Merchant,
+			CashierRepo:           deps.Repositories.Cashier,
+			MerchantRepo:          deps.Repositories.Merchant,
+			TransactionRepo:       deps.Repositories.Transaction,
+			TransactionRefundRepo: deps.Repositories.TransactionRefund,
+			OrderRepo:             deps.Repositories.Order,
+			OrderItemRepo:         deps.Repositories.OrderItem,
+			UnitOfWork:            deps.Repositories.UnitOfWork,
+			Logger:                deps.Logger,
+			Observability:         observability,
+			Cache:                 transaction_cache,
+		}),
+
+		Tax: NewTaxService(TaxServiceDeps{
+			TaxRateRepo:     deps.Repositories.TaxRate,
 			TransactionRepo: deps.Repositories.Transaction,
-			OrderRepo:       deps.Repositories.Order,
 			OrderItemRepo:   deps.Repositories.OrderItem,
 			Logger:          deps.Logger,
 			Observability:   observability,
-			Cache:           transaction_cache,
+			Cache:           tax_cache,
+		}),
+
+		Idempotency: NewIdempotencyService(IdempotencyServiceDeps{
+			IdempotencyKeyRepo: deps.Repositories.IdempotencyKey,
+			Logger:             deps.Logger,
+			Observability:      observability,
+			Cache:              idempotency_cache,
+		}),
+
+		Cache: NewCacheService(CacheServiceDeps{
+			Store:         deps.Cache,
+			Logger:        deps.Logger,
+			Observability: observability,
+		}),
+
+		Supplier: NewSupplierService(SupplierServiceDeps{
+			SupplierRepo:  deps.Repositories.Supplier,
+			MerchantRepo:  deps.Repositories.Merchant,
+			Logger:        deps.Logger,
+			Observability: observability,
+			Cache:         supplier_cache,
+		}),
+
+		PurchaseOrder: NewPurchaseOrderService(PurchaseOrderServiceDeps{
+			PurchaseOrderRepo: deps.Repositories.PurchaseOrder,
+			SupplierRepo:      deps.Repositories.Supplier,
+			UnitOfWork:        deps.Repositories.UnitOfWork,
+			Logger:            deps.Logger,
+			Observability:     observability,
+			Cache:             purchase_order_cache,
+		}),
+
+		StockAlert: NewStockAlertService(StockAlertServiceDeps{
+			ProductRepo:   deps.Repositories.Product,
+			Notifier:      lowStockNotifier,
+			Logger:        deps.Logger,
+			Observability: observability,
+		}),
+
+		Stocktake: NewStocktakeService(StocktakeServiceDeps{
+			StocktakeRepo: deps.Repositories.Stocktake,
+			MerchantRepo:  deps.Repositories.Merchant,
+			CategoryRepo:  deps.Repositories.Category,
+			UnitOfWork:    deps.Repositories.UnitOfWork,
+			Logger:        deps.Logger,
+			Observability: observability,
+			Cache:         stocktake_cache,
 		}),
 	}
 }
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/notifier"
+	"pointofsale/pkg/observability"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// lowStockAlertBatchSize caps how many products one evaluation alerts on, so
+// a large backlog is spread over several runs instead of one huge delivery.
+const lowStockAlertBatchSize = 100
+
+type stockAlertService struct {
+	productRepository repository.ProductRepository
+	notifier          notifier.LowStockNotifier
+	logger            logger.LoggerInterface
+	observability     observability.TraceLoggerObservability
+}
+
+type StockAlertServiceDeps struct {
+	ProductRepo   repository.ProductRepository
+	Notifier      notifier.LowStockNotifier
+	Logger        logger.LoggerInterface
+	Observability observability.TraceLoggerObservability
+}
+
+func NewStockAlertService(deps StockAlertServiceDeps) *stockAlertService {
+	return &stockAlertService{
+		productRepository: deps.ProductRepo,
+		notifier:          deps.Notifier,
+		logger:            deps.Logger,
+		observability:     deps.Observability,
+	}
+}
+
+// EvaluateLowStock alerts on every product that fell to its reorder point
+// since the previous run and returns how many alerts were delivered.
+//
+// A product is alerted once per dip: restocked products are re-armed first,
+// and products whose alert cannot be delivered are released so the next run
+// tries again.
+func (s *stockAlertService) EvaluateLowStock(ctx context.Context) (int, error) {
+	const method = "EvaluateLowStock"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method)
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.productRepository.ResetLowStockAlerts(ctx); err != nil {
+		status = "error"
+		return errorhandler.HandleError[int](
+			s.logger,
+			product_errors.ErrFailedEvaluateLowStock,
+			method,
+			span,
+			zap.Error(err))
+	}
+
+	claimed, err := s.productRepository.ClaimLowStockAlerts(ctx, lowStockAlertBatchSize)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[int](
+			s.logger,
+			product_errors.ErrFailedEvaluateLowStock,
+			method,
+			span,
+			zap.Error(err))
+	}
+
+	if len(claimed) == 0 {
+		return 0, nil
+	}
+
+	detectedAt := time.Now()
+	alerts := make([]notifier.LowStockAlert, 0, len(claimed))
+	productIDs := make([]int, 0, len(claimed))
+
+	for _, product := range claimed {
+		alerts = append(alerts, notifier.LowStockAlert{
+			ProductID:       int(product.ProductID),
+			MerchantID:      int(product.MerchantID),
+			Name:            product.Name,
+			CountInStock:    int(product.CountInStock),
+			ReorderPoint:    int(product.ReorderPoint),
+			ReorderQuantity: int(product.ReorderQuantity),
+			DetectedAt:      detectedAt,
+		})
+		productIDs = append(productIDs, int(product.ProductID))
+	}
+
+	if err := s.notifier.NotifyLowStock(ctx, alerts); err != nil {
+		status = "error"
+
+		if releaseErr := s.productRepository.ReleaseLowStockAlerts(ctx, productIDs); releaseErr != nil {
+			s.logger.Error("Failed to release undelivered low stock alerts",
+				zap.Ints("product_ids", productIDs),
+				zap.Error(releaseErr))
+		}
+
+		return errorhandler.HandleError[int](
+			s.logger,
+			product_errors.ErrFailedNotifyLowStock,
+			method,
+			span,
+			zap.Int("alerts", len(alerts)),
+			zap.Error(err))
+	}
+
+	logSuccess("Delivered low stock alerts", zap.Int("alerts", len(alerts)))
+
+	return len(alerts), nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/auth"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/logger"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Reasons recorded on the stock ledger. Opening balances use 'initial' and
+// are written by the database.
+const (
+	stockReasonSale          = "sale"
+	stockReasonOrderEdit     = "order_edit"
+	stockReasonOrderCancel   = "order_cancel"
+	stockReasonRefund        = "refund"
+	stockReasonAdjustment    = "adjustment"
+	stockReasonGoodsReceived = "goods_received"
+	stockReasonStocktake     = "stocktake"
+)
+
+// Entities a stock movement can point back to.
+const (
+	stockReferenceOrder             = "order"
+	stockReferenceTransactionRefund = "transaction_refund"
+	stockReferencePurchaseOrder     = "purchase_order"
+	stockReferenceStocktake         = "stocktake"
+)
+
+// actingUserID returns the authenticated caller, or nil for work that is not
+// done on behalf of a user.
+func actingUserID(ctx context.Context) *int {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return &userID
+}
+
+// recordStockMovement changes the stock of a product inside a unit of work
+// and appends the change to its ledger. The acting user defaults to the
+// caller. A change that would leave the product with negative stock fails
+// with ErrFailedInsufficientStock; any other error is reported as failure.
+func recordStockMovement(
+	ctx context.Context,
+	repos *repository.Repositories,
+	log logger.LoggerInterface,
+	method string,
+	span trace.Span,
+	failure error,
+	req *requests.CreateStockMovementRecordRequest,
+) (*db.StockMovement, error) {
+	if req.UserID == nil {
+		req.UserID = actingUserID(ctx)
+	}
+
+	movement, err := repos.StockMovement.RecordMovement(ctx, req)
+	if err == nil {
+		return movement, nil
+	}
+
+	if errors.Is(err, product_errors.ErrInsufficientStock) {
+		failure = product_errors.ErrFailedInsufficientStock
+	}
+
+	return nil, errorhandler.HandleTxError(
+		log,
+		failure,
+		method,
+		span,
+		zap.Int("product_id", req.ProductID),
+		zap.String("reason", req.Reason),
+		zap.Int("quantity_delta", req.QuantityDelta))
+}
+
+// toIntPtr converts an optional database ID, such as the variant of an
+// order line, for use in a request.
+func toIntPtr(id *int32) *int {
+	if id == nil {
+		return nil
+	}
+
+	n := int(*id)
+
+	return &n
+}
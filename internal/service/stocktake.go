@@ -0,0 +1,581 @@
+package service
+
+import (
+	"context"
+	"errors"
+	stocktake_cache "pointofsale/internal/cache/stocktake"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/category_errors"
+	"pointofsale/pkg/errors/merchant_errors"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/stocktake_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type stocktakeService struct {
+	stocktakeRepository repository.StocktakeRepository
+	merchantRepository  repository.MerchantRepository
+	categoryRepository  repository.CategoryRepository
+	unitOfWork          repository.UnitOfWork
+	logger              logger.LoggerInterface
+	observability       observability.TraceLoggerObservability
+	cache               stocktake_cache.StocktakeMencache
+}
+
+type StocktakeServiceDeps struct {
+	StocktakeRepo repository.StocktakeRepository
+	MerchantRepo  repository.MerchantRepository
+	CategoryRepo  repository.CategoryRepository
+	UnitOfWork    repository.UnitOfWork
+	Logger        logger.LoggerInterface
+	Observability observability.TraceLoggerObservability
+	Cache         stocktake_cache.StocktakeMencache
+}
+
+func NewStocktakeService(deps StocktakeServiceDeps) *stocktakeService {
+	return &stocktakeService{
+		stocktakeRepository: deps.StocktakeRepo,
+		merchantRepository:  deps.MerchantRepo,
+		categoryRepository:  deps.CategoryRepo,
+		unitOfWork:          deps.UnitOfWork,
+		logger:              deps.Logger,
+		observability:       deps.Observability,
+		cache:               deps.Cache,
+	}
+}
+
+func (s *stocktakeService) FindAll(ctx context.Context, req *requests.FindAllStocktakes) ([]*db.GetStocktakesRow, *int, error) {
+	const method = "FindAllStocktakes"
+
+	page := req.Page
+	pageSize := req.PageSize
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("page", page),
+		attribute.Int("pageSize", pageSize),
+		attribute.Int("merchantID", req.MerchantID),
+		attribute.String("status", req.Status))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.stocktakeRepository.FindAllStocktakes(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandlerErrorPagination[[]*db.GetStocktakesRow](
+			s.logger,
+			stocktake_errors.ErrFailedFindAll,
+			method,
+			span,
+
+			zap.Int("page", req.Page),
+			zap.Int("page_size", req.PageSize),
+			zap.Int("merchant_id", req.MerchantID),
+			zap.String("status", req.Status),
+		)
+	}
+
+	var totalCount int
+
+	if len(res) > 0 {
+		totalCount = int(res[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	logSuccess("Successfully fetched stocktakes",
+		zap.Int("totalRecords", totalCount),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return res, &totalCount, nil
+}
+
+func (s *stocktakeService) FindById(ctx context.Context, stocktake_id int) (*db.Stocktake, error) {
+	const method = "FindByIdStocktake"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("stocktakeID", stocktake_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.cache.GetCachedStocktakeById(ctx, stocktake_id); found {
+		logSuccess("Successfully retrieved stocktake from cache", zap.Int("stocktakeID", stocktake_id))
+
+		return data, nil
+	}
+
+	res, err := s.stocktakeRepository.FindById(ctx, stocktake_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Stocktake](
+			s.logger,
+			stocktake_errors.ErrStocktakeNotFoundRes,
+			method,
+			span,
+
+			zap.Int("stocktake_id", stocktake_id),
+		)
+	}
+
+	s.cache.SetCachedStocktakeById(ctx, res)
+
+	logSuccess("Successfully fetched stocktake", zap.Int("stocktakeID", stocktake_id))
+
+	return res, nil
+}
+
+// FindItems lists the lines of a stocktake with their variance, largest
+// shortages first.
+func (s *stocktakeService) FindItems(ctx context.Context, req *requests.FindStocktakeItems) ([]*db.GetStocktakeItemsRow, *int, error) {
+	const method = "FindStocktakeItems"
+
+	page := req.Page
+	pageSize := req.PageSize
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("stocktakeID", req.StocktakeID),
+		attribute.Int("page", page),
+		attribute.Int("pageSize", pageSize))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.stocktakeRepository.FindItems(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandlerErrorPagination[[]*db.GetStocktakeItemsRow](
+			s.logger,
+			stocktake_errors.ErrFailedFindItems,
+			method,
+			span,
+
+			zap.Int("stocktake_id", req.StocktakeID),
+			zap.Int("page", req.Page),
+			zap.Int("page_size", req.PageSize),
+			zap.Error(err),
+		)
+	}
+
+	var totalCount int
+
+	if len(res) > 0 {
+		totalCount = int(res[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	logSuccess("Successfully fetched stocktake items",
+		zap.Int("stocktakeID", req.StocktakeID),
+		zap.Int("totalRecords", totalCount))
+
+	return res, &totalCount, nil
+}
+
+// FindVarianceReport summarises the variance of a stocktake by category.
+// Only counted lines contribute to the report.
+func (s *stocktakeService) FindVarianceReport(ctx context.Context, stocktake_id int) ([]*db.GetStocktakeVarianceByCategoryRow, error) {
+	const method = "FindStocktakeVarianceReport"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("stocktakeID", stocktake_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.stocktakeRepository.FindById(ctx, stocktake_id); err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.GetStocktakeVarianceByCategoryRow](
+			s.logger,
+			stocktake_errors.ErrStocktakeNotFoundRes,
+			method,
+			span,
+
+			zap.Int("stocktake_id", stocktake_id),
+			zap.Error(err),
+		)
+	}
+
+	res, err := s.stocktakeRepository.FindVarianceByCategory(ctx, stocktake_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.GetStocktakeVarianceByCategoryRow](
+			s.logger,
+			stocktake_errors.ErrFailedVarianceReport,
+			method,
+			span,
+
+			zap.Int("stocktake_id", stocktake_id),
+			zap.Error(err),
+		)
+	}
+
+	logSuccess("Successfully built stocktake variance report",
+		zap.Int("stocktakeID", stocktake_id),
+		zap.Int("categories", len(res)))
+
+	return res, nil
+}
+
+// CreateStocktake opens a stocktake and snapshots the expected quantity and
+// price of every active product it covers. A merchant has at most one open
+// stocktake at a time.
+func (s *stocktakeService) CreateStocktake(ctx context.Context, req *requests.CreateStocktakeRequest) (*db.Stocktake, error) {
+	const method = "CreateStocktake"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("merchantID", req.MerchantID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.merchantRepository.FindById(ctx, req.MerchantID); err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Stocktake](
+			s.logger,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
+			method,
+			span,
+
+			zap.Int("merchant_id", req.MerchantID),
+			zap.Error(err),
+		)
+	}
+
+	if req.CategoryID != nil {
+		if _, err := s.categoryRepository.FindById(ctx, *req.CategoryID); err != nil {
+			status = "error"
+			return errorhandler.HandleError[*db.Stocktake](
+				s.logger,
+				category_errors.ErrFailedFindCategoryById,
+				method,
+				span,
+
+				zap.Int("category_id", *req.CategoryID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	var (
+		res   *db.Stocktake
+		items int64
+	)
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		var err error
+
+		res, err = repos.Stocktake.CreateStocktake(ctx, &requests.CreateStocktakeRecordRequest{
+			MerchantID: req.MerchantID,
+			CategoryID: req.CategoryID,
+			Note:       req.Note,
+			CreatedBy:  actingUserID(ctx),
+		})
+		if err != nil {
+			failure := stocktake_errors.ErrFailedCreateStocktake
+			if errors.Is(err, stocktake_errors.ErrStocktakeAlreadyOpen) {
+				failure = stocktake_errors.ErrFailedAlreadyOpen
+			}
+
+			return errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Int("merchant_id", req.MerchantID),
+				zap.Error(err))
+		}
+
+		items, err = repos.Stocktake.CreateItems(ctx, int(res.StocktakeID))
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				stocktake_errors.ErrFailedSnapshotItems,
+				method,
+				span,
+				zap.Int("stocktake_id", int(res.StocktakeID)),
+				zap.Error(err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.InvalidateStocktakeCache(ctx)
+
+	logSuccess("Successfully opened stocktake",
+		zap.Int("stocktakeID", int(res.StocktakeID)),
+		zap.Int64("items", items))
+
+	return res, nil
+}
+
+// SubmitCounts records counted quantities against an open stocktake.
+// Lines name their product by ID or barcode; lines for the same product are
+// added together and replace any earlier count of it. The whole submission
+// fails if any line cannot be recorded.
+func (s *stocktakeService) SubmitCounts(ctx context.Context, req *requests.SubmitStocktakeCountsRequest) ([]*db.StocktakeItem, error) {
+	const method = "SubmitStocktakeCounts"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("stocktakeID", req.StocktakeID),
+		attribute.Int("lines", len(req.Items)))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res []*db.StocktakeItem
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		stocktake, err := repos.Stocktake.LockForCount(ctx, req.StocktakeID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				stocktake_errors.ErrStocktakeNotFoundRes,
+				method,
+				span,
+				zap.Int("stocktake_id", req.StocktakeID),
+				zap.Error(err))
+		}
+
+		if stocktake.Status != stocktakeStatusOpen {
+			return errorhandler.HandleTxError(
+				s.logger,
+				stocktake_errors.ErrFailedNotOpen,
+				method,
+				span,
+				zap.Int("stocktake_id", req.StocktakeID),
+				zap.String("status", stocktake.Status))
+		}
+
+		var productIDs []int
+		counts := make(map[int]int)
+
+		for _, line := range req.Items {
+			productID, err := s.resolveCountedProduct(ctx, repos, method, span, line)
+			if err != nil {
+				return err
+			}
+
+			if _, seen := counts[productID]; !seen {
+				productIDs = append(productIDs, productID)
+			}
+			counts[productID] += line.Quantity
+		}
+
+		countedBy := actingUserID(ctx)
+
+		for _, productID := range productIDs {
+			item, err := repos.Stocktake.UpdateCount(ctx, &requests.UpdateStocktakeCountRecordRequest{
+				StocktakeID:     req.StocktakeID,
+				ProductID:       productID,
+				CountedQuantity: counts[productID],
+				CountedBy:       countedBy,
+			})
+			if err != nil {
+				failure := stocktake_errors.ErrFailedSubmitCount
+				if errors.Is(err, stocktake_errors.ErrProductNotInStocktake) {
+					failure = stocktake_errors.ErrFailedProductNotInStocktake
+				}
+
+				return errorhandler.HandleTxError(
+					s.logger,
+					failure,
+					method,
+					span,
+					zap.Int("stocktake_id", req.StocktakeID),
+					zap.Int("product_id", productID),
+					zap.Error(err))
+			}
+
+			res = append(res, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteCachedStocktake(ctx, req.StocktakeID)
+
+	logSuccess("Successfully recorded stocktake counts",
+		zap.Int("stocktakeID", req.StocktakeID),
+		zap.Int("products", len(res)))
+
+	return res, nil
+}
+
+// resolveCountedProduct returns the product a count line refers to,
+// looking it up by barcode when the line has no product ID.
+func (s *stocktakeService) resolveCountedProduct(
+	ctx context.Context,
+	repos *repository.Repositories,
+	method string,
+	span trace.Span,
+	line requests.SubmitStocktakeCountRequest,
+) (int, error) {
+	if line.ProductID != nil {
+		return *line.ProductID, nil
+	}
+
+	product, err := repos.Product.FindByBarcode(ctx, *line.Barcode)
+	if err == nil {
+		return int(product.ProductID), nil
+	}
+
+	failure := stocktake_errors.ErrFailedSubmitCount
+	if errors.Is(err, product_errors.ErrBarcodeNotFound) {
+		failure = stocktake_errors.ErrFailedUnknownBarcode
+	}
+
+	return 0, errorhandler.HandleTxError(
+		s.logger,
+		failure,
+		method,
+		span,
+		zap.String("barcode", *line.Barcode),
+		zap.Error(err))
+}
+
+// PostStocktake closes an open stocktake and adjusts stock by the variance
+// of every counted line through the stock ledger. Adjustments are applied
+// as deltas, so sales made after a line was counted are kept. Uncounted
+// lines are left alone. Either every adjustment is posted or none is.
+func (s *stocktakeService) PostStocktake(ctx context.Context, stocktake_id int) (*db.Stocktake, error) {
+	const method = "PostStocktake"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("stocktakeID", stocktake_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	var (
+		res         *db.Stocktake
+		adjustments int
+	)
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		var err error
+
+		res, err = transitionStocktake(ctx, repos, s.logger, method, span, stocktake_id, stocktakeStatusPosted)
+		if err != nil {
+			return err
+		}
+
+		items, err := repos.Stocktake.FindCountedItems(ctx, stocktake_id)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				stocktake_errors.ErrFailedFindItems,
+				method,
+				span,
+				zap.Int("stocktake_id", stocktake_id),
+				zap.Error(err))
+		}
+
+		reference := stockReferenceStocktake
+
+		for _, item := range items {
+			variance := int(*item.CountedQuantity - item.ExpectedQuantity)
+			if variance == 0 {
+				continue
+			}
+
+			_, err = recordStockMovement(ctx, repos, s.logger, method, span, stocktake_errors.ErrFailedPostAdjustment, &requests.CreateStockMovementRecordRequest{
+				ProductID:     int(item.ProductID),
+				Reason:        stockReasonStocktake,
+				QuantityDelta: variance,
+				ReferenceType: &reference,
+				ReferenceID:   &stocktake_id,
+				Note:          res.Note,
+			})
+			if err != nil {
+				return err
+			}
+
+			adjustments++
+		}
+
+		return nil
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteCachedStocktake(ctx, stocktake_id)
+
+	logSuccess("Successfully posted stocktake",
+		zap.Int("stocktakeID", stocktake_id),
+		zap.Int("adjustments", adjustments))
+
+	return res, nil
+}
+
+// CancelStocktake abandons an open stocktake without touching stock.
+func (s *stocktakeService) CancelStocktake(ctx context.Context, stocktake_id int) (*db.Stocktake, error) {
+	const method = "CancelStocktake"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("stocktakeID", stocktake_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	var res *db.Stocktake
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		var err error
+
+		res, err = transitionStocktake(ctx, repos, s.logger, method, span, stocktake_id, stocktakeStatusCancelled)
+
+		return err
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteCachedStocktake(ctx, stocktake_id)
+
+	logSuccess("Successfully cancelled stocktake", zap.Int("stocktakeID", stocktake_id))
+
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/stocktake_errors"
+	"pointofsale/pkg/logger"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	stocktakeStatusOpen      = "open"
+	stocktakeStatusPosted    = "posted"
+	stocktakeStatusCancelled = "cancelled"
+)
+
+// stocktakeTransitions lists the statuses a stocktake may move to from each
+// status. Posted and cancelled stocktakes are final.
+var stocktakeTransitions = map[string][]string{
+	stocktakeStatusOpen: {stocktakeStatusPosted, stocktakeStatusCancelled},
+}
+
+func canTransitionStocktake(from, to string) bool {
+	for _, next := range stocktakeTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transitionStocktake moves a stocktake to the given status inside a unit
+// of work, stamping the acting user when it is posted.
+func transitionStocktake(
+	ctx context.Context,
+	repos *repository.Repositories,
+	log logger.LoggerInterface,
+	method string,
+	span trace.Span,
+	stocktakeID int,
+	to string,
+) (*db.Stocktake, error) {
+	stocktake, err := repos.Stocktake.FindById(ctx, stocktakeID)
+	if err != nil {
+		return nil, errorhandler.HandleTxError(
+			log,
+			stocktake_errors.ErrStocktakeNotFoundRes,
+			method,
+			span,
+			zap.Int("stocktake_id", stocktakeID),
+			zap.Error(err))
+	}
+
+	if !canTransitionStocktake(stocktake.Status, to) {
+		return nil, errorhandler.HandleTxError(
+			log,
+			stocktake_errors.ErrFailedInvalidTransition,
+			method,
+			span,
+			zap.Int("stocktake_id", stocktakeID),
+			zap.String("from", stocktake.Status),
+			zap.String("to", to))
+	}
+
+	res, err := repos.Stocktake.UpdateStocktakeStatus(ctx, stocktakeID, stocktake.Status, to, actingUserID(ctx))
+	if err != nil {
+		failure := stocktake_errors.ErrFailedUpdateStatus
+		if errors.Is(err, stocktake_errors.ErrStocktakeStatusChanged) {
+			failure = stocktake_errors.ErrFailedStatusConflict
+		}
+
+		return nil, errorhandler.HandleTxError(
+			log,
+			failure,
+			method,
+			span,
+			zap.Int("stocktake_id", stocktakeID),
+			zap.String("from", stocktake.Status),
+			zap.String("to", to),
+			zap.Error(err))
+	}
+
+	return res, nil
+}
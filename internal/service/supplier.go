@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	supplier_cache "pointofsale/internal/cache/supplier"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/merchant_errors"
+	"pointofsale/pkg/errors/supplier_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type supplierService struct {
+	supplierRepository repository.SupplierRepository
+	merchantRepository repository.MerchantRepository
+	logger             logger.LoggerInterface
+	observability      observability.TraceLoggerObservability
+	cache              supplier_cache.SupplierMencache
+}
+
+type SupplierServiceDeps struct {
+	SupplierRepo  repository.SupplierRepository
+	MerchantRepo  repository.MerchantRepository
+	Logger        logger.LoggerInterface
+	Observability observability.TraceLoggerObservability
+	Cache         supplier_cache.SupplierMencache
+}
+
+func NewSupplierService(deps SupplierServiceDeps) *supplierService {
+	return &supplierService{
+		supplierRepository: deps.SupplierRepo,
+		merchantRepository: deps.MerchantRepo,
+		logger:             deps.Logger,
+		observability:      deps.Observability,
+		cache:              deps.Cache,
+	}
+}
+
+func (s *supplierService) FindAll(ctx context.Context, req *requests.FindAllSuppliers) ([]*db.GetSuppliersRow, *int, error) {
+	const method = "FindAllSuppliers"
+
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("page", page),
+		attribute.Int("pageSize", pageSize),
+		attribute.String("search", search),
+		attribute.Int("merchantID", req.MerchantID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, total, found := s.cache.GetCachedSuppliers(ctx, req); found {
+		logSuccess("Successfully retrieved all supplier records from cache", zap.Int("totalRecords", *total), zap.Int("page", page), zap.Int("pageSize", pageSize))
+		return data, total, nil
+	}
+
+	res, err := s.supplierRepository.FindAllSuppliers(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandlerErrorPagination[[]*db.GetSuppliersRow](
+			s.logger,
+			supplier_errors.ErrFailedFindAll,
+			method,
+			span,
+
+			zap.Int("page", req.Page),
+			zap.Int("page_size", req.PageSize),
+			zap.String("search", req.Search),
+			zap.Int("merchant_id", req.MerchantID),
+		)
+	}
+
+	var totalCount int
+
+	if len(res) > 0 {
+		totalCount = int(res[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	s.cache.SetCachedSuppliers(ctx, req, res, &totalCount)
+
+	logSuccess("Successfully fetched suppliers",
+		zap.Int("totalRecords", totalCount),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return res, &totalCount, nil
+}
+
+func (s *supplierService) FindById(ctx context.Context, supplier_id int) (*db.Supplier, error) {
+	const method = "FindByIdSupplier"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("supplierID", supplier_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.cache.GetCachedSupplierById(ctx, supplier_id); found {
+		logSuccess("Successfully retrieved supplier from cache", zap.Int("supplierID", supplier_id))
+
+		return data, nil
+	}
+
+	res, err := s.supplierRepository.FindById(ctx, supplier_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Supplier](
+			s.logger,
+			supplier_errors.ErrSupplierNotFoundRes,
+			method,
+			span,
+
+			zap.Int("supplier_id", supplier_id),
+		)
+	}
+
+	s.cache.SetCachedSupplierById(ctx, res)
+
+	logSuccess("Successfully fetched supplier", zap.Int("supplierID", supplier_id))
+
+	return res, nil
+}
+
+func (s *supplierService) CreateSupplier(ctx context.Context, req *requests.CreateSupplierRequest) (*db.Supplier, error) {
+	const method = "CreateSupplier"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("merchantID", req.MerchantID),
+		attribute.String("name", req.Name))
+
+	defer func() {
+		end(status)
+	}()
+
+	_, err := s.merchantRepository.FindById(ctx, req.MerchantID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Supplier](
+			s.logger,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
+			method,
+			span,
+
+			zap.Int("merchant_id", req.MerchantID),
+			zap.Error(err),
+		)
+	}
+
+	res, err := s.supplierRepository.CreateSupplier(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Supplier](
+			s.logger,
+			supplier_errors.ErrFailedCreateSupplier,
+			method,
+			span,
+
+			zap.String("name", req.Name),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.InvalidateSupplierCache(ctx)
+
+	logSuccess("Successfully created supplier",
+		zap.Int("supplierID", int(res.SupplierID)),
+		zap.String("name", req.Name))
+
+	return res, nil
+}
+
+func (s *supplierService) UpdateSupplier(ctx context.Context, req *requests.UpdateSupplierRequest) (*db.Supplier, error) {
+	const method = "UpdateSupplier"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("supplierID", *req.SupplierID))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.supplierRepository.UpdateSupplier(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Supplier](
+			s.logger,
+			supplier_errors.ErrFailedUpdateSupplier,
+			method,
+			span,
+
+			zap.Int("supplier_id", *req.SupplierID),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedSupplier(ctx, *req.SupplierID)
+
+	logSuccess("Successfully updated supplier",
+		zap.Int("supplierID", *req.SupplierID))
+
+	return res, nil
+}
+
+func (s *supplierService) TrashedSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error) {
+	const method = "TrashedSupplier"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("supplierID", supplier_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.supplierRepository.TrashedSupplier(ctx, supplier_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Supplier](
+			s.logger,
+			supplier_errors.ErrFailedTrashedSupplier,
+			method,
+			span,
+
+			zap.Int("supplier_id", supplier_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedSupplier(ctx, supplier_id)
+
+	logSuccess("Successfully trashed supplier", zap.Int("supplierID", supplier_id))
+
+	return res, nil
+}
+
+func (s *supplierService) RestoreSupplier(ctx context.Context, supplier_id int) (*db.Supplier, error) {
+	const method = "RestoreSupplier"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("supplierID", supplier_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.supplierRepository.RestoreSupplier(ctx, supplier_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.Supplier](
+			s.logger,
+			supplier_errors.ErrFailedRestoreSupplier,
+			method,
+			span,
+
+			zap.Int("supplier_id", supplier_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedSupplier(ctx, supplier_id)
+
+	logSuccess("Successfully restored supplier", zap.Int("supplierID", supplier_id))
+
+	return res, nil
+}
+
+func (s *supplierService) DeleteSupplierPermanent(ctx context.Context, supplier_id int) (bool, error) {
+	const method = "DeleteSupplierPermanent"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("supplierID", supplier_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	_, err := s.supplierRepository.DeleteSupplierPermanent(ctx, supplier_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[bool](
+			s.logger,
+			supplier_errors.ErrFailedDeletePermanent,
+			method,
+			span,
+
+			zap.Int("supplier_id", supplier_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedSupplier(ctx, supplier_id)
+
+	logSuccess("Successfully deleted supplier permanently", zap.Int("supplierID", supplier_id))
+
+	return true, nil
+}
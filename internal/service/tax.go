@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"errors"
+	tax_cache "pointofsale/internal/cache/tax"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/errorhandler"
+	"pointofsale/internal/repository"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/tax_errors"
+	"pointofsale/pkg/errors/transaction_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+type taxService struct {
+	taxRateRepository     repository.TaxRateRepository
+	transactionRepository repository.TransactionRepository
+	orderItemRepository   repository.OrderItemRepository
+	logger                logger.LoggerInterface
+	observability         observability.TraceLoggerObservability
+	cache                 tax_cache.TaxMencache
+}
+
+type TaxServiceDeps struct {
+	TaxRateRepo     repository.TaxRateRepository
+	TransactionRepo repository.TransactionRepository
+	OrderItemRepo   repository.OrderItemRepository
+	Logger          logger.LoggerInterface
+	Observability   observability.TraceLoggerObservability
+	Cache           tax_cache.TaxMencache
+}
+
+func NewTaxService(deps TaxServiceDeps) *taxService {
+	return &taxService{
+		taxRateRepository:     deps.TaxRateRepo,
+		transactionRepository: deps.TransactionRepo,
+		orderItemRepository:   deps.OrderItemRepo,
+		logger:                deps.Logger,
+		observability:         deps.Observability,
+		cache:                 deps.Cache,
+	}
+}
+
+func (s *taxService) FindAll(ctx context.Context, req *requests.FindAllTaxRates) ([]*db.GetTaxRatesRow, *int, error) {
+	const method = "FindAllTaxRates"
+
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("page", page),
+		attribute.Int("pageSize", pageSize),
+		attribute.String("search", search))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, total, found := s.cache.GetCachedTaxRates(ctx, req); found {
+		logSuccess("Successfully retrieved all tax rate records from cache", zap.Int("totalRecords", *total), zap.Int("page", page), zap.Int("pageSize", pageSize))
+		return data, total, nil
+	}
+
+	res, err := s.taxRateRepository.FindAllTaxRates(ctx, req)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandlerErrorPagination[[]*db.GetTaxRatesRow](
+			s.logger,
+			tax_errors.ErrFailedFindAll,
+			method,
+			span,
+
+			zap.Int("page", req.Page),
+			zap.Int("page_size", req.PageSize),
+			zap.String("search", req.Search),
+		)
+	}
+
+	var totalCount int
+
+	if len(res) > 0 {
+		totalCount = int(res[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	s.cache.SetCachedTaxRates(ctx, req, res, &totalCount)
+
+	logSuccess("Successfully fetched tax rates",
+		zap.Int("totalRecords", totalCount),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return res, &totalCount, nil
+}
+
+func (s *taxService) FindById(ctx context.Context, tax_rate_id int) (*db.TaxRate, error) {
+	const method = "FindByIdTaxRate"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("taxRateID", tax_rate_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	if data, found := s.cache.GetCachedTaxRateById(ctx, tax_rate_id); found {
+		logSuccess("Successfully retrieved tax rate from cache", zap.Int("taxRateID", tax_rate_id))
+
+		return data, nil
+	}
+
+	res, err := s.taxRateRepository.FindById(ctx, tax_rate_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.TaxRate](
+			s.logger,
+			tax_errors.ErrTaxRateNotFoundRes,
+			method,
+			span,
+
+			zap.Int("tax_rate_id", tax_rate_id),
+		)
+	}
+
+	s.cache.SetCachedTaxRateById(ctx, res)
+
+	logSuccess("Successfully fetched tax rate", zap.Int("taxRateID", tax_rate_id))
+
+	return res, nil
+}
+
+func (s *taxService) FindTransactionTax(ctx context.Context, transaction_id int) ([]*db.GetOrderItemTaxLinesRow, error) {
+	const method = "FindTransactionTax"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("transactionID", transaction_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	transaction, err := s.transactionRepository.FindById(ctx, transaction_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.GetOrderItemTaxLinesRow](
+			s.logger,
+			transactionError(err, transaction_errors.ErrFailedFindTransactionById),
+			method,
+			span,
+
+			zap.Int("transaction_id", transaction_id),
+			zap.Error(err),
+		)
+	}
+
+	res, err := s.orderItemRepository.FindOrderItemTaxLines(ctx, int(transaction.OrderID))
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.GetOrderItemTaxLinesRow](
+			s.logger,
+			tax_errors.ErrFailedFindTaxLines,
+			method,
+			span,
+
+			zap.Int("transaction_id", transaction_id),
+			zap.Error(err),
+		)
+	}
+
+	logSuccess("Successfully fetched transaction tax breakdown",
+		zap.Int("transactionID", transaction_id),
+		zap.Int("lines", len(res)))
+
+	return res, nil
+}
+
+func (s *taxService) CreateTaxRate(ctx context.Context, req *requests.CreateTaxRateRequest) (*db.TaxRate, error) {
+	const method = "CreateTaxRate"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.String("name", req.Name),
+		attribute.Int("rateBps", req.RateBps))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.taxRateRepository.CreateTaxRate(ctx, req)
+	if err != nil {
+		status = "error"
+
+		appErr := tax_errors.ErrFailedCreateTaxRate
+		if errors.Is(err, tax_errors.ErrTaxRateConflict) {
+			appErr = tax_errors.ErrTaxRateConflictRes
+		}
+
+		return errorhandler.HandleError[*db.TaxRate](
+			s.logger,
+			appErr,
+			method,
+			span,
+
+			zap.String("name", req.Name),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.InvalidateTaxRateCache(ctx)
+
+	logSuccess("Successfully created tax rate",
+		zap.Int("taxRateID", int(res.TaxRateID)),
+		zap.String("name", req.Name))
+
+	return res, nil
+}
+
+func (s *taxService) UpdateTaxRate(ctx context.Context, req *requests.UpdateTaxRateRequest) (*db.TaxRate, error) {
+	const method = "UpdateTaxRate"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("taxRateID", *req.TaxRateID),
+		attribute.Int("rateBps", req.RateBps))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.taxRateRepository.UpdateTaxRate(ctx, req)
+	if err != nil {
+		status = "error"
+
+		appErr := tax_errors.ErrFailedUpdateTaxRate
+		if errors.Is(err, tax_errors.ErrTaxRateConflict) {
+			appErr = tax_errors.ErrTaxRateConflictRes
+		}
+
+		return errorhandler.HandleError[*db.TaxRate](
+			s.logger,
+			appErr,
+			method,
+			span,
+
+			zap.Int("tax_rate_id", *req.TaxRateID),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedTaxRate(ctx, *req.TaxRateID)
+
+	logSuccess("Successfully updated tax rate",
+		zap.Int("taxRateID", *req.TaxRateID))
+
+	return res, nil
+}
+
+func (s *taxService) TrashedTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error) {
+	const method = "TrashedTaxRate"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("taxRateID", tax_rate_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.taxRateRepository.TrashedTaxRate(ctx, tax_rate_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[*db.TaxRate](
+			s.logger,
+			tax_errors.ErrFailedTrashedTaxRate,
+			method,
+			span,
+
+			zap.Int("tax_rate_id", tax_rate_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedTaxRate(ctx, tax_rate_id)
+
+	logSuccess("Successfully trashed tax rate", zap.Int("taxRateID", tax_rate_id))
+
+	return res, nil
+}
+
+func (s *taxService) RestoreTaxRate(ctx context.Context, tax_rate_id int) (*db.TaxRate, error) {
+	const method = "RestoreTaxRate"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("taxRateID", tax_rate_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	res, err := s.taxRateRepository.RestoreTaxRate(ctx, tax_rate_id)
+	if err != nil {
+		status = "error"
+
+		appErr := tax_errors.ErrFailedRestoreTaxRate
+		if errors.Is(err, tax_errors.ErrTaxRateConflict) {
+			appErr = tax_errors.ErrTaxRateConflictRes
+		}
+
+		return errorhandler.HandleError[*db.TaxRate](
+			s.logger,
+			appErr,
+			method,
+			span,
+
+			zap.Int("tax_rate_id", tax_rate_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedTaxRate(ctx, tax_rate_id)
+
+	logSuccess("Successfully restored tax rate", zap.Int("taxRateID", tax_rate_id))
+
+	return res, nil
+}
+
+func (s *taxService) DeleteTaxRatePermanent(ctx context.Context, tax_rate_id int) (bool, error) {
+	const method = "DeleteTaxRatePermanent"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("taxRateID", tax_rate_id))
+
+	defer func() {
+		end(status)
+	}()
+
+	_, err := s.taxRateRepository.DeleteTaxRatePermanent(ctx, tax_rate_id)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[bool](
+			s.logger,
+			tax_errors.ErrFailedDeletePermanent,
+			method,
+			span,
+
+			zap.Int("tax_rate_id", tax_rate_id),
+			zap.Error(err),
+		)
+	}
+
+	s.cache.DeleteCachedTaxRate(ctx, tax_rate_id)
+
+	logSuccess("Successfully deleted tax rate permanently", zap.Int("taxRateID", tax_rate_id))
+
+	return true, nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"errors"
+	"pointofsale/pkg/errors/cashier_errors"
+	"pointofsale/pkg/errors/merchant_errors"
+	"pointofsale/pkg/errors/order_errors"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/transaction_errors"
+)
+
+// The database hides the rows of merchants the caller may not act for, so
+// reaching one fails the same way as reaching a row that does not exist. The
+// helpers below answer both with a 404 and any other failure with failure.
+
+func merchantError(err error, failure error) error {
+	if errors.Is(err, merchant_errors.ErrMerchantNotFound) {
+		return merchant_errors.ErrFailedMerchantNotFound
+	}
+
+	return failure
+}
+
+func cashierError(err error, failure error) error {
+	if errors.Is(err, cashier_errors.ErrCashierNotFound) {
+		return cashier_errors.ErrFailedCashierNotFound
+	}
+
+	return failure
+}
+
+func orderError(err error, failure error) error {
+	if errors.Is(err, order_errors.ErrOrderNotFound) {
+		return order_errors.ErrFailedOrderNotFound
+	}
+
+	return failure
+}
+
+func transactionError(err error, failure error) error {
+	if errors.Is(err, transaction_errors.ErrTransactionNotFound) {
+		return transaction_errors.ErrFailedTransactionNotFound
+	}
+
+	return failure
+}
+
+func productError(err error, failure error) error {
+	if errors.Is(err, product_errors.ErrProductNotFound) {
+		return product_errors.ErrFailedProductNotFound
+	}
+
+	return failure
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	transaction_cache "pointofsale/internal/cache/transaction"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/errorhandler"
@@ -11,46 +12,57 @@ import (
 	"pointofsale/pkg/errors/merchant_errors"
 	"pointofsale/pkg/errors/order_errors"
 	orderitem_errors "pointofsale/pkg/errors/order_item_errors"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/tax_errors"
 	"pointofsale/pkg/errors/transaction_errors"
+	"pointofsale/pkg/errors/user_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
+	"pointofsale/pkg/tax"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 type transactionService struct {
-	cashierRepository     repository.CashierRepository
-	merchantRepository    repository.MerchantRepository
-	transactionRepository repository.TransactionRepository
-	orderRepository       repository.OrderRepository
-	orderItemRepository   repository.OrderItemRepository
-	logger                logger.LoggerInterface
-	observability         observability.TraceLoggerObservability
-	cache                 transaction_cache.TransactionMencache
+	cashierRepository           repository.CashierRepository
+	merchantRepository          repository.MerchantRepository
+	transactionRepository       repository.TransactionRepository
+	transactionRefundRepository repository.TransactionRefundRepository
+	orderRepository             repository.OrderRepository
+	orderItemRepository         repository.OrderItemRepository
+	unitOfWork                  repository.UnitOfWork
+	logger                      logger.LoggerInterface
+	observability               observability.TraceLoggerObservability
+	cache                       transaction_cache.TransactionMencache
 }
 
 type TransactionServiceDeps struct {
-	CashierRepo     repository.CashierRepository
-	MerchantRepo    repository.MerchantRepository
-	TransactionRepo repository.TransactionRepository
-	OrderRepo       repository.OrderRepository
-	OrderItemRepo   repository.OrderItemRepository
-	Logger          logger.LoggerInterface
-	Observability   observability.TraceLoggerObservability
-	Cache           transaction_cache.TransactionMencache
+	CashierRepo           repository.CashierRepository
+	MerchantRepo          repository.MerchantRepository
+	TransactionRepo       repository.TransactionRepository
+	TransactionRefundRepo repository.TransactionRefundRepository
+	OrderRepo             repository.OrderRepository
+	OrderItemRepo         repository.OrderItemRepository
+	UnitOfWork            repository.UnitOfWork
+	Logger                logger.LoggerInterface
+	Observability         observability.TraceLoggerObservability
+	Cache                 transaction_cache.TransactionMencache
 }
 
 func NewTransactionService(deps TransactionServiceDeps) *transactionService {
 	return &transactionService{
-		cashierRepository:     deps.CashierRepo,
-		merchantRepository:    deps.MerchantRepo,
-		transactionRepository: deps.TransactionRepo,
-		orderRepository:       deps.OrderRepo,
-		orderItemRepository:   deps.OrderItemRepo,
-		logger:                deps.Logger,
-		cache:                 deps.Cache,
-		observability:         deps.Observability,
+		cashierRepository:           deps.CashierRepo,
+		merchantRepository:          deps.MerchantRepo,
+		transactionRepository:       deps.TransactionRepo,
+		transactionRefundRepository: deps.TransactionRefundRepo,
+		orderRepository:             deps.OrderRepo,
+		orderItemRepository:         deps.OrderItemRepo,
+		unitOfWork:                  deps.UnitOfWork,
+		logger:                      deps.Logger,
+		cache:                       deps.Cache,
+		observability:               deps.Observability,
 	}
 }
 
@@ -338,7 +350,7 @@ func (s *transactionService) FindById(ctx context.Context, transactionID int) (*
 		status = "error"
 		return errorhandler.HandleError[*db.GetTransactionByIDRow](
 			s.logger,
-			transaction_errors.ErrFailedFindTransactionById,
+			transactionError(err, transaction_errors.ErrFailedFindTransactionById),
 			method,
 			span,
 			zap.Int("transaction_id", transactionID))
@@ -352,6 +364,86 @@ func (s *transactionService) FindById(ctx context.Context, transactionID int) (*
 	return transaction, nil
 }
 
+func (s *transactionService) FindPayments(ctx context.Context, transactionID int) ([]*db.TransactionPayment, error) {
+	const method = "FindPayments"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("transaction_id", transactionID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.transactionRepository.FindById(ctx, transactionID); err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.TransactionPayment](
+			s.logger,
+			transactionError(err, transaction_errors.ErrFailedFindTransactionById),
+			method,
+			span,
+			zap.Int("transaction_id", transactionID),
+			zap.Error(err))
+	}
+
+	payments, err := s.transactionRepository.FindPayments(ctx, transactionID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.TransactionPayment](
+			s.logger,
+			transaction_errors.ErrFailedFindTransactionPayments,
+			method,
+			span,
+			zap.Int("transaction_id", transactionID),
+			zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched transaction payments",
+		zap.Int("transaction_id", transactionID),
+		zap.Int("payments", len(payments)))
+
+	return payments, nil
+}
+
+func (s *transactionService) FindRefunds(ctx context.Context, transactionID int) ([]*db.TransactionRefund, error) {
+	const method = "FindRefunds"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("transaction_id", transactionID))
+
+	defer func() {
+		end(status)
+	}()
+
+	if _, err := s.transactionRepository.FindById(ctx, transactionID); err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.TransactionRefund](
+			s.logger,
+			transactionError(err, transaction_errors.ErrFailedFindTransactionById),
+			method,
+			span,
+			zap.Int("transaction_id", transactionID),
+			zap.Error(err))
+	}
+
+	refunds, err := s.transactionRefundRepository.FindByTransaction(ctx, transactionID)
+	if err != nil {
+		status = "error"
+		return errorhandler.HandleError[[]*db.TransactionRefund](
+			s.logger,
+			transaction_errors.ErrFailedFindTransactionRefunds,
+			method,
+			span,
+			zap.Int("transaction_id", transactionID),
+			zap.Error(err))
+	}
+
+	logSuccess("Successfully fetched transaction refunds",
+		zap.Int("transaction_id", transactionID),
+		zap.Int("refunds", len(refunds)))
+
+	return refunds, nil
+}
+
 func (s *transactionService) FindByOrderId(ctx context.Context, orderID int) (*db.GetTransactionByOrderIDRow, error) {
 	const method = "FindByOrderId"
 
@@ -398,14 +490,9 @@ func (s *transactionService) FindMonthlyAmountSuccess(ctx context.Context, req *
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthAmountSuccessCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly successful transaction amounts from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyAmountSuccess(ctx, req)
+	res, err := s.cache.GetOrLoadMonthAmountSuccess(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionSuccessRow, error) {
+		return s.transactionRepository.GetMonthlyAmountSuccess(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyAmountTransactionSuccessRow](
@@ -418,8 +505,6 @@ func (s *transactionService) FindMonthlyAmountSuccess(ctx context.Context, req *
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthAmountSuccessCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly successful transaction amounts",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -438,13 +523,9 @@ func (s *transactionService) FindYearlyAmountSuccess(ctx context.Context, year i
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearAmountSuccessCached(ctx, year); found {
-		logSuccess("Successfully retrieved yearly successful transaction amounts from cache",
-			zap.Int("year", year))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyAmountSuccess(ctx, year)
+	res, err := s.cache.GetOrLoadYearAmountSuccess(ctx, year, func(ctx context.Context) ([]*db.GetYearlyAmountTransactionSuccessRow, error) {
+		return s.transactionRepository.GetYearlyAmountSuccess(ctx, year)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyAmountTransactionSuccessRow](
@@ -456,8 +537,6 @@ func (s *transactionService) FindYearlyAmountSuccess(ctx context.Context, year i
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearAmountSuccessCached(ctx, year, res)
-
 	logSuccess("Successfully fetched yearly successful transaction amounts",
 		zap.Int("year", year),
 		zap.Int("count", len(res)))
@@ -476,14 +555,9 @@ func (s *transactionService) FindMonthlyAmountFailed(ctx context.Context, req *r
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthAmountFailedCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly failed transaction amounts from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyAmountFailed(ctx, req)
+	res, err := s.cache.GetOrLoadMonthAmountFailed(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionFailedRow, error) {
+		return s.transactionRepository.GetMonthlyAmountFailed(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyAmountTransactionFailedRow](
@@ -496,8 +570,6 @@ func (s *transactionService) FindMonthlyAmountFailed(ctx context.Context, req *r
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthAmountFailedCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly failed transaction amounts",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -516,13 +588,9 @@ func (s *transactionService) FindYearlyAmountFailed(ctx context.Context, year in
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearAmountFailedCached(ctx, year); found {
-		logSuccess("Successfully retrieved yearly failed transaction amounts from cache",
-			zap.Int("year", year))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyAmountFailed(ctx, year)
+	res, err := s.cache.GetOrLoadYearAmountFailed(ctx, year, func(ctx context.Context) ([]*db.GetYearlyAmountTransactionFailedRow, error) {
+		return s.transactionRepository.GetYearlyAmountFailed(ctx, year)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyAmountTransactionFailedRow](
@@ -534,8 +602,6 @@ func (s *transactionService) FindYearlyAmountFailed(ctx context.Context, year in
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearAmountFailedCached(ctx, year, res)
-
 	logSuccess("Successfully fetched yearly failed transaction amounts",
 		zap.Int("year", year),
 		zap.Int("count", len(res)))
@@ -554,14 +620,9 @@ func (s *transactionService) FindMonthlyMethodSuccess(ctx context.Context, req *
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthMethodSuccessCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly successful transaction methods from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyTransactionMethodSuccess(ctx, req)
+	res, err := s.cache.GetOrLoadMonthMethodSuccess(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsSuccessRow, error) {
+		return s.transactionRepository.GetMonthlyTransactionMethodSuccess(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyTransactionMethodsSuccessRow](
@@ -574,8 +635,6 @@ func (s *transactionService) FindMonthlyMethodSuccess(ctx context.Context, req *
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthMethodSuccessCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly successful transaction methods",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -594,13 +653,9 @@ func (s *transactionService) FindYearlyMethodSuccess(ctx context.Context, year i
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearMethodSuccessCached(ctx, year); found {
-		logSuccess("Successfully retrieved yearly successful transaction methods from cache",
-			zap.Int("year", year))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyTransactionMethodSuccess(ctx, year)
+	res, err := s.cache.GetOrLoadYearMethodSuccess(ctx, year, func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsSuccessRow, error) {
+		return s.transactionRepository.GetYearlyTransactionMethodSuccess(ctx, year)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyTransactionMethodsSuccessRow](
@@ -612,8 +667,6 @@ func (s *transactionService) FindYearlyMethodSuccess(ctx context.Context, year i
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearMethodSuccessCached(ctx, year, res)
-
 	logSuccess("Successfully fetched yearly successful transaction methods",
 		zap.Int("year", year),
 		zap.Int("count", len(res)))
@@ -632,14 +685,9 @@ func (s *transactionService) FindMonthlyMethodFailed(ctx context.Context, req *r
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthMethodFailedCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly failed transaction methods from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyTransactionMethodFailed(ctx, req)
+	res, err := s.cache.GetOrLoadMonthMethodFailed(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsFailedRow, error) {
+		return s.transactionRepository.GetMonthlyTransactionMethodFailed(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyTransactionMethodsFailedRow](
@@ -652,8 +700,6 @@ func (s *transactionService) FindMonthlyMethodFailed(ctx context.Context, req *r
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthMethodFailedCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly failed transaction methods",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -672,13 +718,9 @@ func (s *transactionService) FindYearlyMethodFailed(ctx context.Context, year in
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearMethodFailedCached(ctx, year); found {
-		logSuccess("Successfully retrieved yearly failed transaction methods from cache",
-			zap.Int("year", year))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyTransactionMethodFailed(ctx, year)
+	res, err := s.cache.GetOrLoadYearMethodFailed(ctx, year, func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsFailedRow, error) {
+		return s.transactionRepository.GetYearlyTransactionMethodFailed(ctx, year)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyTransactionMethodsFailedRow](
@@ -690,8 +732,6 @@ func (s *transactionService) FindYearlyMethodFailed(ctx context.Context, year in
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearMethodFailedCached(ctx, year, res)
-
 	logSuccess("Successfully fetched yearly failed transaction methods",
 		zap.Int("year", year),
 		zap.Int("count", len(res)))
@@ -711,15 +751,9 @@ func (s *transactionService) FindMonthlyAmountSuccessByMerchant(ctx context.Cont
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthAmountSuccessByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly successful transaction amounts by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyAmountSuccessByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadMonthAmountSuccessByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionSuccessByMerchantRow, error) {
+		return s.transactionRepository.GetMonthlyAmountSuccessByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyAmountTransactionSuccessByMerchantRow](
@@ -733,8 +767,6 @@ func (s *transactionService) FindMonthlyAmountSuccessByMerchant(ctx context.Cont
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthAmountSuccessByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly successful transaction amounts by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -755,14 +787,9 @@ func (s *transactionService) FindYearlyAmountSuccessByMerchant(ctx context.Conte
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearAmountSuccessByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved yearly successful transaction amounts by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyAmountSuccessByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadYearAmountSuccessByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetYearlyAmountTransactionSuccessByMerchantRow, error) {
+		return s.transactionRepository.GetYearlyAmountSuccessByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyAmountTransactionSuccessByMerchantRow](
@@ -775,8 +802,6 @@ func (s *transactionService) FindYearlyAmountSuccessByMerchant(ctx context.Conte
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearAmountSuccessByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched yearly successful transaction amounts by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchantID", req.MerchantID),
@@ -797,15 +822,9 @@ func (s *transactionService) FindMonthlyAmountFailedByMerchant(ctx context.Conte
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthAmountFailedByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly failed transaction amounts by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("month", req.Month),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyAmountFailedByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadMonthAmountFailedByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyAmountTransactionFailedByMerchantRow, error) {
+		return s.transactionRepository.GetMonthlyAmountFailedByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyAmountTransactionFailedByMerchantRow](
@@ -819,8 +838,6 @@ func (s *transactionService) FindMonthlyAmountFailedByMerchant(ctx context.Conte
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthAmountFailedByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly failed transaction amounts by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("month", req.Month),
@@ -841,14 +858,9 @@ func (s *transactionService) FindYearlyAmountFailedByMerchant(ctx context.Contex
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearAmountFailedByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved yearly failed transaction amounts by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyAmountFailedByMerchant(ctx, req)
+	res, err := s.cache.GetOrLoadYearAmountFailedByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetYearlyAmountTransactionFailedByMerchantRow, error) {
+		return s.transactionRepository.GetYearlyAmountFailedByMerchant(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyAmountTransactionFailedByMerchantRow](
@@ -861,8 +873,6 @@ func (s *transactionService) FindYearlyAmountFailedByMerchant(ctx context.Contex
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearAmountFailedByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched yearly failed transaction amounts by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchantID", req.MerchantID),
@@ -882,14 +892,9 @@ func (s *transactionService) FindMonthlyMethodByMerchantSuccess(ctx context.Cont
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthMethodSuccessByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly successful transaction methods by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyTransactionMethodByMerchantSuccess(ctx, req)
+	res, err := s.cache.GetOrLoadMonthMethodSuccessByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow, error) {
+		return s.transactionRepository.GetMonthlyTransactionMethodByMerchantSuccess(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyTransactionMethodsByMerchantSuccessRow](
@@ -902,8 +907,6 @@ func (s *transactionService) FindMonthlyMethodByMerchantSuccess(ctx context.Cont
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthMethodSuccessByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly successful transaction methods by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchantID", req.MerchantID),
@@ -923,14 +926,9 @@ func (s *transactionService) FindYearlyMethodByMerchantSuccess(ctx context.Conte
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearMethodSuccessByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved yearly successful transaction methods by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyTransactionMethodByMerchantSuccess(ctx, req)
+	res, err := s.cache.GetOrLoadYearMethodSuccessByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsByMerchantSuccessRow, error) {
+		return s.transactionRepository.GetYearlyTransactionMethodByMerchantSuccess(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyTransactionMethodsByMerchantSuccessRow](
@@ -943,8 +941,6 @@ func (s *transactionService) FindYearlyMethodByMerchantSuccess(ctx context.Conte
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearMethodSuccessByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched yearly successful transaction methods by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchantID", req.MerchantID),
@@ -964,14 +960,9 @@ func (s *transactionService) FindMonthlyMethodByMerchantFailed(ctx context.Conte
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedMonthMethodFailedByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved monthly failed transaction methods by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetMonthlyTransactionMethodByMerchantFailed(ctx, req)
+	res, err := s.cache.GetOrLoadMonthMethodFailedByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetMonthlyTransactionMethodsByMerchantFailedRow, error) {
+		return s.transactionRepository.GetMonthlyTransactionMethodByMerchantFailed(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetMonthlyTransactionMethodsByMerchantFailedRow](
@@ -984,8 +975,6 @@ func (s *transactionService) FindMonthlyMethodByMerchantFailed(ctx context.Conte
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedMonthMethodFailedByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched monthly failed transaction methods by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchantID", req.MerchantID),
@@ -1005,14 +994,9 @@ func (s *transactionService) FindYearlyMethodByMerchantFailed(ctx context.Contex
 		end(status)
 	}()
 
-	if data, found := s.cache.GetCachedYearMethodFailedByMerchantCached(ctx, req); found {
-		logSuccess("Successfully retrieved yearly failed transaction methods by merchant from cache",
-			zap.Int("year", req.Year),
-			zap.Int("merchantID", req.MerchantID))
-		return data, nil
-	}
-
-	res, err := s.transactionRepository.GetYearlyTransactionMethodByMerchantFailed(ctx, req)
+	res, err := s.cache.GetOrLoadYearMethodFailedByMerchant(ctx, req, func(ctx context.Context) ([]*db.GetYearlyTransactionMethodsByMerchantFailedRow, error) {
+		return s.transactionRepository.GetYearlyTransactionMethodByMerchantFailed(ctx, req)
+	})
 	if err != nil {
 		status = "error"
 		return errorhandler.HandleError[[]*db.GetYearlyTransactionMethodsByMerchantFailedRow](
@@ -1025,8 +1009,6 @@ func (s *transactionService) FindYearlyMethodByMerchantFailed(ctx context.Contex
 			zap.Error(err))
 	}
 
-	s.cache.SetCachedYearMethodFailedByMerchantCached(ctx, req, res)
-
 	logSuccess("Successfully fetched yearly failed transaction methods by merchant",
 		zap.Int("year", req.Year),
 		zap.Int("merchantID", req.MerchantID),
@@ -1051,7 +1033,7 @@ func (s *transactionService) CreateTransaction(ctx context.Context, req *request
 		status = "error"
 		return errorhandler.HandleError[*db.CreateTransactionRow](
 			s.logger,
-			cashier_errors.ErrFailedFindCashierById,
+			cashierError(err, cashier_errors.ErrFailedFindCashierById),
 			method,
 			span,
 			zap.Int("cashierId", req.CashierID),
@@ -1063,7 +1045,7 @@ func (s *transactionService) CreateTransaction(ctx context.Context, req *request
 		status = "error"
 		return errorhandler.HandleError[*db.CreateTransactionRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
 			span,
 			zap.Int("merchantId", int(cashier.MerchantID)),
@@ -1072,86 +1054,110 @@ func (s *transactionService) CreateTransaction(ctx context.Context, req *request
 
 	req.MerchantID = int(cashier.MerchantID)
 
-	_, err = s.orderRepository.FindById(ctx, req.OrderID)
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.CreateTransactionRow](
-			s.logger,
-			order_errors.ErrFailedFindOrderById,
-			method,
-			span,
-			zap.Int("orderID", req.OrderID),
-			zap.Error(err))
-	}
+	var (
+		transaction  *db.CreateTransactionRow
+		changeAmount int
+	)
 
-	orderItems, err := s.orderItemRepository.FindOrderItemByOrder(ctx, req.OrderID)
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.CreateTransactionRow](
-			s.logger,
-			orderitem_errors.ErrFailedFindOrderItemByOrder,
-			method,
-			span,
-			zap.Int("orderID", req.OrderID),
-			zap.Error(err))
-	}
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		order, err := repos.Order.FindById(ctx, req.OrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderError(err, order_errors.ErrFailedFindOrderById),
+				method,
+				span,
+				zap.Int("orderID", req.OrderID),
+				zap.Error(err))
+		}
 
-	if len(orderItems) == 0 {
-		status = "error"
-		return errorhandler.HandleError[*db.CreateTransactionRow](
-			s.logger,
-			orderitem_errors.ErrFailedOrderItemEmpty,
-			method,
-			span,
-			zap.Int("orderID", req.OrderID))
-	}
+		if !isOrderPayable(order.Status) {
+			return errorhandler.HandleTxError(
+				s.logger,
+				order_errors.ErrFailedOrderNotPayable,
+				method,
+				span,
+				zap.Int("orderID", req.OrderID),
+				zap.String("orderStatus", order.Status))
+		}
 
-	var totalAmount int32
-	for _, item := range orderItems {
-		if item.Quantity <= 0 {
-			status = "error"
-			return errorhandler.HandleError[*db.CreateTransactionRow](
+		orderItems, err := repos.OrderItem.FindOrderItemByOrder(ctx, req.OrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
 				s.logger,
 				orderitem_errors.ErrFailedFindOrderItemByOrder,
 				method,
 				span,
 				zap.Int("orderID", req.OrderID),
-				zap.Int("itemID", int(item.OrderItemID)),
-				zap.Int("quantity", int(item.Quantity)))
+				zap.Error(err))
 		}
-		totalAmount += item.Price * item.Quantity
-	}
 
-	ppn := totalAmount * 11 / 100
-	totalAmountWithTax := totalAmount + ppn
+		if len(orderItems) == 0 {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderitem_errors.ErrFailedOrderItemEmpty,
+				method,
+				span,
+				zap.Int("orderID", req.OrderID))
+		}
 
-	if req.Amount < int(totalAmountWithTax) {
-		status = "error"
-		return errorhandler.HandleError[*db.CreateTransactionRow](
-			s.logger,
-			transaction_errors.ErrFailedPaymentInsufficientBalance,
-			method,
-			span,
-			zap.Int("paid", req.Amount),
-			zap.Int("required", int(totalAmountWithTax)))
-	}
+		for _, item := range orderItems {
+			if item.Quantity <= 0 {
+				return errorhandler.HandleTxError(
+					s.logger,
+					orderitem_errors.ErrFailedFindOrderItemByOrder,
+					method,
+					span,
+					zap.Int("orderID", req.OrderID),
+					zap.Int("itemID", int(item.OrderItemID)),
+					zap.Int("quantity", int(item.Quantity)))
+			}
+		}
+
+		totals, err := s.applyOrderTax(ctx, repos, method, span, req.MerchantID, orderItems)
+		if err != nil {
+			return err
+		}
 
-	changeAmount := req.Amount - int(totalAmountWithTax)
-	paymentStatus := "success"
+		settlement, err := settleTenders(tenders(req.Payments, req.PaymentMethod, req.Amount), totals.Gross)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				err,
+				method,
+				span,
+				zap.Int("orderID", req.OrderID),
+				zap.Int("required", totals.Gross))
+		}
+
+		changeAmount = settlement.changeAmount
+
+		req.PaymentMethod = settlement.paymentMethod
+		req.PaymentStatus = &settlement.paymentStatus
+		req.ChangeAmount = &changeAmount
+		req.Amount = totals.Gross
+		req.SubtotalAmount = &totals.Net
+		req.TaxAmount = &totals.Tax
+
+		transaction, err = repos.Transaction.CreateTransaction(ctx, req)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transaction_errors.ErrFailedCreateTransaction,
+				method,
+				span,
+				zap.Error(err))
+		}
 
-	req.PaymentStatus = &paymentStatus
-	req.ChangeAmount = &changeAmount
-	req.Amount = int(totalAmountWithTax)
+		if err := s.recordTenders(ctx, repos, method, span, int(transaction.TransactionID), settlement); err != nil {
+			return err
+		}
 
-	transaction, err := s.transactionRepository.CreateTransaction(ctx, req)
+		return s.settleOrder(ctx, repos, method, span, req.OrderID, settlement.paymentStatus)
+	})
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*db.CreateTransactionRow](
-			s.logger,
-			transaction_errors.ErrFailedCreateTransaction,
-			method,
-			span,
-			zap.Error(err))
+		return nil, err
 	}
 
 	s.cache.DeleteTransactionCache(ctx, int(transaction.TransactionID))
@@ -1159,6 +1165,7 @@ func (s *transactionService) CreateTransaction(ctx context.Context, req *request
 	logSuccess("Successfully created transaction",
 		zap.Int("transactionID", int(transaction.TransactionID)),
 		zap.Int("orderID", req.OrderID),
+		zap.String("paymentStatus", transaction.PaymentStatus),
 		zap.Int("amount", req.Amount),
 		zap.Int("changeAmount", changeAmount))
 
@@ -1180,7 +1187,7 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, req *request
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateTransactionRow](
 			s.logger,
-			cashier_errors.ErrFailedFindCashierById,
+			cashierError(err, cashier_errors.ErrFailedFindCashierById),
 			method,
 			span,
 			zap.Int("cashierId", req.CashierID),
@@ -1192,14 +1199,14 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, req *request
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateTransactionRow](
 			s.logger,
-			transaction_errors.ErrFailedFindTransactionById,
+			transactionError(err, transaction_errors.ErrFailedFindTransactionById),
 			method,
 			span,
 			zap.Int("transactionID", *req.TransactionID),
 			zap.Error(err))
 	}
 
-	if existingTx.PaymentStatus == "paid" || existingTx.PaymentStatus == "success" || existingTx.PaymentStatus == "refunded" {
+	if !isModifiableStatus(existingTx.PaymentStatus) {
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateTransactionRow](
 			s.logger,
@@ -1215,7 +1222,7 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, req *request
 		status = "error"
 		return errorhandler.HandleError[*db.UpdateTransactionRow](
 			s.logger,
-			merchant_errors.ErrFailedFindMerchantById,
+			merchantError(err, merchant_errors.ErrFailedFindMerchantById),
 			method,
 			span,
 			zap.Int("merchantId", int(cashier.MerchantID)),
@@ -1224,77 +1231,737 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, req *request
 
 	req.MerchantID = int(cashier.MerchantID)
 
-	_, err = s.orderRepository.FindById(ctx, req.OrderID)
+	var (
+		transaction   *db.UpdateTransactionRow
+		changeAmount  int
+		paymentStatus string
+	)
+
+	err = s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		order, err := repos.Order.FindById(ctx, req.OrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderError(err, order_errors.ErrFailedFindOrderById),
+				method,
+				span,
+				zap.Int("orderID", req.OrderID),
+				zap.Error(err))
+		}
+
+		if !isOrderPayable(order.Status) {
+			return errorhandler.HandleTxError(
+				s.logger,
+				order_errors.ErrFailedOrderNotPayable,
+				method,
+				span,
+				zap.Int("orderID", req.OrderID),
+				zap.String("orderStatus", order.Status))
+		}
+
+		orderItems, err := repos.OrderItem.FindOrderItemByOrder(ctx, req.OrderID)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				orderitem_errors.ErrFailedFindOrderItemByOrder,
+				method,
+				span,
+				zap.Int("orderID", req.OrderID),
+				zap.Error(err))
+		}
+
+		totals, err := s.applyOrderTax(ctx, repos, method, span, req.MerchantID, orderItems)
+		if err != nil {
+			return err
+		}
+
+		settlement, err := settleTenders(tenders(req.Payments, req.PaymentMethod, req.Amount), totals.Gross)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				err,
+				method,
+				span,
+				zap.Int("transactionID", *req.TransactionID),
+				zap.Int("required", totals.Gross))
+		}
+
+		paymentStatus = settlement.paymentStatus
+		changeAmount = settlement.changeAmount
+		req.PaymentMethod = settlement.paymentMethod
+		req.Amount = totals.Gross
+		req.PaymentStatus = &paymentStatus
+		req.ChangeAmount = &changeAmount
+		req.SubtotalAmount = &totals.Net
+		req.TaxAmount = &totals.Tax
+
+		transaction, err = repos.Transaction.UpdateTransaction(ctx, req)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transactionError(err, transaction_errors.ErrFailedUpdateTransaction),
+				method,
+				span,
+				zap.Error(err))
+		}
+
+		if err := repos.Transaction.DeletePayments(ctx, *req.TransactionID); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transaction_errors.ErrFailedRecordTransactionPayments,
+				method,
+				span,
+				zap.Int("transactionID", *req.TransactionID),
+				zap.Error(err))
+		}
+
+		if err := s.recordTenders(ctx, repos, method, span, *req.TransactionID, settlement); err != nil {
+			return err
+		}
+
+		return s.settleOrder(ctx, repos, method, span, req.OrderID, settlement.paymentStatus)
+	})
 	if err != nil {
 		status = "error"
-		return errorhandler.HandleError[*db.UpdateTransactionRow](
+		return nil, err
+	}
+
+	s.cache.DeleteTransactionCache(ctx, int(transaction.TransactionID))
+
+	logSuccess("Successfully updated transaction",
+		zap.Int("transactionID", int(transaction.TransactionID)),
+		zap.String("paymentStatus", paymentStatus),
+		zap.Int("amount", req.Amount),
+		zap.Int("changeAmount", changeAmount))
+
+	return transaction, nil
+}
+
+// settleOrder moves the order of a transaction to paid once its tenders cover
+// the amount due, or to pending_payment while they do not.
+func (s *transactionService) settleOrder(
+	ctx context.Context,
+	repos *repository.Repositories,
+	method string,
+	span trace.Span,
+	orderID int,
+	paymentStatus string,
+) error {
+	to := orderStatusPendingPayment
+	if paymentStatus == "success" {
+		to = orderStatusPaid
+	}
+
+	_, err := transitionOrder(ctx, repos, s.logger, method, span, orderID, to, order_errors.ErrFailedOrderNotPayable)
+	return err
+}
+
+// recordTenders stores the tender lines of a settled transaction.
+func (s *transactionService) recordTenders(
+	ctx context.Context,
+	repos *repository.Repositories,
+	method string,
+	span trace.Span,
+	transactionID int,
+	settlement *tenderSettlement,
+) error {
+	for _, line := range settlement.lines {
+		line.TransactionID = transactionID
+
+		if _, err := repos.Transaction.CreatePayment(ctx, &line); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transaction_errors.ErrFailedRecordTransactionPayments,
+				method,
+				span,
+				zap.Int("transactionID", transactionID),
+				zap.String("paymentMethod", line.PaymentMethod),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// RefundTransaction returns some or all of the units of a completed sale.
+// Each line is refunded pro rata to what the customer paid for it, tax
+// included, and the transaction becomes partially_refunded or refunded.
+func (s *transactionService) RefundTransaction(ctx context.Context, req *requests.RefundTransactionRequest) (*db.TransactionRefund, error) {
+	const method = "RefundTransaction"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("transactionID", req.TransactionID))
+
+	defer func() {
+		end(status)
+	}()
+
+	var (
+		refund        *db.TransactionRefund
+		paymentStatus string
+	)
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		transaction, err := s.findReversible(ctx, repos, method, span, req.TransactionID, req.ApprovedBy)
+		if err != nil {
+			return err
+		}
+
+		if transaction.PaymentStatus != "success" && transaction.PaymentStatus != "partially_refunded" {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transaction_errors.ErrFailedTransactionNotRefundable,
+				method,
+				span,
+				zap.Int("transactionID", req.TransactionID),
+				zap.String("paymentStatus", transaction.PaymentStatus))
+		}
+
+		lines, refunded, err := s.findRefundableLines(ctx, repos, method, span, transaction)
+		if err != nil {
+			return err
+		}
+
+		plan, err := planRefund(lines, refunded, req.Items)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				err,
+				method,
+				span,
+				zap.Int("transactionID", req.TransactionID))
+		}
+
+		refund, err = s.recordReversal(ctx, repos, method, span, &requests.CreateTransactionRefundRecordRequest{
+			TransactionID: req.TransactionID,
+			Kind:          "refund",
+			ReasonCode:    req.ReasonCode,
+			Note:          req.Note,
+			ApprovedBy:    req.ApprovedBy,
+			Restock:       req.Restock,
+		}, plan.lines, transaction_errors.ErrFailedRefundTransaction)
+		if err != nil {
+			return err
+		}
+
+		paymentStatus = "partially_refunded"
+		if plan.complete {
+			paymentStatus = "refunded"
+		}
+
+		if _, err := repos.Transaction.UpdateTransactionStatus(ctx, req.TransactionID, paymentStatus); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transaction_errors.ErrFailedRefundTransaction,
+				method,
+				span,
+				zap.Int("transactionID", req.TransactionID),
+				zap.Error(err))
+		}
+
+		if !plan.complete {
+			return nil
+		}
+
+		_, err = transitionOrder(ctx, repos, s.logger, method, span, int(transaction.OrderID), orderStatusRefunded, order_errors.ErrFailedInvalidOrderTransition)
+		return err
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteTransactionCache(ctx, req.TransactionID)
+
+	logSuccess("Successfully refunded transaction",
+		zap.Int("transactionID", req.TransactionID),
+		zap.Int("refundID", int(refund.TransactionRefundID)),
+		zap.Int("amount", int(refund.Amount)),
+		zap.String("paymentStatus", paymentStatus))
+
+	return refund, nil
+}
+
+// VoidTransaction reverses a completed sale in full. A sale that has already
+// been partly refunded has to be refunded instead.
+func (s *transactionService) VoidTransaction(ctx context.Context, req *requests.VoidTransactionRequest) (*db.TransactionRefund, error) {
+	const method = "VoidTransaction"
+
+	ctx, span, end, status, logSuccess := s.observability.StartTracingAndLogging(ctx, method,
+		attribute.Int("transactionID", req.TransactionID))
+
+	defer func() {
+		end(status)
+	}()
+
+	var void *db.TransactionRefund
+
+	err := s.unitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		transaction, err := s.findReversible(ctx, repos, method, span, req.TransactionID, req.ApprovedBy)
+		if err != nil {
+			return err
+		}
+
+		lines, refunded, err := s.findRefundableLines(ctx, repos, method, span, transaction)
+		if err != nil {
+			return err
+		}
+
+		if transaction.PaymentStatus != "success" || len(refunded) > 0 {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transaction_errors.ErrFailedTransactionNotVoidable,
+				method,
+				span,
+				zap.Int("transactionID", req.TransactionID),
+				zap.String("paymentStatus", transaction.PaymentStatus))
+		}
+
+		plan, err := planRefund(lines, refunded, nil)
+		if err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				err,
+				method,
+				span,
+				zap.Int("transactionID", req.TransactionID))
+		}
+
+		void, err = s.recordReversal(ctx, repos, method, span, &requests.CreateTransactionRefundRecordRequest{
+			TransactionID: req.TransactionID,
+			Kind:          "void",
+			ReasonCode:    req.ReasonCode,
+			Note:          req.Note,
+			ApprovedBy:    req.ApprovedBy,
+			Restock:       req.Restock,
+		}, plan.lines, transaction_errors.ErrFailedVoidTransaction)
+		if err != nil {
+			return err
+		}
+
+		if _, err := repos.Transaction.UpdateTransactionStatus(ctx, req.TransactionID, "voided"); err != nil {
+			return errorhandler.HandleTxError(
+				s.logger,
+				transaction_errors.ErrFailedVoidTransaction,
+				method,
+				span,
+				zap.Int("transactionID", req.TransactionID),
+				zap.Error(err))
+		}
+
+		_, err = transitionOrder(ctx, repos, s.logger, method, span, int(transaction.OrderID), orderStatusCancelled, order_errors.ErrFailedInvalidOrderTransition)
+		return err
+	})
+	if err != nil {
+		status = "error"
+		return nil, err
+	}
+
+	s.cache.DeleteTransactionCache(ctx, req.TransactionID)
+
+	logSuccess("Successfully voided transaction",
+		zap.Int("transactionID", req.TransactionID),
+		zap.Int("refundID", int(void.TransactionRefundID)),
+		zap.Int("amount", int(void.Amount)))
+
+	return void, nil
+}
+
+// findReversible locks the transaction to reverse and checks that the
+// approving user exists. The lock is held until the unit of work ends, so
+// concurrent refunds and voids of one sale plan against each other's lines
+// one at a time.
+func (s *transactionService) findReversible(
+	ctx context.Context,
+	repos *repository.Repositories,
+	method string,
+	span trace.Span,
+	transactionID int,
+	approvedBy int,
+) (*db.GetTransactionForUpdateRow, error) {
+	transaction, err := repos.Transaction.FindByIdForUpdate(ctx, transactionID)
+	if err != nil {
+		return nil, errorhandler.HandleTxError(
 			s.logger,
-			order_errors.ErrFailedFindOrderById,
+			transactionError(err, transaction_errors.ErrFailedFindTransactionById),
 			method,
 			span,
-			zap.Int("orderID", req.OrderID),
+			zap.Int("transactionID", transactionID),
 			zap.Error(err))
 	}
 
-	orderItems, err := s.orderItemRepository.FindOrderItemByOrder(ctx, req.OrderID)
-	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.UpdateTransactionRow](
+	if _, err := repos.User.FindById(ctx, approvedBy); err != nil {
+		return nil, errorhandler.HandleTxError(
 			s.logger,
-			orderitem_errors.ErrFailedFindOrderItemByOrder,
+			user_errors.ErrUserNotFoundRes,
 			method,
 			span,
-			zap.Int("orderID", req.OrderID),
+			zap.Int("approvedBy", approvedBy),
 			zap.Error(err))
 	}
 
-	var totalAmount int32
-	for _, item := range orderItems {
-		totalAmount += item.Price * item.Quantity
-	}
+	return transaction, nil
+}
 
-	ppn := totalAmount * 11 / 100
-	totalAmountWithTax := totalAmount + ppn
+// findRefundableLines returns the priced lines of the transaction's order
+// together with the units of each line that were already reversed.
+func (s *transactionService) findRefundableLines(
+	ctx context.Context,
+	repos *repository.Repositories,
+	method string,
+	span trace.Span,
+	transaction *db.GetTransactionForUpdateRow,
+) ([]*db.GetOrderItemTaxLinesRow, map[int]int, error) {
+	lines, err := repos.OrderItem.FindOrderItemTaxLines(ctx, int(transaction.OrderID))
+	if err != nil {
+		return nil, nil, errorhandler.HandleTxError(
+			s.logger,
+			orderitem_errors.ErrFailedFindOrderItemByOrder,
+			method,
+			span,
+			zap.Int("orderID", int(transaction.OrderID)),
+			zap.Error(err))
+	}
 
-	var paymentStatus string
-	if req.Amount >= int(totalAmountWithTax) {
-		paymentStatus = "success"
-	} else {
-		status = "error"
-		return errorhandler.HandleError[*db.UpdateTransactionRow](
+	refunded, err := repos.TransactionRefund.FindRefundedQuantities(ctx, int(transaction.TransactionID))
+	if err != nil {
+		return nil, nil, errorhandler.HandleTxError(
 			s.logger,
-			transaction_errors.ErrFailedPaymentInsufficientBalance,
+			transaction_errors.ErrFailedFindTransactionRefunds,
 			method,
 			span,
-			zap.Int("paid", req.Amount),
-			zap.Int("required", int(totalAmountWithTax)))
+			zap.Int("transactionID", int(transaction.TransactionID)),
+			zap.Error(err))
 	}
 
-	changeAmount := req.Amount - int(totalAmountWithTax)
-	req.Amount = int(totalAmountWithTax)
-	req.PaymentStatus = &paymentStatus
-	req.ChangeAmount = &changeAmount
+	return lines, refunded, nil
+}
 
-	transaction, err := s.transactionRepository.UpdateTransaction(ctx, req)
+// recordReversal stores a refund or void with its lines and, when asked to,
+// puts the returned units back in stock.
+func (s *transactionService) recordReversal(
+	ctx context.Context,
+	repos *repository.Repositories,
+	method string,
+	span trace.Span,
+	record *requests.CreateTransactionRefundRecordRequest,
+	lines []refundLine,
+	failure error,
+) (*db.TransactionRefund, error) {
+	for _, line := range lines {
+		record.Amount += line.amount
+		record.TaxAmount += line.taxAmount
+	}
+
+	refund, err := repos.TransactionRefund.CreateRefund(ctx, record)
 	if err != nil {
-		status = "error"
-		return errorhandler.HandleError[*db.UpdateTransactionRow](
+		return nil, errorhandler.HandleTxError(
 			s.logger,
-			transaction_errors.ErrFailedUpdateTransaction,
+			failure,
 			method,
 			span,
+			zap.Int("transactionID", record.TransactionID),
 			zap.Error(err))
 	}
 
-	s.cache.DeleteTransactionCache(ctx, int(transaction.TransactionID))
+	reference := stockReferenceTransactionRefund
+	refundID := int(refund.TransactionRefundID)
 
-	logSuccess("Successfully updated transaction",
-		zap.Int("transactionID", int(transaction.TransactionID)),
-		zap.String("paymentStatus", paymentStatus),
-		zap.Int("amount", req.Amount),
-		zap.Int("changeAmount", changeAmount))
+	for _, line := range lines {
+		_, err := repos.TransactionRefund.CreateRefundItem(ctx, &requests.CreateTransactionRefundItemRecordRequest{
+			TransactionRefundID: int(refund.TransactionRefundID),
+			OrderItemID:         line.orderItemID,
+			Quantity:            line.quantity,
+			Amount:              line.amount,
+			TaxAmount:           line.taxAmount,
+		})
+		if err != nil {
+			return nil, errorhandler.HandleTxError(
+				s.logger,
+				failure,
+				method,
+				span,
+				zap.Int("orderItemID", line.orderItemID),
+				zap.Error(err))
+		}
 
-	return transaction, nil
+		if !record.Restock {
+			continue
+		}
+
+		_, err = recordStockMovement(ctx, repos, s.logger, method, span, transaction_errors.ErrFailedRestockRefundItems, &requests.CreateStockMovementRecordRequest{
+			ProductID:     line.productID,
+			VariantID:     line.variantID,
+			Reason:        stockReasonRefund,
+			QuantityDelta: line.quantity,
+			ReferenceType: &reference,
+			ReferenceID:   &refundID,
+			UserID:        &record.ApprovedBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return refund, nil
+}
+
+// applyOrderTax prices every line of an order with the tax rate that applies
+// to its product, snapshots the rate on the order item and returns the order
+// totals. Lines without an applicable rate are recorded as untaxed.
+func (s *transactionService) applyOrderTax(
+	ctx context.Context,
+	repos *repository.Repositories,
+	method string,
+	span trace.Span,
+	merchantID int,
+	orderItems []*db.GetOrderItemsByOrderRow,
+) (tax.Breakdown, error) {
+	var totals tax.Breakdown
+
+	for _, item := range orderItems {
+		product, err := repos.Product.FindById(ctx, int(item.ProductID))
+		if err != nil {
+			return totals, errorhandler.HandleTxError(
+				s.logger,
+				productError(err, product_errors.ErrFailedFindProductById),
+				method,
+				span,
+				zap.Int("productID", int(item.ProductID)),
+				zap.Error(err))
+		}
+
+		rate, err := repos.TaxRate.FindApplicable(ctx, merchantID, int(product.CategoryID))
+		if err != nil && !errors.Is(err, tax_errors.ErrTaxRateNotFound) {
+			return totals, errorhandler.HandleTxError(
+				s.logger,
+				tax_errors.ErrFailedResolveTaxRate,
+				method,
+				span,
+				zap.Int("merchantID", merchantID),
+				zap.Int("categoryID", int(product.CategoryID)),
+				zap.Error(err))
+		}
+
+		line := &requests.UpdateOrderItemTaxRequest{
+			OrderItemID: int(item.OrderItemID),
+			TaxRounding: string(tax.RoundHalfUp),
+		}
+
+		if rate != nil {
+			taxRateID := int(rate.TaxRateID)
+			line.TaxRateID = &taxRateID
+			line.TaxRateBps = int(rate.RateBps)
+			line.TaxInclusive = rate.IsInclusive
+			line.TaxRounding = rate.Rounding
+		}
+
+		breakdown := tax.Calculate(int(item.Price*item.Quantity), tax.Rate{
+			Bps:       line.TaxRateBps,
+			Inclusive: line.TaxInclusive,
+			Rounding:  tax.Rounding(line.TaxRounding),
+		})
+		line.TaxAmount = breakdown.Tax
+
+		if err := repos.OrderItem.UpdateOrderItemTax(ctx, line); err != nil {
+			return totals, errorhandler.HandleTxError(
+				s.logger,
+				tax_errors.ErrFailedRecordLineTax,
+				method,
+				span,
+				zap.Int("orderItemID", int(item.OrderItemID)),
+				zap.Error(err))
+		}
+
+		totals.Net += breakdown.Net
+		totals.Tax += breakdown.Tax
+		totals.Gross += breakdown.Gross
+	}
+
+	return totals, nil
+}
+
+const (
+	cashPaymentMethod  = "cash"
+	splitPaymentMethod = "split"
+)
+
+// tenderSettlement is the outcome of allocating the tenders of a transaction
+// against the amount due.
+type tenderSettlement struct {
+	lines         []requests.CreateTransactionPaymentRecordRequest
+	paymentMethod string
+	paymentStatus string
+	changeAmount  int
+}
+
+// tenders returns the tender lines of a request. Requests without explicit
+// payments are treated as a single tender of the legacy method and amount.
+func tenders(payments []requests.TransactionPaymentRequest, paymentMethod string, amount int) []requests.TransactionPaymentRequest {
+	if len(payments) > 0 {
+		return payments
+	}
+
+	return []requests.TransactionPaymentRequest{{PaymentMethod: paymentMethod, Amount: amount}}
+}
+
+// settleTenders allocates payments against due. Only cash may be tendered
+// above the amount due; the change is handed back from the cash lines, last
+// line first. The transaction stays pending until the tenders cover due.
+func settleTenders(payments []requests.TransactionPaymentRequest, due int) (*tenderSettlement, error) {
+	settlement := &tenderSettlement{
+		lines:         make([]requests.CreateTransactionPaymentRecordRequest, len(payments)),
+		paymentMethod: splitPaymentMethod,
+		paymentStatus: "pending",
+	}
+
+	var paid, nonCash int
+
+	for i, payment := range payments {
+		if payment.Amount <= 0 {
+			return nil, transaction_errors.ErrFailedInvalidTenderAmount
+		}
+
+		paid += payment.Amount
+		if payment.PaymentMethod != cashPaymentMethod {
+			nonCash += payment.Amount
+		}
+
+		settlement.lines[i] = requests.CreateTransactionPaymentRecordRequest{
+			PaymentMethod: payment.PaymentMethod,
+			Amount:        payment.Amount,
+			Reference:     payment.Reference,
+		}
+	}
+
+	if len(payments) == 1 {
+		settlement.paymentMethod = payments[0].PaymentMethod
+	}
+
+	if nonCash > due {
+		return nil, transaction_errors.ErrFailedNonCashOverpayment
+	}
+
+	if paid < due {
+		return settlement, nil
+	}
+
+	settlement.paymentStatus = "success"
+	settlement.changeAmount = paid - due
+
+	remaining := settlement.changeAmount
+	for i := len(settlement.lines) - 1; i >= 0 && remaining > 0; i-- {
+		line := &settlement.lines[i]
+		if line.PaymentMethod != cashPaymentMethod {
+			continue
+		}
+
+		change := min(line.Amount, remaining)
+		line.ChangeAmount = change
+		remaining -= change
+	}
+
+	return settlement, nil
+}
+
+// isModifiableStatus reports whether a transaction can still be edited.
+// Settled and reversed transactions are changed through refunds instead.
+func isModifiableStatus(status string) bool {
+	switch status {
+	case "paid", "success", "refunded", "partially_refunded", "voided":
+		return false
+	}
+
+	return true
+}
+
+type refundLine struct {
+	orderItemID int
+	productID   int
+	variantID   *int
+	quantity    int
+	amount      int
+	taxAmount   int
+}
+
+type refundPlan struct {
+	lines []refundLine
+
+	// complete is set when the plan returns the last outstanding unit.
+	complete bool
+}
+
+// planRefund works out what each requested line gives back. Without items
+// everything not yet refunded is returned.
+func planRefund(
+	lines []*db.GetOrderItemTaxLinesRow,
+	refunded map[int]int,
+	items []requests.RefundItemRequest,
+) (*refundPlan, error) {
+	requested := make(map[int]int, len(items))
+	for _, item := range items {
+		requested[item.OrderItemID] += item.Quantity
+	}
+
+	known := make(map[int]bool, len(lines))
+	plan := &refundPlan{complete: true}
+
+	for _, line := range lines {
+		id := int(line.OrderItemID)
+		known[id] = true
+
+		done := refunded[id]
+		remaining := int(line.Quantity) - done
+
+		quantity := remaining
+		if len(items) > 0 {
+			quantity = requested[id]
+		}
+
+		if quantity > remaining {
+			return nil, transaction_errors.ErrFailedRefundQuantityExceeded
+		}
+
+		if quantity < remaining {
+			plan.complete = false
+		}
+
+		if quantity == 0 {
+			continue
+		}
+
+		gross := int(line.Price * line.Quantity)
+		if !line.TaxInclusive {
+			gross += int(line.TaxAmount)
+		}
+
+		plan.lines = append(plan.lines, refundLine{
+			orderItemID: id,
+			productID:   int(line.ProductID),
+			variantID:   toIntPtr(line.VariantID),
+			quantity:    quantity,
+			amount:      prorate(gross, int(line.Quantity), done, quantity),
+			taxAmount:   prorate(int(line.TaxAmount), int(line.Quantity), done, quantity),
+		})
+	}
+
+	for id := range requested {
+		if !known[id] {
+			return nil, transaction_errors.ErrFailedRefundItemNotInOrder
+		}
+	}
+
+	if len(plan.lines) == 0 {
+		return nil, transaction_errors.ErrFailedNothingToRefund
+	}
+
+	return plan, nil
+}
+
+// prorate returns the share of total covered by units done+1..done+quantity
+// out of all units. Successive shares always add up to total exactly.
+func prorate(total, units, done, quantity int) int {
+	return total*(done+quantity)/units - total*done/units
 }
 
 func (s *transactionService) TrashedTransaction(ctx context.Context, transaction_id int) (*db.Transaction, error) {
@@ -1312,7 +1979,7 @@ func (s *transactionService) TrashedTransaction(ctx context.Context, transaction
 		status = "error"
 		return errorhandler.HandleError[*db.Transaction](
 			s.logger,
-			transaction_errors.ErrFailedTrashedTransaction,
+			transactionError(err, transaction_errors.ErrFailedTrashedTransaction),
 			method,
 			span,
 			zap.Int("transaction_id", transaction_id),
@@ -1341,7 +2008,7 @@ func (s *transactionService) RestoreTransaction(ctx context.Context, transaction
 		status = "error"
 		return errorhandler.HandleError[*db.Transaction](
 			s.logger,
-			transaction_errors.ErrFailedRestoreTransaction,
+			transactionError(err, transaction_errors.ErrFailedRestoreTransaction),
 			method,
 			span,
 			zap.Int("transaction_id", transaction_id),
@@ -1370,7 +2037,7 @@ func (s *transactionService) DeleteTransactionPermanently(ctx context.Context, t
 		status = "error"
 		return errorhandler.HandleError[bool](
 			s.logger,
-			transaction_errors.ErrFailedDeleteTransactionPermanently,
+			transactionError(err, transaction_errors.ErrFailedDeleteTransactionPermanently),
 			method,
 			span,
 			zap.Int("transaction_id", transactionID),
@@ -1404,6 +2071,8 @@ func (s *transactionService) RestoreAllTransactions(ctx context.Context) (bool,
 			zap.Error(err))
 	}
 
+	s.cache.InvalidateTransactionCache(ctx)
+
 	logSuccess("Successfully restored all trashed transactions")
 
 	return success, nil
@@ -1429,6 +2098,8 @@ func (s *transactionService) DeleteAllTransactionPermanent(ctx context.Context)
 			zap.Error(err))
 	}
 
+	s.cache.InvalidateTransactionCache(ctx)
+
 	logSuccess("Successfully permanently deleted all trashed transactions")
 
 	return success, nil
@@ -325,6 +325,8 @@ func (s *userService) CreateUser(ctx context.Context, request *requests.CreateUs
 		)
 	}
 
+	s.cache.InvalidateUserCache(ctx)
+
 	logSuccess("Successfully created new user", zap.String("email", res.Email), zap.Int("user_id", int(res.UserID)))
 
 	return res, nil
@@ -421,6 +423,8 @@ func (s *userService) UpdateUser(
 		)
 	}
 
+	s.cache.DeleteUserCache(ctx, int(res.UserID))
+
 	logSuccess("Successfully updated user",
 		zap.Int("user_id", int(res.UserID)),
 	)
@@ -453,6 +457,8 @@ func (s *userService) TrashedUser(ctx context.Context, user_id int) (*db.TrashUs
 		)
 	}
 
+	s.cache.DeleteUserCache(ctx, user_id)
+
 	logSuccess("Successfully trashed user", zap.Int("user_id", user_id))
 
 	return res, nil
@@ -483,6 +489,8 @@ func (s *userService) RestoreUser(ctx context.Context, user_id int) (*db.Restore
 		)
 	}
 
+	s.cache.DeleteUserCache(ctx, user_id)
+
 	logSuccess("Successfully restored user", zap.Int("user_id", user_id))
 
 	return res, nil
@@ -513,6 +521,8 @@ func (s *userService) DeleteUserPermanent(ctx context.Context, user_id int) (boo
 		)
 	}
 
+	s.cache.DeleteUserCache(ctx, user_id)
+
 	logSuccess("Successfully deleted user permanently", zap.Int("user_id", user_id))
 
 	return true, nil
@@ -540,6 +550,8 @@ func (s *userService) RestoreAllUser(ctx context.Context) (bool, error) {
 		)
 	}
 
+	s.cache.InvalidateUserCache(ctx)
+
 	logSuccess("Successfully restored all users")
 
 	return true, nil
@@ -567,6 +579,8 @@ func (s *userService) DeleteAllUserPermanent(ctx context.Context) (bool, error)
 		)
 	}
 
+	s.cache.InvalidateUserCache(ctx)
+
 	logSuccess("Successfully deleted all users permanently")
 
 	return true, nil
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+type contextKey string
+
+const (
+	userIDContextKey contextKey = "auth.user_id"
+	rolesContextKey  contextKey = "auth.roles"
+	tokenContextKey  contextKey = "auth.access_token"
+
+	tokenIDContextKey    contextKey = "auth.token_id"
+	clientInfoContextKey contextKey = "auth.client_info"
+
+	merchantScopeContextKey contextKey = "auth.merchant_scope"
+)
+
+func WithUserID(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, userIDContextKey, userID)
+}
+
+func UserIDFromContext(ctx context.Context) (int, bool) {
+	userID, ok := ctx.Value(userIDContextKey).(int)
+	return userID, ok
+}
+
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesContextKey, roles)
+}
+
+func RolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(rolesContextKey).([]string)
+	return roles
+}
+
+// WithMerchantScope restricts the caller to the merchants in merchantIDs.
+// Callers without a scope, such as admins and background jobs, may act for
+// every merchant; an empty scope allows none.
+func WithMerchantScope(ctx context.Context, merchantIDs []int) context.Context {
+	scope := slices.Clone(merchantIDs)
+	if scope == nil {
+		scope = []int{}
+	}
+	slices.Sort(scope)
+
+	return context.WithValue(ctx, merchantScopeContextKey, slices.Compact(scope))
+}
+
+// MerchantScopeFromContext returns the sorted merchants the caller may act
+// for, and false when the caller is not restricted.
+func MerchantScopeFromContext(ctx context.Context) ([]int, bool) {
+	scope, ok := ctx.Value(merchantScopeContextKey).([]int)
+	return scope, ok
+}
+
+// CanActForMerchant reports whether the caller may act for merchantID.
+func CanActForMerchant(ctx context.Context, merchantID int) bool {
+	scope, ok := MerchantScopeFromContext(ctx)
+	if !ok {
+		return true
+	}
+
+	_, found := slices.BinarySearch(scope, merchantID)
+	return found
+}
+
+// WithAccessToken stores the caller's raw bearer token so it can be forwarded
+// on outgoing gRPC calls.
+func WithAccessToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenContextKey, token)
+}
+
+func AccessTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenContextKey).(string)
+	return token, ok && token != ""
+}
+
+// WithTokenID stores the jti of the access token that authenticated the
+// request.
+func WithTokenID(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, tokenIDContextKey, tokenID)
+}
+
+func TokenIDFromContext(ctx context.Context) (string, bool) {
+	tokenID, ok := ctx.Value(tokenIDContextKey).(string)
+	return tokenID, ok && tokenID != ""
+}
+
+// ClientInfo describes the device a session was started from.
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoContextKey, info)
+}
+
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoContextKey).(ClientInfo)
+	return info
+}
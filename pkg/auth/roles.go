@@ -0,0 +1,22 @@
+package auth
+
+const (
+	RoleAdmin    = "ROLE_ADMIN"
+	RoleManager  = "ROLE_MANAGER"
+	RoleCashier  = "ROLE_CASHIER"
+	RoleSupplier = "ROLE_SUPPLIER"
+	RoleUser     = "ROLE_USER"
+)
+
+// HasAnyRole reports whether roles contains at least one of wanted.
+func HasAnyRole(roles []string, wanted ...string) bool {
+	for _, role := range roles {
+		for _, w := range wanted {
+			if role == w {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -7,14 +7,39 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-var ErrTokenExpired = errors.New("token expired")
+const (
+	AudienceAccess  = "access"
+	AudienceRefresh = "refresh"
+
+	// AccessTokenTTL bounds how long a stolen access token stays usable when
+	// its session cannot be revoked through the denylist.
+	AccessTokenTTL = 12 * time.Hour
+
+	// RefreshTokenTTL matches the expiration stored with each refresh token.
+	RefreshTokenTTL = 24 * time.Hour
+)
+
+var (
+	ErrTokenExpired = errors.New("token expired")
+	ErrMissingToken = errors.New("missing access token")
+)
+
+// Claims are the registered claims the manager reads back from a token.
+type Claims struct {
+	Subject   string
+	ID        string
+	Audience  string
+	ExpiresAt time.Time
+}
 
 //go:generate mockgen -source=token.go -destination=mocks/token.go
 type TokenManager interface {
 	GenerateToken(userId int, audience string) (string, error)
 	ValidateToken(tokenString string) (string, error)
+	ParseToken(tokenString string) (*Claims, error)
 }
 
 type Manager struct {
@@ -28,11 +53,18 @@ func NewManager(secretKey string) (*Manager, error) {
 	return &Manager{secretKey: []byte(secretKey)}, nil
 }
 
+// GenerateToken signs a token for the user. Every token carries a random jti
+// so that it can be revoked individually before it expires.
 func (m *Manager) GenerateToken(userId int, audience string) (string, error) {
 	nowTime := time.Now()
-	expireTime := nowTime.Add(12 * time.Hour)
+	expireTime := nowTime.Add(AccessTokenTTL)
+	if audience == AudienceRefresh {
+		expireTime = nowTime.Add(RefreshTokenTTL)
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(nowTime),
 		ExpiresAt: jwt.NewNumericDate(expireTime),
 		Subject:   strconv.Itoa(userId),
 		Audience:  []string{audience},
@@ -42,7 +74,18 @@ func (m *Manager) GenerateToken(userId int, audience string) (string, error) {
 }
 
 func (m *Manager) ValidateToken(accessToken string) (string, error) {
-	token, err := jwt.Parse(accessToken, func(token *jwt.Token) (i interface{}, err error) {
+	claims, err := m.ParseToken(accessToken)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Subject, nil
+}
+
+func (m *Manager) ParseToken(tokenString string) (*Claims, error) {
+	var registered jwt.RegisteredClaims
+
+	_, err := jwt.ParseWithClaims(tokenString, &registered, func(token *jwt.Token) (i interface{}, err error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
@@ -52,16 +95,27 @@ func (m *Manager) ValidateToken(accessToken string) (string, error) {
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return "", ErrTokenExpired
+			return nil, ErrTokenExpired
 		}
-		return "", fmt.Errorf("failed to parse token: %w", err)
+		return nil, fmt.Errorf("failed to parse token: %w", err)
 	}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return "", fmt.Errorf("error get user claims from token")
+	if registered.Subject == "" {
+		return nil, fmt.Errorf("error get user claims from token")
 	}
 
-	return claims["sub"].(string), nil
+	claims := &Claims{
+		Subject: registered.Subject,
+		ID:      registered.ID,
+	}
+
+	if len(registered.Audience) > 0 {
+		claims.Audience = registered.Audience[0]
+	}
+
+	if registered.ExpiresAt != nil {
+		claims.ExpiresAt = registered.ExpiresAt.Time
+	}
 
+	return claims, nil
 }
@@ -63,6 +63,8 @@ func NewClient(logger logger.LoggerInterface) (*pgxpool.Pool, error) {
 	}
 	config.HealthCheckPeriod = healthCheckPeriod
 
+	ScopeConnections(config)
+
 	ctx := context.Background()
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
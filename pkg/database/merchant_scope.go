@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"pointofsale/pkg/auth"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// merchantScopeSetting is read by the in_merchant_scope SQL function, which
+// every query on merchant data filters with.
+const merchantScopeSetting = "pointofsale.merchant_scope"
+
+// ScopeConnections makes each connection of the pool apply the merchant
+// scope of the context it is acquired with (auth.WithMerchantScope), so
+// queries and transactions only see the caller's merchants. It must be
+// called before the pool is created.
+func ScopeConnections(config *pgxpool.Config) {
+	var scopes sync.Map
+
+	// The setting lives on the connection, so it is written whenever the
+	// next caller's scope differs from the last one's.
+	config.BeforeAcquire = func(ctx context.Context, conn *pgx.Conn) bool {
+		scope := merchantScopeValue(ctx)
+
+		if current, ok := scopes.Load(conn); ok && current.(string) == scope {
+			return true
+		}
+
+		if _, err := conn.Exec(ctx, "SELECT set_config($1, $2, false)", merchantScopeSetting, scope); err != nil {
+			scopes.Delete(conn)
+			return false
+		}
+
+		scopes.Store(conn, scope)
+		return true
+	}
+
+	config.BeforeClose = func(conn *pgx.Conn) {
+		scopes.Delete(conn)
+	}
+}
+
+// merchantScopeValue formats the scope of ctx as an INT[] literal, or as an
+// empty string when the caller is not restricted.
+func merchantScopeValue(ctx context.Context) string {
+	scope, ok := auth.MerchantScopeFromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	ids := make([]string, len(scope))
+	for i, id := range scope {
+		ids[i] = strconv.Itoa(id)
+	}
+
+	return "{" + strings.Join(ids, ",") + "}"
+}
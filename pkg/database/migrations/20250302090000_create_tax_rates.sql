@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "tax_rates" (
+    "tax_rate_id" SERIAL PRIMARY KEY,
+    "name" VARCHAR(100) NOT NULL,
+    "merchant_id" INT REFERENCES "merchants" ("merchant_id"),
+    "category_id" INT REFERENCES "categories" ("category_id"),
+    "rate_bps" INT NOT NULL,
+    "is_inclusive" BOOLEAN NOT NULL DEFAULT FALSE,
+    "rounding" VARCHAR(16) NOT NULL DEFAULT 'half_up',
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP DEFAULT NULL,
+    CONSTRAINT chk_tax_rates_rate_bps CHECK (rate_bps BETWEEN 0 AND 10000),
+    CONSTRAINT chk_tax_rates_rounding CHECK (rounding IN ('half_up', 'half_even', 'up', 'down'))
+);
+
+CREATE UNIQUE INDEX idx_tax_rates_scope ON tax_rates (
+    COALESCE(merchant_id, 0),
+    COALESCE(category_id, 0)
+)
+WHERE
+    deleted_at IS NULL;
+
+INSERT INTO
+    tax_rates (
+        name,
+        rate_bps,
+        is_inclusive,
+        rounding
+    )
+VALUES ('PPN', 1100, FALSE, 'down');
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tax_rates_scope;
+
+DROP TABLE IF EXISTS "tax_rates";
+
+-- +goose StatementEnd
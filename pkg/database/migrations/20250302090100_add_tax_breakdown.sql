@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "order_items"
+ADD COLUMN "tax_rate_id" INT REFERENCES "tax_rates" ("tax_rate_id"),
+ADD COLUMN "tax_rate_bps" INT NOT NULL DEFAULT 0,
+ADD COLUMN "tax_inclusive" BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN "tax_rounding" VARCHAR(16) NOT NULL DEFAULT 'half_up',
+ADD COLUMN "tax_amount" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE "transactions"
+ADD COLUMN "subtotal_amount" INT NOT NULL DEFAULT 0,
+ADD COLUMN "tax_amount" INT NOT NULL DEFAULT 0;
+
+-- Transactions recorded before the tax engine charged a flat 11% on top of
+-- the order subtotal, so the tax is whatever was paid above it.
+UPDATE transactions t
+SET
+    subtotal_amount = s.subtotal,
+    tax_amount = t.amount - s.subtotal
+FROM (
+        SELECT order_id, SUM(price * quantity)::integer AS subtotal
+        FROM order_items
+        WHERE
+            deleted_at IS NULL
+        GROUP BY
+            order_id
+    ) s
+WHERE
+    s.order_id = t.order_id
+    AND t.amount >= s.subtotal;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "transactions"
+DROP COLUMN IF EXISTS "tax_amount",
+DROP COLUMN IF EXISTS "subtotal_amount";
+
+ALTER TABLE "order_items"
+DROP COLUMN IF EXISTS "tax_amount",
+DROP COLUMN IF EXISTS "tax_rounding",
+DROP COLUMN IF EXISTS "tax_inclusive",
+DROP COLUMN IF EXISTS "tax_rate_bps",
+DROP COLUMN IF EXISTS "tax_rate_id";
+
+-- +goose StatementEnd
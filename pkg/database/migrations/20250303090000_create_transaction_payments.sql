@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "transaction_payments" (
+    "transaction_payment_id" SERIAL PRIMARY KEY,
+    "transaction_id" INT NOT NULL REFERENCES "transactions" ("transaction_id") ON DELETE CASCADE,
+    "payment_method" VARCHAR(50) NOT NULL,
+    "amount" INT NOT NULL,
+    "change_amount" INT NOT NULL DEFAULT 0,
+    "reference" VARCHAR(100),
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_transaction_payments_amount CHECK (amount > 0),
+    CONSTRAINT chk_transaction_payments_change CHECK (change_amount BETWEEN 0 AND amount)
+);
+
+CREATE INDEX idx_transaction_payments_transaction_id ON transaction_payments (transaction_id);
+
+CREATE INDEX idx_transaction_payments_payment_method ON transaction_payments (payment_method);
+
+-- Every transaction recorded so far was paid with a single tender.
+INSERT INTO
+    transaction_payments (
+        transaction_id,
+        payment_method,
+        amount,
+        change_amount,
+        created_at,
+        updated_at
+    )
+SELECT
+    transaction_id,
+    payment_method,
+    amount + COALESCE(change_amount, 0),
+    COALESCE(change_amount, 0),
+    created_at,
+    updated_at
+FROM transactions
+WHERE
+    amount + COALESCE(change_amount, 0) > 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transaction_payments_payment_method;
+
+DROP INDEX IF EXISTS idx_transaction_payments_transaction_id;
+
+DROP TABLE IF EXISTS "transaction_payments";
+
+-- +goose StatementEnd
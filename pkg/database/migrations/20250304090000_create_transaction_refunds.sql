@@ -0,0 +1,86 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "transaction_refunds" (
+    "transaction_refund_id" SERIAL PRIMARY KEY,
+    "transaction_id" INT NOT NULL REFERENCES "transactions" ("transaction_id") ON DELETE CASCADE,
+    "kind" VARCHAR(10) NOT NULL,
+    "amount" INT NOT NULL,
+    "tax_amount" INT NOT NULL DEFAULT 0,
+    "reason_code" VARCHAR(50) NOT NULL,
+    "note" TEXT,
+    "approved_by" INT NOT NULL REFERENCES "users" ("user_id"),
+    "restock" BOOLEAN NOT NULL DEFAULT FALSE,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_transaction_refunds_kind CHECK (kind IN ('refund', 'void')),
+    CONSTRAINT chk_transaction_refunds_amount CHECK (amount > 0)
+);
+
+CREATE INDEX idx_transaction_refunds_transaction_id ON transaction_refunds (transaction_id);
+
+CREATE INDEX idx_transaction_refunds_created_at ON transaction_refunds (created_at);
+
+CREATE TABLE "transaction_refund_items" (
+    "transaction_refund_item_id" SERIAL PRIMARY KEY,
+    "transaction_refund_id" INT NOT NULL REFERENCES "transaction_refunds" ("transaction_refund_id") ON DELETE CASCADE,
+    "order_item_id" INT NOT NULL REFERENCES "order_items" ("order_item_id") ON DELETE CASCADE,
+    "quantity" INT NOT NULL,
+    "amount" INT NOT NULL,
+    "tax_amount" INT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_transaction_refund_items_quantity CHECK (quantity > 0)
+);
+
+CREATE INDEX idx_transaction_refund_items_refund_id ON transaction_refund_items (transaction_refund_id);
+
+CREATE INDEX idx_transaction_refund_items_order_item_id ON transaction_refund_items (order_item_id);
+
+-- Revenue entries: completed sales on the day they were paid and their
+-- refunds and voids as negative amounts on the day they were recorded.
+CREATE VIEW "transaction_revenue" AS
+SELECT
+    t.transaction_id,
+    t.merchant_id,
+    t.amount,
+    t.tax_amount,
+    t.created_at
+FROM transactions t
+WHERE
+    t.deleted_at IS NULL
+    AND t.payment_status IN (
+        'success',
+        'partially_refunded',
+        'refunded',
+        'voided'
+    )
+UNION ALL
+SELECT
+    NULL::INT AS transaction_id,
+    t.merchant_id,
+    - r.amount AS amount,
+    - r.tax_amount AS tax_amount,
+    r.created_at
+FROM
+    transaction_refunds r
+    JOIN transactions t ON t.transaction_id = r.transaction_id
+WHERE
+    t.deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW IF EXISTS "transaction_revenue";
+
+DROP INDEX IF EXISTS idx_transaction_refund_items_order_item_id;
+
+DROP INDEX IF EXISTS idx_transaction_refund_items_refund_id;
+
+DROP TABLE IF EXISTS "transaction_refund_items";
+
+DROP INDEX IF EXISTS idx_transaction_refunds_created_at;
+
+DROP INDEX IF EXISTS idx_transaction_refunds_transaction_id;
+
+DROP TABLE IF EXISTS "transaction_refunds";
+
+-- +goose StatementEnd
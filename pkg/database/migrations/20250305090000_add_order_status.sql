@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "orders"
+ADD COLUMN "status" VARCHAR(20) NOT NULL DEFAULT 'draft',
+ADD CONSTRAINT chk_orders_status CHECK (
+    status IN (
+        'draft',
+        'pending_payment',
+        'paid',
+        'cancelled',
+        'refunded'
+    )
+);
+
+CREATE INDEX idx_orders_status ON orders (status);
+
+-- Derive the status of existing orders from their latest transaction.
+UPDATE orders o
+SET
+    status = CASE t.payment_status
+        WHEN 'success' THEN 'paid'
+        WHEN 'partially_refunded' THEN 'paid'
+        WHEN 'refunded' THEN 'refunded'
+        WHEN 'voided' THEN 'cancelled'
+        WHEN 'pending' THEN 'pending_payment'
+        ELSE 'draft'
+    END
+FROM (
+        SELECT DISTINCT
+            ON (order_id) order_id, payment_status
+        FROM transactions
+        WHERE
+            deleted_at IS NULL
+        ORDER BY order_id, created_at DESC
+    ) t
+WHERE
+    t.order_id = o.order_id;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_status;
+
+ALTER TABLE "orders"
+DROP CONSTRAINT IF EXISTS chk_orders_status,
+DROP COLUMN IF EXISTS "status";
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "refresh_tokens"
+    ADD COLUMN "session_id" VARCHAR(36) NOT NULL DEFAULT gen_random_uuid()::text,
+    ADD COLUMN "access_token_id" VARCHAR(36) NOT NULL DEFAULT '',
+    ADD COLUMN "user_agent" VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN "ip_address" VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN "session_started_at" TIMESTAMP NOT NULL DEFAULT current_timestamp,
+    ADD COLUMN "revoked_at" TIMESTAMP DEFAULT NULL;
+
+-- Existing rows each become their own session; new rows always carry one.
+ALTER TABLE "refresh_tokens" ALTER COLUMN "session_id" DROP DEFAULT;
+
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens (session_id);
+
+CREATE INDEX idx_refresh_tokens_access_token_id ON refresh_tokens (access_token_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_refresh_tokens_access_token_id;
+
+DROP INDEX IF EXISTS idx_refresh_tokens_session_id;
+
+ALTER TABLE "refresh_tokens"
+    DROP COLUMN IF EXISTS "revoked_at",
+    DROP COLUMN IF EXISTS "session_started_at",
+    DROP COLUMN IF EXISTS "ip_address",
+    DROP COLUMN IF EXISTS "user_agent",
+    DROP COLUMN IF EXISTS "access_token_id",
+    DROP COLUMN IF EXISTS "session_id";
+-- +goose StatementEnd
@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "stock_movements" (
+    "stock_movement_id" SERIAL PRIMARY KEY,
+    "product_id" INT NOT NULL REFERENCES "products" ("product_id") ON DELETE CASCADE,
+    "reason" VARCHAR(20) NOT NULL,
+    "quantity_delta" INT NOT NULL,
+    "balance_after" INT NOT NULL,
+    "reference_type" VARCHAR(30),
+    "reference_id" INT,
+    "user_id" INT REFERENCES "users" ("user_id") ON DELETE SET NULL,
+    "note" TEXT,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_stock_movements_reason CHECK (
+        reason IN (
+            'initial',
+            'sale',
+            'order_edit',
+            'order_cancel',
+            'refund',
+            'adjustment',
+            'goods_received',
+            'stocktake'
+        )
+    ),
+    CONSTRAINT chk_stock_movements_balance_after CHECK (balance_after >= 0)
+);
+
+CREATE INDEX idx_stock_movements_product_id ON stock_movements (product_id, stock_movement_id DESC);
+
+CREATE INDEX idx_stock_movements_reference ON stock_movements (reference_type, reference_id);
+
+-- Existing stock has no history yet; open the ledger of every product with
+-- its current count so that the latest balance always matches count_in_stock.
+INSERT INTO
+    stock_movements (
+        product_id,
+        reason,
+        quantity_delta,
+        balance_after,
+        reference_type,
+        reference_id
+    )
+SELECT
+    product_id,
+    'initial',
+    count_in_stock,
+    count_in_stock,
+    'product',
+    product_id
+FROM products;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_stock_movements_reference;
+
+DROP INDEX IF EXISTS idx_stock_movements_product_id;
+
+DROP TABLE IF EXISTS "stock_movements";
+-- +goose StatementEnd
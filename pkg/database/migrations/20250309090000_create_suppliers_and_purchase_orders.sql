@@ -0,0 +1,94 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "suppliers" (
+    "supplier_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "name" VARCHAR(255) NOT NULL,
+    "contact_name" VARCHAR(255),
+    "email" VARCHAR(255),
+    "phone" VARCHAR(50),
+    "address" TEXT,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX idx_suppliers_merchant_id ON suppliers (merchant_id);
+
+CREATE TABLE "purchase_orders" (
+    "purchase_order_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "supplier_id" INT NOT NULL REFERENCES "suppliers" ("supplier_id"),
+    "status" VARCHAR(20) NOT NULL DEFAULT 'draft',
+    "note" TEXT,
+    "created_by" INT REFERENCES "users" ("user_id") ON DELETE SET NULL,
+    "ordered_at" TIMESTAMP,
+    "received_at" TIMESTAMP,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_purchase_orders_status CHECK (
+        status IN (
+            'draft',
+            'ordered',
+            'partially_received',
+            'received',
+            'cancelled'
+        )
+    )
+);
+
+CREATE INDEX idx_purchase_orders_merchant_status ON purchase_orders (merchant_id, status);
+
+CREATE INDEX idx_purchase_orders_supplier_id ON purchase_orders (supplier_id);
+
+CREATE TABLE "purchase_order_items" (
+    "purchase_order_item_id" SERIAL PRIMARY KEY,
+    "purchase_order_id" INT NOT NULL REFERENCES "purchase_orders" ("purchase_order_id") ON DELETE CASCADE,
+    "product_id" INT NOT NULL REFERENCES "products" ("product_id"),
+    "quantity_ordered" INT NOT NULL,
+    "quantity_received" INT NOT NULL DEFAULT 0,
+    "unit_cost" INT NOT NULL,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_purchase_order_items_product UNIQUE (purchase_order_id, product_id),
+    CONSTRAINT chk_purchase_order_items_quantity_ordered CHECK (quantity_ordered > 0),
+    CONSTRAINT chk_purchase_order_items_quantity_received CHECK (
+        quantity_received BETWEEN 0 AND quantity_ordered
+    ),
+    CONSTRAINT chk_purchase_order_items_unit_cost CHECK (unit_cost >= 0)
+);
+
+CREATE TABLE "purchase_order_receipts" (
+    "purchase_order_receipt_id" SERIAL PRIMARY KEY,
+    "purchase_order_item_id" INT NOT NULL REFERENCES "purchase_order_items" ("purchase_order_item_id") ON DELETE CASCADE,
+    "quantity" INT NOT NULL,
+    "unit_cost" INT NOT NULL,
+    "received_by" INT REFERENCES "users" ("user_id") ON DELETE SET NULL,
+    "note" TEXT,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_purchase_order_receipts_quantity CHECK (quantity > 0),
+    CONSTRAINT chk_purchase_order_receipts_unit_cost CHECK (unit_cost >= 0)
+);
+
+CREATE INDEX idx_purchase_order_receipts_item_id ON purchase_order_receipts (purchase_order_item_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_purchase_order_receipts_item_id;
+
+DROP TABLE IF EXISTS "purchase_order_receipts";
+
+DROP TABLE IF EXISTS "purchase_order_items";
+
+DROP INDEX IF EXISTS idx_purchase_orders_supplier_id;
+
+DROP INDEX IF EXISTS idx_purchase_orders_merchant_status;
+
+DROP TABLE IF EXISTS "purchase_orders";
+
+DROP INDEX IF EXISTS idx_suppliers_merchant_id;
+
+DROP TABLE IF EXISTS "suppliers";
+
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "products"
+ADD COLUMN "reorder_point" INT NOT NULL DEFAULT 0,
+ADD COLUMN "reorder_quantity" INT NOT NULL DEFAULT 0,
+ADD COLUMN "low_stock_alerted_at" TIMESTAMP DEFAULT NULL,
+ADD CONSTRAINT chk_products_reorder_point_non_negative CHECK (reorder_point >= 0),
+ADD CONSTRAINT chk_products_reorder_quantity_non_negative CHECK (reorder_quantity >= 0);
+
+CREATE INDEX idx_products_low_stock ON products (merchant_id)
+WHERE
+    reorder_point > 0
+    AND count_in_stock <= reorder_point
+    AND deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_products_low_stock;
+
+ALTER TABLE "products"
+DROP CONSTRAINT IF EXISTS chk_products_reorder_quantity_non_negative,
+DROP CONSTRAINT IF EXISTS chk_products_reorder_point_non_negative,
+DROP COLUMN IF EXISTS "low_stock_alerted_at",
+DROP COLUMN IF EXISTS "reorder_quantity",
+DROP COLUMN IF EXISTS "reorder_point";
+
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "stocktakes" (
+    "stocktake_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+    "category_id" INT REFERENCES "categories" ("category_id") ON DELETE SET NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'open',
+    "note" TEXT,
+    "created_by" INT REFERENCES "users" ("user_id") ON DELETE SET NULL,
+    "posted_by" INT REFERENCES "users" ("user_id") ON DELETE SET NULL,
+    "posted_at" TIMESTAMP,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_stocktakes_status CHECK (
+        status IN ('open', 'posted', 'cancelled')
+    )
+);
+
+CREATE INDEX idx_stocktakes_merchant_status ON stocktakes (merchant_id, status);
+
+-- A merchant counts one session at a time so two sessions never adjust the
+-- same product twice.
+CREATE UNIQUE INDEX uq_stocktakes_open_merchant ON stocktakes (merchant_id)
+WHERE
+    status = 'open';
+
+CREATE TABLE "stocktake_items" (
+    "stocktake_item_id" SERIAL PRIMARY KEY,
+    "stocktake_id" INT NOT NULL REFERENCES "stocktakes" ("stocktake_id") ON DELETE CASCADE,
+    "product_id" INT NOT NULL REFERENCES "products" ("product_id") ON DELETE CASCADE,
+    "expected_quantity" INT NOT NULL,
+    "counted_quantity" INT,
+    "unit_price" INT NOT NULL,
+    "counted_by" INT REFERENCES "users" ("user_id") ON DELETE SET NULL,
+    "counted_at" TIMESTAMP,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_stocktake_items_product UNIQUE (stocktake_id, product_id),
+    CONSTRAINT chk_stocktake_items_counted_quantity CHECK (counted_quantity >= 0)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "stocktake_items";
+
+DROP INDEX IF EXISTS uq_stocktakes_open_merchant;
+
+DROP INDEX IF EXISTS idx_stocktakes_merchant_status;
+
+DROP TABLE IF EXISTS "stocktakes";
+
+-- +goose StatementEnd
@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "product_options" (
+    "product_option_id" SERIAL PRIMARY KEY,
+    "product_id" INT NOT NULL REFERENCES "products" ("product_id") ON DELETE CASCADE,
+    "name" VARCHAR(50) NOT NULL,
+    "option_values" TEXT[] NOT NULL,
+    "position" INT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_product_options_name UNIQUE (product_id, name),
+    CONSTRAINT chk_product_options_values CHECK (cardinality(option_values) > 0)
+);
+
+CREATE TABLE "product_variants" (
+    "variant_id" SERIAL PRIMARY KEY,
+    "product_id" INT NOT NULL REFERENCES "products" ("product_id") ON DELETE CASCADE,
+    "sku" VARCHAR(64) NOT NULL UNIQUE,
+    "barcode" VARCHAR(50) UNIQUE,
+    "options" JSONB NOT NULL DEFAULT '{}',
+    "price" INT,
+    "count_in_stock" INT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP DEFAULT NULL,
+    CONSTRAINT chk_product_variants_price CHECK (
+        price IS NULL
+        OR price >= 0
+    ),
+    CONSTRAINT chk_product_variants_count_in_stock CHECK (count_in_stock >= 0)
+);
+
+CREATE INDEX idx_product_variants_product_id ON product_variants (product_id);
+
+-- Two live variants of a product cannot share the same option combination.
+CREATE UNIQUE INDEX uq_product_variants_options ON product_variants (product_id, options)
+WHERE
+    deleted_at IS NULL;
+
+ALTER TABLE "order_items"
+ADD COLUMN "variant_id" INT REFERENCES "product_variants" ("variant_id");
+
+CREATE INDEX idx_order_items_variant_id ON order_items (variant_id);
+
+ALTER TABLE "stock_movements"
+ADD COLUMN "variant_id" INT REFERENCES "product_variants" ("variant_id") ON DELETE CASCADE;
+
+CREATE INDEX idx_stock_movements_variant_id ON stock_movements (variant_id, stock_movement_id DESC)
+WHERE
+    variant_id IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_stock_movements_variant_id;
+
+ALTER TABLE "stock_movements" DROP COLUMN IF EXISTS "variant_id";
+
+DROP INDEX IF EXISTS idx_order_items_variant_id;
+
+ALTER TABLE "order_items" DROP COLUMN IF EXISTS "variant_id";
+
+DROP INDEX IF EXISTS uq_product_variants_options;
+
+DROP INDEX IF EXISTS idx_product_variants_product_id;
+
+DROP TABLE IF EXISTS "product_variants";
+
+DROP TABLE IF EXISTS "product_options";
+
+-- +goose StatementEnd
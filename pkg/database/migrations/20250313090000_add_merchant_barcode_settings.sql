@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "merchants"
+ADD COLUMN "gs1_prefix" VARCHAR(10) DEFAULT NULL,
+ADD COLUMN "barcode_format" VARCHAR(5) NOT NULL DEFAULT 'ean13',
+ADD CONSTRAINT chk_merchants_gs1_prefix CHECK (gs1_prefix ~ '^[0-9]{6,10}$'),
+ADD CONSTRAINT chk_merchants_barcode_format CHECK (barcode_format IN ('ean13', 'upca'));
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "merchants"
+DROP CONSTRAINT IF EXISTS chk_merchants_barcode_format,
+DROP CONSTRAINT IF EXISTS chk_merchants_gs1_prefix,
+DROP COLUMN IF EXISTS "barcode_format",
+DROP COLUMN IF EXISTS "gs1_prefix";
+
+-- +goose StatementEnd
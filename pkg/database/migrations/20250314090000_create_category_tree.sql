@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "categories"
+ADD COLUMN "parent_id" INT REFERENCES "categories" ("category_id") ON DELETE RESTRICT,
+ADD COLUMN "merchant_id" INT REFERENCES "merchants" ("merchant_id") ON DELETE CASCADE,
+ADD COLUMN "position" INT NOT NULL DEFAULT 0,
+ADD CONSTRAINT chk_categories_parent CHECK (parent_id <> category_id);
+
+-- Slugs are unique among global categories and within each merchant, so two
+-- merchants can both define their own "drinks".
+ALTER TABLE "categories"
+DROP CONSTRAINT IF EXISTS categories_slug_category_key;
+
+CREATE UNIQUE INDEX uq_categories_slug_global ON categories (slug_category)
+WHERE
+    merchant_id IS NULL;
+
+CREATE UNIQUE INDEX uq_categories_slug_merchant ON categories (merchant_id, slug_category)
+WHERE
+    merchant_id IS NOT NULL;
+
+CREATE INDEX idx_categories_parent_position ON categories (parent_id, position);
+
+CREATE INDEX idx_categories_merchant_id ON categories (merchant_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_categories_merchant_id;
+
+DROP INDEX IF EXISTS idx_categories_parent_position;
+
+DROP INDEX IF EXISTS uq_categories_slug_merchant;
+
+DROP INDEX IF EXISTS uq_categories_slug_global;
+
+DELETE FROM categories
+WHERE
+    merchant_id IS NOT NULL;
+
+ALTER TABLE "categories"
+ADD CONSTRAINT categories_slug_category_key UNIQUE (slug_category);
+
+ALTER TABLE "categories"
+DROP CONSTRAINT IF EXISTS chk_categories_parent,
+DROP COLUMN IF EXISTS "position",
+DROP COLUMN IF EXISTS "merchant_id",
+DROP COLUMN IF EXISTS "parent_id";
+
+-- +goose StatementEnd
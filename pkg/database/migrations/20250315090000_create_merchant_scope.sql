@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The application stores the merchants the caller may act for in the
+-- pointofsale.merchant_scope setting of the connection, as an INT[] literal.
+-- An empty or missing setting leaves the caller unrestricted; '{}' allows no
+-- merchant. Rows without a merchant, such as global categories, are only in
+-- the scope of unrestricted callers.
+CREATE OR REPLACE FUNCTION in_merchant_scope (merchant INT) RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT CASE COALESCE(current_setting('pointofsale.merchant_scope', true), '')
+        WHEN '' THEN TRUE
+        ELSE COALESCE(merchant = ANY (current_setting('pointofsale.merchant_scope', true)::INT[]), FALSE)
+    END
+$$;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS in_merchant_scope (INT);
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO "roles" ("role_name")
+VALUES
+    ('ROLE_ADMIN'),
+    ('ROLE_MANAGER'),
+    ('ROLE_CASHIER'),
+    ('ROLE_SUPPLIER'),
+    ('ROLE_USER')
+ON CONFLICT ("role_name") DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM "roles" r
+WHERE
+    r.role_name IN (
+        'ROLE_ADMIN',
+        'ROLE_MANAGER',
+        'ROLE_CASHIER',
+        'ROLE_SUPPLIER',
+        'ROLE_USER'
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "user_roles" ur
+        WHERE
+            ur.role_id = r.role_id
+    );
+
+-- +goose StatementEnd
@@ -23,6 +23,7 @@ SELECT
 FROM cashiers
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -59,6 +60,7 @@ SELECT
 FROM cashiers
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -95,6 +97,7 @@ SELECT
 FROM cashiers
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -127,6 +130,7 @@ FROM cashiers
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $2::TEXT IS NULL
         OR name ILIKE '%' || $2 || '%'
@@ -167,6 +171,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -241,6 +246,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -299,6 +305,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -375,6 +382,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -434,6 +442,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -510,6 +519,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -570,6 +580,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND o.created_at BETWEEN (
                 SELECT start_date
                 FROM date_range
@@ -619,6 +630,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND EXTRACT(
                 YEAR
                 FROM o.created_at
@@ -682,6 +694,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.cashier_id = $2
             AND o.created_at BETWEEN (
                 SELECT start_date
@@ -733,6 +746,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.cashier_id = $2
             AND EXTRACT(
                 YEAR
@@ -797,6 +811,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.merchant_id = $2
             AND o.created_at BETWEEN (
                 SELECT start_date
@@ -848,6 +863,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.merchant_id = $2
             AND EXTRACT(
                 YEAR
@@ -919,7 +935,8 @@ SELECT
 FROM cashiers
 WHERE
     cashier_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id);
 
 -- UpdateCashier: Modifies cashier information
 -- Purpose: Update cashier details
@@ -939,6 +956,7 @@ SET
 WHERE
     cashier_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     cashier_id,
     merchant_id,
@@ -963,6 +981,7 @@ SET
 WHERE
     cashier_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     cashier_id,
     merchant_id,
@@ -988,6 +1007,7 @@ SET
 WHERE
     cashier_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     cashier_id,
     merchant_id,
@@ -1003,13 +1023,14 @@ RETURNING
 --   $1: cashier_id - ID of cashier to delete
 -- Business Logic:
 --   - Permanent deletion of already soft-deleted records
---   - No return value (exec-only)
+--   - Returns the number of deleted rows, 0 when no trashed record matched
 --   - Use with caution - irreversible operation
--- name: DeleteCashierPermanently :exec
+-- name: DeleteCashierPermanently :execrows
 DELETE FROM cashiers
 WHERE
     cashier_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- RestoreAllCashiers: Mass restoration of deleted cashiers
 -- Purpose: Recover all trashed cashiers at once
@@ -1022,7 +1043,8 @@ UPDATE cashiers
 SET
     deleted_at = NULL
 WHERE
-    deleted_at IS NOT NULL;
+    deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- DeleteAllPermanentCashiers: Purges all trashed cashiers
 -- Purpose: Clean up all soft-deleted records
@@ -1031,4 +1053,4 @@ WHERE
 --   - Only affects already soft-deleted records
 --   - Typically used during database maintenance
 -- name: DeleteAllPermanentCashiers :exec
-DELETE FROM cashiers WHERE deleted_at IS NOT NULL;
\ No newline at end of file
+DELETE FROM cashiers WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id);
\ No newline at end of file
@@ -17,12 +17,16 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at,
     COUNT(*) OVER () AS total_count
 FROM categories
 WHERE
     deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -52,6 +56,9 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at,
     deleted_at,
@@ -59,6 +66,7 @@ SELECT
 FROM categories
 WHERE
     deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -88,6 +96,9 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at,
     deleted_at,
@@ -95,6 +106,7 @@ SELECT
 FROM categories
 WHERE
     deleted_at IS NOT NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -115,7 +127,7 @@ OFFSET
 -- Returns:
 --   year: Year of revenue data (text format)
 --   month_name: Full month name (e.g. "January")
---   total_revenue: Sum of order totals for that month (0 if no sales)
+--   total_revenue: Sum of the order lines sold that month (0 if no sales)
 -- Business Logic:
 --   - Compares revenue between two customizable date ranges
 --   - Joins with order_items to ensure accurate order calculations
@@ -131,7 +143,7 @@ WITH
             )::TEXT AS year, EXTRACT(
                 MONTH
                 FROM o.created_at
-            )::integer AS month, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS month, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -140,6 +152,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -197,7 +210,7 @@ ORDER BY am.year::INT DESC, am.month DESC;
 --   $1: Reference year for comparison (current year)
 -- Returns:
 --   year: Year as text
---   total_revenue: Annual revenue total (0 if no sales)
+--   total_revenue: Annual revenue from order lines (0 if no sales)
 -- Business Logic:
 --   - Compares current year with previous year automatically
 --   - Validates product/category relationships through joins
@@ -210,7 +223,7 @@ WITH
         SELECT EXTRACT(
                 YEAR
                 FROM o.created_at
-            )::integer AS year, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS year, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -219,6 +232,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND (
@@ -258,7 +272,7 @@ ORDER BY a.year DESC;
 -- Returns:
 --   year: Year of revenue data (text format)
 --   month_name: Full month name (e.g. "January")
---   total_revenue: Sum of order totals for that month (0 if no sales)
+--   total_revenue: Sum of the order lines sold that month (0 if no sales)
 -- Business Logic:
 --   - Compares revenue between two customizable date ranges
 --   - Joins with order_items to ensure accurate order calculations
@@ -274,7 +288,7 @@ WITH
             )::TEXT AS year, EXTRACT(
                 MONTH
                 FROM o.created_at
-            )::integer AS month, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS month, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -283,6 +297,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -342,7 +357,7 @@ ORDER BY am.year::INT DESC, am.month DESC;
 --   $2: Merchant ID
 -- Returns:
 --   year: Year as text
---   total_revenue: Annual revenue total (0 if no sales)
+--   total_revenue: Annual revenue from order lines (0 if no sales)
 -- Business Logic:
 --   - Compares current year with previous year automatically
 --   - Validates product/category relationships through joins
@@ -355,7 +370,7 @@ WITH
         SELECT EXTRACT(
                 YEAR
                 FROM o.created_at
-            )::integer AS year, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS year, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -364,6 +379,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND (
@@ -401,18 +417,33 @@ ORDER BY a.year DESC;
 --   $3: Start date of second comparison period
 --   $4: End date of second comparison period
 --   $5: Category ID
+--   $6: Roll up subcategories - when true the category's descendants are included
 -- Returns:
 --   year: Year of revenue data (text format)
 --   month_name: Full month name (e.g. "January")
---   total_revenue: Sum of order totals for that month (0 if no sales)
+--   total_revenue: Sum of the order lines sold that month (0 if no sales)
 -- Business Logic:
 --   - Compares revenue between two customizable date ranges
 --   - Joins with order_items to ensure accurate order calculations
 --   - Excludes deleted orders and order items for data integrity
 --   - Uses gap-filling to show all months in both periods
 --   - Formats output for financial dashboards
+--   - With rollup, revenue of every active descendant category is included
 -- name: GetMonthlyTotalPriceById :many
-WITH
+WITH RECURSIVE
+    category_tree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            category_id = $5
+        UNION
+        SELECT child.category_id
+        FROM categories child
+            JOIN category_tree t ON child.parent_id = t.category_id
+        WHERE
+            $6::boolean
+            AND child.deleted_at IS NULL
+    ),
     monthly_totals AS (
         SELECT EXTRACT(
                 YEAR
@@ -420,7 +451,7 @@ WITH
             )::TEXT AS year, EXTRACT(
                 MONTH
                 FROM o.created_at
-            )::integer AS month, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS month, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -429,6 +460,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -439,7 +471,10 @@ WITH
                     AND o.created_at <= $4
                 )
             )
-            AND c.category_id = $5
+            AND c.category_id IN (
+                SELECT category_id
+                FROM category_tree
+            )
         GROUP BY
             EXTRACT(
                 YEAR
@@ -486,22 +521,37 @@ ORDER BY am.year::INT DESC, am.month DESC;
 -- Parameters:
 --   $1: Reference year for comparison (current year)
 --   $2: Category ID
+--   $3: Roll up subcategories - when true the category's descendants are included
 -- Returns:
 --   year: Year as text
---   total_revenue: Annual revenue total (0 if no sales)
+--   total_revenue: Annual revenue from order lines (0 if no sales)
 -- Business Logic:
 --   - Compares current year with previous year automatically
 --   - Validates product/category relationships through joins
 --   - Excludes deleted records across all joined tables
 --   - Ensures complete year reporting even with no sales
 --   - Orders results by most recent year first
+--   - With rollup, revenue of every active descendant category is included
 -- name: GetYearlyTotalPriceById :many
-WITH
+WITH RECURSIVE
+    category_tree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            category_id = $2
+        UNION
+        SELECT child.category_id
+        FROM categories child
+            JOIN category_tree t ON child.parent_id = t.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     yearly_data AS (
         SELECT EXTRACT(
                 YEAR
                 FROM o.created_at
-            )::integer AS year, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS year, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -510,6 +560,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND (
@@ -522,7 +573,10 @@ WITH
                     FROM o.created_at
                 ) = $1::integer - 1
             )
-            AND c.category_id = $2
+            AND c.category_id IN (
+                SELECT category_id
+                FROM category_tree
+            )
         GROUP BY
             EXTRACT(
                 YEAR
@@ -543,21 +597,34 @@ ORDER BY a.year DESC;
 -- Purpose: Provides category performance metrics by month for operational analysis
 -- Parameters:
 --   $1: Reference date (timestamp) - determines the 12-month analysis period
+--   $2: Roll up subcategories - when true each category also counts the sales of its descendants
 -- Returns:
 --   category_id: Unique identifier for the category
 --   category_name: Display name of the category
 --   month: 3-letter month abbreviation (e.g. 'Jan')
 --   order_count: Number of orders associated with the category
 --   items_sold: Total quantity of items sold from the category
---   total_revenue: Total revenue generated from category items
+--   total_revenue: Sum of line price times quantity at the price each line sold for
 -- Business Logic:
 --   - Analyzes a rolling 12-month period from the reference date
 --   - Excludes deleted orders, items, products, and categories to ensure valid data
 --   - Aggregates by category and month for trend tracking
 --   - Uses abbreviated month names for compact visual reporting
 --   - Results sorted by month and revenue for time-series analysis
+--   - With rollup, totals of a category include every active descendant category
 -- name: GetMonthlyCategory :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $2::boolean
+            AND child.deleted_at IS NULL
+    ),
     date_range AS (
         SELECT date_trunc('month', $1::timestamp) AS start_date, date_trunc('month', $1::timestamp) + interval '1 year' - interval '1 day' AS end_date
     ),
@@ -568,15 +635,17 @@ WITH
             date_trunc('month', o.created_at) AS activity_month,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND o.created_at BETWEEN (
@@ -599,13 +668,14 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC;
 -- Purpose: Enables long-term product category performance trend analysis
 -- Parameters:
 --   $1: Reference date (timestamp) - determines the 5-year analysis window
+--   $2: Roll up subcategories - when true each category also counts the sales of its descendants
 -- Returns:
 --   year: 4-digit year as text
 --   category_id: Unique category identifier
 --   category_name: Display name of the category
 --   order_count: Annual number of orders involving this category
 --   items_sold: Quantity of products sold from this category
---   total_revenue: Total sales revenue from category products
+--   total_revenue: Sum of line price times quantity at the price each line sold for
 --   unique_products_sold: Count of unique products sold within the category
 -- Business Logic:
 --   - Covers the current year and previous four years (5-year window)
@@ -613,8 +683,20 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC;
 --   - Provides both volume and value metrics for category-level evaluation
 --   - Results sorted by year and revenue to show historical trends
 --   - Suitable for business reviews and strategic category planning
+--   - With rollup, totals of a category include every active descendant category
 -- name: GetYearlyCategory :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $2::boolean
+            AND child.deleted_at IS NULL
+    ),
     last_five_years AS (
         SELECT
             c.category_id,
@@ -625,16 +707,18 @@ WITH
             )::text AS year,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue,
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue,
             COUNT(DISTINCT oi.product_id) AS unique_products_sold
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND EXTRACT(
@@ -673,21 +757,34 @@ ORDER BY year, total_revenue DESC;
 -- Parameters:
 --   $1: Reference date (timestamp) - determines the 12-month analysis period
 --   $2: Merchant ID
+--   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 -- Returns:
 --   category_id: Unique identifier for the category
 --   category_name: Display name of the category
 --   month: 3-letter month abbreviation (e.g. 'Jan')
 --   order_count: Number of orders associated with the category
 --   items_sold: Total quantity of items sold from the category
---   total_revenue: Total revenue generated from category items
+--   total_revenue: Sum of line price times quantity at the price each line sold for
 -- Business Logic:
 --   - Analyzes a rolling 12-month period from the reference date
 --   - Excludes deleted orders, items, products, and categories to ensure valid data
 --   - Aggregates by category and month for trend tracking
 --   - Uses abbreviated month names for compact visual reporting
 --   - Results sorted by month and revenue for time-series analysis
+--   - With rollup, totals of a category include every active descendant category
 -- name: GetMonthlyCategoryByMerchant :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     date_range AS (
         SELECT date_trunc('month', $1::timestamp) AS start_date, date_trunc('month', $1::timestamp) + interval '1 year' - interval '1 day' AS end_date
     ),
@@ -698,15 +795,17 @@ WITH
             date_trunc('month', o.created_at) AS activity_month,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND o.created_at BETWEEN (
@@ -731,13 +830,14 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC;
 -- Parameters:
 --   $1: Reference date (timestamp) - determines the 5-year analysis window
 --   $2: Merchant ID
+--   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 -- Returns:
 --   year: 4-digit year as text
 --   category_id: Unique category identifier
 --   category_name: Display name of the category
 --   order_count: Annual number of orders involving this category
 --   items_sold: Quantity of products sold from this category
---   total_revenue: Total sales revenue from category products
+--   total_revenue: Sum of line price times quantity at the price each line sold for
 --   unique_products_sold: Count of unique products sold within the category
 -- Business Logic:
 --   - Covers the current year and previous four years (5-year window)
@@ -745,8 +845,20 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC;
 --   - Provides both volume and value metrics for category-level evaluation
 --   - Results sorted by year and revenue to show historical trends
 --   - Suitable for business reviews and strategic category planning
+--   - With rollup, totals of a category include every active descendant category
 -- name: GetYearlyCategoryByMerchant :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     last_five_years AS (
         SELECT
             c.category_id,
@@ -757,16 +869,18 @@ WITH
             )::text AS year,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue,
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue,
             COUNT(DISTINCT oi.product_id) AS unique_products_sold
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND EXTRACT(
@@ -806,21 +920,36 @@ ORDER BY year, total_revenue DESC;
 -- Parameters:
 --   $1: Reference date (timestamp) - determines the 12-month analysis period
 --   $2: Category ID
+--   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 -- Returns:
 --   category_id: Unique identifier for the category
 --   category_name: Display name of the category
 --   month: 3-letter month abbreviation (e.g. 'Jan')
 --   order_count: Number of orders associated with the category
 --   items_sold: Total quantity of items sold from the category
---   total_revenue: Total revenue generated from category items
+--   total_revenue: Sum of line price times quantity at the price each line sold for
 -- Business Logic:
 --   - Analyzes a rolling 12-month period from the reference date
 --   - Excludes deleted orders, items, products, and categories to ensure valid data
 --   - Aggregates by category and month for trend tracking
 --   - Uses abbreviated month names for compact visual reporting
 --   - Results sorted by month and revenue for time-series analysis
+--   - With rollup, totals of a category include every active descendant category
 -- name: GetMonthlyCategoryById :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        WHERE
+            category_id = $2
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     date_range AS (
         SELECT date_trunc('month', $1::timestamp) AS start_date, date_trunc('month', $1::timestamp) + interval '1 year' - interval '1 day' AS end_date
     ),
@@ -831,15 +960,17 @@ WITH
             date_trunc('month', o.created_at) AS activity_month,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND o.created_at BETWEEN (
@@ -864,13 +995,14 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC;
 -- Parameters:
 --   $1: Reference date (timestamp) - determines the 5-year analysis window
 --   $2: Category ID
+--   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 -- Returns:
 --   year: 4-digit year as text
 --   category_id: Unique category identifier
 --   category_name: Display name of the category
 --   order_count: Annual number of orders involving this category
 --   items_sold: Quantity of products sold from this category
---   total_revenue: Total sales revenue from category products
+--   total_revenue: Sum of line price times quantity at the price each line sold for
 --   unique_products_sold: Count of unique products sold within the category
 -- Business Logic:
 --   - Covers the current year and previous four years (5-year window)
@@ -878,8 +1010,22 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC;
 --   - Provides both volume and value metrics for category-level evaluation
 --   - Results sorted by year and revenue to show historical trends
 --   - Suitable for business reviews and strategic category planning
+--   - With rollup, totals of a category include every active descendant category
 -- name: GetYearlyCategoryById :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        WHERE
+            category_id = $2
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     last_five_years AS (
         SELECT
             c.category_id,
@@ -890,16 +1036,18 @@ WITH
             )::text AS year,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue,
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue,
             COUNT(DISTINCT oi.product_id) AS unique_products_sold
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND EXTRACT(
@@ -940,24 +1088,47 @@ ORDER BY year, total_revenue DESC;
 --   $1: Category name
 --   $2: Category description
 --   $3: Slug for category (URL-friendly identifier)
+--   $4: Parent category ID (NULL for a root category)
+--   $5: Owning merchant ID (NULL for a global category)
 -- Returns:
 --   Full category record including generated ID
 -- Business Logic:
---   - Assumes unique slug for identification in URLs
+--   - Assumes unique slug within the global or merchant scope
+--   - Appends the category after its existing siblings
 --   - Automatically populates timestamps via default DB behavior (if configured)
 -- name: CreateCategory :one
 INSERT INTO
     categories (
         name,
         description,
-        slug_category
+        slug_category,
+        parent_id,
+        merchant_id,
+        position
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        (
+            SELECT COALESCE(MAX(s.position) + 1, 0)
+            FROM categories s
+            WHERE
+                s.parent_id IS NOT DISTINCT FROM $4
+                AND s.merchant_id IS NOT DISTINCT FROM $5
+                AND s.deleted_at IS NULL
+        )
     )
-VALUES ($1, $2, $3)
 RETURNING
     category_id,
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at;
 
@@ -975,12 +1146,16 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at
 FROM categories
 WHERE
     category_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id));
 
 -- GetCategoryByName: Fetches a single category by its name
 -- Purpose: Retrieve details of an active (non-deleted) category
@@ -1001,7 +1176,41 @@ SELECT
 FROM categories
 WHERE
     name = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id));
+
+-- GetCategoryByNameOrSlug: Fetches a single category by its name or slug
+-- Purpose: Resolve the category column of a product import
+-- Parameters:
+--   $1: Category name or slug
+--   $2: Merchant ID whose own categories are visible besides global ones
+-- Returns:
+--   Full category record if found and not deleted
+-- Business Logic:
+--   - Excludes soft-deleted categories
+--   - Only considers global categories and those of the merchant
+--   - Prefers a slug match over a name match, then the merchant's own category
+-- name: GetCategoryByNameOrSlug :one
+SELECT
+    category_id,
+    name,
+    description,
+    slug_category,
+    created_at,
+    updated_at
+FROM categories
+WHERE (
+        name = $1
+        OR slug_category = $1
+    )
+    AND (
+        merchant_id IS NULL
+        OR merchant_id = $2
+    )
+    AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
+ORDER BY slug_category = $1 DESC, merchant_id IS NULL
+LIMIT 1;
 
 -- GetCategoryByNameAndId: Fetches a single category by its name and id
 -- Purpose: Retrieve details of an active (non-deleted) category
@@ -1023,7 +1232,8 @@ FROM categories
 WHERE
     name = $1
     AND category_id = $2
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id));
 
 -- UpdateCategory: Updates category details
 -- Purpose: Modify existing category data while maintaining soft delete integrity
@@ -1047,11 +1257,15 @@ SET
 WHERE
     category_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     category_id,
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at;
 
@@ -1071,6 +1285,7 @@ SET
 WHERE
     category_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     category_id,
     name,
@@ -1078,7 +1293,10 @@ RETURNING
     slug_category,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    parent_id,
+    merchant_id,
+    position;
 
 -- RestoreCategory: Recovers a previously trashed category
 -- Purpose: Restores a soft-deleted category for reuse
@@ -1095,6 +1313,7 @@ SET
 WHERE
     category_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     category_id,
     name,
@@ -1102,7 +1321,10 @@ RETURNING
     slug_category,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    parent_id,
+    merchant_id,
+    position;
 
 -- DeleteCategoryPermanently: Removes a soft-deleted category permanently
 -- Purpose: Final cleanup of trashed categories
@@ -1116,7 +1338,8 @@ RETURNING
 DELETE FROM categories
 WHERE
     category_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- RestoreAllCategories: Recovers all trashed categories
 -- Purpose: Bulk restore of all soft-deleted category records
@@ -1129,7 +1352,8 @@ UPDATE categories
 SET
     deleted_at = NULL
 WHERE
-    deleted_at IS NOT NULL;
+    deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- DeleteAllPermanentCategories: Permanently deletes all trashed categories
 -- Purpose: Bulk purge of all soft-deleted category records
@@ -1138,4 +1362,180 @@ WHERE
 -- Business Logic:
 --   - Only affects records marked as deleted
 -- name: DeleteAllPermanentCategories :exec
-DELETE FROM categories WHERE deleted_at IS NOT NULL;
\ No newline at end of file
+DELETE FROM categories WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id);
+
+-- GetCategoryTree: Retrieves the active category tree visible to a merchant
+-- Purpose: Render nested category pickers and navigation
+-- Parameters:
+--   $1: Merchant ID whose own categories are included (NULL for global only)
+-- Returns:
+--   Category fields plus depth (0 for root categories)
+-- Business Logic:
+--   - Walks from the root categories down through parent_id
+--   - Excludes soft-deleted categories and everything below them
+--   - Orders depth-first, siblings by position, so each category follows its parent
+-- name: GetCategoryTree :many
+WITH RECURSIVE
+    tree AS (
+        SELECT c.category_id, 0 AS depth, ARRAY[c.position, c.category_id] AS path
+        FROM categories c
+        WHERE
+            c.parent_id IS NULL
+            AND c.deleted_at IS NULL
+            AND (c.merchant_id IS NULL OR in_merchant_scope(c.merchant_id))
+            AND (
+                c.merchant_id IS NULL
+                OR c.merchant_id = sqlc.narg('merchant_id')::int
+            )
+        UNION ALL
+        SELECT child.category_id, t.depth + 1, t.path || ARRAY[child.position, child.category_id]
+        FROM categories child
+            JOIN tree t ON child.parent_id = t.category_id
+        WHERE
+            child.deleted_at IS NULL
+            AND (child.merchant_id IS NULL OR in_merchant_scope(child.merchant_id))
+            AND (
+                child.merchant_id IS NULL
+                OR child.merchant_id = sqlc.narg('merchant_id')::int
+            )
+    )
+SELECT
+    c.category_id,
+    c.name,
+    c.description,
+    c.slug_category,
+    c.parent_id,
+    c.merchant_id,
+    c.position,
+    c.created_at,
+    c.updated_at,
+    t.depth::int AS depth
+FROM tree t
+    JOIN categories c ON c.category_id = t.category_id
+ORDER BY t.path;
+
+-- GetCategorySiblings: Retrieves the categories sharing a parent and an owner
+-- Purpose: Renumber positions when categories are moved or reordered
+-- Parameters:
+--   $1: Parent category ID (NULL for root categories)
+--   $2: Owning merchant ID (NULL for global categories)
+-- Returns:
+--   Full category records
+-- Business Logic:
+--   - Excludes soft-deleted categories
+--   - Ordered by position, then by ID for stable results
+-- name: GetCategorySiblings :many
+SELECT *
+FROM categories
+WHERE
+    parent_id IS NOT DISTINCT FROM sqlc.narg('parent_id')::int
+    AND merchant_id IS NOT DISTINCT FROM sqlc.narg('merchant_id')::int
+    AND deleted_at IS NULL
+ORDER BY position, category_id;
+
+-- GetCategorySubtreeIds: Retrieves a category and all of its descendants
+-- Purpose: Detect cycles before a category is moved
+-- Parameters:
+--   $1: Root category ID of the subtree
+-- Returns:
+--   category_id of every category in the subtree, the root included
+-- Business Logic:
+--   - Includes soft-deleted descendants, since they still reference their parent
+-- name: GetCategorySubtreeIds :many
+WITH RECURSIVE
+    subtree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            category_id = $1
+        UNION
+        SELECT c.category_id
+        FROM categories c
+            JOIN subtree s ON c.parent_id = s.category_id
+    )
+SELECT category_id
+FROM subtree;
+
+-- CountActiveChildCategories: Counts the active children of a category
+-- Purpose: Prevent trashing a category that still has active subcategories
+-- Parameters:
+--   $1: Parent category ID
+-- Returns:
+--   Number of active child categories
+-- name: CountActiveChildCategories :one
+SELECT COUNT(*)
+FROM categories
+WHERE
+    parent_id = @parent_id::int
+    AND deleted_at IS NULL;
+
+-- CountCategorySlugConflicts: Counts categories whose slug a category would clash with
+-- Purpose: Keep slugs unique within the global scope and across a merchant's view
+-- Parameters:
+--   $1: Slug to check
+--   $2: Category ID to ignore (NULL when creating)
+--   $3: Owning merchant ID (NULL for a global category)
+-- Returns:
+--   Number of conflicting categories, trashed ones included
+-- Business Logic:
+--   - A global category clashes with other global categories
+--   - A merchant category clashes with global categories and the merchant's own
+-- name: CountCategorySlugConflicts :one
+SELECT COUNT(*)
+FROM categories
+WHERE
+    slug_category = @slug_category
+    AND category_id IS DISTINCT FROM sqlc.narg('category_id')::int
+    AND (
+        merchant_id IS NULL
+        OR merchant_id IS NOT DISTINCT FROM sqlc.narg('merchant_id')::int
+    );
+
+-- UpdateCategoryParent: Moves a category under another parent
+-- Purpose: Restructure the category tree
+-- Parameters:
+--   $1: Category ID
+--   $2: New parent category ID (NULL to make it a root category)
+-- Returns:
+--   Updated category record
+-- Business Logic:
+--   - Skips if category has been soft-deleted
+--   - Positions are renumbered separately with ReorderCategories
+-- name: UpdateCategoryParent :one
+UPDATE categories
+SET
+    parent_id = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    category_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    *;
+
+-- ReorderCategories: Sets the position of categories from their order in a list
+-- Purpose: Persist a new sibling order
+-- Parameters:
+--   $1: Category IDs in their new order
+-- Returns:
+--   Nothing (command only)
+-- Business Logic:
+--   - The first category gets position 0, the next 1, and so on
+-- name: ReorderCategories :exec
+UPDATE categories c
+SET
+    position = o.ordinality - 1,
+    updated_at = CURRENT_TIMESTAMP
+FROM unnest(@category_ids::int[]) WITH ORDINALITY AS o (category_id, ordinality)
+WHERE
+    c.category_id = o.category_id
+    AND in_merchant_scope(c.merchant_id);
+
+-- LockCategoryTree: Serializes changes to the category tree
+-- Purpose: Keep concurrent moves from creating a cycle between them
+-- Parameters: None
+-- Returns: None
+-- Business Logic:
+--   - Holds a transaction-level advisory lock until commit or rollback
+-- name: LockCategoryTree :exec
+SELECT pg_advisory_xact_lock(hashtext('categories_tree'));
@@ -0,0 +1,124 @@
+-- ReserveIdempotencyKey: Claims an idempotency key for a request about to run
+-- Purpose: Make sure only one request per key reaches the handler
+-- Parameters:
+--   $1: user_id - Caller the key belongs to
+--   $2: method - Full gRPC method the key is scoped to
+--   $3: idempotency_key - Key sent by the client
+--   $4: request_hash - SHA-256 of the request payload
+--   $5: locked_until - When an unfinished claim may be taken over
+--   $6: expires_at - When the stored response stops being replayed
+-- Returns:
+--   The claimed key, or no row when another request holds it
+-- Business Logic:
+--   - Expired keys and claims abandoned past locked_until are taken over
+--   - Completed keys are never overwritten before they expire
+-- name: ReserveIdempotencyKey :one
+INSERT INTO
+    idempotency_keys (
+        user_id,
+        method,
+        idempotency_key,
+        request_hash,
+        locked_until,
+        expires_at
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, method, idempotency_key) DO
+UPDATE
+SET
+    request_hash = EXCLUDED.request_hash,
+    response = NULL,
+    locked_until = EXCLUDED.locked_until,
+    expires_at = EXCLUDED.expires_at,
+    created_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    idempotency_keys.expires_at < current_timestamp
+    OR (
+        idempotency_keys.response IS NULL
+        AND idempotency_keys.locked_until < current_timestamp
+    )
+RETURNING
+    idempotency_key_id,
+    user_id,
+    method,
+    idempotency_key,
+    request_hash,
+    response,
+    locked_until,
+    expires_at,
+    created_at,
+    updated_at;
+
+-- GetIdempotencyKey: Retrieves a live idempotency key
+-- Purpose: Replay a stored response or detect a reused key
+-- Parameters:
+--   $1: user_id
+--   $2: method
+--   $3: idempotency_key
+-- Returns:
+--   The key while it has not expired
+-- name: GetIdempotencyKey :one
+SELECT
+    idempotency_key_id,
+    user_id,
+    method,
+    idempotency_key,
+    request_hash,
+    response,
+    locked_until,
+    expires_at,
+    created_at,
+    updated_at
+FROM idempotency_keys
+WHERE
+    user_id = $1
+    AND method = $2
+    AND idempotency_key = $3
+    AND expires_at > current_timestamp;
+
+-- CompleteIdempotencyKey: Stores the response of a finished request
+-- Purpose: Let retries with the same key replay the original response
+-- Parameters:
+--   $1: idempotency_key_id
+--   $2: response - Serialized response message
+-- Returns:
+--   The completed key
+-- name: CompleteIdempotencyKey :one
+UPDATE idempotency_keys
+SET
+    response = $2,
+    updated_at = current_timestamp
+WHERE
+    idempotency_key_id = $1
+    AND response IS NULL
+RETURNING
+    idempotency_key_id,
+    user_id,
+    method,
+    idempotency_key,
+    request_hash,
+    response,
+    locked_until,
+    expires_at,
+    created_at,
+    updated_at;
+
+-- ReleaseIdempotencyKey: Drops the claim of a request that failed
+-- Purpose: Allow the client to retry a request that had no effect
+-- Parameters:
+--   $1: idempotency_key_id
+-- Business Logic:
+--   - Completed keys are kept
+-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+    idempotency_key_id = $1
+    AND response IS NULL;
+
+-- DeleteExpiredIdempotencyKeys: Purges keys past their retention window
+-- Purpose: Keep the idempotency_keys table small
+-- Returns:
+--   Number of deleted keys
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at < current_timestamp;
@@ -28,6 +28,7 @@ SELECT
 FROM merchants
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -67,6 +68,7 @@ SELECT
 FROM merchants
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -108,6 +110,7 @@ SELECT
 FROM merchants
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -177,11 +180,39 @@ SELECT
     contact_phone,
     status,
     created_at,
-    updated_at
+    updated_at,
+    gs1_prefix,
+    barcode_format
 FROM merchants
 WHERE
     merchant_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id);
+
+-- GetMerchantScopeByUser: Retrieves the merchants a user may act for
+-- Purpose: Resolve the merchant scope of an authenticated caller
+-- Parameters:
+--   $1: user_id - ID of the caller
+-- Returns:
+--   merchant_id of every merchant the user owns or works at as a cashier
+-- Business Logic:
+--   - Excludes soft-deleted merchants and cashiers
+--   - Must run without a merchant scope, since it is what the scope is built from
+-- name: GetMerchantScopeByUser :many
+SELECT merchant_id
+FROM merchants
+WHERE
+    user_id = $1
+    AND deleted_at IS NULL
+UNION
+SELECT c.merchant_id
+FROM cashiers c
+    JOIN merchants m ON m.merchant_id = c.merchant_id
+WHERE
+    c.user_id = $1
+    AND c.deleted_at IS NULL
+    AND m.deleted_at IS NULL
+ORDER BY merchant_id;
 
 -- UpdateMerchant: Modifies merchant information
 -- Purpose: Update merchant profile details
@@ -212,6 +243,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     merchant_id,
     user_id,
@@ -224,6 +256,32 @@ RETURNING
     created_at,
     updated_at;
 
+-- UpdateMerchantBarcodeSettings: Sets how product barcodes are generated for a merchant
+-- Purpose: Number new products under the merchant's own GS1 company prefix
+-- Parameters:
+--   $1: merchant_id - Target merchant ID
+--   $2: gs1_prefix - GS1 company prefix (NULL to use the in-store range)
+--   $3: barcode_format - 'ean13' or 'upca'
+-- Returns: The merchant's barcode settings
+-- Business Logic:
+--   - Only affects active (non-deleted) records
+--   - Existing product barcodes are left unchanged
+-- name: UpdateMerchantBarcodeSettings :one
+UPDATE merchants
+SET
+    gs1_prefix = $2,
+    barcode_format = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    merchant_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    merchant_id,
+    gs1_prefix,
+    barcode_format,
+    updated_at;
+
 -- TrashMerchant: Soft-deletes a merchant account
 -- Purpose: Deactivate merchant without permanent deletion
 -- Parameters:
@@ -241,6 +299,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     merchant_id,
     user_id,
@@ -252,7 +311,9 @@ RETURNING
     status,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    gs1_prefix,
+    barcode_format;
 
 -- RestoreMerchant: Recovers a soft-deleted merchant
 -- Purpose: Reactivate a previously deactivated merchant
@@ -271,6 +332,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     merchant_id,
     user_id,
@@ -282,7 +344,9 @@ RETURNING
     status,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    gs1_prefix,
+    barcode_format;
 
 -- DeleteMerchantPermanently: Hard-deletes a merchant
 -- Purpose: Completely remove merchant from database
@@ -290,14 +354,15 @@ RETURNING
 --   $1: merchant_id - ID of merchant to delete
 -- Business Logic:
 --   - Permanent deletion of already soft-deleted records
---   - No return value (exec-only operation)
+--   - Returns the number of deleted rows, 0 when no trashed record matched
 --   - Irreversible action - use with caution
 --   - Should trigger cleanup of related records
--- name: DeleteMerchantPermanently :exec
+-- name: DeleteMerchantPermanently :execrows
 DELETE FROM merchants
 WHERE
     merchant_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- RestoreAllMerchants: Mass restoration of deleted merchants
 -- Purpose: Recover all trashed merchants at once
@@ -311,7 +376,8 @@ UPDATE merchants
 SET
     deleted_at = NULL
 WHERE
-    deleted_at IS NOT NULL;
+    deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- DeleteAllPermanentMerchants: Purges all trashed merchants
 -- Purpose: Clean up all soft-deleted merchant records
@@ -321,4 +387,4 @@ WHERE
 --   - Typically used during database maintenance
 --   - Should be restricted to admin users
 -- name: DeleteAllPermanentMerchants :exec
-DELETE FROM merchants WHERE deleted_at IS NOT NULL;
\ No newline at end of file
+DELETE FROM merchants WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id);
\ No newline at end of file
@@ -24,6 +24,7 @@ SELECT
 FROM order_items
 WHERE
     deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
@@ -59,6 +60,7 @@ SELECT
 FROM order_items
 WHERE
     deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
@@ -96,6 +98,7 @@ SELECT
 FROM order_items
 WHERE
     deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
@@ -120,7 +123,8 @@ SELECT COALESCE(SUM(quantity * price), 0)::int AS total_price
 FROM order_items
 WHERE
     order_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id));
 
 -- CreateOrderItem: Inserts a new order item record
 -- Purpose: Adds a product to a specific order
@@ -129,6 +133,7 @@ WHERE
 --   $2: product_id
 --   $3: quantity
 --   $4: price
+--   $5: variant_id (nullable for products without variants)
 -- Returns:
 --   The newly created order item
 -- Business Logic:
@@ -139,9 +144,10 @@ INSERT INTO
         order_id,
         product_id,
         quantity,
-        price
+        price,
+        variant_id
     )
-VALUES ($1, $2, $3, $4)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING
     order_item_id,
     order_id,
@@ -149,7 +155,8 @@ RETURNING
     quantity,
     price,
     created_at,
-    updated_at;
+    updated_at,
+    variant_id;
 
 -- GetOrderItemsByOrder: Retrieves active order items for a specific order
 -- Purpose: Fetches all non-deleted order items under one order
@@ -167,11 +174,13 @@ SELECT
     quantity,
     price,
     created_at,
-    updated_at
+    updated_at,
+    variant_id
 FROM order_items
 WHERE
     order_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id));
 
 -- name: GetOrderItemsByOrderTrashed :many
 SELECT
@@ -182,18 +191,77 @@ SELECT
     price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount,
+    variant_id
 FROM order_items
 WHERE
     order_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id));
+
+-- UpdateOrderItemTax: Records the tax charged on an order line
+-- Purpose: Keep a per-line tax breakdown so receipts can be rebuilt
+-- Parameters:
+--   $1: order_item_id
+--   $2: tax_rate_id - Applied tax rate (nullable when untaxed)
+--   $3: tax_rate_bps - Rate in basis points at the time of sale
+--   $4: tax_inclusive - Whether the line price already included the tax
+--   $5: tax_rounding - Rounding rule used for the line
+--   $6: tax_amount - Tax charged on the line
+-- Business Logic:
+--   - Snapshots the rate so later rate changes do not alter past sales
+-- name: UpdateOrderItemTax :exec
+UPDATE order_items
+SET
+    tax_rate_id = $2,
+    tax_rate_bps = $3,
+    tax_inclusive = $4,
+    tax_rounding = $5,
+    tax_amount = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    order_item_id = $1
+    AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id));
 
--- UpdateOrderItem: Updates quantity and price of an existing order item
+-- GetOrderItemTaxLines: Retrieves the per-line tax breakdown of an order
+-- Purpose: Rebuild the tax section of a receipt
+-- Parameters:
+--   $1: order_id
+-- Returns:
+--   Line quantity, price and the tax recorded for it
+-- name: GetOrderItemTaxLines :many
+SELECT
+    order_item_id,
+    product_id,
+    variant_id,
+    quantity,
+    price,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount
+FROM order_items
+WHERE
+    order_id = $1
+    AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
+ORDER BY order_item_id ASC;
+
+-- UpdateOrderItem: Updates the product, quantity and price of an existing order item
 -- Purpose: Allows modification of product details in an order
 -- Parameters:
 --   $1: order_item_id
 --   $2: new quantity
 --   $3: new price
+--   $4: new product_id
+--   $5: new variant_id (nullable for products without variants)
 -- Returns:
 --   The updated order item
 -- Business Logic:
@@ -204,10 +272,13 @@ UPDATE order_items
 SET
     quantity = $2,
     price = $3,
+    product_id = $4,
+    variant_id = $5,
     updated_at = CURRENT_TIMESTAMP
 WHERE
     order_item_id = $1
     AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 RETURNING
     order_item_id,
     order_id,
@@ -215,7 +286,8 @@ RETURNING
     quantity,
     price,
     created_at,
-    updated_at;
+    updated_at,
+    variant_id;
 
 -- TrashOrderItem: Soft-deletes a specific order item
 -- Purpose: Marks an item as deleted without removing it from DB
@@ -232,6 +304,7 @@ SET
 WHERE
     order_item_id = $1
     AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 RETURNING
     order_item_id,
     order_id,
@@ -240,7 +313,13 @@ RETURNING
     price,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount,
+    variant_id;
 
 -- RestoreOrderItem: Restores a previously trashed order item
 -- Purpose: Undoes a soft-delete action
@@ -257,6 +336,7 @@ SET
 WHERE
     order_item_id = $1
     AND deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 RETURNING
     order_item_id,
     order_id,
@@ -265,7 +345,13 @@ RETURNING
     price,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount,
+    variant_id;
 
 -- DeleteOrderItemPermanently: Permanently deletes a trashed order item
 -- Purpose: Removes the record entirely from the database
@@ -279,7 +365,8 @@ RETURNING
 DELETE FROM order_items
 WHERE
     order_item_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id));
 
 -- RestoreAllOrdersItem: Restores all soft-deleted order items
 -- Purpose: Mass recovery of trashed items
@@ -292,7 +379,8 @@ UPDATE order_items
 SET
     deleted_at = NULL
 WHERE
-    deleted_at IS NOT NULL;
+    deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id));
 
 -- DeleteAllPermanentOrdersItem: Permanently deletes all trashed order items
 -- Purpose: Performs hard delete of all soft-deleted items
@@ -301,4 +389,6 @@ WHERE
 -- Business Logic:
 --   - Used for data cleanup or archival enforcement
 -- name: DeleteAllPermanentOrdersItem :exec
-DELETE FROM order_items WHERE deleted_at IS NOT NULL;
\ No newline at end of file
+DELETE FROM order_items WHERE
+    deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id));
\ No newline at end of file
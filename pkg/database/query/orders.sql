@@ -4,6 +4,7 @@
 --   $1: search_term - Optional text to filter orders by ID or total price (NULL for no filter)
 --   $2: limit - Maximum number of records to return (pagination limit)
 --   $3: offset - Number of records to skip (pagination offset)
+--   $4: status - Optional order status to filter by (empty for every status)
 -- Returns:
 --   All order fields plus total_count of matching records
 -- Business Logic:
@@ -18,17 +19,23 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at,
     COUNT(*) OVER () AS total_count
 FROM orders
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND (
+        $4::TEXT = ''
+        OR status = $4
+    )
 ORDER BY created_at DESC
 LIMIT $2
 OFFSET
@@ -40,6 +47,7 @@ OFFSET
 --   $1: search_term - Optional filter text for order ID or price
 --   $2: limit - Pagination limit
 --   $3: offset - Pagination offset
+--   $4: status - Optional order status to filter by (empty for every status)
 -- Returns:
 --   Active order records with total_count
 -- Business Logic:
@@ -52,6 +60,7 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at,
     deleted_at,
@@ -59,11 +68,16 @@ SELECT
 FROM orders
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND (
+        $4::TEXT = ''
+        OR status = $4
+    )
 ORDER BY created_at DESC
 LIMIT $2
 OFFSET
@@ -75,6 +89,7 @@ OFFSET
 --   $1: search_term - Optional text to filter trashed orders
 --   $2: limit - Maximum records per page
 --   $3: offset - Records to skip
+--   $4: status - Optional order status to filter by (empty for every status)
 -- Returns:
 --   Trashed order records with total_count
 -- Business Logic:
@@ -89,6 +104,7 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at,
     deleted_at,
@@ -96,11 +112,16 @@ SELECT
 FROM orders
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND (
+        $4::TEXT = ''
+        OR status = $4
+    )
 ORDER BY created_at DESC
 LIMIT $2
 OFFSET
@@ -112,27 +133,38 @@ OFFSET
 --   $1: search_term - Optional text to filter orders
 --   $2: limit - Pagination limit
 --   $3: offset - Pagination offset
---   $4: merchant_id - Optional merchant UUID to filter by (NULL for all merchants)
+--   $4: merchant_id - Merchant whose orders are listed
+--   $5: status - Optional order status to filter by (empty for every status)
 -- Returns:
 --   Order records with total_count
 -- Business Logic:
 --   - Combines merchant filtering with search functionality
 --   - Maintains same sorting and pagination as other order queries
 --   - Useful for merchant-specific order dashboards
---   - NULL merchant_id parameter returns all merchants' orders
 -- name: GetOrdersByMerchant :many
-SELECT *, COUNT(*) OVER () AS total_count
+SELECT
+    order_id,
+    merchant_id,
+    cashier_id,
+    total_price,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    COUNT(*) OVER () AS total_count
 FROM orders
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND merchant_id = $4
     AND (
-        $4::UUID IS NULL
-        OR merchant_id = $4
+        $5::TEXT = ''
+        OR status = $5
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -170,6 +202,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -244,6 +277,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -303,6 +337,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -379,6 +414,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -439,6 +475,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -515,6 +552,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -574,6 +612,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND o.created_at BETWEEN (
                 SELECT start_date
                 FROM date_range
@@ -623,6 +662,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND EXTRACT(
                 YEAR
                 FROM o.created_at
@@ -683,6 +723,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND o.created_at BETWEEN (
                 SELECT start_date
                 FROM date_range
@@ -733,6 +774,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND EXTRACT(
                 YEAR
                 FROM o.created_at
@@ -786,6 +828,7 @@ RETURNING
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at;
 
@@ -804,12 +847,14 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at
 FROM orders
 WHERE
     order_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id);
 
 -- name: GetOrderByIDTrashed :one
 SELECT
@@ -819,11 +864,13 @@ SELECT
     total_price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    status
 FROM orders
 WHERE
     order_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- UpdateOrder: Modifies order information
 -- Purpose: Update order details (primarily total price)
@@ -844,14 +891,47 @@ SET
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     order_id,
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at;
 
+-- UpdateOrderStatus: Moves an order to its next lifecycle status
+-- Purpose: Apply a transition checked by the order state machine
+-- Parameters:
+--   $1: order_id - ID of the order
+--   $2: from_status - Status the transition was validated against
+--   $3: to_status - New status
+-- Returns: The updated order record
+-- Business Logic:
+--   - Only succeeds while the order is still in from_status, so two
+--     concurrent transitions cannot both apply
+--   - Only modifies active (non-deleted) orders
+-- name: UpdateOrderStatus :one
+UPDATE orders
+SET
+    status = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    order_id = $1
+    AND status = $2
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    order_id,
+    merchant_id,
+    cashier_id,
+    total_price,
+    created_at,
+    updated_at,
+    deleted_at,
+    status;
+
 -- TrashedOrder: Soft-deletes an order
 -- Purpose: Cancel/void an order without permanent deletion
 -- Parameters:
@@ -869,6 +949,7 @@ SET
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     order_id,
     merchant_id,
@@ -876,7 +957,8 @@ RETURNING
     total_price,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    status;
 
 -- RestoreOrder: Recovers a soft-deleted order
 -- Purpose: Reactivate a cancelled order
@@ -894,6 +976,7 @@ SET
 WHERE
     order_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     order_id,
     merchant_id,
@@ -901,7 +984,8 @@ RETURNING
     total_price,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    status;
 
 -- DeleteOrderPermanently: Hard-deletes an order
 -- Purpose: Completely remove order from database
@@ -909,11 +993,11 @@ RETURNING
 --   $1: order_id - UUID of order to delete
 -- Business Logic:
 --   - Permanent deletion of already cancelled orders
---   - No return value (exec-only operation)
+--   - Returns the number of deleted rows, 0 when no trashed record matched
 --   - Irreversible action - use with caution
 --   - Should trigger deletion of related order_items
--- name: DeleteOrderPermanently :exec
-DELETE FROM orders WHERE order_id = $1 AND deleted_at IS NOT NULL;
+-- name: DeleteOrderPermanently :execrows
+DELETE FROM orders WHERE order_id = $1 AND deleted_at IS NOT NULL AND in_merchant_scope(merchant_id);
 
 -- RestoreAllOrders: Mass restoration of cancelled orders
 -- Purpose: Recover all trashed orders at once
@@ -926,7 +1010,8 @@ UPDATE orders
 SET
     deleted_at = NULL
 WHERE
-    deleted_at IS NOT NULL;
+    deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- DeleteAllPermanentOrders: Purges all cancelled orders
 -- Purpose: Clean up all soft-deleted order records
@@ -936,4 +1021,4 @@ WHERE
 --   - Typically used during database maintenance
 --   - Should be restricted to admin users
 -- name: DeleteAllPermanentOrders :exec
-DELETE FROM orders WHERE deleted_at IS NOT NULL;
\ No newline at end of file
+DELETE FROM orders WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id);
\ No newline at end of file
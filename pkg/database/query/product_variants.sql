@@ -0,0 +1,219 @@
+-- GetProductOptions: Retrieves the variant options of a product
+-- Purpose: List the dimensions (e.g. size, colour) a product varies by
+-- Parameters:
+--   $1: product_id
+-- Returns:
+--   Product option records in display order
+-- name: GetProductOptions :many
+SELECT
+    product_option_id,
+    product_id,
+    name,
+    option_values,
+    position,
+    created_at,
+    updated_at
+FROM product_options
+WHERE
+    product_id = $1
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id))
+ORDER BY position ASC, product_option_id ASC;
+
+-- DeleteProductOptions: Removes every variant option of a product
+-- Purpose: Clear the options before they are replaced
+-- Parameters:
+--   $1: product_id
+-- name: DeleteProductOptions :exec
+DELETE FROM product_options WHERE
+    product_id = $1
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id));
+
+-- CreateProductOption: Adds a variant option to a product
+-- Purpose: Declare a dimension and the values its variants can take
+-- Parameters:
+--   $1: product_id
+--   $2: name - Option name (e.g. 'size')
+--   $3: option_values - Allowed values (e.g. {'S','M','L'})
+--   $4: position - Display order
+-- Returns:
+--   The created product option
+-- name: CreateProductOption :one
+INSERT INTO
+    product_options (
+        product_id,
+        name,
+        option_values,
+        position
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    product_option_id,
+    product_id,
+    name,
+    option_values,
+    position,
+    created_at,
+    updated_at;
+
+-- GetProductVariants: Retrieves the active variants of a product
+-- Purpose: List the sellable variants of a parent product
+-- Parameters:
+--   $1: product_id
+-- Returns:
+--   Variant records in the order they were created
+-- Business Logic:
+--   - Excludes deleted variants
+-- name: GetProductVariants :many
+SELECT
+    variant_id,
+    product_id,
+    sku,
+    barcode,
+    options,
+    price,
+    count_in_stock,
+    created_at,
+    updated_at,
+    deleted_at
+FROM product_variants
+WHERE
+    product_id = $1
+    AND deleted_at IS NULL
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id))
+ORDER BY variant_id ASC;
+
+-- GetProductVariant: Retrieves an active variant by ID
+-- Purpose: Fetch a single variant
+-- Parameters:
+--   $1: variant_id
+-- Returns:
+--   The variant record
+-- name: GetProductVariant :one
+SELECT
+    variant_id,
+    product_id,
+    sku,
+    barcode,
+    options,
+    price,
+    count_in_stock,
+    created_at,
+    updated_at,
+    deleted_at
+FROM product_variants
+WHERE
+    variant_id = $1
+    AND deleted_at IS NULL
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id));
+
+-- CountProductVariants: Counts the active variants of a product
+-- Purpose: Tell products sold through variants apart from plain products
+-- Parameters:
+--   $1: product_id
+-- Returns:
+--   Number of active variants
+-- name: CountProductVariants :one
+SELECT COUNT(*)
+FROM product_variants
+WHERE
+    product_id = $1
+    AND deleted_at IS NULL
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id));
+
+-- CreateProductVariant: Adds a variant to a product
+-- Purpose: Register a sellable option combination of a product
+-- Parameters:
+--   $1: product_id
+--   $2: sku - Stock keeping unit, unique across all variants
+--   $3: barcode (nullable)
+--   $4: options - Option name to value map
+--   $5: price - Price override (nullable to inherit the product price)
+-- Returns:
+--   The created variant
+-- Business Logic:
+--   - Variants start without stock; opening stock is recorded on the ledger
+-- name: CreateProductVariant :one
+INSERT INTO
+    product_variants (
+        product_id,
+        sku,
+        barcode,
+        options,
+        price
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    variant_id,
+    product_id,
+    sku,
+    barcode,
+    options,
+    price,
+    count_in_stock,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- UpdateProductVariant: Updates the identifiers and price of a variant
+-- Purpose: Correct a SKU or barcode, or change the price override
+-- Parameters:
+--   $1: variant_id
+--   $2: sku
+--   $3: barcode (nullable)
+--   $4: price - Price override (nullable to inherit the product price)
+-- Returns:
+--   The updated variant
+-- Business Logic:
+--   - Options and stock are not changed here; stock moves through the ledger
+-- name: UpdateProductVariant :one
+UPDATE product_variants
+SET
+    sku = $2,
+    barcode = $3,
+    price = $4,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    variant_id = $1
+    AND deleted_at IS NULL
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id))
+RETURNING
+    variant_id,
+    product_id,
+    sku,
+    barcode,
+    options,
+    price,
+    count_in_stock,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- TrashProductVariant: Soft-deletes a variant without stock
+-- Purpose: Stop selling a variant while keeping the order lines that reference it
+-- Parameters:
+--   $1: variant_id
+-- Returns:
+--   The trashed variant, or no row when it still holds stock
+-- Business Logic:
+--   - Only variants with no stock left can be removed, so the product total
+--     stays the sum of its live variants
+-- name: TrashProductVariant :one
+UPDATE product_variants
+SET
+    deleted_at = CURRENT_TIMESTAMP
+WHERE
+    variant_id = $1
+    AND deleted_at IS NULL
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id))
+    AND count_in_stock = 0
+RETURNING
+    variant_id,
+    product_id,
+    sku,
+    barcode,
+    options,
+    price,
+    count_in_stock,
+    created_at,
+    updated_at,
+    deleted_at;
@@ -8,7 +8,10 @@
 --   All product fields plus total_count of matching records
 -- Business Logic:
 --   - Excludes soft-deleted products (deleted_at IS NULL)
---   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+--   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+--     and the SKU or barcode of any variant
+--   - variant_count, min_price and max_price summarise the live variants; a product
+--     without variants reports its own price as both bounds
 --   - Orders results by newest first (created_at DESC)
 --   - Uses COUNT(*) OVER() to include total matching record count for pagination UI
 -- name: GetProducts :many
@@ -27,10 +30,24 @@ SELECT
     p.barcode,
     p.created_at,
     p.updated_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price,
     COUNT(*) OVER () AS total_count
 FROM products as p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id)
     AND (
         $1::TEXT IS NULL
         OR p.name ILIKE '%' || $1 || '%'
@@ -38,6 +55,17 @@ WHERE
         OR p.brand ILIKE '%' || $1 || '%'
         OR p.slug_product ILIKE '%' || $1 || '%'
         OR p.barcode ILIKE '%' || $1 || '%'
+        OR EXISTS (
+            SELECT 1
+            FROM product_variants v
+            WHERE
+                v.product_id = p.product_id
+                AND v.deleted_at IS NULL
+                AND (
+                    v.sku ILIKE '%' || $1 || '%'
+                    OR v.barcode ILIKE '%' || $1 || '%'
+                )
+        )
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -54,7 +82,10 @@ OFFSET
 --   All product fields plus total_count of matching records
 -- Business Logic:
 --   - Excludes soft-deleted products (deleted_at IS NULL)
---   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+--   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+--     and the SKU or barcode of any variant
+--   - variant_count, min_price and max_price summarise the live variants; a product
+--     without variants reports its own price as both bounds
 --   - Ordered by newest first (created_at DESC)
 --   - Useful if frontend/backend wants clearer distinction in naming
 -- name: GetProductsActive :many
@@ -74,10 +105,24 @@ SELECT
     p.created_at,
     p.updated_at,
     p.deleted_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price,
     COUNT(*) OVER () AS total_count
 FROM products as p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id)
     AND (
         $1::TEXT IS NULL
         OR p.name ILIKE '%' || $1 || '%'
@@ -85,6 +130,17 @@ WHERE
         OR p.brand ILIKE '%' || $1 || '%'
         OR p.slug_product ILIKE '%' || $1 || '%'
         OR p.barcode ILIKE '%' || $1 || '%'
+        OR EXISTS (
+            SELECT 1
+            FROM product_variants v
+            WHERE
+                v.product_id = p.product_id
+                AND v.deleted_at IS NULL
+                AND (
+                    v.sku ILIKE '%' || $1 || '%'
+                    OR v.barcode ILIKE '%' || $1 || '%'
+                )
+        )
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -101,7 +157,10 @@ OFFSET
 --   All product fields plus total_count of matching trashed records
 -- Business Logic:
 --   - Includes only soft-deleted products (deleted_at IS NOT NULL)
---   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+--   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+--     and the SKU or barcode of any variant
+--   - variant_count, min_price and max_price summarise the live variants; a product
+--     without variants reports its own price as both bounds
 --   - Returns by newest first (created_at DESC)
 --   - Used for "Trash Bin" UI or soft-delete management
 -- name: GetProductsTrashed :many
@@ -121,10 +180,24 @@ SELECT
     p.created_at,
     p.updated_at,
     p.deleted_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price,
     COUNT(*) OVER () AS total_count
 FROM products as p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(p.merchant_id)
     AND (
         $1::TEXT IS NULL
         OR p.name ILIKE '%' || $1 || '%'
@@ -132,6 +205,17 @@ WHERE
         OR p.brand ILIKE '%' || $1 || '%'
         OR p.slug_product ILIKE '%' || $1 || '%'
         OR p.barcode ILIKE '%' || $1 || '%'
+        OR EXISTS (
+            SELECT 1
+            FROM product_variants v
+            WHERE
+                v.product_id = p.product_id
+                AND v.deleted_at IS NULL
+                AND (
+                    v.sku ILIKE '%' || $1 || '%'
+                    OR v.barcode ILIKE '%' || $1 || '%'
+                )
+        )
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -153,9 +237,11 @@ OFFSET
 --   - total_count of all matching products for pagination UI
 -- Business Logic:
 --   - Excludes soft-deleted products (deleted_at IS NULL)
---   - Supports case-insensitive partial search on name and description
+--   - Supports case-insensitive partial search on name, description and variant SKU or barcode
 --   - Filters by category ID only if provided
---   - Filters by price range only if values provided (>= min_price and <= max_price)
+--   - Filters by price range only if values provided (>= min_price and <= max_price);
+--     a product with variants matches when any variant's effective price is in range
+--   - variant_count, min_price and max_price summarise the live variants
 --   - Ordered by newest products first (created_at DESC)
 -- name: GetProductsByMerchant :many
 WITH
@@ -175,15 +261,40 @@ WITH
             p.barcode,
             p.created_at,
             p.updated_at,
+            COALESCE(pv.variant_count, 0)::INT AS variant_count,
+            COALESCE(pv.min_price, p.price)::INT AS min_price,
+            COALESCE(pv.max_price, p.price)::INT AS max_price,
             c.name AS category_name
         FROM products p
             JOIN categories c ON p.category_id = c.category_id
+            LEFT JOIN LATERAL (
+                SELECT
+                    COUNT(*)::INT AS variant_count,
+                    MIN(COALESCE(v.price, p.price)) AS min_price,
+                    MAX(COALESCE(v.price, p.price)) AS max_price
+                FROM product_variants v
+                WHERE
+                    v.product_id = p.product_id
+                    AND v.deleted_at IS NULL
+            ) pv ON TRUE
         WHERE
             p.deleted_at IS NULL
             AND p.merchant_id = $1
+            AND in_merchant_scope(p.merchant_id)
             AND (
                 p.name ILIKE '%' || COALESCE($2, '') || '%'
                 OR p.description ILIKE '%' || COALESCE($2, '') || '%'
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND (
+                            v.sku ILIKE '%' || COALESCE($2, '') || '%'
+                            OR v.barcode ILIKE '%' || COALESCE($2, '') || '%'
+                        )
+                )
                 OR $2::TEXT IS NULL
             )
             AND (
@@ -191,8 +302,20 @@ WITH
                 OR NULLIF($3, 0) IS NULL
             )
             AND (
-                p.price >= COALESCE(NULLIF($4, 0), 0)
-                AND p.price <= COALESCE(NULLIF($5, 0), 999999999)
+                (
+                    COALESCE(pv.variant_count, 0) = 0
+                    AND p.price >= COALESCE(NULLIF($4, 0), 0)
+                    AND p.price <= COALESCE(NULLIF($5, 0), 999999999)
+                )
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND COALESCE(v.price, p.price) >= COALESCE(NULLIF($4, 0), 0)
+                        AND COALESCE(v.price, p.price) <= COALESCE(NULLIF($5, 0), 999999999)
+                )
             )
     )
 SELECT (
@@ -214,18 +337,34 @@ OFFSET
 --   $4: max_price - Maximum price filter (0 to ignore, defaults to very high value)
 --   $5: limit - Number of products to return (pagination)
 --   $6: offset - Number of products to skip (pagination)
+--   $7: include_subcategories - Also list products of every active descendant category
 -- Returns:
 --   - Filtered list of product fields including category name
 --   - total_count of all matching products for pagination UI
 -- Business Logic:
 --   - Excludes soft-deleted products (deleted_at IS NULL)
---   - Matches category name exactly
---   - Supports case-insensitive partial search on name and description
+--   - Matches category name exactly, optionally with the subtree below it
+--   - Supports case-insensitive partial search on name, description and variant SKU or barcode
 --   - Filters by category ID only if provided
---   - Filters by price range only if values provided
+--   - Filters by price range only if values provided; a product with variants
+--     matches when any variant's effective price is in range
+--   - variant_count, min_price and max_price summarise the live variants
 --   - Ordered by newest products first (created_at DESC)
 -- name: GetProductsByCategoryName :many
-WITH
+WITH RECURSIVE
+    category_tree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            name = $1
+        UNION
+        SELECT child.category_id
+        FROM categories child
+            JOIN category_tree t ON child.parent_id = t.category_id
+        WHERE
+            $7::boolean
+            AND child.deleted_at IS NULL
+    ),
     filtered_products AS (
         SELECT
             p.product_id,
@@ -243,20 +382,60 @@ WITH
             p.created_at,
             p.updated_at,
             p.deleted_at,
+            COALESCE(pv.variant_count, 0)::INT AS variant_count,
+            COALESCE(pv.min_price, p.price)::INT AS min_price,
+            COALESCE(pv.max_price, p.price)::INT AS max_price,
             c.name AS category_name
         FROM products p
             JOIN categories c ON p.category_id = c.category_id
+            LEFT JOIN LATERAL (
+                SELECT
+                    COUNT(*)::INT AS variant_count,
+                    MIN(COALESCE(v.price, p.price)) AS min_price,
+                    MAX(COALESCE(v.price, p.price)) AS max_price
+                FROM product_variants v
+                WHERE
+                    v.product_id = p.product_id
+                    AND v.deleted_at IS NULL
+            ) pv ON TRUE
         WHERE
             p.deleted_at IS NULL
-            AND c.name = $1
+            AND in_merchant_scope(p.merchant_id)
+            AND p.category_id IN (
+                SELECT category_id
+                FROM category_tree
+            )
             AND (
                 $2::TEXT IS NULL
                 OR p.name ILIKE '%' || $2 || '%'
                 OR p.description ILIKE '%' || $2 || '%'
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND (
+                            v.sku ILIKE '%' || $2 || '%'
+                            OR v.barcode ILIKE '%' || $2 || '%'
+                        )
+                )
             )
             AND (
-                p.price >= COALESCE(NULLIF($3::INTEGER, 0), 0)
-                AND p.price <= COALESCE(NULLIF($4::INTEGER, 0), 999999999)
+                (
+                    COALESCE(pv.variant_count, 0) = 0
+                    AND p.price >= COALESCE(NULLIF($3::INTEGER, 0), 0)
+                    AND p.price <= COALESCE(NULLIF($4::INTEGER, 0), 999999999)
+                )
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND COALESCE(v.price, p.price) >= COALESCE(NULLIF($3::INTEGER, 0), 0)
+                        AND COALESCE(v.price, p.price) <= COALESCE(NULLIF($4::INTEGER, 0), 999999999)
+                )
             )
     )
 SELECT (
@@ -336,11 +515,56 @@ RETURNING
 -- Purpose: Fetch product details for display/purchase
 -- Parameters:
 --   $1: product_id - ID of product to retrieve
--- Returns: Full product record if active
+-- Returns: Full product record if active, with its variant_count and the
+--   min_price and max_price of its live variants
 -- Business Logic:
 --   - Excludes deleted products
 --   - Used for product pages and checkout
 -- name: GetProductByID :one
+SELECT
+    p.product_id,
+    p.merchant_id,
+    p.category_id,
+    p.name,
+    p.description,
+    p.price,
+    p.count_in_stock,
+    p.brand,
+    p.weight,
+    p.slug_product,
+    p.image_product,
+    p.barcode,
+    p.created_at,
+    p.updated_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price
+FROM products p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
+WHERE
+    p.product_id = $1
+    AND p.deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id);
+
+-- GetProductByBarcode: Retrieves active product by barcode
+-- Purpose: Resolve a scanned barcode to a product
+-- Parameters:
+--   $1: barcode - Barcode printed on the product
+-- Returns: Full product details if found and active
+-- Business Logic:
+--   - Excludes deleted products
+--   - Barcodes are unique across every merchant, so the lookup is not limited
+--     to the caller's merchants
+-- name: GetProductByBarcode :one
 SELECT
     product_id,
     merchant_id,
@@ -358,9 +582,86 @@ SELECT
     updated_at
 FROM products
 WHERE
-    product_id = $1
+    barcode = $1
     AND deleted_at IS NULL;
 
+-- GetProductByScan: Resolves a scanned barcode to a product or one of its variants
+-- Purpose: Look up what a till scanner read, whichever level the barcode is on
+-- Parameters:
+--   $1: barcode - Barcode read by the scanner
+-- Returns:
+--   Product details, plus the variant columns when the barcode is a variant's
+-- Business Logic:
+--   - Excludes deleted products and variants
+--   - Variant columns are NULL when the barcode belongs to the product itself
+-- name: GetProductByScan :one
+SELECT
+    p.product_id,
+    p.merchant_id,
+    p.category_id,
+    p.name,
+    p.description,
+    p.price,
+    p.count_in_stock,
+    p.brand,
+    p.weight,
+    p.slug_product,
+    p.image_product,
+    p.barcode,
+    p.created_at,
+    p.updated_at,
+    v.variant_id,
+    v.sku AS variant_sku,
+    v.barcode AS variant_barcode,
+    v.options AS variant_options,
+    v.price AS variant_price,
+    v.count_in_stock AS variant_count_in_stock
+FROM products p
+    LEFT JOIN product_variants v ON v.product_id = p.product_id
+    AND v.barcode = $1
+    AND v.deleted_at IS NULL
+WHERE
+    p.deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id)
+    AND (
+        p.barcode = $1
+        OR v.variant_id IS NOT NULL
+    )
+LIMIT 1;
+
+-- GetProductsForImport: Finds the products an import row could update
+-- Purpose: Match an imported row to existing products by barcode or slug
+-- Parameters:
+--   $1: barcode - Barcode from the row (NULL to skip)
+--   $2: slug_product - Slug from the row (NULL to skip)
+-- Returns:
+--   Every product carrying the barcode or the slug, with its variant count
+-- Business Logic:
+--   - Includes deleted products, whose barcode and slug stay reserved
+--   - Returns two rows when barcode and slug belong to different products
+--   - Not limited to the caller's merchants, so a row cannot take over the
+--     barcode or slug of another merchant's product
+-- name: GetProductsForImport :many
+SELECT
+    p.product_id,
+    p.merchant_id,
+    p.barcode,
+    p.slug_product,
+    p.count_in_stock,
+    p.deleted_at,
+    (
+        SELECT COUNT(*)
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    )::int AS variant_count
+FROM products p
+WHERE
+    p.barcode = $1
+    OR p.slug_product = $2
+ORDER BY p.product_id;
+
 -- GetProductByIdTrashed: Retrieves product including deleted
 -- Purpose: View deleted products for restoration
 -- Parameters:
@@ -387,7 +688,8 @@ SELECT
     updated_at
 FROM products
 WHERE
-    product_id = $1;
+    product_id = $1
+    AND in_merchant_scope(merchant_id);
 
 -- UpdateProduct: Modifies product information
 -- Purpose: Update product details
@@ -397,16 +699,16 @@ WHERE
 --   $3: name - Updated name
 --   $4: description - Updated description
 --   $5: price - Updated price
---   $6: count_in_stock - Updated inventory count
---   $7: brand - Updated brand
---   $8: weight - Updated weight
---   $9: image_product - Updated image
---   $10: barcode - Updated barcode
+--   $6: brand - Updated brand
+--   $7: weight - Updated weight
+--   $8: image_product - Updated image
+--   $9: barcode - Updated barcode
 -- Returns: Updated product record
 -- Business Logic:
 --   - Auto-updates updated_at
 --   - Only modifies active products
 --   - Validates all fields
+--   - Leaves count_in_stock alone; stock changes go through stock_movements
 -- name: UpdateProduct :one
 UPDATE products
 SET
@@ -414,15 +716,15 @@ SET
     name = $3,
     description = $4,
     price = $5,
-    count_in_stock = $6,
-    brand = $7,
-    weight = $8,
-    image_product = $9,
-    barcode = $10,
+    brand = $6,
+    weight = $7,
+    image_product = $8,
+    barcode = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE
     product_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     product_id,
     merchant_id,
@@ -439,28 +741,6 @@ RETURNING
     created_at,
     updated_at;
 
--- UpdateProductCountStock: Updates inventory count
--- Purpose: Adjust product stock levels
--- Parameters:
---   $1: product_id - Product to update
---   $2: count_in_stock - New inventory count
--- Returns: Updated product record
--- Business Logic:
---   - Dedicated stock adjustment function
---   - Used when inventory changes
---   - Validates non-negative quantity
--- name: UpdateProductCountStock :one
-UPDATE products
-SET
-    count_in_stock = $2
-WHERE
-    product_id = $1
-    AND deleted_at IS NULL
-RETURNING
-    product_id,
-    price,
-    count_in_stock;
-
 -- TrashProduct: Soft-deletes a product
 -- Purpose: Remove product from active listings
 -- Parameters:
@@ -477,6 +757,7 @@ SET
 WHERE
     product_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     product_id,
     merchant_id,
@@ -492,7 +773,10 @@ RETURNING
     barcode,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at;
 
 -- RestoreProduct: Recovers a soft-deleted product
 -- Purpose: Reactivate a removed product
@@ -509,6 +793,7 @@ SET
 WHERE
     product_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     product_id,
     merchant_id,
@@ -524,7 +809,10 @@ RETURNING
     barcode,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at;
 
 -- DeleteProductPermanently: Hard-deletes a product
 -- Purpose: Completely remove product record
@@ -538,7 +826,8 @@ RETURNING
 DELETE FROM products
 WHERE
     product_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- RestoreAllProducts: Mass restoration of deleted products
 -- Purpose: Reactivate all trashed products
@@ -550,7 +839,8 @@ UPDATE products
 SET
     deleted_at = NULL
 WHERE
-    deleted_at IS NOT NULL;
+    deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- DeleteAllPermanentProducts: Purges all trashed products
 -- Purpose: Clean up deleted products
@@ -558,4 +848,146 @@ WHERE
 --   - Bulk permanent deletion
 --   - Database maintenance operation
 -- name: DeleteAllPermanentProducts :exec
-DELETE FROM products WHERE deleted_at IS NOT NULL;
\ No newline at end of file
+DELETE FROM products WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id);
+
+-- CountProductsByImage: Counts products referring to an image
+-- Purpose: Tell whether a stored image can be removed
+-- Parameters:
+--   $1: image_product - Storage key of the image
+-- Returns: Number of products, trashed ones included, using the image
+-- Business Logic:
+--   - Counts the products of every merchant, since identical uploads share an image
+-- name: CountProductsByImage :one
+SELECT COUNT(*)
+FROM products
+WHERE image_product = @image_product::text;
+
+-- GetTrashedProductImages: Lists the images of trashed products
+-- Purpose: Find images that may become unused once trashed products are purged
+-- Returns: Distinct image keys of trashed products
+-- name: GetTrashedProductImages :many
+SELECT DISTINCT image_product
+FROM products
+WHERE deleted_at IS NOT NULL
+  AND in_merchant_scope(merchant_id)
+  AND image_product IS NOT NULL;
+
+-- UpdateProductReorderLevel: Sets the low-stock threshold of a product
+-- Purpose: Configure when a product should be reordered and by how much
+-- Parameters:
+--   $1: product_id - Product to update
+--   $2: reorder_point - Stock level at or below which the product is low (0 disables alerts)
+--   $3: reorder_quantity - Suggested quantity to order when the product is low
+-- Returns: Reorder settings of the updated product
+-- Business Logic:
+--   - Clears any previous alert so the new threshold is evaluated afresh
+-- name: UpdateProductReorderLevel :one
+UPDATE products
+SET
+    reorder_point = $2,
+    reorder_quantity = $3,
+    low_stock_alerted_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    product_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    product_id,
+    merchant_id,
+    category_id,
+    name,
+    count_in_stock,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at;
+
+-- GetLowStockProducts: Lists the products of a merchant that need reordering
+-- Purpose: Show store managers what to reorder
+-- Parameters:
+--   $1: merchant_id - Merchant whose products are listed
+--   $2: Limit (number of records per page)
+--   $3: Offset (starting index for pagination)
+-- Returns:
+--   Reorder fields of each low product and total_count (for pagination support)
+-- Business Logic:
+--   - Only products with a reorder point are considered
+--   - Products furthest below their reorder point come first
+-- name: GetLowStockProducts :many
+SELECT
+    product_id,
+    merchant_id,
+    category_id,
+    name,
+    count_in_stock,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at,
+    COUNT(*) OVER () AS total_count
+FROM products
+WHERE
+    merchant_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+    AND reorder_point > 0
+    AND count_in_stock <= reorder_point
+ORDER BY (count_in_stock - reorder_point) ASC, product_id ASC
+LIMIT $2
+OFFSET
+    $3;
+
+-- ClaimLowStockAlerts: Marks newly low products as alerted
+-- Purpose: Pick the products a low-stock alert has to be sent for
+-- Parameters:
+--   $1: Maximum number of products to claim
+-- Returns:
+--   Reorder fields of the claimed products
+-- Business Logic:
+--   - A product is claimed once per dip below its reorder point
+--   - SKIP LOCKED lets several servers evaluate without alerting twice
+-- name: ClaimLowStockAlerts :many
+UPDATE products
+SET
+    low_stock_alerted_at = CURRENT_TIMESTAMP
+WHERE
+    product_id IN (
+        SELECT product_id
+        FROM products
+        WHERE
+            deleted_at IS NULL
+            AND reorder_point > 0
+            AND count_in_stock <= reorder_point
+            AND low_stock_alerted_at IS NULL
+        ORDER BY product_id
+        LIMIT $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING
+    product_id,
+    merchant_id,
+    name,
+    count_in_stock,
+    reorder_point,
+    reorder_quantity;
+
+-- ReleaseLowStockAlerts: Unmarks products whose alert could not be delivered
+-- Purpose: Retry the alert on the next evaluation
+-- Parameters:
+--   $1: product_ids - Products to unmark
+-- name: ReleaseLowStockAlerts :exec
+UPDATE products
+SET
+    low_stock_alerted_at = NULL
+WHERE
+    product_id = ANY($1::INT[]);
+
+-- ResetLowStockAlerts: Unmarks products that were restocked
+-- Purpose: Alert again the next time a restocked product runs low
+-- Returns: Number of products unmarked
+-- name: ResetLowStockAlerts :execrows
+UPDATE products
+SET
+    low_stock_alerted_at = NULL
+WHERE
+    low_stock_alerted_at IS NOT NULL
+    AND count_in_stock > reorder_point;
@@ -0,0 +1,271 @@
+-- GetPurchaseOrders: Retrieves purchase orders with optional filters and pagination
+-- Purpose: List the purchase orders of a merchant or supplier
+-- Parameters:
+--   $1: merchant_id - Merchant to filter by (0 for every merchant)
+--   $2: supplier_id - Supplier to filter by (0 for every supplier)
+--   $3: status - Status to filter by (empty for every status)
+--   $4: Limit (number of records per page)
+--   $5: Offset (starting index for pagination)
+-- Returns:
+--   Purchase order fields and total_count (for pagination support)
+-- Business Logic:
+--   - Returns newest purchase orders first
+-- name: GetPurchaseOrders :many
+SELECT
+    purchase_order_id,
+    merchant_id,
+    supplier_id,
+    status,
+    note,
+    created_by,
+    ordered_at,
+    received_at,
+    created_at,
+    updated_at,
+    COUNT(*) OVER () AS total_count
+FROM purchase_orders
+WHERE
+    in_merchant_scope(merchant_id)
+    AND (
+        $1::INT = 0
+        OR merchant_id = $1
+    )
+    AND (
+        $2::INT = 0
+        OR supplier_id = $2
+    )
+    AND (
+        $3::TEXT = ''
+        OR status = $3
+    )
+ORDER BY created_at DESC, purchase_order_id DESC
+LIMIT $4
+OFFSET
+    $5;
+
+-- GetPurchaseOrder: Retrieves a purchase order by ID
+-- Purpose: Fetch a single purchase order header
+-- Parameters:
+--   $1: purchase_order_id
+-- Returns:
+--   The purchase order record
+-- name: GetPurchaseOrder :one
+SELECT
+    purchase_order_id,
+    merchant_id,
+    supplier_id,
+    status,
+    note,
+    created_by,
+    ordered_at,
+    received_at,
+    created_at,
+    updated_at
+FROM purchase_orders
+WHERE
+    purchase_order_id = $1
+    AND in_merchant_scope(merchant_id);
+
+-- CreatePurchaseOrder: Opens a draft purchase order
+-- Purpose: Start ordering stock from a supplier
+-- Parameters:
+--   $1: merchant_id
+--   $2: supplier_id
+--   $3: note (nullable)
+--   $4: created_by - Acting user (nullable)
+-- Returns:
+--   The created purchase order in 'draft' status
+-- name: CreatePurchaseOrder :one
+INSERT INTO
+    purchase_orders (
+        merchant_id,
+        supplier_id,
+        note,
+        created_by
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    purchase_order_id,
+    merchant_id,
+    supplier_id,
+    status,
+    note,
+    created_by,
+    ordered_at,
+    received_at,
+    created_at,
+    updated_at;
+
+-- UpdatePurchaseOrderStatus: Moves a purchase order to its next status
+-- Purpose: Apply a transition checked by the purchase order state machine
+-- Parameters:
+--   $1: purchase_order_id
+--   $2: from_status - Status the transition was validated against
+--   $3: to_status - New status
+-- Returns:
+--   The updated purchase order record
+-- Business Logic:
+--   - Only succeeds while the purchase order is still in from_status, so two
+--     concurrent transitions cannot both apply
+--   - Stamps ordered_at when the order is placed and received_at when the
+--     last line is received
+-- name: UpdatePurchaseOrderStatus :one
+UPDATE purchase_orders
+SET
+    status = $3,
+    ordered_at = CASE
+        WHEN $3 = 'ordered' THEN CURRENT_TIMESTAMP
+        ELSE ordered_at
+    END,
+    received_at = CASE
+        WHEN $3 = 'received' THEN CURRENT_TIMESTAMP
+        ELSE received_at
+    END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    purchase_order_id = $1
+    AND status = $2
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    purchase_order_id,
+    merchant_id,
+    supplier_id,
+    status,
+    note,
+    created_by,
+    ordered_at,
+    received_at,
+    created_at,
+    updated_at;
+
+-- GetPurchaseOrderItems: Retrieves the lines of a purchase order
+-- Purpose: Show what was ordered and how much has arrived
+-- Parameters:
+--   $1: purchase_order_id
+-- Returns:
+--   Purchase order item records in the order they were added
+-- name: GetPurchaseOrderItems :many
+SELECT
+    purchase_order_item_id,
+    purchase_order_id,
+    product_id,
+    quantity_ordered,
+    quantity_received,
+    unit_cost,
+    created_at,
+    updated_at
+FROM purchase_order_items
+WHERE
+    purchase_order_id = $1
+    AND purchase_order_id IN (SELECT purchase_order_id FROM purchase_orders WHERE in_merchant_scope(merchant_id))
+ORDER BY purchase_order_item_id ASC;
+
+-- CreatePurchaseOrderItem: Adds a line to a draft purchase order
+-- Purpose: Record a product, quantity and agreed unit cost
+-- Parameters:
+--   $1: purchase_order_id
+--   $2: product_id
+--   $3: quantity_ordered
+--   $4: unit_cost
+-- Returns:
+--   The created purchase order item
+-- name: CreatePurchaseOrderItem :one
+INSERT INTO
+    purchase_order_items (
+        purchase_order_id,
+        product_id,
+        quantity_ordered,
+        unit_cost
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    purchase_order_item_id,
+    purchase_order_id,
+    product_id,
+    quantity_ordered,
+    quantity_received,
+    unit_cost,
+    created_at,
+    updated_at;
+
+-- ReceivePurchaseOrderItem: Adds received units to a purchase order line
+-- Purpose: Track how much of a line has arrived
+-- Parameters:
+--   $1: purchase_order_item_id
+--   $2: purchase_order_id - Purchase order the line must belong to
+--   $3: quantity - Units received
+-- Returns:
+--   The updated purchase order item, or no row when the line does not
+--   belong to the purchase order or more units would be received than ordered
+-- name: ReceivePurchaseOrderItem :one
+UPDATE purchase_order_items
+SET
+    quantity_received = quantity_received + $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    purchase_order_item_id = $1
+    AND purchase_order_id = $2
+    AND purchase_order_id IN (SELECT purchase_order_id FROM purchase_orders WHERE in_merchant_scope(merchant_id))
+    AND quantity_received + $3 <= quantity_ordered
+RETURNING
+    purchase_order_item_id,
+    purchase_order_id,
+    product_id,
+    quantity_ordered,
+    quantity_received,
+    unit_cost,
+    created_at,
+    updated_at;
+
+-- CreatePurchaseOrderReceipt: Records a receiving line
+-- Purpose: Keep who received how many units of a line and at what cost
+-- Parameters:
+--   $1: purchase_order_item_id
+--   $2: quantity
+--   $3: unit_cost - Cost actually paid per unit
+--   $4: received_by - Acting user (nullable)
+--   $5: note (nullable)
+-- Returns:
+--   The created receipt
+-- name: CreatePurchaseOrderReceipt :one
+INSERT INTO
+    purchase_order_receipts (
+        purchase_order_item_id,
+        quantity,
+        unit_cost,
+        received_by,
+        note
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    purchase_order_receipt_id,
+    purchase_order_item_id,
+    quantity,
+    unit_cost,
+    received_by,
+    note,
+    created_at;
+
+-- GetPurchaseOrderReceipts: Retrieves the receiving lines of a purchase order
+-- Purpose: Audit goods received against a purchase order
+-- Parameters:
+--   $1: purchase_order_id
+-- Returns:
+--   Receipt fields with the product received, oldest first
+-- name: GetPurchaseOrderReceipts :many
+SELECT
+    r.purchase_order_receipt_id,
+    r.purchase_order_item_id,
+    i.product_id,
+    r.quantity,
+    r.unit_cost,
+    r.received_by,
+    r.note,
+    r.created_at
+FROM
+    purchase_order_receipts r
+    JOIN purchase_order_items i ON i.purchase_order_item_id = r.purchase_order_item_id
+WHERE
+    i.purchase_order_id = $1
+    AND i.purchase_order_id IN (SELECT purchase_order_id FROM purchase_orders WHERE in_merchant_scope(merchant_id))
+ORDER BY r.purchase_order_receipt_id ASC;
@@ -4,27 +4,32 @@
 --   $1: user_id - ID of the user this token belongs to
 --   $2: token - The actual refresh token string
 --   $3: expiration - Expiration timestamp of the token
+--   $4: session_id - Session (token family) the token belongs to
+--   $5: access_token_id - jti of the access token issued with it
+--   $6: user_agent - User agent of the device that started the session
+--   $7: ip_address - Address of the device that started the session
+--   $8: session_started_at - When the session was started by a login
 -- Returns: The created refresh token record (excluding sensitive fields if any)
 -- Business Logic:
 --   - Sets both created_at and updated_at to current timestamp
 --   - Used in JWT refresh token rotation
 --   - Typically created during login/auth flows
 -- name: CreateRefreshToken :one
-INSERT INTO refresh_tokens (user_id, token, expiration, created_at, updated_at)
-VALUES ($1, $2, $3, current_timestamp, current_timestamp)
-RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at;
+INSERT INTO refresh_tokens (user_id, token, expiration, session_id, access_token_id, user_agent, ip_address, session_started_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, current_timestamp, current_timestamp)
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at;
 
 -- FindRefreshTokenByToken: Retrieves active refresh token by token string
 -- Purpose: Validate and lookup refresh token
 -- Parameters:
 --   $1: token - The refresh token string to find
--- Returns: The refresh token record if found and active
+-- Returns: The refresh token record if found and not deleted
 -- Business Logic:
 --   - Only returns non-deleted tokens
+--   - Also returns revoked tokens so that reuse can be detected
 --   - Used during token refresh operations
---   - Helps prevent token reuse
 -- name: FindRefreshTokenByToken :one
-SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at
+SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
 FROM refresh_tokens
 WHERE token = $1 AND deleted_at IS NULL;
 
@@ -46,7 +51,13 @@ SELECT
     expiration,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    session_id,
+    access_token_id,
+    user_agent,
+    ip_address,
+    session_started_at,
+    revoked_at
 FROM
     refresh_tokens
 WHERE
@@ -55,23 +66,6 @@ ORDER BY
     created_at DESC
 LIMIT 1;
 
--- UpdateRefreshTokenByUserId: Updates refresh token for a user
--- Purpose: Rotate/refresh token for a user
--- Parameters:
---   $1: user_id - ID of the user to update token for
---   $2: token - New token string
---   $3: expiration - New expiration timestamp
--- Business Logic:
---   - Updates token and expiration fields
---   - Sets updated_at to current time
---   - Only modifies active tokens
---   - Used during token rotation flows
--- name: UpdateRefreshTokenByUserId :one
-UPDATE refresh_tokens
-SET token = $2, expiration = $3, updated_at = current_timestamp
-WHERE user_id = $1 AND deleted_at IS NULL
-RETURNING *;
-
 -- DeleteRefreshToken: Permanently deletes a refresh token
 -- Purpose: Invalidate a specific refresh token
 -- Parameters:
@@ -95,3 +89,79 @@ WHERE token = $1;
 -- name: DeleteRefreshTokenByUserId :exec
 DELETE FROM refresh_tokens
 WHERE user_id = $1;
+
+-- FindRefreshTokenByAccessTokenId: Retrieves the refresh token issued with an access token
+-- Purpose: Resolve the session an access token belongs to
+-- Parameters:
+--   $1: user_id - ID of the user owning the token
+--   $2: access_token_id - jti of the access token
+-- Returns: The refresh token record if found and not deleted
+-- Business Logic:
+--   - Used by logout to find the caller's session
+-- name: FindRefreshTokenByAccessTokenId :one
+SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
+FROM refresh_tokens
+WHERE user_id = $1 AND access_token_id = $2 AND deleted_at IS NULL;
+
+-- FindActiveRefreshTokensByUserId: Lists the live refresh token of every session
+-- Purpose: List a user's active sessions
+-- Parameters:
+--   $1: user_id - ID of the user
+-- Returns: One record per active session, newest session first
+-- Business Logic:
+--   - Rotation revokes the previous token, so each session has one live token
+--   - Excludes revoked, deleted and expired tokens
+-- name: FindActiveRefreshTokensByUserId :many
+SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
+FROM refresh_tokens
+WHERE user_id = $1
+  AND revoked_at IS NULL
+  AND deleted_at IS NULL
+  AND expiration > current_timestamp
+ORDER BY session_started_at DESC, refresh_token_id DESC;
+
+-- RevokeRefreshToken: Revokes a single refresh token
+-- Purpose: Consume a refresh token during rotation
+-- Parameters:
+--   $1: refresh_token_id - ID of the token to revoke
+-- Returns: The revoked record, or no rows if it was already revoked
+-- Business Logic:
+--   - Only one concurrent rotation of the same token can succeed
+-- name: RevokeRefreshToken :one
+UPDATE refresh_tokens
+SET revoked_at = current_timestamp, updated_at = current_timestamp
+WHERE refresh_token_id = $1 AND revoked_at IS NULL AND deleted_at IS NULL
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at;
+
+-- RevokeRefreshTokenSession: Revokes every token of a session
+-- Purpose: Log out one device or contain a reused refresh token
+-- Parameters:
+--   $1: user_id - ID of the user owning the session
+--   $2: session_id - Session to revoke
+-- Returns: The tokens that were still live
+-- Business Logic:
+--   - Already revoked tokens keep their original revoked_at
+-- name: RevokeRefreshTokenSession :many
+UPDATE refresh_tokens
+SET revoked_at = current_timestamp, updated_at = current_timestamp
+WHERE user_id = $1 AND session_id = $2 AND revoked_at IS NULL AND deleted_at IS NULL
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at;
+
+-- RevokeRefreshTokensByUserId: Revokes every token of a user
+-- Purpose: Log out all devices
+-- Parameters:
+--   $1: user_id - ID of the user
+-- Returns: The tokens that were still live
+-- name: RevokeRefreshTokensByUserId :many
+UPDATE refresh_tokens
+SET revoked_at = current_timestamp, updated_at = current_timestamp
+WHERE user_id = $1 AND revoked_at IS NULL AND deleted_at IS NULL
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at;
+
+-- DeleteExpiredRefreshTokens: Permanently deletes expired refresh tokens
+-- Purpose: Keep the token table from growing with every rotation
+-- Business Logic:
+--   - Revoked tokens are kept until they expire so reuse is still detected
+-- name: DeleteExpiredRefreshTokens :execrows
+DELETE FROM refresh_tokens
+WHERE expiration < current_timestamp;
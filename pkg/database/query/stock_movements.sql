@@ -0,0 +1,162 @@
+-- RecordStockMovement: Applies a stock change and records it in the ledger
+-- Purpose: Keep count_in_stock as a projection of stock_movements
+-- Parameters:
+--   $1: product_id - Product whose stock changes
+--   $2: quantity_delta - Units added (positive) or removed (negative)
+--   $3: reason - Why the stock changed
+--   $4: reference_type - Kind of entity that caused the change (nullable)
+--   $5: reference_id - ID of that entity (nullable)
+--   $6: user_id - Acting user (nullable)
+--   $7: note - Free text (nullable)
+--   $8: variant_id - Variant of the product whose stock changes (nullable)
+-- Returns: The recorded movement, or no row when the product or variant does
+--   not exist, the change would take its stock below zero, or a product with
+--   variants is changed without naming one of them
+-- Business Logic:
+--   - Updates the stock and inserts the movement in one statement
+--   - A variant movement also moves the stock of its product, which holds the
+--     total of its variants
+--   - balance_after is the count_in_stock left by this movement on the
+--     variant when one is named, otherwise on the product
+-- name: RecordStockMovement :one
+WITH
+    variant AS (
+        UPDATE product_variants
+        SET
+            count_in_stock = count_in_stock + $2,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE
+            variant_id = $8
+            AND product_id = $1
+            AND deleted_at IS NULL
+            AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id))
+            AND count_in_stock + $2 >= 0
+        RETURNING
+            variant_id,
+            count_in_stock
+    ),
+    updated AS (
+        UPDATE products
+        SET
+            count_in_stock = count_in_stock + $2,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE
+            product_id = $1
+            AND deleted_at IS NULL
+            AND in_merchant_scope(merchant_id)
+            AND count_in_stock + $2 >= 0
+            AND (
+                EXISTS (
+                    SELECT 1
+                    FROM variant
+                )
+                OR (
+                    $8::INT IS NULL
+                    AND NOT EXISTS (
+                        SELECT 1
+                        FROM product_variants pv
+                        WHERE
+                            pv.product_id = $1
+                            AND pv.deleted_at IS NULL
+                    )
+                )
+            )
+        RETURNING
+            product_id,
+            count_in_stock
+    )
+INSERT INTO
+    stock_movements (
+        product_id,
+        variant_id,
+        reason,
+        quantity_delta,
+        balance_after,
+        reference_type,
+        reference_id,
+        user_id,
+        note
+    )
+SELECT u.product_id, v.variant_id, $3, $2, COALESCE(v.count_in_stock, u.count_in_stock), $4, $5, $6, $7
+FROM updated u
+    LEFT JOIN variant v ON TRUE
+RETURNING
+    stock_movement_id,
+    product_id,
+    reason,
+    quantity_delta,
+    balance_after,
+    reference_type,
+    reference_id,
+    user_id,
+    note,
+    created_at,
+    variant_id;
+
+-- CreateOpeningStockMovement: Opens the ledger of a new product
+-- Purpose: Record the stock a product was created with
+-- Parameters:
+--   $1: product_id - Newly created product
+--   $2: user_id - Acting user (nullable)
+-- Returns: The 'initial' movement holding the product's current stock
+-- name: CreateOpeningStockMovement :one
+INSERT INTO
+    stock_movements (
+        product_id,
+        reason,
+        quantity_delta,
+        balance_after,
+        reference_type,
+        reference_id,
+        user_id
+    )
+SELECT p.product_id, 'initial', p.count_in_stock, p.count_in_stock, 'product', p.product_id, $2
+FROM products p
+WHERE
+    p.product_id = $1
+    AND in_merchant_scope(p.merchant_id)
+RETURNING
+    stock_movement_id,
+    product_id,
+    reason,
+    quantity_delta,
+    balance_after,
+    reference_type,
+    reference_id,
+    user_id,
+    note,
+    created_at,
+    variant_id;
+
+-- GetStockMovementsByProduct: Pages through the stock history of a product
+-- Purpose: Explain how a product reached its current stock
+-- Parameters:
+--   $1: product_id - Product to inspect
+--   $2: limit - Page size
+--   $3: offset - Pagination offset
+-- Returns:
+--   Movements, newest first, with total_count for pagination
+-- Business Logic:
+--   - Includes the movements of every variant of the product
+-- name: GetStockMovementsByProduct :many
+SELECT
+    stock_movement_id,
+    product_id,
+    variant_id,
+    reason,
+    quantity_delta,
+    balance_after,
+    reference_type,
+    reference_id,
+    user_id,
+    note,
+    created_at,
+    COUNT(*) OVER () AS total_count
+FROM stock_movements
+WHERE
+    product_id = $1
+    AND product_id IN (SELECT product_id FROM products WHERE in_merchant_scope(merchant_id))
+ORDER BY stock_movement_id DESC
+LIMIT $2
+OFFSET
+    $3;
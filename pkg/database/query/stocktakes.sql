@@ -0,0 +1,361 @@
+-- GetStocktakes: Retrieves stocktakes with optional filters and pagination
+-- Purpose: List the stock count sessions of a merchant
+-- Parameters:
+--   $1: merchant_id - Merchant to filter by (0 for every merchant)
+--   $2: status - Status to filter by (empty for every status)
+--   $3: Limit (number of records per page)
+--   $4: Offset (starting index for pagination)
+-- Returns:
+--   Stocktake fields and total_count (for pagination support)
+-- Business Logic:
+--   - Returns newest stocktakes first
+-- name: GetStocktakes :many
+SELECT
+    stocktake_id,
+    merchant_id,
+    category_id,
+    status,
+    note,
+    created_by,
+    posted_by,
+    posted_at,
+    created_at,
+    updated_at,
+    COUNT(*) OVER () AS total_count
+FROM stocktakes
+WHERE
+    in_merchant_scope(merchant_id)
+    AND (
+        $1::INT = 0
+        OR merchant_id = $1
+    )
+    AND (
+        $2::TEXT = ''
+        OR status = $2
+    )
+ORDER BY created_at DESC, stocktake_id DESC
+LIMIT $3
+OFFSET
+    $4;
+
+-- GetStocktake: Retrieves a stocktake by ID
+-- Purpose: Fetch a single stocktake header
+-- Parameters:
+--   $1: stocktake_id
+-- Returns:
+--   The stocktake record
+-- name: GetStocktake :one
+SELECT
+    stocktake_id,
+    merchant_id,
+    category_id,
+    status,
+    note,
+    created_by,
+    posted_by,
+    posted_at,
+    created_at,
+    updated_at
+FROM stocktakes
+WHERE
+    stocktake_id = $1
+    AND in_merchant_scope(merchant_id);
+
+-- LockStocktakeForCount: Retrieves a stocktake and holds it open while counts are recorded
+-- Purpose: Keep a stocktake from being posted or cancelled halfway through a count
+-- Parameters:
+--   $1: stocktake_id
+-- Returns:
+--   The stocktake record
+-- Business Logic:
+--   - Takes a share lock, so concurrent counts proceed together while a
+--     status change waits for them to commit
+-- name: LockStocktakeForCount :one
+SELECT
+    stocktake_id,
+    merchant_id,
+    category_id,
+    status,
+    note,
+    created_by,
+    posted_by,
+    posted_at,
+    created_at,
+    updated_at
+FROM stocktakes
+WHERE
+    stocktake_id = $1
+    AND in_merchant_scope(merchant_id) FOR SHARE;
+
+-- CreateStocktake: Opens a stocktake for a merchant
+-- Purpose: Start a stock count session
+-- Parameters:
+--   $1: merchant_id
+--   $2: category_id - Category to count (nullable for every category)
+--   $3: note (nullable)
+--   $4: created_by - Acting user (nullable)
+-- Returns:
+--   The created stocktake in 'open' status
+-- Business Logic:
+--   - Fails while the merchant already has an open stocktake
+-- name: CreateStocktake :one
+INSERT INTO
+    stocktakes (
+        merchant_id,
+        category_id,
+        note,
+        created_by
+    )
+VALUES ($1, $2, $3, $4)
+RETURNING
+    stocktake_id,
+    merchant_id,
+    category_id,
+    status,
+    note,
+    created_by,
+    posted_by,
+    posted_at,
+    created_at,
+    updated_at;
+
+-- CreateStocktakeItems: Snapshots the products a stocktake counts
+-- Purpose: Record the expected quantity and price of every product in scope
+-- Parameters:
+--   $1: stocktake_id
+-- Returns:
+--   Number of products added to the stocktake
+-- Business Logic:
+--   - Takes the active products of the stocktake's merchant, limited to its
+--     category when it has one
+--   - Skips products sold through variants, whose stock is held per variant
+-- name: CreateStocktakeItems :execrows
+INSERT INTO
+    stocktake_items (
+        stocktake_id,
+        product_id,
+        expected_quantity,
+        unit_price
+    )
+SELECT s.stocktake_id, p.product_id, p.count_in_stock, p.price
+FROM stocktakes s
+    JOIN products p ON p.merchant_id = s.merchant_id
+    AND p.deleted_at IS NULL
+    AND (
+        s.category_id IS NULL
+        OR p.category_id = s.category_id
+    )
+WHERE
+    s.stocktake_id = $1
+    AND in_merchant_scope(s.merchant_id)
+    AND NOT EXISTS (
+        SELECT 1
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    );
+
+-- GetStocktakeItems: Retrieves the lines of a stocktake with their variance
+-- Purpose: Review what was counted against what was expected
+-- Parameters:
+--   $1: stocktake_id
+--   $2: Limit (number of records per page)
+--   $3: Offset (starting index for pagination)
+-- Returns:
+--   Stocktake item fields with product details, variance, variance_value
+--   and total_count (for pagination support)
+-- Business Logic:
+--   - Uncounted lines have no variance
+--   - Variance value is the variance priced at the snapshot unit price
+--   - Largest shortages come first
+-- name: GetStocktakeItems :many
+SELECT
+    si.stocktake_item_id,
+    si.stocktake_id,
+    si.product_id,
+    p.name,
+    p.barcode,
+    p.category_id,
+    si.expected_quantity,
+    si.counted_quantity,
+    si.unit_price,
+    COALESCE(
+        si.counted_quantity - si.expected_quantity,
+        0
+    )::INT AS variance,
+    COALESCE(
+        (
+            si.counted_quantity - si.expected_quantity
+        )::BIGINT * si.unit_price,
+        0
+    )::BIGINT AS variance_value,
+    si.counted_by,
+    si.counted_at,
+    COUNT(*) OVER () AS total_count
+FROM stocktake_items si
+    JOIN products p ON p.product_id = si.product_id
+WHERE
+    si.stocktake_id = $1
+    AND in_merchant_scope(p.merchant_id)
+ORDER BY variance_value ASC, si.stocktake_item_id ASC
+LIMIT $2
+OFFSET
+    $3;
+
+-- GetCountedStocktakeItems: Retrieves the counted lines of a stocktake
+-- Purpose: Collect the adjustments to post
+-- Parameters:
+--   $1: stocktake_id
+-- Returns:
+--   Stocktake item records that have a counted quantity
+-- name: GetCountedStocktakeItems :many
+SELECT
+    stocktake_item_id,
+    stocktake_id,
+    product_id,
+    expected_quantity,
+    counted_quantity,
+    unit_price,
+    counted_by,
+    counted_at,
+    created_at,
+    updated_at
+FROM stocktake_items
+WHERE
+    stocktake_id = $1
+    AND stocktake_id IN (SELECT stocktake_id FROM stocktakes WHERE in_merchant_scope(merchant_id))
+    AND counted_quantity IS NOT NULL
+ORDER BY stocktake_item_id ASC;
+
+-- UpdateStocktakeItemCount: Records the counted quantity of a product
+-- Purpose: Store a count submitted during a stocktake
+-- Parameters:
+--   $1: stocktake_id
+--   $2: product_id
+--   $3: counted_quantity
+--   $4: counted_by - Acting user (nullable)
+-- Returns:
+--   The updated stocktake item, or no row when the product is not part of
+--   the stocktake
+-- Business Logic:
+--   - A recount replaces the previous count
+--   - The expected quantity is re-taken from current stock so sales made
+--     while the store is being counted are not reported as variance
+-- name: UpdateStocktakeItemCount :one
+UPDATE stocktake_items si
+SET
+    counted_quantity = $3,
+    expected_quantity = p.count_in_stock,
+    counted_by = $4,
+    counted_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+FROM products p
+WHERE
+    p.product_id = si.product_id
+    AND si.stocktake_id = $1
+    AND si.product_id = $2
+    AND in_merchant_scope(p.merchant_id)
+RETURNING
+    si.stocktake_item_id,
+    si.stocktake_id,
+    si.product_id,
+    si.expected_quantity,
+    si.counted_quantity,
+    si.unit_price,
+    si.counted_by,
+    si.counted_at,
+    si.created_at,
+    si.updated_at;
+
+-- UpdateStocktakeStatus: Moves a stocktake to its next status
+-- Purpose: Apply a transition checked by the stocktake state machine
+-- Parameters:
+--   $1: stocktake_id
+--   $2: from_status - Status the transition was validated against
+--   $3: to_status - New status
+--   $4: posted_by - Acting user (nullable)
+-- Returns:
+--   The updated stocktake record
+-- Business Logic:
+--   - Only succeeds while the stocktake is still in from_status, so two
+--     concurrent transitions cannot both apply
+--   - Stamps posted_at and posted_by when the stocktake is posted
+-- name: UpdateStocktakeStatus :one
+UPDATE stocktakes
+SET
+    status = $3,
+    posted_by = CASE
+        WHEN $3 = 'posted' THEN $4
+        ELSE posted_by
+    END,
+    posted_at = CASE
+        WHEN $3 = 'posted' THEN CURRENT_TIMESTAMP
+        ELSE posted_at
+    END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    stocktake_id = $1
+    AND status = $2
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    stocktake_id,
+    merchant_id,
+    category_id,
+    status,
+    note,
+    created_by,
+    posted_by,
+    posted_at,
+    created_at,
+    updated_at;
+
+-- GetStocktakeVarianceByCategory: Summarises the variance of a stocktake by category
+-- Purpose: Report where stock was lost or found and what it was worth
+-- Parameters:
+--   $1: stocktake_id
+-- Returns:
+--   Per category: lines, counted lines, expected and counted units of the
+--   counted lines, variance in units and variance_value
+-- Business Logic:
+--   - Only counted lines contribute to quantities and variance
+--   - Categories with the largest loss in value come first
+-- name: GetStocktakeVarianceByCategory :many
+SELECT
+    c.category_id,
+    c.name AS category_name,
+    COUNT(*)::INT AS item_count,
+    COUNT(si.counted_quantity)::INT AS counted_count,
+    COALESCE(
+        SUM(si.expected_quantity) FILTER (
+            WHERE
+                si.counted_quantity IS NOT NULL
+        ),
+        0
+    )::INT AS expected_quantity,
+    COALESCE(SUM(si.counted_quantity), 0)::INT AS counted_quantity,
+    COALESCE(
+        SUM(
+            si.counted_quantity - si.expected_quantity
+        ),
+        0
+    )::INT AS variance_quantity,
+    COALESCE(
+        SUM(
+            (
+                si.counted_quantity - si.expected_quantity
+            )::BIGINT * si.unit_price
+        ),
+        0
+    )::BIGINT AS variance_value
+FROM
+    stocktake_items si
+    JOIN products p ON p.product_id = si.product_id
+    JOIN categories c ON c.category_id = p.category_id
+WHERE
+    si.stocktake_id = $1
+    AND in_merchant_scope(p.merchant_id)
+GROUP BY
+    c.category_id,
+    c.name
+ORDER BY variance_value ASC, c.category_id ASC;
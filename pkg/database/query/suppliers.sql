@@ -0,0 +1,203 @@
+-- GetSuppliers: Retrieves active suppliers with optional merchant filter, name search and pagination
+-- Purpose: List the suppliers a merchant buys stock from
+-- Parameters:
+--   $1: Search query (supplier name, nullable)
+--   $2: merchant_id - Merchant to filter by (0 for every merchant)
+--   $3: Limit (number of records per page)
+--   $4: Offset (starting index for pagination)
+-- Returns:
+--   Supplier fields and total_count (for pagination support)
+-- Business Logic:
+--   - Excludes soft-deleted suppliers
+--   - Supports fuzzy search on name
+-- name: GetSuppliers :many
+SELECT
+    supplier_id,
+    merchant_id,
+    name,
+    contact_name,
+    email,
+    phone,
+    address,
+    created_at,
+    updated_at,
+    COUNT(*) OVER () AS total_count
+FROM suppliers
+WHERE
+    deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+    AND (
+        $1::TEXT IS NULL
+        OR name ILIKE '%' || $1 || '%'
+    )
+    AND (
+        $2::INT = 0
+        OR merchant_id = $2
+    )
+ORDER BY created_at ASC
+LIMIT $3
+OFFSET
+    $4;
+
+-- GetSupplier: Retrieves an active supplier by ID
+-- Purpose: Fetch a single supplier
+-- Parameters:
+--   $1: supplier_id
+-- Returns:
+--   The supplier record
+-- name: GetSupplier :one
+SELECT
+    supplier_id,
+    merchant_id,
+    name,
+    contact_name,
+    email,
+    phone,
+    address,
+    created_at,
+    updated_at,
+    deleted_at
+FROM suppliers
+WHERE
+    supplier_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id);
+
+-- CreateSupplier: Registers a supplier for a merchant
+-- Purpose: Add a supplier purchase orders can be raised against
+-- Parameters:
+--   $1: merchant_id
+--   $2: name
+--   $3: contact_name (nullable)
+--   $4: email (nullable)
+--   $5: phone (nullable)
+--   $6: address (nullable)
+-- Returns:
+--   The created supplier record
+-- name: CreateSupplier :one
+INSERT INTO
+    suppliers (
+        merchant_id,
+        name,
+        contact_name,
+        email,
+        phone,
+        address
+    )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING
+    supplier_id,
+    merchant_id,
+    name,
+    contact_name,
+    email,
+    phone,
+    address,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- UpdateSupplier: Updates the contact details of a supplier
+-- Purpose: Keep supplier details current
+-- Parameters:
+--   $1: supplier_id
+--   $2: name
+--   $3: contact_name (nullable)
+--   $4: email (nullable)
+--   $5: phone (nullable)
+--   $6: address (nullable)
+-- Returns:
+--   The updated supplier record
+-- Business Logic:
+--   - The owning merchant cannot change
+--   - Only modifies active (non-deleted) suppliers
+-- name: UpdateSupplier :one
+UPDATE suppliers
+SET
+    name = $2,
+    contact_name = $3,
+    email = $4,
+    phone = $5,
+    address = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    supplier_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    supplier_id,
+    merchant_id,
+    name,
+    contact_name,
+    email,
+    phone,
+    address,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- TrashSupplier: Soft-deletes a supplier
+-- Purpose: Stop raising purchase orders against a supplier while keeping its history
+-- Parameters:
+--   $1: supplier_id
+-- Returns:
+--   The trashed supplier record
+-- name: TrashSupplier :one
+UPDATE suppliers
+SET
+    deleted_at = CURRENT_TIMESTAMP
+WHERE
+    supplier_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    supplier_id,
+    merchant_id,
+    name,
+    contact_name,
+    email,
+    phone,
+    address,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- RestoreSupplier: Restores a soft-deleted supplier
+-- Purpose: Undo a supplier trash
+-- Parameters:
+--   $1: supplier_id
+-- Returns:
+--   The restored supplier record
+-- name: RestoreSupplier :one
+UPDATE suppliers
+SET
+    deleted_at = NULL
+WHERE
+    supplier_id = $1
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    supplier_id,
+    merchant_id,
+    name,
+    contact_name,
+    email,
+    phone,
+    address,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- DeleteSupplierPermanently: Hard-deletes a trashed supplier
+-- Purpose: Remove a supplier that has no purchase orders
+-- Parameters:
+--   $1: supplier_id
+-- Business Logic:
+--   - Only deletes suppliers that are already trashed
+--   - Fails while purchase orders still reference the supplier
+-- name: DeleteSupplierPermanently :exec
+DELETE FROM suppliers
+WHERE
+    supplier_id = $1
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
@@ -0,0 +1,247 @@
+-- GetTaxRates: Retrieves active tax rates with optional name search and pagination
+-- Purpose: List tax rates for the admin panel
+-- Parameters:
+--   $1: Search query (tax rate name, nullable)
+--   $2: Limit (number of records per page)
+--   $3: Offset (starting index for pagination)
+-- Returns:
+--   Tax rate fields and total_count (for pagination support)
+-- Business Logic:
+--   - Excludes soft-deleted tax rates
+--   - Supports fuzzy search on name
+-- name: GetTaxRates :many
+SELECT
+    tax_rate_id,
+    name,
+    merchant_id,
+    category_id,
+    rate_bps,
+    is_inclusive,
+    rounding,
+    created_at,
+    updated_at,
+    COUNT(*) OVER () AS total_count
+FROM tax_rates
+WHERE
+    deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
+    AND (
+        $1::TEXT IS NULL
+        OR name ILIKE '%' || $1 || '%'
+    )
+ORDER BY created_at ASC
+LIMIT $2
+OFFSET
+    $3;
+
+-- GetTaxRate: Retrieves an active tax rate by ID
+-- Purpose: Fetch a single tax rate
+-- Parameters:
+--   $1: tax_rate_id
+-- Returns:
+--   The tax rate record
+-- name: GetTaxRate :one
+SELECT
+    tax_rate_id,
+    name,
+    merchant_id,
+    category_id,
+    rate_bps,
+    is_inclusive,
+    rounding,
+    created_at,
+    updated_at,
+    deleted_at
+FROM tax_rates
+WHERE
+    tax_rate_id = $1
+    AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id));
+
+-- GetApplicableTaxRate: Resolves the tax rate for a product sold by a merchant
+-- Purpose: Pick the rate used when pricing an order line
+-- Parameters:
+--   $1: merchant_id
+--   $2: category_id
+-- Returns:
+--   The most specific active tax rate
+-- Business Logic:
+--   - A rate scoped to both merchant and category wins
+--   - Then a merchant-wide rate, then a category-wide rate
+--   - Falls back to the global rate (no merchant, no category)
+-- name: GetApplicableTaxRate :one
+SELECT
+    tax_rate_id,
+    name,
+    merchant_id,
+    category_id,
+    rate_bps,
+    is_inclusive,
+    rounding,
+    created_at,
+    updated_at,
+    deleted_at
+FROM tax_rates
+WHERE
+    deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
+    AND (
+        merchant_id = $1::integer
+        OR merchant_id IS NULL
+    )
+    AND (
+        category_id = $2::integer
+        OR category_id IS NULL
+    )
+ORDER BY (merchant_id IS NOT NULL) DESC, (category_id IS NOT NULL) DESC
+LIMIT 1;
+
+-- CreateTaxRate: Creates a new tax rate
+-- Purpose: Register a rate for a merchant, a category, both or neither
+-- Parameters:
+--   $1: name
+--   $2: merchant_id (nullable)
+--   $3: category_id (nullable)
+--   $4: rate_bps - Rate in basis points (1100 = 11%)
+--   $5: is_inclusive - Whether product prices already include the tax
+--   $6: rounding - Integer rounding rule
+-- Returns:
+--   The created tax rate
+-- name: CreateTaxRate :one
+INSERT INTO
+    tax_rates (
+        name,
+        merchant_id,
+        category_id,
+        rate_bps,
+        is_inclusive,
+        rounding,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP
+    )
+RETURNING
+    tax_rate_id,
+    name,
+    merchant_id,
+    category_id,
+    rate_bps,
+    is_inclusive,
+    rounding,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- UpdateTaxRate: Updates an active tax rate
+-- Purpose: Change a rate without a redeploy
+-- Parameters:
+--   $1: tax_rate_id
+--   $2: name
+--   $3: merchant_id (nullable)
+--   $4: category_id (nullable)
+--   $5: rate_bps
+--   $6: is_inclusive
+--   $7: rounding
+-- Returns:
+--   The updated tax rate
+-- Business Logic:
+--   - Already priced order lines keep the rate they were charged
+-- name: UpdateTaxRate :one
+UPDATE tax_rates
+SET
+    name = $2,
+    merchant_id = $3,
+    category_id = $4,
+    rate_bps = $5,
+    is_inclusive = $6,
+    rounding = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    tax_rate_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    tax_rate_id,
+    name,
+    merchant_id,
+    category_id,
+    rate_bps,
+    is_inclusive,
+    rounding,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- TrashTaxRate: Soft-deletes a tax rate
+-- Purpose: Stop applying a rate while keeping it for audit
+-- Parameters:
+--   $1: tax_rate_id
+-- Returns:
+--   The soft-deleted tax rate
+-- name: TrashTaxRate :one
+UPDATE tax_rates
+SET
+    deleted_at = CURRENT_TIMESTAMP
+WHERE
+    tax_rate_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    tax_rate_id,
+    name,
+    merchant_id,
+    category_id,
+    rate_bps,
+    is_inclusive,
+    rounding,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- RestoreTaxRate: Restores a soft-deleted tax rate
+-- Purpose: Re-enable a previously trashed rate
+-- Parameters:
+--   $1: tax_rate_id
+-- Returns:
+--   The restored tax rate
+-- name: RestoreTaxRate :one
+UPDATE tax_rates
+SET
+    deleted_at = NULL
+WHERE
+    tax_rate_id = $1
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    tax_rate_id,
+    name,
+    merchant_id,
+    category_id,
+    rate_bps,
+    is_inclusive,
+    rounding,
+    created_at,
+    updated_at,
+    deleted_at;
+
+-- DeleteTaxRatePermanently: Permanently deletes a trashed tax rate
+-- Purpose: Remove a rate that was never used
+-- Parameters:
+--   $1: tax_rate_id
+-- Business Logic:
+--   - Only deletes rates that are already soft-deleted
+-- name: DeleteTaxRatePermanently :exec
+DELETE FROM tax_rates
+WHERE
+    tax_rate_id = $1
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
@@ -0,0 +1,60 @@
+-- CreateTransactionPayment: Records one tender line of a transaction
+-- Purpose: Store how part of an order was paid
+-- Parameters:
+--   $1: transaction_id
+--   $2: payment_method
+--   $3: amount - Amount tendered with this method
+--   $4: change_amount - Change handed back from this tender (cash only)
+--   $5: reference - Card approval code, e-wallet reference, etc. (nullable)
+-- Returns:
+--   The created tender line
+-- name: CreateTransactionPayment :one
+INSERT INTO
+    transaction_payments (
+        transaction_id,
+        payment_method,
+        amount,
+        change_amount,
+        reference
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    transaction_payment_id,
+    transaction_id,
+    payment_method,
+    amount,
+    change_amount,
+    reference,
+    created_at,
+    updated_at;
+
+-- GetTransactionPayments: Retrieves the tender lines of a transaction
+-- Purpose: Show how a transaction was paid
+-- Parameters:
+--   $1: transaction_id
+-- Returns:
+--   Tender lines in the order they were recorded
+-- name: GetTransactionPayments :many
+SELECT
+    transaction_payment_id,
+    transaction_id,
+    payment_method,
+    amount,
+    change_amount,
+    reference,
+    created_at,
+    updated_at
+FROM transaction_payments
+WHERE
+    transaction_id = $1
+    AND transaction_id IN (SELECT transaction_id FROM transactions WHERE in_merchant_scope(merchant_id))
+ORDER BY transaction_payment_id ASC;
+
+-- DeleteTransactionPayments: Removes every tender line of a transaction
+-- Purpose: Replace the tenders of a transaction that is being updated
+-- Parameters:
+--   $1: transaction_id
+-- name: DeleteTransactionPayments :exec
+DELETE FROM transaction_payments WHERE
+    transaction_id = $1
+    AND transaction_id IN (SELECT transaction_id FROM transactions WHERE in_merchant_scope(merchant_id));
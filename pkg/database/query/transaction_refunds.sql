@@ -0,0 +1,107 @@
+-- CreateTransactionRefund: Records a refund or void of a transaction
+-- Purpose: Keep an auditable reversal linked to the original sale
+-- Parameters:
+--   $1: transaction_id
+--   $2: kind - 'refund' or 'void'
+--   $3: amount - Gross amount handed back
+--   $4: tax_amount - Tax contained in amount
+--   $5: reason_code
+--   $6: note (nullable)
+--   $7: approved_by - User who approved the reversal
+--   $8: restock - Whether the returned items went back to stock
+-- Returns:
+--   The created reversal
+-- name: CreateTransactionRefund :one
+INSERT INTO
+    transaction_refunds (
+        transaction_id,
+        kind,
+        amount,
+        tax_amount,
+        reason_code,
+        note,
+        approved_by,
+        restock
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+    transaction_refund_id,
+    transaction_id,
+    kind,
+    amount,
+    tax_amount,
+    reason_code,
+    note,
+    approved_by,
+    restock,
+    created_at,
+    updated_at;
+
+-- CreateTransactionRefundItem: Records how much of an order line a reversal covers
+-- Parameters:
+--   $1: transaction_refund_id
+--   $2: order_item_id
+--   $3: quantity - Units reversed
+--   $4: amount - Gross amount of those units
+--   $5: tax_amount - Tax contained in amount
+-- Returns:
+--   The created reversal line
+-- name: CreateTransactionRefundItem :one
+INSERT INTO
+    transaction_refund_items (
+        transaction_refund_id,
+        order_item_id,
+        quantity,
+        amount,
+        tax_amount
+    )
+VALUES ($1, $2, $3, $4, $5)
+RETURNING
+    transaction_refund_item_id,
+    transaction_refund_id,
+    order_item_id,
+    quantity,
+    amount,
+    tax_amount,
+    created_at;
+
+-- GetTransactionRefunds: Retrieves the refunds and voids of a transaction
+-- Parameters:
+--   $1: transaction_id
+-- Returns:
+--   Reversals in the order they were recorded
+-- name: GetTransactionRefunds :many
+SELECT
+    transaction_refund_id,
+    transaction_id,
+    kind,
+    amount,
+    tax_amount,
+    reason_code,
+    note,
+    approved_by,
+    restock,
+    created_at,
+    updated_at
+FROM transaction_refunds
+WHERE
+    transaction_id = $1
+    AND transaction_id IN (SELECT transaction_id FROM transactions WHERE in_merchant_scope(merchant_id))
+ORDER BY transaction_refund_id ASC;
+
+-- GetRefundedQuantities: Sums the units already reversed per order line
+-- Purpose: Prevent refunding more than was sold
+-- Parameters:
+--   $1: transaction_id
+-- Returns:
+--   order_item_id and the total quantity reversed so far
+-- name: GetRefundedQuantities :many
+SELECT ri.order_item_id, SUM(ri.quantity)::INT AS quantity
+FROM
+    transaction_refund_items ri
+    JOIN transaction_refunds r ON r.transaction_refund_id = ri.transaction_refund_id
+WHERE
+    r.transaction_id = $1
+    AND r.transaction_id IN (SELECT transaction_id FROM transactions WHERE in_merchant_scope(merchant_id))
+GROUP BY
+    ri.order_item_id;
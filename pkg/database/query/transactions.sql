@@ -27,6 +27,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -65,6 +66,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -105,6 +107,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -144,6 +147,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -169,9 +173,10 @@ OFFSET
 --   year: Year as text
 --   month: 3-letter month abbreviation (e.g. 'Jan')
 --   total_success: Count of successful transactions
---   total_amount: Sum of successful transaction amounts
+--   total_amount: Sum of successful transaction amounts, net of refunds and voids
+--   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 -- Business Logic:
---   - Only includes successful (payment_status = 'success') transactions
+--   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 --   - Excludes deleted transactions
 --   - Compares two customizable time periods
 --   - Includes gap-filling for months with no transactions
@@ -189,12 +194,12 @@ WITH
                 MONTH
                 FROM t.created_at
             )::integer AS month,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND (
                 (
                     t.created_at >= $1::timestamp
@@ -223,7 +228,8 @@ WITH
                 'Mon'
             ) AS month,
             total_success,
-            total_amount
+            total_amount,
+            total_tax
         FROM monthly_data
         UNION ALL
         SELECT
@@ -233,7 +239,8 @@ WITH
             )::text AS year,
             TO_CHAR($1::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -256,7 +263,8 @@ WITH
             )::text AS year,
             TO_CHAR($3::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -283,10 +291,11 @@ ORDER BY year DESC, TO_DATE(month, 'Mon') DESC;
 -- Returns:
 --   year: Year as text
 --   total_success: Count of successful transactions
---   total_amount: Sum of successful transaction amounts
+--   total_amount: Sum of successful transaction amounts, net of refunds and voids
+--   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 -- Business Logic:
 --   - Compares current year with previous year automatically
---   - Only includes successful (payment_status = 'success') transactions
+--   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 --   - Excludes deleted transactions
 --   - Includes gap-filling for years with no transactions
 --   - Returns 0 values for years with no successful transactions
@@ -299,12 +308,12 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -325,13 +334,15 @@ WITH
         SELECT
             year::text,
             total_success::integer,
-            total_amount::integer
+            total_amount::integer,
+            total_tax::integer
         FROM yearly_data
         UNION ALL
         SELECT
             $1::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -342,7 +353,8 @@ WITH
         UNION ALL
         SELECT ($1::integer - 1)::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -391,6 +403,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND (
                 (
@@ -501,6 +514,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND (
                 EXTRACT(
@@ -558,8 +572,8 @@ ORDER BY year DESC;
 -- Returns:
 --   month: 3-letter month abbreviation (e.g. 'Jan')
 --   payment_method: The payment method used
---   total_transactions: Count of successful transactions
---   total_amount: Total amount processed by this method
+--   total_transactions: Count of successful tender lines
+--   total_amount: Total amount processed by this method, net of cash change
 -- name: GetMonthlyTransactionMethodsSuccess :many
 WITH
     date_ranges AS (
@@ -571,10 +585,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -609,20 +626,22 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -641,8 +660,8 @@ ORDER BY ac.activity_month, ac.payment_method;
 -- Returns:
 --   month: 3-letter month abbreviation (e.g. 'Jan')
 --   payment_method: The payment method used
---   total_transactions: Count of failed transactions
---   total_amount: Total amount that failed processing
+--   total_transactions: Count of failed tender lines
+--   total_amount: Total amount that failed processing, net of cash change
 -- name: GetMonthlyTransactionMethodsFailed :many
 WITH
     date_ranges AS (
@@ -654,10 +673,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -692,20 +714,22 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -724,8 +748,8 @@ ORDER BY ac.activity_month, ac.payment_method;
 -- Returns:
 --   year: 4-digit year as text
 --   payment_method: The payment method used
---   total_transactions: Count of successful transactions
---   total_amount: Total amount processed by this method
+--   total_transactions: Count of successful tender lines
+--   total_amount: Total amount processed by this method, net of cash change
 -- name: GetYearlyTransactionMethodsSuccess :many
 WITH
     year_range AS (
@@ -739,10 +763,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_years AS (
         SELECT generate_series(
@@ -766,12 +793,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::text AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
             AND EXTRACT(
                 YEAR
@@ -788,7 +817,7 @@ WITH
                 YEAR
                 FROM t.created_at
             ),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     ac.year,
@@ -807,8 +836,8 @@ ORDER BY ac.year, ac.payment_method;
 -- Returns:
 --   year: 4-digit year as text
 --   payment_method: The payment method used
---   total_transactions: Count of failed transactions
---   total_amount: Total amount that failed processing
+--   total_transactions: Count of failed tender lines
+--   total_amount: Total amount that failed processing, net of cash change
 -- name: GetYearlyTransactionMethodsFailed :many
 WITH
     year_range AS (
@@ -822,10 +851,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_years AS (
         SELECT generate_series(
@@ -849,12 +881,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::text AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND EXTRACT(
                 YEAR
@@ -871,7 +905,7 @@ WITH
                 YEAR
                 FROM t.created_at
             ),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     ac.year,
@@ -896,9 +930,10 @@ ORDER BY ac.year, ac.payment_method;
 --   year: Year as text
 --   month: 3-letter month abbreviation (e.g. 'Jan')
 --   total_success: Count of successful transactions
---   total_amount: Sum of successful transaction amounts
+--   total_amount: Sum of successful transaction amounts, net of refunds and voids
+--   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 -- Business Logic:
---   - Only includes successful (payment_status = 'success') transactions
+--   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 --   - Excludes deleted transactions
 --   - Compares two customizable time periods
 --   - Includes gap-filling for months with no transactions
@@ -916,12 +951,12 @@ WITH
                 MONTH
                 FROM t.created_at
             )::integer AS month,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND t.merchant_id = $5
             AND (
                 (
@@ -951,7 +986,8 @@ WITH
                 'Mon'
             ) AS month,
             total_success,
-            total_amount
+            total_amount,
+            total_tax
         FROM monthly_data
         UNION ALL
         SELECT
@@ -961,7 +997,8 @@ WITH
             )::text AS year,
             TO_CHAR($1::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -984,7 +1021,8 @@ WITH
             )::text AS year,
             TO_CHAR($3::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -1012,10 +1050,11 @@ ORDER BY year DESC, TO_DATE(month, 'Mon') DESC;
 -- Returns:
 --   year: Year as text
 --   total_success: Count of successful transactions
---   total_amount: Sum of successful transaction amounts
+--   total_amount: Sum of successful transaction amounts, net of refunds and voids
+--   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 -- Business Logic:
 --   - Compares current year with previous year automatically
---   - Only includes successful (payment_status = 'success') transactions
+--   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 --   - Excludes deleted transactions
 --   - Includes gap-filling for years with no transactions
 --   - Returns 0 values for years with no successful transactions
@@ -1028,12 +1067,12 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND t.merchant_id = $2
             AND (
                 EXTRACT(
@@ -1055,13 +1094,15 @@ WITH
         SELECT
             year::text,
             total_success::integer,
-            total_amount::integer
+            total_amount::integer,
+            total_tax::integer
         FROM yearly_data
         UNION ALL
         SELECT
             $1::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -1072,7 +1113,8 @@ WITH
         UNION ALL
         SELECT ($1::integer - 1)::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -1122,6 +1164,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $5
             AND (
@@ -1235,6 +1278,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $2
             AND (
@@ -1295,8 +1339,8 @@ ORDER BY year DESC;
 --   merchant_id: The merchant identifier
 --   merchant_name: The merchant's name
 --   payment_method: The payment method used
---   total_transactions: Count of successful transactions
---   total_amount: Total amount processed by this method
+--   total_transactions: Count of successful tender lines
+--   total_amount: Total amount processed by this method, net of cash change
 -- name: GetMonthlyTransactionMethodsByMerchantSuccess :many
 WITH
     date_ranges AS (
@@ -1308,10 +1352,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -1346,21 +1393,23 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
             AND t.merchant_id = $5
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -1381,8 +1430,8 @@ ORDER BY ac.activity_month, ac.payment_method;
 --   merchant_id: The merchant identifier
 --   merchant_name: The merchant's name
 --   payment_method: The payment method used
---   total_transactions: Count of failed transactions
---   total_amount: Total amount that failed processing
+--   total_transactions: Count of failed tender lines
+--   total_amount: Total amount that failed processing, net of cash change
 -- name: GetMonthlyTransactionMethodsByMerchantFailed :many
 WITH
     date_ranges AS (
@@ -1394,10 +1443,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -1432,21 +1484,23 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $5
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -1467,8 +1521,8 @@ ORDER BY ac.activity_month, ac.payment_method;
 --   merchant_id: The merchant identifier
 --   merchant_name: The merchant's name
 --   payment_method: The payment method used
---   total_transactions: Count of successful transactions
---   total_amount: Total amount processed by this method
+--   total_transactions: Count of successful tender lines
+--   total_amount: Total amount processed by this method, net of cash change
 -- name: GetYearlyTransactionMethodsByMerchantSuccess :many
 WITH
     year_series AS (
@@ -1488,12 +1542,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            SUM(t.amount)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            SUM(tp.amount - tp.change_amount)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
             AND t.merchant_id = $2
             AND EXTRACT(
@@ -1510,14 +1566,17 @@ WITH
             )
         GROUP BY
             year,
-            t.payment_method
+            tp.payment_method
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     )
 SELECT
     ys.year::text AS year,
@@ -1539,8 +1598,8 @@ ORDER BY ys.year, pm.payment_method;
 --   merchant_id: The merchant identifier
 --   merchant_name: The merchant's name
 --   payment_method: The payment method used
---   total_transactions: Count of failed transactions
---   total_amount: Total amount that failed processing
+--   total_transactions: Count of failed tender lines
+--   total_amount: Total amount that failed processing, net of cash change
 -- name: GetYearlyTransactionMethodsByMerchantFailed :many
 WITH
     year_series AS (
@@ -1560,12 +1619,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            SUM(t.amount)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            SUM(tp.amount - tp.change_amount)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $2
             AND EXTRACT(
@@ -1582,14 +1643,17 @@ WITH
             )
         GROUP BY
             year,
-            t.payment_method
+            tp.payment_method
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     )
 SELECT
     ys.year::text AS year,
@@ -1626,7 +1690,8 @@ SELECT
 FROM transactions
 WHERE
     order_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id);
 
 -- GetTransactionByID: Retrieves transaction by transaction ID
 -- Purpose: Fetch specific transaction details
@@ -1646,12 +1711,43 @@ SELECT
     amount,
     change_amount,
     payment_status,
+    subtotal_amount,
+    tax_amount,
+    created_at,
+    updated_at
+FROM transactions
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id);
+
+-- GetTransactionForUpdate: Retrieves a transaction and locks its row
+-- Purpose: Serialize refunds and voids of the same transaction
+-- Parameters:
+--   $1: transaction_id - The unique transaction ID
+-- Returns: Full transaction record if active
+-- Business Logic:
+--   - Excludes deleted transactions
+--   - Holds the row lock until the surrounding database transaction ends
+-- name: GetTransactionForUpdate :one
+SELECT
+    transaction_id,
+    order_id,
+    merchant_id,
+    payment_method,
+    amount,
+    change_amount,
+    payment_status,
+    subtotal_amount,
+    tax_amount,
     created_at,
     updated_at
 FROM transactions
 WHERE
     transaction_id = $1
-    AND deleted_at IS NULL;
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+FOR UPDATE;
 
 -- CreateTransaction: Creates a new transaction record
 -- Purpose: Record a new payment transaction
@@ -1662,6 +1758,8 @@ WHERE
 --   $4: change_amount - Change amount (if applicable)
 --   $5: payment_status - Payment status ('success', 'failed', 'pending')
 --   $6: order_id - Associated order reference
+--   $7: subtotal_amount - Order total excluding tax
+--   $8: tax_amount - Tax charged on the order
 -- Returns: Newly created transaction record
 -- Business Logic:
 --   - Sets created_at and updated_at timestamps
@@ -1677,6 +1775,8 @@ INSERT INTO
         change_amount,
         payment_status,
         order_id,
+        subtotal_amount,
+        tax_amount,
         created_at,
         updated_at,
         deleted_at
@@ -1688,6 +1788,8 @@ VALUES (
         $4,
         $5,
         $6,
+        $7,
+        $8,
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP,
         NULL
@@ -1700,6 +1802,8 @@ RETURNING
     amount,
     change_amount,
     payment_status,
+    subtotal_amount,
+    tax_amount,
     created_at,
     updated_at;
 
@@ -1713,6 +1817,8 @@ RETURNING
 --   $5: change_amount - Updated change amount
 --   $6: payment_status - Updated payment status
 --   $7: order_id - Updated order reference
+--   $8: subtotal_amount - Updated order total excluding tax
+--   $9: tax_amount - Updated tax amount
 -- Returns: Updated transaction record
 -- Business Logic:
 --   - Auto-updates updated_at timestamp
@@ -1728,10 +1834,13 @@ SET
     change_amount = $5,
     payment_status = $6,
     order_id = $7,
+    subtotal_amount = $8,
+    tax_amount = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     transaction_id,
     order_id,
@@ -1740,9 +1849,43 @@ RETURNING
     amount,
     change_amount,
     payment_status,
+    subtotal_amount,
+    tax_amount,
     created_at,
     updated_at;
 
+-- UpdateTransactionStatus: Changes the payment status of a transaction
+-- Purpose: Record that a transaction was refunded or voided
+-- Parameters:
+--   $1: transaction_id - ID of transaction to update
+--   $2: payment_status - New payment status
+-- Returns: The updated transaction record
+-- Business Logic:
+--   - Only processes active transactions
+--   - Leaves amounts untouched; reversals are recorded in transaction_refunds
+-- name: UpdateTransactionStatus :one
+UPDATE transactions
+SET
+    payment_status = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    transaction_id,
+    order_id,
+    merchant_id,
+    payment_method,
+    amount,
+    change_amount,
+    payment_status,
+    created_at,
+    updated_at,
+    deleted_at,
+    subtotal_amount,
+    tax_amount;
+
 -- TrashTransaction: Soft-deletes a transaction
 -- Purpose: Void/cancel a transaction without permanent deletion
 -- Parameters:
@@ -1760,6 +1903,7 @@ SET
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     transaction_id,
     order_id,
@@ -1770,7 +1914,9 @@ RETURNING
     payment_status,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    subtotal_amount,
+    tax_amount;
 
 -- RestoreTransaction: Recovers a soft-deleted transaction
 -- Purpose: Reactivate a cancelled transaction
@@ -1788,6 +1934,7 @@ SET
 WHERE
     transaction_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     transaction_id,
     order_id,
@@ -1798,7 +1945,9 @@ RETURNING
     payment_status,
     created_at,
     updated_at,
-    deleted_at;
+    deleted_at,
+    subtotal_amount,
+    tax_amount;
 
 -- DeleteTransactionPermanently: Hard-deletes a transaction
 -- Purpose: Completely remove transaction from database
@@ -1806,14 +1955,15 @@ RETURNING
 --   $1: transaction_id - ID of transaction to delete
 -- Business Logic:
 --   - Permanent deletion of already cancelled transactions
---   - No return value (exec-only operation)
+--   - Returns the number of deleted rows, 0 when no trashed record matched
 --   - Irreversible action - use with caution
 --   - Should be restricted to admin users
--- name: DeleteTransactionPermanently :exec
+-- name: DeleteTransactionPermanently :execrows
 DELETE FROM transactions
 WHERE
     transaction_id = $1
-    AND deleted_at IS NOT NULL;
+    AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- RestoreAllTransactions: Mass restoration of cancelled transactions
 -- Purpose: Recover all trashed transactions at once
@@ -1826,7 +1976,8 @@ UPDATE transactions
 SET
     deleted_at = NULL
 WHERE
-    deleted_at IS NOT NULL;
+    deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id);
 
 -- DeleteAllPermanentTransactions: Purges all cancelled transactions
 -- Purpose: Clean up all soft-deleted transaction records
@@ -1836,4 +1987,4 @@ WHERE
 --   - Typically used during database maintenance
 --   - Should be restricted to admin users
 -- name: DeleteAllPermanentTransactions :exec
-DELETE FROM transactions WHERE deleted_at IS NOT NULL;
\ No newline at end of file
+DELETE FROM transactions WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id);
\ No newline at end of file
@@ -67,7 +67,7 @@ func (q *Queries) CreateCashier(ctx context.Context, arg CreateCashierParams) (*
 }
 
 const deleteAllPermanentCashiers = `-- name: DeleteAllPermanentCashiers :exec
-DELETE FROM cashiers WHERE deleted_at IS NOT NULL
+DELETE FROM cashiers WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id)
 `
 
 // DeleteAllPermanentCashiers: Purges all trashed cashiers
@@ -81,11 +81,12 @@ func (q *Queries) DeleteAllPermanentCashiers(ctx context.Context) error {
 	return err
 }
 
-const deleteCashierPermanently = `-- name: DeleteCashierPermanently :exec
+const deleteCashierPermanently = `-- name: DeleteCashierPermanently :execrows
 DELETE FROM cashiers
 WHERE
     cashier_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // DeleteCashierPermanently: Hard-deletes a cashier
@@ -96,11 +97,14 @@ WHERE
 //
 // Business Logic:
 //   - Permanent deletion of already soft-deleted records
-//   - No return value (exec-only)
+//   - Returns the number of deleted rows, 0 when no trashed record matched
 //   - Use with caution - irreversible operation
-func (q *Queries) DeleteCashierPermanently(ctx context.Context, cashierID int32) error {
-	_, err := q.db.Exec(ctx, deleteCashierPermanently, cashierID)
-	return err
+func (q *Queries) DeleteCashierPermanently(ctx context.Context, cashierID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCashierPermanently, cashierID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getCashierById = `-- name: GetCashierById :one
@@ -115,6 +119,7 @@ FROM cashiers
 WHERE
     cashier_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 type GetCashierByIdRow struct {
@@ -162,6 +167,7 @@ SELECT
 FROM cashiers
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -247,6 +253,7 @@ SELECT
 FROM cashiers
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -335,6 +342,7 @@ FROM cashiers
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $2::TEXT IS NULL
         OR name ILIKE '%' || $2 || '%'
@@ -421,6 +429,7 @@ SELECT
 FROM cashiers
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -512,6 +521,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND o.created_at BETWEEN (
                 SELECT start_date
                 FROM date_range
@@ -600,6 +610,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.cashier_id = $2
             AND o.created_at BETWEEN (
                 SELECT start_date
@@ -695,6 +706,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.merchant_id = $2
             AND o.created_at BETWEEN (
                 SELECT start_date
@@ -788,6 +800,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -917,6 +930,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -1046,6 +1060,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -1173,6 +1188,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND EXTRACT(
                 YEAR
                 FROM o.created_at
@@ -1274,6 +1290,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.cashier_id = $2
             AND EXTRACT(
                 YEAR
@@ -1382,6 +1399,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND c.merchant_id = $2
             AND EXTRACT(
                 YEAR
@@ -1485,6 +1503,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -1571,6 +1590,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -1657,6 +1677,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND c.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -1730,6 +1751,7 @@ SET
     deleted_at = NULL
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // RestoreAllCashiers: Mass restoration of deleted cashiers
@@ -1750,6 +1772,7 @@ SET
 WHERE
     cashier_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     cashier_id,
     merchant_id,
@@ -1793,6 +1816,7 @@ SET
 WHERE
     cashier_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     cashier_id,
     merchant_id,
@@ -1837,6 +1861,7 @@ SET
 WHERE
     cashier_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     cashier_id,
     merchant_id,
@@ -12,19 +12,103 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countActiveChildCategories = `-- name: CountActiveChildCategories :one
+SELECT COUNT(*)
+FROM categories
+WHERE
+    parent_id = $1::int
+    AND deleted_at IS NULL
+`
+
+// CountActiveChildCategories: Counts the active children of a category
+// Purpose: Prevent trashing a category that still has active subcategories
+// Parameters:
+//
+//	$1: Parent category ID
+//
+// Returns:
+//
+//	Number of active child categories
+func (q *Queries) CountActiveChildCategories(ctx context.Context, parentID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveChildCategories, parentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCategorySlugConflicts = `-- name: CountCategorySlugConflicts :one
+SELECT COUNT(*)
+FROM categories
+WHERE
+    slug_category = $1
+    AND category_id IS DISTINCT FROM $2::int
+    AND (
+        merchant_id IS NULL
+        OR merchant_id IS NOT DISTINCT FROM $3::int
+    )
+`
+
+type CountCategorySlugConflictsParams struct {
+	SlugCategory *string `json:"slug_category"`
+	CategoryID   *int32  `json:"category_id"`
+	MerchantID   *int32  `json:"merchant_id"`
+}
+
+// CountCategorySlugConflicts: Counts categories whose slug a category would clash with
+// Purpose: Keep slugs unique within the global scope and across a merchant's view
+// Parameters:
+//
+//	$1: Slug to check
+//	$2: Category ID to ignore (NULL when creating)
+//	$3: Owning merchant ID (NULL for a global category)
+//
+// Returns:
+//
+//	Number of conflicting categories, trashed ones included
+//
+// Business Logic:
+//   - A global category clashes with other global categories
+//   - A merchant category clashes with global categories and the merchant's own
+func (q *Queries) CountCategorySlugConflicts(ctx context.Context, arg CountCategorySlugConflictsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCategorySlugConflicts, arg.SlugCategory, arg.CategoryID, arg.MerchantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCategory = `-- name: CreateCategory :one
 INSERT INTO
     categories (
         name,
         description,
-        slug_category
+        slug_category,
+        parent_id,
+        merchant_id,
+        position
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        (
+            SELECT COALESCE(MAX(s.position) + 1, 0)
+            FROM categories s
+            WHERE
+                s.parent_id IS NOT DISTINCT FROM $4
+                AND s.merchant_id IS NOT DISTINCT FROM $5
+                AND s.deleted_at IS NULL
+        )
     )
-VALUES ($1, $2, $3)
 RETURNING
     category_id,
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at
 `
@@ -33,6 +117,8 @@ type CreateCategoryParams struct {
 	Name         string  `json:"name"`
 	Description  *string `json:"description"`
 	SlugCategory *string `json:"slug_category"`
+	ParentID     *int32  `json:"parent_id"`
+	MerchantID   *int32  `json:"merchant_id"`
 }
 
 type CreateCategoryRow struct {
@@ -40,6 +126,9 @@ type CreateCategoryRow struct {
 	Name         string           `json:"name"`
 	Description  *string          `json:"description"`
 	SlugCategory *string          `json:"slug_category"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 }
@@ -51,22 +140,34 @@ type CreateCategoryRow struct {
 //	$1: Category name
 //	$2: Category description
 //	$3: Slug for category (URL-friendly identifier)
+//	$4: Parent category ID (NULL for a root category)
+//	$5: Owning merchant ID (NULL for a global category)
 //
 // Returns:
 //
 //	Full category record including generated ID
 //
 // Business Logic:
-//   - Assumes unique slug for identification in URLs
+//   - Assumes unique slug within the global or merchant scope
+//   - Appends the category after its existing siblings
 //   - Automatically populates timestamps via default DB behavior (if configured)
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (*CreateCategoryRow, error) {
-	row := q.db.QueryRow(ctx, createCategory, arg.Name, arg.Description, arg.SlugCategory)
+	row := q.db.QueryRow(ctx, createCategory,
+		arg.Name,
+		arg.Description,
+		arg.SlugCategory,
+		arg.ParentID,
+		arg.MerchantID,
+	)
 	var i CreateCategoryRow
 	err := row.Scan(
 		&i.CategoryID,
 		&i.Name,
 		&i.Description,
 		&i.SlugCategory,
+		&i.ParentID,
+		&i.MerchantID,
+		&i.Position,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -74,7 +175,7 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 }
 
 const deleteAllPermanentCategories = `-- name: DeleteAllPermanentCategories :exec
-DELETE FROM categories WHERE deleted_at IS NOT NULL
+DELETE FROM categories WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id)
 `
 
 // DeleteAllPermanentCategories: Permanently deletes all trashed categories
@@ -93,6 +194,7 @@ DELETE FROM categories
 WHERE
     category_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // DeleteCategoryPermanently: Removes a soft-deleted category permanently
@@ -118,12 +220,16 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at,
     COUNT(*) OVER () AS total_count
 FROM categories
 WHERE
     deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -146,6 +252,9 @@ type GetCategoriesRow struct {
 	Name         string           `json:"name"`
 	Description  *string          `json:"description"`
 	SlugCategory *string          `json:"slug_category"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	TotalCount   int64            `json:"total_count"`
@@ -182,6 +291,9 @@ func (q *Queries) GetCategories(ctx context.Context, arg GetCategoriesParams) ([
 			&i.Name,
 			&i.Description,
 			&i.SlugCategory,
+			&i.ParentID,
+			&i.MerchantID,
+			&i.Position,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.TotalCount,
@@ -202,6 +314,9 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at,
     deleted_at,
@@ -209,6 +324,7 @@ SELECT
 FROM categories
 WHERE
     deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -231,6 +347,9 @@ type GetCategoriesActiveRow struct {
 	Name         string           `json:"name"`
 	Description  *string          `json:"description"`
 	SlugCategory *string          `json:"slug_category"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
@@ -268,6 +387,9 @@ func (q *Queries) GetCategoriesActive(ctx context.Context, arg GetCategoriesActi
 			&i.Name,
 			&i.Description,
 			&i.SlugCategory,
+			&i.ParentID,
+			&i.MerchantID,
+			&i.Position,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -289,6 +411,9 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at,
     deleted_at,
@@ -296,6 +421,7 @@ SELECT
 FROM categories
 WHERE
     deleted_at IS NOT NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -318,6 +444,9 @@ type GetCategoriesTrashedRow struct {
 	Name         string           `json:"name"`
 	Description  *string          `json:"description"`
 	SlugCategory *string          `json:"slug_category"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
@@ -355,6 +484,9 @@ func (q *Queries) GetCategoriesTrashed(ctx context.Context, arg GetCategoriesTra
 			&i.Name,
 			&i.Description,
 			&i.SlugCategory,
+			&i.ParentID,
+			&i.MerchantID,
+			&i.Position,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -376,12 +508,16 @@ SELECT
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at
 FROM categories
 WHERE
     category_id = $1
     AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
 `
 
 type GetCategoryByIDRow struct {
@@ -389,6 +525,9 @@ type GetCategoryByIDRow struct {
 	Name         string           `json:"name"`
 	Description  *string          `json:"description"`
 	SlugCategory *string          `json:"slug_category"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 }
@@ -413,6 +552,9 @@ func (q *Queries) GetCategoryByID(ctx context.Context, categoryID int32) (*GetCa
 		&i.Name,
 		&i.Description,
 		&i.SlugCategory,
+		&i.ParentID,
+		&i.MerchantID,
+		&i.Position,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -431,6 +573,7 @@ FROM categories
 WHERE
     name = $1
     AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
 `
 
 type GetCategoryByNameRow struct {
@@ -481,6 +624,7 @@ WHERE
     name = $1
     AND category_id = $2
     AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
 `
 
 type GetCategoryByNameAndIdParams struct {
@@ -523,8 +667,292 @@ func (q *Queries) GetCategoryByNameAndId(ctx context.Context, arg GetCategoryByN
 	return &i, err
 }
 
+const getCategoryByNameOrSlug = `-- name: GetCategoryByNameOrSlug :one
+SELECT
+    category_id,
+    name,
+    description,
+    slug_category,
+    created_at,
+    updated_at
+FROM categories
+WHERE (
+        name = $1
+        OR slug_category = $1
+    )
+    AND (
+        merchant_id IS NULL
+        OR merchant_id = $2
+    )
+    AND deleted_at IS NULL
+    AND (merchant_id IS NULL OR in_merchant_scope(merchant_id))
+ORDER BY slug_category = $1 DESC, merchant_id IS NULL
+LIMIT 1
+`
+
+type GetCategoryByNameOrSlugParams struct {
+	Name       string `json:"name"`
+	MerchantID *int32 `json:"merchant_id"`
+}
+
+type GetCategoryByNameOrSlugRow struct {
+	CategoryID   int32            `json:"category_id"`
+	Name         string           `json:"name"`
+	Description  *string          `json:"description"`
+	SlugCategory *string          `json:"slug_category"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+// GetCategoryByNameOrSlug: Fetches a single category by its name or slug
+// Purpose: Resolve the category column of a product import
+// Parameters:
+//
+//	$1: Category name or slug
+//	$2: Merchant ID whose own categories are visible besides global ones
+//
+// Returns:
+//
+//	Full category record if found and not deleted
+//
+// Business Logic:
+//   - Excludes soft-deleted categories
+//   - Only considers global categories and those of the merchant
+//   - Prefers a slug match over a name match, then the merchant's own category
+func (q *Queries) GetCategoryByNameOrSlug(ctx context.Context, arg GetCategoryByNameOrSlugParams) (*GetCategoryByNameOrSlugRow, error) {
+	row := q.db.QueryRow(ctx, getCategoryByNameOrSlug, arg.Name, arg.MerchantID)
+	var i GetCategoryByNameOrSlugRow
+	err := row.Scan(
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.SlugCategory,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const getCategorySiblings = `-- name: GetCategorySiblings :many
+SELECT category_id, name, description, slug_category, created_at, updated_at, deleted_at, parent_id, merchant_id, position
+FROM categories
+WHERE
+    parent_id IS NOT DISTINCT FROM $1::int
+    AND merchant_id IS NOT DISTINCT FROM $2::int
+    AND deleted_at IS NULL
+ORDER BY position, category_id
+`
+
+type GetCategorySiblingsParams struct {
+	ParentID   *int32 `json:"parent_id"`
+	MerchantID *int32 `json:"merchant_id"`
+}
+
+// GetCategorySiblings: Retrieves the categories sharing a parent and an owner
+// Purpose: Renumber positions when categories are moved or reordered
+// Parameters:
+//
+//	$1: Parent category ID (NULL for root categories)
+//	$2: Owning merchant ID (NULL for global categories)
+//
+// Returns:
+//
+//	Full category records
+//
+// Business Logic:
+//   - Excludes soft-deleted categories
+//   - Ordered by position, then by ID for stable results
+func (q *Queries) GetCategorySiblings(ctx context.Context, arg GetCategorySiblingsParams) ([]*Category, error) {
+	rows, err := q.db.Query(ctx, getCategorySiblings, arg.ParentID, arg.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Category
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(
+			&i.CategoryID,
+			&i.Name,
+			&i.Description,
+			&i.SlugCategory,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ParentID,
+			&i.MerchantID,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCategorySubtreeIds = `-- name: GetCategorySubtreeIds :many
+WITH RECURSIVE
+    subtree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            category_id = $1
+        UNION
+        SELECT c.category_id
+        FROM categories c
+            JOIN subtree s ON c.parent_id = s.category_id
+    )
+SELECT category_id
+FROM subtree
+`
+
+// GetCategorySubtreeIds: Retrieves a category and all of its descendants
+// Purpose: Detect cycles before a category is moved
+// Parameters:
+//
+//	$1: Root category ID of the subtree
+//
+// Returns:
+//
+//	category_id of every category in the subtree, the root included
+//
+// Business Logic:
+//   - Includes soft-deleted descendants, since they still reference their parent
+func (q *Queries) GetCategorySubtreeIds(ctx context.Context, categoryID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getCategorySubtreeIds, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var category_id int32
+		if err := rows.Scan(&category_id); err != nil {
+			return nil, err
+		}
+		items = append(items, category_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCategoryTree = `-- name: GetCategoryTree :many
+WITH RECURSIVE
+    tree AS (
+        SELECT c.category_id, 0 AS depth, ARRAY[c.position, c.category_id] AS path
+        FROM categories c
+        WHERE
+            c.parent_id IS NULL
+            AND c.deleted_at IS NULL
+            AND (c.merchant_id IS NULL OR in_merchant_scope(c.merchant_id))
+            AND (
+                c.merchant_id IS NULL
+                OR c.merchant_id = $1::int
+            )
+        UNION ALL
+        SELECT child.category_id, t.depth + 1, t.path || ARRAY[child.position, child.category_id]
+        FROM categories child
+            JOIN tree t ON child.parent_id = t.category_id
+        WHERE
+            child.deleted_at IS NULL
+            AND (child.merchant_id IS NULL OR in_merchant_scope(child.merchant_id))
+            AND (
+                child.merchant_id IS NULL
+                OR child.merchant_id = $1::int
+            )
+    )
+SELECT
+    c.category_id,
+    c.name,
+    c.description,
+    c.slug_category,
+    c.parent_id,
+    c.merchant_id,
+    c.position,
+    c.created_at,
+    c.updated_at,
+    t.depth::int AS depth
+FROM tree t
+    JOIN categories c ON c.category_id = t.category_id
+ORDER BY t.path
+`
+
+type GetCategoryTreeRow struct {
+	CategoryID   int32            `json:"category_id"`
+	Name         string           `json:"name"`
+	Description  *string          `json:"description"`
+	SlugCategory *string          `json:"slug_category"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	Depth        int32            `json:"depth"`
+}
+
+// GetCategoryTree: Retrieves the active category tree visible to a merchant
+// Purpose: Render nested category pickers and navigation
+// Parameters:
+//
+//	$1: Merchant ID whose own categories are included (NULL for global only)
+//
+// Returns:
+//
+//	Category fields plus depth (0 for root categories)
+//
+// Business Logic:
+//   - Walks from the root categories down through parent_id
+//   - Excludes soft-deleted categories and everything below them
+//   - Orders depth-first, siblings by position, so each category follows its parent
+func (q *Queries) GetCategoryTree(ctx context.Context, merchantID *int32) ([]*GetCategoryTreeRow, error) {
+	rows, err := q.db.Query(ctx, getCategoryTree, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetCategoryTreeRow
+	for rows.Next() {
+		var i GetCategoryTreeRow
+		if err := rows.Scan(
+			&i.CategoryID,
+			&i.Name,
+			&i.Description,
+			&i.SlugCategory,
+			&i.ParentID,
+			&i.MerchantID,
+			&i.Position,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Depth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMonthlyCategory = `-- name: GetMonthlyCategory :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $2::boolean
+            AND child.deleted_at IS NULL
+    ),
     date_range AS (
         SELECT date_trunc('month', $1::timestamp) AS start_date, date_trunc('month', $1::timestamp) + interval '1 year' - interval '1 day' AS end_date
     ),
@@ -535,15 +963,17 @@ WITH
             date_trunc('month', o.created_at) AS activity_month,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND o.created_at BETWEEN (
@@ -563,6 +993,11 @@ FROM monthly_category_stats mcs
 ORDER BY mcs.activity_month, mcs.total_revenue DESC
 `
 
+type GetMonthlyCategoryParams struct {
+	Column1 time.Time `json:"column_1"`
+	Column2 bool      `json:"column_2"`
+}
+
 type GetMonthlyCategoryRow struct {
 	Month        string `json:"month"`
 	CategoryID   int32  `json:"category_id"`
@@ -577,6 +1012,7 @@ type GetMonthlyCategoryRow struct {
 // Parameters:
 //
 //	$1: Reference date (timestamp) - determines the 12-month analysis period
+//	$2: Roll up subcategories - when true each category also counts the sales of its descendants
 //
 // Returns:
 //
@@ -585,7 +1021,7 @@ type GetMonthlyCategoryRow struct {
 //	month: 3-letter month abbreviation (e.g. 'Jan')
 //	order_count: Number of orders associated with the category
 //	items_sold: Total quantity of items sold from the category
-//	total_revenue: Total revenue generated from category items
+//	total_revenue: Sum of line price times quantity at the price each line sold for
 //
 // Business Logic:
 //   - Analyzes a rolling 12-month period from the reference date
@@ -593,8 +1029,9 @@ type GetMonthlyCategoryRow struct {
 //   - Aggregates by category and month for trend tracking
 //   - Uses abbreviated month names for compact visual reporting
 //   - Results sorted by month and revenue for time-series analysis
-func (q *Queries) GetMonthlyCategory(ctx context.Context, dollar_1 time.Time) ([]*GetMonthlyCategoryRow, error) {
-	rows, err := q.db.Query(ctx, getMonthlyCategory, dollar_1)
+//   - With rollup, totals of a category include every active descendant category
+func (q *Queries) GetMonthlyCategory(ctx context.Context, arg GetMonthlyCategoryParams) ([]*GetMonthlyCategoryRow, error) {
+	rows, err := q.db.Query(ctx, getMonthlyCategory, arg.Column1, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
@@ -621,7 +1058,20 @@ func (q *Queries) GetMonthlyCategory(ctx context.Context, dollar_1 time.Time) ([
 }
 
 const getMonthlyCategoryById = `-- name: GetMonthlyCategoryById :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        WHERE
+            category_id = $2
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     date_range AS (
         SELECT date_trunc('month', $1::timestamp) AS start_date, date_trunc('month', $1::timestamp) + interval '1 year' - interval '1 day' AS end_date
     ),
@@ -632,15 +1082,17 @@ WITH
             date_trunc('month', o.created_at) AS activity_month,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND o.created_at BETWEEN (
@@ -664,6 +1116,7 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC
 type GetMonthlyCategoryByIdParams struct {
 	Column1    time.Time `json:"column_1"`
 	CategoryID int32     `json:"category_id"`
+	Column3    bool      `json:"column_3"`
 }
 
 type GetMonthlyCategoryByIdRow struct {
@@ -681,6 +1134,7 @@ type GetMonthlyCategoryByIdRow struct {
 //
 //	$1: Reference date (timestamp) - determines the 12-month analysis period
 //	$2: Category ID
+//	$3: Roll up subcategories - when true each category also counts the sales of its descendants
 //
 // Returns:
 //
@@ -689,7 +1143,7 @@ type GetMonthlyCategoryByIdRow struct {
 //	month: 3-letter month abbreviation (e.g. 'Jan')
 //	order_count: Number of orders associated with the category
 //	items_sold: Total quantity of items sold from the category
-//	total_revenue: Total revenue generated from category items
+//	total_revenue: Sum of line price times quantity at the price each line sold for
 //
 // Business Logic:
 //   - Analyzes a rolling 12-month period from the reference date
@@ -697,8 +1151,9 @@ type GetMonthlyCategoryByIdRow struct {
 //   - Aggregates by category and month for trend tracking
 //   - Uses abbreviated month names for compact visual reporting
 //   - Results sorted by month and revenue for time-series analysis
+//   - With rollup, totals of a category include every active descendant category
 func (q *Queries) GetMonthlyCategoryById(ctx context.Context, arg GetMonthlyCategoryByIdParams) ([]*GetMonthlyCategoryByIdRow, error) {
-	rows, err := q.db.Query(ctx, getMonthlyCategoryById, arg.Column1, arg.CategoryID)
+	rows, err := q.db.Query(ctx, getMonthlyCategoryById, arg.Column1, arg.CategoryID, arg.Column3)
 	if err != nil {
 		return nil, err
 	}
@@ -725,7 +1180,18 @@ func (q *Queries) GetMonthlyCategoryById(ctx context.Context, arg GetMonthlyCate
 }
 
 const getMonthlyCategoryByMerchant = `-- name: GetMonthlyCategoryByMerchant :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     date_range AS (
         SELECT date_trunc('month', $1::timestamp) AS start_date, date_trunc('month', $1::timestamp) + interval '1 year' - interval '1 day' AS end_date
     ),
@@ -736,15 +1202,17 @@ WITH
             date_trunc('month', o.created_at) AS activity_month,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND o.created_at BETWEEN (
@@ -768,6 +1236,7 @@ ORDER BY mcs.activity_month, mcs.total_revenue DESC
 type GetMonthlyCategoryByMerchantParams struct {
 	Column1    time.Time `json:"column_1"`
 	MerchantID int32     `json:"merchant_id"`
+	Column3    bool      `json:"column_3"`
 }
 
 type GetMonthlyCategoryByMerchantRow struct {
@@ -785,6 +1254,7 @@ type GetMonthlyCategoryByMerchantRow struct {
 //
 //	$1: Reference date (timestamp) - determines the 12-month analysis period
 //	$2: Merchant ID
+//	$3: Roll up subcategories - when true each category also counts the sales of its descendants
 //
 // Returns:
 //
@@ -793,7 +1263,7 @@ type GetMonthlyCategoryByMerchantRow struct {
 //	month: 3-letter month abbreviation (e.g. 'Jan')
 //	order_count: Number of orders associated with the category
 //	items_sold: Total quantity of items sold from the category
-//	total_revenue: Total revenue generated from category items
+//	total_revenue: Sum of line price times quantity at the price each line sold for
 //
 // Business Logic:
 //   - Analyzes a rolling 12-month period from the reference date
@@ -801,8 +1271,9 @@ type GetMonthlyCategoryByMerchantRow struct {
 //   - Aggregates by category and month for trend tracking
 //   - Uses abbreviated month names for compact visual reporting
 //   - Results sorted by month and revenue for time-series analysis
+//   - With rollup, totals of a category include every active descendant category
 func (q *Queries) GetMonthlyCategoryByMerchant(ctx context.Context, arg GetMonthlyCategoryByMerchantParams) ([]*GetMonthlyCategoryByMerchantRow, error) {
-	rows, err := q.db.Query(ctx, getMonthlyCategoryByMerchant, arg.Column1, arg.MerchantID)
+	rows, err := q.db.Query(ctx, getMonthlyCategoryByMerchant, arg.Column1, arg.MerchantID, arg.Column3)
 	if err != nil {
 		return nil, err
 	}
@@ -837,7 +1308,7 @@ WITH
             )::TEXT AS year, EXTRACT(
                 MONTH
                 FROM o.created_at
-            )::integer AS month, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS month, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -846,6 +1317,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -924,7 +1396,7 @@ type GetMonthlyTotalPriceRow struct {
 //
 //	year: Year of revenue data (text format)
 //	month_name: Full month name (e.g. "January")
-//	total_revenue: Sum of order totals for that month (0 if no sales)
+//	total_revenue: Sum of the order lines sold that month (0 if no sales)
 //
 // Business Logic:
 //   - Compares revenue between two customizable date ranges
@@ -958,7 +1430,20 @@ func (q *Queries) GetMonthlyTotalPrice(ctx context.Context, arg GetMonthlyTotalP
 }
 
 const getMonthlyTotalPriceById = `-- name: GetMonthlyTotalPriceById :many
-WITH
+WITH RECURSIVE
+    category_tree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            category_id = $5
+        UNION
+        SELECT child.category_id
+        FROM categories child
+            JOIN category_tree t ON child.parent_id = t.category_id
+        WHERE
+            $6::boolean
+            AND child.deleted_at IS NULL
+    ),
     monthly_totals AS (
         SELECT EXTRACT(
                 YEAR
@@ -966,7 +1451,7 @@ WITH
             )::TEXT AS year, EXTRACT(
                 MONTH
                 FROM o.created_at
-            )::integer AS month, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS month, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -975,6 +1460,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -985,7 +1471,10 @@ WITH
                     AND o.created_at <= $4
                 )
             )
-            AND c.category_id = $5
+            AND c.category_id IN (
+                SELECT category_id
+                FROM category_tree
+            )
         GROUP BY
             EXTRACT(
                 YEAR
@@ -1034,6 +1523,7 @@ type GetMonthlyTotalPriceByIdParams struct {
 	CreatedAt_2 pgtype.Timestamp `json:"created_at_2"`
 	CreatedAt_3 pgtype.Timestamp `json:"created_at_3"`
 	CategoryID  int32            `json:"category_id"`
+	Column6     bool             `json:"column_6"`
 }
 
 type GetMonthlyTotalPriceByIdRow struct {
@@ -1051,12 +1541,13 @@ type GetMonthlyTotalPriceByIdRow struct {
 //	$3: Start date of second comparison period
 //	$4: End date of second comparison period
 //	$5: Category ID
+//	$6: Roll up subcategories - when true the category's descendants are included
 //
 // Returns:
 //
 //	year: Year of revenue data (text format)
 //	month_name: Full month name (e.g. "January")
-//	total_revenue: Sum of order totals for that month (0 if no sales)
+//	total_revenue: Sum of the order lines sold that month (0 if no sales)
 //
 // Business Logic:
 //   - Compares revenue between two customizable date ranges
@@ -1064,6 +1555,7 @@ type GetMonthlyTotalPriceByIdRow struct {
 //   - Excludes deleted orders and order items for data integrity
 //   - Uses gap-filling to show all months in both periods
 //   - Formats output for financial dashboards
+//   - With rollup, revenue of every active descendant category is included
 func (q *Queries) GetMonthlyTotalPriceById(ctx context.Context, arg GetMonthlyTotalPriceByIdParams) ([]*GetMonthlyTotalPriceByIdRow, error) {
 	rows, err := q.db.Query(ctx, getMonthlyTotalPriceById,
 		arg.Extract,
@@ -1071,6 +1563,7 @@ func (q *Queries) GetMonthlyTotalPriceById(ctx context.Context, arg GetMonthlyTo
 		arg.CreatedAt_2,
 		arg.CreatedAt_3,
 		arg.CategoryID,
+		arg.Column6,
 	)
 	if err != nil {
 		return nil, err
@@ -1099,7 +1592,7 @@ WITH
             )::TEXT AS year, EXTRACT(
                 MONTH
                 FROM o.created_at
-            )::integer AS month, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS month, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -1108,6 +1601,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -1189,7 +1683,7 @@ type GetMonthlyTotalPriceByMerchantRow struct {
 //
 //	year: Year of revenue data (text format)
 //	month_name: Full month name (e.g. "January")
-//	total_revenue: Sum of order totals for that month (0 if no sales)
+//	total_revenue: Sum of the order lines sold that month (0 if no sales)
 //
 // Business Logic:
 //   - Compares revenue between two customizable date ranges
@@ -1224,7 +1718,18 @@ func (q *Queries) GetMonthlyTotalPriceByMerchant(ctx context.Context, arg GetMon
 }
 
 const getYearlyCategory = `-- name: GetYearlyCategory :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $2::boolean
+            AND child.deleted_at IS NULL
+    ),
     last_five_years AS (
         SELECT
             c.category_id,
@@ -1235,16 +1740,18 @@ WITH
             )::text AS year,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue,
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue,
             COUNT(DISTINCT oi.product_id) AS unique_products_sold
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND EXTRACT(
@@ -1279,6 +1786,11 @@ FROM last_five_years
 ORDER BY year, total_revenue DESC
 `
 
+type GetYearlyCategoryParams struct {
+	Column1 time.Time `json:"column_1"`
+	Column2 bool      `json:"column_2"`
+}
+
 type GetYearlyCategoryRow struct {
 	Year               string `json:"year"`
 	CategoryID         int32  `json:"category_id"`
@@ -1294,6 +1806,7 @@ type GetYearlyCategoryRow struct {
 // Parameters:
 //
 //	$1: Reference date (timestamp) - determines the 5-year analysis window
+//	$2: Roll up subcategories - when true each category also counts the sales of its descendants
 //
 // Returns:
 //
@@ -1302,7 +1815,7 @@ type GetYearlyCategoryRow struct {
 //	category_name: Display name of the category
 //	order_count: Annual number of orders involving this category
 //	items_sold: Quantity of products sold from this category
-//	total_revenue: Total sales revenue from category products
+//	total_revenue: Sum of line price times quantity at the price each line sold for
 //	unique_products_sold: Count of unique products sold within the category
 //
 // Business Logic:
@@ -1311,8 +1824,9 @@ type GetYearlyCategoryRow struct {
 //   - Provides both volume and value metrics for category-level evaluation
 //   - Results sorted by year and revenue to show historical trends
 //   - Suitable for business reviews and strategic category planning
-func (q *Queries) GetYearlyCategory(ctx context.Context, dollar_1 time.Time) ([]*GetYearlyCategoryRow, error) {
-	rows, err := q.db.Query(ctx, getYearlyCategory, dollar_1)
+//   - With rollup, totals of a category include every active descendant category
+func (q *Queries) GetYearlyCategory(ctx context.Context, arg GetYearlyCategoryParams) ([]*GetYearlyCategoryRow, error) {
+	rows, err := q.db.Query(ctx, getYearlyCategory, arg.Column1, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
@@ -1340,7 +1854,20 @@ func (q *Queries) GetYearlyCategory(ctx context.Context, dollar_1 time.Time) ([]
 }
 
 const getYearlyCategoryById = `-- name: GetYearlyCategoryById :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        WHERE
+            category_id = $2
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     last_five_years AS (
         SELECT
             c.category_id,
@@ -1351,16 +1878,18 @@ WITH
             )::text AS year,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue,
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue,
             COUNT(DISTINCT oi.product_id) AS unique_products_sold
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND EXTRACT(
@@ -1399,6 +1928,7 @@ ORDER BY year, total_revenue DESC
 type GetYearlyCategoryByIdParams struct {
 	Column1    time.Time `json:"column_1"`
 	CategoryID int32     `json:"category_id"`
+	Column3    bool      `json:"column_3"`
 }
 
 type GetYearlyCategoryByIdRow struct {
@@ -1417,6 +1947,7 @@ type GetYearlyCategoryByIdRow struct {
 //
 //	$1: Reference date (timestamp) - determines the 5-year analysis window
 //	$2: Category ID
+//	$3: Roll up subcategories - when true each category also counts the sales of its descendants
 //
 // Returns:
 //
@@ -1425,7 +1956,7 @@ type GetYearlyCategoryByIdRow struct {
 //	category_name: Display name of the category
 //	order_count: Annual number of orders involving this category
 //	items_sold: Quantity of products sold from this category
-//	total_revenue: Total sales revenue from category products
+//	total_revenue: Sum of line price times quantity at the price each line sold for
 //	unique_products_sold: Count of unique products sold within the category
 //
 // Business Logic:
@@ -1434,8 +1965,9 @@ type GetYearlyCategoryByIdRow struct {
 //   - Provides both volume and value metrics for category-level evaluation
 //   - Results sorted by year and revenue to show historical trends
 //   - Suitable for business reviews and strategic category planning
+//   - With rollup, totals of a category include every active descendant category
 func (q *Queries) GetYearlyCategoryById(ctx context.Context, arg GetYearlyCategoryByIdParams) ([]*GetYearlyCategoryByIdRow, error) {
-	rows, err := q.db.Query(ctx, getYearlyCategoryById, arg.Column1, arg.CategoryID)
+	rows, err := q.db.Query(ctx, getYearlyCategoryById, arg.Column1, arg.CategoryID, arg.Column3)
 	if err != nil {
 		return nil, err
 	}
@@ -1463,7 +1995,18 @@ func (q *Queries) GetYearlyCategoryById(ctx context.Context, arg GetYearlyCatego
 }
 
 const getYearlyCategoryByMerchant = `-- name: GetYearlyCategoryByMerchant :many
-WITH
+WITH RECURSIVE
+    category_closure AS (
+        SELECT category_id AS ancestor_id, category_id
+        FROM categories
+        UNION
+        SELECT cc.ancestor_id, child.category_id
+        FROM category_closure cc
+            JOIN categories child ON child.parent_id = cc.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     last_five_years AS (
         SELECT
             c.category_id,
@@ -1474,16 +2017,18 @@ WITH
             )::text AS year,
             COUNT(DISTINCT o.order_id) AS order_count,
             SUM(oi.quantity) AS items_sold,
-            COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue,
+            COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue,
             COUNT(DISTINCT oi.product_id) AS unique_products_sold
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
             JOIN products p ON oi.product_id = p.product_id
-            JOIN categories c ON p.category_id = c.category_id
+            JOIN category_closure cc ON cc.category_id = p.category_id
+            JOIN categories c ON c.category_id = cc.ancestor_id
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND EXTRACT(
@@ -1522,6 +2067,7 @@ ORDER BY year, total_revenue DESC
 type GetYearlyCategoryByMerchantParams struct {
 	Column1    time.Time `json:"column_1"`
 	MerchantID int32     `json:"merchant_id"`
+	Column3    bool      `json:"column_3"`
 }
 
 type GetYearlyCategoryByMerchantRow struct {
@@ -1540,6 +2086,7 @@ type GetYearlyCategoryByMerchantRow struct {
 //
 //	$1: Reference date (timestamp) - determines the 5-year analysis window
 //	$2: Merchant ID
+//	$3: Roll up subcategories - when true each category also counts the sales of its descendants
 //
 // Returns:
 //
@@ -1548,7 +2095,7 @@ type GetYearlyCategoryByMerchantRow struct {
 //	category_name: Display name of the category
 //	order_count: Annual number of orders involving this category
 //	items_sold: Quantity of products sold from this category
-//	total_revenue: Total sales revenue from category products
+//	total_revenue: Sum of line price times quantity at the price each line sold for
 //	unique_products_sold: Count of unique products sold within the category
 //
 // Business Logic:
@@ -1557,8 +2104,9 @@ type GetYearlyCategoryByMerchantRow struct {
 //   - Provides both volume and value metrics for category-level evaluation
 //   - Results sorted by year and revenue to show historical trends
 //   - Suitable for business reviews and strategic category planning
+//   - With rollup, totals of a category include every active descendant category
 func (q *Queries) GetYearlyCategoryByMerchant(ctx context.Context, arg GetYearlyCategoryByMerchantParams) ([]*GetYearlyCategoryByMerchantRow, error) {
-	rows, err := q.db.Query(ctx, getYearlyCategoryByMerchant, arg.Column1, arg.MerchantID)
+	rows, err := q.db.Query(ctx, getYearlyCategoryByMerchant, arg.Column1, arg.MerchantID, arg.Column3)
 	if err != nil {
 		return nil, err
 	}
@@ -1591,7 +2139,7 @@ WITH
         SELECT EXTRACT(
                 YEAR
                 FROM o.created_at
-            )::integer AS year, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS year, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -1600,6 +2148,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND (
@@ -1643,7 +2192,7 @@ type GetYearlyTotalPriceRow struct {
 // Returns:
 //
 //	year: Year as text
-//	total_revenue: Annual revenue total (0 if no sales)
+//	total_revenue: Annual revenue from order lines (0 if no sales)
 //
 // Business Logic:
 //   - Compares current year with previous year automatically
@@ -1672,12 +2221,25 @@ func (q *Queries) GetYearlyTotalPrice(ctx context.Context, dollar_1 int32) ([]*G
 }
 
 const getYearlyTotalPriceById = `-- name: GetYearlyTotalPriceById :many
-WITH
+WITH RECURSIVE
+    category_tree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            category_id = $2
+        UNION
+        SELECT child.category_id
+        FROM categories child
+            JOIN category_tree t ON child.parent_id = t.category_id
+        WHERE
+            $3::boolean
+            AND child.deleted_at IS NULL
+    ),
     yearly_data AS (
         SELECT EXTRACT(
                 YEAR
                 FROM o.created_at
-            )::integer AS year, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS year, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -1686,6 +2248,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND (
@@ -1698,7 +2261,10 @@ WITH
                     FROM o.created_at
                 ) = $1::integer - 1
             )
-            AND c.category_id = $2
+            AND c.category_id IN (
+                SELECT category_id
+                FROM category_tree
+            )
         GROUP BY
             EXTRACT(
                 YEAR
@@ -1719,6 +2285,7 @@ ORDER BY a.year DESC
 type GetYearlyTotalPriceByIdParams struct {
 	Column1    int32 `json:"column_1"`
 	CategoryID int32 `json:"category_id"`
+	Column3    bool  `json:"column_3"`
 }
 
 type GetYearlyTotalPriceByIdRow struct {
@@ -1732,11 +2299,12 @@ type GetYearlyTotalPriceByIdRow struct {
 //
 //	$1: Reference year for comparison (current year)
 //	$2: Category ID
+//	$3: Roll up subcategories - when true the category's descendants are included
 //
 // Returns:
 //
 //	year: Year as text
-//	total_revenue: Annual revenue total (0 if no sales)
+//	total_revenue: Annual revenue from order lines (0 if no sales)
 //
 // Business Logic:
 //   - Compares current year with previous year automatically
@@ -1744,8 +2312,9 @@ type GetYearlyTotalPriceByIdRow struct {
 //   - Excludes deleted records across all joined tables
 //   - Ensures complete year reporting even with no sales
 //   - Orders results by most recent year first
+//   - With rollup, revenue of every active descendant category is included
 func (q *Queries) GetYearlyTotalPriceById(ctx context.Context, arg GetYearlyTotalPriceByIdParams) ([]*GetYearlyTotalPriceByIdRow, error) {
-	rows, err := q.db.Query(ctx, getYearlyTotalPriceById, arg.Column1, arg.CategoryID)
+	rows, err := q.db.Query(ctx, getYearlyTotalPriceById, arg.Column1, arg.CategoryID, arg.Column3)
 	if err != nil {
 		return nil, err
 	}
@@ -1770,7 +2339,7 @@ WITH
         SELECT EXTRACT(
                 YEAR
                 FROM o.created_at
-            )::integer AS year, COALESCE(SUM(o.total_price), 0)::INTEGER AS total_revenue
+            )::integer AS year, COALESCE(SUM(oi.price * oi.quantity), 0)::INTEGER AS total_revenue
         FROM
             orders o
             JOIN order_items oi ON o.order_id = oi.order_id
@@ -1779,6 +2348,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND p.deleted_at IS NULL
             AND c.deleted_at IS NULL
             AND (
@@ -1829,7 +2399,7 @@ type GetYearlyTotalPriceByMerchantRow struct {
 // Returns:
 //
 //	year: Year as text
-//	total_revenue: Annual revenue total (0 if no sales)
+//	total_revenue: Annual revenue from order lines (0 if no sales)
 //
 // Business Logic:
 //   - Compares current year with previous year automatically
@@ -1857,12 +2427,56 @@ func (q *Queries) GetYearlyTotalPriceByMerchant(ctx context.Context, arg GetYear
 	return items, nil
 }
 
+const lockCategoryTree = `-- name: LockCategoryTree :exec
+SELECT pg_advisory_xact_lock(hashtext('categories_tree'))
+`
+
+// LockCategoryTree: Serializes changes to the category tree
+// Purpose: Keep concurrent moves from creating a cycle between them
+// Parameters: None
+// Returns: None
+// Business Logic:
+//   - Holds a transaction-level advisory lock until commit or rollback
+func (q *Queries) LockCategoryTree(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockCategoryTree)
+	return err
+}
+
+const reorderCategories = `-- name: ReorderCategories :exec
+UPDATE categories c
+SET
+    position = o.ordinality - 1,
+    updated_at = CURRENT_TIMESTAMP
+FROM unnest($1::int[]) WITH ORDINALITY AS o (category_id, ordinality)
+WHERE
+    c.category_id = o.category_id
+    AND in_merchant_scope(c.merchant_id)
+`
+
+// ReorderCategories: Sets the position of categories from their order in a list
+// Purpose: Persist a new sibling order
+// Parameters:
+//
+//	$1: Category IDs in their new order
+//
+// Returns:
+//
+//	Nothing (command only)
+//
+// Business Logic:
+//   - The first category gets position 0, the next 1, and so on
+func (q *Queries) ReorderCategories(ctx context.Context, categoryIds []int32) error {
+	_, err := q.db.Exec(ctx, reorderCategories, categoryIds)
+	return err
+}
+
 const restoreAllCategories = `-- name: RestoreAllCategories :exec
 UPDATE categories
 SET
     deleted_at = NULL
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // RestoreAllCategories: Recovers all trashed categories
@@ -1883,6 +2497,7 @@ SET
 WHERE
     category_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     category_id,
     name,
@@ -1890,7 +2505,10 @@ RETURNING
     slug_category,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    parent_id,
+    merchant_id,
+    position
 `
 
 // RestoreCategory: Recovers a previously trashed category
@@ -1916,6 +2534,9 @@ func (q *Queries) RestoreCategory(ctx context.Context, categoryID int32) (*Categ
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ParentID,
+		&i.MerchantID,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -1927,6 +2548,7 @@ SET
 WHERE
     category_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     category_id,
     name,
@@ -1934,7 +2556,10 @@ RETURNING
     slug_category,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    parent_id,
+    merchant_id,
+    position
 `
 
 // TrashCategory: Soft-deletes a category
@@ -1961,6 +2586,9 @@ func (q *Queries) TrashCategory(ctx context.Context, categoryID int32) (*Categor
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ParentID,
+		&i.MerchantID,
+		&i.Position,
 	)
 	return &i, err
 }
@@ -1975,11 +2603,15 @@ SET
 WHERE
     category_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     category_id,
     name,
     description,
     slug_category,
+    parent_id,
+    merchant_id,
+    position,
     created_at,
     updated_at
 `
@@ -1996,6 +2628,9 @@ type UpdateCategoryRow struct {
 	Name         string           `json:"name"`
 	Description  *string          `json:"description"`
 	SlugCategory *string          `json:"slug_category"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 }
@@ -2024,6 +2659,55 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		arg.SlugCategory,
 	)
 	var i UpdateCategoryRow
+	err := row.Scan(
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.SlugCategory,
+		&i.ParentID,
+		&i.MerchantID,
+		&i.Position,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const updateCategoryParent = `-- name: UpdateCategoryParent :one
+UPDATE categories
+SET
+    parent_id = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    category_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    category_id, name, description, slug_category, created_at, updated_at, deleted_at, parent_id, merchant_id, position
+`
+
+type UpdateCategoryParentParams struct {
+	CategoryID int32  `json:"category_id"`
+	ParentID   *int32 `json:"parent_id"`
+}
+
+// UpdateCategoryParent: Moves a category under another parent
+// Purpose: Restructure the category tree
+// Parameters:
+//
+//	$1: Category ID
+//	$2: New parent category ID (NULL to make it a root category)
+//
+// Returns:
+//
+//	Updated category record
+//
+// Business Logic:
+//   - Skips if category has been soft-deleted
+//   - Positions are renumbered separately with ReorderCategories
+func (q *Queries) UpdateCategoryParent(ctx context.Context, arg UpdateCategoryParentParams) (*Category, error) {
+	row := q.db.QueryRow(ctx, updateCategoryParent, arg.CategoryID, arg.ParentID)
+	var i Category
 	err := row.Scan(
 		&i.CategoryID,
 		&i.Name,
@@ -2031,6 +2715,10 @@ func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams)
 		&i.SlugCategory,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ParentID,
+		&i.MerchantID,
+		&i.Position,
 	)
 	return &i, err
 }
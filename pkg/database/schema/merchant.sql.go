@@ -103,7 +103,7 @@ func (q *Queries) CreateMerchant(ctx context.Context, arg CreateMerchantParams)
 }
 
 const deleteAllPermanentMerchants = `-- name: DeleteAllPermanentMerchants :exec
-DELETE FROM merchants WHERE deleted_at IS NOT NULL
+DELETE FROM merchants WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id)
 `
 
 // DeleteAllPermanentMerchants: Purges all trashed merchants
@@ -118,11 +118,12 @@ func (q *Queries) DeleteAllPermanentMerchants(ctx context.Context) error {
 	return err
 }
 
-const deleteMerchantPermanently = `-- name: DeleteMerchantPermanently :exec
+const deleteMerchantPermanently = `-- name: DeleteMerchantPermanently :execrows
 DELETE FROM merchants
 WHERE
     merchant_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // DeleteMerchantPermanently: Hard-deletes a merchant
@@ -133,12 +134,15 @@ WHERE
 //
 // Business Logic:
 //   - Permanent deletion of already soft-deleted records
-//   - No return value (exec-only operation)
+//   - Returns the number of deleted rows, 0 when no trashed record matched
 //   - Irreversible action - use with caution
 //   - Should trigger cleanup of related records
-func (q *Queries) DeleteMerchantPermanently(ctx context.Context, merchantID int32) error {
-	_, err := q.db.Exec(ctx, deleteMerchantPermanently, merchantID)
-	return err
+func (q *Queries) DeleteMerchantPermanently(ctx context.Context, merchantID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMerchantPermanently, merchantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getMerchantByID = `-- name: GetMerchantByID :one
@@ -152,24 +156,29 @@ SELECT
     contact_phone,
     status,
     created_at,
-    updated_at
+    updated_at,
+    gs1_prefix,
+    barcode_format
 FROM merchants
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 type GetMerchantByIDRow struct {
-	MerchantID   int32            `json:"merchant_id"`
-	UserID       int32            `json:"user_id"`
-	Name         string           `json:"name"`
-	Description  *string          `json:"description"`
-	Address      *string          `json:"address"`
-	ContactEmail *string          `json:"contact_email"`
-	ContactPhone *string          `json:"contact_phone"`
-	Status       string           `json:"status"`
-	CreatedAt    pgtype.Timestamp `json:"created_at"`
-	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	MerchantID    int32            `json:"merchant_id"`
+	UserID        int32            `json:"user_id"`
+	Name          string           `json:"name"`
+	Description   *string          `json:"description"`
+	Address       *string          `json:"address"`
+	ContactEmail  *string          `json:"contact_email"`
+	ContactPhone  *string          `json:"contact_phone"`
+	Status        string           `json:"status"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	Gs1Prefix     *string          `json:"gs1_prefix"`
+	BarcodeFormat string           `json:"barcode_format"`
 }
 
 // GetMerchantByID: Retrieves active merchant by ID
@@ -197,10 +206,62 @@ func (q *Queries) GetMerchantByID(ctx context.Context, merchantID int32) (*GetMe
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Gs1Prefix,
+		&i.BarcodeFormat,
 	)
 	return &i, err
 }
 
+const getMerchantScopeByUser = `-- name: GetMerchantScopeByUser :many
+SELECT merchant_id
+FROM merchants
+WHERE
+    user_id = $1
+    AND deleted_at IS NULL
+UNION
+SELECT c.merchant_id
+FROM cashiers c
+    JOIN merchants m ON m.merchant_id = c.merchant_id
+WHERE
+    c.user_id = $1
+    AND c.deleted_at IS NULL
+    AND m.deleted_at IS NULL
+ORDER BY merchant_id
+`
+
+// GetMerchantScopeByUser: Retrieves the merchants a user may act for
+// Purpose: Resolve the merchant scope of an authenticated caller
+// Parameters:
+//
+//	$1: user_id - ID of the caller
+//
+// Returns:
+//
+//	merchant_id of every merchant the user owns or works at as a cashier
+//
+// Business Logic:
+//   - Excludes soft-deleted merchants and cashiers
+//   - Must run without a merchant scope, since it is what the scope is built from
+func (q *Queries) GetMerchantScopeByUser(ctx context.Context, userID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getMerchantScopeByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var merchant_id int32
+		if err := rows.Scan(&merchant_id); err != nil {
+			return nil, err
+		}
+		items = append(items, merchant_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMerchants = `-- name: GetMerchants :many
 SELECT
     merchant_id,
@@ -217,6 +278,7 @@ SELECT
 FROM merchants
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -315,6 +377,7 @@ SELECT
 FROM merchants
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -414,6 +477,7 @@ SELECT
 FROM merchants
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR name ILIKE '%' || $1 || '%'
@@ -503,6 +567,7 @@ SET
     deleted_at = NULL
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // RestoreAllMerchants: Mass restoration of deleted merchants
@@ -524,6 +589,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     merchant_id,
     user_id,
@@ -535,7 +601,9 @@ RETURNING
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    gs1_prefix,
+    barcode_format
 `
 
 // RestoreMerchant: Recovers a soft-deleted merchant
@@ -565,6 +633,8 @@ func (q *Queries) RestoreMerchant(ctx context.Context, merchantID int32) (*Merch
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gs1Prefix,
+		&i.BarcodeFormat,
 	)
 	return &i, err
 }
@@ -576,6 +646,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     merchant_id,
     user_id,
@@ -587,7 +658,9 @@ RETURNING
     status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    gs1_prefix,
+    barcode_format
 `
 
 // TrashMerchant: Soft-deletes a merchant account
@@ -617,6 +690,8 @@ func (q *Queries) TrashMerchant(ctx context.Context, merchantID int32) (*Merchan
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gs1Prefix,
+		&i.BarcodeFormat,
 	)
 	return &i, err
 }
@@ -634,6 +709,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     merchant_id,
     user_id,
@@ -713,3 +789,57 @@ func (q *Queries) UpdateMerchant(ctx context.Context, arg UpdateMerchantParams)
 	)
 	return &i, err
 }
+
+const updateMerchantBarcodeSettings = `-- name: UpdateMerchantBarcodeSettings :one
+UPDATE merchants
+SET
+    gs1_prefix = $2,
+    barcode_format = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    merchant_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    merchant_id,
+    gs1_prefix,
+    barcode_format,
+    updated_at
+`
+
+type UpdateMerchantBarcodeSettingsParams struct {
+	MerchantID    int32   `json:"merchant_id"`
+	Gs1Prefix     *string `json:"gs1_prefix"`
+	BarcodeFormat string  `json:"barcode_format"`
+}
+
+type UpdateMerchantBarcodeSettingsRow struct {
+	MerchantID    int32            `json:"merchant_id"`
+	Gs1Prefix     *string          `json:"gs1_prefix"`
+	BarcodeFormat string           `json:"barcode_format"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+// UpdateMerchantBarcodeSettings: Sets how product barcodes are generated for a merchant
+// Purpose: Number new products under the merchant's own GS1 company prefix
+// Parameters:
+//
+//	$1: merchant_id - Target merchant ID
+//	$2: gs1_prefix - GS1 company prefix (NULL to use the in-store range)
+//	$3: barcode_format - 'ean13' or 'upca'
+//
+// Returns: The merchant's barcode settings
+// Business Logic:
+//   - Only affects active (non-deleted) records
+//   - Existing product barcodes are left unchanged
+func (q *Queries) UpdateMerchantBarcodeSettings(ctx context.Context, arg UpdateMerchantBarcodeSettingsParams) (*UpdateMerchantBarcodeSettingsRow, error) {
+	row := q.db.QueryRow(ctx, updateMerchantBarcodeSettings, arg.MerchantID, arg.Gs1Prefix, arg.BarcodeFormat)
+	var i UpdateMerchantBarcodeSettingsRow
+	err := row.Scan(
+		&i.MerchantID,
+		&i.Gs1Prefix,
+		&i.BarcodeFormat,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
@@ -28,20 +28,38 @@ type Category struct {
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+	ParentID     *int32           `json:"parent_id"`
+	MerchantID   *int32           `json:"merchant_id"`
+	Position     int32            `json:"position"`
+}
+
+type IdempotencyKey struct {
+	IdempotencyKeyID int32            `json:"idempotency_key_id"`
+	UserID           int32            `json:"user_id"`
+	Method           string           `json:"method"`
+	IdempotencyKey   string           `json:"idempotency_key"`
+	RequestHash      string           `json:"request_hash"`
+	Response         []byte           `json:"response"`
+	LockedUntil      time.Time        `json:"locked_until"`
+	ExpiresAt        time.Time        `json:"expires_at"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
 }
 
 type Merchant struct {
-	MerchantID   int32            `json:"merchant_id"`
-	UserID       int32            `json:"user_id"`
-	Name         string           `json:"name"`
-	Description  *string          `json:"description"`
-	Address      *string          `json:"address"`
-	ContactEmail *string          `json:"contact_email"`
-	ContactPhone *string          `json:"contact_phone"`
-	Status       string           `json:"status"`
-	CreatedAt    pgtype.Timestamp `json:"created_at"`
-	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
-	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+	MerchantID    int32            `json:"merchant_id"`
+	UserID        int32            `json:"user_id"`
+	Name          string           `json:"name"`
+	Description   *string          `json:"description"`
+	Address       *string          `json:"address"`
+	ContactEmail  *string          `json:"contact_email"`
+	ContactPhone  *string          `json:"contact_phone"`
+	Status        string           `json:"status"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	DeletedAt     pgtype.Timestamp `json:"deleted_at"`
+	Gs1Prefix     *string          `json:"gs1_prefix"`
+	BarcodeFormat string           `json:"barcode_format"`
 }
 
 type Order struct {
@@ -52,45 +70,118 @@ type Order struct {
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 	DeletedAt  pgtype.Timestamp `json:"deleted_at"`
+	Status     string           `json:"status"`
 }
 
 type OrderItem struct {
-	OrderItemID int32            `json:"order_item_id"`
-	OrderID     int32            `json:"order_id"`
-	ProductID   int32            `json:"product_id"`
-	Quantity    int32            `json:"quantity"`
-	Price       int32            `json:"price"`
-	CreatedAt   pgtype.Timestamp `json:"created_at"`
-	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
-	DeletedAt   pgtype.Timestamp `json:"deleted_at"`
+	OrderItemID  int32            `json:"order_item_id"`
+	OrderID      int32            `json:"order_id"`
+	ProductID    int32            `json:"product_id"`
+	Quantity     int32            `json:"quantity"`
+	Price        int32            `json:"price"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+	TaxRateID    *int32           `json:"tax_rate_id"`
+	TaxRateBps   int32            `json:"tax_rate_bps"`
+	TaxInclusive bool             `json:"tax_inclusive"`
+	TaxRounding  string           `json:"tax_rounding"`
+	TaxAmount    int32            `json:"tax_amount"`
+	VariantID    *int32           `json:"variant_id"`
 }
 
 type Product struct {
+	ProductID         int32            `json:"product_id"`
+	MerchantID        int32            `json:"merchant_id"`
+	CategoryID        int32            `json:"category_id"`
+	Name              string           `json:"name"`
+	Description       *string          `json:"description"`
+	Price             int32            `json:"price"`
+	CountInStock      int32            `json:"count_in_stock"`
+	Brand             *string          `json:"brand"`
+	Weight            *int32           `json:"weight"`
+	SlugProduct       *string          `json:"slug_product"`
+	ImageProduct      *string          `json:"image_product"`
+	Barcode           *string          `json:"barcode"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+	DeletedAt         pgtype.Timestamp `json:"deleted_at"`
+	ReorderPoint      int32            `json:"reorder_point"`
+	ReorderQuantity   int32            `json:"reorder_quantity"`
+	LowStockAlertedAt pgtype.Timestamp `json:"low_stock_alerted_at"`
+}
+
+type ProductOption struct {
+	ProductOptionID int32            `json:"product_option_id"`
+	ProductID       int32            `json:"product_id"`
+	Name            string           `json:"name"`
+	OptionValues    []string         `json:"option_values"`
+	Position        int32            `json:"position"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+}
+
+type ProductVariant struct {
+	VariantID    int32            `json:"variant_id"`
 	ProductID    int32            `json:"product_id"`
-	MerchantID   int32            `json:"merchant_id"`
-	CategoryID   int32            `json:"category_id"`
-	Name         string           `json:"name"`
-	Description  *string          `json:"description"`
-	Price        int32            `json:"price"`
-	CountInStock int32            `json:"count_in_stock"`
-	Brand        *string          `json:"brand"`
-	Weight       *int32           `json:"weight"`
-	SlugProduct  *string          `json:"slug_product"`
-	ImageProduct *string          `json:"image_product"`
+	Sku          string           `json:"sku"`
 	Barcode      *string          `json:"barcode"`
+	Options      []byte           `json:"options"`
+	Price        *int32           `json:"price"`
+	CountInStock int32            `json:"count_in_stock"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
 }
 
+type PurchaseOrder struct {
+	PurchaseOrderID int32            `json:"purchase_order_id"`
+	MerchantID      int32            `json:"merchant_id"`
+	SupplierID      int32            `json:"supplier_id"`
+	Status          string           `json:"status"`
+	Note            *string          `json:"note"`
+	CreatedBy       *int32           `json:"created_by"`
+	OrderedAt       pgtype.Timestamp `json:"ordered_at"`
+	ReceivedAt      pgtype.Timestamp `json:"received_at"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+}
+
+type PurchaseOrderItem struct {
+	PurchaseOrderItemID int32            `json:"purchase_order_item_id"`
+	PurchaseOrderID     int32            `json:"purchase_order_id"`
+	ProductID           int32            `json:"product_id"`
+	QuantityOrdered     int32            `json:"quantity_ordered"`
+	QuantityReceived    int32            `json:"quantity_received"`
+	UnitCost            int32            `json:"unit_cost"`
+	CreatedAt           pgtype.Timestamp `json:"created_at"`
+	UpdatedAt           pgtype.Timestamp `json:"updated_at"`
+}
+
+type PurchaseOrderReceipt struct {
+	PurchaseOrderReceiptID int32            `json:"purchase_order_receipt_id"`
+	PurchaseOrderItemID    int32            `json:"purchase_order_item_id"`
+	Quantity               int32            `json:"quantity"`
+	UnitCost               int32            `json:"unit_cost"`
+	ReceivedBy             *int32           `json:"received_by"`
+	Note                   *string          `json:"note"`
+	CreatedAt              pgtype.Timestamp `json:"created_at"`
+}
+
 type RefreshToken struct {
-	RefreshTokenID int32            `json:"refresh_token_id"`
-	UserID         int32            `json:"user_id"`
-	Token          string           `json:"token"`
-	Expiration     time.Time        `json:"expiration"`
-	CreatedAt      pgtype.Timestamp `json:"created_at"`
-	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
-	DeletedAt      pgtype.Timestamp `json:"deleted_at"`
+	RefreshTokenID   int32            `json:"refresh_token_id"`
+	UserID           int32            `json:"user_id"`
+	Token            string           `json:"token"`
+	Expiration       time.Time        `json:"expiration"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
+	DeletedAt        pgtype.Timestamp `json:"deleted_at"`
+	SessionID        string           `json:"session_id"`
+	AccessTokenID    string           `json:"access_token_id"`
+	UserAgent        string           `json:"user_agent"`
+	IpAddress        string           `json:"ip_address"`
+	SessionStartedAt time.Time        `json:"session_started_at"`
+	RevokedAt        pgtype.Timestamp `json:"revoked_at"`
 }
 
 type Role struct {
@@ -101,17 +192,128 @@ type Role struct {
 	DeletedAt pgtype.Timestamp `json:"deleted_at"`
 }
 
+type StockMovement struct {
+	StockMovementID int32            `json:"stock_movement_id"`
+	ProductID       int32            `json:"product_id"`
+	Reason          string           `json:"reason"`
+	QuantityDelta   int32            `json:"quantity_delta"`
+	BalanceAfter    int32            `json:"balance_after"`
+	ReferenceType   *string          `json:"reference_type"`
+	ReferenceID     *int32           `json:"reference_id"`
+	UserID          *int32           `json:"user_id"`
+	Note            *string          `json:"note"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	VariantID       *int32           `json:"variant_id"`
+}
+
+type Stocktake struct {
+	StocktakeID int32            `json:"stocktake_id"`
+	MerchantID  int32            `json:"merchant_id"`
+	CategoryID  *int32           `json:"category_id"`
+	Status      string           `json:"status"`
+	Note        *string          `json:"note"`
+	CreatedBy   *int32           `json:"created_by"`
+	PostedBy    *int32           `json:"posted_by"`
+	PostedAt    pgtype.Timestamp `json:"posted_at"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
+type StocktakeItem struct {
+	StocktakeItemID  int32            `json:"stocktake_item_id"`
+	StocktakeID      int32            `json:"stocktake_id"`
+	ProductID        int32            `json:"product_id"`
+	ExpectedQuantity int32            `json:"expected_quantity"`
+	CountedQuantity  *int32           `json:"counted_quantity"`
+	UnitPrice        int32            `json:"unit_price"`
+	CountedBy        *int32           `json:"counted_by"`
+	CountedAt        pgtype.Timestamp `json:"counted_at"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
+}
+
+type Supplier struct {
+	SupplierID  int32            `json:"supplier_id"`
+	MerchantID  int32            `json:"merchant_id"`
+	Name        string           `json:"name"`
+	ContactName *string          `json:"contact_name"`
+	Email       *string          `json:"email"`
+	Phone       *string          `json:"phone"`
+	Address     *string          `json:"address"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	DeletedAt   pgtype.Timestamp `json:"deleted_at"`
+}
+
+type TaxRate struct {
+	TaxRateID   int32            `json:"tax_rate_id"`
+	Name        string           `json:"name"`
+	MerchantID  *int32           `json:"merchant_id"`
+	CategoryID  *int32           `json:"category_id"`
+	RateBps     int32            `json:"rate_bps"`
+	IsInclusive bool             `json:"is_inclusive"`
+	Rounding    string           `json:"rounding"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	DeletedAt   pgtype.Timestamp `json:"deleted_at"`
+}
+
 type Transaction struct {
+	TransactionID  int32            `json:"transaction_id"`
+	OrderID        int32            `json:"order_id"`
+	MerchantID     int32            `json:"merchant_id"`
+	PaymentMethod  string           `json:"payment_method"`
+	Amount         int32            `json:"amount"`
+	ChangeAmount   *int32           `json:"change_amount"`
+	PaymentStatus  string           `json:"payment_status"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	DeletedAt      pgtype.Timestamp `json:"deleted_at"`
+	SubtotalAmount int32            `json:"subtotal_amount"`
+	TaxAmount      int32            `json:"tax_amount"`
+}
+
+type TransactionPayment struct {
+	TransactionPaymentID int32            `json:"transaction_payment_id"`
+	TransactionID        int32            `json:"transaction_id"`
+	PaymentMethod        string           `json:"payment_method"`
+	Amount               int32            `json:"amount"`
+	ChangeAmount         int32            `json:"change_amount"`
+	Reference            *string          `json:"reference"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	UpdatedAt            pgtype.Timestamp `json:"updated_at"`
+}
+
+type TransactionRefund struct {
+	TransactionRefundID int32            `json:"transaction_refund_id"`
+	TransactionID       int32            `json:"transaction_id"`
+	Kind                string           `json:"kind"`
+	Amount              int32            `json:"amount"`
+	TaxAmount           int32            `json:"tax_amount"`
+	ReasonCode          string           `json:"reason_code"`
+	Note                *string          `json:"note"`
+	ApprovedBy          int32            `json:"approved_by"`
+	Restock             bool             `json:"restock"`
+	CreatedAt           pgtype.Timestamp `json:"created_at"`
+	UpdatedAt           pgtype.Timestamp `json:"updated_at"`
+}
+
+type TransactionRefundItem struct {
+	TransactionRefundItemID int32            `json:"transaction_refund_item_id"`
+	TransactionRefundID     int32            `json:"transaction_refund_id"`
+	OrderItemID             int32            `json:"order_item_id"`
+	Quantity                int32            `json:"quantity"`
+	Amount                  int32            `json:"amount"`
+	TaxAmount               int32            `json:"tax_amount"`
+	CreatedAt               pgtype.Timestamp `json:"created_at"`
+}
+
+type TransactionRevenue struct {
 	TransactionID int32            `json:"transaction_id"`
-	OrderID       int32            `json:"order_id"`
 	MerchantID    int32            `json:"merchant_id"`
-	PaymentMethod string           `json:"payment_method"`
 	Amount        int32            `json:"amount"`
-	ChangeAmount  *int32           `json:"change_amount"`
-	PaymentStatus string           `json:"payment_status"`
+	TaxAmount     int32            `json:"tax_amount"`
 	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
-	DeletedAt     pgtype.Timestamp `json:"deleted_at"`
 }
 
 type User struct {
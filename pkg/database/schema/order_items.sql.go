@@ -17,6 +17,7 @@ FROM order_items
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 `
 
 // CalculateTotalPrice: Calculates total price of active order items for a specific order
@@ -45,9 +46,10 @@ INSERT INTO
         order_id,
         product_id,
         quantity,
-        price
+        price,
+        variant_id
     )
-VALUES ($1, $2, $3, $4)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING
     order_item_id,
     order_id,
@@ -55,14 +57,16 @@ RETURNING
     quantity,
     price,
     created_at,
-    updated_at
+    updated_at,
+    variant_id
 `
 
 type CreateOrderItemParams struct {
-	OrderID   int32 `json:"order_id"`
-	ProductID int32 `json:"product_id"`
-	Quantity  int32 `json:"quantity"`
-	Price     int32 `json:"price"`
+	OrderID   int32  `json:"order_id"`
+	ProductID int32  `json:"product_id"`
+	Quantity  int32  `json:"quantity"`
+	Price     int32  `json:"price"`
+	VariantID *int32 `json:"variant_id"`
 }
 
 type CreateOrderItemRow struct {
@@ -73,6 +77,7 @@ type CreateOrderItemRow struct {
 	Price       int32            `json:"price"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	VariantID   *int32           `json:"variant_id"`
 }
 
 // CreateOrderItem: Inserts a new order item record
@@ -83,6 +88,7 @@ type CreateOrderItemRow struct {
 //	$2: product_id
 //	$3: quantity
 //	$4: price
+//	$5: variant_id (nullable for products without variants)
 //
 // Returns:
 //
@@ -96,6 +102,7 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		arg.ProductID,
 		arg.Quantity,
 		arg.Price,
+		arg.VariantID,
 	)
 	var i CreateOrderItemRow
 	err := row.Scan(
@@ -106,12 +113,15 @@ func (q *Queries) CreateOrderItem(ctx context.Context, arg CreateOrderItemParams
 		&i.Price,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VariantID,
 	)
 	return &i, err
 }
 
 const deleteAllPermanentOrdersItem = `-- name: DeleteAllPermanentOrdersItem :exec
-DELETE FROM order_items WHERE deleted_at IS NOT NULL
+DELETE FROM order_items WHERE
+    deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 `
 
 // DeleteAllPermanentOrdersItem: Permanently deletes all trashed order items
@@ -130,6 +140,7 @@ DELETE FROM order_items
 WHERE
     order_item_id = $1
     AND deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 `
 
 // DeleteOrderItemPermanently: Permanently deletes a trashed order item
@@ -147,6 +158,79 @@ func (q *Queries) DeleteOrderItemPermanently(ctx context.Context, orderItemID in
 	return err
 }
 
+const getOrderItemTaxLines = `-- name: GetOrderItemTaxLines :many
+SELECT
+    order_item_id,
+    product_id,
+    variant_id,
+    quantity,
+    price,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount
+FROM order_items
+WHERE
+    order_id = $1
+    AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
+ORDER BY order_item_id ASC
+`
+
+type GetOrderItemTaxLinesRow struct {
+	OrderItemID  int32  `json:"order_item_id"`
+	ProductID    int32  `json:"product_id"`
+	VariantID    *int32 `json:"variant_id"`
+	Quantity     int32  `json:"quantity"`
+	Price        int32  `json:"price"`
+	TaxRateID    *int32 `json:"tax_rate_id"`
+	TaxRateBps   int32  `json:"tax_rate_bps"`
+	TaxInclusive bool   `json:"tax_inclusive"`
+	TaxRounding  string `json:"tax_rounding"`
+	TaxAmount    int32  `json:"tax_amount"`
+}
+
+// GetOrderItemTaxLines: Retrieves the per-line tax breakdown of an order
+// Purpose: Rebuild the tax section of a receipt
+// Parameters:
+//
+//	$1: order_id
+//
+// Returns:
+//
+//	Line quantity, price and the tax recorded for it
+func (q *Queries) GetOrderItemTaxLines(ctx context.Context, orderID int32) ([]*GetOrderItemTaxLinesRow, error) {
+	rows, err := q.db.Query(ctx, getOrderItemTaxLines, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetOrderItemTaxLinesRow
+	for rows.Next() {
+		var i GetOrderItemTaxLinesRow
+		if err := rows.Scan(
+			&i.OrderItemID,
+			&i.ProductID,
+			&i.VariantID,
+			&i.Quantity,
+			&i.Price,
+			&i.TaxRateID,
+			&i.TaxRateBps,
+			&i.TaxInclusive,
+			&i.TaxRounding,
+			&i.TaxAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOrderItems = `-- name: GetOrderItems :many
 SELECT
     order_item_id,
@@ -160,6 +244,7 @@ SELECT
 FROM order_items
 WHERE
     deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
@@ -248,6 +333,7 @@ SELECT
 FROM order_items
 WHERE
     deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
@@ -330,11 +416,13 @@ SELECT
     quantity,
     price,
     created_at,
-    updated_at
+    updated_at,
+    variant_id
 FROM order_items
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 `
 
 type GetOrderItemsByOrderRow struct {
@@ -345,6 +433,7 @@ type GetOrderItemsByOrderRow struct {
 	Price       int32            `json:"price"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	VariantID   *int32           `json:"variant_id"`
 }
 
 // GetOrderItemsByOrder: Retrieves active order items for a specific order
@@ -376,6 +465,7 @@ func (q *Queries) GetOrderItemsByOrder(ctx context.Context, orderID int32) ([]*G
 			&i.Price,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.VariantID,
 		); err != nil {
 			return nil, err
 		}
@@ -396,11 +486,18 @@ SELECT
     price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount,
+    variant_id
 FROM order_items
 WHERE
     order_id = $1
     AND deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 `
 
 func (q *Queries) GetOrderItemsByOrderTrashed(ctx context.Context, orderID int32) ([]*OrderItem, error) {
@@ -421,6 +518,12 @@ func (q *Queries) GetOrderItemsByOrderTrashed(ctx context.Context, orderID int32
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.TaxRateID,
+			&i.TaxRateBps,
+			&i.TaxInclusive,
+			&i.TaxRounding,
+			&i.TaxAmount,
+			&i.VariantID,
 		); err != nil {
 			return nil, err
 		}
@@ -446,6 +549,7 @@ SELECT
 FROM order_items
 WHERE
     deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
@@ -528,6 +632,7 @@ SET
     deleted_at = NULL
 WHERE
     deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 `
 
 // RestoreAllOrdersItem: Restores all soft-deleted order items
@@ -548,6 +653,7 @@ SET
 WHERE
     order_item_id = $1
     AND deleted_at IS NOT NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 RETURNING
     order_item_id,
     order_id,
@@ -556,7 +662,13 @@ RETURNING
     price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount,
+    variant_id
 `
 
 // RestoreOrderItem: Restores a previously trashed order item
@@ -583,6 +695,12 @@ func (q *Queries) RestoreOrderItem(ctx context.Context, orderItemID int32) (*Ord
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.TaxRateBps,
+		&i.TaxInclusive,
+		&i.TaxRounding,
+		&i.TaxAmount,
+		&i.VariantID,
 	)
 	return &i, err
 }
@@ -594,6 +712,7 @@ SET
 WHERE
     order_item_id = $1
     AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 RETURNING
     order_item_id,
     order_id,
@@ -602,7 +721,13 @@ RETURNING
     price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    tax_rate_id,
+    tax_rate_bps,
+    tax_inclusive,
+    tax_rounding,
+    tax_amount,
+    variant_id
 `
 
 // TrashOrderItem: Soft-deletes a specific order item
@@ -629,6 +754,12 @@ func (q *Queries) TrashOrderItem(ctx context.Context, orderItemID int32) (*Order
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.TaxRateID,
+		&i.TaxRateBps,
+		&i.TaxInclusive,
+		&i.TaxRounding,
+		&i.TaxAmount,
+		&i.VariantID,
 	)
 	return &i, err
 }
@@ -638,10 +769,13 @@ UPDATE order_items
 SET
     quantity = $2,
     price = $3,
+    product_id = $4,
+    variant_id = $5,
     updated_at = CURRENT_TIMESTAMP
 WHERE
     order_item_id = $1
     AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
 RETURNING
     order_item_id,
     order_id,
@@ -649,13 +783,16 @@ RETURNING
     quantity,
     price,
     created_at,
-    updated_at
+    updated_at,
+    variant_id
 `
 
 type UpdateOrderItemParams struct {
-	OrderItemID int32 `json:"order_item_id"`
-	Quantity    int32 `json:"quantity"`
-	Price       int32 `json:"price"`
+	OrderItemID int32  `json:"order_item_id"`
+	Quantity    int32  `json:"quantity"`
+	Price       int32  `json:"price"`
+	ProductID   int32  `json:"product_id"`
+	VariantID   *int32 `json:"variant_id"`
 }
 
 type UpdateOrderItemRow struct {
@@ -666,15 +803,18 @@ type UpdateOrderItemRow struct {
 	Price       int32            `json:"price"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	VariantID   *int32           `json:"variant_id"`
 }
 
-// UpdateOrderItem: Updates quantity and price of an existing order item
+// UpdateOrderItem: Updates the product, quantity and price of an existing order item
 // Purpose: Allows modification of product details in an order
 // Parameters:
 //
 //	$1: order_item_id
 //	$2: new quantity
 //	$3: new price
+//	$4: new product_id
+//	$5: new variant_id (nullable for products without variants)
 //
 // Returns:
 //
@@ -684,7 +824,13 @@ type UpdateOrderItemRow struct {
 //   - Applies changes only to active items
 //   - Automatically updates `updated_at` timestamp
 func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams) (*UpdateOrderItemRow, error) {
-	row := q.db.QueryRow(ctx, updateOrderItem, arg.OrderItemID, arg.Quantity, arg.Price)
+	row := q.db.QueryRow(ctx, updateOrderItem,
+		arg.OrderItemID,
+		arg.Quantity,
+		arg.Price,
+		arg.ProductID,
+		arg.VariantID,
+	)
 	var i UpdateOrderItemRow
 	err := row.Scan(
 		&i.OrderItemID,
@@ -694,6 +840,56 @@ func (q *Queries) UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams
 		&i.Price,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VariantID,
 	)
 	return &i, err
 }
+
+const updateOrderItemTax = `-- name: UpdateOrderItemTax :exec
+UPDATE order_items
+SET
+    tax_rate_id = $2,
+    tax_rate_bps = $3,
+    tax_inclusive = $4,
+    tax_rounding = $5,
+    tax_amount = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    order_item_id = $1
+    AND deleted_at IS NULL
+    AND order_id IN (SELECT order_id FROM orders WHERE in_merchant_scope(merchant_id))
+`
+
+type UpdateOrderItemTaxParams struct {
+	OrderItemID  int32  `json:"order_item_id"`
+	TaxRateID    *int32 `json:"tax_rate_id"`
+	TaxRateBps   int32  `json:"tax_rate_bps"`
+	TaxInclusive bool   `json:"tax_inclusive"`
+	TaxRounding  string `json:"tax_rounding"`
+	TaxAmount    int32  `json:"tax_amount"`
+}
+
+// UpdateOrderItemTax: Records the tax charged on an order line
+// Purpose: Keep a per-line tax breakdown so receipts can be rebuilt
+// Parameters:
+//
+//	$1: order_item_id
+//	$2: tax_rate_id - Applied tax rate (nullable when untaxed)
+//	$3: tax_rate_bps - Rate in basis points at the time of sale
+//	$4: tax_inclusive - Whether the line price already included the tax
+//	$5: tax_rounding - Rounding rule used for the line
+//	$6: tax_amount - Tax charged on the line
+//
+// Business Logic:
+//   - Snapshots the rate so later rate changes do not alter past sales
+func (q *Queries) UpdateOrderItemTax(ctx context.Context, arg UpdateOrderItemTaxParams) error {
+	_, err := q.db.Exec(ctx, updateOrderItemTax,
+		arg.OrderItemID,
+		arg.TaxRateID,
+		arg.TaxRateBps,
+		arg.TaxInclusive,
+		arg.TaxRounding,
+		arg.TaxAmount,
+	)
+	return err
+}
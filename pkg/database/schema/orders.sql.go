@@ -25,6 +25,7 @@ RETURNING
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at
 `
@@ -40,6 +41,7 @@ type CreateOrderRow struct {
 	MerchantID int32            `json:"merchant_id"`
 	CashierID  int32            `json:"cashier_id"`
 	TotalPrice int64            `json:"total_price"`
+	Status     string           `json:"status"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 }
@@ -65,6 +67,7 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (*Crea
 		&i.MerchantID,
 		&i.CashierID,
 		&i.TotalPrice,
+		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -72,7 +75,7 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (*Crea
 }
 
 const deleteAllPermanentOrders = `-- name: DeleteAllPermanentOrders :exec
-DELETE FROM orders WHERE deleted_at IS NOT NULL
+DELETE FROM orders WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id)
 `
 
 // DeleteAllPermanentOrders: Purges all cancelled orders
@@ -87,8 +90,8 @@ func (q *Queries) DeleteAllPermanentOrders(ctx context.Context) error {
 	return err
 }
 
-const deleteOrderPermanently = `-- name: DeleteOrderPermanently :exec
-DELETE FROM orders WHERE order_id = $1 AND deleted_at IS NOT NULL
+const deleteOrderPermanently = `-- name: DeleteOrderPermanently :execrows
+DELETE FROM orders WHERE order_id = $1 AND deleted_at IS NOT NULL AND in_merchant_scope(merchant_id)
 `
 
 // DeleteOrderPermanently: Hard-deletes an order
@@ -99,12 +102,15 @@ DELETE FROM orders WHERE order_id = $1 AND deleted_at IS NOT NULL
 //
 // Business Logic:
 //   - Permanent deletion of already cancelled orders
-//   - No return value (exec-only operation)
+//   - Returns the number of deleted rows, 0 when no trashed record matched
 //   - Irreversible action - use with caution
 //   - Should trigger deletion of related order_items
-func (q *Queries) DeleteOrderPermanently(ctx context.Context, orderID int32) error {
-	_, err := q.db.Exec(ctx, deleteOrderPermanently, orderID)
-	return err
+func (q *Queries) DeleteOrderPermanently(ctx context.Context, orderID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrderPermanently, orderID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getMonthlyOrder = `-- name: GetMonthlyOrder :many
@@ -123,6 +129,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND o.created_at BETWEEN (
                 SELECT start_date
                 FROM date_range
@@ -205,6 +212,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND o.created_at BETWEEN (
                 SELECT start_date
                 FROM date_range
@@ -293,6 +301,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -418,6 +427,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -547,6 +557,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 (
                     o.created_at >= $1
@@ -667,12 +678,14 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at
 FROM orders
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 type GetOrderByIDRow struct {
@@ -680,6 +693,7 @@ type GetOrderByIDRow struct {
 	MerchantID int32            `json:"merchant_id"`
 	CashierID  int32            `json:"cashier_id"`
 	TotalPrice int64            `json:"total_price"`
+	Status     string           `json:"status"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 }
@@ -703,6 +717,7 @@ func (q *Queries) GetOrderByID(ctx context.Context, orderID int32) (*GetOrderByI
 		&i.MerchantID,
 		&i.CashierID,
 		&i.TotalPrice,
+		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -717,11 +732,13 @@ SELECT
     total_price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    status
 FROM orders
 WHERE
     order_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 func (q *Queries) GetOrderByIDTrashed(ctx context.Context, orderID int32) (*Order, error) {
@@ -735,6 +752,7 @@ func (q *Queries) GetOrderByIDTrashed(ctx context.Context, orderID int32) (*Orde
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
 	)
 	return &i, err
 }
@@ -745,17 +763,23 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at,
     COUNT(*) OVER () AS total_count
 FROM orders
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND (
+        $4::TEXT = ''
+        OR status = $4
+    )
 ORDER BY created_at DESC
 LIMIT $2
 OFFSET
@@ -766,6 +790,7 @@ type GetOrdersParams struct {
 	Column1 string `json:"column_1"`
 	Limit   int32  `json:"limit"`
 	Offset  int32  `json:"offset"`
+	Column4 string `json:"column_4"`
 }
 
 type GetOrdersRow struct {
@@ -773,6 +798,7 @@ type GetOrdersRow struct {
 	MerchantID int32            `json:"merchant_id"`
 	CashierID  int32            `json:"cashier_id"`
 	TotalPrice int64            `json:"total_price"`
+	Status     string           `json:"status"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 	TotalCount int64            `json:"total_count"`
@@ -785,6 +811,7 @@ type GetOrdersRow struct {
 //	$1: search_term - Optional text to filter orders by ID or total price (NULL for no filter)
 //	$2: limit - Maximum number of records to return (pagination limit)
 //	$3: offset - Number of records to skip (pagination offset)
+//	$4: status - Optional order status to filter by (empty for every status)
 //
 // Returns:
 //
@@ -797,7 +824,12 @@ type GetOrdersRow struct {
 //   - Provides total_count for client-side pagination
 //   - Uses window function COUNT(*) OVER() for efficient total count
 func (q *Queries) GetOrders(ctx context.Context, arg GetOrdersParams) ([]*GetOrdersRow, error) {
-	rows, err := q.db.Query(ctx, getOrders, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getOrders,
+		arg.Column1,
+		arg.Limit,
+		arg.Offset,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -810,6 +842,7 @@ func (q *Queries) GetOrders(ctx context.Context, arg GetOrdersParams) ([]*GetOrd
 			&i.MerchantID,
 			&i.CashierID,
 			&i.TotalPrice,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.TotalCount,
@@ -830,6 +863,7 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at,
     deleted_at,
@@ -837,11 +871,16 @@ SELECT
 FROM orders
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND (
+        $4::TEXT = ''
+        OR status = $4
+    )
 ORDER BY created_at DESC
 LIMIT $2
 OFFSET
@@ -852,6 +891,7 @@ type GetOrdersActiveParams struct {
 	Column1 string `json:"column_1"`
 	Limit   int32  `json:"limit"`
 	Offset  int32  `json:"offset"`
+	Column4 string `json:"column_4"`
 }
 
 type GetOrdersActiveRow struct {
@@ -859,6 +899,7 @@ type GetOrdersActiveRow struct {
 	MerchantID int32            `json:"merchant_id"`
 	CashierID  int32            `json:"cashier_id"`
 	TotalPrice int64            `json:"total_price"`
+	Status     string           `json:"status"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 	DeletedAt  pgtype.Timestamp `json:"deleted_at"`
@@ -872,6 +913,7 @@ type GetOrdersActiveRow struct {
 //	$1: search_term - Optional filter text for order ID or price
 //	$2: limit - Pagination limit
 //	$3: offset - Pagination offset
+//	$4: status - Optional order status to filter by (empty for every status)
 //
 // Returns:
 //
@@ -883,7 +925,12 @@ type GetOrdersActiveRow struct {
 //
 // Note: Could be consolidated with GetOrders if duplicate functionality is undesired
 func (q *Queries) GetOrdersActive(ctx context.Context, arg GetOrdersActiveParams) ([]*GetOrdersActiveRow, error) {
-	rows, err := q.db.Query(ctx, getOrdersActive, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getOrdersActive,
+		arg.Column1,
+		arg.Limit,
+		arg.Offset,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -896,6 +943,7 @@ func (q *Queries) GetOrdersActive(ctx context.Context, arg GetOrdersActiveParams
 			&i.MerchantID,
 			&i.CashierID,
 			&i.TotalPrice,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -912,18 +960,29 @@ func (q *Queries) GetOrdersActive(ctx context.Context, arg GetOrdersActiveParams
 }
 
 const getOrdersByMerchant = `-- name: GetOrdersByMerchant :many
-SELECT order_id, merchant_id, cashier_id, total_price, created_at, updated_at, deleted_at, COUNT(*) OVER () AS total_count
+SELECT
+    order_id,
+    merchant_id,
+    cashier_id,
+    total_price,
+    status,
+    created_at,
+    updated_at,
+    deleted_at,
+    COUNT(*) OVER () AS total_count
 FROM orders
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND merchant_id = $4
     AND (
-        $4::UUID IS NULL
-        OR merchant_id = $4
+        $5::TEXT = ''
+        OR status = $5
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -932,10 +991,11 @@ OFFSET
 `
 
 type GetOrdersByMerchantParams struct {
-	Column1 string      `json:"column_1"`
-	Limit   int32       `json:"limit"`
-	Offset  int32       `json:"offset"`
-	Column4 pgtype.UUID `json:"column_4"`
+	Column1 string `json:"column_1"`
+	Limit   int32  `json:"limit"`
+	Offset  int32  `json:"offset"`
+	Column4 int32  `json:"column_4"`
+	Column5 string `json:"column_5"`
 }
 
 type GetOrdersByMerchantRow struct {
@@ -943,6 +1003,7 @@ type GetOrdersByMerchantRow struct {
 	MerchantID int32            `json:"merchant_id"`
 	CashierID  int32            `json:"cashier_id"`
 	TotalPrice int64            `json:"total_price"`
+	Status     string           `json:"status"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 	DeletedAt  pgtype.Timestamp `json:"deleted_at"`
@@ -956,7 +1017,8 @@ type GetOrdersByMerchantRow struct {
 //	$1: search_term - Optional text to filter orders
 //	$2: limit - Pagination limit
 //	$3: offset - Pagination offset
-//	$4: merchant_id - Optional merchant UUID to filter by (NULL for all merchants)
+//	$4: merchant_id - Merchant whose orders are listed
+//	$5: status - Optional order status to filter by (empty for every status)
 //
 // Returns:
 //
@@ -966,13 +1028,13 @@ type GetOrdersByMerchantRow struct {
 //   - Combines merchant filtering with search functionality
 //   - Maintains same sorting and pagination as other order queries
 //   - Useful for merchant-specific order dashboards
-//   - NULL merchant_id parameter returns all merchants' orders
 func (q *Queries) GetOrdersByMerchant(ctx context.Context, arg GetOrdersByMerchantParams) ([]*GetOrdersByMerchantRow, error) {
 	rows, err := q.db.Query(ctx, getOrdersByMerchant,
 		arg.Column1,
 		arg.Limit,
 		arg.Offset,
 		arg.Column4,
+		arg.Column5,
 	)
 	if err != nil {
 		return nil, err
@@ -986,6 +1048,7 @@ func (q *Queries) GetOrdersByMerchant(ctx context.Context, arg GetOrdersByMercha
 			&i.MerchantID,
 			&i.CashierID,
 			&i.TotalPrice,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -1007,6 +1070,7 @@ SELECT
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at,
     deleted_at,
@@ -1014,11 +1078,16 @@ SELECT
 FROM orders
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR order_id::TEXT ILIKE '%' || $1 || '%'
         OR total_price::TEXT ILIKE '%' || $1 || '%'
     )
+    AND (
+        $4::TEXT = ''
+        OR status = $4
+    )
 ORDER BY created_at DESC
 LIMIT $2
 OFFSET
@@ -1029,6 +1098,7 @@ type GetOrdersTrashedParams struct {
 	Column1 string `json:"column_1"`
 	Limit   int32  `json:"limit"`
 	Offset  int32  `json:"offset"`
+	Column4 string `json:"column_4"`
 }
 
 type GetOrdersTrashedRow struct {
@@ -1036,6 +1106,7 @@ type GetOrdersTrashedRow struct {
 	MerchantID int32            `json:"merchant_id"`
 	CashierID  int32            `json:"cashier_id"`
 	TotalPrice int64            `json:"total_price"`
+	Status     string           `json:"status"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 	DeletedAt  pgtype.Timestamp `json:"deleted_at"`
@@ -1049,6 +1120,7 @@ type GetOrdersTrashedRow struct {
 //	$1: search_term - Optional text to filter trashed orders
 //	$2: limit - Maximum records per page
 //	$3: offset - Records to skip
+//	$4: status - Optional order status to filter by (empty for every status)
 //
 // Returns:
 //
@@ -1061,7 +1133,12 @@ type GetOrdersTrashedRow struct {
 //   - Used in order recovery/audit interfaces
 //   - Includes total_count for pagination in trash management UI
 func (q *Queries) GetOrdersTrashed(ctx context.Context, arg GetOrdersTrashedParams) ([]*GetOrdersTrashedRow, error) {
-	rows, err := q.db.Query(ctx, getOrdersTrashed, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getOrdersTrashed,
+		arg.Column1,
+		arg.Limit,
+		arg.Offset,
+		arg.Column4,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1074,6 +1151,7 @@ func (q *Queries) GetOrdersTrashed(ctx context.Context, arg GetOrdersTrashedPara
 			&i.MerchantID,
 			&i.CashierID,
 			&i.TotalPrice,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -1107,6 +1185,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND EXTRACT(
                 YEAR
                 FROM o.created_at
@@ -1211,6 +1290,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND EXTRACT(
                 YEAR
                 FROM o.created_at
@@ -1315,6 +1395,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -1395,6 +1476,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -1482,6 +1564,7 @@ WITH
         WHERE
             o.deleted_at IS NULL
             AND oi.deleted_at IS NULL
+            AND in_merchant_scope(o.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -1563,6 +1646,7 @@ SET
     deleted_at = NULL
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // RestoreAllOrders: Mass restoration of cancelled orders
@@ -1583,6 +1667,7 @@ SET
 WHERE
     order_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     order_id,
     merchant_id,
@@ -1590,7 +1675,8 @@ RETURNING
     total_price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    status
 `
 
 // RestoreOrder: Recovers a soft-deleted order
@@ -1615,6 +1701,7 @@ func (q *Queries) RestoreOrder(ctx context.Context, orderID int32) (*Order, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
 	)
 	return &i, err
 }
@@ -1626,6 +1713,7 @@ SET
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     order_id,
     merchant_id,
@@ -1633,7 +1721,8 @@ RETURNING
     total_price,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    status
 `
 
 // TrashedOrder: Soft-deletes an order
@@ -1659,6 +1748,7 @@ func (q *Queries) TrashedOrder(ctx context.Context, orderID int32) (*Order, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Status,
 	)
 	return &i, err
 }
@@ -1671,11 +1761,13 @@ SET
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     order_id,
     merchant_id,
     cashier_id,
     total_price,
+    status,
     created_at,
     updated_at
 `
@@ -1690,6 +1782,7 @@ type UpdateOrderRow struct {
 	MerchantID int32            `json:"merchant_id"`
 	CashierID  int32            `json:"cashier_id"`
 	TotalPrice int64            `json:"total_price"`
+	Status     string           `json:"status"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 }
@@ -1715,8 +1808,65 @@ func (q *Queries) UpdateOrder(ctx context.Context, arg UpdateOrderParams) (*Upda
 		&i.MerchantID,
 		&i.CashierID,
 		&i.TotalPrice,
+		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return &i, err
 }
+
+const updateOrderStatus = `-- name: UpdateOrderStatus :one
+UPDATE orders
+SET
+    status = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    order_id = $1
+    AND status = $2
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    order_id,
+    merchant_id,
+    cashier_id,
+    total_price,
+    created_at,
+    updated_at,
+    deleted_at,
+    status
+`
+
+type UpdateOrderStatusParams struct {
+	OrderID  int32  `json:"order_id"`
+	Status   string `json:"status"`
+	Status_2 string `json:"status_2"`
+}
+
+// UpdateOrderStatus: Moves an order to its next lifecycle status
+// Purpose: Apply a transition checked by the order state machine
+// Parameters:
+//
+//	$1: order_id - ID of the order
+//	$2: from_status - Status the transition was validated against
+//	$3: to_status - New status
+//
+// Returns: The updated order record
+// Business Logic:
+//   - Only succeeds while the order is still in from_status, so two
+//     concurrent transitions cannot both apply
+//   - Only modifies active (non-deleted) orders
+func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (*Order, error) {
+	row := q.db.QueryRow(ctx, updateOrderStatus, arg.OrderID, arg.Status, arg.Status_2)
+	var i Order
+	err := row.Scan(
+		&i.OrderID,
+		&i.MerchantID,
+		&i.CashierID,
+		&i.TotalPrice,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+	)
+	return &i, err
+}
@@ -11,6 +11,103 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimLowStockAlerts = `-- name: ClaimLowStockAlerts :many
+UPDATE products
+SET
+    low_stock_alerted_at = CURRENT_TIMESTAMP
+WHERE
+    product_id IN (
+        SELECT product_id
+        FROM products
+        WHERE
+            deleted_at IS NULL
+            AND reorder_point > 0
+            AND count_in_stock <= reorder_point
+            AND low_stock_alerted_at IS NULL
+        ORDER BY product_id
+        LIMIT $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING
+    product_id,
+    merchant_id,
+    name,
+    count_in_stock,
+    reorder_point,
+    reorder_quantity
+`
+
+type ClaimLowStockAlertsRow struct {
+	ProductID       int32  `json:"product_id"`
+	MerchantID      int32  `json:"merchant_id"`
+	Name            string `json:"name"`
+	CountInStock    int32  `json:"count_in_stock"`
+	ReorderPoint    int32  `json:"reorder_point"`
+	ReorderQuantity int32  `json:"reorder_quantity"`
+}
+
+// ClaimLowStockAlerts: Marks newly low products as alerted
+// Purpose: Pick the products a low-stock alert has to be sent for
+// Parameters:
+//
+//	$1: Maximum number of products to claim
+//
+// Returns:
+//
+//	Reorder fields of the claimed products
+//
+// Business Logic:
+//   - A product is claimed once per dip below its reorder point
+//   - SKIP LOCKED lets several servers evaluate without alerting twice
+func (q *Queries) ClaimLowStockAlerts(ctx context.Context, limit int32) ([]*ClaimLowStockAlertsRow, error) {
+	rows, err := q.db.Query(ctx, claimLowStockAlerts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClaimLowStockAlertsRow
+	for rows.Next() {
+		var i ClaimLowStockAlertsRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.MerchantID,
+			&i.Name,
+			&i.CountInStock,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countProductsByImage = `-- name: CountProductsByImage :one
+SELECT COUNT(*)
+FROM products
+WHERE image_product = $1::text
+`
+
+// CountProductsByImage: Counts products referring to an image
+// Purpose: Tell whether a stored image can be removed
+// Parameters:
+//
+//	$1: image_product - Storage key of the image
+//
+// Returns: Number of products, trashed ones included, using the image
+// Business Logic:
+//   - Counts the products of every merchant, since identical uploads share an image
+func (q *Queries) CountProductsByImage(ctx context.Context, imageProduct string) (int64, error) {
+	row := q.db.QueryRow(ctx, countProductsByImage, imageProduct)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createProduct = `-- name: CreateProduct :one
 INSERT INTO
     products (
@@ -143,7 +240,7 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (*
 }
 
 const deleteAllPermanentProducts = `-- name: DeleteAllPermanentProducts :exec
-DELETE FROM products WHERE deleted_at IS NOT NULL
+DELETE FROM products WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id)
 `
 
 // DeleteAllPermanentProducts: Purges all trashed products
@@ -161,6 +258,7 @@ DELETE FROM products
 WHERE
     product_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // DeleteProductPermanently: Hard-deletes a product
@@ -178,7 +276,94 @@ func (q *Queries) DeleteProductPermanently(ctx context.Context, productID int32)
 	return err
 }
 
-const getProductByID = `-- name: GetProductByID :one
+const getLowStockProducts = `-- name: GetLowStockProducts :many
+SELECT
+    product_id,
+    merchant_id,
+    category_id,
+    name,
+    count_in_stock,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at,
+    COUNT(*) OVER () AS total_count
+FROM products
+WHERE
+    merchant_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+    AND reorder_point > 0
+    AND count_in_stock <= reorder_point
+ORDER BY (count_in_stock - reorder_point) ASC, product_id ASC
+LIMIT $2
+OFFSET
+    $3
+`
+
+type GetLowStockProductsParams struct {
+	MerchantID int32 `json:"merchant_id"`
+	Limit      int32 `json:"limit"`
+	Offset     int32 `json:"offset"`
+}
+
+type GetLowStockProductsRow struct {
+	ProductID         int32            `json:"product_id"`
+	MerchantID        int32            `json:"merchant_id"`
+	CategoryID        int32            `json:"category_id"`
+	Name              string           `json:"name"`
+	CountInStock      int32            `json:"count_in_stock"`
+	ReorderPoint      int32            `json:"reorder_point"`
+	ReorderQuantity   int32            `json:"reorder_quantity"`
+	LowStockAlertedAt pgtype.Timestamp `json:"low_stock_alerted_at"`
+	TotalCount        int64            `json:"total_count"`
+}
+
+// GetLowStockProducts: Lists the products of a merchant that need reordering
+// Purpose: Show store managers what to reorder
+// Parameters:
+//
+//	$1: merchant_id - Merchant whose products are listed
+//	$2: Limit (number of records per page)
+//	$3: Offset (starting index for pagination)
+//
+// Returns:
+//
+//	Reorder fields of each low product and total_count (for pagination support)
+//
+// Business Logic:
+//   - Only products with a reorder point are considered
+//   - Products furthest below their reorder point come first
+func (q *Queries) GetLowStockProducts(ctx context.Context, arg GetLowStockProductsParams) ([]*GetLowStockProductsRow, error) {
+	rows, err := q.db.Query(ctx, getLowStockProducts, arg.MerchantID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetLowStockProductsRow
+	for rows.Next() {
+		var i GetLowStockProductsRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.MerchantID,
+			&i.CategoryID,
+			&i.Name,
+			&i.CountInStock,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.LowStockAlertedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductByBarcode = `-- name: GetProductByBarcode :one
 SELECT
     product_id,
     merchant_id,
@@ -196,10 +381,96 @@ SELECT
     updated_at
 FROM products
 WHERE
-    product_id = $1
+    barcode = $1
     AND deleted_at IS NULL
 `
 
+type GetProductByBarcodeRow struct {
+	ProductID    int32            `json:"product_id"`
+	MerchantID   int32            `json:"merchant_id"`
+	CategoryID   int32            `json:"category_id"`
+	Name         string           `json:"name"`
+	Description  *string          `json:"description"`
+	Price        int32            `json:"price"`
+	CountInStock int32            `json:"count_in_stock"`
+	Brand        *string          `json:"brand"`
+	Weight       *int32           `json:"weight"`
+	SlugProduct  *string          `json:"slug_product"`
+	ImageProduct *string          `json:"image_product"`
+	Barcode      *string          `json:"barcode"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
+
+// GetProductByBarcode: Retrieves active product by barcode
+// Purpose: Resolve a scanned barcode to a product
+// Parameters:
+//
+//	$1: barcode - Barcode printed on the product
+//
+// Returns: Full product details if found and active
+// Business Logic:
+//   - Excludes deleted products
+//   - Barcodes are unique across every merchant, so the lookup is not limited
+//     to the caller's merchants
+func (q *Queries) GetProductByBarcode(ctx context.Context, barcode *string) (*GetProductByBarcodeRow, error) {
+	row := q.db.QueryRow(ctx, getProductByBarcode, barcode)
+	var i GetProductByBarcodeRow
+	err := row.Scan(
+		&i.ProductID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.CountInStock,
+		&i.Brand,
+		&i.Weight,
+		&i.SlugProduct,
+		&i.ImageProduct,
+		&i.Barcode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const getProductByID = `-- name: GetProductByID :one
+SELECT
+    p.product_id,
+    p.merchant_id,
+    p.category_id,
+    p.name,
+    p.description,
+    p.price,
+    p.count_in_stock,
+    p.brand,
+    p.weight,
+    p.slug_product,
+    p.image_product,
+    p.barcode,
+    p.created_at,
+    p.updated_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price
+FROM products p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
+WHERE
+    p.product_id = $1
+    AND p.deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id)
+`
+
 type GetProductByIDRow struct {
 	ProductID    int32            `json:"product_id"`
 	MerchantID   int32            `json:"merchant_id"`
@@ -215,6 +486,9 @@ type GetProductByIDRow struct {
 	Barcode      *string          `json:"barcode"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	VariantCount int32            `json:"variant_count"`
+	MinPrice     int32            `json:"min_price"`
+	MaxPrice     int32            `json:"max_price"`
 }
 
 // GetProductByID: Retrieves active product by ID
@@ -223,7 +497,10 @@ type GetProductByIDRow struct {
 //
 //	$1: product_id - ID of product to retrieve
 //
-// Returns: Full product record if active
+// Returns: Full product record if active, with its variant_count and the
+//
+//	min_price and max_price of its live variants
+//
 // Business Logic:
 //   - Excludes deleted products
 //   - Used for product pages and checkout
@@ -245,6 +522,9 @@ func (q *Queries) GetProductByID(ctx context.Context, productID int32) (*GetProd
 		&i.Barcode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VariantCount,
+		&i.MinPrice,
+		&i.MaxPrice,
 	)
 	return &i, err
 }
@@ -268,6 +548,7 @@ SELECT
 FROM products
 WHERE
     product_id = $1
+    AND in_merchant_scope(merchant_id)
 `
 
 type GetProductByIdTrashedRow struct {
@@ -319,6 +600,106 @@ func (q *Queries) GetProductByIdTrashed(ctx context.Context, productID int32) (*
 	return &i, err
 }
 
+const getProductByScan = `-- name: GetProductByScan :one
+SELECT
+    p.product_id,
+    p.merchant_id,
+    p.category_id,
+    p.name,
+    p.description,
+    p.price,
+    p.count_in_stock,
+    p.brand,
+    p.weight,
+    p.slug_product,
+    p.image_product,
+    p.barcode,
+    p.created_at,
+    p.updated_at,
+    v.variant_id,
+    v.sku AS variant_sku,
+    v.barcode AS variant_barcode,
+    v.options AS variant_options,
+    v.price AS variant_price,
+    v.count_in_stock AS variant_count_in_stock
+FROM products p
+    LEFT JOIN product_variants v ON v.product_id = p.product_id
+    AND v.barcode = $1
+    AND v.deleted_at IS NULL
+WHERE
+    p.deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id)
+    AND (
+        p.barcode = $1
+        OR v.variant_id IS NOT NULL
+    )
+LIMIT 1
+`
+
+type GetProductByScanRow struct {
+	ProductID           int32            `json:"product_id"`
+	MerchantID          int32            `json:"merchant_id"`
+	CategoryID          int32            `json:"category_id"`
+	Name                string           `json:"name"`
+	Description         *string          `json:"description"`
+	Price               int32            `json:"price"`
+	CountInStock        int32            `json:"count_in_stock"`
+	Brand               *string          `json:"brand"`
+	Weight              *int32           `json:"weight"`
+	SlugProduct         *string          `json:"slug_product"`
+	ImageProduct        *string          `json:"image_product"`
+	Barcode             *string          `json:"barcode"`
+	CreatedAt           pgtype.Timestamp `json:"created_at"`
+	UpdatedAt           pgtype.Timestamp `json:"updated_at"`
+	VariantID           *int32           `json:"variant_id"`
+	VariantSku          *string          `json:"variant_sku"`
+	VariantBarcode      *string          `json:"variant_barcode"`
+	VariantOptions      []byte           `json:"variant_options"`
+	VariantPrice        *int32           `json:"variant_price"`
+	VariantCountInStock *int32           `json:"variant_count_in_stock"`
+}
+
+// GetProductByScan: Resolves a scanned barcode to a product or one of its variants
+// Purpose: Look up what a till scanner read, whichever level the barcode is on
+// Parameters:
+//
+//	$1: barcode - Barcode read by the scanner
+//
+// Returns:
+//
+//	Product details, plus the variant columns when the barcode is a variant's
+//
+// Business Logic:
+//   - Excludes deleted products and variants
+//   - Variant columns are NULL when the barcode belongs to the product itself
+func (q *Queries) GetProductByScan(ctx context.Context, barcode *string) (*GetProductByScanRow, error) {
+	row := q.db.QueryRow(ctx, getProductByScan, barcode)
+	var i GetProductByScanRow
+	err := row.Scan(
+		&i.ProductID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.CountInStock,
+		&i.Brand,
+		&i.Weight,
+		&i.SlugProduct,
+		&i.ImageProduct,
+		&i.Barcode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.VariantID,
+		&i.VariantSku,
+		&i.VariantBarcode,
+		&i.VariantOptions,
+		&i.VariantPrice,
+		&i.VariantCountInStock,
+	)
+	return &i, err
+}
+
 const getProducts = `-- name: GetProducts :many
 SELECT
     p.product_id,
@@ -335,10 +716,24 @@ SELECT
     p.barcode,
     p.created_at,
     p.updated_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price,
     COUNT(*) OVER () AS total_count
 FROM products as p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id)
     AND (
         $1::TEXT IS NULL
         OR p.name ILIKE '%' || $1 || '%'
@@ -346,6 +741,17 @@ WHERE
         OR p.brand ILIKE '%' || $1 || '%'
         OR p.slug_product ILIKE '%' || $1 || '%'
         OR p.barcode ILIKE '%' || $1 || '%'
+        OR EXISTS (
+            SELECT 1
+            FROM product_variants v
+            WHERE
+                v.product_id = p.product_id
+                AND v.deleted_at IS NULL
+                AND (
+                    v.sku ILIKE '%' || $1 || '%'
+                    OR v.barcode ILIKE '%' || $1 || '%'
+                )
+        )
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -374,6 +780,9 @@ type GetProductsRow struct {
 	Barcode      *string          `json:"barcode"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	VariantCount int32            `json:"variant_count"`
+	MinPrice     int32            `json:"min_price"`
+	MaxPrice     int32            `json:"max_price"`
 	TotalCount   int64            `json:"total_count"`
 }
 
@@ -391,7 +800,10 @@ type GetProductsRow struct {
 //
 // Business Logic:
 //   - Excludes soft-deleted products (deleted_at IS NULL)
-//   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+//   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+//     and the SKU or barcode of any variant
+//   - variant_count, min_price and max_price summarise the live variants; a product
+//     without variants reports its own price as both bounds
 //   - Orders results by newest first (created_at DESC)
 //   - Uses COUNT(*) OVER() to include total matching record count for pagination UI
 func (q *Queries) GetProducts(ctx context.Context, arg GetProductsParams) ([]*GetProductsRow, error) {
@@ -418,6 +830,9 @@ func (q *Queries) GetProducts(ctx context.Context, arg GetProductsParams) ([]*Ge
 			&i.Barcode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.VariantCount,
+			&i.MinPrice,
+			&i.MaxPrice,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -447,10 +862,24 @@ SELECT
     p.created_at,
     p.updated_at,
     p.deleted_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price,
     COUNT(*) OVER () AS total_count
 FROM products as p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(p.merchant_id)
     AND (
         $1::TEXT IS NULL
         OR p.name ILIKE '%' || $1 || '%'
@@ -458,6 +887,17 @@ WHERE
         OR p.brand ILIKE '%' || $1 || '%'
         OR p.slug_product ILIKE '%' || $1 || '%'
         OR p.barcode ILIKE '%' || $1 || '%'
+        OR EXISTS (
+            SELECT 1
+            FROM product_variants v
+            WHERE
+                v.product_id = p.product_id
+                AND v.deleted_at IS NULL
+                AND (
+                    v.sku ILIKE '%' || $1 || '%'
+                    OR v.barcode ILIKE '%' || $1 || '%'
+                )
+        )
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -487,6 +927,9 @@ type GetProductsActiveRow struct {
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+	VariantCount int32            `json:"variant_count"`
+	MinPrice     int32            `json:"min_price"`
+	MaxPrice     int32            `json:"max_price"`
 	TotalCount   int64            `json:"total_count"`
 }
 
@@ -504,7 +947,10 @@ type GetProductsActiveRow struct {
 //
 // Business Logic:
 //   - Excludes soft-deleted products (deleted_at IS NULL)
-//   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+//   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+//     and the SKU or barcode of any variant
+//   - variant_count, min_price and max_price summarise the live variants; a product
+//     without variants reports its own price as both bounds
 //   - Ordered by newest first (created_at DESC)
 //   - Useful if frontend/backend wants clearer distinction in naming
 func (q *Queries) GetProductsActive(ctx context.Context, arg GetProductsActiveParams) ([]*GetProductsActiveRow, error) {
@@ -532,6 +978,9 @@ func (q *Queries) GetProductsActive(ctx context.Context, arg GetProductsActivePa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.VariantCount,
+			&i.MinPrice,
+			&i.MaxPrice,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -545,7 +994,20 @@ func (q *Queries) GetProductsActive(ctx context.Context, arg GetProductsActivePa
 }
 
 const getProductsByCategoryName = `-- name: GetProductsByCategoryName :many
-WITH
+WITH RECURSIVE
+    category_tree AS (
+        SELECT category_id
+        FROM categories
+        WHERE
+            name = $1
+        UNION
+        SELECT child.category_id
+        FROM categories child
+            JOIN category_tree t ON child.parent_id = t.category_id
+        WHERE
+            $7::boolean
+            AND child.deleted_at IS NULL
+    ),
     filtered_products AS (
         SELECT
             p.product_id,
@@ -563,26 +1025,66 @@ WITH
             p.created_at,
             p.updated_at,
             p.deleted_at,
+            COALESCE(pv.variant_count, 0)::INT AS variant_count,
+            COALESCE(pv.min_price, p.price)::INT AS min_price,
+            COALESCE(pv.max_price, p.price)::INT AS max_price,
             c.name AS category_name
         FROM products p
             JOIN categories c ON p.category_id = c.category_id
+            LEFT JOIN LATERAL (
+                SELECT
+                    COUNT(*)::INT AS variant_count,
+                    MIN(COALESCE(v.price, p.price)) AS min_price,
+                    MAX(COALESCE(v.price, p.price)) AS max_price
+                FROM product_variants v
+                WHERE
+                    v.product_id = p.product_id
+                    AND v.deleted_at IS NULL
+            ) pv ON TRUE
         WHERE
             p.deleted_at IS NULL
-            AND c.name = $1
+            AND in_merchant_scope(p.merchant_id)
+            AND p.category_id IN (
+                SELECT category_id
+                FROM category_tree
+            )
             AND (
                 $2::TEXT IS NULL
                 OR p.name ILIKE '%' || $2 || '%'
                 OR p.description ILIKE '%' || $2 || '%'
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND (
+                            v.sku ILIKE '%' || $2 || '%'
+                            OR v.barcode ILIKE '%' || $2 || '%'
+                        )
+                )
             )
             AND (
-                p.price >= COALESCE(NULLIF($3::INTEGER, 0), 0)
-                AND p.price <= COALESCE(NULLIF($4::INTEGER, 0), 999999999)
+                (
+                    COALESCE(pv.variant_count, 0) = 0
+                    AND p.price >= COALESCE(NULLIF($3::INTEGER, 0), 0)
+                    AND p.price <= COALESCE(NULLIF($4::INTEGER, 0), 999999999)
+                )
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND COALESCE(v.price, p.price) >= COALESCE(NULLIF($3::INTEGER, 0), 0)
+                        AND COALESCE(v.price, p.price) <= COALESCE(NULLIF($4::INTEGER, 0), 999999999)
+                )
             )
     )
 SELECT (
         SELECT COUNT(*)
         FROM filtered_products
-    ) AS total_count, fp.product_id, fp.merchant_id, fp.category_id, fp.slug_product, fp.weight, fp.name, fp.description, fp.price, fp.count_in_stock, fp.brand, fp.image_product, fp.barcode, fp.created_at, fp.updated_at, fp.deleted_at, fp.category_name
+    ) AS total_count, fp.*
 FROM filtered_products fp
 ORDER BY fp.created_at DESC
 LIMIT $5
@@ -597,6 +1099,7 @@ type GetProductsByCategoryNameParams struct {
 	Column4 int32  `json:"column_4"`
 	Limit   int32  `json:"limit"`
 	Offset  int32  `json:"offset"`
+	Column7 bool   `json:"column_7"`
 }
 
 type GetProductsByCategoryNameRow struct {
@@ -616,6 +1119,9 @@ type GetProductsByCategoryNameRow struct {
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+	VariantCount int32            `json:"variant_count"`
+	MinPrice     int32            `json:"min_price"`
+	MaxPrice     int32            `json:"max_price"`
 	CategoryName string           `json:"category_name"`
 }
 
@@ -629,6 +1135,7 @@ type GetProductsByCategoryNameRow struct {
 //	$4: max_price - Maximum price filter (0 to ignore, defaults to very high value)
 //	$5: limit - Number of products to return (pagination)
 //	$6: offset - Number of products to skip (pagination)
+//	$7: include_subcategories - Also list products of every active descendant category
 //
 // Returns:
 //   - Filtered list of product fields including category name
@@ -636,10 +1143,12 @@ type GetProductsByCategoryNameRow struct {
 //
 // Business Logic:
 //   - Excludes soft-deleted products (deleted_at IS NULL)
-//   - Matches category name exactly
-//   - Supports case-insensitive partial search on name and description
+//   - Matches category name exactly, optionally with the subtree below it
+//   - Supports case-insensitive partial search on name, description and variant SKU or barcode
 //   - Filters by category ID only if provided
-//   - Filters by price range only if values provided
+//   - Filters by price range only if values provided; a product with variants
+//     matches when any variant's effective price is in range
+//   - variant_count, min_price and max_price summarise the live variants
 //   - Ordered by newest products first (created_at DESC)
 func (q *Queries) GetProductsByCategoryName(ctx context.Context, arg GetProductsByCategoryNameParams) ([]*GetProductsByCategoryNameRow, error) {
 	rows, err := q.db.Query(ctx, getProductsByCategoryName,
@@ -649,6 +1158,7 @@ func (q *Queries) GetProductsByCategoryName(ctx context.Context, arg GetProducts
 		arg.Column4,
 		arg.Limit,
 		arg.Offset,
+		arg.Column7,
 	)
 	if err != nil {
 		return nil, err
@@ -674,6 +1184,9 @@ func (q *Queries) GetProductsByCategoryName(ctx context.Context, arg GetProducts
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.VariantCount,
+			&i.MinPrice,
+			&i.MaxPrice,
 			&i.CategoryName,
 		); err != nil {
 			return nil, err
@@ -704,15 +1217,40 @@ WITH
             p.barcode,
             p.created_at,
             p.updated_at,
+            COALESCE(pv.variant_count, 0)::INT AS variant_count,
+            COALESCE(pv.min_price, p.price)::INT AS min_price,
+            COALESCE(pv.max_price, p.price)::INT AS max_price,
             c.name AS category_name
         FROM products p
             JOIN categories c ON p.category_id = c.category_id
+            LEFT JOIN LATERAL (
+                SELECT
+                    COUNT(*)::INT AS variant_count,
+                    MIN(COALESCE(v.price, p.price)) AS min_price,
+                    MAX(COALESCE(v.price, p.price)) AS max_price
+                FROM product_variants v
+                WHERE
+                    v.product_id = p.product_id
+                    AND v.deleted_at IS NULL
+            ) pv ON TRUE
         WHERE
             p.deleted_at IS NULL
             AND p.merchant_id = $1
+            AND in_merchant_scope(p.merchant_id)
             AND (
                 p.name ILIKE '%' || COALESCE($2, '') || '%'
                 OR p.description ILIKE '%' || COALESCE($2, '') || '%'
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND (
+                            v.sku ILIKE '%' || COALESCE($2, '') || '%'
+                            OR v.barcode ILIKE '%' || COALESCE($2, '') || '%'
+                        )
+                )
                 OR $2::TEXT IS NULL
             )
             AND (
@@ -720,14 +1258,26 @@ WITH
                 OR NULLIF($3, 0) IS NULL
             )
             AND (
-                p.price >= COALESCE(NULLIF($4, 0), 0)
-                AND p.price <= COALESCE(NULLIF($5, 0), 999999999)
+                (
+                    COALESCE(pv.variant_count, 0) = 0
+                    AND p.price >= COALESCE(NULLIF($4, 0), 0)
+                    AND p.price <= COALESCE(NULLIF($5, 0), 999999999)
+                )
+                OR EXISTS (
+                    SELECT 1
+                    FROM product_variants v
+                    WHERE
+                        v.product_id = p.product_id
+                        AND v.deleted_at IS NULL
+                        AND COALESCE(v.price, p.price) >= COALESCE(NULLIF($4, 0), 0)
+                        AND COALESCE(v.price, p.price) <= COALESCE(NULLIF($5, 0), 999999999)
+                )
             )
     )
 SELECT (
         SELECT COUNT(*)
         FROM filtered_products
-    ) AS total_count, fp.product_id, fp.merchant_id, fp.category_id, fp.name, fp.description, fp.price, fp.count_in_stock, fp.brand, fp.weight, fp.slug_product, fp.image_product, fp.barcode, fp.created_at, fp.updated_at, fp.category_name
+    ) AS total_count, fp.product_id, fp.merchant_id, fp.category_id, fp.name, fp.description, fp.price, fp.count_in_stock, fp.brand, fp.weight, fp.slug_product, fp.image_product, fp.barcode, fp.created_at, fp.updated_at, fp.variant_count, fp.min_price, fp.max_price, fp.category_name
 FROM filtered_products fp
 ORDER BY fp.created_at DESC
 LIMIT $6
@@ -761,6 +1311,9 @@ type GetProductsByMerchantRow struct {
 	Barcode      *string          `json:"barcode"`
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	VariantCount int32            `json:"variant_count"`
+	MinPrice     int32            `json:"min_price"`
+	MaxPrice     int32            `json:"max_price"`
 	CategoryName string           `json:"category_name"`
 }
 
@@ -782,9 +1335,11 @@ type GetProductsByMerchantRow struct {
 //
 // Business Logic:
 //   - Excludes soft-deleted products (deleted_at IS NULL)
-//   - Supports case-insensitive partial search on name and description
+//   - Supports case-insensitive partial search on name, description and variant SKU or barcode
 //   - Filters by category ID only if provided
-//   - Filters by price range only if values provided (>= min_price and <= max_price)
+//   - Filters by price range only if values provided (>= min_price and <= max_price);
+//     a product with variants matches when any variant's effective price is in range
+//   - variant_count, min_price and max_price summarise the live variants
 //   - Ordered by newest products first (created_at DESC)
 func (q *Queries) GetProductsByMerchant(ctx context.Context, arg GetProductsByMerchantParams) ([]*GetProductsByMerchantRow, error) {
 	rows, err := q.db.Query(ctx, getProductsByMerchant,
@@ -819,6 +1374,9 @@ func (q *Queries) GetProductsByMerchant(ctx context.Context, arg GetProductsByMe
 			&i.Barcode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.VariantCount,
+			&i.MinPrice,
+			&i.MaxPrice,
 			&i.CategoryName,
 		); err != nil {
 			return nil, err
@@ -831,6 +1389,87 @@ func (q *Queries) GetProductsByMerchant(ctx context.Context, arg GetProductsByMe
 	return items, nil
 }
 
+const getProductsForImport = `-- name: GetProductsForImport :many
+SELECT
+    p.product_id,
+    p.merchant_id,
+    p.barcode,
+    p.slug_product,
+    p.count_in_stock,
+    p.deleted_at,
+    (
+        SELECT COUNT(*)
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    )::int AS variant_count
+FROM products p
+WHERE
+    p.barcode = $1
+    OR p.slug_product = $2
+ORDER BY p.product_id
+`
+
+type GetProductsForImportParams struct {
+	Barcode     *string `json:"barcode"`
+	SlugProduct *string `json:"slug_product"`
+}
+
+type GetProductsForImportRow struct {
+	ProductID    int32            `json:"product_id"`
+	MerchantID   int32            `json:"merchant_id"`
+	Barcode      *string          `json:"barcode"`
+	SlugProduct  *string          `json:"slug_product"`
+	CountInStock int32            `json:"count_in_stock"`
+	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+	VariantCount int32            `json:"variant_count"`
+}
+
+// GetProductsForImport: Finds the products an import row could update
+// Purpose: Match an imported row to existing products by barcode or slug
+// Parameters:
+//
+//	$1: barcode - Barcode from the row (NULL to skip)
+//	$2: slug_product - Slug from the row (NULL to skip)
+//
+// Returns:
+//
+//	Every product carrying the barcode or the slug, with its variant count
+//
+// Business Logic:
+//   - Includes deleted products, whose barcode and slug stay reserved
+//   - Returns two rows when barcode and slug belong to different products
+//   - Not limited to the caller's merchants, so a row cannot take over the
+//     barcode or slug of another merchant's product
+func (q *Queries) GetProductsForImport(ctx context.Context, arg GetProductsForImportParams) ([]*GetProductsForImportRow, error) {
+	rows, err := q.db.Query(ctx, getProductsForImport, arg.Barcode, arg.SlugProduct)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetProductsForImportRow
+	for rows.Next() {
+		var i GetProductsForImportRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.MerchantID,
+			&i.Barcode,
+			&i.SlugProduct,
+			&i.CountInStock,
+			&i.DeletedAt,
+			&i.VariantCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getProductsTrashed = `-- name: GetProductsTrashed :many
 SELECT
     p.product_id,
@@ -848,10 +1487,24 @@ SELECT
     p.created_at,
     p.updated_at,
     p.deleted_at,
+    COALESCE(pv.variant_count, 0)::INT AS variant_count,
+    COALESCE(pv.min_price, p.price)::INT AS min_price,
+    COALESCE(pv.max_price, p.price)::INT AS max_price,
     COUNT(*) OVER () AS total_count
 FROM products as p
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*)::INT AS variant_count,
+            MIN(COALESCE(v.price, p.price)) AS min_price,
+            MAX(COALESCE(v.price, p.price)) AS max_price
+        FROM product_variants v
+        WHERE
+            v.product_id = p.product_id
+            AND v.deleted_at IS NULL
+    ) pv ON TRUE
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(p.merchant_id)
     AND (
         $1::TEXT IS NULL
         OR p.name ILIKE '%' || $1 || '%'
@@ -859,6 +1512,17 @@ WHERE
         OR p.brand ILIKE '%' || $1 || '%'
         OR p.slug_product ILIKE '%' || $1 || '%'
         OR p.barcode ILIKE '%' || $1 || '%'
+        OR EXISTS (
+            SELECT 1
+            FROM product_variants v
+            WHERE
+                v.product_id = p.product_id
+                AND v.deleted_at IS NULL
+                AND (
+                    v.sku ILIKE '%' || $1 || '%'
+                    OR v.barcode ILIKE '%' || $1 || '%'
+                )
+        )
     )
 ORDER BY created_at DESC
 LIMIT $2
@@ -888,6 +1552,9 @@ type GetProductsTrashedRow struct {
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	DeletedAt    pgtype.Timestamp `json:"deleted_at"`
+	VariantCount int32            `json:"variant_count"`
+	MinPrice     int32            `json:"min_price"`
+	MaxPrice     int32            `json:"max_price"`
 	TotalCount   int64            `json:"total_count"`
 }
 
@@ -905,7 +1572,10 @@ type GetProductsTrashedRow struct {
 //
 // Business Logic:
 //   - Includes only soft-deleted products (deleted_at IS NOT NULL)
-//   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+//   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+//     and the SKU or barcode of any variant
+//   - variant_count, min_price and max_price summarise the live variants; a product
+//     without variants reports its own price as both bounds
 //   - Returns by newest first (created_at DESC)
 //   - Used for "Trash Bin" UI or soft-delete management
 func (q *Queries) GetProductsTrashed(ctx context.Context, arg GetProductsTrashedParams) ([]*GetProductsTrashedRow, error) {
@@ -933,6 +1603,9 @@ func (q *Queries) GetProductsTrashed(ctx context.Context, arg GetProductsTrashed
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.VariantCount,
+			&i.MinPrice,
+			&i.MaxPrice,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -945,12 +1618,82 @@ func (q *Queries) GetProductsTrashed(ctx context.Context, arg GetProductsTrashed
 	return items, nil
 }
 
+const getTrashedProductImages = `-- name: GetTrashedProductImages :many
+SELECT DISTINCT image_product
+FROM products
+WHERE deleted_at IS NOT NULL
+  AND in_merchant_scope(merchant_id)
+  AND image_product IS NOT NULL
+`
+
+// GetTrashedProductImages: Lists the images of trashed products
+// Purpose: Find images that may become unused once trashed products are purged
+// Returns: Distinct image keys of trashed products
+func (q *Queries) GetTrashedProductImages(ctx context.Context) ([]*string, error) {
+	rows, err := q.db.Query(ctx, getTrashedProductImages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*string
+	for rows.Next() {
+		var image_product *string
+		if err := rows.Scan(&image_product); err != nil {
+			return nil, err
+		}
+		items = append(items, image_product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseLowStockAlerts = `-- name: ReleaseLowStockAlerts :exec
+UPDATE products
+SET
+    low_stock_alerted_at = NULL
+WHERE
+    product_id = ANY($1::INT[])
+`
+
+// ReleaseLowStockAlerts: Unmarks products whose alert could not be delivered
+// Purpose: Retry the alert on the next evaluation
+// Parameters:
+//
+//	$1: product_ids - Products to unmark
+func (q *Queries) ReleaseLowStockAlerts(ctx context.Context, dollar_1 []int32) error {
+	_, err := q.db.Exec(ctx, releaseLowStockAlerts, dollar_1)
+	return err
+}
+
+const resetLowStockAlerts = `-- name: ResetLowStockAlerts :execrows
+UPDATE products
+SET
+    low_stock_alerted_at = NULL
+WHERE
+    low_stock_alerted_at IS NOT NULL
+    AND count_in_stock > reorder_point
+`
+
+// ResetLowStockAlerts: Unmarks products that were restocked
+// Purpose: Alert again the next time a restocked product runs low
+// Returns: Number of products unmarked
+func (q *Queries) ResetLowStockAlerts(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, resetLowStockAlerts)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const restoreAllProducts = `-- name: RestoreAllProducts :exec
 UPDATE products
 SET
     deleted_at = NULL
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // RestoreAllProducts: Mass restoration of deleted products
@@ -970,6 +1713,7 @@ SET
 WHERE
     product_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     product_id,
     merchant_id,
@@ -985,7 +1729,10 @@ RETURNING
     barcode,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at
 `
 
 // RestoreProduct: Recovers a soft-deleted product
@@ -1017,6 +1764,9 @@ func (q *Queries) RestoreProduct(ctx context.Context, productID int32) (*Product
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.LowStockAlertedAt,
 	)
 	return &i, err
 }
@@ -1028,6 +1778,7 @@ SET
 WHERE
     product_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     product_id,
     merchant_id,
@@ -1043,7 +1794,10 @@ RETURNING
     barcode,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at
 `
 
 // TrashProduct: Soft-deletes a product
@@ -1076,6 +1830,9 @@ func (q *Queries) TrashProduct(ctx context.Context, productID int32) (*Product,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.LowStockAlertedAt,
 	)
 	return &i, err
 }
@@ -1087,15 +1844,15 @@ SET
     name = $3,
     description = $4,
     price = $5,
-    count_in_stock = $6,
-    brand = $7,
-    weight = $8,
-    image_product = $9,
-    barcode = $10,
+    brand = $6,
+    weight = $7,
+    image_product = $8,
+    barcode = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE
     product_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     product_id,
     merchant_id,
@@ -1119,7 +1876,6 @@ type UpdateProductParams struct {
 	Name         string  `json:"name"`
 	Description  *string `json:"description"`
 	Price        int32   `json:"price"`
-	CountInStock int32   `json:"count_in_stock"`
 	Brand        *string `json:"brand"`
 	Weight       *int32  `json:"weight"`
 	ImageProduct *string `json:"image_product"`
@@ -1152,17 +1908,17 @@ type UpdateProductRow struct {
 //	$3: name - Updated name
 //	$4: description - Updated description
 //	$5: price - Updated price
-//	$6: count_in_stock - Updated inventory count
-//	$7: brand - Updated brand
-//	$8: weight - Updated weight
-//	$9: image_product - Updated image
-//	$10: barcode - Updated barcode
+//	$6: brand - Updated brand
+//	$7: weight - Updated weight
+//	$8: image_product - Updated image
+//	$9: barcode - Updated barcode
 //
 // Returns: Updated product record
 // Business Logic:
 //   - Auto-updates updated_at
 //   - Only modifies active products
 //   - Validates all fields
+//   - Leaves count_in_stock alone; stock changes go through stock_movements
 func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (*UpdateProductRow, error) {
 	row := q.db.QueryRow(ctx, updateProduct,
 		arg.ProductID,
@@ -1170,7 +1926,6 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (*
 		arg.Name,
 		arg.Description,
 		arg.Price,
-		arg.CountInStock,
 		arg.Brand,
 		arg.Weight,
 		arg.ImageProduct,
@@ -1196,45 +1951,68 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (*
 	return &i, err
 }
 
-const updateProductCountStock = `-- name: UpdateProductCountStock :one
+const updateProductReorderLevel = `-- name: UpdateProductReorderLevel :one
 UPDATE products
 SET
-    count_in_stock = $2
+    reorder_point = $2,
+    reorder_quantity = $3,
+    low_stock_alerted_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
 WHERE
     product_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     product_id,
-    price,
-    count_in_stock
+    merchant_id,
+    category_id,
+    name,
+    count_in_stock,
+    reorder_point,
+    reorder_quantity,
+    low_stock_alerted_at
 `
 
-type UpdateProductCountStockParams struct {
-	ProductID    int32 `json:"product_id"`
-	CountInStock int32 `json:"count_in_stock"`
+type UpdateProductReorderLevelParams struct {
+	ProductID       int32 `json:"product_id"`
+	ReorderPoint    int32 `json:"reorder_point"`
+	ReorderQuantity int32 `json:"reorder_quantity"`
 }
 
-type UpdateProductCountStockRow struct {
-	ProductID    int32 `json:"product_id"`
-	Price        int32 `json:"price"`
-	CountInStock int32 `json:"count_in_stock"`
+type UpdateProductReorderLevelRow struct {
+	ProductID         int32            `json:"product_id"`
+	MerchantID        int32            `json:"merchant_id"`
+	CategoryID        int32            `json:"category_id"`
+	Name              string           `json:"name"`
+	CountInStock      int32            `json:"count_in_stock"`
+	ReorderPoint      int32            `json:"reorder_point"`
+	ReorderQuantity   int32            `json:"reorder_quantity"`
+	LowStockAlertedAt pgtype.Timestamp `json:"low_stock_alerted_at"`
 }
 
-// UpdateProductCountStock: Updates inventory count
-// Purpose: Adjust product stock levels
+// UpdateProductReorderLevel: Sets the low-stock threshold of a product
+// Purpose: Configure when a product should be reordered and by how much
 // Parameters:
 //
 //	$1: product_id - Product to update
-//	$2: count_in_stock - New inventory count
+//	$2: reorder_point - Stock level at or below which the product is low (0 disables alerts)
+//	$3: reorder_quantity - Suggested quantity to order when the product is low
 //
-// Returns: Updated product record
+// Returns: Reorder settings of the updated product
 // Business Logic:
-//   - Dedicated stock adjustment function
-//   - Used when inventory changes
-//   - Validates non-negative quantity
-func (q *Queries) UpdateProductCountStock(ctx context.Context, arg UpdateProductCountStockParams) (*UpdateProductCountStockRow, error) {
-	row := q.db.QueryRow(ctx, updateProductCountStock, arg.ProductID, arg.CountInStock)
-	var i UpdateProductCountStockRow
-	err := row.Scan(&i.ProductID, &i.Price, &i.CountInStock)
+//   - Clears any previous alert so the new threshold is evaluated afresh
+func (q *Queries) UpdateProductReorderLevel(ctx context.Context, arg UpdateProductReorderLevelParams) (*UpdateProductReorderLevelRow, error) {
+	row := q.db.QueryRow(ctx, updateProductReorderLevel, arg.ProductID, arg.ReorderPoint, arg.ReorderQuantity)
+	var i UpdateProductReorderLevelRow
+	err := row.Scan(
+		&i.ProductID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.CountInStock,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.LowStockAlertedAt,
+	)
 	return &i, err
 }
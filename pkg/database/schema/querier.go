@@ -31,6 +31,58 @@ type Querier interface {
 	//   - Ignores soft-deleted items
 	//   - Ensures result is zero if no items exist
 	CalculateTotalPrice(ctx context.Context, orderID int32) (int32, error)
+	// ClaimLowStockAlerts: Marks newly low products as alerted
+	// Purpose: Pick the products a low-stock alert has to be sent for
+	// Parameters:
+	//   $1: Maximum number of products to claim
+	// Returns:
+	//   Reorder fields of the claimed products
+	// Business Logic:
+	//   - A product is claimed once per dip below its reorder point
+	//   - SKIP LOCKED lets several servers evaluate without alerting twice
+	ClaimLowStockAlerts(ctx context.Context, limit int32) ([]*ClaimLowStockAlertsRow, error)
+	// CompleteIdempotencyKey: Stores the response of a finished request
+	// Purpose: Let retries with the same key replay the original response
+	// Parameters:
+	//   $1: idempotency_key_id
+	//   $2: response - Serialized response message
+	// Returns:
+	//   The completed key
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) (*IdempotencyKey, error)
+	// CountActiveChildCategories: Counts the active children of a category
+	// Purpose: Prevent trashing a category that still has active subcategories
+	// Parameters:
+	//   $1: Parent category ID
+	// Returns:
+	//   Number of active child categories
+	CountActiveChildCategories(ctx context.Context, parentID int32) (int64, error)
+	// CountCategorySlugConflicts: Counts categories whose slug a category would clash with
+	// Purpose: Keep slugs unique within the global scope and across a merchant's view
+	// Parameters:
+	//   $1: Slug to check
+	//   $2: Category ID to ignore (NULL when creating)
+	//   $3: Owning merchant ID (NULL for a global category)
+	// Returns:
+	//   Number of conflicting categories, trashed ones included
+	// Business Logic:
+	//   - A global category clashes with other global categories
+	//   - A merchant category clashes with global categories and the merchant's own
+	CountCategorySlugConflicts(ctx context.Context, arg CountCategorySlugConflictsParams) (int64, error)
+	// CountProductVariants: Counts the active variants of a product
+	// Purpose: Tell products sold through variants apart from plain products
+	// Parameters:
+	//   $1: product_id
+	// Returns:
+	//   Number of active variants
+	CountProductVariants(ctx context.Context, productID int32) (int64, error)
+	// CountProductsByImage: Counts products referring to an image
+	// Purpose: Tell whether a stored image can be removed
+	// Parameters:
+	//   $1: image_product - Storage key of the image
+	// Returns: Number of products, trashed ones included, using the image
+	// Business Logic:
+	//   - Counts the products of every merchant, since identical uploads share an image
+	CountProductsByImage(ctx context.Context, imageProduct string) (int64, error)
 	// CreateCashier: Creates a new cashier record
 	// Purpose: Add new cashier to the system
 	// Parameters:
@@ -48,10 +100,13 @@ type Querier interface {
 	//   $1: Category name
 	//   $2: Category description
 	//   $3: Slug for category (URL-friendly identifier)
+	//   $4: Parent category ID (NULL for a root category)
+	//   $5: Owning merchant ID (NULL for a global category)
 	// Returns:
 	//   Full category record including generated ID
 	// Business Logic:
-	//   - Assumes unique slug for identification in URLs
+	//   - Assumes unique slug within the global or merchant scope
+	//   - Appends the category after its existing siblings
 	//   - Automatically populates timestamps via default DB behavior (if configured)
 	CreateCategory(ctx context.Context, arg CreateCategoryParams) (*CreateCategoryRow, error)
 	// CreateMerchant: Creates a new merchant account
@@ -70,6 +125,13 @@ type Querier interface {
 	//   - Requires all mandatory merchant fields
 	//   - Status defaults to 'active' unless specified otherwise
 	CreateMerchant(ctx context.Context, arg CreateMerchantParams) (*CreateMerchantRow, error)
+	// CreateOpeningStockMovement: Opens the ledger of a new product
+	// Purpose: Record the stock a product was created with
+	// Parameters:
+	//   $1: product_id - Newly created product
+	//   $2: user_id - Acting user (nullable)
+	// Returns: The 'initial' movement holding the product's current stock
+	CreateOpeningStockMovement(ctx context.Context, arg CreateOpeningStockMovementParams) (*StockMovement, error)
 	// CreateOrder: Creates a new order record
 	// Purpose: Register a new transaction in the system
 	// Parameters:
@@ -89,6 +151,7 @@ type Querier interface {
 	//   $2: product_id
 	//   $3: quantity
 	//   $4: price
+	//   $5: variant_id (nullable for products without variants)
 	// Returns:
 	//   The newly created order item
 	// Business Logic:
@@ -114,12 +177,71 @@ type Querier interface {
 	//   - Validates required fields
 	//   - Initializes inventory tracking
 	CreateProduct(ctx context.Context, arg CreateProductParams) (*CreateProductRow, error)
+	// CreateProductOption: Adds a variant option to a product
+	// Purpose: Declare a dimension and the values its variants can take
+	// Parameters:
+	//   $1: product_id
+	//   $2: name - Option name (e.g. 'size')
+	//   $3: option_values - Allowed values (e.g. {'S','M','L'})
+	//   $4: position - Display order
+	// Returns:
+	//   The created product option
+	CreateProductOption(ctx context.Context, arg CreateProductOptionParams) (*ProductOption, error)
+	// CreateProductVariant: Adds a variant to a product
+	// Purpose: Register a sellable option combination of a product
+	// Parameters:
+	//   $1: product_id
+	//   $2: sku - Stock keeping unit, unique across all variants
+	//   $3: barcode (nullable)
+	//   $4: options - Option name to value map
+	//   $5: price - Price override (nullable to inherit the product price)
+	// Returns:
+	//   The created variant
+	// Business Logic:
+	//   - Variants start without stock; opening stock is recorded on the ledger
+	CreateProductVariant(ctx context.Context, arg CreateProductVariantParams) (*ProductVariant, error)
+	// CreatePurchaseOrder: Opens a draft purchase order
+	// Purpose: Start ordering stock from a supplier
+	// Parameters:
+	//   $1: merchant_id
+	//   $2: supplier_id
+	//   $3: note (nullable)
+	//   $4: created_by - Acting user (nullable)
+	// Returns:
+	//   The created purchase order in 'draft' status
+	CreatePurchaseOrder(ctx context.Context, arg CreatePurchaseOrderParams) (*PurchaseOrder, error)
+	// CreatePurchaseOrderItem: Adds a line to a draft purchase order
+	// Purpose: Record a product, quantity and agreed unit cost
+	// Parameters:
+	//   $1: purchase_order_id
+	//   $2: product_id
+	//   $3: quantity_ordered
+	//   $4: unit_cost
+	// Returns:
+	//   The created purchase order item
+	CreatePurchaseOrderItem(ctx context.Context, arg CreatePurchaseOrderItemParams) (*PurchaseOrderItem, error)
+	// CreatePurchaseOrderReceipt: Records a receiving line
+	// Purpose: Keep who received how many units of a line and at what cost
+	// Parameters:
+	//   $1: purchase_order_item_id
+	//   $2: quantity
+	//   $3: unit_cost - Cost actually paid per unit
+	//   $4: received_by - Acting user (nullable)
+	//   $5: note (nullable)
+	// Returns:
+	//   The created receipt
+	CreatePurchaseOrderReceipt(ctx context.Context, arg CreatePurchaseOrderReceiptParams) (*PurchaseOrderReceipt, error)
 	// CreateRefreshToken: Creates a new refresh token
 	// Purpose: Generate a refresh token for user authentication
 	// Parameters:
 	//   $1: user_id - ID of the user this token belongs to
 	//   $2: token - The actual refresh token string
 	//   $3: expiration - Expiration timestamp of the token
+	//   $4: session_id - Session (token family) the token belongs to
+	//   $5: access_token_id - jti of the access token issued with it
+	//   $6: user_agent - User agent of the device that started the session
+	//   $7: ip_address - Address of the device that started the session
+	//   $8: session_started_at - When the session was started by a login
 	// Returns: The created refresh token record (excluding sensitive fields if any)
 	// Business Logic:
 	//   - Sets both created_at and updated_at to current timestamp
@@ -133,6 +255,53 @@ type Querier interface {
 	// Returns:
 	//   Newly created role's full data (including timestamps)
 	CreateRole(ctx context.Context, roleName string) (*Role, error)
+	// CreateStocktake: Opens a stocktake for a merchant
+	// Purpose: Start a stock count session
+	// Parameters:
+	//   $1: merchant_id
+	//   $2: category_id - Category to count (nullable for every category)
+	//   $3: note (nullable)
+	//   $4: created_by - Acting user (nullable)
+	// Returns:
+	//   The created stocktake in 'open' status
+	// Business Logic:
+	//   - Fails while the merchant already has an open stocktake
+	CreateStocktake(ctx context.Context, arg CreateStocktakeParams) (*Stocktake, error)
+	// CreateStocktakeItems: Snapshots the products a stocktake counts
+	// Purpose: Record the expected quantity and price of every product in scope
+	// Parameters:
+	//   $1: stocktake_id
+	// Returns:
+	//   Number of products added to the stocktake
+	// Business Logic:
+	//   - Takes the active products of the stocktake's merchant, limited to its
+	//     category when it has one
+	//   - Skips products sold through variants, whose stock is held per variant
+	CreateStocktakeItems(ctx context.Context, stocktakeID int32) (int64, error)
+	// CreateSupplier: Registers a supplier for a merchant
+	// Purpose: Add a supplier purchase orders can be raised against
+	// Parameters:
+	//   $1: merchant_id
+	//   $2: name
+	//   $3: contact_name (nullable)
+	//   $4: email (nullable)
+	//   $5: phone (nullable)
+	//   $6: address (nullable)
+	// Returns:
+	//   The created supplier record
+	CreateSupplier(ctx context.Context, arg CreateSupplierParams) (*Supplier, error)
+	// CreateTaxRate: Creates a new tax rate
+	// Purpose: Register a rate for a merchant, a category, both or neither
+	// Parameters:
+	//   $1: name
+	//   $2: merchant_id (nullable)
+	//   $3: category_id (nullable)
+	//   $4: rate_bps - Rate in basis points (1100 = 11%)
+	//   $5: is_inclusive - Whether product prices already include the tax
+	//   $6: rounding - Integer rounding rule
+	// Returns:
+	//   The created tax rate
+	CreateTaxRate(ctx context.Context, arg CreateTaxRateParams) (*TaxRate, error)
 	// CreateTransaction: Creates a new transaction record
 	// Purpose: Record a new payment transaction
 	// Parameters:
@@ -142,6 +311,8 @@ type Querier interface {
 	//   $4: change_amount - Change amount (if applicable)
 	//   $5: payment_status - Payment status ('success', 'failed', 'pending')
 	//   $6: order_id - Associated order reference
+	//   $7: subtotal_amount - Order total excluding tax
+	//   $8: tax_amount - Tax charged on the order
 	// Returns: Newly created transaction record
 	// Business Logic:
 	//   - Sets created_at and updated_at timestamps
@@ -149,6 +320,41 @@ type Querier interface {
 	//   - Validates all payment fields
 	//   - Used for recording new payments
 	CreateTransaction(ctx context.Context, arg CreateTransactionParams) (*CreateTransactionRow, error)
+	// CreateTransactionPayment: Records one tender line of a transaction
+	// Purpose: Store how part of an order was paid
+	// Parameters:
+	//   $1: transaction_id
+	//   $2: payment_method
+	//   $3: amount - Amount tendered with this method
+	//   $4: change_amount - Change handed back from this tender (cash only)
+	//   $5: reference - Card approval code, e-wallet reference, etc. (nullable)
+	// Returns:
+	//   The created tender line
+	CreateTransactionPayment(ctx context.Context, arg CreateTransactionPaymentParams) (*TransactionPayment, error)
+	// CreateTransactionRefund: Records a refund or void of a transaction
+	// Purpose: Keep an auditable reversal linked to the original sale
+	// Parameters:
+	//   $1: transaction_id
+	//   $2: kind - 'refund' or 'void'
+	//   $3: amount - Gross amount handed back
+	//   $4: tax_amount - Tax contained in amount
+	//   $5: reason_code
+	//   $6: note (nullable)
+	//   $7: approved_by - User who approved the reversal
+	//   $8: restock - Whether the returned items went back to stock
+	// Returns:
+	//   The created reversal
+	CreateTransactionRefund(ctx context.Context, arg CreateTransactionRefundParams) (*TransactionRefund, error)
+	// CreateTransactionRefundItem: Records how much of an order line a reversal covers
+	// Parameters:
+	//   $1: transaction_refund_id
+	//   $2: order_item_id
+	//   $3: quantity - Units reversed
+	//   $4: amount - Gross amount of those units
+	//   $5: tax_amount - Tax contained in amount
+	// Returns:
+	//   The created reversal line
+	CreateTransactionRefundItem(ctx context.Context, arg CreateTransactionRefundItemParams) (*TransactionRefundItem, error)
 	// CreateUser: Creates a new user account
 	// Purpose: Register a new user in the system
 	// Parameters:
@@ -232,9 +438,9 @@ type Querier interface {
 	//   $1: cashier_id - ID of cashier to delete
 	// Business Logic:
 	//   - Permanent deletion of already soft-deleted records
-	//   - No return value (exec-only)
+	//   - Returns the number of deleted rows, 0 when no trashed record matched
 	//   - Use with caution - irreversible operation
-	DeleteCashierPermanently(ctx context.Context, cashierID int32) error
+	DeleteCashierPermanently(ctx context.Context, cashierID int32) (int64, error)
 	// DeleteCategoryPermanently: Removes a soft-deleted category permanently
 	// Purpose: Final cleanup of trashed categories
 	// Parameters:
@@ -244,16 +450,26 @@ type Querier interface {
 	// Business Logic:
 	//   - Ensures category is deleted only if it has been soft-deleted
 	DeleteCategoryPermanently(ctx context.Context, categoryID int32) error
+	// DeleteExpiredIdempotencyKeys: Purges keys past their retention window
+	// Purpose: Keep the idempotency_keys table small
+	// Returns:
+	//   Number of deleted keys
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	// DeleteExpiredRefreshTokens: Permanently deletes expired refresh tokens
+	// Purpose: Keep the token table from growing with every rotation
+	// Business Logic:
+	//   - Revoked tokens are kept until they expire so reuse is still detected
+	DeleteExpiredRefreshTokens(ctx context.Context) (int64, error)
 	// DeleteMerchantPermanently: Hard-deletes a merchant
 	// Purpose: Completely remove merchant from database
 	// Parameters:
 	//   $1: merchant_id - ID of merchant to delete
 	// Business Logic:
 	//   - Permanent deletion of already soft-deleted records
-	//   - No return value (exec-only operation)
+	//   - Returns the number of deleted rows, 0 when no trashed record matched
 	//   - Irreversible action - use with caution
 	//   - Should trigger cleanup of related records
-	DeleteMerchantPermanently(ctx context.Context, merchantID int32) error
+	DeleteMerchantPermanently(ctx context.Context, merchantID int32) (int64, error)
 	// DeleteOrderItemPermanently: Permanently deletes a trashed order item
 	// Purpose: Removes the record entirely from the database
 	// Parameters:
@@ -269,15 +485,20 @@ type Querier interface {
 	//   $1: order_id - UUID of order to delete
 	// Business Logic:
 	//   - Permanent deletion of already cancelled orders
-	//   - No return value (exec-only operation)
+	//   - Returns the number of deleted rows, 0 when no trashed record matched
 	//   - Irreversible action - use with caution
 	//   - Should trigger deletion of related order_items
-	DeleteOrderPermanently(ctx context.Context, orderID int32) error
+	DeleteOrderPermanently(ctx context.Context, orderID int32) (int64, error)
 	// DeletePermanentRole: Permanently deletes a trashed role
 	// Purpose: Remove role from DB after soft delete
 	// Parameters:
 	//   $1: Role ID
 	DeletePermanentRole(ctx context.Context, roleID int32) error
+	// DeleteProductOptions: Removes every variant option of a product
+	// Purpose: Clear the options before they are replaced
+	// Parameters:
+	//   $1: product_id
+	DeleteProductOptions(ctx context.Context, productID int32) error
 	// DeleteProductPermanently: Hard-deletes a product
 	// Purpose: Completely remove product record
 	// Parameters:
@@ -305,16 +526,36 @@ type Querier interface {
 	//   - Used during password reset or account lock
 	//   - Ensures complete session invalidation
 	DeleteRefreshTokenByUserId(ctx context.Context, userID int32) error
+	// DeleteSupplierPermanently: Hard-deletes a trashed supplier
+	// Purpose: Remove a supplier that has no purchase orders
+	// Parameters:
+	//   $1: supplier_id
+	// Business Logic:
+	//   - Only deletes suppliers that are already trashed
+	//   - Fails while purchase orders still reference the supplier
+	DeleteSupplierPermanently(ctx context.Context, supplierID int32) error
+	// DeleteTaxRatePermanently: Permanently deletes a trashed tax rate
+	// Purpose: Remove a rate that was never used
+	// Parameters:
+	//   $1: tax_rate_id
+	// Business Logic:
+	//   - Only deletes rates that are already soft-deleted
+	DeleteTaxRatePermanently(ctx context.Context, taxRateID int32) error
+	// DeleteTransactionPayments: Removes every tender line of a transaction
+	// Purpose: Replace the tenders of a transaction that is being updated
+	// Parameters:
+	//   $1: transaction_id
+	DeleteTransactionPayments(ctx context.Context, transactionID int32) error
 	// DeleteTransactionPermanently: Hard-deletes a transaction
 	// Purpose: Completely remove transaction from database
 	// Parameters:
 	//   $1: transaction_id - ID of transaction to delete
 	// Business Logic:
 	//   - Permanent deletion of already cancelled transactions
-	//   - No return value (exec-only operation)
+	//   - Returns the number of deleted rows, 0 when no trashed record matched
 	//   - Irreversible action - use with caution
 	//   - Should be restricted to admin users
-	DeleteTransactionPermanently(ctx context.Context, transactionID int32) error
+	DeleteTransactionPermanently(ctx context.Context, transactionID int32) (int64, error)
 	// DeleteUserPermanently: Hard-deletes a user account
 	// Purpose: Completely remove user from database
 	// Parameters:
@@ -325,15 +566,33 @@ type Querier interface {
 	//   - Irreversible action - use with caution
 	//   - Should trigger cleanup of related records
 	DeleteUserPermanently(ctx context.Context, userID int32) error
+	// FindActiveRefreshTokensByUserId: Lists the live refresh token of every session
+	// Purpose: List a user's active sessions
+	// Parameters:
+	//   $1: user_id - ID of the user
+	// Returns: One record per active session, newest session first
+	// Business Logic:
+	//   - Rotation revokes the previous token, so each session has one live token
+	//   - Excludes revoked, deleted and expired tokens
+	FindActiveRefreshTokensByUserId(ctx context.Context, userID int32) ([]*RefreshToken, error)
+	// FindRefreshTokenByAccessTokenId: Retrieves the refresh token issued with an access token
+	// Purpose: Resolve the session an access token belongs to
+	// Parameters:
+	//   $1: user_id - ID of the user owning the token
+	//   $2: access_token_id - jti of the access token
+	// Returns: The refresh token record if found and not deleted
+	// Business Logic:
+	//   - Used by logout to find the caller's session
+	FindRefreshTokenByAccessTokenId(ctx context.Context, arg FindRefreshTokenByAccessTokenIdParams) (*RefreshToken, error)
 	// FindRefreshTokenByToken: Retrieves active refresh token by token string
 	// Purpose: Validate and lookup refresh token
 	// Parameters:
 	//   $1: token - The refresh token string to find
-	// Returns: The refresh token record if found and active
+	// Returns: The refresh token record if found and not deleted
 	// Business Logic:
 	//   - Only returns non-deleted tokens
+	//   - Also returns revoked tokens so that reuse can be detected
 	//   - Used during token refresh operations
-	//   - Helps prevent token reuse
 	FindRefreshTokenByToken(ctx context.Context, token string) (*RefreshToken, error)
 	// FindRefreshTokenByUserId: Retrieves latest active refresh token for user
 	// Purpose: Get current valid refresh token for a user
@@ -355,6 +614,18 @@ type Querier interface {
 	// Returns:
 	//   role_id, role_name, timestamps, and total_count
 	GetActiveRoles(ctx context.Context, arg GetActiveRolesParams) ([]*GetActiveRolesRow, error)
+	// GetApplicableTaxRate: Resolves the tax rate for a product sold by a merchant
+	// Purpose: Pick the rate used when pricing an order line
+	// Parameters:
+	//   $1: merchant_id
+	//   $2: category_id
+	// Returns:
+	//   The most specific active tax rate
+	// Business Logic:
+	//   - A rate scoped to both merchant and category wins
+	//   - Then a merchant-wide rate, then a category-wide rate
+	//   - Falls back to the global rate (no merchant, no category)
+	GetApplicableTaxRate(ctx context.Context, arg GetApplicableTaxRateParams) (*TaxRate, error)
 	// GetCashierByID: Retrieves active cashier by ID
 	// Purpose: Fetch cashier details for display/editing
 	// Parameters:
@@ -484,6 +755,77 @@ type Querier interface {
 	// Business Logic:
 	//   - Excludes soft-deleted categories
 	GetCategoryByNameAndId(ctx context.Context, arg GetCategoryByNameAndIdParams) (*GetCategoryByNameAndIdRow, error)
+	// GetCategoryByNameOrSlug: Fetches a single category by its name or slug
+	// Purpose: Resolve the category column of a product import
+	// Parameters:
+	//   $1: Category name or slug
+	//   $2: Merchant ID whose own categories are visible besides global ones
+	// Returns:
+	//   Full category record if found and not deleted
+	// Business Logic:
+	//   - Excludes soft-deleted categories
+	//   - Only considers global categories and those of the merchant
+	//   - Prefers a slug match over a name match, then the merchant's own category
+	GetCategoryByNameOrSlug(ctx context.Context, arg GetCategoryByNameOrSlugParams) (*GetCategoryByNameOrSlugRow, error)
+	// GetCategorySiblings: Retrieves the categories sharing a parent and an owner
+	// Purpose: Renumber positions when categories are moved or reordered
+	// Parameters:
+	//   $1: Parent category ID (NULL for root categories)
+	//   $2: Owning merchant ID (NULL for global categories)
+	// Returns:
+	//   Full category records
+	// Business Logic:
+	//   - Excludes soft-deleted categories
+	//   - Ordered by position, then by ID for stable results
+	GetCategorySiblings(ctx context.Context, arg GetCategorySiblingsParams) ([]*Category, error)
+	// GetCategorySubtreeIds: Retrieves a category and all of its descendants
+	// Purpose: Detect cycles before a category is moved
+	// Parameters:
+	//   $1: Root category ID of the subtree
+	// Returns:
+	//   category_id of every category in the subtree, the root included
+	// Business Logic:
+	//   - Includes soft-deleted descendants, since they still reference their parent
+	GetCategorySubtreeIds(ctx context.Context, categoryID int32) ([]int32, error)
+	// GetCategoryTree: Retrieves the active category tree visible to a merchant
+	// Purpose: Render nested category pickers and navigation
+	// Parameters:
+	//   $1: Merchant ID whose own categories are included (NULL for global only)
+	// Returns:
+	//   Category fields plus depth (0 for root categories)
+	// Business Logic:
+	//   - Walks from the root categories down through parent_id
+	//   - Excludes soft-deleted categories and everything below them
+	//   - Orders depth-first, siblings by position, so each category follows its parent
+	GetCategoryTree(ctx context.Context, merchantID *int32) ([]*GetCategoryTreeRow, error)
+	// GetCountedStocktakeItems: Retrieves the counted lines of a stocktake
+	// Purpose: Collect the adjustments to post
+	// Parameters:
+	//   $1: stocktake_id
+	// Returns:
+	//   Stocktake item records that have a counted quantity
+	GetCountedStocktakeItems(ctx context.Context, stocktakeID int32) ([]*StocktakeItem, error)
+	// GetIdempotencyKey: Retrieves a live idempotency key
+	// Purpose: Replay a stored response or detect a reused key
+	// Parameters:
+	//   $1: user_id
+	//   $2: method
+	//   $3: idempotency_key
+	// Returns:
+	//   The key while it has not expired
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (*IdempotencyKey, error)
+	// GetLowStockProducts: Lists the products of a merchant that need reordering
+	// Purpose: Show store managers what to reorder
+	// Parameters:
+	//   $1: merchant_id - Merchant whose products are listed
+	//   $2: Limit (number of records per page)
+	//   $3: Offset (starting index for pagination)
+	// Returns:
+	//   Reorder fields of each low product and total_count (for pagination support)
+	// Business Logic:
+	//   - Only products with a reorder point are considered
+	//   - Products furthest below their reorder point come first
+	GetLowStockProducts(ctx context.Context, arg GetLowStockProductsParams) ([]*GetLowStockProductsRow, error)
 	// GetMerchantByID: Retrieves active merchant by ID
 	// Purpose: Fetch merchant details for display/editing
 	// Parameters:
@@ -494,6 +836,16 @@ type Querier interface {
 	//   - Returns single record or nothing
 	//   - Used for merchant profile viewing and editing
 	GetMerchantByID(ctx context.Context, merchantID int32) (*GetMerchantByIDRow, error)
+	// GetMerchantScopeByUser: Retrieves the merchants a user may act for
+	// Purpose: Resolve the merchant scope of an authenticated caller
+	// Parameters:
+	//   $1: user_id - ID of the caller
+	// Returns:
+	//   merchant_id of every merchant the user owns or works at as a cashier
+	// Business Logic:
+	//   - Excludes soft-deleted merchants and cashiers
+	//   - Must run without a merchant scope, since it is what the scope is built from
+	GetMerchantScopeByUser(ctx context.Context, userID int32) ([]int32, error)
 	// GetMerchants: Retrieves paginated list of active merchants with search capability
 	// Purpose: List all active merchants for management UI
 	// Parameters:
@@ -589,9 +941,10 @@ type Querier interface {
 	//   year: Year as text
 	//   month: 3-letter month abbreviation (e.g. 'Jan')
 	//   total_success: Count of successful transactions
-	//   total_amount: Sum of successful transaction amounts
+	//   total_amount: Sum of successful transaction amounts, net of refunds and voids
+	//   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 	// Business Logic:
-	//   - Only includes successful (payment_status = 'success') transactions
+	//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 	//   - Excludes deleted transactions
 	//   - Compares two customizable time periods
 	//   - Includes gap-filling for months with no transactions
@@ -610,9 +963,10 @@ type Querier interface {
 	//   year: Year as text
 	//   month: 3-letter month abbreviation (e.g. 'Jan')
 	//   total_success: Count of successful transactions
-	//   total_amount: Sum of successful transaction amounts
+	//   total_amount: Sum of successful transaction amounts, net of refunds and voids
+	//   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 	// Business Logic:
-	//   - Only includes successful (payment_status = 'success') transactions
+	//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 	//   - Excludes deleted transactions
 	//   - Compares two customizable time periods
 	//   - Includes gap-filling for months with no transactions
@@ -676,57 +1030,63 @@ type Querier interface {
 	// Purpose: Provides category performance metrics by month for operational analysis
 	// Parameters:
 	//   $1: Reference date (timestamp) - determines the 12-month analysis period
+	//   $2: Roll up subcategories - when true each category also counts the sales of its descendants
 	// Returns:
 	//   category_id: Unique identifier for the category
 	//   category_name: Display name of the category
 	//   month: 3-letter month abbreviation (e.g. 'Jan')
 	//   order_count: Number of orders associated with the category
 	//   items_sold: Total quantity of items sold from the category
-	//   total_revenue: Total revenue generated from category items
+	//   total_revenue: Sum of line price times quantity at the price each line sold for
 	// Business Logic:
 	//   - Analyzes a rolling 12-month period from the reference date
 	//   - Excludes deleted orders, items, products, and categories to ensure valid data
 	//   - Aggregates by category and month for trend tracking
 	//   - Uses abbreviated month names for compact visual reporting
 	//   - Results sorted by month and revenue for time-series analysis
-	GetMonthlyCategory(ctx context.Context, dollar_1 time.Time) ([]*GetMonthlyCategoryRow, error)
+	//   - With rollup, totals of a category include every active descendant category
+	GetMonthlyCategory(ctx context.Context, arg GetMonthlyCategoryParams) ([]*GetMonthlyCategoryRow, error)
 	// GetMonthlyCategoryById: Retrieves monthly sales activity for all categories within a 1-year period by category_id
 	// Purpose: Provides category performance metrics by month for operational analysis
 	// Parameters:
 	//   $1: Reference date (timestamp) - determines the 12-month analysis period
 	//   $2: Category ID
+	//   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 	// Returns:
 	//   category_id: Unique identifier for the category
 	//   category_name: Display name of the category
 	//   month: 3-letter month abbreviation (e.g. 'Jan')
 	//   order_count: Number of orders associated with the category
 	//   items_sold: Total quantity of items sold from the category
-	//   total_revenue: Total revenue generated from category items
+	//   total_revenue: Sum of line price times quantity at the price each line sold for
 	// Business Logic:
 	//   - Analyzes a rolling 12-month period from the reference date
 	//   - Excludes deleted orders, items, products, and categories to ensure valid data
 	//   - Aggregates by category and month for trend tracking
 	//   - Uses abbreviated month names for compact visual reporting
 	//   - Results sorted by month and revenue for time-series analysis
+	//   - With rollup, totals of a category include every active descendant category
 	GetMonthlyCategoryById(ctx context.Context, arg GetMonthlyCategoryByIdParams) ([]*GetMonthlyCategoryByIdRow, error)
 	// GetMonthlyCategoryByMerchant: Retrieves monthly sales activity for all categories within a 1-year period by merchant_id
 	// Purpose: Provides category performance metrics by month for operational analysis
 	// Parameters:
 	//   $1: Reference date (timestamp) - determines the 12-month analysis period
 	//   $2: Merchant ID
+	//   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 	// Returns:
 	//   category_id: Unique identifier for the category
 	//   category_name: Display name of the category
 	//   month: 3-letter month abbreviation (e.g. 'Jan')
 	//   order_count: Number of orders associated with the category
 	//   items_sold: Total quantity of items sold from the category
-	//   total_revenue: Total revenue generated from category items
+	//   total_revenue: Sum of line price times quantity at the price each line sold for
 	// Business Logic:
 	//   - Analyzes a rolling 12-month period from the reference date
 	//   - Excludes deleted orders, items, products, and categories to ensure valid data
 	//   - Aggregates by category and month for trend tracking
 	//   - Uses abbreviated month names for compact visual reporting
 	//   - Results sorted by month and revenue for time-series analysis
+	//   - With rollup, totals of a category include every active descendant category
 	GetMonthlyCategoryByMerchant(ctx context.Context, arg GetMonthlyCategoryByMerchantParams) ([]*GetMonthlyCategoryByMerchantRow, error)
 	// GetMonthlyOrder: Retrieves monthly order summary within a 1-year period
 	// Purpose: Provides monthly sales performance metrics for trend and operational analysis
@@ -787,16 +1147,18 @@ type Querier interface {
 	//   $3: Start date of second comparison period
 	//   $4: End date of second comparison period
 	//   $5: Category ID
+	//   $6: Roll up subcategories - when true the category's descendants are included
 	// Returns:
 	//   year: Year of revenue data (text format)
 	//   month_name: Full month name (e.g. "January")
-	//   total_revenue: Sum of order totals for that month (0 if no sales)
+	//   total_revenue: Sum of the order lines sold that month (0 if no sales)
 	// Business Logic:
 	//   - Compares revenue between two customizable date ranges
 	//   - Joins with order_items to ensure accurate order calculations
 	//   - Excludes deleted orders and order items for data integrity
 	//   - Uses gap-filling to show all months in both periods
 	//   - Formats output for financial dashboards
+	//   - With rollup, revenue of every active descendant category is included
 	GetMonthlyTotalPriceById(ctx context.Context, arg GetMonthlyTotalPriceByIdParams) ([]*GetMonthlyTotalPriceByIdRow, error)
 	// GetMonthlyTotalPriceByMerchant: Retrieves monthly revenue totals across two comparison periods by merchant_id
 	// Purpose: Provides month-over-month revenue analytics for financial reporting
@@ -931,8 +1293,8 @@ type Querier interface {
 	//   merchant_id: The merchant identifier
 	//   merchant_name: The merchant's name
 	//   payment_method: The payment method used
-	//   total_transactions: Count of failed transactions
-	//   total_amount: Total amount that failed processing
+	//   total_transactions: Count of failed tender lines
+	//   total_amount: Total amount that failed processing, net of cash change
 	GetMonthlyTransactionMethodsByMerchantFailed(ctx context.Context, arg GetMonthlyTransactionMethodsByMerchantFailedParams) ([]*GetMonthlyTransactionMethodsByMerchantFailedRow, error)
 	// GetMonthlyTransactionMethodsByMerchantSuccess: Analyzes successful transactions by merchant and payment method monthly
 	// Parameters:
@@ -942,8 +1304,8 @@ type Querier interface {
 	//   merchant_id: The merchant identifier
 	//   merchant_name: The merchant's name
 	//   payment_method: The payment method used
-	//   total_transactions: Count of successful transactions
-	//   total_amount: Total amount processed by this method
+	//   total_transactions: Count of successful tender lines
+	//   total_amount: Total amount processed by this method, net of cash change
 	GetMonthlyTransactionMethodsByMerchantSuccess(ctx context.Context, arg GetMonthlyTransactionMethodsByMerchantSuccessParams) ([]*GetMonthlyTransactionMethodsByMerchantSuccessRow, error)
 	// GetMonthlyTransactionMethodsFailed: Analyzes failed payment method usage by month
 	// Parameters:
@@ -951,8 +1313,8 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g. 'Jan')
 	//   payment_method: The payment method used
-	//   total_transactions: Count of failed transactions
-	//   total_amount: Total amount that failed processing
+	//   total_transactions: Count of failed tender lines
+	//   total_amount: Total amount that failed processing, net of cash change
 	GetMonthlyTransactionMethodsFailed(ctx context.Context, arg GetMonthlyTransactionMethodsFailedParams) ([]*GetMonthlyTransactionMethodsFailedRow, error)
 	// GetMonthlyTransactionMethodsSuccess: Analyzes successful payment method usage by month
 	// Parameters:
@@ -960,8 +1322,8 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g. 'Jan')
 	//   payment_method: The payment method used
-	//   total_transactions: Count of successful transactions
-	//   total_amount: Total amount processed by this method
+	//   total_transactions: Count of successful tender lines
+	//   total_amount: Total amount processed by this method, net of cash change
 	GetMonthlyTransactionMethodsSuccess(ctx context.Context, arg GetMonthlyTransactionMethodsSuccessParams) ([]*GetMonthlyTransactionMethodsSuccessRow, error)
 	// GetOrderByID: Retrieves an active order by ID
 	// Purpose: Fetch order details for display/processing
@@ -974,6 +1336,13 @@ type Querier interface {
 	//   - Typically joined with order_items in application
 	GetOrderByID(ctx context.Context, orderID int32) (*GetOrderByIDRow, error)
 	GetOrderByIDTrashed(ctx context.Context, orderID int32) (*Order, error)
+	// GetOrderItemTaxLines: Retrieves the per-line tax breakdown of an order
+	// Purpose: Rebuild the tax section of a receipt
+	// Parameters:
+	//   $1: order_id
+	// Returns:
+	//   Line quantity, price and the tax recorded for it
+	GetOrderItemTaxLines(ctx context.Context, orderID int32) ([]*GetOrderItemTaxLinesRow, error)
 	// GetOrderItems: Retrieves active order items with pagination and search
 	// Purpose: Provides paginated listing of non-deleted order items for display or reporting
 	// Parameters:
@@ -1030,6 +1399,7 @@ type Querier interface {
 	//   $1: search_term - Optional text to filter orders by ID or total price (NULL for no filter)
 	//   $2: limit - Maximum number of records to return (pagination limit)
 	//   $3: offset - Number of records to skip (pagination offset)
+	//   $4: status - Optional order status to filter by (empty for every status)
 	// Returns:
 	//   All order fields plus total_count of matching records
 	// Business Logic:
@@ -1045,6 +1415,7 @@ type Querier interface {
 	//   $1: search_term - Optional filter text for order ID or price
 	//   $2: limit - Pagination limit
 	//   $3: offset - Pagination offset
+	//   $4: status - Optional order status to filter by (empty for every status)
 	// Returns:
 	//   Active order records with total_count
 	// Business Logic:
@@ -1058,14 +1429,14 @@ type Querier interface {
 	//   $1: search_term - Optional text to filter orders
 	//   $2: limit - Pagination limit
 	//   $3: offset - Pagination offset
-	//   $4: merchant_id - Optional merchant UUID to filter by (NULL for all merchants)
+	//   $4: merchant_id - Merchant whose orders are listed
+	//   $5: status - Optional order status to filter by (empty for every status)
 	// Returns:
 	//   Order records with total_count
 	// Business Logic:
 	//   - Combines merchant filtering with search functionality
 	//   - Maintains same sorting and pagination as other order queries
 	//   - Useful for merchant-specific order dashboards
-	//   - NULL merchant_id parameter returns all merchants' orders
 	GetOrdersByMerchant(ctx context.Context, arg GetOrdersByMerchantParams) ([]*GetOrdersByMerchantRow, error)
 	// GetOrdersTrashed: Retrieves paginated list of soft-deleted orders
 	// Purpose: View and manage deleted orders for potential restoration
@@ -1073,6 +1444,7 @@ type Querier interface {
 	//   $1: search_term - Optional text to filter trashed orders
 	//   $2: limit - Maximum records per page
 	//   $3: offset - Records to skip
+	//   $4: status - Optional order status to filter by (empty for every status)
 	// Returns:
 	//   Trashed order records with total_count
 	// Business Logic:
@@ -1082,11 +1454,22 @@ type Querier interface {
 	//   - Used in order recovery/audit interfaces
 	//   - Includes total_count for pagination in trash management UI
 	GetOrdersTrashed(ctx context.Context, arg GetOrdersTrashedParams) ([]*GetOrdersTrashedRow, error)
+	// GetProductByBarcode: Retrieves active product by barcode
+	// Purpose: Resolve a scanned barcode to a product
+	// Parameters:
+	//   $1: barcode - Barcode printed on the product
+	// Returns: Full product details if found and active
+	// Business Logic:
+	//   - Excludes deleted products
+	//   - Barcodes are unique across every merchant, so the lookup is not limited
+	//     to the caller's merchants
+	GetProductByBarcode(ctx context.Context, barcode *string) (*GetProductByBarcodeRow, error)
 	// GetProductByID: Retrieves active product by ID
 	// Purpose: Fetch product details for display/purchase
 	// Parameters:
 	//   $1: product_id - ID of product to retrieve
-	// Returns: Full product record if active
+	// Returns: Full product record if active, with its variant_count and the
+	//   min_price and max_price of its live variants
 	// Business Logic:
 	//   - Excludes deleted products
 	//   - Used for product pages and checkout
@@ -1100,6 +1483,39 @@ type Querier interface {
 	//   - Bypasses deleted_at filter
 	//   - Used in admin/recovery interfaces
 	GetProductByIdTrashed(ctx context.Context, productID int32) (*GetProductByIdTrashedRow, error)
+	// GetProductByScan: Resolves a scanned barcode to a product or one of its variants
+	// Purpose: Look up what a till scanner read, whichever level the barcode is on
+	// Parameters:
+	//   $1: barcode - Barcode read by the scanner
+	// Returns:
+	//   Product details, plus the variant columns when the barcode is a variant's
+	// Business Logic:
+	//   - Excludes deleted products and variants
+	//   - Variant columns are NULL when the barcode belongs to the product itself
+	GetProductByScan(ctx context.Context, barcode *string) (*GetProductByScanRow, error)
+	// GetProductOptions: Retrieves the variant options of a product
+	// Purpose: List the dimensions (e.g. size, colour) a product varies by
+	// Parameters:
+	//   $1: product_id
+	// Returns:
+	//   Product option records in display order
+	GetProductOptions(ctx context.Context, productID int32) ([]*ProductOption, error)
+	// GetProductVariant: Retrieves an active variant by ID
+	// Purpose: Fetch a single variant
+	// Parameters:
+	//   $1: variant_id
+	// Returns:
+	//   The variant record
+	GetProductVariant(ctx context.Context, variantID int32) (*ProductVariant, error)
+	// GetProductVariants: Retrieves the active variants of a product
+	// Purpose: List the sellable variants of a parent product
+	// Parameters:
+	//   $1: product_id
+	// Returns:
+	//   Variant records in the order they were created
+	// Business Logic:
+	//   - Excludes deleted variants
+	GetProductVariants(ctx context.Context, productID int32) ([]*ProductVariant, error)
 	// GetProducts: Retrieves paginated list of active products with search capability
 	// Purpose: List all active (non-deleted) products for display in UI
 	// Parameters:
@@ -1110,7 +1526,10 @@ type Querier interface {
 	//   All product fields plus total_count of matching records
 	// Business Logic:
 	//   - Excludes soft-deleted products (deleted_at IS NULL)
-	//   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+	//   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+	//     and the SKU or barcode of any variant
+	//   - variant_count, min_price and max_price summarise the live variants; a product
+	//     without variants reports its own price as both bounds
 	//   - Orders results by newest first (created_at DESC)
 	//   - Uses COUNT(*) OVER() to include total matching record count for pagination UI
 	GetProducts(ctx context.Context, arg GetProductsParams) ([]*GetProductsRow, error)
@@ -1124,7 +1543,10 @@ type Querier interface {
 	//   All product fields plus total_count of matching records
 	// Business Logic:
 	//   - Excludes soft-deleted products (deleted_at IS NULL)
-	//   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+	//   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+	//     and the SKU or barcode of any variant
+	//   - variant_count, min_price and max_price summarise the live variants; a product
+	//     without variants reports its own price as both bounds
 	//   - Ordered by newest first (created_at DESC)
 	//   - Useful if frontend/backend wants clearer distinction in naming
 	GetProductsActive(ctx context.Context, arg GetProductsActiveParams) ([]*GetProductsActiveRow, error)
@@ -1137,15 +1559,18 @@ type Querier interface {
 	//   $4: max_price - Maximum price filter (0 to ignore, defaults to very high value)
 	//   $5: limit - Number of products to return (pagination)
 	//   $6: offset - Number of products to skip (pagination)
+	//   $7: include_subcategories - Also list products of every active descendant category
 	// Returns:
 	//   - Filtered list of product fields including category name
 	//   - total_count of all matching products for pagination UI
 	// Business Logic:
 	//   - Excludes soft-deleted products (deleted_at IS NULL)
-	//   - Matches category name exactly
-	//   - Supports case-insensitive partial search on name and description
+	//   - Matches category name exactly, optionally with the subtree below it
+	//   - Supports case-insensitive partial search on name, description and variant SKU or barcode
 	//   - Filters by category ID only if provided
-	//   - Filters by price range only if values provided
+	//   - Filters by price range only if values provided; a product with variants
+	//     matches when any variant's effective price is in range
+	//   - variant_count, min_price and max_price summarise the live variants
 	//   - Ordered by newest products first (created_at DESC)
 	GetProductsByCategoryName(ctx context.Context, arg GetProductsByCategoryNameParams) ([]*GetProductsByCategoryNameRow, error)
 	// GetProductsByMerchant: Retrieves paginated and filtered products owned by a specific merchant
@@ -1163,11 +1588,26 @@ type Querier interface {
 	//   - total_count of all matching products for pagination UI
 	// Business Logic:
 	//   - Excludes soft-deleted products (deleted_at IS NULL)
-	//   - Supports case-insensitive partial search on name and description
+	//   - Supports case-insensitive partial search on name, description and variant SKU or barcode
 	//   - Filters by category ID only if provided
-	//   - Filters by price range only if values provided (>= min_price and <= max_price)
+	//   - Filters by price range only if values provided (>= min_price and <= max_price);
+	//     a product with variants matches when any variant's effective price is in range
+	//   - variant_count, min_price and max_price summarise the live variants
 	//   - Ordered by newest products first (created_at DESC)
 	GetProductsByMerchant(ctx context.Context, arg GetProductsByMerchantParams) ([]*GetProductsByMerchantRow, error)
+	// GetProductsForImport: Finds the products an import row could update
+	// Purpose: Match an imported row to existing products by barcode or slug
+	// Parameters:
+	//   $1: barcode - Barcode from the row (NULL to skip)
+	//   $2: slug_product - Slug from the row (NULL to skip)
+	// Returns:
+	//   Every product carrying the barcode or the slug, with its variant count
+	// Business Logic:
+	//   - Includes deleted products, whose barcode and slug stay reserved
+	//   - Returns two rows when barcode and slug belong to different products
+	//   - Not limited to the caller's merchants, so a row cannot take over the
+	//     barcode or slug of another merchant's product
+	GetProductsForImport(ctx context.Context, arg GetProductsForImportParams) ([]*GetProductsForImportRow, error)
 	// GetProductsTrashed: Retrieves paginated list of trashed (soft-deleted) products
 	// Purpose: List deleted products for admin to manage recovery or audit
 	// Parameters:
@@ -1178,10 +1618,54 @@ type Querier interface {
 	//   All product fields plus total_count of matching trashed records
 	// Business Logic:
 	//   - Includes only soft-deleted products (deleted_at IS NOT NULL)
-	//   - Supports partial, case-insensitive search on name, description, brand, slug, and barcode
+	//   - Supports partial, case-insensitive search on name, description, brand, slug, barcode
+	//     and the SKU or barcode of any variant
+	//   - variant_count, min_price and max_price summarise the live variants; a product
+	//     without variants reports its own price as both bounds
 	//   - Returns by newest first (created_at DESC)
 	//   - Used for "Trash Bin" UI or soft-delete management
 	GetProductsTrashed(ctx context.Context, arg GetProductsTrashedParams) ([]*GetProductsTrashedRow, error)
+	// GetPurchaseOrder: Retrieves a purchase order by ID
+	// Purpose: Fetch a single purchase order header
+	// Parameters:
+	//   $1: purchase_order_id
+	// Returns:
+	//   The purchase order record
+	GetPurchaseOrder(ctx context.Context, purchaseOrderID int32) (*PurchaseOrder, error)
+	// GetPurchaseOrderItems: Retrieves the lines of a purchase order
+	// Purpose: Show what was ordered and how much has arrived
+	// Parameters:
+	//   $1: purchase_order_id
+	// Returns:
+	//   Purchase order item records in the order they were added
+	GetPurchaseOrderItems(ctx context.Context, purchaseOrderID int32) ([]*PurchaseOrderItem, error)
+	// GetPurchaseOrderReceipts: Retrieves the receiving lines of a purchase order
+	// Purpose: Audit goods received against a purchase order
+	// Parameters:
+	//   $1: purchase_order_id
+	// Returns:
+	//   Receipt fields with the product received, oldest first
+	GetPurchaseOrderReceipts(ctx context.Context, purchaseOrderID int32) ([]*GetPurchaseOrderReceiptsRow, error)
+	// GetPurchaseOrders: Retrieves purchase orders with optional filters and pagination
+	// Purpose: List the purchase orders of a merchant or supplier
+	// Parameters:
+	//   $1: merchant_id - Merchant to filter by (0 for every merchant)
+	//   $2: supplier_id - Supplier to filter by (0 for every supplier)
+	//   $3: status - Status to filter by (empty for every status)
+	//   $4: Limit (number of records per page)
+	//   $5: Offset (starting index for pagination)
+	// Returns:
+	//   Purchase order fields and total_count (for pagination support)
+	// Business Logic:
+	//   - Returns newest purchase orders first
+	GetPurchaseOrders(ctx context.Context, arg GetPurchaseOrdersParams) ([]*GetPurchaseOrdersRow, error)
+	// GetRefundedQuantities: Sums the units already reversed per order line
+	// Purpose: Prevent refunding more than was sold
+	// Parameters:
+	//   $1: transaction_id
+	// Returns:
+	//   order_item_id and the total quantity reversed so far
+	GetRefundedQuantities(ctx context.Context, transactionID int32) ([]*GetRefundedQuantitiesRow, error)
 	// GetRole: Retrieves role details by role_id
 	// Purpose: Fetch a single role record (regardless of deleted status)
 	// Parameters:
@@ -1209,6 +1693,100 @@ type Querier interface {
 	//   - Includes both active and trashed roles
 	//   - Useful for admin panels with filters and pagination
 	GetRoles(ctx context.Context, arg GetRolesParams) ([]*GetRolesRow, error)
+	// GetStockMovementsByProduct: Pages through the stock history of a product
+	// Purpose: Explain how a product reached its current stock
+	// Parameters:
+	//   $1: product_id - Product to inspect
+	//   $2: limit - Page size
+	//   $3: offset - Pagination offset
+	// Returns:
+	//   Movements, newest first, with total_count for pagination
+	// Business Logic:
+	//   - Includes the movements of every variant of the product
+	GetStockMovementsByProduct(ctx context.Context, arg GetStockMovementsByProductParams) ([]*GetStockMovementsByProductRow, error)
+	// GetStocktake: Retrieves a stocktake by ID
+	// Purpose: Fetch a single stocktake header
+	// Parameters:
+	//   $1: stocktake_id
+	// Returns:
+	//   The stocktake record
+	GetStocktake(ctx context.Context, stocktakeID int32) (*Stocktake, error)
+	// GetStocktakeItems: Retrieves the lines of a stocktake with their variance
+	// Purpose: Review what was counted against what was expected
+	// Parameters:
+	//   $1: stocktake_id
+	//   $2: Limit (number of records per page)
+	//   $3: Offset (starting index for pagination)
+	// Returns:
+	//   Stocktake item fields with product details, variance, variance_value
+	//   and total_count (for pagination support)
+	// Business Logic:
+	//   - Uncounted lines have no variance
+	//   - Variance value is the variance priced at the snapshot unit price
+	//   - Largest shortages come first
+	GetStocktakeItems(ctx context.Context, arg GetStocktakeItemsParams) ([]*GetStocktakeItemsRow, error)
+	// GetStocktakeVarianceByCategory: Summarises the variance of a stocktake by category
+	// Purpose: Report where stock was lost or found and what it was worth
+	// Parameters:
+	//   $1: stocktake_id
+	// Returns:
+	//   Per category: lines, counted lines, expected and counted units of the
+	//   counted lines, variance in units and variance_value
+	// Business Logic:
+	//   - Only counted lines contribute to quantities and variance
+	//   - Categories with the largest loss in value come first
+	GetStocktakeVarianceByCategory(ctx context.Context, stocktakeID int32) ([]*GetStocktakeVarianceByCategoryRow, error)
+	// GetStocktakes: Retrieves stocktakes with optional filters and pagination
+	// Purpose: List the stock count sessions of a merchant
+	// Parameters:
+	//   $1: merchant_id - Merchant to filter by (0 for every merchant)
+	//   $2: status - Status to filter by (empty for every status)
+	//   $3: Limit (number of records per page)
+	//   $4: Offset (starting index for pagination)
+	// Returns:
+	//   Stocktake fields and total_count (for pagination support)
+	// Business Logic:
+	//   - Returns newest stocktakes first
+	GetStocktakes(ctx context.Context, arg GetStocktakesParams) ([]*GetStocktakesRow, error)
+	// GetSupplier: Retrieves an active supplier by ID
+	// Purpose: Fetch a single supplier
+	// Parameters:
+	//   $1: supplier_id
+	// Returns:
+	//   The supplier record
+	GetSupplier(ctx context.Context, supplierID int32) (*Supplier, error)
+	// GetSuppliers: Retrieves active suppliers with optional merchant filter, name search and pagination
+	// Purpose: List the suppliers a merchant buys stock from
+	// Parameters:
+	//   $1: Search query (supplier name, nullable)
+	//   $2: merchant_id - Merchant to filter by (0 for every merchant)
+	//   $3: Limit (number of records per page)
+	//   $4: Offset (starting index for pagination)
+	// Returns:
+	//   Supplier fields and total_count (for pagination support)
+	// Business Logic:
+	//   - Excludes soft-deleted suppliers
+	//   - Supports fuzzy search on name
+	GetSuppliers(ctx context.Context, arg GetSuppliersParams) ([]*GetSuppliersRow, error)
+	// GetTaxRate: Retrieves an active tax rate by ID
+	// Purpose: Fetch a single tax rate
+	// Parameters:
+	//   $1: tax_rate_id
+	// Returns:
+	//   The tax rate record
+	GetTaxRate(ctx context.Context, taxRateID int32) (*TaxRate, error)
+	// GetTaxRates: Retrieves active tax rates with optional name search and pagination
+	// Purpose: List tax rates for the admin panel
+	// Parameters:
+	//   $1: Search query (tax rate name, nullable)
+	//   $2: Limit (number of records per page)
+	//   $3: Offset (starting index for pagination)
+	// Returns:
+	//   Tax rate fields and total_count (for pagination support)
+	// Business Logic:
+	//   - Excludes soft-deleted tax rates
+	//   - Supports fuzzy search on name
+	GetTaxRates(ctx context.Context, arg GetTaxRatesParams) ([]*GetTaxRatesRow, error)
 	// GetTransactionByID: Retrieves transaction by transaction ID
 	// Purpose: Fetch specific transaction details
 	// Parameters:
@@ -1244,6 +1822,28 @@ type Querier interface {
 	//   - Used for order payment verification
 	//   - Helps prevent duplicate payments
 	GetTransactionByOrderID(ctx context.Context, orderID int32) (*GetTransactionByOrderIDRow, error)
+	// GetTransactionForUpdate: Retrieves a transaction and locks its row
+	// Purpose: Serialize refunds and voids of the same transaction
+	// Parameters:
+	//   $1: transaction_id - The unique transaction ID
+	// Returns: Full transaction record if active
+	// Business Logic:
+	//   - Excludes deleted transactions
+	//   - Holds the row lock until the surrounding database transaction ends
+	GetTransactionForUpdate(ctx context.Context, transactionID int32) (*GetTransactionForUpdateRow, error)
+	// GetTransactionPayments: Retrieves the tender lines of a transaction
+	// Purpose: Show how a transaction was paid
+	// Parameters:
+	//   $1: transaction_id
+	// Returns:
+	//   Tender lines in the order they were recorded
+	GetTransactionPayments(ctx context.Context, transactionID int32) ([]*TransactionPayment, error)
+	// GetTransactionRefunds: Retrieves the refunds and voids of a transaction
+	// Parameters:
+	//   $1: transaction_id
+	// Returns:
+	//   Reversals in the order they were recorded
+	GetTransactionRefunds(ctx context.Context, transactionID int32) ([]*TransactionRefund, error)
 	// GetTransactions: Retrieves paginated list of active transactions with search capability
 	// Purpose: List all active transactions for management UI
 	// Parameters:
@@ -1287,6 +1887,10 @@ type Querier interface {
 	//   - Used in transaction recovery/audit interfaces
 	//   - Includes total_count for pagination in trash management UI
 	GetTransactionsTrashed(ctx context.Context, arg GetTransactionsTrashedParams) ([]*GetTransactionsTrashedRow, error)
+	// GetTrashedProductImages: Lists the images of trashed products
+	// Purpose: Find images that may become unused once trashed products are purged
+	// Returns: Distinct image keys of trashed products
+	GetTrashedProductImages(ctx context.Context) ([]*string, error)
 	// GetTrashedRoles: Retrieves only soft-deleted roles with optional search and pagination
 	// Purpose: For trash/recycle bin management
 	// Parameters:
@@ -1418,10 +2022,11 @@ type Querier interface {
 	// Returns:
 	//   year: Year as text
 	//   total_success: Count of successful transactions
-	//   total_amount: Sum of successful transaction amounts
+	//   total_amount: Sum of successful transaction amounts, net of refunds and voids
+	//   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 	// Business Logic:
 	//   - Compares current year with previous year automatically
-	//   - Only includes successful (payment_status = 'success') transactions
+	//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 	//   - Excludes deleted transactions
 	//   - Includes gap-filling for years with no transactions
 	//   - Returns 0 values for years with no successful transactions
@@ -1435,10 +2040,11 @@ type Querier interface {
 	// Returns:
 	//   year: Year as text
 	//   total_success: Count of successful transactions
-	//   total_amount: Sum of successful transaction amounts
+	//   total_amount: Sum of successful transaction amounts, net of refunds and voids
+	//   total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 	// Business Logic:
 	//   - Compares current year with previous year automatically
-	//   - Only includes successful (payment_status = 'success') transactions
+	//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 	//   - Excludes deleted transactions
 	//   - Includes gap-filling for years with no transactions
 	//   - Returns 0 values for years with no successful transactions
@@ -1501,13 +2107,14 @@ type Querier interface {
 	// Purpose: Enables long-term product category performance trend analysis
 	// Parameters:
 	//   $1: Reference date (timestamp) - determines the 5-year analysis window
+	//   $2: Roll up subcategories - when true each category also counts the sales of its descendants
 	// Returns:
 	//   year: 4-digit year as text
 	//   category_id: Unique category identifier
 	//   category_name: Display name of the category
 	//   order_count: Annual number of orders involving this category
 	//   items_sold: Quantity of products sold from this category
-	//   total_revenue: Total sales revenue from category products
+	//   total_revenue: Sum of line price times quantity at the price each line sold for
 	//   unique_products_sold: Count of unique products sold within the category
 	// Business Logic:
 	//   - Covers the current year and previous four years (5-year window)
@@ -1515,19 +2122,21 @@ type Querier interface {
 	//   - Provides both volume and value metrics for category-level evaluation
 	//   - Results sorted by year and revenue to show historical trends
 	//   - Suitable for business reviews and strategic category planning
-	GetYearlyCategory(ctx context.Context, dollar_1 time.Time) ([]*GetYearlyCategoryRow, error)
+	//   - With rollup, totals of a category include every active descendant category
+	GetYearlyCategory(ctx context.Context, arg GetYearlyCategoryParams) ([]*GetYearlyCategoryRow, error)
 	// GetYearlyCategoryById: Retrieves annual sales performance for categories over a 5-year span by category_id
 	// Purpose: Enables long-term product category performance trend analysis
 	// Parameters:
 	//   $1: Reference date (timestamp) - determines the 5-year analysis window
 	//   $2: Category ID
+	//   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 	// Returns:
 	//   year: 4-digit year as text
 	//   category_id: Unique category identifier
 	//   category_name: Display name of the category
 	//   order_count: Annual number of orders involving this category
 	//   items_sold: Quantity of products sold from this category
-	//   total_revenue: Total sales revenue from category products
+	//   total_revenue: Sum of line price times quantity at the price each line sold for
 	//   unique_products_sold: Count of unique products sold within the category
 	// Business Logic:
 	//   - Covers the current year and previous four years (5-year window)
@@ -1535,19 +2144,21 @@ type Querier interface {
 	//   - Provides both volume and value metrics for category-level evaluation
 	//   - Results sorted by year and revenue to show historical trends
 	//   - Suitable for business reviews and strategic category planning
+	//   - With rollup, totals of a category include every active descendant category
 	GetYearlyCategoryById(ctx context.Context, arg GetYearlyCategoryByIdParams) ([]*GetYearlyCategoryByIdRow, error)
 	// GetYearlyCategoryByMerchant: Retrieves annual sales performance for categories over a 5-year span by merchant_id
 	// Purpose: Enables long-term product category performance trend analysis
 	// Parameters:
 	//   $1: Reference date (timestamp) - determines the 5-year analysis window
 	//   $2: Merchant ID
+	//   $3: Roll up subcategories - when true each category also counts the sales of its descendants
 	// Returns:
 	//   year: 4-digit year as text
 	//   category_id: Unique category identifier
 	//   category_name: Display name of the category
 	//   order_count: Annual number of orders involving this category
 	//   items_sold: Quantity of products sold from this category
-	//   total_revenue: Total sales revenue from category products
+	//   total_revenue: Sum of line price times quantity at the price each line sold for
 	//   unique_products_sold: Count of unique products sold within the category
 	// Business Logic:
 	//   - Covers the current year and previous four years (5-year window)
@@ -1555,6 +2166,7 @@ type Querier interface {
 	//   - Provides both volume and value metrics for category-level evaluation
 	//   - Results sorted by year and revenue to show historical trends
 	//   - Suitable for business reviews and strategic category planning
+	//   - With rollup, totals of a category include every active descendant category
 	GetYearlyCategoryByMerchant(ctx context.Context, arg GetYearlyCategoryByMerchantParams) ([]*GetYearlyCategoryByMerchantRow, error)
 	// GetYearlyOrder: Retrieves yearly order summary over the past 5 years
 	// Purpose: Enables long-term trend analysis of sales performance
@@ -1611,15 +2223,17 @@ type Querier interface {
 	// Parameters:
 	//   $1: Reference year for comparison (current year)
 	//   $2: Category ID
+	//   $3: Roll up subcategories - when true the category's descendants are included
 	// Returns:
 	//   year: Year as text
-	//   total_revenue: Annual revenue total (0 if no sales)
+	//   total_revenue: Annual revenue from order lines (0 if no sales)
 	// Business Logic:
 	//   - Compares current year with previous year automatically
 	//   - Validates product/category relationships through joins
 	//   - Excludes deleted records across all joined tables
 	//   - Ensures complete year reporting even with no sales
 	//   - Orders results by most recent year first
+	//   - With rollup, revenue of every active descendant category is included
 	GetYearlyTotalPriceById(ctx context.Context, arg GetYearlyTotalPriceByIdParams) ([]*GetYearlyTotalPriceByIdRow, error)
 	// GetYearlyTotalPriceByMerchant: Retrieves annual revenue with category/product validation by merchant_id
 	// Purpose: Provides year-over-year revenue analysis with product hierarchy verification
@@ -1720,8 +2334,8 @@ type Querier interface {
 	//   merchant_id: The merchant identifier
 	//   merchant_name: The merchant's name
 	//   payment_method: The payment method used
-	//   total_transactions: Count of failed transactions
-	//   total_amount: Total amount that failed processing
+	//   total_transactions: Count of failed tender lines
+	//   total_amount: Total amount that failed processing, net of cash change
 	GetYearlyTransactionMethodsByMerchantFailed(ctx context.Context, arg GetYearlyTransactionMethodsByMerchantFailedParams) ([]*GetYearlyTransactionMethodsByMerchantFailedRow, error)
 	// GetYearlyTransactionMethodsByMerchantSuccess: Analyzes successful transactions by merchant and payment method yearly
 	// Parameters:
@@ -1731,8 +2345,8 @@ type Querier interface {
 	//   merchant_id: The merchant identifier
 	//   merchant_name: The merchant's name
 	//   payment_method: The payment method used
-	//   total_transactions: Count of successful transactions
-	//   total_amount: Total amount processed by this method
+	//   total_transactions: Count of successful tender lines
+	//   total_amount: Total amount processed by this method, net of cash change
 	GetYearlyTransactionMethodsByMerchantSuccess(ctx context.Context, arg GetYearlyTransactionMethodsByMerchantSuccessParams) ([]*GetYearlyTransactionMethodsByMerchantSuccessRow, error)
 	// GetYearlyTransactionMethodsFailed: Analyzes failed payment method usage by year
 	// Parameters:
@@ -1740,8 +2354,8 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year as text
 	//   payment_method: The payment method used
-	//   total_transactions: Count of failed transactions
-	//   total_amount: Total amount that failed processing
+	//   total_transactions: Count of failed tender lines
+	//   total_amount: Total amount that failed processing, net of cash change
 	GetYearlyTransactionMethodsFailed(ctx context.Context, dollar_1 time.Time) ([]*GetYearlyTransactionMethodsFailedRow, error)
 	// GetYearlyTransactionMethodsSuccess: Analyzes successful payment method usage by year
 	// Parameters:
@@ -1749,9 +2363,69 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year as text
 	//   payment_method: The payment method used
-	//   total_transactions: Count of successful transactions
-	//   total_amount: Total amount processed by this method
+	//   total_transactions: Count of successful tender lines
+	//   total_amount: Total amount processed by this method, net of cash change
 	GetYearlyTransactionMethodsSuccess(ctx context.Context, dollar_1 time.Time) ([]*GetYearlyTransactionMethodsSuccessRow, error)
+	// LockCategoryTree: Serializes changes to the category tree
+	// Purpose: Keep concurrent moves from creating a cycle between them
+	// Parameters: None
+	// Returns: None
+	// Business Logic:
+	//   - Holds a transaction-level advisory lock until commit or rollback
+	LockCategoryTree(ctx context.Context) error
+	// LockStocktakeForCount: Retrieves a stocktake and holds it open while counts are recorded
+	// Purpose: Keep a stocktake from being posted or cancelled halfway through a count
+	// Parameters:
+	//   $1: stocktake_id
+	// Returns:
+	//   The stocktake record
+	// Business Logic:
+	//   - Takes a share lock, so concurrent counts proceed together while a
+	//     status change waits for them to commit
+	LockStocktakeForCount(ctx context.Context, stocktakeID int32) (*Stocktake, error)
+	// ReceivePurchaseOrderItem: Adds received units to a purchase order line
+	// Purpose: Track how much of a line has arrived
+	// Parameters:
+	//   $1: purchase_order_item_id
+	//   $2: purchase_order_id - Purchase order the line must belong to
+	//   $3: quantity - Units received
+	// Returns:
+	//   The updated purchase order item, or no row when the line does not
+	//   belong to the purchase order or more units would be received than ordered
+	ReceivePurchaseOrderItem(ctx context.Context, arg ReceivePurchaseOrderItemParams) (*PurchaseOrderItem, error)
+	// RecordStockMovement: Applies a stock change and records it in the ledger
+	// Purpose: Keep count_in_stock as a projection of stock_movements
+	// Parameters:
+	//   $1: product_id - Product whose stock changes
+	//   $2: quantity_delta - Units added (positive) or removed (negative)
+	//   $3: reason - Why the stock changed
+	//   $4: reference_type - Kind of entity that caused the change (nullable)
+	//   $5: reference_id - ID of that entity (nullable)
+	//   $6: user_id - Acting user (nullable)
+	//   $7: note - Free text (nullable)
+	//   $8: variant_id - Variant of the product whose stock changes (nullable)
+	// Returns: The recorded movement, or no row when the product or variant does
+	//   not exist, the change would take its stock below zero, or a product with
+	//   variants is changed without naming one of them
+	// Business Logic:
+	//   - Updates the stock and inserts the movement in one statement
+	//   - A variant movement also moves the stock of its product, which holds the
+	//     total of its variants
+	//   - balance_after is the count_in_stock left by this movement on the
+	//     variant when one is named, otherwise on the product
+	RecordStockMovement(ctx context.Context, arg RecordStockMovementParams) (*StockMovement, error)
+	// ReleaseIdempotencyKey: Drops the claim of a request that failed
+	// Purpose: Allow the client to retry a request that had no effect
+	// Parameters:
+	//   $1: idempotency_key_id
+	// Business Logic:
+	//   - Completed keys are kept
+	ReleaseIdempotencyKey(ctx context.Context, idempotencyKeyID int32) error
+	// ReleaseLowStockAlerts: Unmarks products whose alert could not be delivered
+	// Purpose: Retry the alert on the next evaluation
+	// Parameters:
+	//   $1: product_ids - Products to unmark
+	ReleaseLowStockAlerts(ctx context.Context, dollar_1 []int32) error
 	// RemoveRoleFromUser: Permanently removes a role from a user
 	// Purpose: Hard delete of a user-role mapping (bypasses trash)
 	// Parameters:
@@ -1761,6 +2435,34 @@ type Querier interface {
 	//   - Deletes the record instead of soft-deleting
 	//   - Use cautiously if audit/history is important
 	RemoveRoleFromUser(ctx context.Context, arg RemoveRoleFromUserParams) error
+	// ReorderCategories: Sets the position of categories from their order in a list
+	// Purpose: Persist a new sibling order
+	// Parameters:
+	//   $1: Category IDs in their new order
+	// Returns:
+	//   Nothing (command only)
+	// Business Logic:
+	//   - The first category gets position 0, the next 1, and so on
+	ReorderCategories(ctx context.Context, categoryIds []int32) error
+	// ReserveIdempotencyKey: Claims an idempotency key for a request about to run
+	// Purpose: Make sure only one request per key reaches the handler
+	// Parameters:
+	//   $1: user_id - Caller the key belongs to
+	//   $2: method - Full gRPC method the key is scoped to
+	//   $3: idempotency_key - Key sent by the client
+	//   $4: request_hash - SHA-256 of the request payload
+	//   $5: locked_until - When an unfinished claim may be taken over
+	//   $6: expires_at - When the stored response stops being replayed
+	// Returns:
+	//   The claimed key, or no row when another request holds it
+	// Business Logic:
+	//   - Expired keys and claims abandoned past locked_until are taken over
+	//   - Completed keys are never overwritten before they expire
+	ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (*IdempotencyKey, error)
+	// ResetLowStockAlerts: Unmarks products that were restocked
+	// Purpose: Alert again the next time a restocked product runs low
+	// Returns: Number of products unmarked
+	ResetLowStockAlerts(ctx context.Context) (int64, error)
 	// RestoreAllCashiers: Mass restoration of deleted cashiers
 	// Purpose: Recover all trashed cashiers at once
 	// Business Logic:
@@ -1885,6 +2587,20 @@ type Querier interface {
 	// Parameters:
 	//   $1: Role ID
 	RestoreRole(ctx context.Context, roleID int32) (*Role, error)
+	// RestoreSupplier: Restores a soft-deleted supplier
+	// Purpose: Undo a supplier trash
+	// Parameters:
+	//   $1: supplier_id
+	// Returns:
+	//   The restored supplier record
+	RestoreSupplier(ctx context.Context, supplierID int32) (*Supplier, error)
+	// RestoreTaxRate: Restores a soft-deleted tax rate
+	// Purpose: Re-enable a previously trashed rate
+	// Parameters:
+	//   $1: tax_rate_id
+	// Returns:
+	//   The restored tax rate
+	RestoreTaxRate(ctx context.Context, taxRateID int32) (*TaxRate, error)
 	// RestoreTransaction: Recovers a soft-deleted transaction
 	// Purpose: Reactivate a cancelled transaction
 	// Parameters:
@@ -1913,6 +2629,29 @@ type Querier interface {
 	// Business Logic:
 	//   - Clears the deleted_at field to mark as active again
 	RestoreUserRole(ctx context.Context, userRoleID int32) error
+	// RevokeRefreshToken: Revokes a single refresh token
+	// Purpose: Consume a refresh token during rotation
+	// Parameters:
+	//   $1: refresh_token_id - ID of the token to revoke
+	// Returns: The revoked record, or no rows if it was already revoked
+	// Business Logic:
+	//   - Only one concurrent rotation of the same token can succeed
+	RevokeRefreshToken(ctx context.Context, refreshTokenID int32) (*RefreshToken, error)
+	// RevokeRefreshTokenSession: Revokes every token of a session
+	// Purpose: Log out one device or contain a reused refresh token
+	// Parameters:
+	//   $1: user_id - ID of the user owning the session
+	//   $2: session_id - Session to revoke
+	// Returns: The tokens that were still live
+	// Business Logic:
+	//   - Already revoked tokens keep their original revoked_at
+	RevokeRefreshTokenSession(ctx context.Context, arg RevokeRefreshTokenSessionParams) ([]*RefreshToken, error)
+	// RevokeRefreshTokensByUserId: Revokes every token of a user
+	// Purpose: Log out all devices
+	// Parameters:
+	//   $1: user_id - ID of the user
+	// Returns: The tokens that were still live
+	RevokeRefreshTokensByUserId(ctx context.Context, userID int32) ([]*RefreshToken, error)
 	// TrashCashier: Soft-deletes a cashier record
 	// Purpose: Remove cashier from active use without permanent deletion
 	// Parameters:
@@ -1963,11 +2702,35 @@ type Querier interface {
 	//   - Preserves product data
 	//   - Excludes from active queries
 	TrashProduct(ctx context.Context, productID int32) (*Product, error)
+	// TrashProductVariant: Soft-deletes a variant without stock
+	// Purpose: Stop selling a variant while keeping the order lines that reference it
+	// Parameters:
+	//   $1: variant_id
+	// Returns:
+	//   The trashed variant, or no row when it still holds stock
+	// Business Logic:
+	//   - Only variants with no stock left can be removed, so the product total
+	//     stays the sum of its live variants
+	TrashProductVariant(ctx context.Context, variantID int32) (*ProductVariant, error)
 	// TrashRole: Soft-deletes a role (moves to trash)
 	// Purpose: Mark role as deleted without removing it permanently
 	// Parameters:
 	//   $1: Role ID
 	TrashRole(ctx context.Context, roleID int32) (*Role, error)
+	// TrashSupplier: Soft-deletes a supplier
+	// Purpose: Stop raising purchase orders against a supplier while keeping its history
+	// Parameters:
+	//   $1: supplier_id
+	// Returns:
+	//   The trashed supplier record
+	TrashSupplier(ctx context.Context, supplierID int32) (*Supplier, error)
+	// TrashTaxRate: Soft-deletes a tax rate
+	// Purpose: Stop applying a rate while keeping it for audit
+	// Parameters:
+	//   $1: tax_rate_id
+	// Returns:
+	//   The soft-deleted tax rate
+	TrashTaxRate(ctx context.Context, taxRateID int32) (*TaxRate, error)
 	// TrashTransaction: Soft-deletes a transaction
 	// Purpose: Void/cancel a transaction without permanent deletion
 	// Parameters:
@@ -2032,6 +2795,17 @@ type Querier interface {
 	//   - Automatically updates the updated_at field
 	//   - Skips if category has been soft-deleted
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (*UpdateCategoryRow, error)
+	// UpdateCategoryParent: Moves a category under another parent
+	// Purpose: Restructure the category tree
+	// Parameters:
+	//   $1: Category ID
+	//   $2: New parent category ID (NULL to make it a root category)
+	// Returns:
+	//   Updated category record
+	// Business Logic:
+	//   - Skips if category has been soft-deleted
+	//   - Positions are renumbered separately with ReorderCategories
+	UpdateCategoryParent(ctx context.Context, arg UpdateCategoryParentParams) (*Category, error)
 	// UpdateMerchant: Modifies merchant information
 	// Purpose: Update merchant profile details
 	// Parameters:
@@ -2049,6 +2823,17 @@ type Querier interface {
 	//   - Validates all required fields
 	//   - Returns modified record for confirmation
 	UpdateMerchant(ctx context.Context, arg UpdateMerchantParams) (*UpdateMerchantRow, error)
+	// UpdateMerchantBarcodeSettings: Sets how product barcodes are generated for a merchant
+	// Purpose: Number new products under the merchant's own GS1 company prefix
+	// Parameters:
+	//   $1: merchant_id - Target merchant ID
+	//   $2: gs1_prefix - GS1 company prefix (NULL to use the in-store range)
+	//   $3: barcode_format - 'ean13' or 'upca'
+	// Returns: The merchant's barcode settings
+	// Business Logic:
+	//   - Only affects active (non-deleted) records
+	//   - Existing product barcodes are left unchanged
+	UpdateMerchantBarcodeSettings(ctx context.Context, arg UpdateMerchantBarcodeSettingsParams) (*UpdateMerchantBarcodeSettingsRow, error)
 	// UpdateOrder: Modifies order information
 	// Purpose: Update order details (primarily total price)
 	// Parameters:
@@ -2061,18 +2846,44 @@ type Querier interface {
 	//   - Used when order items change
 	//   - Should trigger recalculation of total_price
 	UpdateOrder(ctx context.Context, arg UpdateOrderParams) (*UpdateOrderRow, error)
-	// UpdateOrderItem: Updates quantity and price of an existing order item
+	// UpdateOrderItem: Updates the product, quantity and price of an existing order item
 	// Purpose: Allows modification of product details in an order
 	// Parameters:
 	//   $1: order_item_id
 	//   $2: new quantity
 	//   $3: new price
+	//   $4: new product_id
+	//   $5: new variant_id (nullable for products without variants)
 	// Returns:
 	//   The updated order item
 	// Business Logic:
 	//   - Applies changes only to active items
 	//   - Automatically updates `updated_at` timestamp
 	UpdateOrderItem(ctx context.Context, arg UpdateOrderItemParams) (*UpdateOrderItemRow, error)
+	// UpdateOrderItemTax: Records the tax charged on an order line
+	// Purpose: Keep a per-line tax breakdown so receipts can be rebuilt
+	// Parameters:
+	//   $1: order_item_id
+	//   $2: tax_rate_id - Applied tax rate (nullable when untaxed)
+	//   $3: tax_rate_bps - Rate in basis points at the time of sale
+	//   $4: tax_inclusive - Whether the line price already included the tax
+	//   $5: tax_rounding - Rounding rule used for the line
+	//   $6: tax_amount - Tax charged on the line
+	// Business Logic:
+	//   - Snapshots the rate so later rate changes do not alter past sales
+	UpdateOrderItemTax(ctx context.Context, arg UpdateOrderItemTaxParams) error
+	// UpdateOrderStatus: Moves an order to its next lifecycle status
+	// Purpose: Apply a transition checked by the order state machine
+	// Parameters:
+	//   $1: order_id - ID of the order
+	//   $2: from_status - Status the transition was validated against
+	//   $3: to_status - New status
+	// Returns: The updated order record
+	// Business Logic:
+	//   - Only succeeds while the order is still in from_status, so two
+	//     concurrent transitions cannot both apply
+	//   - Only modifies active (non-deleted) orders
+	UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (*Order, error)
 	// UpdateProduct: Modifies product information
 	// Purpose: Update product details
 	// Parameters:
@@ -2081,40 +2892,53 @@ type Querier interface {
 	//   $3: name - Updated name
 	//   $4: description - Updated description
 	//   $5: price - Updated price
-	//   $6: count_in_stock - Updated inventory count
-	//   $7: brand - Updated brand
-	//   $8: weight - Updated weight
-	//   $9: image_product - Updated image
-	//   $10: barcode - Updated barcode
+	//   $6: brand - Updated brand
+	//   $7: weight - Updated weight
+	//   $8: image_product - Updated image
+	//   $9: barcode - Updated barcode
 	// Returns: Updated product record
 	// Business Logic:
 	//   - Auto-updates updated_at
 	//   - Only modifies active products
 	//   - Validates all fields
+	//   - Leaves count_in_stock alone; stock changes go through stock_movements
 	UpdateProduct(ctx context.Context, arg UpdateProductParams) (*UpdateProductRow, error)
-	// UpdateProductCountStock: Updates inventory count
-	// Purpose: Adjust product stock levels
+	// UpdateProductReorderLevel: Sets the low-stock threshold of a product
+	// Purpose: Configure when a product should be reordered and by how much
 	// Parameters:
 	//   $1: product_id - Product to update
-	//   $2: count_in_stock - New inventory count
-	// Returns: Updated product record
-	// Business Logic:
-	//   - Dedicated stock adjustment function
-	//   - Used when inventory changes
-	//   - Validates non-negative quantity
-	UpdateProductCountStock(ctx context.Context, arg UpdateProductCountStockParams) (*UpdateProductCountStockRow, error)
-	// UpdateRefreshTokenByUserId: Updates refresh token for a user
-	// Purpose: Rotate/refresh token for a user
-	// Parameters:
-	//   $1: user_id - ID of the user to update token for
-	//   $2: token - New token string
-	//   $3: expiration - New expiration timestamp
-	// Business Logic:
-	//   - Updates token and expiration fields
-	//   - Sets updated_at to current time
-	//   - Only modifies active tokens
-	//   - Used during token rotation flows
-	UpdateRefreshTokenByUserId(ctx context.Context, arg UpdateRefreshTokenByUserIdParams) (*RefreshToken, error)
+	//   $2: reorder_point - Stock level at or below which the product is low (0 disables alerts)
+	//   $3: reorder_quantity - Suggested quantity to order when the product is low
+	// Returns: Reorder settings of the updated product
+	// Business Logic:
+	//   - Clears any previous alert so the new threshold is evaluated afresh
+	UpdateProductReorderLevel(ctx context.Context, arg UpdateProductReorderLevelParams) (*UpdateProductReorderLevelRow, error)
+	// UpdateProductVariant: Updates the identifiers and price of a variant
+	// Purpose: Correct a SKU or barcode, or change the price override
+	// Parameters:
+	//   $1: variant_id
+	//   $2: sku
+	//   $3: barcode (nullable)
+	//   $4: price - Price override (nullable to inherit the product price)
+	// Returns:
+	//   The updated variant
+	// Business Logic:
+	//   - Options and stock are not changed here; stock moves through the ledger
+	UpdateProductVariant(ctx context.Context, arg UpdateProductVariantParams) (*ProductVariant, error)
+	// UpdatePurchaseOrderStatus: Moves a purchase order to its next status
+	// Purpose: Apply a transition checked by the purchase order state machine
+	// Parameters:
+	//   $1: purchase_order_id
+	//   $2: from_status - Status the transition was validated against
+	//   $3: to_status - New status
+	// Returns:
+	//   The updated purchase order record
+	// Business Logic:
+	//   - Only succeeds while the purchase order is still in from_status, so two
+	//     concurrent transitions cannot both apply
+	//   - Stamps ordered_at when the order is placed and received_at when the
+	//     last line is received
+	UpdatePurchaseOrderStatus(ctx context.Context, arg UpdatePurchaseOrderStatusParams) (*PurchaseOrder, error)
 	// UpdateRole: Updates role name by ID
 	// Purpose: Modify role information (e.g., name correction)
 	// Parameters:
@@ -2123,6 +2947,65 @@ type Querier interface {
 	// Returns:
 	//   Updated role's data
 	UpdateRole(ctx context.Context, arg UpdateRoleParams) (*Role, error)
+	// UpdateStocktakeItemCount: Records the counted quantity of a product
+	// Purpose: Store a count submitted during a stocktake
+	// Parameters:
+	//   $1: stocktake_id
+	//   $2: product_id
+	//   $3: counted_quantity
+	//   $4: counted_by - Acting user (nullable)
+	// Returns:
+	//   The updated stocktake item, or no row when the product is not part of
+	//   the stocktake
+	// Business Logic:
+	//   - A recount replaces the previous count
+	//   - The expected quantity is re-taken from current stock so sales made
+	//     while the store is being counted are not reported as variance
+	UpdateStocktakeItemCount(ctx context.Context, arg UpdateStocktakeItemCountParams) (*StocktakeItem, error)
+	// UpdateStocktakeStatus: Moves a stocktake to its next status
+	// Purpose: Apply a transition checked by the stocktake state machine
+	// Parameters:
+	//   $1: stocktake_id
+	//   $2: from_status - Status the transition was validated against
+	//   $3: to_status - New status
+	//   $4: posted_by - Acting user (nullable)
+	// Returns:
+	//   The updated stocktake record
+	// Business Logic:
+	//   - Only succeeds while the stocktake is still in from_status, so two
+	//     concurrent transitions cannot both apply
+	//   - Stamps posted_at and posted_by when the stocktake is posted
+	UpdateStocktakeStatus(ctx context.Context, arg UpdateStocktakeStatusParams) (*Stocktake, error)
+	// UpdateSupplier: Updates the contact details of a supplier
+	// Purpose: Keep supplier details current
+	// Parameters:
+	//   $1: supplier_id
+	//   $2: name
+	//   $3: contact_name (nullable)
+	//   $4: email (nullable)
+	//   $5: phone (nullable)
+	//   $6: address (nullable)
+	// Returns:
+	//   The updated supplier record
+	// Business Logic:
+	//   - The owning merchant cannot change
+	//   - Only modifies active (non-deleted) suppliers
+	UpdateSupplier(ctx context.Context, arg UpdateSupplierParams) (*Supplier, error)
+	// UpdateTaxRate: Updates an active tax rate
+	// Purpose: Change a rate without a redeploy
+	// Parameters:
+	//   $1: tax_rate_id
+	//   $2: name
+	//   $3: merchant_id (nullable)
+	//   $4: category_id (nullable)
+	//   $5: rate_bps
+	//   $6: is_inclusive
+	//   $7: rounding
+	// Returns:
+	//   The updated tax rate
+	// Business Logic:
+	//   - Already priced order lines keep the rate they were charged
+	UpdateTaxRate(ctx context.Context, arg UpdateTaxRateParams) (*TaxRate, error)
 	// UpdateTransaction: Modifies transaction details
 	// Purpose: Update transaction information
 	// Parameters:
@@ -2133,6 +3016,8 @@ type Querier interface {
 	//   $5: change_amount - Updated change amount
 	//   $6: payment_status - Updated payment status
 	//   $7: order_id - Updated order reference
+	//   $8: subtotal_amount - Updated order total excluding tax
+	//   $9: tax_amount - Updated tax amount
 	// Returns: Updated transaction record
 	// Business Logic:
 	//   - Auto-updates updated_at timestamp
@@ -2140,6 +3025,16 @@ type Querier interface {
 	//   - Validates all payment fields
 	//   - Used for payment corrections/updates
 	UpdateTransaction(ctx context.Context, arg UpdateTransactionParams) (*UpdateTransactionRow, error)
+	// UpdateTransactionStatus: Changes the payment status of a transaction
+	// Purpose: Record that a transaction was refunded or voided
+	// Parameters:
+	//   $1: transaction_id - ID of transaction to update
+	//   $2: payment_status - New payment status
+	// Returns: The updated transaction record
+	// Business Logic:
+	//   - Only processes active transactions
+	//   - Leaves amounts untouched; reversals are recorded in transaction_refunds
+	UpdateTransactionStatus(ctx context.Context, arg UpdateTransactionStatusParams) (*Transaction, error)
 	// UpdateUser: Modifies user account information
 	// Purpose: Update user profile details
 	// Parameters:
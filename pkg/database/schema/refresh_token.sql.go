@@ -11,15 +11,20 @@ import (
 )
 
 const createRefreshToken = `-- name: CreateRefreshToken :one
-INSERT INTO refresh_tokens (user_id, token, expiration, created_at, updated_at)
-VALUES ($1, $2, $3, current_timestamp, current_timestamp)
-RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at
+INSERT INTO refresh_tokens (user_id, token, expiration, session_id, access_token_id, user_agent, ip_address, session_started_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, current_timestamp, current_timestamp)
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
 `
 
 type CreateRefreshTokenParams struct {
-	UserID     int32     `json:"user_id"`
-	Token      string    `json:"token"`
-	Expiration time.Time `json:"expiration"`
+	UserID           int32     `json:"user_id"`
+	Token            string    `json:"token"`
+	Expiration       time.Time `json:"expiration"`
+	SessionID        string    `json:"session_id"`
+	AccessTokenID    string    `json:"access_token_id"`
+	UserAgent        string    `json:"user_agent"`
+	IpAddress        string    `json:"ip_address"`
+	SessionStartedAt time.Time `json:"session_started_at"`
 }
 
 // CreateRefreshToken: Creates a new refresh token
@@ -29,6 +34,11 @@ type CreateRefreshTokenParams struct {
 //	$1: user_id - ID of the user this token belongs to
 //	$2: token - The actual refresh token string
 //	$3: expiration - Expiration timestamp of the token
+//	$4: session_id - Session (token family) the token belongs to
+//	$5: access_token_id - jti of the access token issued with it
+//	$6: user_agent - User agent of the device that started the session
+//	$7: ip_address - Address of the device that started the session
+//	$8: session_started_at - When the session was started by a login
 //
 // Returns: The created refresh token record (excluding sensitive fields if any)
 // Business Logic:
@@ -36,7 +46,16 @@ type CreateRefreshTokenParams struct {
 //   - Used in JWT refresh token rotation
 //   - Typically created during login/auth flows
 func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (*RefreshToken, error) {
-	row := q.db.QueryRow(ctx, createRefreshToken, arg.UserID, arg.Token, arg.Expiration)
+	row := q.db.QueryRow(ctx, createRefreshToken,
+		arg.UserID,
+		arg.Token,
+		arg.Expiration,
+		arg.SessionID,
+		arg.AccessTokenID,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.SessionStartedAt,
+	)
 	var i RefreshToken
 	err := row.Scan(
 		&i.RefreshTokenID,
@@ -46,10 +65,33 @@ func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshToken
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SessionID,
+		&i.AccessTokenID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.SessionStartedAt,
+		&i.RevokedAt,
 	)
 	return &i, err
 }
 
+const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :execrows
+DELETE FROM refresh_tokens
+WHERE expiration < current_timestamp
+`
+
+// DeleteExpiredRefreshTokens: Permanently deletes expired refresh tokens
+// Purpose: Keep the token table from growing with every rotation
+// Business Logic:
+//   - Revoked tokens are kept until they expire so reuse is still detected
+func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredRefreshTokens)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteRefreshToken = `-- name: DeleteRefreshToken :exec
 DELETE FROM refresh_tokens
 WHERE token = $1
@@ -90,8 +132,104 @@ func (q *Queries) DeleteRefreshTokenByUserId(ctx context.Context, userID int32)
 	return err
 }
 
+const findActiveRefreshTokensByUserId = `-- name: FindActiveRefreshTokensByUserId :many
+SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
+FROM refresh_tokens
+WHERE user_id = $1
+  AND revoked_at IS NULL
+  AND deleted_at IS NULL
+  AND expiration > current_timestamp
+ORDER BY session_started_at DESC, refresh_token_id DESC
+`
+
+// FindActiveRefreshTokensByUserId: Lists the live refresh token of every session
+// Purpose: List a user's active sessions
+// Parameters:
+//
+//	$1: user_id - ID of the user
+//
+// Returns: One record per active session, newest session first
+// Business Logic:
+//   - Rotation revokes the previous token, so each session has one live token
+//   - Excludes revoked, deleted and expired tokens
+func (q *Queries) FindActiveRefreshTokensByUserId(ctx context.Context, userID int32) ([]*RefreshToken, error) {
+	rows, err := q.db.Query(ctx, findActiveRefreshTokensByUserId, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*RefreshToken
+	for rows.Next() {
+		var i RefreshToken
+		if err := rows.Scan(
+			&i.RefreshTokenID,
+			&i.UserID,
+			&i.Token,
+			&i.Expiration,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.SessionID,
+			&i.AccessTokenID,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.SessionStartedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findRefreshTokenByAccessTokenId = `-- name: FindRefreshTokenByAccessTokenId :one
+SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
+FROM refresh_tokens
+WHERE user_id = $1 AND access_token_id = $2 AND deleted_at IS NULL
+`
+
+type FindRefreshTokenByAccessTokenIdParams struct {
+	UserID        int32  `json:"user_id"`
+	AccessTokenID string `json:"access_token_id"`
+}
+
+// FindRefreshTokenByAccessTokenId: Retrieves the refresh token issued with an access token
+// Purpose: Resolve the session an access token belongs to
+// Parameters:
+//
+//	$1: user_id - ID of the user owning the token
+//	$2: access_token_id - jti of the access token
+//
+// Returns: The refresh token record if found and not deleted
+// Business Logic:
+//   - Used by logout to find the caller's session
+func (q *Queries) FindRefreshTokenByAccessTokenId(ctx context.Context, arg FindRefreshTokenByAccessTokenIdParams) (*RefreshToken, error) {
+	row := q.db.QueryRow(ctx, findRefreshTokenByAccessTokenId, arg.UserID, arg.AccessTokenID)
+	var i RefreshToken
+	err := row.Scan(
+		&i.RefreshTokenID,
+		&i.UserID,
+		&i.Token,
+		&i.Expiration,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.SessionID,
+		&i.AccessTokenID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.SessionStartedAt,
+		&i.RevokedAt,
+	)
+	return &i, err
+}
+
 const findRefreshTokenByToken = `-- name: FindRefreshTokenByToken :one
-SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at
+SELECT refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
 FROM refresh_tokens
 WHERE token = $1 AND deleted_at IS NULL
 `
@@ -102,11 +240,11 @@ WHERE token = $1 AND deleted_at IS NULL
 //
 //	$1: token - The refresh token string to find
 //
-// Returns: The refresh token record if found and active
+// Returns: The refresh token record if found and not deleted
 // Business Logic:
 //   - Only returns non-deleted tokens
+//   - Also returns revoked tokens so that reuse can be detected
 //   - Used during token refresh operations
-//   - Helps prevent token reuse
 func (q *Queries) FindRefreshTokenByToken(ctx context.Context, token string) (*RefreshToken, error) {
 	row := q.db.QueryRow(ctx, findRefreshTokenByToken, token)
 	var i RefreshToken
@@ -118,6 +256,12 @@ func (q *Queries) FindRefreshTokenByToken(ctx context.Context, token string) (*R
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SessionID,
+		&i.AccessTokenID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.SessionStartedAt,
+		&i.RevokedAt,
 	)
 	return &i, err
 }
@@ -130,7 +274,13 @@ SELECT
     expiration,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    session_id,
+    access_token_id,
+    user_agent,
+    ip_address,
+    session_started_at,
+    revoked_at
 FROM
     refresh_tokens
 WHERE
@@ -163,38 +313,34 @@ func (q *Queries) FindRefreshTokenByUserId(ctx context.Context, userID int32) (*
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SessionID,
+		&i.AccessTokenID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.SessionStartedAt,
+		&i.RevokedAt,
 	)
 	return &i, err
 }
 
-const updateRefreshTokenByUserId = `-- name: UpdateRefreshTokenByUserId :one
+const revokeRefreshToken = `-- name: RevokeRefreshToken :one
 UPDATE refresh_tokens
-SET token = $2, expiration = $3, updated_at = current_timestamp
-WHERE user_id = $1 AND deleted_at IS NULL
-RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at
+SET revoked_at = current_timestamp, updated_at = current_timestamp
+WHERE refresh_token_id = $1 AND revoked_at IS NULL AND deleted_at IS NULL
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
 `
 
-type UpdateRefreshTokenByUserIdParams struct {
-	UserID     int32     `json:"user_id"`
-	Token      string    `json:"token"`
-	Expiration time.Time `json:"expiration"`
-}
-
-// UpdateRefreshTokenByUserId: Updates refresh token for a user
-// Purpose: Rotate/refresh token for a user
+// RevokeRefreshToken: Revokes a single refresh token
+// Purpose: Consume a refresh token during rotation
 // Parameters:
 //
-//	$1: user_id - ID of the user to update token for
-//	$2: token - New token string
-//	$3: expiration - New expiration timestamp
+//	$1: refresh_token_id - ID of the token to revoke
 //
+// Returns: The revoked record, or no rows if it was already revoked
 // Business Logic:
-//   - Updates token and expiration fields
-//   - Sets updated_at to current time
-//   - Only modifies active tokens
-//   - Used during token rotation flows
-func (q *Queries) UpdateRefreshTokenByUserId(ctx context.Context, arg UpdateRefreshTokenByUserIdParams) (*RefreshToken, error) {
-	row := q.db.QueryRow(ctx, updateRefreshTokenByUserId, arg.UserID, arg.Token, arg.Expiration)
+//   - Only one concurrent rotation of the same token can succeed
+func (q *Queries) RevokeRefreshToken(ctx context.Context, refreshTokenID int32) (*RefreshToken, error) {
+	row := q.db.QueryRow(ctx, revokeRefreshToken, refreshTokenID)
 	var i RefreshToken
 	err := row.Scan(
 		&i.RefreshTokenID,
@@ -204,6 +350,116 @@ func (q *Queries) UpdateRefreshTokenByUserId(ctx context.Context, arg UpdateRefr
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SessionID,
+		&i.AccessTokenID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.SessionStartedAt,
+		&i.RevokedAt,
 	)
 	return &i, err
 }
+
+const revokeRefreshTokenSession = `-- name: RevokeRefreshTokenSession :many
+UPDATE refresh_tokens
+SET revoked_at = current_timestamp, updated_at = current_timestamp
+WHERE user_id = $1 AND session_id = $2 AND revoked_at IS NULL AND deleted_at IS NULL
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
+`
+
+type RevokeRefreshTokenSessionParams struct {
+	UserID    int32  `json:"user_id"`
+	SessionID string `json:"session_id"`
+}
+
+// RevokeRefreshTokenSession: Revokes every token of a session
+// Purpose: Log out one device or contain a reused refresh token
+// Parameters:
+//
+//	$1: user_id - ID of the user owning the session
+//	$2: session_id - Session to revoke
+//
+// Returns: The tokens that were still live
+// Business Logic:
+//   - Already revoked tokens keep their original revoked_at
+func (q *Queries) RevokeRefreshTokenSession(ctx context.Context, arg RevokeRefreshTokenSessionParams) ([]*RefreshToken, error) {
+	rows, err := q.db.Query(ctx, revokeRefreshTokenSession, arg.UserID, arg.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*RefreshToken
+	for rows.Next() {
+		var i RefreshToken
+		if err := rows.Scan(
+			&i.RefreshTokenID,
+			&i.UserID,
+			&i.Token,
+			&i.Expiration,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.SessionID,
+			&i.AccessTokenID,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.SessionStartedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeRefreshTokensByUserId = `-- name: RevokeRefreshTokensByUserId :many
+UPDATE refresh_tokens
+SET revoked_at = current_timestamp, updated_at = current_timestamp
+WHERE user_id = $1 AND revoked_at IS NULL AND deleted_at IS NULL
+RETURNING refresh_token_id, user_id, token, expiration, created_at, updated_at, deleted_at, session_id, access_token_id, user_agent, ip_address, session_started_at, revoked_at
+`
+
+// RevokeRefreshTokensByUserId: Revokes every token of a user
+// Purpose: Log out all devices
+// Parameters:
+//
+//	$1: user_id - ID of the user
+//
+// Returns: The tokens that were still live
+func (q *Queries) RevokeRefreshTokensByUserId(ctx context.Context, userID int32) ([]*RefreshToken, error) {
+	rows, err := q.db.Query(ctx, revokeRefreshTokensByUserId, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*RefreshToken
+	for rows.Next() {
+		var i RefreshToken
+		if err := rows.Scan(
+			&i.RefreshTokenID,
+			&i.UserID,
+			&i.Token,
+			&i.Expiration,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.SessionID,
+			&i.AccessTokenID,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.SessionStartedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -21,6 +21,8 @@ INSERT INTO
         change_amount,
         payment_status,
         order_id,
+        subtotal_amount,
+        tax_amount,
         created_at,
         updated_at,
         deleted_at
@@ -32,6 +34,8 @@ VALUES (
         $4,
         $5,
         $6,
+        $7,
+        $8,
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP,
         NULL
@@ -44,29 +48,35 @@ RETURNING
     amount,
     change_amount,
     payment_status,
+    subtotal_amount,
+    tax_amount,
     created_at,
     updated_at
 `
 
 type CreateTransactionParams struct {
-	MerchantID    int32  `json:"merchant_id"`
-	PaymentMethod string `json:"payment_method"`
-	Amount        int32  `json:"amount"`
-	ChangeAmount  *int32 `json:"change_amount"`
-	PaymentStatus string `json:"payment_status"`
-	OrderID       int32  `json:"order_id"`
+	MerchantID     int32  `json:"merchant_id"`
+	PaymentMethod  string `json:"payment_method"`
+	Amount         int32  `json:"amount"`
+	ChangeAmount   *int32 `json:"change_amount"`
+	PaymentStatus  string `json:"payment_status"`
+	OrderID        int32  `json:"order_id"`
+	SubtotalAmount int32  `json:"subtotal_amount"`
+	TaxAmount      int32  `json:"tax_amount"`
 }
 
 type CreateTransactionRow struct {
-	TransactionID int32            `json:"transaction_id"`
-	OrderID       int32            `json:"order_id"`
-	MerchantID    int32            `json:"merchant_id"`
-	PaymentMethod string           `json:"payment_method"`
-	Amount        int32            `json:"amount"`
-	ChangeAmount  *int32           `json:"change_amount"`
-	PaymentStatus string           `json:"payment_status"`
-	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	TransactionID  int32            `json:"transaction_id"`
+	OrderID        int32            `json:"order_id"`
+	MerchantID     int32            `json:"merchant_id"`
+	PaymentMethod  string           `json:"payment_method"`
+	Amount         int32            `json:"amount"`
+	ChangeAmount   *int32           `json:"change_amount"`
+	PaymentStatus  string           `json:"payment_status"`
+	SubtotalAmount int32            `json:"subtotal_amount"`
+	TaxAmount      int32            `json:"tax_amount"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
 }
 
 // CreateTransaction: Creates a new transaction record
@@ -79,6 +89,8 @@ type CreateTransactionRow struct {
 //	$4: change_amount - Change amount (if applicable)
 //	$5: payment_status - Payment status ('success', 'failed', 'pending')
 //	$6: order_id - Associated order reference
+//	$7: subtotal_amount - Order total excluding tax
+//	$8: tax_amount - Tax charged on the order
 //
 // Returns: Newly created transaction record
 // Business Logic:
@@ -94,6 +106,8 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		arg.ChangeAmount,
 		arg.PaymentStatus,
 		arg.OrderID,
+		arg.SubtotalAmount,
+		arg.TaxAmount,
 	)
 	var i CreateTransactionRow
 	err := row.Scan(
@@ -104,6 +118,8 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		&i.Amount,
 		&i.ChangeAmount,
 		&i.PaymentStatus,
+		&i.SubtotalAmount,
+		&i.TaxAmount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -111,7 +127,7 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 }
 
 const deleteAllPermanentTransactions = `-- name: DeleteAllPermanentTransactions :exec
-DELETE FROM transactions WHERE deleted_at IS NOT NULL
+DELETE FROM transactions WHERE deleted_at IS NOT NULL AND in_merchant_scope(merchant_id)
 `
 
 // DeleteAllPermanentTransactions: Purges all cancelled transactions
@@ -126,11 +142,12 @@ func (q *Queries) DeleteAllPermanentTransactions(ctx context.Context) error {
 	return err
 }
 
-const deleteTransactionPermanently = `-- name: DeleteTransactionPermanently :exec
+const deleteTransactionPermanently = `-- name: DeleteTransactionPermanently :execrows
 DELETE FROM transactions
 WHERE
     transaction_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // DeleteTransactionPermanently: Hard-deletes a transaction
@@ -141,12 +158,15 @@ WHERE
 //
 // Business Logic:
 //   - Permanent deletion of already cancelled transactions
-//   - No return value (exec-only operation)
+//   - Returns the number of deleted rows, 0 when no trashed record matched
 //   - Irreversible action - use with caution
 //   - Should be restricted to admin users
-func (q *Queries) DeleteTransactionPermanently(ctx context.Context, transactionID int32) error {
-	_, err := q.db.Exec(ctx, deleteTransactionPermanently, transactionID)
-	return err
+func (q *Queries) DeleteTransactionPermanently(ctx context.Context, transactionID int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTransactionPermanently, transactionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getMonthlyAmountTransactionFailed = `-- name: GetMonthlyAmountTransactionFailed :many
@@ -166,6 +186,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND (
                 (
@@ -333,6 +354,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $5
             AND (
@@ -499,12 +521,12 @@ WITH
                 MONTH
                 FROM t.created_at
             )::integer AS month,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND (
                 (
                     t.created_at >= $1::timestamp
@@ -533,7 +555,8 @@ WITH
                 'Mon'
             ) AS month,
             total_success,
-            total_amount
+            total_amount,
+            total_tax
         FROM monthly_data
         UNION ALL
         SELECT
@@ -543,7 +566,8 @@ WITH
             )::text AS year,
             TO_CHAR($1::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -566,7 +590,8 @@ WITH
             )::text AS year,
             TO_CHAR($3::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -582,7 +607,7 @@ WITH
                     )::integer
             )
     )
-SELECT year, month, total_success, total_amount
+SELECT *
 FROM formatted_data
 ORDER BY year DESC, TO_DATE(month, 'Mon') DESC
 `
@@ -599,6 +624,7 @@ type GetMonthlyAmountTransactionSuccessRow struct {
 	Month        string `json:"month"`
 	TotalSuccess int64  `json:"total_success"`
 	TotalAmount  int32  `json:"total_amount"`
+	TotalTax     int32  `json:"total_tax"`
 }
 
 // GetMonthlyAmountTransactionSuccess: Retrieves monthly success transaction metrics
@@ -615,10 +641,11 @@ type GetMonthlyAmountTransactionSuccessRow struct {
 //	year: Year as text
 //	month: 3-letter month abbreviation (e.g. 'Jan')
 //	total_success: Count of successful transactions
-//	total_amount: Sum of successful transaction amounts
+//	total_amount: Sum of successful transaction amounts, net of refunds and voids
+//	total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 //
 // Business Logic:
-//   - Only includes successful (payment_status = 'success') transactions
+//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 //   - Excludes deleted transactions
 //   - Compares two customizable time periods
 //   - Includes gap-filling for months with no transactions
@@ -643,6 +670,7 @@ func (q *Queries) GetMonthlyAmountTransactionSuccess(ctx context.Context, arg Ge
 			&i.Month,
 			&i.TotalSuccess,
 			&i.TotalAmount,
+			&i.TotalTax,
 		); err != nil {
 			return nil, err
 		}
@@ -666,12 +694,12 @@ WITH
                 MONTH
                 FROM t.created_at
             )::integer AS month,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND t.merchant_id = $5
             AND (
                 (
@@ -701,7 +729,8 @@ WITH
                 'Mon'
             ) AS month,
             total_success,
-            total_amount
+            total_amount,
+            total_tax
         FROM monthly_data
         UNION ALL
         SELECT
@@ -711,7 +740,8 @@ WITH
             )::text AS year,
             TO_CHAR($1::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -734,7 +764,8 @@ WITH
             )::text AS year,
             TO_CHAR($3::timestamp, 'Mon') AS month,
             0 AS total_success,
-            0 AS total_amount
+            0 AS total_amount,
+            0 AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -750,7 +781,7 @@ WITH
                     )::integer
             )
     )
-SELECT year, month, total_success, total_amount
+SELECT *
 FROM formatted_data
 ORDER BY year DESC, TO_DATE(month, 'Mon') DESC
 `
@@ -768,6 +799,7 @@ type GetMonthlyAmountTransactionSuccessByMerchantRow struct {
 	Month        string `json:"month"`
 	TotalSuccess int64  `json:"total_success"`
 	TotalAmount  int32  `json:"total_amount"`
+	TotalTax     int32  `json:"total_tax"`
 }
 
 // GetMonthlyAmountTransactionSuccessByMerchant: Retrieves monthly success transaction metrics by merchant_id
@@ -785,10 +817,11 @@ type GetMonthlyAmountTransactionSuccessByMerchantRow struct {
 //	year: Year as text
 //	month: 3-letter month abbreviation (e.g. 'Jan')
 //	total_success: Count of successful transactions
-//	total_amount: Sum of successful transaction amounts
+//	total_amount: Sum of successful transaction amounts, net of refunds and voids
+//	total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 //
 // Business Logic:
-//   - Only includes successful (payment_status = 'success') transactions
+//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 //   - Excludes deleted transactions
 //   - Compares two customizable time periods
 //   - Includes gap-filling for months with no transactions
@@ -814,6 +847,7 @@ func (q *Queries) GetMonthlyAmountTransactionSuccessByMerchant(ctx context.Conte
 			&i.Month,
 			&i.TotalSuccess,
 			&i.TotalAmount,
+			&i.TotalTax,
 		); err != nil {
 			return nil, err
 		}
@@ -836,10 +870,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -874,21 +911,23 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $5
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -928,8 +967,8 @@ type GetMonthlyTransactionMethodsByMerchantFailedRow struct {
 //	merchant_id: The merchant identifier
 //	merchant_name: The merchant's name
 //	payment_method: The payment method used
-//	total_transactions: Count of failed transactions
-//	total_amount: Total amount that failed processing
+//	total_transactions: Count of failed tender lines
+//	total_amount: Total amount that failed processing, net of cash change
 func (q *Queries) GetMonthlyTransactionMethodsByMerchantFailed(ctx context.Context, arg GetMonthlyTransactionMethodsByMerchantFailedParams) ([]*GetMonthlyTransactionMethodsByMerchantFailedRow, error) {
 	rows, err := q.db.Query(ctx, getMonthlyTransactionMethodsByMerchantFailed,
 		arg.Column1,
@@ -972,10 +1011,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -1010,21 +1052,23 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
             AND t.merchant_id = $5
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -1064,8 +1108,8 @@ type GetMonthlyTransactionMethodsByMerchantSuccessRow struct {
 //	merchant_id: The merchant identifier
 //	merchant_name: The merchant's name
 //	payment_method: The payment method used
-//	total_transactions: Count of successful transactions
-//	total_amount: Total amount processed by this method
+//	total_transactions: Count of successful tender lines
+//	total_amount: Total amount processed by this method, net of cash change
 func (q *Queries) GetMonthlyTransactionMethodsByMerchantSuccess(ctx context.Context, arg GetMonthlyTransactionMethodsByMerchantSuccessParams) ([]*GetMonthlyTransactionMethodsByMerchantSuccessRow, error) {
 	rows, err := q.db.Query(ctx, getMonthlyTransactionMethodsByMerchantSuccess,
 		arg.Column1,
@@ -1108,10 +1152,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -1146,20 +1193,22 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -1196,8 +1245,8 @@ type GetMonthlyTransactionMethodsFailedRow struct {
 //
 //	month: 3-letter month abbreviation (e.g. 'Jan')
 //	payment_method: The payment method used
-//	total_transactions: Count of failed transactions
-//	total_amount: Total amount that failed processing
+//	total_transactions: Count of failed tender lines
+//	total_amount: Total amount that failed processing, net of cash change
 func (q *Queries) GetMonthlyTransactionMethodsFailed(ctx context.Context, arg GetMonthlyTransactionMethodsFailedParams) ([]*GetMonthlyTransactionMethodsFailedRow, error) {
 	rows, err := q.db.Query(ctx, getMonthlyTransactionMethodsFailed,
 		arg.Column1,
@@ -1239,10 +1288,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_months AS (
         SELECT generate_series(
@@ -1277,20 +1329,22 @@ WITH
     monthly_transactions AS (
         SELECT
             date_trunc('month', t.created_at)::date AS activity_month,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
             JOIN date_ranges dr ON (
                 t.created_at BETWEEN dr.range1_start AND dr.range1_end
                 OR t.created_at BETWEEN dr.range2_start AND dr.range2_end
             )
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
         GROUP BY
             date_trunc('month', t.created_at),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     TO_CHAR(ac.activity_month, 'Mon') AS month,
@@ -1327,8 +1381,8 @@ type GetMonthlyTransactionMethodsSuccessRow struct {
 //
 //	month: 3-letter month abbreviation (e.g. 'Jan')
 //	payment_method: The payment method used
-//	total_transactions: Count of successful transactions
-//	total_amount: Total amount processed by this method
+//	total_transactions: Count of successful tender lines
+//	total_amount: Total amount processed by this method, net of cash change
 func (q *Queries) GetMonthlyTransactionMethodsSuccess(ctx context.Context, arg GetMonthlyTransactionMethodsSuccessParams) ([]*GetMonthlyTransactionMethodsSuccessRow, error) {
 	rows, err := q.db.Query(ctx, getMonthlyTransactionMethodsSuccess,
 		arg.Column1,
@@ -1368,24 +1422,29 @@ SELECT
     amount,
     change_amount,
     payment_status,
+    subtotal_amount,
+    tax_amount,
     created_at,
     updated_at
 FROM transactions
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 type GetTransactionByIDRow struct {
-	TransactionID int32            `json:"transaction_id"`
-	OrderID       int32            `json:"order_id"`
-	MerchantID    int32            `json:"merchant_id"`
-	PaymentMethod string           `json:"payment_method"`
-	Amount        int32            `json:"amount"`
-	ChangeAmount  *int32           `json:"change_amount"`
-	PaymentStatus string           `json:"payment_status"`
-	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	TransactionID  int32            `json:"transaction_id"`
+	OrderID        int32            `json:"order_id"`
+	MerchantID     int32            `json:"merchant_id"`
+	PaymentMethod  string           `json:"payment_method"`
+	Amount         int32            `json:"amount"`
+	ChangeAmount   *int32           `json:"change_amount"`
+	PaymentStatus  string           `json:"payment_status"`
+	SubtotalAmount int32            `json:"subtotal_amount"`
+	TaxAmount      int32            `json:"tax_amount"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
 }
 
 // GetTransactionByID: Retrieves transaction by transaction ID
@@ -1410,6 +1469,8 @@ func (q *Queries) GetTransactionByID(ctx context.Context, transactionID int32) (
 		&i.Amount,
 		&i.ChangeAmount,
 		&i.PaymentStatus,
+		&i.SubtotalAmount,
+		&i.TaxAmount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -1431,6 +1492,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -1535,6 +1597,7 @@ FROM transactions
 WHERE
     order_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 type GetTransactionByOrderIDRow struct {
@@ -1577,6 +1640,70 @@ func (q *Queries) GetTransactionByOrderID(ctx context.Context, orderID int32) (*
 	return &i, err
 }
 
+const getTransactionForUpdate = `-- name: GetTransactionForUpdate :one
+SELECT
+    transaction_id,
+    order_id,
+    merchant_id,
+    payment_method,
+    amount,
+    change_amount,
+    payment_status,
+    subtotal_amount,
+    tax_amount,
+    created_at,
+    updated_at
+FROM transactions
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+FOR UPDATE
+`
+
+type GetTransactionForUpdateRow struct {
+	TransactionID  int32            `json:"transaction_id"`
+	OrderID        int32            `json:"order_id"`
+	MerchantID     int32            `json:"merchant_id"`
+	PaymentMethod  string           `json:"payment_method"`
+	Amount         int32            `json:"amount"`
+	ChangeAmount   *int32           `json:"change_amount"`
+	PaymentStatus  string           `json:"payment_status"`
+	SubtotalAmount int32            `json:"subtotal_amount"`
+	TaxAmount      int32            `json:"tax_amount"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+}
+
+// GetTransactionForUpdate: Retrieves a transaction and locks its row
+// Purpose: Serialize refunds and voids of the same transaction
+// Parameters:
+//
+//	$1: transaction_id - The unique transaction ID
+//
+// Returns: Full transaction record if active
+// Business Logic:
+//   - Excludes deleted transactions
+//   - Holds the row lock until the surrounding database transaction ends
+func (q *Queries) GetTransactionForUpdate(ctx context.Context, transactionID int32) (*GetTransactionForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getTransactionForUpdate, transactionID)
+	var i GetTransactionForUpdateRow
+	err := row.Scan(
+		&i.TransactionID,
+		&i.OrderID,
+		&i.MerchantID,
+		&i.PaymentMethod,
+		&i.Amount,
+		&i.ChangeAmount,
+		&i.PaymentStatus,
+		&i.SubtotalAmount,
+		&i.TaxAmount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
 const getTransactions = `-- name: GetTransactions :many
 SELECT
     transaction_id,
@@ -1592,6 +1719,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -1687,6 +1815,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -1783,6 +1912,7 @@ SELECT
 FROM transactions
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
     AND (
         $1::TEXT IS NULL
         OR payment_method ILIKE '%' || $1 || '%'
@@ -1877,6 +2007,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND (
                 EXTRACT(
@@ -1988,6 +2119,7 @@ WITH
         FROM transactions t
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $2
             AND (
@@ -2100,12 +2232,12 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND (
                 EXTRACT(
                     YEAR
@@ -2126,13 +2258,15 @@ WITH
         SELECT
             year::text,
             total_success::integer,
-            total_amount::integer
+            total_amount::integer,
+            total_tax::integer
         FROM yearly_data
         UNION ALL
         SELECT
             $1::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -2143,7 +2277,8 @@ WITH
         UNION ALL
         SELECT ($1::integer - 1)::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -2152,7 +2287,7 @@ WITH
                     year = $1::integer - 1
             )
     )
-SELECT year, total_success, total_amount
+SELECT *
 FROM formatted_data
 ORDER BY year DESC
 `
@@ -2161,6 +2296,7 @@ type GetYearlyAmountTransactionSuccessRow struct {
 	Year         string `json:"year"`
 	TotalSuccess int32  `json:"total_success"`
 	TotalAmount  int32  `json:"total_amount"`
+	TotalTax     int32  `json:"total_tax"`
 }
 
 // GetYearlyAmountTransactionSuccess: Retrieves yearly success transaction metrics
@@ -2173,11 +2309,12 @@ type GetYearlyAmountTransactionSuccessRow struct {
 //
 //	year: Year as text
 //	total_success: Count of successful transactions
-//	total_amount: Sum of successful transaction amounts
+//	total_amount: Sum of successful transaction amounts, net of refunds and voids
+//	total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 //
 // Business Logic:
 //   - Compares current year with previous year automatically
-//   - Only includes successful (payment_status = 'success') transactions
+//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 //   - Excludes deleted transactions
 //   - Includes gap-filling for years with no transactions
 //   - Returns 0 values for years with no successful transactions
@@ -2191,7 +2328,12 @@ func (q *Queries) GetYearlyAmountTransactionSuccess(ctx context.Context, dollar_
 	var items []*GetYearlyAmountTransactionSuccessRow
 	for rows.Next() {
 		var i GetYearlyAmountTransactionSuccessRow
-		if err := rows.Scan(&i.Year, &i.TotalSuccess, &i.TotalAmount); err != nil {
+		if err := rows.Scan(
+			&i.Year,
+			&i.TotalSuccess,
+			&i.TotalAmount,
+			&i.TotalTax,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2210,12 +2352,12 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            COUNT(*) AS total_success,
-            COALESCE(SUM(t.amount), 0)::integer AS total_amount
-        FROM transactions t
+            COUNT(t.transaction_id) AS total_success,
+            COALESCE(SUM(t.amount), 0)::integer AS total_amount,
+            COALESCE(SUM(t.tax_amount), 0)::integer AS total_tax
+        FROM transaction_revenue t
         WHERE
-            t.deleted_at IS NULL
-            AND t.payment_status = 'success'
+            in_merchant_scope(t.merchant_id)
             AND t.merchant_id = $2
             AND (
                 EXTRACT(
@@ -2237,13 +2379,15 @@ WITH
         SELECT
             year::text,
             total_success::integer,
-            total_amount::integer
+            total_amount::integer,
+            total_tax::integer
         FROM yearly_data
         UNION ALL
         SELECT
             $1::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -2254,7 +2398,8 @@ WITH
         UNION ALL
         SELECT ($1::integer - 1)::text AS year,
             0::integer AS total_success,
-            0::integer AS total_amount
+            0::integer AS total_amount,
+            0::integer AS total_tax
         WHERE
             NOT EXISTS (
                 SELECT 1
@@ -2263,7 +2408,7 @@ WITH
                     year = $1::integer - 1
             )
     )
-SELECT year, total_success, total_amount
+SELECT *
 FROM formatted_data
 ORDER BY year DESC
 `
@@ -2277,6 +2422,7 @@ type GetYearlyAmountTransactionSuccessByMerchantRow struct {
 	Year         string `json:"year"`
 	TotalSuccess int32  `json:"total_success"`
 	TotalAmount  int32  `json:"total_amount"`
+	TotalTax     int32  `json:"total_tax"`
 }
 
 // GetYearlyAmountTransactionSuccessByMerchant: Retrieves yearly success transaction metrics
@@ -2290,11 +2436,12 @@ type GetYearlyAmountTransactionSuccessByMerchantRow struct {
 //
 //	year: Year as text
 //	total_success: Count of successful transactions
-//	total_amount: Sum of successful transaction amounts
+//	total_amount: Sum of successful transaction amounts, net of refunds and voids
+//	total_tax: Sum of tax collected on successful transactions, net of refunds and voids
 //
 // Business Logic:
 //   - Compares current year with previous year automatically
-//   - Only includes successful (payment_status = 'success') transactions
+//   - Reads transaction_revenue: completed sales plus refunds and voids as negative amounts
 //   - Excludes deleted transactions
 //   - Includes gap-filling for years with no transactions
 //   - Returns 0 values for years with no successful transactions
@@ -2308,7 +2455,12 @@ func (q *Queries) GetYearlyAmountTransactionSuccessByMerchant(ctx context.Contex
 	var items []*GetYearlyAmountTransactionSuccessByMerchantRow
 	for rows.Next() {
 		var i GetYearlyAmountTransactionSuccessByMerchantRow
-		if err := rows.Scan(&i.Year, &i.TotalSuccess, &i.TotalAmount); err != nil {
+		if err := rows.Scan(
+			&i.Year,
+			&i.TotalSuccess,
+			&i.TotalAmount,
+			&i.TotalTax,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2338,12 +2490,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            SUM(t.amount)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            SUM(tp.amount - tp.change_amount)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND t.merchant_id = $2
             AND EXTRACT(
@@ -2360,14 +2514,17 @@ WITH
             )
         GROUP BY
             year,
-            t.payment_method
+            tp.payment_method
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     )
 SELECT
     ys.year::text AS year,
@@ -2405,8 +2562,8 @@ type GetYearlyTransactionMethodsByMerchantFailedRow struct {
 //	merchant_id: The merchant identifier
 //	merchant_name: The merchant's name
 //	payment_method: The payment method used
-//	total_transactions: Count of failed transactions
-//	total_amount: Total amount that failed processing
+//	total_transactions: Count of failed tender lines
+//	total_amount: Total amount that failed processing, net of cash change
 func (q *Queries) GetYearlyTransactionMethodsByMerchantFailed(ctx context.Context, arg GetYearlyTransactionMethodsByMerchantFailedParams) ([]*GetYearlyTransactionMethodsByMerchantFailedRow, error) {
 	rows, err := q.db.Query(ctx, getYearlyTransactionMethodsByMerchantFailed, arg.Column1, arg.MerchantID)
 	if err != nil {
@@ -2451,12 +2608,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::integer AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            SUM(t.amount)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            SUM(tp.amount - tp.change_amount)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
             AND t.merchant_id = $2
             AND EXTRACT(
@@ -2473,14 +2632,17 @@ WITH
             )
         GROUP BY
             year,
-            t.payment_method
+            tp.payment_method
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     )
 SELECT
     ys.year::text AS year,
@@ -2518,8 +2680,8 @@ type GetYearlyTransactionMethodsByMerchantSuccessRow struct {
 //	merchant_id: The merchant identifier
 //	merchant_name: The merchant's name
 //	payment_method: The payment method used
-//	total_transactions: Count of successful transactions
-//	total_amount: Total amount processed by this method
+//	total_transactions: Count of successful tender lines
+//	total_amount: Total amount processed by this method, net of cash change
 func (q *Queries) GetYearlyTransactionMethodsByMerchantSuccess(ctx context.Context, arg GetYearlyTransactionMethodsByMerchantSuccessParams) ([]*GetYearlyTransactionMethodsByMerchantSuccessRow, error) {
 	rows, err := q.db.Query(ctx, getYearlyTransactionMethodsByMerchantSuccess, arg.Column1, arg.MerchantID)
 	if err != nil {
@@ -2558,10 +2720,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_years AS (
         SELECT generate_series(
@@ -2585,12 +2750,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::text AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'failed'
             AND EXTRACT(
                 YEAR
@@ -2607,7 +2774,7 @@ WITH
                 YEAR
                 FROM t.created_at
             ),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     ac.year,
@@ -2637,8 +2804,8 @@ type GetYearlyTransactionMethodsFailedRow struct {
 //
 //	year: 4-digit year as text
 //	payment_method: The payment method used
-//	total_transactions: Count of failed transactions
-//	total_amount: Total amount that failed processing
+//	total_transactions: Count of failed tender lines
+//	total_amount: Total amount that failed processing, net of cash change
 func (q *Queries) GetYearlyTransactionMethodsFailed(ctx context.Context, dollar_1 time.Time) ([]*GetYearlyTransactionMethodsFailedRow, error) {
 	rows, err := q.db.Query(ctx, getYearlyTransactionMethodsFailed, dollar_1)
 	if err != nil {
@@ -2677,10 +2844,13 @@ WITH
     ),
     payment_methods AS (
         SELECT DISTINCT
-            payment_method
-        FROM transactions
+            tp.payment_method
+        FROM
+            transaction_payments tp
+            JOIN transactions t ON t.transaction_id = tp.transaction_id
         WHERE
-            deleted_at IS NULL
+            t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
     ),
     all_years AS (
         SELECT generate_series(
@@ -2704,12 +2874,14 @@ WITH
                 YEAR
                 FROM t.created_at
             )::text AS year,
-            t.payment_method,
-            COUNT(t.transaction_id) AS total_transactions,
-            COALESCE(SUM(t.amount), 0)::NUMERIC AS total_amount
+            tp.payment_method,
+            COUNT(tp.transaction_payment_id) AS total_transactions,
+            COALESCE(SUM(tp.amount - tp.change_amount), 0)::NUMERIC AS total_amount
         FROM transactions t
+            JOIN transaction_payments tp ON tp.transaction_id = t.transaction_id
         WHERE
             t.deleted_at IS NULL
+            AND in_merchant_scope(t.merchant_id)
             AND t.payment_status = 'success'
             AND EXTRACT(
                 YEAR
@@ -2726,7 +2898,7 @@ WITH
                 YEAR
                 FROM t.created_at
             ),
-            t.payment_method
+            tp.payment_method
     )
 SELECT
     ac.year,
@@ -2756,8 +2928,8 @@ type GetYearlyTransactionMethodsSuccessRow struct {
 //
 //	year: 4-digit year as text
 //	payment_method: The payment method used
-//	total_transactions: Count of successful transactions
-//	total_amount: Total amount processed by this method
+//	total_transactions: Count of successful tender lines
+//	total_amount: Total amount processed by this method, net of cash change
 func (q *Queries) GetYearlyTransactionMethodsSuccess(ctx context.Context, dollar_1 time.Time) ([]*GetYearlyTransactionMethodsSuccessRow, error) {
 	rows, err := q.db.Query(ctx, getYearlyTransactionMethodsSuccess, dollar_1)
 	if err != nil {
@@ -2789,6 +2961,7 @@ SET
     deleted_at = NULL
 WHERE
     deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 `
 
 // RestoreAllTransactions: Mass restoration of cancelled transactions
@@ -2809,6 +2982,7 @@ SET
 WHERE
     transaction_id = $1
     AND deleted_at IS NOT NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     transaction_id,
     order_id,
@@ -2819,7 +2993,9 @@ RETURNING
     payment_status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    subtotal_amount,
+    tax_amount
 `
 
 // RestoreTransaction: Recovers a soft-deleted transaction
@@ -2847,6 +3023,8 @@ func (q *Queries) RestoreTransaction(ctx context.Context, transactionID int32) (
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SubtotalAmount,
+		&i.TaxAmount,
 	)
 	return &i, err
 }
@@ -2858,6 +3036,7 @@ SET
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     transaction_id,
     order_id,
@@ -2868,7 +3047,9 @@ RETURNING
     payment_status,
     created_at,
     updated_at,
-    deleted_at
+    deleted_at,
+    subtotal_amount,
+    tax_amount
 `
 
 // TrashTransaction: Soft-deletes a transaction
@@ -2897,6 +3078,8 @@ func (q *Queries) TrashTransaction(ctx context.Context, transactionID int32) (*T
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.SubtotalAmount,
+		&i.TaxAmount,
 	)
 	return &i, err
 }
@@ -2910,10 +3093,13 @@ SET
     change_amount = $5,
     payment_status = $6,
     order_id = $7,
+    subtotal_amount = $8,
+    tax_amount = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
 RETURNING
     transaction_id,
     order_id,
@@ -2922,30 +3108,36 @@ RETURNING
     amount,
     change_amount,
     payment_status,
+    subtotal_amount,
+    tax_amount,
     created_at,
     updated_at
 `
 
 type UpdateTransactionParams struct {
-	TransactionID int32  `json:"transaction_id"`
-	MerchantID    int32  `json:"merchant_id"`
-	PaymentMethod string `json:"payment_method"`
-	Amount        int32  `json:"amount"`
-	ChangeAmount  *int32 `json:"change_amount"`
-	PaymentStatus string `json:"payment_status"`
-	OrderID       int32  `json:"order_id"`
+	TransactionID  int32  `json:"transaction_id"`
+	MerchantID     int32  `json:"merchant_id"`
+	PaymentMethod  string `json:"payment_method"`
+	Amount         int32  `json:"amount"`
+	ChangeAmount   *int32 `json:"change_amount"`
+	PaymentStatus  string `json:"payment_status"`
+	OrderID        int32  `json:"order_id"`
+	SubtotalAmount int32  `json:"subtotal_amount"`
+	TaxAmount      int32  `json:"tax_amount"`
 }
 
 type UpdateTransactionRow struct {
-	TransactionID int32            `json:"transaction_id"`
-	OrderID       int32            `json:"order_id"`
-	MerchantID    int32            `json:"merchant_id"`
-	PaymentMethod string           `json:"payment_method"`
-	Amount        int32            `json:"amount"`
-	ChangeAmount  *int32           `json:"change_amount"`
-	PaymentStatus string           `json:"payment_status"`
-	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	TransactionID  int32            `json:"transaction_id"`
+	OrderID        int32            `json:"order_id"`
+	MerchantID     int32            `json:"merchant_id"`
+	PaymentMethod  string           `json:"payment_method"`
+	Amount         int32            `json:"amount"`
+	ChangeAmount   *int32           `json:"change_amount"`
+	PaymentStatus  string           `json:"payment_status"`
+	SubtotalAmount int32            `json:"subtotal_amount"`
+	TaxAmount      int32            `json:"tax_amount"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
 }
 
 // UpdateTransaction: Modifies transaction details
@@ -2959,6 +3151,8 @@ type UpdateTransactionRow struct {
 //	$5: change_amount - Updated change amount
 //	$6: payment_status - Updated payment status
 //	$7: order_id - Updated order reference
+//	$8: subtotal_amount - Updated order total excluding tax
+//	$9: tax_amount - Updated tax amount
 //
 // Returns: Updated transaction record
 // Business Logic:
@@ -2975,6 +3169,8 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		arg.ChangeAmount,
 		arg.PaymentStatus,
 		arg.OrderID,
+		arg.SubtotalAmount,
+		arg.TaxAmount,
 	)
 	var i UpdateTransactionRow
 	err := row.Scan(
@@ -2985,8 +3181,70 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		&i.Amount,
 		&i.ChangeAmount,
 		&i.PaymentStatus,
+		&i.SubtotalAmount,
+		&i.TaxAmount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return &i, err
 }
+
+const updateTransactionStatus = `-- name: UpdateTransactionStatus :one
+UPDATE transactions
+SET
+    payment_status = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND in_merchant_scope(merchant_id)
+RETURNING
+    transaction_id,
+    order_id,
+    merchant_id,
+    payment_method,
+    amount,
+    change_amount,
+    payment_status,
+    created_at,
+    updated_at,
+    deleted_at,
+    subtotal_amount,
+    tax_amount
+`
+
+type UpdateTransactionStatusParams struct {
+	TransactionID int32  `json:"transaction_id"`
+	PaymentStatus string `json:"payment_status"`
+}
+
+// UpdateTransactionStatus: Changes the payment status of a transaction
+// Purpose: Record that a transaction was refunded or voided
+// Parameters:
+//
+//	$1: transaction_id - ID of transaction to update
+//	$2: payment_status - New payment status
+//
+// Returns: The updated transaction record
+// Business Logic:
+//   - Only processes active transactions
+//   - Leaves amounts untouched; reversals are recorded in transaction_refunds
+func (q *Queries) UpdateTransactionStatus(ctx context.Context, arg UpdateTransactionStatusParams) (*Transaction, error) {
+	row := q.db.QueryRow(ctx, updateTransactionStatus, arg.TransactionID, arg.PaymentStatus)
+	var i Transaction
+	err := row.Scan(
+		&i.TransactionID,
+		&i.OrderID,
+		&i.MerchantID,
+		&i.PaymentMethod,
+		&i.Amount,
+		&i.ChangeAmount,
+		&i.PaymentStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.SubtotalAmount,
+		&i.TaxAmount,
+	)
+	return &i, err
+}
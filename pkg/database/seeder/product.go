@@ -5,6 +5,7 @@ import (
 	"fmt"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
+	"pointofsale/pkg/utils"
 
 	"go.uber.org/zap"
 	"golang.org/x/exp/rand"
@@ -72,10 +73,15 @@ func (r *productSeeder) Seed() error {
 		weight := int32(rand.Intn(5000) + 100)
 		slug := fmt.Sprintf("%s-%d", name, rand.Intn(1000))
 		image := images[rand.Intn(len(images))]
-		barcode := fmt.Sprintf("BC-%d", rand.Intn(9_999_999))
 		desc := fmt.Sprintf("Description for %s", name)
 
-		_, err := r.db.CreateProduct(r.ctx, db.CreateProductParams{
+		barcode, err := utils.GenerateBarcode(utils.BarcodeFormatEAN13, "")
+		if err != nil {
+			r.logger.Error("Failed to generate barcode:", zap.Any("error", err))
+			return err
+		}
+
+		product, err := r.db.CreateProduct(r.ctx, db.CreateProductParams{
 			MerchantID:   merchant.MerchantID,
 			CategoryID:   category.CategoryID,
 			Name:         name,
@@ -94,6 +100,14 @@ func (r *productSeeder) Seed() error {
 			return err
 		}
 
+		_, err = r.db.CreateOpeningStockMovement(r.ctx, db.CreateOpeningStockMovementParams{
+			ProductID: product.ProductID,
+		})
+
+		if err != nil {
+			r.logger.Error("Failed to record opening stock:", zap.Any("error", err))
+			return err
+		}
 	}
 
 	r.logger.Info("Product seeding completed successfully.")
@@ -0,0 +1,91 @@
+package seeder
+
+import (
+	"context"
+	"fmt"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/logger"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/rand"
+)
+
+type purchaseOrderSeeder struct {
+	db     *db.Queries
+	ctx    context.Context
+	logger logger.LoggerInterface
+}
+
+func NewPurchaseOrderSeeder(db *db.Queries, ctx context.Context, logger logger.LoggerInterface) *purchaseOrderSeeder {
+	return &purchaseOrderSeeder{
+		db:     db,
+		ctx:    ctx,
+		logger: logger,
+	}
+}
+
+func (r *purchaseOrderSeeder) Seed() error {
+	suppliers, err := r.db.GetSuppliers(r.ctx, db.GetSuppliersParams{
+		Limit:  20,
+		Offset: 0,
+	})
+	if err != nil {
+		r.logger.Error("Failed to get suppliers:", zap.Any("error", err))
+		return err
+	}
+
+	products, err := r.db.GetProducts(r.ctx, db.GetProductsParams{
+		Column1: "",
+		Limit:   100,
+		Offset:  0,
+	})
+	if err != nil {
+		r.logger.Error("Failed to get products:", zap.Any("error", err))
+		return err
+	}
+
+	if len(suppliers) == 0 || len(products) == 0 {
+		r.logger.Error("No suppliers or products found, skipping seeding")
+		return nil
+	}
+
+	productsByMerchant := make(map[int32][]*db.GetProductsRow)
+	for _, product := range products {
+		productsByMerchant[product.MerchantID] = append(productsByMerchant[product.MerchantID], product)
+	}
+
+	for _, supplier := range suppliers {
+		merchantProducts := productsByMerchant[supplier.MerchantID]
+		if len(merchantProducts) == 0 {
+			continue
+		}
+
+		note := fmt.Sprintf("Restock from %s", supplier.Name)
+
+		purchaseOrder, err := r.db.CreatePurchaseOrder(r.ctx, db.CreatePurchaseOrderParams{
+			MerchantID: supplier.MerchantID,
+			SupplierID: supplier.SupplierID,
+			Note:       &note,
+		})
+		if err != nil {
+			r.logger.Error("Failed to create purchase order:", zap.Any("error", err))
+			return err
+		}
+
+		for _, product := range merchantProducts {
+			_, err := r.db.CreatePurchaseOrderItem(r.ctx, db.CreatePurchaseOrderItemParams{
+				PurchaseOrderID: purchaseOrder.PurchaseOrderID,
+				ProductID:       product.ProductID,
+				QuantityOrdered: int32(rand.Intn(50) + 1),
+				UnitCost:        product.Price * 7 / 10,
+			})
+			if err != nil {
+				r.logger.Error("Failed to create purchase order item:", zap.Any("error", err))
+				return err
+			}
+		}
+	}
+
+	r.logger.Info("Purchase order seeding completed successfully.")
+	return nil
+}
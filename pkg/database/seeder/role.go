@@ -2,10 +2,13 @@ package seeder
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"pointofsale/pkg/auth"
 	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
@@ -24,12 +27,22 @@ func NewRoleSeeder(db *db.Queries, ctx context.Context, logger logger.LoggerInte
 }
 
 func (r *roleSeeder) Seed() error {
-	randomRoles := []string{"Cashier", "Manager", "Admin", "Supplier"}
+	randomRoles := []string{auth.RoleCashier, auth.RoleManager, auth.RoleAdmin, auth.RoleSupplier, auth.RoleUser}
 
 	totalRoles := len(randomRoles)
 
 	for i, roleName := range randomRoles {
-		_, err := r.db.CreateRole(r.ctx, roleName)
+		// The roles migration already inserts these, so only fill in the ones
+		// that are missing.
+		_, err := r.db.GetRoleByName(r.ctx, roleName)
+		if err == nil {
+			continue
+		}
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			_, err = r.db.CreateRole(r.ctx, roleName)
+		}
+
 		if err != nil {
 			r.logger.Error("failed to seed role", zap.Int("role", i+1), zap.String("roleName", roleName), zap.Error(err))
 			return fmt.Errorf("failed to seed role %d (%s): %w", i+1, roleName, err)
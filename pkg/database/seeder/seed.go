@@ -17,28 +17,32 @@ type Deps struct {
 }
 
 type Seeder struct {
-	User        *userSeeder
-	Role        *roleSeeder
-	UserRole    *userRoleSeeder
-	Cashier     *cashierSeeder
-	Category    *categorySeeder
-	Product     *productSeeder
-	Merchant    *merchantSeeder
-	Order       *orderSeeder
-	Transaction *transactionSeeder
+	User          *userSeeder
+	Role          *roleSeeder
+	UserRole      *userRoleSeeder
+	Cashier       *cashierSeeder
+	Category      *categorySeeder
+	Product       *productSeeder
+	Supplier      *supplierSeeder
+	PurchaseOrder *purchaseOrderSeeder
+	Merchant      *merchantSeeder
+	Order         *orderSeeder
+	Transaction   *transactionSeeder
 }
 
 func NewSeeder(deps Deps) *Seeder {
 	return &Seeder{
-		User:        NewUserSeeder(deps.Db, deps.Hash, deps.Ctx, deps.Logger),
-		Role:        NewRoleSeeder(deps.Db, deps.Ctx, deps.Logger),
-		UserRole:    NewUserRoleSeeder(deps.Db, deps.Ctx, deps.Logger),
-		Merchant:    NewMerchantSeeder(deps.Db, deps.Ctx, deps.Logger),
-		Cashier:     NewCashierSeeder(deps.Db, deps.Ctx, deps.Logger),
-		Category:    NewCategorySeeder(deps.Db, deps.Ctx, deps.Logger),
-		Product:     NewProductSeeder(deps.Db, deps.Ctx, deps.Logger),
-		Order:       NewOrderSeeder(deps.Db, deps.Ctx, deps.Logger),
-		Transaction: NewTransactionSeeder(deps.Db, deps.Ctx, deps.Logger),
+		User:          NewUserSeeder(deps.Db, deps.Hash, deps.Ctx, deps.Logger),
+		Role:          NewRoleSeeder(deps.Db, deps.Ctx, deps.Logger),
+		UserRole:      NewUserRoleSeeder(deps.Db, deps.Ctx, deps.Logger),
+		Merchant:      NewMerchantSeeder(deps.Db, deps.Ctx, deps.Logger),
+		Cashier:       NewCashierSeeder(deps.Db, deps.Ctx, deps.Logger),
+		Category:      NewCategorySeeder(deps.Db, deps.Ctx, deps.Logger),
+		Product:       NewProductSeeder(deps.Db, deps.Ctx, deps.Logger),
+		Supplier:      NewSupplierSeeder(deps.Db, deps.Ctx, deps.Logger),
+		PurchaseOrder: NewPurchaseOrderSeeder(deps.Db, deps.Ctx, deps.Logger),
+		Order:         NewOrderSeeder(deps.Db, deps.Ctx, deps.Logger),
+		Transaction:   NewTransactionSeeder(deps.Db, deps.Ctx, deps.Logger),
 	}
 }
 
@@ -71,6 +75,14 @@ func (s *Seeder) Run() error {
 		return nil
 	}
 
+	if err := s.seedWithDelay("supplier", s.Supplier.Seed); err != nil {
+		return nil
+	}
+
+	if err := s.seedWithDelay("purchase_order", s.PurchaseOrder.Seed); err != nil {
+		return nil
+	}
+
 	if err := s.seedWithDelay("order", s.Order.Seed); err != nil {
 		return nil
 	}
@@ -0,0 +1,73 @@
+package seeder
+
+import (
+	"context"
+	"fmt"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/logger"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/rand"
+)
+
+type supplierSeeder struct {
+	db     *db.Queries
+	ctx    context.Context
+	logger logger.LoggerInterface
+}
+
+func NewSupplierSeeder(db *db.Queries, ctx context.Context, logger logger.LoggerInterface) *supplierSeeder {
+	return &supplierSeeder{
+		db:     db,
+		ctx:    ctx,
+		logger: logger,
+	}
+}
+
+func (r *supplierSeeder) Seed() error {
+	merchants, err := r.db.GetMerchants(r.ctx, db.GetMerchantsParams{
+		Column1: "",
+		Limit:   20,
+		Offset:  0,
+	})
+	if err != nil {
+		r.logger.Error("Failed to get merchants:", zap.Any("error", err))
+		return err
+	}
+
+	if len(merchants) == 0 {
+		r.logger.Error("No merchants found, skipping seeding")
+		return nil
+	}
+
+	supplierNames := []string{
+		"Global Electronics", "Prime Distribution", "Nusantara Supply", "Tech Wholesale", "Metro Trading",
+	}
+	contacts := []string{"Andi", "Budi", "Citra", "Dewi", "Eko"}
+
+	for _, merchant := range merchants {
+		for i := 0; i < 2; i++ {
+			name := supplierNames[rand.Intn(len(supplierNames))]
+			contact := contacts[rand.Intn(len(contacts))]
+			email := fmt.Sprintf("supplier%d.%d@example.com", merchant.MerchantID, i+1)
+			phone := fmt.Sprintf("08%09d", rand.Intn(1_000_000_000))
+			address := fmt.Sprintf("Warehouse %d, Industrial Estate", rand.Intn(100)+1)
+
+			_, err := r.db.CreateSupplier(r.ctx, db.CreateSupplierParams{
+				MerchantID:  merchant.MerchantID,
+				Name:        name,
+				ContactName: &contact,
+				Email:       &email,
+				Phone:       &phone,
+				Address:     &address,
+			})
+			if err != nil {
+				r.logger.Error("Failed to create supplier:", zap.Any("error", err))
+				return err
+			}
+		}
+	}
+
+	r.logger.Info("Supplier seeding completed successfully.")
+	return nil
+}
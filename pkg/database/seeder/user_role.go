@@ -38,7 +38,7 @@ func (r *userRoleSeeder) Seed() error {
 
 	roles, err := r.db.GetRoles(r.ctx, db.GetRolesParams{
 		Column1: "",
-		Limit:   4,
+		Limit:   5,
 		Offset:  0,
 	})
 	if err != nil {
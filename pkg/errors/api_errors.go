@@ -134,6 +134,10 @@ func NewConflictError(message string) *AppError {
 	return ErrConflict.WithMessage(message)
 }
 
+func NewUnprocessableError(message string) *AppError {
+	return ErrUnprocessable.WithMessage(message)
+}
+
 func NewInternalError(err error) *AppError {
 	return ErrInternal.WithInternal(err)
 }
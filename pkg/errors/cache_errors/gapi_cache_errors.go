@@ -0,0 +1,10 @@
+package cache_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrGrpcCacheInvalidNamespace = errors.NewGrpcError("Invalid cache namespace", http.StatusBadRequest)
+)
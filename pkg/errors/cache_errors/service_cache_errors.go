@@ -0,0 +1,15 @@
+package cache_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrCacheNamespaceNotFoundRes = errors.NewErrorResponse("Cache namespace not found", http.StatusNotFound)
+	ErrCacheNamespaceDurable     = errors.NewErrorResponse("Cache namespace holds state that cannot be flushed", http.StatusUnprocessableEntity)
+
+	ErrFailedFindNamespaces = errors.NewErrorResponse("Failed to fetch cache namespaces", http.StatusInternalServerError)
+	ErrFailedFindNamespace  = errors.NewErrorResponse("Failed to fetch cache namespace", http.StatusInternalServerError)
+	ErrFailedFlushNamespace = errors.NewErrorResponse("Failed to flush cache namespace", http.StatusInternalServerError)
+)
@@ -19,6 +19,7 @@ var (
 
 	ErrFindAllCashiers        = errors.New("failed to find all cashiers")
 	ErrFindCashierById        = errors.New("failed to find cashier by ID")
+	ErrCashierNotFound        = errors.New("cashier not found")
 	ErrFindActiveCashiers     = errors.New("failed to find active cashiers")
 	ErrFindTrashedCashiers    = errors.New("failed to find trashed cashiers")
 	ErrFindCashiersByMerchant = errors.New("failed to find cashiers by merchant")
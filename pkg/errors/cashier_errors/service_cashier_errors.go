@@ -22,6 +22,7 @@ var (
 
 	ErrFailedFindAllCashiers       = errors.NewErrorResponse("Failed to find all cashiers", http.StatusInternalServerError)
 	ErrFailedFindCashierById       = errors.NewErrorResponse("Failed to find cashier by ID", http.StatusInternalServerError)
+	ErrFailedCashierNotFound       = errors.NewErrorResponse("Cashier not found", http.StatusNotFound)
 	ErrFailedFindCashierByActive   = errors.NewErrorResponse("Failed to find active cashiers", http.StatusInternalServerError)
 	ErrFailedFindCashierByTrashed  = errors.NewErrorResponse("Failed to find trashed cashiers", http.StatusInternalServerError)
 	ErrFailedFindCashierByMerchant = errors.NewErrorResponse("Failed to find cashiers by merchant", http.StatusInternalServerError)
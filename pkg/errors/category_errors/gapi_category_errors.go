@@ -11,6 +11,8 @@ var (
 	ErrGrpcFailedInvalidYear  = errors.NewGrpcError("Invalid year", int(codes.InvalidArgument))
 	ErrGrpcFailedInvalidMonth = errors.NewGrpcError("Invalid month", int(codes.InvalidArgument))
 
-	ErrGrpcValidateCreateCategory = errors.NewGrpcError("validation failed: invalid create category request", int(codes.InvalidArgument))
-	ErrGrpcValidateUpdateCategory = errors.NewGrpcError("validation failed: invalid update category request", int(codes.InvalidArgument))
+	ErrGrpcValidateCreateCategory    = errors.NewGrpcError("validation failed: invalid create category request", int(codes.InvalidArgument))
+	ErrGrpcValidateUpdateCategory    = errors.NewGrpcError("validation failed: invalid update category request", int(codes.InvalidArgument))
+	ErrGrpcValidateMoveCategory      = errors.NewGrpcError("validation failed: invalid move category request", int(codes.InvalidArgument))
+	ErrGrpcValidateReorderCategories = errors.NewGrpcError("validation failed: invalid reorder categories request", int(codes.InvalidArgument))
 )
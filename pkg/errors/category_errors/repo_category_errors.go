@@ -17,12 +17,14 @@ var (
 	ErrGetMonthPriceById       = errors.New("failed to get month price by category ID")
 	ErrGetYearPriceById        = errors.New("failed to get year price by category ID")
 
-	ErrFindAllCategory = errors.New("failed to find all categories")
-	ErrFindById        = errors.New("failed to find category by ID")
-	ErrFindByNameAndId = errors.New("failed to find category by name and ID")
-	ErrFindByName      = errors.New("failed to find category by name")
-	ErrFindByActive    = errors.New("failed to find active categories")
-	ErrFindByTrashed   = errors.New("failed to find trashed categories")
+	ErrFindAllCategory  = errors.New("failed to find all categories")
+	ErrFindById         = errors.New("failed to find category by ID")
+	ErrFindByNameAndId  = errors.New("failed to find category by name and ID")
+	ErrFindByName       = errors.New("failed to find category by name")
+	ErrFindByNameOrSlug = errors.New("failed to find category by name or slug")
+	ErrCategoryNotFound = errors.New("no category has the name or slug")
+	ErrFindByActive     = errors.New("failed to find active categories")
+	ErrFindByTrashed    = errors.New("failed to find trashed categories")
 
 	ErrCreateCategory               = errors.New("failed to create category")
 	ErrUpdateCategory               = errors.New("failed to update category")
@@ -31,4 +33,13 @@ var (
 	ErrDeleteCategoryPermanently    = errors.New("failed to permanently delete category")
 	ErrRestoreAllCategories         = errors.New("failed to restore all categories")
 	ErrDeleteAllPermanentCategories = errors.New("failed to permanently delete all trashed categories")
+
+	ErrFindTree           = errors.New("failed to find category tree")
+	ErrFindSiblings       = errors.New("failed to find sibling categories")
+	ErrFindSubtree        = errors.New("failed to find category subtree")
+	ErrCountChildren      = errors.New("failed to count child categories")
+	ErrCountSlugConflicts = errors.New("failed to count categories sharing a slug")
+	ErrMoveCategory       = errors.New("failed to move category")
+	ErrLockTree           = errors.New("failed to lock category tree")
+	ErrReorderCategories  = errors.New("failed to reorder categories")
 )
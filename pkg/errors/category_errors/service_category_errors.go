@@ -34,4 +34,22 @@ var (
 	ErrFailedDeleteCategoryPermanent      = errors.NewErrorResponse("Failed to permanently delete category", http.StatusInternalServerError)
 	ErrFailedRestoreAllCategories         = errors.NewErrorResponse("Failed to restore all categories", http.StatusInternalServerError)
 	ErrFailedDeleteAllCategoriesPermanent = errors.NewErrorResponse("Failed to permanently delete all categories", http.StatusInternalServerError)
+
+	ErrFailedFindCategoryTree   = errors.NewErrorResponse("Failed to find category tree", http.StatusInternalServerError)
+	ErrFailedMoveCategory       = errors.NewErrorResponse("Failed to move category", http.StatusInternalServerError)
+	ErrFailedReorderCategories  = errors.NewErrorResponse("Failed to reorder categories", http.StatusInternalServerError)
+	ErrFailedCheckCategorySlug  = errors.NewErrorResponse("Failed to check category slug", http.StatusInternalServerError)
+	ErrFailedCountSubcategories = errors.NewErrorResponse("Failed to count subcategories", http.StatusInternalServerError)
+
+	ErrCategoryNotFoundRes = errors.NewErrorResponse("Category not found", http.StatusNotFound)
+	ErrFailedSlugConflict  = errors.NewErrorResponse("A category with this slug already exists", http.StatusConflict)
+
+	ErrFailedParentNotFound       = errors.NewErrorResponse("Parent category does not exist or is trashed", http.StatusUnprocessableEntity)
+	ErrFailedParentScope          = errors.NewErrorResponse("Parent category must be global or belong to the same merchant", http.StatusUnprocessableEntity)
+	ErrFailedCategoryCycle        = errors.NewErrorResponse("A category cannot be moved below itself or its subcategories", http.StatusUnprocessableEntity)
+	ErrFailedCategoryHasChildren  = errors.NewErrorResponse("Category still has active subcategories", http.StatusUnprocessableEntity)
+	ErrFailedInvalidReorder       = errors.NewErrorResponse("Category IDs must list every sibling exactly once", http.StatusUnprocessableEntity)
+	ErrFailedCategoryNotAvailable = errors.NewErrorResponse("Category belongs to another merchant", http.StatusUnprocessableEntity)
+
+	ErrFailedGlobalCategoryForbidden = errors.NewErrorResponse("Only administrators can create global categories", http.StatusForbidden)
 )
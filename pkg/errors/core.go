@@ -100,6 +100,11 @@ var (
 		Message: "Resource conflict",
 	}
 
+	ErrUnprocessable = &AppError{
+		Code:    http.StatusUnprocessableEntity,
+		Message: "Unprocessable request",
+	}
+
 	ErrTooManyRequests = &AppError{
 		Code:      http.StatusTooManyRequests,
 		Message:   "Too many requests",
@@ -49,6 +49,8 @@ func httpToGrpcCode(code int) codes.Code {
 		return codes.NotFound
 	case http.StatusConflict:
 		return codes.AlreadyExists
+	case http.StatusUnprocessableEntity:
+		return codes.FailedPrecondition
 	default:
 		return codes.Internal
 	}
@@ -0,0 +1,13 @@
+package idempotency_errors
+
+import "errors"
+
+var (
+	ErrIdempotencyKeyNotFound       = errors.New("idempotency key not found")
+	ErrIdempotencyKeyHeld           = errors.New("idempotency key is held by another request")
+	ErrReserveIdempotencyKey        = errors.New("failed to reserve idempotency key")
+	ErrFindIdempotencyKey           = errors.New("failed to find idempotency key")
+	ErrCompleteIdempotencyKey       = errors.New("failed to complete idempotency key")
+	ErrReleaseIdempotencyKey        = errors.New("failed to release idempotency key")
+	ErrDeleteExpiredIdempotencyKeys = errors.New("failed to delete expired idempotency keys")
+)
@@ -0,0 +1,17 @@
+package idempotency_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrInvalidIdempotencyKey    = errors.NewErrorResponse("Invalid Idempotency-Key", http.StatusBadRequest)
+	ErrIdempotencyKeyReused     = errors.NewErrorResponse("Idempotency-Key was already used with a different request", http.StatusConflict)
+	ErrIdempotencyKeyInProgress = errors.NewErrorResponse("A request with this Idempotency-Key is still being processed", http.StatusConflict)
+
+	ErrFailedReserveIdempotencyKey  = errors.NewErrorResponse("Failed to reserve idempotency key", http.StatusInternalServerError)
+	ErrFailedCompleteIdempotencyKey = errors.NewErrorResponse("Failed to store idempotent response", http.StatusInternalServerError)
+	ErrFailedReleaseIdempotencyKey  = errors.NewErrorResponse("Failed to release idempotency key", http.StatusInternalServerError)
+	ErrFailedPurgeIdempotencyKeys   = errors.NewErrorResponse("Failed to purge expired idempotency keys", http.StatusInternalServerError)
+)
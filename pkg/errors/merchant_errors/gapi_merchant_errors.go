@@ -9,6 +9,7 @@ import (
 var (
 	ErrGrpcInvalidID = errors.NewGrpcError("invalid ID", int(codes.InvalidArgument))
 
-	ErrGrpcValidateCreateMerchant = errors.NewGrpcError("validation failed: invalid create merchant request", int(codes.InvalidArgument))
-	ErrGrpcValidateUpdateMerchant = errors.NewGrpcError("validation failed: invalid update merchant request", int(codes.InvalidArgument))
+	ErrGrpcValidateCreateMerchant  = errors.NewGrpcError("validation failed: invalid create merchant request", int(codes.InvalidArgument))
+	ErrGrpcValidateUpdateMerchant  = errors.NewGrpcError("validation failed: invalid update merchant request", int(codes.InvalidArgument))
+	ErrGrpcValidateBarcodeSettings = errors.NewGrpcError("validation failed: invalid barcode settings request", int(codes.InvalidArgument))
 )
@@ -7,12 +7,15 @@ var (
 	ErrFindByActive     = errors.New("failed to find active merchants")
 	ErrFindByTrashed    = errors.New("failed to find trashed merchants")
 	ErrFindById         = errors.New("failed to find merchant by ID")
+	ErrMerchantNotFound = errors.New("merchant not found")
+	ErrFindScopeByUser  = errors.New("failed to find merchants of user")
 
-	ErrCreateMerchant             = errors.New("failed to create merchant")
-	ErrUpdateMerchant             = errors.New("failed to update merchant")
-	ErrTrashedMerchant            = errors.New("failed to move merchant to trash")
-	ErrRestoreMerchant            = errors.New("failed to restore merchant from trash")
-	ErrDeleteMerchantPermanent    = errors.New("failed to permanently delete merchant")
-	ErrRestoreAllMerchant         = errors.New("failed to restore all trashed merchants")
-	ErrDeleteAllMerchantPermanent = errors.New("failed to permanently delete all trashed merchants")
+	ErrCreateMerchant                = errors.New("failed to create merchant")
+	ErrUpdateMerchant                = errors.New("failed to update merchant")
+	ErrUpdateMerchantBarcodeSettings = errors.New("failed to update merchant barcode settings")
+	ErrTrashedMerchant               = errors.New("failed to move merchant to trash")
+	ErrRestoreMerchant               = errors.New("failed to restore merchant from trash")
+	ErrDeleteMerchantPermanent       = errors.New("failed to permanently delete merchant")
+	ErrRestoreAllMerchant            = errors.New("failed to restore all trashed merchants")
+	ErrDeleteAllMerchantPermanent    = errors.New("failed to permanently delete all trashed merchants")
 )
@@ -10,8 +10,12 @@ var (
 	ErrFailedFindMerchantsByActive       = errors.NewErrorResponse("Failed to find active merchants", http.StatusInternalServerError)
 	ErrFailedFindMerchantsByTrashed      = errors.NewErrorResponse("Failed to find trashed merchants", http.StatusInternalServerError)
 	ErrFailedFindMerchantById            = errors.NewErrorResponse("Failed to find merchant by ID", http.StatusInternalServerError)
+	ErrFailedMerchantNotFound            = errors.NewErrorResponse("Merchant not found", http.StatusNotFound)
+	ErrFailedFindScopeByUser             = errors.NewErrorResponse("Failed to find merchants of user", http.StatusInternalServerError)
 	ErrFailedCreateMerchant              = errors.NewErrorResponse("Failed to create merchant", http.StatusInternalServerError)
 	ErrFailedUpdateMerchant              = errors.NewErrorResponse("Failed to update merchant", http.StatusInternalServerError)
+	ErrFailedUpdateBarcodeSettings       = errors.NewErrorResponse("Failed to update merchant barcode settings", http.StatusInternalServerError)
+	ErrFailedInvalidBarcodePrefix        = errors.NewErrorResponse("GS1 prefix cannot be used with the barcode format", http.StatusBadRequest)
 	ErrFailedTrashMerchant               = errors.NewErrorResponse("Failed to trash merchant", http.StatusInternalServerError)
 	ErrFailedRestoreMerchant             = errors.NewErrorResponse("Failed to restore merchant", http.StatusInternalServerError)
 	ErrFailedDeleteMerchantPermanent     = errors.NewErrorResponse("Failed to permanently delete merchant", http.StatusInternalServerError)
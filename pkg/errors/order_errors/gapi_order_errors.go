@@ -1,6 +1,7 @@
 package order_errors
 
 import (
+	"net/http"
 	"pointofsale/pkg/errors"
 
 	"google.golang.org/grpc/codes"
@@ -14,4 +15,6 @@ var (
 
 	ErrGrpcValidateCreateOrder = errors.NewGrpcError("validation failed: invalid create order request", int(codes.InvalidArgument))
 	ErrGrpcValidateUpdateOrder = errors.NewGrpcError("validation failed: invalid update order request", int(codes.InvalidArgument))
+
+	ErrGrpcValidateUpdateOrderStatus = errors.NewGrpcError("validation failed: invalid order status", http.StatusBadRequest)
 )
@@ -20,8 +20,11 @@ var (
 	ErrFindByTrashed           = errors.New("failed to find trashed orders")
 	ErrFindByMerchant          = errors.New("failed to find orders by merchant")
 	ErrFindById                = errors.New("failed to find order by ID")
+	ErrOrderNotFound           = errors.New("order not found")
 	ErrCreateOrder             = errors.New("failed to create order")
 	ErrUpdateOrder             = errors.New("failed to update order")
+	ErrUpdateOrderStatus       = errors.New("failed to update order status")
+	ErrOrderStatusChanged      = errors.New("order status changed concurrently")
 	ErrTrashedOrder            = errors.New("failed to move order to trash")
 	ErrRestoreOrder            = errors.New("failed to restore order from trash")
 	ErrDeleteOrderPermanent    = errors.New("failed to permanently delete order")
@@ -22,14 +22,21 @@ var (
 
 	ErrFailedFindAllOrders           = errors.NewErrorResponse("Failed to find all orders", http.StatusInternalServerError)
 	ErrFailedFindOrderById           = errors.NewErrorResponse("Failed to find order by ID", http.StatusInternalServerError)
+	ErrFailedOrderNotFound           = errors.NewErrorResponse("Order not found", http.StatusNotFound)
 	ErrFailedFindOrdersByActive      = errors.NewErrorResponse("Failed to find active orders", http.StatusInternalServerError)
 	ErrFailedFindOrdersByTrashed     = errors.NewErrorResponse("Failed to find trashed orders", http.StatusInternalServerError)
 	ErrFailedFindOrdersByMerchant    = errors.NewErrorResponse("Failed to find orders by merchant", http.StatusInternalServerError)
 	ErrFailedCreateOrder             = errors.NewErrorResponse("Failed to create order", http.StatusInternalServerError)
 	ErrFailedUpdateOrder             = errors.NewErrorResponse("Failed to update order", http.StatusInternalServerError)
+	ErrFailedUpdateOrderStatus       = errors.NewErrorResponse("Failed to update order status", http.StatusInternalServerError)
 	ErrFailedTrashOrder              = errors.NewErrorResponse("Failed to trash order", http.StatusInternalServerError)
 	ErrFailedRestoreOrder            = errors.NewErrorResponse("Failed to restore order", http.StatusInternalServerError)
 	ErrFailedDeleteOrderPermanent    = errors.NewErrorResponse("Failed to permanently delete order", http.StatusInternalServerError)
 	ErrFailedRestoreAllOrder         = errors.NewErrorResponse("Failed to restore all orders", http.StatusInternalServerError)
 	ErrFailedDeleteAllOrderPermanent = errors.NewErrorResponse("Failed to permanently delete all orders", http.StatusInternalServerError)
+
+	ErrFailedOrderNotEditable       = errors.NewErrorResponse("Order can no longer be modified", http.StatusUnprocessableEntity)
+	ErrFailedOrderNotPayable        = errors.NewErrorResponse("Order cannot be paid in its current status", http.StatusUnprocessableEntity)
+	ErrFailedInvalidOrderTransition = errors.NewErrorResponse("Order cannot move to the requested status", http.StatusUnprocessableEntity)
+	ErrFailedOrderStatusConflict    = errors.NewErrorResponse("Order status was changed by another request", http.StatusConflict)
 )
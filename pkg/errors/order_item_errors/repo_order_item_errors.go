@@ -10,6 +10,8 @@ var (
 	ErrCalculateTotalPrice      = errors.New("failed to calculate total price")
 	ErrCreateOrderItem          = errors.New("failed to create order item")
 	ErrUpdateOrderItem          = errors.New("failed to update order item")
+	ErrUpdateOrderItemTax       = errors.New("failed to update order item tax")
+	ErrFindOrderItemTaxLines    = errors.New("failed to find order item tax lines")
 	ErrTrashedOrderItem         = errors.New("failed to move order item to trash")
 	ErrRestoreOrderItem         = errors.New("failed to restore order item from trash")
 	ErrDeleteOrderItemPermanent = errors.New("failed to permanently delete order item")
@@ -7,8 +7,15 @@ import (
 )
 
 var (
-	ErrGrpcInvalidID = errors.NewGrpcError("invalid ID", int(codes.InvalidArgument))
+	ErrGrpcInvalidID      = errors.NewGrpcError("invalid ID", int(codes.InvalidArgument))
+	ErrGrpcInvalidBarcode = errors.NewGrpcError("invalid barcode", int(codes.InvalidArgument))
 
 	ErrGrpcValidateCreateProduct = errors.NewGrpcError("validation failed: invalid create product request", int(codes.InvalidArgument))
 	ErrGrpcValidateUpdateProduct = errors.NewGrpcError("validation failed: invalid update product request", int(codes.InvalidArgument))
+	ErrGrpcValidateReorderLevel  = errors.NewGrpcError("validation failed: invalid reorder level request", int(codes.InvalidArgument))
+	ErrGrpcValidateImport        = errors.NewGrpcError("validation failed: invalid import products request", int(codes.InvalidArgument))
+	ErrGrpcValidateExport        = errors.NewGrpcError("validation failed: invalid export products request", int(codes.InvalidArgument))
+
+	ErrGrpcImportMetadataFirst = errors.NewGrpcError("import stream must start with its metadata", int(codes.InvalidArgument))
+	ErrGrpcImportFileTooLarge  = errors.NewGrpcError("import file is too large", int(codes.InvalidArgument))
 )
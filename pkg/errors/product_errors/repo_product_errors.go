@@ -10,12 +10,26 @@ var (
 	ErrFindByCategory            = errors.New("failed to find products by category")
 	ErrFindById                  = errors.New("failed to find product by ID")
 	ErrFindByIdTrashed           = errors.New("failed to find trashed product by ID")
+	ErrProductNotFound           = errors.New("product not found")
+	ErrFindByBarcode             = errors.New("failed to find product by barcode")
+	ErrBarcodeNotFound           = errors.New("no product has the barcode")
+	ErrDuplicateBarcode          = errors.New("barcode already used by another product")
+	ErrFindForImport             = errors.New("failed to match imported product")
 	ErrCreateProduct             = errors.New("failed to create product")
 	ErrUpdateProduct             = errors.New("failed to update product")
-	ErrUpdateProductCountStock   = errors.New("failed to update product stock count")
+	ErrInsufficientStock         = errors.New("insufficient product stock")
 	ErrTrashedProduct            = errors.New("failed to move product to trash")
 	ErrRestoreProduct            = errors.New("failed to restore product")
 	ErrDeleteProductPermanent    = errors.New("failed to permanently delete product")
 	ErrRestoreAllProducts        = errors.New("failed to restore all products")
 	ErrDeleteAllProductPermanent = errors.New("failed to permanently delete all products")
+	ErrCountByImage              = errors.New("failed to count products using image")
+	ErrFindTrashedImages         = errors.New("failed to find images of trashed products")
+	ErrFindStockMovements        = errors.New("failed to find stock movements")
+	ErrCreateStockMovement       = errors.New("failed to record stock movement")
+	ErrFindLowStock              = errors.New("failed to find low stock products")
+	ErrUpdateReorderLevel        = errors.New("failed to update product reorder level")
+	ErrClaimLowStockAlerts       = errors.New("failed to claim low stock alerts")
+	ErrReleaseLowStockAlerts     = errors.New("failed to release low stock alerts")
+	ErrResetLowStockAlerts       = errors.New("failed to reset low stock alerts")
 )
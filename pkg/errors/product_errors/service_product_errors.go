@@ -7,6 +7,9 @@ import (
 
 var (
 	ErrFailedDeletingNotFoundProduct = errors.NewErrorResponse("Product not found", http.StatusNotFound)
+	ErrFailedProductNotFound         = errors.NewErrorResponse("Product not found", http.StatusNotFound)
+	ErrFailedBarcodeNotFound         = errors.NewErrorResponse("No product has this barcode", http.StatusNotFound)
+	ErrFailedBarcodeInUse            = errors.NewErrorResponse("Barcode is already used by another product or variant", http.StatusConflict)
 	ErrFailedDeleteImageProduct      = errors.NewErrorResponse("Failed to delete image product", http.StatusInternalServerError)
 
 	ErrFailedFindAllProducts        = errors.NewErrorResponse("Failed to find all products", http.StatusInternalServerError)
@@ -14,11 +17,26 @@ var (
 	ErrFailedFindProductsByCategory = errors.NewErrorResponse("Failed to find products by category", http.StatusInternalServerError)
 	ErrFailedFindProductById        = errors.NewErrorResponse("Failed to find product by ID", http.StatusInternalServerError)
 	ErrFailedFindProductByTrashed   = errors.NewErrorResponse("Failed to find product by trashed", http.StatusInternalServerError)
+	ErrFailedFindProductByBarcode   = errors.NewErrorResponse("Failed to find product by barcode", http.StatusInternalServerError)
+	ErrFailedFindStockMovements     = errors.NewErrorResponse("Failed to find stock movements", http.StatusInternalServerError)
+	ErrFailedFindLowStock           = errors.NewErrorResponse("Failed to find low stock products", http.StatusInternalServerError)
 
 	ErrFailedFindProductsByActive  = errors.NewErrorResponse("Failed to find active products", http.StatusInternalServerError)
 	ErrFailedFindProductsByTrashed = errors.NewErrorResponse("Failed to find trashed products", http.StatusInternalServerError)
 	ErrFailedCreateProduct         = errors.NewErrorResponse("Failed to create product", http.StatusInternalServerError)
 	ErrFailedUpdateProduct         = errors.NewErrorResponse("Failed to update product", http.StatusInternalServerError)
+	ErrFailedGenerateBarcode       = errors.NewErrorResponse("Failed to generate product barcode", http.StatusInternalServerError)
+	ErrFailedUpdateReorderLevel    = errors.NewErrorResponse("Failed to update product reorder level", http.StatusInternalServerError)
+	ErrFailedEvaluateLowStock      = errors.NewErrorResponse("Failed to evaluate low stock products", http.StatusInternalServerError)
+	ErrFailedNotifyLowStock        = errors.NewErrorResponse("Failed to deliver low stock alerts", http.StatusBadGateway)
+	ErrFailedInsufficientStock     = errors.NewErrorResponse("Insufficient product stock", http.StatusUnprocessableEntity)
+
+	ErrFailedReadImportFile       = errors.NewErrorResponse("Failed to read import file, expected a CSV or XLSX sheet", http.StatusBadRequest)
+	ErrFailedImportEmpty          = errors.NewErrorResponse("Import file has no product rows", http.StatusBadRequest)
+	ErrFailedImportMissingColumns = errors.NewErrorResponse("Import file must have name, price and category columns", http.StatusBadRequest)
+	ErrFailedImportTooManyRows    = errors.NewErrorResponse("Import file has too many rows", http.StatusBadRequest)
+	ErrFailedImportProducts       = errors.NewErrorResponse("Failed to import products", http.StatusInternalServerError)
+	ErrFailedExportProducts       = errors.NewErrorResponse("Failed to export products", http.StatusInternalServerError)
 
 	ErrFailedTrashProduct               = errors.NewErrorResponse("Failed to trash product", http.StatusInternalServerError)
 	ErrFailedRestoreProduct             = errors.NewErrorResponse("Failed to restore product", http.StatusInternalServerError)
@@ -0,0 +1,15 @@
+package product_variant_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrGrpcProductInvalidId = errors.NewGrpcError("Invalid product ID", http.StatusBadRequest)
+	ErrGrpcVariantInvalidId = errors.NewGrpcError("Invalid product variant ID", http.StatusBadRequest)
+
+	ErrGrpcValidateSetOptions    = errors.NewGrpcError("validation failed: invalid product options request", http.StatusBadRequest)
+	ErrGrpcValidateCreateVariant = errors.NewGrpcError("validation failed: invalid create product variant request", http.StatusBadRequest)
+	ErrGrpcValidateUpdateVariant = errors.NewGrpcError("validation failed: invalid update product variant request", http.StatusBadRequest)
+)
@@ -0,0 +1,19 @@
+package product_variant_errors
+
+import "errors"
+
+var (
+	ErrFindProductOptions    = errors.New("failed to find product options")
+	ErrReplaceProductOptions = errors.New("failed to replace product options")
+	ErrDuplicateOptionName   = errors.New("product option name already declared")
+
+	ErrVariantNotFound      = errors.New("product variant not found")
+	ErrFindProductVariants  = errors.New("failed to find product variants")
+	ErrFindVariantById      = errors.New("failed to find product variant by ID")
+	ErrCountProductVariants = errors.New("failed to count product variants")
+	ErrCreateProductVariant = errors.New("failed to create product variant")
+	ErrUpdateProductVariant = errors.New("failed to update product variant")
+	ErrTrashProductVariant  = errors.New("failed to trash product variant")
+	ErrDuplicateVariant     = errors.New("variant SKU, barcode or option combination already exists")
+	ErrVariantHasStock      = errors.New("product variant still has stock")
+)
@@ -0,0 +1,33 @@
+package product_variant_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrVariantNotFoundRes    = errors.NewErrorResponse("Product variant not found", http.StatusNotFound)
+	ErrFailedFindOptions     = errors.NewErrorResponse("Failed to fetch product options", http.StatusInternalServerError)
+	ErrFailedFindVariants    = errors.NewErrorResponse("Failed to fetch product variants", http.StatusInternalServerError)
+	ErrFailedFindVariant     = errors.NewErrorResponse("Failed to fetch product variant", http.StatusInternalServerError)
+	ErrFailedCountVariants   = errors.NewErrorResponse("Failed to count product variants", http.StatusInternalServerError)
+	ErrFailedSetOptions      = errors.NewErrorResponse("Failed to set product options", http.StatusInternalServerError)
+	ErrFailedCreateVariant   = errors.NewErrorResponse("Failed to create product variant", http.StatusInternalServerError)
+	ErrFailedUpdateVariant   = errors.NewErrorResponse("Failed to update product variant", http.StatusInternalServerError)
+	ErrFailedDeleteVariant   = errors.NewErrorResponse("Failed to delete product variant", http.StatusInternalServerError)
+	ErrFailedDuplicateOption = errors.NewErrorResponse("Product option names must be unique", http.StatusBadRequest)
+
+	ErrFailedDuplicateVariant = errors.NewErrorResponse("A variant with this SKU, barcode or option combination already exists", http.StatusConflict)
+	ErrFailedBarcodeInUse     = errors.NewErrorResponse("Barcode is already used by a product", http.StatusConflict)
+
+	ErrFailedNoOptions              = errors.NewErrorResponse("Product has no variant options", http.StatusUnprocessableEntity)
+	ErrFailedInvalidOptions         = errors.NewErrorResponse("Variant options do not match the product options", http.StatusUnprocessableEntity)
+	ErrFailedOptionsInUse           = errors.NewErrorResponse("Existing variants use option values that would be removed", http.StatusUnprocessableEntity)
+	ErrFailedProductHasStock        = errors.NewErrorResponse("Product stock must be zero before adding its first variant", http.StatusUnprocessableEntity)
+	ErrFailedVariantHasStock        = errors.NewErrorResponse("Product variant still has stock", http.StatusUnprocessableEntity)
+	ErrFailedVariantRequired        = errors.NewErrorResponse("Product is sold through variants; a variant must be selected", http.StatusUnprocessableEntity)
+	ErrFailedVariantMismatch        = errors.NewErrorResponse("Variant does not belong to the product", http.StatusUnprocessableEntity)
+	ErrFailedStockManagedByVariants = errors.NewErrorResponse("Product stock is managed through its variants", http.StatusUnprocessableEntity)
+	ErrFailedInsufficientStock      = errors.NewErrorResponse("Insufficient variant stock", http.StatusUnprocessableEntity)
+	ErrFailedRecordMovement         = errors.NewErrorResponse("Failed to record variant stock movement", http.StatusInternalServerError)
+)
@@ -0,0 +1,13 @@
+package purchase_order_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrGrpcPurchaseOrderInvalidId = errors.NewGrpcError("Invalid purchase order ID", http.StatusBadRequest)
+
+	ErrGrpcValidateCreatePurchaseOrder  = errors.NewGrpcError("validation failed: invalid create purchase order request", http.StatusBadRequest)
+	ErrGrpcValidateReceivePurchaseOrder = errors.NewGrpcError("validation failed: invalid receive purchase order request", http.StatusBadRequest)
+)
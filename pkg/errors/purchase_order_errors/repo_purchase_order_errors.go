@@ -0,0 +1,21 @@
+package purchase_order_errors
+
+import "errors"
+
+var (
+	ErrPurchaseOrderNotFound      = errors.New("purchase order not found")
+	ErrFindAllPurchaseOrders      = errors.New("failed to find all purchase orders")
+	ErrFindPurchaseOrderById      = errors.New("failed to find purchase order by ID")
+	ErrCreatePurchaseOrder        = errors.New("failed to create purchase order")
+	ErrPurchaseOrderStatusChanged = errors.New("purchase order status changed concurrently")
+	ErrUpdatePurchaseOrderStatus  = errors.New("failed to update purchase order status")
+
+	ErrFindPurchaseOrderItems     = errors.New("failed to find purchase order items")
+	ErrCreatePurchaseOrderItem    = errors.New("failed to create purchase order item")
+	ErrDuplicatePurchaseOrderItem = errors.New("product already on purchase order")
+	ErrOverReceived               = errors.New("received quantity exceeds quantity ordered")
+	ErrReceivePurchaseOrderItem   = errors.New("failed to receive purchase order item")
+
+	ErrCreatePurchaseOrderReceipt = errors.New("failed to create purchase order receipt")
+	ErrFindPurchaseOrderReceipts  = errors.New("failed to find purchase order receipts")
+)
@@ -0,0 +1,28 @@
+package purchase_order_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrPurchaseOrderNotFoundRes = errors.NewErrorResponse("Purchase order not found", http.StatusNotFound)
+	ErrFailedFindAll            = errors.NewErrorResponse("Failed to fetch purchase orders", http.StatusInternalServerError)
+	ErrFailedFindItems          = errors.NewErrorResponse("Failed to fetch purchase order items", http.StatusInternalServerError)
+	ErrFailedFindReceipts       = errors.NewErrorResponse("Failed to fetch purchase order receipts", http.StatusInternalServerError)
+
+	ErrFailedCreatePurchaseOrder = errors.NewErrorResponse("Failed to create purchase order", http.StatusInternalServerError)
+	ErrFailedCreateItem          = errors.NewErrorResponse("Failed to add item to purchase order", http.StatusInternalServerError)
+	ErrFailedDuplicateProduct    = errors.NewErrorResponse("A product can only appear once on a purchase order", http.StatusConflict)
+	ErrFailedSupplierMismatch    = errors.NewErrorResponse("Supplier does not belong to the merchant", http.StatusUnprocessableEntity)
+	ErrFailedProductMismatch     = errors.NewErrorResponse("Product does not belong to the merchant", http.StatusUnprocessableEntity)
+
+	ErrFailedInvalidTransition = errors.NewErrorResponse("Purchase order cannot move to the requested status", http.StatusUnprocessableEntity)
+	ErrFailedStatusConflict    = errors.NewErrorResponse("Purchase order status was changed by another request", http.StatusConflict)
+	ErrFailedUpdateStatus      = errors.NewErrorResponse("Failed to update purchase order status", http.StatusInternalServerError)
+
+	ErrFailedReceiveItem   = errors.NewErrorResponse("Failed to receive purchase order item", http.StatusInternalServerError)
+	ErrFailedOverReceive   = errors.NewErrorResponse("Received quantity exceeds the quantity still outstanding", http.StatusUnprocessableEntity)
+	ErrFailedRecordReceipt = errors.NewErrorResponse("Failed to record goods receipt", http.StatusInternalServerError)
+	ErrFailedReceiveStock  = errors.NewErrorResponse("Failed to add received goods to stock", http.StatusInternalServerError)
+)
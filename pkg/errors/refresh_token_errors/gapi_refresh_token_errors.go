@@ -1,9 +1,13 @@
 package refreshtoken_errors
 
 import (
+	"net/http"
 	"pointofsale/pkg/errors"
 
 	"google.golang.org/grpc/codes"
 )
 
-var ErrGrpcRefreshToken = errors.NewGrpcError("refresh token failed", int(codes.Unauthenticated))
+var (
+	ErrGrpcRefreshToken   = errors.NewGrpcError("refresh token failed", int(codes.Unauthenticated))
+	ErrGrpcMissingSession = errors.NewGrpcError("sessions require an authenticated access token", http.StatusUnauthorized)
+)
@@ -11,4 +11,12 @@ var (
 	ErrDeleteRefreshToken = errors.New("failed to delete refresh token")
 	ErrDeleteByUserID     = errors.New("failed to delete refresh token by user ID")
 	ErrParseDate          = errors.New("failed to parse expiration date")
+
+	ErrTokenRevoked               = errors.New("refresh token already revoked")
+	ErrFindByAccessTokenID        = errors.New("failed to find refresh token by access token ID")
+	ErrFindActiveByUserID         = errors.New("failed to find active refresh tokens by user ID")
+	ErrRevokeRefreshToken         = errors.New("failed to revoke refresh token")
+	ErrRevokeSession              = errors.New("failed to revoke refresh token session")
+	ErrRevokeByUserID             = errors.New("failed to revoke refresh tokens by user ID")
+	ErrDeleteExpiredRefreshTokens = errors.New("failed to delete expired refresh tokens")
 )
@@ -21,4 +21,10 @@ var (
 	ErrFailedDeleteRefreshToken  = errors.NewErrorResponse("Failed to delete refresh token", http.StatusInternalServerError)
 	ErrFailedDeleteByUserID      = errors.NewErrorResponse("Failed to delete refresh token by user ID", http.StatusInternalServerError)
 	ErrFailedParseExpirationDate = errors.NewErrorResponse("Failed to parse expiration date", http.StatusBadRequest)
+
+	ErrRefreshTokenReused       = errors.NewErrorResponse("Refresh token has already been used, the session was revoked", http.StatusUnauthorized)
+	ErrFailedRotateRefreshToken = errors.NewErrorResponse("Failed to rotate refresh token", http.StatusInternalServerError)
+	ErrFailedRevokeSession      = errors.NewErrorResponse("Failed to revoke session", http.StatusInternalServerError)
+	ErrFailedFindSessions       = errors.NewErrorResponse("Failed to find sessions", http.StatusInternalServerError)
+	ErrFailedPurgeRefreshTokens = errors.NewErrorResponse("Failed to purge expired refresh tokens", http.StatusInternalServerError)
 )
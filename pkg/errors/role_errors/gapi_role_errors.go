@@ -1,6 +1,7 @@
 package role_errors
 
 import (
+	"net/http"
 	"pointofsale/pkg/errors"
 
 	"google.golang.org/grpc/codes"
@@ -9,6 +10,7 @@ import (
 var (
 	ErrGrpcRoleNotFound  = errors.NewGrpcError("Role not found", int(codes.NotFound))
 	ErrGrpcRoleInvalidId = errors.NewGrpcError("Invalid Role ID", int(codes.NotFound))
+	ErrGrpcRoleForbidden = errors.NewGrpcError("Not allowed to view roles of another user", http.StatusForbidden)
 
 	ErrGrpcValidateCreateRole = errors.NewGrpcError("validation failed: invalid create Role request", int(codes.InvalidArgument))
 	ErrGrpcValidateUpdateRole = errors.NewGrpcError("validation failed: invalid update Role request", int(codes.InvalidArgument))
@@ -0,0 +1,13 @@
+package stocktake_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrGrpcStocktakeInvalidId = errors.NewGrpcError("Invalid stocktake ID", http.StatusBadRequest)
+
+	ErrGrpcValidateCreateStocktake = errors.NewGrpcError("validation failed: invalid create stocktake request", http.StatusBadRequest)
+	ErrGrpcValidateSubmitCounts    = errors.NewGrpcError("validation failed: invalid stocktake counts request", http.StatusBadRequest)
+)
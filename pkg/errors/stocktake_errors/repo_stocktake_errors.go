@@ -0,0 +1,20 @@
+package stocktake_errors
+
+import "errors"
+
+var (
+	ErrStocktakeNotFound      = errors.New("stocktake not found")
+	ErrFindAllStocktakes      = errors.New("failed to find all stocktakes")
+	ErrFindStocktakeById      = errors.New("failed to find stocktake by ID")
+	ErrCreateStocktake        = errors.New("failed to create stocktake")
+	ErrStocktakeAlreadyOpen   = errors.New("merchant already has an open stocktake")
+	ErrStocktakeStatusChanged = errors.New("stocktake status changed concurrently")
+	ErrUpdateStocktakeStatus  = errors.New("failed to update stocktake status")
+
+	ErrCreateStocktakeItems   = errors.New("failed to snapshot stocktake items")
+	ErrFindStocktakeItems     = errors.New("failed to find stocktake items")
+	ErrFindCountedItems       = errors.New("failed to find counted stocktake items")
+	ErrProductNotInStocktake  = errors.New("product is not part of the stocktake")
+	ErrUpdateStocktakeCount   = errors.New("failed to update stocktake count")
+	ErrFindVarianceByCategory = errors.New("failed to find stocktake variance by category")
+)
@@ -0,0 +1,27 @@
+package stocktake_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrStocktakeNotFoundRes = errors.NewErrorResponse("Stocktake not found", http.StatusNotFound)
+	ErrFailedFindAll        = errors.NewErrorResponse("Failed to fetch stocktakes", http.StatusInternalServerError)
+	ErrFailedFindItems      = errors.NewErrorResponse("Failed to fetch stocktake items", http.StatusInternalServerError)
+	ErrFailedVarianceReport = errors.NewErrorResponse("Failed to build stocktake variance report", http.StatusInternalServerError)
+
+	ErrFailedCreateStocktake = errors.NewErrorResponse("Failed to create stocktake", http.StatusInternalServerError)
+	ErrFailedAlreadyOpen     = errors.NewErrorResponse("Merchant already has an open stocktake", http.StatusConflict)
+	ErrFailedSnapshotItems   = errors.NewErrorResponse("Failed to snapshot stocktake items", http.StatusInternalServerError)
+
+	ErrFailedNotOpen               = errors.NewErrorResponse("Stocktake is no longer open", http.StatusUnprocessableEntity)
+	ErrFailedUnknownBarcode        = errors.NewErrorResponse("No product has the submitted barcode", http.StatusUnprocessableEntity)
+	ErrFailedProductNotInStocktake = errors.NewErrorResponse("Product is not part of the stocktake", http.StatusUnprocessableEntity)
+	ErrFailedSubmitCount           = errors.NewErrorResponse("Failed to record stocktake count", http.StatusInternalServerError)
+
+	ErrFailedInvalidTransition = errors.NewErrorResponse("Stocktake cannot move to the requested status", http.StatusUnprocessableEntity)
+	ErrFailedStatusConflict    = errors.NewErrorResponse("Stocktake status was changed by another request", http.StatusConflict)
+	ErrFailedUpdateStatus      = errors.NewErrorResponse("Failed to update stocktake status", http.StatusInternalServerError)
+	ErrFailedPostAdjustment    = errors.NewErrorResponse("Failed to post stocktake adjustment", http.StatusInternalServerError)
+)
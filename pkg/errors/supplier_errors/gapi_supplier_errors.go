@@ -0,0 +1,13 @@
+package supplier_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrGrpcSupplierInvalidId = errors.NewGrpcError("Invalid supplier ID", http.StatusBadRequest)
+
+	ErrGrpcValidateCreateSupplier = errors.NewGrpcError("validation failed: invalid create supplier request", http.StatusBadRequest)
+	ErrGrpcValidateUpdateSupplier = errors.NewGrpcError("validation failed: invalid update supplier request", http.StatusBadRequest)
+)
@@ -0,0 +1,14 @@
+package supplier_errors
+
+import "errors"
+
+var (
+	ErrSupplierNotFound        = errors.New("supplier not found")
+	ErrFindAllSuppliers        = errors.New("failed to find all suppliers")
+	ErrFindSupplierById        = errors.New("failed to find supplier by ID")
+	ErrCreateSupplier          = errors.New("failed to create supplier")
+	ErrUpdateSupplier          = errors.New("failed to update supplier")
+	ErrTrashedSupplier         = errors.New("failed to move supplier to trash")
+	ErrRestoreSupplier         = errors.New("failed to restore supplier from trash")
+	ErrDeleteSupplierPermanent = errors.New("failed to permanently delete supplier")
+)
@@ -0,0 +1,18 @@
+package supplier_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrSupplierNotFoundRes = errors.NewErrorResponse("Supplier not found", http.StatusNotFound)
+	ErrFailedFindAll       = errors.NewErrorResponse("Failed to fetch suppliers", http.StatusInternalServerError)
+
+	ErrFailedCreateSupplier = errors.NewErrorResponse("Failed to create supplier", http.StatusInternalServerError)
+	ErrFailedUpdateSupplier = errors.NewErrorResponse("Failed to update supplier", http.StatusInternalServerError)
+
+	ErrFailedTrashedSupplier = errors.NewErrorResponse("Failed to move supplier to trash", http.StatusInternalServerError)
+	ErrFailedRestoreSupplier = errors.NewErrorResponse("Failed to restore supplier", http.StatusInternalServerError)
+	ErrFailedDeletePermanent = errors.NewErrorResponse("Failed to delete supplier permanently", http.StatusInternalServerError)
+)
@@ -0,0 +1,14 @@
+package tax_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrGrpcTaxRateInvalidId     = errors.NewGrpcError("Invalid tax rate ID", http.StatusBadRequest)
+	ErrGrpcTransactionInvalidId = errors.NewGrpcError("Invalid transaction ID", http.StatusBadRequest)
+
+	ErrGrpcValidateCreateTaxRate = errors.NewGrpcError("validation failed: invalid create tax rate request", http.StatusBadRequest)
+	ErrGrpcValidateUpdateTaxRate = errors.NewGrpcError("validation failed: invalid update tax rate request", http.StatusBadRequest)
+)
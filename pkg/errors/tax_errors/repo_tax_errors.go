@@ -0,0 +1,16 @@
+package tax_errors
+
+import "errors"
+
+var (
+	ErrTaxRateNotFound        = errors.New("tax rate not found")
+	ErrFindAllTaxRates        = errors.New("failed to find all tax rates")
+	ErrFindTaxRateById        = errors.New("failed to find tax rate by ID")
+	ErrFindApplicable         = errors.New("failed to find applicable tax rate")
+	ErrTaxRateConflict        = errors.New("failed tax rate already exists for this scope")
+	ErrCreateTaxRate          = errors.New("failed to create tax rate")
+	ErrUpdateTaxRate          = errors.New("failed to update tax rate")
+	ErrTrashedTaxRate         = errors.New("failed to move tax rate to trash")
+	ErrRestoreTaxRate         = errors.New("failed to restore tax rate from trash")
+	ErrDeleteTaxRatePermanent = errors.New("failed to permanently delete tax rate")
+)
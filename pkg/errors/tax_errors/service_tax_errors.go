@@ -0,0 +1,23 @@
+package tax_errors
+
+import (
+	"net/http"
+	"pointofsale/pkg/errors"
+)
+
+var (
+	ErrTaxRateNotFoundRes = errors.NewErrorResponse("Tax rate not found", http.StatusNotFound)
+	ErrTaxRateConflictRes = errors.NewErrorResponse("An active tax rate already exists for this merchant and category", http.StatusConflict)
+	ErrFailedFindAll      = errors.NewErrorResponse("Failed to fetch tax rates", http.StatusInternalServerError)
+
+	ErrFailedResolveTaxRate = errors.NewErrorResponse("Failed to resolve tax rate", http.StatusInternalServerError)
+	ErrFailedRecordLineTax  = errors.NewErrorResponse("Failed to record order item tax", http.StatusInternalServerError)
+	ErrFailedFindTaxLines   = errors.NewErrorResponse("Failed to fetch transaction tax breakdown", http.StatusInternalServerError)
+
+	ErrFailedCreateTaxRate = errors.NewErrorResponse("Failed to create tax rate", http.StatusInternalServerError)
+	ErrFailedUpdateTaxRate = errors.NewErrorResponse("Failed to update tax rate", http.StatusInternalServerError)
+
+	ErrFailedTrashedTaxRate  = errors.NewErrorResponse("Failed to move tax rate to trash", http.StatusInternalServerError)
+	ErrFailedRestoreTaxRate  = errors.NewErrorResponse("Failed to restore tax rate", http.StatusInternalServerError)
+	ErrFailedDeletePermanent = errors.NewErrorResponse("Failed to delete tax rate permanently", http.StatusInternalServerError)
+)
@@ -1,6 +1,7 @@
 package transaction_errors
 
 import (
+	"net/http"
 	"pointofsale/pkg/errors"
 
 	"google.golang.org/grpc/codes"
@@ -14,4 +15,8 @@ var (
 
 	ErrGrpcValidateCreateTransaction = errors.NewGrpcError("validation failed: invalid create transaction request", int(codes.InvalidArgument))
 	ErrGrpcValidateUpdateTransaction = errors.NewGrpcError("validation failed: invalid update transaction request", int(codes.InvalidArgument))
+
+	ErrGrpcValidateRefundTransaction = errors.NewGrpcError("validation failed: invalid refund transaction request", http.StatusBadRequest)
+	ErrGrpcValidateVoidTransaction   = errors.NewGrpcError("validation failed: invalid void transaction request", http.StatusBadRequest)
+	ErrGrpcMissingApprover           = errors.NewGrpcError("refunds and voids require an authenticated approver", http.StatusUnauthorized)
 )
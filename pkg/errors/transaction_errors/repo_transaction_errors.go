@@ -23,8 +23,19 @@ var (
 	ErrFindByTrashed       = errors.New("failed to find trashed transactions")
 	ErrFindByMerchant      = errors.New("failed to find transactions by merchant")
 	ErrFindById            = errors.New("failed to find transaction by ID")
+	ErrTransactionNotFound = errors.New("transaction not found")
 	ErrFindByOrderId       = errors.New("failed to find transaction by order ID")
 
+	ErrFindTransactionPayments   = errors.New("failed to find transaction payments")
+	ErrCreateTransactionPayment  = errors.New("failed to create transaction payment")
+	ErrDeleteTransactionPayments = errors.New("failed to delete transaction payments")
+
+	ErrUpdateTransactionStatus     = errors.New("failed to update transaction status")
+	ErrFindTransactionRefunds      = errors.New("failed to find transaction refunds")
+	ErrFindRefundedQuantities      = errors.New("failed to find refunded quantities")
+	ErrCreateTransactionRefund     = errors.New("failed to create transaction refund")
+	ErrCreateTransactionRefundItem = errors.New("failed to create transaction refund item")
+
 	ErrCreateTransaction             = errors.New("failed to create transaction")
 	ErrUpdateTransaction             = errors.New("failed to update transaction")
 	ErrTrashTransaction              = errors.New("failed to move transaction to trash")
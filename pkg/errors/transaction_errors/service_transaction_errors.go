@@ -10,6 +10,13 @@ var (
 	ErrFailedPaymentStatusInvalid          = errors.NewErrorResponse("Invalid payment status", http.StatusBadRequest)
 	ErrFailedPaymentInsufficientBalance    = errors.NewErrorResponse("Insufficient balance", http.StatusBadRequest)
 	ErrFailedOrderItemEmpty                = errors.NewErrorResponse("Failed to order item empty", http.StatusInternalServerError)
+	ErrFailedInvalidTenderAmount           = errors.NewErrorResponse("Tender amount must be greater than zero", http.StatusBadRequest)
+	ErrFailedNonCashOverpayment            = errors.NewErrorResponse("Non-cash tenders cannot exceed the amount due", http.StatusBadRequest)
+	ErrFailedTransactionNotRefundable      = errors.NewErrorResponse("Only completed transactions can be refunded", http.StatusUnprocessableEntity)
+	ErrFailedTransactionNotVoidable        = errors.NewErrorResponse("Only completed transactions without refunds can be voided", http.StatusUnprocessableEntity)
+	ErrFailedNothingToRefund               = errors.NewErrorResponse("Nothing left to refund on this transaction", http.StatusUnprocessableEntity)
+	ErrFailedRefundItemNotInOrder          = errors.NewErrorResponse("Refund item does not belong to the transaction's order", http.StatusBadRequest)
+	ErrFailedRefundQuantityExceeded        = errors.NewErrorResponse("Refund quantity exceeds the quantity not yet refunded", http.StatusBadRequest)
 
 	ErrFailedFindMonthlyAmountSuccess = errors.NewErrorResponse("Failed to find monthly amount success", http.StatusInternalServerError)
 	ErrFailedFindYearlyAmountSuccess  = errors.NewErrorResponse("Failed to find yearly amount success", http.StatusInternalServerError)
@@ -31,10 +38,17 @@ var (
 	ErrFailedFindTransactionsByActive   = errors.NewErrorResponse("Failed to find active transactions", http.StatusInternalServerError)
 	ErrFailedFindTransactionsByTrashed  = errors.NewErrorResponse("Failed to find trashed transactions", http.StatusInternalServerError)
 	ErrFailedFindTransactionById        = errors.NewErrorResponse("Failed to find transaction by ID", http.StatusInternalServerError)
+	ErrFailedTransactionNotFound        = errors.NewErrorResponse("Transaction not found", http.StatusNotFound)
 	ErrFailedFindTransactionByOrderId   = errors.NewErrorResponse("Failed to find transaction by order ID", http.StatusInternalServerError)
+	ErrFailedFindTransactionPayments    = errors.NewErrorResponse("Failed to find transaction payments", http.StatusInternalServerError)
+	ErrFailedFindTransactionRefunds     = errors.NewErrorResponse("Failed to find transaction refunds", http.StatusInternalServerError)
 
 	ErrFailedCreateTransaction             = errors.NewErrorResponse("Failed to create transaction", http.StatusInternalServerError)
 	ErrFailedUpdateTransaction             = errors.NewErrorResponse("Failed to update transaction", http.StatusInternalServerError)
+	ErrFailedRecordTransactionPayments     = errors.NewErrorResponse("Failed to record transaction payments", http.StatusInternalServerError)
+	ErrFailedRefundTransaction             = errors.NewErrorResponse("Failed to refund transaction", http.StatusInternalServerError)
+	ErrFailedVoidTransaction               = errors.NewErrorResponse("Failed to void transaction", http.StatusInternalServerError)
+	ErrFailedRestockRefundItems            = errors.NewErrorResponse("Failed to return refunded items to stock", http.StatusInternalServerError)
 	ErrFailedTrashedTransaction            = errors.NewErrorResponse("Failed to trash transaction", http.StatusInternalServerError)
 	ErrFailedRestoreTransaction            = errors.NewErrorResponse("Failed to restore transaction", http.StatusInternalServerError)
 	ErrFailedDeleteTransactionPermanently  = errors.NewErrorResponse("Failed to permanently delete transaction", http.StatusInternalServerError)
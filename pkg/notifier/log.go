@@ -0,0 +1,32 @@
+package notifier
+
+import (
+	"context"
+	"pointofsale/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// LogNotifier writes low-stock alerts to the application log.
+type LogNotifier struct {
+	logger logger.LoggerInterface
+}
+
+func NewLogNotifier(logger logger.LoggerInterface) *LogNotifier {
+	return &LogNotifier{logger: logger}
+}
+
+func (n *LogNotifier) NotifyLowStock(ctx context.Context, alerts []LowStockAlert) error {
+	for _, alert := range alerts {
+		n.logger.Warn("Product stock is low",
+			zap.Int("product_id", alert.ProductID),
+			zap.Int("merchant_id", alert.MerchantID),
+			zap.String("name", alert.Name),
+			zap.Int("count_in_stock", alert.CountInStock),
+			zap.Int("reorder_point", alert.ReorderPoint),
+			zap.Int("reorder_quantity", alert.ReorderQuantity),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryNotifier keeps low-stock alerts in memory. It is meant for tests.
+type MemoryNotifier struct {
+	mu     sync.Mutex
+	alerts []LowStockAlert
+	err    error
+}
+
+func NewMemoryNotifier() *MemoryNotifier {
+	return &MemoryNotifier{}
+}
+
+func (n *MemoryNotifier) NotifyLowStock(ctx context.Context, alerts []LowStockAlert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.err != nil {
+		return n.err
+	}
+
+	n.alerts = append(n.alerts, alerts...)
+	return nil
+}
+
+// FailWith makes every following delivery fail with err until it is called
+// again with nil.
+func (n *MemoryNotifier) FailWith(err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.err = err
+}
+
+// Alerts returns the alerts delivered so far.
+func (n *MemoryNotifier) Alerts() []LowStockAlert {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return append([]LowStockAlert(nil), n.alerts...)
+}
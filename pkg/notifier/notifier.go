@@ -0,0 +1,24 @@
+package notifier
+
+import (
+	"context"
+	"time"
+)
+
+// LowStockAlert reports a product whose stock fell to or below its reorder
+// point.
+type LowStockAlert struct {
+	ProductID       int       `json:"product_id"`
+	MerchantID      int       `json:"merchant_id"`
+	Name            string    `json:"name"`
+	CountInStock    int       `json:"count_in_stock"`
+	ReorderPoint    int       `json:"reorder_point"`
+	ReorderQuantity int       `json:"reorder_quantity"`
+	DetectedAt      time.Time `json:"detected_at"`
+}
+
+// LowStockNotifier delivers low-stock alerts. When it returns an error none
+// of the alerts are considered delivered and they are sent again later.
+type LowStockNotifier interface {
+	NotifyLowStock(ctx context.Context, alerts []LowStockAlert) error
+}
@@ -0,0 +1,56 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookNotifier posts low-stock alerts as JSON to an HTTP endpoint.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+type webhookPayload struct {
+	Event  string          `json:"event"`
+	Alerts []LowStockAlert `json:"alerts"`
+}
+
+func NewWebhookNotifier(url string, client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = &http.Client{Timeout: defaultWebhookTimeout}
+	}
+
+	return &WebhookNotifier{url: url, client: client}
+}
+
+func (n *WebhookNotifier) NotifyLowStock(ctx context.Context, alerts []LowStockAlert) error {
+	body, err := json.Marshal(webhookPayload{Event: "low_stock", Alerts: alerts})
+	if err != nil {
+		return fmt.Errorf("failed to encode low-stock alerts: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build low-stock webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send low-stock webhook: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("low-stock webhook returned status %d", res.StatusCode)
+	}
+
+	return nil
+}
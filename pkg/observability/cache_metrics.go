@@ -2,6 +2,8 @@ package observability
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -16,6 +18,10 @@ type CacheMetricsInterface interface {
 	RecordCacheDelete(ctx context.Context, key string, success bool)
 	RecordCacheOperationLatency(ctx context.Context, operation string, duration time.Duration)
 	RecordCacheError(ctx context.Context, operation, key string, err error)
+	RecordCacheStaleServe(ctx context.Context, key string)
+	RecordCacheCoalescedLoad(ctx context.Context, key string)
+	RecordLocalCacheHit(ctx context.Context, namespace string)
+	RecordLocalCacheMiss(ctx context.Context, namespace string)
 }
 
 type CacheMetrics struct {
@@ -27,6 +33,17 @@ type CacheMetrics struct {
 	cacheDeleteErrors metric.Int64Counter
 	operationLatency  metric.Float64Histogram
 	errors            metric.Int64Counter
+	staleServes       metric.Int64Counter
+	coalescedLoads    metric.Int64Counter
+	localHits         metric.Int64Counter
+	localMisses       metric.Int64Counter
+
+	// localLookups feeds the local hit rate gauge, keyed by namespace.
+	localLookups sync.Map
+}
+
+type localLookups struct {
+	hits, misses atomic.Int64
 }
 
 func NewCacheMetrics(serviceName string) (CacheMetricsInterface, error) {
@@ -104,7 +121,43 @@ func NewCacheMetrics(serviceName string) (CacheMetricsInterface, error) {
 		return nil, err
 	}
 
-	return &CacheMetrics{
+	staleServes, err := meter.Int64Counter(
+		"cache_stale_serves_total",
+		metric.WithDescription("Total number of expired values served while a refresh was running"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	coalescedLoads, err := meter.Int64Counter(
+		"cache_coalesced_loads_total",
+		metric.WithDescription("Total number of cache misses that waited for another caller's load"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	localHits, err := meter.Int64Counter(
+		"cache_local_hits_total",
+		metric.WithDescription("Total number of lookups served by the in-process cache tier"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	localMisses, err := meter.Int64Counter(
+		"cache_local_misses_total",
+		metric.WithDescription("Total number of lookups the in-process cache tier passed on to Redis"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &CacheMetrics{
 		cacheHits:         cacheHits,
 		cacheMisses:       cacheMisses,
 		cacheSets:         cacheSets,
@@ -113,7 +166,23 @@ func NewCacheMetrics(serviceName string) (CacheMetricsInterface, error) {
 		cacheDeleteErrors: cacheDeleteErrors,
 		operationLatency:  operationLatency,
 		errors:            errors,
-	}, nil
+		staleServes:       staleServes,
+		coalescedLoads:    coalescedLoads,
+		localHits:         localHits,
+		localMisses:       localMisses,
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"cache_local_hit_rate",
+		metric.WithDescription("Share of lookups served by the in-process cache tier since start"),
+		metric.WithUnit("1"),
+		metric.WithFloat64Callback(m.observeLocalHitRate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
 }
 
 func (m *CacheMetrics) RecordCacheHit(ctx context.Context, key string) {
@@ -167,3 +236,58 @@ func (m *CacheMetrics) RecordCacheError(ctx context.Context, operation, key stri
 	}
 	m.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 }
+
+func (m *CacheMetrics) RecordCacheStaleServe(ctx context.Context, key string) {
+	attrs := []attribute.KeyValue{
+		attribute.String("key", key),
+	}
+	m.staleServes.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+func (m *CacheMetrics) RecordCacheCoalescedLoad(ctx context.Context, key string) {
+	attrs := []attribute.KeyValue{
+		attribute.String("key", key),
+	}
+	m.coalescedLoads.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
+func (m *CacheMetrics) RecordLocalCacheHit(ctx context.Context, namespace string) {
+	attrs := []attribute.KeyValue{
+		attribute.String("namespace", namespace),
+	}
+	m.localHits.Add(ctx, 1, metric.WithAttributes(attrs...))
+	m.lookups(namespace).hits.Add(1)
+}
+
+func (m *CacheMetrics) RecordLocalCacheMiss(ctx context.Context, namespace string) {
+	attrs := []attribute.KeyValue{
+		attribute.String("namespace", namespace),
+	}
+	m.localMisses.Add(ctx, 1, metric.WithAttributes(attrs...))
+	m.lookups(namespace).misses.Add(1)
+}
+
+func (m *CacheMetrics) lookups(namespace string) *localLookups {
+	if l, ok := m.localLookups.Load(namespace); ok {
+		return l.(*localLookups)
+	}
+
+	l, _ := m.localLookups.LoadOrStore(namespace, &localLookups{})
+	return l.(*localLookups)
+}
+
+func (m *CacheMetrics) observeLocalHitRate(ctx context.Context, o metric.Float64Observer) error {
+	m.localLookups.Range(func(namespace, value any) bool {
+		l := value.(*localLookups)
+
+		hits, misses := l.hits.Load(), l.misses.Load()
+		if hits+misses > 0 {
+			o.Observe(float64(hits)/float64(hits+misses), metric.WithAttributes(
+				attribute.String("namespace", namespace.(string)),
+			))
+		}
+		return true
+	})
+
+	return nil
+}
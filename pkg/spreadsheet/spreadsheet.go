@@ -0,0 +1,213 @@
+// Package spreadsheet reads and writes the tabular files merchants exchange
+// with the point of sale, as CSV or as the first sheet of an XLSX workbook.
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("spreadsheet format must be csv or xlsx")
+	ErrEmptyWorkbook     = errors.New("workbook has no sheets")
+	ErrEmptySheet        = errors.New("sheet has no header row")
+)
+
+// RowError reports a row of the file that cannot be read. Rows are numbered
+// from 1, the header being row 1.
+type RowError struct {
+	Row int
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// MissingColumnsError reports the required columns a header does not have.
+type MissingColumnsError struct {
+	Columns []string
+}
+
+func (e *MissingColumnsError) Error() string {
+	return "sheet is missing columns: " + strings.Join(e.Columns, ", ")
+}
+
+// Row is a data row of a sheet with its cells keyed by column name.
+type Row struct {
+	// Number is the position of the row in the file, the header being row 1.
+	Number int
+	Values map[string]string
+}
+
+// utf8BOM is written by spreadsheet programs at the start of CSV exports.
+const utf8BOM = "\ufeff"
+
+// ContentType returns the MIME type of files in format.
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "text/csv"
+}
+
+// Read returns every row of the file, header included. Rows may be shorter
+// than the header when trailing cells are empty.
+func Read(format string, r io.Reader) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		var rows [][]string
+
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				var parseErr *csv.ParseError
+				if errors.As(err, &parseErr) {
+					err = parseErr.Err
+				}
+
+				return nil, &RowError{Row: len(rows) + 1, Err: err}
+			}
+
+			rows = append(rows, record)
+		}
+
+		if len(rows) > 0 && len(rows[0]) > 0 {
+			rows[0][0] = strings.TrimPrefix(rows[0][0], utf8BOM)
+		}
+
+		return rows, nil
+	case FormatXLSX:
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, ErrEmptyWorkbook
+		}
+
+		return f.GetRows(sheets[0])
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// MapRows matches the header of records against columns and returns every
+// data row with its cells keyed by column name. Header names are matched
+// case-insensitively, cells are trimmed, unknown columns are ignored and rows
+// whose known cells are all blank are skipped.
+func MapRows(records [][]string, columns []string, required []string) ([]Row, error) {
+	if len(records) == 0 {
+		return nil, ErrEmptySheet
+	}
+
+	index := make(map[string]int)
+
+	for i, header := range records[0] {
+		name := strings.ToLower(strings.TrimSpace(header))
+
+		// The first of two columns with the same name wins.
+		if _, ok := index[name]; !ok {
+			index[name] = i
+		}
+	}
+
+	var missing []string
+	for _, column := range required {
+		if _, ok := index[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, &MissingColumnsError{Columns: missing}
+	}
+
+	var rows []Row
+
+	for i, record := range records[1:] {
+		values := make(map[string]string, len(columns))
+		blank := true
+
+		for _, column := range columns {
+			at, ok := index[column]
+			if !ok || at >= len(record) {
+				continue
+			}
+
+			value := strings.TrimSpace(record[at])
+			values[column] = value
+
+			if value != "" {
+				blank = false
+			}
+		}
+
+		if blank {
+			continue
+		}
+
+		rows = append(rows, Row{Number: i + 2, Values: values})
+	}
+
+	return rows, nil
+}
+
+// Write writes rows to w, the first row being the header.
+func Write(format string, w io.Writer, rows [][]string) error {
+	switch format {
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+
+		if err := writer.WriteAll(rows); err != nil {
+			return err
+		}
+
+		return writer.Error()
+	case FormatXLSX:
+		f := excelize.NewFile()
+		defer f.Close()
+
+		sheet := f.GetSheetName(0)
+
+		for i, row := range rows {
+			cell, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return err
+			}
+
+			if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+				return err
+			}
+		}
+
+		return f.Write(w)
+	default:
+		return ErrUnsupportedFormat
+	}
+}
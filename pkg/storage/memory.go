@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MemoryStorage keeps objects in memory. It is meant for tests.
+type MemoryStorage struct {
+	mu      sync.Mutex
+	objects map[string]MemoryObject
+}
+
+type MemoryObject struct {
+	Data        []byte
+	ContentType string
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{objects: make(map[string]MemoryObject)}
+}
+
+func (s *MemoryStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.objects[key] = MemoryObject{
+		Data:        append([]byte(nil), data...),
+		ContentType: contentType,
+	}
+
+	return nil
+}
+
+func (s *MemoryStorage) Exists(ctx context.Context, key string) (bool, error) {
+	if err := ValidateKey(key); err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.objects[key]
+
+	return ok, nil
+}
+
+func (s *MemoryStorage) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.objects, key)
+
+	return nil
+}
+
+func (s *MemoryStorage) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(time.Now().Add(ttl).Unix(), 10))
+
+	return "memory:///" + key + "?" + query.Encode(), nil
+}
+
+// Get returns the object stored under key.
+func (s *MemoryStorage) Get(key string) (MemoryObject, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[key]
+
+	return object, ok
+}
+
+// Keys returns the keys of every stored object.
+func (s *MemoryStorage) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.objects))
+	for key := range s.objects {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
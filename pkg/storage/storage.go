@@ -0,0 +1,55 @@
+// Package storage keeps uploaded files in a backend shared by every
+// instance of the application: a local directory, an S3-compatible bucket or,
+// in tests, memory.
+package storage
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidKey       = errors.New("storage key must be a relative path without dot segments")
+	ErrInvalidSignature = errors.New("download link is invalid")
+	ErrExpiredSignature = errors.New("download link has expired")
+)
+
+// Storage stores objects under slash-separated keys such as
+// "uploads/products/<digest>.jpg".
+type Storage interface {
+	// Put stores data under key, replacing any object already there.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	// Exists reports whether an object is stored under key.
+	Exists(ctx context.Context, key string) (bool, error)
+
+	// Delete removes the object under key. A missing object is not an error.
+	Delete(ctx context.Context, key string) error
+
+	// SignedURL returns a link anyone can download the object from until ttl
+	// has passed.
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// ValidateKey rejects keys that could escape the storage root or that no
+// backend can store.
+func ValidateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+
+	if path.Clean(key) != key {
+		return ErrInvalidKey
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == ".." || segment == "." {
+			return ErrInvalidKey
+		}
+	}
+
+	return nil
+}
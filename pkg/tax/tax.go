@@ -0,0 +1,75 @@
+package tax
+
+// BasisPoints is the denominator of a rate: 1100 basis points is 11%.
+const BasisPoints = 10000
+
+// Rounding decides how a fractional tax amount is turned into whole currency units.
+type Rounding string
+
+const (
+	RoundHalfUp   Rounding = "half_up"
+	RoundHalfEven Rounding = "half_even"
+	RoundUp       Rounding = "up"
+	RoundDown     Rounding = "down"
+)
+
+// Rate is the tax configuration applied to a single order line.
+type Rate struct {
+	Bps       int
+	Inclusive bool
+	Rounding  Rounding
+}
+
+// Breakdown splits a line amount into its net, tax and gross parts.
+type Breakdown struct {
+	Net   int
+	Tax   int
+	Gross int
+}
+
+// Calculate applies rate to amount. For exclusive rates amount is the net
+// price and tax is added on top; for inclusive rates amount is the gross
+// price and the tax already contained in it is extracted.
+func Calculate(amount int, rate Rate) Breakdown {
+	if rate.Bps <= 0 || amount <= 0 {
+		return Breakdown{Net: amount, Gross: amount}
+	}
+
+	a := int64(amount)
+	bps := int64(rate.Bps)
+
+	if rate.Inclusive {
+		tax := divide(a*bps, BasisPoints+bps, rate.Rounding)
+
+		return Breakdown{Net: int(a - tax), Tax: int(tax), Gross: amount}
+	}
+
+	tax := divide(a*bps, BasisPoints, rate.Rounding)
+
+	return Breakdown{Net: amount, Tax: int(tax), Gross: int(a + tax)}
+}
+
+// divide returns num/den rounded according to r. Both operands are non-negative.
+func divide(num, den int64, r Rounding) int64 {
+	q, rem := num/den, num%den
+	if rem == 0 {
+		return q
+	}
+
+	switch r {
+	case RoundDown:
+		return q
+	case RoundUp:
+		return q + 1
+	case RoundHalfEven:
+		if 2*rem > den || (2*rem == den && q%2 == 1) {
+			return q + 1
+		}
+		return q
+	default:
+		if 2*rem >= den {
+			return q + 1
+		}
+		return q
+	}
+}
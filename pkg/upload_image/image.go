@@ -1,15 +1,13 @@
 package upload_image
 
 import (
-	"fmt"
+	"context"
 	"io"
 	"mime/multipart"
-	"net/http"
-	"os"
-	"path/filepath"
-	"pointofsale/internal/domain/response"
+	"path"
+	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
-	"strings"
+	"pointofsale/pkg/storage"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -17,117 +15,202 @@ import (
 )
 
 type ImageUploads interface {
-	EnsureUploadDirectory(uploadDir string) error
-	ProcessImageUpload(c echo.Context, file *multipart.FileHeader) (string, error)
-	CleanupImageOnFailure(imagePath string)
-	SaveUploadedFile(file *multipart.FileHeader, dst string) error
+	ProcessImageUpload(c echo.Context, file *multipart.FileHeader) (*UploadedImage, error)
+	CleanupImageOnFailure(ctx context.Context, image *UploadedImage)
+	ImageURLs(ctx context.Context, imagePath string) (string, map[string]string)
+}
+
+// productUploadDir is the key prefix of product images and their variants.
+const productUploadDir = "uploads/products"
+
+// defaultImageURLTTL is how long signed image links stay valid when no TTL
+// is configured.
+const defaultImageURLTTL = time.Hour
+
+// UploadedImage is an image stored by ProcessImageUpload. New is false when
+// an identical image was already stored, possibly for another product.
+type UploadedImage struct {
+	Path string
+	New  bool
 }
 
 type ImageUpload struct {
 	logger logger.LoggerInterface
+	store  storage.Storage
+	urlTTL time.Duration
 }
 
-func NewImageUpload(logger logger.LoggerInterface) ImageUploads {
-	return &ImageUpload{logger: logger}
+func NewImageUpload(logger logger.LoggerInterface, store storage.Storage, urlTTL time.Duration) ImageUploads {
+	if urlTTL <= 0 {
+		urlTTL = defaultImageURLTTL
+	}
+
+	return &ImageUpload{logger: logger, store: store, urlTTL: urlTTL}
 }
 
-func (h *ImageUpload) EnsureUploadDirectory(uploadDir string) error {
-	if _, err := os.Stat(uploadDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(uploadDir, 0755); err != nil {
-			h.logger.Error("Failed to create upload directory",
-				zap.String("directory", uploadDir),
+// ProcessImageUpload runs an uploaded product image through the pipeline in
+// processImage and stores it together with its variants. Files are named
+// after their content, so uploading the same picture again reuses them.
+func (h *ImageUpload) ProcessImageUpload(c echo.Context, file *multipart.FileHeader) (*UploadedImage, error) {
+	if file.Size > maxImageSize {
+		return nil, errors.NewBadRequestError(ErrImageTooLarge.Error())
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return nil, errors.NewInternalError(err).WithMessage("Failed to read uploaded image")
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(io.LimitReader(src, maxImageSize+1))
+	if err != nil {
+		return nil, errors.NewInternalError(err).WithMessage("Failed to read uploaded image")
+	}
+
+	processed, err := processImage(data)
+	if err != nil {
+		switch err {
+		case ErrImageTooLarge, ErrUnsupportedImage, ErrImageDimensions, ErrCorruptImage:
+			h.logger.Debug("Rejected uploaded image",
+				zap.String("filename", file.Filename),
 				zap.Error(err),
 			)
-			return err
+			return nil, errors.NewBadRequestError(err.Error())
 		}
+
+		h.logger.Error("Failed to process image", zap.Error(err))
+		return nil, errors.NewInternalError(err).WithMessage("Failed to process uploaded image")
 	}
-	return nil
-}
 
-func (h *ImageUpload) ProcessImageUpload(c echo.Context, file *multipart.FileHeader) (string, error) {
-	allowedTypes := map[string]bool{
-		".jpg":  true,
-		".jpeg": true,
-		".png":  true,
+	ctx := c.Request().Context()
+
+	base := path.Join(productUploadDir, processed.Name)
+	uploaded := &UploadedImage{Path: base + processed.Ext}
+
+	exists, err := h.store.Exists(ctx, uploaded.Path)
+	if err != nil {
+		h.logger.Error("Failed to check stored image",
+			zap.String("path", uploaded.Path),
+			zap.Error(err),
+		)
+		return nil, errors.NewInternalError(err).WithMessage("Failed to save uploaded image")
 	}
 
-	ext := strings.ToLower(filepath.Ext(file.Filename))
-	if !allowedTypes[ext] {
-		return "", c.JSON(http.StatusBadRequest, response.ErrorResponse{
-			Status:  "invalid_image_type",
-			Message: "Only JPG, JPEG, and PNG",
-			Code:    http.StatusBadRequest,
-		})
+	if exists {
+		h.logger.Debug("Reusing stored image", zap.String("path", uploaded.Path))
+		return uploaded, nil
 	}
 
-	if file.Size > 5<<20 {
-		return "", c.JSON(http.StatusBadRequest, response.ErrorResponse{
-			Status:  "invalid_image_size",
-			Message: "Image size must be less than 5MB",
-			Code:    http.StatusBadRequest,
-		})
+	contentType := "image/jpeg"
+	if processed.Ext == ".png" {
+		contentType = "image/png"
 	}
 
-	uploadDir := "uploads/products"
-	if err := h.EnsureUploadDirectory(uploadDir); err != nil {
-		return "", c.JSON(http.StatusInternalServerError, response.ErrorResponse{
-			Status:  "server_error",
-			Message: "Failed to prepare storage for upload",
-			Code:    http.StatusInternalServerError,
-		})
+	// Variants go first, so an image whose original exists always has them.
+	objects := make([]string, 0, len(ImageVariants)+1)
+	for _, variant := range ImageVariants {
+		objects = append(objects, variantPath(base, variant.Name, processed.Ext))
 	}
+	objects = append(objects, uploaded.Path)
 
-	filename := fmt.Sprintf("%d%s", time.Now().UnixNano(), ext)
-	imagePath := filepath.Join(uploadDir, filename)
+	for i, key := range objects {
+		data := processed.Data
+		if i < len(ImageVariants) {
+			data = processed.Variants[ImageVariants[i].Name]
+		}
 
-	if err := h.SaveUploadedFile(file, imagePath); err != nil {
-		h.logger.Error("Failed to save image",
-			zap.String("path", imagePath),
-			zap.Error(err),
-		)
-		return "", c.JSON(http.StatusInternalServerError, response.ErrorResponse{
-			Status:  "upload_failed",
-			Message: "Failed to save uploaded image",
-			Code:    http.StatusInternalServerError,
-		})
+		if err := h.store.Put(ctx, key, data, contentType); err != nil {
+			h.logger.Error("Failed to save image",
+				zap.String("path", key),
+				zap.Error(err),
+			)
+
+			h.removeObjects(ctx, objects[:i])
+			return nil, errors.NewInternalError(err).WithMessage("Failed to save uploaded image")
+		}
 	}
 
-	h.logger.Debug("Successfully saved uploaded file",
-		zap.String("path", imagePath),
-		zap.Int64("size", file.Size),
+	uploaded.New = true
+
+	h.logger.Debug("Successfully saved uploaded image",
+		zap.String("path", uploaded.Path),
+		zap.Int64("upload_size", file.Size),
+		zap.Int("stored_size", len(processed.Data)),
 	)
 
-	return imagePath, nil
+	return uploaded, nil
 }
 
-func (h *ImageUpload) CleanupImageOnFailure(imagePath string) {
-	if removeErr := os.Remove(imagePath); removeErr != nil {
-		h.logger.Debug("Failed to clean up uploaded file after failure",
-			zap.String("path", imagePath),
-			zap.Error(removeErr),
+// CleanupImageOnFailure removes an image stored for a request that failed.
+// Images that were already stored before the request are kept, since they
+// may be in use by another product.
+func (h *ImageUpload) CleanupImageOnFailure(ctx context.Context, image *UploadedImage) {
+	if image == nil || !image.New {
+		return
+	}
+
+	if err := RemoveImage(ctx, h.store, image.Path); err != nil {
+		h.logger.Debug("Failed to clean up uploaded image after failure",
+			zap.String("path", image.Path),
+			zap.Error(err),
 		)
 	}
 }
 
-func (h *ImageUpload) SaveUploadedFile(file *multipart.FileHeader, dst string) error {
-	src, err := file.Open()
-	if err != nil {
-		return fmt.Errorf("failed to open uploaded file: %w", err)
+// ImageURLs returns signed download links for an image and its variants.
+// Links that cannot be signed are left out.
+func (h *ImageUpload) ImageURLs(ctx context.Context, imagePath string) (string, map[string]string) {
+	if imagePath == "" {
+		return "", nil
 	}
-	defer src.Close()
 
-	out, err := os.Create(dst)
+	imageURL, err := h.store.SignedURL(ctx, imagePath, h.urlTTL)
 	if err != nil {
-		return fmt.Errorf("failed to create destination file: %w", err)
+		h.logger.Debug("Failed to sign image URL",
+			zap.String("path", imagePath),
+			zap.Error(err),
+		)
+		return "", nil
 	}
-	defer out.Close()
 
-	if _, err = io.Copy(out, src); err != nil {
-		return fmt.Errorf("failed to copy file contents: %w", err)
+	variants := ImageVariantPaths(imagePath)
+	if variants == nil {
+		return imageURL, nil
 	}
 
-	if stat, err := os.Stat(dst); err != nil || stat.Size() == 0 {
-		return fmt.Errorf("failed to verify file write: %w", err)
+	variantURLs := make(map[string]string, len(variants))
+	for name, variantPath := range variants {
+		if signed, err := h.store.SignedURL(ctx, variantPath, h.urlTTL); err == nil {
+			variantURLs[name] = signed
+		}
+	}
+
+	return imageURL, variantURLs
+}
+
+func (h *ImageUpload) removeObjects(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := h.store.Delete(ctx, key); err != nil {
+			h.logger.Debug("Failed to remove partially stored image",
+				zap.String("path", key),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// RemoveImage deletes an image and its variants from store. Callers must make
+// sure no product still refers to it.
+func RemoveImage(ctx context.Context, store storage.Storage, imagePath string) error {
+	keys := []string{imagePath}
+	for _, variantPath := range ImageVariantPaths(imagePath) {
+		keys = append(keys, variantPath)
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,310 @@
+package upload_image
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	// maxImageSize is the largest upload accepted, in bytes.
+	maxImageSize = 5 << 20
+
+	// maxImagePixels guards against small files that decode to huge bitmaps.
+	maxImagePixels = 25_000_000
+
+	jpegQuality = 85
+)
+
+// ImageVariant is a downscaled copy stored next to every processed image.
+// The image is fitted inside a MaxSize square and never enlarged.
+type ImageVariant struct {
+	Name    string
+	MaxSize int
+}
+
+// ImageVariants are the copies built for the POS product grid and detail
+// views, smallest first.
+var ImageVariants = []ImageVariant{
+	{Name: "thumb", MaxSize: 150},
+	{Name: "small", MaxSize: 320},
+	{Name: "medium", MaxSize: 800},
+}
+
+var (
+	ErrUnsupportedImage = errors.New("only JPEG and PNG images are supported")
+	ErrImageTooLarge    = errors.New("image size must be less than 5MB")
+	ErrImageDimensions  = errors.New("image dimensions are too large")
+	ErrCorruptImage     = errors.New("image could not be decoded")
+)
+
+// contentAddressed matches the file names the pipeline stores, a SHA-256
+// digest of the processed image.
+var contentAddressed = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// processedImage is an upload after decoding and re-encoding, ready to be
+// stored under Name.
+type processedImage struct {
+	Name     string
+	Ext      string
+	Data     []byte
+	Variants map[string][]byte
+}
+
+// processImage sniffs the real type of data, decodes it, applies the EXIF
+// orientation and encodes it again, which drops EXIF, GPS and every other
+// kind of metadata. The result is named after its own digest so identical
+// uploads end up in the same file.
+func processImage(data []byte) (*processedImage, error) {
+	if len(data) > maxImageSize {
+		return nil, ErrImageTooLarge
+	}
+
+	var ext string
+
+	switch http.DetectContentType(data) {
+	case "image/jpeg":
+		ext = ".jpg"
+	case "image/png":
+		ext = ".png"
+	default:
+		return nil, ErrUnsupportedImage
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+
+	if config.Width*config.Height > maxImagePixels {
+		return nil, ErrImageDimensions
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorruptImage
+	}
+
+	if ext == ".jpg" {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	encoded, err := encodeImage(img, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	processed := &processedImage{
+		Name:     hex.EncodeToString(sum[:]),
+		Ext:      ext,
+		Data:     encoded,
+		Variants: make(map[string][]byte, len(ImageVariants)),
+	}
+
+	for _, variant := range ImageVariants {
+		scaled, err := encodeImage(fit(img, variant.MaxSize), ext)
+		if err != nil {
+			return nil, err
+		}
+
+		processed.Variants[variant.Name] = scaled
+	}
+
+	return processed, nil
+}
+
+// ImageVariantPaths returns the path of every variant of an image stored by
+// the pipeline, keyed by variant name. Images uploaded before the pipeline
+// existed have no variants and yield nil.
+func ImageVariantPaths(imagePath string) map[string]string {
+	ext := filepath.Ext(imagePath)
+	name := strings.TrimSuffix(filepath.Base(imagePath), ext)
+
+	if !contentAddressed.MatchString(name) {
+		return nil
+	}
+
+	base := strings.TrimSuffix(imagePath, ext)
+	paths := make(map[string]string, len(ImageVariants))
+
+	for _, variant := range ImageVariants {
+		paths[variant.Name] = variantPath(base, variant.Name, ext)
+	}
+
+	return paths
+}
+
+func variantPath(base, variant, ext string) string {
+	return base + "_" + variant + ext
+}
+
+func encodeImage(img image.Image, ext string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var err error
+	if ext == ".png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// fit scales img down to fit inside a size by size square.
+func fit(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	if w <= size && h <= size {
+		return img
+	}
+
+	if w >= h {
+		h = max(1, h*size/w)
+		w = size
+	} else {
+		w = max(1, w*size/h)
+		h = size
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+
+	return dst
+}
+
+// orient turns img upright according to an EXIF orientation value, which
+// would otherwise be lost together with the rest of the metadata.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+
+			dst.Set(dx, dy, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+
+	return dst
+}
+
+// jpegOrientation reads the EXIF orientation tag of a JPEG file and returns
+// 1, upright, when there is none.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+
+		marker := data[i+1]
+
+		// EXIF sits in the header; the image data starts at SOS.
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+
+		segment := data[i+4 : i+2+size]
+
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+
+		i += 2 + size
+	}
+
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,274 @@
+package upload_image
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	red  = color.NRGBA{R: 255, A: 255}
+	blue = color.NRGBA{B: 255, A: 255}
+)
+
+// halves returns a w by h image, red on the left and blue on the right.
+func halves(w, h int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+
+	return buf.Bytes()
+}
+
+// exifTIFF builds a big-endian TIFF header with a single IFD holding the
+// orientation tag.
+func exifTIFF(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a")
+	tiff = binary.BigEndian.AppendUint32(tiff, 8)
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+
+	// tag, type SHORT, count 1, value padded to four bytes
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0)
+
+	return binary.BigEndian.AppendUint32(tiff, 0)
+}
+
+// withAPP1 inserts an APP1 segment holding payload right after the SOI
+// marker of a JPEG file.
+func withAPP1(jpg, payload []byte) []byte {
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, segment...)
+
+	return append(out, jpg[2:]...)
+}
+
+func exifPayload(orientation uint16) []byte {
+	return append([]byte("Exif\x00\x00"), exifTIFF(orientation)...)
+}
+
+// hasSegment reports whether a JPEG file has a segment with the given marker
+// before its image data.
+func hasSegment(data []byte, marker byte) bool {
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF || data[i+1] == 0xDA {
+			return false
+		}
+
+		if data[i+1] == marker {
+			return true
+		}
+
+		i += 2 + int(binary.BigEndian.Uint16(data[i+2:]))
+	}
+
+	return false
+}
+
+func isRed(c color.Color) bool {
+	r, _, b, _ := c.RGBA()
+	return r > 0xC000 && b < 0x4000
+}
+
+func isBlue(c color.Color) bool {
+	r, _, b, _ := c.RGBA()
+	return b > 0xC000 && r < 0x4000
+}
+
+func TestProcessImageOrientation(t *testing.T) {
+	const w, h = 64, 32
+
+	plain := encodeJPEG(t, halves(w, h))
+
+	cases := []struct {
+		name          string
+		data          []byte
+		width, height int
+		// colour at the top-left and bottom-left corner of the output
+		top, bottom func(color.Color) bool
+	}{
+		{"no exif", plain, w, h, isRed, isRed},
+		{"upright", withAPP1(plain, exifPayload(1)), w, h, isRed, isRed},
+		// Rotated 90° clockwise: the red left half ends up on top.
+		{"orientation 6", withAPP1(plain, exifPayload(6)), h, w, isRed, isBlue},
+		// Rotated 90° counter-clockwise: the red left half ends up below.
+		{"orientation 8", withAPP1(plain, exifPayload(8)), h, w, isBlue, isRed},
+		{"garbage app1", withAPP1(plain, []byte("Exif\x00\x00MM\xff")), w, h, isRed, isRed},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			processed, err := processImage(tc.data)
+			require.NoError(t, err)
+			assert.Equal(t, ".jpg", processed.Ext)
+
+			assert.False(t, hasSegment(processed.Data, 0xE1), "output still has an APP1 segment")
+			assert.NotContains(t, string(processed.Data), "Exif")
+			assert.Equal(t, 1, jpegOrientation(processed.Data))
+
+			img, err := jpeg.Decode(bytes.NewReader(processed.Data))
+			require.NoError(t, err)
+
+			b := img.Bounds()
+			assert.Equal(t, tc.width, b.Dx())
+			assert.Equal(t, tc.height, b.Dy())
+
+			assert.True(t, tc.top(img.At(b.Min.X+2, b.Min.Y+2)), "top-left is %v", img.At(b.Min.X+2, b.Min.Y+2))
+			assert.True(t, tc.bottom(img.At(b.Min.X+2, b.Max.Y-3)), "bottom-left is %v", img.At(b.Min.X+2, b.Max.Y-3))
+		})
+	}
+}
+
+// pngWithSize encodes a tiny PNG and rewrites its IHDR to claim the given
+// dimensions, so the pixel limit can be tested without building the bitmap.
+func pngWithSize(t *testing.T, width, height uint32) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 1, 1))))
+
+	data := buf.Bytes()
+
+	// signature (8), length (4), "IHDR" (4), then width and height
+	binary.BigEndian.PutUint32(data[16:], width)
+	binary.BigEndian.PutUint32(data[20:], height)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+	return data
+}
+
+func TestProcessImageRejects(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"too many pixels", pngWithSize(t, 5001, 5000), ErrImageDimensions},
+		{"too wide", pngWithSize(t, 1_000_000, 30), ErrImageDimensions},
+		{"too large", append(pngWithSize(t, 1, 1), make([]byte, maxImageSize)...), ErrImageTooLarge},
+		{"not an image", []byte("GIF89a not really"), ErrUnsupportedImage},
+		{"truncated jpeg", []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00}, ErrCorruptImage},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := processImage(tc.data)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	soi := []byte{0xFF, 0xD8}
+
+	app1 := func(payload []byte) []byte {
+		return withAPP1(append(soi, 0xFF, 0xD9), payload)
+	}
+
+	littleEndian := []byte("Exif\x00\x00II\x2a\x00\x08\x00\x00\x00\x01\x00\x12\x01\x03\x00\x01\x00\x00\x00\x08\x00\x00\x00")
+
+	cases := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"big endian", app1(exifPayload(6)), 6},
+		{"little endian", app1(littleEndian), 8},
+		{"empty", nil, 1},
+		{"not a jpeg", []byte("\x89PNG\r\n\x1a\n"), 1},
+		{"soi only", soi, 1},
+		{"no app1", append(soi, 0xFF, 0xD9), 1},
+		{"segment past end", append(soi, 0xFF, 0xE1, 0xFF, 0xFF, 'E', 'x'), 1},
+		{"segment size below two", append(soi, 0xFF, 0xE1, 0x00, 0x01, 0x00), 1},
+		{"no marker", append(soi, 0x00, 0xE1, 0x00, 0x10), 1},
+		{"not exif", app1([]byte("http://ns.adobe.com/xap/1.0/\x00")), 1},
+		{"truncated tiff", app1([]byte("Exif\x00\x00MM\x00")), 1},
+		{"unknown byte order", app1(append([]byte("Exif\x00\x00XX"), exifTIFF(6)[2:]...)), 1},
+		{"ifd offset past end", app1([]byte("Exif\x00\x00MM\x00\x2a\xff\xff\xff\xf0")), 1},
+		{"ifd offset inside header", app1([]byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x04")), 1},
+		{"entries past end", app1([]byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08\xff\xff\x01\x12")), 1},
+		{"garbage", app1([]byte("Exif\x00\x00\xde\xad\xbe\xef\xde\xad\xbe\xef")), 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				assert.Equal(t, tc.want, jpegOrientation(tc.data))
+			})
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// 0 1
+	// 2 3
+	// 4 5
+	src := image.NewGray(image.Rect(0, 0, 2, 3))
+	for i := range src.Pix {
+		src.Pix[i] = uint8(i)
+	}
+
+	cases := []struct {
+		orientation int
+		want        [][]uint8
+	}{
+		{1, [][]uint8{{0, 1}, {2, 3}, {4, 5}}},
+		{2, [][]uint8{{1, 0}, {3, 2}, {5, 4}}},
+		{3, [][]uint8{{5, 4}, {3, 2}, {1, 0}}},
+		{4, [][]uint8{{4, 5}, {2, 3}, {0, 1}}},
+		{5, [][]uint8{{0, 2, 4}, {1, 3, 5}}},
+		{6, [][]uint8{{4, 2, 0}, {5, 3, 1}}},
+		{7, [][]uint8{{5, 3, 1}, {4, 2, 0}}},
+		{8, [][]uint8{{1, 3, 5}, {0, 2, 4}}},
+		{9, [][]uint8{{0, 1}, {2, 3}, {4, 5}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(rune('0'+tc.orientation)), func(t *testing.T) {
+			img := orient(src, tc.orientation)
+
+			b := img.Bounds()
+			require.Equal(t, len(tc.want), b.Dy())
+			require.Equal(t, len(tc.want[0]), b.Dx())
+
+			for y, row := range tc.want {
+				for x, want := range row {
+					got := color.GrayModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.Gray).Y
+					assert.Equal(t, want, got, "pixel (%d, %d)", x, y)
+				}
+			}
+		})
+	}
+}
@@ -1,29 +1,122 @@
 package utils
 
 import (
-	"strconv"
+	"errors"
+	"math/rand/v2"
 	"strings"
-	"time"
-	"unicode"
+)
+
+// Barcode formats products can be numbered in.
+const (
+	BarcodeFormatEAN13 = "ean13"
+	BarcodeFormatUPCA  = "upca"
+)
+
+// Prefixes used when a merchant has no GS1 company prefix of its own. Both
+// are reserved by GS1 for numbers that are only meaningful inside a store.
+const (
+	defaultEAN13Prefix = "20"
+	defaultUPCAPrefix  = "4"
+)
 
-	"math/rand"
+var (
+	ErrInvalidBarcode       = errors.New("barcode must be a valid EAN-8, UPC-A or EAN-13 code")
+	ErrInvalidBarcodeFormat = errors.New("barcode format must be ean13 or upca")
+	ErrInvalidBarcodePrefix = errors.New("barcode prefix does not fit the barcode format")
 )
 
-func GenerateBarcode(name string) string {
-	var initials []rune
-	for _, word := range strings.Fields(name) {
-		if len(word) > 0 {
-			initials = append(initials, unicode.ToUpper(rune(word[0])))
+// GenerateBarcode returns a random EAN-13 or UPC-A code that starts with
+// prefix and ends in a valid check digit. An empty prefix falls back to the
+// GS1 in-store range. A UPC-A code is an EAN-13 code starting with 0, so a
+// GS1 prefix in its 13-digit form must start with 0 to be used for UPC-A.
+func GenerateBarcode(format, prefix string) (string, error) {
+	length := 13
+
+	switch format {
+	case BarcodeFormatEAN13:
+		if prefix == "" {
+			prefix = defaultEAN13Prefix
+		}
+	case BarcodeFormatUPCA:
+		length = 12
+
+		if prefix == "" {
+			prefix = defaultUPCAPrefix
+		} else {
+			trimmed, ok := strings.CutPrefix(prefix, "0")
+			if !ok {
+				return "", ErrInvalidBarcodePrefix
+			}
+			prefix = trimmed
 		}
+	default:
+		return "", ErrInvalidBarcodeFormat
 	}
-	if len(initials) == 0 {
-		initials = []rune{'P', 'R', 'D'}
+
+	if !isDigits(prefix) || len(prefix) >= length-1 {
+		return "", ErrInvalidBarcodePrefix
 	}
 
-	src := rand.NewSource(time.Now().UnixNano())
-	r := rand.New(src)
+	var b strings.Builder
+	b.Grow(length)
+	b.WriteString(prefix)
 
-	numbers := r.Intn(900000) + 100000
+	for b.Len() < length-1 {
+		b.WriteByte(byte('0' + rand.IntN(10)))
+	}
+
+	payload := b.String()
+
+	return payload + string(byte('0'+BarcodeCheckDigit(payload))), nil
+}
+
+// ValidateBarcode reports whether code is an EAN-8, UPC-A or EAN-13 code
+// with a correct check digit.
+func ValidateBarcode(code string) error {
+	switch len(code) {
+	case 8, 12, 13:
+	default:
+		return ErrInvalidBarcode
+	}
+
+	if !isDigits(code) {
+		return ErrInvalidBarcode
+	}
+
+	payload, check := code[:len(code)-1], int(code[len(code)-1]-'0')
+
+	if BarcodeCheckDigit(payload) != check {
+		return ErrInvalidBarcode
+	}
+
+	return nil
+}
+
+// BarcodeCheckDigit computes the GS1 mod-10 check digit for payload, the
+// code without its last digit. Digits are weighted 3 and 1 alternately from
+// the right, which makes the same rule work for every GTIN length.
+func BarcodeCheckDigit(payload string) int {
+	sum := 0
+
+	for i := len(payload) - 1; i >= 0; i-- {
+		digit := int(payload[i] - '0')
+
+		if (len(payload)-1-i)%2 == 0 {
+			digit *= 3
+		}
+
+		sum += digit
+	}
+
+	return (10 - sum%10) % 10
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
 
-	return string(initials) + strconv.Itoa(numbers)
+	return true
 }
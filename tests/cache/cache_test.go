@@ -0,0 +1,471 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"pointofsale/internal/cache"
+	"pointofsale/pkg/auth"
+	"pointofsale/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// countingMetrics counts the cache outcomes the tests assert on.
+type countingMetrics struct {
+	hits, misses, staleServes, coalescedLoads atomic.Int64
+	localHits, localMisses                    atomic.Int64
+}
+
+func (m *countingMetrics) RecordCacheHit(ctx context.Context, key string)  { m.hits.Add(1) }
+func (m *countingMetrics) RecordCacheMiss(ctx context.Context, key string) { m.misses.Add(1) }
+func (m *countingMetrics) RecordCacheSet(ctx context.Context, key string, success bool) {
+}
+func (m *countingMetrics) RecordCacheDelete(ctx context.Context, key string, success bool) {
+}
+func (m *countingMetrics) RecordCacheOperationLatency(ctx context.Context, operation string, duration time.Duration) {
+}
+func (m *countingMetrics) RecordCacheError(ctx context.Context, operation, key string, err error) {
+}
+func (m *countingMetrics) RecordCacheStaleServe(ctx context.Context, key string) {
+	m.staleServes.Add(1)
+}
+func (m *countingMetrics) RecordCacheCoalescedLoad(ctx context.Context, key string) {
+	m.coalescedLoads.Add(1)
+}
+func (m *countingMetrics) RecordLocalCacheHit(ctx context.Context, namespace string) {
+	m.localHits.Add(1)
+}
+func (m *countingMetrics) RecordLocalCacheMiss(ctx context.Context, namespace string) {
+	m.localMisses.Add(1)
+}
+
+type CacheStoreTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	container *tcredis.RedisContainer
+	client    *redis.Client
+	log       logger.LoggerInterface
+	metrics   *countingMetrics
+	store     *cache.CacheStore
+}
+
+func (s *CacheStoreTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	container, err := tcredis.Run(s.ctx, "redis:7-alpine")
+	s.Require().NoError(err)
+	s.container = container
+
+	url, err := container.ConnectionString(s.ctx)
+	s.Require().NoError(err)
+
+	opts, err := redis.ParseURL(url)
+	s.Require().NoError(err)
+	s.client = redis.NewClient(opts)
+
+	logger.ResetInstance()
+	log, err := logger.NewLogger("test-cache", sdklog.NewLoggerProvider())
+	s.Require().NoError(err)
+	s.log = log
+}
+
+func (s *CacheStoreTestSuite) TearDownSuite() {
+	if s.client != nil {
+		s.client.Close()
+	}
+	if s.container != nil {
+		_ = s.container.Terminate(s.ctx)
+	}
+}
+
+func (s *CacheStoreTestSuite) SetupTest() {
+	s.Require().NoError(s.client.FlushDB(s.ctx).Err())
+	s.metrics = &countingMetrics{}
+	s.store = s.newStore()
+}
+
+// newStore returns a store with its own in-process state, as another replica
+// sharing the same Redis would have.
+func (s *CacheStoreTestSuite) newStore() *cache.CacheStore {
+	return cache.NewCacheStore(s.client, s.log, s.metrics)
+}
+
+func (s *CacheStoreTestSuite) TestTagsInvalidateEntries() {
+	orderTag := cache.EntityTag("order")
+	merchantTag := cache.MerchantTag(7)
+	page := []int{1, 2, 3}
+
+	cache.SetToCache(s.ctx, s.store, "order:all", &page, time.Minute, orderTag)
+	cache.SetToCache(s.ctx, s.store, "order:merchant:7", &page, time.Minute, orderTag, merchantTag)
+	cache.SetToCache(s.ctx, s.store, "order:merchant:8", &page, time.Minute, orderTag, cache.MerchantTag(8))
+
+	got, found := cache.GetFromCache[[]int](s.ctx, s.store, "order:all", orderTag)
+	s.True(found)
+	s.Equal(page, got)
+
+	// 1. A merchant tag only affects that merchant's entries
+	cache.InvalidateTags(s.ctx, s.store, merchantTag)
+
+	_, found = cache.GetFromCache[[]int](s.ctx, s.store, "order:merchant:7", orderTag, merchantTag)
+	s.False(found)
+	_, found = cache.GetFromCache[[]int](s.ctx, s.store, "order:merchant:8", orderTag, cache.MerchantTag(8))
+	s.True(found)
+
+	// 2. An entity tag affects every entry of the entity at once
+	cache.InvalidateTags(s.ctx, s.store, orderTag)
+
+	_, found = cache.GetFromCache[[]int](s.ctx, s.store, "order:all", orderTag)
+	s.False(found)
+	_, found = cache.GetFromCache[[]int](s.ctx, s.store, "order:merchant:8", orderTag, cache.MerchantTag(8))
+	s.False(found)
+
+	// 3. Entries written after the bump are valid again
+	cache.SetToCache(s.ctx, s.store, "order:all", &page, time.Minute, orderTag)
+	_, found = cache.GetFromCache[[]int](s.ctx, s.store, "order:all", orderTag)
+	s.True(found)
+}
+
+func (s *CacheStoreTestSuite) TestGetOrLoadCoalescesMisses() {
+	var loads atomic.Int64
+	load := func(ctx context.Context) ([]int, error) {
+		loads.Add(1)
+		time.Sleep(200 * time.Millisecond)
+		return []int{42}, nil
+	}
+
+	opts := cache.LoadOptions{TTL: time.Minute}
+	replicas := []*cache.CacheStore{s.store, s.newStore()}
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func(store *cache.CacheStore) {
+			defer wg.Done()
+
+			got, err := cache.GetOrLoad(s.ctx, store, "stats:hot", opts, load)
+			s.NoError(err)
+			s.Equal([]int{42}, got)
+		}(replicas[i%len(replicas)])
+	}
+	wg.Wait()
+
+	// Both replicas missed together, but only one of them hit the database
+	s.Equal(int64(1), loads.Load())
+	s.Equal(int64(19), s.metrics.coalescedLoads.Load())
+
+	got, err := cache.GetOrLoad(s.ctx, s.store, "stats:hot", opts, load)
+	s.NoError(err)
+	s.Equal([]int{42}, got)
+	s.Equal(int64(1), loads.Load())
+	s.Equal(int64(1), s.metrics.hits.Load())
+}
+
+func (s *CacheStoreTestSuite) TestGetOrLoadServesStaleWhileRefreshing() {
+	var loads atomic.Int64
+	load := func(ctx context.Context) (int64, error) {
+		time.Sleep(100 * time.Millisecond)
+		return loads.Add(1), nil
+	}
+
+	opts := cache.LoadOptions{TTL: 200 * time.Millisecond, StaleTTL: time.Minute}
+
+	got, err := cache.GetOrLoad(s.ctx, s.store, "stats:stale", opts, load)
+	s.Require().NoError(err)
+	s.Equal(int64(1), got)
+
+	time.Sleep(300 * time.Millisecond)
+
+	// 1. Expired values are served at once while one refresh runs
+	for range 5 {
+		got, err = cache.GetOrLoad(s.ctx, s.store, "stats:stale", opts, load)
+		s.Require().NoError(err)
+		s.Equal(int64(1), got)
+	}
+	s.Equal(int64(5), s.metrics.staleServes.Load())
+
+	// 2. The refreshed value replaces it
+	s.Eventually(func() bool {
+		got, err := cache.GetOrLoad(s.ctx, s.store, "stats:stale", opts, load)
+		return err == nil && got == 2
+	}, 2*time.Second, 20*time.Millisecond)
+	s.Equal(int64(2), loads.Load())
+}
+
+func (s *CacheStoreTestSuite) TestGetOrLoadDoesNotServeInvalidatedValues() {
+	tag := cache.EntityTag("transaction")
+
+	var loads atomic.Int64
+	load := func(ctx context.Context) (int64, error) {
+		return loads.Add(1), nil
+	}
+
+	opts := cache.LoadOptions{TTL: time.Minute, StaleTTL: time.Minute, Tags: []string{tag}}
+
+	got, err := cache.GetOrLoad(s.ctx, s.store, "stats:tagged", opts, load)
+	s.Require().NoError(err)
+	s.Equal(int64(1), got)
+
+	cache.InvalidateTags(s.ctx, s.store, tag)
+
+	got, err = cache.GetOrLoad(s.ctx, s.store, "stats:tagged", opts, load)
+	s.Require().NoError(err)
+	s.Equal(int64(2), got)
+	s.Zero(s.metrics.staleServes.Load())
+}
+
+func (s *CacheStoreTestSuite) TestGetOrLoadCachesNotFound() {
+	errNotFound := errors.New("not found")
+
+	var loads atomic.Int64
+	load := func(ctx context.Context) (*int, error) {
+		loads.Add(1)
+		return nil, errNotFound
+	}
+
+	opts := cache.LoadOptions{TTL: time.Minute, NotFound: errNotFound, NegativeTTL: time.Minute}
+
+	for range 3 {
+		_, err := cache.GetOrLoad(s.ctx, s.store, "product:barcode:missing", opts, load)
+		s.ErrorIs(err, errNotFound)
+	}
+	s.Equal(int64(1), loads.Load())
+
+	// Other errors are never cached
+	errDown := errors.New("database down")
+	failing := func(ctx context.Context) (*int, error) {
+		loads.Add(1)
+		return nil, errDown
+	}
+
+	for range 2 {
+		_, err := cache.GetOrLoad(s.ctx, s.store, "product:barcode:flaky", opts, failing)
+		s.ErrorIs(err, errDown)
+	}
+	s.Equal(int64(3), loads.Load())
+}
+
+func (s *CacheStoreTestSuite) TestLocalTierServesHotKeysInProcess() {
+	tag := cache.EntityTag("role")
+	s.store.UseLocalTier("role", cache.LocalTierOptions{MaxEntries: 2, TTL: time.Minute})
+
+	for _, key := range []string{"role:id:1", "role:id:2", "role:id:3"} {
+		cache.SetToCache(s.ctx, s.store, key, &key, time.Minute, tag)
+		_, found := cache.GetFromCache[string](s.ctx, s.store, key, tag)
+		s.Require().True(found)
+	}
+	s.Require().NoError(s.client.FlushDB(s.ctx).Err())
+
+	// 1. The two most recent keys are still served without Redis
+	for _, key := range []string{"role:id:2", "role:id:3"} {
+		got, found := cache.GetFromCache[string](s.ctx, s.store, key, tag)
+		s.True(found)
+		s.Equal(key, got)
+	}
+	s.Equal(int64(2), s.metrics.localHits.Load())
+
+	// 2. The least recently used one was evicted
+	_, found := cache.GetFromCache[string](s.ctx, s.store, "role:id:1", tag)
+	s.False(found)
+
+	// 3. Namespaces without a tier always go to Redis
+	value := "x"
+	cache.SetToCache(s.ctx, s.store, "order:id:1", &value, time.Minute)
+	cache.GetFromCache[string](s.ctx, s.store, "order:id:1")
+	s.Require().NoError(s.client.FlushDB(s.ctx).Err())
+	_, found = cache.GetFromCache[string](s.ctx, s.store, "order:id:1")
+	s.False(found)
+}
+
+func (s *CacheStoreTestSuite) TestLocalTierFollowsWritesOfOtherReplicas() {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	tag := cache.EntityTag("category")
+	opts := cache.LocalTierOptions{MaxEntries: 100, TTL: time.Minute}
+
+	writer, reader := s.store, s.newStore()
+	reader.UseLocalTier("category", opts)
+	done := reader.ListenForInvalidations(ctx)
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	page := []int{1}
+	cache.SetToCache(s.ctx, writer, "category:all", &page, time.Minute, tag)
+	cache.SetToCache(s.ctx, writer, "category:id:1", &page, time.Minute, tag)
+
+	for _, key := range []string{"category:all", "category:id:1"} {
+		_, found := cache.GetFromCache[[]int](s.ctx, reader, key, tag)
+		s.Require().True(found)
+	}
+
+	// 1. A tag bump on another replica drops the local copies
+	page = []int{1, 2}
+	cache.InvalidateTags(s.ctx, writer, tag)
+	cache.SetToCache(s.ctx, writer, "category:all", &page, time.Minute, tag)
+
+	s.Eventually(func() bool {
+		got, found := cache.GetFromCache[[]int](s.ctx, reader, "category:all", tag)
+		return found && len(got) == 2
+	}, 2*time.Second, 20*time.Millisecond)
+
+	// 2. So does a delete of a single key
+	cache.SetToCache(s.ctx, writer, "category:id:1", &page, time.Minute, tag)
+	_, found := cache.GetFromCache[[]int](s.ctx, reader, "category:id:1", tag)
+	s.Require().True(found)
+
+	cache.DeleteFromCache(s.ctx, writer, "category:id:1")
+
+	s.Eventually(func() bool {
+		_, found := cache.GetFromCache[[]int](s.ctx, reader, "category:id:1", tag)
+		return !found
+	}, 2*time.Second, 20*time.Millisecond)
+}
+
+func (s *CacheStoreTestSuite) TestPrefixKeepsApplicationsApart() {
+	gateway := cache.NewCacheStoreWithPrefix(s.client, "pointofsale:gateway:", s.log, s.metrics)
+	s.store.RegisterNamespace("order", cache.NamespaceOptions{})
+	gateway.RegisterNamespace("order", cache.NamespaceOptions{})
+
+	server, client := "server", "gateway"
+	cache.SetToCache(s.ctx, s.store, "order:id:1", &server, time.Minute)
+	cache.SetToCache(s.ctx, gateway, "order:id:1", &client, time.Minute)
+	s.Require().NoError(s.client.Set(s.ctx, "order:id:1", "another application", time.Minute).Err())
+
+	// 1. The same key stays apart under each prefix
+	got, found := cache.GetFromCache[string](s.ctx, s.store, "order:id:1")
+	s.Require().True(found)
+	s.Equal("server", got)
+
+	got, found = cache.GetFromCache[string](s.ctx, gateway, "order:id:1")
+	s.Require().True(found)
+	s.Equal("gateway", got)
+
+	// 2. Flushing one application leaves the others alone
+	deleted, err := s.store.FlushNamespace(s.ctx, "order")
+	s.Require().NoError(err)
+	s.Equal(int64(1), deleted)
+
+	_, found = cache.GetFromCache[string](s.ctx, s.store, "order:id:1")
+	s.False(found)
+	_, found = cache.GetFromCache[string](s.ctx, gateway, "order:id:1")
+	s.True(found)
+	s.Equal("another application", s.client.Get(s.ctx, "order:id:1").Val())
+}
+
+func (s *CacheStoreTestSuite) TestTenantNamespacesArePartitionedByCaller() {
+	s.store.RegisterNamespace("order", cache.NamespaceOptions{Tenant: true})
+	orderTag := cache.EntityTag("order")
+
+	merchantA := auth.WithMerchantScope(s.ctx, []int{1})
+	merchantB := auth.WithMerchantScope(s.ctx, []int{2})
+	gatewayUser := auth.WithUserID(s.ctx, 42)
+
+	pageA, pageAll := []int{1}, []int{1, 2}
+	cache.SetToCache(merchantA, s.store, "order:all", &pageA, time.Minute, orderTag)
+	cache.SetToCache(s.ctx, s.store, "order:all", &pageAll, time.Minute, orderTag)
+
+	// 1. Each caller only reads what was cached for it
+	got, found := cache.GetFromCache[[]int](merchantA, s.store, "order:all", orderTag)
+	s.Require().True(found)
+	s.Equal(pageA, got)
+
+	_, found = cache.GetFromCache[[]int](merchantB, s.store, "order:all", orderTag)
+	s.False(found)
+	_, found = cache.GetFromCache[[]int](gatewayUser, s.store, "order:all", orderTag)
+	s.False(found)
+
+	got, found = cache.GetFromCache[[]int](s.ctx, s.store, "order:all", orderTag)
+	s.Require().True(found)
+	s.Equal(pageAll, got)
+
+	// 2. Tags still reach every partition
+	cache.InvalidateTags(s.ctx, s.store, orderTag)
+
+	_, found = cache.GetFromCache[[]int](merchantA, s.store, "order:all", orderTag)
+	s.False(found)
+	_, found = cache.GetFromCache[[]int](s.ctx, s.store, "order:all", orderTag)
+	s.False(found)
+}
+
+func (s *CacheStoreTestSuite) TestFlushNamespaceReachesEveryReplica() {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	opts := cache.LocalTierOptions{MaxEntries: 100, TTL: time.Minute}
+
+	admin, reader := s.store, s.newStore()
+	admin.UseLocalTier("merchant", opts)
+	reader.UseLocalTier("merchant", opts)
+	done := reader.ListenForInvalidations(ctx)
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	for _, key := range []string{"merchant:id:1", "merchant:id:2", "merchant:all"} {
+		cache.SetToCache(s.ctx, admin, key, &key, time.Minute)
+		_, found := cache.GetFromCache[string](s.ctx, reader, key)
+		s.Require().True(found)
+	}
+
+	stats, err := admin.InspectNamespace(s.ctx, "merchant")
+	s.Require().NoError(err)
+	s.Equal(int64(3), stats.Keys)
+	s.True(stats.LocalTier)
+
+	// 1. Every key goes, including the copies held in process elsewhere
+	deleted, err := admin.FlushNamespace(s.ctx, "merchant")
+	s.Require().NoError(err)
+	s.Equal(int64(3), deleted)
+
+	s.Eventually(func() bool {
+		stats, err := reader.InspectNamespace(s.ctx, "merchant")
+		return err == nil && stats.Keys == 0 && stats.LocalEntries == 0
+	}, 2*time.Second, 20*time.Millisecond)
+
+	_, found := cache.GetFromCache[string](s.ctx, reader, "merchant:id:1")
+	s.False(found)
+
+	// 2. Namespaces that hold state, or that were never registered, are refused
+	admin.RegisterNamespace("auth", cache.NamespaceOptions{Durable: true})
+	_, err = admin.FlushNamespace(s.ctx, "auth")
+	s.ErrorIs(err, cache.ErrDurableNamespace)
+
+	_, err = admin.FlushNamespace(s.ctx, "unknown")
+	s.ErrorIs(err, cache.ErrUnknownNamespace)
+}
+
+func (s *CacheStoreTestSuite) TestPruneNamespacesDropsExpiredKeys() {
+	s.store.RegisterNamespace("product", cache.NamespaceOptions{})
+
+	value := "x"
+	cache.SetToCache(s.ctx, s.store, "product:id:1", &value, 50*time.Millisecond)
+	cache.SetToCache(s.ctx, s.store, "product:id:2", &value, time.Minute)
+
+	s.Eventually(func() bool {
+		_, found := cache.GetFromCache[string](s.ctx, s.store, "product:id:1")
+		return !found
+	}, 2*time.Second, 20*time.Millisecond)
+
+	pruned, err := s.store.PruneNamespaces(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(int64(1), pruned)
+
+	stats, err := s.store.InspectNamespace(s.ctx, "product")
+	s.Require().NoError(err)
+	s.Equal(int64(1), stats.Keys)
+}
+
+func TestCacheStoreSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(CacheStoreTestSuite))
+}
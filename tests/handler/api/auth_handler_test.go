@@ -2,7 +2,6 @@ package api_test
 
 import (
 	"bytes"
-	"context"
 	"pointofsale/internal/cache"
 	api_cache "pointofsale/internal/cache/api/auth"
 	auth_cache "pointofsale/internal/cache/auth"
@@ -14,7 +13,6 @@ import (
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
 	auth_manager "pointofsale/pkg/auth"
-	db "pointofsale/pkg/database/schema"
 	app_errors "pointofsale/pkg/errors"
 	"pointofsale/pkg/hash"
 	"pointofsale/pkg/logger"
@@ -59,8 +57,7 @@ func (s *AuthHandlerTestSuite) SetupSuite() {
 		Addr: s.ts.RedisURL,
 	})
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -82,11 +79,9 @@ func (s *AuthHandlerTestSuite) SetupSuite() {
 		Logger:           log,
 		Observability:    obs,
 		Cache:            cAuth,
+		UnitOfWork:       repos.UnitOfWork,
 	})
 
-	// Seed ROLE_ADMIN
-	_, _ = repos.Role.CreateRole(context.Background(), &requests.CreateRoleRequest{Name: "ROLE_ADMIN"})
-
 	// Start gRPC Server
 	authGapiHandler := gapi.NewAuthHandleGrpc(authService)
 	server := grpc.NewServer()
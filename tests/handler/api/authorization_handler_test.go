@@ -0,0 +1,200 @@
+package api_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"pointofsale/internal/middlewares"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/auth"
+	"pointofsale/pkg/logger"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	authzSecret    = "authorization-test-secret"
+	authzAdminID   = 1
+	authzManagerID = 2
+	authzCashierID = 3
+	authzUserID    = 4
+)
+
+// callerRoleServer answers FindByUserId with the ID the auth interceptor
+// extracted from the forwarded bearer token.
+type callerRoleServer struct {
+	pb.UnimplementedRoleServiceServer
+}
+
+func (callerRoleServer) FindByUserId(ctx context.Context, req *pb.FindByIdUserRoleRequest) (*pb.ApiResponsesRole, error) {
+	userID, _ := auth.UserIDFromContext(ctx)
+
+	return &pb.ApiResponsesRole{Status: "success", Message: strconv.Itoa(userID)}, nil
+}
+
+type AuthorizationApiTestSuite struct {
+	suite.Suite
+	echo       *echo.Echo
+	token      *auth.Manager
+	grpcServer *grpc.Server
+	conn       *grpc.ClientConn
+}
+
+func (s *AuthorizationApiTestSuite) SetupSuite() {
+	viper.Set("SECRET_KEY", authzSecret)
+
+	token, err := auth.NewManager(authzSecret)
+	s.Require().NoError(err)
+	s.token = token
+
+	resolver := middlewares.RoleResolverFunc(func(ctx context.Context, userID int) ([]string, error) {
+		switch userID {
+		case authzAdminID:
+			return []string{auth.RoleAdmin}, nil
+		case authzManagerID:
+			return []string{auth.RoleManager}, nil
+		case authzCashierID:
+			return []string{auth.RoleCashier}, nil
+		default:
+			return []string{auth.RoleUser}, nil
+		}
+	})
+
+	logger.ResetInstance()
+	log, err := logger.NewLogger("test-authorization-api", sdklog.NewLoggerProvider())
+	s.Require().NoError(err)
+
+	authentication := middlewares.NewAuthInterceptor(token, nil, log, middlewares.DefaultPublicGrpcMethods()...)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(authentication.UnaryInterceptor()))
+	pb.RegisterRoleServiceServer(server, callerRoleServer{})
+	s.grpcServer = server
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(middlewares.ForwardTokenUnaryClientInterceptor()),
+	)
+	s.Require().NoError(err)
+	s.conn = conn
+	client := pb.NewRoleServiceClient(conn)
+
+	e := echo.New()
+	middlewares.WebSecurityConfig(e)
+	e.Use(middlewares.RoleAuthorization(middlewares.DefaultRestPolicy(), resolver))
+
+	ok := func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}
+
+	e.POST("/api/auth/login", ok)
+	e.GET("/api/auth/me", ok)
+	e.GET("/api/product", ok)
+	e.POST("/api/product/create", ok)
+	e.DELETE("/api/product/permanent/:id", ok)
+	e.POST("/api/product/restore/all", ok)
+	e.GET("/api/user", ok)
+	e.POST("/api/order/create", ok)
+	e.GET("/api/role/user/:user_id", func(c echo.Context) error {
+		res, err := client.FindByUserId(c.Request().Context(), &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+		if err != nil {
+			return echo.ErrBadGateway
+		}
+
+		return c.String(http.StatusOK, res.Message)
+	})
+
+	s.echo = e
+}
+
+func (s *AuthorizationApiTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+}
+
+func (s *AuthorizationApiTestSuite) do(method, path string, userID int) int {
+	req := httptest.NewRequest(method, path, nil)
+
+	if userID != 0 {
+		token, err := s.token.GenerateToken(userID, "access")
+		s.Require().NoError(err)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	return rec.Code
+}
+
+func (s *AuthorizationApiTestSuite) TestWhitelistedRouteSkipsAuthorization() {
+	s.Equal(http.StatusOK, s.do(http.MethodPost, "/api/auth/login", 0))
+}
+
+func (s *AuthorizationApiTestSuite) TestMissingTokenIsUnauthorized() {
+	s.Equal(http.StatusUnauthorized, s.do(http.MethodGet, "/api/product", 0))
+}
+
+func (s *AuthorizationApiTestSuite) TestAuthenticatedRouteAllowsAnyRole() {
+	s.Equal(http.StatusOK, s.do(http.MethodGet, "/api/auth/me", authzUserID))
+}
+
+func (s *AuthorizationApiTestSuite) TestUserWithoutStaffRoleIsForbidden() {
+	s.Equal(http.StatusForbidden, s.do(http.MethodGet, "/api/product", authzUserID))
+	s.Equal(http.StatusForbidden, s.do(http.MethodPost, "/api/order/create", authzUserID))
+}
+
+func (s *AuthorizationApiTestSuite) TestCashierCanReadButNotWriteCatalog() {
+	s.Equal(http.StatusOK, s.do(http.MethodGet, "/api/product", authzCashierID))
+	s.Equal(http.StatusOK, s.do(http.MethodPost, "/api/order/create", authzCashierID))
+	s.Equal(http.StatusForbidden, s.do(http.MethodPost, "/api/product/create", authzCashierID))
+}
+
+func (s *AuthorizationApiTestSuite) TestDestructiveRoutesRequireAdmin() {
+	s.Equal(http.StatusOK, s.do(http.MethodPost, "/api/product/create", authzManagerID))
+	s.Equal(http.StatusForbidden, s.do(http.MethodDelete, "/api/product/permanent/1", authzManagerID))
+	s.Equal(http.StatusForbidden, s.do(http.MethodPost, "/api/product/restore/all", authzManagerID))
+
+	s.Equal(http.StatusOK, s.do(http.MethodDelete, "/api/product/permanent/1", authzAdminID))
+	s.Equal(http.StatusOK, s.do(http.MethodPost, "/api/product/restore/all", authzAdminID))
+}
+
+func (s *AuthorizationApiTestSuite) TestUserManagementIsAdminOnly() {
+	s.Equal(http.StatusForbidden, s.do(http.MethodGet, "/api/user", authzManagerID))
+	s.Equal(http.StatusOK, s.do(http.MethodGet, "/api/user", authzAdminID))
+}
+
+func (s *AuthorizationApiTestSuite) TestBearerTokenIsForwardedToGrpc() {
+	token, err := s.token.GenerateToken(authzUserID, "access")
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/role/user/4", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal(strconv.Itoa(authzUserID), rec.Body.String())
+}
+
+func TestAuthorizationApiSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationApiTestSuite))
+}
@@ -58,8 +58,7 @@ func (s *CashierApiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
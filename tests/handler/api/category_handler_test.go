@@ -11,7 +11,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
@@ -57,8 +56,7 @@ func (s *CategoryApiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -75,6 +73,7 @@ func (s *CategoryApiTestSuite) SetupSuite() {
 
 	categoryService := service.NewCategoryService(service.CategoryServiceDeps{
 		CategoryRepo:  repos.Category,
+		UnitOfWork:    repos.UnitOfWork,
 		Logger:             log,
 		Observability:      obs,
 		Cache:              catCacheSrv,
@@ -0,0 +1,263 @@
+package api_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/middlewares"
+	"pointofsale/internal/pb"
+	"pointofsale/pkg/auth"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/idempotency_errors"
+	"pointofsale/pkg/logger"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	idempotencySecret = "idempotency-test-secret"
+	idempotencyUserID = 7
+)
+
+// memoryIdempotencyStore mirrors the conflict rules of the idempotency
+// service without Postgres or Redis.
+type memoryIdempotencyStore struct {
+	mu     sync.Mutex
+	nextID int32
+	keys   map[string]*db.IdempotencyKey
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{keys: map[string]*db.IdempotencyKey{}}
+}
+
+func (m *memoryIdempotencyStore) Begin(ctx context.Context, req *requests.IdempotencyRequest) (*db.IdempotencyKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	scope := req.Method + "|" + req.Key
+	if existing, ok := m.keys[scope]; ok {
+		if existing.RequestHash != req.RequestHash {
+			return nil, idempotency_errors.ErrIdempotencyKeyReused
+		}
+		if existing.Response == nil {
+			return nil, idempotency_errors.ErrIdempotencyKeyInProgress
+		}
+
+		return existing, nil
+	}
+
+	m.nextID++
+	claim := &db.IdempotencyKey{
+		IdempotencyKeyID: m.nextID,
+		UserID:           int32(req.UserID),
+		Method:           req.Method,
+		IdempotencyKey:   req.Key,
+		RequestHash:      req.RequestHash,
+	}
+	m.keys[scope] = claim
+
+	return claim, nil
+}
+
+func (m *memoryIdempotencyStore) Complete(ctx context.Context, claim *db.IdempotencyKey, response []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	claim.Response = response
+
+	return nil
+}
+
+func (m *memoryIdempotencyStore) Release(ctx context.Context, claim *db.IdempotencyKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.keys, claim.Method+"|"+claim.IdempotencyKey)
+
+	return nil
+}
+
+// countingOrderServer creates a new order on every call that reaches it and
+// rejects orders without a merchant.
+type countingOrderServer struct {
+	pb.UnimplementedOrderServiceServer
+	created atomic.Int32
+}
+
+func (o *countingOrderServer) Create(ctx context.Context, req *pb.CreateOrderRequest) (*pb.ApiResponseOrder, error) {
+	if req.GetMerchantId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "merchant is required")
+	}
+
+	id := o.created.Add(1)
+
+	return &pb.ApiResponseOrder{
+		Status:  "success",
+		Message: "Successfully created order",
+		Data:    &pb.OrderResponse{Id: id, MerchantId: req.GetMerchantId()},
+	}, nil
+}
+
+type IdempotencyApiTestSuite struct {
+	suite.Suite
+	echo       *echo.Echo
+	token      string
+	orders     *countingOrderServer
+	grpcServer *grpc.Server
+	conn       *grpc.ClientConn
+}
+
+func (s *IdempotencyApiTestSuite) SetupSuite() {
+	viper.Set("SECRET_KEY", idempotencySecret)
+
+	manager, err := auth.NewManager(idempotencySecret)
+	s.Require().NoError(err)
+
+	s.token, err = manager.GenerateToken(idempotencyUserID, "access")
+	s.Require().NoError(err)
+
+	logger.ResetInstance()
+	log, err := logger.NewLogger("test-idempotency-api", sdklog.NewLoggerProvider())
+	s.Require().NoError(err)
+
+	authentication := middlewares.NewAuthInterceptor(manager, nil, log, middlewares.DefaultPublicGrpcMethods()...)
+	idempotency := middlewares.NewIdempotencyInterceptor(newMemoryIdempotencyStore(), log, middlewares.DefaultIdempotentGrpcMethods()...)
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(authentication.UnaryInterceptor(), idempotency.UnaryInterceptor()))
+	s.orders = &countingOrderServer{}
+	pb.RegisterOrderServiceServer(server, s.orders)
+	s.grpcServer = server
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			middlewares.ForwardTokenUnaryClientInterceptor(),
+			middlewares.ForwardIdempotencyKeyUnaryClientInterceptor(),
+		),
+	)
+	s.Require().NoError(err)
+	s.conn = conn
+	client := pb.NewOrderServiceClient(conn)
+
+	e := echo.New()
+	middlewares.WebSecurityConfig(e)
+
+	e.POST("/api/order/create", func(c echo.Context) error {
+		var body requests.CreateOrderRequest
+		if err := c.Bind(&body); err != nil {
+			return echo.ErrBadRequest
+		}
+
+		res, err := client.Create(c.Request().Context(), &pb.CreateOrderRequest{
+			MerchantId: int32(body.MerchantID),
+			CashierId:  int32(body.CashierID),
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.AlreadyExists:
+				return echo.NewHTTPError(http.StatusConflict, status.Convert(err).Message())
+			case codes.InvalidArgument:
+				return echo.ErrBadRequest
+			default:
+				return echo.ErrBadGateway
+			}
+		}
+
+		return c.JSON(http.StatusOK, res)
+	}, middlewares.IdempotencyKey())
+
+	s.echo = e
+}
+
+func (s *IdempotencyApiTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+}
+
+func (s *IdempotencyApiTestSuite) create(key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/order/create", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+s.token)
+
+	if key != "" {
+		req.Header.Set(middlewares.IdempotencyKeyHeader, key)
+	}
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func (s *IdempotencyApiTestSuite) TestRetryReplaysOriginalResponse() {
+	before := s.orders.created.Load()
+
+	first := s.create("retry-key", `{"merchant_id": 1, "cashier_id": 2}`)
+	s.Require().Equal(http.StatusOK, first.Code)
+	s.Empty(first.Header().Get(middlewares.IdempotentReplayedHeader))
+
+	second := s.create("retry-key", `{"merchant_id": 1, "cashier_id": 2}`)
+	s.Require().Equal(http.StatusOK, second.Code)
+	s.Equal("true", second.Header().Get(middlewares.IdempotentReplayedHeader))
+
+	s.JSONEq(first.Body.String(), second.Body.String())
+	s.Equal(before+1, s.orders.created.Load())
+}
+
+func (s *IdempotencyApiTestSuite) TestReusedKeyWithDifferentPayloadConflicts() {
+	s.Require().Equal(http.StatusOK, s.create("reused-key", `{"merchant_id": 1, "cashier_id": 2}`).Code)
+
+	rec := s.create("reused-key", `{"merchant_id": 1, "cashier_id": 3}`)
+	s.Equal(http.StatusConflict, rec.Code)
+}
+
+func (s *IdempotencyApiTestSuite) TestFailedRequestCanBeRetried() {
+	s.Equal(http.StatusBadRequest, s.create("failed-key", `{"merchant_id": 0}`).Code)
+
+	rec := s.create("failed-key", `{"merchant_id": 0}`)
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Empty(rec.Header().Get(middlewares.IdempotentReplayedHeader))
+}
+
+func (s *IdempotencyApiTestSuite) TestRequestsWithoutKeyAreNotDeduplicated() {
+	before := s.orders.created.Load()
+
+	s.Equal(http.StatusOK, s.create("", `{"merchant_id": 1}`).Code)
+	s.Equal(http.StatusOK, s.create("", `{"merchant_id": 1}`).Code)
+
+	s.Equal(before+2, s.orders.created.Load())
+}
+
+func (s *IdempotencyApiTestSuite) TestMalformedKeyIsRejected() {
+	s.Equal(http.StatusBadRequest, s.create("has space", `{"merchant_id": 1}`).Code)
+	s.Equal(http.StatusBadRequest, s.create(strings.Repeat("k", 256), `{"merchant_id": 1}`).Code)
+}
+
+func TestIdempotencyApiSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyApiTestSuite))
+}
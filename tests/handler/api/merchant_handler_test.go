@@ -12,7 +12,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
@@ -58,8 +57,7 @@ func (s *MerchantApiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
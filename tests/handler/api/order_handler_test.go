@@ -7,13 +7,13 @@ import (
 	api_order_cache "pointofsale/internal/cache/api/order"
 	order_cache "pointofsale/internal/cache/order"
 	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/domain/response"
 	"pointofsale/internal/handler/api"
 	"pointofsale/internal/handler/gapi"
 	response_api "pointofsale/internal/mapper"
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
@@ -64,8 +64,7 @@ func (s *OrderApiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -86,6 +85,7 @@ func (s *OrderApiTestSuite) SetupSuite() {
 		ProductRepo:   s.repos.Product,
 		CashierRepo:   s.repos.Cashier,
 		MerchantRepo:  s.repos.Merchant,
+		UnitOfWork:    s.repos.UnitOfWork,
 		Logger:        log,
 		Observability: obs,
 		Cache:         orderCacheSrv,
@@ -265,6 +265,65 @@ func (s *OrderApiTestSuite) TestOrderApiLifecycle() {
 	s.Equal(http.StatusOK, rec.Code)
 }
 
+func (s *OrderApiTestSuite) TestListingsSeeWrites() {
+	listTotal := func(path string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		rec := httptest.NewRecorder()
+		s.echo.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var res struct {
+			Pagination response.PaginationMeta `json:"pagination"`
+		}
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+
+		return res.Pagination.TotalRecords
+	}
+
+	post := func(path string, payload any) map[string]interface{} {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBuffer(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		s.echo.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code)
+
+		var res map[string]interface{}
+		s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+
+		return res
+	}
+
+	// 1. Listing once caches the pages
+	all := listTotal("/api/order?page=1&page_size=10")
+	trashed := listTotal("/api/order/trashed?page=1&page_size=10")
+	s.Equal(all, listTotal("/api/order?page=1&page_size=10"))
+
+	// 2. A new order shows up at once instead of after the TTL
+	created := post("/api/order/create", map[string]interface{}{
+		"merchant_id": s.merchantID,
+		"cashier_id":  s.cashierID,
+		"user_id":     s.userID,
+		"total_price": 100,
+		"items": []map[string]interface{}{
+			{"product_id": s.productID, "quantity": 1, "price": 100},
+		},
+	})
+	orderID := int(created["data"].(map[string]interface{})["id"].(float64))
+
+	s.Equal(all+1, listTotal("/api/order?page=1&page_size=10"))
+
+	// 3. Trashing moves it between the cached listings
+	post(fmt.Sprintf("/api/order/trashed/%d", orderID), nil)
+
+	s.Equal(trashed+1, listTotal("/api/order/trashed?page=1&page_size=10"))
+
+	// 4. Bulk restores invalidate every page as well
+	post("/api/order/restore/all", nil)
+
+	s.Equal(trashed, listTotal("/api/order/trashed?page=1&page_size=10"))
+}
+
 func TestOrderApiSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -13,7 +13,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
@@ -65,8 +64,7 @@ func (s *OrderItemApiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -96,6 +94,7 @@ func (s *OrderItemApiTestSuite) SetupSuite() {
 		ProductRepo:   repos.Product,
 		CashierRepo:   repos.Cashier,
 		MerchantRepo:  repos.Merchant,
+		UnitOfWork:    repos.UnitOfWork,
 		Logger:        log,
 		Observability: obs,
 		Cache:         orderCacheSrv,
@@ -11,18 +11,24 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
+	"pointofsale/pkg/storage"
 	"pointofsale/pkg/upload_image"
 	"pointofsale/tests"
 	"encoding/json"
 	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
 	"mime/multipart"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 	"context"
@@ -45,6 +51,7 @@ type ProductApiTestSuite struct {
 	echo        *echo.Echo
 	client      pb.ProductServiceClient
 	conn        *grpc.ClientConn
+	store       *storage.MemoryStorage
 
 	// IDs for reference
 	merchantID int32
@@ -64,8 +71,7 @@ func (s *ProductApiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -80,13 +86,19 @@ func (s *ProductApiTestSuite) SetupSuite() {
 	// API layer cache
 	prodCacheApi := api_product_cache.NewProductMencache(cacheStore)
 
+	// Client and server share the image storage, as replicas do in production
+	s.store = storage.NewMemoryStorage()
+
 	productService := service.NewProductService(service.ProductServiceDeps{
 		CategoryRepo: repos.Category,
 		MerchantRepo: repos.Merchant,
 		ProductRepo:  repos.Product,
+		StockMovementRepo: repos.StockMovement,
+		UnitOfWork:        repos.UnitOfWork,
 		Logger:        log,
 		Observability: obs,
 		Cache:         prodCacheSrv,
+		Storage:       s.store,
 	})
 
 	// Start gRPC Server
@@ -111,7 +123,7 @@ func (s *ProductApiTestSuite) SetupSuite() {
 	// Echo Setup
 	s.echo = echo.New()
 	mapping := response_api.NewProductResponseMapper()
-	imgUpload := upload_image.NewImageUpload(log)
+	imgUpload := upload_image.NewImageUpload(log, s.store, time.Hour)
 	apiHandler := errors.NewApiHandler(obs, log)
 
 	api.NewHandlerProduct(s.echo, s.client, log, mapping, imgUpload, apiHandler, prodCacheApi)
@@ -169,7 +181,7 @@ func (s *ProductApiTestSuite) TestProductApiLifecycle() {
 	_ = writer.WriteField("slug_product", slug)
 
 	part, _ := writer.CreateFormFile("image_product", "api_test.jpg")
-	_, _ = part.Write([]byte("api test image content"))
+	_, _ = part.Write(testJPEG(64, 48))
 	_ = writer.Close()
 
 	req := httptest.NewRequest(http.MethodPost, "/api/product/create", body)
@@ -212,7 +224,7 @@ func (s *ProductApiTestSuite) TestProductApiLifecycle() {
 	_ = writer.WriteField("rating", "4")
 	_ = writer.WriteField("slug_product", slug+"-updated")
 	part, _ = writer.CreateFormFile("image_product", "api_test_updated.jpg")
-	_, _ = part.Write([]byte("api test image updated content"))
+	_, _ = part.Write(testJPEG(48, 64))
 	_ = writer.Close()
 
 	req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/product/update/%d", productID), body)
@@ -246,6 +258,156 @@ func (s *ProductApiTestSuite) TestProductApiLifecycle() {
 	s.Equal(http.StatusOK, rec.Code)
 }
 
+func (s *ProductApiTestSuite) TestImageUploads() {
+	var created struct {
+		Data struct {
+			ID               int               `json:"id"`
+			ImageProduct     string            `json:"image_product"`
+			ImageVariants    map[string]string `json:"image_variants"`
+			ImageURL         string            `json:"image_url"`
+			ImageVariantURLs map[string]string `json:"image_variant_urls"`
+		} `json:"data"`
+	}
+
+	// 1. A PNG is stored under its digest together with downscaled variants
+	img := image.NewNRGBA(image.Rect(0, 0, 1000, 500))
+	var pngData bytes.Buffer
+	s.Require().NoError(png.Encode(&pngData, img))
+
+	// The extension is ignored in favour of the real content
+	rec := s.uploadProduct(s.categoryID, "wide.jpg", pngData.Bytes())
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+
+	stored := created.Data.ImageProduct
+	s.Equal(".png", filepath.Ext(stored))
+	s.Len(strings.TrimSuffix(filepath.Base(stored), ".png"), 64)
+	s.Require().Len(created.Data.ImageVariants, 3)
+
+	object, ok := s.store.Get(created.Data.ImageVariants["thumb"])
+	s.Require().True(ok)
+	s.Equal("image/png", object.ContentType)
+	config, format, err := image.DecodeConfig(bytes.NewReader(object.Data))
+	s.Require().NoError(err)
+	s.Equal("png", format)
+	s.Equal(150, config.Width)
+	s.Equal(75, config.Height)
+
+	// Responses carry signed, expiring links to the image and its variants
+	s.Contains(created.Data.ImageURL, stored)
+	s.Contains(created.Data.ImageURL, "expires=")
+	s.Len(created.Data.ImageVariantURLs, 3)
+
+	// 2. The same picture uploaded again reuses the stored file
+	rec = s.uploadProduct(s.categoryID, "again.png", pngData.Bytes())
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	s.Equal(stored, created.Data.ImageProduct)
+
+	// 3. Files that are not images are rejected whatever their name
+	rec = s.uploadProduct(s.categoryID, "fake.jpg", []byte("not really a jpeg"))
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ProductApiTestSuite) TestImageCleanup() {
+	var created struct {
+		Data struct {
+			ID           int    `json:"id"`
+			ImageProduct string `json:"image_product"`
+		} `json:"data"`
+	}
+
+	deleteProduct := func(id int) {
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/product/trashed/%d", id), nil)
+		rec := httptest.NewRecorder()
+		s.echo.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		req = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/product/permanent/%d", id), nil)
+		rec = httptest.NewRecorder()
+		s.echo.ServeHTTP(rec, req)
+		s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	stored := func(imagePath string) bool {
+		_, ok := s.store.Get(imagePath)
+		return ok
+	}
+
+	before := len(s.store.Keys())
+
+	// 1. An image uploaded for a product that could not be created is removed
+	rec := s.uploadProduct(999999, "orphan.jpg", testJPEG(33, 21))
+	s.Require().NotEqual(http.StatusCreated, rec.Code)
+	s.Equal(before, len(s.store.Keys()))
+
+	// 2. An image shared by two products outlives the first deletion
+	shared := testJPEG(41, 29)
+
+	rec = s.uploadProduct(s.categoryID, "first.jpg", shared)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	first := created.Data.ID
+	imagePath := created.Data.ImageProduct
+
+	rec = s.uploadProduct(s.categoryID, "second.jpg", shared)
+	s.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &created))
+	second := created.Data.ID
+	s.Require().Equal(imagePath, created.Data.ImageProduct)
+
+	s.Equal(before+4, len(s.store.Keys()))
+
+	deleteProduct(first)
+	s.True(stored(imagePath))
+
+	// 3. Deleting the last product that uses it removes the image and variants
+	deleteProduct(second)
+	s.False(stored(imagePath))
+
+	for _, variantPath := range upload_image.ImageVariantPaths(imagePath) {
+		s.False(stored(variantPath))
+	}
+
+	s.Equal(before, len(s.store.Keys()))
+}
+
+func (s *ProductApiTestSuite) uploadProduct(categoryID int32, filename string, content []byte) *httptest.ResponseRecorder {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("merchant_id", fmt.Sprintf("%d", s.merchantID))
+	_ = writer.WriteField("category_id", fmt.Sprintf("%d", categoryID))
+	_ = writer.WriteField("name", "Api Image Product")
+	_ = writer.WriteField("description", "Image pipeline")
+	_ = writer.WriteField("price", "500")
+	_ = writer.WriteField("count_in_stock", "5")
+	_ = writer.WriteField("brand", "ApiBrand")
+	_ = writer.WriteField("weight", "200")
+
+	part, _ := writer.CreateFormFile("image_product", filename)
+	_, _ = part.Write(content)
+	_ = writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/product/create", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func testJPEG(width, height int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, x%height, color.NRGBA{R: 200, A: 255})
+	}
+
+	var buf bytes.Buffer
+	_ = jpeg.Encode(&buf, img, nil)
+
+	return buf.Bytes()
+}
+
 func TestProductApiSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -13,7 +13,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
@@ -48,6 +47,7 @@ type TransactionApiTestSuite struct {
 	userID      int
 	orderID     int
 	cashierID   int
+	productID   int
 }
 
 func (s *TransactionApiTestSuite) SetupSuite() {
@@ -66,8 +66,7 @@ func (s *TransactionApiTestSuite) SetupSuite() {
 	s.redisClient = redis.NewClient(opt)
 
 	// Repositories
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	// Logging & Observability
 	logger.ResetInstance()
@@ -90,8 +89,10 @@ func (s *TransactionApiTestSuite) SetupSuite() {
 		CashierRepo:     s.repos.Cashier,
 		MerchantRepo:    s.repos.Merchant,
 		TransactionRepo: s.repos.Transaction,
+		TransactionRefundRepo: s.repos.TransactionRefund,
 		OrderRepo:       s.repos.Order,
 		OrderItemRepo:   s.repos.OrderItem,
+		UnitOfWork:      s.repos.UnitOfWork,
 		Logger:          l,
 		Cache:           transServiceCache,
 		Observability:   obs,
@@ -177,6 +178,14 @@ func (s *TransactionApiTestSuite) SetupSuite() {
 		ImageProduct: "prod.jpg",
 	})
 	s.Require().NoError(err)
+	s.productID = int(prod.ProductID)
+
+	s.orderID = s.createOrder()
+}
+
+// createOrder creates an unpaid order for one unit of the test product.
+func (s *TransactionApiTestSuite) createOrder() int {
+	ctx := context.Background()
 
 	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
 		MerchantID: s.merchantID,
@@ -184,17 +193,16 @@ func (s *TransactionApiTestSuite) SetupSuite() {
 		TotalPrice: 1100,
 	})
 	s.Require().NoError(err)
-	s.orderID = int(order.OrderID)
 
 	_, err = s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
-		OrderID:   s.orderID,
-		ProductID: int(prod.ProductID),
+		OrderID:   int(order.OrderID),
+		ProductID: s.productID,
 		Quantity:  1,
 		Price:     1000,
 	})
 	s.Require().NoError(err)
 
-	s.Require().NoError(err)
+	return int(order.OrderID)
 }
 
 func (s *TransactionApiTestSuite) TearDownSuite() {
@@ -218,7 +226,7 @@ func (s *TransactionApiTestSuite) TestTransactionApiLifecycle() {
 		OrderID:       s.orderID,
 		CashierID:     s.cashierID,
 		MerchantID:    s.merchantID,
-		PaymentMethod: "credit_card",
+		PaymentMethod: "cash",
 		Amount:        2000,
 	}
 	body, _ := json.Marshal(createReq)
@@ -291,12 +299,12 @@ func (s *TransactionApiTestSuite) TestTransactionApiLifecycle() {
 
 func (s *TransactionApiTestSuite) TestTransactionReports() {
 	// Setup data for reporting
-	// (Already have one order setup, let's create a transaction for it)
+	// (Pay a fresh order, the suite's order may already be paid)
 	createReq := &requests.CreateTransactionRequest{
-		OrderID:       s.orderID,
+		OrderID:       s.createOrder(),
 		CashierID:     s.cashierID,
 		MerchantID:    s.merchantID,
-		PaymentMethod: "credit_card",
+		PaymentMethod: "cash",
 		Amount:        2000,
 	}
 	body, _ := json.Marshal(createReq)
@@ -4,13 +4,11 @@ import (
 	"context"
 	"pointofsale/internal/cache"
 	auth_cache "pointofsale/internal/cache/auth"
-	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/handler/gapi"
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
 	"pointofsale/pkg/auth"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/hash"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
@@ -49,8 +47,7 @@ func (s *AuthGapiTestSuite) SetupSuite() {
 		Addr: s.ts.RedisURL,
 	})
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -72,11 +69,9 @@ func (s *AuthGapiTestSuite) SetupSuite() {
 		Logger:           log,
 		Observability:    obs,
 		Cache:            cacheAuth,
+		UnitOfWork:       repos.UnitOfWork,
 	})
 
-	// Seed ROLE_ADMIN
-	_, _ = repos.Role.CreateRole(context.Background(), &requests.CreateRoleRequest{Name: "ROLE_ADMIN"})
-
 	// Start gRPC Server
 	authHandler := gapi.NewAuthHandleGrpc(authService)
 	server := grpc.NewServer()
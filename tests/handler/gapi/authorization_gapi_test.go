@@ -0,0 +1,221 @@
+package gapi_test
+
+import (
+	"context"
+	"net"
+	"pointofsale/internal/handler/gapi"
+	"pointofsale/internal/middlewares"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/auth"
+	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/logger"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authzAdminID   = 1
+	authzCashierID = 2
+	authzUserID    = 3
+)
+
+// stubRoleService only implements the lookup exercised by these tests.
+type stubRoleService struct {
+	service.RoleService
+}
+
+func (s *stubRoleService) FindByUserId(ctx context.Context, id int) ([]*db.GetUserRolesRow, error) {
+	return []*db.GetUserRolesRow{{RoleID: 1, RoleName: auth.RoleUser}}, nil
+}
+
+// memoryDenylist stands in for the Redis token denylist.
+type memoryDenylist struct {
+	mu     sync.Mutex
+	denied map[string]bool
+}
+
+func (d *memoryDenylist) deny(tokenID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.denied[tokenID] = true
+}
+
+func (d *memoryDenylist) IsTokenDenied(ctx context.Context, tokenID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.denied[tokenID]
+}
+
+type AuthorizationGapiTestSuite struct {
+	suite.Suite
+	denylist   *memoryDenylist
+	grpcServer *grpc.Server
+	conn       *grpc.ClientConn
+	roles      pb.RoleServiceClient
+	auth       pb.AuthServiceClient
+	token      *auth.Manager
+}
+
+func (s *AuthorizationGapiTestSuite) SetupSuite() {
+	logger.ResetInstance()
+	log, err := logger.NewLogger("test-authorization-gapi", sdklog.NewLoggerProvider())
+	s.Require().NoError(err)
+
+	token, err := auth.NewManager("authorization-test-secret")
+	s.Require().NoError(err)
+	s.token = token
+
+	resolver := middlewares.RoleResolverFunc(func(ctx context.Context, userID int) ([]string, error) {
+		switch userID {
+		case authzAdminID:
+			return []string{auth.RoleAdmin}, nil
+		case authzCashierID:
+			return []string{auth.RoleCashier}, nil
+		default:
+			return []string{auth.RoleUser}, nil
+		}
+	})
+
+	s.denylist = &memoryDenylist{denied: map[string]bool{}}
+
+	authentication := middlewares.NewAuthInterceptor(token, s.denylist, log, middlewares.DefaultPublicGrpcMethods()...)
+	authorization := middlewares.NewAuthorizationInterceptor(middlewares.DefaultGrpcPolicy(), resolver, log)
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authentication.UnaryInterceptor(), authorization.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(authentication.StreamInterceptor(), authorization.StreamInterceptor()),
+	)
+	pb.RegisterRoleServiceServer(server, gapi.NewRoleHandleGrpc(&stubRoleService{}))
+	s.grpcServer = server
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	s.conn = conn
+	s.roles = pb.NewRoleServiceClient(conn)
+	s.auth = pb.NewAuthServiceClient(conn)
+}
+
+func (s *AuthorizationGapiTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+}
+
+func (s *AuthorizationGapiTestSuite) as(userID int) context.Context {
+	token, err := s.token.GenerateToken(userID, "access")
+	s.Require().NoError(err)
+
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func (s *AuthorizationGapiTestSuite) TestMissingTokenIsUnauthenticated() {
+	_, err := s.roles.FindByUserId(context.Background(), &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+	s.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *AuthorizationGapiTestSuite) TestInvalidTokenIsUnauthenticated() {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer not-a-jwt")
+
+	_, err := s.roles.FindByUserId(ctx, &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+	s.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *AuthorizationGapiTestSuite) TestTokenSignedWithOtherSecretIsUnauthenticated() {
+	other, err := auth.NewManager("some-other-secret")
+	s.Require().NoError(err)
+
+	token, err := other.GenerateToken(authzAdminID, "access")
+	s.Require().NoError(err)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	_, err = s.roles.FindAllRole(ctx, &pb.FindAllRoleRequest{Page: 1, PageSize: 10})
+	s.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *AuthorizationGapiTestSuite) TestRevokedTokenIsUnauthenticated() {
+	token, err := s.token.GenerateToken(authzUserID, auth.AudienceAccess)
+	s.Require().NoError(err)
+
+	claims, err := s.token.ParseToken(token)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(claims.ID)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	_, err = s.roles.FindByUserId(ctx, &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+	s.Require().NoError(err)
+
+	s.denylist.deny(claims.ID)
+
+	_, err = s.roles.FindByUserId(ctx, &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+	s.Equal(codes.Unauthenticated, status.Code(err))
+
+	// Other tokens of the same user are unaffected.
+	_, err = s.roles.FindByUserId(s.as(authzUserID), &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+	s.NoError(err)
+}
+
+func (s *AuthorizationGapiTestSuite) TestRefreshTokenIsNotAnAccessToken() {
+	token, err := s.token.GenerateToken(authzAdminID, auth.AudienceRefresh)
+	s.Require().NoError(err)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+
+	_, err = s.roles.FindAllRole(ctx, &pb.FindAllRoleRequest{Page: 1, PageSize: 10})
+	s.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *AuthorizationGapiTestSuite) TestPublicMethodSkipsAuthentication() {
+	// AuthService is not registered here, so reaching the server yields Unimplemented.
+	_, err := s.auth.LoginUser(context.Background(), &pb.LoginRequest{})
+	s.Equal(codes.Unimplemented, status.Code(err))
+}
+
+func (s *AuthorizationGapiTestSuite) TestAdminOnlyMethodDeniesOtherRoles() {
+	_, err := s.roles.FindAllRole(s.as(authzCashierID), &pb.FindAllRoleRequest{Page: 1, PageSize: 10})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = s.roles.FindAllRole(s.as(authzUserID), &pb.FindAllRoleRequest{Page: 1, PageSize: 10})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *AuthorizationGapiTestSuite) TestUserCanOnlyReadOwnRoles() {
+	res, err := s.roles.FindByUserId(s.as(authzUserID), &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+	s.Require().NoError(err)
+	s.Equal("success", res.Status)
+
+	_, err = s.roles.FindByUserId(s.as(authzUserID), &pb.FindByIdUserRoleRequest{UserId: authzCashierID})
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *AuthorizationGapiTestSuite) TestAdminCanReadAnyUserRoles() {
+	res, err := s.roles.FindByUserId(s.as(authzAdminID), &pb.FindByIdUserRoleRequest{UserId: authzUserID})
+	s.Require().NoError(err)
+	s.Equal("success", res.Status)
+}
+
+func TestAuthorizationGapiSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationGapiTestSuite))
+}
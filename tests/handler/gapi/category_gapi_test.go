@@ -8,7 +8,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
@@ -20,7 +19,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type CategoryGapiTestSuite struct {
@@ -46,8 +48,7 @@ func (s *CategoryGapiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -60,6 +61,7 @@ func (s *CategoryGapiTestSuite) SetupSuite() {
 
 	categoryService := service.NewCategoryService(service.CategoryServiceDeps{
 		CategoryRepo:  repos.Category,
+		UnitOfWork:    repos.UnitOfWork,
 		Logger:             log,
 		Observability:      obs,
 		Cache:              catCache,
@@ -152,6 +154,54 @@ func (s *CategoryGapiTestSuite) TestCategoryLifecycle() {
 
 }
 
+func (s *CategoryGapiTestSuite) TestCategoryTree() {
+	ctx := context.Background()
+
+	parent, err := s.client.Create(ctx, &pb.CreateCategoryRequest{Name: "Tree Parent Gapi", Description: "Tree parent"})
+	s.Require().NoError(err)
+
+	child, err := s.client.Create(ctx, &pb.CreateCategoryRequest{
+		Name:        "Tree Child Gapi",
+		Description: "Tree child",
+		ParentId:    wrapperspb.Int32(parent.Data.Id),
+	})
+	s.Require().NoError(err)
+	s.Equal(parent.Data.Id, child.Data.ParentId.GetValue())
+
+	// 1. Children are nested below their parent
+	findNode := func(nodes []*pb.CategoryTreeNode, id int32) *pb.CategoryTreeNode {
+		for _, node := range nodes {
+			if node.Category.Id == id {
+				return node
+			}
+		}
+		return nil
+	}
+
+	tree, err := s.client.FindTree(ctx, &pb.FindCategoryTreeRequest{})
+	s.Require().NoError(err)
+
+	node := findNode(tree.Data, parent.Data.Id)
+	s.Require().NotNil(node)
+	s.Require().Len(node.Children, 1)
+	s.Equal(child.Data.Id, node.Children[0].Category.Id)
+	s.Nil(findNode(tree.Data, child.Data.Id))
+
+	// 2. A parent cannot move below its child
+	_, err = s.client.MoveCategory(ctx, &pb.MoveCategoryRequest{CategoryId: parent.Data.Id, ParentId: wrapperspb.Int32(child.Data.Id)})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// 3. Moving to the root takes the child out of its parent
+	moved, err := s.client.MoveCategory(ctx, &pb.MoveCategoryRequest{CategoryId: child.Data.Id})
+	s.Require().NoError(err)
+	s.Nil(moved.Data.ParentId)
+
+	tree, err = s.client.FindTree(ctx, &pb.FindCategoryTreeRequest{})
+	s.Require().NoError(err)
+	s.Empty(findNode(tree.Data, parent.Data.Id).Children)
+	s.NotNil(findNode(tree.Data, child.Data.Id))
+}
+
 func TestCategoryGapiSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -9,7 +9,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
@@ -43,8 +42,7 @@ func (s *MerchantGapiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 	s.userRepo = repos.User
 
 	logger.ResetInstance()
@@ -9,7 +9,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
@@ -53,8 +52,7 @@ func (s *OrderGapiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -71,6 +69,7 @@ func (s *OrderGapiTestSuite) SetupSuite() {
 		ProductRepo:   s.repos.Product,
 		CashierRepo:   s.repos.Cashier,
 		MerchantRepo:  s.repos.Merchant,
+		UnitOfWork:    s.repos.UnitOfWork,
 		Logger:        log,
 		Observability: obs,
 		Cache:         orderCache,
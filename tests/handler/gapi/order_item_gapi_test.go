@@ -10,7 +10,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
@@ -55,8 +54,7 @@ func (s *OrderItemGapiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -80,6 +78,7 @@ func (s *OrderItemGapiTestSuite) SetupSuite() {
 		ProductRepo:   repos.Product,
 		CashierRepo:   repos.Cashier,
 		MerchantRepo:  repos.Merchant,
+		UnitOfWork:    repos.UnitOfWork,
 		Logger:        log,
 		Observability: obs,
 		Cache:         orderCache,
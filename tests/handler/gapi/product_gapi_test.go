@@ -8,11 +8,11 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
 	"fmt"
+	"io"
 	"net"
 	"testing"
 
@@ -21,7 +21,9 @@ import (
 	"github.com/stretchr/testify/suite"
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type ProductGapiTestSuite struct {
@@ -51,8 +53,7 @@ func (s *ProductGapiTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -66,6 +67,8 @@ func (s *ProductGapiTestSuite) SetupSuite() {
 		CategoryRepo: repos.Category,
 		MerchantRepo: repos.Merchant,
 		ProductRepo:  repos.Product,
+		StockMovementRepo: repos.StockMovement,
+		UnitOfWork:        repos.UnitOfWork,
 		Logger:        log,
 		Observability: obs,
 		Cache:         prodCache,
@@ -216,6 +219,84 @@ func (s *ProductGapiTestSuite) TestProductLifecycle() {
 	s.Error(err)
 }
 
+func (s *ProductGapiTestSuite) TestImportExportStreams() {
+	ctx := context.Background()
+
+	sheet := "name,description,price,count_in_stock,brand,weight,category,image_product\n" +
+		"Gapi Import One,Streamed in,120,5,GapiBrand,10,gapi-category,one.jpg\n" +
+		"Gapi Import Two,Streamed in,240,6,GapiBrand,20,Gapi Category,two.jpg\n"
+
+	upload := func(chunks ...*pb.ImportProductsChunk) (*pb.ApiResponseProductImport, error) {
+		stream, err := s.client.ImportProducts(ctx)
+		s.Require().NoError(err)
+
+		// The server's error, if any, is returned by CloseAndRecv.
+		for _, chunk := range chunks {
+			if err := stream.Send(chunk); err != nil {
+				break
+			}
+		}
+
+		return stream.CloseAndRecv()
+	}
+
+	metadata := func(dryRun bool) *pb.ImportProductsChunk {
+		return &pb.ImportProductsChunk{Payload: &pb.ImportProductsChunk_Metadata{
+			Metadata: &pb.ImportProductsMetadata{MerchantId: s.merchantID, Format: "csv", DryRun: dryRun},
+		}}
+	}
+
+	// 1. The file may arrive split at any byte
+	half := len(sheet) / 2
+	res, err := upload(
+		metadata(false),
+		&pb.ImportProductsChunk{Payload: &pb.ImportProductsChunk_Data{Data: []byte(sheet[:half])}},
+		&pb.ImportProductsChunk{Payload: &pb.ImportProductsChunk_Data{Data: []byte(sheet[half:])}},
+	)
+	s.Require().NoError(err)
+	s.True(res.Data.Applied)
+	s.Equal(int32(2), res.Data.Created)
+	s.Require().Len(res.Data.Rows, 2)
+	s.NotNil(res.Data.Rows[0].ProductId)
+
+	// 2. A stream without metadata first is rejected
+	_, err = upload(&pb.ImportProductsChunk{Payload: &pb.ImportProductsChunk_Data{Data: []byte(sheet)}})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	// 3. The export streams back a sheet with both products
+	export, err := s.client.ExportProducts(ctx, &pb.ExportProductsRequest{
+		MerchantId: s.merchantID,
+		Format:     "csv",
+		Search:     "Gapi Import",
+	})
+	s.Require().NoError(err)
+
+	var file []byte
+	for {
+		chunk, err := export.Recv()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+		file = append(file, chunk.Data...)
+	}
+
+	s.Contains(string(file), "Gapi Import One")
+	s.Contains(string(file), "Gapi Import Two")
+
+	// 4. Importing the export again only matches existing products
+	res, err = upload(
+		metadata(true),
+		&pb.ImportProductsChunk{Payload: &pb.ImportProductsChunk_Data{Data: file}},
+	)
+	s.Require().NoError(err)
+	s.False(res.Data.Applied)
+	s.Zero(res.Data.Failed)
+	for _, row := range res.Data.Rows {
+		s.Equal("update", row.Action)
+	}
+}
+
 func TestProductGapiSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
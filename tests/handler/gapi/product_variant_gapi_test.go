@@ -0,0 +1,238 @@
+package gapi_test
+
+import (
+	"context"
+	"net"
+	"pointofsale/internal/cache"
+	product_cache "pointofsale/internal/cache/product"
+	product_variant_cache "pointofsale/internal/cache/product_variant"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/handler/gapi"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type ProductVariantGapiTestSuite struct {
+	suite.Suite
+	ts          *tests.TestSuite
+	dbPool      *pgxpool.Pool
+	redisClient *redis.Client
+	grpcServer  *grpc.Server
+	client      pb.ProductVariantServiceClient
+	conn        *grpc.ClientConn
+	productID   int32
+}
+
+func (s *ProductVariantGapiTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opts, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opts)
+
+	repos := repository.NewRepositories(pool)
+	ctx := context.Background()
+
+	user, err := repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Variant",
+		LastName:  "Gapi",
+		Email:     "variant.gapi@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Variant gRPC Merchant",
+		Description: "Merchant for product variant gRPC testing",
+	})
+	s.Require().NoError(err)
+
+	slug := "variant-gapi-category"
+	category, err := repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Variant gRPC Category",
+		Description:  "Category for product variant gRPC testing",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+
+	productSlug := "variant-gapi-product"
+	product, err := repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   int(merchant.MerchantID),
+		CategoryID:   int(category.CategoryID),
+		Name:         "Variant gRPC Product",
+		Description:  "Product for product variant gRPC testing",
+		Price:        1000,
+		CountInStock: 0,
+		Brand:        "Variant Brand",
+		Weight:       100,
+		SlugProduct:  &productSlug,
+		ImageProduct: "variant.jpg",
+	})
+	s.Require().NoError(err)
+	s.productID = product.ProductID
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	log, _ := logger.NewLogger("test-product-variant-gapi", lp)
+	obs, _ := observability.NewObservability("test-product-variant-gapi", log)
+
+	cacheMetrics, _ := observability.NewCacheMetrics("test-product-variant-gapi")
+	cacheStore := cache.NewCacheStore(s.redisClient, log, cacheMetrics)
+
+	productVariantService := service.NewProductVariantService(service.ProductVariantServiceDeps{
+		ProductVariantRepo: repos.ProductVariant,
+		ProductRepo:        repos.Product,
+		UnitOfWork:         repos.UnitOfWork,
+		Logger:             log,
+		Observability:      obs,
+		Cache:              product_variant_cache.NewProductVariantMencache(cacheStore),
+		ProductCache:       product_cache.NewProductMencache(cacheStore),
+	})
+
+	server := grpc.NewServer()
+	pb.RegisterProductVariantServiceServer(server, gapi.NewProductVariantHandleGrpc(productVariantService))
+	s.grpcServer = server
+
+	lis, err := net.Listen("tcp", ":0")
+	s.Require().NoError(err)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	s.conn = conn
+	s.client = pb.NewProductVariantServiceClient(conn)
+}
+
+func (s *ProductVariantGapiTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *ProductVariantGapiTestSuite) TestProductVariantGapiLifecycle() {
+	ctx := context.Background()
+
+	// 1. Declare options
+	optionsRes, err := s.client.SetProductOptions(ctx, &pb.SetProductOptionsRequest{
+		ProductId: s.productID,
+		Options: []*pb.ProductOptionRequest{
+			{Name: "size", Values: []string{"S", "M"}},
+			{Name: "colour", Values: []string{"red"}},
+		},
+	})
+	s.Require().NoError(err)
+	s.Len(optionsRes.Data, 2)
+
+	// 2. Create a variant with its own barcode, price and stock
+	createRes, err := s.client.CreateProductVariant(ctx, &pb.CreateProductVariantRequest{
+		ProductId:    s.productID,
+		Sku:          "GAPI-RED-S",
+		Barcode:      wrapperspb.String("4006381333931"),
+		Options:      map[string]string{"size": "S", "colour": "red"},
+		Price:        wrapperspb.Int32(1250),
+		CountInStock: 7,
+	})
+	s.Require().NoError(err)
+	s.Equal("S", createRes.Data.Options["size"])
+	s.Equal(int32(1250), createRes.Data.Price.GetValue())
+	s.Equal(int32(7), createRes.Data.CountInStock)
+	id := createRes.Data.Id
+
+	// 3. Options must name every declared option
+	_, err = s.client.CreateProductVariant(ctx, &pb.CreateProductVariantRequest{
+		ProductId: s.productID,
+		Sku:       "GAPI-M",
+		Options:   map[string]string{"size": "M"},
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// 4. SKUs are unique
+	_, err = s.client.CreateProductVariant(ctx, &pb.CreateProductVariantRequest{
+		ProductId: s.productID,
+		Sku:       "GAPI-RED-S",
+		Options:   map[string]string{"size": "M", "colour": "red"},
+	})
+	s.Equal(codes.AlreadyExists, status.Code(err))
+
+	listRes, err := s.client.FindProductVariants(ctx, &pb.FindProductVariantsRequest{ProductId: s.productID})
+	s.Require().NoError(err)
+	s.Len(listRes.Data.Options, 2)
+	s.Require().Len(listRes.Data.Variants, 1)
+	s.Equal("4006381333931", listRes.Data.Variants[0].Barcode.GetValue())
+
+	// 5. Stock must be cleared before the variant can be removed
+	_, err = s.client.DeleteProductVariant(ctx, &pb.FindByIdProductVariantRequest{VariantId: id})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	updateRes, err := s.client.UpdateProductVariant(ctx, &pb.UpdateProductVariantRequest{
+		VariantId:    id,
+		Sku:          "GAPI-RED-S",
+		CountInStock: wrapperspb.Int32(0),
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(0), updateRes.Data.CountInStock)
+	s.Nil(updateRes.Data.Price)
+
+	_, err = s.client.DeleteProductVariant(ctx, &pb.FindByIdProductVariantRequest{VariantId: id})
+	s.Require().NoError(err)
+
+	_, err = s.client.FindByIdProductVariant(ctx, &pb.FindByIdProductVariantRequest{VariantId: id})
+	s.Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *ProductVariantGapiTestSuite) TestRejectsInvalidRequests() {
+	ctx := context.Background()
+
+	_, err := s.client.FindByIdProductVariant(ctx, &pb.FindByIdProductVariantRequest{})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.client.CreateProductVariant(ctx, &pb.CreateProductVariantRequest{
+		ProductId: s.productID,
+		Options:   map[string]string{"size": "S"},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestProductVariantGapiSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(ProductVariantGapiTestSuite))
+}
@@ -0,0 +1,222 @@
+package gapi_test
+
+import (
+	"context"
+	"net"
+	"pointofsale/internal/cache"
+	purchase_order_cache "pointofsale/internal/cache/purchase_order"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/handler/gapi"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type PurchaseOrderGapiTestSuite struct {
+	suite.Suite
+	ts          *tests.TestSuite
+	dbPool      *pgxpool.Pool
+	redisClient *redis.Client
+	grpcServer  *grpc.Server
+	client      pb.PurchaseOrderServiceClient
+	conn        *grpc.ClientConn
+	merchantID  int32
+	supplierID  int32
+	productID   int32
+}
+
+func (s *PurchaseOrderGapiTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opts, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opts)
+
+	repos := repository.NewRepositories(pool)
+	ctx := context.Background()
+
+	user, err := repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Purchase",
+		LastName:  "Gapi",
+		Email:     "purchase.gapi@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Purchase gRPC Merchant",
+		Description: "Merchant for purchase order gRPC testing",
+	})
+	s.Require().NoError(err)
+	s.merchantID = merchant.MerchantID
+
+	slug := "purchase-gapi-category"
+	category, err := repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Purchase gRPC Category",
+		Description:  "Category for purchase order gRPC testing",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+
+	productSlug := "purchase-gapi-product"
+	product, err := repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   int(s.merchantID),
+		CategoryID:   int(category.CategoryID),
+		Name:         "Purchase gRPC Product",
+		Description:  "Product for purchase order gRPC testing",
+		Price:        1000,
+		CountInStock: 1,
+		Brand:        "Purchase Brand",
+		Weight:       100,
+		SlugProduct:  &productSlug,
+		ImageProduct: "purchase.jpg",
+	})
+	s.Require().NoError(err)
+	s.productID = product.ProductID
+
+	supplier, err := repos.Supplier.CreateSupplier(ctx, &requests.CreateSupplierRequest{
+		MerchantID: int(s.merchantID),
+		Name:       "Purchase gRPC Supplier",
+	})
+	s.Require().NoError(err)
+	s.supplierID = supplier.SupplierID
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	log, _ := logger.NewLogger("test-purchase-order-gapi", lp)
+	obs, _ := observability.NewObservability("test-purchase-order-gapi", log)
+
+	cacheMetrics, _ := observability.NewCacheMetrics("test-purchase-order-gapi")
+	cacheStore := cache.NewCacheStore(s.redisClient, log, cacheMetrics)
+
+	purchaseOrderService := service.NewPurchaseOrderService(service.PurchaseOrderServiceDeps{
+		PurchaseOrderRepo: repos.PurchaseOrder,
+		SupplierRepo:      repos.Supplier,
+		UnitOfWork:        repos.UnitOfWork,
+		Logger:            log,
+		Observability:     obs,
+		Cache:             purchase_order_cache.NewPurchaseOrderMencache(cacheStore),
+	})
+
+	server := grpc.NewServer()
+	pb.RegisterPurchaseOrderServiceServer(server, gapi.NewPurchaseOrderHandleGrpc(purchaseOrderService))
+	s.grpcServer = server
+
+	lis, err := net.Listen("tcp", ":0")
+	s.Require().NoError(err)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	s.conn = conn
+	s.client = pb.NewPurchaseOrderServiceClient(conn)
+}
+
+func (s *PurchaseOrderGapiTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *PurchaseOrderGapiTestSuite) TestPurchaseOrderGapiLifecycle() {
+	ctx := context.Background()
+
+	// 1. Create a draft
+	createRes, err := s.client.CreatePurchaseOrder(ctx, &pb.CreatePurchaseOrderRequest{
+		MerchantId: s.merchantID,
+		SupplierId: s.supplierID,
+		Items: []*pb.CreatePurchaseOrderItemRequest{
+			{ProductId: s.productID, Quantity: 5, UnitCost: 700},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal("draft", createRes.Data.PurchaseOrder.Status)
+	s.Require().Len(createRes.Data.Items, 1)
+	poID := createRes.Data.PurchaseOrder.Id
+	itemID := createRes.Data.Items[0].Id
+
+	// 2. Drafts cannot be received
+	_, err = s.client.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{
+		PurchaseOrderId: poID,
+		Items:           []*pb.ReceivePurchaseOrderItemRequest{{PurchaseOrderItemId: itemID, Quantity: 1}},
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// 3. Submit
+	submitRes, err := s.client.SubmitPurchaseOrder(ctx, &pb.FindByIdPurchaseOrderRequest{PurchaseOrderId: poID})
+	s.Require().NoError(err)
+	s.Equal("ordered", submitRes.Data.Status)
+	s.NotNil(submitRes.Data.OrderedAt)
+
+	// 4. Over-receiving is rejected
+	_, err = s.client.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{
+		PurchaseOrderId: poID,
+		Items:           []*pb.ReceivePurchaseOrderItemRequest{{PurchaseOrderItemId: itemID, Quantity: 6}},
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// 5. Receive everything
+	receiveRes, err := s.client.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{
+		PurchaseOrderId: poID,
+		Items:           []*pb.ReceivePurchaseOrderItemRequest{{PurchaseOrderItemId: itemID, Quantity: 5}},
+	})
+	s.Require().NoError(err)
+	s.Equal("received", receiveRes.Data.PurchaseOrder.Status)
+	s.Equal(int32(5), receiveRes.Data.Items[0].QuantityReceived)
+
+	receiptsRes, err := s.client.FindPurchaseOrderReceipts(ctx, &pb.FindByIdPurchaseOrderRequest{PurchaseOrderId: poID})
+	s.Require().NoError(err)
+	s.Len(receiptsRes.Data, 1)
+}
+
+func (s *PurchaseOrderGapiTestSuite) TestCreatePurchaseOrderRejectsInvalidRequest() {
+	ctx := context.Background()
+
+	_, err := s.client.CreatePurchaseOrder(ctx, &pb.CreatePurchaseOrderRequest{
+		MerchantId: s.merchantID,
+		SupplierId: s.supplierID,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestPurchaseOrderGapiSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(PurchaseOrderGapiTestSuite))
+}
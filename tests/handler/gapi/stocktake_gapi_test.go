@@ -0,0 +1,227 @@
+package gapi_test
+
+import (
+	"context"
+	"net"
+	"pointofsale/internal/cache"
+	stocktake_cache "pointofsale/internal/cache/stocktake"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/handler/gapi"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type StocktakeGapiTestSuite struct {
+	suite.Suite
+	ts          *tests.TestSuite
+	dbPool      *pgxpool.Pool
+	redisClient *redis.Client
+	grpcServer  *grpc.Server
+	client      pb.StocktakeServiceClient
+	conn        *grpc.ClientConn
+	merchantID  int32
+	productID   int32
+}
+
+func (s *StocktakeGapiTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opts, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opts)
+
+	repos := repository.NewRepositories(pool)
+	ctx := context.Background()
+
+	user, err := repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Stocktake",
+		LastName:  "Gapi",
+		Email:     "stocktake.gapi@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Stocktake gRPC Merchant",
+		Description: "Merchant for stocktake gRPC testing",
+	})
+	s.Require().NoError(err)
+	s.merchantID = merchant.MerchantID
+
+	slug := "stocktake-gapi-category"
+	category, err := repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Stocktake gRPC Category",
+		Description:  "Category for stocktake gRPC testing",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+
+	productSlug := "stocktake-gapi-product"
+	barcode := "4006381333931"
+	product, err := repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   int(s.merchantID),
+		CategoryID:   int(category.CategoryID),
+		Name:         "Stocktake gRPC Product",
+		Description:  "Product for stocktake gRPC testing",
+		Price:        1000,
+		CountInStock: 5,
+		Brand:        "Stocktake Brand",
+		Weight:       100,
+		SlugProduct:  &productSlug,
+		ImageProduct: "stocktake.jpg",
+		Barcode:      &barcode,
+	})
+	s.Require().NoError(err)
+	s.productID = product.ProductID
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	log, _ := logger.NewLogger("test-stocktake-gapi", lp)
+	obs, _ := observability.NewObservability("test-stocktake-gapi", log)
+
+	cacheMetrics, _ := observability.NewCacheMetrics("test-stocktake-gapi")
+	cacheStore := cache.NewCacheStore(s.redisClient, log, cacheMetrics)
+
+	stocktakeService := service.NewStocktakeService(service.StocktakeServiceDeps{
+		StocktakeRepo: repos.Stocktake,
+		MerchantRepo:  repos.Merchant,
+		CategoryRepo:  repos.Category,
+		UnitOfWork:    repos.UnitOfWork,
+		Logger:        log,
+		Observability: obs,
+		Cache:         stocktake_cache.NewStocktakeMencache(cacheStore),
+	})
+
+	server := grpc.NewServer()
+	pb.RegisterStocktakeServiceServer(server, gapi.NewStocktakeHandleGrpc(stocktakeService))
+	s.grpcServer = server
+
+	lis, err := net.Listen("tcp", ":0")
+	s.Require().NoError(err)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	s.conn = conn
+	s.client = pb.NewStocktakeServiceClient(conn)
+}
+
+func (s *StocktakeGapiTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *StocktakeGapiTestSuite) TestStocktakeGapiLifecycle() {
+	ctx := context.Background()
+
+	// 1. Open
+	createRes, err := s.client.CreateStocktake(ctx, &pb.CreateStocktakeRequest{
+		MerchantId: s.merchantID,
+		Note:       wrapperspb.String("Monthly count"),
+	})
+	s.Require().NoError(err)
+	s.Equal("open", createRes.Data.Status)
+	id := createRes.Data.Id
+
+	// 2. Only one open stocktake per merchant
+	_, err = s.client.CreateStocktake(ctx, &pb.CreateStocktakeRequest{
+		MerchantId: s.merchantID,
+	})
+	s.Equal(codes.AlreadyExists, status.Code(err))
+
+	// 3. Unknown barcodes are rejected
+	_, err = s.client.SubmitStocktakeCounts(ctx, &pb.SubmitStocktakeCountsRequest{
+		StocktakeId: id,
+		Items: []*pb.StocktakeCountRequest{
+			{Barcode: wrapperspb.String("0000000000000"), Quantity: 1},
+		},
+	})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// 4. Count by barcode
+	countRes, err := s.client.SubmitStocktakeCounts(ctx, &pb.SubmitStocktakeCountsRequest{
+		StocktakeId: id,
+		Items: []*pb.StocktakeCountRequest{
+			{Barcode: wrapperspb.String("4006381333931"), Quantity: 3},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(countRes.Data, 1)
+	s.Equal(int32(-2), countRes.Data[0].Variance)
+
+	reportRes, err := s.client.FindStocktakeVarianceReport(ctx, &pb.FindByIdStocktakeRequest{StocktakeId: id})
+	s.Require().NoError(err)
+	s.Equal(int32(-2), reportRes.Data.VarianceQuantity)
+	s.Equal(int64(-2000), reportRes.Data.VarianceValue)
+
+	// 5. Post
+	postRes, err := s.client.PostStocktake(ctx, &pb.FindByIdStocktakeRequest{StocktakeId: id})
+	s.Require().NoError(err)
+	s.Equal("posted", postRes.Data.Status)
+	s.NotNil(postRes.Data.PostedAt)
+
+	// 6. Posted stocktakes cannot be cancelled
+	_, err = s.client.CancelStocktake(ctx, &pb.FindByIdStocktakeRequest{StocktakeId: id})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+
+	itemsRes, err := s.client.FindStocktakeItems(ctx, &pb.FindStocktakeItemsRequest{StocktakeId: id})
+	s.Require().NoError(err)
+	s.Require().Len(itemsRes.Data, 1)
+	s.Equal(s.productID, itemsRes.Data[0].ProductId)
+	s.Equal(int32(3), itemsRes.Data[0].CountedQuantity.GetValue())
+}
+
+func (s *StocktakeGapiTestSuite) TestSubmitCountsRejectsInvalidRequest() {
+	ctx := context.Background()
+
+	_, err := s.client.SubmitStocktakeCounts(ctx, &pb.SubmitStocktakeCountsRequest{
+		StocktakeId: 1,
+		Items:       []*pb.StocktakeCountRequest{{Quantity: 1}},
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestStocktakeGapiSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(StocktakeGapiTestSuite))
+}
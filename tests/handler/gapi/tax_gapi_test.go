@@ -0,0 +1,200 @@
+package gapi_test
+
+import (
+	"context"
+	"net"
+	"pointofsale/internal/cache"
+	tax_cache "pointofsale/internal/cache/tax"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/handler/gapi"
+	"pointofsale/internal/pb"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type TaxGapiTestSuite struct {
+	suite.Suite
+	ts          *tests.TestSuite
+	dbPool      *pgxpool.Pool
+	redisClient *redis.Client
+	grpcServer  *grpc.Server
+	client      pb.TaxServiceClient
+	conn        *grpc.ClientConn
+	categoryID  int32
+}
+
+func (s *TaxGapiTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opts, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opts)
+
+	repos := repository.NewRepositories(pool)
+
+	slug := "tax-gapi-category"
+	category, err := repos.Category.CreateCategory(context.Background(), &requests.CreateCategoryRequest{
+		Name:         "Tax gRPC Category",
+		Description:  "Category for tax gRPC testing",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+	s.categoryID = category.CategoryID
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	log, _ := logger.NewLogger("test-tax-gapi", lp)
+	obs, _ := observability.NewObservability("test-tax-gapi", log)
+
+	cacheMetrics, _ := observability.NewCacheMetrics("test-tax-gapi")
+	cacheStore := cache.NewCacheStore(s.redisClient, log, cacheMetrics)
+
+	taxService := service.NewTaxService(service.TaxServiceDeps{
+		TaxRateRepo:     repos.TaxRate,
+		TransactionRepo: repos.Transaction,
+		OrderItemRepo:   repos.OrderItem,
+		Logger:          log,
+		Observability:   obs,
+		Cache:           tax_cache.NewTaxMencache(cacheStore),
+	})
+
+	server := grpc.NewServer()
+	pb.RegisterTaxServiceServer(server, gapi.NewTaxHandleGrpc(taxService))
+	s.grpcServer = server
+
+	lis, err := net.Listen("tcp", ":0")
+	s.Require().NoError(err)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	s.conn = conn
+	s.client = pb.NewTaxServiceClient(conn)
+}
+
+func (s *TaxGapiTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *TaxGapiTestSuite) TestTaxGapiLifecycle() {
+	ctx := context.Background()
+
+	// 1. The seeded global PPN rate is listed
+	allRes, err := s.client.FindAllTaxRate(ctx, &pb.FindAllTaxRateRequest{Page: 1, PageSize: 10, Search: "PPN"})
+	s.NoError(err)
+	s.Require().Len(allRes.Data, 1)
+	s.Nil(allRes.Data[0].MerchantId)
+	s.Equal(int32(1100), allRes.Data[0].RateBps)
+
+	// 2. Create
+	createRes, err := s.client.CreateTaxRate(ctx, &pb.CreateTaxRateRequest{
+		Name:        "gRPC Rate",
+		CategoryId:  wrapperspb.Int32(s.categoryID),
+		RateBps:     500,
+		IsInclusive: true,
+		Rounding:    "half_even",
+	})
+	s.Require().NoError(err)
+	s.Equal("success", createRes.Status)
+	s.Equal(s.categoryID, createRes.Data.CategoryId.GetValue())
+	rateID := createRes.Data.Id
+
+	// 3. Update
+	updateRes, err := s.client.UpdateTaxRate(ctx, &pb.UpdateTaxRateRequest{
+		TaxRateId:  rateID,
+		Name:       "gRPC Rate Updated",
+		CategoryId: wrapperspb.Int32(s.categoryID),
+		RateBps:    600,
+		Rounding:   "up",
+	})
+	s.Require().NoError(err)
+	s.False(updateRes.Data.IsInclusive)
+	s.Equal(int32(600), updateRes.Data.RateBps)
+
+	// 4. Only one active rate per scope
+	_, err = s.client.CreateTaxRate(ctx, &pb.CreateTaxRateRequest{
+		Name:       "gRPC Rate Duplicate",
+		CategoryId: wrapperspb.Int32(s.categoryID),
+		RateBps:    700,
+		Rounding:   "half_up",
+	})
+	s.Equal(codes.AlreadyExists, status.Code(err))
+
+	// 5. Trash and restore
+	trashRes, err := s.client.TrashedTaxRate(ctx, &pb.FindByIdTaxRateRequest{TaxRateId: rateID})
+	s.NoError(err)
+	s.NotNil(trashRes.Data.DeletedAt)
+
+	restoreRes, err := s.client.RestoreTaxRate(ctx, &pb.FindByIdTaxRateRequest{TaxRateId: rateID})
+	s.NoError(err)
+	s.Nil(restoreRes.Data.DeletedAt)
+
+	// 6. Delete Permanent
+	_, _ = s.client.TrashedTaxRate(ctx, &pb.FindByIdTaxRateRequest{TaxRateId: rateID})
+
+	deleteRes, err := s.client.DeleteTaxRatePermanent(ctx, &pb.FindByIdTaxRateRequest{TaxRateId: rateID})
+	s.NoError(err)
+	s.Equal("success", deleteRes.Status)
+}
+
+func (s *TaxGapiTestSuite) TestCreateTaxRateRejectsInvalidRequest() {
+	ctx := context.Background()
+
+	_, err := s.client.CreateTaxRate(ctx, &pb.CreateTaxRateRequest{
+		Name:     "Too high",
+		RateBps:  10001,
+		Rounding: "half_up",
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.client.CreateTaxRate(ctx, &pb.CreateTaxRateRequest{
+		Name:     "Unknown rounding",
+		RateBps:  1000,
+		Rounding: "bankers",
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestTaxGapiSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(TaxGapiTestSuite))
+}
@@ -10,7 +10,6 @@ import (
 	"pointofsale/internal/pb"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
@@ -56,8 +55,7 @@ func (s *TransactionGapiTestSuite) SetupSuite() {
 	s.redisClient = redis.NewClient(opt)
 
 	// Repositories
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	// Logging & Observability
 	logger.ResetInstance()
@@ -79,8 +77,10 @@ func (s *TransactionGapiTestSuite) SetupSuite() {
 		CashierRepo:     s.repos.Cashier,
 		MerchantRepo:    s.repos.Merchant,
 		TransactionRepo: s.repos.Transaction,
+		TransactionRefundRepo: s.repos.TransactionRefund,
 		OrderRepo:       s.repos.Order,
 		OrderItemRepo:   s.repos.OrderItem,
+		UnitOfWork:      s.repos.UnitOfWork,
 		Logger:          l,
 		Cache:           transCache,
 		Observability:   obs,
@@ -201,7 +201,7 @@ func (s *TransactionGapiTestSuite) TestTransactionGapiLifecycle() {
 	createReq := &pb.CreateTransactionRequest{
 		OrderId:       int32(s.orderID),
 		CashierId:     int32(s.cashierID),
-		PaymentMethod: "credit_card",
+		PaymentMethod: "cash",
 		Amount:        2000,
 	}
 
@@ -0,0 +1,146 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"pointofsale/pkg/notifier"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lowStockAlerts() []notifier.LowStockAlert {
+	return []notifier.LowStockAlert{
+		{
+			ProductID:       7,
+			MerchantID:      3,
+			Name:            "Arabica Beans",
+			CountInStock:    2,
+			ReorderPoint:    5,
+			ReorderQuantity: 20,
+			DetectedAt:      time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestWebhookNotifierPostsAlerts(t *testing.T) {
+	var (
+		method      string
+		contentType string
+		payload     struct {
+			Event  string                   `json:"event"`
+			Alerts []notifier.LowStockAlert `json:"alerts"`
+		}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	alerts := lowStockAlerts()
+
+	err := notifier.NewWebhookNotifier(server.URL, nil).NotifyLowStock(context.Background(), alerts)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, "low_stock", payload.Event)
+	require.Len(t, payload.Alerts, 1)
+	assert.Equal(t, alerts[0].ProductID, payload.Alerts[0].ProductID)
+	assert.Equal(t, alerts[0].MerchantID, payload.Alerts[0].MerchantID)
+	assert.Equal(t, alerts[0].CountInStock, payload.Alerts[0].CountInStock)
+	assert.Equal(t, alerts[0].ReorderQuantity, payload.Alerts[0].ReorderQuantity)
+	assert.True(t, alerts[0].DetectedAt.Equal(payload.Alerts[0].DetectedAt))
+}
+
+func TestWebhookNotifierRejectsNon2xx(t *testing.T) {
+	cases := []struct {
+		name   string
+		status int
+	}{
+		{"not found", http.StatusNotFound},
+		{"server error", http.StatusInternalServerError},
+		{"unavailable", http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			err := notifier.NewWebhookNotifier(server.URL, nil).NotifyLowStock(context.Background(), lowStockAlerts())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), fmt.Sprintf("status %d", tc.status))
+		})
+	}
+}
+
+func TestWebhookNotifierTimesOut(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := &http.Client{Timeout: 50 * time.Millisecond}
+
+	start := time.Now()
+	err := notifier.NewWebhookNotifier(server.URL, client).NotifyLowStock(context.Background(), lowStockAlerts())
+
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestWebhookNotifierHonoursContext(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := notifier.NewWebhookNotifier(server.URL, nil).NotifyLowStock(ctx, lowStockAlerts())
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestMemoryNotifier(t *testing.T) {
+	n := notifier.NewMemoryNotifier()
+	errUnavailable := errors.New("unavailable")
+
+	n.FailWith(errUnavailable)
+	assert.ErrorIs(t, n.NotifyLowStock(context.Background(), lowStockAlerts()), errUnavailable)
+	assert.Empty(t, n.Alerts())
+
+	n.FailWith(nil)
+	require.NoError(t, n.NotifyLowStock(context.Background(), lowStockAlerts()))
+	assert.Len(t, n.Alerts(), 1)
+}
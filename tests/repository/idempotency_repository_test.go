@@ -0,0 +1,143 @@
+package repository_test
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/errors/idempotency_errors"
+	"pointofsale/tests"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyRepositoryTestSuite struct {
+	suite.Suite
+	ts     *tests.TestSuite
+	dbPool *pgxpool.Pool
+	repos  *repository.Repositories
+	userID int
+}
+
+func (s *IdempotencyRepositoryTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	s.repos = repository.NewRepositories(pool)
+
+	user, err := s.repos.User.CreateUser(context.Background(), &requests.CreateUserRequest{
+		FirstName: "Idempotency",
+		LastName:  "User",
+		Email:     "idempotency.user@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+	s.userID = int(user.UserID)
+}
+
+func (s *IdempotencyRepositoryTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *IdempotencyRepositoryTestSuite) reserve(key string, lockedUntil, expiresAt time.Time) (int, error) {
+	res, err := s.repos.IdempotencyKey.Reserve(context.Background(), &requests.ReserveIdempotencyKeyRecordRequest{
+		UserID:      s.userID,
+		Method:      "/pb.OrderService/Create",
+		Key:         key,
+		RequestHash: strings.Repeat("a", 64),
+		LockedUntil: lockedUntil,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.IdempotencyKeyID), nil
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestIdempotencyKeyLifecycle() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// 1. Reserve
+	id, err := s.reserve("lifecycle", now.Add(time.Minute), now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	// 2. A live claim cannot be reserved twice
+	_, err = s.reserve("lifecycle", now.Add(time.Minute), now.Add(time.Hour))
+	s.ErrorIs(err, idempotency_errors.ErrIdempotencyKeyHeld)
+
+	// 3. Release lets the key be claimed again
+	s.NoError(s.repos.IdempotencyKey.Release(ctx, id))
+
+	id, err = s.reserve("lifecycle", now.Add(time.Minute), now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	// 4. Complete stores the response
+	completed, err := s.repos.IdempotencyKey.Complete(ctx, id, []byte("response"))
+	s.NoError(err)
+	s.Equal([]byte("response"), completed.Response)
+
+	found, err := s.repos.IdempotencyKey.FindByKey(ctx, s.userID, "/pb.OrderService/Create", "lifecycle")
+	s.NoError(err)
+	s.Equal([]byte("response"), found.Response)
+
+	// 5. Completed keys are neither released nor reserved again
+	s.NoError(s.repos.IdempotencyKey.Release(ctx, id))
+
+	_, err = s.reserve("lifecycle", now.Add(-time.Minute), now.Add(time.Hour))
+	s.ErrorIs(err, idempotency_errors.ErrIdempotencyKeyHeld)
+
+	// 6. Other methods do not share the key
+	_, err = s.repos.IdempotencyKey.FindByKey(ctx, s.userID, "/pb.TransactionService/Create", "lifecycle")
+	s.ErrorIs(err, idempotency_errors.ErrIdempotencyKeyNotFound)
+}
+
+func (s *IdempotencyRepositoryTestSuite) TestAbandonedAndExpiredKeysAreTakenOver() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// A claim whose lock lapsed without completing is taken over.
+	abandoned, err := s.reserve("abandoned", now.Add(-time.Second), now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	takenOver, err := s.reserve("abandoned", now.Add(time.Minute), now.Add(time.Hour))
+	s.NoError(err)
+	s.Equal(abandoned, takenOver)
+
+	// An expired response is no longer found, can be purged and reused.
+	expired, err := s.reserve("expired", now.Add(-time.Hour), now.Add(-time.Minute))
+	s.Require().NoError(err)
+	_, err = s.repos.IdempotencyKey.Complete(ctx, expired, []byte("stale"))
+	s.Require().NoError(err)
+
+	_, err = s.repos.IdempotencyKey.FindByKey(ctx, s.userID, "/pb.OrderService/Create", "expired")
+	s.ErrorIs(err, idempotency_errors.ErrIdempotencyKeyNotFound)
+
+	deleted, err := s.repos.IdempotencyKey.DeleteExpired(ctx)
+	s.NoError(err)
+	s.GreaterOrEqual(deleted, int64(1))
+
+	_, err = s.reserve("expired", now.Add(time.Minute), now.Add(time.Hour))
+	s.NoError(err)
+}
+
+func TestIdempotencyRepositorySuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(IdempotencyRepositoryTestSuite))
+}
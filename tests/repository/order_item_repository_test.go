@@ -4,7 +4,6 @@ import (
 	"context"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/tests"
 	"testing"
 
@@ -34,8 +33,7 @@ func (s *OrderItemRepositoryTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.dbPool = pool
 
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	ctx := context.Background()
 
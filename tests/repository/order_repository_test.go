@@ -4,7 +4,7 @@ import (
 	"context"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
-	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/order_errors"
 	"pointofsale/tests"
 	"testing"
 
@@ -31,8 +31,7 @@ func (s *OrderRepositoryTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.dbPool = pool
 
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	ctx := context.Background()
 
@@ -149,6 +148,49 @@ func (s *OrderRepositoryTestSuite) TestOrderLifecycle() {
 	s.Error(err)
 }
 
+func (s *OrderRepositoryTestSuite) TestOrderStatus() {
+	ctx := context.Background()
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: 300,
+	})
+	s.Require().NoError(err)
+	s.Equal("draft", order.Status)
+	orderID := int(order.OrderID)
+
+	paid, err := s.repos.Order.UpdateOrderStatus(ctx, orderID, "draft", "paid")
+	s.NoError(err)
+	s.Equal("paid", paid.Status)
+
+	// A stale expected status must not overwrite a concurrent change.
+	_, err = s.repos.Order.UpdateOrderStatus(ctx, orderID, "draft", "cancelled")
+	s.ErrorIs(err, order_errors.ErrOrderStatusChanged)
+
+	paidOrders, err := s.repos.Order.FindAllOrders(ctx, &requests.FindAllOrders{
+		Page:     1,
+		PageSize: 50,
+		Status:   "paid",
+	})
+	s.NoError(err)
+	s.NotEmpty(paidOrders)
+	for _, o := range paidOrders {
+		s.Equal("paid", o.Status)
+	}
+
+	draftOrders, err := s.repos.Order.FindByMerchant(ctx, &requests.FindAllOrderMerchant{
+		Page:       1,
+		PageSize:   50,
+		MerchantID: s.merchantID,
+		Status:     "draft",
+	})
+	s.NoError(err)
+	for _, o := range draftOrders {
+		s.NotEqual(order.OrderID, o.OrderID)
+	}
+}
+
 func TestOrderRepositorySuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
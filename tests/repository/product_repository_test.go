@@ -5,7 +5,10 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
 	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/product_errors"
 	"pointofsale/tests"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -30,8 +33,7 @@ func (s *ProductRepositoryTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.dbPool = pool
 
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	ctx := context.Background()
 
@@ -131,11 +133,15 @@ func (s *ProductRepositoryTestSuite) TestProductLifecycle() {
 	s.NotNil(updated)
 	s.Equal(updateReq.Name, updated.Name)
 
-	// 5. Update Stock
-	updatedStock, err := s.repos.Product.UpdateProductCountStock(ctx, productID, 30)
+	// 5. Adjust Stock
+	adjusted, err := s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "adjustment",
+		QuantityDelta: -20,
+	})
 	s.NoError(err)
-	s.NotNil(updatedStock)
-	s.Equal(int32(30), updatedStock.CountInStock)
+	s.NotNil(adjusted)
+	s.Equal(int32(30), adjusted.BalanceAfter)
 
 	// 6. Trash Product
 	trashed, err := s.repos.Product.TrashedProduct(ctx, productID)
@@ -171,6 +177,327 @@ func (s *ProductRepositoryTestSuite) TestProductLifecycle() {
 	s.Error(err)
 }
 
+func (s *ProductRepositoryTestSuite) createStockedProduct(slug string, stock int) int {
+	product, err := s.repos.Product.CreateProduct(context.Background(), &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         slug,
+		Description:  "Stock test product",
+		Price:        100,
+		CountInStock: stock,
+		Brand:        "Test Brand",
+		Weight:       100,
+		SlugProduct:  &slug,
+	})
+	s.Require().NoError(err)
+
+	return int(product.ProductID)
+}
+
+func (s *ProductRepositoryTestSuite) TestRecordMovementConcurrently() {
+	ctx := context.Background()
+
+	const (
+		stock   = 10
+		workers = 50
+	)
+
+	productID := s.createStockedProduct("stock-concurrency", stock)
+
+	var (
+		wg           sync.WaitGroup
+		succeeded    atomic.Int32
+		insufficient atomic.Int32
+		unexpected   atomic.Int32
+	)
+
+	start := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			_, err := s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+				ProductID:     productID,
+				Reason:        "sale",
+				QuantityDelta: -1,
+			})
+			switch {
+			case err == nil:
+				succeeded.Add(1)
+			case err == product_errors.ErrInsufficientStock:
+				insufficient.Add(1)
+			default:
+				unexpected.Add(1)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	s.Equal(int32(stock), succeeded.Load())
+	s.Equal(int32(workers-stock), insufficient.Load())
+	s.Zero(unexpected.Load())
+
+	found, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(0), found.CountInStock)
+
+	// Every accepted sale is in the ledger, and nothing else is.
+	movements, err := s.repos.StockMovement.FindByProduct(ctx, &requests.FindStockMovementsRequest{
+		ProductID: productID,
+		Page:      1,
+		PageSize:  workers,
+	})
+	s.Require().NoError(err)
+	s.Len(movements, stock)
+}
+
+func (s *ProductRepositoryTestSuite) TestRecordMovementRejectsOversell() {
+	ctx := context.Background()
+
+	productID := s.createStockedProduct("stock-oversell", 3)
+
+	_, err := s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "sale",
+		QuantityDelta: -4,
+	})
+	s.ErrorIs(err, product_errors.ErrInsufficientStock)
+
+	remaining, err := s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "sale",
+		QuantityDelta: -3,
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(0), remaining.BalanceAfter)
+
+	restocked, err := s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "refund",
+		QuantityDelta: 2,
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(2), restocked.BalanceAfter)
+}
+
+func (s *ProductRepositoryTestSuite) TestStockCheckConstraint() {
+	productID := s.createStockedProduct("stock-check", 1)
+
+	_, err := s.dbPool.Exec(context.Background(),
+		"UPDATE products SET count_in_stock = -1 WHERE product_id = $1", productID)
+	s.Error(err)
+}
+
+func (s *ProductRepositoryTestSuite) TestStockMovementLedger() {
+	ctx := context.Background()
+
+	productID := s.createStockedProduct("stock-ledger", 5)
+
+	opening, err := s.repos.StockMovement.CreateOpeningBalance(ctx, productID, nil)
+	s.Require().NoError(err)
+	s.Equal("initial", opening.Reason)
+	s.Equal(int32(5), opening.BalanceAfter)
+
+	reference := "order"
+	orderID := 1
+
+	sale, err := s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "sale",
+		QuantityDelta: -3,
+		ReferenceType: &reference,
+		ReferenceID:   &orderID,
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(2), sale.BalanceAfter)
+	s.Equal(int32(orderID), *sale.ReferenceID)
+
+	_, err = s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "sale",
+		QuantityDelta: -3,
+	})
+	s.ErrorIs(err, product_errors.ErrInsufficientStock)
+
+	_, err = s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "refund",
+		QuantityDelta: 4,
+	})
+	s.Require().NoError(err)
+
+	found, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(6), found.CountInStock)
+
+	movements, err := s.repos.StockMovement.FindByProduct(ctx, &requests.FindStockMovementsRequest{
+		ProductID: productID,
+		Page:      1,
+		PageSize:  10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 3)
+	s.Equal(int64(3), movements[0].TotalCount)
+
+	var balances []int32
+	for _, movement := range movements {
+		balances = append(balances, movement.BalanceAfter)
+	}
+	s.Equal([]int32{6, 2, 5}, balances)
+}
+
+func (s *ProductRepositoryTestSuite) TestStockMovementUnknownProduct() {
+	_, err := s.repos.StockMovement.RecordMovement(context.Background(), &requests.CreateStockMovementRecordRequest{
+		ProductID:     999999,
+		Reason:        "adjustment",
+		QuantityDelta: 1,
+	})
+	s.ErrorIs(err, product_errors.ErrFindById)
+}
+
+func (s *ProductRepositoryTestSuite) TestLowStockAlerts() {
+	ctx := context.Background()
+
+	productID := s.createStockedProduct("low-stock", 4)
+
+	level, err := s.repos.Product.UpdateReorderLevel(ctx, &requests.UpdateProductReorderLevelRequest{
+		ProductID:       &productID,
+		ReorderPoint:    5,
+		ReorderQuantity: 20,
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(5), level.ReorderPoint)
+	s.Equal(int32(20), level.ReorderQuantity)
+
+	lowStock, err := s.repos.Product.FindLowStock(ctx, &requests.FindLowStockProducts{
+		MerchantID: s.merchantID,
+		Page:       1,
+		PageSize:   50,
+	})
+	s.Require().NoError(err)
+	s.True(containsLowStock(lowStock, productID))
+
+	claimed, err := s.repos.Product.ClaimLowStockAlerts(ctx, 100)
+	s.Require().NoError(err)
+	s.True(containsClaim(claimed, productID))
+
+	again, err := s.repos.Product.ClaimLowStockAlerts(ctx, 100)
+	s.Require().NoError(err)
+	s.False(containsClaim(again, productID))
+
+	s.Require().NoError(s.repos.Product.ReleaseLowStockAlerts(ctx, []int{productID}))
+
+	retried, err := s.repos.Product.ClaimLowStockAlerts(ctx, 100)
+	s.Require().NoError(err)
+	s.True(containsClaim(retried, productID))
+
+	_, err = s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "goods_received",
+		QuantityDelta: 26,
+	})
+	s.Require().NoError(err)
+
+	reset, err := s.repos.Product.ResetLowStockAlerts(ctx)
+	s.Require().NoError(err)
+	s.GreaterOrEqual(reset, int64(1))
+
+	_, err = s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "sale",
+		QuantityDelta: -28,
+	})
+	s.Require().NoError(err)
+
+	rearmed, err := s.repos.Product.ClaimLowStockAlerts(ctx, 100)
+	s.Require().NoError(err)
+	s.True(containsClaim(rearmed, productID))
+
+	missing := 999999
+	_, err = s.repos.Product.UpdateReorderLevel(ctx, &requests.UpdateProductReorderLevelRequest{
+		ProductID:    &missing,
+		ReorderPoint: 1,
+	})
+	s.ErrorIs(err, product_errors.ErrFindById)
+}
+
+func (s *ProductRepositoryTestSuite) TestFindByCategoryIncludesSubcategories() {
+	ctx := context.Background()
+
+	parentSlug := "rollup-parent"
+	parent, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Rollup Parent",
+		Description:  "Parent of the rollup test",
+		SlugCategory: &parentSlug,
+	})
+	s.Require().NoError(err)
+
+	parentID := int(parent.CategoryID)
+	childSlug := "rollup-child"
+	child, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Rollup Child",
+		Description:  "Child of the rollup test",
+		SlugCategory: &childSlug,
+		ParentID:     &parentID,
+	})
+	s.Require().NoError(err)
+
+	for slug, categoryID := range map[string]int32{"rollup-parent-product": parent.CategoryID, "rollup-child-product": child.CategoryID} {
+		_, err := s.repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+			MerchantID:   s.merchantID,
+			CategoryID:   int(categoryID),
+			Name:         slug,
+			Description:  "Rollup test product",
+			Price:        100,
+			CountInStock: 1,
+			Brand:        "Test Brand",
+			Weight:       100,
+			SlugProduct:  &slug,
+		})
+		s.Require().NoError(err)
+	}
+
+	req := &requests.ProductByCategoryRequest{
+		CategoryName: "Rollup Parent",
+		Page:         1,
+		PageSize:     10,
+	}
+
+	// 1. Only the named category by default
+	products, err := s.repos.Product.FindByCategory(ctx, req)
+	s.Require().NoError(err)
+	s.Len(products, 1)
+	s.Equal("rollup-parent-product", products[0].Name)
+
+	// 2. The whole subtree on request
+	req.IncludeSubcategories = true
+	products, err = s.repos.Product.FindByCategory(ctx, req)
+	s.Require().NoError(err)
+	s.Len(products, 2)
+	s.Equal(int64(2), products[0].TotalCount)
+}
+
+func containsLowStock(rows []*db.GetLowStockProductsRow, productID int) bool {
+	for _, row := range rows {
+		if int(row.ProductID) == productID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsClaim(rows []*db.ClaimLowStockAlertsRow, productID int) bool {
+	for _, row := range rows {
+		if int(row.ProductID) == productID {
+			return true
+		}
+	}
+	return false
+}
+
 func TestProductRepositorySuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -0,0 +1,186 @@
+package repository_test
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/errors/product_variant_errors"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductVariantRepositoryTestSuite struct {
+	suite.Suite
+	ts        *tests.TestSuite
+	dbPool    *pgxpool.Pool
+	repos     *repository.Repositories
+	productID int
+}
+
+func (s *ProductVariantRepositoryTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	s.repos = repository.NewRepositories(pool)
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Variant",
+		LastName:  "User",
+		Email:     "variant.user@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Variant Merchant",
+		Description: "A merchant for testing product variants",
+	})
+	s.Require().NoError(err)
+
+	slug := "variant-category"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Variant Category",
+		Description:  "A category for testing product variants",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+
+	productSlug := "variant-product"
+	product, err := s.repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   int(merchant.MerchantID),
+		CategoryID:   int(category.CategoryID),
+		Name:         "Variant Product",
+		Description:  "A product for testing product variants",
+		Price:        1000,
+		CountInStock: 0,
+		Brand:        "Variant Brand",
+		Weight:       100,
+		SlugProduct:  &productSlug,
+		ImageProduct: "variant.jpg",
+	})
+	s.Require().NoError(err)
+	s.productID = int(product.ProductID)
+}
+
+func (s *ProductVariantRepositoryTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *ProductVariantRepositoryTestSuite) TestOptionsKeepOrderAndUniqueNames() {
+	ctx := context.Background()
+
+	options, err := s.repos.ProductVariant.ReplaceOptions(ctx, s.productID, []requests.ProductOptionRequest{
+		{Name: "size", Values: []string{"S", "M", "L"}},
+		{Name: "colour", Values: []string{"red", "blue"}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(options, 2)
+
+	found, err := s.repos.ProductVariant.FindOptions(ctx, s.productID)
+	s.Require().NoError(err)
+	s.Require().Len(found, 2)
+	s.Equal("size", found[0].Name)
+	s.Equal([]string{"S", "M", "L"}, found[0].OptionValues)
+	s.Equal("colour", found[1].Name)
+
+	_, err = s.repos.ProductVariant.ReplaceOptions(ctx, s.productID, []requests.ProductOptionRequest{
+		{Name: "size", Values: []string{"S"}},
+		{Name: "size", Values: []string{"M"}},
+	})
+	s.ErrorIs(err, product_variant_errors.ErrDuplicateOptionName)
+}
+
+func (s *ProductVariantRepositoryTestSuite) TestVariantsAreUniqueAndStockFollowsLedger() {
+	ctx := context.Background()
+
+	price := 1200
+	red, err := s.repos.ProductVariant.CreateVariant(ctx, &requests.CreateProductVariantRecordRequest{
+		ProductID: s.productID,
+		Sku:       "VARIANT-RED-S",
+		Options:   map[string]string{"size": "S", "colour": "red"},
+		Price:     &price,
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(0), red.CountInStock)
+	s.Equal(int32(1200), *red.Price)
+
+	// The SKU is unique across all variants.
+	_, err = s.repos.ProductVariant.CreateVariant(ctx, &requests.CreateProductVariantRecordRequest{
+		ProductID: s.productID,
+		Sku:       "VARIANT-RED-S",
+		Options:   map[string]string{"size": "M", "colour": "red"},
+	})
+	s.ErrorIs(err, product_variant_errors.ErrDuplicateVariant)
+
+	// So is an option combination within a product.
+	_, err = s.repos.ProductVariant.CreateVariant(ctx, &requests.CreateProductVariantRecordRequest{
+		ProductID: s.productID,
+		Sku:       "VARIANT-RED-S-2",
+		Options:   map[string]string{"colour": "red", "size": "S"},
+	})
+	s.ErrorIs(err, product_variant_errors.ErrDuplicateVariant)
+
+	blue, err := s.repos.ProductVariant.CreateVariant(ctx, &requests.CreateProductVariantRecordRequest{
+		ProductID: s.productID,
+		Sku:       "VARIANT-BLUE-S",
+		Options:   map[string]string{"size": "S", "colour": "blue"},
+	})
+	s.Require().NoError(err)
+	s.Nil(blue.Price)
+
+	redID := int(red.VariantID)
+	blueID := int(blue.VariantID)
+
+	for _, movement := range []requests.CreateStockMovementRecordRequest{
+		{ProductID: s.productID, VariantID: &redID, Reason: "adjustment", QuantityDelta: 4},
+		{ProductID: s.productID, VariantID: &blueID, Reason: "adjustment", QuantityDelta: 6},
+		{ProductID: s.productID, VariantID: &redID, Reason: "sale", QuantityDelta: -1},
+	} {
+		_, err = s.repos.StockMovement.RecordMovement(ctx, &movement)
+		s.Require().NoError(err)
+	}
+
+	variants, err := s.repos.ProductVariant.FindByProduct(ctx, s.productID)
+	s.Require().NoError(err)
+	s.Require().Len(variants, 2)
+	s.Equal(int32(3), variants[0].CountInStock)
+	s.Equal(int32(6), variants[1].CountInStock)
+
+	product, err := s.repos.Product.FindById(ctx, s.productID)
+	s.Require().NoError(err)
+	s.Equal(int32(9), product.CountInStock)
+
+	count, err := s.repos.ProductVariant.CountByProduct(ctx, s.productID)
+	s.Require().NoError(err)
+	s.Equal(2, count)
+
+	// A variant holding stock cannot be removed.
+	_, err = s.repos.ProductVariant.TrashVariant(ctx, blueID)
+	s.ErrorIs(err, product_variant_errors.ErrVariantHasStock)
+
+	_, err = s.repos.ProductVariant.TrashVariant(ctx, 999999)
+	s.ErrorIs(err, product_variant_errors.ErrVariantNotFound)
+}
+
+func TestProductVariantRepositorySuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(ProductVariantRepositoryTestSuite))
+}
@@ -0,0 +1,216 @@
+package repository_test
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/errors/purchase_order_errors"
+	"pointofsale/pkg/errors/supplier_errors"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+type PurchaseOrderRepositoryTestSuite struct {
+	suite.Suite
+	ts         *tests.TestSuite
+	dbPool     *pgxpool.Pool
+	repos      *repository.Repositories
+	merchantID int
+	productID  int
+}
+
+func (s *PurchaseOrderRepositoryTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	s.repos = repository.NewRepositories(pool)
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Purchase",
+		LastName:  "User",
+		Email:     "purchase.user@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Purchase Merchant",
+		Description: "A merchant for testing purchase orders",
+	})
+	s.Require().NoError(err)
+	s.merchantID = int(merchant.MerchantID)
+
+	slug := "purchase-category"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Purchase Category",
+		Description:  "A category for testing purchase orders",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+
+	productSlug := "purchase-product"
+	product, err := s.repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   int(category.CategoryID),
+		Name:         "Purchase Product",
+		Description:  "A product for testing purchase orders",
+		Price:        1000,
+		CountInStock: 10,
+		Brand:        "Purchase Brand",
+		Weight:       100,
+		SlugProduct:  &productSlug,
+		ImageProduct: "purchase.jpg",
+	})
+	s.Require().NoError(err)
+	s.productID = int(product.ProductID)
+}
+
+func (s *PurchaseOrderRepositoryTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *PurchaseOrderRepositoryTestSuite) TestSupplierLifecycle() {
+	ctx := context.Background()
+
+	email := "sales@acme.example.com"
+	created, err := s.repos.Supplier.CreateSupplier(ctx, &requests.CreateSupplierRequest{
+		MerchantID: s.merchantID,
+		Name:       "Acme Supply",
+		Email:      &email,
+	})
+	s.Require().NoError(err)
+	id := int(created.SupplierID)
+
+	phone := "0800123456"
+	updated, err := s.repos.Supplier.UpdateSupplier(ctx, &requests.UpdateSupplierRequest{
+		SupplierID: &id,
+		Name:       "Acme Supply Co",
+		Email:      &email,
+		Phone:      &phone,
+	})
+	s.Require().NoError(err)
+	s.Equal("Acme Supply Co", updated.Name)
+	s.Equal(phone, *updated.Phone)
+
+	all, err := s.repos.Supplier.FindAllSuppliers(ctx, &requests.FindAllSuppliers{
+		Search:     "Acme",
+		MerchantID: s.merchantID,
+		Page:       1,
+		PageSize:   10,
+	})
+	s.Require().NoError(err)
+	s.Len(all, 1)
+
+	_, err = s.repos.Supplier.TrashedSupplier(ctx, id)
+	s.Require().NoError(err)
+
+	_, err = s.repos.Supplier.FindById(ctx, id)
+	s.ErrorIs(err, supplier_errors.ErrSupplierNotFound)
+
+	_, err = s.repos.Supplier.RestoreSupplier(ctx, id)
+	s.Require().NoError(err)
+
+	_, err = s.repos.Supplier.TrashedSupplier(ctx, id)
+	s.Require().NoError(err)
+
+	deleted, err := s.repos.Supplier.DeleteSupplierPermanent(ctx, id)
+	s.NoError(err)
+	s.True(deleted)
+}
+
+func (s *PurchaseOrderRepositoryTestSuite) TestReceiveItemGuardsQuantityAndStatus() {
+	ctx := context.Background()
+
+	supplier, err := s.repos.Supplier.CreateSupplier(ctx, &requests.CreateSupplierRequest{
+		MerchantID: s.merchantID,
+		Name:       "Receiving Supplier",
+	})
+	s.Require().NoError(err)
+
+	purchaseOrder, err := s.repos.PurchaseOrder.CreatePurchaseOrder(ctx, &requests.CreatePurchaseOrderRecordRequest{
+		MerchantID: s.merchantID,
+		SupplierID: int(supplier.SupplierID),
+	})
+	s.Require().NoError(err)
+	s.Equal("draft", purchaseOrder.Status)
+	poID := int(purchaseOrder.PurchaseOrderID)
+
+	item, err := s.repos.PurchaseOrder.CreatePurchaseOrderItem(ctx, &requests.CreatePurchaseOrderItemRecordRequest{
+		PurchaseOrderID: poID,
+		ProductID:       s.productID,
+		QuantityOrdered: 10,
+		UnitCost:        700,
+	})
+	s.Require().NoError(err)
+	itemID := int(item.PurchaseOrderItemID)
+
+	// A product appears on a purchase order at most once.
+	_, err = s.repos.PurchaseOrder.CreatePurchaseOrderItem(ctx, &requests.CreatePurchaseOrderItemRecordRequest{
+		PurchaseOrderID: poID,
+		ProductID:       s.productID,
+		QuantityOrdered: 5,
+		UnitCost:        700,
+	})
+	s.ErrorIs(err, purchase_order_errors.ErrDuplicatePurchaseOrderItem)
+
+	ordered, err := s.repos.PurchaseOrder.UpdatePurchaseOrderStatus(ctx, poID, "draft", "ordered")
+	s.Require().NoError(err)
+	s.Equal("ordered", ordered.Status)
+	s.True(ordered.OrderedAt.Valid)
+
+	// The transition was already applied, so replaying it loses the race.
+	_, err = s.repos.PurchaseOrder.UpdatePurchaseOrderStatus(ctx, poID, "draft", "ordered")
+	s.ErrorIs(err, purchase_order_errors.ErrPurchaseOrderStatusChanged)
+
+	received, err := s.repos.PurchaseOrder.ReceiveItem(ctx, poID, itemID, 6)
+	s.Require().NoError(err)
+	s.Equal(int32(6), received.QuantityReceived)
+
+	_, err = s.repos.PurchaseOrder.ReceiveItem(ctx, poID, itemID, 5)
+	s.ErrorIs(err, purchase_order_errors.ErrOverReceived)
+
+	_, err = s.repos.PurchaseOrder.CreateReceipt(ctx, &requests.CreatePurchaseOrderReceiptRecordRequest{
+		PurchaseOrderItemID: itemID,
+		Quantity:            6,
+		UnitCost:            700,
+	})
+	s.Require().NoError(err)
+
+	receipts, err := s.repos.PurchaseOrder.FindReceipts(ctx, poID)
+	s.Require().NoError(err)
+	s.Require().Len(receipts, 1)
+	s.Equal(int32(s.productID), receipts[0].ProductID)
+	s.Equal(int32(6), receipts[0].Quantity)
+
+	all, err := s.repos.PurchaseOrder.FindAllPurchaseOrders(ctx, &requests.FindAllPurchaseOrders{
+		MerchantID: s.merchantID,
+		Status:     "ordered",
+		Page:       1,
+		PageSize:   10,
+	})
+	s.Require().NoError(err)
+	s.Len(all, 1)
+}
+
+func TestPurchaseOrderRepositorySuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(PurchaseOrderRepositoryTestSuite))
+}
@@ -0,0 +1,178 @@
+package repository_test
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/errors/stocktake_errors"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+type StocktakeRepositoryTestSuite struct {
+	suite.Suite
+	ts         *tests.TestSuite
+	dbPool     *pgxpool.Pool
+	repos      *repository.Repositories
+	merchantID int
+	categoryID int
+	productID  int
+}
+
+func (s *StocktakeRepositoryTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	s.repos = repository.NewRepositories(pool)
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Stocktake",
+		LastName:  "User",
+		Email:     "stocktake.user@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Stocktake Merchant",
+		Description: "A merchant for testing stocktakes",
+	})
+	s.Require().NoError(err)
+	s.merchantID = int(merchant.MerchantID)
+
+	slug := "stocktake-category"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Stocktake Category",
+		Description:  "A category for testing stocktakes",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+	s.categoryID = int(category.CategoryID)
+
+	productSlug := "stocktake-product"
+	barcode := "4006381333931"
+	product, err := s.repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Stocktake Product",
+		Description:  "A product for testing stocktakes",
+		Price:        1000,
+		CountInStock: 10,
+		Brand:        "Stocktake Brand",
+		Weight:       100,
+		SlugProduct:  &productSlug,
+		ImageProduct: "stocktake.jpg",
+		Barcode:      &barcode,
+	})
+	s.Require().NoError(err)
+	s.productID = int(product.ProductID)
+}
+
+func (s *StocktakeRepositoryTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *StocktakeRepositoryTestSuite) TestSnapshotCountAndPost() {
+	ctx := context.Background()
+
+	stocktake, err := s.repos.Stocktake.CreateStocktake(ctx, &requests.CreateStocktakeRecordRequest{
+		MerchantID: s.merchantID,
+		CategoryID: &s.categoryID,
+	})
+	s.Require().NoError(err)
+	s.Equal("open", stocktake.Status)
+	id := int(stocktake.StocktakeID)
+
+	// A merchant counts one session at a time.
+	_, err = s.repos.Stocktake.CreateStocktake(ctx, &requests.CreateStocktakeRecordRequest{
+		MerchantID: s.merchantID,
+	})
+	s.ErrorIs(err, stocktake_errors.ErrStocktakeAlreadyOpen)
+
+	snapshotted, err := s.repos.Stocktake.CreateItems(ctx, id)
+	s.Require().NoError(err)
+	s.Equal(int64(1), snapshotted)
+
+	product, err := s.repos.Product.FindByBarcode(ctx, "4006381333931")
+	s.Require().NoError(err)
+	s.Equal(int32(s.productID), product.ProductID)
+
+	counted, err := s.repos.Stocktake.UpdateCount(ctx, &requests.UpdateStocktakeCountRecordRequest{
+		StocktakeID:     id,
+		ProductID:       s.productID,
+		CountedQuantity: 7,
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(10), counted.ExpectedQuantity)
+	s.Equal(int32(7), *counted.CountedQuantity)
+
+	_, err = s.repos.Stocktake.UpdateCount(ctx, &requests.UpdateStocktakeCountRecordRequest{
+		StocktakeID:     id,
+		ProductID:       s.productID + 1000,
+		CountedQuantity: 1,
+	})
+	s.ErrorIs(err, stocktake_errors.ErrProductNotInStocktake)
+
+	items, err := s.repos.Stocktake.FindItems(ctx, &requests.FindStocktakeItems{
+		StocktakeID: id,
+		Page:        1,
+		PageSize:    10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Equal(int32(-3), items[0].Variance)
+	s.Equal(int64(-3000), items[0].VarianceValue)
+
+	variance, err := s.repos.Stocktake.FindVarianceByCategory(ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(variance, 1)
+	s.Equal(int32(-3), variance[0].VarianceQuantity)
+	s.Equal(int64(-3000), variance[0].VarianceValue)
+
+	posted, err := s.repos.Stocktake.UpdateStocktakeStatus(ctx, id, "open", "posted", nil)
+	s.Require().NoError(err)
+	s.Equal("posted", posted.Status)
+	s.True(posted.PostedAt.Valid)
+
+	// The transition was already applied, so replaying it loses the race.
+	_, err = s.repos.Stocktake.UpdateStocktakeStatus(ctx, id, "open", "cancelled", nil)
+	s.ErrorIs(err, stocktake_errors.ErrStocktakeStatusChanged)
+
+	all, err := s.repos.Stocktake.FindAllStocktakes(ctx, &requests.FindAllStocktakes{
+		MerchantID: s.merchantID,
+		Status:     "posted",
+		Page:       1,
+		PageSize:   10,
+	})
+	s.Require().NoError(err)
+	s.Len(all, 1)
+
+	// Once the session is closed the merchant can open another one.
+	_, err = s.repos.Stocktake.CreateStocktake(ctx, &requests.CreateStocktakeRecordRequest{
+		MerchantID: s.merchantID,
+	})
+	s.NoError(err)
+}
+
+func TestStocktakeRepositorySuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(StocktakeRepositoryTestSuite))
+}
@@ -0,0 +1,201 @@
+package repository_test
+
+import (
+	"context"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/errors/tax_errors"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+type TaxRepositoryTestSuite struct {
+	suite.Suite
+	ts         *tests.TestSuite
+	dbPool     *pgxpool.Pool
+	repos      *repository.Repositories
+	merchantID int
+	categoryID int
+}
+
+func (s *TaxRepositoryTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	s.repos = repository.NewRepositories(pool)
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Tax",
+		LastName:  "User",
+		Email:     "tax.user@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Tax Merchant",
+		Description: "A merchant for testing tax rates",
+	})
+	s.Require().NoError(err)
+	s.merchantID = int(merchant.MerchantID)
+
+	slug := "tax-category"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Tax Category",
+		Description:  "A category for testing tax rates",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+	s.categoryID = int(category.CategoryID)
+}
+
+func (s *TaxRepositoryTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *TaxRepositoryTestSuite) TestFindApplicablePrefersMostSpecificRate() {
+	ctx := context.Background()
+
+	// The migration seeds the global PPN rate.
+	rate, err := s.repos.TaxRate.FindApplicable(ctx, s.merchantID, s.categoryID)
+	s.Require().NoError(err)
+	s.Equal("PPN", rate.Name)
+	s.Nil(rate.MerchantID)
+	s.Nil(rate.CategoryID)
+
+	categoryRate, err := s.repos.TaxRate.CreateTaxRate(ctx, &requests.CreateTaxRateRequest{
+		Name:       "Category rate",
+		CategoryID: &s.categoryID,
+		RateBps:    500,
+		Rounding:   "half_up",
+	})
+	s.Require().NoError(err)
+
+	rate, err = s.repos.TaxRate.FindApplicable(ctx, s.merchantID, s.categoryID)
+	s.Require().NoError(err)
+	s.Equal(categoryRate.TaxRateID, rate.TaxRateID)
+
+	merchantRate, err := s.repos.TaxRate.CreateTaxRate(ctx, &requests.CreateTaxRateRequest{
+		Name:       "Merchant rate",
+		MerchantID: &s.merchantID,
+		RateBps:    1000,
+		Rounding:   "half_even",
+	})
+	s.Require().NoError(err)
+
+	rate, err = s.repos.TaxRate.FindApplicable(ctx, s.merchantID, s.categoryID)
+	s.Require().NoError(err)
+	s.Equal(merchantRate.TaxRateID, rate.TaxRateID)
+
+	bothRate, err := s.repos.TaxRate.CreateTaxRate(ctx, &requests.CreateTaxRateRequest{
+		Name:        "Merchant category rate",
+		MerchantID:  &s.merchantID,
+		CategoryID:  &s.categoryID,
+		RateBps:     0,
+		IsInclusive: true,
+		Rounding:    "down",
+	})
+	s.Require().NoError(err)
+
+	rate, err = s.repos.TaxRate.FindApplicable(ctx, s.merchantID, s.categoryID)
+	s.Require().NoError(err)
+	s.Equal(bothRate.TaxRateID, rate.TaxRateID)
+
+	// Trashed rates are ignored.
+	_, err = s.repos.TaxRate.TrashedTaxRate(ctx, int(bothRate.TaxRateID))
+	s.Require().NoError(err)
+
+	rate, err = s.repos.TaxRate.FindApplicable(ctx, s.merchantID, s.categoryID)
+	s.Require().NoError(err)
+	s.Equal(merchantRate.TaxRateID, rate.TaxRateID)
+
+	for _, id := range []int32{bothRate.TaxRateID, merchantRate.TaxRateID, categoryRate.TaxRateID} {
+		_, _ = s.repos.TaxRate.TrashedTaxRate(ctx, int(id))
+		_, err = s.repos.TaxRate.DeleteTaxRatePermanent(ctx, int(id))
+		s.NoError(err)
+	}
+}
+
+func (s *TaxRepositoryTestSuite) TestTaxRateLifecycle() {
+	ctx := context.Background()
+
+	created, err := s.repos.TaxRate.CreateTaxRate(ctx, &requests.CreateTaxRateRequest{
+		Name:       "Lifecycle rate",
+		MerchantID: &s.merchantID,
+		RateBps:    1100,
+		Rounding:   "half_up",
+	})
+	s.Require().NoError(err)
+	id := int(created.TaxRateID)
+
+	// Only one active rate per scope.
+	_, err = s.repos.TaxRate.CreateTaxRate(ctx, &requests.CreateTaxRateRequest{
+		Name:       "Duplicate rate",
+		MerchantID: &s.merchantID,
+		RateBps:    1200,
+		Rounding:   "half_up",
+	})
+	s.ErrorIs(err, tax_errors.ErrTaxRateConflict)
+
+	updated, err := s.repos.TaxRate.UpdateTaxRate(ctx, &requests.UpdateTaxRateRequest{
+		TaxRateID:   &id,
+		Name:        "Lifecycle rate updated",
+		MerchantID:  &s.merchantID,
+		RateBps:     1200,
+		IsInclusive: true,
+		Rounding:    "up",
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(1200), updated.RateBps)
+	s.True(updated.IsInclusive)
+	s.Equal("up", updated.Rounding)
+
+	all, err := s.repos.TaxRate.FindAllTaxRates(ctx, &requests.FindAllTaxRates{
+		Search:   "Lifecycle",
+		Page:     1,
+		PageSize: 10,
+	})
+	s.Require().NoError(err)
+	s.Len(all, 1)
+
+	trashed, err := s.repos.TaxRate.TrashedTaxRate(ctx, id)
+	s.Require().NoError(err)
+	s.True(trashed.DeletedAt.Valid)
+
+	_, err = s.repos.TaxRate.FindById(ctx, id)
+	s.ErrorIs(err, tax_errors.ErrTaxRateNotFound)
+
+	restored, err := s.repos.TaxRate.RestoreTaxRate(ctx, id)
+	s.Require().NoError(err)
+	s.False(restored.DeletedAt.Valid)
+
+	_, err = s.repos.TaxRate.TrashedTaxRate(ctx, id)
+	s.Require().NoError(err)
+
+	deleted, err := s.repos.TaxRate.DeleteTaxRatePermanent(ctx, id)
+	s.NoError(err)
+	s.True(deleted)
+}
+
+func TestTaxRepositorySuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(TaxRepositoryTestSuite))
+}
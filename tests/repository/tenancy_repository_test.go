@@ -0,0 +1,269 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/pkg/auth"
+	"pointofsale/pkg/database"
+	"pointofsale/pkg/errors/cashier_errors"
+	"pointofsale/pkg/errors/merchant_errors"
+	"pointofsale/pkg/errors/order_errors"
+	"pointofsale/tests"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+type tenant struct {
+	userID     int
+	merchantID int
+	cashierID  int
+	orderID    int
+}
+
+type TenancyRepositoryTestSuite struct {
+	suite.Suite
+	ts     *tests.TestSuite
+	dbPool *pgxpool.Pool
+	repos  *repository.Repositories
+
+	merchantA tenant
+	merchantB tenant
+
+	// staffUserID works as a cashier at merchant B without owning a merchant.
+	staffUserID int
+}
+
+func (s *TenancyRepositoryTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	config, err := pgxpool.ParseConfig(s.ts.DBURL)
+	s.Require().NoError(err)
+	database.ScopeConnections(config)
+
+	pool, err := pgxpool.NewWithConfig(s.ts.Ctx, config)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	s.repos = repository.NewRepositories(pool)
+
+	ctx := context.Background()
+
+	slug := "tenancy-cat"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Tenancy Cat",
+		Description:  "Global category shared by both merchants",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+
+	s.merchantA = s.createTenant(ctx, "a", int(category.CategoryID), 100)
+	s.merchantB = s.createTenant(ctx, "b", int(category.CategoryID), 900)
+
+	staff, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Tenancy",
+		LastName:  "Staff",
+		Email:     "tenancy.staff@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+	s.staffUserID = int(staff.UserID)
+
+	_, err = s.repos.Cashier.CreateCashier(ctx, &requests.CreateCashierRequest{
+		MerchantID: s.merchantB.merchantID,
+		UserID:     s.staffUserID,
+		Name:       "Tenancy Staff",
+	})
+	s.Require().NoError(err)
+}
+
+// createTenant creates a merchant owned by a new user, with a cashier and one
+// order of price.
+func (s *TenancyRepositoryTestSuite) createTenant(ctx context.Context, name string, categoryID int, price int) tenant {
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Tenancy",
+		LastName:  name,
+		Email:     fmt.Sprintf("tenancy.%s@example.com", name),
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Tenancy Merchant " + name,
+		Description: "A merchant for testing tenancy",
+	})
+	s.Require().NoError(err)
+
+	slug := "tenancy-prod-" + name
+	product, err := s.repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   int(merchant.MerchantID),
+		CategoryID:   categoryID,
+		Name:         "Tenancy Prod " + name,
+		Description:  "A product for testing tenancy",
+		Price:        price,
+		CountInStock: 100,
+		Brand:        "Test Brand",
+		Weight:       1000,
+		SlugProduct:  &slug,
+	})
+	s.Require().NoError(err)
+
+	cashier, err := s.repos.Cashier.CreateCashier(ctx, &requests.CreateCashierRequest{
+		MerchantID: int(merchant.MerchantID),
+		UserID:     int(user.UserID),
+		Name:       "Tenancy Cashier " + name,
+	})
+	s.Require().NoError(err)
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: int(merchant.MerchantID),
+		CashierID:  int(cashier.CashierID),
+		TotalPrice: price,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   int(order.OrderID),
+		ProductID: int(product.ProductID),
+		Quantity:  1,
+		Price:     price,
+	})
+	s.Require().NoError(err)
+
+	return tenant{
+		userID:     int(user.UserID),
+		merchantID: int(merchant.MerchantID),
+		cashierID:  int(cashier.CashierID),
+		orderID:    int(order.OrderID),
+	}
+}
+
+func (s *TenancyRepositoryTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *TenancyRepositoryTestSuite) scopeOfA() context.Context {
+	return auth.WithMerchantScope(context.Background(), []int{s.merchantA.merchantID})
+}
+
+func (s *TenancyRepositoryTestSuite) TestFindScopeByUser() {
+	ctx := context.Background()
+
+	scope, err := s.repos.Merchant.FindScopeByUser(ctx, s.merchantA.userID)
+	s.NoError(err)
+	s.Equal([]int{s.merchantA.merchantID}, scope)
+
+	scope, err = s.repos.Merchant.FindScopeByUser(ctx, s.staffUserID)
+	s.NoError(err)
+	s.Equal([]int{s.merchantB.merchantID}, scope)
+}
+
+func (s *TenancyRepositoryTestSuite) TestListingsOnlyShowOwnOrders() {
+	ctx := s.scopeOfA()
+
+	orders, err := s.repos.Order.FindAllOrders(ctx, &requests.FindAllOrders{
+		Page:     1,
+		PageSize: 50,
+	})
+	s.NoError(err)
+	s.NotEmpty(orders)
+	for _, o := range orders {
+		s.Equal(int32(s.merchantA.merchantID), o.MerchantID)
+	}
+
+	byMerchant, err := s.repos.Order.FindByMerchant(ctx, &requests.FindAllOrderMerchant{
+		Page:       1,
+		PageSize:   50,
+		MerchantID: s.merchantB.merchantID,
+	})
+	s.NoError(err)
+	s.Empty(byMerchant)
+
+	cashiers, err := s.repos.Cashier.FindAllCashiers(ctx, &requests.FindAllCashiers{
+		Page:     1,
+		PageSize: 50,
+	})
+	s.NoError(err)
+	for _, c := range cashiers {
+		s.Equal(int32(s.merchantA.merchantID), c.MerchantID)
+	}
+
+	// Without a scope every merchant is visible.
+	all, err := s.repos.Order.FindAllOrders(context.Background(), &requests.FindAllOrders{
+		Page:     1,
+		PageSize: 50,
+	})
+	s.NoError(err)
+	s.Len(all, 2)
+}
+
+func (s *TenancyRepositoryTestSuite) TestOtherMerchantIsNotFound() {
+	ctx := s.scopeOfA()
+
+	_, err := s.repos.Order.FindById(ctx, s.merchantB.orderID)
+	s.ErrorIs(err, order_errors.ErrOrderNotFound)
+
+	_, err = s.repos.Order.UpdateOrder(ctx, &requests.UpdateOrderRecordRequest{
+		OrderID:    s.merchantB.orderID,
+		TotalPrice: 1,
+	})
+	s.ErrorIs(err, order_errors.ErrOrderNotFound)
+
+	_, err = s.repos.Order.TrashedOrder(ctx, s.merchantB.orderID)
+	s.ErrorIs(err, order_errors.ErrOrderNotFound)
+
+	_, err = s.repos.Merchant.FindById(ctx, s.merchantB.merchantID)
+	s.ErrorIs(err, merchant_errors.ErrMerchantNotFound)
+
+	_, err = s.repos.Cashier.FindById(ctx, s.merchantB.cashierID)
+	s.ErrorIs(err, cashier_errors.ErrCashierNotFound)
+
+	items, err := s.repos.OrderItem.FindOrderItemByOrder(ctx, s.merchantB.orderID)
+	s.NoError(err)
+	s.Empty(items)
+
+	// B's order is untouched.
+	order, err := s.repos.Order.FindById(context.Background(), s.merchantB.orderID)
+	s.NoError(err)
+	s.Equal(int64(900), order.TotalPrice)
+}
+
+func (s *TenancyRepositoryTestSuite) TestStatisticsOnlyCountOwnOrders() {
+	year := time.Now().Year()
+
+	scoped, err := s.repos.Order.GetYearlyOrder(s.scopeOfA(), year)
+	s.NoError(err)
+	s.Require().NotEmpty(scoped)
+	s.Equal(int64(1), scoped[0].OrderCount)
+	s.Equal(float64(100), scoped[0].TotalRevenue)
+
+	all, err := s.repos.Order.GetYearlyOrder(context.Background(), year)
+	s.NoError(err)
+	s.Require().NotEmpty(all)
+	s.Equal(int64(2), all[0].OrderCount)
+
+	none, err := s.repos.Order.GetYearlyOrder(auth.WithMerchantScope(context.Background(), nil), year)
+	s.NoError(err)
+	for _, row := range none {
+		s.Zero(row.OrderCount)
+	}
+}
+
+func TestTenancyRepositorySuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(TenancyRepositoryTestSuite))
+}
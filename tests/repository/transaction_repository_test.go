@@ -4,7 +4,7 @@ import (
 	"context"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
-	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/transaction_errors"
 	"pointofsale/tests"
 	"testing"
 
@@ -32,8 +32,7 @@ func (s *TransactionRepositoryTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.dbPool = pool
 
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	ctx := context.Background()
 
@@ -215,6 +214,142 @@ func (s *TransactionRepositoryTestSuite) TestTransactionReporting() {
 	s.NotNil(monthlyMerchant)
 }
 
+func (s *TransactionRepositoryTestSuite) TestTransactionPayments() {
+	ctx := context.Background()
+	statusSuccess := "success"
+
+	trans, err := s.repos.Transaction.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       s.orderID,
+		MerchantID:    s.merchantID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "split",
+		Amount:        1000,
+		PaymentStatus: &statusSuccess,
+	})
+	s.Require().NoError(err)
+	transID := int(trans.TransactionID)
+
+	reference := "APPR-001"
+
+	// 1. Record tender lines
+	_, err = s.repos.Transaction.CreatePayment(ctx, &requests.CreateTransactionPaymentRecordRequest{
+		TransactionID: transID,
+		PaymentMethod: "card",
+		Amount:        600,
+		Reference:     &reference,
+	})
+	s.NoError(err)
+
+	cash, err := s.repos.Transaction.CreatePayment(ctx, &requests.CreateTransactionPaymentRecordRequest{
+		TransactionID: transID,
+		PaymentMethod: "cash",
+		Amount:        500,
+		ChangeAmount:  100,
+	})
+	s.NoError(err)
+	s.Nil(cash.Reference)
+
+	// 2. Change can never exceed the tendered amount
+	_, err = s.repos.Transaction.CreatePayment(ctx, &requests.CreateTransactionPaymentRecordRequest{
+		TransactionID: transID,
+		PaymentMethod: "cash",
+		Amount:        100,
+		ChangeAmount:  200,
+	})
+	s.ErrorIs(err, transaction_errors.ErrCreateTransactionPayment)
+
+	// 3. Find the tender lines in the order they were recorded
+	payments, err := s.repos.Transaction.FindPayments(ctx, transID)
+	s.NoError(err)
+	s.Require().Len(payments, 2)
+	s.Equal("card", payments[0].PaymentMethod)
+	s.Equal(reference, *payments[0].Reference)
+	s.Equal(int32(100), payments[1].ChangeAmount)
+
+	// 4. Delete them
+	s.NoError(s.repos.Transaction.DeletePayments(ctx, transID))
+
+	payments, err = s.repos.Transaction.FindPayments(ctx, transID)
+	s.NoError(err)
+	s.Empty(payments)
+
+	// Tender lines are removed together with their transaction
+	_, err = s.repos.Transaction.CreatePayment(ctx, &requests.CreateTransactionPaymentRecordRequest{
+		TransactionID: transID,
+		PaymentMethod: "cash",
+		Amount:        1000,
+	})
+	s.NoError(err)
+
+	_, err = s.repos.Transaction.TrashTransaction(ctx, transID)
+	s.NoError(err)
+
+	_, err = s.repos.Transaction.DeleteTransactionPermanently(ctx, transID)
+	s.NoError(err)
+
+	payments, err = s.repos.Transaction.FindPayments(ctx, transID)
+	s.NoError(err)
+	s.Empty(payments)
+}
+
+func (s *TransactionRepositoryTestSuite) TestTransactionRefunds() {
+	ctx := context.Background()
+	statusSuccess := "success"
+
+	trans, err := s.repos.Transaction.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       s.orderID,
+		MerchantID:    s.merchantID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        1000,
+		PaymentStatus: &statusSuccess,
+	})
+	s.Require().NoError(err)
+	transID := int(trans.TransactionID)
+
+	note := "customer changed their mind"
+
+	// 1. Record a refund approved by a known user
+	refund, err := s.repos.TransactionRefund.CreateRefund(ctx, &requests.CreateTransactionRefundRecordRequest{
+		TransactionID: transID,
+		Kind:          "refund",
+		Amount:        400,
+		TaxAmount:     40,
+		ReasonCode:    "customer_return",
+		Note:          &note,
+		ApprovedBy:    s.userID,
+		Restock:       true,
+	})
+	s.Require().NoError(err)
+	s.Equal("refund", refund.Kind)
+	s.Equal(note, *refund.Note)
+
+	// 2. Refunds must hand back a positive amount
+	_, err = s.repos.TransactionRefund.CreateRefund(ctx, &requests.CreateTransactionRefundRecordRequest{
+		TransactionID: transID,
+		Kind:          "refund",
+		Amount:        0,
+		ReasonCode:    "other",
+		ApprovedBy:    s.userID,
+	})
+	s.ErrorIs(err, transaction_errors.ErrCreateTransactionRefund)
+
+	// 3. Find them back
+	refunds, err := s.repos.TransactionRefund.FindByTransaction(ctx, transID)
+	s.NoError(err)
+	s.Require().Len(refunds, 1)
+	s.Equal(int32(400), refunds[0].Amount)
+
+	quantities, err := s.repos.TransactionRefund.FindRefundedQuantities(ctx, transID)
+	s.NoError(err)
+	s.Empty(quantities)
+
+	// 4. Move the transaction to its refunded state
+	updated, err := s.repos.Transaction.UpdateTransactionStatus(ctx, transID, "partially_refunded")
+	s.NoError(err)
+	s.Equal("partially_refunded", updated.PaymentStatus)
+}
+
 func TestTransactionRepositorySuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
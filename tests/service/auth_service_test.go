@@ -5,14 +5,16 @@ import (
 	"pointofsale/internal/cache"
 	auth_cache "pointofsale/internal/cache/auth"
 	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/domain/response"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
 	"pointofsale/pkg/auth"
-	db "pointofsale/pkg/database/schema"
+	refreshtoken_errors "pointofsale/pkg/errors/refresh_token_errors"
 	"pointofsale/pkg/hash"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
+	"strconv"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -27,6 +29,8 @@ type AuthServiceTestSuite struct {
 	dbPool      *pgxpool.Pool
 	redisClient *redis.Client
 	authService service.AuthService
+	token       *auth.Manager
+	cache       auth_cache.AuthMencache
 }
 
 func (s *AuthServiceTestSuite) SetupSuite() {
@@ -42,8 +46,7 @@ func (s *AuthServiceTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opts)
 
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -55,6 +58,9 @@ func (s *AuthServiceTestSuite) SetupSuite() {
 	cacheStore := cache.NewCacheStore(s.redisClient, log, cacheMetrics)
 	cacheAuth := auth_cache.NewMencache(cacheStore)
 
+	s.token = tokenManager
+	s.cache = cacheAuth
+
 	s.authService = service.NewAuthService(service.AuthServiceDeps{
 		UserRepo:         repos.User,
 		RefreshTokenRepo: repos.RefreshToken,
@@ -65,12 +71,11 @@ func (s *AuthServiceTestSuite) SetupSuite() {
 		Logger:           log,
 		Observability:    obs,
 		Cache:            cacheAuth,
+		UnitOfWork:       repos.UnitOfWork,
 	})
 
-	// Seed ROLE_ADMIN as it's required for registration
-	_, err = repos.Role.CreateRole(context.Background(), &requests.CreateRoleRequest{
-		Name: "ROLE_ADMIN",
-	})
+	// ROLE_USER, the default role assigned on registration, is seeded by the migrations
+	_, err = repos.Role.FindByName(context.Background(), "ROLE_USER")
 	s.Require().NoError(err)
 }
 
@@ -104,6 +109,115 @@ func (s *AuthServiceTestSuite) TestRegisterAndLogin() {
 	s.NotEmpty(tokenRes.RefreshToken)
 }
 
+func (s *AuthServiceTestSuite) registerAndLogin(email string) *response.TokenResponse {
+	ctx := context.Background()
+
+	_, err := s.authService.Register(ctx, &requests.CreateUserRequest{
+		FirstName: "Session",
+		LastName:  "User",
+		Email:     email,
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	tokens, err := s.authService.Login(ctx, &requests.AuthRequest{Email: email, Password: "password123"})
+	s.Require().NoError(err)
+
+	return tokens
+}
+
+func (s *AuthServiceTestSuite) logoutRequest(accessToken string) *requests.LogoutRequest {
+	claims, err := s.token.ParseToken(accessToken)
+	s.Require().NoError(err)
+
+	userID, err := strconv.Atoi(claims.Subject)
+	s.Require().NoError(err)
+
+	return &requests.LogoutRequest{UserID: userID, TokenID: claims.ID}
+}
+
+func (s *AuthServiceTestSuite) TestLoginStartsNewSessionEachTime() {
+	ctx := context.Background()
+	first := s.registerAndLogin("sessions.login@example.com")
+
+	second, err := s.authService.Login(ctx, &requests.AuthRequest{Email: "sessions.login@example.com", Password: "password123"})
+	s.Require().NoError(err)
+	s.NotEqual(first.RefreshToken, second.RefreshToken)
+
+	sessions, err := s.authService.FindSessions(ctx, s.logoutRequest(first.AccessToken).UserID)
+	s.Require().NoError(err)
+	s.Len(sessions, 2)
+}
+
+func (s *AuthServiceTestSuite) TestRefreshTokenRotationDetectsReuse() {
+	ctx := context.Background()
+	tokens := s.registerAndLogin("sessions.rotation@example.com")
+
+	rotated, err := s.authService.RefreshToken(ctx, tokens.RefreshToken)
+	s.Require().NoError(err)
+	s.NotEqual(tokens.RefreshToken, rotated.RefreshToken)
+
+	// The access token issued with the consumed refresh token is revoked.
+	s.True(s.cache.IsTokenDenied(ctx, s.logoutRequest(tokens.AccessToken).TokenID))
+
+	_, err = s.authService.RefreshToken(ctx, tokens.RefreshToken)
+	s.ErrorIs(err, refreshtoken_errors.ErrRefreshTokenReused)
+
+	// Reuse revokes the whole session, including the latest tokens.
+	_, err = s.authService.RefreshToken(ctx, rotated.RefreshToken)
+	s.ErrorIs(err, refreshtoken_errors.ErrRefreshTokenReused)
+	s.True(s.cache.IsTokenDenied(ctx, s.logoutRequest(rotated.AccessToken).TokenID))
+
+	sessions, err := s.authService.FindSessions(ctx, s.logoutRequest(rotated.AccessToken).UserID)
+	s.Require().NoError(err)
+	s.Empty(sessions)
+}
+
+func (s *AuthServiceTestSuite) TestLogoutRevokesOnlyCurrentSession() {
+	ctx := context.Background()
+	phone := s.registerAndLogin("sessions.logout@example.com")
+
+	laptop, err := s.authService.Login(ctx, &requests.AuthRequest{Email: "sessions.logout@example.com", Password: "password123"})
+	s.Require().NoError(err)
+
+	req := s.logoutRequest(phone.AccessToken)
+
+	ok, err := s.authService.Logout(ctx, req)
+	s.Require().NoError(err)
+	s.True(ok)
+	s.True(s.cache.IsTokenDenied(ctx, req.TokenID))
+	s.False(s.cache.IsTokenDenied(ctx, s.logoutRequest(laptop.AccessToken).TokenID))
+
+	_, err = s.authService.RefreshToken(ctx, phone.RefreshToken)
+	s.Error(err)
+
+	sessions, err := s.authService.FindSessions(ctx, req.UserID)
+	s.Require().NoError(err)
+	s.Require().Len(sessions, 1)
+	s.Equal(s.logoutRequest(laptop.AccessToken).TokenID, sessions[0].AccessTokenID)
+}
+
+func (s *AuthServiceTestSuite) TestLogoutAllRevokesEverySession() {
+	ctx := context.Background()
+	phone := s.registerAndLogin("sessions.logout.all@example.com")
+
+	laptop, err := s.authService.Login(ctx, &requests.AuthRequest{Email: "sessions.logout.all@example.com", Password: "password123"})
+	s.Require().NoError(err)
+
+	req := s.logoutRequest(phone.AccessToken)
+
+	ok, err := s.authService.LogoutAll(ctx, req)
+	s.Require().NoError(err)
+	s.True(ok)
+
+	s.True(s.cache.IsTokenDenied(ctx, req.TokenID))
+	s.True(s.cache.IsTokenDenied(ctx, s.logoutRequest(laptop.AccessToken).TokenID))
+
+	sessions, err := s.authService.FindSessions(ctx, req.UserID)
+	s.Require().NoError(err)
+	s.Empty(sessions)
+}
+
 func TestAuthServiceSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
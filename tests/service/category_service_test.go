@@ -6,11 +6,13 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/category_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
 	"context"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -24,6 +26,7 @@ type CategoryServiceTestSuite struct {
 	ts     *tests.TestSuite
 	dbPool *pgxpool.Pool
 	rdb    *redis.Client
+	repos  *repository.Repositories
 	srv    service.CategoryService
 }
 
@@ -43,8 +46,7 @@ func (s *CategoryServiceTestSuite) SetupSuite() {
 	s.rdb = redis.NewClient(opt)
 
 	// Dependencies
-	queries := db.New(pool)
-	repo := repository.NewCategoryRepository(queries)
+	s.repos = repository.NewRepositories(pool)
 
 
 	logger.ResetInstance()
@@ -63,7 +65,8 @@ func (s *CategoryServiceTestSuite) SetupSuite() {
 
 
 	s.srv = service.NewCategoryService(service.CategoryServiceDeps{
-		CategoryRepo:  repo,
+		CategoryRepo:  s.repos.Category,
+		UnitOfWork:    s.repos.UnitOfWork,
 		Logger:        l,
 		Observability: obs,
 		Cache:         catCache,
@@ -153,6 +156,201 @@ func (s *CategoryServiceTestSuite) TestCategoryLifecycle() {
 	s.Error(err)
 }
 
+func (s *CategoryServiceTestSuite) createCategory(name string, parentID, merchantID *int) int {
+	category, err := s.srv.CreateCategory(context.Background(), &requests.CreateCategoryRequest{
+		Name:        name,
+		Description: name + " category",
+		ParentID:    parentID,
+		MerchantID:  merchantID,
+	})
+	s.Require().NoError(err)
+
+	return int(category.CategoryID)
+}
+
+func (s *CategoryServiceTestSuite) createMerchant(name string) int {
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: name,
+		LastName:  "Owner",
+		Email:     strings.ReplaceAll(strings.ToLower(name), " ", ".") + "@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        name,
+		Description: "A merchant for category service tests",
+	})
+	s.Require().NoError(err)
+
+	return int(merchant.MerchantID)
+}
+
+func (s *CategoryServiceTestSuite) childIDs(parentID int) []int {
+	siblings, err := s.repos.Category.FindSiblings(context.Background(), &parentID, nil)
+	s.Require().NoError(err)
+
+	ids := make([]int, len(siblings))
+	for i, sibling := range siblings {
+		ids[i] = int(sibling.CategoryID)
+	}
+
+	return ids
+}
+
+func (s *CategoryServiceTestSuite) TestCategoryTree() {
+	ctx := context.Background()
+
+	drinks := s.createCategory("Tree Drinks", nil, nil)
+	coffee := s.createCategory("Tree Coffee", &drinks, nil)
+	espresso := s.createCategory("Tree Espresso", &coffee, nil)
+
+	// 1. Every category follows its parent, one level deeper
+	tree, err := s.srv.FindTree(ctx, nil)
+	s.Require().NoError(err)
+
+	depths := map[int]int32{}
+	order := []int{}
+	for _, row := range tree {
+		depths[int(row.CategoryID)] = row.Depth
+		order = append(order, int(row.CategoryID))
+	}
+
+	s.Equal(int32(0), depths[drinks])
+	s.Equal(int32(1), depths[coffee])
+	s.Equal(int32(2), depths[espresso])
+	s.Less(slices.Index(order, drinks), slices.Index(order, coffee))
+	s.Less(slices.Index(order, coffee), slices.Index(order, espresso))
+
+	// 2. A category cannot move into its own subtree
+	_, err = s.srv.MoveCategory(ctx, &requests.MoveCategoryRequest{CategoryID: drinks, ParentID: &espresso})
+	s.ErrorIs(err, category_errors.ErrFailedCategoryCycle)
+
+	_, err = s.srv.MoveCategory(ctx, &requests.MoveCategoryRequest{CategoryID: drinks, ParentID: &drinks})
+	s.ErrorIs(err, category_errors.ErrFailedCategoryCycle)
+
+	// 3. A category with active subcategories cannot be trashed
+	_, err = s.srv.TrashedCategory(ctx, coffee)
+	s.ErrorIs(err, category_errors.ErrFailedCategoryHasChildren)
+
+	// 4. A category cannot be restored below a trashed parent
+	_, err = s.srv.TrashedCategory(ctx, espresso)
+	s.Require().NoError(err)
+	_, err = s.srv.TrashedCategory(ctx, coffee)
+	s.Require().NoError(err)
+
+	_, err = s.srv.RestoreCategory(ctx, espresso)
+	s.ErrorIs(err, category_errors.ErrFailedParentNotFound)
+
+	_, err = s.srv.FindById(ctx, espresso)
+	s.Error(err, "the failed restore must be rolled back")
+
+	_, err = s.srv.RestoreCategory(ctx, coffee)
+	s.Require().NoError(err)
+	_, err = s.srv.RestoreCategory(ctx, espresso)
+	s.NoError(err)
+}
+
+func (s *CategoryServiceTestSuite) TestMoveAndReorderCategories() {
+	ctx := context.Background()
+
+	parent := s.createCategory("Order Parent", nil, nil)
+	a := s.createCategory("Order A", &parent, nil)
+	b := s.createCategory("Order B", &parent, nil)
+	c := s.createCategory("Order C", &parent, nil)
+	s.Equal([]int{a, b, c}, s.childIDs(parent))
+
+	// 1. Moving within the same parent changes the position
+	zero := 0
+	moved, err := s.srv.MoveCategory(ctx, &requests.MoveCategoryRequest{CategoryID: c, ParentID: &parent, Position: &zero})
+	s.Require().NoError(err)
+	s.Equal(int32(0), moved.Position)
+	s.Equal([]int{c, a, b}, s.childIDs(parent))
+
+	// 2. Reordering needs every sibling exactly once
+	_, err = s.srv.ReorderCategories(ctx, &requests.ReorderCategoriesRequest{ParentID: &parent, CategoryIDs: []int{a, b}})
+	s.ErrorIs(err, category_errors.ErrFailedInvalidReorder)
+
+	_, err = s.srv.ReorderCategories(ctx, &requests.ReorderCategoriesRequest{ParentID: &parent, CategoryIDs: []int{a, b, c, c}})
+	s.ErrorIs(err, category_errors.ErrFailedInvalidReorder)
+
+	reordered, err := s.srv.ReorderCategories(ctx, &requests.ReorderCategoriesRequest{ParentID: &parent, CategoryIDs: []int{b, c, a}})
+	s.Require().NoError(err)
+	s.Len(reordered, 3)
+	s.Equal([]int{b, c, a}, s.childIDs(parent))
+
+	// 3. Moving away closes the gap among the old siblings
+	moved, err = s.srv.MoveCategory(ctx, &requests.MoveCategoryRequest{CategoryID: c})
+	s.Require().NoError(err)
+	s.Nil(moved.ParentID)
+	s.Equal([]int{b, a}, s.childIDs(parent))
+
+	siblings, err := s.repos.Category.FindSiblings(ctx, &parent, nil)
+	s.Require().NoError(err)
+	for i, sibling := range siblings {
+		s.Equal(int32(i), sibling.Position)
+	}
+
+	// 4. Moving below another parent without a position appends
+	moved, err = s.srv.MoveCategory(ctx, &requests.MoveCategoryRequest{CategoryID: c, ParentID: &parent})
+	s.Require().NoError(err)
+	s.Equal(int32(2), moved.Position)
+	s.Equal([]int{b, a, c}, s.childIDs(parent))
+}
+
+func (s *CategoryServiceTestSuite) TestMerchantCategories() {
+	ctx := context.Background()
+
+	first := s.createMerchant("Category Merchant One")
+	second := s.createMerchant("Category Merchant Two")
+
+	global := s.createCategory("Scoped Food", nil, nil)
+
+	// 1. Merchants add their own categories below global ones
+	snacks := s.createCategory("Scoped Snacks", &global, &first)
+	otherSnacks := s.createCategory("Scoped Snacks", &global, &second)
+	s.NotEqual(snacks, otherSnacks)
+
+	// 2. Slugs stay unique within a merchant's view
+	_, err := s.srv.CreateCategory(ctx, &requests.CreateCategoryRequest{Name: "Scoped Snacks", Description: "again", MerchantID: &first})
+	s.ErrorIs(err, category_errors.ErrFailedSlugConflict)
+
+	_, err = s.srv.CreateCategory(ctx, &requests.CreateCategoryRequest{Name: "Scoped Food", Description: "again", MerchantID: &first})
+	s.ErrorIs(err, category_errors.ErrFailedSlugConflict)
+
+	// 3. A merchant cannot build on another merchant's category
+	_, err = s.srv.CreateCategory(ctx, &requests.CreateCategoryRequest{Name: "Scoped Chips", Description: "chips", ParentID: &snacks, MerchantID: &second})
+	s.ErrorIs(err, category_errors.ErrFailedParentScope)
+
+	_, err = s.srv.CreateCategory(ctx, &requests.CreateCategoryRequest{Name: "Scoped Chips", Description: "chips", ParentID: &snacks})
+	s.ErrorIs(err, category_errors.ErrFailedParentScope)
+
+	_, err = s.srv.MoveCategory(ctx, &requests.MoveCategoryRequest{CategoryID: otherSnacks, ParentID: &snacks})
+	s.ErrorIs(err, category_errors.ErrFailedParentScope)
+
+	// 4. Each merchant sees the global tree and its own categories only
+	tree, err := s.srv.FindTree(ctx, &first)
+	s.Require().NoError(err)
+
+	visible := map[int]bool{}
+	for _, row := range tree {
+		visible[int(row.CategoryID)] = true
+	}
+
+	s.True(visible[global])
+	s.True(visible[snacks])
+	s.False(visible[otherSnacks])
+
+	tree, err = s.srv.FindTree(ctx, nil)
+	s.Require().NoError(err)
+
+	for _, row := range tree {
+		s.Nil(row.MerchantID)
+	}
+}
 
 func TestCategoryServiceSuite(t *testing.T) {
 	if testing.Short() {
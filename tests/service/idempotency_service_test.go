@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"context"
+	"pointofsale/internal/cache"
+	idempotency_cache "pointofsale/internal/cache/idempotency"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/errors/idempotency_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type IdempotencyServiceTestSuite struct {
+	suite.Suite
+	ts          *tests.TestSuite
+	dbPool      *pgxpool.Pool
+	redisClient *redis.Client
+	repos       *repository.Repositories
+	service     service.IdempotencyService
+	userID      int
+}
+
+func (s *IdempotencyServiceTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opt, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opt)
+
+	s.repos = repository.NewRepositories(pool)
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	l, err := logger.NewLogger("test-idempotency-service", lp)
+	s.Require().NoError(err)
+
+	obs, err := observability.NewObservability("test-idempotency-service", l)
+	s.Require().NoError(err)
+
+	cacheMetrics, err := observability.NewCacheMetrics("test-idempotency-service")
+	s.Require().NoError(err)
+	cacheStore := cache.NewCacheStore(s.redisClient, l, cacheMetrics)
+
+	s.service = service.NewIdempotencyService(service.IdempotencyServiceDeps{
+		IdempotencyKeyRepo: s.repos.IdempotencyKey,
+		Logger:             l,
+		Observability:      obs,
+		Cache:              idempotency_cache.NewIdempotencyCache(cacheStore),
+	})
+
+	user, err := s.repos.User.CreateUser(context.Background(), &requests.CreateUserRequest{
+		FirstName: "IdempotencySvc",
+		LastName:  "User",
+		Email:     "idempotency.svc@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+	s.userID = int(user.UserID)
+}
+
+func (s *IdempotencyServiceTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *IdempotencyServiceTestSuite) request(key, hash string) *requests.IdempotencyRequest {
+	return &requests.IdempotencyRequest{
+		UserID:      s.userID,
+		Method:      "/pb.TransactionService/Create",
+		Key:         key,
+		RequestHash: strings.Repeat(hash, 64),
+	}
+}
+
+func (s *IdempotencyServiceTestSuite) TestBeginReplaysCompletedRequest() {
+	ctx := context.Background()
+
+	// 1. The first attempt claims the key
+	claim, err := s.service.Begin(ctx, s.request("replay", "a"))
+	s.Require().NoError(err)
+	s.Nil(claim.Response)
+
+	// 2. A concurrent retry is turned away while the claim is open
+	_, err = s.service.Begin(ctx, s.request("replay", "a"))
+	s.ErrorIs(err, idempotency_errors.ErrIdempotencyKeyInProgress)
+
+	// 3. After completion the retry gets the stored response
+	s.Require().NoError(s.service.Complete(ctx, claim, []byte("first response")))
+
+	replay, err := s.service.Begin(ctx, s.request("replay", "a"))
+	s.NoError(err)
+	s.Equal([]byte("first response"), replay.Response)
+
+	// 4. The cached replay still checks the payload
+	_, err = s.service.Begin(ctx, s.request("replay", "b"))
+	s.ErrorIs(err, idempotency_errors.ErrIdempotencyKeyReused)
+}
+
+func (s *IdempotencyServiceTestSuite) TestReusedKeyIsRejectedWhileInProgress() {
+	ctx := context.Background()
+
+	_, err := s.service.Begin(ctx, s.request("reused", "a"))
+	s.Require().NoError(err)
+
+	_, err = s.service.Begin(ctx, s.request("reused", "b"))
+	s.ErrorIs(err, idempotency_errors.ErrIdempotencyKeyReused)
+}
+
+func (s *IdempotencyServiceTestSuite) TestReleasedKeyCanBeRetried() {
+	ctx := context.Background()
+
+	claim, err := s.service.Begin(ctx, s.request("released", "a"))
+	s.Require().NoError(err)
+	s.Require().NoError(s.service.Release(ctx, claim))
+
+	retry, err := s.service.Begin(ctx, s.request("released", "a"))
+	s.NoError(err)
+	s.Nil(retry.Response)
+}
+
+func (s *IdempotencyServiceTestSuite) TestInvalidKeyIsRejected() {
+	_, err := s.service.Begin(context.Background(), s.request("has space", "a"))
+	s.ErrorIs(err, idempotency_errors.ErrInvalidIdempotencyKey)
+}
+
+func TestIdempotencyServiceSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(IdempotencyServiceTestSuite))
+}
@@ -8,7 +8,6 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
@@ -52,8 +51,7 @@ func (s *OrderItemServiceTestSuite) SetupSuite() {
 	s.rdb = redis.NewClient(opt)
 
 	// Dependencies
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -82,6 +80,7 @@ func (s *OrderItemServiceTestSuite) SetupSuite() {
 		ProductRepo:   s.repos.Product,
 		CashierRepo:   s.repos.Cashier,
 		MerchantRepo:  s.repos.Merchant,
+		UnitOfWork:    s.repos.UnitOfWork,
 		Logger:        l,
 		Observability: obs,
 		Cache:         orderCache,
@@ -7,7 +7,7 @@ import (
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/order_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
@@ -49,8 +49,7 @@ func (s *OrderServiceTestSuite) SetupSuite() {
 	s.rdb = redis.NewClient(opt)
 
 	// Dependencies
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -72,6 +71,7 @@ func (s *OrderServiceTestSuite) SetupSuite() {
 		ProductRepo:   s.repos.Product,
 		CashierRepo:   s.repos.Cashier,
 		MerchantRepo:  s.repos.Merchant,
+		UnitOfWork:    s.repos.UnitOfWork,
 		Logger:        l,
 		Observability: obs,
 		Cache:         orderCache,
@@ -219,6 +219,113 @@ func (s *OrderServiceTestSuite) TestOrderServiceLifecycle() {
 	s.Error(err)
 }
 
+func (s *OrderServiceTestSuite) TestOrderStatusTransitions() {
+	ctx := context.Background()
+
+	stockOf := func() int32 {
+		product, err := s.repos.Product.FindById(ctx, s.productID)
+		s.Require().NoError(err)
+		return product.CountInStock
+	}
+
+	newOrder := func() int {
+		order, err := s.srv.CreateOrder(ctx, &requests.CreateOrderRequest{
+			MerchantID: s.merchantID,
+			CashierID:  s.cashierID,
+			Items: []requests.CreateOrderItemRequest{
+				{ProductID: s.productID, Quantity: 3},
+			},
+		})
+		s.Require().NoError(err)
+		s.Equal("draft", order.Status)
+		return int(order.OrderID)
+	}
+
+	// 1. Cancelling an unpaid order returns its reserved stock
+	before := stockOf()
+	cancelID := newOrder()
+	s.Equal(before-3, stockOf())
+
+	pending, err := s.srv.UpdateOrderStatus(ctx, &requests.UpdateOrderStatusRequest{
+		OrderID: cancelID,
+		Status:  "pending_payment",
+	})
+	s.NoError(err)
+	s.Equal("pending_payment", pending.Status)
+
+	cancelled, err := s.srv.UpdateOrderStatus(ctx, &requests.UpdateOrderStatusRequest{
+		OrderID: cancelID,
+		Status:  "cancelled",
+	})
+	s.NoError(err)
+	s.Equal("cancelled", cancelled.Status)
+	s.Equal(before, stockOf())
+
+	// Both stock changes are in the ledger and point back at the order
+	movements, err := s.repos.StockMovement.FindByProduct(ctx, &requests.FindStockMovementsRequest{
+		ProductID: s.productID,
+		Page:      1,
+		PageSize:  2,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 2)
+	s.Equal("order_cancel", movements[0].Reason)
+	s.Equal(int32(3), movements[0].QuantityDelta)
+	s.Equal("sale", movements[1].Reason)
+	s.Equal(int32(-3), movements[1].QuantityDelta)
+	for _, movement := range movements {
+		s.Equal("order", *movement.ReferenceType)
+		s.Equal(int32(cancelID), *movement.ReferenceID)
+	}
+
+	// Cancelled orders are final
+	_, err = s.srv.UpdateOrderStatus(ctx, &requests.UpdateOrderStatusRequest{
+		OrderID: cancelID,
+		Status:  "draft",
+	})
+	s.ErrorIs(err, order_errors.ErrFailedInvalidOrderTransition)
+	s.Equal(before, stockOf())
+
+	// 2. Paid orders can no longer be edited
+	paidID := newOrder()
+	_, err = s.repos.Order.UpdateOrderStatus(ctx, paidID, "draft", "paid")
+	s.Require().NoError(err)
+
+	_, err = s.srv.UpdateOrder(ctx, &requests.UpdateOrderRequest{
+		OrderID: &paidID,
+		Items:   []requests.UpdateOrderItemRequest{},
+	})
+	s.ErrorIs(err, order_errors.ErrFailedOrderNotEditable)
+
+	_, err = s.srv.UpdateOrderStatus(ctx, &requests.UpdateOrderStatusRequest{
+		OrderID: paidID,
+		Status:  "cancelled",
+	})
+	s.ErrorIs(err, order_errors.ErrFailedInvalidOrderTransition)
+
+	// 3. Listings filter by status
+	paidList, _, err := s.srv.FindAllOrders(ctx, &requests.FindAllOrders{
+		Page:     1,
+		PageSize: 50,
+		Status:   "paid",
+	})
+	s.NoError(err)
+	s.Require().NotEmpty(paidList)
+	for _, o := range paidList {
+		s.Equal("paid", o.Status)
+	}
+
+	cancelledList, _, err := s.srv.FindByMerchant(ctx, &requests.FindAllOrderMerchant{
+		Page:       1,
+		PageSize:   50,
+		MerchantID: s.merchantID,
+		Status:     "cancelled",
+	})
+	s.NoError(err)
+	s.Require().Len(cancelledList, 1)
+	s.Equal(int32(cancelID), cancelledList[0].OrderID)
+}
+
 func TestOrderServiceSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -1,16 +1,23 @@
 package service_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"pointofsale/internal/cache"
 	product_cache "pointofsale/internal/cache/product"
 	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/domain/response"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/product_errors"
 	"pointofsale/pkg/logger"
+	"pointofsale/pkg/notifier"
 	"pointofsale/pkg/observability"
+	"pointofsale/pkg/spreadsheet"
+	"pointofsale/pkg/utils"
 	"pointofsale/tests"
+	"strings"
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -25,6 +32,8 @@ type ProductServiceTestSuite struct {
 	dbPool      *pgxpool.Pool
 	redisClient *redis.Client
 	service     service.ProductService
+	stockAlert  service.StockAlertService
+	notifier    *notifier.MemoryNotifier
 	merchantID  int
 	categoryID  int
 	categoryName string
@@ -46,8 +55,7 @@ func (s *ProductServiceTestSuite) SetupSuite() {
 	s.redisClient = redis.NewClient(opt)
 
 	// Repositories
-	queries := db.New(pool)
-	repos := repository.NewRepositories(queries)
+	repos := repository.NewRepositories(pool)
 
 	// Logging & Observability
 	logger.ResetInstance()
@@ -69,11 +77,21 @@ func (s *ProductServiceTestSuite) SetupSuite() {
 		CategoryRepo:  repos.Category,
 		MerchantRepo:  repos.Merchant,
 		ProductRepo:   repos.Product,
+		StockMovementRepo: repos.StockMovement,
+		UnitOfWork:        repos.UnitOfWork,
 		Logger:        l,
 		Observability: obs,
 		Cache:         prodCache,
 	})
 
+	s.notifier = notifier.NewMemoryNotifier()
+	s.stockAlert = service.NewStockAlertService(service.StockAlertServiceDeps{
+		ProductRepo:   repos.Product,
+		Notifier:      s.notifier,
+		Logger:        l,
+		Observability: obs,
+	})
+
 	// Create Prerequisites
 	ctx := context.Background()
 	user, err := repos.User.CreateUser(ctx, &requests.CreateUserRequest{
@@ -219,6 +237,458 @@ func (s *ProductServiceTestSuite) TestProductLifecycle() {
 	s.Error(err)
 }
 
+func (s *ProductServiceTestSuite) TestStockMovementHistory() {
+	ctx := context.Background()
+
+	slug := "stock-history-service"
+	product, err := s.service.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Stock History Product",
+		Description:  "Tracks stock movements",
+		Price:        100,
+		CountInStock: 100,
+		Brand:        "Service Brand",
+		Weight:       500,
+		SlugProduct:  &slug,
+		ImageProduct: "stock-history.jpg",
+	})
+	s.Require().NoError(err)
+	productID := int(product.ProductID)
+
+	updated, err := s.service.UpdateProduct(ctx, &requests.UpdateProductRequest{
+		ProductID:    &productID,
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Stock History Product",
+		Description:  "Tracks stock movements",
+		Price:        100,
+		CountInStock: 80,
+		Brand:        "Service Brand",
+		Weight:       500,
+		SlugProduct:  &slug,
+		ImageProduct: "stock-history.jpg",
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(80), updated.CountInStock)
+
+	movements, total, err := s.service.FindStockMovements(ctx, &requests.FindStockMovementsRequest{
+		ProductID: productID,
+		Page:      1,
+		PageSize:  10,
+	})
+	s.Require().NoError(err)
+	s.Equal(2, *total)
+	s.Require().Len(movements, 2)
+
+	s.Equal("adjustment", movements[0].Reason)
+	s.Equal(int32(-20), movements[0].QuantityDelta)
+	s.Equal(int32(80), movements[0].BalanceAfter)
+
+	s.Equal("initial", movements[1].Reason)
+	s.Equal(int32(100), movements[1].BalanceAfter)
+}
+
+func (s *ProductServiceTestSuite) TestLowStockAlerts() {
+	ctx := context.Background()
+
+	slug := "low-stock-service"
+	product, err := s.service.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Low Stock Product",
+		Description:  "Runs low on stock",
+		Price:        100,
+		CountInStock: 3,
+		Brand:        "Service Brand",
+		Weight:       500,
+		SlugProduct:  &slug,
+		ImageProduct: "low-stock.jpg",
+	})
+	s.Require().NoError(err)
+	productID := int(product.ProductID)
+
+	_, err = s.service.UpdateReorderLevel(ctx, &requests.UpdateProductReorderLevelRequest{
+		ProductID:       &productID,
+		ReorderPoint:    5,
+		ReorderQuantity: 10,
+	})
+	s.Require().NoError(err)
+
+	lowStock, total, err := s.service.FindLowStock(ctx, &requests.FindLowStockProducts{
+		MerchantID: s.merchantID,
+		Page:       1,
+		PageSize:   10,
+	})
+	s.Require().NoError(err)
+	s.Equal(1, *total)
+	s.Require().Len(lowStock, 1)
+	s.Equal(int32(productID), lowStock[0].ProductID)
+
+	s.notifier.FailWith(errors.New("webhook unavailable"))
+	_, err = s.stockAlert.EvaluateLowStock(ctx)
+	s.ErrorIs(err, product_errors.ErrFailedNotifyLowStock)
+	s.Empty(s.notifier.Alerts())
+
+	s.notifier.FailWith(nil)
+	delivered, err := s.stockAlert.EvaluateLowStock(ctx)
+	s.Require().NoError(err)
+	s.Equal(1, delivered)
+
+	alerts := s.notifier.Alerts()
+	s.Require().Len(alerts, 1)
+	s.Equal(productID, alerts[0].ProductID)
+	s.Equal(3, alerts[0].CountInStock)
+	s.Equal(10, alerts[0].ReorderQuantity)
+
+	delivered, err = s.stockAlert.EvaluateLowStock(ctx)
+	s.Require().NoError(err)
+	s.Equal(0, delivered)
+
+	missing := 999999
+	_, err = s.service.UpdateReorderLevel(ctx, &requests.UpdateProductReorderLevelRequest{
+		ProductID:    &missing,
+		ReorderPoint: 1,
+	})
+	s.ErrorIs(err, product_errors.ErrFailedProductNotFound)
+}
+
+func (s *ProductServiceTestSuite) TestLowStockAlertOncePerCrossing() {
+	ctx := context.Background()
+	movements := repository.NewRepositories(s.dbPool).StockMovement
+
+	slug := "low-stock-crossing"
+	product, err := s.service.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Low Stock Crossing Product",
+		Description:  "Dips below its reorder point twice",
+		Price:        100,
+		CountInStock: 10,
+		Brand:        "Service Brand",
+		Weight:       500,
+		SlugProduct:  &slug,
+		ImageProduct: "low-stock.jpg",
+	})
+	s.Require().NoError(err)
+	productID := int(product.ProductID)
+
+	_, err = s.service.UpdateReorderLevel(ctx, &requests.UpdateProductReorderLevelRequest{
+		ProductID:       &productID,
+		ReorderPoint:    5,
+		ReorderQuantity: 10,
+	})
+	s.Require().NoError(err)
+
+	move := func(delta int) {
+		_, err := movements.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+			ProductID:     productID,
+			Reason:        "adjustment",
+			QuantityDelta: delta,
+		})
+		s.Require().NoError(err)
+	}
+
+	alertsFor := func() []notifier.LowStockAlert {
+		var alerts []notifier.LowStockAlert
+		for _, alert := range s.notifier.Alerts() {
+			if alert.ProductID == productID {
+				alerts = append(alerts, alert)
+			}
+		}
+		return alerts
+	}
+
+	evaluate := func() {
+		_, err := s.stockAlert.EvaluateLowStock(ctx)
+		s.Require().NoError(err)
+	}
+
+	// 1. Above the reorder point nothing is alerted
+	evaluate()
+	s.Empty(alertsFor())
+
+	// 2. Falling to the reorder point alerts once, however often it is evaluated
+	move(-5)
+	evaluate()
+	move(-2)
+	evaluate()
+	evaluate()
+
+	alerts := alertsFor()
+	s.Require().Len(alerts, 1)
+	s.Equal(5, alerts[0].CountInStock)
+
+	// 3. Restocking re-arms the alert without sending one
+	move(7)
+	evaluate()
+	s.Len(alertsFor(), 1)
+
+	// 4. The next dip is a new crossing and alerts again
+	move(-6)
+	evaluate()
+	evaluate()
+
+	alerts = alertsFor()
+	s.Require().Len(alerts, 2)
+	s.Equal(4, alerts[1].CountInStock)
+
+	// Leave the product above its reorder point for the other tests.
+	move(6)
+	evaluate()
+}
+
+func (s *ProductServiceTestSuite) TestBarcodes() {
+	ctx := context.Background()
+	merchants := repository.NewRepositories(s.dbPool).Merchant
+
+	newProduct := func(name string, barcode *string) *requests.CreateProductRequest {
+		return &requests.CreateProductRequest{
+			MerchantID:   s.merchantID,
+			CategoryID:   s.categoryID,
+			Name:         name,
+			Description:  "A product with a barcode",
+			Price:        250,
+			CountInStock: 1,
+			Brand:        "Service Brand",
+			Weight:       100,
+			ImageProduct: "barcode.jpg",
+			Barcode:      barcode,
+		}
+	}
+
+	// 1. Without a GS1 prefix, codes come from the in-store EAN-13 range
+	first, err := s.service.CreateProduct(ctx, newProduct("Barcode In Store", nil))
+	s.Require().NoError(err)
+	s.Require().NotNil(first.Barcode)
+	s.Len(*first.Barcode, 13)
+	s.True(strings.HasPrefix(*first.Barcode, "20"))
+	s.NoError(utils.ValidateBarcode(*first.Barcode))
+
+	// 2. A merchant prefix is used for UPC-A without its leading zero
+	merchantID := s.merchantID
+	prefix := "0614141"
+	_, err = merchants.UpdateBarcodeSettings(ctx, &requests.UpdateMerchantBarcodeSettingsRequest{
+		MerchantID:    &merchantID,
+		Gs1Prefix:     &prefix,
+		BarcodeFormat: utils.BarcodeFormatUPCA,
+	})
+	s.Require().NoError(err)
+
+	second, err := s.service.CreateProduct(ctx, newProduct("Barcode UPC", nil))
+	s.Require().NoError(err)
+	s.Require().NotNil(second.Barcode)
+	s.Len(*second.Barcode, 12)
+	s.True(strings.HasPrefix(*second.Barcode, "614141"))
+	s.NoError(utils.ValidateBarcode(*second.Barcode))
+
+	_, err = merchants.UpdateBarcodeSettings(ctx, &requests.UpdateMerchantBarcodeSettingsRequest{
+		MerchantID:    &merchantID,
+		BarcodeFormat: utils.BarcodeFormatEAN13,
+	})
+	s.Require().NoError(err)
+
+	// 3. A barcode already in use is rejected
+	_, err = s.service.CreateProduct(ctx, newProduct("Barcode Taken", second.Barcode))
+	s.ErrorIs(err, product_errors.ErrFailedBarcodeInUse)
+
+	// 4. Updating a product keeps its barcode unless a new one is given
+	firstID := int(first.ProductID)
+	updated, err := s.service.UpdateProduct(ctx, &requests.UpdateProductRequest{
+		ProductID:    &firstID,
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Barcode In Store Renamed",
+		Description:  "A product with a barcode",
+		Price:        300,
+		CountInStock: 1,
+		Brand:        "Service Brand",
+		Weight:       100,
+		ImageProduct: "barcode.jpg",
+	})
+	s.Require().NoError(err)
+	s.Equal(*first.Barcode, *updated.Barcode)
+
+	// 5. Scans resolve to the product
+	scanned, err := s.service.FindByBarcode(ctx, *first.Barcode)
+	s.Require().NoError(err)
+	s.Equal(first.ProductID, scanned.ProductID)
+	s.Equal(int32(300), scanned.Price)
+	s.Nil(scanned.VariantID)
+
+	_, err = s.service.FindByBarcode(ctx, "4006381333931")
+	s.ErrorIs(err, product_errors.ErrFailedBarcodeNotFound)
+
+	// 6. Changing the barcode drops the old one from the scan cache
+	manual := "4006381333931"
+	_, err = s.service.UpdateProduct(ctx, &requests.UpdateProductRequest{
+		ProductID:    &firstID,
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Barcode In Store Renamed",
+		Description:  "A product with a barcode",
+		Price:        300,
+		CountInStock: 1,
+		Brand:        "Service Brand",
+		Weight:       100,
+		ImageProduct: "barcode.jpg",
+		Barcode:      &manual,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.FindByBarcode(ctx, *first.Barcode)
+	s.ErrorIs(err, product_errors.ErrFailedBarcodeNotFound)
+
+	scanned, err = s.service.FindByBarcode(ctx, manual)
+	s.Require().NoError(err)
+	s.Equal(first.ProductID, scanned.ProductID)
+
+	// 7. Manually entered barcodes need a valid check digit
+	wrong := "4006381333932"
+	s.Error(newProduct("Barcode Wrong", &wrong).Validate())
+	s.NoError(newProduct("Barcode Right", &manual).Validate())
+}
+
+func (s *ProductServiceTestSuite) TestImportExport() {
+	ctx := context.Background()
+
+	lamp, err := utils.GenerateBarcode(utils.BarcodeFormatEAN13, "")
+	s.Require().NoError(err)
+
+	rowErrors := func(row *response.ProductImportRowResponse) string {
+		return strings.Join(row.Errors, "; ")
+	}
+
+	// 1. A dry run reports every problem and writes nothing
+	sheet := "Name,Description,Price,Count_In_Stock,Brand,Weight,Category,Barcode,Slug_Product,Image_Product\n" +
+		"Imported Lamp,A desk lamp,1500,4,Import Brand,800," + s.categoryName + "," + lamp + ",,lamp.jpg\n" +
+		",,,,,,,,,\n" +
+		"Imported Broken,A broken row,abc,4,Import Brand,800,Missing Category,,,broken.jpg\n" +
+		"Imported Twin,A twin row,900,2,Import Brand,300,service-category," + lamp + ",,twin.jpg\n"
+
+	report, err := s.service.ImportProducts(ctx, &requests.ImportProductsRequest{
+		MerchantID: s.merchantID,
+		Format:     spreadsheet.FormatCSV,
+		DryRun:     true,
+		File:       []byte(sheet),
+	})
+	s.Require().NoError(err)
+	s.False(report.Applied)
+	s.Equal(2, report.Failed)
+	s.Require().Len(report.Rows, 3)
+
+	s.Equal(2, report.Rows[0].Row)
+	s.Equal("create", report.Rows[0].Action)
+	s.Empty(report.Rows[0].Errors)
+
+	s.Equal(4, report.Rows[1].Row)
+	s.Contains(rowErrors(report.Rows[1]), "price must be a whole number")
+	s.Contains(rowErrors(report.Rows[1]), `category "Missing Category" does not exist`)
+	s.NotContains(rowErrors(report.Rows[1]), "price is required")
+
+	s.Contains(rowErrors(report.Rows[2]), "barcode is already used by row 2")
+
+	_, err = s.service.FindByBarcode(ctx, lamp)
+	s.ErrorIs(err, product_errors.ErrFailedBarcodeNotFound)
+
+	// 2. Valid rows are created; a row without a barcode gets one generated
+	sheet = "name,description,price,count_in_stock,brand,weight,category,barcode,slug_product,image_product\n" +
+		"Imported Lamp,A desk lamp,1500,4,Import Brand,800," + s.categoryName + "," + lamp + ",,lamp.jpg\n" +
+		"Imported Chair,An office chair,4500,2,Import Brand,9000,service-category,,imported-chair,chair.jpg\n"
+
+	report, err = s.service.ImportProducts(ctx, &requests.ImportProductsRequest{
+		MerchantID: s.merchantID,
+		Format:     spreadsheet.FormatCSV,
+		File:       []byte(sheet),
+	})
+	s.Require().NoError(err)
+	s.True(report.Applied)
+	s.Equal(2, report.Created)
+	s.Zero(report.Failed)
+	s.Require().NotNil(report.Rows[0].ProductID)
+	s.Require().NotNil(report.Rows[1].ProductID)
+
+	lampID, chairID := *report.Rows[0].ProductID, *report.Rows[1].ProductID
+
+	chair, err := s.service.FindById(ctx, chairID)
+	s.Require().NoError(err)
+	s.Equal("imported-chair", *chair.SlugProduct)
+	s.Require().NotNil(chair.Barcode)
+
+	// 3. Importing again matches on barcode and on slug and updates
+	sheet = "name,price,count_in_stock,description,brand,weight,category,barcode,slug_product,image_product\n" +
+		"Imported Lamp,1750,4,A desk lamp,Import Brand,800," + s.categoryName + "," + lamp + ",,lamp.jpg\n" +
+		"Imported Chair Deluxe,4900,3,An office chair,Import Brand,9000,service-category,,imported-chair,chair.jpg\n"
+
+	report, err = s.service.ImportProducts(ctx, &requests.ImportProductsRequest{
+		MerchantID: s.merchantID,
+		Format:     spreadsheet.FormatCSV,
+		File:       []byte(sheet),
+	})
+	s.Require().NoError(err)
+	s.Equal(2, report.Updated)
+	s.Zero(report.Created)
+	s.Equal("update", report.Rows[0].Action)
+	s.Equal(lampID, *report.Rows[0].ProductID)
+	s.Equal(chairID, *report.Rows[1].ProductID)
+
+	updated, err := s.service.FindById(ctx, chairID)
+	s.Require().NoError(err)
+	s.Equal("Imported Chair Deluxe", updated.Name)
+	s.Equal(int32(3), updated.CountInStock)
+	s.Equal("imported-chair", *updated.SlugProduct)
+	s.Equal(*chair.Barcode, *updated.Barcode)
+
+	scanned, err := s.service.FindByBarcode(ctx, lamp)
+	s.Require().NoError(err)
+	s.Equal(int32(1750), scanned.Price)
+
+	// 4. An export can be read back and imported as updates
+	for _, format := range []string{spreadsheet.FormatCSV, spreadsheet.FormatXLSX} {
+		file, err := s.service.ExportProducts(ctx, &requests.ExportProductsRequest{
+			MerchantID: s.merchantID,
+			Format:     format,
+			Search:     "Imported",
+		})
+		s.Require().NoError(err)
+
+		records, err := spreadsheet.Read(format, bytes.NewReader(file))
+		s.Require().NoError(err)
+		s.Require().Len(records, 3)
+		s.Equal("name", records[0][0])
+
+		report, err = s.service.ImportProducts(ctx, &requests.ImportProductsRequest{
+			MerchantID: s.merchantID,
+			Format:     format,
+			DryRun:     true,
+			File:       file,
+		})
+		s.Require().NoError(err)
+		s.Zero(report.Failed)
+
+		for _, row := range report.Rows {
+			s.Equal("update", row.Action)
+		}
+	}
+
+	// 5. A sheet without the required columns is rejected
+	_, err = s.service.ImportProducts(ctx, &requests.ImportProductsRequest{
+		MerchantID: s.merchantID,
+		Format:     spreadsheet.FormatCSV,
+		File:       []byte("name,price\nImported Nothing,100\n"),
+	})
+	s.ErrorIs(err, product_errors.ErrFailedImportMissingColumns)
+
+	// 6. A malformed row is reported with its row number
+	_, err = s.service.ImportProducts(ctx, &requests.ImportProductsRequest{
+		MerchantID: s.merchantID,
+		Format:     spreadsheet.FormatCSV,
+		File:       []byte("name,price,category\nImported Latte,100,Coffee\nFlat \"White,100,Coffee\n"),
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "row 3 is malformed")
+}
+
 func TestProductServiceSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -0,0 +1,428 @@
+package service_test
+
+import (
+	"context"
+	"pointofsale/internal/cache"
+	order_cache "pointofsale/internal/cache/order"
+	product_cache "pointofsale/internal/cache/product"
+	product_variant_cache "pointofsale/internal/cache/product_variant"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/errors/product_errors"
+	"pointofsale/pkg/errors/product_variant_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type ProductVariantServiceTestSuite struct {
+	suite.Suite
+	ts             *tests.TestSuite
+	dbPool         *pgxpool.Pool
+	redisClient    *redis.Client
+	repos          *repository.Repositories
+	service        service.ProductVariantService
+	productService service.ProductService
+	orderService   service.OrderService
+	userID         int
+	categoryID     int
+}
+
+func (s *ProductVariantServiceTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opt, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opt)
+
+	s.repos = repository.NewRepositories(pool)
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	l, err := logger.NewLogger("test-product-variant-service", lp)
+	s.Require().NoError(err)
+
+	obs, err := observability.NewObservability("test-product-variant-service", l)
+	s.Require().NoError(err)
+
+	cacheMetrics, err := observability.NewCacheMetrics("test-product-variant-service")
+	s.Require().NoError(err)
+	cacheStore := cache.NewCacheStore(s.redisClient, l, cacheMetrics)
+	productCache := product_cache.NewProductMencache(cacheStore)
+
+	s.service = service.NewProductVariantService(service.ProductVariantServiceDeps{
+		ProductVariantRepo: s.repos.ProductVariant,
+		ProductRepo:        s.repos.Product,
+		UnitOfWork:         s.repos.UnitOfWork,
+		Logger:             l,
+		Observability:      obs,
+		Cache:              product_variant_cache.NewProductVariantMencache(cacheStore),
+		ProductCache:       productCache,
+	})
+
+	s.productService = service.NewProductService(service.ProductServiceDeps{
+		CategoryRepo:      s.repos.Category,
+		MerchantRepo:      s.repos.Merchant,
+		ProductRepo:       s.repos.Product,
+		StockMovementRepo: s.repos.StockMovement,
+		UnitOfWork:        s.repos.UnitOfWork,
+		Logger:            l,
+		Observability:     obs,
+		Cache:             productCache,
+	})
+
+	s.orderService = service.NewOrderService(service.OrderServiceDeps{
+		OrderRepo:     s.repos.Order,
+		OrderItemRepo: s.repos.OrderItem,
+		ProductRepo:   s.repos.Product,
+		CashierRepo:   s.repos.Cashier,
+		MerchantRepo:  s.repos.Merchant,
+		UnitOfWork:    s.repos.UnitOfWork,
+		Logger:        l,
+		Observability: obs,
+		Cache:         order_cache.NewOrderMencache(cacheStore),
+	})
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Variant",
+		LastName:  "Service",
+		Email:     "variant.service@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+	s.userID = int(user.UserID)
+
+	slug := "variant-service-category"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Variant Service Category",
+		Description:  "A category for product variant service tests",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+	s.categoryID = int(category.CategoryID)
+}
+
+func (s *ProductVariantServiceTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *ProductVariantServiceTestSuite) createMerchant(name string) int {
+	merchant, err := s.repos.Merchant.CreateMerchant(context.Background(), &requests.CreateMerchantRequest{
+		UserID:      s.userID,
+		Name:        name,
+		Description: "A merchant for product variant service tests",
+	})
+	s.Require().NoError(err)
+	return int(merchant.MerchantID)
+}
+
+// createShirt creates a product sized S and M, ready to take variants.
+func (s *ProductVariantServiceTestSuite) createShirt(merchantID int, name string, stock int) int {
+	slug := name
+	product, err := s.repos.Product.CreateProduct(context.Background(), &requests.CreateProductRequest{
+		MerchantID:   merchantID,
+		CategoryID:   s.categoryID,
+		Name:         name,
+		Description:  "A product sold in sizes",
+		Price:        1000,
+		CountInStock: stock,
+		Brand:        "Variant Brand",
+		Weight:       200,
+		SlugProduct:  &slug,
+		ImageProduct: "variant.jpg",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.SetOptions(context.Background(), &requests.SetProductOptionsRequest{
+		ProductID: int(product.ProductID),
+		Options:   []requests.ProductOptionRequest{{Name: "size", Values: []string{"S", "M"}}},
+	})
+	s.Require().NoError(err)
+
+	return int(product.ProductID)
+}
+
+func (s *ProductVariantServiceTestSuite) TestOrderDecrementsVariantAndParentStock() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Variant Order Merchant")
+	productID := s.createShirt(merchantID, "variant-order-shirt", 0)
+
+	price := 1200
+	small, err := s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID:    productID,
+		Sku:          "SHIRT-ORDER-S",
+		Options:      map[string]string{"size": "S"},
+		Price:        &price,
+		CountInStock: 5,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID:    productID,
+		Sku:          "SHIRT-ORDER-M",
+		Options:      map[string]string{"size": "M"},
+		CountInStock: 3,
+	})
+	s.Require().NoError(err)
+
+	product, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(8), product.CountInStock)
+
+	cashier, err := s.repos.Cashier.CreateCashier(ctx, &requests.CreateCashierRequest{
+		MerchantID: merchantID,
+		UserID:     s.userID,
+		Name:       "Variant Cashier",
+	})
+	s.Require().NoError(err)
+
+	smallID := int(small.VariantID)
+	order, err := s.orderService.CreateOrder(ctx, &requests.CreateOrderRequest{
+		MerchantID: merchantID,
+		CashierID:  int(cashier.CashierID),
+		Items: []requests.CreateOrderItemRequest{
+			{ProductID: productID, VariantID: &smallID, Quantity: 2},
+		},
+	})
+	s.Require().NoError(err)
+
+	items, err := s.repos.OrderItem.FindOrderItemByOrder(ctx, int(order.OrderID))
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.Equal(int32(1200), items[0].Price)
+	s.Require().NotNil(items[0].VariantID)
+	s.Equal(small.VariantID, *items[0].VariantID)
+
+	variant, err := s.repos.ProductVariant.FindById(ctx, smallID)
+	s.Require().NoError(err)
+	s.Equal(int32(3), variant.CountInStock)
+
+	product, err = s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(6), product.CountInStock)
+
+	_, err = s.orderService.CreateOrder(ctx, &requests.CreateOrderRequest{
+		MerchantID: merchantID,
+		CashierID:  int(cashier.CashierID),
+		Items: []requests.CreateOrderItemRequest{
+			{ProductID: productID, Quantity: 1},
+		},
+	})
+	s.ErrorIs(err, product_variant_errors.ErrFailedVariantRequired)
+}
+
+func (s *ProductVariantServiceTestSuite) TestFirstVariantNeedsProductWithoutStock() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Variant Stocked Merchant")
+	productID := s.createShirt(merchantID, "variant-stocked-shirt", 4)
+
+	_, err := s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID: productID,
+		Sku:       "SHIRT-STOCKED-S",
+		Options:   map[string]string{"size": "S"},
+	})
+	s.ErrorIs(err, product_variant_errors.ErrFailedProductHasStock)
+}
+
+func (s *ProductVariantServiceTestSuite) TestOptionsMustMatchProduct() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Variant Options Merchant")
+	productID := s.createShirt(merchantID, "variant-options-shirt", 0)
+
+	_, err := s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID: productID,
+		Sku:       "SHIRT-OPTIONS-XL",
+		Options:   map[string]string{"size": "XL"},
+	})
+	s.ErrorIs(err, product_variant_errors.ErrFailedInvalidOptions)
+
+	_, err = s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID: productID,
+		Sku:       "SHIRT-OPTIONS-S",
+		Options:   map[string]string{"size": "S"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.SetOptions(ctx, &requests.SetProductOptionsRequest{
+		ProductID: productID,
+		Options:   []requests.ProductOptionRequest{{Name: "colour", Values: []string{"red"}}},
+	})
+	s.ErrorIs(err, product_variant_errors.ErrFailedOptionsInUse)
+}
+
+func (s *ProductVariantServiceTestSuite) TestListingUsesVariantPrices() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Variant Listing Merchant")
+	productID := s.createShirt(merchantID, "variant-listing-shirt", 0)
+
+	price := 1500
+	_, err := s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID: productID,
+		Sku:       "SHIRT-LISTING-S",
+		Options:   map[string]string{"size": "S"},
+		Price:     &price,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID: productID,
+		Sku:       "SHIRT-LISTING-M",
+		Options:   map[string]string{"size": "M"},
+	})
+	s.Require().NoError(err)
+
+	product, err := s.productService.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(2), product.VariantCount)
+	s.Equal(int32(1000), product.MinPrice)
+	s.Equal(int32(1500), product.MaxPrice)
+
+	products, total, err := s.productService.FindByMerchant(ctx, &requests.ProductByMerchantRequest{
+		MerchantID: merchantID,
+		MinPrice:   1400,
+		Page:       1,
+		PageSize:   10,
+	})
+	s.Require().NoError(err)
+	s.Equal(1, *total)
+	s.Require().Len(products, 1)
+	s.Equal(int32(productID), products[0].ProductID)
+
+	products, total, err = s.productService.FindByMerchant(ctx, &requests.ProductByMerchantRequest{
+		MerchantID: merchantID,
+		MinPrice:   1600,
+		Page:       1,
+		PageSize:   10,
+	})
+	s.Require().NoError(err)
+	s.Equal(0, *total)
+	s.Empty(products)
+
+	products, _, err = s.productService.FindByMerchant(ctx, &requests.ProductByMerchantRequest{
+		MerchantID: merchantID,
+		Search:     "SHIRT-LISTING-M",
+		Page:       1,
+		PageSize:   10,
+	})
+	s.Require().NoError(err)
+	s.Len(products, 1)
+}
+
+func (s *ProductVariantServiceTestSuite) TestScanResolvesVariant() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Variant Scan Merchant")
+	productID := s.createShirt(merchantID, "variant-scan-shirt", 0)
+
+	barcode := "5901234123457"
+	price := 1500
+	small, err := s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID:    productID,
+		Sku:          "SHIRT-SCAN-S",
+		Barcode:      &barcode,
+		Options:      map[string]string{"size": "S"},
+		Price:        &price,
+		CountInStock: 4,
+	})
+	s.Require().NoError(err)
+
+	scanned, err := s.productService.FindByBarcode(ctx, barcode)
+	s.Require().NoError(err)
+	s.Equal(int32(productID), scanned.ProductID)
+	s.Require().NotNil(scanned.VariantID)
+	s.Equal(small.VariantID, *scanned.VariantID)
+	s.Equal(int32(1500), *scanned.VariantPrice)
+	s.Equal(int32(4), *scanned.VariantCountInStock)
+
+	// A new barcode on the variant drops the old one from the scan cache
+	moved := "96385074"
+	smallID := int(small.VariantID)
+	_, err = s.service.UpdateVariant(ctx, &requests.UpdateProductVariantRequest{
+		VariantID: &smallID,
+		Sku:       "SHIRT-SCAN-S",
+		Barcode:   &moved,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.productService.FindByBarcode(ctx, barcode)
+	s.ErrorIs(err, product_errors.ErrFailedBarcodeNotFound)
+
+	scanned, err = s.productService.FindByBarcode(ctx, moved)
+	s.Require().NoError(err)
+	s.Equal(small.VariantID, *scanned.VariantID)
+}
+
+func (s *ProductVariantServiceTestSuite) TestDeleteVariantWithStockFails() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Variant Delete Merchant")
+	productID := s.createShirt(merchantID, "variant-delete-shirt", 0)
+
+	variant, err := s.service.CreateVariant(ctx, &requests.CreateProductVariantRequest{
+		ProductID:    productID,
+		Sku:          "SHIRT-DELETE-S",
+		Options:      map[string]string{"size": "S"},
+		CountInStock: 2,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.DeleteVariant(ctx, int(variant.VariantID))
+	s.ErrorIs(err, product_variant_errors.ErrFailedVariantHasStock)
+
+	zero := 0
+	_, err = s.service.UpdateVariant(ctx, &requests.UpdateProductVariantRequest{
+		VariantID:    &zero,
+		Sku:          "SHIRT-DELETE-S",
+		CountInStock: &zero,
+	})
+	s.Error(err)
+
+	id := int(variant.VariantID)
+	_, err = s.service.UpdateVariant(ctx, &requests.UpdateProductVariantRequest{
+		VariantID:    &id,
+		Sku:          "SHIRT-DELETE-S",
+		CountInStock: &zero,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.DeleteVariant(ctx, id)
+	s.Require().NoError(err)
+
+	product, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(0), product.CountInStock)
+}
+
+func TestProductVariantServiceSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(ProductVariantServiceTestSuite))
+}
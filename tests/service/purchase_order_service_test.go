@@ -0,0 +1,308 @@
+package service_test
+
+import (
+	"context"
+	"pointofsale/internal/cache"
+	purchase_order_cache "pointofsale/internal/cache/purchase_order"
+	supplier_cache "pointofsale/internal/cache/supplier"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/errors/purchase_order_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type PurchaseOrderServiceTestSuite struct {
+	suite.Suite
+	ts              *tests.TestSuite
+	dbPool          *pgxpool.Pool
+	redisClient     *redis.Client
+	repos           *repository.Repositories
+	service         service.PurchaseOrderService
+	supplierService service.SupplierService
+	merchantID      int
+	categoryID      int
+	supplierID      int
+}
+
+func (s *PurchaseOrderServiceTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opt, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opt)
+
+	s.repos = repository.NewRepositories(pool)
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	l, err := logger.NewLogger("test-purchase-order-service", lp)
+	s.Require().NoError(err)
+
+	obs, err := observability.NewObservability("test-purchase-order-service", l)
+	s.Require().NoError(err)
+
+	cacheMetrics, err := observability.NewCacheMetrics("test-purchase-order-service")
+	s.Require().NoError(err)
+	cacheStore := cache.NewCacheStore(s.redisClient, l, cacheMetrics)
+
+	s.supplierService = service.NewSupplierService(service.SupplierServiceDeps{
+		SupplierRepo:  s.repos.Supplier,
+		MerchantRepo:  s.repos.Merchant,
+		Logger:        l,
+		Observability: obs,
+		Cache:         supplier_cache.NewSupplierMencache(cacheStore),
+	})
+
+	s.service = service.NewPurchaseOrderService(service.PurchaseOrderServiceDeps{
+		PurchaseOrderRepo: s.repos.PurchaseOrder,
+		SupplierRepo:      s.repos.Supplier,
+		UnitOfWork:        s.repos.UnitOfWork,
+		Logger:            l,
+		Observability:     obs,
+		Cache:             purchase_order_cache.NewPurchaseOrderMencache(cacheStore),
+	})
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Purchase",
+		LastName:  "Service",
+		Email:     "purchase.service@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Purchase Service Merchant",
+		Description: "A merchant for purchase order service tests",
+	})
+	s.Require().NoError(err)
+	s.merchantID = int(merchant.MerchantID)
+
+	slug := "purchase-service-category"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Purchase Service Category",
+		Description:  "A category for purchase order service tests",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+	s.categoryID = int(category.CategoryID)
+
+	supplier, err := s.supplierService.CreateSupplier(ctx, &requests.CreateSupplierRequest{
+		MerchantID: s.merchantID,
+		Name:       "Service Supplier",
+	})
+	s.Require().NoError(err)
+	s.supplierID = int(supplier.SupplierID)
+}
+
+func (s *PurchaseOrderServiceTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *PurchaseOrderServiceTestSuite) createProduct(name string, stock int) int {
+	slug := name
+	product, err := s.repos.Product.CreateProduct(context.Background(), &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         name,
+		Description:  "A product restocked through purchase orders",
+		Price:        1000,
+		CountInStock: stock,
+		Brand:        "Purchase Brand",
+		Weight:       100,
+		SlugProduct:  &slug,
+		ImageProduct: "purchase.jpg",
+	})
+	s.Require().NoError(err)
+	return int(product.ProductID)
+}
+
+func (s *PurchaseOrderServiceTestSuite) TestReceiveInPartsUpdatesStock() {
+	ctx := context.Background()
+
+	productID := s.createProduct("purchase-receive-product", 5)
+
+	created, err := s.service.CreatePurchaseOrder(ctx, &requests.CreatePurchaseOrderRequest{
+		MerchantID: s.merchantID,
+		SupplierID: s.supplierID,
+		Items: []requests.CreatePurchaseOrderItemRequest{
+			{ProductID: productID, Quantity: 10, UnitCost: 600},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal("draft", created.Status)
+	poID := int(created.PurchaseOrderID)
+
+	items, err := s.service.FindItems(ctx, poID)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	itemID := int(items[0].PurchaseOrderItemID)
+
+	// Drafts cannot be received.
+	_, err = s.service.ReceivePurchaseOrder(ctx, &requests.ReceivePurchaseOrderRequest{
+		PurchaseOrderID: poID,
+		Items:           []requests.ReceivePurchaseOrderItemRequest{{PurchaseOrderItemID: itemID, Quantity: 1}},
+	})
+	s.ErrorIs(err, purchase_order_errors.ErrFailedInvalidTransition)
+
+	submitted, err := s.service.SubmitPurchaseOrder(ctx, poID)
+	s.Require().NoError(err)
+	s.Equal("ordered", submitted.Status)
+
+	partial, err := s.service.ReceivePurchaseOrder(ctx, &requests.ReceivePurchaseOrderRequest{
+		PurchaseOrderID: poID,
+		Items:           []requests.ReceivePurchaseOrderItemRequest{{PurchaseOrderItemID: itemID, Quantity: 4}},
+	})
+	s.Require().NoError(err)
+	s.Equal("partially_received", partial.Status)
+
+	product, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(9), product.CountInStock)
+
+	// Receiving more than is outstanding rolls the whole delivery back.
+	_, err = s.service.ReceivePurchaseOrder(ctx, &requests.ReceivePurchaseOrderRequest{
+		PurchaseOrderID: poID,
+		Items:           []requests.ReceivePurchaseOrderItemRequest{{PurchaseOrderItemID: itemID, Quantity: 7}},
+	})
+	s.ErrorIs(err, purchase_order_errors.ErrFailedOverReceive)
+
+	cost := 650
+	received, err := s.service.ReceivePurchaseOrder(ctx, &requests.ReceivePurchaseOrderRequest{
+		PurchaseOrderID: poID,
+		Items:           []requests.ReceivePurchaseOrderItemRequest{{PurchaseOrderItemID: itemID, Quantity: 6, UnitCost: &cost}},
+	})
+	s.Require().NoError(err)
+	s.Equal("received", received.Status)
+	s.True(received.ReceivedAt.Valid)
+
+	product, err = s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(15), product.CountInStock)
+
+	receipts, err := s.service.FindReceipts(ctx, poID)
+	s.Require().NoError(err)
+	s.Require().Len(receipts, 2)
+	s.Equal(int32(600), receipts[0].UnitCost)
+	s.Equal(int32(650), receipts[1].UnitCost)
+
+	movements, err := s.repos.StockMovement.FindByProduct(ctx, &requests.FindStockMovementsRequest{
+		ProductID: productID,
+		Page:      1,
+		PageSize:  10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 2)
+	s.Equal("goods_received", movements[0].Reason)
+	s.Equal(int32(6), movements[0].QuantityDelta)
+	s.Equal(int32(15), movements[0].BalanceAfter)
+
+	// Fully received orders are final.
+	_, err = s.service.CancelPurchaseOrder(ctx, poID)
+	s.ErrorIs(err, purchase_order_errors.ErrFailedInvalidTransition)
+}
+
+func (s *PurchaseOrderServiceTestSuite) TestCancelOrderedPurchaseOrder() {
+	ctx := context.Background()
+
+	productID := s.createProduct("purchase-cancel-product", 3)
+
+	created, err := s.service.CreatePurchaseOrder(ctx, &requests.CreatePurchaseOrderRequest{
+		MerchantID: s.merchantID,
+		SupplierID: s.supplierID,
+		Items: []requests.CreatePurchaseOrderItemRequest{
+			{ProductID: productID, Quantity: 2, UnitCost: 500},
+		},
+	})
+	s.Require().NoError(err)
+	poID := int(created.PurchaseOrderID)
+
+	_, err = s.service.SubmitPurchaseOrder(ctx, poID)
+	s.Require().NoError(err)
+
+	cancelled, err := s.service.CancelPurchaseOrder(ctx, poID)
+	s.Require().NoError(err)
+	s.Equal("cancelled", cancelled.Status)
+
+	_, err = s.service.SubmitPurchaseOrder(ctx, poID)
+	s.ErrorIs(err, purchase_order_errors.ErrFailedInvalidTransition)
+
+	product, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(3), product.CountInStock)
+}
+
+func (s *PurchaseOrderServiceTestSuite) TestRejectsProductOfAnotherMerchant() {
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Other",
+		LastName:  "Merchant",
+		Email:     "purchase.other@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	other, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Other Purchase Merchant",
+		Description: "Owns a product the supplier does not deliver",
+	})
+	s.Require().NoError(err)
+
+	slug := "purchase-foreign-product"
+	product, err := s.repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   int(other.MerchantID),
+		CategoryID:   s.categoryID,
+		Name:         "Foreign Product",
+		Description:  "Belongs to another merchant",
+		Price:        1000,
+		CountInStock: 1,
+		Brand:        "Purchase Brand",
+		Weight:       100,
+		SlugProduct:  &slug,
+		ImageProduct: "purchase.jpg",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.CreatePurchaseOrder(ctx, &requests.CreatePurchaseOrderRequest{
+		MerchantID: s.merchantID,
+		SupplierID: s.supplierID,
+		Items: []requests.CreatePurchaseOrderItemRequest{
+			{ProductID: int(product.ProductID), Quantity: 1, UnitCost: 500},
+		},
+	})
+	s.ErrorIs(err, purchase_order_errors.ErrFailedProductMismatch)
+}
+
+func TestPurchaseOrderServiceSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(PurchaseOrderServiceTestSuite))
+}
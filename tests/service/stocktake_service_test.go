@@ -0,0 +1,303 @@
+package service_test
+
+import (
+	"context"
+	"pointofsale/internal/cache"
+	stocktake_cache "pointofsale/internal/cache/stocktake"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/errors/stocktake_errors"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type StocktakeServiceTestSuite struct {
+	suite.Suite
+	ts          *tests.TestSuite
+	dbPool      *pgxpool.Pool
+	redisClient *redis.Client
+	repos       *repository.Repositories
+	service     service.StocktakeService
+	userID      int
+	categoryID  int
+}
+
+func (s *StocktakeServiceTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opt, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opt)
+
+	s.repos = repository.NewRepositories(pool)
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	l, err := logger.NewLogger("test-stocktake-service", lp)
+	s.Require().NoError(err)
+
+	obs, err := observability.NewObservability("test-stocktake-service", l)
+	s.Require().NoError(err)
+
+	cacheMetrics, err := observability.NewCacheMetrics("test-stocktake-service")
+	s.Require().NoError(err)
+	cacheStore := cache.NewCacheStore(s.redisClient, l, cacheMetrics)
+
+	s.service = service.NewStocktakeService(service.StocktakeServiceDeps{
+		StocktakeRepo: s.repos.Stocktake,
+		MerchantRepo:  s.repos.Merchant,
+		CategoryRepo:  s.repos.Category,
+		UnitOfWork:    s.repos.UnitOfWork,
+		Logger:        l,
+		Observability: obs,
+		Cache:         stocktake_cache.NewStocktakeMencache(cacheStore),
+	})
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Stocktake",
+		LastName:  "Service",
+		Email:     "stocktake.service@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+	s.userID = int(user.UserID)
+
+	slug := "stocktake-service-category"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Stocktake Service Category",
+		Description:  "A category for stocktake service tests",
+		SlugCategory: &slug,
+	})
+	s.Require().NoError(err)
+	s.categoryID = int(category.CategoryID)
+}
+
+func (s *StocktakeServiceTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+// createMerchant gives every test its own merchant, since a merchant can
+// only have one open stocktake at a time.
+func (s *StocktakeServiceTestSuite) createMerchant(name string) int {
+	merchant, err := s.repos.Merchant.CreateMerchant(context.Background(), &requests.CreateMerchantRequest{
+		UserID:      s.userID,
+		Name:        name,
+		Description: "A merchant for stocktake service tests",
+	})
+	s.Require().NoError(err)
+	return int(merchant.MerchantID)
+}
+
+func (s *StocktakeServiceTestSuite) createProduct(merchantID int, name string, barcode string, stock int) int {
+	slug := name
+	product, err := s.repos.Product.CreateProduct(context.Background(), &requests.CreateProductRequest{
+		MerchantID:   merchantID,
+		CategoryID:   s.categoryID,
+		Name:         name,
+		Description:  "A product counted by a stocktake",
+		Price:        500,
+		CountInStock: stock,
+		Brand:        "Stocktake Brand",
+		Weight:       100,
+		SlugProduct:  &slug,
+		ImageProduct: "stocktake.jpg",
+		Barcode:      &barcode,
+	})
+	s.Require().NoError(err)
+	return int(product.ProductID)
+}
+
+func (s *StocktakeServiceTestSuite) TestCountAndPostAdjustsStock() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Stocktake Post Merchant")
+	shortID := s.createProduct(merchantID, "stocktake-short-product", "4006381333931", 10)
+	overID := s.createProduct(merchantID, "stocktake-over-product", "036000291452", 4)
+	uncountedID := s.createProduct(merchantID, "stocktake-uncounted-product", "5901234123457", 8)
+
+	stocktake, err := s.service.CreateStocktake(ctx, &requests.CreateStocktakeRequest{
+		MerchantID: merchantID,
+	})
+	s.Require().NoError(err)
+	s.Equal("open", stocktake.Status)
+	id := int(stocktake.StocktakeID)
+
+	_, err = s.service.CreateStocktake(ctx, &requests.CreateStocktakeRequest{
+		MerchantID: merchantID,
+	})
+	s.ErrorIs(err, stocktake_errors.ErrFailedAlreadyOpen)
+
+	// Scanning the same barcode twice adds the two lines together.
+	barcode := "4006381333931"
+	counts, err := s.service.SubmitCounts(ctx, &requests.SubmitStocktakeCountsRequest{
+		StocktakeID: id,
+		Items: []requests.SubmitStocktakeCountRequest{
+			{Barcode: &barcode, Quantity: 4},
+			{Barcode: &barcode, Quantity: 3},
+			{ProductID: &overID, Quantity: 6},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(counts, 2)
+	s.Equal(int32(7), *counts[0].CountedQuantity)
+	s.Equal(int32(6), *counts[1].CountedQuantity)
+
+	unknown := "0000000000000"
+	_, err = s.service.SubmitCounts(ctx, &requests.SubmitStocktakeCountsRequest{
+		StocktakeID: id,
+		Items:       []requests.SubmitStocktakeCountRequest{{Barcode: &unknown, Quantity: 1}},
+	})
+	s.ErrorIs(err, stocktake_errors.ErrFailedUnknownBarcode)
+
+	items, total, err := s.service.FindItems(ctx, &requests.FindStocktakeItems{
+		StocktakeID: id,
+		Page:        1,
+		PageSize:    10,
+	})
+	s.Require().NoError(err)
+	s.Equal(3, *total)
+	s.Len(items, 3)
+
+	report, err := s.service.FindVarianceReport(ctx, id)
+	s.Require().NoError(err)
+	s.Require().Len(report, 1)
+	s.Equal(int32(3), report[0].ItemCount)
+	s.Equal(int32(2), report[0].CountedCount)
+	s.Equal(int32(-1), report[0].VarianceQuantity)
+	s.Equal(int64(-500), report[0].VarianceValue)
+
+	posted, err := s.service.PostStocktake(ctx, id)
+	s.Require().NoError(err)
+	s.Equal("posted", posted.Status)
+	s.True(posted.PostedAt.Valid)
+
+	short, err := s.repos.Product.FindById(ctx, shortID)
+	s.Require().NoError(err)
+	s.Equal(int32(7), short.CountInStock)
+
+	over, err := s.repos.Product.FindById(ctx, overID)
+	s.Require().NoError(err)
+	s.Equal(int32(6), over.CountInStock)
+
+	// Uncounted lines are left alone.
+	uncounted, err := s.repos.Product.FindById(ctx, uncountedID)
+	s.Require().NoError(err)
+	s.Equal(int32(8), uncounted.CountInStock)
+
+	movements, err := s.repos.StockMovement.FindByProduct(ctx, &requests.FindStockMovementsRequest{
+		ProductID: shortID,
+		Page:      1,
+		PageSize:  10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+	s.Equal("stocktake", movements[0].Reason)
+	s.Equal(int32(-3), movements[0].QuantityDelta)
+	s.Equal(int32(id), *movements[0].ReferenceID)
+
+	// Posted stocktakes are final.
+	_, err = s.service.SubmitCounts(ctx, &requests.SubmitStocktakeCountsRequest{
+		StocktakeID: id,
+		Items:       []requests.SubmitStocktakeCountRequest{{ProductID: &overID, Quantity: 1}},
+	})
+	s.ErrorIs(err, stocktake_errors.ErrFailedNotOpen)
+
+	_, err = s.service.CancelStocktake(ctx, id)
+	s.ErrorIs(err, stocktake_errors.ErrFailedInvalidTransition)
+}
+
+func (s *StocktakeServiceTestSuite) TestPostKeepsSalesMadeAfterCounting() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Stocktake Sales Merchant")
+	productID := s.createProduct(merchantID, "stocktake-sold-product", "9780201379624", 10)
+
+	stocktake, err := s.service.CreateStocktake(ctx, &requests.CreateStocktakeRequest{
+		MerchantID: merchantID,
+	})
+	s.Require().NoError(err)
+	id := int(stocktake.StocktakeID)
+
+	_, err = s.service.SubmitCounts(ctx, &requests.SubmitStocktakeCountsRequest{
+		StocktakeID: id,
+		Items:       []requests.SubmitStocktakeCountRequest{{ProductID: &productID, Quantity: 9}},
+	})
+	s.Require().NoError(err)
+
+	// Two units are sold between the count and the post.
+	_, err = s.repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+		ProductID:     productID,
+		Reason:        "sale",
+		QuantityDelta: -2,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.PostStocktake(ctx, id)
+	s.Require().NoError(err)
+
+	product, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(7), product.CountInStock)
+}
+
+func (s *StocktakeServiceTestSuite) TestCancelLeavesStockAlone() {
+	ctx := context.Background()
+
+	merchantID := s.createMerchant("Stocktake Cancel Merchant")
+	productID := s.createProduct(merchantID, "stocktake-cancel-product", "4012345678901", 5)
+
+	stocktake, err := s.service.CreateStocktake(ctx, &requests.CreateStocktakeRequest{
+		MerchantID: merchantID,
+		CategoryID: &s.categoryID,
+	})
+	s.Require().NoError(err)
+	id := int(stocktake.StocktakeID)
+
+	_, err = s.service.SubmitCounts(ctx, &requests.SubmitStocktakeCountsRequest{
+		StocktakeID: id,
+		Items:       []requests.SubmitStocktakeCountRequest{{ProductID: &productID, Quantity: 0}},
+	})
+	s.Require().NoError(err)
+
+	cancelled, err := s.service.CancelStocktake(ctx, id)
+	s.Require().NoError(err)
+	s.Equal("cancelled", cancelled.Status)
+
+	_, err = s.service.PostStocktake(ctx, id)
+	s.ErrorIs(err, stocktake_errors.ErrFailedInvalidTransition)
+
+	product, err := s.repos.Product.FindById(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal(int32(5), product.CountInStock)
+}
+
+func TestStocktakeServiceSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(StocktakeServiceTestSuite))
+}
@@ -0,0 +1,284 @@
+package service_test
+
+import (
+	"context"
+	"pointofsale/internal/cache"
+	tax_cache "pointofsale/internal/cache/tax"
+	transaction_cache "pointofsale/internal/cache/transaction"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/pkg/tax"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type TaxServiceTestSuite struct {
+	suite.Suite
+	ts                 *tests.TestSuite
+	dbPool             *pgxpool.Pool
+	redisClient        *redis.Client
+	repos              *repository.Repositories
+	service            service.TaxService
+	transactionService service.TransactionService
+	merchantID         int
+	categoryID         int
+	cashierID          int
+	orderID            int
+}
+
+func (s *TaxServiceTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opt, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.redisClient = redis.NewClient(opt)
+
+	s.repos = repository.NewRepositories(pool)
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	l, err := logger.NewLogger("test-tax-service", lp)
+	s.Require().NoError(err)
+
+	obs, err := observability.NewObservability("test-tax-service", l)
+	s.Require().NoError(err)
+
+	cacheMetrics, err := observability.NewCacheMetrics("test-tax-service")
+	s.Require().NoError(err)
+	cacheStore := cache.NewCacheStore(s.redisClient, l, cacheMetrics)
+
+	s.service = service.NewTaxService(service.TaxServiceDeps{
+		TaxRateRepo:     s.repos.TaxRate,
+		TransactionRepo: s.repos.Transaction,
+		OrderItemRepo:   s.repos.OrderItem,
+		Logger:          l,
+		Observability:   obs,
+		Cache:           tax_cache.NewTaxMencache(cacheStore),
+	})
+
+	s.transactionService = service.NewTransactionService(service.TransactionServiceDeps{
+		CashierRepo:     s.repos.Cashier,
+		MerchantRepo:    s.repos.Merchant,
+		TransactionRepo: s.repos.Transaction,
+		TransactionRefundRepo: s.repos.TransactionRefund,
+		OrderRepo:       s.repos.Order,
+		OrderItemRepo:   s.repos.OrderItem,
+		UnitOfWork:      s.repos.UnitOfWork,
+		Logger:          l,
+		Cache:           transaction_cache.NewTransactionMencache(cacheStore),
+		Observability:   obs,
+	})
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "TaxService",
+		LastName:  "User",
+		Email:     "tax.service@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "TaxService Merchant",
+		Description: "Merchant for tax service testing",
+	})
+	s.Require().NoError(err)
+	s.merchantID = int(merchant.MerchantID)
+
+	slugCat := "tax-service-category"
+	cat, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Tax Service Category",
+		Description:  "Category for tax service testing",
+		SlugCategory: &slugCat,
+	})
+	s.Require().NoError(err)
+	s.categoryID = int(cat.CategoryID)
+
+	slugProd := "tax-service-product"
+	prod, err := s.repos.Product.CreateProduct(ctx, &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         "Tax Service Product",
+		Description:  "Product priced with tax included",
+		Price:        1000,
+		CountInStock: 100,
+		Brand:        "Test Brand",
+		Weight:       1,
+		SlugProduct:  &slugProd,
+		ImageProduct: "product.jpg",
+	})
+	s.Require().NoError(err)
+
+	cash, err := s.repos.Cashier.CreateCashier(ctx, &requests.CreateCashierRequest{
+		MerchantID: s.merchantID,
+		UserID:     int(user.UserID),
+		Name:       "Tax Cashier",
+	})
+	s.Require().NoError(err)
+	s.cashierID = int(cash.CashierID)
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: 2000,
+	})
+	s.Require().NoError(err)
+	s.orderID = int(order.OrderID)
+
+	_, err = s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   s.orderID,
+		ProductID: int(prod.ProductID),
+		Quantity:  2,
+		Price:     1000,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *TaxServiceTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *TaxServiceTestSuite) TestTaxRateServiceLifecycle() {
+	ctx := context.Background()
+
+	created, err := s.service.CreateTaxRate(ctx, &requests.CreateTaxRateRequest{
+		Name:       "Category VAT",
+		CategoryID: &s.categoryID,
+		RateBps:    500,
+		Rounding:   "half_even",
+	})
+	s.Require().NoError(err)
+	id := int(created.TaxRateID)
+
+	found, err := s.service.FindById(ctx, id)
+	s.Require().NoError(err)
+	s.Equal("Category VAT", found.Name)
+
+	all, total, err := s.service.FindAll(ctx, &requests.FindAllTaxRates{Page: 1, PageSize: 10})
+	s.Require().NoError(err)
+	s.NotEmpty(all)
+	s.GreaterOrEqual(*total, 2)
+
+	updated, err := s.service.UpdateTaxRate(ctx, &requests.UpdateTaxRateRequest{
+		TaxRateID:  &id,
+		Name:       "Category VAT",
+		CategoryID: &s.categoryID,
+		RateBps:    700,
+		Rounding:   "half_even",
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(700), updated.RateBps)
+
+	found, err = s.service.FindById(ctx, id)
+	s.Require().NoError(err)
+	s.Equal(int32(700), found.RateBps)
+
+	_, err = s.service.TrashedTaxRate(ctx, id)
+	s.Require().NoError(err)
+
+	_, err = s.service.FindById(ctx, id)
+	s.Error(err)
+
+	_, err = s.service.RestoreTaxRate(ctx, id)
+	s.Require().NoError(err)
+
+	_, err = s.service.TrashedTaxRate(ctx, id)
+	s.Require().NoError(err)
+
+	deleted, err := s.service.DeleteTaxRatePermanent(ctx, id)
+	s.NoError(err)
+	s.True(deleted)
+}
+
+func (s *TaxServiceTestSuite) TestTransactionUsesMerchantInclusiveRate() {
+	ctx := context.Background()
+
+	rate, err := s.service.CreateTaxRate(ctx, &requests.CreateTaxRateRequest{
+		Name:        "Merchant inclusive VAT",
+		MerchantID:  &s.merchantID,
+		RateBps:     1000,
+		IsInclusive: true,
+		Rounding:    "half_up",
+	})
+	s.Require().NoError(err)
+
+	// Inclusive 10% on a 2000 line: tax = 2000 * 1000 / 11000 = 181.8 -> 182.
+	trans, err := s.transactionService.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       s.orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        2500,
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(2000), trans.Amount)
+	s.Equal(int32(1818), trans.SubtotalAmount)
+	s.Equal(int32(182), trans.TaxAmount)
+	s.Equal(int32(500), *trans.ChangeAmount)
+
+	lines, err := s.service.FindTransactionTax(ctx, int(trans.TransactionID))
+	s.Require().NoError(err)
+	s.Require().Len(lines, 1)
+	s.Equal(rate.TaxRateID, *lines[0].TaxRateID)
+	s.Equal(int32(1000), lines[0].TaxRateBps)
+	s.True(lines[0].TaxInclusive)
+	s.Equal("half_up", lines[0].TaxRounding)
+	s.Equal(int32(182), lines[0].TaxAmount)
+}
+
+func TestTaxServiceSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(TaxServiceTestSuite))
+}
+
+func TestTaxCalculate(t *testing.T) {
+	cases := []struct {
+		name   string
+		amount int
+		rate   tax.Rate
+		want   tax.Breakdown
+	}{
+		{"exclusive ppn rounds down", 1005, tax.Rate{Bps: 1100, Rounding: tax.RoundDown}, tax.Breakdown{Net: 1005, Tax: 110, Gross: 1115}},
+		{"exclusive half up", 1005, tax.Rate{Bps: 1100, Rounding: tax.RoundHalfUp}, tax.Breakdown{Net: 1005, Tax: 111, Gross: 1116}},
+		{"exclusive up", 1001, tax.Rate{Bps: 1100, Rounding: tax.RoundUp}, tax.Breakdown{Net: 1001, Tax: 111, Gross: 1112}},
+		{"exact amount needs no rounding", 250, tax.Rate{Bps: 1000, Rounding: tax.RoundHalfEven}, tax.Breakdown{Net: 250, Tax: 25, Gross: 275}},
+		{"half even tie down", 25, tax.Rate{Bps: 1000, Rounding: tax.RoundHalfEven}, tax.Breakdown{Net: 25, Tax: 2, Gross: 27}},
+		{"half even tie up", 35, tax.Rate{Bps: 1000, Rounding: tax.RoundHalfEven}, tax.Breakdown{Net: 35, Tax: 4, Gross: 39}},
+		{"half up tie", 25, tax.Rate{Bps: 1000, Rounding: tax.RoundHalfUp}, tax.Breakdown{Net: 25, Tax: 3, Gross: 28}},
+		{"inclusive extracts tax", 2000, tax.Rate{Bps: 1000, Inclusive: true, Rounding: tax.RoundHalfUp}, tax.Breakdown{Net: 1818, Tax: 182, Gross: 2000}},
+		{"inclusive rounds down", 2000, tax.Rate{Bps: 1000, Inclusive: true, Rounding: tax.RoundDown}, tax.Breakdown{Net: 1819, Tax: 181, Gross: 2000}},
+		{"zero rate", 1000, tax.Rate{Bps: 0, Rounding: tax.RoundHalfUp}, tax.Breakdown{Net: 1000, Tax: 0, Gross: 1000}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tax.Calculate(tc.amount, tc.rate))
+		})
+	}
+}
@@ -3,15 +3,20 @@ package service_test
 import (
 	"context"
 	"pointofsale/internal/cache"
+	order_cache "pointofsale/internal/cache/order"
 	transaction_cache "pointofsale/internal/cache/transaction"
 	"pointofsale/internal/domain/requests"
 	"pointofsale/internal/repository"
 	"pointofsale/internal/service"
-	db "pointofsale/pkg/database/schema"
+	"pointofsale/pkg/errors/order_errors"
+	"pointofsale/pkg/errors/transaction_errors"
+	"pointofsale/pkg/errors/user_errors"
 	"pointofsale/pkg/logger"
 	"pointofsale/pkg/observability"
 	"pointofsale/tests"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
@@ -26,10 +31,12 @@ type TransactionServiceTestSuite struct {
 	redisClient *redis.Client
 	repos       *repository.Repositories
 	service     service.TransactionService
+	orderSrv    service.OrderService
 	merchantID  int
 	userID      int
 	orderID     int
 	cashierID   int
+	productID   int
 }
 
 func (s *TransactionServiceTestSuite) SetupSuite() {
@@ -45,8 +52,7 @@ func (s *TransactionServiceTestSuite) SetupSuite() {
 	s.Require().NoError(err)
 	s.redisClient = redis.NewClient(opt)
 
-	queries := db.New(pool)
-	s.repos = repository.NewRepositories(queries)
+	s.repos = repository.NewRepositories(pool)
 
 	logger.ResetInstance()
 	lp := sdklog.NewLoggerProvider()
@@ -65,13 +71,27 @@ func (s *TransactionServiceTestSuite) SetupSuite() {
 		CashierRepo:     s.repos.Cashier,
 		MerchantRepo:    s.repos.Merchant,
 		TransactionRepo: s.repos.Transaction,
+		TransactionRefundRepo: s.repos.TransactionRefund,
 		OrderRepo:       s.repos.Order,
 		OrderItemRepo:   s.repos.OrderItem,
+		UnitOfWork:      s.repos.UnitOfWork,
 		Logger:          l,
 		Cache:           transCache,
 		Observability:   obs,
 	})
 
+	s.orderSrv = service.NewOrderService(service.OrderServiceDeps{
+		OrderRepo:     s.repos.Order,
+		OrderItemRepo: s.repos.OrderItem,
+		ProductRepo:   s.repos.Product,
+		CashierRepo:   s.repos.Cashier,
+		MerchantRepo:  s.repos.Merchant,
+		UnitOfWork:    s.repos.UnitOfWork,
+		Logger:        l,
+		Observability: obs,
+		Cache:         order_cache.NewOrderMencache(cacheStore),
+	})
+
 	ctx := context.Background()
 
 	// 1. Create User
@@ -117,6 +137,7 @@ func (s *TransactionServiceTestSuite) SetupSuite() {
 		ImageProduct: "product.jpg",
 	})
 	s.Require().NoError(err)
+	s.productID = int(prod.ProductID)
 
 	// 5. Create Cashier
 	cash, err := s.repos.Cashier.CreateCashier(ctx, &requests.CreateCashierRequest{
@@ -158,6 +179,29 @@ func (s *TransactionServiceTestSuite) TearDownSuite() {
 	}
 }
 
+// createOrder creates an unpaid order for quantity units of the suite's
+// product at 1000 each.
+func (s *TransactionServiceTestSuite) createOrder(quantity int) int {
+	ctx := context.Background()
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: quantity * 1000,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   int(order.OrderID),
+		ProductID: s.productID,
+		Quantity:  quantity,
+		Price:     1000,
+	})
+	s.Require().NoError(err)
+
+	return int(order.OrderID)
+}
+
 func (s *TransactionServiceTestSuite) TestTransactionServiceLifecycle() {
 	ctx := context.Background()
 	// Total amount = (1000 * 1) + 100 = 1100
@@ -169,7 +213,7 @@ func (s *TransactionServiceTestSuite) TestTransactionServiceLifecycle() {
 	createReq := &requests.CreateTransactionRequest{
 		OrderID:       s.orderID,
 		CashierID:     s.cashierID,
-		PaymentMethod: "credit_card",
+		PaymentMethod: "cash",
 		Amount:        providedAmount,
 	}
 
@@ -178,6 +222,8 @@ func (s *TransactionServiceTestSuite) TestTransactionServiceLifecycle() {
 	s.NotNil(trans)
 	s.Equal("success", trans.PaymentStatus)
 	s.Equal(int32(1110), trans.Amount)
+	s.Equal(int32(1000), trans.SubtotalAmount)
+	s.Equal(int32(110), trans.TaxAmount)
 	transID := int(trans.TransactionID)
 
 	// 2. Find All
@@ -230,6 +276,440 @@ func (s *TransactionServiceTestSuite) TestTransactionServiceLifecycle() {
 	s.True(success)
 }
 
+func (s *TransactionServiceTestSuite) TestSplitTenderTransaction() {
+	ctx := context.Background()
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: 1000,
+	})
+	s.Require().NoError(err)
+	orderID := int(order.OrderID)
+
+	_, err = s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   orderID,
+		ProductID: s.productID,
+		Quantity:  1,
+		Price:     1000,
+	})
+	s.Require().NoError(err)
+
+	approval := "APPR-001"
+
+	// 1. A partial card payment leaves the transaction pending
+	trans, err := s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:   orderID,
+		CashierID: s.cashierID,
+		Payments: []requests.TransactionPaymentRequest{
+			{PaymentMethod: "card", Amount: 500, Reference: &approval},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal("pending", trans.PaymentStatus)
+	s.Equal("card", trans.PaymentMethod)
+	s.Equal(int32(1110), trans.Amount)
+	s.Equal(int32(0), *trans.ChangeAmount)
+	transID := int(trans.TransactionID)
+
+	payments, err := s.service.FindPayments(ctx, transID)
+	s.Require().NoError(err)
+	s.Require().Len(payments, 1)
+	s.Equal(approval, *payments[0].Reference)
+
+	// 2. Card, e-wallet and cash together cover the total; change comes from cash
+	updated, err := s.service.UpdateTransaction(ctx, &requests.UpdateTransactionRequest{
+		TransactionID: &transID,
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		Payments: []requests.TransactionPaymentRequest{
+			{PaymentMethod: "card", Amount: 500, Reference: &approval},
+			{PaymentMethod: "e_wallet", Amount: 300},
+			{PaymentMethod: "cash", Amount: 400},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal("success", updated.PaymentStatus)
+	s.Equal("split", updated.PaymentMethod)
+	s.Equal(int32(90), *updated.ChangeAmount)
+
+	payments, err = s.service.FindPayments(ctx, transID)
+	s.Require().NoError(err)
+	s.Require().Len(payments, 3)
+	s.Equal(int32(0), payments[0].ChangeAmount)
+	s.Equal(int32(0), payments[1].ChangeAmount)
+	s.Equal("cash", payments[2].PaymentMethod)
+	s.Equal(int32(90), payments[2].ChangeAmount)
+
+	// 3. Method statistics count every tender line separately
+	methods, err := s.service.FindYearlyMethodSuccess(ctx, time.Now().Year())
+	s.Require().NoError(err)
+
+	year := time.Now().Format("2006")
+	totals := map[string]int{}
+	for _, row := range methods {
+		if row.Year == year {
+			totals[row.PaymentMethod] = int(row.TotalAmount)
+			s.NotEqual("split", row.PaymentMethod)
+		}
+	}
+	s.Equal(500, totals["card"])
+	s.Equal(300, totals["e_wallet"])
+
+	// 4. Non-cash tenders cannot produce change
+	_, err = s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:   s.createOrder(1),
+		CashierID: s.cashierID,
+		Payments: []requests.TransactionPaymentRequest{
+			{PaymentMethod: "card", Amount: 2000},
+		},
+	})
+	s.ErrorIs(err, transaction_errors.ErrFailedNonCashOverpayment)
+}
+
+func (s *TransactionServiceTestSuite) TestPayingPaidOrderFails() {
+	ctx := context.Background()
+
+	orderID := s.createOrder(1)
+
+	_, err := s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        2000,
+	})
+	s.Require().NoError(err)
+
+	// 1. A second payment is not recorded against the paid order
+	_, err = s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        2000,
+	})
+	s.ErrorIs(err, order_errors.ErrFailedOrderNotPayable)
+
+	// 2. A pending transaction cannot be moved onto the paid order
+	pending, err := s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       s.createOrder(1),
+		CashierID:     s.cashierID,
+		PaymentMethod: "card",
+		Amount:        500,
+	})
+	s.Require().NoError(err)
+	s.Equal("pending", pending.PaymentStatus)
+
+	pendingID := int(pending.TransactionID)
+	_, err = s.service.UpdateTransaction(ctx, &requests.UpdateTransactionRequest{
+		TransactionID: &pendingID,
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        2000,
+	})
+	s.ErrorIs(err, order_errors.ErrFailedOrderNotPayable)
+
+	paid, err := s.orderSrv.FindById(ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal("paid", paid.Status)
+}
+
+func (s *TransactionServiceTestSuite) TestPaymentInvalidatesCachedOrder() {
+	ctx := context.Background()
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: 1000,
+	})
+	s.Require().NoError(err)
+	orderID := int(order.OrderID)
+
+	_, err = s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   orderID,
+		ProductID: s.productID,
+		Quantity:  1,
+		Price:     1000,
+	})
+	s.Require().NoError(err)
+
+	// 1. Reading the order caches it while it is still unpaid
+	cached, err := s.orderSrv.FindById(ctx, orderID)
+	s.Require().NoError(err)
+	s.NotEqual("paid", cached.Status)
+
+	// 2. Paying settles the order
+	trans, err := s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        2000,
+	})
+	s.Require().NoError(err)
+	s.Equal("success", trans.PaymentStatus)
+
+	// 3. The cached order is no longer served with its old status
+	found, err := s.orderSrv.FindById(ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal("paid", found.Status)
+}
+
+func (s *TransactionServiceTestSuite) TestRefundTransaction() {
+	ctx := context.Background()
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: 3000,
+	})
+	s.Require().NoError(err)
+	orderID := int(order.OrderID)
+
+	item, err := s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   orderID,
+		ProductID: s.productID,
+		Quantity:  3,
+		Price:     1000,
+	})
+	s.Require().NoError(err)
+	itemID := int(item.OrderItemID)
+
+	trans, err := s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        5000,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("success", trans.PaymentStatus)
+	transID := int(trans.TransactionID)
+
+	product, err := s.repos.Product.FindById(ctx, s.productID)
+	s.Require().NoError(err)
+	stock := product.CountInStock
+
+	yearly := func() int {
+		rows, err := s.repos.Transaction.GetYearlyAmountSuccess(ctx, time.Now().Year())
+		s.Require().NoError(err)
+
+		for _, row := range rows {
+			if row.Year == time.Now().Format("2006") {
+				return int(row.TotalAmount)
+			}
+		}
+		return 0
+	}
+	revenue := yearly()
+
+	// 1. Refunds need an approver that exists
+	_, err = s.service.RefundTransaction(ctx, &requests.RefundTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "customer_return",
+		ApprovedBy:    999999,
+	})
+	s.ErrorIs(err, user_errors.ErrUserNotFoundRes)
+
+	// 2. Only units that were sold can be refunded
+	_, err = s.service.RefundTransaction(ctx, &requests.RefundTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "customer_return",
+		ApprovedBy:    s.userID,
+		Items:         []requests.RefundItemRequest{{OrderItemID: itemID, Quantity: 4}},
+	})
+	s.ErrorIs(err, transaction_errors.ErrFailedRefundQuantityExceeded)
+
+	// 3. Refund one unit and put it back in stock
+	partial, err := s.service.RefundTransaction(ctx, &requests.RefundTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "damaged",
+		ApprovedBy:    s.userID,
+		Restock:       true,
+		Items:         []requests.RefundItemRequest{{OrderItemID: itemID, Quantity: 1}},
+	})
+	s.Require().NoError(err)
+	s.Equal("refund", partial.Kind)
+	s.Equal(trans.Amount/3, partial.Amount)
+	s.Equal(trans.TaxAmount/3, partial.TaxAmount)
+
+	found, err := s.service.FindById(ctx, transID)
+	s.Require().NoError(err)
+	s.Equal("partially_refunded", found.PaymentStatus)
+
+	product, err = s.repos.Product.FindById(ctx, s.productID)
+	s.Require().NoError(err)
+	s.Equal(stock+1, product.CountInStock)
+
+	// 4. Refund whatever is left; the two refunds add up to the sale
+	rest, err := s.service.RefundTransaction(ctx, &requests.RefundTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "customer_return",
+		ApprovedBy:    s.userID,
+	})
+	s.Require().NoError(err)
+	s.Equal(trans.Amount, partial.Amount+rest.Amount)
+	s.Equal(trans.TaxAmount, partial.TaxAmount+rest.TaxAmount)
+
+	found, err = s.service.FindById(ctx, transID)
+	s.Require().NoError(err)
+	s.Equal("refunded", found.PaymentStatus)
+
+	product, err = s.repos.Product.FindById(ctx, s.productID)
+	s.Require().NoError(err)
+	s.Equal(stock+1, product.CountInStock)
+
+	refunds, err := s.service.FindRefunds(ctx, transID)
+	s.Require().NoError(err)
+	s.Len(refunds, 2)
+
+	// 5. Revenue reports the sale net of its refunds
+	s.Equal(revenue-int(trans.Amount), yearly())
+
+	// 6. A fully refunded sale cannot be refunded again
+	_, err = s.service.RefundTransaction(ctx, &requests.RefundTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "customer_return",
+		ApprovedBy:    s.userID,
+	})
+	s.ErrorIs(err, transaction_errors.ErrFailedTransactionNotRefundable)
+}
+
+func (s *TransactionServiceTestSuite) TestConcurrentRefundsDoNotOverRefund() {
+	ctx := context.Background()
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: 3000,
+	})
+	s.Require().NoError(err)
+	orderID := int(order.OrderID)
+
+	item, err := s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   orderID,
+		ProductID: s.productID,
+		Quantity:  3,
+		Price:     1000,
+	})
+	s.Require().NoError(err)
+	itemID := int(item.OrderItemID)
+
+	trans, err := s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        5000,
+	})
+	s.Require().NoError(err)
+	transID := int(trans.TransactionID)
+
+	// Each request alone fits the 3 units sold, any two together do not.
+	const attempts = 5
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, attempts)
+	)
+
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			_, errs[i] = s.service.RefundTransaction(ctx, &requests.RefundTransactionRequest{
+				TransactionID: transID,
+				ReasonCode:    "customer_return",
+				ApprovedBy:    s.userID,
+				Items:         []requests.RefundItemRequest{{OrderItemID: itemID, Quantity: 2}},
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		s.ErrorIs(err, transaction_errors.ErrFailedRefundQuantityExceeded)
+	}
+	s.Equal(1, succeeded)
+
+	refunded, err := s.repos.TransactionRefund.FindRefundedQuantities(ctx, transID)
+	s.Require().NoError(err)
+	s.Equal(2, refunded[itemID])
+
+	found, err := s.service.FindById(ctx, transID)
+	s.Require().NoError(err)
+	s.Equal("partially_refunded", found.PaymentStatus)
+}
+
+func (s *TransactionServiceTestSuite) TestVoidTransaction() {
+	ctx := context.Background()
+
+	order, err := s.repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		TotalPrice: 2000,
+	})
+	s.Require().NoError(err)
+	orderID := int(order.OrderID)
+
+	_, err = s.repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+		OrderID:   orderID,
+		ProductID: s.productID,
+		Quantity:  2,
+		Price:     1000,
+	})
+	s.Require().NoError(err)
+
+	trans, err := s.service.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       orderID,
+		CashierID:     s.cashierID,
+		PaymentMethod: "cash",
+		Amount:        5000,
+	})
+	s.Require().NoError(err)
+	transID := int(trans.TransactionID)
+
+	product, err := s.repos.Product.FindById(ctx, s.productID)
+	s.Require().NoError(err)
+	stock := product.CountInStock
+
+	// 1. Void the whole sale without restocking
+	void, err := s.service.VoidTransaction(ctx, &requests.VoidTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "wrong_item",
+		ApprovedBy:    s.userID,
+	})
+	s.Require().NoError(err)
+	s.Equal("void", void.Kind)
+	s.Equal(trans.Amount, void.Amount)
+
+	found, err := s.service.FindById(ctx, transID)
+	s.Require().NoError(err)
+	s.Equal("voided", found.PaymentStatus)
+
+	product, err = s.repos.Product.FindById(ctx, s.productID)
+	s.Require().NoError(err)
+	s.Equal(stock, product.CountInStock)
+
+	// 2. A voided sale can be neither voided nor refunded
+	_, err = s.service.VoidTransaction(ctx, &requests.VoidTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "wrong_item",
+		ApprovedBy:    s.userID,
+	})
+	s.ErrorIs(err, transaction_errors.ErrFailedTransactionNotVoidable)
+
+	_, err = s.service.RefundTransaction(ctx, &requests.RefundTransactionRequest{
+		TransactionID: transID,
+		ReasonCode:    "wrong_item",
+		ApprovedBy:    s.userID,
+	})
+	s.ErrorIs(err, transaction_errors.ErrFailedTransactionNotRefundable)
+}
+
 func TestTransactionServiceSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
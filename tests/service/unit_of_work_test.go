@@ -0,0 +1,425 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pointofsale/internal/cache"
+	order_cache "pointofsale/internal/cache/order"
+	transaction_cache "pointofsale/internal/cache/transaction"
+	"pointofsale/internal/domain/requests"
+	"pointofsale/internal/repository"
+	"pointofsale/internal/service"
+	"pointofsale/pkg/logger"
+	"pointofsale/pkg/observability"
+	"pointofsale/tests"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+type UnitOfWorkTestSuite struct {
+	suite.Suite
+	ts         *tests.TestSuite
+	dbPool     *pgxpool.Pool
+	rdb        *redis.Client
+	repos      *repository.Repositories
+	orderSrv   service.OrderService
+	trxSrv     service.TransactionService
+	merchantID int
+	cashierID  int
+	categoryID int
+	productSeq int
+}
+
+func (s *UnitOfWorkTestSuite) SetupSuite() {
+	ts, err := tests.SetupTestSuite()
+	s.Require().NoError(err)
+	s.ts = ts
+
+	pool, err := pgxpool.New(s.ts.Ctx, s.ts.DBURL)
+	s.Require().NoError(err)
+	s.dbPool = pool
+
+	opt, err := redis.ParseURL(s.ts.RedisURL)
+	s.Require().NoError(err)
+	s.rdb = redis.NewClient(opt)
+
+	s.repos = repository.NewRepositories(pool)
+
+	logger.ResetInstance()
+	lp := sdklog.NewLoggerProvider()
+	l, err := logger.NewLogger("test-unit-of-work", lp)
+	s.Require().NoError(err)
+
+	obs, err := observability.NewObservability("test-unit-of-work", l)
+	s.Require().NoError(err)
+
+	cacheMetrics, err := observability.NewCacheMetrics("test-unit-of-work")
+	s.Require().NoError(err)
+	cacheStore := cache.NewCacheStore(s.rdb, l, cacheMetrics)
+
+	s.orderSrv = service.NewOrderService(service.OrderServiceDeps{
+		OrderRepo:     s.repos.Order,
+		OrderItemRepo: s.repos.OrderItem,
+		ProductRepo:   s.repos.Product,
+		CashierRepo:   s.repos.Cashier,
+		MerchantRepo:  s.repos.Merchant,
+		UnitOfWork:    s.repos.UnitOfWork,
+		Logger:        l,
+		Observability: obs,
+		Cache:         order_cache.NewOrderMencache(cacheStore),
+	})
+
+	s.trxSrv = service.NewTransactionService(service.TransactionServiceDeps{
+		CashierRepo:     s.repos.Cashier,
+		MerchantRepo:    s.repos.Merchant,
+		TransactionRepo: s.repos.Transaction,
+		TransactionRefundRepo: s.repos.TransactionRefund,
+		OrderRepo:       s.repos.Order,
+		OrderItemRepo:   s.repos.OrderItem,
+		UnitOfWork:      s.repos.UnitOfWork,
+		Logger:          l,
+		Observability:   obs,
+		Cache:           transaction_cache.NewTransactionMencache(cacheStore),
+	})
+
+	ctx := context.Background()
+
+	user, err := s.repos.User.CreateUser(ctx, &requests.CreateUserRequest{
+		FirstName: "Uow",
+		LastName:  "User",
+		Email:     "uow.user@example.com",
+		Password:  "password123",
+	})
+	s.Require().NoError(err)
+
+	merchant, err := s.repos.Merchant.CreateMerchant(ctx, &requests.CreateMerchantRequest{
+		UserID:      int(user.UserID),
+		Name:        "Uow Merchant",
+		Description: "Merchant for unit of work tests",
+	})
+	s.Require().NoError(err)
+	s.merchantID = int(merchant.MerchantID)
+
+	slugCat := "uow-cat"
+	category, err := s.repos.Category.CreateCategory(ctx, &requests.CreateCategoryRequest{
+		Name:         "Uow Cat",
+		Description:  "Category for unit of work tests",
+		SlugCategory: &slugCat,
+	})
+	s.Require().NoError(err)
+	s.categoryID = int(category.CategoryID)
+
+	cashier, err := s.repos.Cashier.CreateCashier(ctx, &requests.CreateCashierRequest{
+		MerchantID: s.merchantID,
+		UserID:     int(user.UserID),
+		Name:       "Uow Cashier",
+	})
+	s.Require().NoError(err)
+	s.cashierID = int(cashier.CashierID)
+}
+
+func (s *UnitOfWorkTestSuite) TearDownSuite() {
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+	if s.rdb != nil {
+		s.rdb.Close()
+	}
+	if s.ts != nil {
+		s.ts.Teardown()
+	}
+}
+
+func (s *UnitOfWorkTestSuite) createProduct(stock int) int {
+	s.productSeq++
+	slug := fmt.Sprintf("uow-prod-%d", s.productSeq)
+
+	product, err := s.repos.Product.CreateProduct(context.Background(), &requests.CreateProductRequest{
+		MerchantID:   s.merchantID,
+		CategoryID:   s.categoryID,
+		Name:         slug,
+		Description:  "Product for unit of work tests",
+		Price:        100,
+		CountInStock: stock,
+		Brand:        "Uow",
+		Weight:       100,
+		SlugProduct:  &slug,
+	})
+	s.Require().NoError(err)
+
+	return int(product.ProductID)
+}
+
+func (s *UnitOfWorkTestSuite) stockOf(productID int) int32 {
+	product, err := s.repos.Product.FindById(context.Background(), productID)
+	s.Require().NoError(err)
+
+	return product.CountInStock
+}
+
+func (s *UnitOfWorkTestSuite) countRows(table string) int {
+	var count int
+	err := s.dbPool.QueryRow(context.Background(), "SELECT COUNT(*) FROM "+table).Scan(&count)
+	s.Require().NoError(err)
+
+	return count
+}
+
+func (s *UnitOfWorkTestSuite) TestCreateOrderRollsBackWhenLaterItemIsOutOfStock() {
+	ctx := context.Background()
+
+	first := s.createProduct(10)
+	second := s.createProduct(10)
+	third := s.createProduct(1)
+
+	ordersBefore := s.countRows("orders")
+	itemsBefore := s.countRows("order_items")
+
+	_, err := s.orderSrv.CreateOrder(ctx, &requests.CreateOrderRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		Items: []requests.CreateOrderItemRequest{
+			{ProductID: first, Quantity: 2},
+			{ProductID: second, Quantity: 3},
+			{ProductID: third, Quantity: 5},
+		},
+	})
+	s.Error(err)
+
+	s.Equal(ordersBefore, s.countRows("orders"))
+	s.Equal(itemsBefore, s.countRows("order_items"))
+	s.Equal(int32(10), s.stockOf(first))
+	s.Equal(int32(10), s.stockOf(second))
+	s.Equal(int32(1), s.stockOf(third))
+}
+
+func (s *UnitOfWorkTestSuite) TestCreateOrderRollsBackWhenLaterProductIsMissing() {
+	ctx := context.Background()
+
+	first := s.createProduct(10)
+
+	ordersBefore := s.countRows("orders")
+
+	_, err := s.orderSrv.CreateOrder(ctx, &requests.CreateOrderRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		Items: []requests.CreateOrderItemRequest{
+			{ProductID: first, Quantity: 4},
+			{ProductID: 999999, Quantity: 1},
+		},
+	})
+	s.Error(err)
+
+	s.Equal(ordersBefore, s.countRows("orders"))
+	s.Equal(int32(10), s.stockOf(first))
+}
+
+func (s *UnitOfWorkTestSuite) TestUpdateOrderRollsBackAddedItems() {
+	ctx := context.Background()
+
+	base := s.createProduct(10)
+	extra := s.createProduct(10)
+	scarce := s.createProduct(1)
+
+	order, err := s.orderSrv.CreateOrder(ctx, &requests.CreateOrderRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		Items: []requests.CreateOrderItemRequest{
+			{ProductID: base, Quantity: 1},
+		},
+	})
+	s.Require().NoError(err)
+	orderID := int(order.OrderID)
+
+	itemsBefore, err := s.repos.OrderItem.FindOrderItemByOrder(ctx, orderID)
+	s.Require().NoError(err)
+
+	_, err = s.orderSrv.UpdateOrder(ctx, &requests.UpdateOrderRequest{
+		OrderID: &orderID,
+		Items: []requests.UpdateOrderItemRequest{
+			{ProductID: extra, Quantity: 3},
+			{ProductID: scarce, Quantity: 2},
+		},
+	})
+	s.Error(err)
+
+	itemsAfter, err := s.repos.OrderItem.FindOrderItemByOrder(ctx, orderID)
+	s.Require().NoError(err)
+	s.Len(itemsAfter, len(itemsBefore))
+	s.Equal(int32(10), s.stockOf(extra))
+	s.Equal(int32(1), s.stockOf(scarce))
+
+	found, err := s.orderSrv.FindById(ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal(order.TotalPrice, found.TotalPrice)
+}
+
+func (s *UnitOfWorkTestSuite) TestUpdateOrderAdjustsStockByQuantityDelta() {
+	ctx := context.Background()
+
+	product := s.createProduct(10)
+
+	order, err := s.orderSrv.CreateOrder(ctx, &requests.CreateOrderRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		Items: []requests.CreateOrderItemRequest{
+			{ProductID: product, Quantity: 4},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(6), s.stockOf(product))
+
+	orderID := int(order.OrderID)
+	items, err := s.repos.OrderItem.FindOrderItemByOrder(ctx, orderID)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+
+	_, err = s.orderSrv.UpdateOrder(ctx, &requests.UpdateOrderRequest{
+		OrderID: &orderID,
+		Items: []requests.UpdateOrderItemRequest{
+			{OrderItemID: int(items[0].OrderItemID), ProductID: product, Quantity: 1},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal(int32(9), s.stockOf(product))
+
+	_, err = s.orderSrv.UpdateOrder(ctx, &requests.UpdateOrderRequest{
+		OrderID: &orderID,
+		Items: []requests.UpdateOrderItemRequest{
+			{OrderItemID: int(items[0].OrderItemID), ProductID: product, Quantity: 11},
+		},
+	})
+	s.Error(err)
+	s.Equal(int32(9), s.stockOf(product))
+}
+
+func (s *UnitOfWorkTestSuite) TestCreateTransactionRollsBackOnNonCashOverpayment() {
+	ctx := context.Background()
+
+	product := s.createProduct(10)
+
+	order, err := s.orderSrv.CreateOrder(ctx, &requests.CreateOrderRequest{
+		MerchantID: s.merchantID,
+		CashierID:  s.cashierID,
+		Items: []requests.CreateOrderItemRequest{
+			{ProductID: product, Quantity: 2},
+		},
+	})
+	s.Require().NoError(err)
+
+	transactionsBefore := s.countRows("transactions")
+
+	_, err = s.trxSrv.CreateTransaction(ctx, &requests.CreateTransactionRequest{
+		OrderID:       int(order.OrderID),
+		CashierID:     s.cashierID,
+		PaymentMethod: "card",
+		Amount:        1_000_000,
+	})
+	s.Error(err)
+
+	s.Equal(transactionsBefore, s.countRows("transactions"))
+}
+
+func (s *UnitOfWorkTestSuite) TestWithinTransactionRollsBackInjectedFailure() {
+	ctx := context.Background()
+
+	product := s.createProduct(10)
+	ordersBefore := s.countRows("orders")
+	errInjected := errors.New("injected failure")
+
+	err := s.repos.UnitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		order, err := repos.Order.CreateOrder(ctx, &requests.CreateOrderRecordRequest{
+			MerchantID: s.merchantID,
+			CashierID:  s.cashierID,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = repos.OrderItem.CreateOrderItem(ctx, &requests.CreateOrderItemRecordRequest{
+			OrderID:   int(order.OrderID),
+			ProductID: product,
+			Quantity:  1,
+			Price:     100,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+			ProductID:     product,
+			Reason:        "sale",
+			QuantityDelta: -1,
+		})
+		if err != nil {
+			return err
+		}
+
+		return errInjected
+	})
+	s.ErrorIs(err, errInjected)
+
+	s.Equal(ordersBefore, s.countRows("orders"))
+	s.Equal(int32(10), s.stockOf(product))
+}
+
+func (s *UnitOfWorkTestSuite) TestWithinTransactionRollsBackOnPanic() {
+	ctx := context.Background()
+
+	product := s.createProduct(10)
+
+	s.Panics(func() {
+		_ = s.repos.UnitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+			_, err := repos.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+				ProductID:     product,
+				Reason:        "adjustment",
+				QuantityDelta: -10,
+			})
+			if err != nil {
+				return err
+			}
+
+			panic("injected panic")
+		})
+	})
+
+	s.Equal(int32(10), s.stockOf(product))
+}
+
+func (s *UnitOfWorkTestSuite) TestNestedWithinTransactionJoinsOuterTransaction() {
+	ctx := context.Background()
+
+	product := s.createProduct(10)
+	errInjected := errors.New("injected failure")
+
+	err := s.repos.UnitOfWork.WithinTransaction(ctx, func(repos *repository.Repositories) error {
+		err := repos.UnitOfWork.WithinTransaction(ctx, func(inner *repository.Repositories) error {
+			_, err := inner.StockMovement.RecordMovement(ctx, &requests.CreateStockMovementRecordRequest{
+				ProductID:     product,
+				Reason:        "adjustment",
+				QuantityDelta: -7,
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		return errInjected
+	})
+	s.ErrorIs(err, errInjected)
+
+	s.Equal(int32(10), s.stockOf(product))
+}
+
+func TestUnitOfWorkSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(UnitOfWorkTestSuite))
+}
@@ -0,0 +1,159 @@
+package spreadsheet_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"pointofsale/pkg/spreadsheet"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	productColumns  = []string{"name", "price", "category", "barcode"}
+	requiredColumns = []string{"name", "price", "category"}
+)
+
+func TestReadCSV(t *testing.T) {
+	file := "\ufeffName,Price,Category\n" +
+		"Latte, 25000,Coffee\n" +
+		"\"Tea, Iced\",18000\n"
+
+	rows, err := spreadsheet.Read(spreadsheet.FormatCSV, strings.NewReader(file))
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{
+		{"Name", "Price", "Category"},
+		{"Latte", "25000", "Coffee"},
+		{"Tea, Iced", "18000"},
+	}, rows)
+}
+
+func TestReadCSVMalformedRow(t *testing.T) {
+	cases := []struct {
+		name string
+		file string
+		row  int
+		err  error
+	}{
+		{"bare quote", "name,price\nLatte,25000\nFlat \"White,30000\n", 3, csv.ErrBareQuote},
+		{"unterminated quote", "name,price\n\"Latte,25000\n", 2, csv.ErrQuote},
+		{"broken header", "na\"me,price\nLatte,25000\n", 1, csv.ErrBareQuote},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := spreadsheet.Read(spreadsheet.FormatCSV, strings.NewReader(tc.file))
+
+			var rowErr *spreadsheet.RowError
+			require.ErrorAs(t, err, &rowErr)
+			assert.Equal(t, tc.row, rowErr.Row)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestReadUnsupportedFormat(t *testing.T) {
+	_, err := spreadsheet.Read("ods", strings.NewReader(""))
+	assert.ErrorIs(t, err, spreadsheet.ErrUnsupportedFormat)
+}
+
+func TestReadXLSXNotAWorkbook(t *testing.T) {
+	_, err := spreadsheet.Read(spreadsheet.FormatXLSX, strings.NewReader("name,price\n"))
+	assert.Error(t, err)
+}
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"name", "price", "category"},
+		{"Latte", "25000", "Coffee"},
+		{"Tea, \"Iced\"", "18000", "Tea"},
+	}
+
+	for _, format := range []string{spreadsheet.FormatCSV, spreadsheet.FormatXLSX} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, spreadsheet.Write(format, &buf, rows))
+
+			read, err := spreadsheet.Read(format, &buf)
+			require.NoError(t, err)
+			assert.Equal(t, rows, read)
+		})
+	}
+}
+
+func TestMapRows(t *testing.T) {
+	records := [][]string{
+		{" NAME ", "Price", "notes", "Category", "barcode"},
+		{"Latte", "25000", "ignored", "Coffee", "2000000000015"},
+		{"", "", "only an unknown column", "", ""},
+		{},
+		{"Espresso", "20000", "", "Coffee"},
+		{"  ", "", "", " "},
+		{"Mocha", "abc", "", ""},
+	}
+
+	rows, err := spreadsheet.MapRows(records, productColumns, requiredColumns)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, 2, rows[0].Number)
+	assert.Equal(t, map[string]string{
+		"name":     "Latte",
+		"price":    "25000",
+		"category": "Coffee",
+		"barcode":  "2000000000015",
+	}, rows[0].Values)
+
+	// Short rows leave their trailing columns out; blank rows keep their
+	// place in the numbering.
+	assert.Equal(t, 5, rows[1].Number)
+	assert.Equal(t, "Espresso", rows[1].Values["name"])
+	_, hasBarcode := rows[1].Values["barcode"]
+	assert.False(t, hasBarcode)
+
+	// Values are not checked here, so a bad price still reaches the caller
+	// with its row number.
+	assert.Equal(t, 7, rows[2].Number)
+	assert.Equal(t, "abc", rows[2].Values["price"])
+	assert.Equal(t, "", rows[2].Values["category"])
+}
+
+func TestMapRowsHeaderErrors(t *testing.T) {
+	_, err := spreadsheet.MapRows(nil, productColumns, requiredColumns)
+	assert.ErrorIs(t, err, spreadsheet.ErrEmptySheet)
+
+	_, err = spreadsheet.MapRows([][]string{{"Name", "Cost", "barcode"}}, productColumns, requiredColumns)
+
+	var missing *spreadsheet.MissingColumnsError
+	require.ErrorAs(t, err, &missing)
+	assert.Equal(t, []string{"price", "category"}, missing.Columns)
+	assert.Contains(t, err.Error(), "price, category")
+}
+
+func TestMapRowsHeaderOnly(t *testing.T) {
+	rows, err := spreadsheet.MapRows([][]string{{"name", "price", "category"}, {"", "", ""}}, productColumns, requiredColumns)
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+}
+
+func TestMapRowsXLSXNumbering(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, spreadsheet.Write(spreadsheet.FormatXLSX, &buf, [][]string{
+		{"name", "price", "category"},
+		{"Latte", "25000", "Coffee"},
+		{},
+		{"Espresso", "20000", "Coffee"},
+	}))
+
+	records, err := spreadsheet.Read(spreadsheet.FormatXLSX, &buf)
+	require.NoError(t, err)
+
+	rows, err := spreadsheet.MapRows(records, productColumns, requiredColumns)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, 2, rows[0].Number)
+	assert.Equal(t, 4, rows[1].Number)
+}
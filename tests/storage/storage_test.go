@@ -0,0 +1,249 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"pointofsale/pkg/storage"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// storageContract runs the behaviour every Storage implementation shares.
+func storageContract(s *suite.Suite, store storage.Storage) {
+	ctx := context.Background()
+	key := fmt.Sprintf("uploads/products/contract-%d.jpg", time.Now().UnixNano())
+
+	// 1. Missing objects do not exist and deleting them is not an error
+	exists, err := store.Exists(ctx, key)
+	s.Require().NoError(err)
+	s.False(exists)
+	s.NoError(store.Delete(ctx, key))
+
+	// 2. Stored objects exist until deleted
+	s.Require().NoError(store.Put(ctx, key, []byte("first"), "image/jpeg"))
+	s.Require().NoError(store.Put(ctx, key, []byte("second"), "image/jpeg"))
+
+	exists, err = store.Exists(ctx, key)
+	s.Require().NoError(err)
+	s.True(exists)
+
+	signed, err := store.SignedURL(ctx, key, time.Minute)
+	s.Require().NoError(err)
+	s.Contains(signed, key)
+
+	s.Require().NoError(store.Delete(ctx, key))
+
+	exists, err = store.Exists(ctx, key)
+	s.Require().NoError(err)
+	s.False(exists)
+
+	// 3. Keys that could escape the storage root are rejected
+	for _, bad := range []string{"", "/etc/passwd", "../secret", "uploads/../../secret", "uploads//x", "uploads\\x"} {
+		s.ErrorIs(store.Put(ctx, bad, []byte("x"), "text/plain"), storage.ErrInvalidKey, bad)
+	}
+}
+
+type MemoryStorageTestSuite struct {
+	suite.Suite
+}
+
+func (s *MemoryStorageTestSuite) TestContract() {
+	store := storage.NewMemoryStorage()
+	storageContract(&s.Suite, store)
+	s.Empty(store.Keys())
+}
+
+func TestMemoryStorageSuite(t *testing.T) {
+	suite.Run(t, new(MemoryStorageTestSuite))
+}
+
+type LocalStorageTestSuite struct {
+	suite.Suite
+	store  *storage.LocalStorage
+	server *httptest.Server
+}
+
+func (s *LocalStorageTestSuite) SetupTest() {
+	s.store = storage.NewLocalStorage(storage.LocalStorageConfig{
+		Root:       s.T().TempDir(),
+		SigningKey: "test-signing-key",
+	})
+	s.server = httptest.NewServer(s.store.Handler())
+}
+
+func (s *LocalStorageTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *LocalStorageTestSuite) TestContract() {
+	storageContract(&s.Suite, s.store)
+}
+
+func (s *LocalStorageTestSuite) TestSignedURLs() {
+	ctx := context.Background()
+	key := "uploads/products/signed.png"
+	s.Require().NoError(s.store.Put(ctx, key, []byte("png bytes"), "image/png"))
+
+	get := func(link string) (int, string) {
+		res, err := http.Get(s.server.URL + link)
+		s.Require().NoError(err)
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		s.Require().NoError(err)
+
+		return res.StatusCode, string(body)
+	}
+
+	// 1. A signed link serves the object
+	signed, err := s.store.SignedURL(ctx, key, time.Minute)
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(signed, "/"+key+"?"))
+
+	code, body := get(signed)
+	s.Equal(http.StatusOK, code)
+	s.Equal("png bytes", body)
+
+	// 2. A link for one key does not open another
+	s.Require().NoError(s.store.Put(ctx, "uploads/products/other.png", []byte("other"), "image/png"))
+	code, _ = get(strings.Replace(signed, "signed.png", "other.png", 1))
+	s.Equal(http.StatusForbidden, code)
+
+	// 3. Tampering with the expiry breaks the signature
+	parsed, err := url.Parse(signed)
+	s.Require().NoError(err)
+	query := parsed.Query()
+	query.Set("expires", fmt.Sprint(time.Now().Add(24*time.Hour).Unix()))
+	parsed.RawQuery = query.Encode()
+
+	code, _ = get(parsed.String())
+	s.Equal(http.StatusForbidden, code)
+
+	// 4. Links stop working once they expire
+	expired, err := s.store.SignedURL(ctx, key, -time.Minute)
+	s.Require().NoError(err)
+
+	code, _ = get(expired)
+	s.Equal(http.StatusForbidden, code)
+
+	query = parsed.Query()
+	s.ErrorIs(s.store.Verify(key, query.Get("expires"), query.Get("signature")), storage.ErrInvalidSignature)
+
+	expiredURL, err := url.Parse(expired)
+	s.Require().NoError(err)
+	query = expiredURL.Query()
+	s.ErrorIs(s.store.Verify(key, query.Get("expires"), query.Get("signature")), storage.ErrExpiredSignature)
+
+	// 5. Links signed with another key are rejected
+	other := storage.NewLocalStorage(storage.LocalStorageConfig{SigningKey: "another-key"})
+	forged, err := other.SignedURL(ctx, key, time.Minute)
+	s.Require().NoError(err)
+
+	code, _ = get(forged)
+	s.Equal(http.StatusForbidden, code)
+}
+
+func TestLocalStorageSuite(t *testing.T) {
+	suite.Run(t, new(LocalStorageTestSuite))
+}
+
+// S3StorageTestSuite runs against MinIO, which speaks the S3 API.
+type S3StorageTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	container testcontainers.Container
+	store     *storage.S3Storage
+}
+
+func (s *S3StorageTestSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	container, err := testcontainers.GenericContainer(s.ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "minio/minio:latest",
+			Cmd:          []string{"server", "/data"},
+			ExposedPorts: []string{"9000/tcp"},
+			Env: map[string]string{
+				"MINIO_ROOT_USER":     "minioadmin",
+				"MINIO_ROOT_PASSWORD": "minioadmin",
+			},
+			WaitingFor: wait.ForHTTP("/minio/health/live").
+				WithPort("9000/tcp").
+				WithStartupTimeout(60 * time.Second),
+		},
+		Started: true,
+	})
+	s.Require().NoError(err)
+	s.container = container
+
+	endpoint, err := container.PortEndpoint(s.ctx, "9000/tcp", "")
+	s.Require().NoError(err)
+
+	store, err := storage.NewS3Storage(storage.S3StorageConfig{
+		Endpoint:  endpoint,
+		AccessKey: "minioadmin",
+		SecretKey: "minioadmin",
+		Bucket:    "pointofsale-test",
+		Region:    "us-east-1",
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(store.EnsureBucket(s.ctx))
+
+	// Creating the bucket twice is harmless
+	s.Require().NoError(store.EnsureBucket(s.ctx))
+	s.store = store
+}
+
+func (s *S3StorageTestSuite) TearDownSuite() {
+	if s.container != nil {
+		_ = s.container.Terminate(s.ctx)
+	}
+}
+
+func (s *S3StorageTestSuite) TestContract() {
+	storageContract(&s.Suite, s.store)
+}
+
+func (s *S3StorageTestSuite) TestPresignedURLs() {
+	key := "uploads/products/presigned.jpg"
+	s.Require().NoError(s.store.Put(s.ctx, key, []byte("jpeg bytes"), "image/jpeg"))
+
+	signed, err := s.store.SignedURL(s.ctx, key, time.Minute)
+	s.Require().NoError(err)
+
+	res, err := http.Get(signed)
+	s.Require().NoError(err)
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	s.Require().NoError(err)
+
+	s.Equal(http.StatusOK, res.StatusCode)
+	s.Equal("jpeg bytes", string(body))
+	s.Equal("image/jpeg", res.Header.Get("Content-Type"))
+
+	// Without the signature the bucket stays private
+	unsigned, err := url.Parse(signed)
+	s.Require().NoError(err)
+	unsigned.RawQuery = ""
+
+	res, err = http.Get(unsigned.String())
+	s.Require().NoError(err)
+	res.Body.Close()
+	s.Equal(http.StatusForbidden, res.StatusCode)
+}
+
+func TestS3StorageSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	suite.Run(t, new(S3StorageTestSuite))
+}
@@ -0,0 +1,102 @@
+package utils_test
+
+import (
+	"pointofsale/pkg/utils"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBarcodeCheckDigit(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		want    int
+	}{
+		{"ean8", "9638507", 4},
+		{"upca", "03600029145", 2},
+		{"ean13", "400638133393", 1},
+		{"isbn", "978020137962", 4},
+		{"check digit zero", "000000000000", 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, utils.BarcodeCheckDigit(tc.payload))
+		})
+	}
+}
+
+func TestValidateBarcode(t *testing.T) {
+	cases := []struct {
+		name    string
+		code    string
+		wantErr bool
+	}{
+		{"valid ean8", "96385074", false},
+		{"valid upca", "036000291452", false},
+		{"valid ean13", "4006381333931", false},
+		{"valid isbn", "9780201379624", false},
+		{"ean8 bad check digit", "96385075", true},
+		{"upca bad check digit", "036000291453", true},
+		{"ean13 bad check digit", "4006381333932", true},
+		{"letters", "40063813339A1", true},
+		{"spaces", "4006381 33931", true},
+		{"sign", "-36000291452", true},
+		{"empty", "", true},
+		{"too short", "1234567", true},
+		{"gtin14 not supported", "14006381333938", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := utils.ValidateBarcode(tc.code)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, utils.ErrInvalidBarcode)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGenerateBarcode(t *testing.T) {
+	cases := []struct {
+		name       string
+		format     string
+		prefix     string
+		wantLen    int
+		wantPrefix string
+		wantErr    error
+	}{
+		{"ean13 default prefix", utils.BarcodeFormatEAN13, "", 13, "20", nil},
+		{"ean13 gs1 prefix", utils.BarcodeFormatEAN13, "8991234", 13, "8991234", nil},
+		{"upca default prefix", utils.BarcodeFormatUPCA, "", 12, "4", nil},
+		{"upca drops leading zero", utils.BarcodeFormatUPCA, "0614141", 12, "614141", nil},
+		{"upca prefix must start with zero", utils.BarcodeFormatUPCA, "8991234", 0, "", utils.ErrInvalidBarcodePrefix},
+		{"non-digit prefix", utils.BarcodeFormatEAN13, "89A", 0, "", utils.ErrInvalidBarcodePrefix},
+		{"prefix leaves no room", utils.BarcodeFormatEAN13, "899123456789", 0, "", utils.ErrInvalidBarcodePrefix},
+		{"unknown format", "ean8", "", 0, "", utils.ErrInvalidBarcodeFormat},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Generated digits are random, so draw enough codes to catch a
+			// payload the check digit does not cover.
+			for i := 0; i < 100; i++ {
+				code, err := utils.GenerateBarcode(tc.format, tc.prefix)
+				if tc.wantErr != nil {
+					require.ErrorIs(t, err, tc.wantErr)
+					return
+				}
+
+				require.NoError(t, err)
+				require.Len(t, code, tc.wantLen)
+				require.True(t, strings.HasPrefix(code, tc.wantPrefix), "code %s lost prefix %s", code, tc.wantPrefix)
+				require.NoError(t, utils.ValidateBarcode(code), "generated code %s", code)
+			}
+		})
+	}
+}